@@ -0,0 +1,32 @@
+// Command config-schema-dump prints the JSON Schema of the LinodeMCP config
+// file, generated from the config package's struct tags. `make config-schema`
+// writes it to docs/contracts/config.schema.json and to the copy the Python
+// package loads for its unknown-key check.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+func run() error {
+	schema, err := config.JSONSchema()
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stdout.Write(schema); err != nil {
+		return fmt.Errorf("write schema: %w", err)
+	}
+
+	return nil
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
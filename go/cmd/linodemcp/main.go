@@ -2,13 +2,20 @@
 package main
 
 import (
+	"cmp"
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"log/slog"
+	"net"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -16,10 +23,12 @@ import (
 	"github.com/chadit/LinodeMCP/go/internal/audit"
 	"github.com/chadit/LinodeMCP/go/internal/cli"
 	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
 	"github.com/chadit/LinodeMCP/go/internal/observability"
 	"github.com/chadit/LinodeMCP/go/internal/profiles"
 	"github.com/chadit/LinodeMCP/go/internal/server"
 	"github.com/chadit/LinodeMCP/go/internal/tools"
+	"github.com/chadit/LinodeMCP/go/internal/updatecheck"
 )
 
 const (
@@ -32,17 +41,30 @@ func main() {
 
 // dispatch routes a subcommand to its handler and returns the process
 // exit code. A bare invocation (no subcommand) and the explicit `serve`
-// alias both start the MCP stdio server, so existing host configs that
-// run the binary with no arguments keep working unchanged. Every other
-// subcommand is a non-interactive CLI command in internal/cli.
+// alias both start the MCP server on the configured transport (stdio by
+// default), so existing host configs that run the binary with no
+// arguments keep working unchanged. Serve flags may follow `serve` or
+// stand alone. Every other subcommand is a non-interactive CLI command in
+// internal/cli.
+//
+// A process the Windows service control manager started never reaches the
+// switch: runAsService takes over and serves until the SCM stops it.
 func dispatch(args []string) int {
+	if exitCode, ok := runAsService(args); ok {
+		return exitCode
+	}
+
 	if len(args) == 0 {
-		return run()
+		return run(context.Background(), serveOptions{})
+	}
+
+	if strings.HasPrefix(args[0], "-") {
+		return runServe(args)
 	}
 
 	switch args[0] {
 	case "serve":
-		return run()
+		return runServe(args[1:])
 	case "profile":
 		return cli.RunProfileCommand(args[1:], os.Stdout, os.Stderr)
 	case "call":
@@ -55,11 +77,44 @@ func dispatch(args []string) int {
 		return cli.RunTUICommand(os.Stdout, os.Stderr)
 	case "version":
 		return cli.RunVersionCommand(os.Stdout, os.Stderr)
+	case "install-service":
+		return cli.RunInstallServiceCommand(args[1:], os.Stdout, os.Stderr)
 	default:
-		return run()
+		return run(context.Background(), serveOptions{})
 	}
 }
 
+// serveOptions are the serve command's flags. Empty fields fall back to
+// server.transport and server.host:server.port from the config.
+type serveOptions struct {
+	transport string
+	listen    string
+}
+
+// runServe parses the serve flags and starts the server.
+func runServe(args []string) int {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+
+	var opts serveOptions
+
+	flags.StringVar(&opts.transport, "transport", "", "MCP transport: stdio, sse, or http (default server.transport)")
+	flags.StringVar(&opts.listen, "listen", "", "host:port the sse and http transports listen on (default server.host:server.port)")
+
+	if err := flags.Parse(args); err != nil {
+		return cli.ExitUsageError
+	}
+
+	if opts.transport != "" {
+		if err := config.ValidateTransport(opts.transport); err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid --transport: %v\n", err)
+
+			return cli.ExitUsageError
+		}
+	}
+
+	return run(context.Background(), opts)
+}
+
 // auditLogger is the minimal logging surface the audit setup needs.
 // Narrowed to an interface so the helpers can be tested with a stub
 // and stay decoupled from the concrete *slog.Logger.
@@ -256,7 +311,94 @@ func runScopeValidation(
 	return 0
 }
 
-func run() int {
+// checkAPIEndpoints probes every configured environment's API URL (with its
+// pinned version) at startup. Returns 0 to continue startup, non-zero to
+// abort.
+//
+// Policy:
+//   - Version path not found (the host answers 404): fail, naming every
+//     misconfigured environment, since every tool call would 404 too.
+//   - Host unreachable: error log naming the environment but continue; the
+//     network may come back, and other environments may be fine.
+func checkAPIEndpoints(ctx context.Context, cfg *config.Config, log *slog.Logger) int {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		failures = map[string]error{}
+	)
+
+	for name, env := range cfg.Environments {
+		// A fixtures backend answers from disk, so its URL is never dialed.
+		if env.Linode.APIURL == "" || env.Linode.Backend == config.BackendFixtures {
+			continue
+		}
+
+		wg.Go(func() {
+			if err := linode.ProbeAPIURL(ctx, env.Linode.BaseURL(), env.Linode.Headers); err != nil {
+				mu.Lock()
+				failures[name] = err
+				mu.Unlock()
+			}
+		})
+	}
+
+	wg.Wait()
+
+	var misconfigured []string
+
+	for name, err := range failures {
+		if errors.Is(err, linode.ErrAPIVersionNotFound) {
+			misconfigured = append(misconfigured, name)
+			log.Error("Linode API version path not found", "environment", name, "error", err)
+
+			continue
+		}
+
+		log.Error("Linode API URL unreachable; tool calls for this environment will fail until it answers",
+			"environment", name, "error", err)
+	}
+
+	if len(misconfigured) > 0 {
+		slices.Sort(misconfigured)
+		log.Error("refusing to start: fix environments.<name>.linode.apiUrl or apiVersion",
+			"environments", misconfigured)
+
+		return 1
+	}
+
+	return 0
+}
+
+// checkForUpdate runs the startup release check and logs a newer version
+// when one exists. Advisory: a disabled check returns at once, and a failed
+// lookup (offline, rate limited) logs at debug so it never alarms anyone.
+func checkForUpdate(ctx context.Context, cfg *config.Config, log *slog.Logger) {
+	if cfg.UpdateCheck.Enabled == nil || !*cfg.UpdateCheck.Enabled {
+		return
+	}
+
+	result, err := updatecheck.Check(ctx, nil, cfg.UpdateCheck.URL, appinfo.Version)
+	if err != nil {
+		log.Debug("update check failed", "error", err)
+
+		return
+	}
+
+	if result.UpdateAvailable {
+		log.Info("newer LinodeMCP version available",
+			"current", result.Current,
+			"latest", result.Latest,
+			"url", result.ReleaseURL,
+			"highlights", result.Highlights,
+		)
+	}
+}
+
+// run starts the server and blocks until parent is cancelled or a shutdown
+// signal (SIGINT, SIGTERM; what systemd sends on stop) arrives, then drains
+// and returns the exit code. The Windows service runner cancels parent when
+// the SCM asks the service to stop.
+func run(parent context.Context, opts serveOptions) int {
 	configPath := config.Path()
 
 	watcher, err := config.NewWatcher(configPath, config.DefaultWatchInterval)
@@ -285,7 +427,7 @@ func run() int {
 	log.Info("version info", "version", versionInfo.Version, "platform", versionInfo.Platform)
 	log.Info("server config", "name", cfg.Server.Name)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(parent)
 	defer cancel()
 
 	sigChan := make(chan os.Signal, 1)
@@ -323,6 +465,10 @@ func run() int {
 	// recording middleware is otherwise built but never reached.
 	srv.SetMetricsRecorder(obs)
 
+	// /healthz embeds the same self-report the linode_server_health tool
+	// returns, for probes and dashboards watching a long-lived server.
+	obs.SetDiagnostics(srv.HealthDiagnostics)
+
 	// Phase 2a/2b/3b: open the JSONL sink (always on), add the SQLite
 	// sink when audit.sqlite.enabled, attach the combined sink, and
 	// start the retention sweeper. setupAudit returns a cleanup that
@@ -344,14 +490,28 @@ func run() int {
 	// profile's required scopes. Missing scopes always fail load; an
 	// API failure or missing token fails for elevated profiles and
 	// warns-and-continues for read-only ones. Excess scopes warn only.
+	//
+	// With server.tokenPassthrough there is no server token to check: each
+	// caller's own token authorizes their calls, so the check is skipped.
+	if exitCode := checkAPIEndpoints(ctx, cfg, log); exitCode != 0 {
+		return exitCode
+	}
+
 	active := srv.ActiveProfile()
-	if exitCode := runScopeValidation(ctx, srv, &active, log); exitCode != 0 {
+	if cfg.Server.TokenPassthrough {
+		log.Info("token passthrough enabled; Linode tokens come from each request's X-Linode-Token header")
+	} else if exitCode := runScopeValidation(ctx, srv, &active, log); exitCode != 0 {
 		return exitCode
 	}
 
 	watcher.Start(ctx)
 
-	if err := srv.Start(ctx); err != nil {
+	go checkForUpdate(ctx, cfg, log)
+
+	transport := cmp.Or(opts.transport, cfg.Server.Transport)
+	addr := cmp.Or(opts.listen, net.JoinHostPort(cfg.Server.Host, strconv.Itoa(cfg.Server.Port)))
+
+	if err := srv.Serve(ctx, transport, addr); err != nil {
 		log.Error("server error", "error", err)
 
 		return 1
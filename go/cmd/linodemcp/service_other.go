@@ -0,0 +1,11 @@
+//go:build !windows
+
+package main
+
+// runAsService reports whether the process was started by a service manager
+// that needs an in-process handshake. Only the Windows SCM does; systemd runs
+// `linodemcp serve` as a plain process and stops it with SIGTERM, which run
+// already handles.
+func runAsService([]string) (int, bool) {
+	return 0, false
+}
@@ -0,0 +1,178 @@
+//go:build windows
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/sys/windows/svc"
+	"golang.org/x/sys/windows/svc/eventlog"
+)
+
+// defaultServiceName matches the install-service --name default; it is the
+// event log source when the service was registered without --service-name.
+const defaultServiceName = "linodemcp"
+
+// eventID is the event ID every forwarded log line carries. The source is
+// registered with EventCreate.exe as its message file, which accepts 1-1000.
+const eventID = 1
+
+// runAsService serves under the Windows service control manager when the SCM
+// started this process, and reports false otherwise so dispatch carries on.
+// It changes to the working directory install-service recorded (the SCM
+// starts services in System32), forwards the server's stderr log to the
+// Application event log, and runs the server until the SCM stops it.
+func runAsService(args []string) (int, bool) {
+	isService, err := svc.IsWindowsService()
+	if err != nil || !isService {
+		return 0, false
+	}
+
+	name, workingDir := serviceFlags(args)
+
+	elog, err := eventlog.Open(name)
+	if err != nil {
+		return 1, true
+	}
+	defer func() { _ = elog.Close() }()
+
+	if workingDir != "" {
+		if err := os.Chdir(workingDir); err != nil {
+			_ = elog.Error(eventID, "change to working directory "+workingDir+": "+err.Error())
+
+			return 1, true
+		}
+	}
+
+	stopForwarding, err := forwardStderr(elog)
+	if err != nil {
+		_ = elog.Error(eventID, "redirect log output: "+err.Error())
+
+		return 1, true
+	}
+	defer stopForwarding()
+
+	handler := &windowsService{}
+	if err := svc.Run(name, handler); err != nil {
+		_ = elog.Error(eventID, "service run failed: "+err.Error())
+
+		return 1, true
+	}
+
+	return handler.exitCode, true
+}
+
+// serviceFlags reads the --service-name and --working-dir arguments
+// install-service registers after "serve". Anything unparsable falls back
+// to the defaults rather than failing the start.
+func serviceFlags(args []string) (string, string) {
+	if len(args) > 0 && args[0] == "serve" {
+		args = args[1:]
+	}
+
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+
+	name := flags.String("service-name", defaultServiceName, "")
+	workingDir := flags.String("working-dir", "", "")
+
+	if err := flags.Parse(args); err != nil || *name == "" {
+		return defaultServiceName, *workingDir
+	}
+
+	return *name, *workingDir
+}
+
+// forwardStderr points os.Stderr at a pipe and copies each line into the
+// event log, at Error or Warning for slog ERROR/WARN records and Info
+// otherwise. It must run before observability.New captures os.Stderr. The
+// returned func restores stderr and waits for the last line to land.
+func forwardStderr(elog *eventlog.Log) (func(), error) {
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+
+	original := os.Stderr
+	os.Stderr = writer
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		scanner := bufio.NewScanner(reader)
+		for scanner.Scan() {
+			line := scanner.Text()
+
+			switch {
+			case strings.Contains(line, "level=ERROR"), strings.Contains(line, `"level":"ERROR"`):
+				_ = elog.Error(eventID, line)
+			case strings.Contains(line, "level=WARN"), strings.Contains(line, `"level":"WARN"`):
+				_ = elog.Warning(eventID, line)
+			default:
+				_ = elog.Info(eventID, line)
+			}
+		}
+	}()
+
+	return func() {
+		os.Stderr = original
+		_ = writer.Close()
+		<-done
+		_ = reader.Close()
+	}, nil
+}
+
+// windowsService adapts run to the SCM's control protocol: it reports
+// Running once the server goroutine starts and cancels the server's context
+// on Stop or Shutdown, so shutdown drains in-flight tool calls exactly as a
+// SIGTERM does on Linux.
+type windowsService struct {
+	exitCode int
+}
+
+// Execute implements svc.Handler.
+func (s *windowsService) Execute(
+	_ []string, requests <-chan svc.ChangeRequest, status chan<- svc.Status,
+) (bool, uint32) {
+	status <- svc.Status{State: svc.StartPending}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan int, 1)
+
+	go func() { done <- run(ctx, serveOptions{}) }()
+
+	status <- svc.Status{State: svc.Running, Accepts: svc.AcceptStop | svc.AcceptShutdown}
+
+	for {
+		select {
+		case exitCode := <-done:
+			s.exitCode = exitCode
+			status <- svc.Status{State: svc.StopPending}
+
+			if exitCode != 0 {
+				return false, 1
+			}
+
+			return false, 0
+		case request := <-requests:
+			switch request.Cmd {
+			case svc.Interrogate:
+				status <- request.CurrentStatus
+			case svc.Stop, svc.Shutdown:
+				status <- svc.Status{State: svc.StopPending}
+
+				cancel()
+			default:
+			}
+		}
+	}
+}
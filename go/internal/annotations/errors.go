@@ -0,0 +1,18 @@
+package annotations
+
+import "errors"
+
+var (
+	// ErrInvalidEnvironment marks an environment name that cannot be used as
+	// a store file name.
+	ErrInvalidEnvironment = errors.New("environment name cannot be used as an annotations file name")
+	// ErrInvalidKey marks a key outside the allowed character set or length.
+	ErrInvalidKey = errors.New("key must be 1-64 lowercase letters, digits, '.', '_', or '-', starting with a letter or digit")
+	// ErrValueTooLong marks a value over MaxValueLength characters.
+	ErrValueTooLong = errors.New("value must be at most 1024 characters")
+	// ErrTooManyAnnotations marks a Set that would give a resource more than
+	// MaxPerResource annotations.
+	ErrTooManyAnnotations = errors.New("resource already has the maximum of 50 annotations")
+	// ErrCorruptStore marks a store file that is not valid annotations JSON.
+	ErrCorruptStore = errors.New("annotations file is not valid JSON")
+)
@@ -0,0 +1,271 @@
+// Package annotations keeps small key-value notes on Linode resources in a
+// local JSON file per environment, so an agent can leave context ("resized
+// 2024-06-01 due to OOM") that a later session reads back. Notes never reach
+// the Linode API.
+//
+// The file layout is shared with the Python server, so either server reads
+// the notes the other wrote:
+//
+//	{"version": 1, "resources": {"instance/123": {"resize-reason":
+//	  {"value": "...", "updated_at": "2026-10-15T12:00:00Z"}}}}
+package annotations
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Store limits. They keep one environment's file small enough to rewrite
+// on every Set.
+const (
+	MaxKeyLength   = 64
+	MaxValueLength = 1024
+	MaxPerResource = 50
+)
+
+const (
+	fileVersion = 1
+	dirMode     = 0o700
+	fileMode    = 0o600
+)
+
+var keyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+
+// writeMu serializes read-modify-write cycles within the process. Every
+// Store shares it, since the set and get tools each hold their own Store over
+// the same files. Writes land by rename, so a concurrent reader never sees a
+// partial file.
+var writeMu sync.Mutex
+
+// Ref names an annotated resource.
+type Ref struct {
+	Type string
+	ID   int
+}
+
+// String is the resource's key in the store file, e.g. "instance/123".
+func (r Ref) String() string {
+	return r.Type + "/" + strconv.Itoa(r.ID)
+}
+
+// ParseRef reverses Ref.String, reporting false for a malformed key.
+func ParseRef(key string) (Ref, bool) {
+	kind, rawID, found := strings.Cut(key, "/")
+	if !found || kind == "" {
+		return Ref{}, false
+	}
+
+	id, err := strconv.Atoi(rawID)
+	if err != nil {
+		return Ref{}, false
+	}
+
+	return Ref{Type: kind, ID: id}, true
+}
+
+// Entry is one stored annotation value.
+type Entry struct {
+	Value     string `json:"value"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// Resources maps a resource key (Ref.String) to its annotations by key.
+type Resources map[string]map[string]Entry
+
+type storeFile struct {
+	Version   int       `json:"version"`
+	Resources Resources `json:"resources"`
+}
+
+// Store reads and writes the annotation files under one directory.
+type Store struct {
+	now func() time.Time
+	dir string
+}
+
+// Option configures a Store at construction time.
+type Option func(*Store)
+
+// WithClock overrides the clock that stamps UpdatedAt. Tests inject a fixed
+// time; production passes nothing and gets time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(s *Store) {
+		s.now = now
+	}
+}
+
+// NewStore returns a Store over dir. The directory is created on the first
+// Set, so reading an environment that was never annotated does not touch
+// the filesystem.
+func NewStore(dir string, opts ...Option) *Store {
+	store := &Store{dir: dir, now: time.Now}
+	for _, opt := range opts {
+		opt(store)
+	}
+
+	return store
+}
+
+// ValidateKey reports whether key may name an annotation.
+func ValidateKey(key string) error {
+	if len(key) > MaxKeyLength || !keyPattern.MatchString(key) {
+		return ErrInvalidKey
+	}
+
+	return nil
+}
+
+// ValidateValue reports whether value fits in an annotation.
+func ValidateValue(value string) error {
+	if utf8.RuneCountInString(value) > MaxValueLength {
+		return ErrValueTooLong
+	}
+
+	return nil
+}
+
+// Get returns every annotated resource in environment. An environment with
+// no file yet has no annotations.
+func (s *Store) Get(environment string) (Resources, error) {
+	path, err := s.path(environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return readResources(path)
+}
+
+// Set stores value under key on ref in environment and returns the
+// resource's annotations afterwards. An empty value removes the key, and a
+// resource left with no annotations is dropped from the file.
+func (s *Store) Set(environment string, ref Ref, key, value string) (map[string]Entry, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateValue(value); err != nil {
+		return nil, err
+	}
+
+	path, err := s.path(environment)
+	if err != nil {
+		return nil, err
+	}
+
+	writeMu.Lock()
+	defer writeMu.Unlock()
+
+	resources, err := readResources(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := resources[ref.String()]
+
+	if value == "" {
+		delete(entries, key)
+	} else {
+		if _, exists := entries[key]; !exists && len(entries) >= MaxPerResource {
+			return nil, ErrTooManyAnnotations
+		}
+
+		if entries == nil {
+			entries = map[string]Entry{}
+		}
+
+		entries[key] = Entry{Value: value, UpdatedAt: s.now().UTC().Format(time.RFC3339)}
+	}
+
+	if len(entries) == 0 {
+		delete(resources, ref.String())
+	} else {
+		resources[ref.String()] = entries
+	}
+
+	if err := writeResources(path, resources); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// path is the store file for environment. Environment names come from the
+// config's keys, so this only guards against one that would escape the
+// directory.
+func (s *Store) path(environment string) (string, error) {
+	if environment == "" || environment == "." || environment == ".." ||
+		strings.ContainsAny(environment, `/\`) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidEnvironment, environment)
+	}
+
+	return filepath.Join(s.dir, environment+".json"), nil
+}
+
+func readResources(path string) (Resources, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is the configured annotations dir plus a validated environment name
+	if errors.Is(err, os.ErrNotExist) {
+		return Resources{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("read annotations file %s: %w", path, err)
+	}
+
+	var parsed storeFile
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrCorruptStore, path, err)
+	}
+
+	if parsed.Resources == nil {
+		parsed.Resources = Resources{}
+	}
+
+	return parsed.Resources, nil
+}
+
+// writeResources replaces the store file through a temp file and rename, so
+// a crash mid-write leaves the previous file intact.
+func writeResources(path string, resources Resources) error {
+	data, err := json.MarshalIndent(storeFile{Version: fileVersion, Resources: resources}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode annotations: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, dirMode); err != nil {
+		return fmt.Errorf("create annotations dir %s: %w", dir, err)
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp.*")
+	if err != nil {
+		return fmt.Errorf("create temp file in %s: %w", dir, err)
+	}
+
+	tmpPath := tmp.Name()
+
+	_, writeErr := tmp.Write(append(data, '\n'))
+	closeErr := tmp.Close()
+
+	if err := errors.Join(writeErr, closeErr, os.Chmod(tmpPath, fileMode)); err != nil {
+		_ = os.Remove(tmpPath)
+
+		return fmt.Errorf("write annotations file %s: %w", path, err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+
+		return fmt.Errorf("replace annotations file %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package annotations_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chadit/LinodeMCP/go/internal/annotations"
+)
+
+var fixedNow = time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+func newTestStore(t *testing.T) (*annotations.Store, string) {
+	t.Helper()
+
+	dir := filepath.Join(t.TempDir(), "annotations")
+
+	return annotations.NewStore(dir, annotations.WithClock(func() time.Time { return fixedNow })), dir
+}
+
+func TestStoreSetAndGet(t *testing.T) {
+	t.Parallel()
+
+	store, dir := newTestStore(t)
+	ref := annotations.Ref{Type: "instance", ID: 123}
+
+	if _, err := store.Set("default", ref, "resize-reason", "resized 2024-06-01 due to OOM"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	entries, err := store.Set("default", ref, "owner", "web team")
+	if err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	if len(entries) != 2 || entries["owner"].UpdatedAt != "2026-10-15T12:00:00Z" {
+		t.Errorf("entries = %v, want both keys stamped", entries)
+	}
+
+	// A fresh Store over the same dir sees the notes, as a later session would.
+	resources, err := annotations.NewStore(dir).Get("default")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	if got := resources["instance/123"]["resize-reason"].Value; got != "resized 2024-06-01 due to OOM" {
+		t.Errorf("resize-reason = %q", got)
+	}
+
+	info, err := os.Stat(filepath.Join(dir, "default.json"))
+	if err != nil {
+		t.Fatalf("stat store file: %v", err)
+	}
+
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("store file mode = %v, want 0600", info.Mode().Perm())
+	}
+}
+
+func TestStoreEmptyValueRemovesKey(t *testing.T) {
+	t.Parallel()
+
+	store, _ := newTestStore(t)
+	ref := annotations.Ref{Type: "volume", ID: 9}
+
+	if _, err := store.Set("default", ref, "note", "keep"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	if _, err := store.Set("default", ref, "note", ""); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	resources, err := store.Get("default")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	if _, ok := resources["volume/9"]; ok {
+		t.Errorf("resources = %v, want the emptied resource dropped", resources)
+	}
+}
+
+func TestStoreEnvironmentsAreSeparate(t *testing.T) {
+	t.Parallel()
+
+	store, _ := newTestStore(t)
+
+	if _, err := store.Set("production", annotations.Ref{Type: "instance", ID: 1}, "note", "prod"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	resources, err := store.Get("staging")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	if len(resources) != 0 {
+		t.Errorf("staging resources = %v, want none", resources)
+	}
+}
+
+func TestStoreValidation(t *testing.T) {
+	t.Parallel()
+
+	store, _ := newTestStore(t)
+	ref := annotations.Ref{Type: "instance", ID: 1}
+
+	tests := []struct {
+		name        string
+		environment string
+		key         string
+		value       string
+		want        error
+	}{
+		{name: "uppercase key", environment: "default", key: "Note", value: "x", want: annotations.ErrInvalidKey},
+		{name: "key too long", environment: "default", key: strings.Repeat("k", 65), value: "x", want: annotations.ErrInvalidKey},
+		{name: "value too long", environment: "default", key: "note", value: strings.Repeat("v", 1025), want: annotations.ErrValueTooLong},
+		{name: "environment escapes dir", environment: "../etc", key: "note", value: "x", want: annotations.ErrInvalidEnvironment},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := store.Set(tt.environment, ref, tt.key, tt.value); !errors.Is(err, tt.want) {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestStoreCapsAnnotationsPerResource(t *testing.T) {
+	t.Parallel()
+
+	store, _ := newTestStore(t)
+	ref := annotations.Ref{Type: "instance", ID: 1}
+
+	for i := range annotations.MaxPerResource {
+		if _, err := store.Set("default", ref, "k"+strings.Repeat("x", i), "v"); err != nil {
+			t.Fatalf("Set %d: %v", i, err)
+		}
+	}
+
+	if _, err := store.Set("default", ref, "one-too-many", "v"); !errors.Is(err, annotations.ErrTooManyAnnotations) {
+		t.Errorf("error = %v, want ErrTooManyAnnotations", err)
+	}
+
+	// Overwriting an existing key is still allowed at the cap.
+	if _, err := store.Set("default", ref, "k", "updated"); err != nil {
+		t.Errorf("overwrite at cap: %v", err)
+	}
+}
+
+func TestStoreRejectsCorruptFile(t *testing.T) {
+	t.Parallel()
+
+	store, dir := newTestStore(t)
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "default.json"), []byte("{not json"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	if _, err := store.Get("default"); !errors.Is(err, annotations.ErrCorruptStore) {
+		t.Errorf("error = %v, want ErrCorruptStore", err)
+	}
+}
+
+func TestParseRef(t *testing.T) {
+	t.Parallel()
+
+	ref, ok := annotations.ParseRef("volume/42")
+	if !ok || ref != (annotations.Ref{Type: "volume", ID: 42}) {
+		t.Errorf("ParseRef = %v, %v", ref, ok)
+	}
+
+	for _, bad := range []string{"volume", "/42", "volume/x"} {
+		if _, ok := annotations.ParseRef(bad); ok {
+			t.Errorf("ParseRef(%q) ok = true, want false", bad)
+		}
+	}
+}
@@ -4,6 +4,7 @@ package appinfo
 import (
 	"fmt"
 	"runtime"
+	"time"
 )
 
 // APIVersion is the current MCP API version.
@@ -20,6 +21,12 @@ var (
 	buildDate = "unknown"
 )
 
+// startTime is when the process loaded this package, close enough to process
+// start for uptime reporting.
+//
+//nolint:gochecknoglobals // fixed at process start, read-only afterwards
+var startTime = time.Now()
+
 // Info holds build and version metadata for the LinodeMCP server.
 type Info struct {
 	Version    string `json:"version"`
@@ -39,3 +46,8 @@ func Get() Info {
 		Platform:   fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
 	}
 }
+
+// StartTime returns when the process started.
+func StartTime() time.Time {
+	return startTime
+}
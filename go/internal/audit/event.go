@@ -71,6 +71,11 @@ type Mode string
 // Event is one audit record per tool call. All fields are non-optional
 // in the JSON encoding (null for genuinely absent values rather than
 // omitted) so every record has the same shape.
+//
+// MonthlyCostDeltaUSD is the estimated change in the account's monthly
+// list-price bill a successful write caused (negative for a delete),
+// set only when audit.cost_attribution is on and the tool is one the
+// pricing table covers; every other event carries null.
 type Event struct {
 	TS                   time.Time      `json:"ts"`
 	TSUnixNS             int64          `json:"ts_unix_ns"`
@@ -90,6 +95,7 @@ type Event struct {
 	LinodemcpVersion     string         `json:"linodemcp_version"`
 	SessionID            string         `json:"session_id"`
 	CredentialGeneration uint64         `json:"credential_generation"`
+	MonthlyCostDeltaUSD  *float64       `json:"monthly_cost_delta_usd"`
 }
 
 // NewEvent constructs an Event with the timestamp, ULID, and tool
@@ -146,6 +152,7 @@ func NewEvent(
 		LinodemcpVersion:     linodemcpVersion,
 		SessionID:            sessionID,
 		CredentialGeneration: credentialGeneration,
+		MonthlyCostDeltaUSD:  nil,
 	}
 }
 
@@ -185,6 +192,13 @@ func (e *Event) SetMode(mode Mode, planID string) {
 	e.PlanID = &planID
 }
 
+// SetMonthlyCostDelta records the estimated monthly cost change of a
+// successful write. The capture middleware calls it only for writes
+// the pricing table covers, after the handler succeeds.
+func (e *Event) SetMonthlyCostDelta(usd float64) {
+	e.MonthlyCostDeltaUSD = &usd
+}
+
 // MarshalJSON ensures the empty `args_redacted` slice serializes to
 // `[]` rather than `null`. Empty `args` similarly serializes to `{}`.
 // The standard encoder's behavior on nil maps and slices would
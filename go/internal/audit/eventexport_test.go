@@ -8,6 +8,7 @@ import (
 	"math"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"strings"
 	"testing"
 
@@ -66,6 +67,7 @@ func TestExportEventsSQLiteFullRecord(t *testing.T) {
 	evt.ArgsRedacted = []string{argKeyToken}
 	errText := "boom"
 	evt.Error = &errText
+	evt.SetMonthlyCostDelta(-24)
 
 	sink.Write(t.Context(), &evt)
 
@@ -108,6 +110,10 @@ func TestExportEventsSQLiteFullRecord(t *testing.T) {
 	if *got.Error != tcBoom {
 		t.Errorf("*got.Error = %v, want %v", *got.Error, tcBoom)
 	}
+
+	if got.MonthlyCostDeltaUSD == nil || *got.MonthlyCostDeltaUSD != -24 {
+		t.Errorf("got.MonthlyCostDeltaUSD = %v, want -24", got.MonthlyCostDeltaUSD)
+	}
 }
 
 // TestEncodeEventsJSON checks the JSON format round-trips to the same
@@ -179,6 +185,7 @@ func TestEncodeEventsCSV(t *testing.T) {
 
 	evt := makeTestEvent("tool_a", audit.CapabilityRead, audit.StatusSuccess, day(20, 8))
 	evt.Args = map[string]any{keyRegion: valUSEast}
+	evt.SetMonthlyCostDelta(12.5)
 
 	var buf bytes.Buffer
 
@@ -206,6 +213,15 @@ func TestEncodeEventsCSV(t *testing.T) {
 	if !strings.Contains(records[1][len(records[1])-1], valUSEast) {
 		t.Errorf("collection does not contain %v", valUSEast)
 	}
+
+	costColumn := slices.Index(records[0], "monthly_cost_delta_usd")
+	if costColumn < 0 {
+		t.Fatal("CSV header has no monthly_cost_delta_usd column")
+	}
+
+	if records[1][costColumn] != "12.50" {
+		t.Errorf("monthly_cost_delta_usd cell = %q, want %q", records[1][costColumn], "12.50")
+	}
 }
 
 // TestEncodeEventsUnknownFormat surfaces a bad format as the sentinel.
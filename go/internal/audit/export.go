@@ -31,7 +31,7 @@ const (
 const exportColumns = `event_id, ts_unix_ns, tool, tool_capability, environment, profile,
 	mode, plan_id, status, latency_ms, result_summary, error,
 	linodemcp_version, session_id, credential_generation,
-	args_json, args_redacted_json`
+	args_json, args_redacted_json, monthly_cost_delta_usd`
 
 // ExportEvents loads up to query.Limit matching events for export,
 // newest first. It reads the SQLite store when sqlitePath is non-empty
@@ -99,13 +99,15 @@ func exportFromSQLite(ctx context.Context, path string, query *RecentQuery) ([]E
 // scanExportRow reconstructs one full Event from an export row,
 // rebuilding TS from ts_unix_ns and decoding the args/args_redacted
 // JSON columns. Nullable plan_id, result_summary, and error are
-// handled via sql.NullString.
+// handled via sql.NullString, and monthly_cost_delta_usd via
+// sql.NullFloat64.
 func scanExportRow(rows *sql.Rows) (Event, error) {
 	var (
 		event                            Event
 		tsUnixNS                         int64
 		planID, resultSummary, errorText sql.NullString
 		argsJSON, redactedJSON           string
+		costDelta                        sql.NullFloat64
 	)
 
 	if err := rows.Scan(
@@ -113,7 +115,7 @@ func scanExportRow(rows *sql.Rows) (Event, error) {
 		&event.Environment, &event.Profile, &event.Mode, &planID,
 		&event.Status, &event.LatencyMS, &resultSummary, &errorText,
 		&event.LinodemcpVersion, &event.SessionID, &event.CredentialGeneration,
-		&argsJSON, &redactedJSON,
+		&argsJSON, &redactedJSON, &costDelta,
 	); err != nil {
 		return Event{}, fmt.Errorf("audit: sqlite export scan: %w", err)
 	}
@@ -130,6 +132,10 @@ func scanExportRow(rows *sql.Rows) (Event, error) {
 		event.Error = &errorText.String
 	}
 
+	if costDelta.Valid {
+		event.MonthlyCostDeltaUSD = &costDelta.Float64
+	}
+
 	if err := json.Unmarshal([]byte(argsJSON), &event.Args); err != nil {
 		return Event{}, fmt.Errorf("audit: sqlite export decode args %s: %w", event.EventID, err)
 	}
@@ -186,7 +192,7 @@ func exportCSVHeader() []string {
 	return []string{
 		"ts", "event_id", columnTool, "tool_capability", columnStatus, "environment",
 		"profile", "mode", "latency_ms", "result_summary", "error", "plan_id",
-		"session_id", "credential_generation", "args_redacted", "args",
+		"session_id", "credential_generation", "monthly_cost_delta_usd", "args_redacted", "args",
 	}
 }
 
@@ -220,8 +226,8 @@ func encodeCSV(out io.Writer, events []Event) error {
 }
 
 // exportCSVRow flattens an event into CSV cells in exportCSVHeader
-// order. Nullable plan_id/error render as empty cells; args and
-// args_redacted are compact JSON.
+// order. Nullable plan_id/error/monthly_cost_delta_usd render as empty
+// cells; args and args_redacted are compact JSON.
 func exportCSVRow(event *Event) ([]string, error) {
 	argsCell, err := json.Marshal(event.Args)
 	if err != nil {
@@ -248,6 +254,7 @@ func exportCSVRow(event *Event) ([]string, error) {
 		derefString(event.PlanID),
 		event.SessionID,
 		strconv.FormatUint(event.CredentialGeneration, 10),
+		formatCostDelta(event.MonthlyCostDeltaUSD),
 		string(redactedCell),
 		string(argsCell),
 	}, nil
@@ -262,3 +269,13 @@ func derefString(value *string) string {
 
 	return *value
 }
+
+// formatCostDelta renders a cost delta to the cent, or an empty cell
+// for an event the pricing table did not cover.
+func formatCostDelta(value *float64) string {
+	if value == nil {
+		return ""
+	}
+
+	return strconv.FormatFloat(*value, 'f', 2, 64)
+}
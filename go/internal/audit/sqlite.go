@@ -42,7 +42,8 @@ CREATE TABLE IF NOT EXISTS events (
     session_id TEXT NOT NULL,
     credential_generation INTEGER NOT NULL,
     args_json TEXT NOT NULL,
-    args_redacted_json TEXT NOT NULL
+    args_redacted_json TEXT NOT NULL,
+    monthly_cost_delta_usd REAL
 );
 CREATE INDEX IF NOT EXISTS idx_events_ts ON events(ts_unix_ns DESC);
 CREATE INDEX IF NOT EXISTS idx_events_tool ON events(tool, ts_unix_ns DESC);
@@ -58,8 +59,8 @@ INSERT OR IGNORE INTO events (
     event_id, ts_unix_ns, tool, tool_capability, environment, profile,
     mode, plan_id, status, latency_ms, result_summary, error,
     linodemcp_version, session_id, credential_generation,
-    args_json, args_redacted_json
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    args_json, args_redacted_json, monthly_cost_delta_usd
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 // SQLiteSink writes audit events to a SQLite database. Opt-in via the
@@ -99,6 +100,12 @@ func NewSQLiteSink(ctx context.Context, path string, busyTimeoutMS int) (*SQLite
 		return nil, fmt.Errorf("audit: create sqlite schema: %w", err)
 	}
 
+	if err := migrateSchema(ctx, db); err != nil {
+		_ = db.Close()
+
+		return nil, err
+	}
+
 	return &SQLiteSink{db: db, onWriteErr: defaultSQLiteWriteErrorHandler}, nil
 }
 
@@ -128,7 +135,7 @@ func (s *SQLiteSink) Write(ctx context.Context, event *Event) {
 		event.Environment, event.Profile, string(event.Mode), nullableString(event.PlanID),
 		string(event.Status), event.LatencyMS, event.ResultSummary, nullableString(event.Error),
 		event.LinodemcpVersion, event.SessionID, event.CredentialGeneration,
-		string(argsJSON), string(redactedJSON),
+		string(argsJSON), string(redactedJSON), nullableFloat(event.MonthlyCostDeltaUSD),
 	); err != nil {
 		s.onWriteErr(fmt.Errorf("audit: sqlite insert: %w", err))
 	}
@@ -209,6 +216,44 @@ func (s *SQLiteSink) runRetentionOnce(ctx context.Context, retentionDays int, lo
 	}
 }
 
+// migrateSchema adds the columns later releases appended to the events
+// table to a database created before them. CREATE TABLE IF NOT EXISTS
+// leaves an existing table alone, so without this an older audit.db
+// would reject every insert that names the new column.
+func migrateSchema(ctx context.Context, db *sql.DB) error {
+	rows, err := db.QueryContext(ctx, `SELECT name FROM pragma_table_info('events')`)
+	if err != nil {
+		return fmt.Errorf("audit: read sqlite columns: %w", err)
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	columns := map[string]bool{}
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return fmt.Errorf("audit: scan sqlite column: %w", err)
+		}
+
+		columns[name] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("audit: read sqlite columns: %w", err)
+	}
+
+	if columns["monthly_cost_delta_usd"] {
+		return nil
+	}
+
+	if _, err := db.ExecContext(ctx, `ALTER TABLE events ADD COLUMN monthly_cost_delta_usd REAL`); err != nil {
+		return fmt.Errorf("audit: add sqlite column monthly_cost_delta_usd: %w", err)
+	}
+
+	return nil
+}
+
 // nullableString maps a nil *string to a SQL NULL and a non-nil
 // pointer to its value. database/sql does not accept a raw *string,
 // so the conversion to an any (nil or string) happens here.
@@ -220,6 +265,15 @@ func nullableString(value *string) any {
 	return *value
 }
 
+// nullableFloat maps a nil *float64 to a SQL NULL, like nullableString.
+func nullableFloat(value *float64) any {
+	if value == nil {
+		return nil
+	}
+
+	return *value
+}
+
 // emptyMapIfNil normalizes a nil args map to an empty map so the JSON
 // column stores "{}" rather than "null", matching the JSONL sink's
 // MarshalJSON behavior.
@@ -176,3 +176,53 @@ func TestSQLiteSinkStoresNullsForAbsentOptionals(t *testing.T) {
 		t.Error("errCol.Valid = true, want false")
 	}
 }
+
+// TestSQLiteSinkAddsCostColumnToOlderDatabase opens a database created
+// before monthly_cost_delta_usd existed and checks the sink adds the
+// column, so inserts keep working and carry the cost delta.
+func TestSQLiteSinkAddsCostColumnToOlderDatabase(t *testing.T) {
+	t.Parallel()
+
+	dbPath := filepath.Join(t.TempDir(), "audit.db")
+
+	older, err := sql.Open("sqlite", "file:"+dbPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := older.ExecContext(t.Context(), `CREATE TABLE events (
+		event_id TEXT PRIMARY KEY, ts_unix_ns INTEGER NOT NULL, tool TEXT NOT NULL,
+		tool_capability TEXT NOT NULL, environment TEXT NOT NULL, profile TEXT NOT NULL,
+		mode TEXT NOT NULL, plan_id TEXT, status TEXT NOT NULL, latency_ms INTEGER NOT NULL,
+		result_summary TEXT, error TEXT, linodemcp_version TEXT NOT NULL,
+		session_id TEXT NOT NULL, credential_generation INTEGER NOT NULL,
+		args_json TEXT NOT NULL, args_redacted_json TEXT NOT NULL)`); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_ = older.Close()
+
+	sink, err := audit.NewSQLiteSink(t.Context(), dbPath, 5000)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Cleanup(func() { _ = sink.Close() })
+
+	evt := makeTestEvent("linode_volume_create", audit.CapabilityWrite, audit.StatusSuccess, day(20, 11))
+	evt.SetMonthlyCostDelta(2)
+
+	sink.Write(t.Context(), &evt)
+
+	var costDelta sql.NullFloat64
+
+	row := sink.DB().QueryRowContext(t.Context(),
+		`SELECT monthly_cost_delta_usd FROM events WHERE event_id = ?`, evt.EventID)
+	if err := row.Scan(&costDelta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !costDelta.Valid || costDelta.Float64 != 2 {
+		t.Errorf("monthly_cost_delta_usd = %v, want 2", costDelta)
+	}
+}
@@ -0,0 +1,88 @@
+// Package availwatch remembers the sold-out status linode_availability_watch
+// last saw for each plan it watches in a region, so the next call can report
+// what changed. Observations live in process memory only: a restart forgets
+// them and the next call starts the watch over.
+package availwatch
+
+import (
+	"sync"
+	"time"
+)
+
+// Key identifies one watched plan in one region of one environment. Under
+// server.tokenPassthrough, Environment also carries a hash of the caller's
+// token, so each tenant watches on its own.
+type Key struct {
+	Environment string
+	Region      string
+	Plan        string
+}
+
+// Observation is the last status seen for a watched plan.
+type Observation struct {
+	Status string
+	// Since is when Status was first seen; it moves only when the status
+	// changes.
+	Since time.Time
+	// CheckedAt is when the status was last read.
+	CheckedAt time.Time
+}
+
+// Store holds the observations in process memory.
+type Store struct {
+	now  func() time.Time
+	seen map[Key]Observation
+	mu   sync.Mutex
+}
+
+// Option configures a Store at construction time.
+type Option func(*Store)
+
+// WithClock overrides the wall clock used to stamp Since and CheckedAt.
+func WithClock(now func() time.Time) Option {
+	return func(s *Store) {
+		s.now = now
+	}
+}
+
+// NewStore returns an empty watch store.
+func NewStore(opts ...Option) *Store {
+	store := &Store{
+		now:  time.Now,
+		seen: make(map[Key]Observation),
+	}
+
+	for _, opt := range opts {
+		opt(store)
+	}
+
+	return store
+}
+
+// Record stores status as the latest observation for key. It returns the
+// observation as stored and the one it replaced; ok is false when key had
+// not been seen before.
+func (s *Store) Record(key Key, status string) (Observation, Observation, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now().UTC()
+	previous, ok := s.seen[key]
+
+	current := Observation{Status: status, Since: now, CheckedAt: now}
+	if ok && previous.Status == status {
+		current.Since = previous.Since
+	}
+
+	s.seen[key] = current
+
+	return current, previous, ok
+}
+
+// Forget drops the observation for key, so the next Record starts over.
+func (s *Store) Forget(key Key) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.seen, key)
+}
@@ -0,0 +1,45 @@
+package availwatch_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chadit/LinodeMCP/go/internal/availwatch"
+)
+
+func TestStoreRecordTracksStatusChanges(t *testing.T) {
+	t.Parallel()
+
+	clock := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	store := availwatch.NewStore(availwatch.WithClock(func() time.Time { return clock }))
+	key := availwatch.Key{Environment: "default", Region: "us-ord", Plan: "g1-gpu-rtx6000-1"}
+	start := clock
+
+	if _, _, ok := store.Record(key, "sold_out"); ok {
+		t.Fatal("first Record reported a previous observation")
+	}
+
+	clock = clock.Add(time.Hour)
+
+	current, previous, ok := store.Record(key, "sold_out")
+	if !ok || previous.Status != "sold_out" {
+		t.Fatalf("previous = %+v (ok %v), want sold_out", previous, ok)
+	}
+
+	if !current.Since.Equal(start) || !current.CheckedAt.Equal(clock) {
+		t.Errorf("unchanged status = %+v, want since %s checked %s", current, start, clock)
+	}
+
+	clock = clock.Add(time.Hour)
+
+	current, _, _ = store.Record(key, "available")
+	if !current.Since.Equal(clock) {
+		t.Errorf("changed status since = %s, want %s", current.Since, clock)
+	}
+
+	store.Forget(key)
+
+	if _, _, ok := store.Record(key, "available"); ok {
+		t.Error("Record after Forget reported a previous observation")
+	}
+}
@@ -0,0 +1,192 @@
+// Package backendprobe runs a NodeBalancer's health check against one backend
+// directly from this host. linode_nodebalancer_backend_probe compares the
+// outcome with the status the NodeBalancer reports for the node, which tells
+// an application failing its check apart from a NodeBalancer that cannot
+// reach a healthy backend.
+package backendprobe
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"regexp"
+	"time"
+)
+
+// NodeBalancer config check types. CheckNone and CheckConnection are probed
+// with a TCP connect; CheckHTTP and CheckHTTPBody with an HTTP GET.
+const (
+	CheckNone       = "none"
+	CheckConnection = "connection"
+	CheckHTTP       = "http"
+	CheckHTTPBody   = "http_body"
+)
+
+// Probe methods reported in Result.Method.
+const (
+	MethodTCP  = "tcp"
+	MethodHTTP = "http"
+)
+
+// DefaultTimeout bounds a probe whose config sets no check timeout, and
+// MaxTimeout caps a configured one so a backend list cannot hold the tool
+// call for minutes.
+const (
+	DefaultTimeout = 5 * time.Second
+	MaxTimeout     = 10 * time.Second
+)
+
+// maxBodyBytes caps the response body read for a http_body match.
+const maxBodyBytes = 1 << 20
+
+// Target is one backend and the check its NodeBalancer config runs.
+type Target struct {
+	// Address is the backend's "ip:port".
+	Address netip.AddrPort
+	// Check is the config's check type; empty is treated as CheckNone.
+	Check string
+	// Path is the HTTP check path; empty means "/".
+	Path string
+	// Body is the regular expression a http_body check matches the response
+	// body against.
+	Body string
+	// Timeout bounds the probe; zero means DefaultTimeout.
+	Timeout time.Duration
+}
+
+// Result is the outcome of one probe.
+type Result struct {
+	// Method is MethodTCP or MethodHTTP.
+	Method string
+	// URL is the requested URL for an HTTP probe.
+	URL string
+	// OK is true when the backend passed the check.
+	OK bool
+	// StatusCode is the HTTP status; 0 for a TCP probe or no response.
+	StatusCode int
+	Latency    time.Duration
+	// Err says why the check failed; empty when OK.
+	Err string
+}
+
+// Probe runs target's check once. A failure is recorded in the Result, never
+// returned. The HTTP client does not follow redirects or use a proxy, so
+// nothing is contacted beyond the backend address.
+func Probe(ctx context.Context, target Target) Result {
+	timeout := target.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	timeout = min(timeout, MaxTimeout)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	switch target.Check {
+	case CheckHTTP, CheckHTTPBody:
+		return probeHTTP(ctx, target)
+	default:
+		return probeTCP(ctx, target)
+	}
+}
+
+func probeTCP(ctx context.Context, target Target) Result {
+	result := Result{Method: MethodTCP}
+
+	var dialer net.Dialer
+
+	start := time.Now()
+	conn, err := dialer.DialContext(ctx, "tcp", target.Address.String())
+	result.Latency = time.Since(start)
+
+	if err != nil {
+		result.Err = err.Error()
+
+		return result
+	}
+
+	_ = conn.Close()
+	result.OK = true
+
+	return result
+}
+
+func probeHTTP(ctx context.Context, target Target) Result {
+	path := target.Path
+	if path == "" {
+		path = "/"
+	}
+
+	result := Result{Method: MethodHTTP, URL: "http://" + target.Address.String() + path}
+
+	var bodyPattern *regexp.Regexp
+
+	if target.Check == CheckHTTPBody {
+		pattern, err := regexp.Compile(target.Body)
+		if err != nil {
+			result.Err = fmt.Sprintf("check_body is not a valid regular expression: %v", err)
+
+			return result
+		}
+
+		bodyPattern = pattern
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, result.URL, http.NoBody)
+	if err != nil {
+		result.Err = err.Error()
+
+		return result
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{DisableKeepAlives: true},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	result.Latency = time.Since(start)
+
+	if err != nil {
+		result.Err = err.Error()
+
+		return result
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	result.StatusCode = resp.StatusCode
+
+	// The NodeBalancer counts any 2xx or 3xx answer as healthy.
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		result.Err = fmt.Sprintf("HTTP %d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+
+		return result
+	}
+
+	if bodyPattern != nil {
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes))
+		if err != nil {
+			result.Err = fmt.Sprintf("read response: %v", err)
+
+			return result
+		}
+
+		if !bodyPattern.Match(body) {
+			result.Err = fmt.Sprintf("response body does not match check_body %q", target.Body)
+
+			return result
+		}
+	}
+
+	result.OK = true
+
+	return result
+}
@@ -0,0 +1,91 @@
+package backendprobe_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/backendprobe"
+)
+
+// backend serves "status: healthy" at /healthz, a redirect at /moved, and a
+// 503 everywhere else, and returns its address.
+func backend(t *testing.T) netip.AddrPort {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz":
+			_, _ = w.Write([]byte("status: healthy"))
+		case "/moved":
+			http.Redirect(w, r, "http://198.51.100.1/elsewhere", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return netip.MustParseAddrPort(strings.TrimPrefix(srv.URL, "http://"))
+}
+
+func TestProbeHTTPChecks(t *testing.T) {
+	t.Parallel()
+
+	address := backend(t)
+
+	tests := []struct {
+		name    string
+		target  backendprobe.Target
+		wantOK  bool
+		wantErr string
+	}{
+		{"http passes", backendprobe.Target{Check: backendprobe.CheckHTTP, Path: "/healthz"}, true, ""},
+		{"http fails on 503", backendprobe.Target{Check: backendprobe.CheckHTTP, Path: "/down"}, false, "HTTP 503"},
+		{"redirect counts as healthy and is not followed", backendprobe.Target{Check: backendprobe.CheckHTTP, Path: "/moved"}, true, ""},
+		{"body matches", backendprobe.Target{Check: backendprobe.CheckHTTPBody, Path: "/healthz", Body: "health[y]$"}, true, ""},
+		{"body mismatch", backendprobe.Target{Check: backendprobe.CheckHTTPBody, Path: "/healthz", Body: "^ok$"}, false, "does not match check_body"},
+		{"bad body pattern", backendprobe.Target{Check: backendprobe.CheckHTTPBody, Path: "/healthz", Body: "("}, false, "not a valid regular expression"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.target.Address = address
+
+			got := backendprobe.Probe(t.Context(), tt.target)
+			if got.OK != tt.wantOK || !strings.Contains(got.Err, tt.wantErr) {
+				t.Errorf("Probe() = {OK: %v, Err: %q}, want {OK: %v, Err containing %q}", got.OK, got.Err, tt.wantOK, tt.wantErr)
+			}
+
+			if got.Method != backendprobe.MethodHTTP {
+				t.Errorf("Method = %q, want %q", got.Method, backendprobe.MethodHTTP)
+			}
+		})
+	}
+}
+
+func TestProbeTCPCheck(t *testing.T) {
+	t.Parallel()
+
+	got := backendprobe.Probe(t.Context(), backendprobe.Target{Address: backend(t), Check: backendprobe.CheckConnection})
+	if !got.OK || got.Method != backendprobe.MethodTCP {
+		t.Errorf("Probe() = %+v, want a passing tcp probe", got)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	closed := netip.MustParseAddrPort(listener.Addr().String())
+	_ = listener.Close()
+
+	got = backendprobe.Probe(t.Context(), backendprobe.Target{Address: closed, Check: backendprobe.CheckNone})
+	if got.OK || got.Err == "" {
+		t.Errorf("Probe() = %+v, want a failed tcp probe", got)
+	}
+}
@@ -33,4 +33,11 @@ var (
 	// the underlying RunProfileUse config write returns a non-zero code
 	// (unknown profile, or the config file could not be written).
 	errProfileSwitchFailed = errors.New("profile switch failed")
+
+	// errServiceUnsupported is reported by install-service on a platform
+	// with no supported service manager (anything but Linux and Windows).
+	errServiceUnsupported = errors.New("install-service is not supported on this platform")
+	// errServiceExists means a Windows service with the requested name is
+	// already registered; the operator removes it (sc.exe delete) first.
+	errServiceExists = errors.New("service already exists")
 )
@@ -0,0 +1,273 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+const installServiceUsage = `Usage: linodemcp install-service [flags]
+
+Register linodemcp as a managed background service: a systemd unit on Linux,
+a Windows service on Windows. The service runs "linodemcp serve" with the
+config path pinned, so it does not depend on the service account's home.
+
+  --name name        Service name (default "linodemcp").
+  --config path      Config file the service loads (default: the resolved
+                     config path for the installing user).
+  --working-dir dir  Working directory (default: the config file's directory).
+  --user             Linux: install a systemd user unit instead of a system one.
+  --print            Print the unit (or Windows commands) instead of installing.
+  --force            Overwrite an existing unit file.`
+
+// defaultServiceName is the unit / Windows service name when --name is unset.
+const defaultServiceName = "linodemcp"
+
+// serviceFilePerm is the mode for a written unit file: world-readable like
+// every other unit, writable by the installer only.
+const serviceFilePerm = 0o644
+
+// serviceDirPerm is the mode for a created systemd user unit directory.
+const serviceDirPerm = 0o755
+
+// systemUnitDir is where system-wide units are installed.
+const systemUnitDir = "/etc/systemd/system"
+
+// serviceOptions is everything a service definition needs, resolved from the
+// flags and the environment before anything is rendered or installed.
+type serviceOptions struct {
+	Name       string
+	Executable string
+	ConfigPath string
+	WorkingDir string
+	User       bool
+}
+
+// RunInstallServiceCommand handles `linodemcp install-service` and returns
+// the exit code. It resolves the binary and config paths, then installs (or
+// with --print, prints) the platform's service definition. Output streams
+// are parameters for tests.
+func RunInstallServiceCommand(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("install-service", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() { writeln(stderr, installServiceUsage) }
+
+	var (
+		opts           serviceOptions
+		printOnly      bool
+		overwrite      bool
+		configFlag     string
+		workingDirFlag string
+	)
+
+	flags.StringVar(&opts.Name, "name", defaultServiceName, "service name")
+	flags.StringVar(&configFlag, "config", "", "config file the service loads")
+	flags.StringVar(&workingDirFlag, "working-dir", "", "service working directory")
+	flags.BoolVar(&opts.User, "user", false, "install a systemd user unit")
+	flags.BoolVar(&printOnly, "print", false, "print instead of installing")
+	flags.BoolVar(&overwrite, "force", false, "overwrite an existing unit file")
+
+	if err := flags.Parse(args); err != nil {
+		return ExitUsageError
+	}
+
+	if flags.NArg() > 0 || opts.Name == "" {
+		writeln(stderr, installServiceUsage)
+
+		return ExitUsageError
+	}
+
+	if err := resolveServiceOptions(&opts, configFlag, workingDirFlag); err != nil {
+		writef(stderr, "%v\n", err)
+
+		return 1
+	}
+
+	warnStdioTransport(stderr, opts.ConfigPath)
+
+	switch runtime.GOOS {
+	case "linux":
+		return installSystemdUnit(&opts, printOnly, overwrite, stdout, stderr)
+	case "windows":
+		return installWindowsService(&opts, printOnly, stdout, stderr)
+	default:
+		writef(stderr, "%v: %s\n", errServiceUnsupported, runtime.GOOS)
+
+		return 1
+	}
+}
+
+// resolveServiceOptions fills the executable, config, and working-directory
+// paths as absolute paths so the service never depends on the installer's
+// shell or the service account's home directory.
+func resolveServiceOptions(opts *serviceOptions, configPath, workingDir string) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("locate linodemcp binary: %w", err)
+	}
+
+	if resolved, evalErr := filepath.EvalSymlinks(exe); evalErr == nil {
+		exe = resolved
+	}
+
+	if configPath == "" {
+		configPath = config.Path()
+	}
+
+	if configPath, err = filepath.Abs(configPath); err != nil {
+		return fmt.Errorf("resolve config path: %w", err)
+	}
+
+	if workingDir == "" {
+		workingDir = filepath.Dir(configPath)
+	}
+
+	if workingDir, err = filepath.Abs(workingDir); err != nil {
+		return fmt.Errorf("resolve working directory: %w", err)
+	}
+
+	opts.Executable = exe
+	opts.ConfigPath = configPath
+	opts.WorkingDir = workingDir
+
+	return nil
+}
+
+// warnStdioTransport flags a config whose transport is stdio. A service has
+// no client on stdin, so a stdio server exits as soon as it starts; the
+// service only stays up with a network transport configured.
+func warnStdioTransport(stderr io.Writer, configPath string) {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		writef(stderr, "warning: could not load %s (%v); the service will fail until it exists\n", configPath, err)
+
+		return
+	}
+
+	if cfg.Server.Transport == config.DefaultTransport {
+		writef(stderr, "warning: server.transport is %q in %s; a service has no stdin client, "+
+			"so configure a network transport before starting it\n", cfg.Server.Transport, configPath)
+	}
+}
+
+// installSystemdUnit writes (or prints) the unit and tells the operator how
+// to enable it. It never runs systemctl itself: enabling a service is left
+// to the operator.
+func installSystemdUnit(opts *serviceOptions, printOnly, overwrite bool, stdout, stderr io.Writer) int {
+	unit := systemdUnit(opts)
+	if printOnly {
+		writef(stdout, "%s", unit)
+
+		return 0
+	}
+
+	dir := systemUnitDir
+
+	if opts.User {
+		userConfig, err := os.UserConfigDir()
+		if err != nil {
+			writef(stderr, "locate user config directory: %v\n", err)
+
+			return 1
+		}
+
+		dir = filepath.Join(userConfig, "systemd", "user")
+		if err := os.MkdirAll(dir, serviceDirPerm); err != nil {
+			writef(stderr, "create %s: %v\n", dir, err)
+
+			return 1
+		}
+	}
+
+	path := filepath.Join(dir, opts.Name+".service")
+
+	if _, err := os.Stat(path); err == nil && !overwrite {
+		writef(stderr, "%s already exists; pass --force to overwrite\n", path)
+
+		return 1
+	} else if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		writef(stderr, "check %s: %v\n", path, err)
+
+		return 1
+	}
+
+	if err := os.WriteFile(path, []byte(unit), serviceFilePerm); err != nil {
+		writef(stderr, "write %s: %v\n", path, err)
+
+		return 1
+	}
+
+	systemctl := "systemctl"
+	if opts.User {
+		systemctl += " --user"
+	}
+
+	writef(stdout, "Wrote %s\n\nEnable and start it with:\n  %s daemon-reload\n  %s enable --now %s\n"+
+		"Follow its logs with:\n  journalctl%s -u %s -f\n",
+		path, systemctl, systemctl, opts.Name, strings.TrimPrefix(systemctl, "systemctl"), opts.Name)
+
+	return 0
+}
+
+// systemdUnit renders the unit file. systemd delivers SIGTERM on stop, which
+// the serve path already treats as a graceful shutdown, and journald captures
+// stderr, where the server logs, under the service name.
+func systemdUnit(opts *serviceOptions) string {
+	wantedBy := "multi-user.target"
+	if opts.User {
+		wantedBy = "default.target"
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, `[Unit]
+Description=LinodeMCP server
+Documentation=https://github.com/chadit/LinodeMCP
+After=network-online.target
+Wants=network-online.target
+
+[Service]
+Type=simple
+ExecStart=%s serve
+WorkingDirectory=%s
+Environment=%s
+Restart=on-failure
+RestartSec=5
+KillSignal=SIGTERM
+TimeoutStopSec=30
+StandardOutput=journal
+StandardError=journal
+SyslogIdentifier=%s
+
+[Install]
+WantedBy=%s
+`,
+		systemdQuote(opts.Executable),
+		systemdQuote(opts.WorkingDir),
+		systemdQuote("LINODEMCP_CONFIG_PATH="+opts.ConfigPath),
+		opts.Name,
+		wantedBy,
+	)
+
+	return b.String()
+}
+
+// systemdQuote double-quotes a unit-file value that contains whitespace or a
+// quote, escaping backslashes and quotes the way systemd unquotes them.
+func systemdQuote(value string) string {
+	if !strings.ContainsAny(value, " \t\"'\\") {
+		return value
+	}
+
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+
+	return `"` + escaped + `"`
+}
@@ -0,0 +1,83 @@
+package cli_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/cli"
+)
+
+// TestInstallServicePrintRendersUnit checks `install-service --print` on
+// Linux renders a systemd unit that runs `serve`, pins the config path, and
+// sends logs to the journal, quoting paths that contain spaces.
+func TestInstallServicePrintRendersUnit(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("systemd units are rendered on Linux only")
+	}
+
+	configPath := filepath.Join(t.TempDir(), "linode mcp", "config.yml")
+
+	var stdout, stderr bytes.Buffer
+
+	code := cli.RunInstallServiceCommand([]string{"--print", "--name", "linodemcp-test", "--config", configPath},
+		&stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("code = %d, want 0; stderr: %s", code, stderr.String())
+	}
+
+	unit := stdout.String()
+	for _, want := range []string{
+		" serve\n",
+		`WorkingDirectory="` + filepath.Dir(configPath) + `"`,
+		`Environment="LINODEMCP_CONFIG_PATH=` + configPath + `"`,
+		"StandardError=journal",
+		"SyslogIdentifier=linodemcp-test",
+		"KillSignal=SIGTERM",
+		"WantedBy=multi-user.target",
+	} {
+		if !strings.Contains(unit, want) {
+			t.Errorf("unit missing %q:\n%s", want, unit)
+		}
+	}
+
+	if !strings.Contains(stderr.String(), "warning: could not load") {
+		t.Errorf("stderr = %q, want a missing-config warning", stderr.String())
+	}
+}
+
+// TestInstallServiceUserUnitTarget checks --user targets default.target,
+// the only target a systemd user manager reaches.
+func TestInstallServiceUserUnitTarget(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("systemd units are rendered on Linux only")
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	code := cli.RunInstallServiceCommand([]string{"--print", "--user", "--config", writeTestConfigFile(t)},
+		&stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("code = %d, want 0; stderr: %s", code, stderr.String())
+	}
+
+	if !strings.Contains(stdout.String(), "WantedBy=default.target") {
+		t.Errorf("unit = %s, want WantedBy=default.target", stdout.String())
+	}
+
+	if !strings.Contains(stderr.String(), `server.transport is "stdio"`) {
+		t.Errorf("stderr = %q, want a stdio transport warning", stderr.String())
+	}
+}
+
+func TestInstallServiceRejectsPositionalArgs(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+
+	if code := cli.RunInstallServiceCommand([]string{"extra"}, &stdout, &stderr); code != cli.ExitUsageError {
+		t.Errorf("code = %d, want %d", code, cli.ExitUsageError)
+	}
+}
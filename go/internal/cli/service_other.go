@@ -0,0 +1,17 @@
+//go:build !windows
+
+package cli
+
+import (
+	"io"
+	"runtime"
+)
+
+// installWindowsService is unreachable off Windows; RunInstallServiceCommand
+// only calls it when runtime.GOOS is "windows". It exists so the dispatch
+// compiles everywhere without the Windows service packages.
+func installWindowsService(_ *serviceOptions, _ bool, _, stderr io.Writer) int {
+	writef(stderr, "%v: %s\n", errServiceUnsupported, runtime.GOOS)
+
+	return 1
+}
@@ -0,0 +1,126 @@
+//go:build windows
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/windows/registry"
+	"golang.org/x/sys/windows/svc/eventlog"
+	"golang.org/x/sys/windows/svc/mgr"
+)
+
+// serviceRestartDelay mirrors the systemd unit's RestartSec: the service
+// control manager restarts a crashed server after this long.
+const serviceRestartDelay = 5 * time.Second
+
+// serviceFailureResetSeconds is how long the service must run cleanly before
+// the SCM forgets earlier failures (one day).
+const serviceFailureResetSeconds = 24 * 60 * 60
+
+// serviceArgs returns the arguments the SCM passes when it starts the
+// service. cmd/linodemcp reads --service-name and --working-dir only when it
+// is running under the SCM, which has no per-service working directory and
+// does not tell a process its own service name.
+func serviceArgs(opts *serviceOptions) []string {
+	return []string{"serve", "--service-name", opts.Name, "--working-dir", opts.WorkingDir}
+}
+
+// installWindowsService registers the service with the SCM (automatic start,
+// restart on failure), pins LINODEMCP_CONFIG_PATH in the service's
+// environment, and registers an event log source under the service name so
+// server logs land in the Application log. With printOnly it prints the
+// equivalent sc.exe commands instead.
+func installWindowsService(opts *serviceOptions, printOnly bool, stdout, stderr io.Writer) int {
+	args := serviceArgs(opts)
+
+	if printOnly {
+		writef(stdout, "sc.exe create %s binPath= \"\\\"%s\\\" %s\" start= auto DisplayName= \"LinodeMCP server\"\n",
+			opts.Name, opts.Executable, strings.Join(quoteWindowsArgs(args), " "))
+		writef(stdout, "sc.exe failure %s reset= %d actions= restart/%d\n",
+			opts.Name, serviceFailureResetSeconds, serviceRestartDelay.Milliseconds())
+		writef(stdout, "reg.exe add HKLM\\SYSTEM\\CurrentControlSet\\Services\\%s /v Environment /t REG_MULTI_SZ /d \"LINODEMCP_CONFIG_PATH=%s\"\n",
+			opts.Name, opts.ConfigPath)
+
+		return 0
+	}
+
+	if err := createWindowsService(opts, args); err != nil {
+		writef(stderr, "%v\n", err)
+
+		return 1
+	}
+
+	writef(stdout, "Installed service %s\n\nStart it with:\n  sc.exe start %s\n"+
+		"Logs go to the Application event log under source %q.\n", opts.Name, opts.Name, opts.Name)
+
+	return 0
+}
+
+// createWindowsService does the SCM, registry, and event log work for
+// installWindowsService.
+func createWindowsService(opts *serviceOptions, args []string) error {
+	manager, err := mgr.Connect()
+	if err != nil {
+		return fmt.Errorf("connect to service manager (run as Administrator): %w", err)
+	}
+	defer func() { _ = manager.Disconnect() }()
+
+	if existing, openErr := manager.OpenService(opts.Name); openErr == nil {
+		_ = existing.Close()
+
+		return fmt.Errorf("%w: %s (remove it with sc.exe delete %s)", errServiceExists, opts.Name, opts.Name)
+	}
+
+	service, err := manager.CreateService(opts.Name, opts.Executable, mgr.Config{
+		DisplayName: "LinodeMCP server",
+		Description: "Model Context Protocol server for the Linode API.",
+		StartType:   mgr.StartAutomatic,
+	}, args...)
+	if err != nil {
+		return fmt.Errorf("create service %s: %w", opts.Name, err)
+	}
+	defer func() { _ = service.Close() }()
+
+	if err := service.SetRecoveryActions([]mgr.RecoveryAction{
+		{Type: mgr.ServiceRestart, Delay: serviceRestartDelay},
+	}, serviceFailureResetSeconds); err != nil {
+		return fmt.Errorf("set recovery actions: %w", err)
+	}
+
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE,
+		`SYSTEM\CurrentControlSet\Services\`+opts.Name, registry.SET_VALUE)
+	if err != nil {
+		return fmt.Errorf("open service registry key: %w", err)
+	}
+	defer func() { _ = key.Close() }()
+
+	if err := key.SetStringsValue("Environment", []string{"LINODEMCP_CONFIG_PATH=" + opts.ConfigPath}); err != nil {
+		return fmt.Errorf("set service environment: %w", err)
+	}
+
+	if err := eventlog.InstallAsEventCreate(opts.Name, eventlog.Error|eventlog.Warning|eventlog.Info); err != nil &&
+		!strings.Contains(err.Error(), "registry key already exists") {
+		return fmt.Errorf("register event log source: %w", err)
+	}
+
+	return nil
+}
+
+// quoteWindowsArgs double-quotes arguments containing spaces for the sc.exe
+// binPath, escaped for the outer binPath quotes.
+func quoteWindowsArgs(args []string) []string {
+	quoted := make([]string, len(args))
+
+	for i, arg := range args {
+		quoted[i] = arg
+		if strings.ContainsAny(arg, " \t") {
+			quoted[i] = `\"` + arg + `\"`
+		}
+	}
+
+	return quoted
+}
@@ -0,0 +1,92 @@
+package config_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+func apiURLConfig(apiURL, apiVersion string) string {
+	block := `
+environments:
+  staging:
+    label: "Staging"
+    linode:
+      apiUrl: "` + apiURL + `"
+      token: "tok"
+`
+	if apiVersion != "" {
+		block += `      apiVersion: "` + apiVersion + "\"\n"
+	}
+
+	return block
+}
+
+// TestAPIURLValidation verifies malformed API URLs and pinned versions fail
+// at load with an error naming the environment.
+func TestAPIURLValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		apiURL     string
+		apiVersion string
+		wantErr    error
+	}{
+		{name: "valid", apiURL: "https://api.linode.com/v4"},
+		{name: "valid trailing slash and pin", apiURL: "https://api.linode.com/v4/", apiVersion: "v4beta"},
+		{name: "bad scheme", apiURL: "ftp://api.linode.com/v4", wantErr: config.ErrInvalidAPIURL},
+		{name: "missing host", apiURL: "https:///v4", wantErr: config.ErrInvalidAPIURL},
+		{name: "missing version path", apiURL: "https://api.linode.com", wantErr: config.ErrInvalidAPIURL},
+		{name: "query string", apiURL: "https://api.linode.com/v4?x=1", wantErr: config.ErrInvalidAPIURL},
+		{name: "bad pin", apiURL: "https://api.linode.com/v4", apiVersion: "latest", wantErr: config.ErrInvalidAPIVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := writeConfigFile(t, t.TempDir(), "config.yml", apiURLConfig(tt.apiURL, tt.apiVersion))
+
+			_, err := config.Load(path)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			if !strings.Contains(err.Error(), "environment 'staging'") {
+				t.Errorf("err = %v, want it to name environment 'staging'", err)
+			}
+		})
+	}
+}
+
+// TestLinodeConfigBaseURL verifies a pinned version replaces the URL's
+// version segment.
+func TestLinodeConfigBaseURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		linode config.LinodeConfig
+		want   string
+	}{
+		{linode: config.LinodeConfig{APIURL: "https://api.linode.com/v4"}, want: "https://api.linode.com/v4"},
+		{linode: config.LinodeConfig{APIURL: "https://api.linode.com/v4/", APIVersion: "v4beta"}, want: "https://api.linode.com/v4beta"},
+		{linode: config.LinodeConfig{APIURL: "https://proxy.example.com/linode/v4beta", APIVersion: "v4"}, want: "https://proxy.example.com/linode/v4"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.linode.BaseURL(); got != tt.want {
+			t.Errorf("BaseURL(%+v) = %q, want %q", tt.linode, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package config_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+func backendConfig(linode string) string {
+	return `
+environments:
+  staging:
+    label: "Staging"
+    linode:
+      apiUrl: "https://api.linode.com/v4"
+      token: "tok"
+` + linode
+}
+
+// TestLinodeBackendLoad verifies an environment's backend and fixtures
+// directory load as configured.
+func TestLinodeBackendLoad(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfigFile(t, t.TempDir(), "config.yml", backendConfig(`      backend: "fixtures"
+      fixturesDir: "/srv/fixtures"
+`))
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	linode := cfg.Environments["staging"].Linode
+	if linode.Backend != config.BackendFixtures || linode.FixturesDir != "/srv/fixtures" {
+		t.Errorf("backend = %q, fixturesDir = %q, want fixtures from /srv/fixtures", linode.Backend, linode.FixturesDir)
+	}
+}
+
+// TestLinodeBackendValidation verifies an unknown backend, or a fixturesDir
+// that does not match the backend, fails at load naming the environment.
+func TestLinodeBackendValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		linode string
+	}{
+		{name: "unknown backend", linode: `      backend: "simulator"` + "\n"},
+		{name: "fixtures without dir", linode: `      backend: "fixtures"` + "\n"},
+		{name: "dir without fixtures", linode: `      fixturesDir: "/srv/fixtures"` + "\n"},
+		{name: "dir with api", linode: "      backend: \"api\"\n      fixturesDir: \"/srv/fixtures\"\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := writeConfigFile(t, t.TempDir(), "config.yml", backendConfig(tt.linode))
+
+			_, err := config.Load(path)
+			if !errors.Is(err, config.ErrInvalidBackend) {
+				t.Fatalf("err = %v, want %v", err, config.ErrInvalidBackend)
+			}
+
+			if !strings.Contains(err.Error(), "environment 'staging'") {
+				t.Errorf("err = %v, want it to name environment 'staging'", err)
+			}
+		})
+	}
+}
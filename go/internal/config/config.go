@@ -4,8 +4,14 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
+	"net/netip"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -17,11 +23,22 @@ import (
 const (
 	DefaultServerName = "LinodeMCP"
 	DefaultLogLevel   = "info"
-	DefaultTransport  = "stdio"
+	DefaultTransport  = TransportStdio
 	DefaultHost       = "127.0.0.1"
 	DefaultServerPort = 8080
 )
 
+// MCP transports for server.transport. TransportStdio talks to one client
+// over stdin and stdout. TransportSSE and TransportHTTP listen on
+// server.host:server.port for remote clients: TransportSSE serves the legacy
+// HTTP+SSE transport (/sse and /message), TransportHTTP the streamable HTTP
+// transport at /mcp.
+const (
+	TransportStdio = "stdio"
+	TransportSSE   = "sse"
+	TransportHTTP  = "http"
+)
+
 // Default observability configuration values.
 const (
 	DefaultMetricsPort     = 8888
@@ -67,6 +84,20 @@ const (
 	// PII in audit (e.g. for accountability investigations) can opt out
 	// by setting audit.redact_pii: false.
 	DefaultAuditRedactPII = true
+
+	// DefaultUpdateCheckURL is the GitHub releases endpoint the version
+	// check queries when update_check.url is unset.
+	DefaultUpdateCheckURL = "https://api.github.com/repos/chadit/LinodeMCP/releases/latest"
+
+	// DefaultStatusPageURL is the Linode status page summary linode_api_status
+	// reads when status_page.url is unset.
+	DefaultStatusPageURL = "https://status.linode.com/api/v2/summary.json"
+
+	// DefaultOAuthReadScope and DefaultOAuthWriteScope are the OAuth scopes
+	// that grant the read (meta and read tools) and write (every tool)
+	// toolsets when oauth.read_scope / oauth.write_scope are unset.
+	DefaultOAuthReadScope  = "linodemcp:read"
+	DefaultOAuthWriteScope = "linodemcp:write"
 )
 
 const (
@@ -84,50 +115,611 @@ const (
 )
 
 // ServerConfig holds core server settings.
+//
+// TokenPassthrough makes one shared HTTP deployment serve many users: each
+// tool call authenticates to Linode with the token the client sends in the
+// X-Linode-Token header, never a configured one, so environments must not
+// carry a token when it is set. Over stdio there are no headers, so every
+// Linode call fails; it is an HTTP-transport setting.
+//
+// AuthToken, when set, is the shared secret remote clients of the sse and
+// http transports must send as "Authorization: Bearer <token>"; a request
+// without it is refused with 401 before it reaches MCP. It is the simple
+// alternative to the oauth block, which reads the same header, so the two
+// cannot both be set.
 type ServerConfig struct {
-	Name      string `json:"name"      yaml:"name"`
-	LogLevel  string `json:"log_level" yaml:"logLevel"`
-	Transport string `json:"transport" yaml:"transport"`
-	Host      string `json:"host"      yaml:"host"`
-	Port      int    `json:"port"      yaml:"port"`
+	Name             string `json:"name"              yaml:"name"`
+	LogLevel         string `json:"log_level"         yaml:"logLevel"`
+	Transport        string `json:"transport"         yaml:"transport"`
+	Host             string `json:"host"              yaml:"host"`
+	Port             int    `json:"port"              yaml:"port"`
+	TokenPassthrough bool   `json:"token_passthrough" yaml:"tokenPassthrough"`
+	AuthToken        string `json:"auth_token"        yaml:"authToken,omitempty"`
 }
 
 // ResilienceConfig holds retry, rate limit, and circuit breaker settings.
 // RateLimitPerMinute caps outbound calls to stay under the Linode 700 req/min
 // account limit. CircuitBreaker* gate the client when an upstream goes hard
-// down so we stop hammering it.
+// down so we stop hammering it. MaxRetryElapsed bounds how long one call
+// keeps retrying, waits included; zero leaves MaxRetries as the only bound.
+//
+// The Pool* fields size the Python client's HTTP connection pool. Go's
+// transport does not read them; they are declared so a config file shared by
+// both binaries passes the unknown-key check here too.
 type ResilienceConfig struct {
-	MaxRetries              int           `json:"max_retries"               yaml:"maxRetries"`
-	BaseRetryDelay          time.Duration `json:"base_retry_delay"          yaml:"baseRetryDelay"`
-	MaxRetryDelay           time.Duration `json:"max_retry_delay"           yaml:"maxRetryDelay"`
-	RateLimitPerMinute      int           `json:"rate_limit_per_minute"     yaml:"rateLimitPerMinute"`
-	CircuitBreakerThreshold int           `json:"circuit_breaker_threshold" yaml:"circuitBreakerThreshold"`
-	CircuitBreakerTimeout   time.Duration `json:"circuit_breaker_timeout"   yaml:"circuitBreakerTimeout"`
+	MaxRetries                  int           `json:"max_retries"                              yaml:"maxRetries"`
+	BaseRetryDelay              time.Duration `json:"base_retry_delay"                         yaml:"baseRetryDelay"`
+	MaxRetryDelay               time.Duration `json:"max_retry_delay"                          yaml:"maxRetryDelay"`
+	MaxRetryElapsed             time.Duration `json:"max_retry_elapsed,omitempty"              yaml:"maxRetryElapsed,omitempty"`
+	RateLimitPerMinute          int           `json:"rate_limit_per_minute"                    yaml:"rateLimitPerMinute"`
+	CircuitBreakerThreshold     int           `json:"circuit_breaker_threshold"                yaml:"circuitBreakerThreshold"`
+	CircuitBreakerTimeout       time.Duration `json:"circuit_breaker_timeout"                  yaml:"circuitBreakerTimeout"`
+	PoolMaxConnections          int           `json:"pool_max_connections,omitempty"           yaml:"poolMaxConnections,omitempty"`
+	PoolMaxKeepaliveConnections int           `json:"pool_max_keepalive_connections,omitempty" yaml:"poolMaxKeepaliveConnections,omitempty"`
+	PoolKeepaliveExpiry         float64       `json:"pool_keepalive_expiry,omitempty"          yaml:"poolKeepaliveExpiry,omitempty"`
 }
 
 // LinodeConfig holds Linode API settings for an environment.
+//
+// APIURL must be an http(s) URL ending in an API version path such as
+// https://api.linode.com/v4. APIVersion, when set, pins the version: it
+// replaces the URL's version segment, so one environment can run against
+// v4beta while the others stay on v4.
+//
+// Headers are extra HTTP headers attached to every API request, for
+// deployments that front the Linode API with a gateway expecting its own
+// auth or tracing headers.
+//
+// Backend picks what answers the environment's API calls. BackendAPI (the
+// default) sends them to APIURL, which also covers API-compatible stand-ins
+// such as a local simulator or an internal proxy. BackendFixtures answers
+// them from recorded JSON files under FixturesDir and never touches the
+// network, for trying tools out without an account.
 type LinodeConfig struct {
-	APIURL string `json:"api_url" yaml:"apiUrl"`
-	Token  string `json:"token"   yaml:"token"`
+	APIURL      string            `json:"api_url"      yaml:"apiUrl"`
+	APIVersion  string            `json:"api_version"  yaml:"apiVersion,omitempty"`
+	Token       string            `json:"token"        yaml:"token"`
+	Headers     map[string]string `json:"headers"      yaml:"headers,omitempty"`
+	Backend     string            `json:"backend"      yaml:"backend,omitempty"`
+	FixturesDir string            `json:"fixtures_dir" yaml:"fixturesDir,omitempty"`
+}
+
+// Environment backends. See LinodeConfig.
+const (
+	BackendAPI      = "api"
+	BackendFixtures = "fixtures"
+)
+
+// apiVersionPattern matches a Linode API version path segment: v4, v4beta.
+var apiVersionPattern = regexp.MustCompile(`^v[0-9]+(beta)?$`)
+
+// headerNamePattern matches an HTTP header name (an RFC 9110 token).
+var headerNamePattern = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$")
+
+// reservedHeaders are set by the client on every request, so configuring
+// them would be silently overwritten.
+var reservedHeaders = []string{"Authorization", "Content-Type", "Host", "User-Agent"}
+
+// BaseURL returns the URL API requests are built on: APIURL with any
+// trailing slash removed and its version segment replaced by APIVersion when
+// one is pinned.
+func (l LinodeConfig) BaseURL() string {
+	return WithAPIVersion(l.APIURL, l.APIVersion)
+}
+
+// WithAPIVersion returns apiURL with its trailing version segment replaced by
+// version. An empty version, or a URL without a version segment, returns
+// apiURL with only its trailing slash trimmed.
+func WithAPIVersion(apiURL, version string) string {
+	apiURL = strings.TrimRight(apiURL, "/")
+	if version == "" {
+		return apiURL
+	}
+
+	cut := strings.LastIndex(apiURL, "/")
+	if cut < 0 || !apiVersionPattern.MatchString(apiURL[cut+1:]) {
+		return apiURL
+	}
+
+	return apiURL[:cut+1] + version
+}
+
+// ValidateAPIVersion checks a pinned API version such as v4 or v4beta.
+// Shared by config validation and the per-call API version override header.
+func ValidateAPIVersion(version string) error {
+	if !apiVersionPattern.MatchString(version) {
+		return fmt.Errorf("%w: got %q", ErrInvalidAPIVersion, version)
+	}
+
+	return nil
 }
 
-// EnvironmentConfig holds settings for a named environment.
+// validateAPIURL checks an environment's API URL is an absolute http(s) URL
+// whose path ends in an API version segment, and that a pinned version is
+// well formed. Errors name the environment so a bad entry fails at load
+// rather than on the first tool call.
+func validateAPIURL(envName string, linode LinodeConfig) error {
+	parsed, err := url.Parse(linode.APIURL)
+	if err != nil {
+		return fmt.Errorf("%w: environment '%s': %w", ErrInvalidAPIURL, envName, err)
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("%w: environment '%s': scheme must be http or https, got %q",
+			ErrInvalidAPIURL, envName, parsed.Scheme)
+	}
+
+	if parsed.Host == "" {
+		return fmt.Errorf("%w: environment '%s': missing host", ErrInvalidAPIURL, envName)
+	}
+
+	if parsed.RawQuery != "" || parsed.Fragment != "" {
+		return fmt.Errorf("%w: environment '%s': must not carry a query or fragment", ErrInvalidAPIURL, envName)
+	}
+
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if !apiVersionPattern.MatchString(segments[len(segments)-1]) {
+		return fmt.Errorf("%w: environment '%s': path %q must end in an API version such as /v4",
+			ErrInvalidAPIURL, envName, parsed.Path)
+	}
+
+	if linode.APIVersion != "" {
+		if err := ValidateAPIVersion(linode.APIVersion); err != nil {
+			return fmt.Errorf("environment '%s': %w", envName, err)
+		}
+	}
+
+	return nil
+}
+
+// validateHeaders checks an environment's extra API headers: each name must
+// be a valid header name the client does not set itself, and no value may
+// carry CR, LF, or NUL, which would split or corrupt the request.
+func validateHeaders(envName string, headers map[string]string) error {
+	for _, name := range slices.Sorted(maps.Keys(headers)) {
+		value := headers[name]
+		if !headerNamePattern.MatchString(name) {
+			return fmt.Errorf("%w: environment '%s': %q is not a valid header name", ErrInvalidHeader, envName, name)
+		}
+
+		for _, reserved := range reservedHeaders {
+			if strings.EqualFold(name, reserved) {
+				return fmt.Errorf("%w: environment '%s': %s is set by the client and cannot be overridden",
+					ErrInvalidHeader, envName, reserved)
+			}
+		}
+
+		if strings.ContainsAny(value, "\r\n\x00") {
+			return fmt.Errorf("%w: environment '%s': value of %s must not contain CR, LF, or NUL", ErrInvalidHeader, envName, name)
+		}
+	}
+
+	return nil
+}
+
+// validateBackend checks an environment's backend is one this build knows,
+// and that fixturesDir is set exactly when the fixtures backend reads it.
+func validateBackend(envName string, linode LinodeConfig) error {
+	switch linode.Backend {
+	case "", BackendAPI:
+		if linode.FixturesDir != "" {
+			return fmt.Errorf("%w: environment '%s': fixturesDir is only read by the %q backend",
+				ErrInvalidBackend, envName, BackendFixtures)
+		}
+	case BackendFixtures:
+		if linode.FixturesDir == "" {
+			return fmt.Errorf("%w: environment '%s': the %q backend needs fixturesDir",
+				ErrInvalidBackend, envName, BackendFixtures)
+		}
+	default:
+		return fmt.Errorf("%w: environment '%s': got %q, want %q or %q",
+			ErrInvalidBackend, envName, linode.Backend, BackendAPI, BackendFixtures)
+	}
+
+	return nil
+}
+
+// EnvironmentConfig holds settings for a named environment. LabelNamespace,
+// when set, scopes the environment to one team or agent on a shared account:
+// top-level resource creates prefix their label with "<namespace>-" and list
+// tools called with namespace_only=true return only resources carrying that
+// prefix.
 type EnvironmentConfig struct {
-	Label  string       `json:"label"  yaml:"label"`
-	Linode LinodeConfig `json:"linode" yaml:"linode"`
+	Label          string       `json:"label"           yaml:"label"`
+	Linode         LinodeConfig `json:"linode"          yaml:"linode"`
+	LabelNamespace string       `json:"label_namespace" yaml:"label_namespace,omitempty"`
+}
+
+// MaxLabelNamespaceLength keeps room in Linode's 32 to 64 character label
+// limits for the part of the label the caller chooses.
+const MaxLabelNamespaceLength = 16
+
+// labelNamespacePattern matches a label_namespace: letters, digits, and
+// '-', '_', '.', starting and ending with a letter or digit, so the prefixed
+// label is still a valid Linode label.
+var labelNamespacePattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9_.-]*[A-Za-z0-9])?$`)
+
+// LabelNamespacePrefix returns the prefix create tools put on labels in this
+// environment, or "" when it has no label_namespace.
+func (e EnvironmentConfig) LabelNamespacePrefix() string {
+	if e.LabelNamespace == "" {
+		return ""
+	}
+
+	return e.LabelNamespace + "-"
 }
 
-// Config holds the full LinodeMCP configuration.
+// validateLabelNamespace checks an environment's label_namespace can prefix
+// a Linode label.
+func validateLabelNamespace(envName, namespace string) error {
+	if namespace == "" {
+		return nil
+	}
+
+	if len(namespace) > MaxLabelNamespaceLength || !labelNamespacePattern.MatchString(namespace) {
+		return fmt.Errorf("%w: environment '%s': got %q: use at most %d letters, digits, '-', '_', or '.', "+
+			"starting and ending with a letter or digit", ErrInvalidLabelNamespace, envName, namespace, MaxLabelNamespaceLength)
+	}
+
+	return nil
+}
+
+// Config holds the full LinodeMCP configuration. ReadOnly (read_only, or
+// LINODEMCP_READ_ONLY) keeps every tool that can change Linode state off the
+// server, whatever the active profile allows, and Tools narrows the surface
+// further by name.
 type Config struct {
 	Server                   ServerConfig                 `json:"server"                     yaml:"server"`
 	Resilience               ResilienceConfig             `json:"resilience"                 yaml:"resilience"`
 	Observability            ObservabilityConfig          `json:"observability"              yaml:"observability"`
 	Environments             map[string]EnvironmentConfig `json:"environments"               yaml:"environments"`
 	ActiveProfile            string                       `json:"active_profile"             yaml:"active_profile"`
+	ReadOnly                 bool                         `json:"read_only"                  yaml:"read_only"`
+	Tools                    ToolsConfig                  `json:"tools"                      yaml:"tools"`
 	Profiles                 map[string]UserProfileConfig `json:"profiles"                   yaml:"profiles"`
 	ProfilesBuiltinOverrides map[string]BuiltinOverride   `json:"profiles_builtin_overrides" yaml:"profiles_builtin_overrides"`
 	Audit                    AuditConfig                  `json:"audit"                      yaml:"audit"`
 	TwoStage                 TwoStageConfig               `json:"two_stage"                  yaml:"two_stage"`
+	SchemaDrift              SchemaDriftConfig            `json:"schema_drift"               yaml:"schema_drift"`
+	UpdateCheck              UpdateCheckConfig            `json:"update_check"               yaml:"update_check"`
+	StatusPage               StatusPageConfig             `json:"status_page"                yaml:"status_page"`
+	OAuth                    OAuthConfig                  `json:"oauth"                      yaml:"oauth"`
+	ObjectStorage            ObjectStorageConfig          `json:"object_storage"             yaml:"object_storage"`
+	OutputFormat             OutputFormatConfig           `json:"output_format"              yaml:"output_format"`
+	Quotas                   QuotaConfig                  `json:"quotas"                     yaml:"quotas"`
+	ReadAfterWrite           ReadAfterWriteConfig         `json:"read_after_write"           yaml:"read_after_write"`
+	Annotations              AnnotationsConfig            `json:"annotations"                yaml:"annotations"`
+	LKE                      LKEConfig                    `json:"lke"                        yaml:"lke"`
+	NodeBalancerProbe        NodeBalancerProbeConfig      `json:"nodebalancer_probe"         yaml:"nodebalancer_probe"`
+	OfflineCache             OfflineCacheConfig           `json:"offline_cache"              yaml:"offline_cache"`
+	ResultSummary            ResultSummaryConfig          `json:"result_summary"             yaml:"result_summary"`
+	ToolTimeouts             ToolTimeoutConfig            `json:"tool_timeouts"              yaml:"tool_timeouts"`
+	StructuredContent        StructuredContentConfig      `json:"structured_content"         yaml:"structured_content"`
+	InstanceDefaults         InstanceDefaultsConfig       `json:"instance_defaults"          yaml:"instance_defaults"`
+	ConfirmLabel             ConfirmLabelConfig           `json:"confirm_label"              yaml:"confirm_label"`
+}
+
+// Schema drift modes. SchemaDriftLenient (the default) ignores response
+// fields the client models do not declare, SchemaDriftWarn decodes leniently
+// but logs the first unknown field per response, and SchemaDriftStrict fails
+// the call so drift surfaces immediately (useful in CI and canary setups).
+const (
+	SchemaDriftLenient = "lenient"
+	SchemaDriftWarn    = "warn"
+	SchemaDriftStrict  = "strict"
+)
+
+// SchemaDriftConfig controls how the Linode client reacts when a response
+// carries fields the models don't capture, so maintainers learn when Linode
+// adds fields. An empty Mode resolves to SchemaDriftLenient.
+type SchemaDriftConfig struct {
+	Mode string `json:"mode" yaml:"mode"`
+}
+
+// UpdateCheckConfig controls the GitHub releases check that reports a newer
+// LinodeMCP version at startup and through the linode_version_check tool.
+// Enabled is a pointer so an explicit false (air-gapped installs) is
+// distinguishable from unset; setDefaults leaves it non-nil. URL overrides
+// the releases endpoint for mirrors and tests.
+type UpdateCheckConfig struct {
+	Enabled *bool  `json:"enabled" yaml:"enabled"`
+	URL     string `json:"url"     yaml:"url"`
+}
+
+// StatusPageConfig points linode_api_status at Linode's status page. URL is
+// the Statuspage summary document; override it for mirrors and tests.
+type StatusPageConfig struct {
+	URL string `json:"url" yaml:"url"`
+}
+
+// OAuthConfig turns on MCP authorization for the HTTP transport. With
+// Enabled set every tool call must carry an "Authorization: Bearer" access
+// token issued by Issuer for Resource (this server's canonical URL), which
+// is validated at IntrospectionURL (RFC 7662) using ClientID and
+// ClientSecret. ReadScope grants the meta and read tools, WriteScope every
+// tool. TokenExchange optionally trades the caller's access token for a
+// downstream Linode token (RFC 8693) instead of using a configured one.
+type OAuthConfig struct {
+	Enabled          bool                     `json:"enabled"           yaml:"enabled"`
+	Issuer           string                   `json:"issuer"            yaml:"issuer"`
+	Resource         string                   `json:"resource"          yaml:"resource"`
+	IntrospectionURL string                   `json:"introspection_url" yaml:"introspection_url"`
+	ClientID         string                   `json:"client_id"         yaml:"client_id"`
+	ClientSecret     string                   `json:"client_secret"     yaml:"client_secret"`
+	ReadScope        string                   `json:"read_scope"        yaml:"read_scope"`
+	WriteScope       string                   `json:"write_scope"       yaml:"write_scope"`
+	TokenExchange    OAuthTokenExchangeConfig `json:"token_exchange"    yaml:"token_exchange"`
+}
+
+// OAuthTokenExchangeConfig configures minting the Linode token per call: the
+// caller's access token is exchanged at TokenURL for a token scoped to
+// Audience, and that token authenticates the call's Linode requests. It
+// requires server.tokenPassthrough, so no shared Linode token is configured.
+type OAuthTokenExchangeConfig struct {
+	Enabled  bool   `json:"enabled"   yaml:"enabled"`
+	TokenURL string `json:"token_url" yaml:"token_url"`
+	Audience string `json:"audience"  yaml:"audience"`
+}
+
+// ObjectStorageConfig holds Object Storage defaults. PreferredRegions is the
+// order linode_object_storage_bucket_create tries when called with
+// region=auto: the first entry with an Object Storage endpoint wins. When it
+// is empty (or no entry has an endpoint) the region with the lowest measured
+// endpoint latency is picked instead.
+type ObjectStorageConfig struct {
+	PreferredRegions []string `json:"preferred_regions" yaml:"preferred_regions"`
+}
+
+// Output format values. SizeUnitGiB reports sizes in binary units (1 GiB =
+// 1024 MiB, the units Linode measures in) and SizeUnitGB in decimal units.
+// PricePeriodBoth shows the monthly price with the hourly one after it.
+const (
+	SizeUnitGiB        = "GiB"
+	SizeUnitGB         = "GB"
+	PricePeriodMonthly = "monthly"
+	PricePeriodHourly  = "hourly"
+	PricePeriodBoth    = "both"
+)
+
+// OutputFormatConfig sets the default display annotations tool results carry.
+// With SizeUnit set, size fields (memory, disk, transfer, volume and image
+// sizes) gain a "<field>_display" string in that unit; with PricePeriod set,
+// price objects gain a "display" string for that period. The raw values are
+// never changed, and both empty (the default) leaves results untouched.
+// CloudManagerLinks adds a "cloud_manager_url" to each resource Cloud Manager
+// has a page for, so a reader can jump from the transcript to the resource.
+// CurrencySymbol is a pointer so an explicit false ("5.00 USD/mo" rather than
+// "$5.00/mo") is distinguishable from unset; setDefaults leaves it non-nil.
+// A call can override any field with its output_format argument.
+type OutputFormatConfig struct {
+	SizeUnit          string `json:"size_unit"           yaml:"size_unit"`
+	PricePeriod       string `json:"price_period"        yaml:"price_period"`
+	CurrencySymbol    *bool  `json:"currency_symbol"     yaml:"currency_symbol"`
+	CloudManagerLinks bool   `json:"cloud_manager_links" yaml:"cloud_manager_links"`
+}
+
+// QuotaConfig holds the per-resource account limits linode_quota_report
+// checks counts against. The Linode API does not expose most entity limits,
+// so Limits carries the ones the operator knows (the defaults, or a limit
+// raised through a support ticket), keyed by the report's resource names
+// (instances, volumes, nodebalancers, lke_clusters, ...).
+type QuotaConfig struct {
+	Limits map[string]int `json:"limits" yaml:"limits"`
+}
+
+// Read-after-write defaults and bounds. The check reads at most
+// MaxReadAfterWriteAttempts times, so with the largest interval a call waits
+// well under a minute for a write that never shows up.
+const (
+	DefaultReadAfterWriteAttempts   = 5
+	DefaultReadAfterWriteIntervalMS = 500
+	MaxReadAfterWriteAttempts       = 20
+	MaxReadAfterWriteIntervalMS     = 5000
+)
+
+// ReadAfterWriteConfig turns on the read-after-write check. Some Linode
+// endpoints keep serving the old state for a moment after a write; with
+// Enabled set, a write tool re-reads every resource it created, updated, or
+// deleted, up to Attempts times IntervalMS apart, until the change is
+// visible, and only then returns. A call can turn the check on or off with
+// its read_after_write argument. Attempts and IntervalMS are pointers so an
+// explicit 0 interval is distinguishable from unset; setDefaults leaves them
+// non-nil.
+type ReadAfterWriteConfig struct {
+	Enabled    bool `json:"enabled"     yaml:"enabled"`
+	Attempts   *int `json:"attempts"    yaml:"attempts"`
+	IntervalMS *int `json:"interval_ms" yaml:"interval_ms"`
+}
+
+// AnnotationsConfig locates the local store behind linode_annotations_set
+// and linode_annotations_get. Each environment's annotations live in
+// <Dir>/<environment>.json; an empty Dir means "annotations" under the audit
+// log directory.
+type AnnotationsConfig struct {
+	Dir string `json:"dir" yaml:"dir"`
+}
+
+// LKEConfig holds opt-in LKE behavior. WorkloadPing lets
+// linode_lke_workload_ping fetch a cluster's kubeconfig and use its admin
+// credentials against the Kubernetes API; it is off by default because the
+// other LKE tools only ever talk to the Linode API.
+type LKEConfig struct {
+	WorkloadPing bool `json:"workload_ping" yaml:"workload_ping"`
+}
+
+// InstanceDefaultsConfig holds what linode_instance_create applies when a call
+// leaves it out. AuthorizedKeyLabels names SSH keys on the token's profile
+// (see linode_sshkey_list) whose public keys are authorized on every instance
+// deployed from an image, so each instance an agent creates is reachable by
+// the team's keys. A call that passes authorized_keys, even an empty list,
+// replaces them. Labels resolve at create time, and a label with no matching
+// key fails the create rather than leaving a key off.
+type InstanceDefaultsConfig struct {
+	AuthorizedKeyLabels []string `json:"authorized_key_labels" yaml:"authorized_key_labels"`
+}
+
+// ToolsConfig tailors the registered tool surface below what the active
+// profile allows. Entries are tool names or globs of them using '*' and '?'
+// ("linode_lke_*"). With any plain Enabled entry, only matching tools stay;
+// an Enabled entry starting with "!" ("!linode_*_delete") and every Disabled
+// entry remove matching tools. Like read_only it applies on every profile
+// resolution, so a profile switch cannot bring a filtered tool back.
+type ToolsConfig struct {
+	Enabled  []string `json:"enabled"  yaml:"enabled"`
+	Disabled []string `json:"disabled" yaml:"disabled"`
+}
+
+// toolPatternPattern is the syntax of a tools entry once an Enabled entry's
+// leading "!" is removed: a tool name or a glob of one.
+var toolPatternPattern = regexp.MustCompile(`^[a-z0-9_*?]+$`)
+
+// Filters reports whether any tools entry is set.
+func (t *ToolsConfig) Filters() bool {
+	return len(t.Enabled) > 0 || len(t.Disabled) > 0
+}
+
+// Allows reports whether the named tool survives the filter: it matches a
+// plain Enabled entry, or there is none, and matches no "!" Enabled entry
+// and no Disabled entry.
+func (t *ToolsConfig) Allows(name string) bool {
+	kept, restricted := false, false
+
+	for _, pattern := range t.Enabled {
+		if excluded, negated := strings.CutPrefix(pattern, "!"); negated {
+			if toolPatternMatches(excluded, name) {
+				return false
+			}
+
+			continue
+		}
+
+		restricted = true
+		kept = kept || toolPatternMatches(pattern, name)
+	}
+
+	for _, pattern := range t.Disabled {
+		if toolPatternMatches(pattern, name) {
+			return false
+		}
+	}
+
+	return kept || !restricted
+}
+
+// toolPatternMatches matches one validated tools entry against a tool name.
+func toolPatternMatches(pattern, name string) bool {
+	ok, err := path.Match(pattern, name)
+
+	return err == nil && ok
+}
+
+// ConfirmLabelConfig turns on the label echo for the highest-risk deletes
+// (linode_instance_delete and linode_lke_cluster_delete). With Enabled set, a
+// confirmed call or a two-stage plan must also pass the resource's exact
+// label as confirm_label, the way GitHub asks for a repository's name before
+// deleting it, so a mixed-up ID fails instead of deleting the wrong resource.
+type ConfirmLabelConfig struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+}
+
+// NodeBalancerProbeConfig is the allowlist behind
+// linode_nodebalancer_backend_probe, which connects from this host straight
+// to NodeBalancer backends. AllowedCIDRs lists the networks it may reach
+// (IPv4 or IPv6 CIDRs, or bare addresses); a backend outside them is
+// reported, not probed. The tool is off while the list is empty, so the
+// server never opens connections into a network nobody named.
+type NodeBalancerProbeConfig struct {
+	AllowedCIDRs []string `json:"allowed_cidrs" yaml:"allowed_cidrs"`
+}
+
+// ProbePrefixes parses AllowedCIDRs. A bare address becomes a single-host
+// prefix.
+func (p NodeBalancerProbeConfig) ProbePrefixes() ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(p.AllowedCIDRs))
+
+	for _, raw := range p.AllowedCIDRs {
+		entry := strings.TrimSpace(raw)
+
+		if addr, err := netip.ParseAddr(entry); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidProbeCIDR, raw)
+		}
+
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
+
+// OfflineCacheConfig turns on the offline cache. With Enabled set, each
+// successful read tool result is saved under <Dir>/<environment>/, and when
+// the Linode API cannot be reached a read tool answers from the last result
+// saved for the same arguments, marked with when it was saved. An empty Dir
+// means "offline-cache" under the audit log directory. It is off by default
+// because it keeps copies of API responses on disk, and it cannot be enabled
+// with server.tokenPassthrough, whose tenants must never see each other's
+// results.
+type OfflineCacheConfig struct {
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+	Dir     string `json:"dir"     yaml:"dir"`
+}
+
+// Result summary defaults, used when threshold_bytes or max_tokens is 0.
+const (
+	DefaultResultSummaryThresholdBytes = 65536
+	DefaultResultSummaryMaxTokens      = 1024
+)
+
+// ResultSummaryConfig turns on summarizing oversized results. With Enabled
+// set, a successful result whose text is larger than ThresholdBytes is
+// replaced by a summary of at most MaxTokens that the client's own model
+// writes through an MCP sampling request, and the full result is kept in
+// memory for linode_result_get. A client that does not support sampling gets
+// the full result. A zero ThresholdBytes or MaxTokens means the default.
+type ResultSummaryConfig struct {
+	Enabled        bool `json:"enabled"         yaml:"enabled"`
+	ThresholdBytes int  `json:"threshold_bytes" yaml:"threshold_bytes"`
+	MaxTokens      int  `json:"max_tokens"      yaml:"max_tokens"`
+}
+
+// DefaultToolTimeoutMaxSeconds caps a call's timeout_seconds argument when
+// tool_timeouts.max_seconds is 0. It matches the longest built-in wait (a
+// Managed Database restore), so no wait-style tool is cut short by default.
+const DefaultToolTimeoutMaxSeconds = 1800
+
+// ToolTimeoutConfig bounds how long a tool call may run. Without a timeout a
+// call has no overall deadline and each Linode API request it makes is
+// bounded at 30 seconds. With one, the whole call must finish within it and
+// each API request may take up to that long, so a slow provision can outlast
+// 30 seconds while a lookup can be made to fail fast. Tools sets the timeout
+// per tool by name and DefaultSeconds for every other tool (0 keeps the
+// built-in behavior). A call can ask for its own with the timeout_seconds
+// argument, which is clamped to MaxSeconds; a zero MaxSeconds means
+// DefaultToolTimeoutMaxSeconds.
+type ToolTimeoutConfig struct {
+	DefaultSeconds int            `json:"default_seconds" yaml:"default_seconds"`
+	MaxSeconds     int            `json:"max_seconds"     yaml:"max_seconds"`
+	Tools          map[string]int `json:"tools"           yaml:"tools"`
+}
+
+// StructuredContentConfig controls the machine-readable copies of a tool
+// result. With Enabled set (the default), a successful result whose text is a
+// JSON object also carries that object as the MCP result's
+// structuredContent, so a program can read it without parsing the text;
+// clients that predate structured content ignore the field and read the text.
+// EmbeddedResource also attaches the JSON as an application/json embedded
+// resource. It is off by default because a client that does not understand
+// embedded resources may show the result twice. Enabled is a pointer so an
+// explicit false is distinguishable from unset; setDefaults leaves it non-nil.
+type StructuredContentConfig struct {
+	Enabled          *bool `json:"enabled"           yaml:"enabled"`
+	EmbeddedResource bool  `json:"embedded_resource" yaml:"embedded_resource"`
 }
 
 // TwoStageConfig tunes the plan/apply (two-stage write) flow. Every field is
@@ -145,18 +737,24 @@ type TwoStageConfig struct {
 
 // AuditConfig holds audit-log settings. The JSONL sink is always on
 // (Phase 2); these fields tune retention, the optional SQLite sink
-// (Phase 3b), the optional PII redaction tier (Phase 4c), and named
-// custom reports (Phase 4a/b).
+// (Phase 3b), the optional PII redaction tier (Phase 4c), named
+// custom reports (Phase 4a/b), and cost attribution.
+//
+// CostAttribution prices each priced write (instance, volume, and
+// NodeBalancer create/resize/delete) against the Linode price tables
+// and records the estimated monthly cost change on its audit event.
+// Off by default because pricing costs extra API reads per write.
 //
 // RetentionDays and RedactPII are pointers so an explicit zero value
 // ("never delete" / "log PII in cleartext") is distinguishable from
 // "unset" (nil → defaults). After setDefaults runs they are always
 // non-nil, so consumers can dereference safely.
 type AuditConfig struct {
-	RetentionDays *int                    `json:"retention_days" yaml:"retention_days"`
-	RedactPII     *bool                   `json:"redact_pii"     yaml:"redact_pii"`
-	SQLite        AuditSQLiteConfig       `json:"sqlite"         yaml:"sqlite"`
-	Reports       map[string]ReportConfig `json:"reports"        yaml:"reports"`
+	RetentionDays   *int                    `json:"retention_days"   yaml:"retention_days"`
+	RedactPII       *bool                   `json:"redact_pii"       yaml:"redact_pii"`
+	SQLite          AuditSQLiteConfig       `json:"sqlite"           yaml:"sqlite"`
+	Reports         map[string]ReportConfig `json:"reports"          yaml:"reports"`
+	CostAttribution bool                    `json:"cost_attribution" yaml:"cost_attribution"`
 }
 
 // Report output modes. ReportOutputSummary aggregates into per-bucket
@@ -281,26 +879,32 @@ type HealthConfig struct {
 // Load reads and returns the configuration from the given file path.
 func Load(path string) (*Config, error) {
 	data, err := os.ReadFile(path) // #nosec G304 -- path comes from operator config or env var
-	if err != nil {
-		if os.IsPermission(err) {
-			return nil, fmt.Errorf("%w: %s", ErrConfigPermissions, path)
-		}
-
-		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("%w: %s", ErrConfigFileNotFound, path)
-		}
 
+	switch {
+	case err == nil:
+	case os.IsPermission(err):
+		return nil, fmt.Errorf("%w: %s", ErrConfigPermissions, path)
+	case os.IsNotExist(err) && envEnvironmentsConfigured():
+		// A container configured entirely through LINODEMCP_ENV_* has no
+		// file to mount; load it as an empty file plus the environment.
+	case os.IsNotExist(err):
+		return nil, fmt.Errorf("%w: %s", ErrConfigFileNotFound, path)
+	default:
 		return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
 	}
 
 	var cfg Config
 	if err := parseConfigData(data, &cfg); err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrConfigMalformed, err.Error())
+		return nil, fmt.Errorf("%w: %w", ErrConfigMalformed, err)
 	}
 
 	setDefaults(&cfg)
 	applyEnvironmentOverrides(&cfg)
 
+	if err := applyEnvEnvironments(&cfg); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrConfigInvalid, err)
+	}
+
 	if err := validateConfig(&cfg); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrConfigInvalid, err)
 	}
@@ -345,10 +949,12 @@ func (c *Config) SelectEnvironment(userInput string) (*EnvironmentConfig, error)
 	return nil, fmt.Errorf("%w: no matching environment found for input: %s", ErrEnvironmentNotFound, userInput)
 }
 
+// parseConfigData decodes data as JSON when it is a JSON object and as YAML
+// otherwise, rejecting keys no Config field reads (see checkUnknownKeys).
 func parseConfigData(data []byte, cfg *Config) error {
 	if len(data) > 0 && data[0] == '{' {
 		if err := json.Unmarshal(data, cfg); err == nil {
-			return nil
+			return checkUnknownKeys(data, tagJSON)
 		}
 	}
 
@@ -356,7 +962,7 @@ func parseConfigData(data []byte, cfg *Config) error {
 		return fmt.Errorf("failed to unmarshal YAML: %w", err)
 	}
 
-	return nil
+	return checkUnknownKeys(data, tagYAML)
 }
 
 func setDefaults(cfg *Config) {
@@ -364,6 +970,51 @@ func setDefaults(cfg *Config) {
 	setResilienceDefaults(cfg)
 	setObservabilityDefaults(cfg)
 	setAuditDefaults(cfg)
+
+	if cfg.SchemaDrift.Mode == "" {
+		cfg.SchemaDrift.Mode = SchemaDriftLenient
+	}
+
+	if cfg.UpdateCheck.Enabled == nil {
+		enabled := true
+		cfg.UpdateCheck.Enabled = &enabled
+	}
+
+	if cfg.UpdateCheck.URL == "" {
+		cfg.UpdateCheck.URL = DefaultUpdateCheckURL
+	}
+
+	if cfg.StatusPage.URL == "" {
+		cfg.StatusPage.URL = DefaultStatusPageURL
+	}
+
+	if cfg.OAuth.ReadScope == "" {
+		cfg.OAuth.ReadScope = DefaultOAuthReadScope
+	}
+
+	if cfg.OAuth.WriteScope == "" {
+		cfg.OAuth.WriteScope = DefaultOAuthWriteScope
+	}
+
+	if cfg.OutputFormat.CurrencySymbol == nil {
+		symbol := true
+		cfg.OutputFormat.CurrencySymbol = &symbol
+	}
+
+	if cfg.StructuredContent.Enabled == nil {
+		enabled := true
+		cfg.StructuredContent.Enabled = &enabled
+	}
+
+	if cfg.ReadAfterWrite.Attempts == nil {
+		attempts := DefaultReadAfterWriteAttempts
+		cfg.ReadAfterWrite.Attempts = &attempts
+	}
+
+	if cfg.ReadAfterWrite.IntervalMS == nil {
+		interval := DefaultReadAfterWriteIntervalMS
+		cfg.ReadAfterWrite.IntervalMS = &interval
+	}
 }
 
 func setAuditDefaults(cfg *Config) {
@@ -541,6 +1192,10 @@ func applyServerOverrides(cfg *Config) {
 		cfg.Server.LogLevel = v
 		cfg.Observability.Logging.Level = v
 	}
+
+	if v := os.Getenv("LINODEMCP_READ_ONLY"); v != "" {
+		cfg.ReadOnly = v == boolTrue || v == "1"
+	}
 }
 
 // Observability has no env overrides on purpose: metrics, tracing, and
@@ -584,6 +1239,14 @@ func validateConfig(cfg *Config) error {
 		return ErrEmptyLogLevel
 	}
 
+	if err := ValidateTransport(cfg.Server.Transport); err != nil {
+		return err
+	}
+
+	if cfg.Server.AuthToken != "" && cfg.OAuth.Enabled {
+		return ErrAuthTokenWithOAuth
+	}
+
 	if len(cfg.Environments) == 0 {
 		return ErrNoEnvironments
 	}
@@ -593,6 +1256,32 @@ func validateConfig(cfg *Config) error {
 			return ErrEmptyEnvironmentName
 		}
 
+		if env.Linode.APIURL != "" {
+			if err := validateAPIURL(envName, env.Linode); err != nil {
+				return err
+			}
+		}
+
+		if err := validateHeaders(envName, env.Linode.Headers); err != nil {
+			return err
+		}
+
+		if err := validateBackend(envName, env.Linode); err != nil {
+			return err
+		}
+
+		if err := validateLabelNamespace(envName, env.LabelNamespace); err != nil {
+			return err
+		}
+
+		if cfg.Server.TokenPassthrough {
+			if env.Linode.Token != "" {
+				return fmt.Errorf("%w: environment '%s'", ErrPassthroughToken, envName)
+			}
+
+			continue
+		}
+
 		if env.Linode.APIURL != "" || env.Linode.Token != "" {
 			if env.Linode.APIURL == "" {
 				return fmt.Errorf("%w: environment '%s'", ErrMissingAPIURL, envName)
@@ -608,9 +1297,163 @@ func validateConfig(cfg *Config) error {
 		return ErrNegativeRetentionDays
 	}
 
+	switch cfg.SchemaDrift.Mode {
+	case SchemaDriftLenient, SchemaDriftWarn, SchemaDriftStrict:
+	default:
+		return fmt.Errorf("%w: got %q", ErrInvalidSchemaDriftMode, cfg.SchemaDrift.Mode)
+	}
+
+	if err := validateOAuth(cfg); err != nil {
+		return err
+	}
+
+	if cfg.OfflineCache.Enabled && cfg.Server.TokenPassthrough {
+		return ErrOfflineCacheWithPassthrough
+	}
+
+	if err := ValidateOutputFormat(cfg.OutputFormat.SizeUnit, cfg.OutputFormat.PricePeriod); err != nil {
+		return err
+	}
+
+	for resource, limit := range cfg.Quotas.Limits {
+		if limit < 0 {
+			return fmt.Errorf("%w: quotas.limits.%s is %d", ErrNegativeQuotaLimit, resource, limit)
+		}
+	}
+
+	if attempts := cfg.ReadAfterWrite.Attempts; attempts != nil && (*attempts < 1 || *attempts > MaxReadAfterWriteAttempts) {
+		return fmt.Errorf("%w: got %d", ErrInvalidReadAfterWriteAttempts, *attempts)
+	}
+
+	if interval := cfg.ReadAfterWrite.IntervalMS; interval != nil && (*interval < 0 || *interval > MaxReadAfterWriteIntervalMS) {
+		return fmt.Errorf("%w: got %d", ErrInvalidReadAfterWriteInterval, *interval)
+	}
+
+	if _, err := cfg.NodeBalancerProbe.ProbePrefixes(); err != nil {
+		return err
+	}
+
+	if cfg.ResultSummary.ThresholdBytes < 0 {
+		return fmt.Errorf("%w: got %d", ErrNegativeResultSummaryThreshold, cfg.ResultSummary.ThresholdBytes)
+	}
+
+	if cfg.ResultSummary.MaxTokens < 0 {
+		return fmt.Errorf("%w: got %d", ErrNegativeResultSummaryMaxTokens, cfg.ResultSummary.MaxTokens)
+	}
+
+	if err := validateToolTimeouts(cfg.ToolTimeouts); err != nil {
+		return err
+	}
+
+	if err := validateToolPatterns(&cfg.Tools); err != nil {
+		return err
+	}
+
+	for i, label := range cfg.InstanceDefaults.AuthorizedKeyLabels {
+		if strings.TrimSpace(label) == "" {
+			return fmt.Errorf("%w: entry %d", ErrEmptyAuthorizedKeyLabel, i)
+		}
+	}
+
 	return validateAuditReports(cfg.Audit.Reports)
 }
 
+// validateToolTimeouts checks the tool_timeouts values are not negative and
+// that every per-tool entry is a positive number of seconds.
+func validateToolTimeouts(timeouts ToolTimeoutConfig) error {
+	if timeouts.DefaultSeconds < 0 {
+		return fmt.Errorf("%w: default_seconds is %d", ErrInvalidToolTimeout, timeouts.DefaultSeconds)
+	}
+
+	if timeouts.MaxSeconds < 0 {
+		return fmt.Errorf("%w: max_seconds is %d", ErrInvalidToolTimeout, timeouts.MaxSeconds)
+	}
+
+	for tool, seconds := range timeouts.Tools {
+		if seconds < 1 {
+			return fmt.Errorf("%w: tools.%s is %d", ErrInvalidToolTimeout, tool, seconds)
+		}
+	}
+
+	return nil
+}
+
+// validateToolPatterns checks every tools entry is a tool name or a glob of
+// one. Only Enabled entries may start with "!".
+func validateToolPatterns(tools *ToolsConfig) error {
+	for i, pattern := range tools.Enabled {
+		if !toolPatternPattern.MatchString(strings.TrimPrefix(pattern, "!")) {
+			return fmt.Errorf("%w: tools.enabled[%d] is %q", ErrInvalidToolPattern, i, pattern)
+		}
+	}
+
+	for i, pattern := range tools.Disabled {
+		if !toolPatternPattern.MatchString(pattern) {
+			return fmt.Errorf("%w: tools.disabled[%d] is %q", ErrInvalidToolPattern, i, pattern)
+		}
+	}
+
+	return nil
+}
+
+// ValidateOutputFormat checks an output_format size unit and price period,
+// either of which may be empty (no annotation). Shared by config validation
+// and the per-call output_format argument.
+func ValidateOutputFormat(sizeUnit, pricePeriod string) error {
+	switch sizeUnit {
+	case "", SizeUnitGiB, SizeUnitGB:
+	default:
+		return fmt.Errorf("%w: got %q", ErrInvalidSizeUnit, sizeUnit)
+	}
+
+	switch pricePeriod {
+	case "", PricePeriodMonthly, PricePeriodHourly, PricePeriodBoth:
+	default:
+		return fmt.Errorf("%w: got %q", ErrInvalidPricePeriod, pricePeriod)
+	}
+
+	return nil
+}
+
+// ValidateTransport checks an MCP transport name, from server.transport or
+// the serve command's --transport flag.
+func ValidateTransport(transport string) error {
+	switch transport {
+	case TransportStdio, TransportSSE, TransportHTTP:
+		return nil
+	default:
+		return fmt.Errorf("%w: got %q", ErrInvalidTransport, transport)
+	}
+}
+
+// validateOAuth checks an enabled oauth block names everything token
+// validation needs, and that token exchange runs only with
+// server.tokenPassthrough, where no shared Linode token exists.
+func validateOAuth(cfg *Config) error {
+	oauth := &cfg.OAuth
+	if !oauth.Enabled {
+		return nil
+	}
+
+	if oauth.Issuer == "" || oauth.Resource == "" || oauth.IntrospectionURL == "" {
+		return fmt.Errorf("%w: issuer, resource, and introspection_url are required", ErrOAuthIncomplete)
+	}
+
+	if !oauth.TokenExchange.Enabled {
+		return nil
+	}
+
+	if oauth.TokenExchange.TokenURL == "" {
+		return fmt.Errorf("%w: token_exchange.token_url is required", ErrOAuthIncomplete)
+	}
+
+	if !cfg.Server.TokenPassthrough {
+		return ErrOAuthExchangeNeedsPassthrough
+	}
+
+	return nil
+}
+
 // validateAuditReports checks each custom report's structural grammar:
 // a known output mode, a parseable since_offset duration, parseable
 // since/until timestamps, and that capability/status use either the
@@ -216,7 +216,7 @@ environments:
 	}
 }
 
-func TestLoadFromFileUnknownFieldsIgnored(t *testing.T) {
+func TestLoadFromFileUnknownFieldsRejected(t *testing.T) {
 	t.Parallel()
 
 	dir := t.TempDir()
@@ -233,13 +233,9 @@ environments:
       token: "tok"
 `)
 
-	cfg, err := config.Load(path)
-	if err != nil {
-		t.Errorf("unexpected error: %v", err)
-	}
-
-	if cfg.Server.Name != tcTestServer {
-		t.Errorf("cfg.Server.Name = %v, want %v", cfg.Server.Name, tcTestServer)
+	_, err := config.Load(path)
+	if !errors.Is(err, config.ErrUnknownConfigKey) {
+		t.Errorf("error = %v, want %v", err, config.ErrUnknownConfigKey)
 	}
 }
 
@@ -346,8 +342,9 @@ func TestPathWithEnvOverride(t *testing.T) {
 func TestApplyEnvironmentOverrides(t *testing.T) {
 	t.Setenv("LINODEMCP_SERVER_NAME", "EnvServer")
 	t.Setenv("LINODEMCP_LOG_LEVEL", "error")
-	t.Setenv("LINODEMCP_LINODE_API_URL", "https://override.api.com")
+	t.Setenv("LINODEMCP_LINODE_API_URL", "https://override.api.com/v4")
 	t.Setenv("LINODEMCP_LINODE_TOKEN", "env-token")
+	t.Setenv("LINODEMCP_READ_ONLY", "true")
 
 	dir := t.TempDir()
 	path := writeConfigFile(t, dir, "config.yml", `
@@ -372,11 +369,198 @@ environments:
 		t.Errorf("cfg.Server.LogLevel = %v, want %v", cfg.Server.LogLevel, "error")
 	}
 
-	if cfg.Environments["default"].Linode.APIURL != "https://override.api.com" {
-		t.Errorf("got %v, want %v", cfg.Environments["default"].Linode.APIURL, "https://override.api.com")
+	if cfg.Environments["default"].Linode.APIURL != "https://override.api.com/v4" {
+		t.Errorf("got %v, want %v", cfg.Environments["default"].Linode.APIURL, "https://override.api.com/v4")
 	}
 
 	if cfg.Environments["default"].Linode.Token != "env-token" {
 		t.Errorf("got %v, want %v", cfg.Environments["default"].Linode.Token, "env-token")
 	}
+
+	if !cfg.ReadOnly {
+		t.Error("cfg.ReadOnly = false, want true from LINODEMCP_READ_ONLY")
+	}
+}
+
+// TestLoadTokenPassthrough checks server.tokenPassthrough accepts an
+// environment with only an API URL and rejects one that still carries a
+// token, since passthrough must never fall back to a shared credential.
+func TestLoadTokenPassthrough(t *testing.T) {
+	dir := t.TempDir()
+
+	urlOnly := filepath.Join(dir, "url-only.yml")
+	if err := os.WriteFile(urlOnly, []byte(`
+server:
+  tokenPassthrough: true
+environments:
+  default:
+    linode:
+      apiUrl: "https://api.linode.com/v4"
+`), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cfg, err := config.Load(urlOnly)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !cfg.Server.TokenPassthrough {
+		t.Error("Server.TokenPassthrough = false, want true")
+	}
+
+	withToken := filepath.Join(dir, "with-token.yml")
+	if err := os.WriteFile(withToken, []byte(`
+server:
+  tokenPassthrough: true
+environments:
+  default:
+    linode:
+      apiUrl: "https://api.linode.com/v4"
+      token: "shared-token"
+`), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := config.Load(withToken); !errors.Is(err, config.ErrPassthroughToken) {
+		t.Errorf("err = %v, want ErrPassthroughToken", err)
+	}
+
+	withCache := filepath.Join(dir, "with-cache.yml")
+	if err := os.WriteFile(withCache, []byte(`
+server:
+  tokenPassthrough: true
+offline_cache:
+  enabled: true
+environments:
+  default:
+    linode:
+      apiUrl: "https://api.linode.com/v4"
+`), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := config.Load(withCache); !errors.Is(err, config.ErrOfflineCacheWithPassthrough) {
+		t.Errorf("err = %v, want ErrOfflineCacheWithPassthrough", err)
+	}
+}
+
+// TestLoadTransport checks server.transport accepts only the served
+// transports and that server.authToken is refused alongside oauth, which
+// reads the same Authorization header.
+func TestLoadTransport(t *testing.T) {
+	const environments = `
+environments:
+  default:
+    linode:
+      apiUrl: "https://api.linode.com/v4"
+      token: "tok"
+`
+
+	cases := []struct {
+		name    string
+		yaml    string
+		wantErr error
+	}{
+		{name: "http", yaml: "server:\n  transport: http\n  authToken: s3cret\n", wantErr: nil},
+		{name: "sse", yaml: "server:\n  transport: sse\n", wantErr: nil},
+		{name: "unknown", yaml: "server:\n  transport: websocket\n", wantErr: config.ErrInvalidTransport},
+		{name: "auth-token-with-oauth", yaml: `
+server:
+  transport: http
+  authToken: s3cret
+oauth:
+  enabled: true
+  issuer: "https://auth.example.test"
+  resource: "https://mcp.example.test"
+  introspection_url: "https://auth.example.test/introspect"
+`, wantErr: config.ErrAuthTokenWithOAuth},
+	}
+
+	dir := t.TempDir()
+
+	for _, tc := range cases {
+		path := filepath.Join(dir, tc.name+".yml")
+		if err := os.WriteFile(path, []byte(tc.yaml+environments), 0o600); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, err := config.Load(path); !errors.Is(err, tc.wantErr) {
+			t.Errorf("%s: err = %v, want %v", tc.name, err, tc.wantErr)
+		}
+	}
+}
+
+func TestLoadOAuth(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "oauth.yml")
+	if err := os.WriteFile(valid, []byte(`
+server:
+  tokenPassthrough: true
+environments:
+  default:
+    linode:
+      apiUrl: "https://api.linode.com/v4"
+oauth:
+  enabled: true
+  issuer: "https://auth.example.test"
+  resource: "https://mcp.example.test"
+  introspection_url: "https://auth.example.test/introspect"
+  token_exchange:
+    enabled: true
+    token_url: "https://auth.example.test/token"
+`), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cfg, err := config.Load(valid)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.OAuth.ReadScope != config.DefaultOAuthReadScope || cfg.OAuth.WriteScope != config.DefaultOAuthWriteScope {
+		t.Errorf("scopes = %q/%q, want defaults", cfg.OAuth.ReadScope, cfg.OAuth.WriteScope)
+	}
+
+	incomplete := filepath.Join(dir, "incomplete.yml")
+	if err := os.WriteFile(incomplete, []byte(`
+environments:
+  default:
+    linode:
+      apiUrl: "https://api.linode.com/v4"
+      token: "shared-token"
+oauth:
+  enabled: true
+  issuer: "https://auth.example.test"
+`), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := config.Load(incomplete); !errors.Is(err, config.ErrOAuthIncomplete) {
+		t.Errorf("err = %v, want ErrOAuthIncomplete", err)
+	}
+
+	noPassthrough := filepath.Join(dir, "no-passthrough.yml")
+	if err := os.WriteFile(noPassthrough, []byte(`
+environments:
+  default:
+    linode:
+      apiUrl: "https://api.linode.com/v4"
+      token: "shared-token"
+oauth:
+  enabled: true
+  issuer: "https://auth.example.test"
+  resource: "https://mcp.example.test"
+  introspection_url: "https://auth.example.test/introspect"
+  token_exchange:
+    enabled: true
+    token_url: "https://auth.example.test/token"
+`), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := config.Load(noPassthrough); !errors.Is(err, config.ErrOAuthExchangeNeedsPassthrough) {
+		t.Errorf("err = %v, want ErrOAuthExchangeNeedsPassthrough", err)
+	}
 }
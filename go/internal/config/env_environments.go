@@ -0,0 +1,178 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// envEnvironmentPrefix starts the per-environment variables that configure
+// Linode environments without a config file:
+//
+//	LINODEMCP_ENV_<NAME>_URL         environments.<name>.linode.apiUrl
+//	LINODEMCP_ENV_<NAME>_API_VERSION environments.<name>.linode.apiVersion
+//	LINODEMCP_ENV_<NAME>_TOKEN       environments.<name>.linode.token
+//	LINODEMCP_ENV_<NAME>_TOKEN_FILE  token read from a mounted secret file
+//	LINODEMCP_ENV_<NAME>_LABEL       environments.<name>.label
+//
+// <NAME> is lowercased to form the environment name, so
+// LINODEMCP_ENV_PROD_TOKEN configures "prod". The names are dynamic, so they
+// are read by scanning os.Environ rather than with os.Getenv literals, and
+// docs/contracts/env-vars.txt documents the family instead of listing it.
+const envEnvironmentPrefix = "LINODEMCP_ENV_"
+
+// Field suffixes, longest first so _TOKEN_FILE is not read as _TOKEN.
+const (
+	envSuffixAPIVersion = "_API_VERSION"
+	envSuffixTokenFile  = "_TOKEN_FILE"
+	envSuffixToken      = "_TOKEN"
+	envSuffixURL        = "_URL"
+	envSuffixLabel      = "_LABEL"
+)
+
+// envEnvironment is one environment's worth of LINODEMCP_ENV_* values.
+type envEnvironment struct {
+	label      string
+	url        string
+	apiVersion string
+	token      string
+	tokenFile  string
+}
+
+// envEnvironmentsConfigured reports whether any LINODEMCP_ENV_* variable is
+// set. Load uses it to accept a missing config file: a container configured
+// entirely from the environment has nothing to mount.
+func envEnvironmentsConfigured() bool {
+	for _, entry := range os.Environ() {
+		if key, value, _ := strings.Cut(entry, "="); strings.HasPrefix(key, envEnvironmentPrefix) && value != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyEnvEnvironments merges the LINODEMCP_ENV_* variables into
+// cfg.Environments. A variable overrides the matching file value, and an
+// environment the file does not define is created, labelled with its name
+// unless _LABEL is set. A token given both inline and as a file, or a token
+// file that cannot be read, is an error rather than a silent pick.
+func applyEnvEnvironments(cfg *Config) error {
+	found := collectEnvEnvironments()
+	if len(found) == 0 {
+		return nil
+	}
+
+	if cfg.Environments == nil {
+		cfg.Environments = make(map[string]EnvironmentConfig)
+	}
+
+	names := make([]string, 0, len(found))
+	for name := range found {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		values := found[name]
+		env := cfg.Environments[name]
+
+		switch {
+		case values.label != "":
+			env.Label = values.label
+		case env.Label == "":
+			env.Label = name
+		}
+
+		if values.url != "" {
+			env.Linode.APIURL = values.url
+		}
+
+		if values.apiVersion != "" {
+			env.Linode.APIVersion = values.apiVersion
+		}
+
+		token, err := values.resolveToken(name)
+		if err != nil {
+			return err
+		}
+
+		if token != "" {
+			env.Linode.Token = token
+		}
+
+		cfg.Environments[name] = env
+	}
+
+	return nil
+}
+
+// collectEnvEnvironments groups the set LINODEMCP_ENV_* variables by
+// environment name. Unknown suffixes and empty names are ignored.
+func collectEnvEnvironments() map[string]*envEnvironment {
+	found := make(map[string]*envEnvironment)
+
+	for _, entry := range os.Environ() {
+		key, value, _ := strings.Cut(entry, "=")
+
+		rest, ok := strings.CutPrefix(key, envEnvironmentPrefix)
+		if !ok || value == "" {
+			continue
+		}
+
+		for _, suffix := range []string{envSuffixAPIVersion, envSuffixTokenFile, envSuffixToken, envSuffixURL, envSuffixLabel} {
+			upper, ok := strings.CutSuffix(rest, suffix)
+			if !ok {
+				continue
+			}
+
+			if upper == "" {
+				break
+			}
+
+			name := strings.ToLower(upper)
+			if found[name] == nil {
+				found[name] = &envEnvironment{}
+			}
+
+			switch suffix {
+			case envSuffixTokenFile:
+				found[name].tokenFile = value
+			case envSuffixToken:
+				found[name].token = value
+			case envSuffixAPIVersion:
+				found[name].apiVersion = value
+			case envSuffixURL:
+				found[name].url = value
+			case envSuffixLabel:
+				found[name].label = value
+			}
+
+			break
+		}
+	}
+
+	return found
+}
+
+// resolveToken returns the inline token or the trimmed contents of the
+// token file. Secrets mounted by Kubernetes and Docker end in a newline,
+// which would otherwise become part of the bearer token.
+func (e *envEnvironment) resolveToken(name string) (string, error) {
+	if e.tokenFile == "" {
+		return e.token, nil
+	}
+
+	if e.token != "" {
+		return "", fmt.Errorf("%w: environment '%s'", ErrEnvTokenConflict, name)
+	}
+
+	data, err := os.ReadFile(e.tokenFile) // #nosec G304 -- path comes from operator env var
+	if err != nil {
+		return "", fmt.Errorf("%w: environment '%s': %w", ErrEnvTokenFile, name, err)
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,104 @@
+package config_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+// TestLoadEnvOnlyWithoutConfigFile checks a missing config file is accepted
+// when LINODEMCP_ENV_* variables define the environments, the way a
+// container deployment configures the server.
+func TestLoadEnvOnlyWithoutConfigFile(t *testing.T) {
+	secret := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(secret, []byte("staging-secret\n"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Setenv("LINODEMCP_ENV_PROD_URL", apiURLLinodeV4)
+	t.Setenv("LINODEMCP_ENV_PROD_TOKEN", "prod-token")
+	t.Setenv("LINODEMCP_ENV_PROD_LABEL", envLabelProduction)
+	t.Setenv("LINODEMCP_ENV_STAGING_URL", apiURLLinodeV4)
+	t.Setenv("LINODEMCP_ENV_STAGING_TOKEN_FILE", secret)
+
+	cfg, err := config.Load(filepath.Join(t.TempDir(), "absent.yml"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	prod := cfg.Environments["prod"]
+	if prod.Label != envLabelProduction || prod.Linode.Token != "prod-token" || prod.Linode.APIURL != apiURLLinodeV4 {
+		t.Errorf("prod = %+v, want the LINODEMCP_ENV_PROD_* values", prod)
+	}
+
+	staging := cfg.Environments["staging"]
+	if staging.Label != "staging" || staging.Linode.Token != "staging-secret" {
+		t.Errorf("staging = %+v, want label staging and the trimmed file token", staging)
+	}
+
+	if cfg.Server.Name != config.DefaultServerName {
+		t.Errorf("Server.Name = %q, want %q", cfg.Server.Name, config.DefaultServerName)
+	}
+}
+
+// TestLoadEnvEnvironmentOverridesFile checks a variable wins over the file
+// value for the same environment and leaves the other fields alone.
+func TestLoadEnvEnvironmentOverridesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yml")
+	if err := os.WriteFile(path, []byte(validYAMLConfig()), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Setenv("LINODEMCP_ENV_DEFAULT_TOKEN", "rotated-token")
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	env := cfg.Environments[envKeyDefault]
+	if env.Linode.Token != "rotated-token" || env.Label != envLabelDefault || env.Linode.APIURL != apiURLLinodeV4 {
+		t.Errorf("default = %+v, want the file values with the env token", env)
+	}
+}
+
+func TestLoadEnvTokenAndTokenFileConflict(t *testing.T) {
+	t.Setenv("LINODEMCP_ENV_PROD_URL", apiURLLinodeV4)
+	t.Setenv("LINODEMCP_ENV_PROD_TOKEN", "inline")
+	t.Setenv("LINODEMCP_ENV_PROD_TOKEN_FILE", filepath.Join(t.TempDir(), "token"))
+
+	_, err := config.Load(filepath.Join(t.TempDir(), "absent.yml"))
+	if !errors.Is(err, config.ErrEnvTokenConflict) || !errors.Is(err, config.ErrConfigInvalid) {
+		t.Errorf("err = %v, want ErrEnvTokenConflict wrapped in ErrConfigInvalid", err)
+	}
+}
+
+func TestLoadEnvTokenFileUnreadable(t *testing.T) {
+	t.Setenv("LINODEMCP_ENV_PROD_URL", apiURLLinodeV4)
+	t.Setenv("LINODEMCP_ENV_PROD_TOKEN_FILE", filepath.Join(t.TempDir(), "missing"))
+
+	_, err := config.Load(filepath.Join(t.TempDir(), "absent.yml"))
+	if !errors.Is(err, config.ErrEnvTokenFile) {
+		t.Errorf("err = %v, want ErrEnvTokenFile", err)
+	}
+}
+
+// TestNewWatcherEnvOnly checks the watcher starts without a config file when
+// the environment supplies the config, and that polling stays quiet.
+func TestNewWatcherEnvOnly(t *testing.T) {
+	t.Setenv("LINODEMCP_ENV_PROD_URL", apiURLLinodeV4)
+	t.Setenv("LINODEMCP_ENV_PROD_TOKEN", "prod-token")
+
+	watcher, err := config.NewWatcher(filepath.Join(t.TempDir(), "absent.yml"), 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer watcher.Close()
+
+	if _, ok := watcher.Get().Environments["prod"]; !ok {
+		t.Errorf("Environments = %v, want prod", watcher.Get().Environments)
+	}
+}
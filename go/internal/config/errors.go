@@ -37,4 +37,87 @@ var (
 	// ErrInvalidReportTimestamp is returned when a report filter's since
 	// or until is not a valid RFC 3339 timestamp.
 	ErrInvalidReportTimestamp = errors.New("audit report since/until is not a valid RFC 3339 timestamp")
+	// ErrInvalidSchemaDriftMode is returned when schema_drift.mode is not
+	// one of "lenient", "warn", or "strict".
+	ErrInvalidSchemaDriftMode = errors.New("schema_drift.mode must be 'lenient', 'warn', or 'strict'")
+	// ErrEnvTokenConflict is returned when an environment sets both
+	// LINODEMCP_ENV_<NAME>_TOKEN and LINODEMCP_ENV_<NAME>_TOKEN_FILE.
+	ErrEnvTokenConflict = errors.New("set either _TOKEN or _TOKEN_FILE, not both")
+	// ErrEnvTokenFile is returned when LINODEMCP_ENV_<NAME>_TOKEN_FILE
+	// names a file that cannot be read.
+	ErrEnvTokenFile = errors.New("cannot read token file")
+	// ErrPassthroughToken is returned when server.tokenPassthrough is set
+	// and an environment still carries a token. Passthrough serves each
+	// user with their own token only, so a shared one is never allowed.
+	ErrPassthroughToken = errors.New("server.tokenPassthrough is set, so environments must not carry a token")
+	// ErrInvalidTransport is returned when server.transport is not one of
+	// "stdio", "sse", or "http".
+	ErrInvalidTransport = errors.New("server.transport must be 'stdio', 'sse', or 'http'")
+	// ErrAuthTokenWithOAuth is returned when server.authToken and
+	// oauth.enabled are both set: both read the Authorization header.
+	ErrAuthTokenWithOAuth = errors.New("server.authToken cannot be combined with oauth.enabled")
+	// ErrOAuthIncomplete is returned when oauth.enabled is set without the
+	// endpoints token validation (or token exchange) needs.
+	ErrOAuthIncomplete = errors.New("oauth configuration is incomplete")
+	// ErrOAuthExchangeNeedsPassthrough is returned when token exchange is
+	// enabled without server.tokenPassthrough: exchanged tokens replace
+	// per-request tokens, never a shared configured one.
+	ErrOAuthExchangeNeedsPassthrough = errors.New("oauth.token_exchange requires server.tokenPassthrough")
+	// ErrOfflineCacheWithPassthrough is returned when offline_cache.enabled
+	// and server.tokenPassthrough are both set: cached results are kept per
+	// environment, so one tenant's inventory would be served to another.
+	ErrOfflineCacheWithPassthrough = errors.New("offline_cache cannot be combined with server.tokenPassthrough")
+	// ErrInvalidSizeUnit is returned when output_format.size_unit is not
+	// "GiB" or "GB".
+	ErrInvalidSizeUnit = errors.New("output_format.size_unit must be 'GiB' or 'GB'")
+	// ErrInvalidPricePeriod is returned when output_format.price_period is
+	// not "monthly", "hourly", or "both".
+	ErrInvalidPricePeriod = errors.New("output_format.price_period must be 'monthly', 'hourly', or 'both'")
+	// ErrNegativeQuotaLimit is returned when a quotas.limits entry is below
+	// zero.
+	ErrNegativeQuotaLimit = errors.New("quota limits cannot be negative")
+	// ErrInvalidReadAfterWriteAttempts is returned when
+	// read_after_write.attempts is outside 1-20.
+	ErrInvalidReadAfterWriteAttempts = errors.New("read_after_write.attempts must be from 1 through 20")
+	// ErrInvalidReadAfterWriteInterval is returned when
+	// read_after_write.interval_ms is outside 0-5000.
+	ErrInvalidReadAfterWriteInterval = errors.New("read_after_write.interval_ms must be from 0 through 5000")
+	// ErrNegativeResultSummaryThreshold is returned when
+	// result_summary.threshold_bytes is below zero.
+	ErrNegativeResultSummaryThreshold = errors.New("result_summary.threshold_bytes cannot be negative")
+	// ErrNegativeResultSummaryMaxTokens is returned when
+	// result_summary.max_tokens is below zero.
+	ErrNegativeResultSummaryMaxTokens = errors.New("result_summary.max_tokens cannot be negative")
+	// ErrInvalidToolTimeout is returned when a tool_timeouts value is
+	// negative or a per-tool timeout is not a positive number of seconds.
+	ErrInvalidToolTimeout = errors.New("invalid tool_timeouts value")
+	// ErrInvalidAPIURL is returned when an environment's apiUrl is not an
+	// http(s) URL ending in an API version path.
+	ErrInvalidAPIURL = errors.New("invalid Linode API URL")
+	// ErrInvalidAPIVersion is returned when a pinned API version is not a
+	// version segment such as "v4" or "v4beta".
+	ErrInvalidAPIVersion = errors.New("API version must look like 'v4' or 'v4beta'")
+	// ErrInvalidHeader is returned when an environment's linode.headers
+	// entry has a malformed name or value, or names a header the client
+	// sets itself.
+	ErrInvalidHeader = errors.New("invalid Linode API header")
+	// ErrInvalidBackend is returned when an environment's linode.backend is
+	// unknown or its fixturesDir does not match the backend.
+	ErrInvalidBackend = errors.New("invalid Linode API backend")
+	// ErrInvalidLabelNamespace is returned when an environment's
+	// label_namespace could not prefix a valid Linode label.
+	ErrInvalidLabelNamespace = errors.New("invalid label_namespace")
+	// ErrUnknownConfigKey is returned when the config file carries a key no
+	// setting reads, usually a misspelling. The message lists each such key
+	// with its line.
+	ErrUnknownConfigKey = errors.New("unknown configuration key")
+	// ErrInvalidProbeCIDR is returned when a nodebalancer_probe.allowed_cidrs
+	// entry is neither a CIDR nor an IP address.
+	ErrInvalidProbeCIDR = errors.New("nodebalancer_probe.allowed_cidrs entry must be a CIDR or IP address")
+	// ErrEmptyAuthorizedKeyLabel is returned when an
+	// instance_defaults.authorized_key_labels entry is blank.
+	ErrEmptyAuthorizedKeyLabel = errors.New("instance_defaults.authorized_key_labels entries cannot be blank")
+	// ErrInvalidToolPattern is returned when a tools.enabled or
+	// tools.disabled entry is not a tool name or a glob of one.
+	ErrInvalidToolPattern = errors.New("tools entries must be tool names or globs using '*' and '?'")
 )
@@ -0,0 +1,76 @@
+package config_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+func headersConfig(headers string) string {
+	return `
+environments:
+  staging:
+    label: "Staging"
+    linode:
+      apiUrl: "https://api.linode.com/v4"
+      token: "tok"
+      headers:
+` + headers
+}
+
+// TestLinodeHeadersLoad verifies an environment's extra API headers load
+// as configured.
+func TestLinodeHeadersLoad(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfigFile(t, t.TempDir(), "config.yml", headersConfig(`        X-Gateway-Key: "gw-secret"
+        traceparent: "00-abc-def-01"
+`))
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	headers := cfg.Environments["staging"].Linode.Headers
+	if len(headers) != 2 || headers["X-Gateway-Key"] != "gw-secret" || headers["traceparent"] != "00-abc-def-01" {
+		t.Errorf("headers = %v, want X-Gateway-Key and traceparent", headers)
+	}
+}
+
+// TestLinodeHeadersValidation verifies malformed or reserved header entries
+// fail at load with an error naming the environment.
+func TestLinodeHeadersValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		headers string
+	}{
+		{name: "space in name", headers: `        "X Gateway": "v"` + "\n"},
+		{name: "colon in name", headers: `        "X-Gateway:": "v"` + "\n"},
+		{name: "authorization", headers: `        authorization: "Bearer other"` + "\n"},
+		{name: "content type", headers: `        Content-Type: "text/plain"` + "\n"},
+		{name: "user agent", headers: `        User-Agent: "curl"` + "\n"},
+		{name: "line break in value", headers: `        X-Gateway-Key: "a\r\nX-Injected: 1"` + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := writeConfigFile(t, t.TempDir(), "config.yml", headersConfig(tt.headers))
+
+			_, err := config.Load(path)
+			if !errors.Is(err, config.ErrInvalidHeader) {
+				t.Fatalf("err = %v, want %v", err, config.ErrInvalidHeader)
+			}
+
+			if !strings.Contains(err.Error(), "environment 'staging'") {
+				t.Errorf("err = %v, want it to name environment 'staging'", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package config_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+// TestInstanceDefaultsAuthorizedKeyLabels verifies the labels load as written.
+func TestInstanceDefaultsAuthorizedKeyLabels(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	block := "instance_defaults:\n  authorized_key_labels: [\"ops-laptop\", \"ci-deploy\"]\n"
+	path := writeConfigFile(t, dir, "config.yml", minimalConfigWith(block))
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := []string{"ops-laptop", "ci-deploy"}; !slices.Equal(cfg.InstanceDefaults.AuthorizedKeyLabels, want) {
+		t.Errorf("AuthorizedKeyLabels = %v, want %v", cfg.InstanceDefaults.AuthorizedKeyLabels, want)
+	}
+}
+
+// TestInstanceDefaultsBlankLabelRejected verifies a blank label is a
+// load-time validation error.
+func TestInstanceDefaultsBlankLabelRejected(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	block := "instance_defaults:\n  authorized_key_labels: [\"ops-laptop\", \" \"]\n"
+	path := writeConfigFile(t, dir, "config.yml", minimalConfigWith(block))
+
+	if _, err := config.Load(path); !errors.Is(err, config.ErrEmptyAuthorizedKeyLabel) {
+		t.Errorf("err = %v, want %v", err, config.ErrEmptyAuthorizedKeyLabel)
+	}
+}
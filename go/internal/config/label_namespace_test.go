@@ -0,0 +1,56 @@
+package config_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+// TestLabelNamespaceValidation verifies a label_namespace that cannot prefix
+// a Linode label fails at load with an error naming the environment.
+func TestLabelNamespaceValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		namespace string
+		wantErr   error
+	}{
+		{name: "valid", namespace: "team-a"},
+		{name: "too long", namespace: "abcdefghijklmnopq", wantErr: config.ErrInvalidLabelNamespace},
+		{name: "trailing dash", namespace: "team-", wantErr: config.ErrInvalidLabelNamespace},
+		{name: "space", namespace: "team a", wantErr: config.ErrInvalidLabelNamespace},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			block := apiURLConfig("https://api.linode.com/v4", "") + `    label_namespace: "` + tt.namespace + "\"\n"
+			path := writeConfigFile(t, t.TempDir(), "config.yml", block)
+
+			cfg, err := config.Load(path)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if got := cfg.Environments["staging"].LabelNamespacePrefix(); got != tt.namespace+"-" {
+					t.Errorf("LabelNamespacePrefix() = %q, want %q", got, tt.namespace+"-")
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			if !strings.Contains(err.Error(), "environment 'staging'") {
+				t.Errorf("err = %v, want it to name environment 'staging'", err)
+			}
+		})
+	}
+}
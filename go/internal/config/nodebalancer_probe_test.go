@@ -0,0 +1,53 @@
+package config_test
+
+import (
+	"errors"
+	"net/netip"
+	"slices"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+// TestNodeBalancerProbePrefixes verifies CIDRs are masked and a bare address
+// becomes a single-host prefix.
+func TestNodeBalancerProbePrefixes(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	block := "nodebalancer_probe:\n  allowed_cidrs: [\"192.168.128.7/17\", \"203.0.113.4\", \"2600:3c00::/32\"]\n"
+	path := writeConfigFile(t, dir, "config.yml", minimalConfigWith(block))
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := cfg.NodeBalancerProbe.ProbePrefixes()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []netip.Prefix{
+		netip.MustParsePrefix("192.168.128.0/17"),
+		netip.MustParsePrefix("203.0.113.4/32"),
+		netip.MustParsePrefix("2600:3c00::/32"),
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("ProbePrefixes() = %v, want %v", got, want)
+	}
+}
+
+// TestNodeBalancerProbeInvalidCIDRRejected verifies a malformed allowlist
+// entry is a load-time validation error.
+func TestNodeBalancerProbeInvalidCIDRRejected(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	block := "nodebalancer_probe:\n  allowed_cidrs: [\"10.0.0.0/33\"]\n"
+	path := writeConfigFile(t, dir, "config.yml", minimalConfigWith(block))
+
+	if _, err := config.Load(path); !errors.Is(err, config.ErrInvalidProbeCIDR) {
+		t.Errorf("err = %v, want %v", err, config.ErrInvalidProbeCIDR)
+	}
+}
@@ -0,0 +1,81 @@
+package config_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+// TestOutputFormatDefaults verifies an omitted output_format block adds no
+// annotations and defaults the currency symbol on.
+func TestOutputFormatDefaults(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := writeConfigFile(t, dir, "config.yml", minimalConfigWith(""))
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.OutputFormat.SizeUnit != "" || cfg.OutputFormat.PricePeriod != "" {
+		t.Errorf("cfg.OutputFormat = %+v, want empty size_unit and price_period", cfg.OutputFormat)
+	}
+
+	if cfg.OutputFormat.CurrencySymbol == nil || !*cfg.OutputFormat.CurrencySymbol {
+		t.Errorf("cfg.OutputFormat.CurrencySymbol = %v, want true", cfg.OutputFormat.CurrencySymbol)
+	}
+}
+
+// TestOutputFormatParses verifies a full output_format block loads as written.
+func TestOutputFormatParses(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	block := "output_format:\n  size_unit: GB\n  price_period: both\n  currency_symbol: false\n"
+	path := writeConfigFile(t, dir, "config.yml", minimalConfigWith(block))
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.OutputFormat.SizeUnit != config.SizeUnitGB || cfg.OutputFormat.PricePeriod != config.PricePeriodBoth {
+		t.Errorf("cfg.OutputFormat = %+v, want GB/both", cfg.OutputFormat)
+	}
+
+	if cfg.OutputFormat.CurrencySymbol == nil || *cfg.OutputFormat.CurrencySymbol {
+		t.Errorf("cfg.OutputFormat.CurrencySymbol = %v, want false", cfg.OutputFormat.CurrencySymbol)
+	}
+}
+
+// TestOutputFormatInvalidRejected verifies unknown units and periods are
+// load-time validation errors.
+func TestOutputFormatInvalidRejected(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		block string
+		want  error
+	}{
+		{name: "size unit", block: "output_format:\n  size_unit: TB\n", want: config.ErrInvalidSizeUnit},
+		{name: "price period", block: "output_format:\n  price_period: weekly\n", want: config.ErrInvalidPricePeriod},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			path := writeConfigFile(t, dir, "config.yml", minimalConfigWith(tt.block))
+
+			_, err := config.Load(path)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
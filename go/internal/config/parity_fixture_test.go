@@ -73,7 +73,11 @@ func TestSharedConfigParityFixture(t *testing.T) {
 		{"resilience.maxRetryDelay", cfg.Resilience.MaxRetryDelay, 90 * time.Second},
 		{"environment.label", env.Label, "Parity"},
 		{"environment.linode.apiUrl", env.Linode.APIURL, "https://api.linode.com/v4"},
+		{"environment.linode.apiVersion", env.Linode.APIVersion, "v4beta"},
 		{"environment.linode.token", env.Linode.Token, "parity-test-token"},
+		{"environment.linode.headers", env.Linode.Headers["X-Gateway-Key"], "parity-gateway"},
+		{"environment.linode.backend", env.Linode.Backend, "fixtures"},
+		{"environment.linode.fixturesDir", env.Linode.FixturesDir, "parity-fixtures"},
 	}
 
 	for _, check := range checks {
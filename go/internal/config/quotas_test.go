@@ -0,0 +1,41 @@
+package config_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+// TestQuotasParse verifies quotas.limits loads keyed by resource name.
+func TestQuotasParse(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	block := "quotas:\n  limits:\n    instances: 50\n    volumes: 0\n"
+	path := writeConfigFile(t, dir, "config.yml", minimalConfigWith(block))
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.Quotas.Limits["instances"] != 50 || len(cfg.Quotas.Limits) != 2 {
+		t.Errorf("cfg.Quotas.Limits = %v, want instances=50 and volumes=0", cfg.Quotas.Limits)
+	}
+}
+
+// TestQuotasNegativeRejected verifies a negative limit is a load-time
+// validation error.
+func TestQuotasNegativeRejected(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	block := "quotas:\n  limits:\n    instances: -1\n"
+	path := writeConfigFile(t, dir, "config.yml", minimalConfigWith(block))
+
+	_, err := config.Load(path)
+	if !errors.Is(err, config.ErrNegativeQuotaLimit) {
+		t.Errorf("err = %v, want %v", err, config.ErrNegativeQuotaLimit)
+	}
+}
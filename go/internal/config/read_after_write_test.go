@@ -0,0 +1,79 @@
+package config_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+// TestReadAfterWriteDefaults verifies an absent read_after_write block loads
+// disabled with the default attempts and interval.
+func TestReadAfterWriteDefaults(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := writeConfigFile(t, dir, "config.yml", minimalConfigWith(""))
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := cfg.ReadAfterWrite
+	if got.Enabled || *got.Attempts != config.DefaultReadAfterWriteAttempts || *got.IntervalMS != config.DefaultReadAfterWriteIntervalMS {
+		t.Errorf("cfg.ReadAfterWrite = {%v %d %d}, want {false %d %d}", got.Enabled, *got.Attempts, *got.IntervalMS,
+			config.DefaultReadAfterWriteAttempts, config.DefaultReadAfterWriteIntervalMS)
+	}
+}
+
+// TestReadAfterWriteParse verifies an explicit zero interval is kept rather
+// than replaced by the default.
+func TestReadAfterWriteParse(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	block := "read_after_write:\n  enabled: true\n  attempts: 3\n  interval_ms: 0\n"
+	path := writeConfigFile(t, dir, "config.yml", minimalConfigWith(block))
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := cfg.ReadAfterWrite
+	if !got.Enabled || *got.Attempts != 3 || *got.IntervalMS != 0 {
+		t.Errorf("cfg.ReadAfterWrite = {%v %d %d}, want {true 3 0}", got.Enabled, *got.Attempts, *got.IntervalMS)
+	}
+}
+
+// TestReadAfterWriteOutOfRangeRejected verifies attempts and interval_ms
+// outside their bounds are load-time validation errors.
+func TestReadAfterWriteOutOfRangeRejected(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		block string
+		want  error
+	}{
+		{"zero attempts", "read_after_write:\n  attempts: 0\n", config.ErrInvalidReadAfterWriteAttempts},
+		{"too many attempts", "read_after_write:\n  attempts: 21\n", config.ErrInvalidReadAfterWriteAttempts},
+		{"negative interval", "read_after_write:\n  interval_ms: -1\n", config.ErrInvalidReadAfterWriteInterval},
+		{"interval too long", "read_after_write:\n  interval_ms: 5001\n", config.ErrInvalidReadAfterWriteInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			path := writeConfigFile(t, dir, "config.yml", minimalConfigWith(tt.block))
+
+			_, err := config.Load(path)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
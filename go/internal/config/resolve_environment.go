@@ -0,0 +1,125 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// maxSuggestionDistance is how many single-character edits a misspelled
+// environment name may be from a configured one and still be suggested.
+const maxSuggestionDistance = 2
+
+// ResolveEnvironment looks up the environment a tool call names. An exact
+// key match wins, then a case-insensitive one. Unlike SelectEnvironment it
+// never falls back to another environment: an unknown name is an
+// ErrEnvironmentNotFound error listing the configured names and, when one is
+// close, suggesting it.
+func (c *Config) ResolveEnvironment(name string) (*EnvironmentConfig, error) {
+	key, err := c.ResolveEnvironmentName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	env := c.Environments[key]
+
+	return &env, nil
+}
+
+// ResolveEnvironmentName is ResolveEnvironment returning the configured key
+// instead of the environment, for callers that store state per environment
+// and need one spelling of its name.
+func (c *Config) ResolveEnvironmentName(name string) (string, error) {
+	trimmed := strings.TrimSpace(name)
+	if trimmed == "" {
+		return "", ErrEmptyEnvironmentName
+	}
+
+	if _, ok := c.Environments[trimmed]; ok {
+		return trimmed, nil
+	}
+
+	names := c.EnvironmentNames()
+	if len(names) == 0 {
+		return "", fmt.Errorf("%w: no provider environments configured", ErrEnvironmentNotFound)
+	}
+
+	for _, envName := range names {
+		if strings.EqualFold(envName, trimmed) {
+			return envName, nil
+		}
+	}
+
+	msg := fmt.Sprintf("%q; valid environments: %s", trimmed, strings.Join(names, ", "))
+	if suggestion := closestEnvironmentName(trimmed, names); suggestion != "" {
+		msg += fmt.Sprintf("; did you mean %q?", suggestion)
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrEnvironmentNotFound, msg)
+}
+
+// EnvironmentNames returns the configured environment names, sorted.
+func (c *Config) EnvironmentNames() []string {
+	names := make([]string, 0, len(c.Environments))
+	for name := range c.Environments {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// closestEnvironmentName returns the configured name nearest to input, or ""
+// when none is close. Comparison ignores case. A name that starts with input
+// (or that input starts with) counts as close, so "prod" suggests
+// "production"; otherwise the name must be within maxSuggestionDistance
+// edits. Ties go to the name that sorts first.
+func closestEnvironmentName(input string, names []string) string {
+	lowered := strings.ToLower(input)
+	best, bestDistance := "", maxSuggestionDistance+1
+
+	for _, name := range names {
+		candidate := strings.ToLower(name)
+
+		distance := editDistance(lowered, candidate)
+		if strings.HasPrefix(candidate, lowered) || strings.HasPrefix(lowered, candidate) {
+			distance = min(distance, maxSuggestionDistance)
+		}
+
+		if distance < bestDistance {
+			best, bestDistance = name, distance
+		}
+	}
+
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b in runes.
+func editDistance(a, b string) int {
+	source, target := []rune(a), []rune(b)
+
+	previous := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	current := make([]int, len(target)+1)
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(target)]
+}
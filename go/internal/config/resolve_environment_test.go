@@ -0,0 +1,92 @@
+package config_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+func TestResolveEnvironment(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault},
+			"production":  {Label: envLabelProduction},
+			"staging":     {Label: "Staging"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		input     string
+		wantLabel string
+		wantErr   string
+	}{
+		{name: "exact match", input: "staging", wantLabel: "Staging"},
+		{name: "case insensitive", input: "Production", wantLabel: envLabelProduction},
+		{
+			name:    "typo suggests closest",
+			input:   "stagnig",
+			wantErr: `environment not found in configuration: "stagnig"; valid environments: default, production, staging; did you mean "staging"?`,
+		},
+		{
+			name:    "prefix suggests full name",
+			input:   "prod",
+			wantErr: `environment not found in configuration: "prod"; valid environments: default, production, staging; did you mean "production"?`,
+		},
+		{
+			name:    "nothing close lists names only",
+			input:   "qa",
+			wantErr: `environment not found in configuration: "qa"; valid environments: default, production, staging`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env, err := cfg.ResolveEnvironment(tt.input)
+
+			if tt.wantErr != "" {
+				if !errors.Is(err, config.ErrEnvironmentNotFound) || err.Error() != tt.wantErr {
+					t.Errorf("error = %v, want %s", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if env.Label != tt.wantLabel {
+				t.Errorf("env.Label = %v, want %v", env.Label, tt.wantLabel)
+			}
+		})
+	}
+}
+
+func TestResolveEnvironmentName(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			"Production": {Label: envLabelProduction},
+		},
+	}
+
+	name, err := cfg.ResolveEnvironmentName(" production ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if name != "Production" {
+		t.Errorf("name = %q, want the configured key %q", name, "Production")
+	}
+
+	if _, err := cfg.ResolveEnvironmentName(""); !errors.Is(err, config.ErrEmptyEnvironmentName) {
+		t.Errorf("error = %v, want ErrEmptyEnvironmentName", err)
+	}
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// SchemaID is where the config JSON Schema is published. Editors that read
+// a "# yaml-language-server: $schema=<url>" modeline validate and complete
+// the config file against it.
+const SchemaID = "https://raw.githubusercontent.com/chadit/LinodeMCP/main/docs/contracts/config.schema.json"
+
+// durationSchema describes a time.Duration field. Go's YAML decoder reads
+// only the duration string form, so the schema does not offer bare numbers.
+var durationSchema = map[string]any{
+	"type":        "string",
+	"description": `A Go duration such as "30s" or "1m30s".`,
+}
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of the YAML config file,
+// generated from Config's yaml tags. Every object closes its properties the
+// way the unknown-key check does, so an editor flags the same misspellings
+// Load rejects. The output is the published docs/contracts/config.schema.json
+// (regenerate with `make config-schema`).
+func JSONSchema() ([]byte, error) {
+	schema := typeSchema(reflect.TypeFor[Config]())
+	schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	schema["$id"] = SchemaID
+	schema["title"] = "LinodeMCP configuration"
+
+	out, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode config schema: %w", err)
+	}
+
+	return append(out, '\n'), nil
+}
+
+// typeSchema is the schema of one Go type as the YAML decoder reads it.
+func typeSchema(typ reflect.Type) map[string]any {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ == reflect.TypeFor[time.Duration]() {
+		return durationSchema
+	}
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		properties := map[string]any{}
+
+		for i := range typ.NumField() {
+			field := typ.Field(i)
+			if name, ok := tagName(field, tagYAML); ok {
+				properties[name] = typeSchema(field.Type)
+			}
+		}
+
+		return map[string]any{"type": "object", "properties": properties, "additionalProperties": false}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": typeSchema(typ.Elem())}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": typeSchema(typ.Elem())}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	default:
+		return map[string]any{}
+	}
+}
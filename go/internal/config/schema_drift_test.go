@@ -0,0 +1,63 @@
+package config_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+// TestSchemaDriftDefaultsToLenient verifies an omitted schema_drift block
+// keeps the historical behavior of silently ignoring unknown fields.
+func TestSchemaDriftDefaultsToLenient(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := writeConfigFile(t, dir, "config.yml", minimalConfigWith(""))
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.SchemaDrift.Mode != config.SchemaDriftLenient {
+		t.Errorf("cfg.SchemaDrift.Mode = %q, want %q", cfg.SchemaDrift.Mode, config.SchemaDriftLenient)
+	}
+}
+
+// TestSchemaDriftModeParses verifies each accepted mode loads as written.
+func TestSchemaDriftModeParses(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range []string{config.SchemaDriftLenient, config.SchemaDriftWarn, config.SchemaDriftStrict} {
+		t.Run(mode, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			path := writeConfigFile(t, dir, "config.yml", minimalConfigWith("schema_drift:\n  mode: "+mode+"\n"))
+
+			cfg, err := config.Load(path)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if cfg.SchemaDrift.Mode != mode {
+				t.Errorf("cfg.SchemaDrift.Mode = %q, want %q", cfg.SchemaDrift.Mode, mode)
+			}
+		})
+	}
+}
+
+// TestSchemaDriftInvalidModeRejected verifies an unknown mode is a
+// load-time validation error rather than a silent fallback.
+func TestSchemaDriftInvalidModeRejected(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := writeConfigFile(t, dir, "config.yml", minimalConfigWith("schema_drift:\n  mode: paranoid\n"))
+
+	_, err := config.Load(path)
+	if !errors.Is(err, config.ErrInvalidSchemaDriftMode) {
+		t.Errorf("error = %v, want %v", err, config.ErrInvalidSchemaDriftMode)
+	}
+}
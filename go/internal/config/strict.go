@@ -0,0 +1,166 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Struct tags the strict check matches keys against: YAML files use the
+// yaml tags, JSON files the json tags, the same ones the decoders read.
+const (
+	tagYAML = "yaml"
+	tagJSON = "json"
+)
+
+// unknownKey is a config key no Config field reads, with the 1-based line
+// it appears on.
+type unknownKey struct {
+	path string
+	line int
+}
+
+// checkUnknownKeys fails when data carries a key no Config field reads, so a
+// misspelled setting is an error at load instead of a silently ignored line.
+// Every unknown key is reported with its dotted path and line. The key names
+// come from the struct tags named by tag; JSON keys match case-insensitively,
+// as encoding/json decodes them. A JSON file yaml.v3 cannot parse into a node
+// tree (rare, since YAML is a JSON superset) is not checked.
+func checkUnknownKeys(data []byte, tag string) error {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		if tag == tagJSON {
+			return nil
+		}
+
+		return fmt.Errorf("failed to unmarshal YAML: %w", err)
+	}
+
+	var unknown []unknownKey
+
+	walkKnownKeys(&root, reflect.TypeFor[Config](), tag, "", &unknown)
+
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	entries := make([]string, 0, len(unknown))
+	for _, key := range unknown {
+		entries = append(entries, fmt.Sprintf("%s (line %d)", key.path, key.line))
+	}
+
+	return fmt.Errorf("%w: %s", ErrUnknownConfigKey, strings.Join(entries, ", "))
+}
+
+// walkKnownKeys records in unknown every mapping key under node that the
+// type it decodes into does not declare. Map keys are free-form (environment
+// and profile names), so only their values are walked. A merge key ("<<")
+// contributes the merged mapping's keys to the mapping it sits in.
+func walkKnownKeys(node *yaml.Node, typ reflect.Type, tag, path string, unknown *[]unknownKey) {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			walkKnownKeys(child, typ, tag, path, unknown)
+		}
+	case yaml.AliasNode:
+		if node.Alias != nil {
+			walkKnownKeys(node.Alias, typ, tag, path, unknown)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Tag == "!!merge" {
+				walkKnownKeys(value, typ, tag, path, unknown)
+
+				continue
+			}
+
+			keyPath := joinKeyPath(path, key.Value)
+
+			switch typ.Kind() {
+			case reflect.Struct:
+				field, ok := fieldForKey(typ, tag, key.Value)
+				if !ok {
+					*unknown = append(*unknown, unknownKey{path: keyPath, line: key.Line})
+
+					continue
+				}
+
+				walkKnownKeys(value, field.Type, tag, keyPath, unknown)
+			case reflect.Map:
+				walkKnownKeys(value, typ.Elem(), tag, keyPath, unknown)
+			default:
+			}
+		}
+	case yaml.SequenceNode:
+		if typ.Kind() != reflect.Slice {
+			// A merge key's list of mappings, each merged in turn.
+			for _, item := range node.Content {
+				if item.Kind == yaml.MappingNode || item.Kind == yaml.AliasNode {
+					walkKnownKeys(item, typ, tag, path, unknown)
+				}
+			}
+
+			return
+		}
+
+		for i, item := range node.Content {
+			walkKnownKeys(item, typ.Elem(), tag, fmt.Sprintf("%s[%d]", path, i), unknown)
+		}
+	default:
+	}
+}
+
+// fieldForKey finds the field of struct type typ that key decodes into.
+func fieldForKey(typ reflect.Type, tag, key string) (reflect.StructField, bool) {
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+
+		name, ok := tagName(field, tag)
+		if !ok {
+			continue
+		}
+
+		if name == key || (tag == tagJSON && strings.EqualFold(name, key)) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// tagName is the key a field is read from under tag, or false for a field
+// the decoder skips. An untagged field falls back to the decoder's default:
+// the lowercased field name for YAML, the field name for JSON.
+func tagName(field reflect.StructField, tag string) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+
+	switch {
+	case name == "-":
+		return "", false
+	case name != "":
+		return name, true
+	case tag == tagYAML:
+		return strings.ToLower(field.Name), true
+	default:
+		return field.Name, true
+	}
+}
+
+func joinKeyPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
@@ -0,0 +1,135 @@
+package config_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+// TestLoadRejectsUnknownKeys verifies a misspelled key fails the load and the
+// error names every unknown key with its line, including keys nested under a
+// free-form map such as an environment name.
+func TestLoadRejectsUnknownKeys(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := writeConfigFile(t, dir, "config.yml", `server:
+  logLevl: debug
+environments:
+  default:
+    label: "Default"
+    linode:
+      apiUrl: "https://api.linode.com/v4"
+      tokn: "tok"
+`)
+
+	_, err := config.Load(path)
+	if !errors.Is(err, config.ErrConfigMalformed) || !errors.Is(err, config.ErrUnknownConfigKey) {
+		t.Fatalf("error = %v, want ErrConfigMalformed wrapping ErrUnknownConfigKey", err)
+	}
+
+	want := "unknown configuration key: server.logLevl (line 2), environments.default.linode.tokn (line 8)"
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("error = %q, want it to contain %q", err.Error(), want)
+	}
+}
+
+// TestLoadRejectsUnknownJSONKeys verifies JSON files are checked against the
+// json tags, matched case-insensitively as encoding/json decodes them.
+func TestLoadRejectsUnknownJSONKeys(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := writeConfigFile(t, dir, "config.json", `{
+  "environments": {
+    "default": {
+      "Label": "Default",
+      "linode": {"api_url": "https://api.linode.com/v4", "token": "tok"}
+    }
+  },
+  "audit": {"retention": 7}
+}`)
+
+	_, err := config.Load(path)
+	if !errors.Is(err, config.ErrUnknownConfigKey) {
+		t.Fatalf("error = %v, want ErrUnknownConfigKey", err)
+	}
+
+	if want := "unknown configuration key: audit.retention (line 8)"; !strings.Contains(err.Error(), want) {
+		t.Errorf("error = %q, want it to contain %q", err.Error(), want)
+	}
+}
+
+// TestLoadAcceptsPythonPoolKeys verifies the resilience pool keys only the
+// Python client reads still load, so one config file serves both binaries.
+func TestLoadAcceptsPythonPoolKeys(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := writeConfigFile(t, dir, "config.yml", minimalConfigWith(`resilience:
+  poolMaxConnections: 20
+  poolMaxKeepaliveConnections: 5
+  poolKeepaliveExpiry: 15.5
+`))
+
+	if _, err := config.Load(path); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// TestLoadChecksMergedKeys verifies keys merged in with "<<" are checked
+// against the mapping they land in, so shared anchors load and a typo next to
+// the merge is still caught.
+func TestLoadChecksMergedKeys(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := writeConfigFile(t, dir, "config.yml", `environments:
+  default:
+    label: "Default"
+    linode: &linode
+      apiUrl: "https://api.linode.com/v4"
+      token: "tok"
+  staging:
+    label: "Staging"
+    linode:
+      <<: *linode
+      apiVersoin: "v4beta"
+`)
+
+	_, err := config.Load(path)
+	if want := "unknown configuration key: environments.staging.linode.apiVersoin (line 11)"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("error = %v, want it to contain %q", err, want)
+	}
+}
+
+// TestPublishedConfigSchemaIsCurrent verifies both published copies of the
+// config JSON Schema match what JSONSchema generates from the structs.
+func TestPublishedConfigSchemaIsCurrent(t *testing.T) {
+	t.Parallel()
+
+	want, err := config.JSONSchema()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	root := filepath.Join("..", "..", "..")
+	for _, path := range []string{
+		filepath.Join(root, "docs", "contracts", "config.schema.json"),
+		filepath.Join(root, "python", "src", "linodemcp", "config", "config.schema.json"),
+	} {
+		got, err := os.ReadFile(path) //nolint:gosec // fixed repo path
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is stale; run `make config-schema`", path)
+		}
+	}
+}
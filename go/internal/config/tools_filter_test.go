@@ -0,0 +1,54 @@
+package config_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+// TestToolsConfigAllows verifies plain enabled entries restrict the surface
+// and "!" enabled entries and disabled entries remove from it.
+func TestToolsConfigAllows(t *testing.T) {
+	t.Parallel()
+
+	tools := config.ToolsConfig{
+		Enabled:  []string{"linode_lke_*", "linode_instance_list", "!linode_*_delete"},
+		Disabled: []string{"linode_lke_cluster_recycle"},
+	}
+
+	for name, want := range map[string]bool{
+		"linode_lke_cluster_list":    true,
+		"linode_instance_list":       true,
+		"linode_lke_cluster_delete":  false,
+		"linode_lke_cluster_recycle": false,
+		"linode_volume_list":         false,
+	} {
+		if got := tools.Allows(name); got != want {
+			t.Errorf("Allows(%q) = %v, want %v", name, got, want)
+		}
+	}
+
+	onlyExcluded := config.ToolsConfig{Enabled: []string{"!linode_*_delete"}}
+	if !onlyExcluded.Allows("linode_volume_list") || onlyExcluded.Allows("linode_volume_delete") {
+		t.Error("an enabled list of only \"!\" entries should keep every tool it does not exclude")
+	}
+}
+
+// TestToolsConfigInvalidPatternRejected verifies a malformed entry is a
+// load-time validation error naming the entry.
+func TestToolsConfigInvalidPatternRejected(t *testing.T) {
+	t.Parallel()
+
+	for _, block := range []string{
+		"tools:\n  enabled: [\"linode_lke_*\", \"linode_[lke\"]\n",
+		"tools:\n  disabled: [\"!linode_*_delete\"]\n",
+		"tools:\n  enabled: [\"\"]\n",
+	} {
+		path := writeConfigFile(t, t.TempDir(), "config.yml", minimalConfigWith(block))
+
+		if _, err := config.Load(path); !errors.Is(err, config.ErrInvalidToolPattern) {
+			t.Errorf("block %q: err = %v, want %v", block, err, config.ErrInvalidToolPattern)
+		}
+	}
+}
@@ -53,15 +53,22 @@ func NewWatcher(path string, interval time.Duration) (*Watcher, error) {
 		interval = DefaultWatchInterval
 	}
 
+	// An env-only config (no file, LINODEMCP_ENV_* set) starts with a zero
+	// lastMod, so a file created later is picked up by the first poll.
+	var lastMod time.Time
+
 	info, statErr := os.Stat(path) // #nosec G304 -- path comes from operator config
-	if statErr != nil {
+	switch {
+	case statErr == nil:
+		lastMod = info.ModTime()
+	case !os.IsNotExist(statErr):
 		return nil, fmt.Errorf("stat config file: %w", statErr)
 	}
 
 	watcher := &Watcher{
 		path:     path,
 		interval: interval,
-		lastMod:  info.ModTime(),
+		lastMod:  lastMod,
 		errs:     make(chan error, watcherErrBuffer),
 		stop:     make(chan struct{}),
 	}
@@ -145,6 +152,11 @@ func (w *Watcher) run(ctx context.Context) {
 func (w *Watcher) checkAndReload() error {
 	info, err := os.Stat(w.path) // #nosec G304 -- path is from operator config
 	if err != nil {
+		// Still running env-only with no file yet: nothing to reload.
+		if os.IsNotExist(err) && w.lastMod.IsZero() {
+			return nil
+		}
+
 		return fmt.Errorf("stat config: %w", err)
 	}
 
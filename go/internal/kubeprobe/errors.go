@@ -0,0 +1,18 @@
+package kubeprobe
+
+import "errors"
+
+var (
+	// ErrInvalidKubeconfig marks a kubeconfig that is not valid YAML or
+	// carries undecodable certificate data.
+	ErrInvalidKubeconfig = errors.New("kubeconfig is not valid")
+	// ErrNoContext marks a kubeconfig whose current context cannot be found.
+	ErrNoContext = errors.New("kubeconfig has no usable current context")
+	// ErrNoServer marks a current context whose cluster has no server URL.
+	ErrNoServer = errors.New("kubeconfig cluster has no server URL")
+	// ErrNoToken marks a current context whose user has no bearer token.
+	ErrNoToken = errors.New("kubeconfig user has no bearer token")
+	// ErrInvalidCA marks certificate-authority-data that holds no PEM
+	// certificate.
+	ErrInvalidCA = errors.New("kubeconfig certificate-authority-data holds no PEM certificate")
+)
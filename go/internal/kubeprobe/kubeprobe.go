@@ -0,0 +1,343 @@
+// Package kubeprobe checks that a Kubernetes API server is actually serving,
+// using the credentials in a kubeconfig. linode_lke_workload_ping runs it
+// against an LKE cluster's kubeconfig as a deeper readiness signal than the
+// cluster's Linode status: it asks the API server for /version and the node
+// list, and reports each request's outcome rather than failing on the first.
+package kubeprobe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Check names, in the order Ping runs them.
+const (
+	CheckVersion = "version"
+	CheckNodes   = "nodes"
+)
+
+const (
+	versionPath = "/version"
+	nodesPath   = "/api/v1/nodes"
+)
+
+// requestTimeout bounds each API request so an unreachable control plane
+// fails the check instead of holding the tool call.
+const requestTimeout = 10 * time.Second
+
+// maxBodyBytes caps a response read; the node list of a large cluster is a
+// few MB.
+const maxBodyBytes = 16 << 20
+
+// Target is where and how to reach one API server.
+type Target struct {
+	// Server is the API server base URL, e.g. "https://<id>.<region>.linodelke.net:443".
+	Server string
+	// CAData is the PEM bundle that signs the server certificate. Empty means
+	// the system roots.
+	CAData []byte
+	// Token is the bearer token sent on every request.
+	Token string
+}
+
+// Check is the outcome of one API request.
+type Check struct {
+	Name       string
+	Path       string
+	OK         bool
+	StatusCode int
+	Latency    time.Duration
+	Err        string
+}
+
+// Node is one node from the node list.
+type Node struct {
+	Name           string
+	Ready          bool
+	KubeletVersion string
+}
+
+// Result is the outcome of a Ping. Version and Nodes are filled only by the
+// checks that succeeded.
+type Result struct {
+	Version string
+	Nodes   []Node
+	Checks  []Check
+}
+
+// Serving reports whether every check succeeded.
+func (r Result) Serving() bool {
+	for _, check := range r.Checks {
+		if !check.OK {
+			return false
+		}
+	}
+
+	return len(r.Checks) > 0
+}
+
+// FirstFailure returns the first failed check, or false when all passed.
+func (r Result) FirstFailure() (Check, bool) {
+	for _, check := range r.Checks {
+		if !check.OK {
+			return check, true
+		}
+	}
+
+	return Check{}, false
+}
+
+type kubeconfig struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			Token string `yaml:"token"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+}
+
+// ParseKubeconfig reads the server, CA, and token of the kubeconfig's current
+// context. A kubeconfig without current-context must have exactly one
+// context. Only bearer-token users are supported, which is what LKE issues.
+func ParseKubeconfig(data []byte) (Target, error) {
+	var parsed kubeconfig
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		return Target{}, fmt.Errorf("%w: %w", ErrInvalidKubeconfig, err)
+	}
+
+	contextIndex := -1
+
+	for i, named := range parsed.Contexts {
+		if named.Name == parsed.CurrentContext {
+			contextIndex = i
+
+			break
+		}
+	}
+
+	if contextIndex < 0 && parsed.CurrentContext == "" && len(parsed.Contexts) == 1 {
+		contextIndex = 0
+	}
+
+	if contextIndex < 0 {
+		return Target{}, fmt.Errorf("%w: %q", ErrNoContext, parsed.CurrentContext)
+	}
+
+	current := parsed.Contexts[contextIndex].Context
+
+	var target Target
+
+	for _, named := range parsed.Clusters {
+		if named.Name != current.Cluster {
+			continue
+		}
+
+		target.Server = strings.TrimRight(named.Cluster.Server, "/")
+
+		if named.Cluster.CertificateAuthorityData != "" {
+			ca, err := base64.StdEncoding.DecodeString(named.Cluster.CertificateAuthorityData)
+			if err != nil {
+				return Target{}, fmt.Errorf("%w: certificate-authority-data: %w", ErrInvalidKubeconfig, err)
+			}
+
+			target.CAData = ca
+		}
+	}
+
+	if target.Server == "" {
+		return Target{}, fmt.Errorf("%w: cluster %q", ErrNoServer, current.Cluster)
+	}
+
+	for _, named := range parsed.Users {
+		if named.Name == current.User {
+			target.Token = named.User.Token
+		}
+	}
+
+	if target.Token == "" {
+		return Target{}, fmt.Errorf("%w: user %q", ErrNoToken, current.User)
+	}
+
+	return target, nil
+}
+
+// Ping runs the version check and then the node list check against target.
+// A failed check is recorded in the Result, not returned; the error is only
+// for a target that cannot be used at all (an unparsable CA bundle).
+func Ping(ctx context.Context, target Target) (Result, error) {
+	client, err := newClient(target)
+	if err != nil {
+		return Result{}, err
+	}
+
+	// Each Ping builds its own transport, so drop its connections when done.
+	defer client.CloseIdleConnections()
+
+	var result Result
+
+	var version struct {
+		GitVersion string `json:"gitVersion"`
+	}
+
+	check := get(ctx, client, target, CheckVersion, versionPath, &version)
+	if check.OK {
+		result.Version = version.GitVersion
+	}
+
+	result.Checks = append(result.Checks, check)
+
+	var nodes nodeList
+
+	check = get(ctx, client, target, CheckNodes, nodesPath, &nodes)
+	if check.OK {
+		result.Nodes = nodes.toNodes()
+	}
+
+	result.Checks = append(result.Checks, check)
+
+	return result, nil
+}
+
+func newClient(target Target) (*http.Client, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if len(target.CAData) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(target.CAData) {
+			return nil, ErrInvalidCA
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	return &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig, Proxy: http.ProxyFromEnvironment}}, nil
+}
+
+// get fetches path and decodes the JSON body into out, timing the request.
+func get(ctx context.Context, client *http.Client, target Target, name, path string, out any) Check {
+	check := Check{Name: name, Path: path}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.Server+path, http.NoBody)
+	if err != nil {
+		check.Err = err.Error()
+
+		return check
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+target.Token)
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	check.Latency = time.Since(start)
+
+	if err != nil {
+		check.Err = err.Error()
+
+		return check
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	check.StatusCode = resp.StatusCode
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes))
+	if err != nil {
+		check.Err = fmt.Sprintf("read response: %v", err)
+
+		return check
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		check.Err = statusError(resp.StatusCode, body)
+
+		return check
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		check.Err = fmt.Sprintf("decode response: %v", err)
+
+		return check
+	}
+
+	check.OK = true
+
+	return check
+}
+
+// statusError describes a non-200 answer, using the message of the
+// Kubernetes Status object the API server sends with most errors.
+func statusError(code int, body []byte) string {
+	var status struct {
+		Message string `json:"message"`
+	}
+
+	if json.Unmarshal(body, &status) == nil && status.Message != "" {
+		return fmt.Sprintf("HTTP %d: %s", code, status.Message)
+	}
+
+	return fmt.Sprintf("HTTP %d %s", code, http.StatusText(code))
+}
+
+type nodeList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Status struct {
+			Conditions []struct {
+				Type   string `json:"type"`
+				Status string `json:"status"`
+			} `json:"conditions"`
+			NodeInfo struct {
+				KubeletVersion string `json:"kubeletVersion"`
+			} `json:"nodeInfo"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+func (l nodeList) toNodes() []Node {
+	nodes := make([]Node, 0, len(l.Items))
+
+	for _, item := range l.Items {
+		node := Node{Name: item.Metadata.Name, KubeletVersion: item.Status.NodeInfo.KubeletVersion}
+
+		for _, condition := range item.Status.Conditions {
+			if condition.Type == "Ready" {
+				node.Ready = condition.Status == "True"
+			}
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
@@ -0,0 +1,181 @@
+package kubeprobe_test
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/kubeprobe"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: lke123
+  cluster:
+    certificate-authority-data: %CA%
+    server: https://123.us-east.linodelke.net:443/
+users:
+- name: lke123-admin
+  user:
+    token: secret-token
+contexts:
+- name: lke123-ctx
+  context:
+    cluster: lke123
+    namespace: default
+    user: lke123-admin
+current-context: lke123-ctx
+`
+
+// apiServer serves /version and /api/v1/nodes over TLS, requiring the
+// secret-token bearer token, and returns a Target that trusts it.
+func apiServer(t *testing.T, nodesStatus int) kubeprobe.Target {
+	t.Helper()
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		switch r.URL.Path {
+		case "/version":
+			_, _ = w.Write([]byte(`{"major":"1","minor":"31","gitVersion":"v1.31.1"}`))
+		case "/api/v1/nodes":
+			w.WriteHeader(nodesStatus)
+
+			if nodesStatus != http.StatusOK {
+				_, _ = w.Write([]byte(`{"kind":"Status","message":"nodes is forbidden"}`))
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"items":[` +
+				`{"metadata":{"name":"lke123-1"},"status":{"conditions":[{"type":"Ready","status":"True"}],"nodeInfo":{"kubeletVersion":"v1.31.1"}}},` +
+				`{"metadata":{"name":"lke123-2"},"status":{"conditions":[{"type":"Ready","status":"Unknown"}],"nodeInfo":{"kubeletVersion":"v1.31.1"}}}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return kubeprobe.Target{
+		Server: srv.URL,
+		CAData: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}),
+		Token:  "secret-token",
+	}
+}
+
+func TestParseKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	ca := "-----BEGIN CERTIFICATE-----\n-----END CERTIFICATE-----\n"
+	raw := strings.Replace(testKubeconfig, "%CA%", base64.StdEncoding.EncodeToString([]byte(ca)), 1)
+
+	target, err := kubeprobe.ParseKubeconfig([]byte(raw))
+	if err != nil {
+		t.Fatalf("ParseKubeconfig: %v", err)
+	}
+
+	if target.Server != "https://123.us-east.linodelke.net:443" || target.Token != "secret-token" || string(target.CAData) != ca {
+		t.Errorf("target = %+v", target)
+	}
+}
+
+func TestParseKubeconfigErrors(t *testing.T) {
+	t.Parallel()
+
+	valid := strings.Replace(testKubeconfig, "%CA%", "", 1)
+
+	tests := []struct {
+		name string
+		raw  string
+		want error
+	}{
+		{name: "not yaml", raw: "clusters: [", want: kubeprobe.ErrInvalidKubeconfig},
+		{name: "unknown context", raw: strings.Replace(valid, "current-context: lke123-ctx", "current-context: other", 1), want: kubeprobe.ErrNoContext},
+		{name: "no server", raw: strings.Replace(valid, "server: https://123.us-east.linodelke.net:443/", "server: \"\"", 1), want: kubeprobe.ErrNoServer},
+		{name: "no token", raw: strings.Replace(valid, "token: secret-token", "token: \"\"", 1), want: kubeprobe.ErrNoToken},
+		{name: "bad ca", raw: strings.Replace(testKubeconfig, "%CA%", "not*base64", 1), want: kubeprobe.ErrInvalidKubeconfig},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := kubeprobe.ParseKubeconfig([]byte(tt.raw)); !errors.Is(err, tt.want) {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPingServing(t *testing.T) {
+	t.Parallel()
+
+	result, err := kubeprobe.Ping(t.Context(), apiServer(t, http.StatusOK))
+	if err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+
+	if !result.Serving() || result.Version != "v1.31.1" {
+		t.Fatalf("result = %+v, want serving v1.31.1", result)
+	}
+
+	if len(result.Nodes) != 2 || !result.Nodes[0].Ready || result.Nodes[1].Ready {
+		t.Errorf("nodes = %+v, want lke123-1 ready and lke123-2 not", result.Nodes)
+	}
+}
+
+func TestPingRecordsFailedCheck(t *testing.T) {
+	t.Parallel()
+
+	result, err := kubeprobe.Ping(t.Context(), apiServer(t, http.StatusForbidden))
+	if err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+
+	failed, ok := result.FirstFailure()
+	if result.Serving() || !ok || failed.Name != kubeprobe.CheckNodes {
+		t.Fatalf("result = %+v, want the nodes check failed", result)
+	}
+
+	if failed.StatusCode != http.StatusForbidden || failed.Err != "HTTP 403: nodes is forbidden" {
+		t.Errorf("failed check = %+v", failed)
+	}
+
+	if result.Version != "v1.31.1" {
+		t.Errorf("version = %q, want the version check still recorded", result.Version)
+	}
+}
+
+func TestPingRejectsUntrustedServer(t *testing.T) {
+	t.Parallel()
+
+	target := apiServer(t, http.StatusOK)
+	target.CAData = nil
+
+	result, err := kubeprobe.Ping(t.Context(), target)
+	if err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+
+	if result.Serving() || result.Checks[0].StatusCode != 0 || result.Checks[0].Err == "" {
+		t.Errorf("result = %+v, want a TLS failure without a response", result)
+	}
+}
+
+func TestPingInvalidCA(t *testing.T) {
+	t.Parallel()
+
+	_, err := kubeprobe.Ping(t.Context(), kubeprobe.Target{Server: "https://example.test", CAData: []byte("junk"), Token: "t"})
+	if !errors.Is(err, kubeprobe.ErrInvalidCA) {
+		t.Errorf("error = %v, want ErrInvalidCA", err)
+	}
+}
@@ -0,0 +1,101 @@
+package linode
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultAPIErrorLogSize is how many failed requests an APIErrorLog keeps.
+const DefaultAPIErrorLogSize = 200
+
+// APIErrorRecord is one Linode API request that failed in a way an outage
+// would explain: no response at all (Status 0), a 429, or a 5xx. Client
+// errors such as a 404 or a validation 400 are the caller's and are not
+// recorded.
+type APIErrorRecord struct {
+	At       time.Time
+	Method   string
+	Endpoint string
+	Status   int
+}
+
+// APIErrorLog keeps the most recent failed API requests across every call
+// the server handles, so linode_api_status can line them up with the
+// incidents on Linode's status page. It lives in process memory only and
+// drops the oldest record once full.
+type APIErrorLog struct {
+	records []APIErrorRecord
+	size    int
+	mu      sync.Mutex
+}
+
+// NewAPIErrorLog returns an empty log holding at most size records; a
+// non-positive size uses DefaultAPIErrorLogSize.
+func NewAPIErrorLog(size int) *APIErrorLog {
+	if size <= 0 {
+		size = DefaultAPIErrorLogSize
+	}
+
+	return &APIErrorLog{size: size}
+}
+
+// Add records one failed request.
+func (l *APIErrorLog) Add(record APIErrorRecord) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.records) == l.size {
+		l.records = l.records[1:]
+	}
+
+	l.records = append(l.records, record)
+}
+
+// Since returns the records at or after since, oldest first.
+func (l *APIErrorLog) Since(since time.Time) []APIErrorRecord {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	out := make([]APIErrorRecord, 0, len(l.records))
+
+	for _, record := range l.records {
+		if !record.At.Before(since) {
+			out = append(out, record)
+		}
+	}
+
+	return out
+}
+
+type apiErrorLogKey struct{}
+
+// WithAPIErrorLog returns a context carrying the log the client adds failed
+// requests to. The server middleware sets it on every call.
+func WithAPIErrorLog(ctx context.Context, log *APIErrorLog) context.Context {
+	return context.WithValue(ctx, apiErrorLogKey{}, log)
+}
+
+// APIErrorLogFromContext returns the log set by WithAPIErrorLog, or nil.
+func APIErrorLogFromContext(ctx context.Context) *APIErrorLog {
+	log, _ := ctx.Value(apiErrorLogKey{}).(*APIErrorLog)
+
+	return log
+}
+
+// recordAPIError adds a finished request to the context's log when its
+// status is one an outage would explain. A request the caller canceled is
+// not the API's failure and is skipped.
+func recordAPIError(ctx context.Context, method, endpoint string, status int) {
+	log := APIErrorLogFromContext(ctx)
+	if log == nil || ctx.Err() != nil {
+		return
+	}
+
+	if status != 0 && status != http.StatusTooManyRequests && status < http.StatusInternalServerError {
+		return
+	}
+
+	log.Add(APIErrorRecord{At: time.Now().UTC(), Method: method, Endpoint: endpoint, Status: status})
+}
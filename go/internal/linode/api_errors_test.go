@@ -0,0 +1,67 @@
+package linode_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// TestAPIErrorLogRecordsOutageStatuses verifies only failures an outage
+// would explain are logged: a 503 and a refused connection are, a 404 is
+// not.
+func TestAPIErrorLogRecordsOutageStatuses(t *testing.T) {
+	t.Parallel()
+
+	var status atomic.Int32
+
+	status.Store(http.StatusNotFound)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer srv.Close()
+
+	log := linode.NewAPIErrorLog(0)
+	ctx := linode.WithAPIErrorLog(t.Context(), log)
+	up := linode.NewClient(srv.URL, "my-token", nil, linode.WithMaxRetries(0))
+
+	_, _ = up.GetProfile(ctx)
+
+	if got := log.Since(time.Time{}); len(got) != 0 {
+		t.Fatalf("records after a 404 = %+v, want none", got)
+	}
+
+	status.Store(http.StatusServiceUnavailable)
+	_, _ = up.GetProfile(ctx)
+
+	down := linode.NewClient("http://127.0.0.1:1", "my-token", nil, linode.WithMaxRetries(0))
+	_, _ = down.GetProfile(ctx)
+
+	got := log.Since(time.Time{})
+	if len(got) != 2 || got[0].Status != http.StatusServiceUnavailable || got[1].Status != 0 {
+		t.Fatalf("records = %+v, want a 503 then a status 0", got)
+	}
+
+	if got[0].Method != http.MethodGet || got[0].Endpoint != "/profile" {
+		t.Errorf("record = %+v, want GET /profile", got[0])
+	}
+}
+
+// TestAPIErrorLogDropsOldest verifies a full log drops its oldest record.
+func TestAPIErrorLogDropsOldest(t *testing.T) {
+	t.Parallel()
+
+	log := linode.NewAPIErrorLog(2)
+	for _, status := range []int{500, 502, 503} {
+		log.Add(linode.APIErrorRecord{At: time.Now(), Status: status})
+	}
+
+	got := log.Since(time.Time{})
+	if len(got) != 2 || got[0].Status != 502 || got[1].Status != 503 {
+		t.Errorf("records = %+v, want 502 and 503", got)
+	}
+}
@@ -0,0 +1,126 @@
+package linode
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+// EnvironmentOptions returns the client options an environment's config calls
+// for: its extra headers, plus the transport of a backend other than the API.
+func EnvironmentOptions(env config.LinodeConfig) []Option {
+	opts := []Option{WithHeaders(env.Headers)}
+
+	if env.Backend == config.BackendFixtures {
+		opts = append(opts, WithTransport(fixtureTransport{dir: env.FixturesDir, prefix: fixturePrefix(env.APIURL)}))
+	}
+
+	return opts
+}
+
+// fixtureTransport answers API requests from recorded JSON files under dir,
+// one per endpoint below the API version: GET /v4/linode/instances/123 reads
+// linode/instances/123.json, and any other method reads the file with the
+// method added, such as linode/instances/123/reboot.post.json. Query strings
+// are ignored except for a GET's page past the first, which reads the file
+// with the page added, such as linode/instances_page2.json, so a page walk
+// over a recorded list ends where the recording does. A request with no
+// recorded file gets the API's 404 error envelope naming the file it looked
+// for.
+type fixtureTransport struct {
+	dir string
+	// prefix is the API URL's path ahead of its version segment, such as
+	// /proxy for https://gateway.internal/proxy/v4.
+	prefix string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (f fixtureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		if err := req.Body.Close(); err != nil {
+			return nil, fmt.Errorf("fixtures backend: close request body: %w", err)
+		}
+	}
+
+	name := fixtureName(req.Method, f.endpoint(req.URL.Path), req.URL.Query().Get("page"))
+
+	root, err := os.OpenRoot(f.dir)
+	if err != nil {
+		return nil, fmt.Errorf("fixtures backend: %w", err)
+	}
+	defer func() { _ = root.Close() }()
+
+	body, err := root.ReadFile(name)
+
+	switch {
+	case err == nil:
+		return fixtureResponse(req, http.StatusOK, body), nil
+	case errors.Is(err, fs.ErrNotExist):
+		missing, marshalErr := json.Marshal(map[string]any{
+			"errors": []map[string]string{{"reason": "no fixture recorded at " + name}},
+		})
+		if marshalErr != nil {
+			return nil, fmt.Errorf("fixtures backend: %w", marshalErr)
+		}
+
+		return fixtureResponse(req, http.StatusNotFound, missing), nil
+	default:
+		return nil, fmt.Errorf("fixtures backend: read %s: %w", name, err)
+	}
+}
+
+// endpoint strips the API URL's path and version segment from urlPath,
+// leaving the endpoint the client asked for.
+func (f fixtureTransport) endpoint(urlPath string) string {
+	_, rest, _ := strings.Cut(strings.TrimPrefix(strings.TrimPrefix(urlPath, f.prefix), "/"), "/")
+
+	return rest
+}
+
+// fixturePrefix returns apiURL's path ahead of its version segment.
+func fixturePrefix(apiURL string) string {
+	parsed, err := url.Parse(apiURL)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSuffix(path.Dir(strings.TrimRight(parsed.Path, "/")), "/")
+}
+
+// fixtureName maps a request to its fixture file, relative to the fixtures
+// directory. The path is cleaned first so it cannot climb out of it.
+func fixtureName(method, urlPath, page string) string {
+	name := strings.TrimPrefix(path.Clean("/"+urlPath), "/")
+	if method != http.MethodGet {
+		name += "." + strings.ToLower(method)
+	} else if n, err := strconv.Atoi(page); err == nil && n > 1 {
+		name += "_page" + strconv.Itoa(n)
+	}
+
+	return name + ".json"
+}
+
+func fixtureResponse(req *http.Request, status int, body []byte) *http.Response {
+	return &http.Response{
+		Status:        strconv.Itoa(status) + " " + http.StatusText(status),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{contentTypeJSON}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
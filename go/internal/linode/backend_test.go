@@ -0,0 +1,117 @@
+package linode_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// newFixturesClient returns a client for an environment on the fixtures
+// backend, reading the given files from a fresh directory.
+func newFixturesClient(t *testing.T, apiURL string, files map[string]string) *linode.Client {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, body := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	env := config.LinodeConfig{
+		APIURL:      apiURL,
+		Token:       "fixtures",
+		Backend:     config.BackendFixtures,
+		FixturesDir: dir,
+	}
+
+	return linode.NewClient(env.BaseURL(), env.Token, nil,
+		append(linode.EnvironmentOptions(env), linode.WithMaxRetries(0))...)
+}
+
+// TestFixturesBackendServesRecordedFiles verifies the fixtures backend reads
+// each endpoint's file relative to the API version, whatever path the API
+// URL carries ahead of it.
+func TestFixturesBackendServesRecordedFiles(t *testing.T) {
+	t.Parallel()
+
+	for _, apiURL := range []string{"https://api.linode.com/v4", "https://gateway.internal/proxy/v4beta"} {
+		t.Run(apiURL, func(t *testing.T) {
+			t.Parallel()
+
+			client := newFixturesClient(t, apiURL, map[string]string{
+				"volumes/7.json": `{"id":7,"label":"recorded"}`,
+			})
+
+			volume, err := client.GetVolume(t.Context(), 7)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if volume.Label != "recorded" {
+				t.Errorf("volume.Label = %q, want %q", volume.Label, "recorded")
+			}
+		})
+	}
+}
+
+// TestFixturesBackendMissingFile verifies a request with no recorded file
+// fails with the API's not-found error naming the file it looked for.
+func TestFixturesBackendMissingFile(t *testing.T) {
+	t.Parallel()
+
+	client := newFixturesClient(t, "https://api.linode.com/v4", nil)
+
+	_, err := client.GetVolume(t.Context(), 8)
+	if err == nil {
+		t.Fatal("want an error for an endpoint with no recorded file")
+	}
+
+	if !strings.Contains(err.Error(), "volumes/8.json") {
+		t.Errorf("err = %v, want it to name volumes/8.json", err)
+	}
+}
+
+// TestFixturesBackendWriteReadsMethodFile verifies a non-GET request reads
+// the file named for its method.
+func TestFixturesBackendWriteReadsMethodFile(t *testing.T) {
+	t.Parallel()
+
+	client := newFixturesClient(t, "https://api.linode.com/v4", map[string]string{
+		"volumes/7.delete.json": `{}`,
+	})
+
+	if err := client.DeleteVolume(t.Context(), 7); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// TestFixturesBackendReadsPageFiles verifies a page walk reads each later
+// page from its own file instead of replaying the first.
+func TestFixturesBackendReadsPageFiles(t *testing.T) {
+	t.Parallel()
+
+	client := newFixturesClient(t, "https://api.linode.com/v4", map[string]string{
+		"tags/prod.json":       `{"data":[{"type":"linode","data":{"id":1}}],"page":1,"pages":2,"results":2}`,
+		"tags/prod_page2.json": `{"data":[{"type":"volume","data":{"id":2}}],"page":2,"pages":2,"results":2}`,
+	})
+
+	objects, err := client.ListTaggedObjects(t.Context(), "prod", 0, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(objects.Data) != 2 || objects.Data[1]["type"] != "volume" {
+		t.Errorf("objects = %+v, want the linode then the volume", objects.Data)
+	}
+}
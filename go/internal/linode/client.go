@@ -11,6 +11,7 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strconv"
 	"time"
 
@@ -22,7 +23,6 @@ import (
 )
 
 const (
-	defaultTimeout     = 30 * time.Second
 	defaultIdleTimeout = 30 * time.Second
 	maxIdleConns       = 10
 	httpBadRequest     = 400
@@ -35,12 +35,17 @@ const (
 	contentTypeJSON    = "application/json"
 	contentTypePNG     = "image/png"
 
-	// requestTimeout is the per-request context timeout for API calls.
+	// requestTimeout is the per-request context timeout for API calls. A
+	// tool call with its own timeout replaces it (see WithRequestTimeout), so
+	// the HTTP client sets no timeout of its own.
 	requestTimeout = 30 * time.Second
+
+	// probeTimeout bounds the startup reachability check of one API URL.
+	probeTimeout = 5 * time.Second
 )
 
 // Option configures a Client.
-type Option func(*retryConfig)
+type Option func(*Client)
 
 // Client is the Linode API client with built-in retry logic, a token-bucket
 // rate limiter, and a circuit breaker that trips after sustained upstream
@@ -52,33 +57,60 @@ type Client struct {
 	retryCfg   retryConfig
 	circuit    *CircuitBreaker
 	limiter    *RateLimiter
+	driftMode  string
+	headers    map[string]string
 }
 
 // WithMaxRetries sets the maximum number of retry attempts.
 func WithMaxRetries(n int) Option {
-	return func(rc *retryConfig) { rc.MaxRetries = n }
+	return func(c *Client) { c.retryCfg.MaxRetries = n }
 }
 
 // WithBaseDelay sets the initial delay between retries.
 func WithBaseDelay(d time.Duration) Option {
-	return func(rc *retryConfig) { rc.BaseDelay = d }
+	return func(c *Client) { c.retryCfg.BaseDelay = d }
 }
 
 // WithMaxDelay sets the upper bound on retry delay.
 func WithMaxDelay(d time.Duration) Option {
-	return func(rc *retryConfig) { rc.MaxDelay = d }
+	return func(c *Client) { c.retryCfg.MaxDelay = d }
 }
 
 // WithBackoffFactor sets the exponential backoff multiplier.
 func WithBackoffFactor(f float64) Option {
-	return func(rc *retryConfig) { rc.BackoffFactor = f }
+	return func(c *Client) { c.retryCfg.BackoffFactor = f }
+}
+
+// WithMaxElapsed bounds how long one call keeps retrying, waits included.
+// Zero removes the bound.
+func WithMaxElapsed(d time.Duration) Option {
+	return func(c *Client) { c.retryCfg.MaxElapsed = d }
 }
 
 // WithJitter enables or disables jitter on retry delays.
 func WithJitter(enabled bool) Option {
-	return func(rc *retryConfig) { rc.JitterEnabled = enabled }
+	return func(c *Client) { c.retryCfg.JitterEnabled = enabled }
+}
+
+// WithHeaders attaches extra headers to every API request, such as the auth
+// or tracing headers a gateway in front of the API expects. They never
+// replace the Authorization, Content-Type, or User-Agent the client sets.
+func WithHeaders(headers map[string]string) Option {
+	return func(c *Client) { c.headers = headers }
+}
+
+// WithTransport serves every request through rt instead of the network, for
+// a recorded or in-memory backend such as linodefake.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) { c.httpClient.Transport = rt }
 }
 
+// ClientFactory builds the client a caller talks to the API through.
+// NewClient is the factory production uses; a test or an embedding program
+// hands the server one that serves the API from elsewhere, such as
+// linodefake.
+type ClientFactory func(apiURL, token string, cfg *config.Config, opts ...Option) *Client
+
 // NewClient creates a Linode API client.
 // Retry settings layer: hardcoded defaults, then cfg.Resilience values
 // (if cfg is non-nil), then caller-supplied options.
@@ -89,6 +121,7 @@ func NewClient(apiURL, token string, cfg *config.Config, opts ...Option) *Client
 		cbThreshold int
 		cbTimeout   time.Duration
 		rateLimit   int
+		driftMode   = config.SchemaDriftLenient
 	)
 
 	if cfg != nil {
@@ -104,30 +137,74 @@ func NewClient(apiURL, token string, cfg *config.Config, opts ...Option) *Client
 			retryCfg.MaxDelay = cfg.Resilience.MaxRetryDelay
 		}
 
+		if cfg.Resilience.MaxRetryElapsed > 0 {
+			retryCfg.MaxElapsed = cfg.Resilience.MaxRetryElapsed
+		}
+
 		cbThreshold = cfg.Resilience.CircuitBreakerThreshold
 		cbTimeout = cfg.Resilience.CircuitBreakerTimeout
 		rateLimit = cfg.Resilience.RateLimitPerMinute
-	}
 
-	for _, opt := range opts {
-		opt(&retryCfg)
+		if cfg.SchemaDrift.Mode != "" {
+			driftMode = cfg.SchemaDrift.Mode
+		}
 	}
 
-	return &Client{
+	client := &Client{
 		httpClient: &http.Client{
-			Timeout: defaultTimeout,
 			Transport: &http.Transport{
 				MaxIdleConns:        maxIdleConns,
 				MaxIdleConnsPerHost: maxIdleConns,
 				IdleConnTimeout:     defaultIdleTimeout,
 			},
 		},
-		baseURL:  apiURL,
-		token:    token,
-		retryCfg: retryCfg,
-		circuit:  NewCircuitBreaker(cbThreshold, cbTimeout),
-		limiter:  NewRateLimiter(rateLimit),
+		baseURL:   apiURL,
+		token:     token,
+		retryCfg:  retryCfg,
+		circuit:   NewCircuitBreaker(cbThreshold, cbTimeout),
+		limiter:   NewRateLimiter(rateLimit),
+		driftMode: driftMode,
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client
+}
+
+// ProbeAPIURL checks that a Linode API base URL answers at its version path
+// by requesting the public GET /regions without credentials. Any response
+// other than 404 proves both the host and the version path; a 404 means the
+// host is up but the version segment is wrong. headers are the environment's
+// extra headers, so a gateway in front of the API lets the probe through.
+func ProbeAPIURL(ctx context.Context, baseURL string, headers map[string]string) error {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+endpointRegions, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrAPIUnreachable, err)
+	}
+
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	req.Header.Set("User-Agent", "LinodeMCP/"+appinfo.Version)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrAPIUnreachable, err)
 	}
+
+	defer drainClose(resp)
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: GET %s returned 404", ErrAPIVersionNotFound, baseURL+endpointRegions)
+	}
+
+	return nil
 }
 
 // makeRequest builds and executes an authenticated HTTP request against the Linode API.
@@ -155,6 +232,15 @@ func (c *Client) makeRequestWithContentType(ctx context.Context, method, endpoin
 		return nil, err
 	}
 
+	var recordedBody []byte
+
+	if _, logging := ctx.Value(writeLogKey{}).(*writeLog); logging && method != http.MethodGet {
+		var err error
+		if recordedBody, body, err = captureRequestBody(body); err != nil {
+			return nil, err
+		}
+	}
+
 	rawURL := c.baseURL + endpoint
 
 	parsedURL, err := url.Parse(rawURL)
@@ -167,28 +253,55 @@ func (c *Client) makeRequestWithContentType(ctx context.Context, method, endpoin
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
+	for name, value := range c.headers {
+		req.Header.Set(name, value)
+	}
+
 	req.Header.Set("Authorization", authHeaderPrefix+c.token)
 	req.Header.Set("Content-Type", contentType)
-	req.Header.Set("User-Agent", "LinodeMCP/"+appinfo.Version)
+	req.Header.Set("User-Agent", userAgent(ctx))
+
+	correlationID := CorrelationIDFromContext(ctx)
+	if correlationID != "" {
+		req.Header.Set(CorrelationIDHeader, correlationID)
+	}
+
+	filter, err := listFilterHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter != "" {
+		req.Header.Set(XFilterHeader, filter)
+	}
 
 	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 
-	if recorder := apiRecorderFromContext(ctx); recorder != nil {
-		var status int
-		if resp != nil {
-			status = resp.StatusCode
-		}
+	var status int
+	if resp != nil {
+		status = resp.StatusCode
+	}
 
+	if recorder := apiRecorderFromContext(ctx); recorder != nil {
 		recorder.RecordAPIRequest(ctx, metricsEndpoint(endpoint), method, status, time.Since(start).Seconds())
 	}
 
+	recordAPIError(ctx, method, metricsEndpoint(endpoint), status)
+
+	slog.DebugContext(ctx, "linode api request", "method", method, "endpoint", metricsEndpoint(endpoint),
+		"status", status, "correlation_id", correlationID)
+
+	recordReachability(ctx, err)
+
 	if err != nil {
 		// Carry the method so the retry layer can tell whether replaying this
 		// failed request is safe (idempotent) or risks a duplicate side effect.
 		return nil, &requestError{Method: method, Err: err}
 	}
 
+	recordWrite(ctx, method, endpoint, recordedBody, resp)
+
 	return resp, nil
 }
 
@@ -222,18 +335,30 @@ func (c *Client) handleResponse(resp *http.Response, target any) error {
 	}
 
 	if target != nil {
-		if err := json.Unmarshal(body, target); err != nil {
-			return fmt.Errorf("failed to unmarshal response: %w", err)
-		}
+		return c.decodeJSON(resp, body, target)
 	}
 
 	return nil
 }
 
+// decodeJSON unmarshals a successful response body into target, honoring the
+// configured schema drift mode: after the plain json.Unmarshal, jsonDrift
+// reports a field the target does not capture.
+func (c *Client) decodeJSON(resp *http.Response, body []byte, target any) error {
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	_, err := c.jsonDrift(resp, body, target)
+
+	return err
+}
+
 // handleProtoResponse reads the body and decodes it into a proto message with
 // protojson, discarding fields the message does not model (the Linode API may
-// return more fields than a message declares). It mirrors handleResponse's read
-// and error handling for the proto-backed read path.
+// return more fields than a message declares) unless the schema drift mode
+// says otherwise. It mirrors handleResponse's read and error handling for the
+// proto-backed read path.
 func (c *Client) handleProtoResponse(resp *http.Response, msg proto.Message) error {
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -253,9 +378,87 @@ func (c *Client) handleProtoResponse(resp *http.Response, msg proto.Message) err
 		return fmt.Errorf("failed to unmarshal proto response: %w", err)
 	}
 
+	_, err = c.protoDrift(resp, body, msg)
+
+	return err
+}
+
+// jsonDrift reports whether body carries a field target does not capture.
+// target must already hold the lenient decode of body. Warn and strict modes
+// re-decode body with DisallowUnknownFields into a scratch value of target's
+// type; since the lenient decode accepted the same body, a strict failure can
+// only be an undeclared field. Lenient mode never checks.
+func (c *Client) jsonDrift(resp *http.Response, body []byte, target any) (bool, error) {
+	if !c.checksDrift() {
+		return false, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+
+	err := dec.Decode(reflect.New(reflect.TypeOf(target).Elem()).Interface())
+	if err == nil {
+		return false, nil
+	}
+
+	return true, c.reportSchemaDrift(resp, err)
+}
+
+// protoDrift is jsonDrift for a proto message: msg must already hold the
+// DiscardUnknown decode of body, and the strict protojson decode runs into a
+// fresh message of the same type.
+func (c *Client) protoDrift(resp *http.Response, body []byte, msg proto.Message) (bool, error) {
+	if !c.checksDrift() {
+		return false, nil
+	}
+
+	err := protojson.Unmarshal(body, msg.ProtoReflect().New().Interface())
+	if err == nil {
+		return false, nil
+	}
+
+	return true, c.reportSchemaDrift(resp, err)
+}
+
+// checksDrift reports whether the schema drift mode looks for undeclared
+// response fields at all.
+func (c *Client) checksDrift() bool {
+	return c.driftMode == config.SchemaDriftWarn || c.driftMode == config.SchemaDriftStrict
+}
+
+// reportSchemaDrift handles an undeclared response field: strict fails the
+// call with ErrSchemaDrift, warn logs it and keeps the lenient decode.
+func (c *Client) reportSchemaDrift(resp *http.Response, err error) error {
+	if c.driftMode == config.SchemaDriftStrict {
+		return fmt.Errorf("%w: %s: %w", ErrSchemaDrift, responseEndpoint(resp), err)
+	}
+
+	slog.Warn("linode response has fields the model does not capture",
+		"endpoint", responseEndpoint(resp), "correlation_id", responseCorrelationID(resp), "error", err)
+
 	return nil
 }
 
+// responseEndpoint returns the request path of resp for drift log lines and
+// errors, or "unknown" when the response carries no request.
+func responseEndpoint(resp *http.Response) string {
+	if resp.Request == nil || resp.Request.URL == nil {
+		return "unknown"
+	}
+
+	return resp.Request.URL.Path
+}
+
+// responseCorrelationID returns the correlation ID the request behind resp
+// carried, or "" when there is none.
+func responseCorrelationID(resp *http.Response) string {
+	if resp.Request == nil {
+		return ""
+	}
+
+	return resp.Request.Header.Get(CorrelationIDHeader)
+}
+
 func (*Client) handleErrorResponse(statusCode int, body []byte, resp *http.Response) error {
 	var apiError struct {
 		Errors []struct {
@@ -1387,6 +1387,44 @@ func TestNewClientOptionsOverrideConfig(t *testing.T) {
 	}
 }
 
+// TestNewClientMaxRetryElapsedStopsRetries verifies that retries stop once
+// the next wait would carry the call past config.Resilience.MaxRetryElapsed,
+// even with attempts left.
+func TestNewClientMaxRetryElapsedStopsRetries(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	// Waits of 40ms then 80ms: the second would end past the 100ms budget.
+	cfg := &config.Config{
+		Resilience: config.ResilienceConfig{
+			MaxRetries:      5,
+			BaseRetryDelay:  40 * time.Millisecond,
+			MaxRetryDelay:   time.Second,
+			MaxRetryElapsed: 100 * time.Millisecond,
+		},
+	}
+
+	client := linode.NewClient(srv.URL, "token", cfg, linode.WithJitter(false))
+
+	_, err := client.GetProfile(t.Context())
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("attempts = %v, want %v", got, 2)
+	}
+}
+
 // TestNewClientNilConfigUsesDefaults verifies that passing nil config
 // uses hardcoded defaults (3 retries).
 func TestNewClientNilConfigUsesDefaults(t *testing.T) {
@@ -0,0 +1,45 @@
+package linode
+
+import (
+	"context"
+
+	"github.com/chadit/LinodeMCP/go/internal/appinfo"
+)
+
+// CorrelationIDHeader carries the tool call's correlation ID on every Linode
+// API request it makes, so a failing call can be matched to the audit entry,
+// the server logs, and the tool result during a support investigation.
+const CorrelationIDHeader = "X-LinodeMCP-Correlation-ID"
+
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a context carrying the correlation ID the client
+// sends with each API request. The server sets it once per tool dispatch. An
+// empty id is ignored.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationIDFromContext returns the ID set by WithCorrelationID, or ""
+// when the client is used outside the server dispatch path.
+func CorrelationIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+
+	return id
+}
+
+// userAgent is the User-Agent for a request made under ctx. A correlation ID
+// rides along as a second product token, so it reaches Linode's own request
+// logs even where custom headers are dropped.
+func userAgent(ctx context.Context) string {
+	agent := "LinodeMCP/" + appinfo.Version
+	if id := CorrelationIDFromContext(ctx); id != "" {
+		agent += " correlation/" + id
+	}
+
+	return agent
+}
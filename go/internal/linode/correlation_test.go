@@ -0,0 +1,51 @@
+package linode_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// TestClientSendsCorrelationID verifies a correlation ID on the context goes
+// out as its own header and as a User-Agent product token, and that a
+// request without one sends neither.
+func TestClientSendsCorrelationID(t *testing.T) {
+	t.Parallel()
+
+	var header, userAgent string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get(linode.CorrelationIDHeader)
+		userAgent = r.Header.Get("User-Agent")
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"username":"alice"}`))
+	}))
+	defer srv.Close()
+
+	client := linode.NewClient(srv.URL, "my-token", nil, linode.WithMaxRetries(0))
+
+	ctx := linode.WithCorrelationID(t.Context(), "evt_01HQXY3ZKQ8M7VRBNP4W5T2J9F")
+	if _, err := client.GetProfile(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if header != "evt_01HQXY3ZKQ8M7VRBNP4W5T2J9F" {
+		t.Errorf("%s = %q, want the context's ID", linode.CorrelationIDHeader, header)
+	}
+
+	if !strings.HasPrefix(userAgent, "LinodeMCP/") || !strings.HasSuffix(userAgent, " correlation/evt_01HQXY3ZKQ8M7VRBNP4W5T2J9F") {
+		t.Errorf("User-Agent = %q, want LinodeMCP/<version> correlation/<id>", userAgent)
+	}
+
+	if _, err := client.GetProfile(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if header != "" || strings.Contains(userAgent, "correlation/") {
+		t.Errorf("header = %q, User-Agent = %q, want no correlation ID without one on the context", header, userAgent)
+	}
+}
@@ -0,0 +1,41 @@
+package linode_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+func TestClientCountResultsReadsTotal(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/volumes" {
+			t.Errorf("r.URL.Path = %v, want %v", r.URL.Path, "/volumes")
+		}
+
+		if r.URL.RawQuery != "page=1&page_size=25" {
+			t.Errorf("r.URL.RawQuery = %v, want %v", r.URL.RawQuery, "page=1&page_size=25")
+		}
+
+		w.Header().Set("Content-Type", tcApplicationJSON)
+
+		if _, err := w.Write([]byte(`{"data":[{"id":1}],"page":1,"pages":3,"results":57}`)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	client := linode.NewClient(srv.URL, "my-token", nil, linode.WithMaxRetries(0))
+
+	count, err := client.CountResults(t.Context(), "/volumes")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if count != 57 {
+		t.Errorf("count = %d, want 57", count)
+	}
+}
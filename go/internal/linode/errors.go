@@ -24,11 +24,24 @@ var ErrFirewallHistoryNotObject = errors.New("firewall history response is not a
 // from "we tried and the upstream failed".
 var ErrCircuitOpen = errors.New("circuit breaker open")
 
+// ErrSchemaDrift is returned in strict schema drift mode when a response
+// carries a field the client model does not declare. The wrapped error names
+// the endpoint and the offending field.
+var ErrSchemaDrift = errors.New("response schema drift")
+
 // ErrRateLimitWaitCanceled is returned when a caller's context is canceled
 // while a goroutine is blocked waiting for a token. The breaker shouldn't
 // count this; it's a caller-side decision, not an upstream-health signal.
 var ErrRateLimitWaitCanceled = errors.New("rate limit wait canceled")
 
+// ErrAPIUnreachable is returned by ProbeAPIURL when the API host does not
+// answer at all (DNS failure, refused connection, timeout).
+var ErrAPIUnreachable = errors.New("linode API URL is unreachable")
+
+// ErrAPIVersionNotFound is returned by ProbeAPIURL when the host answers but
+// the URL's version path does not exist.
+var ErrAPIVersionNotFound = errors.New("linode API version path not found")
+
 // ErrUpdateImageRequestRequired is returned when UpdateImage is called without a request body.
 var ErrUpdateImageRequestRequired = errors.New("update image request is required")
 
@@ -116,6 +129,9 @@ var ErrUpdateNodeBalancerNodeRequestRequired = errors.New("update nodebalancer n
 // ErrUpdateConfigRequestRequired is returned when UpdateInstanceConfig is called without a request body.
 var ErrUpdateConfigRequestRequired = errors.New("update config request is required")
 
+// ErrCreateDatabaseBackupRequestRequired is returned when CreateDatabaseBackup is called without a request body.
+var ErrCreateDatabaseBackupRequestRequired = errors.New("create database backup request is required")
+
 // ErrAddConfigInterfaceRequestRequired is returned when AddInstanceConfigInterface is called without a request body.
 var ErrAddConfigInterfaceRequestRequired = errors.New("add config interface request is required")
 
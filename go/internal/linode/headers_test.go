@@ -0,0 +1,40 @@
+package linode_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// TestClientSendsConfiguredHeaders verifies WithHeaders attaches the extra
+// headers to API requests alongside the client's own Authorization header.
+func TestClientSendsConfiguredHeaders(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Gateway-Key"); got != "gw-secret" {
+			t.Errorf("X-Gateway-Key = %q, want %q", got, "gw-secret")
+		}
+
+		if got := r.Header.Get("Traceparent"); got != "00-abc-def-01" {
+			t.Errorf("Traceparent = %q, want %q", got, "00-abc-def-01")
+		}
+
+		if got := r.Header.Get("Authorization"); got != "Bearer my-token" {
+			t.Errorf("Authorization = %q, want %q", got, "Bearer my-token")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"username":"alice"}`))
+	}))
+	defer srv.Close()
+
+	client := linode.NewClient(srv.URL, "my-token", nil, linode.WithMaxRetries(0),
+		linode.WithHeaders(map[string]string{"X-Gateway-Key": "gw-secret", "traceparent": "00-abc-def-01"}))
+
+	if _, err := client.GetProfile(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
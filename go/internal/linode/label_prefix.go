@@ -0,0 +1,70 @@
+package linode
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+type labelPrefixKey struct{}
+
+// WithLabelPrefix returns a context on which every paginated list the client
+// walks keeps only the items whose label starts with prefix. The items are
+// dropped as each page arrives, before the max_results cap counts them, so a
+// capped list holds the first in-prefix items rather than whatever the cap
+// left behind. An empty prefix leaves ctx unchanged.
+func WithLabelPrefix(ctx context.Context, prefix string) context.Context {
+	if prefix == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, labelPrefixKey{}, prefix)
+}
+
+// labelPrefix returns ctx's WithLabelPrefix prefix, or "" when it has none.
+func labelPrefix(ctx context.Context) string {
+	prefix, _ := ctx.Value(labelPrefixKey{}).(string)
+
+	return prefix
+}
+
+// filterLabelPrefix drops the data[] items of a list page whose label does
+// not start with ctx's WithLabelPrefix prefix and returns how many it
+// dropped. A page whose items do not all carry a string label lists
+// something that is not a labeled resource and is left whole.
+func filterLabelPrefix(ctx context.Context, envelope map[string]json.RawMessage) int {
+	prefix := labelPrefix(ctx)
+	if prefix == "" {
+		return 0
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(envelope["data"], &items); err != nil || len(items) == 0 {
+		return 0
+	}
+
+	kept := make([]json.RawMessage, 0, len(items))
+
+	for _, item := range items {
+		var probe struct {
+			Label *string `json:"label"`
+		}
+
+		if err := json.Unmarshal(item, &probe); err != nil || probe.Label == nil {
+			return 0
+		}
+
+		if strings.HasPrefix(*probe.Label, prefix) {
+			kept = append(kept, item)
+		}
+	}
+
+	encoded, err := json.Marshal(kept)
+	if err != nil {
+		return 0
+	}
+
+	envelope["data"] = encoded
+
+	return len(items) - len(kept)
+}
@@ -0,0 +1,51 @@
+package linode_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+func TestListVolumesKeepsLabelPrefixBeforeMaxResults(t *testing.T) {
+	t.Parallel()
+
+	// Page 2 holds nothing in the prefix, so the walk must read past it.
+	pages := map[string]string{
+		"":  `{"id":1,"label":"other-1"},{"id":2,"label":"team-a-2"}`,
+		"2": `{"id":3,"label":"other-3"},{"id":4,"label":"other-4"}`,
+		"3": `{"id":5,"label":"team-a-5"},{"id":6,"label":"team-a-6"}`,
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", tcApplicationJSON)
+
+		body := fmt.Sprintf(`{"data":[%s],"page":1,"pages":3,"results":6}`, pages[r.URL.Query().Get("page")])
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	client := linode.NewClient(srv.URL, "my-token", nil, linode.WithMaxRetries(0))
+
+	ctx := linode.WithLabelPrefix(linode.WithMaxResults(t.Context(), 2), "team-a-")
+
+	volumes, err := client.ListVolumesProto(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(volumes) != 2 || volumes[0].GetId() != 2 || volumes[1].GetId() != 5 {
+		t.Fatalf("volumes = %v, want ids 2 and 5", volumes)
+	}
+
+	// The API's total counts the volumes outside the prefix, so none is
+	// reported.
+	total, truncated := linode.ListTruncated(ctx)
+	if !truncated || total != 0 {
+		t.Errorf("ListTruncated = (%d, %v), want (0, true)", total, truncated)
+	}
+}
@@ -0,0 +1,89 @@
+package linode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+)
+
+// XFilterHeader carries a list read's server-side filter and sort order.
+const XFilterHeader = "X-Filter"
+
+// ListOptions filters and sorts list reads on the API side. Filter is a Linode
+// filter expression such as {"region": "us-east"} or
+// {"+or": [{"label": "a"}, {"label": "b"}]}; OrderBy and Order become its
+// +order_by and +order keys.
+type ListOptions struct {
+	Filter  map[string]any
+	OrderBy string
+	Order   string
+}
+
+// IsZero reports whether o asks for no filtering or ordering.
+func (o ListOptions) IsZero() bool {
+	return len(o.Filter) == 0 && o.OrderBy == "" && o.Order == ""
+}
+
+// XFilter returns the X-Filter header value for o, or "" when o is zero.
+func (o ListOptions) XFilter() (string, error) {
+	if o.IsZero() {
+		return "", nil
+	}
+
+	filter := maps.Clone(o.Filter)
+	if filter == nil {
+		filter = map[string]any{}
+	}
+
+	if o.OrderBy != "" {
+		filter["+order_by"] = o.OrderBy
+	}
+
+	if o.Order != "" {
+		filter["+order"] = o.Order
+	}
+
+	encoded, err := json.Marshal(filter)
+	if err != nil {
+		return "", fmt.Errorf("encode %s: %w", XFilterHeader, err)
+	}
+
+	return string(encoded), nil
+}
+
+type listOptionsKey struct{}
+
+// listReadKey marks a context as one page request of a list walk, the only
+// requests that carry the X-Filter header.
+type listReadKey struct{}
+
+// WithListOptions returns a context on which every paginated list the client
+// reads sends opts as its X-Filter header. A zero opts leaves ctx unchanged.
+func WithListOptions(ctx context.Context, opts ListOptions) context.Context {
+	if opts.IsZero() {
+		return ctx
+	}
+
+	return context.WithValue(ctx, listOptionsKey{}, opts)
+}
+
+// withListRead marks ctx as a list page request.
+func withListRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, listReadKey{}, true)
+}
+
+// listFilterHeader returns the X-Filter value for a request made with ctx:
+// the WithListOptions filter on a list page request, and "" otherwise.
+func listFilterHeader(ctx context.Context) (string, error) {
+	if listRead, _ := ctx.Value(listReadKey{}).(bool); !listRead {
+		return "", nil
+	}
+
+	opts, ok := ctx.Value(listOptionsKey{}).(ListOptions)
+	if !ok {
+		return "", nil
+	}
+
+	return opts.XFilter()
+}
@@ -0,0 +1,108 @@
+package linode_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+func TestListOptionsXFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts linode.ListOptions
+		want string
+	}{
+		{name: "zero", opts: linode.ListOptions{}, want: ""},
+		{
+			name: "filter",
+			opts: linode.ListOptions{Filter: map[string]any{"region": "us-east"}},
+			want: `{"region":"us-east"}`,
+		},
+		{
+			name: "order only",
+			opts: linode.ListOptions{OrderBy: "label", Order: "desc"},
+			want: `{"+order":"desc","+order_by":"label"}`,
+		},
+		{
+			name: "filter and order",
+			opts: linode.ListOptions{
+				Filter:  map[string]any{"+or": []any{map[string]any{"label": "a"}, map[string]any{"label": "b"}}},
+				OrderBy: "created",
+			},
+			want: `{"+or":[{"label":"a"},{"label":"b"}],"+order_by":"created"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.opts.XFilter()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("XFilter() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListOptionsSentOnListReadsOnly(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		filters = map[string]string{}
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		filters[r.URL.Path] = r.Header.Get(linode.XFilterHeader)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", tcApplicationJSON)
+
+		body := `{"id":7,"label":"vol"}`
+		if r.URL.Path == "/volumes" {
+			body = `{"data":[{"id":7,"label":"vol"}],"page":1,"pages":1,"results":1}`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	client := linode.NewClient(srv.URL, "my-token", nil, linode.WithMaxRetries(0))
+
+	ctx := linode.WithListOptions(t.Context(), linode.ListOptions{
+		Filter:  map[string]any{"region": "us-east"},
+		OrderBy: "label",
+	})
+
+	if _, err := client.ListVolumesProto(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := client.GetVolumeProto(ctx, 7); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if got, want := filters["/volumes"], `{"+order_by":"label","region":"us-east"}`; got != want {
+		t.Errorf("list X-Filter = %q, want %q", got, want)
+	}
+
+	if got := filters["/volumes/7"]; got != "" {
+		t.Errorf("get X-Filter = %q, want none", got)
+	}
+}
@@ -0,0 +1,69 @@
+package linode
+
+import (
+	"context"
+	"sync"
+)
+
+// listCap is the max_results cap of one tool call and whether a list it made
+// stopped at the cap. A handler may fan out across goroutines, so it is
+// guarded.
+type listCap struct {
+	limit int
+
+	mu        sync.Mutex
+	truncated bool
+	total     int
+}
+
+type listCapKey struct{}
+
+// WithMaxResults returns a context on which every list the client walks stops
+// after limit items instead of following the remaining pages. A limit below 1
+// leaves ctx unchanged.
+func WithMaxResults(ctx context.Context, limit int) context.Context {
+	if limit < 1 {
+		return ctx
+	}
+
+	return context.WithValue(ctx, listCapKey{}, &listCap{limit: limit})
+}
+
+// ListTruncated reports whether a list made with ctx stopped at the
+// WithMaxResults cap, and the largest result total the API reported for such
+// a list. It is false when ctx carries no cap.
+func ListTruncated(ctx context.Context) (int, bool) {
+	capped, ok := ctx.Value(listCapKey{}).(*listCap)
+	if !ok {
+		return 0, false
+	}
+
+	capped.mu.Lock()
+	defer capped.mu.Unlock()
+
+	return capped.total, capped.truncated
+}
+
+// maxResults returns ctx's WithMaxResults cap, or 0 when it has none.
+func maxResults(ctx context.Context) int {
+	capped, ok := ctx.Value(listCapKey{}).(*listCap)
+	if !ok {
+		return 0
+	}
+
+	return capped.limit
+}
+
+// recordTruncated notes that a list stopped at the cap with total results
+// left on the API side.
+func recordTruncated(ctx context.Context, total int) {
+	capped, ok := ctx.Value(listCapKey{}).(*listCap)
+	if !ok {
+		return
+	}
+
+	capped.mu.Lock()
+	capped.truncated = true
+	capped.total = max(capped.total, total)
+	capped.mu.Unlock()
+}
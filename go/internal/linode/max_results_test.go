@@ -0,0 +1,101 @@
+package linode_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// newPagedVolumesServer serves three pages of two volumes each at /volumes,
+// counting the requests it answers.
+func newPagedVolumesServer(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		page := 1
+
+		if raw := r.URL.Query().Get("page"); raw != "" {
+			parsed, err := strconv.Atoi(raw)
+			if err != nil {
+				t.Errorf("page = %q, want a number", raw)
+			}
+
+			page = parsed
+		}
+
+		w.Header().Set("Content-Type", tcApplicationJSON)
+
+		body := fmt.Sprintf(`{"data":[{"id":%d},{"id":%d}],"page":%d,"pages":3,"results":6}`, page*2-1, page*2, page)
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+}
+
+func TestListVolumesFollowsEveryPage(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	srv := newPagedVolumesServer(t, &requests)
+	defer srv.Close()
+
+	client := linode.NewClient(srv.URL, "my-token", nil, linode.WithMaxRetries(0))
+
+	ctx := linode.WithMaxResults(t.Context(), 0)
+
+	volumes, err := client.ListVolumesProto(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(volumes) != 6 || volumes[5].GetId() != 6 {
+		t.Fatalf("volumes = %v, want ids 1 through 6", volumes)
+	}
+
+	if got := requests.Load(); got != 3 {
+		t.Errorf("requests = %d, want 3", got)
+	}
+
+	if _, truncated := linode.ListTruncated(ctx); truncated {
+		t.Error("ListTruncated = true for an uncapped list")
+	}
+}
+
+func TestListVolumesStopsAtMaxResults(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	srv := newPagedVolumesServer(t, &requests)
+	defer srv.Close()
+
+	client := linode.NewClient(srv.URL, "my-token", nil, linode.WithMaxRetries(0))
+
+	ctx := linode.WithMaxResults(t.Context(), 3)
+
+	volumes, err := client.ListVolumesProto(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(volumes) != 3 {
+		t.Fatalf("len(volumes) = %d, want 3", len(volumes))
+	}
+
+	if got := requests.Load(); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+
+	total, truncated := linode.ListTruncated(ctx)
+	if !truncated || total != 6 {
+		t.Errorf("ListTruncated = (%d, %v), want (6, true)", total, truncated)
+	}
+}
@@ -3,6 +3,7 @@ package linode
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
@@ -12,6 +13,10 @@ import (
 	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
 )
 
+// minCountPageSize is the smallest page_size Linode accepts; httpCountResults
+// only needs the collection's "results" total, not its items.
+const minCountPageSize = 25
+
 const (
 	endpointProfile                      = "/profile"
 	endpointProfilePreferences           = endpointProfile + "/preferences"
@@ -63,7 +68,7 @@ const (
 
 // GetProfile retrieves the authenticated user's profile from the Linode API.
 func (c *Client) httpGetProfile(ctx context.Context) (*Profile, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointProfile, nil)
@@ -83,7 +88,7 @@ func (c *Client) httpGetProfile(ctx context.Context) (*Profile, error) {
 
 // httpGetProfileProto retrieves the profile as a proto message.
 func (c *Client) httpGetProfileProto(ctx context.Context) (*linodev1.Profile, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointProfile, nil)
@@ -105,7 +110,7 @@ func (c *Client) httpGetProfileProto(ctx context.Context) (*linodev1.Profile, er
 // created token (including the one-time secret) into a proto message for the
 // proto-backed write path.
 func (c *Client) httpCreateProfileTokenProto(ctx context.Context, req CreateProfileTokenRequest) (*linodev1.CreatedPersonalAccessToken, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointProfileTokens, req)
@@ -125,7 +130,7 @@ func (c *Client) httpCreateProfileTokenProto(ctx context.Context, req CreateProf
 
 // httpUpdateProfilePreferences updates the authenticated user's preferences.
 func (c *Client) httpUpdateProfilePreferences(ctx context.Context, req ProfilePreferences) (ProfilePreferences, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	if req == nil {
@@ -153,7 +158,7 @@ func (c *Client) httpUpdateProfilePreferences(ctx context.Context, req ProfilePr
 // return it. The secret is returned to the user by design (it must be confirmed
 // to activate two-factor auth), so it is not output-redacted.
 func (c *Client) httpEnableProfileTFAProto(ctx context.Context) (*linodev1.ProfileTfaEnableResponse, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointProfileTFAEnable, nil)
@@ -173,7 +178,7 @@ func (c *Client) httpEnableProfileTFAProto(ctx context.Context) (*linodev1.Profi
 
 // httpSendProfilePhoneNumberVerificationCode sends a profile phone verification code.
 func (c *Client) httpSendProfilePhoneNumberVerificationCode(ctx context.Context, req *ProfilePhoneNumberRequest) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointProfilePhoneNumber, req)
@@ -192,7 +197,7 @@ func (c *Client) httpSendProfilePhoneNumberVerificationCode(ctx context.Context,
 
 // httpDeleteProfilePhoneNumber deletes the authenticated profile's phone number.
 func (c *Client) httpDeleteProfilePhoneNumber(ctx context.Context) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodDelete, endpointProfilePhoneNumber, nil)
@@ -211,7 +216,7 @@ func (c *Client) httpDeleteProfilePhoneNumber(ctx context.Context) error {
 
 // httpVerifyProfilePhoneNumber verifies a profile phone number using an OTP code.
 func (c *Client) httpVerifyProfilePhoneNumber(ctx context.Context, req *ProfilePhoneNumberVerifyRequest) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointProfilePhoneNumberVerify, req)
@@ -230,7 +235,7 @@ func (c *Client) httpVerifyProfilePhoneNumber(ctx context.Context, req *ProfileP
 
 // httpDisableProfileTFA disables two-factor authentication for the profile.
 func (c *Client) httpDisableProfileTFA(ctx context.Context) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointProfileTFADisable, nil)
@@ -249,7 +254,7 @@ func (c *Client) httpDisableProfileTFA(ctx context.Context) error {
 
 // httpConfirmProfileTFAEnable confirms two-factor authentication enablement for the profile.
 func (c *Client) httpConfirmProfileTFAEnable(ctx context.Context, req *ProfileTFAEnableConfirmRequest) (ProfileTFAEnableConfirmResponse, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	if req == nil {
@@ -281,7 +286,7 @@ func (c *Client) httpListProfileLoginsProto(ctx context.Context, page, pageSize
 
 // httpDeleteProfileToken revokes a personal access token for the authenticated profile.
 func (c *Client) httpDeleteProfileToken(ctx context.Context, tokenID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointProfileTokens + "/" + url.PathEscape(strconv.Itoa(tokenID))
@@ -305,7 +310,7 @@ func (c *Client) httpDeleteProfileToken(ctx context.Context, tokenID int) error
 // update never returns the token secret, so the metadata element carries no
 // secret field.
 func (c *Client) httpUpdateProfileTokenProto(ctx context.Context, tokenID string, req UpdateProfileTokenRequest) (*linodev1.PersonalAccessToken, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	if req == nil {
@@ -332,7 +337,7 @@ func (c *Client) httpUpdateProfileTokenProto(ctx context.Context, tokenID string
 // httpGetProfileLoginProto retrieves one profile login as a proto message (shared
 // AccountLogin shape).
 func (c *Client) httpGetProfileLoginProto(ctx context.Context, loginID int) (*linodev1.AccountLogin, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointProfileLogins + "/" + url.PathEscape(strconv.Itoa(loginID))
@@ -354,7 +359,7 @@ func (c *Client) httpGetProfileLoginProto(ctx context.Context, loginID int) (*li
 
 // httpGetProfileApp retrieves one authorized OAuth app from the profile.
 func (c *Client) httpGetProfileApp(ctx context.Context, appID int) (*ProfileApp, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointProfileApps + "/" + url.PathEscape(strconv.Itoa(appID))
@@ -376,7 +381,7 @@ func (c *Client) httpGetProfileApp(ctx context.Context, appID int) (*ProfileApp,
 
 // httpGetProfileAppProto retrieves one authorized OAuth app as a proto message.
 func (c *Client) httpGetProfileAppProto(ctx context.Context, appID int) (*linodev1.ProfileApp, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointProfileApps + "/" + url.PathEscape(strconv.Itoa(appID))
@@ -398,7 +403,7 @@ func (c *Client) httpGetProfileAppProto(ctx context.Context, appID int) (*linode
 
 // httpDeleteProfileApp revokes access for one OAuth app authorized on the profile.
 func (c *Client) httpDeleteProfileApp(ctx context.Context, appID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointProfileApps + "/" + url.PathEscape(strconv.Itoa(appID))
@@ -419,7 +424,7 @@ func (c *Client) httpDeleteProfileApp(ctx context.Context, appID int) error {
 
 // httpGetProfileDevice retrieves one trusted device from the profile.
 func (c *Client) httpGetProfileDevice(ctx context.Context, deviceID int) (*ProfileDevice, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointProfileDevices + "/" + url.PathEscape(strconv.Itoa(deviceID))
@@ -442,7 +447,7 @@ func (c *Client) httpGetProfileDevice(ctx context.Context, deviceID int) (*Profi
 // httpGetProfileDeviceProto retrieves one trusted device from the profile and
 // decodes it into the TrustedDevice proto element for the proto-backed read path.
 func (c *Client) httpGetProfileDeviceProto(ctx context.Context, deviceID int) (*linodev1.TrustedDevice, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointProfileDevices + "/" + url.PathEscape(strconv.Itoa(deviceID))
@@ -464,7 +469,7 @@ func (c *Client) httpGetProfileDeviceProto(ctx context.Context, deviceID int) (*
 
 // httpDeleteProfileDevice revokes one trusted device from the profile.
 func (c *Client) httpDeleteProfileDevice(ctx context.Context, deviceID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointProfileDevices + "/" + url.PathEscape(strconv.Itoa(deviceID))
@@ -489,7 +494,7 @@ func (c *Client) httpDeleteProfileDevice(ctx context.Context, deviceID int) erro
 // distinguish PAT vs OAuth by checking Profile.Scopes != "" first; this
 // method does not need to know which token type the caller has.
 func (c *Client) httpGetProfileGrants(ctx context.Context) (*Grants, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointProfileGrants, nil)
@@ -513,7 +518,7 @@ func (c *Client) httpGetProfileGrants(ctx context.Context) (*Grants, error) {
 // expiry) and no secret field, so any token value the API returns is dropped by
 // the DiscardUnknown decode.
 func (c *Client) httpGetProfileTokenProto(ctx context.Context, tokenID int) (*linodev1.PersonalAccessToken, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointProfileTokens + "/" + url.PathEscape(strconv.Itoa(tokenID))
@@ -535,7 +540,7 @@ func (c *Client) httpGetProfileTokenProto(ctx context.Context, tokenID int) (*li
 
 // httpAnswerProfileSecurityQuestions answers the authenticated user's security questions via POST /v4/profile/security-questions.
 func (c *Client) httpAnswerProfileSecurityQuestions(ctx context.Context, req *AnswerProfileSecurityQuestionsRequest) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointProfileSecurityQuestions, req)
@@ -554,7 +559,7 @@ func (c *Client) httpAnswerProfileSecurityQuestions(ctx context.Context, req *An
 
 // httpGetProfilePreferences retrieves /profile/preferences for the authenticated user.
 func (c *Client) httpGetProfilePreferences(ctx context.Context) (*ProfilePreferences, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointProfilePreferences, nil)
@@ -582,7 +587,7 @@ func (c *Client) httpListProfileAppsProto(ctx context.Context, page, pageSize in
 
 // GetAccount retrieves the authenticated user's account information from the Linode API.
 func (c *Client) httpGetAccount(ctx context.Context) (*Account, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointAccount, nil)
@@ -602,7 +607,7 @@ func (c *Client) httpGetAccount(ctx context.Context) (*Account, error) {
 
 // httpGetAccountProto retrieves the account as a proto message.
 func (c *Client) httpGetAccountProto(ctx context.Context) (*linodev1.Account, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointAccount, nil)
@@ -622,7 +627,7 @@ func (c *Client) httpGetAccountProto(ctx context.Context) (*linodev1.Account, er
 
 // httpUpdateAccountProto updates the account as a proto message.
 func (c *Client) httpUpdateAccountProto(ctx context.Context, req *UpdateAccountRequest) (*linodev1.Account, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPut, endpointAccount, req)
@@ -642,7 +647,7 @@ func (c *Client) httpUpdateAccountProto(ctx context.Context, req *UpdateAccountR
 
 // httpGetAccountTransferProto retrieves account transfer usage as a proto message.
 func (c *Client) httpGetAccountTransferProto(ctx context.Context) (*linodev1.AccountTransfer, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointAccountTransfer, nil)
@@ -662,7 +667,7 @@ func (c *Client) httpGetAccountTransferProto(ctx context.Context) (*linodev1.Acc
 
 // httpGetAccountSettings retrieves account-wide settings from the Linode API.
 func (c *Client) httpGetAccountSettings(ctx context.Context) (*AccountSettings, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointAccountSettings, nil)
@@ -682,7 +687,7 @@ func (c *Client) httpGetAccountSettings(ctx context.Context) (*AccountSettings,
 
 // httpGetAccountSettingsProto retrieves the account settings as a proto message.
 func (c *Client) httpGetAccountSettingsProto(ctx context.Context) (*linodev1.AccountSettings, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointAccountSettings, nil)
@@ -703,7 +708,7 @@ func (c *Client) httpGetAccountSettingsProto(ctx context.Context) (*linodev1.Acc
 // httpUpdateAccountSettingsProto updates account settings and decodes the
 // response into the proto AccountSettings element.
 func (c *Client) httpUpdateAccountSettingsProto(ctx context.Context, req *UpdateAccountSettingsRequest) (*linodev1.AccountSettings, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPut, endpointAccountSettings, req)
@@ -723,7 +728,7 @@ func (c *Client) httpUpdateAccountSettingsProto(ctx context.Context, req *Update
 
 // httpEnableAccountManaged enables Linode Managed for the account via POST /v4/account/settings/managed-enable.
 func (c *Client) httpEnableAccountManaged(ctx context.Context) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointAccountSettingsManagedEnable, nil)
@@ -753,7 +758,7 @@ func (c *Client) httpListManagedCredentialsProto(ctx context.Context, page, page
 // and decodes the response into the proto element so the write tool emits the
 // same field set as the credential GET/LIST path.
 func (c *Client) httpUpdateManagedCredentialProto(ctx context.Context, credentialID int, req UpdateManagedCredentialRequest) (*linodev1.ManagedCredential, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedCredentials + "/" + url.PathEscape(strconv.Itoa(credentialID))
@@ -775,7 +780,7 @@ func (c *Client) httpUpdateManagedCredentialProto(ctx context.Context, credentia
 
 // httpUpdateManagedCredentialUsernamePassword updates one stored Managed credential's username and password.
 func (c *Client) httpUpdateManagedCredentialUsernamePassword(ctx context.Context, credentialID int, req *UpdateManagedCredentialUsernamePasswordRequest) (*ManagedCredential, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedCredentials + "/" + url.PathEscape(strconv.Itoa(credentialID)) + "/update"
@@ -798,7 +803,7 @@ func (c *Client) httpUpdateManagedCredentialUsernamePassword(ctx context.Context
 // httpGetManagedSSHKeyProto retrieves the Managed SSH public key assigned to the
 // account and decodes it into the ManagedSSHKey proto element.
 func (c *Client) httpGetManagedSSHKeyProto(ctx context.Context) (*linodev1.ManagedSSHKey, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointManagedCredentialsSSHKey, nil)
@@ -820,7 +825,7 @@ func (c *Client) httpGetManagedSSHKeyProto(ctx context.Context) (*linodev1.Manag
 // the response into the proto element so the write tool emits the same field set
 // as the credential GET/LIST path (the secret is never echoed).
 func (c *Client) httpCreateManagedCredentialProto(ctx context.Context, request *CreateManagedCredentialRequest) (*linodev1.ManagedCredential, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointManagedCredentials, request)
@@ -840,7 +845,7 @@ func (c *Client) httpCreateManagedCredentialProto(ctx context.Context, request *
 
 // httpGetManagedCredential retrieves one stored managed credential.
 func (c *Client) httpGetManagedCredential(ctx context.Context, credentialID int) (*ManagedCredential, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedCredentials + "/" + url.PathEscape(strconv.Itoa(credentialID))
@@ -862,7 +867,7 @@ func (c *Client) httpGetManagedCredential(ctx context.Context, credentialID int)
 
 // httpGetManagedCredentialProto retrieves a Managed credential as a proto message.
 func (c *Client) httpGetManagedCredentialProto(ctx context.Context, credentialID int) (*linodev1.ManagedCredential, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedCredentials + "/" + url.PathEscape(strconv.Itoa(credentialID))
@@ -884,7 +889,7 @@ func (c *Client) httpGetManagedCredentialProto(ctx context.Context, credentialID
 
 // httpRevokeManagedCredential revokes one stored managed credential.
 func (c *Client) httpRevokeManagedCredential(ctx context.Context, credentialID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedCredentials + "/" + url.PathEscape(strconv.Itoa(credentialID)) + "/revoke"
@@ -907,7 +912,7 @@ func (c *Client) httpRevokeManagedCredential(ctx context.Context, credentialID i
 // as a proto message. The endpoint returns a flat object of bool flags, decoded
 // into AccountAgreements.
 func (c *Client) httpGetAccountAgreementsProto(ctx context.Context) (*linodev1.AccountAgreements, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointAccountAgreements, nil)
@@ -979,7 +984,7 @@ func (c *Client) httpListProfileSecurityQuestionsProto(ctx context.Context) ([]*
 // httpGetAccountAvailabilityProto retrieves one region's account availability as a
 // proto message.
 func (c *Client) httpGetAccountAvailabilityProto(ctx context.Context, regionID string) (*linodev1.AccountAvailability, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountAvailability + "/" + url.PathEscape(regionID)
@@ -1017,7 +1022,7 @@ func (c *Client) httpListBetasProto(ctx context.Context, page, pageSize int) ([]
 
 // httpGetBetaProto retrieves one available beta program as a proto message.
 func (c *Client) httpGetBetaProto(ctx context.Context, betaID string) (*linodev1.BetaProgram, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointBetas + "/" + url.PathEscape(betaID)
@@ -1070,7 +1075,7 @@ func (c *Client) UpdateLongviewClientProto(ctx context.Context, clientID int, re
 // httpUpdateLongviewClientProto updates one Longview client and decodes the
 // response into the proto LongviewClient element.
 func (c *Client) httpUpdateLongviewClientProto(ctx context.Context, clientID int, req *UpdateLongviewClientRequest) (*linodev1.LongviewClient, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointLongviewClients + "/" + url.PathEscape(strconv.Itoa(clientID))
@@ -1092,7 +1097,7 @@ func (c *Client) httpUpdateLongviewClientProto(ctx context.Context, clientID int
 
 // httpDeleteLongviewClient deletes one Longview client.
 func (c *Client) httpDeleteLongviewClient(ctx context.Context, clientID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointLongviewClients + "/" + url.PathEscape(strconv.Itoa(clientID))
@@ -1109,7 +1114,7 @@ func (c *Client) httpDeleteLongviewClient(ctx context.Context, clientID int) err
 
 // httpGetAccountPaymentMethod retrieves one payment method by ID.
 func (c *Client) httpGetAccountPaymentMethod(ctx context.Context, paymentMethodID string) (*AccountPaymentMethod, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountPaymentMethods + "/" + url.PathEscape(paymentMethodID)
@@ -1133,7 +1138,7 @@ func (c *Client) httpGetAccountPaymentMethod(ctx context.Context, paymentMethodI
 // decodes it into the proto AccountPaymentMethod element for the proto-backed
 // read path. The polymorphic data object rides through the element's Struct field.
 func (c *Client) httpGetAccountPaymentMethodProto(ctx context.Context, paymentMethodID string) (*linodev1.AccountPaymentMethod, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountPaymentMethods + "/" + url.PathEscape(paymentMethodID)
@@ -1162,7 +1167,7 @@ func (c *Client) CreateAccountPaymentMethodProto(ctx context.Context, req *Creat
 // httpCreateAccountPaymentMethodProto adds a payment method and decodes the
 // response into the proto AccountPaymentMethod element.
 func (c *Client) httpCreateAccountPaymentMethodProto(ctx context.Context, req *CreateAccountPaymentMethodRequest) (*linodev1.AccountPaymentMethod, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointAccountPaymentMethods, req)
@@ -1182,7 +1187,7 @@ func (c *Client) httpCreateAccountPaymentMethodProto(ctx context.Context, req *C
 
 // httpDeleteAccountPaymentMethod deletes one payment method by ID.
 func (c *Client) httpDeleteAccountPaymentMethod(ctx context.Context, paymentMethodID string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountPaymentMethods + "/" + url.PathEscape(paymentMethodID)
@@ -1198,7 +1203,7 @@ func (c *Client) httpDeleteAccountPaymentMethod(ctx context.Context, paymentMeth
 }
 
 func (c *Client) httpMakeAccountPaymentMethodDefault(ctx context.Context, paymentMethodID string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountPaymentMethods + "/" + url.PathEscape(paymentMethodID) + "/make-default"
@@ -1215,7 +1220,7 @@ func (c *Client) httpMakeAccountPaymentMethodDefault(ctx context.Context, paymen
 
 // httpGetAccountOAuthClient retrieves one OAuth client by ID.
 func (c *Client) httpGetAccountOAuthClient(ctx context.Context, clientID string) (*OAuthClient, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountOAuthClients + "/" + url.PathEscape(clientID)
@@ -1237,7 +1242,7 @@ func (c *Client) httpGetAccountOAuthClient(ctx context.Context, clientID string)
 
 // httpGetAccountOAuthClientProto retrieves one OAuth client as a proto message.
 func (c *Client) httpGetAccountOAuthClientProto(ctx context.Context, clientID string) (*linodev1.OAuthClient, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountOAuthClients + "/" + url.PathEscape(clientID)
@@ -1260,7 +1265,7 @@ func (c *Client) httpGetAccountOAuthClientProto(ctx context.Context, clientID st
 // httpUpdateOAuthClientProto updates one OAuth client and decodes the response
 // into the proto OAuthClient element (the metadata element, no secret).
 func (c *Client) httpUpdateOAuthClientProto(ctx context.Context, clientID string, req *UpdateOAuthClientRequest) (*linodev1.OAuthClient, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountOAuthClients + "/" + url.PathEscape(clientID)
@@ -1282,7 +1287,7 @@ func (c *Client) httpUpdateOAuthClientProto(ctx context.Context, clientID string
 
 // httpUpdateOAuthClientThumbnail updates one OAuth client's thumbnail by ID.
 func (c *Client) httpUpdateOAuthClientThumbnail(ctx context.Context, clientID string, thumbnailPNG []byte) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountOAuthClients + "/" + url.PathEscape(clientID) + "/thumbnail"
@@ -1299,7 +1304,7 @@ func (c *Client) httpUpdateOAuthClientThumbnail(ctx context.Context, clientID st
 
 // httpGetOAuthClientThumbnail retrieves one OAuth client's thumbnail by ID as raw PNG bytes.
 func (c *Client) httpGetOAuthClientThumbnail(ctx context.Context, clientID string) ([]byte, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountOAuthClients + "/" + url.PathEscape(clientID) + "/thumbnail"
@@ -1335,7 +1340,7 @@ func (c *Client) httpGetOAuthClientThumbnail(ctx context.Context, clientID strin
 
 // httpDeleteAccountOAuthClient deletes one OAuth client by ID.
 func (c *Client) httpDeleteAccountOAuthClient(ctx context.Context, clientID string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountOAuthClients + "/" + url.PathEscape(clientID)
@@ -1353,7 +1358,7 @@ func (c *Client) httpDeleteAccountOAuthClient(ctx context.Context, clientID stri
 // httpResetOAuthClientSecretProto resets an OAuth client secret and decodes the
 // response into the proto OAuthClientSecret element.
 func (c *Client) httpResetOAuthClientSecretProto(ctx context.Context, clientID string) (*linodev1.OAuthClientSecret, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountOAuthClients + "/" + url.PathEscape(clientID) + "/reset-secret"
@@ -1391,7 +1396,7 @@ func (c *Client) httpListAccountUsersProto(ctx context.Context, page, pageSize i
 
 // httpGetAccountUser retrieves one account user by username.
 func (c *Client) httpGetAccountUser(ctx context.Context, username string) (*AccountUser, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountUsers + "/" + url.PathEscape(username)
@@ -1413,7 +1418,7 @@ func (c *Client) httpGetAccountUser(ctx context.Context, username string) (*Acco
 
 // httpGetAccountUserProto retrieves one account user as a proto message.
 func (c *Client) httpGetAccountUserProto(ctx context.Context, username string) (*linodev1.AccountUser, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountUsers + "/" + url.PathEscape(username)
@@ -1435,7 +1440,7 @@ func (c *Client) httpGetAccountUserProto(ctx context.Context, username string) (
 
 // httpGetAccountUserGrants retrieves one account user's grants by username.
 func (c *Client) httpGetAccountUserGrants(ctx context.Context, username string) (*Grants, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountUsers + "/" + url.PathEscape(username) + "/grants"
@@ -1460,7 +1465,7 @@ func (c *Client) httpGetAccountUserGrants(ctx context.Context, username string)
 // The API omits grant sections the user has none of; protojson leaves those
 // repeated fields empty so the canonical output normalizes them to [].
 func (c *Client) httpGetAccountUserGrantsProto(ctx context.Context, username string) (*linodev1.AccountUserGrants, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountUsers + "/" + url.PathEscape(username) + "/grants"
@@ -1483,7 +1488,7 @@ func (c *Client) httpGetAccountUserGrantsProto(ctx context.Context, username str
 // httpCreateAccountUserProto creates a user and decodes the response into the
 // proto AccountUser element.
 func (c *Client) httpCreateAccountUserProto(ctx context.Context, request *CreateAccountUserRequest) (*linodev1.AccountUser, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointAccountUsers, request)
@@ -1504,7 +1509,7 @@ func (c *Client) httpCreateAccountUserProto(ctx context.Context, request *Create
 // httpUpdateAccountUserProto updates one account user by username and decodes the
 // response into the proto AccountUser element.
 func (c *Client) httpUpdateAccountUserProto(ctx context.Context, username string, request *UpdateAccountUserRequest) (*linodev1.AccountUser, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountUsers + "/" + url.PathEscape(username)
@@ -1527,7 +1532,7 @@ func (c *Client) httpUpdateAccountUserProto(ctx context.Context, username string
 // httpUpdateAccountUserGrantsProto updates one account user's grants and decodes
 // the response into the proto AccountUserGrants element.
 func (c *Client) httpUpdateAccountUserGrantsProto(ctx context.Context, username string, request *UpdateAccountUserGrantsRequest) (*linodev1.AccountUserGrants, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountUsers + "/" + url.PathEscape(username) + "/grants"
@@ -1549,7 +1554,7 @@ func (c *Client) httpUpdateAccountUserGrantsProto(ctx context.Context, username
 
 // httpDeleteAccountUser deletes one account user by username.
 func (c *Client) httpDeleteAccountUser(ctx context.Context, username string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountUsers + "/" + url.PathEscape(username)
@@ -1574,7 +1579,7 @@ func (c *Client) httpListAccountLoginsProto(ctx context.Context, page, pageSize
 
 // httpGetAccountLoginProto retrieves one account login as a proto message.
 func (c *Client) httpGetAccountLoginProto(ctx context.Context, loginID int) (*linodev1.AccountLogin, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountLogins + "/" + url.PathEscape(strconv.Itoa(loginID))
@@ -1612,7 +1617,7 @@ func (c *Client) httpListAccountPaymentsProto(ctx context.Context, page, pageSiz
 
 // httpGetAccountPaymentProto retrieves one account payment as a proto message.
 func (c *Client) httpGetAccountPaymentProto(ctx context.Context, paymentID int) (*linodev1.AccountPayment, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountPayments + "/" + url.PathEscape(strconv.Itoa(paymentID))
@@ -1641,7 +1646,7 @@ func (c *Client) CreateAccountPaymentProto(ctx context.Context, req *CreateAccou
 // httpCreateAccountPaymentProto makes an account payment and decodes the
 // response into the proto AccountPayment element.
 func (c *Client) httpCreateAccountPaymentProto(ctx context.Context, req *CreateAccountPaymentRequest) (*linodev1.AccountPayment, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointAccountPayments, req)
@@ -1661,7 +1666,7 @@ func (c *Client) httpCreateAccountPaymentProto(ctx context.Context, req *CreateA
 
 // httpGetAccountInvoiceProto retrieves one account invoice as a proto message.
 func (c *Client) httpGetAccountInvoiceProto(ctx context.Context, invoiceID int) (*linodev1.AccountInvoice, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountInvoices + "/" + strconv.Itoa(invoiceID)
@@ -1722,7 +1727,7 @@ func (c *Client) httpListAccountServiceTransfersProto(ctx context.Context, page,
 
 // httpGetAccountServiceTransfer retrieves one account service transfer by token.
 func (c *Client) httpGetAccountServiceTransfer(ctx context.Context, token string) (*AccountEntityTransfer, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountServiceTransfers + "/" + url.PathEscape(token)
@@ -1745,7 +1750,7 @@ func (c *Client) httpGetAccountServiceTransfer(ctx context.Context, token string
 // httpGetAccountServiceTransferProto retrieves one account service transfer as a
 // proto message.
 func (c *Client) httpGetAccountServiceTransferProto(ctx context.Context, token string) (*linodev1.AccountEntityTransfer, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountServiceTransfers + "/" + url.PathEscape(token)
@@ -1767,7 +1772,7 @@ func (c *Client) httpGetAccountServiceTransferProto(ctx context.Context, token s
 
 // httpDeleteAccountServiceTransfer cancels one account service transfer by token.
 func (c *Client) httpDeleteAccountServiceTransfer(ctx context.Context, token string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountServiceTransfers + "/" + url.PathEscape(token)
@@ -1784,7 +1789,7 @@ func (c *Client) httpDeleteAccountServiceTransfer(ctx context.Context, token str
 
 // httpAcceptAccountServiceTransfer accepts one account service transfer by token.
 func (c *Client) httpAcceptAccountServiceTransfer(ctx context.Context, token string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountServiceTransfers + "/" + url.PathEscape(token) + "/accept"
@@ -1802,7 +1807,7 @@ func (c *Client) httpAcceptAccountServiceTransfer(ctx context.Context, token str
 // httpCreateAccountServiceTransferProto creates an account service transfer and
 // decodes the response into the proto AccountEntityTransfer element.
 func (c *Client) httpCreateAccountServiceTransferProto(ctx context.Context, req *CreateAccountServiceTransferRequest) (*linodev1.AccountEntityTransfer, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointAccountServiceTransfers, req)
@@ -1822,7 +1827,7 @@ func (c *Client) httpCreateAccountServiceTransferProto(ctx context.Context, req
 
 // httpGetAccountEvent retrieves one account event by ID.
 func (c *Client) httpGetAccountEvent(ctx context.Context, eventID int) (*AccountEvent, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountEvents + "/" + url.PathEscape(strconv.Itoa(eventID))
@@ -1844,7 +1849,7 @@ func (c *Client) httpGetAccountEvent(ctx context.Context, eventID int) (*Account
 
 // httpGetAccountEventProto retrieves one account event as a proto message.
 func (c *Client) httpGetAccountEventProto(ctx context.Context, eventID int) (*linodev1.AccountEvent, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountEvents + "/" + url.PathEscape(strconv.Itoa(eventID))
@@ -1866,7 +1871,7 @@ func (c *Client) httpGetAccountEventProto(ctx context.Context, eventID int) (*li
 
 // httpMarkAccountEventSeen marks one account event as seen by ID.
 func (c *Client) httpMarkAccountEventSeen(ctx context.Context, eventID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountEvents + "/" + url.PathEscape(strconv.Itoa(eventID)) + "/seen"
@@ -1883,7 +1888,7 @@ func (c *Client) httpMarkAccountEventSeen(ctx context.Context, eventID int) erro
 
 // httpGetAccountChildAccount retrieves one child-level account by EUUID.
 func (c *Client) httpGetAccountChildAccount(ctx context.Context, euuid string) (*ChildAccount, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountChildAccounts + "/" + url.PathEscape(euuid)
@@ -1908,7 +1913,7 @@ func (c *Client) httpGetAccountChildAccount(ctx context.Context, euuid string) (
 // credit_card is a message field, so a null credit_card from the API is omitted
 // rather than rendered as empty strings.
 func (c *Client) httpGetAccountChildAccountProto(ctx context.Context, euuid string) (*linodev1.ChildAccount, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountChildAccounts + "/" + url.PathEscape(euuid)
@@ -1932,7 +1937,7 @@ func (c *Client) httpGetAccountChildAccountProto(ctx context.Context, euuid stri
 // child-level account and decodes the response into the proto ProxyUserToken
 // element. The token it carries is returned to the user by design.
 func (c *Client) httpCreateAccountChildAccountTokenProto(ctx context.Context, euuid string) (*linodev1.ProxyUserToken, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountChildAccounts + "/" + url.PathEscape(euuid) + "/token"
@@ -1955,7 +1960,7 @@ func (c *Client) httpCreateAccountChildAccountTokenProto(ctx context.Context, eu
 // httpCreateOAuthClientProto creates an OAuth client and decodes the response
 // into the proto CreatedOAuthClient element (which carries the one-time secret).
 func (c *Client) httpCreateOAuthClientProto(ctx context.Context, req *CreateOAuthClientRequest) (*linodev1.CreatedOAuthClient, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointAccountOAuthClients, req)
@@ -1976,7 +1981,7 @@ func (c *Client) httpCreateOAuthClientProto(ctx context.Context, req *CreateOAut
 // httpGetAccountBetaProto retrieves one enrolled account beta program as a proto
 // message.
 func (c *Client) httpGetAccountBetaProto(ctx context.Context, betaID string) (*linodev1.AccountBetaProgram, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointAccountBetas + "/" + url.PathEscape(betaID)
@@ -1998,7 +2003,7 @@ func (c *Client) httpGetAccountBetaProto(ctx context.Context, betaID string) (*l
 
 // httpEnrollAccountBeta enrolls the account in a beta program via POST /v4/account/betas.
 func (c *Client) httpEnrollAccountBeta(ctx context.Context, req *EnrollAccountBetaRequest) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointAccountBetas, req)
@@ -2013,7 +2018,7 @@ func (c *Client) httpEnrollAccountBeta(ctx context.Context, req *EnrollAccountBe
 
 // httpAddAccountPromoCredit applies a promo credit to the account via POST /v4/account/promo-codes.
 func (c *Client) httpAddAccountPromoCredit(ctx context.Context, req *AddAccountPromoCreditRequest) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointAccountPromoCodes, req)
@@ -2046,7 +2051,7 @@ func withPaginationQuery(endpoint string, page, pageSize int) string {
 
 // httpAcknowledgeAccountAgreements acknowledges account agreements via POST /v4/account/agreements.
 func (c *Client) httpAcknowledgeAccountAgreements(ctx context.Context, req *AcknowledgeAccountAgreementsRequest) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointAccountAgreements, req)
@@ -2062,7 +2067,7 @@ func (c *Client) httpAcknowledgeAccountAgreements(ctx context.Context, req *Ackn
 // httpCancelAccountProto cancels the account and decodes the response into the
 // proto AccountCancelResponse element.
 func (c *Client) httpCancelAccountProto(ctx context.Context, req *CancelAccountRequest) (*linodev1.AccountCancelResponse, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointAccountCancel, req)
@@ -2082,7 +2087,7 @@ func (c *Client) httpCancelAccountProto(ctx context.Context, req *CancelAccountR
 
 // httpUpdateProfile updates the authenticated user's profile via PUT /v4/profile.
 func (c *Client) httpUpdateProfile(ctx context.Context, req *UpdateProfileRequest) (*Profile, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPut, endpointProfile, req)
@@ -2099,3 +2104,24 @@ func (c *Client) httpUpdateProfile(ctx context.Context, req *UpdateProfileReques
 
 	return &profile, nil
 }
+
+// httpCountResults returns the "results" total a paginated collection
+// endpoint reports, fetching only its smallest first page.
+func (c *Client) httpCountResults(ctx context.Context, endpoint string) (int, error) {
+	ctx, cancel := withRequestTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.makeRequest(ctx, http.MethodGet, withPaginationQuery(endpoint, 1, minCountPageSize), nil)
+	if err != nil {
+		return 0, &NetworkError{Operation: "CountResults", Err: err}
+	}
+
+	defer drainClose(resp) // errcheck: body close is best-effort; all account methods use this pattern
+
+	var response PaginatedResponse[json.RawMessage]
+	if err := c.handleResponse(resp, &response); err != nil {
+		return 0, err
+	}
+
+	return response.Results, nil
+}
@@ -38,7 +38,7 @@ func (c *Client) httpListInstancesProto(ctx context.Context) ([]*linodev1.Instan
 // httpGetInstanceProto retrieves a single Linode instance by ID as a proto
 // message, decoded directly from the API JSON for the proto-backed read path.
 func (c *Client) httpGetInstanceProto(ctx context.Context, instanceID int) (*linodev1.Instance, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstances+"/%d", instanceID)
@@ -60,7 +60,7 @@ func (c *Client) httpGetInstanceProto(ctx context.Context, instanceID int) (*lin
 
 // GetInstance retrieves a single Linode instance by its ID.
 func (c *Client) httpGetInstance(ctx context.Context, instanceID int) (*Instance, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstances+"/%d", instanceID)
@@ -96,7 +96,7 @@ func (c *Client) httpGetInstanceStatsByYearMonthProto(ctx context.Context, linod
 		return nil, ErrStatsMonthRange
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstances+"/%d/stats/%d/%d", linodeID, year, month)
@@ -123,7 +123,7 @@ func (c *Client) httpGetInstanceTransferProto(ctx context.Context, linodeID int)
 		return nil, ErrLinodeIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -153,7 +153,7 @@ func (c *Client) httpListRegionsProto(ctx context.Context) ([]*linodev1.Region,
 
 // GetRegion retrieves a single Linode region by its ID.
 func (c *Client) httpGetRegion(ctx context.Context, regionID string) (*Region, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointRegions + "/" + url.PathEscape(regionID)
@@ -175,7 +175,7 @@ func (c *Client) httpGetRegion(ctx context.Context, regionID string) (*Region, e
 
 // httpGetRegionProto retrieves one region as a proto message.
 func (c *Client) httpGetRegionProto(ctx context.Context, regionID string) (*linodev1.Region, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointRegions + "/" + url.PathEscape(regionID)
@@ -233,7 +233,7 @@ func (c *Client) httpListTypesProto(ctx context.Context) ([]*linodev1.InstanceTy
 
 // GetType retrieves a single Linode instance type by ID.
 func (c *Client) httpGetType(ctx context.Context, typeID string) (*InstanceType, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointTypes + "/" + url.PathEscape(typeID)
@@ -255,7 +255,7 @@ func (c *Client) httpGetType(ctx context.Context, typeID string) (*InstanceType,
 
 // httpGetTypeProto retrieves one instance type as a proto message.
 func (c *Client) httpGetTypeProto(ctx context.Context, typeID string) (*linodev1.InstanceType, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointTypes + "/" + url.PathEscape(typeID)
@@ -277,7 +277,7 @@ func (c *Client) httpGetTypeProto(ctx context.Context, typeID string) (*linodev1
 
 // httpGetKernelProto retrieves one kernel as a proto message.
 func (c *Client) httpGetKernelProto(ctx context.Context, kernelID string) (*linodev1.Kernel, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointKernels + "/" + url.PathEscape(kernelID)
@@ -306,7 +306,7 @@ func (c *Client) httpListImagesProto(ctx context.Context) ([]*linodev1.Image, er
 
 // GetImage retrieves a single Linode image by ID.
 func (c *Client) httpGetImage(ctx context.Context, imageID string) (*Image, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImages + "/" + escapeImageIDSegment(imageID)
@@ -328,7 +328,7 @@ func (c *Client) httpGetImage(ctx context.Context, imageID string) (*Image, erro
 
 // httpGetImageProto retrieves an image and decodes it as a proto message.
 func (c *Client) httpGetImageProto(ctx context.Context, imageID string) (*linodev1.Image, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImages + "/" + escapeImageIDSegment(imageID)
@@ -350,7 +350,7 @@ func (c *Client) httpGetImageProto(ctx context.Context, imageID string) (*linode
 
 // DeleteImage deletes a private image.
 func (c *Client) httpDeleteImage(ctx context.Context, imageID string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImages + "/" + escapeImageIDSegment(imageID)
@@ -368,7 +368,7 @@ func (c *Client) httpDeleteImage(ctx context.Context, imageID string) error {
 // httpReplicateImageProto replicates an image and decodes the response as a proto
 // message for the proto-backed write path.
 func (c *Client) httpReplicateImageProto(ctx context.Context, imageID string, req *ReplicateImageRequest) (*linodev1.Image, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImages + "/" + escapeImageIDSegment(imageID) + "/regions"
@@ -394,7 +394,7 @@ func (c *Client) httpUpdateImageProto(ctx context.Context, imageID string, req *
 		return nil, ErrUpdateImageRequestRequired
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImages + "/" + escapeImageIDSegment(imageID)
@@ -424,7 +424,7 @@ func (c *Client) httpListImageShareGroupsProto(ctx context.Context, page, pageSi
 
 // GetImageShareGroup retrieves a single image share group by ID.
 func (c *Client) httpGetImageShareGroup(ctx context.Context, shareGroupID int) (*ImageShareGroup, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointImageShareGroups+"/%d", shareGroupID)
@@ -446,7 +446,7 @@ func (c *Client) httpGetImageShareGroup(ctx context.Context, shareGroupID int) (
 
 // httpGetImageShareGroupProto retrieves one image share group as a proto message.
 func (c *Client) httpGetImageShareGroupProto(ctx context.Context, shareGroupID int) (*linodev1.ImageShareGroup, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointImageShareGroups+"/%d", shareGroupID)
@@ -505,7 +505,7 @@ func (c *Client) httpListMembersByImageShareGroupProto(ctx context.Context, shar
 // httpGetImageShareGroupMemberTokenProto retrieves one image share group member
 // token as a proto message.
 func (c *Client) httpGetImageShareGroupMemberTokenProto(ctx context.Context, shareGroupID int, tokenUUID string) (*linodev1.ImageShareGroupMember, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImageShareGroups + "/" + escapeImageShareGroupID(shareGroupID) + "/members/" + escapeImageShareGroupTokenUUID(tokenUUID)
@@ -528,7 +528,7 @@ func (c *Client) httpGetImageShareGroupMemberTokenProto(ctx context.Context, sha
 // httpUpdateImageShareGroupMemberProto updates a member token and decodes the
 // response as a proto message for the proto-backed write path.
 func (c *Client) httpUpdateImageShareGroupMemberProto(ctx context.Context, shareGroupID int, tokenUUID string, req *UpdateImageShareGroupMemberRequest) (*linodev1.ImageShareGroupMember, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImageShareGroups + "/" + escapeImageShareGroupID(shareGroupID) + "/members/" + escapeImageShareGroupTokenUUID(tokenUUID)
@@ -551,7 +551,7 @@ func (c *Client) httpUpdateImageShareGroupMemberProto(ctx context.Context, share
 // httpCreateImageShareGroupProto creates a share group and decodes the response
 // as a proto message for the proto-backed write path.
 func (c *Client) httpCreateImageShareGroupProto(ctx context.Context, req *CreateImageShareGroupRequest) (*linodev1.ImageShareGroup, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointImageShareGroups, req)
@@ -572,7 +572,7 @@ func (c *Client) httpCreateImageShareGroupProto(ctx context.Context, req *Create
 // httpAddImageShareGroupImagesProto adds images to a share group and decodes the
 // response image as a proto message for the proto-backed write path.
 func (c *Client) httpAddImageShareGroupImagesProto(ctx context.Context, shareGroupID int, req *AddImageShareGroupImagesRequest) (*linodev1.Image, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImageShareGroups + "/" + escapeImageShareGroupID(shareGroupID) + "/images"
@@ -595,7 +595,7 @@ func (c *Client) httpAddImageShareGroupImagesProto(ctx context.Context, shareGro
 // httpAddImageShareGroupMembersProto adds members to a share group and decodes the
 // returned parent share group as a proto message for the proto-backed write path.
 func (c *Client) httpAddImageShareGroupMembersProto(ctx context.Context, shareGroupID int, req *AddImageShareGroupMembersRequest) (*linodev1.ImageShareGroup, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImageShareGroups + "/" + escapeImageShareGroupID(shareGroupID) + "/members"
@@ -617,7 +617,7 @@ func (c *Client) httpAddImageShareGroupMembersProto(ctx context.Context, shareGr
 
 // DeleteImageShareGroupImage revokes access to one shared image in an owned image share group.
 func (c *Client) httpDeleteImageShareGroupImage(ctx context.Context, shareGroupID, imageID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImageShareGroups + "/" + escapeImageShareGroupID(shareGroupID) + "/images/" + escapeImageShareGroupID(imageID)
@@ -635,7 +635,7 @@ func (c *Client) httpDeleteImageShareGroupImage(ctx context.Context, shareGroupI
 // httpUpdateImageShareGroupProto updates a share group and decodes the response
 // as a proto message for the proto-backed write path.
 func (c *Client) httpUpdateImageShareGroupProto(ctx context.Context, shareGroupID int, req *UpdateImageShareGroupRequest) (*linodev1.ImageShareGroup, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImageShareGroups + "/" + escapeImageShareGroupID(shareGroupID)
@@ -658,7 +658,7 @@ func (c *Client) httpUpdateImageShareGroupProto(ctx context.Context, shareGroupI
 // httpUpdateImageShareGroupImageProto updates a shared image and decodes the
 // response as a proto message for the proto-backed write path.
 func (c *Client) httpUpdateImageShareGroupImageProto(ctx context.Context, shareGroupID int, imageID string, req *UpdateImageShareGroupImageRequest) (*linodev1.Image, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImageShareGroups + "/" + escapeImageShareGroupID(shareGroupID) + "/images/" + escapeImageIDSegment(imageID)
@@ -680,7 +680,7 @@ func (c *Client) httpUpdateImageShareGroupImageProto(ctx context.Context, shareG
 
 // DeleteImageShareGroup removes an owned image share group.
 func (c *Client) httpDeleteImageShareGroup(ctx context.Context, shareGroupID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointImageShareGroups+"/%d", shareGroupID)
@@ -707,7 +707,7 @@ func (c *Client) httpListImageShareGroupTokensProto(ctx context.Context, page, p
 // httpCreateImageShareGroupTokenProto creates a membership token and decodes the
 // response as a proto message for the proto-backed write path.
 func (c *Client) httpCreateImageShareGroupTokenProto(ctx context.Context, req *CreateImageShareGroupTokenRequest) (*linodev1.ImageShareGroupToken, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointImageShareGroupMembershipCreate, req)
@@ -728,7 +728,7 @@ func (c *Client) httpCreateImageShareGroupTokenProto(ctx context.Context, req *C
 // httpGetImageShareGroupTokenProto retrieves one image share group token as a
 // proto message.
 func (c *Client) httpGetImageShareGroupTokenProto(ctx context.Context, tokenUUID string) (*linodev1.ImageShareGroupToken, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImageShareGroups + "/tokens/" + escapeImageShareGroupTokenUUID(tokenUUID)
@@ -763,7 +763,7 @@ func (c *Client) httpListImagesByShareGroupTokenProto(ctx context.Context, token
 // httpUpdateImageShareGroupTokenProto updates a membership token label and decodes
 // the response as a proto message for the proto-backed write path.
 func (c *Client) httpUpdateImageShareGroupTokenProto(ctx context.Context, tokenUUID string, req *UpdateImageShareGroupTokenRequest) (*linodev1.ImageShareGroupToken, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImageShareGroupMembershipCreate + "/" + escapeImageShareGroupTokenUUID(tokenUUID)
@@ -785,7 +785,7 @@ func (c *Client) httpUpdateImageShareGroupTokenProto(ctx context.Context, tokenU
 
 // GetImageShareGroupByToken retrieves a share group through a membership token UUID.
 func (c *Client) httpGetImageShareGroupByToken(ctx context.Context, tokenUUID string) (*ImageShareGroup, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImageShareGroups + "/tokens/" + escapeImageShareGroupTokenUUID(tokenUUID) + "/sharegroup"
@@ -808,7 +808,7 @@ func (c *Client) httpGetImageShareGroupByToken(ctx context.Context, tokenUUID st
 // httpGetImageShareGroupByTokenProto resolves a token to its parent share group
 // as a proto message.
 func (c *Client) httpGetImageShareGroupByTokenProto(ctx context.Context, tokenUUID string) (*linodev1.ImageShareGroup, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImageShareGroups + "/tokens/" + escapeImageShareGroupTokenUUID(tokenUUID) + "/sharegroup"
@@ -847,7 +847,7 @@ func escapeImageIDSegment(imageID string) string {
 
 // DeleteImageShareGroupToken removes one image share group membership token.
 func (c *Client) httpDeleteImageShareGroupToken(ctx context.Context, tokenUUID string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImageShareGroups + "/tokens/" + escapeImageShareGroupTokenUUID(tokenUUID)
@@ -864,7 +864,7 @@ func (c *Client) httpDeleteImageShareGroupToken(ctx context.Context, tokenUUID s
 
 // DeleteImageShareGroupMemberToken revokes one accepted membership token from an owned image share group.
 func (c *Client) httpDeleteImageShareGroupMemberToken(ctx context.Context, shareGroupID int, tokenUUID string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointImageShareGroups + "/" + escapeImageShareGroupID(shareGroupID) + "/members/" + escapeImageShareGroupTokenUUID(tokenUUID)
@@ -881,7 +881,7 @@ func (c *Client) httpDeleteImageShareGroupMemberToken(ctx context.Context, share
 
 // httpCreateImageProto creates an image and decodes the response as a proto message.
 func (c *Client) httpCreateImageProto(ctx context.Context, req *CreateImageRequest) (*linodev1.Image, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointImages, req)
@@ -904,7 +904,7 @@ func (c *Client) httpCreateImageProto(ctx context.Context, req *CreateImageReque
 // is {image, upload_to}; the image sub-object is protojson-decoded into the proto
 // Image element (DiscardUnknown) so the output matches the Python serializer.
 func (c *Client) httpUploadImageProto(ctx context.Context, req *UploadImageRequest) (*linodev1.Image, string, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointImagesUpload, req)
@@ -941,7 +941,7 @@ func (c *Client) httpListStackScriptsProto(ctx context.Context) ([]*linodev1.Sta
 
 // GetStackScript retrieves a single StackScript by ID.
 func (c *Client) httpGetStackScript(ctx context.Context, stackScriptID int) (*StackScript, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointStackScripts + "/" + url.PathEscape(strconv.Itoa(stackScriptID))
@@ -963,7 +963,7 @@ func (c *Client) httpGetStackScript(ctx context.Context, stackScriptID int) (*St
 
 // httpGetStackScriptProto retrieves one StackScript as a proto message.
 func (c *Client) httpGetStackScriptProto(ctx context.Context, stackScriptID int) (*linodev1.StackScript, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointStackScripts + "/" + url.PathEscape(strconv.Itoa(stackScriptID))
@@ -987,7 +987,7 @@ func (c *Client) httpGetStackScriptProto(ctx context.Context, stackScriptID int)
 // the StackScript proto element so the write tool emits the same shape as the
 // StackScript read path.
 func (c *Client) httpCreateStackScriptProto(ctx context.Context, req *CreateStackScriptRequest) (*linodev1.StackScript, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointStackScripts, req)
@@ -1016,7 +1016,7 @@ func (c *Client) httpUpdateStackScriptProto(ctx context.Context, stackScriptID i
 		return nil, ErrStackScriptUpdateRequired
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedStackScriptID := strings.ReplaceAll(url.PathEscape(strconv.Itoa(stackScriptID)), ".", "%2E")
@@ -1039,7 +1039,7 @@ func (c *Client) httpUpdateStackScriptProto(ctx context.Context, stackScriptID i
 
 // DeleteStackScript deletes a StackScript.
 func (c *Client) httpDeleteStackScript(ctx context.Context, stackScriptID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointStackScripts+"/%d", stackScriptID)
@@ -1064,7 +1064,7 @@ func updateStackScriptRequestEmpty(req *UpdateStackScriptRequest) bool {
 
 // BootInstance boots a Linode instance.
 func (c *Client) httpBootInstance(ctx context.Context, instanceID int, configID *int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstances+"/%d/boot", instanceID)
@@ -1086,7 +1086,7 @@ func (c *Client) httpBootInstance(ctx context.Context, instanceID int, configID
 
 // RebootInstance reboots a Linode instance.
 func (c *Client) httpRebootInstance(ctx context.Context, instanceID int, configID *int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstances+"/%d/reboot", instanceID)
@@ -1108,7 +1108,7 @@ func (c *Client) httpRebootInstance(ctx context.Context, instanceID int, configI
 
 // ShutdownInstance shuts down a Linode instance.
 func (c *Client) httpShutdownInstance(ctx context.Context, instanceID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstances+"/%d/shutdown", instanceID)
@@ -1126,7 +1126,7 @@ func (c *Client) httpShutdownInstance(ctx context.Context, instanceID int) error
 // httpCreateInstanceProto creates a Linode instance and decodes the response as
 // a proto message for the proto-backed write path.
 func (c *Client) httpCreateInstanceProto(ctx context.Context, req *CreateInstanceRequest) (*linodev1.Instance, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointInstances, req)
@@ -1147,7 +1147,7 @@ func (c *Client) httpCreateInstanceProto(ctx context.Context, req *CreateInstanc
 // httpUpdateInstanceProto updates a Linode instance and decodes the response as
 // a proto message for the proto-backed write path.
 func (c *Client) httpUpdateInstanceProto(ctx context.Context, instanceID int, req *UpdateInstanceRequest) (*linodev1.Instance, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstances+"/%d", instanceID)
@@ -1169,7 +1169,7 @@ func (c *Client) httpUpdateInstanceProto(ctx context.Context, instanceID int, re
 
 // DeleteInstance deletes a Linode instance.
 func (c *Client) httpDeleteInstance(ctx context.Context, instanceID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstances+"/%d", instanceID)
@@ -1186,7 +1186,7 @@ func (c *Client) httpDeleteInstance(ctx context.Context, instanceID int) error {
 
 // ResizeInstance resizes a Linode instance to a new plan.
 func (c *Client) httpResizeInstance(ctx context.Context, instanceID int, req ResizeInstanceRequest) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstances+"/%d/resize", instanceID)
@@ -37,7 +37,7 @@ func (c *Client) httpListDatabaseTypesProto(ctx context.Context, page, pageSize
 
 // httpGetDatabaseTypeProto retrieves one Managed Database type as a proto message.
 func (c *Client) httpGetDatabaseTypeProto(ctx context.Context, typeID string, page, pageSize int) (*linodev1.DatabaseType, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabaseTypes + "/" + url.PathEscape(typeID)
@@ -86,7 +86,7 @@ func (c *Client) httpListDatabasePostgreSQLInstancesProto(ctx context.Context, p
 
 // GetDatabaseInstance retrieves one MySQL Managed Database instance.
 func (c *Client) httpGetDatabaseInstance(ctx context.Context, instanceID int) (*DatabaseInstance, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabaseInstances + "/" + url.PathEscape(strconv.Itoa(instanceID))
@@ -108,7 +108,7 @@ func (c *Client) httpGetDatabaseInstance(ctx context.Context, instanceID int) (*
 
 // GetDatabasePostgreSQLInstance retrieves one PostgreSQL Managed Database instance.
 func (c *Client) httpGetDatabasePostgreSQLInstance(ctx context.Context, instanceID int) (*DatabaseInstance, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabasePostgreSQLInstances + "/" + url.PathEscape(strconv.Itoa(instanceID))
@@ -133,7 +133,7 @@ func (c *Client) httpGetDatabasePostgreSQLInstance(ctx context.Context, instance
 // path. The GET returns the bare instance object, so the body decodes straight
 // into the element with DiscardUnknown, matching the list decode.
 func (c *Client) httpGetDatabaseInstanceProto(ctx context.Context, instanceID int) (*linodev1.DatabaseInstance, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabaseInstances + "/" + url.PathEscape(strconv.Itoa(instanceID))
@@ -157,7 +157,7 @@ func (c *Client) httpGetDatabaseInstanceProto(ctx context.Context, instanceID in
 // Database instance and decodes it into the DatabaseInstance proto element for
 // the proto-backed read path.
 func (c *Client) httpGetDatabasePostgreSQLInstanceProto(ctx context.Context, instanceID int) (*linodev1.DatabaseInstance, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabasePostgreSQLInstances + "/" + url.PathEscape(strconv.Itoa(instanceID))
@@ -180,7 +180,7 @@ func (c *Client) httpGetDatabasePostgreSQLInstanceProto(ctx context.Context, ins
 // httpGetDatabaseInstanceSSLProto retrieves a MySQL database SSL certificate as a
 // proto message.
 func (c *Client) httpGetDatabaseInstanceSSLProto(ctx context.Context, instanceID int) (*linodev1.DatabaseSSL, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabaseInstances + "/" + url.PathEscape(strconv.Itoa(instanceID)) + "/ssl"
@@ -203,7 +203,7 @@ func (c *Client) httpGetDatabaseInstanceSSLProto(ctx context.Context, instanceID
 // httpGetDatabasePostgreSQLInstanceSSLProto retrieves a PostgreSQL database SSL
 // certificate as a proto message.
 func (c *Client) httpGetDatabasePostgreSQLInstanceSSLProto(ctx context.Context, instanceID int) (*linodev1.DatabaseSSL, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabasePostgreSQLInstances + "/" + url.PathEscape(strconv.Itoa(instanceID)) + "/ssl"
@@ -225,7 +225,7 @@ func (c *Client) httpGetDatabasePostgreSQLInstanceSSLProto(ctx context.Context,
 
 // GetDatabaseInstanceCredentials retrieves MySQL Managed Database credentials.
 func (c *Client) httpGetDatabaseInstanceCredentials(ctx context.Context, instanceID int) (*DatabaseCredentials, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabaseInstances + "/" + url.PathEscape(strconv.Itoa(instanceID)) + "/credentials"
@@ -247,7 +247,7 @@ func (c *Client) httpGetDatabaseInstanceCredentials(ctx context.Context, instanc
 
 // GetDatabasePostgreSQLInstanceCredentials retrieves PostgreSQL Managed Database credentials.
 func (c *Client) httpGetDatabasePostgreSQLInstanceCredentials(ctx context.Context, instanceID int) (*DatabaseCredentials, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabasePostgreSQLInstances + "/" + url.PathEscape(strconv.Itoa(instanceID)) + "/credentials"
@@ -269,7 +269,7 @@ func (c *Client) httpGetDatabasePostgreSQLInstanceCredentials(ctx context.Contex
 
 // ResetDatabaseInstanceCredentials resets MySQL Managed Database credentials.
 func (c *Client) httpResetDatabaseInstanceCredentials(ctx context.Context, instanceID int) (*DatabaseCredentials, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabaseInstances + "/" + url.PathEscape(strconv.Itoa(instanceID)) + "/credentials/reset"
@@ -291,7 +291,7 @@ func (c *Client) httpResetDatabaseInstanceCredentials(ctx context.Context, insta
 
 // ResetDatabasePostgreSQLInstanceCredentials resets PostgreSQL Managed Database credentials.
 func (c *Client) httpResetDatabasePostgreSQLInstanceCredentials(ctx context.Context, instanceID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabasePostgreSQLInstances + "/" + url.PathEscape(strconv.Itoa(instanceID)) + "/credentials/reset"
@@ -311,7 +311,7 @@ func (c *Client) httpResetDatabasePostgreSQLInstanceCredentials(ctx context.Cont
 // update paths only differ by method, endpoint, and operation label, so they
 // route through this one helper to keep dupl happy.
 func (c *Client) writeDatabaseInstanceProto(ctx context.Context, operation, method, endpoint string, body any) (*linodev1.DatabaseInstance, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, method, endpoint, body)
@@ -332,7 +332,7 @@ func (c *Client) writeDatabaseInstanceProto(ctx context.Context, operation, meth
 // httpCreateDatabaseInstanceProto creates a MySQL Managed Database instance and
 // decodes the response into the proto element.
 func (c *Client) httpCreateDatabaseInstanceProto(ctx context.Context, req *CreateDatabaseInstanceRequest) (*linodev1.DatabaseInstance, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointDatabaseInstances, req)
@@ -374,7 +374,7 @@ func (c *Client) httpUpdateDatabasePostgreSQLInstanceProto(ctx context.Context,
 
 // DeleteDatabaseInstance deletes one MySQL Managed Database instance.
 func (c *Client) httpDeleteDatabaseInstance(ctx context.Context, instanceID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabaseInstances + "/" + url.PathEscape(strconv.Itoa(instanceID))
@@ -391,7 +391,7 @@ func (c *Client) httpDeleteDatabaseInstance(ctx context.Context, instanceID int)
 
 // DeleteDatabasePostgreSQLInstance deletes one PostgreSQL Managed Database instance.
 func (c *Client) httpDeleteDatabasePostgreSQLInstance(ctx context.Context, instanceID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabasePostgreSQLInstances + "/" + url.PathEscape(strconv.Itoa(instanceID))
@@ -408,7 +408,7 @@ func (c *Client) httpDeleteDatabasePostgreSQLInstance(ctx context.Context, insta
 
 // PatchDatabaseInstance applies security patches and updates to one MySQL Managed Database instance.
 func (c *Client) httpPatchDatabaseInstance(ctx context.Context, instanceID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabaseInstances + "/" + url.PathEscape(strconv.Itoa(instanceID)) + "/patch"
@@ -425,7 +425,7 @@ func (c *Client) httpPatchDatabaseInstance(ctx context.Context, instanceID int)
 
 // PatchDatabasePostgreSQLInstance applies security patches and updates to one PostgreSQL Managed Database instance.
 func (c *Client) httpPatchDatabasePostgreSQLInstance(ctx context.Context, instanceID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabasePostgreSQLInstances + "/" + url.PathEscape(strconv.Itoa(instanceID)) + "/patch"
@@ -442,7 +442,7 @@ func (c *Client) httpPatchDatabasePostgreSQLInstance(ctx context.Context, instan
 
 // SuspendDatabaseInstance suspends one active MySQL Managed Database instance.
 func (c *Client) httpSuspendDatabaseInstance(ctx context.Context, instanceID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabaseInstances + "/" + url.PathEscape(strconv.Itoa(instanceID)) + "/suspend"
@@ -459,7 +459,7 @@ func (c *Client) httpSuspendDatabaseInstance(ctx context.Context, instanceID int
 
 // SuspendDatabasePostgreSQLInstance suspends one active PostgreSQL Managed Database instance.
 func (c *Client) httpSuspendDatabasePostgreSQLInstance(ctx context.Context, instanceID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabasePostgreSQLInstances + "/" + url.PathEscape(strconv.Itoa(instanceID)) + "/suspend"
@@ -476,7 +476,7 @@ func (c *Client) httpSuspendDatabasePostgreSQLInstance(ctx context.Context, inst
 
 // ResumeDatabaseInstance resumes one suspended MySQL Managed Database instance.
 func (c *Client) httpResumeDatabaseInstance(ctx context.Context, instanceID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabaseInstances + "/" + url.PathEscape(strconv.Itoa(instanceID)) + "/resume"
@@ -493,7 +493,7 @@ func (c *Client) httpResumeDatabaseInstance(ctx context.Context, instanceID int)
 
 // ResumeDatabasePostgreSQLInstance resumes one suspended PostgreSQL Managed Database instance.
 func (c *Client) httpResumeDatabasePostgreSQLInstance(ctx context.Context, instanceID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabasePostgreSQLInstances + "/" + url.PathEscape(strconv.Itoa(instanceID)) + "/resume"
@@ -510,7 +510,7 @@ func (c *Client) httpResumeDatabasePostgreSQLInstance(ctx context.Context, insta
 
 // GetDatabaseMySQLConfig retrieves MySQL Managed Database advanced parameters.
 func (c *Client) httpGetDatabaseMySQLConfig(ctx context.Context) (map[string]any, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointDatabaseMySQLConfig, nil)
@@ -530,7 +530,7 @@ func (c *Client) httpGetDatabaseMySQLConfig(ctx context.Context) (map[string]any
 
 // GetDatabasePostgreSQLConfig retrieves PostgreSQL Managed Database advanced parameters.
 func (c *Client) httpGetDatabasePostgreSQLConfig(ctx context.Context) (map[string]any, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointDatabasePostgreSQLConfig, nil)
@@ -551,7 +551,7 @@ func (c *Client) httpGetDatabasePostgreSQLConfig(ctx context.Context) (map[strin
 // httpGetDatabaseEngineProto retrieves one Managed Database engine as a proto
 // message.
 func (c *Client) httpGetDatabaseEngineProto(ctx context.Context, engineID string) (*linodev1.DatabaseEngine, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointDatabaseEngines + "/" + url.PathEscape(engineID)
@@ -570,3 +570,56 @@ func (c *Client) httpGetDatabaseEngineProto(ctx context.Context, engineID string
 
 	return engine, nil
 }
+
+// httpListDatabaseBackupsProto retrieves the backups of one MySQL Managed
+// Database instance as proto messages. page/page_size flow through
+// withPaginationQuery inside listProtoElementsPaginated.
+func (c *Client) httpListDatabaseBackupsProto(ctx context.Context, instanceID, page, pageSize int) ([]*linodev1.DatabaseBackup, error) {
+	endpoint := endpointDatabaseInstances + "/" + url.PathEscape(strconv.Itoa(instanceID)) + "/backups"
+
+	return listProtoElementsPaginated(ctx, c, "ListDatabaseBackups", endpoint, page, pageSize,
+		func() *linodev1.DatabaseBackup { return &linodev1.DatabaseBackup{} })
+}
+
+// httpListDatabasePostgreSQLBackupsProto retrieves the backups of one
+// PostgreSQL Managed Database instance as proto messages.
+func (c *Client) httpListDatabasePostgreSQLBackupsProto(ctx context.Context, instanceID, page, pageSize int) ([]*linodev1.DatabaseBackup, error) {
+	endpoint := endpointDatabasePostgreSQLInstances + "/" + url.PathEscape(strconv.Itoa(instanceID)) + "/backups"
+
+	return listProtoElementsPaginated(ctx, c, "ListDatabasePostgreSQLBackups", endpoint, page, pageSize,
+		func() *linodev1.DatabaseBackup { return &linodev1.DatabaseBackup{} })
+}
+
+// createDatabaseBackup posts an on-demand backup request. The endpoint returns
+// an empty body, so there is nothing to decode.
+func (c *Client) createDatabaseBackup(ctx context.Context, operation, instancesEndpoint string, instanceID int, req *CreateDatabaseBackupRequest) error {
+	if req == nil {
+		return ErrCreateDatabaseBackupRequestRequired
+	}
+
+	ctx, cancel := withRequestTimeout(ctx)
+	defer cancel()
+
+	endpoint := instancesEndpoint + "/" + url.PathEscape(strconv.Itoa(instanceID)) + "/backups"
+
+	resp, err := c.makeRequest(ctx, http.MethodPost, endpoint, req)
+	if err != nil {
+		return &NetworkError{Operation: operation, Err: err}
+	}
+
+	defer drainClose(resp)
+
+	return c.handleResponse(resp, nil)
+}
+
+// httpCreateDatabaseBackup takes an on-demand backup of one MySQL Managed
+// Database instance.
+func (c *Client) httpCreateDatabaseBackup(ctx context.Context, instanceID int, req *CreateDatabaseBackupRequest) error {
+	return c.createDatabaseBackup(ctx, "CreateDatabaseBackup", endpointDatabaseInstances, instanceID, req)
+}
+
+// httpCreateDatabasePostgreSQLBackup takes an on-demand backup of one
+// PostgreSQL Managed Database instance.
+func (c *Client) httpCreateDatabasePostgreSQLBackup(ctx context.Context, instanceID int, req *CreateDatabaseBackupRequest) error {
+	return c.createDatabaseBackup(ctx, "CreateDatabasePostgreSQLBackup", endpointDatabasePostgreSQLInstances, instanceID, req)
+}
@@ -21,7 +21,7 @@ func (c *Client) httpListDomainsProto(ctx context.Context) ([]*linodev1.Domain,
 
 // GetDomain retrieves a single DNS domain by its ID.
 func (c *Client) httpGetDomain(ctx context.Context, domainID int) (*Domain, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointDomains+"/%d", domainID)
@@ -41,23 +41,12 @@ func (c *Client) httpGetDomain(ctx context.Context, domainID int) (*Domain, erro
 	return &domain, nil
 }
 
-// ListDomainRecords retrieves all DNS records for a specific domain.
+// ListDomainRecords retrieves all DNS records for a specific domain, walking
+// every page.
 func (c *Client) httpListDomainRecords(ctx context.Context, domainID int) ([]DomainRecord, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
-
-	endpoint := fmt.Sprintf(endpointDomains+"/%d/records", domainID)
-
-	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	response, err := listPaginatedResponse[DomainRecord](ctx, c, "ListDomainRecords",
+		fmt.Sprintf(endpointDomains+"/%d/records", domainID), 0, 0)
 	if err != nil {
-		return nil, &NetworkError{Operation: "ListDomainRecords", Err: err}
-	}
-
-	defer drainClose(resp)
-
-	var response PaginatedResponse[DomainRecord]
-
-	if err := c.handleResponse(resp, &response); err != nil {
 		return nil, err
 	}
 
@@ -78,7 +67,7 @@ func (c *Client) httpListDomainRecordsProto(ctx context.Context, domainID int) (
 // httpGetDomainZoneFileProto retrieves a domain's rendered zone file as a proto
 // message.
 func (c *Client) httpGetDomainZoneFileProto(ctx context.Context, domainID int) (*linodev1.DomainZoneFile, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointDomains+"/%d/zone-file", domainID)
@@ -100,7 +89,7 @@ func (c *Client) httpGetDomainZoneFileProto(ctx context.Context, domainID int) (
 
 // httpImportDomainProto imports a domain and decodes the response as a proto message.
 func (c *Client) httpImportDomainProto(ctx context.Context, req *ImportDomainRequest) (*linodev1.Domain, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointDomains+"/import", req)
@@ -120,7 +109,7 @@ func (c *Client) httpImportDomainProto(ctx context.Context, req *ImportDomainReq
 
 // httpCloneDomainProto clones a domain and decodes the response as a proto message.
 func (c *Client) httpCloneDomainProto(ctx context.Context, domainID int, req *CloneDomainRequest) (*linodev1.Domain, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointDomains+"/%d/clone", domainID)
@@ -142,7 +131,7 @@ func (c *Client) httpCloneDomainProto(ctx context.Context, domainID int, req *Cl
 
 // httpGetDomainProto retrieves a domain as a proto message.
 func (c *Client) httpGetDomainProto(ctx context.Context, domainID int) (*linodev1.Domain, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointDomains+"/%d", domainID)
@@ -164,7 +153,7 @@ func (c *Client) httpGetDomainProto(ctx context.Context, domainID int) (*linodev
 
 // httpCreateDomainProto creates a domain as a proto message.
 func (c *Client) httpCreateDomainProto(ctx context.Context, req *CreateDomainRequest) (*linodev1.Domain, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointDomains, req)
@@ -184,7 +173,7 @@ func (c *Client) httpCreateDomainProto(ctx context.Context, req *CreateDomainReq
 
 // httpUpdateDomainProto updates a domain as a proto message.
 func (c *Client) httpUpdateDomainProto(ctx context.Context, domainID int, req *UpdateDomainRequest) (*linodev1.Domain, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointDomains+"/%d", domainID)
@@ -206,7 +195,7 @@ func (c *Client) httpUpdateDomainProto(ctx context.Context, domainID int, req *U
 
 // DeleteDomain deletes a DNS domain and all its records.
 func (c *Client) httpDeleteDomain(ctx context.Context, domainID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointDomains+"/%d", domainID)
@@ -223,7 +212,7 @@ func (c *Client) httpDeleteDomain(ctx context.Context, domainID int) error {
 
 // GetDomainRecord retrieves a single DNS record by ID within a domain.
 func (c *Client) httpGetDomainRecord(ctx context.Context, domainID, recordID int) (*DomainRecord, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointDomains+"/%d/records/%d", domainID, recordID)
@@ -245,7 +234,7 @@ func (c *Client) httpGetDomainRecord(ctx context.Context, domainID, recordID int
 
 // httpGetDomainRecordProto retrieves a domain record as a proto message.
 func (c *Client) httpGetDomainRecordProto(ctx context.Context, domainID, recordID int) (*linodev1.DomainRecord, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointDomains+"/%d/records/%d", domainID, recordID)
@@ -267,7 +256,7 @@ func (c *Client) httpGetDomainRecordProto(ctx context.Context, domainID, recordI
 
 // httpCreateDomainRecordProto creates a domain record as a proto message.
 func (c *Client) httpCreateDomainRecordProto(ctx context.Context, domainID int, req *CreateDomainRecordRequest) (*linodev1.DomainRecord, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointDomains+"/%d/records", domainID)
@@ -289,7 +278,7 @@ func (c *Client) httpCreateDomainRecordProto(ctx context.Context, domainID int,
 
 // httpUpdateDomainRecordProto updates a domain record as a proto message.
 func (c *Client) httpUpdateDomainRecordProto(ctx context.Context, domainID, recordID int, req *UpdateDomainRecordRequest) (*linodev1.DomainRecord, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointDomains+"/%d/records/%d", domainID, recordID)
@@ -311,7 +300,7 @@ func (c *Client) httpUpdateDomainRecordProto(ctx context.Context, domainID, reco
 
 // DeleteDomainRecord deletes a DNS record from a domain.
 func (c *Client) httpDeleteDomainRecord(ctx context.Context, domainID, recordID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointDomains+"/%d/records/%d", domainID, recordID)
@@ -19,7 +19,7 @@ const (
 // a snapshot object), so this decodes the whole structure into
 // InstanceBackupsResponse.
 func (c *Client) httpListInstanceBackupsProto(ctx context.Context, linodeID int) (*linodev1.InstanceBackupsResponse, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/backups", linodeID)
@@ -43,7 +43,7 @@ func (c *Client) httpListInstanceBackupsProto(ctx context.Context, linodeID int)
 // a proto message. The API nests the graphs under a top-level "data" object, so
 // InstanceStats models that wrapper (see instance_stats.proto).
 func (c *Client) httpGetInstanceStatsProto(ctx context.Context, linodeID int) (*linodev1.InstanceStats, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/stats", linodeID)
@@ -80,7 +80,7 @@ func (c *Client) httpGetInstanceTransferByYearMonthProto(ctx context.Context, li
 		return nil, ErrTransferMonthRange
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -105,7 +105,7 @@ func (c *Client) httpGetInstanceTransferByYearMonthProto(ctx context.Context, li
 
 // GetInstanceBackup retrieves a specific backup for a Linode instance.
 func (c *Client) httpGetInstanceBackup(ctx context.Context, linodeID, backupID int) (*InstanceBackup, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/backups/%d", linodeID, backupID)
@@ -127,7 +127,7 @@ func (c *Client) httpGetInstanceBackup(ctx context.Context, linodeID, backupID i
 
 // httpGetInstanceBackupProto retrieves one instance backup as a proto message.
 func (c *Client) httpGetInstanceBackupProto(ctx context.Context, linodeID, backupID int) (*linodev1.InstanceBackup, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/backups/%d", linodeID, backupID)
@@ -149,7 +149,7 @@ func (c *Client) httpGetInstanceBackupProto(ctx context.Context, linodeID, backu
 
 // RestoreInstanceBackup restores a backup to a Linode instance.
 func (c *Client) httpRestoreInstanceBackup(ctx context.Context, linodeID, backupID int, req RestoreBackupRequest) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/backups/%d/restore", linodeID, backupID)
@@ -166,7 +166,7 @@ func (c *Client) httpRestoreInstanceBackup(ctx context.Context, linodeID, backup
 
 // EnableInstanceBackups enables the backup service for a Linode instance.
 func (c *Client) httpEnableInstanceBackups(ctx context.Context, linodeID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/backups/enable", linodeID)
@@ -183,7 +183,7 @@ func (c *Client) httpEnableInstanceBackups(ctx context.Context, linodeID int) er
 
 // CancelInstanceBackups cancels the backup service for a Linode instance.
 func (c *Client) httpCancelInstanceBackups(ctx context.Context, linodeID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/backups/cancel", linodeID)
@@ -204,7 +204,7 @@ func (c *Client) httpApplyInstanceFirewalls(ctx context.Context, linodeID int) e
 		return ErrLinodeIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/firewalls/apply", linodeID)
@@ -230,7 +230,7 @@ func (c *Client) httpAddInstanceInterfaceProto(ctx context.Context, linodeID int
 		return nil, ErrAddInstanceInterfaceRequestRequired
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -266,7 +266,7 @@ func (c *Client) httpUpdateInstanceInterfaceProto(ctx context.Context, linodeID,
 		return nil, ErrUpdateInstanceInterfaceRequestRequired
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -298,7 +298,7 @@ func (c *Client) httpDeleteInstanceInterface(ctx context.Context, linodeID, inte
 		return ErrInterfaceIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -321,7 +321,7 @@ func (c *Client) httpGetInstanceConfigInterface(ctx context.Context, linodeID, c
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedInterfaceID := url.PathEscape(strconv.Itoa(interfaceID))
@@ -349,7 +349,7 @@ func (c *Client) httpGetInstanceConfigInterfaceProto(ctx context.Context, linode
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedInterfaceID := url.PathEscape(strconv.Itoa(interfaceID))
@@ -376,7 +376,7 @@ func (c *Client) httpDeleteInstanceConfigInterface(ctx context.Context, linodeID
 		return err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedInterfaceID := url.PathEscape(strconv.Itoa(interfaceID))
@@ -398,7 +398,7 @@ func (c *Client) httpGetInstanceInterfaceSettings(ctx context.Context, linodeID
 		return nil, ErrLinodeIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -426,7 +426,7 @@ func (c *Client) httpGetInstanceInterfaceSettingsProto(ctx context.Context, lino
 		return nil, ErrLinodeIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -458,7 +458,7 @@ func (c *Client) httpUpdateInstanceInterfaceSettingsProto(ctx context.Context, l
 		return nil, ErrUpdateInterfaceSettingsRequestRequired
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -495,23 +495,12 @@ func validateInstanceConfigInterfaceIDs(linodeID, configID, interfaceID int) err
 	return nil
 }
 
-// ListInstanceDisks retrieves all disks for a Linode instance.
+// ListInstanceDisks retrieves all disks for a Linode instance, walking every
+// page.
 func (c *Client) httpListInstanceDisks(ctx context.Context, linodeID int) ([]InstanceDisk, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
-
-	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/disks", linodeID)
-
-	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	response, err := listPaginatedResponse[InstanceDisk](ctx, c, "ListInstanceDisks",
+		fmt.Sprintf(endpointInstanceDeep+"/%d/disks", linodeID), 0, 0)
 	if err != nil {
-		return nil, &NetworkError{Operation: "ListInstanceDisks", Err: err}
-	}
-
-	defer drainClose(resp)
-
-	var response PaginatedResponse[InstanceDisk]
-
-	if err := c.handleResponse(resp, &response); err != nil {
 		return nil, err
 	}
 
@@ -552,27 +541,18 @@ func instanceConfigEndpoint(linodeID, configID int) string {
 	return fmt.Sprintf(endpointInstanceDeep+"/%s/configs/%s", encodedLinodeID, encodedConfigID)
 }
 
-// ListInstanceConfigs retrieves all configuration profiles for a Linode instance.
+// ListInstanceConfigs retrieves the configuration profiles for a Linode
+// instance. A page of 0 walks every page.
 func (c *Client) httpListInstanceConfigs(ctx context.Context, linodeID, page, pageSize int) ([]InstanceConfig, error) {
 	if linodeID <= 0 {
 		return nil, ErrLinodeIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
-
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
-	endpoint := withPaginationQuery(fmt.Sprintf(endpointInstanceDeep+"/%s/configs", encodedLinodeID), page, pageSize)
 
-	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	response, err := listPaginatedResponse[InstanceConfig](ctx, c, "ListInstanceConfigs",
+		fmt.Sprintf(endpointInstanceDeep+"/%s/configs", encodedLinodeID), page, pageSize)
 	if err != nil {
-		return nil, &NetworkError{Operation: "ListInstanceConfigs", Err: err}
-	}
-
-	defer drainClose(resp)
-
-	var response PaginatedResponse[InstanceConfig]
-	if err := c.handleResponse(resp, &response); err != nil {
 		return nil, err
 	}
 
@@ -596,27 +576,18 @@ func (c *Client) httpListInstanceConfigsProto(ctx context.Context, linodeID, pag
 		func() *linodev1.InstanceConfig { return &linodev1.InstanceConfig{} })
 }
 
-// ListInstanceVolumes retrieves all volumes attached to a Linode instance.
+// ListInstanceVolumes retrieves all volumes attached to a Linode instance. A
+// page of 0 walks every page.
 func (c *Client) httpListInstanceVolumes(ctx context.Context, linodeID, page, pageSize int) ([]Volume, error) {
 	if linodeID <= 0 {
 		return nil, ErrLinodeIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
-
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
-	endpoint := withPaginationQuery(fmt.Sprintf(endpointInstanceDeep+"/%s/volumes", encodedLinodeID), page, pageSize)
 
-	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	response, err := listPaginatedResponse[Volume](ctx, c, "ListInstanceVolumes",
+		fmt.Sprintf(endpointInstanceDeep+"/%s/volumes", encodedLinodeID), page, pageSize)
 	if err != nil {
-		return nil, &NetworkError{Operation: "ListInstanceVolumes", Err: err}
-	}
-
-	defer drainClose(resp)
-
-	var response PaginatedResponse[Volume]
-	if err := c.handleResponse(resp, &response); err != nil {
 		return nil, err
 	}
 
@@ -652,7 +623,7 @@ func (c *Client) httpUpdateInstanceFirewallsProto(ctx context.Context, linodeID,
 		return nil, ErrUpdateInstanceFirewallsRequestRequired
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -693,7 +664,7 @@ func (c *Client) httpUpgradeLinodeInterfacesProto(ctx context.Context, linodeID
 		return nil, ErrLinodeIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -724,7 +695,7 @@ func (c *Client) httpGetInstanceInterface(ctx context.Context, linodeID, interfa
 		return nil, ErrInterfaceIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -757,7 +728,7 @@ func (c *Client) httpGetInstanceInterfaceProto(ctx context.Context, linodeID, in
 		return nil, ErrInterfaceIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -849,7 +820,7 @@ func (c *Client) httpGetInstanceConfig(ctx context.Context, linodeID, configID i
 		return nil, ErrConfigIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -884,7 +855,7 @@ func (c *Client) httpGetInstanceConfigProto(ctx context.Context, linodeID, confi
 		return nil, ErrConfigIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -916,7 +887,7 @@ func (c *Client) httpDeleteInstanceConfig(ctx context.Context, linodeID, configI
 		return ErrConfigIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -947,7 +918,7 @@ func (c *Client) httpReorderInstanceConfigInterfaces(ctx context.Context, linode
 		return ErrReorderConfigInterfacesRequestRequired
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -964,27 +935,18 @@ func (c *Client) httpReorderInstanceConfigInterfaces(ctx context.Context, linode
 	return c.handleResponse(resp, nil)
 }
 
-// ListInstanceFirewalls retrieves all Cloud Firewalls assigned to a Linode instance.
+// ListInstanceFirewalls retrieves all Cloud Firewalls assigned to a Linode
+// instance. A page of 0 walks every page.
 func (c *Client) httpListInstanceFirewalls(ctx context.Context, linodeID, page, pageSize int) ([]Firewall, error) {
 	if linodeID <= 0 {
 		return nil, ErrLinodeIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
-
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
-	endpoint := withPaginationQuery(fmt.Sprintf(endpointInstanceDeep+"/%s/firewalls", encodedLinodeID), page, pageSize)
 
-	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	response, err := listPaginatedResponse[Firewall](ctx, c, "ListInstanceFirewalls",
+		fmt.Sprintf(endpointInstanceDeep+"/%s/firewalls", encodedLinodeID), page, pageSize)
 	if err != nil {
-		return nil, &NetworkError{Operation: "ListInstanceFirewalls", Err: err}
-	}
-
-	defer drainClose(resp)
-
-	var response PaginatedResponse[Firewall]
-	if err := c.handleResponse(resp, &response); err != nil {
 		return nil, err
 	}
 
@@ -1026,7 +988,7 @@ func (c *Client) httpListInstanceNodeBalancersProto(ctx context.Context, linodeI
 
 // GetInstanceDisk retrieves a specific disk for a Linode instance.
 func (c *Client) httpGetInstanceDisk(ctx context.Context, linodeID, diskID int) (*InstanceDisk, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/disks/%d", linodeID, diskID)
@@ -1048,7 +1010,7 @@ func (c *Client) httpGetInstanceDisk(ctx context.Context, linodeID, diskID int)
 
 // httpGetInstanceDiskProto retrieves one instance disk as a proto message.
 func (c *Client) httpGetInstanceDiskProto(ctx context.Context, linodeID, diskID int) (*linodev1.InstanceDisk, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/disks/%d", linodeID, diskID)
@@ -1070,7 +1032,7 @@ func (c *Client) httpGetInstanceDiskProto(ctx context.Context, linodeID, diskID
 
 // DeleteInstanceDisk deletes a disk from a Linode instance.
 func (c *Client) httpDeleteInstanceDisk(ctx context.Context, linodeID, diskID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/disks/%d", linodeID, diskID)
@@ -1087,7 +1049,7 @@ func (c *Client) httpDeleteInstanceDisk(ctx context.Context, linodeID, diskID in
 
 // ResizeInstanceDisk resizes a disk on a Linode instance.
 func (c *Client) httpResizeInstanceDisk(ctx context.Context, linodeID, diskID int, req ResizeDiskRequest) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/disks/%d/resize", linodeID, diskID)
@@ -1112,7 +1074,7 @@ func (c *Client) httpResetInstanceDiskPassword(ctx context.Context, linodeID, di
 		return ErrDiskIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -1133,7 +1095,7 @@ func (c *Client) httpResetInstanceDiskPassword(ctx context.Context, linodeID, di
 
 // ListInstanceIPs retrieves all IP addresses for a Linode instance.
 func (c *Client) httpListInstanceIPs(ctx context.Context, linodeID int) (*InstanceIPAddresses, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/ips", linodeID)
@@ -1157,7 +1119,7 @@ func (c *Client) httpListInstanceIPs(ctx context.Context, linodeID int) (*Instan
 // a Linode instance as a proto message. The /ips endpoint returns a nested
 // object, so this decodes the whole structure into InstanceIPsResponse.
 func (c *Client) httpListInstanceIPsProto(ctx context.Context, linodeID int) (*linodev1.InstanceIPsResponse, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/ips", linodeID)
@@ -1179,7 +1141,7 @@ func (c *Client) httpListInstanceIPsProto(ctx context.Context, linodeID int) (*l
 
 // GetInstanceIP retrieves a specific IP address for a Linode instance.
 func (c *Client) httpGetInstanceIP(ctx context.Context, linodeID int, address string) (*IPAddress, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/ips/%s", linodeID, url.PathEscape(address))
@@ -1201,7 +1163,7 @@ func (c *Client) httpGetInstanceIP(ctx context.Context, linodeID int, address st
 
 // httpGetInstanceIPProto retrieves one instance IP address as a proto message.
 func (c *Client) httpGetInstanceIPProto(ctx context.Context, linodeID int, address string) (*linodev1.IPAddress, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/ips/%s", linodeID, url.PathEscape(address))
@@ -1240,7 +1202,7 @@ func (c *Client) AllocateInstanceIPProto(ctx context.Context, linodeID int, req
 // httpAllocateInstanceIPProto allocates an instance IP and decodes the response
 // into the proto IPAddress element.
 func (c *Client) httpAllocateInstanceIPProto(ctx context.Context, linodeID int, req AllocateIPRequest) (*linodev1.IPAddress, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/ips", linodeID)
@@ -1279,7 +1241,7 @@ func (c *Client) UpdateInstanceIPProto(ctx context.Context, linodeID int, addres
 // httpUpdateInstanceIPProto updates an instance IP's RDNS and decodes the
 // response into the proto IPAddress element.
 func (c *Client) httpUpdateInstanceIPProto(ctx context.Context, linodeID int, address string, req UpdateIPRDNSRequest) (*linodev1.IPAddress, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/ips/%s", linodeID, url.PathEscape(address))
@@ -1301,7 +1263,7 @@ func (c *Client) httpUpdateInstanceIPProto(ctx context.Context, linodeID int, ad
 
 // DeleteInstanceIP removes an IP address from a Linode instance.
 func (c *Client) httpDeleteInstanceIP(ctx context.Context, linodeID int, address string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/ips/%s", linodeID, url.PathEscape(address))
@@ -1319,7 +1281,7 @@ func (c *Client) httpDeleteInstanceIP(ctx context.Context, linodeID int, address
 // httpCloneInstanceProto clones a Linode instance and decodes the response as a
 // proto message for the proto-backed write path.
 func (c *Client) httpCloneInstanceProto(ctx context.Context, linodeID int, req *CloneInstanceRequest) (*linodev1.Instance, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/clone", linodeID)
@@ -1340,15 +1302,15 @@ func (c *Client) httpCloneInstanceProto(ctx context.Context, linodeID int, req *
 }
 
 // MigrateInstance migrates a Linode instance to a new region.
-func (c *Client) httpMigrateInstance(ctx context.Context, linodeID int, region string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+func (c *Client) httpMigrateInstance(ctx context.Context, linodeID int, req MigrateInstanceRequest) error {
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/migrate", linodeID)
 
 	var payload any
-	if region != "" {
-		payload = map[string]string{"region": region}
+	if req != (MigrateInstanceRequest{}) {
+		payload = req
 	}
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpoint, payload)
@@ -1363,7 +1325,7 @@ func (c *Client) httpMigrateInstance(ctx context.Context, linodeID int, region s
 
 // httpMutateInstance upgrades a Linode instance to the latest generation type.
 func (c *Client) httpMutateInstance(ctx context.Context, linodeID int, req *MutateInstanceRequest) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/mutate", linodeID)
@@ -1380,7 +1342,7 @@ func (c *Client) httpMutateInstance(ctx context.Context, linodeID int, req *Muta
 
 // RescueInstance boots a Linode instance into rescue mode.
 func (c *Client) httpRescueInstance(ctx context.Context, linodeID int, req RescueInstanceRequest) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/rescue", linodeID)
@@ -1397,7 +1359,7 @@ func (c *Client) httpRescueInstance(ctx context.Context, linodeID int, req Rescu
 
 // ResetInstancePassword resets the root password on a Linode instance.
 func (c *Client) httpResetInstancePassword(ctx context.Context, linodeID int, rootPass string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/password", linodeID)
@@ -1425,7 +1387,7 @@ func (c *Client) httpCreateInstanceConfigProto(ctx context.Context, linodeID int
 		return nil, ErrCreateConfigRequestRequired
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedLinodeID := url.PathEscape(strconv.Itoa(linodeID))
@@ -1453,7 +1415,7 @@ func (c *Client) httpUpdateInstanceConfigProto(ctx context.Context, linodeID, co
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := instanceConfigEndpoint(linodeID, configID)
@@ -1480,7 +1442,7 @@ func (c *Client) httpAddInstanceConfigInterfaceProto(ctx context.Context, linode
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := instanceConfigEndpoint(linodeID, configID) + "/interfaces"
@@ -1511,7 +1473,7 @@ func (c *Client) httpUpdateInstanceConfigInterfaceProto(ctx context.Context, lin
 		return nil, ErrInterfaceIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedInterfaceID := url.PathEscape(strconv.Itoa(interfaceID))
@@ -1535,7 +1497,7 @@ func (c *Client) httpUpdateInstanceConfigInterfaceProto(ctx context.Context, lin
 // httpCreateInstanceDiskProto creates a disk and decodes the response into the
 // proto element.
 func (c *Client) httpCreateInstanceDiskProto(ctx context.Context, linodeID int, req *CreateDiskRequest) (*linodev1.InstanceDisk, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/disks", linodeID)
@@ -1558,7 +1520,7 @@ func (c *Client) httpCreateInstanceDiskProto(ctx context.Context, linodeID int,
 // httpUpdateInstanceDiskProto updates a disk and decodes the response into the
 // proto element.
 func (c *Client) httpUpdateInstanceDiskProto(ctx context.Context, linodeID, diskID int, req UpdateDiskRequest) (*linodev1.InstanceDisk, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/disks/%d", linodeID, diskID)
@@ -1581,7 +1543,7 @@ func (c *Client) httpUpdateInstanceDiskProto(ctx context.Context, linodeID, disk
 // httpCloneInstanceDiskProto clones a disk and decodes the response into the
 // proto element.
 func (c *Client) httpCloneInstanceDiskProto(ctx context.Context, linodeID, diskID int) (*linodev1.InstanceDisk, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/disks/%d/clone", linodeID, diskID)
@@ -1604,7 +1566,7 @@ func (c *Client) httpCloneInstanceDiskProto(ctx context.Context, linodeID, diskI
 // httpCreateInstanceBackupProto takes a manual snapshot and decodes the response
 // into the proto element.
 func (c *Client) httpCreateInstanceBackupProto(ctx context.Context, linodeID int, label string) (*linodev1.InstanceBackup, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/backups", linodeID)
@@ -1632,7 +1594,7 @@ func (c *Client) httpCreateInstanceBackupProto(ctx context.Context, linodeID int
 // httpRebuildInstanceProto rebuilds a Linode instance and decodes the response
 // into the proto element.
 func (c *Client) httpRebuildInstanceProto(ctx context.Context, linodeID int, req *RebuildInstanceRequest) (*linodev1.Instance, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointInstanceDeep+"/%d/rebuild", linodeID)
@@ -28,7 +28,7 @@ func (c *Client) httpListLKEClustersProto(ctx context.Context) ([]*linodev1.LKEC
 
 // GetLKECluster retrieves a single LKE cluster by its ID.
 func (c *Client) httpGetLKECluster(ctx context.Context, clusterID int) (*LKECluster, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d", clusterID)
@@ -50,7 +50,7 @@ func (c *Client) httpGetLKECluster(ctx context.Context, clusterID int) (*LKEClus
 
 // httpGetLKEClusterProto retrieves an LKE cluster as a proto message.
 func (c *Client) httpGetLKEClusterProto(ctx context.Context, clusterID int) (*linodev1.LKECluster, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d", clusterID)
@@ -72,7 +72,7 @@ func (c *Client) httpGetLKEClusterProto(ctx context.Context, clusterID int) (*li
 
 // httpCreateLKEClusterProto creates an LKE cluster as a proto message.
 func (c *Client) httpCreateLKEClusterProto(ctx context.Context, req *CreateLKEClusterRequest) (*linodev1.LKECluster, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointLKEClusters, req)
@@ -92,7 +92,7 @@ func (c *Client) httpCreateLKEClusterProto(ctx context.Context, req *CreateLKECl
 
 // httpUpdateLKEClusterProto updates an LKE cluster as a proto message.
 func (c *Client) httpUpdateLKEClusterProto(ctx context.Context, clusterID int, req UpdateLKEClusterRequest) (*linodev1.LKECluster, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d", clusterID)
@@ -114,7 +114,7 @@ func (c *Client) httpUpdateLKEClusterProto(ctx context.Context, clusterID int, r
 
 // DeleteLKECluster deletes an LKE cluster.
 func (c *Client) httpDeleteLKECluster(ctx context.Context, clusterID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d", clusterID)
@@ -131,7 +131,7 @@ func (c *Client) httpDeleteLKECluster(ctx context.Context, clusterID int) error
 
 // RecycleLKECluster recycles all nodes in an LKE cluster.
 func (c *Client) httpRecycleLKECluster(ctx context.Context, clusterID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/recycle", clusterID)
@@ -148,7 +148,7 @@ func (c *Client) httpRecycleLKECluster(ctx context.Context, clusterID int) error
 
 // RegenerateLKECluster regenerates the service token for an LKE cluster.
 func (c *Client) httpRegenerateLKECluster(ctx context.Context, clusterID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/regenerate", clusterID)
@@ -163,23 +163,12 @@ func (c *Client) httpRegenerateLKECluster(ctx context.Context, clusterID int) er
 	return c.handleResponse(resp, nil)
 }
 
-// ListLKENodePools retrieves all node pools for an LKE cluster.
+// ListLKENodePools retrieves all node pools for an LKE cluster, walking every
+// page.
 func (c *Client) httpListLKENodePools(ctx context.Context, clusterID int) ([]LKENodePool, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
-
-	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/pools", clusterID)
-
-	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	response, err := listPaginatedResponse[LKENodePool](ctx, c, "ListLKENodePools",
+		fmt.Sprintf(endpointLKEClusters+"/%d/pools", clusterID), 0, 0)
 	if err != nil {
-		return nil, &NetworkError{Operation: "ListLKENodePools", Err: err}
-	}
-
-	defer drainClose(resp)
-
-	var response PaginatedResponse[LKENodePool]
-
-	if err := c.handleResponse(resp, &response); err != nil {
 		return nil, err
 	}
 
@@ -199,7 +188,7 @@ func (c *Client) httpListLKENodePoolsProto(ctx context.Context, clusterID int) (
 
 // GetLKENodePool retrieves a single node pool by its ID.
 func (c *Client) httpGetLKENodePool(ctx context.Context, clusterID, poolID int) (*LKENodePool, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/pools/%d", clusterID, poolID)
@@ -221,7 +210,7 @@ func (c *Client) httpGetLKENodePool(ctx context.Context, clusterID, poolID int)
 
 // httpGetLKENodePoolProto retrieves one LKE node pool as a proto message.
 func (c *Client) httpGetLKENodePoolProto(ctx context.Context, clusterID, poolID int) (*linodev1.LKENodePool, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/pools/%d", clusterID, poolID)
@@ -245,7 +234,7 @@ func (c *Client) httpGetLKENodePoolProto(ctx context.Context, clusterID, poolID
 // proto element so the write tool emits the same field set as the pool GET/LIST
 // path.
 func (c *Client) httpCreateLKENodePoolProto(ctx context.Context, clusterID int, req *CreateLKENodePoolRequest) (*linodev1.LKENodePool, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/pools", clusterID)
@@ -268,7 +257,7 @@ func (c *Client) httpCreateLKENodePoolProto(ctx context.Context, clusterID int,
 // httpUpdateLKENodePoolProto updates a node pool and decodes the response into the
 // proto element.
 func (c *Client) httpUpdateLKENodePoolProto(ctx context.Context, clusterID, poolID int, req UpdateLKENodePoolRequest) (*linodev1.LKENodePool, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/pools/%d", clusterID, poolID)
@@ -290,7 +279,7 @@ func (c *Client) httpUpdateLKENodePoolProto(ctx context.Context, clusterID, pool
 
 // DeleteLKENodePool deletes a node pool from an LKE cluster.
 func (c *Client) httpDeleteLKENodePool(ctx context.Context, clusterID, poolID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/pools/%d", clusterID, poolID)
@@ -307,7 +296,7 @@ func (c *Client) httpDeleteLKENodePool(ctx context.Context, clusterID, poolID in
 
 // RecycleLKENodePool recycles all nodes in a specific node pool.
 func (c *Client) httpRecycleLKENodePool(ctx context.Context, clusterID, poolID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/pools/%d/recycle", clusterID, poolID)
@@ -324,7 +313,7 @@ func (c *Client) httpRecycleLKENodePool(ctx context.Context, clusterID, poolID i
 
 // GetLKENode retrieves a single node by its ID within an LKE cluster.
 func (c *Client) httpGetLKENode(ctx context.Context, clusterID int, nodeID string) (*LKENode, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/nodes/%s", clusterID, url.PathEscape(nodeID))
@@ -346,7 +335,7 @@ func (c *Client) httpGetLKENode(ctx context.Context, clusterID int, nodeID strin
 
 // httpGetLKENodeProto retrieves one LKE cluster node as a proto message.
 func (c *Client) httpGetLKENodeProto(ctx context.Context, clusterID int, nodeID string) (*linodev1.LKENode, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/nodes/%s", clusterID, url.PathEscape(nodeID))
@@ -368,7 +357,7 @@ func (c *Client) httpGetLKENodeProto(ctx context.Context, clusterID int, nodeID
 
 // DeleteLKENode deletes a specific node from an LKE cluster.
 func (c *Client) httpDeleteLKENode(ctx context.Context, clusterID int, nodeID string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/nodes/%s", clusterID, url.PathEscape(nodeID))
@@ -385,7 +374,7 @@ func (c *Client) httpDeleteLKENode(ctx context.Context, clusterID int, nodeID st
 
 // RecycleLKENode recycles a specific node in an LKE cluster.
 func (c *Client) httpRecycleLKENode(ctx context.Context, clusterID int, nodeID string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/nodes/%s/recycle", clusterID, url.PathEscape(nodeID))
@@ -403,7 +392,7 @@ func (c *Client) httpRecycleLKENode(ctx context.Context, clusterID int, nodeID s
 // httpGetLKEKubeconfigProto retrieves an LKE cluster kubeconfig as a proto
 // message.
 func (c *Client) httpGetLKEKubeconfigProto(ctx context.Context, clusterID int) (*linodev1.LKEKubeconfig, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/kubeconfig", clusterID)
@@ -425,7 +414,7 @@ func (c *Client) httpGetLKEKubeconfigProto(ctx context.Context, clusterID int) (
 
 // DeleteLKEKubeconfig deletes and regenerates the kubeconfig for an LKE cluster.
 func (c *Client) httpDeleteLKEKubeconfig(ctx context.Context, clusterID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/kubeconfig", clusterID)
@@ -442,7 +431,7 @@ func (c *Client) httpDeleteLKEKubeconfig(ctx context.Context, clusterID int) err
 
 // httpGetLKEDashboardProto retrieves the LKE dashboard URL as a proto message.
 func (c *Client) httpGetLKEDashboardProto(ctx context.Context, clusterID int) (*linodev1.LKEDashboard, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/dashboard", clusterID)
@@ -475,7 +464,7 @@ func (c *Client) httpListLKEAPIEndpointsProto(ctx context.Context, clusterID int
 
 // DeleteLKEServiceToken deletes and regenerates the service token for an LKE cluster.
 func (c *Client) httpDeleteLKEServiceToken(ctx context.Context, clusterID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/servicetoken", clusterID)
@@ -492,7 +481,7 @@ func (c *Client) httpDeleteLKEServiceToken(ctx context.Context, clusterID int) e
 
 // GetLKEControlPlaneACL retrieves the control plane ACL for an LKE cluster.
 func (c *Client) httpGetLKEControlPlaneACL(ctx context.Context, clusterID int) (*LKEControlPlaneACL, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/control_plane_acl", clusterID)
@@ -522,7 +511,7 @@ func (c *Client) httpGetLKEControlPlaneACL(ctx context.Context, clusterID int) (
 // (DiscardUnknown) into the proto element to keep the full {enabled, addresses}
 // shape the API returns.
 func (c *Client) httpUpdateLKEControlPlaneACLProto(ctx context.Context, clusterID int, req UpdateLKEControlPlaneACLRequest) (*linodev1.LKEControlPlaneACL, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/control_plane_acl", clusterID)
@@ -555,7 +544,7 @@ func (c *Client) httpUpdateLKEControlPlaneACLProto(ctx context.Context, clusterI
 // (DiscardUnknown) into the proto element to keep the full {enabled, addresses}
 // shape the API returns.
 func (c *Client) httpGetLKEControlPlaneACLProto(ctx context.Context, clusterID int) (*linodev1.LKEControlPlaneACL, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/control_plane_acl", clusterID)
@@ -584,7 +573,7 @@ func (c *Client) httpGetLKEControlPlaneACLProto(ctx context.Context, clusterID i
 
 // DeleteLKEControlPlaneACL deletes the control plane ACL for an LKE cluster.
 func (c *Client) httpDeleteLKEControlPlaneACL(ctx context.Context, clusterID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointLKEClusters+"/%d/control_plane_acl", clusterID)
@@ -608,7 +597,7 @@ func (c *Client) httpListLKEVersionsProto(ctx context.Context) ([]*linodev1.LKEV
 
 // httpGetLKEVersionProto retrieves one LKE Kubernetes version as a proto message.
 func (c *Client) httpGetLKEVersionProto(ctx context.Context, versionID string) (*linodev1.LKEVersion, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf("%s/%s", endpointLKEVersions, url.PathEscape(versionID))
@@ -649,7 +638,7 @@ func (c *Client) httpListLKETierVersionsProto(ctx context.Context, tier string)
 // httpGetLKETierVersionProto retrieves one LKE tier Kubernetes version as a proto
 // message.
 func (c *Client) httpGetLKETierVersionProto(ctx context.Context, tierID, versionID string) (*linodev1.LKETierVersion, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf("%s/%s/versions/%s", endpointLKETierVersions, url.PathEscape(tierID), url.PathEscape(versionID))
@@ -10,7 +10,7 @@ import (
 
 // httpGetLongviewPlan retrieves the account Longview subscription plan.
 func (c *Client) httpGetLongviewPlan(ctx context.Context) (*LongviewSubscription, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointLongviewPlan, nil)
@@ -32,7 +32,7 @@ func (c *Client) httpGetLongviewPlan(ctx context.Context) (*LongviewSubscription
 // decodes it into the proto LongviewSubscription element for the proto-backed
 // read path.
 func (c *Client) httpGetLongviewPlanProto(ctx context.Context) (*linodev1.LongviewSubscription, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointLongviewPlan, nil)
@@ -76,7 +76,7 @@ func (c *Client) CreateLongviewClientProto(ctx context.Context, req *CreateLongv
 // httpCreateLongviewClientProto creates a Longview client and decodes the
 // response into the proto CreatedLongviewClient element.
 func (c *Client) httpCreateLongviewClientProto(ctx context.Context, req *CreateLongviewClientRequest) (*linodev1.CreatedLongviewClient, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointLongviewClients, req)
@@ -96,7 +96,7 @@ func (c *Client) httpCreateLongviewClientProto(ctx context.Context, req *CreateL
 
 // httpGetLongviewClient retrieves one Longview client by ID.
 func (c *Client) httpGetLongviewClient(ctx context.Context, clientID string) (*LongviewClient, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointLongviewClients + "/" + url.PathEscape(clientID)
@@ -118,7 +118,7 @@ func (c *Client) httpGetLongviewClient(ctx context.Context, clientID string) (*L
 
 // httpGetLongviewClientProto retrieves a Longview client as a proto message.
 func (c *Client) httpGetLongviewClientProto(ctx context.Context, clientID string) (*linodev1.LongviewClient, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointLongviewClients + "/" + url.PathEscape(clientID)
@@ -141,7 +141,7 @@ func (c *Client) httpGetLongviewClientProto(ctx context.Context, clientID string
 // httpGetLongviewSubscriptionProto retrieves a Longview subscription as a proto
 // message.
 func (c *Client) httpGetLongviewSubscriptionProto(ctx context.Context, subscriptionID string) (*linodev1.LongviewSubscription, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointLongviewSubscriptions + "/" + url.PathEscape(subscriptionID)
@@ -170,7 +170,7 @@ func (c *Client) UpdateLongviewPlanProto(ctx context.Context, req *UpdateLongvie
 // httpUpdateLongviewPlanProto updates the account Longview subscription plan and
 // decodes the response into the proto LongviewSubscription element.
 func (c *Client) httpUpdateLongviewPlanProto(ctx context.Context, req *UpdateLongviewPlanRequest) (*linodev1.LongviewSubscription, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPut, endpointLongviewPlan, req)
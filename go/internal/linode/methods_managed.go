@@ -19,7 +19,7 @@ const (
 
 // httpGetManagedLinodeSettings retrieves Managed settings for one Linode.
 func (c *Client) httpGetManagedLinodeSettings(ctx context.Context, linodeID int) (*ManagedLinodeSettings, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedLinodeSettings + "/" + url.PathEscape(strconv.Itoa(linodeID))
@@ -42,7 +42,7 @@ func (c *Client) httpGetManagedLinodeSettings(ctx context.Context, linodeID int)
 // httpGetManagedLinodeSettingsProto retrieves Managed Linode settings as a proto
 // message.
 func (c *Client) httpGetManagedLinodeSettingsProto(ctx context.Context, linodeID int) (*linodev1.ManagedLinodeSettings, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedLinodeSettings + "/" + url.PathEscape(strconv.Itoa(linodeID))
@@ -64,7 +64,7 @@ func (c *Client) httpGetManagedLinodeSettingsProto(ctx context.Context, linodeID
 
 // httpGetManagedContact retrieves one managed contact by ID.
 func (c *Client) httpGetManagedContact(ctx context.Context, contactID int) (*ManagedContact, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedContacts + "/" + url.PathEscape(strconv.Itoa(contactID))
@@ -86,7 +86,7 @@ func (c *Client) httpGetManagedContact(ctx context.Context, contactID int) (*Man
 
 // httpGetManagedContactProto retrieves a Managed contact as a proto message.
 func (c *Client) httpGetManagedContactProto(ctx context.Context, contactID int) (*linodev1.ManagedContact, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedContacts + "/" + url.PathEscape(strconv.Itoa(contactID))
@@ -108,7 +108,7 @@ func (c *Client) httpGetManagedContactProto(ctx context.Context, contactID int)
 
 // httpDeleteManagedContact deletes one Managed contact.
 func (c *Client) httpDeleteManagedContact(ctx context.Context, contactID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedContacts + "/" + url.PathEscape(strconv.Itoa(contactID))
@@ -125,7 +125,7 @@ func (c *Client) httpDeleteManagedContact(ctx context.Context, contactID int) er
 
 // httpGetManagedStats retrieves Managed statistics from the last 24 hours.
 func (c *Client) httpGetManagedStats(ctx context.Context) (map[string]any, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointManagedStats, nil)
@@ -147,7 +147,7 @@ func (c *Client) httpGetManagedStats(ctx context.Context) (map[string]any, error
 // and decodes the response into the proto element so the write tool emits the
 // same field set as the settings GET/LIST path.
 func (c *Client) httpUpdateManagedLinodeSettingsProto(ctx context.Context, linodeID int, req UpdateManagedLinodeSettingsRequest) (*linodev1.ManagedLinodeSettings, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedLinodeSettings + "/" + url.PathEscape(strconv.Itoa(linodeID))
@@ -169,7 +169,7 @@ func (c *Client) httpUpdateManagedLinodeSettingsProto(ctx context.Context, linod
 
 // httpGetManagedService retrieves one Managed service by ID.
 func (c *Client) httpGetManagedService(ctx context.Context, serviceID int) (*ManagedService, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedServices + "/" + url.PathEscape(strconv.Itoa(serviceID))
@@ -191,7 +191,7 @@ func (c *Client) httpGetManagedService(ctx context.Context, serviceID int) (*Man
 
 // httpGetManagedServiceProto retrieves a Managed service as a proto message.
 func (c *Client) httpGetManagedServiceProto(ctx context.Context, serviceID int) (*linodev1.ManagedService, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedServices + "/" + url.PathEscape(strconv.Itoa(serviceID))
@@ -215,7 +215,7 @@ func (c *Client) httpGetManagedServiceProto(ctx context.Context, serviceID int)
 // the response into the proto element so the write tool emits the same field set
 // as the service GET/LIST path.
 func (c *Client) httpUpdateManagedServiceProto(ctx context.Context, serviceID int, req *UpdateManagedServiceRequest) (*linodev1.ManagedService, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedServices + "/" + url.PathEscape(strconv.Itoa(serviceID))
@@ -237,7 +237,7 @@ func (c *Client) httpUpdateManagedServiceProto(ctx context.Context, serviceID in
 
 // httpDeleteManagedService deletes one Managed service monitor.
 func (c *Client) httpDeleteManagedService(ctx context.Context, serviceID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedServices + "/" + url.PathEscape(strconv.Itoa(serviceID))
@@ -254,7 +254,7 @@ func (c *Client) httpDeleteManagedService(ctx context.Context, serviceID int) er
 
 // httpDisableManagedService disables one Managed service monitor.
 func (c *Client) httpDisableManagedService(ctx context.Context, serviceID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedServices + "/" + url.PathEscape(strconv.Itoa(serviceID)) + "/disable"
@@ -271,7 +271,7 @@ func (c *Client) httpDisableManagedService(ctx context.Context, serviceID int) e
 
 // httpEnableManagedService enables one Managed service monitor.
 func (c *Client) httpEnableManagedService(ctx context.Context, serviceID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedServices + "/" + url.PathEscape(strconv.Itoa(serviceID)) + "/enable"
@@ -320,7 +320,7 @@ func (c *Client) httpListManagedIssuesProto(ctx context.Context, page, pageSize
 
 // httpGetManagedIssueProto retrieves one Managed issue as a proto message.
 func (c *Client) httpGetManagedIssueProto(ctx context.Context, issueID int) (*linodev1.ManagedIssue, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedIssues + "/" + url.PathEscape(strconv.Itoa(issueID))
@@ -344,7 +344,7 @@ func (c *Client) httpGetManagedIssueProto(ctx context.Context, issueID int) (*li
 // response into the proto element so the write tool emits the same field set as
 // the service GET/LIST path.
 func (c *Client) httpCreateManagedServiceProto(ctx context.Context, request *CreateManagedServiceRequest) (*linodev1.ManagedService, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointManagedServices, request)
@@ -366,7 +366,7 @@ func (c *Client) httpCreateManagedServiceProto(ctx context.Context, request *Cre
 // response into the proto element so the write tool emits the same field set as
 // the contact GET/LIST path.
 func (c *Client) httpUpdateManagedContactProto(ctx context.Context, contactID int, req UpdateManagedContactRequest) (*linodev1.ManagedContact, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointManagedContacts + "/" + url.PathEscape(strconv.Itoa(contactID))
@@ -390,7 +390,7 @@ func (c *Client) httpUpdateManagedContactProto(ctx context.Context, contactID in
 // response into the proto element so the write tool emits the same field set as
 // the contact GET/LIST path.
 func (c *Client) httpCreateManagedContactProto(ctx context.Context, request *CreateManagedContactRequest) (*linodev1.ManagedContact, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointManagedContacts, request)
@@ -19,7 +19,7 @@ func (c *Client) httpListMonitorServicesProto(ctx context.Context) ([]*linodev1.
 
 // httpGetMonitorServiceProto retrieves a Monitor service as a proto message.
 func (c *Client) httpGetMonitorServiceProto(ctx context.Context, serviceType string) (*linodev1.MonitorService, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointMonitorServices + "/" + url.PathEscape(serviceType)
@@ -75,7 +75,7 @@ func (c *Client) httpListMonitorServiceDashboardsProto(ctx context.Context, serv
 
 // httpGetMonitorServiceMetrics retrieves metrics for one monitoring service type.
 func (c *Client) httpGetMonitorServiceMetrics(ctx context.Context, serviceType string) (MonitorMetrics, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointMonitorServices + "/" + url.PathEscape(serviceType) + "/metrics"
@@ -99,7 +99,7 @@ func (c *Client) httpGetMonitorServiceMetrics(ctx context.Context, serviceType s
 // type and decodes the response into the MonitorServiceTokenCreateResponse
 // proto message so the write tool emits the proto-canonical body.
 func (c *Client) httpCreateMonitorServiceToken(ctx context.Context, serviceType string, request *CreateMonitorServiceTokenRequest) (*linodev1.MonitorServiceTokenCreateResponse, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointMonitorServices + "/" + url.PathEscape(serviceType) + "/token"
@@ -123,7 +123,7 @@ func (c *Client) httpCreateMonitorServiceToken(ctx context.Context, serviceType
 // decodes the response into the MonitorAlertDefinition proto element so the
 // write tool emits the same field set as the alert-definition GET/LIST path.
 func (c *Client) httpCreateMonitorServiceAlertDefinitionProto(ctx context.Context, serviceType string, request *CreateAlertDefinitionRequest) (*linodev1.MonitorAlertDefinition, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointMonitorServices + "/" + url.PathEscape(serviceType) + "/alert-definitions"
@@ -146,7 +146,7 @@ func (c *Client) httpCreateMonitorServiceAlertDefinitionProto(ctx context.Contex
 // httpUpdateMonitorServiceAlertDefinitionProto updates an alert definition and
 // decodes the response into the MonitorAlertDefinition proto element.
 func (c *Client) httpUpdateMonitorServiceAlertDefinitionProto(ctx context.Context, serviceType string, alertID int, request *UpdateAlertDefinitionRequest) (*linodev1.MonitorAlertDefinition, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointMonitorServices + "/" + url.PathEscape(serviceType) + "/alert-definitions/" + url.PathEscape(strconv.Itoa(alertID))
@@ -168,7 +168,7 @@ func (c *Client) httpUpdateMonitorServiceAlertDefinitionProto(ctx context.Contex
 
 // httpGetMonitorServiceAlertDefinition retrieves one alert definition for one monitoring service type.
 func (c *Client) httpGetMonitorServiceAlertDefinition(ctx context.Context, serviceType string, alertID int) (AlertDefinition, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointMonitorServices + "/" + url.PathEscape(serviceType) + "/alert-definitions/" + url.PathEscape(strconv.Itoa(alertID))
@@ -194,7 +194,7 @@ func (c *Client) httpGetMonitorServiceAlertDefinition(ctx context.Context, servi
 // trigger_conditions are arbitrary JSON the element models as
 // google.protobuf.Struct, so the body decodes straight into the element.
 func (c *Client) httpGetMonitorServiceAlertDefinitionProto(ctx context.Context, serviceType string, alertID int) (*linodev1.MonitorAlertDefinition, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointMonitorServices + "/" + url.PathEscape(serviceType) + "/alert-definitions/" + url.PathEscape(strconv.Itoa(alertID))
@@ -216,7 +216,7 @@ func (c *Client) httpGetMonitorServiceAlertDefinitionProto(ctx context.Context,
 
 // httpDeleteMonitorServiceAlertDefinition deletes one alert definition for one monitoring service type.
 func (c *Client) httpDeleteMonitorServiceAlertDefinition(ctx context.Context, serviceType string, alertID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointMonitorServices + "/" + url.PathEscape(serviceType) + "/alert-definitions/" + url.PathEscape(strconv.Itoa(alertID))
@@ -246,7 +246,7 @@ func (c *Client) httpListMonitorDashboardsProto(ctx context.Context, page, pageS
 // widget is arbitrary JSON the API returns verbatim, so the element models
 // widgets as google.protobuf.Struct; the body decodes straight into the element.
 func (c *Client) httpGetMonitorDashboardProto(ctx context.Context, dashboardID int) (*linodev1.MonitorDashboard, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointMonitorDashboards + "/" + url.PathEscape(strconv.Itoa(dashboardID))
@@ -36,35 +36,64 @@ const (
 	endpointNodeBalancerNodes     = endpointNodeBalancerConfigs + "/%d/nodes"
 )
 
+// reservedIPItem is one reserved IP list element, decoded and raw.
+type reservedIPItem struct {
+	reservedIP *linodev1.ReservedIPAddress
+	raw        json.RawMessage
+}
+
 // httpListReservedIPsProto retrieves reserved public IPv4 addresses with their
-// raw API objects so the tool can preserve documented explicit null fields.
+// raw API objects so the tool can preserve documented explicit null fields. A
+// page of 0 walks every page.
 func (c *Client) httpListReservedIPsProto(ctx context.Context, page, pageSize int) (*ReservedIPListPage, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
+	decode := func(resp *http.Response, envelope map[string]json.RawMessage) ([]reservedIPItem, error) {
+		var rawItems []json.RawMessage
+		if raw, ok := envelope["data"]; ok && len(raw) > 0 {
+			if err := json.Unmarshal(raw, &rawItems); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal ListReservedIPs list envelope: %w", err)
+			}
+		}
 
-	endpoint := withPaginationQuery(endpointNetworkingReservedIPs, page, pageSize)
+		reservedIPs, err := decodeRawProtoItems(resp, c, rawItems, "ListReservedIPs",
+			func() *linodev1.ReservedIPAddress { return &linodev1.ReservedIPAddress{} })
+		if err != nil {
+			return nil, err
+		}
 
-	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, &NetworkError{Operation: "ListReservedIPs", Err: err}
+		items := make([]reservedIPItem, len(reservedIPs))
+		for i, reservedIP := range reservedIPs {
+			items[i] = reservedIPItem{reservedIP: reservedIP, raw: rawItems[i]}
+		}
+
+		return items, nil
 	}
 
-	defer drainClose(resp)
+	var (
+		items []reservedIPItem
+		err   error
+	)
 
-	var envelope struct {
-		Data []json.RawMessage `json:"data"`
-	}
-	if err := c.handleResponse(resp, &envelope); err != nil {
-		return nil, err
+	if page <= 0 {
+		items, err = listPages(ctx, c, "ListReservedIPs", endpointNetworkingReservedIPs, pageSize, decode)
+	} else {
+		items, _, err = listPage(ctx, c, "ListReservedIPs", withPaginationQuery(endpointNetworkingReservedIPs, page, pageSize), decode)
 	}
 
-	reservedIPs, err := decodeRawProtoItems(envelope.Data, "ListReservedIPs",
-		func() *linodev1.ReservedIPAddress { return &linodev1.ReservedIPAddress{} })
 	if err != nil {
 		return nil, err
 	}
 
-	return &ReservedIPListPage{ReservedIPs: reservedIPs, RawReservedIPs: envelope.Data}, nil
+	result := &ReservedIPListPage{
+		ReservedIPs:    make([]*linodev1.ReservedIPAddress, 0, len(items)),
+		RawReservedIPs: make([]json.RawMessage, 0, len(items)),
+	}
+
+	for _, item := range items {
+		result.ReservedIPs = append(result.ReservedIPs, item.reservedIP)
+		result.RawReservedIPs = append(result.RawReservedIPs, item.raw)
+	}
+
+	return result, nil
 }
 
 // httpGetReservedIPRaw retrieves one reserved public IPv4 address while
@@ -75,7 +104,7 @@ func (c *Client) httpGetReservedIPRaw(ctx context.Context, address string) (json
 		return nil, ErrIPv4AddressInvalid
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointNetworkingReservedIPs + "/" + url.PathEscape(address)
@@ -102,7 +131,7 @@ func (c *Client) httpDeleteReservedIP(ctx context.Context, address string) error
 		return ErrIPv4AddressInvalid
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointNetworkingReservedIPs + "/" + url.PathEscape(address)
@@ -127,7 +156,7 @@ func (c *Client) httpDeleteNodeBalancerConfig(ctx context.Context, nodeBalancerI
 		return ErrConfigIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedNodeBalancerID := url.PathEscape(strconv.Itoa(nodeBalancerID))
@@ -151,26 +180,10 @@ func (c *Client) httpListFirewallsProto(ctx context.Context) ([]*linodev1.Firewa
 		func() *linodev1.Firewall { return &linodev1.Firewall{} })
 }
 
-// ListVLANs retrieves all VLANs for the authenticated user.
+// ListVLANs retrieves all VLANs for the authenticated user. A page of 0 walks
+// every page.
 func (c *Client) httpListVLANs(ctx context.Context, page, pageSize int) (*PaginatedResponse[VLAN], error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
-
-	endpoint := withPaginationQuery(endpointNetworkingVLANs, page, pageSize)
-
-	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, &NetworkError{Operation: "ListVLANs", Err: err}
-	}
-
-	defer drainClose(resp)
-
-	var response PaginatedResponse[VLAN]
-	if err := c.handleResponse(resp, &response); err != nil {
-		return nil, err
-	}
-
-	return &response, nil
+	return listPaginatedResponse[VLAN](ctx, c, "ListVLANs", endpointNetworkingVLANs, page, pageSize)
 }
 
 // httpListVLANsProto retrieves VLANs as proto messages for the proto-backed list
@@ -191,7 +204,7 @@ func (c *Client) httpDeleteVLAN(ctx context.Context, regionID, label string) err
 		return ErrLabelRequired
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointNetworkingVLANs+"/%s/%s", url.PathEscape(regionID), url.PathEscape(label))
@@ -229,7 +242,7 @@ func (c *Client) httpListFirewallRules(ctx context.Context, firewallID int) (*Fi
 		return nil, ErrFirewallIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedFirewallID := url.PathEscape(strconv.Itoa(firewallID))
@@ -256,7 +269,7 @@ func (c *Client) httpListFirewallRulesProto(ctx context.Context, firewallID int)
 		return nil, ErrFirewallIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedFirewallID := url.PathEscape(strconv.Itoa(firewallID))
@@ -292,7 +305,7 @@ func (c *Client) httpListFirewallRuleVersionsProto(ctx context.Context, firewall
 		return nil, ErrFirewallIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedFirewallID := url.PathEscape(strconv.Itoa(firewallID))
@@ -349,7 +362,7 @@ func (c *Client) httpGetFirewallRuleVersionProto(ctx context.Context, firewallID
 		return nil, ErrFirewallRuleVersionPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedFirewallID := url.PathEscape(strconv.Itoa(firewallID))
@@ -371,31 +384,17 @@ func (c *Client) httpGetFirewallRuleVersionProto(ctx context.Context, firewallID
 	return ruleVersion, nil
 }
 
-// ListFirewallDevices retrieves devices assigned to a Cloud Firewall.
+// ListFirewallDevices retrieves devices assigned to a Cloud Firewall. A page of
+// 0 walks every page.
 func (c *Client) httpListFirewallDevices(ctx context.Context, firewallID, page, pageSize int) (*PaginatedResponse[FirewallDevice], error) {
 	if firewallID <= 0 {
 		return nil, ErrFirewallIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
-
 	encodedFirewallID := url.PathEscape(strconv.Itoa(firewallID))
-	endpoint := withPaginationQuery(endpointFirewalls+"/"+encodedFirewallID+"/devices", page, pageSize)
-
-	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, &NetworkError{Operation: "ListFirewallDevices", Err: err}
-	}
-
-	defer drainClose(resp)
-
-	var response PaginatedResponse[FirewallDevice]
-	if err := c.handleResponse(resp, &response); err != nil {
-		return nil, err
-	}
 
-	return &response, nil
+	return listPaginatedResponse[FirewallDevice](ctx, c, "ListFirewallDevices",
+		endpointFirewalls+"/"+encodedFirewallID+"/devices", page, pageSize)
 }
 
 // httpListFirewallDevicesProto retrieves a Cloud Firewall's assigned devices as
@@ -439,7 +438,7 @@ func (c *Client) httpCreateFirewallDeviceProto(ctx context.Context, firewallID i
 		return nil, ErrInvalidFirewallDeviceType
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedFirewallID := url.PathEscape(strconv.Itoa(firewallID))
@@ -470,7 +469,7 @@ func (c *Client) httpGetFirewallDevice(ctx context.Context, firewallID, deviceID
 		return nil, ErrFirewallDeviceIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedFirewallID := url.PathEscape(strconv.Itoa(firewallID))
@@ -502,7 +501,7 @@ func (c *Client) httpGetFirewallDeviceProto(ctx context.Context, firewallID, dev
 		return nil, ErrFirewallDeviceIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedFirewallID := url.PathEscape(strconv.Itoa(firewallID))
@@ -534,7 +533,7 @@ func (c *Client) httpDeleteFirewallDevice(ctx context.Context, firewallID, devic
 		return ErrFirewallDeviceIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedFirewallID := url.PathEscape(strconv.Itoa(firewallID))
@@ -562,7 +561,7 @@ func isFirewallDeviceType(deviceType string) bool {
 
 // ListFirewallSettings retrieves default firewall assignments.
 func (c *Client) httpListFirewallSettings(ctx context.Context, page, pageSize int) (*FirewallSettings, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := withPaginationQuery(endpointFirewallSettings, page, pageSize)
@@ -586,7 +585,7 @@ func (c *Client) httpListFirewallSettings(ctx context.Context, page, pageSize in
 // decodes the single-object response into the FirewallSettings proto element so
 // the read tool emits the same shape as the firewall settings write path.
 func (c *Client) httpListFirewallSettingsProto(ctx context.Context, page, pageSize int) (*linodev1.FirewallSettings, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := withPaginationQuery(endpointFirewallSettings, page, pageSize)
@@ -610,7 +609,7 @@ func (c *Client) httpListFirewallSettingsProto(ctx context.Context, page, pageSi
 // decodes the response into the FirewallSettings proto element so the write tool
 // emits the same shape as the firewall settings read path.
 func (c *Client) httpUpdateFirewallSettingsProto(ctx context.Context, req *UpdateFirewallSettingsRequest) (*linodev1.FirewallSettings, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPut, endpointFirewallSettings, req)
@@ -667,7 +666,7 @@ func (c *Client) httpGetFirewallTemplateProto(ctx context.Context, slug string,
 		return nil, ErrInvalidFirewallTemplateSlug
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := withPaginationQuery(endpointFirewallTemplates+"/"+url.PathEscape(slug), page, pageSize)
@@ -689,7 +688,7 @@ func (c *Client) httpGetFirewallTemplateProto(ctx context.Context, slug string,
 
 // GetFirewall retrieves a single firewall by its ID.
 func (c *Client) httpGetFirewall(ctx context.Context, firewallID int) (*Firewall, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointFirewalls+"/%d", firewallID)
@@ -711,7 +710,7 @@ func (c *Client) httpGetFirewall(ctx context.Context, firewallID int) (*Firewall
 
 // httpGetFirewallProto retrieves one Cloud Firewall as a proto message.
 func (c *Client) httpGetFirewallProto(ctx context.Context, firewallID int) (*linodev1.Firewall, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointFirewalls+"/%d", firewallID)
@@ -733,7 +732,7 @@ func (c *Client) httpGetFirewallProto(ctx context.Context, firewallID int) (*lin
 
 // DeleteFirewall deletes a Cloud Firewall.
 func (c *Client) httpDeleteFirewall(ctx context.Context, firewallID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointFirewalls+"/%d", firewallID)
@@ -752,7 +751,7 @@ func (c *Client) httpDeleteFirewall(ctx context.Context, firewallID int) error {
 // the Firewall proto element so the write tool emits the same field set as the
 // firewall GET/LIST path.
 func (c *Client) httpCreateFirewallProto(ctx context.Context, req CreateFirewallRequest) (*linodev1.Firewall, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointFirewalls, firewallCreateBodyFromRequest(req))
@@ -773,7 +772,7 @@ func (c *Client) httpCreateFirewallProto(ctx context.Context, req CreateFirewall
 // httpUpdateFirewallProto updates a Cloud Firewall and decodes the response into
 // the Firewall proto element.
 func (c *Client) httpUpdateFirewallProto(ctx context.Context, firewallID int, req UpdateFirewallRequest) (*linodev1.Firewall, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointFirewalls+"/%d", firewallID)
@@ -807,13 +806,18 @@ func (c *Client) httpUpdateFirewallRulesProto(ctx context.Context, firewallID in
 		return nil, ErrFirewallRulesRequired
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedFirewallID := url.PathEscape(strconv.Itoa(firewallID))
 	endpoint := endpointFirewalls + "/" + encodedFirewallID + "/rules"
 
-	body := firewallRulesRawReplaceBody{Inbound: req.Inbound, Outbound: req.Outbound}
+	body := firewallRulesRawReplaceBody{
+		Inbound:        req.Inbound,
+		Outbound:       req.Outbound,
+		InboundPolicy:  req.InboundPolicy,
+		OutboundPolicy: req.OutboundPolicy,
+	}
 
 	resp, err := c.makeRequest(ctx, http.MethodPut, endpoint, body)
 	if err != nil {
@@ -852,7 +856,7 @@ func (c *Client) httpGetNetworkingIP(ctx context.Context, address string) (*IPAd
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointNetworkingIPs + "/" + url.PathEscape(address)
@@ -878,7 +882,7 @@ func (c *Client) httpGetNetworkingIPProto(ctx context.Context, address string) (
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointNetworkingIPs + "/" + url.PathEscape(address)
@@ -909,7 +913,7 @@ func (c *Client) httpUpdateNetworkingIPProto(ctx context.Context, address string
 		return nil, ErrRDNSRequired
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointNetworkingIPs + "/" + url.PathEscape(address)
@@ -936,7 +940,7 @@ func (c *Client) httpAllocateNetworkingIPProto(ctx context.Context, req Allocate
 		return nil, ErrLinodeIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointNetworkingIPs, req)
@@ -960,7 +964,7 @@ func (c *Client) httpAssignNetworkingIPs(ctx context.Context, req AssignNetworki
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointNetworkingIPsAssign, req)
@@ -988,7 +992,7 @@ func (c *Client) httpAssignNetworkingIPv4s(ctx context.Context, req AssignNetwor
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointNetworkingIPv4Assign, req)
@@ -1057,7 +1061,7 @@ func (c *Client) httpShareNetworkingIPv4s(ctx context.Context, req ShareNetworki
 		return nil, ErrIPAddressRequired
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointNetworkingIPv4Share, req)
@@ -1091,7 +1095,7 @@ func (c *Client) httpShareNetworkingIPs(ctx context.Context, req ShareNetworking
 		return nil, ErrIPAddressRequired
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointNetworkingIPsShare, req)
@@ -1152,7 +1156,7 @@ func (c *Client) httpCreateIPv6RangeProto(ctx context.Context, req CreateIPv6Ran
 		}
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointNetworkingIPv6Ranges, req)
@@ -1186,7 +1190,7 @@ func (c *Client) httpListNodeBalancersProto(ctx context.Context) ([]*linodev1.No
 
 // GetNodeBalancer retrieves a single NodeBalancer by its ID.
 func (c *Client) httpGetNodeBalancer(ctx context.Context, nodeBalancerID int) (*NodeBalancer, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointNodeBalancers+"/%d", nodeBalancerID)
@@ -1217,7 +1221,7 @@ func (c *Client) httpGetNodeBalancerVPCConfigProto(ctx context.Context, nodeBala
 		return nil, ErrConfigIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedNodeBalancerID := url.PathEscape(strconv.Itoa(nodeBalancerID))
@@ -1239,22 +1243,12 @@ func (c *Client) httpGetNodeBalancerVPCConfigProto(ctx context.Context, nodeBala
 	return config, nil
 }
 
-// ListNodeBalancerConfigs retrieves configs for a NodeBalancer by its ID.
+// ListNodeBalancerConfigs retrieves configs for a NodeBalancer by its ID. A
+// page of 0 walks every page.
 func (c *Client) httpListNodeBalancerConfigs(ctx context.Context, nodeBalancerID, page, pageSize int) ([]NodeBalancerConfig, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
-
-	endpoint := withPaginationQuery(fmt.Sprintf(endpointNodeBalancerConfigs, nodeBalancerID), page, pageSize)
-
-	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	response, err := listPaginatedResponse[NodeBalancerConfig](ctx, c, "ListNodeBalancerConfigs",
+		fmt.Sprintf(endpointNodeBalancerConfigs, nodeBalancerID), page, pageSize)
 	if err != nil {
-		return nil, &NetworkError{Operation: "ListNodeBalancerConfigs", Err: err}
-	}
-
-	defer drainClose(resp)
-
-	var response PaginatedResponse[NodeBalancerConfig]
-	if err := c.handleResponse(resp, &response); err != nil {
 		return nil, err
 	}
 
@@ -1273,27 +1267,18 @@ func (c *Client) httpListNodeBalancerConfigsProto(ctx context.Context, nodeBalan
 		func() *linodev1.NodeBalancerConfig { return &linodev1.NodeBalancerConfig{} })
 }
 
-// ListNodeBalancerFirewalls retrieves Cloud Firewalls assigned to a NodeBalancer.
+// ListNodeBalancerFirewalls retrieves Cloud Firewalls assigned to a
+// NodeBalancer. A page of 0 walks every page.
 func (c *Client) httpListNodeBalancerFirewalls(ctx context.Context, nodeBalancerID, page, pageSize int) ([]Firewall, error) {
 	if nodeBalancerID <= 0 {
 		return nil, ErrNodeBalancerIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
-
 	encodedNodeBalancerID := url.PathEscape(strconv.Itoa(nodeBalancerID))
-	endpoint := withPaginationQuery(endpointNodeBalancers+"/"+encodedNodeBalancerID+"/firewalls", page, pageSize)
 
-	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	response, err := listPaginatedResponse[Firewall](ctx, c, "ListNodeBalancerFirewalls",
+		endpointNodeBalancers+"/"+encodedNodeBalancerID+"/firewalls", page, pageSize)
 	if err != nil {
-		return nil, &NetworkError{Operation: "ListNodeBalancerFirewalls", Err: err}
-	}
-
-	defer drainClose(resp)
-
-	var response PaginatedResponse[Firewall]
-	if err := c.handleResponse(resp, &response); err != nil {
 		return nil, err
 	}
 
@@ -1329,7 +1314,7 @@ func (c *Client) httpUpdateNodeBalancerFirewallsProto(ctx context.Context, nodeB
 		return nil, ErrUpdateNodeBalancerFirewallsRequestRequired
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedNodeBalancerID := url.PathEscape(strconv.Itoa(nodeBalancerID))
@@ -1346,7 +1331,8 @@ func (c *Client) httpUpdateNodeBalancerFirewallsProto(ctx context.Context, nodeB
 		func() *linodev1.Firewall { return &linodev1.Firewall{} })
 }
 
-// ListNodeBalancerConfigNodes retrieves nodes for a NodeBalancer config.
+// ListNodeBalancerConfigNodes retrieves nodes for a NodeBalancer config. A
+// page of 0 walks every page.
 func (c *Client) httpListNodeBalancerConfigNodes(ctx context.Context, nodeBalancerID, configID, page, pageSize int) (*PaginatedResponse[NodeBalancerConfigNode], error) {
 	if nodeBalancerID <= 0 {
 		return nil, ErrNodeBalancerIDPositive
@@ -1356,26 +1342,11 @@ func (c *Client) httpListNodeBalancerConfigNodes(ctx context.Context, nodeBalanc
 		return nil, ErrConfigIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
-
 	encodedNodeBalancerID := url.PathEscape(strconv.Itoa(nodeBalancerID))
 	encodedConfigID := url.PathEscape(strconv.Itoa(configID))
-	endpoint := withPaginationQuery(endpointNodeBalancers+"/"+encodedNodeBalancerID+"/configs/"+encodedConfigID+"/nodes", page, pageSize)
-
-	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, &NetworkError{Operation: "ListNodeBalancerConfigNodes", Err: err}
-	}
-
-	defer drainClose(resp)
-
-	var response PaginatedResponse[NodeBalancerConfigNode]
-	if err := c.handleResponse(resp, &response); err != nil {
-		return nil, err
-	}
 
-	return &response, nil
+	return listPaginatedResponse[NodeBalancerConfigNode](ctx, c, "ListNodeBalancerConfigNodes",
+		endpointNodeBalancers+"/"+encodedNodeBalancerID+"/configs/"+encodedConfigID+"/nodes", page, pageSize)
 }
 
 // httpListNodeBalancerConfigNodesProto retrieves the backend nodes of one
@@ -1403,7 +1374,7 @@ func (c *Client) httpListNodeBalancerConfigNodesProto(ctx context.Context, nodeB
 // httpGetNodeBalancerConfigProto retrieves one NodeBalancer config as a proto
 // message.
 func (c *Client) httpGetNodeBalancerConfigProto(ctx context.Context, nodeBalancerID, configID int) (*linodev1.NodeBalancerConfig, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedNodeBalancerID := url.PathEscape(strconv.Itoa(nodeBalancerID))
@@ -1439,7 +1410,7 @@ func (c *Client) httpGetNodeBalancerConfigNode(ctx context.Context, nodeBalancer
 		return nil, ErrNodeIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedNodeBalancerID := url.PathEscape(strconv.Itoa(nodeBalancerID))
@@ -1477,7 +1448,7 @@ func (c *Client) httpGetNodeBalancerConfigNodeProto(ctx context.Context, nodeBal
 		return nil, ErrNodeIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedNodeBalancerID := url.PathEscape(strconv.Itoa(nodeBalancerID))
@@ -1514,7 +1485,7 @@ func (c *Client) httpDeleteNodeBalancerConfigNode(ctx context.Context, nodeBalan
 		return ErrNodeIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedNodeBalancerID := url.PathEscape(strconv.Itoa(nodeBalancerID))
@@ -1536,7 +1507,7 @@ func (c *Client) httpDeleteNodeBalancerConfigNode(ctx context.Context, nodeBalan
 // response into the proto element so the write tool emits the same field set as
 // the config GET/LIST path.
 func (c *Client) httpCreateNodeBalancerConfigProto(ctx context.Context, nodeBalancerID int, req *CreateNodeBalancerConfigRequest) (*linodev1.NodeBalancerConfig, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	if nodeBalancerID < 1 {
@@ -1567,7 +1538,7 @@ func (c *Client) httpCreateNodeBalancerConfigProto(ctx context.Context, nodeBala
 // httpUpdateNodeBalancerConfigProto updates a NodeBalancer config and decodes the
 // response into the proto element.
 func (c *Client) httpUpdateNodeBalancerConfigProto(ctx context.Context, nodeBalancerID, configID int, req *UpdateNodeBalancerConfigRequest) (*linodev1.NodeBalancerConfig, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	if nodeBalancerID < 1 {
@@ -1602,7 +1573,7 @@ func (c *Client) httpUpdateNodeBalancerConfigProto(ctx context.Context, nodeBala
 // httpRebuildNodeBalancerConfigProto rebuilds a NodeBalancer config and decodes
 // the response into the proto element.
 func (c *Client) httpRebuildNodeBalancerConfigProto(ctx context.Context, nodeBalancerID, configID int) (*linodev1.NodeBalancerConfig, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	if nodeBalancerID < 1 {
@@ -1636,7 +1607,7 @@ func (c *Client) httpRebuildNodeBalancerConfigProto(ctx context.Context, nodeBal
 // the response into the proto element so the write tool emits the same field set
 // as the node GET/LIST path.
 func (c *Client) httpCreateNodeBalancerNodeProto(ctx context.Context, nodeBalancerID, configID int, req *CreateNodeBalancerNodeRequest) (*linodev1.NodeBalancerConfigNode, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	if nodeBalancerID < 1 {
@@ -1671,7 +1642,7 @@ func (c *Client) httpCreateNodeBalancerNodeProto(ctx context.Context, nodeBalanc
 // httpUpdateNodeBalancerNodeProto updates a NodeBalancer config node and decodes
 // the response into the proto element.
 func (c *Client) httpUpdateNodeBalancerNodeProto(ctx context.Context, nodeBalancerID, configID, nodeID int, req *UpdateNodeBalancerNodeRequest) (*linodev1.NodeBalancerConfigNode, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	if nodeBalancerID < 1 {
@@ -1718,7 +1689,7 @@ func (c *Client) httpGetNodeBalancerStatsProto(ctx context.Context, nodeBalancer
 		return nil, ErrNodeBalancerIDPositive
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedNodeBalancerID := url.PathEscape(strconv.Itoa(nodeBalancerID))
@@ -1741,7 +1712,7 @@ func (c *Client) httpGetNodeBalancerStatsProto(ctx context.Context, nodeBalancer
 
 // httpGetNodeBalancerProto retrieves a NodeBalancer as a proto message.
 func (c *Client) httpGetNodeBalancerProto(ctx context.Context, nodeBalancerID int) (*linodev1.NodeBalancer, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointNodeBalancers+"/%d", nodeBalancerID)
@@ -1767,7 +1738,7 @@ func (c *Client) httpCreateNodeBalancerProto(ctx context.Context, req CreateNode
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointNodeBalancers, req)
@@ -1800,7 +1771,7 @@ func validateCreateNodeBalancerRequest(req CreateNodeBalancerRequest) error {
 
 // httpUpdateNodeBalancerProto updates a NodeBalancer as a proto message.
 func (c *Client) httpUpdateNodeBalancerProto(ctx context.Context, nodeBalancerID int, req UpdateNodeBalancerRequest) (*linodev1.NodeBalancer, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointNodeBalancers+"/%d", nodeBalancerID)
@@ -1822,7 +1793,7 @@ func (c *Client) httpUpdateNodeBalancerProto(ctx context.Context, nodeBalancerID
 
 // DeleteNodeBalancer deletes a NodeBalancer.
 func (c *Client) httpDeleteNodeBalancer(ctx context.Context, nodeBalancerID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointNodeBalancers+"/%d", nodeBalancerID)
@@ -18,7 +18,7 @@ func (c *Client) httpGetIPv6Range(ctx context.Context, ipv6Range string) (*IPv6R
 		return nil, ErrIPv6RangeInvalid
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedRange := url.PathEscape(ipv6Range)
@@ -49,7 +49,7 @@ func (c *Client) httpGetIPv6RangeProto(ctx context.Context, ipv6Range string) (*
 		return nil, ErrIPv6RangeInvalid
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedRange := url.PathEscape(ipv6Range)
@@ -77,7 +77,7 @@ func (c *Client) httpDeleteIPv6Range(ctx context.Context, ipv6Range string) erro
 		return ErrIPv6RangeInvalid
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	encodedRange := url.PathEscape(ipv6Range)
@@ -1,12 +1,14 @@
 package linode
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 
+	"github.com/chadit/LinodeMCP/go/internal/appinfo"
 	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
 )
 
@@ -41,7 +43,7 @@ func (c *Client) httpListObjectStorageBucketsByRegionProto(ctx context.Context,
 
 // GetObjectStorageBucket retrieves a specific Object Storage bucket.
 func (c *Client) httpGetObjectStorageBucket(ctx context.Context, region, label string) (*ObjectStorageBucket, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s", url.PathEscape(region), url.PathEscape(label))
@@ -64,7 +66,7 @@ func (c *Client) httpGetObjectStorageBucket(ctx context.Context, region, label s
 // httpGetObjectStorageBucketProto retrieves an Object Storage bucket as a proto
 // message.
 func (c *Client) httpGetObjectStorageBucketProto(ctx context.Context, region, label string) (*linodev1.ObjectStorageBucket, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s", url.PathEscape(region), url.PathEscape(label))
@@ -100,7 +102,7 @@ type ObjectStorageBucketContentsPage struct {
 // so this decodes the data[] elements with protojson and returns the truncation
 // metadata alongside them.
 func (c *Client) httpListObjectStorageBucketContentsProto(ctx context.Context, region, label string, params map[string]string) (*ObjectStorageBucketContentsPage, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s/object-list", url.PathEscape(region), url.PathEscape(label))
@@ -131,7 +133,7 @@ func (c *Client) httpListObjectStorageBucketContentsProto(ctx context.Context, r
 		return nil, err
 	}
 
-	objects, err := decodeRawProtoItems(envelope.Data, "ListObjectStorageBucketContents",
+	objects, err := decodeRawProtoItems(resp, c, envelope.Data, "ListObjectStorageBucketContents",
 		func() *linodev1.ObjectStorageObject { return &linodev1.ObjectStorageObject{} })
 	if err != nil {
 		return nil, err
@@ -179,7 +181,7 @@ func (c *Client) httpListObjectStorageKeysProto(ctx context.Context) ([]*linodev
 
 // GetObjectStorageKey retrieves a specific Object Storage access key by ID.
 func (c *Client) httpGetObjectStorageKey(ctx context.Context, keyID int) (*ObjectStorageKey, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjKeys+"/%d", keyID)
@@ -201,7 +203,7 @@ func (c *Client) httpGetObjectStorageKey(ctx context.Context, keyID int) (*Objec
 
 // httpGetObjectStorageKeyProto retrieves an Object Storage key as a proto message.
 func (c *Client) httpGetObjectStorageKeyProto(ctx context.Context, keyID int) (*linodev1.ObjectStorageKey, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjKeys+"/%d", keyID)
@@ -226,7 +228,7 @@ func (c *Client) httpGetObjectStorageKeyProto(ctx context.Context, keyID int) (*
 // byte counts are int64, so protojson serializes them as JSON strings; usage is
 // optional and omitted when the API returns null (before any usage is recorded).
 func (c *Client) httpGetObjectStorageQuotaUsageProto(ctx context.Context, quotaID string) (*linodev1.ObjectStorageQuotaUsage, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjQuotas+"/%s/usage", url.PathEscape(quotaID))
@@ -250,7 +252,7 @@ func (c *Client) httpGetObjectStorageQuotaUsageProto(ctx context.Context, quotaI
 // transfer usage and decodes it into the ObjectStorageTransfer proto element. The
 // used byte count is int64, so protojson serializes it as a JSON string.
 func (c *Client) httpGetObjectStorageTransferProto(ctx context.Context) (*linodev1.ObjectStorageTransfer, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodGet, endpointObjTransfer, nil)
@@ -273,7 +275,7 @@ func (c *Client) httpGetObjectStorageTransferProto(ctx context.Context) (*linode
 // path. The quota GET returns the bare quota object (quota usage is a separate
 // endpoint), so the body decodes straight into the element with DiscardUnknown.
 func (c *Client) httpGetObjectStorageQuotaProto(ctx context.Context, objQuotaID string) (*linodev1.ObjectStorageQuota, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjQuotas+"/%s", url.PathEscape(objQuotaID))
@@ -295,7 +297,7 @@ func (c *Client) httpGetObjectStorageQuotaProto(ctx context.Context, objQuotaID
 
 // CancelObjectStorage cancels Object Storage service for the account.
 func (c *Client) httpCancelObjectStorage(ctx context.Context) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointObjCancel, nil)
@@ -310,7 +312,7 @@ func (c *Client) httpCancelObjectStorage(ctx context.Context) error {
 
 // GetObjectStorageBucketAccess retrieves ACL and CORS settings for a bucket.
 func (c *Client) httpGetObjectStorageBucketAccess(ctx context.Context, region, label string) (*ObjectStorageBucketAccess, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s/access", url.PathEscape(region), url.PathEscape(label))
@@ -333,7 +335,7 @@ func (c *Client) httpGetObjectStorageBucketAccess(ctx context.Context, region, l
 // httpGetObjectStorageBucketAccessProto retrieves a bucket's access config as a
 // proto message.
 func (c *Client) httpGetObjectStorageBucketAccessProto(ctx context.Context, region, label string) (*linodev1.ObjectStorageBucketAccess, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s/access", url.PathEscape(region), url.PathEscape(label))
@@ -356,7 +358,7 @@ func (c *Client) httpGetObjectStorageBucketAccessProto(ctx context.Context, regi
 // httpCreateObjectStorageBucketProto creates an Object Storage bucket as a proto
 // message.
 func (c *Client) httpCreateObjectStorageBucketProto(ctx context.Context, req CreateObjectStorageBucketRequest) (*linodev1.ObjectStorageBucket, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointObjBuckets, req)
@@ -376,7 +378,7 @@ func (c *Client) httpCreateObjectStorageBucketProto(ctx context.Context, req Cre
 
 // DeleteObjectStorageBucket deletes an Object Storage bucket.
 func (c *Client) httpDeleteObjectStorageBucket(ctx context.Context, region, label string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s", url.PathEscape(region), url.PathEscape(label))
@@ -393,7 +395,7 @@ func (c *Client) httpDeleteObjectStorageBucket(ctx context.Context, region, labe
 
 // UpdateObjectStorageBucketAccess updates bucket ACL and CORS settings.
 func (c *Client) httpUpdateObjectStorageBucketAccess(ctx context.Context, region, label string, req UpdateObjectStorageBucketAccessRequest) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s/access", url.PathEscape(region), url.PathEscape(label))
@@ -410,7 +412,7 @@ func (c *Client) httpUpdateObjectStorageBucketAccess(ctx context.Context, region
 
 // AllowObjectStorageBucketAccess applies bucket ACL and CORS settings.
 func (c *Client) httpAllowObjectStorageBucketAccess(ctx context.Context, region, label string, req AllowObjectStorageBucketAccessRequest) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s/access", url.PathEscape(region), url.PathEscape(label))
@@ -427,7 +429,7 @@ func (c *Client) httpAllowObjectStorageBucketAccess(ctx context.Context, region,
 
 // httpCreateObjectStorageKeyProto creates an Object Storage key as a proto message.
 func (c *Client) httpCreateObjectStorageKeyProto(ctx context.Context, req CreateObjectStorageKeyRequest) (*linodev1.ObjectStorageKey, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointObjKeys, req)
@@ -449,7 +451,7 @@ func (c *Client) httpCreateObjectStorageKeyProto(ctx context.Context, req Create
 // proto message. The update endpoint returns the full key without secret
 // material, so the element's secret_key serializes as its empty default.
 func (c *Client) httpUpdateObjectStorageKeyProto(ctx context.Context, keyID int, req UpdateObjectStorageKeyRequest) (*linodev1.ObjectStorageKey, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjKeys+"/%d", keyID)
@@ -471,7 +473,7 @@ func (c *Client) httpUpdateObjectStorageKeyProto(ctx context.Context, keyID int,
 
 // DeleteObjectStorageKey revokes an Object Storage access key.
 func (c *Client) httpDeleteObjectStorageKey(ctx context.Context, keyID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjKeys+"/%d", keyID)
@@ -486,10 +488,44 @@ func (c *Client) httpDeleteObjectStorageKey(ctx context.Context, keyID int) erro
 	return c.handleResponse(resp, nil)
 }
 
+// httpPutPresignedObject uploads data to a presigned PUT URL. The URL carries
+// its own signature, so the request goes straight to the Object Storage
+// endpoint without the API token. S3 answers errors in XML, so a failure is
+// reported by status only.
+func (c *Client) httpPutPresignedObject(ctx context.Context, presignedURL, contentType string, data []byte) error {
+	ctx, cancel := withRequestTimeout(ctx)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, presignedURL, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("User-Agent", "LinodeMCP/"+appinfo.Version)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return &NetworkError{Operation: "PutPresignedObject", Err: &requestError{Method: http.MethodPut, Err: err}}
+	}
+
+	defer drainClose(resp)
+
+	if resp.StatusCode >= httpBadRequest {
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Message:    "upload to presigned URL failed: " + resp.Status,
+			Method:     http.MethodPut,
+		}
+	}
+
+	return nil
+}
+
 // httpCreatePresignedURLProto generates a presigned URL for an object in Object
 // Storage and decodes the response into the PresignedURLResponse proto element.
 func (c *Client) httpCreatePresignedURLProto(ctx context.Context, region, label string, req PresignedURLRequest) (*linodev1.PresignedURLResponse, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s/object-url", url.PathEscape(region), url.PathEscape(label))
@@ -511,7 +547,7 @@ func (c *Client) httpCreatePresignedURLProto(ctx context.Context, region, label
 
 // GetObjectACL retrieves the ACL of an object in Object Storage.
 func (c *Client) httpGetObjectACL(ctx context.Context, region, label, name string) (*ObjectACL, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s/object-acl?name=%s", url.PathEscape(region), url.PathEscape(label), url.QueryEscape(name))
@@ -533,7 +569,7 @@ func (c *Client) httpGetObjectACL(ctx context.Context, region, label, name strin
 
 // httpGetObjectACLProto retrieves an object's ACL as a proto message.
 func (c *Client) httpGetObjectACLProto(ctx context.Context, region, label, name string) (*linodev1.ObjectACL, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s/object-acl?name=%s", url.PathEscape(region), url.PathEscape(label), url.QueryEscape(name))
@@ -556,7 +592,7 @@ func (c *Client) httpGetObjectACLProto(ctx context.Context, region, label, name
 // httpUpdateObjectACLProto updates an object's ACL and decodes the echoed ACL
 // as a proto message.
 func (c *Client) httpUpdateObjectACLProto(ctx context.Context, region, label string, req ObjectACLUpdateRequest) (*linodev1.ObjectACL, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s/object-acl", url.PathEscape(region), url.PathEscape(label))
@@ -578,7 +614,7 @@ func (c *Client) httpUpdateObjectACLProto(ctx context.Context, region, label str
 
 // GetBucketSSL retrieves the SSL/TLS certificate status for an Object Storage bucket.
 func (c *Client) httpGetBucketSSL(ctx context.Context, region, label string) (*BucketSSL, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s/ssl", url.PathEscape(region), url.PathEscape(label))
@@ -600,7 +636,7 @@ func (c *Client) httpGetBucketSSL(ctx context.Context, region, label string) (*B
 
 // httpGetBucketSSLProto retrieves a bucket's TLS status as a proto message.
 func (c *Client) httpGetBucketSSLProto(ctx context.Context, region, label string) (*linodev1.BucketSSL, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s/ssl", url.PathEscape(region), url.PathEscape(label))
@@ -622,7 +658,7 @@ func (c *Client) httpGetBucketSSLProto(ctx context.Context, region, label string
 
 // DeleteBucketSSL deletes the SSL/TLS certificate from an Object Storage bucket.
 func (c *Client) httpDeleteBucketSSL(ctx context.Context, region, label string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s/ssl", url.PathEscape(region), url.PathEscape(label))
@@ -640,7 +676,7 @@ func (c *Client) httpDeleteBucketSSL(ctx context.Context, region, label string)
 // httpUploadBucketSSLProto uploads a certificate and decodes the echoed TLS
 // status as a proto message.
 func (c *Client) httpUploadBucketSSLProto(ctx context.Context, region, label string, req UploadBucketSSLRequest) (*linodev1.BucketSSL, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointObjBuckets+"/%s/%s/ssl", url.PathEscape(region), url.PathEscape(label))
@@ -23,7 +23,7 @@ func (c *Client) httpListPlacementGroupsProto(ctx context.Context, page, pageSiz
 // httpAssignPlacementGroupLinodesProto assigns Linodes to a placement group and
 // decodes the response as a proto message.
 func (c *Client) httpAssignPlacementGroupLinodesProto(ctx context.Context, groupID int, req *AssignPlacementGroupLinodesRequest) (*linodev1.PlacementGroup, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointPlacementGroups + "/" + url.PathEscape(strconv.Itoa(groupID)) + "/assign"
@@ -45,7 +45,7 @@ func (c *Client) httpAssignPlacementGroupLinodesProto(ctx context.Context, group
 
 // GetPlacementGroup retrieves a single placement group by ID.
 func (c *Client) httpGetPlacementGroup(ctx context.Context, groupID int) (*PlacementGroup, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointPlacementGroups+"/%d", groupID)
@@ -67,7 +67,7 @@ func (c *Client) httpGetPlacementGroup(ctx context.Context, groupID int) (*Place
 
 // httpGetPlacementGroupProto retrieves one placement group as a proto message.
 func (c *Client) httpGetPlacementGroupProto(ctx context.Context, groupID int) (*linodev1.PlacementGroup, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointPlacementGroups+"/%d", groupID)
@@ -89,7 +89,7 @@ func (c *Client) httpGetPlacementGroupProto(ctx context.Context, groupID int) (*
 
 // httpCreatePlacementGroupProto creates a placement group as a proto message.
 func (c *Client) httpCreatePlacementGroupProto(ctx context.Context, req *CreatePlacementGroupRequest) (*linodev1.PlacementGroup, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointPlacementGroups, req)
@@ -109,7 +109,7 @@ func (c *Client) httpCreatePlacementGroupProto(ctx context.Context, req *CreateP
 
 // httpUpdatePlacementGroupProto updates a placement group as a proto message.
 func (c *Client) httpUpdatePlacementGroupProto(ctx context.Context, groupID int, request *UpdatePlacementGroupRequest) (*linodev1.PlacementGroup, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointPlacementGroups + "/" + url.PathEscape(strconv.Itoa(groupID))
@@ -131,7 +131,7 @@ func (c *Client) httpUpdatePlacementGroupProto(ctx context.Context, groupID int,
 
 // DeletePlacementGroup deletes a placement group by ID.
 func (c *Client) httpDeletePlacementGroup(ctx context.Context, groupID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointPlacementGroups + "/" + url.PathEscape(strconv.Itoa(groupID))
@@ -157,7 +157,7 @@ func (c *Client) httpUnassignPlacementGroupProto(ctx context.Context, groupID in
 		return nil, ErrPlacementGroupUnassignLinodesRequired
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointPlacementGroups + "/" + url.PathEscape(strconv.Itoa(groupID)) + "/unassign"
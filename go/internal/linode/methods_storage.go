@@ -30,7 +30,7 @@ func (c *Client) httpListVolumeTypesProto(ctx context.Context) ([]*linodev1.Lino
 
 // GetVolume retrieves a single volume by its ID.
 func (c *Client) httpGetVolume(ctx context.Context, volumeID int) (*Volume, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVolumes+"/%d", volumeID)
@@ -53,7 +53,7 @@ func (c *Client) httpGetVolume(ctx context.Context, volumeID int) (*Volume, erro
 // httpGetVolumeProto retrieves a single volume and decodes it as a proto message
 // for the proto-backed read path.
 func (c *Client) httpGetVolumeProto(ctx context.Context, volumeID int) (*linodev1.Volume, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVolumes+"/%d", volumeID)
@@ -76,7 +76,7 @@ func (c *Client) httpGetVolumeProto(ctx context.Context, volumeID int) (*linodev
 // httpCreateVolumeProto creates a volume and decodes the response as a proto
 // message for the proto-backed write path.
 func (c *Client) httpCreateVolumeProto(ctx context.Context, req *CreateVolumeRequest) (*linodev1.Volume, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointVolumes, req)
@@ -97,7 +97,7 @@ func (c *Client) httpCreateVolumeProto(ctx context.Context, req *CreateVolumeReq
 // httpCloneVolumeProto clones a volume and decodes the response as a proto
 // message for the proto-backed write path.
 func (c *Client) httpCloneVolumeProto(ctx context.Context, volumeID int, req CloneVolumeRequest) (*linodev1.Volume, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVolumes+"/%d/clone", volumeID)
@@ -120,7 +120,7 @@ func (c *Client) httpCloneVolumeProto(ctx context.Context, volumeID int, req Clo
 // httpAttachVolumeProto attaches a volume and decodes the response as a proto
 // message for the proto-backed write path.
 func (c *Client) httpAttachVolumeProto(ctx context.Context, volumeID int, req AttachVolumeRequest) (*linodev1.Volume, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVolumes+"/%d/attach", volumeID)
@@ -142,7 +142,7 @@ func (c *Client) httpAttachVolumeProto(ctx context.Context, volumeID int, req At
 
 // DetachVolume detaches a volume from a Linode instance.
 func (c *Client) httpDetachVolume(ctx context.Context, volumeID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVolumes+"/%d/detach", volumeID)
@@ -160,7 +160,7 @@ func (c *Client) httpDetachVolume(ctx context.Context, volumeID int) error {
 // httpResizeVolumeProto resizes a volume and decodes the response as a proto
 // message for the proto-backed write path.
 func (c *Client) httpResizeVolumeProto(ctx context.Context, volumeID, size int) (*linodev1.Volume, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVolumes+"/%d/resize", volumeID)
@@ -183,7 +183,7 @@ func (c *Client) httpResizeVolumeProto(ctx context.Context, volumeID, size int)
 
 // DeleteVolume deletes a block storage volume.
 func (c *Client) httpDeleteVolume(ctx context.Context, volumeID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVolumes+"/%d", volumeID)
@@ -201,7 +201,7 @@ func (c *Client) httpDeleteVolume(ctx context.Context, volumeID int) error {
 // httpUpdateVolumeProto updates a volume and decodes the response as a proto
 // message for the proto-backed write path.
 func (c *Client) httpUpdateVolumeProto(ctx context.Context, volumeID int, req *UpdateVolumeRequest) (*linodev1.Volume, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVolumes+"/%d", volumeID)
@@ -230,7 +230,7 @@ func (c *Client) httpListSSHKeysProto(ctx context.Context) ([]*linodev1.SSHKey,
 
 // GetSSHKey retrieves a single SSH key by its ID.
 func (c *Client) httpGetSSHKey(ctx context.Context, sshKeyID int) (*SSHKey, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointSSHKeys+"/%d", sshKeyID)
@@ -252,7 +252,7 @@ func (c *Client) httpGetSSHKey(ctx context.Context, sshKeyID int) (*SSHKey, erro
 
 // httpGetSSHKeyProto retrieves one SSH key as a proto message.
 func (c *Client) httpGetSSHKeyProto(ctx context.Context, sshKeyID int) (*linodev1.SSHKey, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointSSHKeys+"/%d", sshKeyID)
@@ -275,7 +275,7 @@ func (c *Client) httpGetSSHKeyProto(ctx context.Context, sshKeyID int) (*linodev
 // httpCreateSSHKeyProto creates an SSH key and decodes the created key into a
 // proto message for the proto-backed write path.
 func (c *Client) httpCreateSSHKeyProto(ctx context.Context, req CreateSSHKeyRequest) (*linodev1.SSHKey, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointSSHKeys, req)
@@ -296,7 +296,7 @@ func (c *Client) httpCreateSSHKeyProto(ctx context.Context, req CreateSSHKeyRequ
 // httpUpdateSSHKeyProto updates an SSH key and decodes the updated key into a
 // proto message for the proto-backed write path.
 func (c *Client) httpUpdateSSHKeyProto(ctx context.Context, sshKeyID int, req UpdateSSHKeyRequest) (*linodev1.SSHKey, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointSSHKeys+"/%d", sshKeyID)
@@ -318,7 +318,7 @@ func (c *Client) httpUpdateSSHKeyProto(ctx context.Context, sshKeyID int, req Up
 
 // DeleteSSHKey deletes an SSH key from the user's profile.
 func (c *Client) httpDeleteSSHKey(ctx context.Context, sshKeyID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointSSHKeys+"/%d", sshKeyID)
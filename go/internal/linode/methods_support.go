@@ -19,7 +19,7 @@ const endpointSupportTickets = "/support/tickets"
 // httpCreateSupportTicketProto opens a support ticket and decodes the created
 // ticket into a proto message for the proto-backed write path.
 func (c *Client) httpCreateSupportTicketProto(ctx context.Context, request *CreateSupportTicketRequest) (*linodev1.SupportTicket, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointSupportTickets, request)
@@ -39,7 +39,7 @@ func (c *Client) httpCreateSupportTicketProto(ctx context.Context, request *Crea
 
 // httpGetSupportTicketProto retrieves one support ticket as a proto message.
 func (c *Client) httpGetSupportTicketProto(ctx context.Context, ticketID int) (*linodev1.SupportTicket, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointSupportTickets + "/" + url.PathEscape(strconv.Itoa(ticketID))
@@ -64,7 +64,7 @@ func (c *Client) httpGetSupportTicketProto(ctx context.Context, ticketID int) (*
 // file is read from request.File and sent under the "file" form field, mirroring
 // Python's make_file_request.
 func (c *Client) httpCreateSupportTicketAttachment(ctx context.Context, ticketID int, request *CreateSupportTicketAttachmentRequest) (*SupportTicketAttachment, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	body, contentType, err := supportTicketAttachmentBody(request.File)
@@ -121,7 +121,7 @@ func supportTicketAttachmentBody(path string) (*bytes.Buffer, string, error) {
 // httpCreateSupportTicketReplyProto creates a reply for a support ticket and
 // decodes the created reply into a proto message for the proto-backed write path.
 func (c *Client) httpCreateSupportTicketReplyProto(ctx context.Context, ticketID int, request *CreateSupportTicketReplyRequest) (*linodev1.SupportTicketReply, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointSupportTickets + "/" + url.PathEscape(strconv.Itoa(ticketID)) + "/replies"
@@ -153,7 +153,7 @@ func (c *Client) httpListSupportTicketsProto(ctx context.Context, page, pageSize
 
 // httpCloseSupportTicket closes one support ticket.
 func (c *Client) httpCloseSupportTicket(ctx context.Context, ticketID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointSupportTickets+"/"+url.PathEscape(strconv.Itoa(ticketID))+"/close", nil)
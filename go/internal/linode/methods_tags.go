@@ -18,26 +18,10 @@ func (c *Client) httpListTagsProto(ctx context.Context, page, pageSize int) ([]*
 		func() *linodev1.Tag { return &linodev1.Tag{} })
 }
 
-// httpListTaggedObjects retrieves objects that have the supplied tag label.
+// httpListTaggedObjects retrieves objects that have the supplied tag label. A
+// page of 0 walks every page.
 func (c *Client) httpListTaggedObjects(ctx context.Context, tagLabel string, page, pageSize int) (*PaginatedResponse[TaggedObject], error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
-
-	endpoint := withPaginationQuery(endpointTags+"/"+url.PathEscape(tagLabel), page, pageSize)
-
-	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, &NetworkError{Operation: "ListTaggedObjects", Err: err}
-	}
-
-	defer drainClose(resp)
-
-	var taggedObjects PaginatedResponse[TaggedObject]
-	if err := c.handleResponse(resp, &taggedObjects); err != nil {
-		return nil, err
-	}
-
-	return &taggedObjects, nil
+	return listPaginatedResponse[TaggedObject](ctx, c, "ListTaggedObjects", endpointTags+"/"+url.PathEscape(tagLabel), page, pageSize)
 }
 
 // httpListTaggedObjectsProto retrieves tagged objects as proto messages for the
@@ -51,7 +35,7 @@ func (c *Client) httpListTaggedObjectsProto(ctx context.Context, tagLabel string
 
 // httpCreateTagProto creates a tag and decodes the response as a proto message.
 func (c *Client) httpCreateTagProto(ctx context.Context, req *CreateTagRequest) (*linodev1.Tag, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointTags, req)
@@ -71,7 +55,7 @@ func (c *Client) httpCreateTagProto(ctx context.Context, req *CreateTagRequest)
 
 // httpDeleteTag deletes the supplied tag label from all objects on the account.
 func (c *Client) httpDeleteTag(ctx context.Context, tagLabel string) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := endpointTags + "/" + url.PathEscape(tagLabel)
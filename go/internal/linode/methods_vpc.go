@@ -21,7 +21,7 @@ func (c *Client) httpListVPCsProto(ctx context.Context) ([]*linodev1.Vpc, error)
 
 // GetVPC retrieves a single VPC by its ID.
 func (c *Client) httpGetVPC(ctx context.Context, vpcID int) (*VPC, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVPCs+"/%d", vpcID)
@@ -43,7 +43,7 @@ func (c *Client) httpGetVPC(ctx context.Context, vpcID int) (*VPC, error) {
 
 // httpGetVPCProto retrieves a VPC and decodes it as a proto message.
 func (c *Client) httpGetVPCProto(ctx context.Context, vpcID int) (*linodev1.Vpc, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVPCs+"/%d", vpcID)
@@ -65,7 +65,7 @@ func (c *Client) httpGetVPCProto(ctx context.Context, vpcID int) (*linodev1.Vpc,
 
 // httpCreateVPCProto creates a VPC and decodes it as a proto message.
 func (c *Client) httpCreateVPCProto(ctx context.Context, req CreateVPCRequest) (*linodev1.Vpc, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.makeRequest(ctx, http.MethodPost, endpointVPCs, req)
@@ -85,7 +85,7 @@ func (c *Client) httpCreateVPCProto(ctx context.Context, req CreateVPCRequest) (
 
 // httpUpdateVPCProto updates a VPC and decodes it as a proto message.
 func (c *Client) httpUpdateVPCProto(ctx context.Context, vpcID int, req UpdateVPCRequest) (*linodev1.Vpc, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVPCs+"/%d", vpcID)
@@ -107,7 +107,7 @@ func (c *Client) httpUpdateVPCProto(ctx context.Context, vpcID int, req UpdateVP
 
 // DeleteVPC deletes a VPC.
 func (c *Client) httpDeleteVPC(ctx context.Context, vpcID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVPCs+"/%d", vpcID)
@@ -139,23 +139,11 @@ func (c *Client) httpListVPCIPAddressesProto(ctx context.Context, vpcID int) ([]
 		func() *linodev1.VPCIP { return &linodev1.VPCIP{} })
 }
 
-// ListVPCSubnets retrieves all subnets for a VPC.
+// ListVPCSubnets retrieves all subnets for a VPC, walking every page.
 func (c *Client) httpListVPCSubnets(ctx context.Context, vpcID int) ([]VPCSubnet, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
-
-	endpoint := fmt.Sprintf(endpointVPCs+"/%d/subnets", vpcID)
-
-	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	response, err := listPaginatedResponse[VPCSubnet](ctx, c, "ListVPCSubnets",
+		fmt.Sprintf(endpointVPCs+"/%d/subnets", vpcID), 0, 0)
 	if err != nil {
-		return nil, &NetworkError{Operation: "ListVPCSubnets", Err: err}
-	}
-
-	defer drainClose(resp)
-
-	var response PaginatedResponse[VPCSubnet]
-
-	if err := c.handleResponse(resp, &response); err != nil {
 		return nil, err
 	}
 
@@ -175,7 +163,7 @@ func (c *Client) httpListVPCSubnetsProto(ctx context.Context, vpcID int) ([]*lin
 
 // GetVPCSubnet retrieves a single subnet by its ID within a VPC.
 func (c *Client) httpGetVPCSubnet(ctx context.Context, vpcID, subnetID int) (*VPCSubnet, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVPCs+"/%d/subnets/%d", vpcID, subnetID)
@@ -197,7 +185,7 @@ func (c *Client) httpGetVPCSubnet(ctx context.Context, vpcID, subnetID int) (*VP
 
 // httpGetVPCSubnetProto retrieves a subnet as a proto message.
 func (c *Client) httpGetVPCSubnetProto(ctx context.Context, vpcID, subnetID int) (*linodev1.VpcSubnet, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVPCs+"/%d/subnets/%d", vpcID, subnetID)
@@ -219,7 +207,7 @@ func (c *Client) httpGetVPCSubnetProto(ctx context.Context, vpcID, subnetID int)
 
 // httpCreateVPCSubnetProto creates a subnet as a proto message.
 func (c *Client) httpCreateVPCSubnetProto(ctx context.Context, vpcID int, req CreateSubnetRequest) (*linodev1.VpcSubnet, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVPCs+"/%d/subnets", vpcID)
@@ -241,7 +229,7 @@ func (c *Client) httpCreateVPCSubnetProto(ctx context.Context, vpcID int, req Cr
 
 // httpUpdateVPCSubnetProto updates a subnet as a proto message.
 func (c *Client) httpUpdateVPCSubnetProto(ctx context.Context, vpcID, subnetID int, req UpdateSubnetRequest) (*linodev1.VpcSubnet, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVPCs+"/%d/subnets/%d", vpcID, subnetID)
@@ -263,7 +251,7 @@ func (c *Client) httpUpdateVPCSubnetProto(ctx context.Context, vpcID, subnetID i
 
 // DeleteVPCSubnet deletes a subnet from a VPC.
 func (c *Client) httpDeleteVPCSubnet(ctx context.Context, vpcID, subnetID int) error {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	endpoint := fmt.Sprintf(endpointVPCs+"/%d/subnets/%d", vpcID, subnetID)
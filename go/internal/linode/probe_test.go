@@ -0,0 +1,45 @@
+package linode_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+func TestProbeAPIURL(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/regions" {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("Authorization = %q, want none on the probe", r.Header.Get("Authorization"))
+		}
+
+		if r.Header.Get("X-Gateway-Key") != "gw-secret" {
+			t.Errorf("X-Gateway-Key = %q, want the configured header on the probe", r.Header.Get("X-Gateway-Key"))
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	if err := linode.ProbeAPIURL(t.Context(), srv.URL+"/v4", map[string]string{"X-Gateway-Key": "gw-secret"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := linode.ProbeAPIURL(t.Context(), srv.URL+"/v9", map[string]string{"X-Gateway-Key": "gw-secret"}); !errors.Is(err, linode.ErrAPIVersionNotFound) {
+		t.Errorf("err = %v, want %v", err, linode.ErrAPIVersionNotFound)
+	}
+
+	if err := linode.ProbeAPIURL(t.Context(), "http://127.0.0.1:1/v4", nil); !errors.Is(err, linode.ErrAPIUnreachable) {
+		t.Errorf("err = %v, want %v", err, linode.ErrAPIUnreachable)
+	}
+}
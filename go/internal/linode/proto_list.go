@@ -5,16 +5,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 )
 
-// listProtoElements fetches a paginated list endpoint and protojson-decodes each
-// data[] element into a fresh proto message. This is the shared decode path for
-// every proto-backed list tool: it decodes the {data:[...]} envelope, then
-// protojson-decodes each element with DiscardUnknown so the output matches the
-// Go proto read path and the Python serializer element-for-element.
+// listProtoElements fetches every page of a paginated list endpoint and
+// protojson-decodes each data[] element into a fresh proto message. This is the
+// shared decode path for every proto-backed list tool: it decodes the
+// {data:[...]} envelope, then protojson-decodes each element with DiscardUnknown
+// so the output matches the Go proto read path and the Python serializer
+// element-for-element. Pages after the first are fetched until the envelope's
+// pages count is reached or the call's max_results cap (WithMaxResults) is met.
 //
 // newElem returns a fresh, empty element message (e.g. func() *linodev1.Domain {
 // return &linodev1.Domain{} }); operation names the call for error wrapping.
@@ -24,24 +27,15 @@ func listProtoElements[T proto.Message](
 	operation, endpoint string,
 	newElem func() T,
 ) ([]T, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
-
-	resp, err := client.makeRequest(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, &NetworkError{Operation: operation, Err: err}
-	}
-
-	defer drainClose(resp)
-
-	return decodeProtoElements[T](resp, client, operation, newElem)
+	return listProtoPages(ctx, client, operation, endpoint, 0, newElem)
 }
 
 // listProtoElementsPaginated is listProtoElements for endpoints that take
 // page/page_size query params. It builds the request URL with withPaginationQuery
 // (the same helper the non-proto list methods use, so the runtime request matches
 // the existing httpListX exactly), then decodes the {data:[...]} envelope the same
-// way listProtoElements does.
+// way listProtoElements does. A page of 0 (the caller asked for no page) walks
+// every page at pageSize; an explicit page returns only that page.
 //
 // Sub-resource paginated lists (e.g. /linode/instances/{linode_id}/configs with
 // page/page_size) reuse this helper directly: the caller formats the path id into
@@ -56,42 +50,235 @@ func listProtoElementsPaginated[T proto.Message](
 	page, pageSize int,
 	newElem func() T,
 ) ([]T, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	if page <= 0 {
+		return listProtoPages(ctx, client, operation, endpoint, pageSize, newElem)
+	}
+
+	elems, _, err := listPage(ctx, client, operation, withPaginationQuery(endpoint, page, pageSize),
+		protoItems(client, operation, "data", newElem))
+
+	return elems, err
+}
+
+// listPaginatedResponse is listProtoElementsPaginated for the struct-typed
+// list methods that return a PaginatedResponse. A page of 0 walks every page
+// and returns the items merged as one page that keeps the API's results total,
+// so a walk the max_results cap stopped still reports how many there are; an
+// explicit page returns that page with the counts the API reported.
+func listPaginatedResponse[T any](
+	ctx context.Context,
+	client *Client,
+	operation, endpoint string,
+	page, pageSize int,
+) (*PaginatedResponse[T], error) {
+	if page <= 0 {
+		results := 0
+		decode := func(resp *http.Response, envelope map[string]json.RawMessage) ([]T, error) {
+			results = envelopePageInfo(envelope).results
+
+			return jsonItems[T](client)(resp, envelope)
+		}
+
+		items, err := listPages(ctx, client, operation, endpoint, pageSize, decode)
+		if err != nil {
+			return nil, err
+		}
+
+		return &PaginatedResponse[T]{Data: items, Page: 1, Pages: 1, Results: max(results, len(items))}, nil
+	}
+
+	items, info, err := listPage(ctx, client, operation, withPaginationQuery(endpoint, page, pageSize), jsonItems[T](client))
+	if err != nil {
+		return nil, err
+	}
+
+	return &PaginatedResponse[T]{Data: items, Page: page, Pages: info.pages, Results: info.results}, nil
+}
+
+// listProtoPages walks a paginated list endpoint from page 1 and
+// protojson-decodes each page's data[] elements; see listPages.
+func listProtoPages[T proto.Message](
+	ctx context.Context,
+	client *Client,
+	operation, endpoint string,
+	pageSize int,
+	newElem func() T,
+) ([]T, error) {
+	return listPages(ctx, client, operation, endpoint, pageSize, protoItems(client, operation, "data", newElem))
+}
+
+// pageDecoder turns one list page's envelope, read from resp, into its items.
+type pageDecoder[T any] func(resp *http.Response, envelope map[string]json.RawMessage) ([]T, error)
+
+// protoItems decodes the elements a page holds under itemsKey into fresh proto
+// messages.
+func protoItems[T proto.Message](client *Client, operation, itemsKey string, newElem func() T) pageDecoder[T] {
+	return func(resp *http.Response, envelope map[string]json.RawMessage) ([]T, error) {
+		return decodeEnvelopeItems[T](resp, client, envelope, operation, itemsKey, newElem)
+	}
+}
+
+// jsonItems decodes a page's data[] elements with encoding/json, honoring the
+// client's schema drift mode like any other struct decode.
+func jsonItems[T any](client *Client) pageDecoder[T] {
+	return func(resp *http.Response, envelope map[string]json.RawMessage) ([]T, error) {
+		items := []T{}
+
+		if raw, ok := envelope["data"]; ok && len(raw) > 0 {
+			if err := client.decodeJSON(resp, raw, &items); err != nil {
+				return nil, err
+			}
+		}
+
+		return items, nil
+	}
+}
+
+// listPages walks a paginated list endpoint from page 1, requesting pageSize
+// items per page (0 keeps the API default), and decodes each page with decode.
+// The first request is the bare endpoint, as it was before the walk existed;
+// later pages add page=N. The walk stops after the last page the envelope
+// reports (an envelope without a pages count is a single page), on an empty
+// page, or once the call's max_results cap is met, which it records for
+// ListTruncated when results were left behind. Items outside the call's
+// WithLabelPrefix prefix are dropped before the cap counts them; the API's
+// results total then counts items the walk drops, so it is not recorded.
+func listPages[T any](
+	ctx context.Context,
+	client *Client,
+	operation, endpoint string,
+	pageSize int,
+	decode pageDecoder[T],
+) ([]T, error) {
+	limit := maxResults(ctx)
+
+	var elems []T
+
+	for page := 1; ; page++ {
+		items, info, err := listPage(ctx, client, operation, pageEndpoint(endpoint, page, pageSize), decode)
+		if err != nil {
+			return nil, err
+		}
+
+		elems = append(elems, items...)
+
+		if limit > 0 && len(elems) >= limit {
+			if len(elems) > limit || page < info.pages {
+				total := info.results
+				if labelPrefix(ctx) != "" {
+					total = 0
+				}
+
+				recordTruncated(ctx, total)
+			}
+
+			return elems[:limit], nil
+		}
+
+		if page >= info.pages || len(items)+info.dropped == 0 {
+			if elems == nil {
+				elems = []T{}
+			}
+
+			return elems, nil
+		}
+	}
+}
+
+// listPage fetches one page of a list endpoint and decodes its items and page
+// counts. The request carries the call's WithListOptions filter, and the items
+// outside its WithLabelPrefix prefix are dropped before decoding.
+func listPage[T any](
+	ctx context.Context,
+	client *Client,
+	operation, endpoint string,
+	decode pageDecoder[T],
+) ([]T, pageInfo, error) {
+	ctx, cancel := withRequestTimeout(withListRead(ctx))
 	defer cancel()
 
-	resp, err := client.makeRequest(ctx, http.MethodGet, withPaginationQuery(endpoint, page, pageSize), nil)
+	resp, err := client.makeRequest(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
-		return nil, &NetworkError{Operation: operation, Err: err}
+		return nil, pageInfo{}, &NetworkError{Operation: operation, Err: err}
 	}
 
 	defer drainClose(resp)
 
-	return decodeProtoElements[T](resp, client, operation, newElem)
+	envelope, err := decodeListEnvelope(resp, client, operation)
+	if err != nil {
+		return nil, pageInfo{}, err
+	}
+
+	dropped := filterLabelPrefix(ctx, envelope)
+
+	elems, err := decode(resp, envelope)
+	if err != nil {
+		return nil, pageInfo{}, err
+	}
+
+	info := envelopePageInfo(envelope)
+	info.dropped = dropped
+
+	return elems, info, nil
+}
+
+// pageInfo is the page count and result total of one list envelope, and how
+// many of its items the WithLabelPrefix filter dropped.
+type pageInfo struct {
+	pages   int
+	results int
+	dropped int
+}
+
+// envelopePageInfo reads pages and results from a list envelope. A missing or
+// malformed count reads as 0, which ends a walk after the page that carried it.
+func envelopePageInfo(envelope map[string]json.RawMessage) pageInfo {
+	var info pageInfo
+
+	if raw, ok := envelope["pages"]; ok {
+		_ = json.Unmarshal(raw, &info.pages)
+	}
+
+	if raw, ok := envelope["results"]; ok {
+		_ = json.Unmarshal(raw, &info.results)
+	}
+
+	return info
+}
+
+// pageEndpoint adds the page and page_size params of one page of a walk to
+// endpoint, which may already carry a query. Page 1 is the API default, so it
+// is not sent.
+func pageEndpoint(endpoint string, page, pageSize int) string {
+	if page == 1 {
+		page = 0
+	}
+
+	query := strings.TrimPrefix(withPaginationQuery("", page, pageSize), "?")
+	if query == "" {
+		return endpoint
+	}
+
+	if strings.Contains(endpoint, "?") {
+		return endpoint + "&" + query
+	}
+
+	return endpoint + "?" + query
 }
 
 // listProtoElementsKeyed is listProtoElements for endpoints that wrap their
 // elements under a key other than "data". The current Interfaces generation
 // endpoint /linode/instances/{id}/interfaces returns {"interfaces":[...]} rather
 // than the usual {"data":[...]} page envelope, so this fetcher reads itemsKey
-// instead. The decode tail (DiscardUnknown protojson per element) is shared with
-// the data[] path via decodeProtoElementsKeyed.
+// instead. It walks pages the same way listProtoElements does, so an envelope
+// that starts reporting a pages count is followed rather than cut at page 1.
 func listProtoElementsKeyed[T proto.Message](
 	ctx context.Context,
 	client *Client,
 	operation, endpoint, itemsKey string,
 	newElem func() T,
 ) ([]T, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
-	defer cancel()
-
-	resp, err := client.makeRequest(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, &NetworkError{Operation: operation, Err: err}
-	}
-
-	defer drainClose(resp)
-
-	return decodeProtoElementsKeyed[T](resp, client, operation, itemsKey, newElem)
+	return listPages(ctx, client, operation, endpoint, 0, protoItems(client, operation, itemsKey, newElem))
 }
 
 // listProtoElementsBare fetches endpoints whose response body is a top-level
@@ -102,7 +289,7 @@ func listProtoElementsBare[T proto.Message](
 	operation, endpoint string,
 	newElem func() T,
 ) ([]T, error) {
-	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	ctx, cancel := withRequestTimeout(ctx)
 	defer cancel()
 
 	resp, err := client.makeRequest(ctx, http.MethodGet, endpoint, nil)
@@ -146,7 +333,7 @@ func decodeProtoElementsBare[T proto.Message](
 		return nil, fmt.Errorf("failed to unmarshal %s array: %w", operation, errResponseBodyNotJSONArray)
 	}
 
-	return decodeRawProtoItems[T](rawItems, operation, newElem)
+	return decodeRawProtoItems[T](resp, client, rawItems, operation, newElem)
 }
 
 // decodeProtoElementsKeyed reads the list envelope from resp under itemsKey and
@@ -160,6 +347,16 @@ func decodeProtoElementsKeyed[T proto.Message](
 	operation, itemsKey string,
 	newElem func() T,
 ) ([]T, error) {
+	envelope, err := decodeListEnvelope(resp, client, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeEnvelopeItems[T](resp, client, envelope, operation, itemsKey, newElem)
+}
+
+// decodeListEnvelope reads the list envelope object from resp.
+func decodeListEnvelope(resp *http.Response, client *Client, operation string) (map[string]json.RawMessage, error) {
 	var envelope map[string]json.RawMessage
 
 	if err := client.handleResponse(resp, &envelope); err != nil {
@@ -174,6 +371,18 @@ func decodeProtoElementsKeyed[T proto.Message](
 		)
 	}
 
+	return envelope, nil
+}
+
+// decodeEnvelopeItems protojson-decodes the elements a list envelope read
+// from resp holds under itemsKey.
+func decodeEnvelopeItems[T proto.Message](
+	resp *http.Response,
+	client *Client,
+	envelope map[string]json.RawMessage,
+	operation, itemsKey string,
+	newElem func() T,
+) ([]T, error) {
 	var rawItems []json.RawMessage
 	if raw, ok := envelope[itemsKey]; ok && len(raw) > 0 {
 		if err := json.Unmarshal(raw, &rawItems); err != nil {
@@ -181,20 +390,26 @@ func decodeProtoElementsKeyed[T proto.Message](
 		}
 	}
 
-	return decodeRawProtoItems[T](rawItems, operation, newElem)
+	return decodeRawProtoItems[T](resp, client, rawItems, operation, newElem)
 }
 
-// decodeRawProtoItems protojson-decodes each raw list element into a fresh proto
-// message with DiscardUnknown. It is the shared per-element decode tail of the
-// proto list fetchers (the data[] / custom-key and bare-only paths), so every
-// fetcher decodes elements identically.
+// decodeRawProtoItems protojson-decodes each raw list element read from resp
+// into a fresh proto message with DiscardUnknown, honoring the client's schema
+// drift mode the way a single-object read does: strict fails the list on an
+// element's undeclared field, warn logs the first one per page. It is the
+// shared per-element decode tail of the proto list fetchers (the data[] /
+// custom-key and bare-only paths), so every fetcher decodes elements
+// identically.
 func decodeRawProtoItems[T proto.Message](
+	resp *http.Response,
+	client *Client,
 	rawItems []json.RawMessage,
 	operation string,
 	newElem func() T,
 ) ([]T, error) {
 	opts := protojson.UnmarshalOptions{DiscardUnknown: true}
 	elems := make([]T, 0, len(rawItems))
+	drifted := false
 
 	for _, raw := range rawItems {
 		elem := newElem()
@@ -202,6 +417,13 @@ func decodeRawProtoItems[T proto.Message](
 			return nil, fmt.Errorf("failed to unmarshal %s element: %w", operation, err)
 		}
 
+		if !drifted {
+			var err error
+			if drifted, err = client.protoDrift(resp, raw, elem); err != nil {
+				return nil, err
+			}
+		}
+
 		elems = append(elems, elem)
 	}
 
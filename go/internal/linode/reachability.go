@@ -0,0 +1,51 @@
+package linode
+
+import (
+	"context"
+	"sync"
+)
+
+// reachability tracks whether the Linode API answered the latest request of
+// one tool call. A handler may fan out across goroutines, so it is guarded.
+type reachability struct {
+	mu          sync.Mutex
+	unreachable bool
+}
+
+type reachabilityKey struct{}
+
+// WithReachability returns a context on which the client notes whether each
+// request it makes got an HTTP response. The server attaches one only to
+// calls the offline cache may answer, so other calls pay nothing.
+func WithReachability(ctx context.Context) context.Context {
+	return context.WithValue(ctx, reachabilityKey{}, &reachability{})
+}
+
+// APIUnreachable reports whether the latest request made with ctx failed
+// before any HTTP response arrived (DNS, connect, TLS, or a transport
+// timeout). An HTTP error status means the API was reached, so it does not
+// count, and neither does the caller canceling ctx. It is false when ctx
+// carries no WithReachability tracker.
+func APIUnreachable(ctx context.Context) bool {
+	tracker, ok := ctx.Value(reachabilityKey{}).(*reachability)
+	if !ok {
+		return false
+	}
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	return tracker.unreachable
+}
+
+// recordReachability notes the outcome of one round trip on ctx's tracker.
+func recordReachability(ctx context.Context, err error) {
+	tracker, ok := ctx.Value(reachabilityKey{}).(*reachability)
+	if !ok || ctx.Err() != nil {
+		return
+	}
+
+	tracker.mu.Lock()
+	tracker.unreachable = err != nil
+	tracker.mu.Unlock()
+}
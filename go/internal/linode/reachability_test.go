@@ -0,0 +1,51 @@
+package linode_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// TestAPIUnreachableTracksLatestRequest verifies only a request that got no
+// HTTP response marks the API unreachable: an error status does not, and a
+// later answered request clears the mark.
+func TestAPIUnreachableTracksLatestRequest(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	ctx := linode.WithReachability(t.Context())
+	up := linode.NewClient(srv.URL, "my-token", nil, linode.WithMaxRetries(0))
+
+	if _, err := up.GetProfile(ctx); err == nil {
+		t.Fatal("expected the 404 to fail the request")
+	}
+
+	if linode.APIUnreachable(ctx) {
+		t.Error("APIUnreachable = true after an HTTP 404, want false")
+	}
+
+	down := linode.NewClient("http://127.0.0.1:1", "my-token", nil, linode.WithMaxRetries(0))
+	if _, err := down.GetProfile(ctx); err == nil {
+		t.Fatal("expected the refused connection to fail the request")
+	}
+
+	if !linode.APIUnreachable(ctx) {
+		t.Error("APIUnreachable = false after a refused connection, want true")
+	}
+
+	_, _ = up.GetProfile(ctx)
+
+	if linode.APIUnreachable(ctx) {
+		t.Error("APIUnreachable = true after the API answered again, want false")
+	}
+
+	if linode.APIUnreachable(t.Context()) {
+		t.Error("APIUnreachable = true on a context without a tracker, want false")
+	}
+}
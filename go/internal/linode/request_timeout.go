@@ -0,0 +1,36 @@
+package linode
+
+import (
+	"context"
+	"time"
+)
+
+type requestTimeoutKey struct{}
+
+// WithRequestTimeout returns a context whose API requests are each bounded by
+// timeout instead of the default 30 seconds. The server sets it when a tool
+// call has its own timeout, so a slow provision is not cut off at 30 seconds;
+// the call's deadline still bounds every request. A non-positive timeout is
+// ignored.
+func WithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	if timeout <= 0 {
+		return ctx
+	}
+
+	return context.WithValue(ctx, requestTimeoutKey{}, timeout)
+}
+
+// RequestTimeoutFromContext returns the timeout set by WithRequestTimeout, or
+// the default per-request timeout when none is set.
+func RequestTimeoutFromContext(ctx context.Context) time.Duration {
+	if timeout, ok := ctx.Value(requestTimeoutKey{}).(time.Duration); ok {
+		return timeout
+	}
+
+	return requestTimeout
+}
+
+// withRequestTimeout bounds one API request made under ctx.
+func withRequestTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, RequestTimeoutFromContext(ctx))
+}
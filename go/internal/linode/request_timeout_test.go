@@ -0,0 +1,43 @@
+package linode_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// TestClientHonorsRequestTimeout verifies a request timeout on the context
+// bounds each API request in place of the default.
+func TestClientHonorsRequestTimeout(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"username":"alice"}`))
+	}))
+	defer srv.Close()
+
+	client := linode.NewClient(srv.URL, "my-token", nil, linode.WithMaxRetries(0))
+
+	ctx := linode.WithRequestTimeout(t.Context(), 20*time.Millisecond)
+	if _, err := client.GetProfile(ctx); err == nil {
+		t.Error("GetProfile under a 20ms request timeout succeeded, want a timeout error")
+	}
+
+	if _, err := client.GetProfile(t.Context()); err != nil {
+		t.Errorf("GetProfile with the default timeout: unexpected error: %v", err)
+	}
+
+	if got := linode.RequestTimeoutFromContext(ctx); got != 20*time.Millisecond {
+		t.Errorf("RequestTimeoutFromContext = %s, want 20ms", got)
+	}
+}
@@ -19,6 +19,9 @@ type retryConfig struct {
 	MaxDelay      time.Duration
 	BackoffFactor float64
 	JitterEnabled bool
+	// MaxElapsed stops retrying once the next wait would carry the call past
+	// it. Zero leaves MaxRetries as the only bound.
+	MaxElapsed time.Duration
 }
 
 const (
@@ -422,6 +425,23 @@ func (c *Client) GetAccountProto(ctx context.Context) (*linodev1.Account, error)
 	return account, err
 }
 
+// CountResults returns how many entities a paginated collection endpoint
+// (for example /linode/instances) holds, with automatic retry on transient
+// failures.
+func (c *Client) CountResults(ctx context.Context, endpoint string) (int, error) {
+	var count int
+
+	err := c.executeWithRetry(ctx, "CountResults", func() error {
+		var err error
+
+		count, err = c.httpCountResults(ctx, endpoint)
+
+		return err
+	})
+
+	return count, err
+}
+
 // GetAccountTransferProto retrieves account network transfer usage as a proto
 // message with automatic retry on transient failures.
 func (c *Client) GetAccountTransferProto(ctx context.Context) (*linodev1.AccountTransfer, error) {
@@ -2682,6 +2702,52 @@ func (c *Client) ResetDatabasePostgreSQLInstanceCredentials(ctx context.Context,
 	return c.httpResetDatabasePostgreSQLInstanceCredentials(ctx, instanceID)
 }
 
+// ListDatabaseBackupsProto retrieves the backups of one MySQL Managed
+// Database instance as proto messages with automatic retry on transient
+// failures.
+func (c *Client) ListDatabaseBackupsProto(ctx context.Context, instanceID, page, pageSize int) ([]*linodev1.DatabaseBackup, error) {
+	var backups []*linodev1.DatabaseBackup
+
+	err := c.executeWithRetry(ctx, "ListDatabaseBackups", func() error {
+		var retryErr error
+
+		backups, retryErr = c.httpListDatabaseBackupsProto(ctx, instanceID, page, pageSize)
+
+		return retryErr
+	})
+
+	return backups, err
+}
+
+// ListDatabasePostgreSQLBackupsProto retrieves the backups of one PostgreSQL
+// Managed Database instance as proto messages with automatic retry on
+// transient failures.
+func (c *Client) ListDatabasePostgreSQLBackupsProto(ctx context.Context, instanceID, page, pageSize int) ([]*linodev1.DatabaseBackup, error) {
+	var backups []*linodev1.DatabaseBackup
+
+	err := c.executeWithRetry(ctx, "ListDatabasePostgreSQLBackups", func() error {
+		var retryErr error
+
+		backups, retryErr = c.httpListDatabasePostgreSQLBackupsProto(ctx, instanceID, page, pageSize)
+
+		return retryErr
+	})
+
+	return backups, err
+}
+
+// CreateDatabaseBackup takes an on-demand backup of one MySQL Managed Database
+// instance without retrying the POST.
+func (c *Client) CreateDatabaseBackup(ctx context.Context, instanceID int, req *CreateDatabaseBackupRequest) error {
+	return c.httpCreateDatabaseBackup(ctx, instanceID, req)
+}
+
+// CreateDatabasePostgreSQLBackup takes an on-demand backup of one PostgreSQL
+// Managed Database instance without retrying the POST.
+func (c *Client) CreateDatabasePostgreSQLBackup(ctx context.Context, instanceID int, req *CreateDatabaseBackupRequest) error {
+	return c.httpCreateDatabasePostgreSQLBackup(ctx, instanceID, req)
+}
+
 // CreateDatabaseInstanceProto creates a MySQL Managed Database instance and
 // returns the proto element without retrying the POST.
 func (c *Client) CreateDatabaseInstanceProto(ctx context.Context, req *CreateDatabaseInstanceRequest) (*linodev1.DatabaseInstance, error) {
@@ -5059,6 +5125,14 @@ func (c *Client) CreatePresignedURLProto(ctx context.Context, region, label stri
 	return result, err
 }
 
+// PutPresignedObject uploads data to a presigned PUT URL with automatic
+// retry. A PUT replaces the whole object, so replaying it is safe.
+func (c *Client) PutPresignedObject(ctx context.Context, presignedURL, contentType string, data []byte) error {
+	return c.executeWithRetry(ctx, "PutPresignedObject", func() error {
+		return c.httpPutPresignedObject(ctx, presignedURL, contentType, data)
+	})
+}
+
 // GetObjectACL retrieves an object's ACL with automatic retry.
 func (c *Client) GetObjectACL(ctx context.Context, region, label, name string) (*ObjectACL, error) {
 	var result *ObjectACL
@@ -6693,9 +6767,9 @@ func (c *Client) RebuildInstanceProto(ctx context.Context, linodeID int, req *Re
 }
 
 // MigrateInstance migrates an instance with automatic retry on transient failures.
-func (c *Client) MigrateInstance(ctx context.Context, linodeID int, region string) error {
+func (c *Client) MigrateInstance(ctx context.Context, linodeID int, req MigrateInstanceRequest) error {
 	return c.executeWithRetry(ctx, "MigrateInstance", func() error {
-		return c.httpMigrateInstance(ctx, linodeID, region)
+		return c.httpMigrateInstance(ctx, linodeID, req)
 	})
 }
 
@@ -6799,9 +6873,15 @@ func (c *Client) executeWithRetry(ctx context.Context, operation string, retryFu
 
 	var attempt int
 
+	start := time.Now()
+
 	for attempt <= c.retryCfg.MaxRetries {
 		if attempt > 0 {
 			delay := c.delayForAttempt(attempt, lastErr)
+			if c.retryCfg.MaxElapsed > 0 && time.Since(start)+delay > c.retryCfg.MaxElapsed {
+				break
+			}
+
 			select {
 			case <-ctx.Done():
 				// Caller canceled; not an upstream-health signal.
@@ -6826,8 +6906,8 @@ func (c *Client) executeWithRetry(ctx context.Context, operation string, retryFu
 		}
 	}
 
-	// Retries exhausted on a retryable failure. This is exactly the signal
-	// the breaker exists to track.
+	// Retries exhausted on a retryable failure, by count or by elapsed time.
+	// This is exactly the signal the breaker exists to track.
 	c.circuit.RecordFailure()
 
 	return fmt.Errorf("%s: %w", operation, lastErr)
@@ -0,0 +1,108 @@
+package linode_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// profileWithDrift is a /profile body carrying a field no model declares,
+// standing in for a field Linode adds after the models were written.
+const profileWithDrift = `{"username":"patuser","email":"pat@example.com","brand_new_field":true}`
+
+// sshKeysWithDrift is a list page whose element carries an undeclared field.
+const sshKeysWithDrift = `{"data":[{"id":1,"label":"laptop","brand_new_field":true}],"page":1,"pages":1,"results":1}`
+
+func newDriftClient(t *testing.T, mode string) *linode.Client {
+	t.Helper()
+
+	return newDriftClientWithBody(t, mode, profileWithDrift)
+}
+
+func newDriftClientWithBody(t *testing.T, mode, body string) *linode.Client {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", tcApplicationJSON)
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &config.Config{SchemaDrift: config.SchemaDriftConfig{Mode: mode}}
+
+	return linode.NewClient(srv.URL, "tok", cfg, linode.WithMaxRetries(0))
+}
+
+// TestSchemaDriftLenientIgnoresUnknownFields verifies the default mode keeps
+// decoding responses that carry undeclared fields.
+func TestSchemaDriftLenientIgnoresUnknownFields(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range []string{"", config.SchemaDriftLenient, config.SchemaDriftWarn} {
+		client := newDriftClient(t, mode)
+
+		got, err := client.GetProfile(t.Context())
+		if err != nil {
+			t.Fatalf("mode %q: unexpected error: %v", mode, err)
+		}
+
+		if got.Username != "patuser" {
+			t.Errorf("mode %q: got.Username = %q, want %q", mode, got.Username, "patuser")
+		}
+
+		msg, err := client.GetProfileProto(t.Context())
+		if err != nil {
+			t.Fatalf("mode %q: unexpected proto error: %v", mode, err)
+		}
+
+		if msg.GetUsername() != "patuser" {
+			t.Errorf("mode %q: msg.GetUsername() = %q, want %q", mode, msg.GetUsername(), "patuser")
+		}
+	}
+}
+
+// TestSchemaDriftStrictRejectsUnknownFields verifies strict mode fails both
+// the struct and proto decode paths with ErrSchemaDrift.
+func TestSchemaDriftStrictRejectsUnknownFields(t *testing.T) {
+	t.Parallel()
+
+	client := newDriftClient(t, config.SchemaDriftStrict)
+
+	if _, err := client.GetProfile(t.Context()); !errors.Is(err, linode.ErrSchemaDrift) {
+		t.Errorf("GetProfile error = %v, want %v", err, linode.ErrSchemaDrift)
+	}
+
+	if _, err := client.GetProfileProto(t.Context()); !errors.Is(err, linode.ErrSchemaDrift) {
+		t.Errorf("GetProfileProto error = %v, want %v", err, linode.ErrSchemaDrift)
+	}
+}
+
+// TestSchemaDriftAppliesToListElements verifies the proto list decode honors
+// the drift mode per element: lenient and warn keep the element, strict fails
+// the list with ErrSchemaDrift.
+func TestSchemaDriftAppliesToListElements(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range []string{config.SchemaDriftLenient, config.SchemaDriftWarn} {
+		keys, err := newDriftClientWithBody(t, mode, sshKeysWithDrift).ListSSHKeysProto(t.Context())
+		if err != nil {
+			t.Fatalf("mode %q: unexpected error: %v", mode, err)
+		}
+
+		if len(keys) != 1 || keys[0].GetLabel() != "laptop" {
+			t.Errorf("mode %q: keys = %v, want the one laptop key", mode, keys)
+		}
+	}
+
+	client := newDriftClientWithBody(t, config.SchemaDriftStrict, sshKeysWithDrift)
+	if _, err := client.ListSSHKeysProto(t.Context()); !errors.Is(err, linode.ErrSchemaDrift) {
+		t.Errorf("ListSSHKeysProto error = %v, want %v", err, linode.ErrSchemaDrift)
+	}
+}
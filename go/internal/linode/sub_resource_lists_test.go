@@ -0,0 +1,167 @@
+package linode_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// newTwoPageServer serves two pages of one item each at path: page 1 holds id
+// 1 and page 2 holds id 2.
+func newTwoPageServer(t *testing.T, path string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			t.Errorf("r.URL.Path = %v, want %v", r.URL.Path, path)
+		}
+
+		page := 1
+		if r.URL.Query().Get("page") == "2" {
+			page = 2
+		}
+
+		w.Header().Set("Content-Type", tcApplicationJSON)
+
+		body := fmt.Sprintf(`{"data":[{"id":%d}],"page":%d,"pages":2,"results":2}`, page, page)
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+}
+
+// TestSubResourceListsWalkEveryPage verifies the struct-typed sub-resource
+// list methods follow the envelope's pages count rather than stopping at the
+// first page.
+func TestSubResourceListsWalkEveryPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		path string
+		list func(ctx context.Context, client *linode.Client) ([]int, error)
+	}{
+		{
+			name: "domain records",
+			path: "/domains/7/records",
+			list: func(ctx context.Context, client *linode.Client) ([]int, error) {
+				records, err := client.ListDomainRecords(ctx, 7)
+
+				return collectIDs(records, err, func(r linode.DomainRecord) int { return r.ID })
+			},
+		},
+		{
+			name: "instance disks",
+			path: "/linode/instances/7/disks",
+			list: func(ctx context.Context, client *linode.Client) ([]int, error) {
+				disks, err := client.ListInstanceDisks(ctx, 7)
+
+				return collectIDs(disks, err, func(d linode.InstanceDisk) int { return d.ID })
+			},
+		},
+		{
+			name: "instance volumes",
+			path: "/linode/instances/7/volumes",
+			list: func(ctx context.Context, client *linode.Client) ([]int, error) {
+				volumes, err := client.ListInstanceVolumes(ctx, 7, 0, 0)
+
+				return collectIDs(volumes, err, func(v linode.Volume) int { return v.ID })
+			},
+		},
+		{
+			name: "instance firewalls",
+			path: "/linode/instances/7/firewalls",
+			list: func(ctx context.Context, client *linode.Client) ([]int, error) {
+				firewalls, err := client.ListInstanceFirewalls(ctx, 7, 0, 0)
+
+				return collectIDs(firewalls, err, func(f linode.Firewall) int { return f.ID })
+			},
+		},
+		{
+			name: "LKE node pools",
+			path: "/lke/clusters/7/pools",
+			list: func(ctx context.Context, client *linode.Client) ([]int, error) {
+				pools, err := client.ListLKENodePools(ctx, 7)
+
+				return collectIDs(pools, err, func(p linode.LKENodePool) int { return p.ID })
+			},
+		},
+		{
+			name: "NodeBalancer configs",
+			path: "/nodebalancers/7/configs",
+			list: func(ctx context.Context, client *linode.Client) ([]int, error) {
+				configs, err := client.ListNodeBalancerConfigs(ctx, 7, 0, 0)
+
+				return collectIDs(configs, err, func(c linode.NodeBalancerConfig) int { return c.ID })
+			},
+		},
+		{
+			name: "NodeBalancer firewalls",
+			path: "/nodebalancers/7/firewalls",
+			list: func(ctx context.Context, client *linode.Client) ([]int, error) {
+				firewalls, err := client.ListNodeBalancerFirewalls(ctx, 7, 0, 0)
+
+				return collectIDs(firewalls, err, func(f linode.Firewall) int { return f.ID })
+			},
+		},
+		{
+			name: "NodeBalancer config nodes",
+			path: "/nodebalancers/7/configs/8/nodes",
+			list: func(ctx context.Context, client *linode.Client) ([]int, error) {
+				nodes, err := client.ListNodeBalancerConfigNodes(ctx, 7, 8, 0, 0)
+				if err != nil {
+					return nil, err
+				}
+
+				return collectIDs(nodes.Data, nil, func(n linode.NodeBalancerConfigNode) int { return n.ID })
+			},
+		},
+		{
+			name: "VPC subnets",
+			path: "/vpcs/7/subnets",
+			list: func(ctx context.Context, client *linode.Client) ([]int, error) {
+				subnets, err := client.ListVPCSubnets(ctx, 7)
+
+				return collectIDs(subnets, err, func(s linode.VPCSubnet) int { return s.ID })
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newTwoPageServer(t, tt.path)
+			defer srv.Close()
+
+			client := linode.NewClient(srv.URL, "my-token", nil, linode.WithMaxRetries(0))
+
+			ids, err := tt.list(t.Context(), client)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(ids, []int{1, 2}) {
+				t.Errorf("ids = %v, want the items of pages 1 and 2", ids)
+			}
+		})
+	}
+}
+
+func collectIDs[T any](items []T, err error, id func(T) int) ([]int, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, id(item))
+	}
+
+	return ids, nil
+}
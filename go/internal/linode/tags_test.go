@@ -187,6 +187,47 @@ func TestClientListTaggedObjectsRetriesTransientError(t *testing.T) {
 	}
 }
 
+// TestClientListTaggedObjectsWalksEveryPage verifies a list asked for no page
+// follows the envelope's pages count and returns every page's objects as one
+// page.
+func TestClientListTaggedObjectsWalksEveryPage(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := 1
+		if r.URL.Query().Get("page") == "2" {
+			page = 2
+		}
+
+		w.Header().Set("Content-Type", tcApplicationJSON)
+
+		if err := json.NewEncoder(w).Encode(linode.PaginatedResponse[linode.TaggedObject]{
+			Data:    []linode.TaggedObject{{keyID: float64(page)}},
+			Page:    page,
+			Pages:   2,
+			Results: 2,
+		}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	client := linode.NewClient(srv.URL, "my-token", nil, linode.WithMaxRetries(0))
+
+	result, err := client.ListTaggedObjects(t.Context(), "prod", 0, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Data) != 2 || result.Data[0][keyID] != float64(1) || result.Data[1][keyID] != float64(2) {
+		t.Fatalf("result.Data = %v, want the objects of pages 1 and 2", result.Data)
+	}
+
+	if result.Pages != 1 || result.Results != 2 {
+		t.Errorf("result.Pages, result.Results = %d, %d, want 1, 2", result.Pages, result.Results)
+	}
+}
+
 func TestClientDeleteTagSuccess(t *testing.T) {
 	t.Parallel()
 
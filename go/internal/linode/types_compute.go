@@ -146,8 +146,9 @@ type InterfacePublicIPv6 struct {
 
 // InterfaceIPv4Address represents a single IPv4 address on an interface.
 type InterfaceIPv4Address struct {
-	Address string `json:"address"`
-	Primary bool   `json:"primary,omitempty"`
+	Address      string `json:"address"`
+	Primary      bool   `json:"primary,omitempty"`
+	NAT11Address string `json:"nat_1_1_address,omitempty"`
 }
 
 // InterfaceIPv6Range represents an IPv6 range on an interface.
@@ -457,14 +458,15 @@ type StackScript struct {
 	Mine              bool     `json:"mine"`
 }
 
-// UDF represents a user-defined field in a StackScript.
+// UDF represents a user-defined field in a StackScript. Default is nil when
+// the API omits it, which marks the field as required at deploy time.
 type UDF struct {
-	Label   string `json:"label"`
-	Name    string `json:"name"`
-	Example string `json:"example"`
-	OneOf   string `json:"oneof"`
-	Default string `json:"default"`
-	ManyOf  string `json:"manyof"`
+	Label   string  `json:"label"`
+	Name    string  `json:"name"`
+	Example string  `json:"example"`
+	OneOf   string  `json:"oneof"`
+	Default *string `json:"default"`
+	ManyOf  string  `json:"manyof"`
 }
 
 // CreateStackScriptRequest represents the request body for creating a StackScript.
@@ -496,6 +498,7 @@ type CreateInstanceRequest struct {
 	Type                string              `json:"type"`
 	Label               string              `json:"label,omitempty"`
 	Image               string              `json:"image,omitempty"`
+	BackupID            *int                `json:"backup_id,omitempty"`
 	RootPass            string              `json:"root_pass,omitempty"`
 	AuthorizedKeys      []string            `json:"authorized_keys,omitempty"`
 	AuthorizedUsers     []string            `json:"authorized_users,omitempty"`
@@ -519,10 +522,25 @@ type ResizeInstanceRequest struct {
 // UpdateInstanceRequest represents the request body for updating a Linode
 // instance. All fields are optional; only provided fields are updated.
 type UpdateInstanceRequest struct {
-	Label             string   `json:"label,omitempty"`
-	Group             string   `json:"group,omitempty"`
-	Tags              []string `json:"tags,omitempty"`
-	WatchdogEnabled   *bool    `json:"watchdog_enabled,omitempty"`
-	Alerts            *Alerts  `json:"alerts,omitempty"`
-	MaintenancePolicy string   `json:"maintenance_policy,omitempty"`
+	Label             string                 `json:"label,omitempty"`
+	Group             string                 `json:"group,omitempty"`
+	Tags              []string               `json:"tags,omitempty"`
+	WatchdogEnabled   *bool                  `json:"watchdog_enabled,omitempty"`
+	Alerts            *Alerts                `json:"alerts,omitempty"`
+	MaintenancePolicy string                 `json:"maintenance_policy,omitempty"`
+	Backups           *UpdateInstanceBackups `json:"backups,omitempty"`
+}
+
+// UpdateInstanceBackups is the backups part of an instance update. Only the
+// schedule is writable; enabling and cancelling backups have their own
+// endpoints.
+type UpdateInstanceBackups struct {
+	Schedule BackupScheduleUpdate `json:"schedule"`
+}
+
+// BackupScheduleUpdate sets the backup day and window. An empty field is
+// left out, so it keeps its current value.
+type BackupScheduleUpdate struct {
+	Day    string `json:"day,omitempty"`
+	Window string `json:"window,omitempty"`
 }
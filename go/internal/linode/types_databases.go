@@ -62,6 +62,14 @@ type DatabaseSSL struct {
 	CACertificate string `json:"ca_certificate"`
 }
 
+// CreateDatabaseBackupRequest takes an on-demand backup of a Managed Database
+// instance. Target selects the node ("primary" or "secondary"); the API
+// defaults to the primary when it is omitted.
+type CreateDatabaseBackupRequest struct {
+	Label  string `json:"label"`
+	Target string `json:"target,omitempty"`
+}
+
 // CreateDatabaseInstanceRequest creates or restores a MySQL Managed Database instance.
 type CreateDatabaseInstanceRequest struct {
 	Label          string         `json:"label"`
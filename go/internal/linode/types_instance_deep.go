@@ -282,6 +282,14 @@ type CloneInstanceRequest struct {
 	Configs        []int  `json:"configs,omitempty"`
 }
 
+// MigrateInstanceRequest represents the request body for migrating a Linode
+// instance. An empty request sends no body, so Linode picks the destination and
+// the migration is cold.
+type MigrateInstanceRequest struct {
+	Region string `json:"region,omitempty"`
+	Type   string `json:"type,omitempty"`
+}
+
 // MutateInstanceRequest represents the request body for upgrading a Linode instance.
 type MutateInstanceRequest struct {
 	AllowAutoDiskResize *bool `json:"allow_auto_disk_resize,omitempty"`
@@ -356,10 +356,14 @@ func firewallCreateBodyFromRequest(req CreateFirewallRequest) firewallCreateBody
 // action/protocol/ports/label/description and a null ipv6 the caller never
 // sent, which drifts from the Python client and breaks the wire-defaults ruling
 // (send only what the caller sent; the API owns rule field defaults). Handlers
-// build this from validated tool input.
+// build this from validated tool input. InboundPolicy and OutboundPolicy are
+// sent only when set, so a caller that leaves them empty keeps the firewall's
+// current default policies.
 type FirewallRulesReplaceRequest struct {
-	Inbound  []map[string]any
-	Outbound []map[string]any
+	Inbound        []map[string]any
+	Outbound       []map[string]any
+	InboundPolicy  string
+	OutboundPolicy string
 }
 
 // firewallRulesRawReplaceBody is the wire form of a PUT
@@ -367,8 +371,10 @@ type FirewallRulesReplaceRequest struct {
 // Both lists are always present (an empty array clears that direction), and each
 // rule is emitted with exactly the keys the caller provided.
 type firewallRulesRawReplaceBody struct {
-	Inbound  []map[string]any `json:"inbound"`
-	Outbound []map[string]any `json:"outbound"`
+	Inbound        []map[string]any `json:"inbound"`
+	Outbound       []map[string]any `json:"outbound"`
+	InboundPolicy  string           `json:"inbound_policy,omitempty"`
+	OutboundPolicy string           `json:"outbound_policy,omitempty"`
 }
 
 // Device represents a device attached to a firewall.
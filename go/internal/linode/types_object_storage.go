@@ -81,6 +81,9 @@ type PresignedURLRequest struct {
 	Method    string `json:"method"`
 	Name      string `json:"name"`
 	ExpiresIn int    `json:"expires_in,omitempty"`
+	// ContentType binds a PUT URL to this Content-Type header; the upload
+	// must then send the same value.
+	ContentType string `json:"content_type,omitempty"`
 }
 
 // ObjectACL represents the ACL of an object in Object Storage.
@@ -0,0 +1,112 @@
+package linode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// WriteRecord is one mutating request the API accepted: its method, endpoint,
+// JSON request body (nil when none), and response body. The read-after-write
+// check replays a GET against what these name.
+type WriteRecord struct {
+	Method   string
+	Endpoint string
+	Body     []byte
+	Response []byte
+}
+
+// writeLog collects the accepted writes of one tool call. A handler may fan
+// out across goroutines, so appends are guarded.
+type writeLog struct {
+	mu      sync.Mutex
+	records []WriteRecord
+}
+
+type writeLogKey struct{}
+
+// WithWriteLog returns a context that records every accepted POST, PUT, and
+// DELETE the client makes with it. The server attaches one per call only when
+// the read-after-write check is on, so other calls pay nothing.
+func WithWriteLog(ctx context.Context) context.Context {
+	return context.WithValue(ctx, writeLogKey{}, &writeLog{})
+}
+
+// WritesFromContext returns the writes recorded on ctx in the order they were
+// made, or nil when ctx carries no write log.
+func WritesFromContext(ctx context.Context) []WriteRecord {
+	log, ok := ctx.Value(writeLogKey{}).(*writeLog)
+	if !ok {
+		return nil
+	}
+
+	log.mu.Lock()
+	defer log.mu.Unlock()
+
+	return append([]WriteRecord(nil), log.records...)
+}
+
+// captureRequestBody buffers a request body so it can be both sent and
+// recorded. It returns the bytes and a fresh reader over them.
+func captureRequestBody(body io.Reader) ([]byte, io.Reader, error) {
+	if body == nil {
+		return nil, nil, nil
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	return data, bytes.NewReader(data), nil
+}
+
+// recordWrite appends an accepted write to the context's log. The response
+// body is read here and put back so the caller decodes it as usual. Failed
+// attempts are not recorded: the retry layer replays them, and only the
+// attempt the API accepted changed anything.
+func recordWrite(ctx context.Context, method, endpoint string, body []byte, resp *http.Response) {
+	log, ok := ctx.Value(writeLogKey{}).(*writeLog)
+	if !ok || method == http.MethodGet || resp.StatusCode >= httpBadRequest {
+		return
+	}
+
+	data, err := io.ReadAll(resp.Body)
+
+	drainClose(resp)
+
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
+	if err != nil {
+		return
+	}
+
+	log.mu.Lock()
+	log.records = append(log.records, WriteRecord{Method: method, Endpoint: endpoint, Body: body, Response: data})
+	log.mu.Unlock()
+}
+
+// GetRaw fetches endpoint once, without retrying, and returns its raw JSON.
+// The read-after-write check polls with it and runs its own bounded retries.
+func (c *Client) GetRaw(ctx context.Context, endpoint string) (json.RawMessage, error) {
+	ctx, cancel := withRequestTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, &NetworkError{Operation: "GetRaw", Err: err}
+	}
+
+	defer drainClose(resp)
+
+	var raw json.RawMessage
+	if err := c.handleResponse(resp, &raw); err != nil {
+		return nil, err
+	}
+
+	return raw, nil
+}
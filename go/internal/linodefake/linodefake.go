@@ -0,0 +1,192 @@
+// Package linodefake is an in-memory stand-in for the Linode API. A Backend
+// is an http.RoundTripper, and its NewClient is a linode.ClientFactory: hand
+// that to the server or bind it with tools.WithClientFactory, and the tool
+// handlers talk to the fake instead of the network, with no httptest server,
+// no listener, and no state shared with any other Backend.
+package linodefake
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// apiURL is the API URL a Backend's environment carries. Nothing resolves
+// it: the Backend answers every request the environment's clients make, so
+// request paths are the API's own, such as /volumes/7.
+const apiURL = "http://linodefake.invalid"
+
+// Request is one call the Backend answered.
+type Request struct {
+	Method string
+	Path   string
+	Query  string
+	Header http.Header
+	Body   []byte
+}
+
+type response struct {
+	status int
+	body   any
+}
+
+// Backend serves canned objects, lists, and responses from memory and
+// records every request it answers. Safe for concurrent use.
+type Backend struct {
+	mu        sync.Mutex
+	objects   map[string]any
+	lists     map[string][]any
+	responses map[string]response
+	requests  []Request
+}
+
+// New returns an empty Backend.
+func New() *Backend {
+	return &Backend{
+		objects:   map[string]any{},
+		lists:     map[string][]any{},
+		responses: map[string]response{},
+	}
+}
+
+// Environment returns the Linode settings of an environment for clients
+// built by NewClient, authenticating with token.
+func (b *Backend) Environment(token string) config.LinodeConfig {
+	return config.LinodeConfig{APIURL: apiURL, Token: token}
+}
+
+// NewClient is a linode.ClientFactory whose clients this Backend answers,
+// whatever API URL they are built for.
+func (b *Backend) NewClient(apiURL, token string, cfg *config.Config, opts ...linode.Option) *linode.Client {
+	return linode.NewClient(apiURL, token, cfg, slices.Concat(opts, []linode.Option{linode.WithTransport(b)})...)
+}
+
+// SetObject serves v as JSON for GET path. DELETE path removes it.
+func (b *Backend) SetObject(path string, v any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.objects[path] = v
+}
+
+// SetList serves items for GET path as a single page of the API's list
+// envelope.
+func (b *Backend) SetList(path string, items ...any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lists[path] = items
+}
+
+// SetResponse answers method on path with status and body, ahead of any
+// object or list stored for the path. A nil body sends an empty object.
+func (b *Backend) SetResponse(method, path string, status int, body any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.responses[method+" "+path] = response{status: status, body: body}
+}
+
+// Requests returns the requests answered so far, oldest first.
+func (b *Backend) Requests() []Request {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]Request(nil), b.requests...)
+}
+
+// RoundTrip answers req from memory: a canned response first, then a stored
+// object or list, and otherwise the API's 404 error envelope.
+func (b *Backend) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if req.Body != nil {
+		read, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("linodefake: read request body: %w", err)
+		}
+
+		body = read
+
+		if err := req.Body.Close(); err != nil {
+			return nil, fmt.Errorf("linodefake: close request body: %w", err)
+		}
+	}
+
+	status, payload := b.answer(req, body)
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("linodefake: encode %s %s response: %w", req.Method, req.URL.Path, err)
+	}
+
+	return &http.Response{
+		Status:        strconv.Itoa(status) + " " + http.StatusText(status),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(encoded)),
+		ContentLength: int64(len(encoded)),
+		Request:       req,
+	}, nil
+}
+
+// answer records req and picks its status and payload.
+func (b *Backend) answer(req *http.Request, body []byte) (int, any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	path := req.URL.Path
+
+	b.requests = append(b.requests, Request{
+		Method: req.Method,
+		Path:   path,
+		Query:  req.URL.RawQuery,
+		Header: req.Header.Clone(),
+		Body:   body,
+	})
+
+	if canned, ok := b.responses[req.Method+" "+path]; ok {
+		if canned.body == nil {
+			return canned.status, map[string]any{}
+		}
+
+		return canned.status, canned.body
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		if object, ok := b.objects[path]; ok {
+			return http.StatusOK, object
+		}
+
+		if items, ok := b.lists[path]; ok {
+			return http.StatusOK, map[string]any{
+				"data":    items,
+				"page":    1,
+				"pages":   1,
+				"results": len(items),
+			}
+		}
+	case http.MethodDelete:
+		if _, ok := b.objects[path]; ok {
+			delete(b.objects, path)
+
+			return http.StatusOK, map[string]any{}
+		}
+	}
+
+	return http.StatusNotFound, map[string]any{
+		"errors": []map[string]string{{"reason": "Not found"}},
+	}
+}
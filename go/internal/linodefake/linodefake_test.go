@@ -0,0 +1,55 @@
+package linodefake_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/linodefake"
+)
+
+// newClient returns a client the backend serves.
+func newClient(backend *linodefake.Backend) *linode.Client {
+	env := backend.Environment("my-token")
+
+	return backend.NewClient(env.BaseURL(), env.Token, nil, linode.WithMaxRetries(0))
+}
+
+func TestBackendDeleteRemovesObject(t *testing.T) {
+	t.Parallel()
+
+	backend := linodefake.New()
+
+	backend.SetObject("/volumes/7", linode.Volume{ID: 7})
+
+	client := newClient(backend)
+
+	if err := client.DeleteVolume(t.Context(), 7); err != nil {
+		t.Fatalf("DeleteVolume: unexpected error: %v", err)
+	}
+
+	if _, err := client.GetVolume(t.Context(), 7); err == nil {
+		t.Error("GetVolume after delete: want a not-found error")
+	}
+
+	requests := backend.Requests()
+	if len(requests) != 2 || requests[0].Method != http.MethodDelete {
+		t.Errorf("requests = %+v, want DELETE then GET", requests)
+	}
+}
+
+func TestBackendCannedResponse(t *testing.T) {
+	t.Parallel()
+
+	backend := linodefake.New()
+
+	backend.SetResponse(http.MethodGet, "/volumes/7", http.StatusForbidden, map[string]any{
+		"errors": []map[string]string{{"reason": "Unauthorized"}},
+	})
+
+	client := newClient(backend)
+
+	if _, err := client.GetVolume(t.Context(), 7); err == nil {
+		t.Error("GetVolume: want the canned 403")
+	}
+}
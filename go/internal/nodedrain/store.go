@@ -0,0 +1,179 @@
+// Package nodedrain remembers the NodeBalancer backend nodes that
+// linode_nodebalancer_node_drain took out of rotation: the mode each had
+// before, so linode_nodebalancer_node_undrain can put it back, and an
+// optional timer that restores it automatically. Drains live in process
+// memory only: a restart forgets them and cancels any pending restore.
+package nodedrain
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RestoreTimeout bounds one automatic restore call.
+const RestoreTimeout = 30 * time.Second
+
+// Key identifies one backend node as one tenant drained it. Partition is
+// empty unless server.tokenPassthrough has tenants share one server, where
+// each tenant sees only its own drains.
+type Key struct {
+	Partition      string
+	NodeBalancerID int
+	ConfigID       int
+	NodeID         int
+}
+
+// Drain is one node held out of rotation.
+type Drain struct {
+	Key
+
+	// Mode is the mode the drain set, drain or reject.
+	Mode string
+	// RestoreMode is the mode the node had before it was first drained.
+	RestoreMode string
+	DrainedAt   time.Time
+	// RestoreAt is when the node is restored automatically; zero means it
+	// waits for linode_nodebalancer_node_undrain.
+	RestoreAt time.Time
+	// RestoreError is why the last automatic restore failed. A failed
+	// restore keeps the drain so undrain can retry it.
+	RestoreError string
+}
+
+// RestoreFunc puts a drained node back into RestoreMode.
+type RestoreFunc func(ctx context.Context, drain Drain) error
+
+type entry struct {
+	drain Drain
+	timer *time.Timer
+}
+
+// Store holds the drains in process memory.
+type Store struct {
+	now    func() time.Time
+	drains map[Key]*entry
+	mu     sync.Mutex
+}
+
+// Option configures a Store at construction time.
+type Option func(*Store)
+
+// WithClock overrides the wall clock used to stamp DrainedAt and RestoreAt.
+func WithClock(now func() time.Time) Option {
+	return func(s *Store) {
+		s.now = now
+	}
+}
+
+// NewStore returns an empty drain store.
+func NewStore(opts ...Option) *Store {
+	store := &Store{
+		now:    time.Now,
+		drains: make(map[Key]*entry),
+	}
+
+	for _, opt := range opts {
+		opt(store)
+	}
+
+	return store
+}
+
+// Put records a drain, replacing any earlier one for the same node and
+// cancelling its timer. Draining a node again keeps the RestoreMode of the
+// first drain, so the node goes back to the mode it had before either. When
+// after is positive, restore runs once it elapses; on success the drain is
+// forgotten. Put returns the drain as stored.
+func (s *Store) Put(drain Drain, after time.Duration, restore RestoreFunc) Drain {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if previous, ok := s.drains[drain.Key]; ok {
+		stopTimer(previous)
+
+		drain.RestoreMode = previous.drain.RestoreMode
+	}
+
+	drain.DrainedAt = s.now().UTC()
+	drain.RestoreAt = time.Time{}
+	drain.RestoreError = ""
+
+	current := &entry{drain: drain}
+	if after > 0 {
+		current.drain.RestoreAt = drain.DrainedAt.Add(after)
+		current.timer = time.AfterFunc(after, func() {
+			s.restore(current, restore)
+		})
+	}
+
+	s.drains[drain.Key] = current
+
+	return current.drain
+}
+
+// Get returns the drain recorded for a node.
+func (s *Store) Get(key Key) (Drain, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.drains[key]
+	if !ok {
+		return Drain{}, false
+	}
+
+	return current.drain, true
+}
+
+// Take forgets the drain recorded for a node and cancels its timer,
+// returning what was recorded.
+func (s *Store) Take(key Key) (Drain, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.drains[key]
+	if !ok {
+		return Drain{}, false
+	}
+
+	stopTimer(current)
+	delete(s.drains, key)
+
+	return current.drain, true
+}
+
+// restore runs a timed restore. The drain is forgotten only when it is still
+// the one the timer belongs to, so a later Put or Take wins.
+func (s *Store) restore(current *entry, restore RestoreFunc) {
+	s.mu.Lock()
+	drain := current.drain
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), RestoreTimeout)
+	defer cancel()
+
+	err := restore(ctx, drain)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.drains[drain.Key] != current {
+		return
+	}
+
+	if err != nil {
+		current.drain.RestoreError = err.Error()
+		current.drain.RestoreAt = time.Time{}
+		current.timer = nil
+
+		return
+	}
+
+	delete(s.drains, drain.Key)
+}
+
+func stopTimer(current *entry) {
+	if current.timer != nil {
+		current.timer.Stop()
+	}
+}
@@ -0,0 +1,118 @@
+package nodedrain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chadit/LinodeMCP/go/internal/nodedrain"
+)
+
+var errRestore = errors.New("api unavailable")
+
+func TestStorePutKeepsFirstRestoreMode(t *testing.T) {
+	t.Parallel()
+
+	store := nodedrain.NewStore()
+	key := nodedrain.Key{NodeBalancerID: 1, ConfigID: 2, NodeID: 3}
+
+	store.Put(nodedrain.Drain{Key: key, Mode: "drain", RestoreMode: "backup"}, 0, nil)
+	drain := store.Put(nodedrain.Drain{Key: key, Mode: "reject", RestoreMode: "drain"}, 0, nil)
+
+	if drain.Mode != "reject" || drain.RestoreMode != "backup" {
+		t.Errorf("drain = %+v, want mode reject restoring to backup", drain)
+	}
+
+	if _, ok := store.Take(key); !ok {
+		t.Fatal("Take found nothing")
+	}
+
+	if _, ok := store.Get(key); ok {
+		t.Error("Get found a drain after Take")
+	}
+}
+
+func TestStoreKeepsPartitionsApart(t *testing.T) {
+	t.Parallel()
+
+	store := nodedrain.NewStore()
+	key := nodedrain.Key{Partition: "0a1b2c3d4e5f6071", NodeBalancerID: 1, ConfigID: 2, NodeID: 3}
+
+	store.Put(nodedrain.Drain{Key: key, Mode: "drain", RestoreMode: "accept"}, 0, nil)
+
+	other := key
+	other.Partition = "8192a3b4c5d6e7f8"
+
+	if _, ok := store.Get(other); ok {
+		t.Error("another partition found the drain")
+	}
+
+	if _, ok := store.Take(other); ok {
+		t.Error("another partition took the drain")
+	}
+
+	if _, ok := store.Get(key); !ok {
+		t.Error("the draining partition lost its drain")
+	}
+}
+
+func TestStoreRestoresAfterDuration(t *testing.T) {
+	t.Parallel()
+
+	store := nodedrain.NewStore()
+	key := nodedrain.Key{NodeBalancerID: 1, ConfigID: 2, NodeID: 3}
+	restored := make(chan nodedrain.Drain, 1)
+
+	store.Put(nodedrain.Drain{Key: key, Mode: "drain", RestoreMode: "accept"}, time.Millisecond,
+		func(_ context.Context, drain nodedrain.Drain) error {
+			restored <- drain
+
+			return nil
+		})
+
+	select {
+	case drain := <-restored:
+		if drain.RestoreMode != "accept" {
+			t.Errorf("restored to %q, want accept", drain.RestoreMode)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the timed restore never ran")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if _, ok := store.Get(key); !ok {
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Error("the drain is still recorded after a successful restore")
+}
+
+func TestStoreKeepsDrainWhenRestoreFails(t *testing.T) {
+	t.Parallel()
+
+	store := nodedrain.NewStore()
+	key := nodedrain.Key{NodeBalancerID: 1, ConfigID: 2, NodeID: 3}
+
+	store.Put(nodedrain.Drain{Key: key, Mode: "drain", RestoreMode: "accept"}, time.Millisecond,
+		func(context.Context, nodedrain.Drain) error { return errRestore })
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if drain, ok := store.Get(key); ok && drain.RestoreError != "" {
+			if drain.RestoreError != errRestore.Error() || !drain.RestoreAt.IsZero() {
+				t.Errorf("drain = %+v, want the restore error and no pending restore", drain)
+			}
+
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Error("the failed restore was not recorded")
+}
@@ -0,0 +1,29 @@
+package oauth
+
+import "errors"
+
+// Sentinel errors for OAuth authorization. The denial errors are returned to
+// the calling client as tool errors, so their text says what to fix.
+var (
+	// ErrMissingBearer is returned when a call carries no
+	// "Authorization: Bearer" access token.
+	ErrMissingBearer = errors.New("oauth is enabled: send an access token in the Authorization: Bearer header")
+	// ErrTokenInactive is returned when introspection reports the access
+	// token as inactive (expired, revoked, or unknown to the issuer).
+	ErrTokenInactive = errors.New("access token is not active")
+	// ErrWrongIssuer is returned when the token was issued by a different
+	// authorization server than oauth.issuer.
+	ErrWrongIssuer = errors.New("access token was issued by a different authorization server")
+	// ErrWrongAudience is returned when the token's audience does not
+	// include this server's oauth.resource.
+	ErrWrongAudience = errors.New("access token was not issued for this server")
+	// ErrInsufficientScope is returned when the token lacks the scope the
+	// tool's toolset requires.
+	ErrInsufficientScope = errors.New("access token lacks the required scope")
+	// ErrUnexpectedStatus is returned when the introspection or token
+	// endpoint answers with a non-200 status.
+	ErrUnexpectedStatus = errors.New("unexpected status from authorization server")
+	// ErrNoExchangedToken is returned when a token exchange succeeds but the
+	// response carries no access_token.
+	ErrNoExchangedToken = errors.New("token exchange returned no access_token")
+)
@@ -0,0 +1,330 @@
+// Package oauth implements MCP authorization for the HTTP transport. A
+// hosted server acts as an OAuth 2.1 resource server: every tool call carries
+// a bearer access token, the token is validated by introspection (RFC 7662),
+// and its scopes decide which toolset the call may use. Optionally the access
+// token is exchanged (RFC 8693) for a downstream Linode token per call, so no
+// shared Linode credential lives on the server at all.
+package oauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+)
+
+// MetadataPath is where the protected resource metadata document (RFC 9728)
+// is served, so clients can discover which authorization server to use.
+const MetadataPath = "/.well-known/oauth-protected-resource"
+
+// tokenExchangeGrant is the RFC 8693 grant type.
+const tokenExchangeGrant = "urn:ietf:params:oauth:grant-type:token-exchange"
+
+// accessTokenType is the RFC 8693 token type of both the subject token and
+// the requested token.
+const accessTokenType = "urn:ietf:params:oauth:token-type:access_token"
+
+// requestTimeout bounds one introspection or exchange round trip.
+const requestTimeout = 10 * time.Second
+
+// maxBodyBytes caps an authorization server response; they are a few hundred
+// bytes.
+const maxBodyBytes = 1 << 20
+
+// maxCacheTTL caps how long an introspection result is reused, so a revoked
+// token stops working within a minute even if it has not expired.
+const maxCacheTTL = time.Minute
+
+// Grant is a validated access token: who it was issued to and what it allows.
+type Grant struct {
+	Subject string
+	Scopes  []string
+}
+
+// Authorizer validates access tokens against one authorization server and
+// maps their scopes onto tool capabilities. It is safe for concurrent use.
+type Authorizer struct {
+	cfg    config.OAuthConfig
+	client *http.Client
+	now    func() time.Time
+
+	mu    sync.Mutex
+	cache map[[sha256.Size]byte]cachedGrant
+}
+
+// cachedGrant is an introspection result reused until expires.
+type cachedGrant struct {
+	grant   Grant
+	expires time.Time
+}
+
+// introspection is the subset of the RFC 7662 response the authorizer reads.
+// aud may be a string or a list, so it is decoded separately.
+type introspection struct {
+	Active   bool            `json:"active"`
+	Scope    string          `json:"scope"`
+	Subject  string          `json:"sub"`
+	Issuer   string          `json:"iss"`
+	Audience json.RawMessage `json:"aud"`
+	Expiry   int64           `json:"exp"`
+}
+
+// New returns an Authorizer for cfg. A nil client uses http.DefaultClient.
+func New(cfg *config.OAuthConfig, client *http.Client) *Authorizer {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &Authorizer{
+		cfg:    *cfg,
+		client: client,
+		now:    time.Now,
+		cache:  make(map[[sha256.Size]byte]cachedGrant),
+	}
+}
+
+// Authorize validates the bearer token in header and checks it grants
+// capability. The read scope covers meta and read tools; the write scope
+// covers every tool and implies read.
+func (a *Authorizer) Authorize(ctx context.Context, header http.Header, capability profiles.Capability) (Grant, error) {
+	grant, err := a.Authenticate(ctx, header)
+	if err != nil {
+		return Grant{}, err
+	}
+
+	required := a.RequiredScope(capability)
+	if !slices.Contains(grant.Scopes, required) && !slices.Contains(grant.Scopes, a.cfg.WriteScope) {
+		return Grant{}, fmt.Errorf("%w: %s", ErrInsufficientScope, required)
+	}
+
+	return grant, nil
+}
+
+// Authenticate validates the bearer token in header without checking its
+// scopes, for an HTTP transport that refuses unauthenticated requests before
+// any tool runs.
+func (a *Authorizer) Authenticate(ctx context.Context, header http.Header) (Grant, error) {
+	token, ok := BearerToken(header)
+	if !ok {
+		return Grant{}, ErrMissingBearer
+	}
+
+	return a.validate(ctx, token)
+}
+
+// RequiredScope returns the scope a tool with capability needs.
+func (a *Authorizer) RequiredScope(capability profiles.Capability) string {
+	switch capability {
+	case profiles.CapRead, profiles.CapMeta:
+		return a.cfg.ReadScope
+	default:
+		return a.cfg.WriteScope
+	}
+}
+
+// BearerToken extracts the access token from an "Authorization: Bearer"
+// header. The scheme is matched case-insensitively per RFC 6750.
+func BearerToken(header http.Header) (string, bool) {
+	scheme, token, ok := strings.Cut(header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+
+	return token, token != ""
+}
+
+// validate introspects token, reusing a recent result for the same token.
+func (a *Authorizer) validate(ctx context.Context, token string) (Grant, error) {
+	key := sha256.Sum256([]byte(token))
+	now := a.now()
+
+	a.mu.Lock()
+	cached, ok := a.cache[key]
+	a.mu.Unlock()
+
+	if ok && now.Before(cached.expires) {
+		return cached.grant, nil
+	}
+
+	result, err := a.introspect(ctx, token)
+	if err != nil {
+		return Grant{}, err
+	}
+
+	if err := a.checkClaims(&result, now); err != nil {
+		return Grant{}, err
+	}
+
+	grant := Grant{Subject: result.Subject, Scopes: strings.Fields(result.Scope)}
+
+	expires := now.Add(maxCacheTTL)
+	if result.Expiry > 0 {
+		if exp := time.Unix(result.Expiry, 0); exp.Before(expires) {
+			expires = exp
+		}
+	}
+
+	a.mu.Lock()
+	for k, entry := range a.cache {
+		if !now.Before(entry.expires) {
+			delete(a.cache, k)
+		}
+	}
+
+	a.cache[key] = cachedGrant{grant: grant, expires: expires}
+	a.mu.Unlock()
+
+	return grant, nil
+}
+
+// checkClaims rejects an inactive or expired token, one from another issuer,
+// and one whose audience does not name this server.
+func (a *Authorizer) checkClaims(result *introspection, now time.Time) error {
+	if !result.Active || (result.Expiry > 0 && !now.Before(time.Unix(result.Expiry, 0))) {
+		return ErrTokenInactive
+	}
+
+	if result.Issuer != "" && strings.TrimSuffix(result.Issuer, "/") != strings.TrimSuffix(a.cfg.Issuer, "/") {
+		return fmt.Errorf("%w: %s", ErrWrongIssuer, result.Issuer)
+	}
+
+	if !slices.Contains(audiences(result.Audience), a.cfg.Resource) {
+		return fmt.Errorf("%w: expected audience %s", ErrWrongAudience, a.cfg.Resource)
+	}
+
+	return nil
+}
+
+// audiences decodes an aud claim that is either one string or a list.
+func audiences(raw json.RawMessage) []string {
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return []string{single}
+	}
+
+	var list []string
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return list
+	}
+
+	return nil
+}
+
+// introspect asks the introspection endpoint about token, authenticating
+// with the configured client credentials.
+func (a *Authorizer) introspect(ctx context.Context, token string) (introspection, error) {
+	var result introspection
+
+	form := url.Values{"token": {token}, "token_type_hint": {"access_token"}}
+	if err := a.post(ctx, a.cfg.IntrospectionURL, form, &result); err != nil {
+		return introspection{}, fmt.Errorf("introspect access token: %w", err)
+	}
+
+	return result, nil
+}
+
+// Exchange trades the caller's access token for a downstream Linode token at
+// the configured token endpoint (RFC 8693).
+func (a *Authorizer) Exchange(ctx context.Context, subjectToken string) (string, error) {
+	exchange := a.cfg.TokenExchange
+
+	form := url.Values{
+		"grant_type":           {tokenExchangeGrant},
+		"subject_token":        {subjectToken},
+		"subject_token_type":   {accessTokenType},
+		"requested_token_type": {accessTokenType},
+	}
+	if exchange.Audience != "" {
+		form.Set("audience", exchange.Audience)
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+	}
+
+	if err := a.post(ctx, exchange.TokenURL, form, &result); err != nil {
+		return "", fmt.Errorf("exchange access token: %w", err)
+	}
+
+	if result.AccessToken == "" {
+		return "", ErrNoExchangedToken
+	}
+
+	return result.AccessToken, nil
+}
+
+// ExchangeEnabled reports whether calls should run on an exchanged token.
+func (a *Authorizer) ExchangeEnabled() bool {
+	return a.cfg.TokenExchange.Enabled
+}
+
+// post sends form to endpoint with client credentials and decodes the JSON
+// response into out.
+func (a *Authorizer) post(ctx context.Context, endpoint string, form url.Values, out any) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	if a.cfg.ClientID != "" {
+		req.SetBasicAuth(url.QueryEscape(a.cfg.ClientID), url.QueryEscape(a.cfg.ClientSecret))
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("call %s: %w", endpoint, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %d", ErrUnexpectedStatus, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxBodyBytes)).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+
+	return nil
+}
+
+// MetadataHandler serves the protected resource metadata document (RFC 9728)
+// at MetadataPath: this server's resource identifier, its authorization
+// server, and the scopes it understands.
+func (a *Authorizer) MetadataHandler() http.Handler {
+	document, _ := json.Marshal(map[string]any{
+		"resource":                 a.cfg.Resource,
+		"authorization_servers":    []string{a.cfg.Issuer},
+		"scopes_supported":         []string{a.cfg.ReadScope, a.cfg.WriteScope},
+		"bearer_methods_supported": []string{"header"},
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(document)
+	})
+}
+
+// Challenge is the WWW-Authenticate value an HTTP transport sends with a 401,
+// pointing the client at the metadata document.
+func (a *Authorizer) Challenge() string {
+	return fmt.Sprintf(`Bearer resource_metadata="%s%s"`, strings.TrimSuffix(a.cfg.Resource, "/"), MetadataPath)
+}
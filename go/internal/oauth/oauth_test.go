@@ -0,0 +1,212 @@
+package oauth_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/oauth"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+)
+
+const (
+	issuerTest   = "https://auth.example.test"
+	resourceTest = "https://mcp.example.test"
+)
+
+// newAuthServer answers introspection with response for "good-token" and
+// inactive for anything else, counting calls.
+func newAuthServer(t *testing.T, response string, calls *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+
+		if user, pass, ok := r.BasicAuth(); !ok || user != "mcp" || pass != "secret" {
+			t.Errorf("basic auth = %q/%q, want mcp/secret", user, pass)
+		}
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parse form: %v", err)
+		}
+
+		if r.PostForm.Get("token") != "good-token" {
+			_, _ = w.Write([]byte(`{"active":false}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func newAuthorizer(introspectionURL string) *oauth.Authorizer {
+	return oauth.New(&config.OAuthConfig{
+		Enabled:          true,
+		Issuer:           issuerTest,
+		Resource:         resourceTest,
+		IntrospectionURL: introspectionURL,
+		ClientID:         "mcp",
+		ClientSecret:     "secret",
+		ReadScope:        config.DefaultOAuthReadScope,
+		WriteScope:       config.DefaultOAuthWriteScope,
+	}, nil)
+}
+
+func bearer(token string) http.Header {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+token)
+
+	return header
+}
+
+func TestAuthorizeMapsScopesToToolsets(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	srv := newAuthServer(t,
+		`{"active":true,"sub":"alice","iss":"`+issuerTest+`","aud":"`+resourceTest+`","scope":"linodemcp:read"}`, &calls)
+	authorizer := newAuthorizer(srv.URL)
+
+	grant, err := authorizer.Authorize(t.Context(), bearer("good-token"), profiles.CapRead)
+	if err != nil {
+		t.Fatalf("Authorize(read) = %v, want nil", err)
+	}
+
+	if grant.Subject != "alice" {
+		t.Errorf("Subject = %q, want alice", grant.Subject)
+	}
+
+	if _, err := authorizer.Authorize(t.Context(), bearer("good-token"), profiles.CapMeta); err != nil {
+		t.Errorf("Authorize(meta) = %v, want nil", err)
+	}
+
+	for _, capability := range []profiles.Capability{profiles.CapWrite, profiles.CapDestroy, profiles.CapAdmin} {
+		if _, err := authorizer.Authorize(t.Context(), bearer("good-token"), capability); !errors.Is(err, oauth.ErrInsufficientScope) {
+			t.Errorf("Authorize(%v) = %v, want ErrInsufficientScope", capability, err)
+		}
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("introspection calls = %d, want 1 (cached)", got)
+	}
+}
+
+func TestAuthorizeWriteScopeImpliesRead(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	srv := newAuthServer(t,
+		`{"active":true,"aud":["other","`+resourceTest+`"],"scope":"linodemcp:write"}`, &calls)
+	authorizer := newAuthorizer(srv.URL)
+
+	for _, capability := range []profiles.Capability{profiles.CapRead, profiles.CapWrite, profiles.CapDestroy} {
+		if _, err := authorizer.Authorize(t.Context(), bearer("good-token"), capability); err != nil {
+			t.Errorf("Authorize(%v) = %v, want nil", capability, err)
+		}
+	}
+}
+
+func TestAuthorizeRejectsBadTokens(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		response string
+		header   http.Header
+		want     error
+	}{
+		{"missing header", `{}`, http.Header{}, oauth.ErrMissingBearer},
+		{"wrong scheme", `{}`, http.Header{"Authorization": {"Basic abc"}}, oauth.ErrMissingBearer},
+		{"inactive", `{}`, bearer("other-token"), oauth.ErrTokenInactive},
+		{"expired", `{"active":true,"aud":"` + resourceTest + `","exp":1}`, bearer("good-token"), oauth.ErrTokenInactive},
+		{"wrong issuer", `{"active":true,"iss":"https://evil.test","aud":"` + resourceTest + `"}`, bearer("good-token"), oauth.ErrWrongIssuer},
+		{"wrong audience", `{"active":true,"aud":"https://other.test","scope":"linodemcp:write"}`, bearer("good-token"), oauth.ErrWrongAudience},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+
+			srv := newAuthServer(t, tt.response, &calls)
+
+			_, err := newAuthorizer(srv.URL).Authorize(t.Context(), tt.header, profiles.CapRead)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Authorize = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestExchangeMintsDownstreamToken(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parse form: %v", err)
+		}
+
+		if got := r.PostForm.Get("grant_type"); got != "urn:ietf:params:oauth:grant-type:token-exchange" {
+			t.Errorf("grant_type = %q", got)
+		}
+
+		if r.PostForm.Get("subject_token") != "good-token" || r.PostForm.Get("audience") != "linode" {
+			t.Errorf("form = %v", r.PostForm)
+		}
+
+		_, _ = w.Write([]byte(`{"access_token":"linode-token","token_type":"Bearer"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	authorizer := oauth.New(&config.OAuthConfig{
+		TokenExchange: config.OAuthTokenExchangeConfig{Enabled: true, TokenURL: srv.URL, Audience: "linode"},
+	}, nil)
+
+	token, err := authorizer.Exchange(t.Context(), "good-token")
+	if err != nil {
+		t.Fatalf("Exchange = %v", err)
+	}
+
+	if token != "linode-token" {
+		t.Errorf("token = %q, want linode-token", token)
+	}
+}
+
+func TestMetadataHandlerAdvertisesResource(t *testing.T) {
+	t.Parallel()
+
+	authorizer := newAuthorizer("https://auth.example.test/introspect")
+
+	rec := httptest.NewRecorder()
+	authorizer.MetadataHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, oauth.MetadataPath, http.NoBody))
+
+	var doc struct {
+		Resource             string   `json:"resource"`
+		AuthorizationServers []string `json:"authorization_servers"`
+		ScopesSupported      []string `json:"scopes_supported"`
+	}
+
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("decode metadata: %v", err)
+	}
+
+	if doc.Resource != resourceTest || len(doc.AuthorizationServers) != 1 || doc.AuthorizationServers[0] != issuerTest {
+		t.Errorf("metadata = %+v", doc)
+	}
+
+	if !strings.Contains(authorizer.Challenge(), resourceTest+oauth.MetadataPath) {
+		t.Errorf("Challenge = %q, want metadata URL", authorizer.Challenge())
+	}
+}
@@ -16,6 +16,11 @@ import (
 // HealthCheck is a function that checks the health of a dependency.
 type HealthCheck func(context.Context) error
 
+// DiagnosticsFunc renders server self-diagnostics as a JSON object for the
+// /healthz route. It is supplied by the server so this package stays free of
+// tool and proto dependencies.
+type DiagnosticsFunc func(context.Context) (json.RawMessage, error)
+
 // HealthStatus represents the status of a health check.
 type HealthStatus struct {
 	Status  string `json:"status"`
@@ -27,6 +32,9 @@ type HealthResponse struct {
 	Status    string                  `json:"status"`
 	Timestamp time.Time               `json:"timestamp"`
 	Checks    map[string]HealthStatus `json:"checks"`
+	// Diagnostics carries the server's self-report (uptime, version,
+	// environments, rate limits, cache) on /healthz when one is set.
+	Diagnostics json.RawMessage `json:"diagnostics,omitempty"`
 }
 
 const (
@@ -64,7 +72,7 @@ func (o *Observability) initHealth(cfg config.HealthConfig) {
 	mux := http.NewServeMux()
 	mux.HandleFunc(cfg.Path+"/live", o.handleLive)
 	mux.HandleFunc(cfg.Path+"/ready", o.handleReady)
-	mux.HandleFunc(cfg.Path+"/healthz", o.handleReady)
+	mux.HandleFunc(cfg.Path+"/healthz", o.handleHealthz)
 
 	bindHost := cfg.Host
 	if bindHost == "" {
@@ -107,6 +115,15 @@ func (o *Observability) RegisterHealthCheck(name string, check HealthCheck) {
 	o.healthChecks[name] = check
 }
 
+// SetDiagnostics sets the function /healthz calls to embed the server's
+// self-diagnostics. Passing nil drops the block from the response.
+func (o *Observability) SetDiagnostics(fn DiagnosticsFunc) {
+	o.healthMu.Lock()
+	defer o.healthMu.Unlock()
+
+	o.diagnostics = fn
+}
+
 // UnregisterHealthCheck removes a health check by name.
 func (o *Observability) UnregisterHealthCheck(name string) {
 	o.healthMu.Lock()
@@ -130,13 +147,30 @@ func (*Observability) handleLive(w http.ResponseWriter, _ *http.Request) {
 	}
 }
 
-// handleReady serves /ready and /healthz: are all dependencies healthy?
+// handleReady serves /ready: are all dependencies healthy?
 func (o *Observability) handleReady(w http.ResponseWriter, r *http.Request) {
+	o.serveHealth(w, r, false)
+}
+
+// handleHealthz serves /healthz: the /ready answer plus the server's
+// self-diagnostics when SetDiagnostics supplied them.
+func (o *Observability) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	o.serveHealth(w, r, true)
+}
+
+// serveHealth runs every check and writes the response, optionally with the
+// diagnostics block. A diagnostics failure is logged and the block omitted;
+// it never turns a healthy server unhealthy.
+func (o *Observability) serveHealth(w http.ResponseWriter, r *http.Request, withDiagnostics bool) {
 	ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
 	defer cancel()
 
 	response := o.checkAllHealth(ctx)
 
+	if withDiagnostics {
+		response.Diagnostics = o.collectDiagnostics(ctx)
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 
 	if response.Status != healthStatusHealthy {
@@ -152,6 +186,26 @@ func (o *Observability) handleReady(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// collectDiagnostics calls the registered DiagnosticsFunc, if any.
+func (o *Observability) collectDiagnostics(ctx context.Context) json.RawMessage {
+	o.healthMu.RLock()
+	fn := o.diagnostics
+	o.healthMu.RUnlock()
+
+	if fn == nil {
+		return nil
+	}
+
+	data, err := fn(ctx)
+	if err != nil {
+		o.logger.Warn("health diagnostics failed", "error", err)
+
+		return nil
+	}
+
+	return data
+}
+
 // checkAllHealth runs every registered check under one read lock.
 func (o *Observability) checkAllHealth(ctx context.Context) HealthResponse {
 	o.healthMu.RLock()
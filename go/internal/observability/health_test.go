@@ -0,0 +1,96 @@
+package observability_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/observability"
+)
+
+// TestHealthzEmbedsDiagnostics drives the real health server: /healthz carries
+// the SetDiagnostics block while /ready stays the bare check summary.
+func TestHealthzEmbedsDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	baseCtx := t.Context()
+	port := freePort(t)
+
+	obs, err := observability.New(&config.ObservabilityConfig{
+		Logging: config.LoggingConfig{Level: "error", Format: "json"},
+		Health:  config.HealthConfig{Enabled: true, Port: port, Path: "/health"},
+	})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	t.Cleanup(func() {
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(baseCtx), 5*time.Second)
+		defer cancel()
+
+		if shutdownErr := obs.Shutdown(shutdownCtx); shutdownErr != nil {
+			t.Errorf("Shutdown: %v", shutdownErr)
+		}
+	})
+
+	obs.SetDiagnostics(func(context.Context) (json.RawMessage, error) {
+		return json.RawMessage(`{"status":"ok","uptime_seconds":7}`), nil
+	})
+
+	healthz := fetchHealth(t, port, "/health/healthz")
+	if healthz.Status != "healthy" || string(healthz.Diagnostics) != `{"status":"ok","uptime_seconds":7}` {
+		t.Errorf("/healthz = %+v, want healthy with the diagnostics block", healthz)
+	}
+
+	if ready := fetchHealth(t, port, "/health/ready"); ready.Diagnostics != nil {
+		t.Errorf("/ready diagnostics = %s, want none", ready.Diagnostics)
+	}
+}
+
+// fetchHealth GETs a health route, retrying while the listener (started in a
+// goroutine by New) comes up, and decodes the 200 body.
+func fetchHealth(t *testing.T, port int, path string) observability.HealthResponse {
+	t.Helper()
+
+	url := fmt.Sprintf("http://127.0.0.1:%d%s", port, path)
+	client := &http.Client{Timeout: 3 * time.Second}
+
+	var (
+		resp *http.Response
+		err  error
+	)
+
+	for range 50 {
+		req, reqErr := http.NewRequestWithContext(t.Context(), http.MethodGet, url, http.NoBody)
+		if reqErr != nil {
+			t.Fatalf("build request: %v", reqErr)
+		}
+
+		if resp, err = client.Do(req); err == nil {
+			break
+		}
+
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET %s status = %d, want 200", url, resp.StatusCode)
+	}
+
+	var decoded observability.HealthResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		t.Fatalf("decode %s: %v", url, err)
+	}
+
+	return decoded
+}
@@ -42,6 +42,7 @@ type Observability struct {
 	healthMu     sync.RWMutex
 	healthChecks map[string]HealthCheck
 	healthServer *http.Server
+	diagnostics  DiagnosticsFunc
 
 	shutdownMu    sync.Mutex
 	shutdownFuncs []func(context.Context) error
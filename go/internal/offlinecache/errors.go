@@ -0,0 +1,11 @@
+package offlinecache
+
+import "errors"
+
+var (
+	// ErrInvalidEnvironment marks an environment name that cannot be used as
+	// a cache directory name.
+	ErrInvalidEnvironment = errors.New("environment name cannot be used as an offline cache directory name")
+	// ErrCorruptEntry marks a cache file that is not valid cache entry JSON.
+	ErrCorruptEntry = errors.New("offline cache file is not valid JSON")
+)
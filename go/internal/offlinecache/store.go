@@ -0,0 +1,207 @@
+// Package offlinecache keeps the last successful result of each read tool
+// call on disk, one directory per environment, so that when the Linode API
+// cannot be reached the server can still answer with what it last saw and
+// say how old it is.
+//
+// An entry is keyed by the tool name and its arguments, minus the ones that
+// only pick the environment or shape the output. The file layout is shared
+// with the Python server, so either server can answer from entries the other
+// saved:
+//
+//	<dir>/<environment>/<tool>.<sha256 of tool and arguments>.json
+//	{"version": 1, "tool": "linode_instance_list", "arguments": {},
+//	 "cached_at": "2026-10-16T12:00:00Z", "content": ["{...}"]}
+package offlinecache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	fileVersion = 1
+	dirMode     = 0o700
+	fileMode    = 0o600
+)
+
+// ignoredArguments never reach an entry's key: they select the environment
+// (already the directory) or only change how the result is displayed.
+var ignoredArguments = []string{"environment", "output_format", "read_after_write"}
+
+// Entry is one saved tool result. Content holds the result's text items in
+// order, as the handler returned them.
+type Entry struct {
+	Version   int            `json:"version"`
+	Tool      string         `json:"tool"`
+	Arguments map[string]any `json:"arguments"`
+	CachedAt  string         `json:"cached_at"`
+	Content   []string       `json:"content"`
+}
+
+// Store reads and writes the cache entries under one directory.
+type Store struct {
+	now func() time.Time
+	dir string
+}
+
+// Option configures a Store at construction time.
+type Option func(*Store)
+
+// WithClock overrides the clock that stamps CachedAt. Tests inject a fixed
+// time; production passes nothing and gets time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(s *Store) {
+		s.now = now
+	}
+}
+
+// NewStore returns a Store over dir. Directories are created on the first
+// Put, so a server that never saves anything does not touch the filesystem.
+func NewStore(dir string, opts ...Option) *Store {
+	store := &Store{dir: dir, now: time.Now}
+	for _, opt := range opts {
+		opt(store)
+	}
+
+	return store
+}
+
+// Put saves content as the latest result of tool called with arguments in
+// environment, replacing any earlier entry for the same call.
+func (s *Store) Put(environment, tool string, arguments map[string]any, content []string) error {
+	path, keyArgs, err := s.path(environment, tool, arguments)
+	if err != nil {
+		return err
+	}
+
+	entry := Entry{
+		Version:   fileVersion,
+		Tool:      tool,
+		Arguments: keyArgs,
+		CachedAt:  s.now().UTC().Format(time.RFC3339),
+		Content:   content,
+	}
+
+	return writeEntry(path, &entry)
+}
+
+// Get returns the entry saved for tool called with arguments in environment.
+// The bool is false when nothing was saved for that call.
+func (s *Store) Get(environment, tool string, arguments map[string]any) (Entry, bool, error) {
+	path, _, err := s.path(environment, tool, arguments)
+	if err != nil {
+		return Entry{}, false, err
+	}
+
+	data, err := os.ReadFile(path) // #nosec G304 -- path is the configured cache dir plus a validated environment and a hashed file name
+	if errors.Is(err, os.ErrNotExist) {
+		return Entry{}, false, nil
+	}
+
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("read offline cache file %s: %w", path, err)
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return Entry{}, false, fmt.Errorf("%w: %s: %w", ErrCorruptEntry, path, err)
+	}
+
+	if entry.Tool != tool {
+		return Entry{}, false, nil
+	}
+
+	return entry, true, nil
+}
+
+// path is the cache file for one call, along with the arguments its key is
+// built from. Environment names come from the config's keys, so this only
+// guards against one that would escape the directory.
+func (s *Store) path(environment, tool string, arguments map[string]any) (string, map[string]any, error) {
+	if environment == "" || environment == "." || environment == ".." ||
+		strings.ContainsAny(environment, `/\`) {
+		return "", nil, fmt.Errorf("%w: %q", ErrInvalidEnvironment, environment)
+	}
+
+	keyArgs := maps.Clone(arguments)
+	if keyArgs == nil {
+		keyArgs = map[string]any{}
+	}
+
+	for _, name := range ignoredArguments {
+		delete(keyArgs, name)
+	}
+
+	key, err := canonicalJSON(keyArgs)
+	if err != nil {
+		return "", nil, fmt.Errorf("encode offline cache key: %w", err)
+	}
+
+	sum := sha256.Sum256([]byte(tool + "\n" + key))
+	name := tool + "." + hex.EncodeToString(sum[:]) + ".json"
+
+	return filepath.Join(s.dir, environment, name), keyArgs, nil
+}
+
+// canonicalJSON encodes arguments compactly with sorted keys and without
+// HTML escaping, the same bytes Python's json.dumps(sort_keys=True,
+// separators=(",", ":"), ensure_ascii=False) produces for them.
+func canonicalJSON(arguments map[string]any) (string, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(arguments); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// writeEntry replaces a cache file through a temp file and rename, so a
+// crash mid-write leaves the previous entry intact.
+func writeEntry(path string, entry *Entry) error {
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode offline cache entry: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, dirMode); err != nil {
+		return fmt.Errorf("create offline cache dir %s: %w", dir, err)
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp.*")
+	if err != nil {
+		return fmt.Errorf("create temp file in %s: %w", dir, err)
+	}
+
+	tmpPath := tmp.Name()
+
+	_, writeErr := tmp.Write(append(data, '\n'))
+	closeErr := tmp.Close()
+
+	if err := errors.Join(writeErr, closeErr, os.Chmod(tmpPath, fileMode)); err != nil {
+		_ = os.Remove(tmpPath)
+
+		return fmt.Errorf("write offline cache file %s: %w", path, err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+
+		return fmt.Errorf("replace offline cache file %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package offlinecache_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/chadit/LinodeMCP/go/internal/offlinecache"
+)
+
+var fixedNow = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+func newTestStore(t *testing.T) (*offlinecache.Store, string) {
+	t.Helper()
+
+	dir := filepath.Join(t.TempDir(), "offline-cache")
+
+	return offlinecache.NewStore(dir, offlinecache.WithClock(func() time.Time { return fixedNow })), dir
+}
+
+func TestStorePutAndGet(t *testing.T) {
+	t.Parallel()
+
+	store, dir := newTestStore(t)
+	args := map[string]any{"page": float64(2), "environment": "default", "output_format": map[string]any{"size_unit": "GB"}}
+
+	if err := store.Put("default", "linode_instance_list", args, []string{`{"instances":[]}`}); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	// The environment and output_format arguments are not part of the key,
+	// and a fresh Store over the same dir sees the entry.
+	entry, ok, err := offlinecache.NewStore(dir).Get("default", "linode_instance_list", map[string]any{"page": float64(2)})
+	if err != nil || !ok {
+		t.Fatalf("Get = %v, %v, want a saved entry", ok, err)
+	}
+
+	if entry.CachedAt != "2026-10-16T12:00:00Z" || !slices.Equal(entry.Content, []string{`{"instances":[]}`}) {
+		t.Errorf("entry = %+v", entry)
+	}
+
+	if _, exists := entry.Arguments["environment"]; exists {
+		t.Errorf("entry arguments = %v, want environment dropped", entry.Arguments)
+	}
+
+	matches, err := filepath.Glob(filepath.Join(dir, "default", "linode_instance_list.*.json"))
+	if err != nil || len(matches) != 1 {
+		t.Fatalf("cache files = %v, %v, want one", matches, err)
+	}
+
+	info, err := os.Stat(matches[0])
+	if err != nil {
+		t.Fatalf("stat cache file: %v", err)
+	}
+
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("cache file mode = %v, want 0600", info.Mode().Perm())
+	}
+}
+
+func TestStoreGetMisses(t *testing.T) {
+	t.Parallel()
+
+	store, _ := newTestStore(t)
+
+	if err := store.Put("default", "linode_instance_get", map[string]any{"instance_id": float64(1)}, []string{"{}"}); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name, environment, tool string
+		args                    map[string]any
+	}{
+		{"other arguments", "default", "linode_instance_get", map[string]any{"instance_id": float64(2)}},
+		{"other environment", "staging", "linode_instance_get", map[string]any{"instance_id": float64(1)}},
+		{"other tool", "default", "linode_volume_get", map[string]any{"instance_id": float64(1)}},
+	} {
+		if _, ok, err := store.Get(tc.environment, tc.tool, tc.args); ok || err != nil {
+			t.Errorf("%s: Get = %v, %v, want a miss", tc.name, ok, err)
+		}
+	}
+}
+
+func TestStoreRejectsEscapingEnvironment(t *testing.T) {
+	t.Parallel()
+
+	store, _ := newTestStore(t)
+
+	for _, environment := range []string{"", "..", "a/b"} {
+		if err := store.Put(environment, "linode_instance_list", nil, nil); !errors.Is(err, offlinecache.ErrInvalidEnvironment) {
+			t.Errorf("Put(%q) = %v, want %v", environment, err, offlinecache.ErrInvalidEnvironment)
+		}
+	}
+}
+
+// TestStoreFileNameIsShared pins the file name for one call. Python's
+// offlinecache store derives the same name, so either server finds the
+// entries the other saved.
+func TestStoreFileNameIsShared(t *testing.T) {
+	t.Parallel()
+
+	store, dir := newTestStore(t)
+
+	if err := store.Put("default", "linode_tag_list", map[string]any{"page": float64(2), "label": "a<b&é"}, []string{"{}"}); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	want := filepath.Join(dir, "default", "linode_tag_list.744f13c0d5ac09e34f979850098acc723a1a07486583977cf6b7e23adc87b153.json")
+	if _, err := os.Stat(want); err != nil {
+		t.Errorf("stat %s: %v", want, err)
+	}
+}
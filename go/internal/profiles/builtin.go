@@ -52,13 +52,14 @@ func Categories(toolName string) []string {
 
 	// Core: a small explicit list of meta/account names.
 	switch toolName {
-	case "hello", "version", "linode_profile_get", "linode_profile_preferences_get", "linode_profile_preferences_update", "linode_profile_token_create", "linode_profile_token_delete", "linode_profile_security_question_list", "linode_profile_security_question_answer", "linode_profile_token_list", "linode_profile_token_update", "linode_profile_device_list", "linode_profile_login_get", "linode_profile_tfa_enable", "linode_profile_tfa_enable_confirm", "linode_profile_phone_number_send", "linode_profile_phone_number_delete", "linode_profile_phone_number_verify", "linode_profile_tfa_disable", "linode_profile_app_get", "linode_profile_app_delete", "linode_profile_device_get", "linode_profile_device_revoke", "linode_profile_app_list", "linode_account_get", "linode_beta_list", "linode_beta_get", "linode_account_beta_list", "linode_account_oauth_client_list", "linode_account_payment_method_list", "linode_account_payment_method_get", "linode_account_payment_method_create", "linode_account_payment_method_delete", "linode_account_payment_method_make_default", "linode_account_notification_list", "linode_tag_list", "linode_tag_create", "linode_tag_delete", "linode_maintenance_policy_list", "linode_account_event_list", "linode_tag_object_list", "linode_support_ticket_reply_list", "linode_support_ticket_list", "linode_support_ticket_close", "linode_account_user_list", "linode_account_user_get", "linode_profile_token_get", "linode_account_user_grants_get", "linode_account_user_grants_update", "linode_account_user_update", "linode_account_user_delete", "linode_account_user_create", "linode_support_ticket_create", "linode_support_ticket_attachment_create", "linode_support_ticket_reply_create", "linode_managed_contact_create", "linode_managed_service_create", "linode_account_invoice_list", "linode_account_payment_list", "linode_account_payment_create", "linode_account_promo_credit_add", "linode_account_invoice_item_list", "linode_account_beta_get":
+	case "hello", "version", "linode_profile_get", "linode_profile_preferences_get", "linode_profile_preferences_update", "linode_profile_token_create", "linode_profile_token_delete", "linode_profile_security_question_list", "linode_profile_security_question_answer", "linode_profile_token_list", "linode_profile_token_update", "linode_profile_device_list", "linode_profile_login_get", "linode_profile_tfa_enable", "linode_profile_tfa_enable_confirm", "linode_profile_phone_number_send", "linode_profile_phone_number_delete", "linode_profile_phone_number_verify", "linode_profile_tfa_disable", "linode_profile_app_get", "linode_profile_app_delete", "linode_profile_device_get", "linode_profile_device_revoke", "linode_profile_app_list", "linode_account_get", "linode_beta_list", "linode_beta_get", "linode_account_beta_list", "linode_account_oauth_client_list", "linode_account_payment_method_list", "linode_account_payment_method_get", "linode_account_payment_method_create", "linode_account_payment_method_delete", "linode_account_payment_method_make_default", "linode_account_notification_list", "linode_tag_list", "linode_tag_create", "linode_tag_delete", "linode_tags_retag", "linode_tags_cleanup", "linode_quota_report", "linode_transfer_forecast", "linode_security_posture", "linode_timeline", "linode_projects_list", "linode_projects_get", "linode_bulk_delete", "linode_maintenance_policy_list", "linode_account_event_list", "linode_tag_object_list", "linode_support_ticket_reply_list", "linode_support_ticket_list", "linode_support_ticket_close", "linode_account_user_list", "linode_account_user_get", "linode_profile_token_get", "linode_account_user_grants_get", "linode_account_user_grants_update", "linode_account_user_update", "linode_account_user_delete", "linode_account_user_create", "linode_support_ticket_create", "linode_support_ticket_attachment_create", "linode_support_ticket_reply_create", "linode_managed_contact_create", "linode_managed_service_create", "linode_account_invoice_list", "linode_account_payment_list", "linode_account_payment_create", "linode_account_promo_credit_add", "linode_account_invoice_item_list", "linode_account_beta_get":
 		cats = append(cats, "core")
 	}
 
 	// compute_deep: per-instance backups, stats, disks, and IPs.
 	if hasAnyPrefix(
 		toolName,
+		"linode_backup_schedule_",
 		"linode_instance_backup_",
 		"linode_instance_stats_",
 		"linode_instance_transfer_",
@@ -81,7 +82,9 @@ func Categories(toolName string) []string {
 	// so a single singular prefix per resource covers list/get/write tools.
 	if hasAnyPrefix(
 		toolName,
+		"linode_alerts_",
 		"linode_instance_",
+		"linode_multi_region_",
 		"linode_region_",
 		"linode_kernel_",
 		"linode_type_",
@@ -101,13 +104,14 @@ func Categories(toolName string) []string {
 		cats = append(cats, "object_storage")
 	}
 
-	if strings.HasPrefix(toolName, "linode_domain_") {
+	if hasAnyPrefix(toolName, "linode_domain_", "linode_dns_") {
 		cats = append(cats, "dns")
 	}
 
 	if hasAnyPrefix(
 		toolName,
 		"linode_firewall_",
+		"linode_access_",
 		"linode_network_transfer_",
 		"linode_networking_ip_",
 		"linode_networking_ips_",
@@ -125,7 +129,7 @@ func Categories(toolName string) []string {
 		cats = append(cats, "databases")
 	}
 
-	if strings.HasPrefix(toolName, "linode_lke_") {
+	if hasAnyPrefix(toolName, "linode_lke_", "linode_access_") {
 		cats = append(cats, "lke")
 	}
 
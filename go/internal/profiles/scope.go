@@ -201,8 +201,9 @@ func scopePrefixTable() []prefixRule {
 		// /profile too.
 		{
 			prefixes: []string{
-				"linode_account_", "linode_managed_", "linode_tag_",
-				"linode_support_ticket_", "linode_profile_", "linode_sshkey_",
+				"linode_account_", "linode_managed_", "linode_tag_", "linode_tags_",
+				"linode_quota_", "linode_support_ticket_", "linode_profile_", "linode_sshkey_",
+				"linode_transfer_", "linode_projects_", "linode_bulk_",
 			},
 			category: categoryAccount,
 		},
@@ -214,7 +215,7 @@ func scopePrefixTable() []prefixRule {
 		{prefixes: []string{"linode_monitor_"}, category: categoryMonitor},
 		{prefixes: []string{"linode_nodebalancer_"}, category: categoryNodeBalancers},
 		{prefixes: []string{"linode_firewall_"}, category: categoryFirewall},
-		{prefixes: []string{"linode_domain_"}, category: categoryDomains},
+		{prefixes: []string{"linode_domain_", "linode_dns_"}, category: categoryDomains},
 		{prefixes: []string{"linode_volume_"}, category: categoryVolumes},
 		{prefixes: []string{"linode_stackscript_"}, category: categoryStackScripts},
 		{prefixes: []string{"linode_vpc_"}, category: categoryVPC},
@@ -232,8 +233,8 @@ func scopePrefixTable() []prefixRule {
 		// with linodes:* scopes.
 		{
 			prefixes: []string{
-				"linode_instance_", "linode_placement_group_",
-				"linode_region_", "linode_type_", "linode_vlan_",
+				"linode_alerts_", "linode_backup_schedule_", "linode_instance_", "linode_multi_region_",
+				"linode_placement_group_", "linode_region_", "linode_type_", "linode_vlan_",
 			},
 			category: categoryLinodes,
 		},
@@ -251,10 +252,12 @@ func scopePrefixTable() []prefixRule {
 func isScopelessRoute(toolName string) bool {
 	switch toolName {
 	// Catalog and pricing routes: kernels, regions, instance types,
-	// per-service type/price lists, database engines and types.
+	// per-service type/price lists, database engines and types. The
+	// availability watch only reads region availability.
 	case "linode_kernel_get", "linode_kernel_list",
 		"linode_region_get", "linode_region_list",
 		"linode_region_availability_get", "linode_region_availability_list",
+		"linode_availability_watch",
 		"linode_type_get", "linode_type_list",
 		"linode_database_engine_get", "linode_database_engine_list",
 		"linode_database_type_get", "linode_database_type_list",
@@ -314,6 +317,7 @@ func scopeOverrides() map[string][]Scope {
 		"linode_instance_interface_get":       {ScopeLinodesReadWrite},
 		"linode_instance_interface_list":      {ScopeLinodesReadWrite},
 		"linode_lke_kubeconfig_get":           {ScopeLKEReadWrite},
+		"linode_lke_workload_ping":            {ScopeLKEReadWrite},
 		"linode_lke_node_get":                 {ScopeLKEReadWrite},
 		"linode_nodebalancer_config_node_get": {ScopeNodeBalancersReadWrite},
 		// The docs put this instance-interface read under the
@@ -330,6 +334,65 @@ func scopeOverrides() map[string][]Scope {
 		// VPC IP listings are documented under ips:*, not vpc:*.
 		"linode_vpc_ip_list":     {ScopeIPsReadOnly},
 		"linode_vpc_ip_all_list": {ScopeIPsReadOnly},
+		// The allowlist updater rewrites LKE control-plane ACLs and
+		// Cloud Firewall rules in one call, so it needs both write scopes.
+		"linode_access_allowlist_update": {ScopeLKEReadWrite, ScopeFirewallReadWrite},
+		// Retagging rewrites the tags on every Linode, domain, volume, and
+		// NodeBalancer that carries the tag, then deletes the old tag.
+		"linode_tags_retag": {
+			ScopeAccountReadWrite, ScopeLinodesReadWrite, ScopeDomainsReadWrite,
+			ScopeVolumesReadWrite, ScopeNodeBalancersReadWrite,
+		},
+		// The quota report counts every entity collection it covers.
+		"linode_quota_report": {
+			ScopeAccountReadOnly, ScopeLinodesReadOnly, ScopeVolumesReadOnly,
+			ScopeNodeBalancersReadOnly, ScopeLKEReadOnly, ScopeFirewallReadOnly,
+			ScopeVPCReadOnly, ScopeDomainsReadOnly, ScopeDatabasesReadOnly,
+			ScopeObjectStorageReadOnly,
+		},
+		// The transfer forecast reads the account pool and every instance's
+		// share of it.
+		"linode_transfer_forecast": {ScopeAccountReadOnly, ScopeLinodesReadOnly},
+		// Project views read every collection a project can hold.
+		"linode_projects_list": {ScopeLinodesReadOnly, ScopeVolumesReadOnly, ScopeNodeBalancersReadOnly, ScopeDomainsReadOnly},
+		"linode_projects_get":  {ScopeLinodesReadOnly, ScopeVolumesReadOnly, ScopeNodeBalancersReadOnly, ScopeDomainsReadOnly},
+		// Bulk delete resolves a tag, then detaches volumes, removes DNS
+		// records, and deletes instances, volumes, NodeBalancers, and domains.
+		"linode_bulk_delete": {
+			ScopeAccountReadOnly, ScopeLinodesReadWrite, ScopeDomainsReadWrite,
+			ScopeVolumesReadWrite, ScopeNodeBalancersReadWrite,
+		},
+		// The console view reads the instance and scans the event feed for its
+		// events.
+		"linode_instance_console": {ScopeLinodesReadOnly, ScopeEventsReadOnly},
+		// Capturing an instance reads its disks, then creates and replicates
+		// the image.
+		"linode_instance_to_image": {ScopeLinodesReadOnly, ScopeImagesReadWrite},
+		// Replacing an instance creates and deletes instances, attaches the
+		// old one's firewalls to the new one, and repoints its DNS records.
+		"linode_instance_replace": {ScopeLinodesReadWrite, ScopeFirewallReadWrite, ScopeDomainsReadWrite},
+		// The cloud-init status reads the instance and scans the event feed
+		// for its provisioning and boot events.
+		"linode_instance_cloudinit_status": {ScopeLinodesReadOnly, ScopeEventsReadOnly},
+		// The SSH key report reads the profile and its keys, then scans the
+		// event feed for the instances deployed since each key was added.
+		"linode_sshkey_usage_report": {ScopeAccountReadOnly, ScopeEventsReadOnly},
+		// The stale resource report lists instances, images, domains, and SSH
+		// keys, reads the profile, and scans the event feed for boots and key
+		// deployments.
+		"linode_stale_resources": {
+			ScopeLinodesReadOnly, ScopeImagesReadOnly, ScopeDomainsReadOnly,
+			ScopeAccountReadOnly, ScopeEventsReadOnly,
+		},
+		// The security posture report reads the profile, users, tokens, logins,
+		// and SSH keys, scans the event feed, reads every bucket's ACL, and
+		// reads every NodeBalancer's configs for its HTTPS cipher suite.
+		"linode_security_posture": {
+			ScopeAccountReadOnly, ScopeEventsReadOnly, ScopeObjectStorageReadOnly,
+			ScopeNodeBalancersReadOnly,
+		},
+		// The timeline only scans the event feed, gated by events:*.
+		"linode_timeline": {ScopeEventsReadOnly},
 	}
 }
 
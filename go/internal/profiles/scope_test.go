@@ -689,6 +689,7 @@ func TestRequiredScopesScopelessRoutes(t *testing.T) {
 		"linode_region_list",
 		"linode_region_availability_get",
 		"linode_region_availability_list",
+		"linode_availability_watch",
 		"linode_type_get",
 		"linode_type_list",
 		toolDatabaseEngineGet,
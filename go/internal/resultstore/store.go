@@ -0,0 +1,109 @@
+// Package resultstore keeps the full text of tool results that were replaced
+// by a summary, so linode_result_get can return them on request. Results live
+// in process memory only: they do not survive a restart, and the store holds
+// at most MaxResults of them.
+package resultstore
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxResults caps the store. Once it is reached, the next Put evicts the
+// oldest result to make room.
+const MaxResults = 32
+
+// ResultIDPrefix marks stored-result identifiers so the model can tell them
+// apart from plan IDs and Linode IDs.
+const ResultIDPrefix = "result_"
+
+// Entry is one stored result. Partition is the tenant that stored it: empty
+// unless server.tokenPassthrough has tenants share one server, where only
+// the same tenant reads it back.
+type Entry struct {
+	ID        string
+	Partition string
+	Tool      string
+	StoredAt  time.Time
+	Content   []string
+}
+
+// Store holds summarized results in process memory.
+type Store struct {
+	now     func() time.Time
+	entries map[string]*Entry
+	order   []string
+	mu      sync.Mutex
+}
+
+// Option configures a Store at construction time.
+type Option func(*Store)
+
+// WithClock overrides the wall clock used to stamp StoredAt.
+func WithClock(now func() time.Time) Option {
+	return func(s *Store) {
+		s.now = now
+	}
+}
+
+// NewStore returns an empty result store.
+func NewStore(opts ...Option) *Store {
+	store := &Store{
+		now:     time.Now,
+		entries: make(map[string]*Entry),
+	}
+
+	for _, opt := range opts {
+		opt(store)
+	}
+
+	return store
+}
+
+// Put stores the text items of one tool result for a partition and returns
+// their ID. The oldest result is evicted first when the store is full.
+func (s *Store) Put(partition, tool string, content []string) (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("generate result id: %w", err)
+	}
+
+	entry := &Entry{
+		ID:        ResultIDPrefix + id.String(),
+		Partition: partition,
+		Tool:      tool,
+		StoredAt:  s.now().UTC(),
+		Content:   content,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for len(s.order) >= MaxResults {
+		delete(s.entries, s.order[0])
+		s.order = s.order[1:]
+	}
+
+	s.entries[entry.ID] = entry
+	s.order = append(s.order, entry.ID)
+
+	return entry.ID, nil
+}
+
+// Get returns the stored result with the given ID. The bool is false when no
+// such result is held, because the ID is unknown, was evicted, predates a
+// restart, or belongs to another partition.
+func (s *Store) Get(partition, id string) (Entry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[id]
+	if !ok || entry.Partition != partition {
+		return Entry{}, false
+	}
+
+	return *entry, true
+}
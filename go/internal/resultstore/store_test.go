@@ -0,0 +1,85 @@
+package resultstore_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chadit/LinodeMCP/go/internal/resultstore"
+)
+
+func TestStorePutAndGet(t *testing.T) {
+	t.Parallel()
+
+	stored := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	store := resultstore.NewStore(resultstore.WithClock(func() time.Time { return stored }))
+
+	id, err := store.Put("", "linode_instance_list", []string{`{"instances":[]}`})
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	if !strings.HasPrefix(id, resultstore.ResultIDPrefix) {
+		t.Errorf("id = %q, want prefix %q", id, resultstore.ResultIDPrefix)
+	}
+
+	entry, ok := store.Get("", id)
+	if !ok {
+		t.Fatalf("Get(%q) found nothing", id)
+	}
+
+	if entry.Tool != "linode_instance_list" || !entry.StoredAt.Equal(stored) || !slices.Equal(entry.Content, []string{`{"instances":[]}`}) {
+		t.Errorf("entry = %+v", entry)
+	}
+
+	if _, ok := store.Get("", "result_unknown"); ok {
+		t.Error("Get(unknown) found an entry")
+	}
+}
+
+func TestStoreEvictsOldest(t *testing.T) {
+	t.Parallel()
+
+	store := resultstore.NewStore()
+
+	first, err := store.Put("", "linode_instance_list", nil)
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	var last string
+
+	for range resultstore.MaxResults {
+		if last, err = store.Put("", "linode_volume_list", nil); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+	}
+
+	if _, ok := store.Get("", first); ok {
+		t.Error("oldest result survived a full store")
+	}
+
+	if _, ok := store.Get("", last); !ok {
+		t.Error("newest result was evicted")
+	}
+}
+
+func TestStoreGetKeepsPartitionsApart(t *testing.T) {
+	t.Parallel()
+
+	store := resultstore.NewStore()
+
+	id, err := store.Put("0a1b2c3d4e5f6071", "linode_instance_list", []string{"{}"})
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	if _, ok := store.Get("8192a3b4c5d6e7f8", id); ok {
+		t.Error("another partition read the result")
+	}
+
+	if _, ok := store.Get("0a1b2c3d4e5f6071", id); !ok {
+		t.Error("the storing partition could not read the result")
+	}
+}
@@ -1,11 +1,14 @@
 package server_test
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"slices"
 	"testing"
 
 	"github.com/chadit/LinodeMCP/go/internal/audit"
+	"github.com/chadit/LinodeMCP/go/internal/config"
 	"github.com/chadit/LinodeMCP/go/internal/server"
 )
 
@@ -65,6 +68,64 @@ func TestAuditMiddlewareWritesEventOnSuccess(t *testing.T) {
 	}
 }
 
+// TestAuditMiddlewareAttributesWriteCost verifies that with
+// audit.cost_attribution on, a successful NodeBalancer create records its
+// monthly list price on the audit event, while a dry run of the same call
+// records none.
+func TestAuditMiddlewareAttributesWriteCost(t *testing.T) {
+	t.Parallel()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		body := `{"id":20,"label":"web-lb","region":"us-east"}`
+		if r.URL.Path == "/nodebalancers/types" {
+			body = `{"data":[{"id":"nodebalancer","price":{"hourly":0.015,"monthly":10},"region_prices":[]}],"page":1,"pages":1,"results":1}`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	defer api.Close()
+
+	cfg := fullAccessConfig()
+	cfg.Environments = map[string]config.EnvironmentConfig{
+		envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: api.URL, Token: tokenShort}},
+	}
+	cfg.Audit.CostAttribution = true
+
+	srv, err := server.New(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sink := audit.NewCapturingSink()
+	srv.SetAuditSink(sink)
+
+	for _, arguments := range []string{`{"region": "us-east", "confirm": true}`, `{"region": "us-east", "dry_run": true}`} {
+		_ = srv.HandleMessage(t.Context(), []byte(`{
+			"jsonrpc": "2.0",
+			"id": 1,
+			"method": "tools/call",
+			"params": {"name": "linode_nodebalancer_create", "arguments": `+arguments+`}
+		}`))
+	}
+
+	events := sink.Events()
+	if len(events) != 2 {
+		t.Fatalf("len(events) = %d, want 2", len(events))
+	}
+
+	if got := events[0].MonthlyCostDeltaUSD; got == nil || *got != 10 {
+		t.Errorf("create MonthlyCostDeltaUSD = %v, want 10", got)
+	}
+
+	if got := events[1].MonthlyCostDeltaUSD; got != nil {
+		t.Errorf("dry run MonthlyCostDeltaUSD = %v, want nil", *got)
+	}
+}
+
 // TestSetAuditSinkNilRestoresNoop locks the documented contract for
 // SetAuditSink(nil): the server doesn't nil-deref on the next tool
 // call. Instead it falls back to NoopSink, which discards events
@@ -245,3 +306,35 @@ func TestAuditMiddlewareLeavesPIIWhenFlagOff(t *testing.T) {
 		t.Errorf("helloEvent.ArgsRedacted does not contain %v", "token")
 	}
 }
+
+// TestOAuthRefusesCallWithoutBearer locks the oauth gate: with
+// oauth.enabled, a call that carries no access token (stdio never does)
+// is refused before the handler runs and audited as refused.
+func TestOAuthRefusesCallWithoutBearer(t *testing.T) {
+	t.Parallel()
+
+	cfg := fullAccessConfig()
+	cfg.OAuth.Enabled = true
+	cfg.OAuth.Issuer = "https://auth.example.test"
+	cfg.OAuth.Resource = "https://mcp.example.test"
+	cfg.OAuth.IntrospectionURL = "https://auth.example.test/introspect"
+
+	srv, err := server.New(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sink := audit.NewCapturingSink()
+	srv.SetAuditSink(sink)
+
+	_ = srv.HandleMessage(t.Context(), []byte(helloCallMessage))
+
+	helloEvent := findEventByTool(sink.Events(), "hello")
+	if helloEvent == nil {
+		t.Fatal("helloEvent is nil")
+	}
+
+	if helloEvent.Status != audit.StatusRefused {
+		t.Errorf("helloEvent.Status = %v, want %v", helloEvent.Status, audit.StatusRefused)
+	}
+}
@@ -1,6 +1,7 @@
 package server_test
 
 import (
+	"cmp"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/chadit/LinodeMCP/go/internal/config"
 	"github.com/chadit/LinodeMCP/go/internal/server"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
 )
 
 // behaviorFixture is one shared cross-language behavior contract file from
@@ -40,20 +42,29 @@ type behaviorFixture struct {
 // with no matching key fails the case, but an unused key does not:
 // implementations may fetch equivalent data from different endpoints, and
 // the contract these fixtures pin is the OUTPUT, not the fetch pattern.
-// Without APIResponses the single APIResponse (or {}) answers every request.
+// Without APIResponses the single APIResponse (or {}) answers every request,
+// with status APIStatus (200 when unset), so a case can pin how a tool
+// reports an API error.
 //
 // A case whose args include dry_run:true additionally asserts that every
 // captured request is a GET: a dry run may read whatever it needs to build
 // its preview but must never mutate.
+//
+// ExpectEnvelope, when set, additionally pins the result's response envelope
+// (_meta "linodemcp/envelope") without its per-call elapsed_ms and
+// correlation_id, so both servers agree on the count, environment, and
+// warnings they report alongside the content.
 type behaviorCase struct {
 	Name           string                     `json:"name"`
 	Args           map[string]any             `json:"args"`
 	APIResponse    json.RawMessage            `json:"api_response"`
 	APIResponses   map[string]json.RawMessage `json:"api_responses"`
+	APIStatus      int                        `json:"api_status"`
 	ExpectAPIError string                     `json:"expect_api_error"`
 	ExpectError    string                     `json:"expect_error"`
 	ExpectRequest  *behaviorRequest           `json:"expect_request"`
 	ExpectResult   json.RawMessage            `json:"expect_result"`
+	ExpectEnvelope json.RawMessage            `json:"expect_envelope"`
 }
 
 // behaviorRequest is the expected outgoing HTTP call: method, path (with any
@@ -129,6 +140,40 @@ func decodeBehaviorResult(t *testing.T, rawResponse []byte) (bool, string) {
 	return isError, text
 }
 
+// checkBehaviorEnvelope asserts the response envelope in a marshaled
+// tools/call JSON-RPC response equals want once its per-call elapsed_ms and
+// correlation_id are dropped.
+func checkBehaviorEnvelope(t *testing.T, rawResponse []byte, want json.RawMessage) {
+	t.Helper()
+
+	var decoded struct {
+		Result struct {
+			Meta map[string]map[string]any `json:"_meta"`
+		} `json:"result"`
+	}
+
+	if err := json.Unmarshal(rawResponse, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, ok := decoded.Result.Meta[tools.EnvelopeMetaKey]
+	if !ok {
+		t.Fatalf("no %s in _meta: %s", tools.EnvelopeMetaKey, rawResponse)
+	}
+
+	delete(got, "elapsed_ms")
+	delete(got, "correlation_id")
+
+	var wantValue map[string]any
+	if err := json.Unmarshal(want, &wantValue); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, wantValue) {
+		t.Errorf("envelope mismatch\ngot:  %v\nwant: %s", got, want)
+	}
+}
+
 // TestBehaviorConformance replays every shared behavior fixture through the
 // real server dispatch path (registration, profile filter, middleware,
 // handler, client) with the HTTP transport faked by httptest.
@@ -233,7 +278,7 @@ func resolveBehaviorResponse(testCase *behaviorCase, method, path string) (json.
 			response = json.RawMessage(`{}`)
 		}
 
-		return response, http.StatusOK, true
+		return response, cmp.Or(testCase.APIStatus, http.StatusOK), true
 	}
 
 	response, ok := testCase.APIResponses[method+" "+path]
@@ -316,6 +361,10 @@ func runBehaviorCase(t *testing.T, toolName string, testCase *behaviorCase) {
 		checkBehaviorNoMutation(t, captured)
 	}
 
+	if testCase.ExpectEnvelope != nil {
+		checkBehaviorEnvelope(t, rawResponse, testCase.ExpectEnvelope)
+	}
+
 	switch {
 	case testCase.ExpectError != "":
 		checkBehaviorError(t, isError, text, captured, testCase.ExpectError)
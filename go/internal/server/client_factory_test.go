@@ -0,0 +1,47 @@
+package server_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linodefake"
+	"github.com/chadit/LinodeMCP/go/internal/server"
+)
+
+// TestSetClientFactoryServesToolCalls verifies that a tool call dispatched
+// through the server builds its client with the factory the server was
+// given, so an in-memory backend answers it instead of the network.
+func TestSetClientFactoryServesToolCalls(t *testing.T) {
+	t.Parallel()
+
+	backend := linodefake.New()
+	cfg := fullAccessConfig()
+	cfg.Environments[envKeyDefault] = config.EnvironmentConfig{Label: envLabelDefault, Linode: backend.Environment(tokenShort)}
+
+	srv, err := server.New(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	backend.SetList("/tags", map[string]any{"label": "fake-tag"})
+	srv.SetClientFactory(backend.NewClient)
+
+	message := `{
+		"jsonrpc": "2.0",
+		"id": 1,
+		"method": "tools/call",
+		"params": {"name": "linode_tag_list", "arguments": {}}
+	}`
+
+	result := decodeCallResult(t, srv.HandleMessage(t.Context(), []byte(message)))
+	if result.IsError || len(result.Content) == 0 || !strings.Contains(result.Content[0].Text, "fake-tag") {
+		t.Fatalf("result = %+v, want the backend's tag", result)
+	}
+
+	requests := backend.Requests()
+	if len(requests) != 1 || requests[0].Method != http.MethodGet || requests[0].Path != "/tags" {
+		t.Errorf("requests = %+v, want one GET /tags", requests)
+	}
+}
@@ -0,0 +1,168 @@
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/audit"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/server"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// decodeEnvelope extracts the linodemcp/envelope block from a marshaled
+// tools/call JSON-RPC response's _meta.
+func decodeEnvelope(t *testing.T, response any) tools.Envelope {
+	t.Helper()
+
+	raw, err := json.Marshal(response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded struct {
+		Result struct {
+			Meta map[string]json.RawMessage `json:"_meta"`
+		} `json:"result"`
+	}
+
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	block, ok := decoded.Result.Meta[tools.EnvelopeMetaKey]
+	if !ok {
+		t.Fatalf("no %s in _meta: %s", tools.EnvelopeMetaKey, raw)
+	}
+
+	var env tools.Envelope
+	if err := json.Unmarshal(block, &env); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return env
+}
+
+// TestEnvelopeWarnsOnIgnoredArgument verifies an argument the tool's schema
+// does not declare surfaces as an envelope warning while the call itself
+// still succeeds.
+func TestEnvelopeWarnsOnIgnoredArgument(t *testing.T) {
+	t.Parallel()
+
+	srv, err := server.New(fullAccessConfig())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	message := `{
+		"jsonrpc": "2.0",
+		"id": 1,
+		"method": "tools/call",
+		"params": {"name": "hello", "arguments": {"name": "Pat", "nmae": "typo"}}
+	}`
+
+	env := decodeEnvelope(t, srv.HandleMessage(t.Context(), []byte(message)))
+
+	want := `argument "nmae" is not accepted by hello and was ignored`
+	if !slices.Contains(env.Warnings, want) {
+		t.Errorf("env.Warnings = %v, want to contain %q", env.Warnings, want)
+	}
+
+	if env.Count != nil {
+		t.Errorf("env.Count = %v, want nil for a non-list tool", *env.Count)
+	}
+}
+
+// TestEnvelopeCarriesListCountAndEnvironment verifies a list tool stamps its
+// item count and the requested environment, with an empty warnings array.
+func TestEnvelopeCarriesListCountAndEnvironment(t *testing.T) {
+	t.Parallel()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if _, err := w.Write([]byte(`{"data":[{"label":"prod"},{"label":"staging"}],"page":1,"pages":1,"results":2}`)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	defer api.Close()
+
+	srv := newTestServerWithAPIURL(t, api.URL)
+
+	message := `{
+		"jsonrpc": "2.0",
+		"id": 1,
+		"method": "tools/call",
+		"params": {"name": "linode_tag_list", "arguments": {"environment": "default"}}
+	}`
+
+	env := decodeEnvelope(t, srv.HandleMessage(t.Context(), []byte(message)))
+
+	if env.Count == nil || *env.Count != 2 {
+		t.Errorf("env.Count = %v, want 2", env.Count)
+	}
+
+	if env.Environment != envKeyDefault {
+		t.Errorf("env.Environment = %q, want %q", env.Environment, envKeyDefault)
+	}
+
+	if env.Warnings == nil || len(env.Warnings) != 0 {
+		t.Errorf("env.Warnings = %#v, want empty array", env.Warnings)
+	}
+}
+
+// TestEnvelopeCorrelationIDMatchesAPIRequestAndAudit verifies one tool call
+// carries a single correlation ID: the audit event's ID, sent with the call's
+// Linode API request and echoed in the result envelope.
+func TestEnvelopeCorrelationIDMatchesAPIRequestAndAudit(t *testing.T) {
+	t.Parallel()
+
+	var header, userAgent string
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get(linode.CorrelationIDHeader)
+		userAgent = r.Header.Get("User-Agent")
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if _, err := w.Write([]byte(`{"data":[],"page":1,"pages":1,"results":0}`)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	defer api.Close()
+
+	srv := newTestServerWithAPIURL(t, api.URL)
+
+	sink := audit.NewCapturingSink()
+	srv.SetAuditSink(sink)
+
+	message := `{
+		"jsonrpc": "2.0",
+		"id": 1,
+		"method": "tools/call",
+		"params": {"name": "linode_tag_list", "arguments": {}}
+	}`
+
+	env := decodeEnvelope(t, srv.HandleMessage(t.Context(), []byte(message)))
+
+	if !strings.HasPrefix(env.CorrelationID, audit.EventIDPrefix) {
+		t.Fatalf("env.CorrelationID = %q, want an audit event ID", env.CorrelationID)
+	}
+
+	if header != env.CorrelationID {
+		t.Errorf("%s = %q, want %q", linode.CorrelationIDHeader, header, env.CorrelationID)
+	}
+
+	if !strings.HasSuffix(userAgent, " correlation/"+env.CorrelationID) {
+		t.Errorf("User-Agent = %q, want it to end with the correlation ID", userAgent)
+	}
+
+	event := findEventByTool(sink.Events(), "linode_tag_list")
+	if event == nil || event.EventID != env.CorrelationID {
+		t.Errorf("audit event = %+v, want event_id %q", event, env.CorrelationID)
+	}
+}
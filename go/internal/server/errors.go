@@ -4,7 +4,8 @@ import "errors"
 
 // Sentinel errors for server operations.
 var (
-	ErrConfigNil             = errors.New("config cannot be nil")
-	ErrExecuteNotImplemented = errors.New("execute method not implemented for wrapper")
-	errServerShuttingDown    = errors.New("server is shutting down")
+	ErrConfigNil          = errors.New("config cannot be nil")
+	ErrToolNotFound       = errors.New("tool not found in the active profile")
+	errServerShuttingDown = errors.New("server is shutting down")
+	errSamplingNotText    = errors.New("sampling result is not text")
 )
@@ -1,6 +1,7 @@
 package server_test
 
 import (
+	"maps"
 	"slices"
 	"testing"
 
@@ -21,9 +22,13 @@ func TestInstanceConfigInterfacesListToolRegistered(t *testing.T) {
 			t.Errorf("info.Capability = %v, want %v", info.Capability, profiles.CapRead)
 		}
 
+		// The server adds the list arguments and timeout_seconds to the
+		// tool's own environment, linode_id, and config_id.
 		properties := toolSchemaProps(t, &info)
-		if len(properties) != 3 {
-			t.Errorf("schema properties = %v, want only environment, linode_id, and config_id", properties)
+		want := []string{"config_id", "environment", "filter", "linode_id", "order", "order_by", "timeout_seconds"}
+
+		if got := slices.Sorted(maps.Keys(properties)); !slices.Equal(got, want) {
+			t.Errorf("schema properties = %v, want %v", got, want)
 		}
 
 		for _, key := range []string{"linode_id", "config_id"} {
@@ -0,0 +1,242 @@
+package server_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/server"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// TestOfflineCacheAnswersWhenAPIUnreachable verifies a read tool's
+// successful result is saved, and that once the API stops answering the
+// same call returns it with a stale-as-of warning in the content and the
+// envelope instead of failing.
+func TestOfflineCacheAnswersWhenAPIUnreachable(t *testing.T) {
+	t.Parallel()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if _, err := w.Write([]byte(`{"data":[{"label":"prod"}],"page":1,"pages":1,"results":1}`)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+
+	cfg := &config.Config{
+		Server:       config.ServerConfig{Name: serverNameTest, LogLevel: logLevelInfo, Transport: transportStdio, Host: hostLocalhost, Port: 8080},
+		OfflineCache: config.OfflineCacheConfig{Enabled: true, Dir: t.TempDir()},
+		Resilience:   config.ResilienceConfig{BaseRetryDelay: time.Millisecond, MaxRetryDelay: time.Millisecond},
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: api.URL, Token: tokenShort}},
+		},
+	}
+
+	srv, err := server.New(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	message := `{
+		"jsonrpc": "2.0",
+		"id": 1,
+		"method": "tools/call",
+		"params": {"name": "linode_tag_list", "arguments": {}}
+	}`
+
+	live := decodeCallResult(t, srv.HandleMessage(t.Context(), []byte(message)))
+	if live.IsError || len(live.Content) != 1 {
+		t.Fatalf("live result = %+v, want one text item", live)
+	}
+
+	api.Close()
+
+	response := srv.HandleMessage(t.Context(), []byte(message))
+
+	cached := decodeCallResult(t, response)
+	if cached.IsError || len(cached.Content) != 2 {
+		t.Fatalf("cached result = %+v, want the saved item plus a warning", cached)
+	}
+
+	if cached.Content[0].Text != live.Content[0].Text {
+		t.Errorf("cached text = %q, want %q", cached.Content[0].Text, live.Content[0].Text)
+	}
+
+	if !strings.HasPrefix(cached.Content[1].Text, "Warning: the Linode API is unreachable") ||
+		!strings.Contains(cached.Content[1].Text, "stale as of ") {
+		t.Errorf("warning item = %q, want a stale-as-of warning", cached.Content[1].Text)
+	}
+
+	env := decodeEnvelope(t, response)
+	if len(env.Warnings) != 1 || !strings.Contains(env.Warnings[0], "offline cache, stale as of ") {
+		t.Errorf("env.Warnings = %v, want the stale-as-of warning", env.Warnings)
+	}
+}
+
+// TestOfflineCacheOffUnderTokenPassthrough verifies that when two tokens
+// share one deployment, the second never gets the first one's saved
+// inventory: once the API stops answering, both calls fail instead.
+func TestOfflineCacheOffUnderTokenPassthrough(t *testing.T) {
+	t.Parallel()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if _, err := w.Write([]byte(`{"data":[{"label":"alice-prod"}],"page":1,"pages":1,"results":1}`)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+
+	cacheDir := t.TempDir()
+	cfg := &config.Config{
+		Server: config.ServerConfig{
+			Name: serverNameTest, LogLevel: logLevelInfo, Transport: config.TransportHTTP, Host: hostLocalhost, Port: 8080,
+			TokenPassthrough: true,
+		},
+		OfflineCache: config.OfflineCacheConfig{Enabled: true, Dir: cacheDir},
+		Resilience:   config.ResilienceConfig{BaseRetryDelay: time.Millisecond, MaxRetryDelay: time.Millisecond},
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: api.URL}},
+		},
+	}
+
+	srv, err := server.New(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	handler, err := srv.HTTPHandler(config.TransportHTTP)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	session := initializeHTTPSession(t, handler, nil)
+	alice := http.Header{tools.TokenHeader: {"alice-token"}}
+	bob := http.Header{tools.TokenHeader: {"bob-token"}}
+
+	if live := callToolHTTP(t, handler, session, alice, "linode_tag_list"); live.IsError {
+		t.Fatalf("live result = %+v, want success", live)
+	}
+
+	api.Close()
+
+	for name, header := range map[string]http.Header{"bob": bob, "alice": alice} {
+		result := callToolHTTP(t, handler, session, header, "linode_tag_list")
+		if !result.IsError {
+			t.Errorf("%s's call with the API down = %+v, want an error, not a cached result", name, result)
+		}
+
+		for _, item := range result.Content {
+			if strings.Contains(item.Text, "alice-prod") {
+				t.Errorf("%s's call returned saved inventory: %q", name, item.Text)
+			}
+		}
+	}
+}
+
+// initializeHTTPSession runs the MCP initialize handshake against handler
+// with header set on the request, and returns the session ID it assigns.
+func initializeHTTPSession(t *testing.T, handler http.Handler, header http.Header) string {
+	t.Helper()
+
+	rec := postMCP(t, handler, "", header, `{
+		"jsonrpc": "2.0",
+		"id": 1,
+		"method": "initialize",
+		"params": {"protocolVersion": "2025-03-26", "capabilities": {}, "clientInfo": {"name": "test", "version": "1.0"}}
+	}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("initialize status = %d (body %q)", rec.Code, rec.Body.String())
+	}
+
+	return rec.Header().Get("Mcp-Session-Id")
+}
+
+// callToolHTTP calls a tool with no arguments over the streamable HTTP
+// transport and decodes the result, whether it came back as JSON or as an
+// event stream.
+func callToolHTTP(t *testing.T, handler http.Handler, session string, header http.Header, name string) callResult {
+	t.Helper()
+
+	rec := postMCP(t, handler, session, header,
+		`{"jsonrpc": "2.0", "id": 2, "method": "tools/call", "params": {"name": "`+name+`", "arguments": {}}}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("tools/call status = %d (body %q)", rec.Code, rec.Body.String())
+	}
+
+	body := rec.Body.String()
+
+	if strings.HasPrefix(rec.Header().Get("Content-Type"), "text/event-stream") {
+		scanner := bufio.NewScanner(strings.NewReader(body))
+		for scanner.Scan() {
+			if data, ok := strings.CutPrefix(scanner.Text(), "data: "); ok {
+				body = data
+			}
+		}
+	}
+
+	var decoded struct {
+		Result callResult `json:"result"`
+	}
+
+	if err := json.Unmarshal([]byte(body), &decoded); err != nil {
+		t.Fatalf("decode tools/call response %q: %v", body, err)
+	}
+
+	return decoded.Result
+}
+
+func postMCP(t *testing.T, handler http.Handler, session string, header http.Header, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/mcp", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+
+	if session != "" {
+		req.Header.Set("Mcp-Session-Id", session)
+	}
+
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+// callResult is the part of a tools/call response the offline cache test
+// reads.
+type callResult struct {
+	IsError bool `json:"isError"`
+	Content []struct {
+		Text string `json:"text"`
+	} `json:"content"`
+}
+
+func decodeCallResult(t *testing.T, response any) callResult {
+	t.Helper()
+
+	raw, err := json.Marshal(response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded struct {
+		Result callResult `json:"result"`
+	}
+
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return decoded.Result
+}
@@ -204,6 +204,88 @@ func TestNewFullAccessRegistersEverything(t *testing.T) {
 	}
 }
 
+// TestNewReadOnlyOverridesFullAccess is the read_only contract: with
+// cfg.ReadOnly set, even full-access registers only CapRead and CapMeta
+// tools, and a reload to another write-capable profile keeps it that way.
+func TestNewReadOnlyOverridesFullAccess(t *testing.T) {
+	t.Parallel()
+
+	cfg := fullAccessConfig()
+	cfg.ReadOnly = true
+
+	srv, err := server.New(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	names := toolNames(srv)
+	if !slices.Contains(names, toolInstancesList) {
+		t.Errorf("names does not contain %v", toolInstancesList)
+	}
+
+	if slices.Contains(names, toolInstanceCreate) {
+		t.Errorf("names should not contain %v", toolInstanceCreate)
+	}
+
+	if slices.Contains(srv.ActiveProfile().AllowedTools, toolInstanceCreate) {
+		t.Errorf("ActiveProfile().AllowedTools should not contain %v", toolInstanceCreate)
+	}
+
+	reload := fullAccessConfig()
+	reload.ReadOnly = true
+	reload.ActiveProfile = profiles.BuiltinComputeAdmin
+
+	if err := srv.ReloadProfile(reload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, info := range srv.ToolInfos() {
+		if info.Capability != profiles.CapRead && info.Capability != profiles.CapMeta {
+			t.Errorf("tool %s has capability %v, want only CapRead or CapMeta in read_only mode", info.Name, info.Capability)
+		}
+	}
+}
+
+// TestNewToolsConfigFiltersSurface confirms the tools config narrows what
+// full-access registers, and that the filter holds across a profile reload.
+func TestNewToolsConfigFiltersSurface(t *testing.T) {
+	t.Parallel()
+
+	cfg := fullAccessConfig()
+	cfg.Tools = config.ToolsConfig{
+		Enabled:  []string{"linode_instance_*", "!linode_*_delete"},
+		Disabled: []string{toolInstanceCreate},
+	}
+
+	srv, err := server.New(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	names := toolNames(srv)
+	if !slices.Contains(names, toolInstancesList) {
+		t.Errorf("names does not contain %v", toolInstancesList)
+	}
+
+	for _, filtered := range []string{toolInstanceCreate, "linode_instance_delete", toolVolumesList} {
+		if slices.Contains(names, filtered) {
+			t.Errorf("names should not contain %v", filtered)
+		}
+	}
+
+	reload := fullAccessConfig()
+	reload.Tools = cfg.Tools
+	reload.ActiveProfile = profiles.BuiltinComputeAdmin
+
+	if err := srv.ReloadProfile(reload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if slices.Contains(srv.ActiveProfile().AllowedTools, toolInstanceCreate) {
+		t.Errorf("ActiveProfile().AllowedTools should not contain %v after reload", toolInstanceCreate)
+	}
+}
+
 // TestNewDisabledBuiltinFailsStartup confirms server construction refuses
 // to start when the configured active profile names a disabled built-in.
 // The error must wrap profiles.ErrActiveProfileDisabled so callers can
@@ -20,6 +20,7 @@ func scopelessTools() map[string]bool {
 		"linode_region_list":                        true,
 		"linode_region_availability_get":            true,
 		"linode_region_availability_list":           true,
+		"linode_availability_watch":                 true,
 		"linode_type_get":                           true,
 		"linode_type_list":                          true,
 		"linode_database_engine_get":                true,
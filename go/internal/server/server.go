@@ -5,8 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"log/slog"
+	"slices"
 	"sync"
 	"time"
 
@@ -15,10 +15,14 @@ import (
 
 	"github.com/chadit/LinodeMCP/go/internal/appinfo"
 	"github.com/chadit/LinodeMCP/go/internal/audit"
+	"github.com/chadit/LinodeMCP/go/internal/availwatch"
 	"github.com/chadit/LinodeMCP/go/internal/config"
 	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/nodedrain"
+	"github.com/chadit/LinodeMCP/go/internal/oauth"
 	"github.com/chadit/LinodeMCP/go/internal/profiles"
 	"github.com/chadit/LinodeMCP/go/internal/profiles/builder"
+	"github.com/chadit/LinodeMCP/go/internal/resultstore"
 	"github.com/chadit/LinodeMCP/go/internal/tools"
 	"github.com/chadit/LinodeMCP/go/internal/twostage"
 	"github.com/chadit/LinodeMCP/go/pkg/contracts"
@@ -96,6 +100,25 @@ type Server struct {
 	// apply it after a drift check. Start launches the TTL janitor.
 	planStore *twostage.PlanStore
 
+	// resultStore holds the full results that result_summary replaced with a
+	// summary, for linode_result_get. Like planStore it lives in process
+	// memory and is attached to every call's context.
+	resultStore *resultstore.Store
+
+	// nodeDrains records the NodeBalancer nodes linode_nodebalancer_node_drain
+	// took out of rotation and runs their timed restores. Process memory
+	// only, attached to every call's context like planStore.
+	nodeDrains *nodedrain.Store
+
+	// availabilityWatch remembers the plan statuses linode_availability_watch
+	// last saw, so each call can report what changed. Process memory only.
+	availabilityWatch *availwatch.Store
+
+	// apiErrors keeps the recent Linode API requests that failed in a way an
+	// outage would explain, for linode_api_status. Process memory only; the
+	// client adds to it through the call's context.
+	apiErrors *linode.APIErrorLog
+
 	// metrics wraps each tool dispatch so request totals, durations, and
 	// errors record on the OpenTelemetry meter. Defaults to a no-op so a
 	// Server built without observability (tests, the CLI front-end)
@@ -104,6 +127,17 @@ type Server struct {
 	// recording middleware is built but never reached, so the /metrics
 	// endpoint carries no application series.
 	metrics MetricsRecorder
+
+	// clientFactory builds every Linode API client the server's tools and
+	// its startup scope check use. Defaults to linode.NewClient; tests and
+	// embedding programs swap in another backend via SetClientFactory. The
+	// middleware attaches it to every call's context.
+	clientFactory linode.ClientFactory
+
+	// authorizer validates each call's OAuth access token and checks its
+	// scopes against the tool's capability. Nil unless oauth.enabled is
+	// set, in which case every dispatch must pass it before the handler.
+	authorizer *oauth.Authorizer
 }
 
 // New creates a new LinodeMCP server. Returns an error if config is nil or if
@@ -122,14 +156,23 @@ func New(cfg *config.Config) (*Server, error) {
 	)
 
 	srv := &Server{
-		config:        cfg,
-		mcp:           mcpServer,
-		tools:         make([]contracts.Tool, 0),
-		registered:    make(map[string]*toolWrapper),
-		draftRegistry: builder.NewRegistry(),
-		auditSink:     audit.NoopSink{},
-		planStore:     twostage.NewPlanStore(),
-		metrics:       noopMetricsRecorder{},
+		config:            cfg,
+		mcp:               mcpServer,
+		tools:             make([]contracts.Tool, 0),
+		registered:        make(map[string]*toolWrapper),
+		draftRegistry:     builder.NewRegistry(),
+		auditSink:         audit.NoopSink{},
+		planStore:         twostage.NewPlanStore(),
+		resultStore:       resultstore.NewStore(),
+		nodeDrains:        nodedrain.NewStore(),
+		availabilityWatch: availwatch.NewStore(),
+		apiErrors:         linode.NewAPIErrorLog(0),
+		metrics:           noopMetricsRecorder{},
+		clientFactory:     linode.NewClient,
+	}
+
+	if cfg.OAuth.Enabled {
+		srv.authorizer = oauth.New(&cfg.OAuth, nil)
 	}
 
 	srv.allEntries = collectAllToolEntries(cfg)
@@ -164,7 +207,7 @@ func builderToolEntries(srv *Server) []toolEntry {
 	saveTool, saveCap, saveHandler := tools.NewLinodeProfileDraftSaveTool(srv.draftRegistry, config.Path)
 	canRunTool, canRunCap, canRunHandler := tools.NewLinodeProfileCanRunTool(srv.ToolCatalog, srv.ActiveProfile)
 
-	return []toolEntry{
+	entries := []toolEntry{
 		{tool: listTool, capability: listCap, handler: listHandler},
 		{tool: catTool, capability: catCap, handler: catHandler},
 		{tool: newTool, capability: newCap, handler: newHandler},
@@ -176,11 +219,23 @@ func builderToolEntries(srv *Server) []toolEntry {
 		{tool: saveTool, capability: saveCap, handler: saveHandler},
 		{tool: canRunTool, capability: canRunCap, handler: canRunHandler},
 	}
+
+	// entryFromFactory adds timeout_seconds to every other tool's schema.
+	for i := range entries {
+		tools.AddToolTimeoutSchema(&entries[i].tool)
+	}
+
+	return entries
 }
 
+// toolWrapper is the contracts.Tool view of a registered tool. dispatch is
+// the same middleware-wrapped handler mcp-go calls, so Execute runs a call
+// through argument limits, confirm gates, authorization, and audit exactly
+// as an MCP client's call would.
 type toolWrapper struct {
 	tool       mcp.Tool
 	capability profiles.Capability
+	dispatch   toolHandler
 }
 
 func (tw *toolWrapper) Name() string        { return tw.tool.Name }
@@ -196,13 +251,23 @@ func (tw *toolWrapper) Capability() profiles.Capability { return tw.capability }
 // the input schema. Server-internal accessor.
 func (tw *toolWrapper) RawTool() mcp.Tool { return tw.tool }
 
-func (*toolWrapper) Execute(ctx context.Context, _ map[string]any) (*mcp.CallToolResult, error) {
-	select {
-	case <-ctx.Done():
-		return nil, fmt.Errorf("context canceled: %w", ctx.Err())
-	default:
-		return nil, ErrExecuteNotImplemented
+// Execute calls the tool with params as its arguments. A tool-level failure
+// (bad input, missing confirm, an API error) comes back as a result with
+// IsError set; the error return is for calls the server refused outright.
+func (tw *toolWrapper) Execute(ctx context.Context, params map[string]any) (*mcp.CallToolResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("context canceled: %w", err)
 	}
+
+	if params == nil {
+		params = map[string]any{}
+	}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Name = tw.tool.Name
+	req.Params.Arguments = params
+
+	return tw.dispatch(ctx, req)
 }
 
 // Tools returns the registered tool list. The slice is a snapshot copy so
@@ -218,6 +283,21 @@ func (s *Server) Tools() []contracts.Tool {
 	return out
 }
 
+// CallTool runs the named tool with args through the same dispatch path as an
+// MCP tools/call. Only tools the active profile exposes can be called; any
+// other name returns ErrToolNotFound.
+func (s *Server) CallTool(ctx context.Context, name string, args map[string]any) (*mcp.CallToolResult, error) {
+	s.profileMu.RLock()
+	wrapper, ok := s.registered[name]
+	s.profileMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrToolNotFound, name)
+	}
+
+	return wrapper.Execute(ctx, args)
+}
+
 // ActiveProfile returns the profile the server is currently running under.
 // Reflects the most recent successful ReloadProfile if one has been called;
 // otherwise the profile resolved at construction time. Returned by value so
@@ -314,7 +394,7 @@ func (s *Server) ValidateScopes(ctx context.Context) (*profiles.ScopeValidationR
 		return nil, profiles.ErrTokenNotConfigured
 	}
 
-	client := linode.NewClient(env.Linode.APIURL, env.Linode.Token, cfg)
+	client := s.clientFactory(env.Linode.BaseURL(), env.Linode.Token, cfg, linode.EnvironmentOptions(env.Linode)...)
 
 	result, err := profiles.ValidateScopes(ctx, client, required)
 	if err != nil {
@@ -427,37 +507,6 @@ func (s *Server) Shutdown(ctx context.Context) error {
 	}
 }
 
-// Start starts the LinodeMCP server using stdio transport.
-func (s *Server) Start(ctx context.Context) error {
-	log.Printf("Starting LinodeMCP server with %d tools", len(s.tools))
-
-	for _, tool := range s.tools {
-		log.Printf("Registered tool: %s - %s", tool.Name(), tool.Description())
-	}
-
-	log.Printf("LinodeMCP server started")
-
-	// Reap expired two-stage plans for the life of the serve context.
-	s.planStore.StartJanitor(ctx, time.Minute)
-
-	errCh := make(chan error, 1)
-
-	go func() {
-		errCh <- server.ServeStdio(s.mcp)
-	}()
-
-	select {
-	case <-ctx.Done():
-		return fmt.Errorf("context canceled: %w", ctx.Err())
-	case err := <-errCh:
-		if err != nil {
-			return fmt.Errorf("failed to start server: %w", err)
-		}
-
-		return nil
-	}
-}
-
 type toolFactory func(*config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error))
 
 // ReloadProfile swaps the running server to the profile resolved from cfg.
@@ -608,6 +657,35 @@ func (s *Server) SetMetricsRecorder(recorder MetricsRecorder) {
 	s.metrics = recorder
 }
 
+// SetClientFactory replaces the factory that builds every Linode API client
+// the server uses, for example with linodefake's to serve the API from
+// memory. Call it before serving; passing nil restores linode.NewClient.
+func (s *Server) SetClientFactory(factory linode.ClientFactory) {
+	if factory == nil {
+		factory = linode.NewClient
+	}
+
+	s.clientFactory = factory
+}
+
+// HealthDiagnostics renders the linode_server_health report as JSON for the
+// health server's /healthz route. It reads the same live config and plan
+// store a tool call would see, so the endpoint and the tool agree.
+func (s *Server) HealthDiagnostics(ctx context.Context) (json.RawMessage, error) {
+	s.profileMu.RLock()
+	cfg := s.config
+	s.profileMu.RUnlock()
+
+	ctx = tools.WithPlanStore(ctx, s.planStore)
+
+	data, err := tools.MarshalProtoJSON(tools.ServerHealthProto(ctx, cfg))
+	if err != nil {
+		return nil, fmt.Errorf("render server health: %w", err)
+	}
+
+	return data, nil
+}
+
 // addTool registers a tool with mcp-go and the local list, wrapping the
 // handler so each in-flight invocation is tracked in s.inflight. Shutdown
 // uses that WaitGroup to drain handlers before returning. Takes the tool by
@@ -625,6 +703,7 @@ func (s *Server) SetMetricsRecorder(recorder MetricsRecorder) {
 func (s *Server) addTool(tool *mcp.Tool, capability profiles.Capability, handler toolHandler) {
 	toolName := tool.Name
 	auditCapability := profilesCapabilityToAudit(capability)
+	knownArgs := toolArgumentNames(tool)
 
 	wrapped := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		s.shutdownMu.Lock()
@@ -640,6 +719,12 @@ func (s *Server) addTool(tool *mcp.Tool, capability profiles.Capability, handler
 
 		defer s.inflight.Done()
 
+		if err := s.authorizeCall(ctx, capability, &req); err != nil {
+			s.writeRefusalAuditEvent(ctx, toolName, auditCapability, &req, err)
+
+			return nil, err
+		}
+
 		evt := s.newAuditEvent(toolName, auditCapability, &req)
 		start := time.Now()
 
@@ -651,24 +736,309 @@ func (s *Server) addTool(tool *mcp.Tool, capability profiles.Capability, handler
 		}
 
 		ctx = tools.WithPlanStore(ctx, s.planStore)
+		ctx = tools.WithResultStore(ctx, s.resultStore)
+		ctx = tools.WithNodeDrains(ctx, s.nodeDrains)
+		ctx = tools.WithClientFactory(ctx, s.clientFactory)
+		ctx = tools.WithAvailabilityWatch(ctx, s.availabilityWatch)
 		ctx = linode.WithAPIRecorder(ctx, s.metrics)
+		ctx = linode.WithAPIErrorLog(ctx, s.apiErrors)
+		ctx = linode.WithCorrelationID(ctx, evt.EventID)
+		ctx = tools.WithWarnings(ctx)
+
+		warnIgnoredArguments(ctx, toolName, knownArgs, &req)
+
+		format := tools.ResolveOutputFormat(ctx, s.outputFormatConfig(), &req)
+
+		liveCfg := s.liveConfig()
+		ctx = tools.WithTokenPartition(ctx, liveCfg, &req)
+		namespace, namespaceErr := tools.ResolveLabelNamespace(ctx, liveCfg, toolName, &req)
+		ctx = linode.WithLabelPrefix(ctx, namespace.ListPrefix())
+		readAfterWrite := tools.ResolveReadAfterWrite(ctx, readAfterWriteConfig(liveCfg), capability, &req)
+
+		if readAfterWrite.Enabled() {
+			ctx = linode.WithWriteLog(ctx)
+		}
+
+		offlineCache := tools.ResolveOfflineCache(liveCfg, capability, &req)
+		if offlineCache.Enabled() {
+			ctx = linode.WithReachability(ctx)
+		}
+
+		maxResults := tools.ResolveMaxResults(ctx, toolName, &req)
+		ctx = linode.WithMaxResults(ctx, maxResults)
+
+		listOptions, listOptionsErr := tools.ResolveListOptions(toolName, &req)
+		ctx = linode.WithListOptions(ctx, listOptions)
+
+		var (
+			result *mcp.CallToolResult
+			err    error
+		)
+
+		// Malformed arguments never reach the handler, so a runaway string or
+		// a control character in a label is reported by name rather than as
+		// whatever the API makes of it.
+		if limitErr := tools.ValidateArgumentLimits(req.GetArguments()); limitErr != nil {
+			result = mcp.NewToolResultError(limitErr.Error())
+		} else if listOptionsErr != nil {
+			result = mcp.NewToolResultError(listOptionsErr.Error())
+		} else if namespaceErr != nil {
+			result = mcp.NewToolResultError(namespaceErr.Error())
+		} else {
+			costDelta, costPriced := writeCostDelta(ctx, liveCfg, toolName, mode, &req)
+
+			timeout := tools.ResolveToolTimeout(ctx, toolTimeoutConfig(liveCfg), toolName, &req)
+			handlerCtx, cancel := tools.WithToolTimeout(ctx, timeout)
+			result, err = handler(handlerCtx, req)
+			result, err = tools.ApplyToolTimeout(handlerCtx, toolName, timeout, result, err)
+
+			cancel()
+
+			if costPriced && err == nil && result != nil && !result.IsError {
+				evt.SetMonthlyCostDelta(costDelta)
+			}
+		}
+
+		if err == nil {
+			tools.AwaitReadAfterWrite(ctx, &req, liveCfg, readAfterWrite, result)
+		}
+
+		result, err = tools.ApplyOfflineCache(ctx, offlineCache, toolName, &req, result, err)
+
+		tools.WarnListTruncated(ctx, maxResults)
+
+		tools.ApplyLabelNamespace(result, namespace)
 
-		result, err := handler(ctx, req)
+		tools.AppendErrorHint(result)
+		tools.ApplyOutputFormat(result, toolName, format)
+		tools.ApplyResultSummary(ctx, tools.ResolveResultSummary(resultSummaryConfig(liveCfg), capability, s.sampler(ctx)), toolName, result)
+		tools.ApplyStructuredContent(ctx, structuredContentConfig(liveCfg), toolName, result)
+		tools.FinalizeEnvelope(ctx, result, &req, start)
 
 		s.metrics.RecordToolCall(ctx, toolName, time.Since(start), err)
 		finalizeAuditEvent(&evt, start, err)
 		s.auditSink.Write(context.WithoutCancel(ctx), &evt)
 
+		slog.DebugContext(ctx, "tool call finished", "tool", toolName, "status", string(evt.Status),
+			"correlation_id", evt.EventID, "elapsed_ms", evt.LatencyMS)
+
 		return result, err
 	}
 
 	s.mcp.AddTool(*tool, wrapped)
 
-	wrapper := &toolWrapper{tool: *tool, capability: capability}
+	wrapper := &toolWrapper{tool: *tool, capability: capability, dispatch: wrapped}
 	s.tools = append(s.tools, wrapper)
 	s.registered[tool.Name] = wrapper
 }
 
+// outputFormatConfig returns the live config's output_format defaults, read
+// under profileMu because a config reload may swap s.config.
+func (s *Server) outputFormatConfig() config.OutputFormatConfig {
+	s.profileMu.RLock()
+	defer s.profileMu.RUnlock()
+
+	if s.config == nil {
+		return config.OutputFormatConfig{}
+	}
+
+	return s.config.OutputFormat
+}
+
+// liveConfig returns the current config, read under profileMu because a
+// config reload may swap s.config.
+func (s *Server) liveConfig() *config.Config {
+	s.profileMu.RLock()
+	defer s.profileMu.RUnlock()
+
+	return s.config
+}
+
+// readAfterWriteConfig returns cfg's read_after_write block, or the zero
+// (disabled) block when the server runs without a config.
+func readAfterWriteConfig(cfg *config.Config) config.ReadAfterWriteConfig {
+	if cfg == nil {
+		return config.ReadAfterWriteConfig{}
+	}
+
+	return cfg.ReadAfterWrite
+}
+
+// resultSummaryConfig returns cfg's result_summary block, or the zero
+// (disabled) block when cfg is nil.
+func resultSummaryConfig(cfg *config.Config) config.ResultSummaryConfig {
+	if cfg == nil {
+		return config.ResultSummaryConfig{}
+	}
+
+	return cfg.ResultSummary
+}
+
+// structuredContentConfig returns cfg's structured_content block, or the zero
+// block (structured content on, no embedded resource) when cfg is nil.
+func structuredContentConfig(cfg *config.Config) config.StructuredContentConfig {
+	if cfg == nil {
+		return config.StructuredContentConfig{}
+	}
+
+	return cfg.StructuredContent
+}
+
+// toolTimeoutConfig returns cfg's tool_timeouts block, or the zero block (no
+// timeouts) when cfg is nil.
+func toolTimeoutConfig(cfg *config.Config) config.ToolTimeoutConfig {
+	if cfg == nil {
+		return config.ToolTimeoutConfig{}
+	}
+
+	return cfg.ToolTimeouts
+}
+
+// sampler returns a tools.Sampler that sends MCP sampling requests to the
+// calling client, or nil when the client did not declare the sampling
+// capability.
+func (s *Server) sampler(ctx context.Context) tools.Sampler {
+	session, ok := server.ClientSessionFromContext(ctx).(server.SessionWithClientInfo)
+	if !ok || session.GetClientCapabilities().Sampling == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, systemPrompt, text string, maxTokens int) (string, error) {
+		request := mcp.CreateMessageRequest{CreateMessageParams: mcp.CreateMessageParams{
+			Messages:     []mcp.SamplingMessage{{Role: mcp.RoleUser, Content: mcp.NewTextContent(text)}},
+			SystemPrompt: systemPrompt,
+			MaxTokens:    maxTokens,
+		}}
+
+		result, err := s.mcp.RequestSampling(ctx, request)
+		if err != nil {
+			return "", fmt.Errorf("sampling request: %w", err)
+		}
+
+		return samplingText(result.Content)
+	}
+}
+
+// samplingText returns the text of a sampling result's content. Transports
+// hand the content back either parsed or as the raw JSON object.
+func samplingText(content any) (string, error) {
+	if raw, ok := content.(map[string]any); ok {
+		parsed, err := mcp.ParseContent(raw)
+		if err != nil {
+			return "", fmt.Errorf("sampling result: %w", err)
+		}
+
+		content = parsed
+	}
+
+	switch text := content.(type) {
+	case mcp.TextContent:
+		return text.Text, nil
+	case *mcp.TextContent:
+		return text.Text, nil
+	default:
+		return "", errSamplingNotText
+	}
+}
+
+// authorizeCall enforces OAuth when it is enabled: the call's access token
+// must be valid and carry the scope for capability. With token exchange on,
+// the access token is traded for a Linode token that replaces any
+// X-Linode-Token the client sent, so the passthrough path uses it.
+func (s *Server) authorizeCall(ctx context.Context, capability profiles.Capability, req *mcp.CallToolRequest) error {
+	if s.authorizer == nil {
+		return nil
+	}
+
+	if _, err := s.authorizer.Authorize(ctx, req.Header, capability); err != nil {
+		return err
+	}
+
+	if !s.authorizer.ExchangeEnabled() {
+		return nil
+	}
+
+	accessToken, _ := oauth.BearerToken(req.Header)
+
+	linodeToken, err := s.authorizer.Exchange(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	req.Header = req.Header.Clone()
+	req.Header.Set(tools.TokenHeader, linodeToken)
+
+	return nil
+}
+
+// toolArgumentNames returns the argument names a tool's input schema
+// declares, read from the synthesized schema or the generated raw one. It
+// returns nil when the schema cannot be read, which disables the
+// ignored-argument warning for that tool rather than flagging every argument.
+func toolArgumentNames(tool *mcp.Tool) map[string]struct{} {
+	properties := tool.InputSchema.Properties
+
+	if len(tool.RawInputSchema) > 0 {
+		var raw struct {
+			Properties map[string]any `json:"properties"`
+		}
+
+		if err := json.Unmarshal(tool.RawInputSchema, &raw); err != nil {
+			return nil
+		}
+
+		properties = raw.Properties
+	}
+
+	names := make(map[string]struct{}, len(properties))
+	for name := range properties {
+		names[name] = struct{}{}
+	}
+
+	return names
+}
+
+// gateArguments are read by the dispatch middleware and the destroy gate for
+// every tool, so they never appear in a tool's own input schema and must not
+// be reported as ignored.
+var gateArguments = map[string]struct{}{
+	"yolo":                    {},
+	"confirmed_dry_run":       {},
+	"confirm_bypass_dry_run":  {},
+	tools.ParamMaxResults:     {},
+	tools.ParamNamespaceOnly:  {},
+	tools.ParamOutputFormat:   {},
+	tools.ParamReadAfterWrite: {},
+}
+
+// warnIgnoredArguments records an envelope warning for each argument the tool's
+// schema does not declare. Handlers read only the arguments they know, so an
+// unknown one (a misspelled filter, a field from another tool) would otherwise
+// be dropped without a trace.
+func warnIgnoredArguments(ctx context.Context, toolName string, known map[string]struct{}, req *mcp.CallToolRequest) {
+	if known == nil {
+		return
+	}
+
+	args := req.GetArguments()
+	names := make([]string, 0, len(args))
+
+	for name := range args {
+		_, declared := known[name]
+		_, gate := gateArguments[name]
+
+		if !declared && !gate {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		tools.AddWarning(ctx, "argument %q is not accepted by %s and was ignored", name, toolName)
+	}
+}
+
 // newAuditEvent constructs an audit event for a reaching handler.
 // Reads the active profile name under the profile read-lock so a
 // concurrent hot-reload doesn't observe a torn pointer. Request is
@@ -754,6 +1124,18 @@ func (s *Server) writeRefusalAuditEvent(
 	s.auditSink.Write(context.WithoutCancel(ctx), &evt)
 }
 
+// writeCostDelta prices a call for its audit event when
+// audit.cost_attribution is on. It runs before the handler so a delete
+// or resize is priced from the resource as it was; a dry run or plan
+// changes nothing and is never priced.
+func writeCostDelta(ctx context.Context, cfg *config.Config, toolName string, mode audit.Mode, req *mcp.CallToolRequest) (float64, bool) {
+	if cfg == nil || !cfg.Audit.CostAttribution || mode == audit.ModeDryRun || mode == audit.ModePlan {
+		return 0, false
+	}
+
+	return tools.WriteCostDelta(ctx, cfg, toolName, req)
+}
+
 // finalizeAuditEvent sets status/latency/error based on handler
 // outcome. Success when err is nil; Error otherwise. Result-summary
 // generation lands in Phase 2; for Phase 1b it stays empty.
@@ -797,6 +1179,8 @@ func profilesCapabilityToAudit(capability profiles.Capability) audit.Capability
 // per-category collector can stay free of mcp.AddTool side effects.
 func entryFromFactory(cfg *config.Config, factory toolFactory) toolEntry {
 	tool, capability, handler := factory(cfg)
+	tools.AddListOptionsSchema(&tool)
+	tools.AddToolTimeoutSchema(&tool)
 
 	return toolEntry{tool: tool, capability: capability, handler: handler}
 }
@@ -840,10 +1224,21 @@ func (s *Server) registerTools() error {
 
 	for i := range s.allEntries {
 		entry := &s.allEntries[i]
+		if !s.config.Tools.Allows(entry.tool.Name) {
+			slog.Info(
+				"tools config filtered out tool at registration",
+				"tool", entry.tool.Name,
+				"capability", entry.capability.String(),
+			)
+
+			continue
+		}
+
 		if _, ok := allowed[entry.tool.Name]; !ok {
 			slog.Info(
 				"profile filtered out tool at registration",
 				"profile", profile.Name,
+				"read_only", s.config.ReadOnly,
 				"tool", entry.tool.Name,
 				"capability", entry.capability.String(),
 			)
@@ -876,9 +1271,55 @@ func (s *Server) resolveProfileLocked(cfg *config.Config) (profiles.Profile, err
 		return profiles.Profile{}, fmt.Errorf("resolve active profile: %w", err)
 	}
 
+	if cfg.ReadOnly {
+		profile.AllowedTools = readOnlyAllowedTools(profile.AllowedTools, registry)
+		profile.Elevated = false
+	}
+
+	if cfg.Tools.Filters() {
+		profile.AllowedTools = toolsConfigAllowedTools(profile.AllowedTools, &cfg.Tools)
+	}
+
 	return profile, nil
 }
 
+// toolsConfigAllowedTools narrows a profile's allowed tools to those the
+// tools config keeps, so the filter, like read_only, holds across
+// ReloadProfile and shows in linode_profile_can_run.
+func toolsConfigAllowedTools(allowed []string, tools *config.ToolsConfig) []string {
+	kept := make([]string, 0, len(allowed))
+
+	for _, name := range allowed {
+		if tools.Allows(name) {
+			kept = append(kept, name)
+		}
+	}
+
+	return kept
+}
+
+// readOnlyAllowedTools narrows a profile's allowed tools for read_only mode:
+// only CapRead and CapMeta tools survive, so a write, destroy, or admin tool
+// is never registered and the model cannot even see it, whatever the profile
+// grants. Applied on resolution, it also holds across ReloadProfile, and the
+// clamped profile is no longer elevated for the missing-token policy.
+func readOnlyAllowedTools(allowed []string, registry []profiles.ToolDescriptor) []string {
+	capabilities := make(map[string]profiles.Capability, len(registry))
+	for _, descriptor := range registry {
+		capabilities[descriptor.Name] = descriptor.Capability
+	}
+
+	kept := make([]string, 0, len(allowed))
+
+	for _, name := range allowed {
+		if capability := capabilities[name]; capability == profiles.CapRead || capability == profiles.CapMeta {
+			kept = append(kept, name)
+		}
+	}
+
+	return kept
+}
+
 // ToolDescriptors returns the flat list of (name, capability) pairs for
 // every tool the package can register against the given config. Lets
 // the CLI subcommands (profile list/show) enumerate the catalog without
@@ -936,6 +1377,11 @@ func coreToolEntries(cfg *config.Config) []toolEntry {
 	return entriesFromFactories(cfg, []toolFactory{
 		tools.NewHelloTool,
 		tools.NewVersionTool,
+		tools.NewLinodeServerHealthTool,
+		tools.NewLinodeConfigDumpTool,
+		tools.NewLinodeResultGetTool,
+		tools.NewLinodeVersionCheckTool,
+		tools.NewLinodeAPIStatusTool,
 		tools.NewLinodeProfileTool,
 		tools.NewLinodeProfilePreferencesTool,
 		tools.NewLinodeProfilePreferencesUpdateTool,
@@ -948,6 +1394,12 @@ func coreToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeProfileLoginsTool,
 		tools.NewLinodeAccountTool,
 		tools.NewLinodeAccountTransferTool,
+		tools.NewLinodeQuotaReportTool,
+		tools.NewLinodeTransferForecastTool,
+		tools.NewLinodeSecurityPostureTool,
+		tools.NewLinodeTimelineTool,
+		tools.NewLinodeProjectsListTool,
+		tools.NewLinodeProjectsGetTool,
 		tools.NewLinodeAccountSettingsTool,
 		tools.NewLinodeAccountSettingsUpdateTool,
 		tools.NewLinodeAccountSettingsManagedEnableTool,
@@ -1078,6 +1530,9 @@ func coreToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeAccountAvailabilityTool,
 		tools.NewLinodeAccountAvailabilityGetTool,
 		tools.NewLinodeTagCreateTool,
+		tools.NewLinodeTagsRetagTool,
+		tools.NewLinodeTagsCleanupTool,
+		tools.NewLinodeBulkDeleteTool,
 		tools.NewLinodeAccountAgreementsAcknowledgeTool,
 		tools.NewLinodeAccountCancelTool,
 		tools.NewLinodeAccountUpdateTool,
@@ -1086,6 +1541,8 @@ func coreToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeAuditHealthTool,
 		tools.NewLinodeAuditExportTool,
 		tools.NewLinodeAuditReportTool,
+		tools.NewLinodeAnnotationsSetTool,
+		tools.NewLinodeAnnotationsGetTool,
 	})
 }
 
@@ -1095,6 +1552,7 @@ func computeToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeInstanceGetTool,
 		tools.NewLinodeInstanceStatsByYearMonthTool,
 		tools.NewLinodeInstanceTransferGetTool,
+		tools.NewLinodeAlertsAuditTool,
 		tools.NewLinodePlacementGroupAssignTool,
 		tools.NewLinodePlacementGroupGetTool,
 		tools.NewLinodePlacementGroupDeleteTool,
@@ -1102,6 +1560,7 @@ func computeToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeRegionGetTool,
 		tools.NewLinodeRegionAvailabilityListTool,
 		tools.NewLinodeRegionAvailabilityGetTool,
+		tools.NewLinodeAvailabilityWatchTool,
 		tools.NewLinodePlacementGroupListTool,
 		tools.NewLinodePlacementGroupUpdateTool,
 		tools.NewLinodeKernelListTool,
@@ -1142,9 +1601,13 @@ func computeToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeImageShareGroupTokenUpdateTool,
 		tools.NewLinodeImageShareGroupByTokenGetTool,
 		tools.NewLinodeImageCreateTool,
+		tools.NewLinodeInstanceToImageTool,
 		tools.NewLinodeSSHKeyListTool,
 		tools.NewLinodeSSHKeyGetTool,
+		tools.NewLinodeSSHKeyUsageReportTool,
+		tools.NewLinodeStaleResourcesTool,
 		tools.NewLinodeStackScriptGetTool,
+		tools.NewLinodeStackScriptUDFsGetTool,
 		tools.NewLinodeStackScriptListTool,
 		tools.NewLinodeStackScriptCreateTool,
 		tools.NewLinodeStackScriptDeleteTool,
@@ -1156,7 +1619,14 @@ func computeToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeInstanceRebootTool,
 		tools.NewLinodeInstanceShutdownTool,
 		tools.NewLinodeInstanceCreateTool,
+		tools.NewLinodeMultiRegionDeployTool,
+		tools.NewLinodeInstanceReplaceTool,
+		tools.NewLinodeInstanceConsoleTool,
+		tools.NewLinodeInstanceCloudInitStatusTool,
+		tools.NewLinodeInstanceNeighborsTool,
+		tools.NewLinodeInstanceSSHFingerprintsTool,
 		tools.NewLinodeInstanceUpdateTool,
+		tools.NewLinodeInstanceAlertsUpdateTool,
 		tools.NewLinodeInstanceDeleteTool,
 		tools.NewLinodeInstanceResizeTool,
 	})
@@ -1179,7 +1649,16 @@ func networkingToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeFirewallSettingsListTool,
 		tools.NewLinodeFirewallTemplatesListTool,
 		tools.NewLinodeFirewallTemplateGetTool,
+		tools.NewLinodeFirewallDiffTool,
+		tools.NewLinodeFirewallSimulateTool,
+		tools.NewLinodeFirewallExportTool,
+		tools.NewLinodeFirewallImportTool,
 		tools.NewLinodeFirewallSettingsUpdateTool,
+		tools.NewLinodeFirewallTempAllowTool,
+		tools.NewLinodeFirewallExpireRulesTool,
+		tools.NewLinodeFirewallAllowInstanceTool,
+		tools.NewLinodeBackupScheduleUpdateTool,
+		tools.NewLinodeBackupScheduleAuditTool,
 		tools.NewLinodeNetworkTransferPricesTool,
 		tools.NewLinodeNetworkingIPListTool,
 		tools.NewLinodeNetworkingIPGetTool,
@@ -1206,6 +1685,7 @@ func networkingToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeNodeBalancerVPCListTool,
 		tools.NewLinodeNodeBalancerConfigListTool,
 		tools.NewLinodeNodeBalancerConfigNodesListTool,
+		tools.NewLinodeNodeBalancerBackendProbeTool,
 		tools.NewLinodeNodeBalancerConfigGetTool,
 
 		tools.NewLinodeNodeBalancerConfigNodeGetTool,
@@ -1216,6 +1696,8 @@ func networkingToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeNodeBalancerConfigRebuildTool,
 		tools.NewLinodeNodeBalancerConfigDeleteTool,
 		tools.NewLinodeNodeBalancerNodeUpdateTool,
+		tools.NewLinodeNodeBalancerNodeDrainTool,
+		tools.NewLinodeNodeBalancerNodeUndrainTool,
 		tools.NewLinodeFirewallCreateTool,
 		tools.NewLinodeFirewallUpdateTool,
 		tools.NewLinodeFirewallDeleteTool,
@@ -1230,8 +1712,10 @@ func dnsToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeDomainListTool,
 		tools.NewLinodeDomainGetTool,
 		tools.NewLinodeDomainZoneFileGetTool,
+		tools.NewLinodeDomainDelegationCheckTool,
 		tools.NewLinodeDomainRecordListTool,
 		tools.NewLinodeDomainRecordGetTool,
+		tools.NewLinodeDomainRecordsExportBindTool,
 		tools.NewLinodeDomainImportTool,
 		tools.NewLinodeDomainCreateTool,
 		tools.NewLinodeDomainCloneTool,
@@ -1240,6 +1724,10 @@ func dnsToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeDomainRecordCreateTool,
 		tools.NewLinodeDomainRecordUpdateTool,
 		tools.NewLinodeDomainRecordDeleteTool,
+		tools.NewLinodeDomainRecordsImportBindTool,
+		tools.NewLinodeDomainTTLSetTool,
+		tools.NewLinodeDNSCutoverTool,
+		tools.NewLinodeDNSPointAtInstanceTool,
 	})
 }
 
@@ -1279,9 +1767,11 @@ func objectStorageToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeObjectStorageBucketAccessAllowTool,
 		tools.NewLinodeObjectStorageBucketAccessUpdateTool,
 		tools.NewLinodeObjectStorageKeyCreateTool,
+		tools.NewLinodeObjectStorageCICDCredentialsTool,
 		tools.NewLinodeObjectStorageKeyUpdateTool,
 		tools.NewLinodeObjectStorageKeyDeleteTool,
 		tools.NewLinodeObjectStoragePresignedURLTool,
+		tools.NewLinodeObjectStorageBucketManifestExportTool,
 		tools.NewLinodeObjectStorageObjectACLGetTool,
 		tools.NewLinodeObjectStorageObjectACLUpdateTool,
 		tools.NewLinodeObjectStorageSSLGetTool,
@@ -1321,6 +1811,12 @@ func databaseToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeDatabasePostgreSQLInstanceSuspendTool,
 		tools.NewLinodeDatabaseInstanceResumeTool,
 		tools.NewLinodeDatabasePostgreSQLInstanceResumeTool,
+		tools.NewLinodeDatabaseMySQLBackupListTool,
+		tools.NewLinodeDatabasePostgreSQLBackupListTool,
+		tools.NewLinodeDatabaseMySQLBackupCreateTool,
+		tools.NewLinodeDatabasePostgreSQLBackupCreateTool,
+		tools.NewLinodeDatabaseMySQLInstanceRestoreTool,
+		tools.NewLinodeDatabasePostgreSQLInstanceRestoreTool,
 	})
 }
 
@@ -1462,7 +1958,9 @@ func lkeToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeLKEPoolListTool,
 		tools.NewLinodeLKEPoolGetTool,
 		tools.NewLinodeLKENodeGetTool,
+		tools.NewLinodeLKENodeInstancesTool,
 		tools.NewLinodeLKEKubeconfigGetTool,
+		tools.NewLinodeLKEWorkloadPingTool,
 		tools.NewLinodeLKEDashboardGetTool,
 		tools.NewLinodeLKEAPIEndpointListTool,
 		tools.NewLinodeLKEACLGetTool,
@@ -1477,6 +1975,7 @@ func lkeToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeLKEClusterDeleteTool,
 		tools.NewLinodeLKEClusterRecycleTool,
 		tools.NewLinodeLKEClusterRegenerateTool,
+		tools.NewLinodeLKEUpgradePlanTool,
 		tools.NewLinodeLKEPoolCreateTool,
 		tools.NewLinodeLKEPoolUpdateTool,
 		tools.NewLinodeLKEPoolDeleteTool,
@@ -1487,5 +1986,6 @@ func lkeToolEntries(cfg *config.Config) []toolEntry {
 		tools.NewLinodeLKEServiceTokenDeleteTool,
 		tools.NewLinodeLKEACLUpdateTool,
 		tools.NewLinodeLKEACLDeleteTool,
+		tools.NewLinodeAccessAllowlistUpdateTool,
 	})
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 
+	"github.com/chadit/LinodeMCP/go/internal/audit"
 	"github.com/chadit/LinodeMCP/go/internal/config"
 	"github.com/chadit/LinodeMCP/go/internal/profiles"
 	"github.com/chadit/LinodeMCP/go/internal/server"
@@ -466,38 +467,48 @@ func TestDeprecatedAccountEntityTransfersListToolRemoved(t *testing.T) {
 	}
 }
 
-// TestToolWrapperExecuteReturnsError verifies that calling Execute on a
-// toolWrapper returns ErrExecuteNotImplemented since handlers are dispatched
-// through the MCP server, not through the wrapper directly.
-func TestToolWrapperExecuteReturnsError(t *testing.T) {
+// TestCallToolRunsDispatchPath verifies that CallTool, the entry point the
+// public embedding package uses, runs a call through the same middleware as
+// an MCP tools/call: the handler runs and the audit sink records the call.
+func TestCallToolRunsDispatchPath(t *testing.T) {
 	t.Parallel()
 
-	cfg := &config.Config{
-		Server: config.ServerConfig{Name: serverNameTest, LogLevel: logLevelInfo},
-		Environments: map[string]config.EnvironmentConfig{
-			envKeyDefault: {
-				Label:  envLabelDefault,
-				Linode: config.LinodeConfig{APIURL: apiURLLinodeV4, Token: tokenShort},
-			},
-		},
+	srv, err := server.New(fullAccessConfig())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
 
-	srv, err := server.New(cfg)
+	sink := audit.NewCapturingSink()
+	srv.SetAuditSink(sink)
+
+	result, err := srv.CallTool(t.Context(), "linode_vpc_create", map[string]any{"label": "net", "region": "us-east"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	if len(srv.Tools()) == 0 {
-		t.Fatal("srv.Tools() is empty")
+	if !result.IsError {
+		t.Fatal("result.IsError = false, want true for a create without confirm")
 	}
 
-	result, execErr := srv.Tools()[0].Execute(t.Context(), nil)
+	if findEventByTool(sink.Events(), "linode_vpc_create") == nil {
+		t.Error("no audit event recorded for linode_vpc_create")
+	}
+}
+
+// TestCallToolUnknownTool verifies a name outside the active profile is
+// refused rather than dispatched.
+func TestCallToolUnknownTool(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+
+	result, err := srv.CallTool(t.Context(), "linode_no_such_tool", nil)
 	if result != nil {
 		t.Errorf("result = %v, want nil", result)
 	}
 
-	if !errors.Is(execErr, server.ErrExecuteNotImplemented) {
-		t.Errorf("error = %v, want %v", execErr, server.ErrExecuteNotImplemented)
+	if !errors.Is(err, server.ErrToolNotFound) {
+		t.Errorf("error = %v, want %v", err, server.ErrToolNotFound)
 	}
 }
 
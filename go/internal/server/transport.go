@@ -0,0 +1,232 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/oauth"
+)
+
+const (
+	// streamableHTTPPath is where the http transport serves MCP.
+	streamableHTTPPath = "/mcp"
+
+	// httpReadHeaderTimeout bounds how long a client may take to send request
+	// headers, so idle connections cannot pin the listener.
+	httpReadHeaderTimeout = 10 * time.Second
+
+	// httpShutdownTimeout bounds how long in-flight requests get to finish
+	// once the serve context is canceled.
+	httpShutdownTimeout = 10 * time.Second
+)
+
+// Serve runs the server on transport until ctx is canceled or the transport
+// fails. addr is the listen address for the sse and http transports and is
+// ignored for stdio.
+func (s *Server) Serve(ctx context.Context, transport, addr string) error {
+	if err := config.ValidateTransport(transport); err != nil {
+		return err
+	}
+
+	log.Printf("Starting LinodeMCP server with %d tools", len(s.tools))
+
+	for _, tool := range s.tools {
+		log.Printf("Registered tool: %s - %s", tool.Name(), tool.Description())
+	}
+
+	log.Printf("LinodeMCP server started")
+
+	// Reap expired two-stage plans for the life of the serve context.
+	s.planStore.StartJanitor(ctx, time.Minute)
+
+	if transport == config.TransportStdio {
+		return s.serveStdio(ctx)
+	}
+
+	return s.serveHTTP(ctx, transport, addr)
+}
+
+func (s *Server) serveStdio(ctx context.Context) error {
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- server.ServeStdio(s.mcp)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("context canceled: %w", ctx.Err())
+	case err := <-errCh:
+		if err != nil {
+			return fmt.Errorf("failed to start server: %w", err)
+		}
+
+		return nil
+	}
+}
+
+// HTTPHandler returns the handler remote clients reach for the sse or http
+// transport: MCP behind the server.authToken check or, when oauth is
+// enabled, the access token check, plus the OAuth protected resource
+// metadata document.
+func (s *Server) HTTPHandler(transport string) (http.Handler, error) {
+	mux := http.NewServeMux()
+
+	switch transport {
+	case config.TransportSSE:
+		mux.Handle("/", s.requireAuth(server.NewSSEServer(s.mcp)))
+	case config.TransportHTTP:
+		mux.Handle(streamableHTTPPath, s.requireAuth(server.NewStreamableHTTPServer(s.mcp)))
+	default:
+		return nil, fmt.Errorf("%w: %q has no HTTP handler", config.ErrInvalidTransport, transport)
+	}
+
+	// The metadata document tells clients where to get a token, so it is
+	// served without one.
+	if s.authorizer != nil {
+		mux.Handle(oauth.MetadataPath, s.authorizer.MetadataHandler())
+	}
+
+	return mux, nil
+}
+
+// serveHTTP listens on addr and serves transport until ctx is canceled, then
+// gives in-flight requests httpShutdownTimeout to finish.
+func (s *Server) serveHTTP(ctx context.Context, transport, addr string) error {
+	handler, err := s.HTTPHandler(transport)
+	if err != nil {
+		return err
+	}
+
+	listenConfig := net.ListenConfig{}
+
+	listener, err := listenConfig.Listen(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	httpServer := &http.Server{
+		ReadHeaderTimeout: httpReadHeaderTimeout,
+	}
+	httpServer.Handler = closeStreamsOnShutdown(httpServer, handler)
+
+	log.Printf("Serving MCP over %s on %s", transport, listener.Addr())
+
+	if s.config.Server.AuthToken == "" && s.authorizer == nil {
+		log.Printf("WARNING: neither server.authToken nor oauth is set, so any client that reaches %s can call tools", listener.Addr())
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- httpServer.Serve(listener)
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("failed to serve %s: %w", transport, err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), httpShutdownTimeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down %s transport: %w", transport, err)
+	}
+
+	if err := <-errCh; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve %s: %w", transport, err)
+	}
+
+	return nil
+}
+
+// closeStreamsOnShutdown ends the long-lived GET event streams of both
+// transports when httpServer shuts down. Shutdown waits for active requests,
+// and a stream never finishes on its own; tool calls arrive as POSTs and are
+// left to complete.
+func closeStreamsOnShutdown(httpServer *http.Server, next http.Handler) http.Handler {
+	streams, closeStreams := context.WithCancel(context.Background())
+	httpServer.RegisterOnShutdown(closeStreams)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		stop := context.AfterFunc(streams, cancel)
+		defer stop()
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// requireAuth puts next behind whichever check the config enables:
+// server.authToken or oauth (config validation refuses both at once).
+func (s *Server) requireAuth(next http.Handler) http.Handler {
+	return requireBearerToken(s.config.Server.AuthToken, requireAccessToken(s.authorizer, next))
+}
+
+// requireAccessToken refuses requests whose bearer access token authorizer
+// does not accept, with a 401 whose challenge points the client at the
+// protected resource metadata (RFC 9728), so it knows where to get a token.
+// Scopes are checked per tool call. A nil authorizer disables the check.
+func requireAccessToken(authorizer *oauth.Authorizer, next http.Handler) http.Handler {
+	if authorizer == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := authorizer.Authenticate(r.Context(), r.Header)
+
+		switch {
+		case err == nil:
+			next.ServeHTTP(w, r)
+		case errors.Is(err, oauth.ErrMissingBearer):
+			w.Header().Set("WWW-Authenticate", authorizer.Challenge())
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		case errors.Is(err, oauth.ErrTokenInactive), errors.Is(err, oauth.ErrWrongIssuer), errors.Is(err, oauth.ErrWrongAudience):
+			w.Header().Set("WWW-Authenticate", authorizer.Challenge()+`, error="invalid_token"`)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		default:
+			// The authorization server could not be asked, which says
+			// nothing about the token.
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		}
+	})
+}
+
+// requireBearerToken refuses requests that do not carry token as an
+// "Authorization: Bearer" header. An empty token disables the check.
+func requireBearerToken(token string, next http.Handler) http.Handler {
+	if token == "" {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, ok := oauth.BearerToken(r.Header)
+		if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="linodemcp"`)
+			http.Error(w, "missing or invalid bearer token", http.StatusUnauthorized)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,165 @@
+package server_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/server"
+)
+
+const initializeMessage = `{
+	"jsonrpc": "2.0",
+	"id": 1,
+	"method": "initialize",
+	"params": {
+		"protocolVersion": "2025-03-26",
+		"capabilities": {},
+		"clientInfo": {"name": "test", "version": "1.0"}
+	}
+}`
+
+func TestHTTPHandlerRequiresAuthToken(t *testing.T) {
+	t.Parallel()
+
+	cfg := baseTestConfig()
+	cfg.Server.Transport = config.TransportHTTP
+	cfg.Server.AuthToken = "s3cret"
+
+	srv, err := server.New(cfg)
+	if err != nil {
+		t.Fatalf("server.New: %v", err)
+	}
+
+	handler, err := srv.HTTPHandler(config.TransportHTTP)
+	if err != nil {
+		t.Fatalf("HTTPHandler: %v", err)
+	}
+
+	cases := []struct {
+		name          string
+		authorization string
+		want          int
+	}{
+		{name: "missing", authorization: "", want: http.StatusUnauthorized},
+		{name: "wrong token", authorization: "Bearer nope", want: http.StatusUnauthorized},
+		{name: "wrong scheme", authorization: "Basic s3cret", want: http.StatusUnauthorized},
+		{name: "valid", authorization: "Bearer s3cret", want: http.StatusOK},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/mcp", strings.NewReader(initializeMessage))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Accept", "application/json, text/event-stream")
+
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tc.want {
+				t.Fatalf("status = %d, want %d (body %q)", rec.Code, tc.want, rec.Body.String())
+			}
+
+			if tc.want == http.StatusUnauthorized && rec.Header().Get("WWW-Authenticate") == "" {
+				t.Error("401 response has no WWW-Authenticate challenge")
+			}
+		})
+	}
+}
+
+func TestHTTPHandlerRejectsStdio(t *testing.T) {
+	t.Parallel()
+
+	srv, err := server.New(baseTestConfig())
+	if err != nil {
+		t.Fatalf("server.New: %v", err)
+	}
+
+	if _, err := srv.HTTPHandler(config.TransportStdio); err == nil {
+		t.Fatal("HTTPHandler(stdio) returned no error")
+	}
+}
+
+func TestHTTPHandlerChallengesForAccessToken(t *testing.T) {
+	t.Parallel()
+
+	introspection := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parse form: %v", err)
+		}
+
+		if r.PostForm.Get("token") != "good-token" {
+			_, _ = w.Write([]byte(`{"active":false}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"active":true,"scope":"linode:read","aud":"https://mcp.example.test"}`))
+	}))
+	t.Cleanup(introspection.Close)
+
+	cfg := baseTestConfig()
+	cfg.Server.Transport = config.TransportHTTP
+	cfg.OAuth.Enabled = true
+	cfg.OAuth.Issuer = "https://auth.example.test"
+	cfg.OAuth.Resource = "https://mcp.example.test"
+	cfg.OAuth.IntrospectionURL = introspection.URL
+	cfg.OAuth.ReadScope = "linode:read"
+	cfg.OAuth.WriteScope = "linode:write"
+
+	srv, err := server.New(cfg)
+	if err != nil {
+		t.Fatalf("server.New: %v", err)
+	}
+
+	handler, err := srv.HTTPHandler(config.TransportHTTP)
+	if err != nil {
+		t.Fatalf("HTTPHandler: %v", err)
+	}
+
+	const challenge = `Bearer resource_metadata="https://mcp.example.test/.well-known/oauth-protected-resource"`
+
+	cases := []struct {
+		name          string
+		authorization string
+		want          int
+		wantChallenge string
+	}{
+		{name: "missing", want: http.StatusUnauthorized, wantChallenge: challenge},
+		{name: "inactive", authorization: "Bearer revoked", want: http.StatusUnauthorized, wantChallenge: challenge + `, error="invalid_token"`},
+		{name: "valid", authorization: "Bearer good-token", want: http.StatusOK},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/mcp", strings.NewReader(initializeMessage))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Accept", "application/json, text/event-stream")
+
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tc.want {
+				t.Fatalf("status = %d, want %d (body %q)", rec.Code, tc.want, rec.Body.String())
+			}
+
+			if got := rec.Header().Get("WWW-Authenticate"); got != tc.wantChallenge {
+				t.Errorf("WWW-Authenticate = %q, want %q", got, tc.wantChallenge)
+			}
+		})
+	}
+}
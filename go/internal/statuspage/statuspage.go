@@ -0,0 +1,138 @@
+// Package statuspage reads Linode's public status page (an Atlassian
+// Statuspage summary document) for linode_api_status: the overall status and
+// the incidents not yet resolved. Nothing here talks to the Linode API.
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// requestTimeout bounds one status lookup so an unreachable status page
+// never holds up the tool call for long.
+const requestTimeout = 5 * time.Second
+
+// maxBodyBytes caps the summary read; it lists every component, so it runs
+// to a few hundred KB.
+const maxBodyBytes = 4 << 20
+
+// ErrUnexpectedStatus is returned when the status page answers with a
+// non-200 status.
+var ErrUnexpectedStatus = errors.New("unexpected status from status page")
+
+// Summary is the overall status and the unresolved incidents.
+type Summary struct {
+	// Indicator is none, minor, major, or critical.
+	Indicator   string
+	Description string
+	Incidents   []Incident
+}
+
+// Incident is one unresolved incident. Components names the status page
+// components it affects, which carry the product and region (for example
+// "Object Storage - US-East (Newark)"). LatestUpdate is the text of its most
+// recent update.
+type Incident struct {
+	Name         string
+	Status       string
+	Impact       string
+	ShortLink    string
+	CreatedAt    string
+	UpdatedAt    string
+	Components   []string
+	LatestUpdate string
+}
+
+// summaryDocument is the subset of the Statuspage summary the check reads.
+type summaryDocument struct {
+	Status struct {
+		Indicator   string `json:"indicator"`
+		Description string `json:"description"`
+	} `json:"status"`
+	Incidents []struct {
+		Name       string `json:"name"`
+		Status     string `json:"status"`
+		Impact     string `json:"impact"`
+		ShortLink  string `json:"shortlink"`
+		CreatedAt  string `json:"created_at"`
+		UpdatedAt  string `json:"updated_at"`
+		Components []struct {
+			Name string `json:"name"`
+		} `json:"components"`
+		IncidentUpdates []struct {
+			Body string `json:"body"`
+		} `json:"incident_updates"`
+	} `json:"incidents"`
+}
+
+// Fetch reads the summary document at url. A nil client uses
+// http.DefaultClient. The request carries its own timeout on top of ctx.
+func Fetch(ctx context.Context, client *http.Client, url, userAgent string) (Summary, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return Summary{}, fmt.Errorf("build status page request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Summary{}, fmt.Errorf("fetch status page: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return Summary{}, fmt.Errorf("%w: %d", ErrUnexpectedStatus, resp.StatusCode)
+	}
+
+	var doc summaryDocument
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxBodyBytes)).Decode(&doc); err != nil {
+		return Summary{}, fmt.Errorf("decode status page: %w", err)
+	}
+
+	summary := Summary{
+		Indicator:   doc.Status.Indicator,
+		Description: doc.Status.Description,
+		Incidents:   make([]Incident, 0, len(doc.Incidents)),
+	}
+
+	for _, raw := range doc.Incidents {
+		incident := Incident{
+			Name:       raw.Name,
+			Status:     raw.Status,
+			Impact:     raw.Impact,
+			ShortLink:  raw.ShortLink,
+			CreatedAt:  raw.CreatedAt,
+			UpdatedAt:  raw.UpdatedAt,
+			Components: make([]string, 0, len(raw.Components)),
+		}
+
+		for _, component := range raw.Components {
+			incident.Components = append(incident.Components, component.Name)
+		}
+
+		// Statuspage lists updates newest first.
+		if len(raw.IncidentUpdates) > 0 {
+			incident.LatestUpdate = strings.TrimSpace(raw.IncidentUpdates[0].Body)
+		}
+
+		summary.Incidents = append(summary.Incidents, incident)
+	}
+
+	return summary, nil
+}
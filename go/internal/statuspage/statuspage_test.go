@@ -0,0 +1,69 @@
+package statuspage_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/statuspage"
+)
+
+const summaryBody = `{
+  "status": {"indicator": "minor", "description": "Minor Service Outage"},
+  "incidents": [{
+    "name": "Object Storage degraded in Newark",
+    "status": "investigating",
+    "impact": "minor",
+    "shortlink": "https://stspg.io/abc",
+    "created_at": "2026-10-16T08:00:00Z",
+    "updated_at": "2026-10-16T08:30:00Z",
+    "components": [{"name": "Object Storage - US-East (Newark)"}],
+    "incident_updates": [{"body": "We are investigating. "}, {"body": "older"}]
+  }]
+}`
+
+func TestFetchReadsUnresolvedIncidents(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") != "LinodeMCP/test" {
+			t.Errorf("User-Agent = %q, want LinodeMCP/test", r.Header.Get("User-Agent"))
+		}
+
+		_, _ = w.Write([]byte(summaryBody))
+	}))
+	defer srv.Close()
+
+	summary, err := statuspage.Fetch(t.Context(), srv.Client(), srv.URL, "LinodeMCP/test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if summary.Indicator != "minor" || len(summary.Incidents) != 1 {
+		t.Fatalf("summary = %+v, want one minor incident", summary)
+	}
+
+	incident := summary.Incidents[0]
+	if !slices.Equal(incident.Components, []string{"Object Storage - US-East (Newark)"}) {
+		t.Errorf("Components = %q", incident.Components)
+	}
+
+	if incident.LatestUpdate != "We are investigating." {
+		t.Errorf("LatestUpdate = %q, want the newest update", incident.LatestUpdate)
+	}
+}
+
+func TestFetchRejectsNonOK(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	if _, err := statuspage.Fetch(t.Context(), srv.Client(), srv.URL, "LinodeMCP/test"); !errors.Is(err, statuspage.ErrUnexpectedStatus) {
+		t.Errorf("err = %v, want %v", err, statuspage.ErrUnexpectedStatus)
+	}
+}
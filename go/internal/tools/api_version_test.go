@@ -0,0 +1,69 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// TestAPIVersionPinAndHeaderOverride verifies the environment's pinned API
+// version picks the request path, and the override header wins for one call.
+func TestAPIVersionPinAndHeaderOverride(t *testing.T) {
+	t.Parallel()
+
+	paths := make(chan string, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths <- r.URL.Path
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if _, err := w.Write([]byte(`{"username":"alice"}`)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {
+		Label:  envLabelDefault,
+		Linode: config.LinodeConfig{APIURL: srv.URL + "/v4", APIVersion: "v4beta", Token: tokenTest},
+	}}}
+	_, _, handler := tools.NewLinodeProfileTool(cfg)
+
+	for _, tt := range []struct{ header, want string }{{"", "/v4beta/profile"}, {"v4", "/v4/profile"}} {
+		request := createRequestWithArgs(t, map[string]any{})
+		request.Header = http.Header{}
+
+		if tt.header != "" {
+			request.Header.Set(tools.APIVersionHeader, tt.header)
+		}
+
+		result, err := handler(t.Context(), request)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if result.IsError {
+			t.Fatalf("result.IsError = true, want false: %v", result.Content)
+		}
+
+		if got := <-paths; got != tt.want {
+			t.Errorf("path with header %q = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+
+	request := createRequestWithArgs(t, map[string]any{})
+	request.Header = http.Header{}
+	request.Header.Set(tools.APIVersionHeader, "latest")
+
+	result, err := handler(t.Context(), request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !result.IsError {
+		t.Error("result.IsError = false, want true for an invalid override header")
+	}
+}
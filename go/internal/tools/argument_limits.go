@@ -0,0 +1,142 @@
+package tools
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Limits the dispatcher applies to every tool call's arguments before the
+// handler runs. They sit well above anything the Linode API accepts, so they
+// only catch malformed model output (a runaway string, a list of thousands of
+// IDs) that would otherwise reach the API as an odd failure.
+const (
+	maxArgumentsBytes        = 10 << 20
+	maxArgumentStringLength  = 64 << 10
+	maxArgumentContentLength = 8 << 20
+	maxArgumentArrayItems    = 1000
+)
+
+// Sentinel errors for argument limit violations.
+var (
+	ErrArgumentsTooLarge        = errors.New("arguments exceed the request size limit")
+	ErrArgumentTooLong          = errors.New("argument is too long")
+	ErrArgumentTooManyItems     = errors.New("argument has too many items")
+	ErrArgumentControlCharacter = errors.New("argument contains a control character")
+)
+
+// contentArguments carry file-sized content (a StackScript body, a pasted zone
+// file, a base64 attachment), so they get maxArgumentContentLength instead of
+// the per-string limit.
+var contentArguments = map[string]bool{
+	"script":    true,
+	"zone_file": true,
+	"file":      true,
+}
+
+// textArguments are free-form prose where line breaks and tabs are expected;
+// every other control character is still rejected.
+var textArguments = map[string]bool{
+	"description": true,
+	"summary":     true,
+	"body":        true,
+}
+
+// ValidateArgumentLimits checks a tool call's arguments against the size
+// limits and rejects control characters in labels, tags, and descriptions.
+// Labels end up in API request bodies and log lines, where a stray newline or
+// escape sequence is either rejected with an unhelpful message or forges a
+// line. Keys are walked in sorted order so the reported violation is stable.
+func ValidateArgumentLimits(args map[string]any) error {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(args); err == nil && buf.Len()-1 > maxArgumentsBytes {
+		return fmt.Errorf("%w: %d bytes, at most %d", ErrArgumentsTooLarge, buf.Len()-1, maxArgumentsBytes)
+	}
+
+	return validateArgumentObject("", args)
+}
+
+func validateArgumentObject(prefix string, args map[string]any) error {
+	for _, name := range slices.Sorted(maps.Keys(args)) {
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+
+		if err := validateArgumentValue(path, name, args[name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateArgumentValue(path, name string, value any) error {
+	switch typed := value.(type) {
+	case string:
+		return validateArgumentString(path, name, typed)
+	case map[string]any:
+		return validateArgumentObject(path, typed)
+	case []any:
+		if len(typed) > maxArgumentArrayItems {
+			return fmt.Errorf("%w: %s has %d items, at most %d", ErrArgumentTooManyItems, path, len(typed), maxArgumentArrayItems)
+		}
+
+		for i, item := range typed {
+			if err := validateArgumentValue(fmt.Sprintf("%s[%d]", path, i), name, item); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateArgumentString(path, name, value string) error {
+	limit := maxArgumentStringLength
+	if contentArguments[name] {
+		limit = maxArgumentContentLength
+	}
+
+	if length := utf8.RuneCountInString(value); length > limit {
+		return fmt.Errorf("%w: %s is %d characters, at most %d", ErrArgumentTooLong, path, length, limit)
+	}
+
+	if !isLabelArgument(name) && !textArguments[name] {
+		return nil
+	}
+
+	position := 0
+
+	for _, r := range value {
+		position++
+
+		if !unicode.IsControl(r) {
+			continue
+		}
+
+		if textArguments[name] && (r == '\n' || r == '\r' || r == '\t') {
+			continue
+		}
+
+		return fmt.Errorf("%w: %s has %U at character %d", ErrArgumentControlCharacter, path, r, position)
+	}
+
+	return nil
+}
+
+// isLabelArgument reports whether name holds a resource label or tag: label
+// itself, a qualified label such as new_label, or a tags list entry.
+func isLabelArgument(name string) bool {
+	return name == "label" || strings.HasSuffix(name, "_label") || name == "tags" || name == "tag"
+}
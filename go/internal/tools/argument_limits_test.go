@@ -0,0 +1,83 @@
+package tools_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestValidateArgumentLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		args    map[string]any
+		wantErr error
+		wantMsg string
+	}{
+		{
+			name: "accepts ordinary arguments",
+			args: map[string]any{
+				"label":       "web-1",
+				"description": "line one\nline two\ttabbed",
+				"tags":        []any{"prod", "web"},
+				"linode_id":   float64(5),
+			},
+		},
+		{
+			name:    "rejects a newline in a label",
+			args:    map[string]any{"label": "web\nINFO forged"},
+			wantErr: tools.ErrArgumentControlCharacter,
+			wantMsg: "argument contains a control character: label has U+000A at character 4",
+		},
+		{
+			name:    "rejects an escape in a nested tag",
+			args:    map[string]any{"rules": map[string]any{"tags": []any{"ok", "bad\x1b[31m"}}},
+			wantErr: tools.ErrArgumentControlCharacter,
+			wantMsg: "argument contains a control character: rules.tags[1] has U+001B at character 4",
+		},
+		{
+			name:    "rejects a NUL in a description",
+			args:    map[string]any{"description": "a\x00b"},
+			wantErr: tools.ErrArgumentControlCharacter,
+		},
+		{
+			name:    "rejects an oversized string",
+			args:    map[string]any{"root_pass": strings.Repeat("x", 70000)},
+			wantErr: tools.ErrArgumentTooLong,
+			wantMsg: "argument is too long: root_pass is 70000 characters, at most 65536",
+		},
+		{
+			name: "allows a large script",
+			args: map[string]any{"script": strings.Repeat("x", 70000)},
+		},
+		{
+			name:    "rejects an oversized array",
+			args:    map[string]any{"entity_ids": make([]any, 1001)},
+			wantErr: tools.ErrArgumentTooManyItems,
+			wantMsg: "argument has too many items: entity_ids has 1001 items, at most 1000",
+		},
+		{
+			name:    "rejects an oversized request",
+			args:    map[string]any{"script": strings.Repeat("x", 6<<20), "zone_file": strings.Repeat("x", 6<<20)},
+			wantErr: tools.ErrArgumentsTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tools.ValidateArgumentLimits(tt.args)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateArgumentLimits() = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantMsg != "" && err.Error() != tt.wantMsg {
+				t.Errorf("error = %q, want %q", err.Error(), tt.wantMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// TestInstanceDeleteConfirmLabel covers the confirm_label config: when it is
+// on, the delete runs only when confirm_label matches the instance's label,
+// and a refusal never names the real label.
+func TestInstanceDeleteConfirmLabel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		enabled    bool
+		label      any
+		wantError  string
+		wantDelete bool
+	}{
+		{name: "disabled", enabled: false, wantDelete: true},
+		{name: "missing", enabled: true, wantError: "linode_instance_delete requires confirm_label"},
+		{name: "mismatch", enabled: true, label: "web-staging-01", wantError: `confirm_label "web-staging-01" does not match`},
+		{name: "match", enabled: true, label: "web-prod-01", wantDelete: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var methods []string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method)
+
+				w.Header().Set("Content-Type", "application/json")
+
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(linode.Instance{ID: 123, Label: "web-prod-01"})
+
+					return
+				}
+
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer srv.Close()
+
+			cfg := &config.Config{
+				Environments: map[string]config.EnvironmentConfig{
+					envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+				},
+				ConfirmLabel: config.ConfirmLabelConfig{Enabled: tt.enabled},
+			}
+			_, _, handler := tools.NewLinodeInstanceDeleteTool(cfg)
+
+			args := map[string]any{keyInstanceID: float64(123), keyConfirm: true, keyConfirmBypassDryRun: true}
+			if tt.label != nil {
+				args["confirm_label"] = tt.label
+			}
+
+			result, err := handler(t.Context(), createRequestWithArgs(t, args))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text, _ := result.Content[0].(mcp.TextContent)
+
+			if tt.wantError != "" {
+				if !result.IsError || !strings.Contains(text.Text, tt.wantError) {
+					t.Errorf("result = %q, want an error containing %q", text.Text, tt.wantError)
+				}
+
+				if strings.Contains(text.Text, "web-prod-01") {
+					t.Errorf("refusal %q names the instance's label", text.Text)
+				}
+			} else if result.IsError {
+				t.Errorf("result = %q, want success", text.Text)
+			}
+
+			if deleted := slices.Contains(methods, http.MethodDelete); deleted != tt.wantDelete {
+				t.Errorf("DELETE issued = %v, want %v (requests %v)", deleted, tt.wantDelete, methods)
+			}
+		})
+	}
+}
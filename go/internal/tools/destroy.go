@@ -60,6 +60,11 @@ type DestructiveAction struct {
 	// CapDestroy, since every delete tool leaves this unset. A CapWrite action
 	// (e.g. instance_resize) sets it so the flow stays opt-in by default.
 	Capability profiles.Capability
+
+	// ConfirmLabel, when non-nil, reads the resource's label from the fetched
+	// state. With confirm_label.enabled set, a real call or a plan must then
+	// echo that label as confirm_label. Nil leaves the tool out of the check.
+	ConfirmLabel func(state any) string
 }
 
 // capability returns the action's capability, defaulting an unset (zero) value
@@ -83,7 +88,7 @@ func runDestructiveDryRun(
 	cfg *config.Config,
 	action *DestructiveAction,
 ) (*mcp.CallToolResult, error) {
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -130,6 +135,10 @@ func RunDestructiveAction(
 		return result, nil
 	}
 
+	if result := requireConfirmLabel(ctx, request, cfg, action, nil); result != nil {
+		return result, nil
+	}
+
 	return executeDestroy(ctx, request, cfg, action)
 }
 
@@ -230,6 +239,54 @@ func destroyBypassMessage(toolName string) string {
 	)
 }
 
+// requireConfirmLabel enforces the confirm_label config on tools that set
+// ConfirmLabel: the call must pass the resource's current label as
+// confirm_label, so an ID the model mixed up cannot delete the wrong resource.
+// The label is never revealed in the error, since echoing it back would let
+// the model copy it without noticing the mix-up. state is the already-fetched
+// state, or nil to fetch it here. Unlike the confirm gate, yolo does not skip
+// the check: it guards against a wrong ID, not against an unreviewed call.
+// Returns a non-nil error result to short-circuit, or nil to proceed.
+func requireConfirmLabel(
+	ctx context.Context,
+	request *mcp.CallToolRequest,
+	cfg *config.Config,
+	action *DestructiveAction,
+	state any,
+) *mcp.CallToolResult {
+	if action.ConfirmLabel == nil || !resolveConfig(cfg).ConfirmLabel.Enabled {
+		return nil
+	}
+
+	if state == nil {
+		client, err := prepareClient(ctx, request, cfg)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error())
+		}
+
+		fetched, fetchErr := action.FetchState(ctx, client)
+		if fetchErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to fetch state for the label check: %v", fetchErr))
+		}
+
+		state = fetched
+	}
+
+	supplied := request.GetString(paramConfirmLabel, "")
+
+	switch {
+	case supplied == "":
+		return mcp.NewToolResultError(fmt.Sprintf(
+			"%s requires confirm_label: pass the exact label of the resource you mean to delete", action.ToolName))
+	case supplied != action.ConfirmLabel(state):
+		return mcp.NewToolResultError(fmt.Sprintf(
+			"confirm_label %q does not match the label of %s; check that the ID is the resource you mean to delete",
+			supplied, action.Path))
+	}
+
+	return nil
+}
+
 // DestructiveActionByID configures a single-ID destroy tool. The vast
 // majority of CapDestroy tools take one integer ID arg, a fixed path
 // shape, and a uniform success response, so this thinner config form
@@ -263,6 +320,10 @@ type DestructiveActionByID struct {
 	// HashIgnore lists cosmetic state fields stripped before the two-stage
 	// drift hash. Use twostage.HashIgnoreFields(resourceType) to populate it.
 	HashIgnore []string
+
+	// ConfirmLabel reads the resource's label from the fetched state for the
+	// confirm_label check. Nil leaves the tool out of it.
+	ConfirmLabel func(state any) string
 }
 
 // RunDestructiveActionWithID is the single-ID convenience wrapper over
@@ -302,6 +363,7 @@ func RunDestructiveActionWithID(
 		Success:        destructiveByIDSuccess(params, id),
 		DependencyWalk: walk,
 		HashIgnore:     params.HashIgnore,
+		ConfirmLabel:   params.ConfirmLabel,
 	})
 }
 
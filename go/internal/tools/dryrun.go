@@ -289,7 +289,7 @@ func RunDryRunPreviewDetailed(
 	)
 
 	if fetchState != nil {
-		preparedClient, err := prepareClient(request, cfg)
+		preparedClient, err := prepareClient(ctx, request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
@@ -329,7 +329,7 @@ func RunDryRunPreviewWithBody(
 	var state any
 
 	if fetchState != nil {
-		client, err := prepareClient(request, cfg)
+		client, err := prepareClient(ctx, request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
@@ -363,7 +363,7 @@ func RunDryRunPreviewWithBodyDetailed(
 	)
 
 	if fetchState != nil {
-		preparedClient, err := prepareClient(request, cfg)
+		preparedClient, err := prepareClient(ctx, request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
@@ -597,7 +597,7 @@ func vpcUpdateSideEffects(ctx context.Context, state any, newLabel, newDescripti
 // nodebalancerUpdateSideEffects is the Tier B walk for
 // linode_nodebalancer_update. It reports the label change and a
 // connection-throttle change against the fetched state.
-func nodebalancerUpdateSideEffects(ctx context.Context, state any, newLabel string, newThrottle int) (DryRunDetails, error) {
+func nodebalancerUpdateSideEffects(ctx context.Context, state any, newLabel string, newThrottle int, hasTags bool) (DryRunDetails, error) {
 	var details DryRunDetails
 
 	if err := ctx.Err(); err != nil {
@@ -617,6 +617,11 @@ func nodebalancerUpdateSideEffects(ctx context.Context, state any, newLabel stri
 		))
 	}
 
+	if hasTags {
+		details.SideEffects = append(details.SideEffects,
+			"The NodeBalancer's tag set is replaced with the provided tags.")
+	}
+
 	return details, nil
 }
 
@@ -1054,9 +1059,9 @@ func sshKeyCreateSideEffects(ctx context.Context, label string) (DryRunDetails,
 }
 
 // instanceCreateSideEffects is the Tier B preview for linode_instance_create.
-// It describes the instance that would be created (arg-only, no fetch) and
-// warns that billing begins on creation.
-func instanceCreateSideEffects(ctx context.Context, instanceType, region, image string) (DryRunDetails, error) {
+// It describes the instance that would be created and what it is built from
+// (an image, a backup, or nothing) and warns that billing begins on creation.
+func instanceCreateSideEffects(ctx context.Context, instanceType, region, source string) (DryRunDetails, error) {
 	var details DryRunDetails
 
 	if err := ctx.Err(); err != nil {
@@ -1064,8 +1069,8 @@ func instanceCreateSideEffects(ctx context.Context, instanceType, region, image
 	}
 
 	effect := fmt.Sprintf("A new %s instance will be created in region %s", instanceType, region)
-	if image != "" {
-		effect += " from image " + image
+	if source != "" {
+		effect += " from " + source
 	}
 
 	details.SideEffects = append(details.SideEffects, effect+".")
@@ -1114,7 +1119,8 @@ func imageCreateSideEffects(ctx context.Context, diskID int, label string) (DryR
 
 // bucketCreateSideEffects is the Tier B preview for
 // linode_object_storage_bucket_create. It names the bucket and region
-// (arg-only, no fetch) and warns that billing begins on creation.
+// (arg-only, no fetch; region=auto is only resolved at apply time) and warns
+// that billing begins on creation.
 func bucketCreateSideEffects(ctx context.Context, label, region string) (DryRunDetails, error) {
 	var details DryRunDetails
 
@@ -1122,8 +1128,13 @@ func bucketCreateSideEffects(ctx context.Context, label, region string) (DryRunD
 		return details, fmt.Errorf("bucket-create side-effect walk canceled: %w", err)
 	}
 
+	where := region
+	if strings.EqualFold(region, bucketRegionAuto) {
+		where = "the region picked at apply time (first configured preferred region with an endpoint, else lowest endpoint latency)"
+	}
+
 	details.SideEffects = append(details.SideEffects,
-		fmt.Sprintf("A new Object Storage bucket %q will be created in %s.", label, region))
+		fmt.Sprintf("A new Object Storage bucket %q will be created in %s.", label, where))
 	details.Warnings = append(details.Warnings, "Billing for Object Storage starts immediately on creation.")
 
 	return details, nil
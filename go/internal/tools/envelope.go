@@ -0,0 +1,130 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// EnvelopeMetaKey is the _meta key every tool result carries its response
+// envelope under. The key is namespaced so it cannot collide with protocol
+// fields such as progressToken.
+const EnvelopeMetaKey = "linodemcp/envelope"
+
+// Envelope is the uniform summary attached to every tool result's _meta. The
+// text content stays the tool's data payload, unchanged and byte-identical to
+// the proto contract; the envelope rides alongside it so partial failures (an
+// ignored argument, a skipped page) reach the caller as Warnings without
+// flipping the whole result to IsError. Count is set only by list tools.
+// CorrelationID is the call's audit event ID, which the Linode client also
+// sends with every API request the call makes.
+type Envelope struct {
+	Count         *int32   `json:"count,omitempty"`
+	Warnings      []string `json:"warnings"`
+	Environment   string   `json:"environment"`
+	ElapsedMS     int64    `json:"elapsed_ms"`
+	CorrelationID string   `json:"correlation_id"`
+}
+
+// warningCollector accumulates warnings for one tool call. Handlers may fan
+// out across goroutines, so appends are guarded.
+type warningCollector struct {
+	mu    sync.Mutex
+	items []string
+}
+
+type envelopeCtxKey struct{}
+
+// WithWarnings attaches a fresh warning collector to ctx. The server
+// middleware calls it once per dispatch and reads the collected warnings back
+// into the result envelope when the handler returns.
+func WithWarnings(ctx context.Context) context.Context {
+	return context.WithValue(ctx, envelopeCtxKey{}, &warningCollector{})
+}
+
+// AddWarning records a non-fatal problem for the current call. It is a no-op
+// when ctx carries no collector (for example in unit tests that call a handler
+// directly), so handlers can call it unconditionally.
+func AddWarning(ctx context.Context, format string, args ...any) {
+	collector, ok := ctx.Value(envelopeCtxKey{}).(*warningCollector)
+	if !ok {
+		return
+	}
+
+	collector.mu.Lock()
+	collector.items = append(collector.items, fmt.Sprintf(format, args...))
+	collector.mu.Unlock()
+}
+
+// warningsFromContext returns a copy of the warnings recorded so far, never
+// nil, so the envelope always serializes warnings as an array.
+func warningsFromContext(ctx context.Context) []string {
+	collector, ok := ctx.Value(envelopeCtxKey{}).(*warningCollector)
+	if !ok {
+		return []string{}
+	}
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	return append([]string{}, collector.items...)
+}
+
+// envelopeOf returns the envelope already attached to result, creating and
+// attaching an empty one when absent.
+func envelopeOf(result *mcp.CallToolResult) *Envelope {
+	if result.Meta == nil {
+		result.Meta = &mcp.Meta{}
+	}
+
+	if result.Meta.AdditionalFields == nil {
+		result.Meta.AdditionalFields = map[string]any{}
+	}
+
+	if env, ok := result.Meta.AdditionalFields[EnvelopeMetaKey].(*Envelope); ok {
+		return env
+	}
+
+	env := &Envelope{Warnings: []string{}}
+	result.Meta.AdditionalFields[EnvelopeMetaKey] = env
+
+	return env
+}
+
+// setEnvelopeCount records a list tool's item count on its result envelope.
+func setEnvelopeCount(result *mcp.CallToolResult, count int32) {
+	envelopeOf(result).Count = &count
+}
+
+// FinalizeEnvelope fills the per-call envelope fields on result: the
+// environment the call targeted, the warnings collected on ctx, the elapsed
+// wall time since start, and the correlation ID on ctx. A nil result (the
+// handler returned a Go error) is left untouched.
+func FinalizeEnvelope(ctx context.Context, result *mcp.CallToolResult, request *mcp.CallToolRequest, start time.Time) {
+	if result == nil {
+		return
+	}
+
+	env := envelopeOf(result)
+	env.Environment = request.GetString(paramEnvironment, "")
+	env.Warnings = append(env.Warnings, warningsFromContext(ctx)...)
+	env.ElapsedMS = time.Since(start).Milliseconds()
+	env.CorrelationID = linode.CorrelationIDFromContext(ctx)
+}
+
+// EnvelopeFromResult returns the envelope attached to result, or nil when the
+// result carries none.
+func EnvelopeFromResult(result *mcp.CallToolResult) *Envelope {
+	if result == nil || result.Meta == nil {
+		return nil
+	}
+
+	env, _ := result.Meta.AdditionalFields[EnvelopeMetaKey].(*Envelope)
+
+	return env
+}
@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// linodeAPIErrorMarker is the prefix linode.APIError renders with. Hints are
+// only matched against results that carry it, so a local validation message
+// that happens to share a phrase with the catalog is left alone.
+const linodeAPIErrorMarker = "Linode API error (status"
+
+// errorHint maps Linode API error message fragments to the remediation an
+// agent should try next. Fragments match case-insensitively.
+type errorHint struct {
+	fragments []string
+	hint      string
+}
+
+// errorHints is the remediation catalog, checked in order; the first entry
+// with a fragment in the error text wins. Mirrored in Python's error_hints.py.
+var errorHints = []errorHint{
+	{
+		fragments: []string{"insufficient funds", "not enough funds", "payment method"},
+		hint: "The account cannot be billed. Check the balance with linode_account_get, add a card with " +
+			"linode_account_payment_method_create or pay with linode_account_payment_create, or pick a smaller plan.",
+	},
+	{
+		fragments: []string{"sold out", "not available in this region", "currently unavailable", "not available for this region"},
+		hint: "That plan or service cannot be deployed there right now. Use linode_region_availability_list or " +
+			"linode_account_availability_list to find a region with capacity, or choose another plan from linode_type_list.",
+	},
+	{
+		fragments: []string{"must be unique", "already in use", "already exists"},
+		hint: "Another resource already uses that label. Pick a different label, or find the existing resource " +
+			"with the matching list tool and reuse it.",
+	},
+	{
+		fragments: []string{"volume is attached", "currently attached", "must be detached"},
+		hint:      "Detach the volume with linode_volume_detach, wait for linode_volume_get to show no linode_id, then retry.",
+	},
+	{
+		fragments: []string{"linode busy", "linode is busy", "another operation is in progress"},
+		hint:      "The instance is running another job. Wait for it to finish (see linode_account_event_list), then retry.",
+	},
+	{
+		fragments: []string{"must be powered off", "must be offline", "while it is running"},
+		hint:      "Power the instance off with linode_instance_shutdown, wait for status offline, then retry.",
+	},
+}
+
+// errorHintFor returns the remediation hint for a Linode API error text, or ""
+// when the catalog has no entry for it.
+func errorHintFor(text string) string {
+	if !strings.Contains(text, linodeAPIErrorMarker) {
+		return ""
+	}
+
+	lower := strings.ToLower(text)
+
+	for _, entry := range errorHints {
+		for _, fragment := range entry.fragments {
+			if strings.Contains(lower, fragment) {
+				return entry.hint
+			}
+		}
+	}
+
+	return ""
+}
+
+// AppendErrorHint appends a "Hint:" line to an error result's text when the
+// Linode API error it reports has a catalog entry, so an agent retrying the
+// call is told what to change. Successful and nil results are left untouched.
+func AppendErrorHint(result *mcp.CallToolResult) {
+	if result == nil || !result.IsError {
+		return
+	}
+
+	for i, content := range result.Content {
+		text, ok := content.(mcp.TextContent)
+		if !ok {
+			continue
+		}
+
+		if hint := errorHintFor(text.Text); hint != "" {
+			text.Text += "\nHint: " + hint
+			result.Content[i] = text
+
+			return
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package tools_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestAppendErrorHint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		result   *mcp.CallToolResult
+		wantHint string
+	}{
+		{
+			name:     "label collision",
+			result:   mcp.NewToolResultError("Failed to create instance: Linode API error (status 400): Label must be unique among your linodes (field: label)"),
+			wantHint: "Another resource already uses that label",
+		},
+		{
+			name:     "sold out plan",
+			result:   mcp.NewToolResultError("Failed to create instance: Linode API error (status 400): This plan is Sold Out in us-east"),
+			wantHint: "linode_region_availability_list",
+		},
+		{
+			name:     "attached volume",
+			result:   mcp.NewToolResultError("Failed to delete volume: Linode API error (status 400): Volume is attached to a Linode"),
+			wantHint: "linode_volume_detach",
+		},
+		{
+			name:   "local validation text is not an API error",
+			result: mcp.NewToolResultError("label must be unique within the request"),
+		},
+		{
+			name:   "API error without a catalog entry",
+			result: mcp.NewToolResultError("Failed to get instance: Linode API error (status 404): Not found"),
+		},
+		{
+			name:   "success result",
+			result: mcp.NewToolResultText("Linode API error (status 400): must be unique"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			before := dryRunResultText(t, tt.result)
+
+			tools.AppendErrorHint(tt.result)
+
+			after := dryRunResultText(t, tt.result)
+
+			if tt.wantHint == "" {
+				if after != before {
+					t.Errorf("text = %q, want unchanged %q", after, before)
+				}
+
+				return
+			}
+
+			if !strings.HasPrefix(after, before+"\nHint: ") || !strings.Contains(after, tt.wantHint) {
+				t.Errorf("text = %q, want %q plus a hint mentioning %q", after, before, tt.wantHint)
+			}
+		})
+	}
+}
+
+func TestAppendErrorHintNilResult(t *testing.T) {
+	t.Parallel()
+
+	tools.AppendErrorHint(nil)
+}
@@ -4,13 +4,15 @@ import "errors"
 
 // Sentinel errors for Linode instance operations.
 var (
-	ErrEnvironmentNotFound    = errors.New("environment not found in configuration")
 	ErrLinodeConfigIncomplete = errors.New("linode configuration is incomplete: check your API URL and token")
-	ErrInstanceIDRequired     = errors.New("instance_id is required")
-	ErrInvalidInstanceID      = errors.New("instance_id must be a valid integer")
-	ErrLinodeIDRequired       = errors.New("linode_id is required")
-	ErrLinodeIDInvalid        = errors.New("linode_id must be a valid integer")
-	errUnexpectedTrailingJSON = errors.New("unexpected trailing JSON")
+	// ErrPassthroughTokenMissing means server.tokenPassthrough is set and
+	// the request carried no X-Linode-Token header.
+	ErrPassthroughTokenMissing = errors.New("token passthrough is enabled: send your Linode token in the X-Linode-Token header")
+	ErrInstanceIDRequired      = errors.New("instance_id is required")
+	ErrInvalidInstanceID       = errors.New("instance_id must be a valid integer")
+	ErrLinodeIDRequired        = errors.New("linode_id is required")
+	ErrLinodeIDInvalid         = errors.New("linode_id must be a valid integer")
+	errUnexpectedTrailingJSON  = errors.New("unexpected trailing JSON")
 	// errUnexpectedKeyToken reports a non-string object key, which valid
 	// JSON never produces; it guards the widenObject type assertion.
 	errUnexpectedKeyToken  = errors.New("unexpected object key token")
@@ -21,6 +23,47 @@ var (
 	ErrDiskIDRequired      = errors.New("disk_id is required")
 	ErrDiskIDInvalid       = errors.New("disk_id must be a valid integer")
 	errReservedIPListShape = errors.New("reserved IP list response shape mismatch")
+	// ErrVPCNotFound and ErrVPCSubnetNotFound report a vpc_subnet label
+	// reference ("<vpc label>/<subnet label>") that names no VPC or subnet.
+	ErrVPCNotFound       = errors.New("no VPC with that label")
+	ErrVPCSubnetNotFound = errors.New("no subnet with that label in the VPC")
+	// ErrLKEClusterNotFound reports a cluster ID that is not on the account.
+	ErrLKEClusterNotFound = errors.New("no LKE cluster with that ID")
+	// ErrInstanceLabelNotFound reports an instance reference that is neither
+	// an ID nor the label of an instance on the account.
+	ErrInstanceLabelNotFound   = errors.New("no instance with that label")
+	ErrInstanceNoPublicAddress = errors.New("no public IPv4 or IPv6 address")
+	// ErrBackupNotOnAccount and ErrBackupNotRestorable reject the backup an
+	// instance create restores from: one the account cannot read, or one
+	// that has not completed or is no longer available.
+	ErrBackupNotOnAccount  = errors.New("backup not found on this account")
+	ErrBackupNotRestorable = errors.New("backup cannot be restored")
+	// ErrDefaultKeyLabelNotFound reports an instance_defaults SSH key label
+	// that matches no key on the profile.
+	ErrDefaultKeyLabelNotFound = errors.New("instance_defaults.authorized_key_labels names SSH keys not on the profile")
+	// errTagRetagUnsupportedType reports a tagged object whose type has no
+	// update endpoint linode_tags_retag knows how to call.
+	errTagRetagUnsupportedType = errors.New("retagging is not supported for tagged objects of type")
+	// errBulkDeleteUnsupportedType, errBulkDeleteNoTaggedResources, and
+	// errBulkDeleteTooManyTargets reject the set a linode_bulk_delete tag
+	// resolves to.
+	errBulkDeleteUnsupportedType   = errors.New("linode_bulk_delete cannot delete tagged objects of type")
+	errBulkDeleteNoTaggedResources = errors.New("no resources carry the tag")
+	errBulkDeleteTooManyTargets    = errors.New("too many resources for one bulk delete")
+	// errInstanceReplaceNoImage, errInstanceReplaceNoFirewall, and
+	// errInstanceReplaceManyIPv4 reject an old instance linode_instance_replace
+	// cannot copy without being told more or without losing DNS records;
+	// errInstanceReplaceNotRunning, errInstanceReplaceNoAddress, and
+	// errInstanceReplaceUnknownStep fail a step of the run.
+	errInstanceReplaceNoImage     = errors.New("no image to rebuild from; pass image")
+	errInstanceReplaceNoFirewall  = errors.New("no firewall to carry over; pass firewall_id")
+	errInstanceReplaceManyIPv4    = errors.New("A records point at more than one of its IPv4 addresses, and the new instance gets one; move them by hand")
+	errInstanceReplaceNotRunning  = errors.New("the new instance was not running")
+	errInstanceReplaceNoAddress   = errors.New("the new instance has no public address for the record")
+	errInstanceReplaceUnknownStep = errors.New("unknown step action")
+	// errManifestTooManyObjects rejects a manifest export whose listing runs
+	// past maxManifestObjects.
+	errManifestTooManyObjects = errors.New("bucket listing is too large for one manifest")
 )
 
 // Sentinel errors for image share group validation.
@@ -47,9 +90,11 @@ var (
 
 // Sentinel errors for DNS validation.
 var (
-	ErrDNSNameTooLong       = errors.New("DNS record name exceeds maximum length of 253 characters")
-	ErrDNSNameInvalid       = errors.New("invalid DNS record name: must contain only alphanumeric characters, hyphens, and dots")
-	ErrDNSTargetRequired    = errors.New("target is required")
+	ErrDNSNameTooLong    = errors.New("DNS record name exceeds maximum length of 253 characters")
+	ErrDNSNameInvalid    = errors.New("invalid DNS record name: must contain only alphanumeric characters, hyphens, and dots")
+	ErrDNSTargetRequired = errors.New("target is required")
+	// ErrDNSPointAmbiguous refuses to pick one of several same-type records.
+	ErrDNSPointAmbiguous    = errors.New("refusing to pick one; update them individually or use linode_dns_cutover")
 	ErrDNSTargetInvalidA    = errors.New("a record target must be a valid IPv4 address")
 	ErrDNSTargetPrivateIP   = errors.New("a record target cannot be a private IP address")
 	ErrDNSTargetInvalidAAAA = errors.New("aaaa record target must be a valid IPv6 address")
@@ -146,3 +191,15 @@ var (
 	// path to write to.
 	ErrConfigPathUnknown = errors.New("config path not configured")
 )
+
+// Sentinel errors for write cost attribution. WriteCostDelta logs them and
+// leaves the write unpriced.
+var (
+	errNoInstanceTypePrice = errors.New("no list price for instance type")
+	errNoPriceTable        = errors.New("price table lists no types")
+	errNoCostRegion        = errors.New("region or linode_id is required to price a volume")
+)
+
+// ErrLabelNamespaceTooLong rejects a create whose label, with the
+// environment's label_namespace prefix added, is longer than the API accepts.
+var ErrLabelNamespaceTooLong = errors.New("label is too long with the label_namespace prefix")
@@ -0,0 +1,118 @@
+package tools_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/linodefake"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// newFakeEnvironment returns a config with a default environment and a
+// fresh in-memory backend. Each call gets its own backend, so tests built on
+// it run in parallel without an httptest server.
+func newFakeEnvironment(t *testing.T) (*config.Config, *linodefake.Backend) {
+	t.Helper()
+
+	backend := linodefake.New()
+
+	return &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: backend.Environment(tokenTest)},
+		},
+	}, backend
+}
+
+// fakeResultText runs handler with args against backend and returns its text
+// content.
+func fakeResultText(
+	t *testing.T,
+	backend *linodefake.Backend,
+	handler func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error),
+	args map[string]any,
+) (string, bool) {
+	t.Helper()
+
+	ctx := tools.WithClientFactory(t.Context(), backend.NewClient)
+
+	result, err := handler(ctx, createRequestWithArgs(t, args))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("result.Content[0] is not text")
+	}
+
+	return textContent.Text, result.IsError
+}
+
+func TestFakeBackendVolumeGet(t *testing.T) {
+	t.Parallel()
+
+	cfg, backend := newFakeEnvironment(t)
+	backend.SetObject("/volumes/1234", linode.Volume{ID: 1234, Label: labelDataVol, Status: statusActive, Region: regionUSEast})
+
+	_, _, handler := tools.NewLinodeVolumeGetTool(cfg)
+
+	text, isError := fakeResultText(t, backend, handler, map[string]any{keyVolumeID: 1234})
+	if isError {
+		t.Fatalf("result is an error: %s", text)
+	}
+
+	if !strings.Contains(text, labelDataVol) {
+		t.Errorf("result = %s, want it to contain %v", text, labelDataVol)
+	}
+
+	requests := backend.Requests()
+	if len(requests) != 1 || requests[0].Method != http.MethodGet || requests[0].Path != "/volumes/1234" {
+		t.Fatalf("requests = %+v, want one GET /volumes/1234", requests)
+	}
+
+	if got := requests[0].Header.Get("Authorization"); got != "Bearer "+tokenTest {
+		t.Errorf("Authorization = %q, want the environment token", got)
+	}
+}
+
+func TestFakeBackendVolumeList(t *testing.T) {
+	t.Parallel()
+
+	cfg, backend := newFakeEnvironment(t)
+	backend.SetList("/volumes",
+		linode.Volume{ID: 1, Label: labelDataVol, Status: statusActive, Region: regionUSEast},
+		linode.Volume{ID: 2, Label: labelBackupVol, Status: statusActive, Region: regionEUWest},
+	)
+
+	_, _, handler := tools.NewLinodeVolumeListTool(cfg)
+
+	text, isError := fakeResultText(t, backend, handler, map[string]any{})
+	if isError {
+		t.Fatalf("result is an error: %s", text)
+	}
+
+	for _, label := range []string{labelDataVol, labelBackupVol} {
+		if !strings.Contains(text, label) {
+			t.Errorf("result = %s, want it to contain %v", text, label)
+		}
+	}
+}
+
+func TestFakeBackendNotFound(t *testing.T) {
+	t.Parallel()
+
+	cfg, backend := newFakeEnvironment(t)
+
+	_, _, handler := tools.NewLinodeVolumeGetTool(cfg)
+
+	text, isError := fakeResultText(t, backend, handler, map[string]any{keyVolumeID: 99})
+	if !isError {
+		t.Fatalf("result = %s, want an error for a volume the backend does not hold", text)
+	}
+}
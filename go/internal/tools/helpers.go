@@ -2,6 +2,8 @@ package tools
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -33,6 +35,10 @@ const (
 	paramConfirmedDryRun     = "confirmed_dry_run"
 	paramConfirmBypassDryRun = "confirm_bypass_dry_run"
 
+	// paramConfirmLabel is the label echo the confirm_label config requires on
+	// the highest-risk deletes (see requireConfirmLabel in destroy.go).
+	paramConfirmLabel = "confirm_label"
+
 	// paramYolo is the request flag that bypasses preview and confirm. The
 	// server middleware honors it only when the active profile allows yolo.
 	paramYolo = "yolo"
@@ -95,8 +101,10 @@ func resolveConfig(snapshot *config.Config) *config.Config {
 // prepareClient extracts the environment parameter, validates the config, and returns a ready-to-use API client.
 // When a live config source is registered (see SetLiveConfigSource), the
 // latest values flow through here so reloaded resilience and environment
-// settings take effect on the very next tool call.
-func prepareClient(request *mcp.CallToolRequest, cfg *config.Config) (*linode.Client, error) {
+// settings take effect on the very next tool call. The client comes from the
+// factory WithClientFactory bound to ctx, or linode.NewClient.
+func prepareClient(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*linode.Client, error) {
+	newClient := clientFactory(ctx)
 	cfg = resolveConfig(cfg)
 	environment := request.GetString(paramEnvironment, "")
 
@@ -105,11 +113,133 @@ func prepareClient(request *mcp.CallToolRequest, cfg *config.Config) (*linode.Cl
 		return nil, err
 	}
 
-	if err := validateLinodeConfig(selectedEnv); err != nil {
+	baseURL, err := apiBaseURL(request, selectedEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cfg.Server.TokenPassthrough {
+		if err := validateLinodeConfig(selectedEnv); err != nil {
+			return nil, err
+		}
+
+		return newClient(baseURL, selectedEnv.Linode.Token, cfg, linode.EnvironmentOptions(selectedEnv.Linode)...), nil
+	}
+
+	token, err := passthroughToken(request)
+	if err != nil {
 		return nil, err
 	}
 
-	return linode.NewClient(selectedEnv.Linode.APIURL, selectedEnv.Linode.Token, cfg), nil
+	if selectedEnv.Linode.APIURL == "" {
+		return nil, ErrLinodeConfigIncomplete
+	}
+
+	return newClient(baseURL, token, cfg, linode.EnvironmentOptions(selectedEnv.Linode)...), nil
+}
+
+type clientFactoryCtxKey struct{}
+
+// WithClientFactory makes every tool handler on ctx build its API client with
+// factory instead of linode.NewClient. The server middleware binds the
+// factory the server was given; tests bind one that serves an in-memory
+// backend.
+func WithClientFactory(ctx context.Context, factory linode.ClientFactory) context.Context {
+	return context.WithValue(ctx, clientFactoryCtxKey{}, factory)
+}
+
+// clientFactory returns the factory WithClientFactory bound, or
+// linode.NewClient when ctx has none.
+func clientFactory(ctx context.Context) linode.ClientFactory {
+	if factory, _ := ctx.Value(clientFactoryCtxKey{}).(linode.ClientFactory); factory != nil {
+		return factory
+	}
+
+	return linode.NewClient
+}
+
+// APIVersionHeader lets an HTTP caller override the environment's pinned API
+// version for one call, for example to try a v4beta-only field without
+// repointing the whole environment.
+const APIVersionHeader = "X-LinodeMCP-API-Version"
+
+// apiBaseURL returns the environment's API base URL with the version from
+// APIVersionHeader when the request carries one, or the configured pin
+// otherwise.
+func apiBaseURL(request *mcp.CallToolRequest, env *config.EnvironmentConfig) (string, error) {
+	version := strings.TrimSpace(request.Header.Get(APIVersionHeader))
+	if version == "" {
+		return env.Linode.BaseURL(), nil
+	}
+
+	if err := config.ValidateAPIVersion(version); err != nil {
+		return "", fmt.Errorf("%s header: %w", APIVersionHeader, err)
+	}
+
+	return config.WithAPIVersion(env.Linode.APIURL, version), nil
+}
+
+// TokenHeader carries the caller's own Linode token when
+// server.tokenPassthrough is set. It is deliberately not Authorization, which
+// belongs to whatever authenticates the client to this server.
+const TokenHeader = "X-Linode-Token"
+
+// passthroughToken returns the Linode token the client sent with this call.
+// mcp-go copies the HTTP request headers onto every tool request, so the
+// token lives only as long as the call: nothing is cached or shared across
+// requests, and a request without the header fails instead of falling back
+// to any other credential.
+func passthroughToken(request *mcp.CallToolRequest) (string, error) {
+	token := strings.TrimSpace(request.Header.Get(TokenHeader))
+	if token == "" {
+		return "", ErrPassthroughTokenMissing
+	}
+
+	return token, nil
+}
+
+// tokenPartition names the tenant a call belongs to in the server's local
+// state. It is empty except under server.tokenPassthrough, where tenants
+// share one deployment: there it is a hash of the caller's Linode token.
+func tokenPartition(request *mcp.CallToolRequest, cfg *config.Config) (string, error) {
+	if !resolveConfig(cfg).Server.TokenPassthrough {
+		return "", nil
+	}
+
+	token, err := passthroughToken(request)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+type tokenPartitionCtxKey struct{}
+
+type tokenPartitionBinding struct {
+	err       error
+	partition string
+}
+
+// WithTokenPartition binds the call's tenant to a context so the server's
+// in-memory stores (summarized results, two-stage plans, and node drains)
+// keep what one tenant left out of another's reach. The server middleware
+// sets it on every call.
+func WithTokenPartition(ctx context.Context, cfg *config.Config, request *mcp.CallToolRequest) context.Context {
+	partition, err := tokenPartition(request, cfg)
+
+	return context.WithValue(ctx, tokenPartitionCtxKey{}, tokenPartitionBinding{err: err, partition: partition})
+}
+
+// storePartition returns the tenant WithTokenPartition bound, or "" when ctx
+// has none. A passthrough call that sent no token has no tenant and gets
+// ErrPassthroughTokenMissing.
+func storePartition(ctx context.Context) (string, error) {
+	binding, _ := ctx.Value(tokenPartitionCtxKey{}).(tokenPartitionBinding)
+
+	return binding.partition, binding.err
 }
 
 // RequireConfirm checks that confirm is the literal JSON boolean true.
@@ -133,7 +263,7 @@ func newSimpleProtoGetTool(
 	tool := mcp.NewToolWithRawSchema(toolName, description, toolschemas.Schema(schemaName))
 
 	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		client, err := prepareClient(&request, cfg)
+		client, err := prepareClient(ctx, &request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
@@ -246,7 +376,8 @@ func protoListFilterOptions[T proto.Message](opts []mcp.ToolOption, filterParams
 // MarshalProtoToolResponse), so each factory body stays short and distinct (and
 // under the dupl linter's threshold). assemble builds the family list-response
 // message from the filtered items, the count, and the optional filter echo
-// (joined with the same ", " separator the Python side uses).
+// (joined with the same ", " separator the Python side uses). The count is also
+// stamped on the result envelope so callers can read it without parsing.
 func finishProtoList[T, R proto.Message](
 	request *mcp.CallToolRequest,
 	items []T,
@@ -262,6 +393,18 @@ func finishProtoList[T, R proto.Message](
 		}
 	}
 
+	return marshalProtoList(items, appliedFilters, assemble)
+}
+
+// marshalProtoList is the count clamp, filter echo, and marshal step of
+// finishProtoList, for list tools that filter on arguments a string
+// listFilterParam cannot read. appliedFilters holds the name=value pairs to
+// echo, in order.
+func marshalProtoList[T, R proto.Message](
+	items []T,
+	appliedFilters []string,
+	assemble func(items []T, count int32, filter *string) R,
+) (*mcp.CallToolResult, error) {
 	var count int32
 	if n := len(items); n <= math.MaxInt32 {
 		count = int32(n)
@@ -274,7 +417,14 @@ func finishProtoList[T, R proto.Message](
 		filter = &joined
 	}
 
-	return MarshalProtoToolResponse(assemble(items, count, filter))
+	result, err := MarshalProtoToolResponse(assemble(items, count, filter))
+	if err != nil {
+		return nil, err
+	}
+
+	setEnvelopeCount(result, count)
+
+	return result, nil
 }
 
 // newProtoListTool is the proto analog of newListTool. It builds the MCP tool
@@ -300,7 +450,7 @@ func newProtoListTool[T, R proto.Message](
 	tool := mcp.NewTool(toolName, options...)
 
 	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		client, err := prepareClient(&request, cfg)
+		client, err := prepareClient(ctx, &request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
@@ -372,7 +522,7 @@ func newProtoListToolPaginated[T, R proto.Message](
 			return mcp.NewToolResultError(validationMessage), nil
 		}
 
-		client, err := prepareClient(&request, cfg)
+		client, err := prepareClient(ctx, &request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
@@ -535,7 +685,7 @@ func buildProtoSubresourceListPaginated[P comparable, T, R proto.Message](
 			return mcp.NewToolResultError(validationMessage), nil
 		}
 
-		client, err := prepareClient(&request, cfg)
+		client, err := prepareClient(ctx, &request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
@@ -666,7 +816,7 @@ func buildProtoSubresourceList[P comparable, T, R proto.Message](
 			return mcp.NewToolResultError(validationMessage), nil
 		}
 
-		client, err := prepareClient(&request, cfg)
+		client, err := prepareClient(ctx, &request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
@@ -715,7 +865,7 @@ func newProtoListToolSubresource2[T, R proto.Message](
 			return mcp.NewToolResultError(validationMessage), nil
 		}
 
-		client, err := prepareClient(&request, cfg)
+		client, err := prepareClient(ctx, &request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
@@ -771,7 +921,7 @@ func newProtoListToolSubresource2Paginated[T, R proto.Message](
 			return mcp.NewToolResultError(validationMessage), nil
 		}
 
-		client, err := prepareClient(&request, cfg)
+		client, err := prepareClient(ctx, &request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
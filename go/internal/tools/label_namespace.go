@@ -0,0 +1,302 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+// ParamNamespaceOnly is the per-call argument that limits a list tool's
+// result to resources in the environment's label_namespace. The dispatch
+// middleware reads it for every tool, so it never appears in a tool's own
+// input schema.
+const ParamNamespaceOnly = "namespace_only"
+
+// LabelNamespace is the label_namespace handling resolved for one call.
+// Filter is set when a list tool was called with namespace_only=true in an
+// environment that has a namespace.
+type LabelNamespace struct {
+	Prefix string
+	Filter bool
+}
+
+// ListPrefix returns the label prefix the call's list reads keep, or "" when
+// the call did not ask for namespace_only.
+func (n LabelNamespace) ListPrefix() string {
+	if !n.Filter {
+		return ""
+	}
+
+	return n.Prefix
+}
+
+// namespacedLabel is the argument of a create tool that names a top-level
+// resource, and the longest value the API accepts for it.
+type namespacedLabel struct {
+	param string
+	limit int
+}
+
+// namespacedCreateLabels returns the create tools whose label names a
+// top-level resource, and so gets the label_namespace prefix, with the
+// argument carrying it and the longest label the API accepts. Sub-resource
+// labels (a disk, a config profile, a backup) and names that are not labels
+// at all (a bucket, a tag) are left as given. linode_multi_region_deploy
+// builds each instance label from label_prefix, so that is what it prefixes.
+func namespacedCreateLabels() map[string]namespacedLabel {
+	return map[string]namespacedLabel{
+		"linode_instance_create":                     {param: "label", limit: 64},
+		"linode_instance_clone":                      {param: "label", limit: 64},
+		"linode_instance_replace":                    {param: "label", limit: 64},
+		"linode_multi_region_deploy":                 {param: "label_prefix", limit: instanceLabelMaxLength},
+		"linode_volume_create":                       {param: "label", limit: 32},
+		"linode_volume_clone":                        {param: "label", limit: 32},
+		"linode_nodebalancer_create":                 {param: "label", limit: 32},
+		"linode_firewall_create":                     {param: "label", limit: 32},
+		"linode_lke_cluster_create":                  {param: "label", limit: 32},
+		"linode_vpc_create":                          {param: "label", limit: 64},
+		"linode_image_create":                        {param: "label", limit: 50},
+		"linode_instance_to_image":                   {param: "label", limit: 50},
+		"linode_placement_group_create":              {param: "label", limit: 64},
+		"linode_database_mysql_instance_create":      {param: "label", limit: 32},
+		"linode_database_postgresql_instance_create": {param: "label", limit: 32},
+	}
+}
+
+// ResolveLabelNamespace looks up the label_namespace of the environment the
+// call names. The label argument of a top-level resource create is prefixed
+// with it in place, before the handler (and any dry-run preview) sees it; a
+// label that already carries the prefix is left alone, and a create without
+// a label is left to the API. A prefixed label longer than the API accepts is
+// an error, so the call fails naming the namespace instead of the API
+// rejecting a label the caller never wrote. namespace_only on a tool that is
+// not a list tool, or in an environment without a namespace, is ignored with
+// a warning.
+func ResolveLabelNamespace(ctx context.Context, cfg *config.Config, toolName string, request *mcp.CallToolRequest) (LabelNamespace, error) {
+	args := request.GetArguments()
+
+	filter := false
+
+	if raw, ok := args[ParamNamespaceOnly]; ok {
+		only, isBool := raw.(bool)
+		if !isBool {
+			AddWarning(ctx, "%s must be a boolean and was ignored", ParamNamespaceOnly)
+		}
+
+		filter = only
+	}
+
+	prefix := labelNamespacePrefix(cfg, request.GetString(paramEnvironment, ""))
+
+	if filter {
+		switch {
+		case !strings.HasSuffix(toolName, "_list"):
+			AddWarning(ctx, "%s only applies to list tools and was ignored", ParamNamespaceOnly)
+
+			filter = false
+		case prefix == "":
+			AddWarning(ctx, "%s was ignored: the environment has no label_namespace", ParamNamespaceOnly)
+
+			filter = false
+		}
+	}
+
+	if prefix == "" {
+		return LabelNamespace{}, nil
+	}
+
+	namespace := LabelNamespace{Prefix: prefix, Filter: filter}
+
+	create, isCreate := namespacedCreateLabels()[toolName]
+	if label, ok := args[create.param].(string); isCreate && ok && label != "" && !strings.HasPrefix(label, prefix) {
+		prefixed := prefix + label
+		if length := utf8.RuneCountInString(prefixed); length > create.limit {
+			return namespace, fmt.Errorf("%w: %q is %d characters, and %s accepts at most %d",
+				ErrLabelNamespaceTooLong, prefixed, length, toolName, create.limit)
+		}
+
+		args[create.param] = prefixed
+	}
+
+	return namespace, nil
+}
+
+// labelNamespacePrefix returns the label prefix of the named environment, or
+// "" when it has none or cannot be resolved (the handler reports that).
+func labelNamespacePrefix(cfg *config.Config, name string) string {
+	cfg = resolveConfig(cfg)
+	if cfg == nil {
+		return ""
+	}
+
+	resolved, err := localStoreEnvironment(cfg, name)
+	if err != nil {
+		return ""
+	}
+
+	return cfg.Environments[resolved].LabelNamespacePrefix()
+}
+
+// ApplyLabelNamespace drops the resources outside the namespace from a list
+// result when the call asked for namespace_only. Every top-level array whose
+// items all carry a label is filtered, and a top-level count and envelope
+// count that counted such an array are updated. Field order and number
+// literals are kept. The client's page walk already drops most of them (see
+// linode.WithLabelPrefix); this catches lists read some other way.
+func ApplyLabelNamespace(result *mcp.CallToolResult, namespace LabelNamespace) {
+	if result == nil || result.IsError || !namespace.Filter {
+		return
+	}
+
+	for i, content := range result.Content {
+		text, ok := content.(mcp.TextContent)
+		if !ok || !strings.HasPrefix(strings.TrimSpace(text.Text), "{") {
+			continue
+		}
+
+		filtered, before, after, err := filterLabelNamespace([]byte(text.Text), namespace.Prefix)
+		if err != nil {
+			continue
+		}
+
+		text.Text = string(filtered)
+		result.Content[i] = text
+
+		if env := EnvelopeFromResult(result); env != nil && env.Count != nil && int(*env.Count) == before && after <= math.MaxInt32 {
+			count := int32(after)
+			env.Count = &count
+		}
+	}
+}
+
+// labeledField is one top-level member of a list result, in document order.
+type labeledField struct {
+	key   string
+	value json.RawMessage
+}
+
+// filterLabelNamespace filters the labeled arrays of one list result and
+// returns it re-encoded, with the item counts of the last array it filtered
+// before and after (-1 when it filtered none).
+func filterLabelNamespace(data []byte, prefix string) ([]byte, int, int, error) {
+	fields, err := decodeOrderedObject(data)
+	if err != nil {
+		return nil, -1, -1, err
+	}
+
+	before, after := -1, -1
+
+	for i := range fields {
+		items, kept, ok := filterLabeledItems(fields[i].value, prefix)
+		if !ok {
+			continue
+		}
+
+		encoded, err := json.Marshal(kept)
+		if err != nil {
+			return nil, -1, -1, err
+		}
+
+		fields[i].value = encoded
+		before, after = len(items), len(kept)
+	}
+
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+
+	for i, field := range fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(field.key)
+		if err != nil {
+			return nil, -1, -1, err
+		}
+
+		value := field.value
+		if field.key == "count" && before >= 0 && string(bytes.TrimSpace(value)) == strconv.Itoa(before) {
+			value = json.RawMessage(strconv.Itoa(after))
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, buf.Bytes(), "", "  "); err != nil {
+		return nil, -1, -1, err
+	}
+
+	return indented.Bytes(), before, after, nil
+}
+
+// decodeOrderedObject splits a JSON object into its members in order.
+func decodeOrderedObject(data []byte) ([]labeledField, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	var fields []labeledField
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		key, _ := token.(string)
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, labeledField{key: key, value: value})
+	}
+
+	return fields, nil
+}
+
+// filterLabeledItems returns the items of a non-empty array whose every item
+// is an object with a string label, and those whose label carries prefix.
+// ok is false for anything else.
+func filterLabeledItems(value json.RawMessage, prefix string) (items, kept []json.RawMessage, ok bool) {
+	if err := json.Unmarshal(value, &items); err != nil || len(items) == 0 {
+		return nil, nil, false
+	}
+
+	kept = []json.RawMessage{}
+
+	for _, item := range items {
+		var probe struct {
+			Label *string `json:"label"`
+		}
+
+		if err := json.Unmarshal(item, &probe); err != nil || probe.Label == nil {
+			return nil, nil, false
+		}
+
+		if strings.HasPrefix(*probe.Label, prefix) {
+			kept = append(kept, item)
+		}
+	}
+
+	return items, kept, true
+}
@@ -0,0 +1,138 @@
+package tools_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func labelNamespaceConfig() *config.Config {
+	return &config.Config{Environments: map[string]config.EnvironmentConfig{
+		envKeyDefault: {Label: envLabelDefault, LabelNamespace: "team-a", Linode: config.LinodeConfig{APIURL: "https://api.linode.com/v4", Token: tokenTest}},
+	}}
+}
+
+func TestResolveLabelNamespacePrefixesCreateLabel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		tool  string
+		label string
+		want  string
+	}{
+		{name: "create", tool: "linode_volume_create", label: "data", want: "team-a-data"},
+		{name: "already prefixed", tool: "linode_volume_create", label: "team-a-data", want: "team-a-data"},
+		{name: "instance clone", tool: "linode_instance_clone", label: "web", want: "team-a-web"},
+		{name: "volume clone", tool: "linode_volume_clone", label: "data", want: "team-a-data"},
+		{name: "not a create tool", tool: "linode_volume_update", label: "data", want: "data"},
+		{name: "sub-resource create", tool: "linode_instance_disk_create", label: "boot", want: "boot"},
+		{name: "bucket name", tool: "linode_object_storage_bucket_create", label: "assets", want: "assets"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := createRequestWithArgs(t, map[string]any{"label": tt.label})
+			if _, err := tools.ResolveLabelNamespace(t.Context(), labelNamespaceConfig(), tt.tool, &req); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := req.GetString("label", ""); got != tt.want {
+				t.Errorf("label = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveLabelNamespacePrefixesDeployLabelPrefix(t *testing.T) {
+	t.Parallel()
+
+	req := createRequestWithArgs(t, map[string]any{"label_prefix": "web", "label": "ignored"})
+	if _, err := tools.ResolveLabelNamespace(t.Context(), labelNamespaceConfig(), "linode_multi_region_deploy", &req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := req.GetString("label_prefix", ""); got != "team-a-web" {
+		t.Errorf("label_prefix = %q, want %q", got, "team-a-web")
+	}
+
+	if got := req.GetString("label", ""); got != "ignored" {
+		t.Errorf("label = %q, want it left as given", got)
+	}
+}
+
+func TestResolveLabelNamespaceRejectsOverlongLabel(t *testing.T) {
+	t.Parallel()
+
+	req := createRequestWithArgs(t, map[string]any{"label": "data-volume-for-the-nightly-jobs"})
+
+	_, err := tools.ResolveLabelNamespace(t.Context(), labelNamespaceConfig(), "linode_volume_create", &req)
+	if !errors.Is(err, tools.ErrLabelNamespaceTooLong) {
+		t.Fatalf("err = %v, want %v", err, tools.ErrLabelNamespaceTooLong)
+	}
+
+	want := `label is too long with the label_namespace prefix: "team-a-data-volume-for-the-nightly-jobs" is 39 characters, and linode_volume_create accepts at most 32`
+	if err.Error() != want {
+		t.Errorf("err = %q, want %q", err.Error(), want)
+	}
+
+	if got := req.GetString("label", ""); got != "data-volume-for-the-nightly-jobs" {
+		t.Errorf("label = %q, want it left as given", got)
+	}
+}
+
+func TestApplyLabelNamespaceFiltersList(t *testing.T) {
+	t.Parallel()
+
+	req := createRequestWithArgs(t, map[string]any{tools.ParamNamespaceOnly: true})
+
+	namespace, err := tools.ResolveLabelNamespace(t.Context(), labelNamespaceConfig(), "linode_volumes_list", &req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if namespace.ListPrefix() != "team-a-" {
+		t.Fatalf("namespace.ListPrefix() = %q, want %q", namespace.ListPrefix(), "team-a-")
+	}
+
+	result := mcp.NewToolResultText(`{"count":2,"volumes":[{"id":1,"label":"team-a-data"},{"id":2,"label":"other"}]}`)
+	count := int32(2)
+	result.Meta = &mcp.Meta{AdditionalFields: map[string]any{tools.EnvelopeMetaKey: &tools.Envelope{Count: &count}}}
+
+	tools.ApplyLabelNamespace(result, namespace)
+
+	if env := tools.EnvelopeFromResult(result); env == nil || env.Count == nil || *env.Count != 1 {
+		t.Errorf("envelope count = %v, want 1", env)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	want := "{\n  \"count\": 1,\n  \"volumes\": [\n    {\n      \"id\": 1,\n      \"label\": \"team-a-data\"\n    }\n  ]\n}"
+	if textContent.Text != want {
+		t.Errorf("text = %s, want %s", textContent.Text, want)
+	}
+}
+
+func TestResolveLabelNamespaceIgnoresNamespaceOnlyOffList(t *testing.T) {
+	t.Parallel()
+
+	req := createRequestWithArgs(t, map[string]any{tools.ParamNamespaceOnly: true, "label": "data"})
+
+	namespace, err := tools.ResolveLabelNamespace(t.Context(), labelNamespaceConfig(), "linode_volume_create", &req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if namespace.Filter {
+		t.Error("namespace.Filter = true, want false")
+	}
+}
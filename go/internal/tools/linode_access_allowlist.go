@@ -0,0 +1,553 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// Allowlist targets reported in AccessAllowlistChange.target.
+const (
+	allowlistTargetLKEACL       = "lke_acl"
+	allowlistTargetFirewallRule = "firewall_rule"
+)
+
+// Update outcomes reported in AccessAllowlistUpdateResponse.status.
+const (
+	allowlistApplied = "applied"
+	allowlistPartial = "partial"
+)
+
+// allowlistDefaultRuleLabel is the inbound rule label that marks a firewall's
+// managed allowlist when the caller names none.
+const allowlistDefaultRuleLabel = "allowlist"
+
+// accessAllowlistArgs is the validated linode_access_allowlist_update input.
+// ipv4 and ipv6 hold the canonical CIDRs split by family, in request order.
+type accessAllowlistArgs struct {
+	ipv4        []string
+	ipv6        []string
+	clusterIDs  []int
+	firewallTag string
+	ruleLabel   string
+}
+
+// addresses returns the allowlist as one list, IPv4 first.
+func (a *accessAllowlistArgs) addresses() []string {
+	return slices.Concat(a.ipv4, a.ipv6)
+}
+
+// allowlistTarget is one planned change. firewall and ruleIndex locate the
+// managed rule for a firewall_rule target so its ruleset can be rewritten.
+type allowlistTarget struct {
+	change    *linodev1.AccessAllowlistChange
+	firewall  *linodev1.Firewall
+	ruleIndex int
+}
+
+// allowlistTargetState is the dry-run current_state entry for one target.
+// It is a plain struct rather than the proto change so zero values (an empty
+// address list, changed=false) still serialize.
+type allowlistTargetState struct {
+	Target       string   `json:"target"`
+	ID           int32    `json:"id"`
+	Label        string   `json:"label"`
+	OldAddresses []string `json:"old_addresses"`
+	NewAddresses []string `json:"new_addresses"`
+	Changed      bool     `json:"changed"`
+}
+
+// NewLinodeAccessAllowlistUpdateTool creates a tool that pushes one CIDR
+// allowlist to LKE control-plane ACLs and tagged Cloud Firewall rules.
+func NewLinodeAccessAllowlistUpdateTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_access_allowlist_update",
+		"Sets one IP allowlist (cidrs) everywhere it is managed: the control-plane ACL of each LKE cluster in cluster_ids (the ACL is enabled), "+
+			"and the inbound rule labeled rule_label (default \"allowlist\") on every Cloud Firewall tagged firewall_tag. "+
+			"The cidrs replace each target's addresses; targets already matching are left alone, and every change is reported with its prior addresses. "+
+			"Pass dry_run=true to preview the changes without updating.",
+		toolschemas.Schema("linode.mcp.v1.AccessAllowlistUpdateInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeAccessAllowlistUpdateRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// parseAccessAllowlistArgs validates the input, returning the parsed args or
+// an error message. Shared by the real path and the dry-run preview.
+func parseAccessAllowlistArgs(request *mcp.CallToolRequest) (*accessAllowlistArgs, string) {
+	args := &accessAllowlistArgs{
+		ipv4:        []string{},
+		ipv6:        []string{},
+		clusterIDs:  request.GetIntSlice("cluster_ids", nil),
+		firewallTag: strings.TrimSpace(request.GetString("firewall_tag", "")),
+		ruleLabel:   strings.TrimSpace(request.GetString("rule_label", "")),
+	}
+
+	if args.ruleLabel == "" {
+		args.ruleLabel = allowlistDefaultRuleLabel
+	}
+
+	cidrs := request.GetStringSlice("cidrs", nil)
+	if len(cidrs) == 0 {
+		return nil, "cidrs is required"
+	}
+
+	seen := map[string]bool{}
+
+	for idx, raw := range cidrs {
+		prefix, msg := parseAllowlistCIDR(raw)
+		if msg != "" {
+			return nil, fmt.Sprintf("cidrs[%d] %q %s", idx, raw, msg)
+		}
+
+		canonical := prefix.String()
+		if seen[canonical] {
+			continue
+		}
+
+		seen[canonical] = true
+
+		if prefix.Addr().Is4() {
+			args.ipv4 = append(args.ipv4, canonical)
+		} else {
+			args.ipv6 = append(args.ipv6, canonical)
+		}
+	}
+
+	if len(args.clusterIDs) == 0 && args.firewallTag == "" {
+		return nil, "cluster_ids or firewall_tag is required"
+	}
+
+	for idx, id := range args.clusterIDs {
+		if id <= 0 {
+			return nil, fmt.Sprintf("cluster_ids[%d] must be a positive integer", idx)
+		}
+	}
+
+	return args, ""
+}
+
+// parseAllowlistCIDR parses a CIDR or bare address (a single host). A prefix
+// with host bits set is rejected rather than silently widened or narrowed.
+func parseAllowlistCIDR(raw string) (netip.Prefix, string) {
+	raw = strings.TrimSpace(raw)
+
+	prefix, err := netip.ParsePrefix(raw)
+	if err != nil {
+		addr, addrErr := netip.ParseAddr(raw)
+		if addrErr != nil {
+			return netip.Prefix{}, "is not a valid IP address or CIDR"
+		}
+
+		prefix = netip.PrefixFrom(addr, addr.BitLen())
+	}
+
+	if masked := prefix.Masked(); masked != prefix {
+		return netip.Prefix{}, "has host bits set; use " + masked.String()
+	}
+
+	return prefix, ""
+}
+
+// canonicalAllowlistAddress normalizes a stored address for comparison, so
+// "10.0.0.1" and "10.0.0.1/32" match. Unparseable values compare as-is.
+func canonicalAllowlistAddress(raw string) string {
+	if prefix, msg := parseAllowlistCIDR(raw); msg == "" {
+		return prefix.String()
+	}
+
+	return raw
+}
+
+// sameAllowlist reports whether current holds exactly the wanted addresses,
+// ignoring order, duplicates, and /32 or /128 spelling.
+func sameAllowlist(current, wanted []string) bool {
+	have := map[string]bool{}
+	for _, address := range current {
+		have[canonicalAllowlistAddress(address)] = true
+	}
+
+	if len(have) != len(wanted) {
+		return false
+	}
+
+	for _, address := range wanted {
+		if !have[address] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// planAccessAllowlist resolves the targets and compares each against the
+// allowlist. It fails before any change when a named cluster does not exist;
+// a tagged firewall without exactly one managed rule is skipped with a warning.
+func planAccessAllowlist(ctx context.Context, client *linode.Client, args *accessAllowlistArgs) ([]*allowlistTarget, []string, error) {
+	var (
+		targets  []*allowlistTarget
+		warnings []string
+		wanted   = args.addresses()
+	)
+
+	if len(args.clusterIDs) > 0 {
+		clusters, err := client.ListLKEClustersProto(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("list LKE clusters: %w", err)
+		}
+
+		labels := make(map[int]string, len(clusters))
+		for _, cluster := range clusters {
+			labels[int(cluster.GetId())] = cluster.GetLabel()
+		}
+
+		for _, clusterID := range args.clusterIDs {
+			if _, ok := labels[clusterID]; !ok {
+				return nil, nil, fmt.Errorf("%w: %d", ErrLKEClusterNotFound, clusterID)
+			}
+		}
+
+		for _, clusterID := range args.clusterIDs {
+			label := labels[clusterID]
+
+			acl, err := client.GetLKEControlPlaneACLProto(ctx, clusterID)
+			if err != nil {
+				return nil, nil, fmt.Errorf("get control-plane ACL for cluster %d: %w", clusterID, err)
+			}
+
+			current := slices.Concat(acl.GetAddresses().GetIpv4(), acl.GetAddresses().GetIpv6())
+			if !acl.GetEnabled() {
+				warnings = append(warnings, fmt.Sprintf("The control-plane ACL of LKE cluster %d (%s) is disabled; updating it enables it, so only cidrs can reach the Kubernetes API.", clusterID, label))
+			}
+
+			targets = append(targets, &allowlistTarget{change: &linodev1.AccessAllowlistChange{
+				Target:       allowlistTargetLKEACL,
+				Id:           linodeIDToInt32(clusterID),
+				Label:        label,
+				OldAddresses: current,
+				NewAddresses: wanted,
+				Changed:      !acl.GetEnabled() || !sameAllowlist(current, wanted),
+			}})
+		}
+	}
+
+	if args.firewallTag != "" {
+		firewallTargets, firewallWarnings, err := planFirewallAllowlist(ctx, client, args, wanted)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		targets = append(targets, firewallTargets...)
+		warnings = append(warnings, firewallWarnings...)
+	}
+
+	return targets, warnings, nil
+}
+
+// planFirewallAllowlist finds the managed rule on every firewall carrying the
+// tag (matched case-insensitively, as Linode stores tags).
+func planFirewallAllowlist(ctx context.Context, client *linode.Client, args *accessAllowlistArgs, wanted []string) ([]*allowlistTarget, []string, error) {
+	firewalls, err := client.ListFirewallsProto(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list firewalls: %w", err)
+	}
+
+	var (
+		targets  []*allowlistTarget
+		warnings []string
+		tagged   int
+	)
+
+	for _, firewall := range firewalls {
+		if !slices.ContainsFunc(firewall.GetTags(), func(tag string) bool { return strings.EqualFold(tag, args.firewallTag) }) {
+			continue
+		}
+
+		tagged++
+
+		ruleIndex, matches := -1, 0
+
+		for idx, rule := range firewall.GetRules().GetInbound() {
+			if rule.GetLabel() == args.ruleLabel {
+				ruleIndex = idx
+				matches++
+			}
+		}
+
+		if matches != 1 {
+			warnings = append(warnings, fmt.Sprintf("Firewall %d (%s) has %d inbound rules labeled %q, want exactly 1; skipped.",
+				firewall.GetId(), firewall.GetLabel(), matches, args.ruleLabel))
+
+			continue
+		}
+
+		rule := firewall.GetRules().GetInbound()[ruleIndex]
+		current := slices.Concat(rule.GetAddresses().GetIpv4(), rule.GetAddresses().GetIpv6())
+
+		targets = append(targets, &allowlistTarget{
+			change: &linodev1.AccessAllowlistChange{
+				Target:       allowlistTargetFirewallRule,
+				Id:           firewall.GetId(),
+				Label:        firewall.GetLabel(),
+				OldAddresses: current,
+				NewAddresses: wanted,
+				Changed:      !sameAllowlist(current, wanted),
+			},
+			firewall:  firewall,
+			ruleIndex: ruleIndex,
+		})
+	}
+
+	if tagged == 0 {
+		warnings = append(warnings, fmt.Sprintf("No firewall is tagged %q.", args.firewallTag))
+	}
+
+	return targets, warnings, nil
+}
+
+// accessAllowlistSideEffects is the Tier B walk: one line per target that
+// would change, then a count of the targets already matching.
+func accessAllowlistSideEffects(targets []*allowlistTarget) []string {
+	sideEffects := make([]string, 0, len(targets)+1)
+	unchanged := 0
+
+	for _, target := range targets {
+		change := target.change
+		if !change.GetChanged() {
+			unchanged++
+
+			continue
+		}
+
+		old := strings.Join(change.GetOldAddresses(), ", ")
+		if old == "" {
+			old = "(none)"
+		}
+
+		sideEffects = append(sideEffects, fmt.Sprintf("%s addresses change from [%s] to [%s].",
+			allowlistTargetName(change), old, strings.Join(change.GetNewAddresses(), ", ")))
+	}
+
+	return append(sideEffects, fmt.Sprintf("%d target(s) already match and are left alone.", unchanged))
+}
+
+// allowlistTargetName describes a target for messages.
+func allowlistTargetName(change *linodev1.AccessAllowlistChange) string {
+	if change.GetTarget() == allowlistTargetLKEACL {
+		return fmt.Sprintf("LKE cluster %d (%s) control-plane ACL", change.GetId(), change.GetLabel())
+	}
+
+	return fmt.Sprintf("Firewall %d (%s) allowlist rule", change.GetId(), change.GetLabel())
+}
+
+// accessAllowlistPath is the would-execute path for the dry-run preview.
+func accessAllowlistPath(args *accessAllowlistArgs) string {
+	var paths []string
+	if len(args.clusterIDs) > 0 {
+		paths = append(paths, "/lke/clusters/{cluster_id}/control_plane_acl")
+	}
+
+	if args.firewallTag != "" {
+		paths = append(paths, "/networking/firewalls/{firewall_id}/rules")
+	}
+
+	return strings.Join(paths, " and ")
+}
+
+func handleLinodeAccessAllowlistUpdateRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if IsDryRun(request) {
+		args, msg := parseAccessAllowlistArgs(request)
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		var (
+			targets  []*allowlistTarget
+			warnings []string
+		)
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_access_allowlist_update", "PUT", accessAllowlistPath(args),
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				var err error
+
+				targets, warnings, err = planAccessAllowlist(ctx, c, args)
+				if err != nil {
+					return nil, err
+				}
+
+				states := make([]allowlistTargetState, 0, len(targets))
+				for _, target := range targets {
+					change := target.change
+					states = append(states, allowlistTargetState{
+						Target:       change.GetTarget(),
+						ID:           change.GetId(),
+						Label:        change.GetLabel(),
+						OldAddresses: append([]string{}, change.GetOldAddresses()...),
+						NewAddresses: change.GetNewAddresses(),
+						Changed:      change.GetChanged(),
+					})
+				}
+
+				return states, nil
+			},
+			func(_ context.Context, _ *linode.Client, _ any) (DryRunDetails, error) {
+				return DryRunDetails{SideEffects: accessAllowlistSideEffects(targets), Warnings: warnings}, nil
+			})
+	}
+
+	if result := RequireConfirm(request, "This replaces the allowlist on LKE control planes and firewalls. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	args, msg := parseAccessAllowlistArgs(request)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	targets, warnings, err := planAccessAllowlist(ctx, client, args)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to resolve allowlist targets: %v", err)), nil
+	}
+
+	if len(targets) == 0 {
+		return mcp.NewToolResultError("no LKE cluster or tagged firewall to update; see firewall_tag and rule_label"), nil
+	}
+
+	for _, warning := range warnings {
+		AddWarning(ctx, "%s", warning)
+	}
+
+	response := applyAccessAllowlist(ctx, client, args, targets)
+	response.Warnings = warnings
+
+	return MarshalProtoToolResponse(response)
+}
+
+// applyAccessAllowlist updates every changed target in plan order. Targets
+// are independent, so a failure is recorded on its change and the rest still
+// run; nothing is rolled back.
+func applyAccessAllowlist(ctx context.Context, client *linode.Client, args *accessAllowlistArgs, targets []*allowlistTarget) *linodev1.AccessAllowlistUpdateResponse {
+	response := &linodev1.AccessAllowlistUpdateResponse{
+		Status:  allowlistApplied,
+		Changes: make([]*linodev1.AccessAllowlistChange, 0, len(targets)),
+	}
+
+	updated, failed := 0, 0
+
+	for _, target := range targets {
+		response.Changes = append(response.Changes, target.change)
+
+		if !target.change.GetChanged() {
+			continue
+		}
+
+		var err error
+
+		if target.change.GetTarget() == allowlistTargetLKEACL {
+			_, err = client.UpdateLKEControlPlaneACLProto(ctx, int(target.change.GetId()), linode.UpdateLKEControlPlaneACLRequest{
+				ACL: linode.LKEControlPlaneACL{
+					Enabled:   true,
+					Addresses: linode.LKEControlPlaneACLAddresses{IPv4: args.ipv4, IPv6: args.ipv6},
+				},
+			})
+		} else {
+			_, err = client.UpdateFirewallRulesProto(ctx, int(target.change.GetId()), allowlistRulesReplace(target, args))
+		}
+
+		if err != nil {
+			target.change.Error = new(err.Error())
+			failed++
+
+			continue
+		}
+
+		updated++
+	}
+
+	unchanged := len(targets) - updated - failed
+	response.Message = fmt.Sprintf("Updated %d target(s); %d already matched.", updated, unchanged)
+
+	if failed > 0 {
+		response.Status = allowlistPartial
+		response.Message = fmt.Sprintf("Updated %d target(s); %d already matched; %d failed and keep their previous addresses, see changes[].error.",
+			updated, unchanged, failed)
+		AddWarning(ctx, "%s", response.GetMessage())
+	}
+
+	return response
+}
+
+// allowlistRulesReplace rebuilds the firewall's full ruleset with only the
+// managed rule's addresses swapped; every other rule is sent back unchanged.
+func allowlistRulesReplace(target *allowlistTarget, args *accessAllowlistArgs) *linode.FirewallRulesReplaceRequest {
+	rules := target.firewall.GetRules()
+	req := &linode.FirewallRulesReplaceRequest{
+		Inbound:  make([]map[string]any, 0, len(rules.GetInbound())),
+		Outbound: make([]map[string]any, 0, len(rules.GetOutbound())),
+	}
+
+	for idx, rule := range rules.GetInbound() {
+		ipv4, ipv6 := rule.GetAddresses().GetIpv4(), rule.GetAddresses().GetIpv6()
+		if idx == target.ruleIndex {
+			ipv4, ipv6 = args.ipv4, args.ipv6
+		}
+
+		req.Inbound = append(req.Inbound, allowlistRuleMap(rule, ipv4, ipv6))
+	}
+
+	for _, rule := range rules.GetOutbound() {
+		req.Outbound = append(req.Outbound, allowlistRuleMap(rule, rule.GetAddresses().GetIpv4(), rule.GetAddresses().GetIpv6()))
+	}
+
+	return req
+}
+
+// allowlistRuleMap renders a rule in the replace-request wire form, leaving
+// out the fields the API treats as absent when empty (ports on ICMP rules, an
+// unused address family, a blank description).
+func allowlistRuleMap(rule *linodev1.FirewallRule, ipv4, ipv6 []string) map[string]any {
+	addresses := map[string]any{}
+	if len(ipv4) > 0 {
+		addresses["ipv4"] = ipv4
+	}
+
+	if len(ipv6) > 0 {
+		addresses["ipv6"] = ipv6
+	}
+
+	out := map[string]any{
+		"action":    rule.GetAction(),
+		"protocol":  rule.GetProtocol(),
+		"addresses": addresses,
+		"label":     rule.GetLabel(),
+	}
+
+	if rule.GetPorts() != "" {
+		out["ports"] = rule.GetPorts()
+	}
+
+	if rule.GetDescription() != "" {
+		out["description"] = rule.GetDescription()
+	}
+
+	return out
+}
@@ -0,0 +1,123 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// An unknown cluster ID fails the whole call before any target is written.
+func TestLinodeAccessAllowlistUpdateToolUnknownCluster(t *testing.T) {
+	t.Parallel()
+
+	var writes atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writes.Add(1)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"id":10,"label":"prod"}],"page":1,"pages":1,"results":1}`))
+	}))
+	defer srv.Close()
+
+	cfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+	_, _, handler := tools.NewLinodeAccessAllowlistUpdateTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"confirm":     true,
+		"cidrs":       []any{"203.0.113.0/24"},
+		"cluster_ids": []any{float64(10), float64(99)},
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !result.IsError || !ok || !strings.Contains(text.Text, "no LKE cluster with that ID: 99") {
+		t.Errorf("result = %v, want an unknown-cluster error", result.Content)
+	}
+
+	if got := writes.Load(); got != 0 {
+		t.Errorf("writes = %d, want 0", got)
+	}
+}
+
+// A failed firewall update is reported on its change and marks the run
+// partial; the ACL update before it still lands.
+func TestLinodeAccessAllowlistUpdateToolPartialFailure(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /lke/clusters":
+			_, _ = w.Write([]byte(`{"data":[{"id":10,"label":"prod"}],"page":1,"pages":1,"results":1}`))
+		case "GET /lke/clusters/10/control_plane_acl", "PUT /lke/clusters/10/control_plane_acl":
+			_, _ = w.Write([]byte(`{"acl":{"enabled":true,"addresses":{"ipv4":[],"ipv6":[]}}}`))
+		case "GET /networking/firewalls":
+			_, _ = w.Write([]byte(`{"data":[{"id":7,"label":"edge","tags":["edge"],"rules":{"inbound":[` +
+				`{"action":"ACCEPT","protocol":"TCP","ports":"443","addresses":{"ipv4":[]},"label":"allowlist"}],"outbound":[]}}],` +
+				`"page":1,"pages":1,"results":1}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":[{"reason":"rules invalid"}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	cfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+	_, _, handler := tools.NewLinodeAccessAllowlistUpdateTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"confirm":      true,
+		"cidrs":        []any{"203.0.113.0/24"},
+		"cluster_ids":  []any{float64(10)},
+		"firewall_tag": "edge",
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want a partial result", result.Content)
+	}
+
+	var response struct {
+		Status  string `json:"status"`
+		Changes []struct {
+			Target string `json:"target"`
+			Error  string `json:"error"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if response.Status != "partial" || len(response.Changes) != 2 {
+		t.Fatalf("response = %+v, want a partial run with 2 changes", response)
+	}
+
+	if response.Changes[0].Error != "" || !strings.Contains(response.Changes[1].Error, "rules invalid") {
+		t.Errorf("changes = %+v, want only the firewall change to carry the error", response.Changes)
+	}
+}
@@ -1837,7 +1837,7 @@ func runProfilePhoneAction(
 		return result, nil
 	}
 
-	client, prepErr := prepareClient(request, cfg)
+	client, prepErr := prepareClient(ctx, request, cfg)
 	if prepErr != nil {
 		return mcp.NewToolResultError(prepErr.Error()), nil
 	}
@@ -1882,7 +1882,7 @@ func handleLinodeProfilePhoneNumberDeleteRequest(ctx context.Context, request *m
 		return result, nil
 	}
 
-	client, prepErr := prepareClient(request, cfg)
+	client, prepErr := prepareClient(ctx, request, cfg)
 	if prepErr != nil {
 		return mcp.NewToolResultError(prepErr.Error()), nil
 	}
@@ -1956,7 +1956,7 @@ func handleLinodeProfileTFADisableRequest(ctx context.Context, request *mcp.Call
 		return result, nil
 	}
 
-	client, prepErr := prepareClient(request, cfg)
+	client, prepErr := prepareClient(ctx, request, cfg)
 	if prepErr != nil {
 		return mcp.NewToolResultError(prepErr.Error()), nil
 	}
@@ -1995,7 +1995,7 @@ func handleLinodeProfileTFAEnableConfirmRequest(ctx context.Context, request *mc
 		return result, nil
 	}
 
-	client, prepErr := prepareClient(request, cfg)
+	client, prepErr := prepareClient(ctx, request, cfg)
 	if prepErr != nil {
 		return mcp.NewToolResultError(prepErr.Error()), nil
 	}
@@ -2073,7 +2073,7 @@ func handleLinodeProfileLoginGetRequest(ctx context.Context, request *mcp.CallTo
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2092,7 +2092,7 @@ func handleLinodeProfileAppGetRequest(ctx context.Context, request *mcp.CallTool
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2190,7 +2190,7 @@ func handleProfileRevokeRequest(
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2212,7 +2212,7 @@ func handleLinodeProfileDeviceGetRequest(ctx context.Context, request *mcp.CallT
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2304,7 +2304,7 @@ func handleLinodeLongviewClientUpdateRequest(ctx context.Context, request *mcp.C
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2398,7 +2398,7 @@ func handleLinodeLongviewClientDeleteRequest(ctx context.Context, request *mcp.C
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2444,7 +2444,7 @@ func handleLinodeLongviewSubscriptionGetRequest(ctx context.Context, request *mc
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2481,7 +2481,7 @@ func handleLinodeLongviewClientGetRequest(ctx context.Context, request *mcp.Call
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2509,7 +2509,7 @@ func handleLinodeAccountPaymentMethodGetRequest(ctx context.Context, request *mc
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2545,7 +2545,7 @@ func handleLinodeAccountPaymentMethodCreateRequest(ctx context.Context, request
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2609,7 +2609,7 @@ func handleLinodeProfileTFAEnableRequest(ctx context.Context, request *mcp.CallT
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2654,7 +2654,7 @@ func handleLinodeAccountPaymentMethodDeleteRequest(ctx context.Context, request
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2696,7 +2696,7 @@ func handleLinodeAccountPaymentMethodMakeDefaultRequest(ctx context.Context, req
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2735,7 +2735,7 @@ func handleLinodeAccountOAuthClientGetRequest(ctx context.Context, request *mcp.
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2780,7 +2780,7 @@ func handleLinodeAccountOAuthClientCreateRequest(ctx context.Context, request *m
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2829,7 +2829,7 @@ func handleLinodeAccountOAuthClientUpdateRequest(ctx context.Context, request *m
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2877,7 +2877,7 @@ func handleLinodeAccountOAuthClientThumbnailUpdateRequest(ctx context.Context, r
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2927,7 +2927,7 @@ func handleLinodeAccountOAuthClientThumbnailGetRequest(ctx context.Context, requ
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -2964,7 +2964,7 @@ func handleLinodeAccountOAuthClientDeleteRequest(ctx context.Context, request *m
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -3009,7 +3009,7 @@ func handleLinodeAccountOAuthClientResetSecretRequest(ctx context.Context, reque
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -3117,7 +3117,7 @@ func handleLinodeTaggedObjectsRequest(ctx context.Context, request *mcp.CallTool
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -3177,7 +3177,7 @@ func handleLinodeAccountUserGetRequest(ctx context.Context, request *mcp.CallToo
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -3196,7 +3196,7 @@ func handleLinodeProfileTokenGetRequest(ctx context.Context, request *mcp.CallTo
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -3219,7 +3219,7 @@ func handleLinodeAccountUserGrantsRequest(ctx context.Context, request *mcp.Call
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -3255,7 +3255,7 @@ func handleLinodeAccountUserGrantsUpdateRequest(ctx context.Context, request *mc
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -3307,7 +3307,7 @@ func handleLinodeAccountUserUpdateRequest(ctx context.Context, request *mcp.Call
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -3341,7 +3341,7 @@ func handleLinodeAccountUserDeleteRequest(ctx context.Context, request *mcp.Call
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -3577,7 +3577,7 @@ func handleLinodeAccountUserCreateRequest(ctx context.Context, request *mcp.Call
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -3637,7 +3637,7 @@ func handleLinodeAccountSupportTicketCreateRequest(ctx context.Context, request
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -3773,7 +3773,7 @@ func runSupportTicketSubresourceCreate(
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -3936,7 +3936,7 @@ func handleLinodeManagedContactCreateRequest(ctx context.Context, request *mcp.C
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -4098,7 +4098,7 @@ func handleLinodeAccountLoginGetRequest(ctx context.Context, request *mcp.CallTo
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -4153,7 +4153,7 @@ func handleLinodeAccountPaymentGetRequest(ctx context.Context, request *mcp.Call
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -4184,7 +4184,7 @@ func handleLinodeAccountPaymentCreateRequest(ctx context.Context, request *mcp.C
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -4214,7 +4214,7 @@ func handleLinodeAccountPromoCreditRequest(ctx context.Context, request *mcp.Cal
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -4294,7 +4294,7 @@ func handleLinodeAccountInvoiceGetRequest(ctx context.Context, request *mcp.Call
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -4349,7 +4349,7 @@ func handleLinodeAccountChildAccountGetRequest(ctx context.Context, request *mcp
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -4385,7 +4385,7 @@ func handleLinodeAccountChildAccountTokenRequest(ctx context.Context, request *m
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -4407,7 +4407,7 @@ func handleLinodeAccountServiceTransferGetRequest(ctx context.Context, request *
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -4458,7 +4458,7 @@ func runAccountTransferAction(
 		return result, nil
 	}
 
-	client, prepErr := prepareClient(request, cfg)
+	client, prepErr := prepareClient(ctx, request, cfg)
 	if prepErr != nil {
 		return mcp.NewToolResultError(prepErr.Error()), nil
 	}
@@ -4503,7 +4503,7 @@ func handleLinodeAccountEventGetRequest(ctx context.Context, request *mcp.CallTo
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -4537,7 +4537,7 @@ func handleLinodeAccountEventSeenRequest(ctx context.Context, request *mcp.CallT
 		return result, nil
 	}
 
-	client, prepErr := prepareClient(request, cfg)
+	client, prepErr := prepareClient(ctx, request, cfg)
 	if prepErr != nil {
 		return mcp.NewToolResultError(prepErr.Error()), nil
 	}
@@ -4614,7 +4614,7 @@ func handleLinodeAccountServiceTransferCreateRequest(ctx context.Context, reques
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -4746,7 +4746,7 @@ func handleLinodeBetaGetRequest(ctx context.Context, request *mcp.CallToolReques
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -4765,7 +4765,7 @@ func handleLinodeAccountBetaGetRequest(ctx context.Context, request *mcp.CallToo
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -4795,7 +4795,7 @@ func handleLinodeAccountBetaEnrollRequest(ctx context.Context, request *mcp.Call
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -4910,7 +4910,7 @@ func handleLinodeManagedCredentialUpdateRequest(ctx context.Context, request *mc
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -4955,7 +4955,7 @@ func handleLinodeManagedCredentialUsernamePasswordUpdateRequest(ctx context.Cont
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -5015,7 +5015,7 @@ func managedCredentialUsernamePasswordUpdateRequestFromTool(request *mcp.CallToo
 }
 
 func handleLinodeManagedSSHKeyRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -5042,7 +5042,7 @@ func handleLinodeManagedCredentialGetRequest(ctx context.Context, request *mcp.C
 			})
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -5073,7 +5073,7 @@ func handleLinodeManagedCredentialRevokeRequest(ctx context.Context, request *mc
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -5125,7 +5125,7 @@ func handleLinodeManagedCredentialCreateRequest(ctx context.Context, request *mc
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -5208,7 +5208,7 @@ func handleLinodeAccountAvailabilityGetRequest(ctx context.Context, request *mcp
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -5341,7 +5341,7 @@ func handleLinodeAccountAgreementsAcknowledgeRequest(ctx context.Context, reques
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -5421,7 +5421,7 @@ func handleLinodeAccountCancelRequest(ctx context.Context, request *mcp.CallTool
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -5465,7 +5465,7 @@ func handleLinodeAccountUpdateRequest(ctx context.Context, request *mcp.CallTool
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -5496,7 +5496,7 @@ func handleLinodeAccountUpdateRequest(ctx context.Context, request *mcp.CallTool
 // touch tool responses, so the model can compare current vs proposed
 // values.
 func handleLinodeAccountUpdateDryRun(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -5588,7 +5588,7 @@ func handleLinodeAccountSettingsManagedEnableRequest(ctx context.Context, reques
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -5620,7 +5620,7 @@ func handleLinodeAccountSettingsUpdateRequest(ctx context.Context, request *mcp.
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
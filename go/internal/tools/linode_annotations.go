@@ -0,0 +1,264 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/annotations"
+	"github.com/chadit/LinodeMCP/go/internal/audit"
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// NewLinodeAnnotationsSetTool returns linode_annotations_set, which stores a
+// key-value note on an instance or volume. The note lives in a local file per
+// environment, never on the resource, so the tool is CapMeta and available in
+// every profile.
+func NewLinodeAnnotationsSetTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_annotations_set",
+		"Stores a key-value annotation on an instance or volume (e.g. key=resize-reason, "+
+			"value='resized 2024-06-01 due to OOM') so later sessions can read it back with "+
+			"linode_annotations_get. Annotations are kept locally per environment and never "+
+			"change the resource. An empty value removes the annotation.",
+		toolschemas.Schema("linode.mcp.v1.AnnotationsSetInput"),
+	)
+
+	handler := func(_ context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleAnnotationsSetRequest(&request, cfg)
+	}
+
+	return tool, profiles.CapMeta, handler
+}
+
+// NewLinodeAnnotationsGetTool returns linode_annotations_get, which reads the
+// annotations linode_annotations_set stored.
+func NewLinodeAnnotationsGetTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_annotations_get",
+		"Reads annotations stored with linode_annotations_set. With resource_type and "+
+			"resource_id returns that resource's annotations; without them, every annotated "+
+			"resource in the environment. Optionally filter by key.",
+		toolschemas.Schema("linode.mcp.v1.AnnotationsGetInput"),
+	)
+
+	handler := func(_ context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleAnnotationsGetRequest(&request, cfg)
+	}
+
+	return tool, profiles.CapMeta, handler
+}
+
+// annotationsStore opens the configured annotations directory, defaulting to
+// "annotations" under the audit log directory.
+func annotationsStore(cfg *config.Config) *annotations.Store {
+	dir := resolveConfig(cfg).Annotations.Dir
+	if dir == "" {
+		dir = filepath.Join(audit.ResolveDefaultAuditDir(), "annotations")
+	}
+
+	return annotations.NewStore(dir)
+}
+
+// localStoreEnvironment resolves the environment a call names to its
+// configured name for the local stores kept per environment (annotations and
+// the offline cache), so "Production" and "production" share one file. With
+// none given it is "default", or the only configured environment when there
+// is no "default".
+func localStoreEnvironment(cfg *config.Config, name string) (string, error) {
+	cfg = resolveConfig(cfg)
+
+	if name != "" {
+		return cfg.ResolveEnvironmentName(name)
+	}
+
+	resolved, err := cfg.ResolveEnvironmentName("default")
+	if err != nil {
+		if names := cfg.EnvironmentNames(); len(names) == 1 {
+			return names[0], nil
+		}
+
+		return "", err
+	}
+
+	return resolved, nil
+}
+
+// localStorePartition returns the name a call's data is kept under in the
+// local stores kept per environment (annotations and the availability
+// watch). It is the environment itself, except under server.tokenPassthrough,
+// where tenants share one deployment: there it carries a hash of the caller's
+// Linode token, so no tenant reads or overwrites what another left.
+func localStorePartition(request *mcp.CallToolRequest, cfg *config.Config, environment string) (string, error) {
+	partition, err := tokenPartition(request, cfg)
+	if err != nil {
+		return "", err
+	}
+
+	if partition == "" {
+		return environment, nil
+	}
+
+	return environment + "@" + partition, nil
+}
+
+func handleAnnotationsSetRequest(request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	resourceType := request.GetString("resource_type", "")
+	if msg := requiredEnumChoice(request, "resource_type", linodev1.AnnotationResourceType_Value_value); msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	resourceID, msg := requiredIDArgument(request, "resource_id")
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	key := request.GetString("key", "")
+	if key == "" {
+		return mcp.NewToolResultError("key is required"), nil
+	}
+
+	if err := annotations.ValidateKey(key); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if _, exists := request.GetArguments()["value"]; !exists {
+		return mcp.NewToolResultError("value is required (pass an empty value to remove the annotation)"), nil
+	}
+
+	value := request.GetString("value", "")
+	if err := annotations.ValidateValue(value); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	environment, err := localStoreEnvironment(cfg, request.GetString("environment", ""))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	partition, err := localStorePartition(request, cfg, environment)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	ref := annotations.Ref{Type: resourceType, ID: resourceID}
+
+	entries, err := annotationsStore(cfg).Set(partition, ref, key, value)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to set annotation: %v", err)), nil
+	}
+
+	message := fmt.Sprintf("Annotation '%s' set on %s %d", key, resourceType, resourceID)
+	if value == "" {
+		message = fmt.Sprintf("Annotation '%s' removed from %s %d", key, resourceType, resourceID)
+	}
+
+	return MarshalProtoToolResponse(&linodev1.AnnotationsSetResponse{
+		Message:     message,
+		Environment: environment,
+		Resource:    resourceAnnotationsProto(ref, entries, ""),
+	})
+}
+
+func handleAnnotationsGetRequest(request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	resourceType, msg := optionalEnumChoice(request, "resource_type", linodev1.AnnotationResourceType_Value_value)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	var resourceID int
+
+	if _, exists := request.GetArguments()["resource_id"]; exists {
+		if resourceType == "" {
+			return mcp.NewToolResultError("resource_id requires resource_type"), nil
+		}
+
+		if resourceID, msg = requiredIDArgument(request, "resource_id"); msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+	}
+
+	key := request.GetString("key", "")
+
+	environment, err := localStoreEnvironment(cfg, request.GetString("environment", ""))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	partition, err := localStorePartition(request, cfg, environment)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	stored, err := annotationsStore(cfg).Get(partition)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to read annotations: %v", err)), nil
+	}
+
+	refs := make([]annotations.Ref, 0, len(stored))
+
+	for refKey := range stored {
+		ref, ok := annotations.ParseRef(refKey)
+		if !ok || (resourceType != "" && ref.Type != resourceType) || (resourceID != 0 && ref.ID != resourceID) {
+			continue
+		}
+
+		refs = append(refs, ref)
+	}
+
+	slices.SortFunc(refs, func(a, b annotations.Ref) int {
+		if c := strings.Compare(a.Type, b.Type); c != 0 {
+			return c
+		}
+
+		return a.ID - b.ID
+	})
+
+	resources := make([]*linodev1.ResourceAnnotations, 0, len(refs))
+
+	for _, ref := range refs {
+		resource := resourceAnnotationsProto(ref, stored[ref.String()], key)
+		if len(resource.GetAnnotations()) > 0 {
+			resources = append(resources, resource)
+		}
+	}
+
+	return MarshalProtoToolResponse(&linodev1.AnnotationsGetResponse{
+		Environment: environment,
+		Count:       linodeIDToInt32(len(resources)),
+		Resources:   resources,
+	})
+}
+
+// resourceAnnotationsProto converts one resource's entries, sorted by key
+// and limited to onlyKey when it is set.
+func resourceAnnotationsProto(ref annotations.Ref, entries map[string]annotations.Entry, onlyKey string) *linodev1.ResourceAnnotations {
+	resource := &linodev1.ResourceAnnotations{
+		ResourceType: ref.Type,
+		ResourceId:   linodeIDToInt32(ref.ID),
+		Annotations:  []*linodev1.Annotation{},
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(entries)) {
+		if onlyKey != "" && key != onlyKey {
+			continue
+		}
+
+		entry := entries[key]
+		resource.Annotations = append(resource.Annotations, &linodev1.Annotation{
+			Key:       key,
+			Value:     entry.Value,
+			UpdatedAt: entry.UpdatedAt,
+		})
+	}
+
+	return resource
+}
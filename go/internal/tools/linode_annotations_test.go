@@ -0,0 +1,212 @@
+package tools_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+type annotationsOutput struct {
+	Message     string `json:"message"`
+	Environment string `json:"environment"`
+	Count       int    `json:"count"`
+	Resources   []struct {
+		ResourceType string `json:"resource_type"`
+		ResourceID   int    `json:"resource_id"`
+		Annotations  []struct {
+			Key       string `json:"key"`
+			Value     string `json:"value"`
+			UpdatedAt string `json:"updated_at"`
+		} `json:"annotations"`
+	} `json:"resources"`
+}
+
+func annotationsConfig(t *testing.T) *config.Config {
+	t.Helper()
+
+	return &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault},
+		},
+		Annotations: config.AnnotationsConfig{Dir: t.TempDir()},
+	}
+}
+
+func callAnnotations(
+	t *testing.T,
+	handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error),
+	args map[string]any,
+) annotationsOutput {
+	t.Helper()
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, args))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true: %s", text.Text)
+	}
+
+	var out annotationsOutput
+	if err := json.Unmarshal([]byte(text.Text), &out); err != nil {
+		t.Fatalf("unmarshal output: %v", err)
+	}
+
+	return out
+}
+
+func TestLinodeAnnotationsToolDefinitions(t *testing.T) {
+	t.Parallel()
+
+	setTool, setCap, _ := tools.NewLinodeAnnotationsSetTool(&config.Config{})
+	getTool, getCap, _ := tools.NewLinodeAnnotationsGetTool(&config.Config{})
+
+	if setTool.Name != "linode_annotations_set" || getTool.Name != "linode_annotations_get" {
+		t.Errorf("tool names = %q, %q", setTool.Name, getTool.Name)
+	}
+
+	if setCap != profiles.CapMeta || getCap != profiles.CapMeta {
+		t.Errorf("capabilities = %v, %v, want %v", setCap, getCap, profiles.CapMeta)
+	}
+}
+
+func TestLinodeAnnotationsSetThenGet(t *testing.T) {
+	t.Parallel()
+
+	cfg := annotationsConfig(t)
+	_, _, setHandler := tools.NewLinodeAnnotationsSetTool(cfg)
+	_, _, getHandler := tools.NewLinodeAnnotationsGetTool(cfg)
+
+	set := callAnnotations(t, setHandler, map[string]any{
+		"resource_type": "instance",
+		"resource_id":   123,
+		"key":           "resize-reason",
+		"value":         "resized 2024-06-01 due to OOM",
+	})
+
+	if set.Message != "Annotation 'resize-reason' set on instance 123" || set.Environment != envKeyDefault {
+		t.Errorf("set response = %+v", set)
+	}
+
+	callAnnotations(t, setHandler, map[string]any{
+		"environment":   "DEFAULT",
+		"resource_type": "volume",
+		"resource_id":   9,
+		"key":           "owner",
+		"value":         "web team",
+	})
+
+	all := callAnnotations(t, getHandler, map[string]any{})
+	if all.Count != 2 || all.Resources[0].ResourceType != "instance" || all.Resources[1].ResourceType != "volume" {
+		t.Fatalf("get response = %+v, want the instance then the volume", all)
+	}
+
+	note := all.Resources[0].Annotations[0]
+	if note.Key != "resize-reason" || note.Value != "resized 2024-06-01 due to OOM" || note.UpdatedAt == "" {
+		t.Errorf("instance annotation = %+v", note)
+	}
+
+	one := callAnnotations(t, getHandler, map[string]any{"resource_type": "volume", "resource_id": 9})
+	if one.Count != 1 || one.Resources[0].ResourceID != 9 {
+		t.Errorf("filtered get = %+v, want volume 9 only", one)
+	}
+
+	byKey := callAnnotations(t, getHandler, map[string]any{"key": "owner"})
+	if byKey.Count != 1 || byKey.Resources[0].Annotations[0].Value != "web team" {
+		t.Errorf("key-filtered get = %+v, want only the owner note", byKey)
+	}
+}
+
+func TestLinodeAnnotationsSetEmptyValueRemoves(t *testing.T) {
+	t.Parallel()
+
+	cfg := annotationsConfig(t)
+	_, _, setHandler := tools.NewLinodeAnnotationsSetTool(cfg)
+	_, _, getHandler := tools.NewLinodeAnnotationsGetTool(cfg)
+
+	args := map[string]any{"resource_type": "instance", "resource_id": 1, "key": "note", "value": "temporary"}
+	callAnnotations(t, setHandler, args)
+
+	args["value"] = ""
+
+	removed := callAnnotations(t, setHandler, args)
+	if removed.Message != "Annotation 'note' removed from instance 1" {
+		t.Errorf("message = %q", removed.Message)
+	}
+
+	if got := callAnnotations(t, getHandler, map[string]any{}); got.Count != 0 {
+		t.Errorf("get after removal = %+v, want no resources", got)
+	}
+}
+
+// TestLinodeAnnotationsSplitByPassthroughToken checks that under token
+// passthrough each caller's token gets its own notes, and that a call without
+// a token reads nothing.
+func TestLinodeAnnotationsSplitByPassthroughToken(t *testing.T) {
+	t.Parallel()
+
+	cfg := annotationsConfig(t)
+	cfg.Server.TokenPassthrough = true
+	_, _, setHandler := tools.NewLinodeAnnotationsSetTool(cfg)
+	_, _, getHandler := tools.NewLinodeAnnotationsGetTool(cfg)
+
+	call := func(handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), token string, args map[string]any) *mcp.CallToolResult {
+		request := createRequestWithArgs(t, args)
+		request.Header = http.Header{}
+		request.Header.Set(tools.TokenHeader, token)
+
+		result, err := handler(t.Context(), request)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		return result
+	}
+
+	call(setHandler, "alice-token", map[string]any{
+		"resource_type": "instance", "resource_id": 123, "key": "owner", "value": "alice",
+	})
+
+	counts := map[string]int{}
+
+	for _, token := range []string{"alice-token", "bob-token"} {
+		text, ok := call(getHandler, token, map[string]any{}).Content[0].(mcp.TextContent)
+		if !ok {
+			t.Fatal("ok = false, want true")
+		}
+
+		var out annotationsOutput
+		if err := json.Unmarshal([]byte(text.Text), &out); err != nil {
+			t.Fatalf("unmarshal output: %v", err)
+		}
+
+		if out.Environment != envKeyDefault {
+			t.Errorf("environment = %q, want %q", out.Environment, envKeyDefault)
+		}
+
+		counts[token] = out.Count
+	}
+
+	if counts["alice-token"] != 1 || counts["bob-token"] != 0 {
+		t.Errorf("note counts = %v, want alice 1 and bob 0", counts)
+	}
+
+	missing := call(getHandler, "", map[string]any{})
+	if text, ok := missing.Content[0].(mcp.TextContent); !missing.IsError || !ok || !strings.Contains(text.Text, tools.TokenHeader) {
+		t.Errorf("result without a token = %+v, want an error naming %s", missing.Content, tools.TokenHeader)
+	}
+}
@@ -0,0 +1,241 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/appinfo"
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/statuspage"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+const (
+	apiStatusDefaultWindow = 15
+	apiStatusMaxWindow     = 1440
+	apiStatusMaxEndpoints  = 3
+	apiStatusOtherProduct  = "Other"
+)
+
+// apiStatusProduct ties an API path prefix to the words the status page uses
+// for that product. Order matters: the first matching prefix wins, so the
+// narrower /networking/firewalls comes before /networking.
+type apiStatusProduct struct {
+	name     string
+	prefix   string
+	keywords []string
+}
+
+var apiStatusProducts = []apiStatusProduct{
+	{name: "Object Storage", prefix: "/object-storage", keywords: []string{"object storage"}},
+	{name: "LKE", prefix: "/lke", keywords: []string{"kubernetes", "lke"}},
+	{name: "NodeBalancers", prefix: "/nodebalancers", keywords: []string{"nodebalancer"}},
+	{name: "DNS", prefix: "/domains", keywords: []string{"dns", "domain"}},
+	{name: "Managed Databases", prefix: "/databases", keywords: []string{"database"}},
+	{name: "Block Storage", prefix: "/volumes", keywords: []string{"block storage", "volume"}},
+	{name: "Cloud Firewall", prefix: "/networking/firewalls", keywords: []string{"firewall"}},
+	{name: "Networking", prefix: "/networking", keywords: []string{"network"}},
+	{name: "VPC", prefix: "/vpcs", keywords: []string{"vpc"}},
+	{name: "Images", prefix: "/images", keywords: []string{"image"}},
+	{name: "Compute", prefix: "/linode", keywords: []string{"compute", "linodes", "backup"}},
+	{name: "Monitor", prefix: "/monitor", keywords: []string{"monitor", "longview"}},
+}
+
+// apiStatusAPIWord matches an incident about the API itself, which every
+// failed request may be explained by.
+var apiStatusAPIWord = regexp.MustCompile(`(?i)\bapi\b`)
+
+// NewLinodeAPIStatusTool creates a tool that lines up Linode's status page
+// incidents with the API requests this server recently saw fail.
+func NewLinodeAPIStatusTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_api_status",
+		"Reads the Linode status page (status_page.url) and lines its open incidents up with the Linode API "+
+			"requests this server saw fail recently (no response, 429, or 5xx), grouped by product, so a run of "+
+			"failures can be put down to an incident instead of retried blindly. The failure record lives in "+
+			"server memory; a restart clears it.",
+		toolschemas.Schema("linode.mcp.v1.APIStatusInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeAPIStatusRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapMeta, handler
+}
+
+func handleLinodeAPIStatusRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	window, msg := optionalPaginationInt(request.GetArguments(), "window_minutes", 1, apiStatusMaxWindow)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	if window == 0 {
+		window = apiStatusDefaultWindow
+	}
+
+	var records []linode.APIErrorRecord
+	if log := linode.APIErrorLogFromContext(ctx); log != nil {
+		records = log.Since(time.Now().Add(-time.Duration(window) * time.Minute))
+	}
+
+	response := &linodev1.APIStatusResponse{
+		WindowMinutes: linodeIDToInt32(window),
+		RecentErrors:  linodeIDToInt32(len(records)),
+		ErrorGroups:   apiStatusErrorGroups(records),
+	}
+
+	url := config.DefaultStatusPageURL
+	if live := resolveConfig(cfg); live != nil && live.StatusPage.URL != "" {
+		url = live.StatusPage.URL
+	}
+
+	summary, err := statuspage.Fetch(ctx, nil, url, "LinodeMCP/"+appinfo.Get().Version)
+	if err != nil {
+		response.StatusError = new(err.Error())
+		response.Message = fmt.Sprintf("Could not read the Linode status page (%v); this server saw %d failed API requests in the last %d minutes",
+			err, len(records), window)
+
+		return MarshalProtoToolResponse(response)
+	}
+
+	response.Indicator = new(summary.Indicator)
+	response.Description = new(summary.Description)
+	matched := map[int]bool{}
+
+	for i := range summary.Incidents {
+		entry := apiStatusIncident(&summary.Incidents[i])
+
+		for idx, record := range records {
+			if slices.Contains(entry.GetProducts(), apiStatusProductFor(record.Endpoint)) {
+				entry.MatchedErrors++
+				matched[idx] = true
+			}
+		}
+
+		response.Incidents = append(response.Incidents, entry)
+	}
+
+	response.Message = apiStatusMessage(response, len(matched), window)
+
+	return MarshalProtoToolResponse(response)
+}
+
+// apiStatusProductFor names the API area a request path belongs to.
+func apiStatusProductFor(endpoint string) string {
+	for _, product := range apiStatusProducts {
+		if endpoint == product.prefix || strings.HasPrefix(endpoint, product.prefix+"/") {
+			return product.name
+		}
+	}
+
+	return apiStatusOtherProduct
+}
+
+// apiStatusIncident maps an incident onto the wire, naming the API areas its
+// name and components mention. An incident about the API itself covers
+// every area, Other included.
+func apiStatusIncident(incident *statuspage.Incident) *linodev1.APIStatusIncident {
+	entry := &linodev1.APIStatusIncident{
+		Name:       incident.Name,
+		Status:     incident.Status,
+		Impact:     incident.Impact,
+		Url:        incident.ShortLink,
+		CreatedAt:  incident.CreatedAt,
+		UpdatedAt:  incident.UpdatedAt,
+		Components: incident.Components,
+		Products:   []string{},
+	}
+
+	if incident.LatestUpdate != "" {
+		entry.LatestUpdate = new(incident.LatestUpdate)
+	}
+
+	text := strings.ToLower(incident.Name + "\n" + strings.Join(incident.Components, "\n"))
+	everything := apiStatusAPIWord.MatchString(text)
+
+	for _, product := range apiStatusProducts {
+		if everything || slices.ContainsFunc(product.keywords, func(keyword string) bool { return strings.Contains(text, keyword) }) {
+			entry.Products = append(entry.Products, product.name)
+		}
+	}
+
+	if everything {
+		entry.Products = append(entry.Products, apiStatusOtherProduct)
+	}
+
+	return entry
+}
+
+// apiStatusErrorGroups groups failed requests by API area, busiest first.
+func apiStatusErrorGroups(records []linode.APIErrorRecord) []*linodev1.APIStatusErrorGroup {
+	groups := []*linodev1.APIStatusErrorGroup{}
+	byProduct := map[string]*linodev1.APIStatusErrorGroup{}
+
+	for _, record := range records {
+		name := apiStatusProductFor(record.Endpoint)
+
+		group, ok := byProduct[name]
+		if !ok {
+			group = &linodev1.APIStatusErrorGroup{Product: name, Statuses: []int32{}, Endpoints: []string{}}
+			byProduct[name] = group
+			groups = append(groups, group)
+		}
+
+		group.Count++
+		group.LastAt = record.At.UTC().Format(time.RFC3339)
+
+		status := linodeIDToInt32(record.Status)
+		if !slices.Contains(group.Statuses, status) {
+			group.Statuses = append(group.Statuses, status)
+		}
+
+		endpoint := record.Method + " " + record.Endpoint
+		if len(group.Endpoints) < apiStatusMaxEndpoints && !slices.Contains(group.Endpoints, endpoint) {
+			group.Endpoints = append(group.Endpoints, endpoint)
+		}
+	}
+
+	slices.SortStableFunc(groups, func(a, b *linodev1.APIStatusErrorGroup) int {
+		return int(b.GetCount() - a.GetCount())
+	})
+
+	return groups
+}
+
+func apiStatusMessage(response *linodev1.APIStatusResponse, matched, window int) string {
+	total := int(response.GetRecentErrors())
+	incidents := response.GetIncidents()
+
+	names := make([]string, 0, len(incidents))
+	for _, incident := range incidents {
+		names = append(names, fmt.Sprintf("%s (%s)", incident.GetName(), incident.GetImpact()))
+	}
+
+	switch {
+	case len(incidents) == 0 && total == 0:
+		return fmt.Sprintf("Linode reports %s with no open incidents, and this server saw no failed API requests in the last %d minutes",
+			response.GetDescription(), window)
+	case len(incidents) == 0:
+		return fmt.Sprintf("Linode reports %s with no open incidents, so the %d failed API requests in the last %d minutes are not explained by an outage",
+			response.GetDescription(), total, window)
+	case matched > 0:
+		return fmt.Sprintf("%d of %d failed API requests in the last %d minutes match an open Linode incident: %s",
+			matched, total, window, strings.Join(names, "; "))
+	case total == 0:
+		return fmt.Sprintf("Linode reports %d open incidents, and this server saw no failed API requests in the last %d minutes: %s",
+			len(incidents), window, strings.Join(names, "; "))
+	default:
+		return fmt.Sprintf("Linode reports %d open incidents, none matching the %d failed API requests in the last %d minutes: %s",
+			len(incidents), total, window, strings.Join(names, "; "))
+	}
+}
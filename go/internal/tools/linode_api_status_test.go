@@ -0,0 +1,114 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeAPIStatusMatchesIncidentToErrors(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"status":{"indicator":"minor","description":"Minor Service Outage"},` +
+			`"incidents":[{"name":"Connectivity Issue","status":"investigating","impact":"minor",` +
+			`"shortlink":"https://stspg.io/x","components":[{"name":"Object Storage - US-East (Newark)"}],` +
+			`"incident_updates":[{"body":" We are investigating. "}]}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	log := linode.NewAPIErrorLog(0)
+	log.Add(linode.APIErrorRecord{At: time.Now(), Method: http.MethodGet, Endpoint: "/object-storage/buckets", Status: http.StatusServiceUnavailable})
+	log.Add(linode.APIErrorRecord{At: time.Now(), Method: http.MethodGet, Endpoint: "/linode/instances", Status: http.StatusBadGateway})
+	log.Add(linode.APIErrorRecord{At: time.Now().Add(-time.Hour), Method: http.MethodGet, Endpoint: "/volumes", Status: 0})
+
+	_, _, handler := tools.NewLinodeAPIStatusTool(&config.Config{StatusPage: config.StatusPageConfig{URL: srv.URL}})
+
+	result, err := handler(linode.WithAPIErrorLog(t.Context(), log), createRequestWithArgs(t, map[string]any{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %s", textContent.Text)
+	}
+
+	var response struct {
+		Message      string `json:"message"`
+		RecentErrors int    `json:"recent_errors"`
+		Incidents    []struct {
+			Products      []string `json:"products"`
+			MatchedErrors int      `json:"matched_errors"`
+			LatestUpdate  string   `json:"latest_update"`
+		} `json:"incidents"`
+		ErrorGroups []struct {
+			Product string `json:"product"`
+			Count   int    `json:"count"`
+		} `json:"error_groups"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "1 of 2 failed API requests in the last 15 minutes match an open Linode incident: Connectivity Issue (minor)"
+	if response.Message != want {
+		t.Errorf("message = %q, want %q", response.Message, want)
+	}
+
+	if len(response.Incidents) != 1 || response.Incidents[0].MatchedErrors != 1 ||
+		len(response.Incidents[0].Products) != 1 || response.Incidents[0].Products[0] != "Object Storage" ||
+		response.Incidents[0].LatestUpdate != "We are investigating." {
+		t.Errorf("incidents = %+v, want one Object Storage incident matching one error", response.Incidents)
+	}
+
+	if response.RecentErrors != 2 || len(response.ErrorGroups) != 2 {
+		t.Errorf("recent_errors = %d, error_groups = %+v, want 2 errors in 2 groups", response.RecentErrors, response.ErrorGroups)
+	}
+}
+
+func TestLinodeAPIStatusReportsUnreachableStatusPage(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(srv.Close)
+
+	_, _, handler := tools.NewLinodeAPIStatusTool(&config.Config{StatusPage: config.StatusPageConfig{URL: srv.URL}})
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"window_minutes": float64(30)}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %s", textContent.Text)
+	}
+
+	var response map[string]any
+	if err := json.Unmarshal([]byte(textContent.Text), &response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if response["status_error"] == nil || response["window_minutes"] != float64(30) {
+		t.Errorf("response = %v, want a status_error over a 30 minute window", response)
+	}
+}
@@ -39,6 +39,7 @@ func auditEventProto(event *audit.Event) (*linodev1.AuditEvent, error) {
 		LinodemcpVersion:     event.LinodemcpVersion,
 		SessionId:            event.SessionID,
 		CredentialGeneration: event.CredentialGeneration,
+		MonthlyCostDeltaUsd:  event.MonthlyCostDeltaUSD,
 	}, nil
 }
 
@@ -0,0 +1,204 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/availwatch"
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+const (
+	availabilityStatusAvailable = "available"
+	availabilityStatusSoldOut   = "sold_out"
+	availabilityStatusUnlisted  = "unlisted"
+	availabilityWatchMaxPlans   = 25
+)
+
+type availabilityWatchCtxKey struct{}
+
+// WithAvailabilityWatch attaches the server's watch store to a context so
+// linode_availability_watch can compare each call with the one before it.
+// The server middleware sets it on every call.
+func WithAvailabilityWatch(ctx context.Context, store *availwatch.Store) context.Context {
+	return context.WithValue(ctx, availabilityWatchCtxKey{}, store)
+}
+
+// availabilityWatchFromContext returns the watch store the server attached,
+// or nil.
+func availabilityWatchFromContext(ctx context.Context) *availwatch.Store {
+	store, _ := ctx.Value(availabilityWatchCtxKey{}).(*availwatch.Store)
+
+	return store
+}
+
+// NewLinodeAvailabilityWatchTool creates a tool that records whether plans
+// are sold out in a region and reports what changed since the last call.
+func NewLinodeAvailabilityWatchTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_availability_watch",
+		"Watches whether plans (for example premium or GPU types) are sold out in a region: records each plan's "+
+			"current status and, on later calls with the same region and plans, reports which changed and since "+
+			"when. Observations live in server memory; a restart starts the watch over.",
+		toolschemas.Schema("linode.mcp.v1.AvailabilityWatchInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeAvailabilityWatchRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+// availabilityWatchPlansFromTool reads the plans to watch, dropping
+// repeats while keeping their order.
+func availabilityWatchPlansFromTool(request *mcp.CallToolRequest) ([]string, string) {
+	raw := request.GetStringSlice("plans", nil)
+	if len(raw) == 0 {
+		return nil, "plans is required"
+	}
+
+	plans := make([]string, 0, len(raw))
+	seen := map[string]bool{}
+
+	for idx, plan := range raw {
+		plan = strings.TrimSpace(plan)
+		if err := validateRegionSlug(plan); err != nil {
+			return nil, fmt.Sprintf("plans[%d] %q is not a plan ID such as g6-dedicated-2", idx, plan)
+		}
+
+		if seen[plan] {
+			continue
+		}
+
+		seen[plan] = true
+
+		plans = append(plans, plan)
+	}
+
+	if len(plans) > availabilityWatchMaxPlans {
+		return nil, fmt.Sprintf("plans accepts at most %d plans", availabilityWatchMaxPlans)
+	}
+
+	return plans, ""
+}
+
+func handleLinodeAvailabilityWatchRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	regionID, msg := regionAvailabilityRegionIDFromTool(request)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	plans, msg := availabilityWatchPlansFromTool(request)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	store := availabilityWatchFromContext(ctx)
+	if store == nil {
+		return mcp.NewToolResultError("linode_availability_watch needs the server's watch store, which this call does not have"), nil
+	}
+
+	environment, err := localStoreEnvironment(cfg, request.GetString(paramEnvironment, ""))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	partition, err := localStorePartition(request, cfg, environment)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	availability, msg := getRegionAvailability(ctx, client, regionID)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	statuses := make(map[string]string, len(availability))
+
+	for _, entry := range availability {
+		status := availabilityStatusSoldOut
+		if entry.GetAvailable() {
+			status = availabilityStatusAvailable
+		}
+
+		statuses[entry.GetPlan()] = status
+	}
+
+	response := &linodev1.AvailabilityWatchResponse{RegionId: regionID}
+	first := 0
+	changes := make([]string, 0, len(plans))
+
+	for _, plan := range plans {
+		key := availwatch.Key{Environment: partition, Region: regionID, Plan: plan}
+		if request.GetBool("reset", false) {
+			store.Forget(key)
+		}
+
+		status, listed := statuses[plan]
+		if !listed {
+			status = availabilityStatusUnlisted
+		}
+
+		current, previous, seen := store.Record(key, status)
+		entry := &linodev1.AvailabilityWatchPlan{
+			Plan:   plan,
+			Status: status,
+			Since:  current.Since.Format(time.RFC3339),
+		}
+
+		response.CheckedAt = current.CheckedAt.Format(time.RFC3339)
+
+		if !seen {
+			first++
+		} else {
+			entry.PreviousStatus = new(previous.Status)
+			entry.LastChecked = new(previous.CheckedAt.Format(time.RFC3339))
+			entry.Changed = previous.Status != status
+		}
+
+		if entry.GetChanged() {
+			response.Changed++
+
+			changes = append(changes, fmt.Sprintf("%s %s -> %s", plan, previous.Status, status))
+		}
+
+		response.Plans = append(response.Plans, entry)
+	}
+
+	response.Message = availabilityWatchMessage(response, regionID, first, changes)
+
+	return MarshalProtoToolResponse(response)
+}
+
+func availabilityWatchMessage(response *linodev1.AvailabilityWatchResponse, regionID string, first int, changes []string) string {
+	plans := response.GetPlans()
+
+	switch {
+	case first == len(plans):
+		current := make([]string, 0, len(plans))
+		for _, plan := range plans {
+			current = append(current, plan.GetPlan()+" "+plan.GetStatus())
+		}
+
+		return fmt.Sprintf("Watching %d plans in %s: %s; call again with the same region and plans to see what changed",
+			len(plans), regionID, strings.Join(current, ", "))
+	case len(changes) > 0:
+		return fmt.Sprintf("%d of %d plans in %s changed since the last check: %s",
+			len(changes), len(plans), regionID, strings.Join(changes, ", "))
+	default:
+		return fmt.Sprintf("No change for %d plans in %s since the last check", len(plans), regionID)
+	}
+}
@@ -0,0 +1,93 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/availwatch"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeAvailabilityWatchReportsChanges(t *testing.T) {
+	t.Parallel()
+
+	var soldOut atomic.Bool
+
+	soldOut.Store(true)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/regions/us-ord/availability" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body := `[{"region":"us-ord","plan":"g1-gpu-rtx6000-1","available":true}]`
+		if soldOut.Load() {
+			body = `[{"region":"us-ord","plan":"g1-gpu-rtx6000-1","available":false}]`
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	_, _, handler := tools.NewLinodeAvailabilityWatchTool(newTestConfig(srv.URL))
+	ctx := tools.WithAvailabilityWatch(t.Context(), availwatch.NewStore())
+	args := map[string]any{"region_id": "us-ord", "plans": []any{"g1-gpu-rtx6000-1", "g6-dedicated-2"}}
+
+	type watchResult struct {
+		Message string `json:"message"`
+		Changed int    `json:"changed"`
+		Plans   []struct {
+			Plan           string  `json:"plan"`
+			Status         string  `json:"status"`
+			PreviousStatus *string `json:"previous_status"`
+			Changed        bool    `json:"changed"`
+		} `json:"plans"`
+	}
+
+	watch := func() watchResult {
+		t.Helper()
+
+		result, err := handler(ctx, createRequestWithArgs(t, args))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		text, ok := result.Content[0].(mcp.TextContent)
+		if !ok || result.IsError {
+			t.Fatalf("result = %v, want a watch report", result.Content)
+		}
+
+		var got watchResult
+		if err := json.Unmarshal([]byte(text.Text), &got); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		return got
+	}
+
+	first := watch()
+	if first.Changed != 0 || first.Plans[0].PreviousStatus != nil || first.Plans[1].Status != "unlisted" {
+		t.Errorf("first watch = %+v, want no previous status and g6-dedicated-2 unlisted", first)
+	}
+
+	soldOut.Store(false)
+
+	second := watch()
+	if second.Changed != 1 || !second.Plans[0].Changed || second.Plans[0].Status != "available" || second.Plans[1].Changed {
+		t.Errorf("second watch = %+v, want only g1-gpu-rtx6000-1 changed to available", second)
+	}
+
+	want := "1 of 2 plans in us-ord changed since the last check: g1-gpu-rtx6000-1 sold_out -> available"
+	if second.Message != want {
+		t.Errorf("message = %q, want %q", second.Message, want)
+	}
+}
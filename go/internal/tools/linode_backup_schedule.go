@@ -0,0 +1,259 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+const (
+	backupAuditMaxAgeDefault = 48
+	backupAuditMaxAgeLimit   = 8760
+)
+
+// Issues linode_backup_schedule_audit reports for an instance.
+const (
+	backupIssueDisabled     = "backups_disabled"
+	backupIssueNoSuccessful = "no_successful_backup"
+	backupIssueStale        = "stale_backup"
+)
+
+// backupScheduleState is the linode_backup_schedule_update dry-run
+// current_state.
+type backupScheduleState struct {
+	LinodeID       int32  `json:"linode_id"`
+	Label          string `json:"label"`
+	BackupsEnabled bool   `json:"backups_enabled"`
+	Day            string `json:"day"`
+	Window         string `json:"window"`
+}
+
+// NewLinodeBackupScheduleUpdateTool creates a tool that sets the backup day
+// and window of one instance.
+func NewLinodeBackupScheduleUpdateTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_backup_schedule_update",
+		"Sets when a Linode instance is backed up: day is the weekly backup's day and window the two-hour UTC window "+
+			"(W0 = 00:00-02:00 through W22) the daily backup starts in. Pass either or both; the other keeps its value. "+
+			"Backups must already be enabled (linode_instance_backups_enable). Pass dry_run=true to preview.",
+		toolschemas.Schema("linode.mcp.v1.BackupScheduleUpdateInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeBackupScheduleUpdateRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// NewLinodeBackupScheduleAuditTool creates a tool that lists the instances
+// whose backups are off or behind.
+func NewLinodeBackupScheduleAuditTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_backup_schedule_audit",
+		"Lists the Linode instances whose backups need attention: backups disabled, enabled but with no successful "+
+			"backup yet, or a last successful backup at least max_age_hours old (default 48). Each finding carries the "+
+			"instance's backup schedule and last successful backup time.",
+		toolschemas.Schema("linode.mcp.v1.BackupScheduleAuditInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeBackupScheduleAuditRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+// parseBackupScheduleArgs validates the input, returning the instance ID and
+// the schedule to send, or an error message. Shared by the real path and the
+// dry-run preview.
+func parseBackupScheduleArgs(request *mcp.CallToolRequest) (int, linode.BackupScheduleUpdate, string) {
+	linodeID, msg := requiredIDArgument(request, paramLinodeID)
+	if msg != "" {
+		return 0, linode.BackupScheduleUpdate{}, msg
+	}
+
+	day, msg := optionalEnumChoice(request, "day", linodev1.BackupScheduleDay_Value_value)
+	if msg != "" {
+		return 0, linode.BackupScheduleUpdate{}, msg
+	}
+
+	window, msg := optionalEnumChoice(request, "window", linodev1.BackupScheduleWindow_Value_value)
+	if msg != "" {
+		return 0, linode.BackupScheduleUpdate{}, msg
+	}
+
+	if day == "" && window == "" {
+		return 0, linode.BackupScheduleUpdate{}, "day or window is required"
+	}
+
+	return linodeID, linode.BackupScheduleUpdate{Day: day, Window: window}, ""
+}
+
+// backupScheduleAfter is the schedule once update is applied to current.
+func backupScheduleAfter(current *linodev1.Schedule, update linode.BackupScheduleUpdate) *linodev1.Schedule {
+	after := &linodev1.Schedule{Day: current.GetDay(), Window: current.GetWindow()}
+	if update.Day != "" {
+		after.Day = update.Day
+	}
+
+	if update.Window != "" {
+		after.Window = update.Window
+	}
+
+	return after
+}
+
+func handleLinodeBackupScheduleUpdateRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if IsDryRun(request) {
+		linodeID, update, msg := parseBackupScheduleArgs(request)
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_backup_schedule_update", "PUT",
+			fmt.Sprintf("/linode/instances/%d", linodeID),
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				instance, err := c.GetInstanceProto(ctx, linodeID)
+				if err != nil {
+					return nil, err
+				}
+
+				return backupScheduleState{
+					LinodeID:       instance.GetId(),
+					Label:          instance.GetLabel(),
+					BackupsEnabled: instance.GetBackups().GetEnabled(),
+					Day:            instance.GetBackups().GetSchedule().GetDay(),
+					Window:         instance.GetBackups().GetSchedule().GetWindow(),
+				}, nil
+			},
+			func(_ context.Context, _ *linode.Client, state any) (DryRunDetails, error) {
+				current, _ := state.(backupScheduleState)
+				after := backupScheduleAfter(&linodev1.Schedule{Day: current.Day, Window: current.Window}, update)
+
+				details := DryRunDetails{SideEffects: []string{fmt.Sprintf("The backup schedule of Linode %s (%d) changes from %s %s to %s %s.",
+					current.Label, linodeID, current.Day, current.Window, after.GetDay(), after.GetWindow())}}
+				if !current.BackupsEnabled {
+					details.Warnings = []string{fmt.Sprintf("Backups are not enabled on Linode %d; the call would fail.", linodeID)}
+				}
+
+				return details, nil
+			})
+	}
+
+	if result := RequireConfirm(request, "This changes the instance's backup schedule. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	linodeID, update, msg := parseBackupScheduleArgs(request)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	before, err := client.GetInstanceProto(ctx, linodeID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to update backup schedule: %v", err)), nil
+	}
+
+	if !before.GetBackups().GetEnabled() {
+		return mcp.NewToolResultError(fmt.Sprintf("Backups are not enabled on Linode %d; enable them with linode_instance_backups_enable first", linodeID)), nil
+	}
+
+	instance, err := client.UpdateInstanceProto(ctx, linodeID, &linode.UpdateInstanceRequest{Backups: &linode.UpdateInstanceBackups{Schedule: update}})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to update backup schedule: %v", err)), nil
+	}
+
+	schedule := &linodev1.Schedule{Day: instance.GetBackups().GetSchedule().GetDay(), Window: instance.GetBackups().GetSchedule().GetWindow()}
+
+	return MarshalProtoToolResponse(&linodev1.BackupScheduleUpdateResponse{
+		Message: fmt.Sprintf("Backup schedule of Linode %s (%d) set to %s %s",
+			instance.GetLabel(), linodeID, schedule.GetDay(), schedule.GetWindow()),
+		LinodeId:         linodeIDToInt32(linodeID),
+		Label:            instance.GetLabel(),
+		PreviousSchedule: &linodev1.Schedule{Day: before.GetBackups().GetSchedule().GetDay(), Window: before.GetBackups().GetSchedule().GetWindow()},
+		Schedule:         schedule,
+	})
+}
+
+func handleLinodeBackupScheduleAuditRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	maxAge := backupAuditMaxAgeDefault
+	if _, exists := request.GetArguments()["max_age_hours"]; exists {
+		value, msg := boundedIntArgument(request, "max_age_hours", 1, backupAuditMaxAgeLimit,
+			fmt.Sprintf("max_age_hours must be from 1 through %d", backupAuditMaxAgeLimit))
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		maxAge = value
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	instances, err := client.ListInstancesProto(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to audit backups: %v", err)), nil
+	}
+
+	return MarshalProtoToolResponse(backupScheduleAudit(instances, time.Now().UTC(), maxAge))
+}
+
+// backupScheduleAudit builds the audit as of now. A last successful backup
+// whose finish time does not parse is not judged stale.
+func backupScheduleAudit(instances []*linodev1.Instance, now time.Time, maxAge int) *linodev1.BackupScheduleAuditResponse {
+	response := &linodev1.BackupScheduleAuditResponse{
+		Checked:     linodeIDToInt32(len(instances)),
+		MaxAgeHours: linodeIDToInt32(maxAge),
+		Findings:    []*linodev1.BackupScheduleFinding{},
+	}
+
+	for _, instance := range instances {
+		backups := instance.GetBackups()
+
+		finding := &linodev1.BackupScheduleFinding{
+			LinodeId:       instance.GetId(),
+			Label:          instance.GetLabel(),
+			Region:         instance.GetRegion(),
+			Schedule:       &linodev1.Schedule{Day: backups.GetSchedule().GetDay(), Window: backups.GetSchedule().GetWindow()},
+			LastSuccessful: backups.GetLastSuccessful().GetFinished(),
+		}
+
+		switch {
+		case !backups.GetEnabled():
+			finding.Issue = backupIssueDisabled
+		case finding.GetLastSuccessful() == "":
+			finding.Issue = backupIssueNoSuccessful
+		default:
+			finished, err := time.Parse(linodeTimeLayout, finding.GetLastSuccessful())
+			if err != nil || now.Sub(finished) < time.Duration(maxAge)*time.Hour {
+				continue
+			}
+
+			finding.Issue = backupIssueStale
+		}
+
+		response.Findings = append(response.Findings, finding)
+	}
+
+	response.Count = linodeIDToInt32(len(response.GetFindings()))
+	response.Message = fmt.Sprintf("%d of %d instances have backups disabled, missing, or at least %d hours old",
+		response.GetCount(), response.GetChecked(), maxAge)
+
+	return response
+}
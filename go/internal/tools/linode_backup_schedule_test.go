@@ -0,0 +1,165 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// backupScheduleServer serves the given instance list and instance 5, and
+// records the body of a PUT to instance 5.
+func backupScheduleServer(t *testing.T, list, instance string, put *map[string]any) *config.Config {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /linode/instances":
+			_, _ = w.Write([]byte(list))
+		case "GET /linode/instances/5":
+			_, _ = w.Write([]byte(instance))
+		case "PUT /linode/instances/5":
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, put); err != nil {
+				t.Errorf("decode PUT body: %v", err)
+			}
+
+			_, _ = w.Write([]byte(`{"id":5,"label":"app-1","backups":{"enabled":true,"schedule":{"day":"Sunday","window":"W4"}}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+}
+
+// Only the window given is sent; the day keeps its value and the response
+// carries both the old and the new schedule.
+func TestLinodeBackupScheduleUpdateToolSendsOnlyGivenFields(t *testing.T) {
+	t.Parallel()
+
+	var put map[string]any
+
+	instance := `{"id":5,"label":"app-1","backups":{"enabled":true,"schedule":{"day":"Sunday","window":"W22"}}}`
+	_, _, handler := tools.NewLinodeBackupScheduleUpdateTool(backupScheduleServer(t, "", instance, &put))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"confirm":   true,
+		"linode_id": float64(5),
+		"window":    "W4",
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want success", result.Content)
+	}
+
+	want := map[string]any{"backups": map[string]any{"schedule": map[string]any{"window": "W4"}}}
+	if !reflect.DeepEqual(put, want) {
+		t.Errorf("PUT body = %v, want %v", put, want)
+	}
+
+	var response struct {
+		PreviousSchedule struct{ Window string } `json:"previous_schedule"`
+		Schedule         struct{ Window string } `json:"schedule"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if response.PreviousSchedule.Window != "W22" || response.Schedule.Window != "W4" {
+		t.Errorf("windows = %q -> %q, want W22 -> W4", response.PreviousSchedule.Window, response.Schedule.Window)
+	}
+}
+
+// With backups off the schedule cannot be set, so the call fails before the PUT.
+func TestLinodeBackupScheduleUpdateToolRequiresBackupsEnabled(t *testing.T) {
+	t.Parallel()
+
+	var put map[string]any
+
+	instance := `{"id":5,"label":"app-1","backups":{"enabled":false}}`
+	_, _, handler := tools.NewLinodeBackupScheduleUpdateTool(backupScheduleServer(t, "", instance, &put))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"confirm":   true,
+		"linode_id": float64(5),
+		"day":       "Monday",
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, _ := result.Content[0].(mcp.TextContent)
+	if !result.IsError || text.Text != "Backups are not enabled on Linode 5; enable them with linode_instance_backups_enable first" {
+		t.Errorf("result = %q, want the backups-disabled error", text.Text)
+	}
+
+	if put != nil {
+		t.Errorf("PUT body = %v, want no PUT", put)
+	}
+}
+
+// The audit flags disabled, never-succeeded, and stale instances, and leaves
+// a recently backed-up instance out.
+func TestLinodeBackupScheduleAuditToolFlagsInstances(t *testing.T) {
+	t.Parallel()
+
+	list := `{"data":[` +
+		`{"id":1,"label":"off","region":"us-east","backups":{"enabled":false}},` +
+		`{"id":2,"label":"new","region":"us-east","backups":{"enabled":true,"last_successful":null}},` +
+		`{"id":3,"label":"stale","region":"us-east","backups":{"enabled":true,"last_successful":{"finished":"2000-01-01T00:00:00"}}},` +
+		`{"id":4,"label":"fresh","region":"us-east","backups":{"enabled":true,"last_successful":{"finished":"2999-01-01T00:00:00"}}}` +
+		`],"page":1,"pages":1,"results":4}`
+	_, _, handler := tools.NewLinodeBackupScheduleAuditTool(backupScheduleServer(t, list, "", nil))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want success", result.Content)
+	}
+
+	var response struct {
+		Checked     int `json:"checked"`
+		MaxAgeHours int `json:"max_age_hours"`
+		Findings    []struct {
+			LinodeID int    `json:"linode_id"`
+			Issue    string `json:"issue"`
+		} `json:"findings"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	issues := map[int]string{}
+	for _, finding := range response.Findings {
+		issues[finding.LinodeID] = finding.Issue
+	}
+
+	want := map[int]string{1: "backups_disabled", 2: "no_successful_backup", 3: "stale_backup"}
+	if !reflect.DeepEqual(issues, want) || response.Checked != 4 || response.MaxAgeHours != 48 {
+		t.Errorf("issues = %v, checked = %d, max_age_hours = %d; want %v, 4, 48", issues, response.Checked, response.MaxAgeHours, want)
+	}
+}
@@ -0,0 +1,541 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+const (
+	bulkDeleteMaxTargets    = 50
+	bulkDeleteDelayDefault  = 1000
+	bulkDeleteDelayMax      = 10000
+	bulkDeleteStatusPlanned = "planned"
+	bulkDeleteStatusDone    = "done"
+	bulkDeleteStatusFailed  = "failed"
+	bulkDeleteStatusNotRun  = "not_run"
+)
+
+// Plan step actions, in the order a plan runs them: volumes come off their
+// instances and DNS records stop pointing at doomed instances before anything
+// is deleted, and volumes go last so no instance still holds them.
+const (
+	bulkDeleteDetachVolume = "detach_volume"
+	bulkDeleteDNSRecord    = "delete_dns_record"
+	bulkDeleteDomain       = "delete_domain"
+	bulkDeleteNodeBalancer = "delete_nodebalancer"
+	bulkDeleteInstance     = "delete_instance"
+	bulkDeleteVolume       = "delete_volume"
+)
+
+const (
+	bulkDeleteTypeInstance     = "instance"
+	bulkDeleteTypeVolume       = "volume"
+	bulkDeleteTypeNodeBalancer = "nodebalancer"
+	bulkDeleteTypeDomain       = "domain"
+	bulkDeleteTypeDomainRecord = "domain_record"
+	bulkDeleteResourcesShape   = "resources must be an array of {type, id} objects"
+)
+
+// bulkDeleteTypes are the resource types linode_bulk_delete accepts.
+var bulkDeleteTypes = []string{bulkDeleteTypeInstance, bulkDeleteTypeVolume, bulkDeleteTypeNodeBalancer, bulkDeleteTypeDomain}
+
+// bulkDeleteTarget is one resource in the set. Label is filled in while
+// planning.
+type bulkDeleteTarget struct {
+	Type  string `json:"type"`
+	ID    int    `json:"id"`
+	Label string `json:"label"`
+}
+
+// bulkDeleteStep is one planned API call, as the dry-run reports it in
+// current_state and the real run executes it.
+type bulkDeleteStep struct {
+	Order      int    `json:"order"`
+	Action     string `json:"action"`
+	Type       string `json:"type"`
+	ID         int    `json:"id"`
+	Label      string `json:"label"`
+	Reason     string `json:"reason"`
+	OutsideSet bool   `json:"outside_set"`
+	DomainID   int    `json:"domain_id,omitempty"`
+}
+
+// bulkDeletePlan is the resolved set and the ordered steps that delete it.
+type bulkDeletePlan struct {
+	Targets []bulkDeleteTarget `json:"targets"`
+	Steps   []bulkDeleteStep   `json:"steps"`
+}
+
+// bulkDeleteArgs is the validated linode_bulk_delete input. Exactly one of
+// Targets and Tag is set.
+type bulkDeleteArgs struct {
+	Targets []bulkDeleteTarget
+	Tag     string
+	Force   bool
+	Delay   time.Duration
+}
+
+// NewLinodeBulkDeleteTool creates a tool that deletes a set of instances,
+// volumes, NodeBalancers, and domains in dependency order.
+func NewLinodeBulkDeleteTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_bulk_delete",
+		"Deletes a set of instances, volumes, NodeBalancers, and domains, named as typed IDs in resources or "+
+			"by a tag. It plans a safe order (detach volumes, remove DNS records pointing at deleted instances, "+
+			"then delete domains, NodeBalancers, instances, and volumes), runs the calls one at a time delay_ms "+
+			"apart, stops at the first failure, and reports every step. Steps that touch resources outside the "+
+			"set (a volume attached to a kept instance, a record in a kept domain) need force=true. Pass "+
+			"dry_run=true to see the plan first.",
+		toolschemas.Schema("linode.mcp.v1.BulkDeleteInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeBulkDeleteRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapDestroy, handler
+}
+
+func bulkDeleteArgsFromTool(request *mcp.CallToolRequest) (*bulkDeleteArgs, string) {
+	args := request.GetArguments()
+	parsed := &bulkDeleteArgs{Delay: bulkDeleteDelayDefault * time.Millisecond}
+	parsed.Force, _ = args["force"].(bool)
+
+	tag, _ := args["tag"].(string)
+	parsed.Tag = strings.TrimSpace(tag)
+
+	var items []any
+
+	if raw, present := args["resources"]; present && raw != nil {
+		list, ok := raw.([]any)
+		if !ok {
+			return nil, bulkDeleteResourcesShape
+		}
+
+		items = list
+	}
+
+	switch {
+	case len(items) > 0 && parsed.Tag != "":
+		return nil, "pass either resources or tag, not both"
+	case len(items) == 0 && parsed.Tag == "":
+		return nil, "resources or tag is required"
+	case len(items) > bulkDeleteMaxTargets:
+		return nil, fmt.Sprintf("at most %d resources can be deleted at once", bulkDeleteMaxTargets)
+	}
+
+	for i, item := range items {
+		object, ok := item.(map[string]any)
+		if !ok {
+			return nil, bulkDeleteResourcesShape
+		}
+
+		resourceType, _ := object["type"].(string)
+		if !slices.Contains(bulkDeleteTypes, resourceType) {
+			return nil, fmt.Sprintf("resources[%d].type must be one of: %s", i, strings.Join(bulkDeleteTypes, ", "))
+		}
+
+		id, ok := numberArgToInt(object["id"])
+		if !ok || id <= 0 {
+			return nil, fmt.Sprintf("resources[%d].id must be a positive integer", i)
+		}
+
+		target := bulkDeleteTarget{Type: resourceType, ID: id}
+		if !slices.Contains(parsed.Targets, target) {
+			parsed.Targets = append(parsed.Targets, target)
+		}
+	}
+
+	if raw, present := args["delay_ms"]; present && raw != nil {
+		delay, ok := numberArgToInt(raw)
+		if !ok || delay < 0 || delay > bulkDeleteDelayMax {
+			return nil, fmt.Sprintf("delay_ms must be an integer from 0 through %d", bulkDeleteDelayMax)
+		}
+
+		parsed.Delay = time.Duration(delay) * time.Millisecond
+	}
+
+	return parsed, ""
+}
+
+// taggedBulkDeleteTargets resolves a tag to the set it names. A tag that also
+// covers a type linode_bulk_delete cannot delete is refused rather than
+// deleting only part of what it names.
+func taggedBulkDeleteTargets(ctx context.Context, client *linode.Client, tag string) ([]bulkDeleteTarget, error) {
+	objects, err := listAllTaggedObjects(ctx, client, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]bulkDeleteTarget, 0, len(objects))
+
+	for _, object := range objects {
+		state := taggedObjectStateOf(object)
+		if state.Type == "linode" {
+			state.Type = bulkDeleteTypeInstance
+		}
+
+		if !slices.Contains(bulkDeleteTypes, state.Type) {
+			return nil, fmt.Errorf("%w: %s %d", errBulkDeleteUnsupportedType, state.Type, state.ID)
+		}
+
+		targets = append(targets, bulkDeleteTarget{Type: state.Type, ID: state.ID, Label: state.Label})
+	}
+
+	switch {
+	case len(targets) == 0:
+		return nil, fmt.Errorf("%w %q", errBulkDeleteNoTaggedResources, tag)
+	case len(targets) > bulkDeleteMaxTargets:
+		return nil, fmt.Errorf("%w: tag %q covers %d, at most %d", errBulkDeleteTooManyTargets, tag, len(targets), bulkDeleteMaxTargets)
+	}
+
+	return targets, nil
+}
+
+// bulkDeleteDNSSteps plans removing the A and AAAA records in kept domains
+// that point at an address of an instance being deleted.
+func bulkDeleteDNSSteps(ctx context.Context, client *linode.Client, addresses map[string]bulkDeleteTarget, deletedDomains []int) ([]bulkDeleteStep, error) {
+	domains, err := client.ListDomainsProto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list domains: %w", err)
+	}
+
+	var steps []bulkDeleteStep
+
+	for _, domain := range domains {
+		domainID := int(domain.GetId())
+		if slices.Contains(deletedDomains, domainID) {
+			continue
+		}
+
+		records, err := client.ListDomainRecordsProto(ctx, domainID)
+		if err != nil {
+			return nil, fmt.Errorf("list records of domain %d: %w", domainID, err)
+		}
+
+		for _, record := range records {
+			instance, ok := addresses[record.GetTarget()]
+			if !ok || (record.GetType() != "A" && record.GetType() != "AAAA") {
+				continue
+			}
+
+			label := domain.GetDomain()
+			if record.GetName() != "" {
+				label = record.GetName() + "." + label
+			}
+
+			steps = append(steps, bulkDeleteStep{
+				Action:     bulkDeleteDNSRecord,
+				Type:       bulkDeleteTypeDomainRecord,
+				ID:         int(record.GetId()),
+				Label:      label,
+				Reason:     fmt.Sprintf("%s record points at instance %d (%s)", record.GetType(), instance.ID, instance.Label),
+				OutsideSet: true,
+				DomainID:   domainID,
+			})
+		}
+	}
+
+	return steps, nil
+}
+
+// planBulkDelete resolves the set, reads each resource, and orders the steps
+// that delete it.
+func planBulkDelete(ctx context.Context, client *linode.Client, args *bulkDeleteArgs) (*bulkDeletePlan, error) {
+	targets := args.Targets
+	reason := "listed in resources"
+
+	if args.Tag != "" {
+		var err error
+		if targets, err = taggedBulkDeleteTargets(ctx, client, args.Tag); err != nil {
+			return nil, err
+		}
+
+		reason = fmt.Sprintf("tagged %q", args.Tag)
+	}
+
+	plan := &bulkDeletePlan{Targets: make([]bulkDeleteTarget, 0, len(targets)), Steps: []bulkDeleteStep{}}
+	deletes := map[string][]bulkDeleteStep{}
+	addresses := map[string]bulkDeleteTarget{}
+
+	var (
+		detaches       []bulkDeleteStep
+		deletedDomains []int
+	)
+
+	for _, target := range targets {
+		switch target.Type {
+		case bulkDeleteTypeInstance:
+			instance, err := client.GetInstanceProto(ctx, target.ID)
+			if err != nil {
+				return nil, fmt.Errorf("instance %d: %w", target.ID, err)
+			}
+
+			target.Label = instance.GetLabel()
+
+			for _, address := range instance.GetIpv4() {
+				addresses[address] = target
+			}
+
+			if ipv6, _, _ := strings.Cut(instance.GetIpv6(), "/"); ipv6 != "" {
+				addresses[ipv6] = target
+			}
+		case bulkDeleteTypeVolume:
+			volume, err := client.GetVolumeProto(ctx, target.ID)
+			if err != nil {
+				return nil, fmt.Errorf("volume %d: %w", target.ID, err)
+			}
+
+			target.Label = volume.GetLabel()
+
+			if linodeID := int(volume.GetLinodeId()); linodeID != 0 {
+				kept := !slices.ContainsFunc(targets, func(other bulkDeleteTarget) bool {
+					return other.Type == bulkDeleteTypeInstance && other.ID == linodeID
+				})
+
+				detachReason := fmt.Sprintf("attached to instance %d (%s), which is also deleted", linodeID, volume.GetLinodeLabel())
+				if kept {
+					detachReason = fmt.Sprintf("attached to instance %d (%s), which is kept", linodeID, volume.GetLinodeLabel())
+				}
+
+				detaches = append(detaches, bulkDeleteStep{
+					Action: bulkDeleteDetachVolume, Type: bulkDeleteTypeVolume, ID: target.ID,
+					Label: target.Label, Reason: detachReason, OutsideSet: kept,
+				})
+			}
+		case bulkDeleteTypeNodeBalancer:
+			nodeBalancer, err := client.GetNodeBalancerProto(ctx, target.ID)
+			if err != nil {
+				return nil, fmt.Errorf("nodebalancer %d: %w", target.ID, err)
+			}
+
+			target.Label = nodeBalancer.GetLabel()
+		case bulkDeleteTypeDomain:
+			domain, err := client.GetDomainProto(ctx, target.ID)
+			if err != nil {
+				return nil, fmt.Errorf("domain %d: %w", target.ID, err)
+			}
+
+			target.Label = domain.GetDomain()
+			deletedDomains = append(deletedDomains, target.ID)
+		}
+
+		plan.Targets = append(plan.Targets, target)
+		deletes[target.Type] = append(deletes[target.Type], bulkDeleteStep{
+			Action: "delete_" + target.Type, Type: target.Type, ID: target.ID, Label: target.Label, Reason: reason,
+		})
+	}
+
+	plan.Steps = append(plan.Steps, detaches...)
+
+	if len(addresses) > 0 {
+		dnsSteps, err := bulkDeleteDNSSteps(ctx, client, addresses, deletedDomains)
+		if err != nil {
+			return nil, err
+		}
+
+		plan.Steps = append(plan.Steps, dnsSteps...)
+	}
+
+	for _, resourceType := range []string{bulkDeleteTypeDomain, bulkDeleteTypeNodeBalancer, bulkDeleteTypeInstance, bulkDeleteTypeVolume} {
+		plan.Steps = append(plan.Steps, deletes[resourceType]...)
+	}
+
+	for i := range plan.Steps {
+		plan.Steps[i].Order = i + 1
+	}
+
+	return plan, nil
+}
+
+// outsideSetSteps returns the steps that change a resource being kept.
+func outsideSetSteps(steps []bulkDeleteStep) []bulkDeleteStep {
+	var outside []bulkDeleteStep
+
+	for _, step := range steps {
+		if step.OutsideSet {
+			outside = append(outside, step)
+		}
+	}
+
+	return outside
+}
+
+func bulkDeleteStepSummary(step bulkDeleteStep) string {
+	return fmt.Sprintf("%s %s %d (%s)", step.Action, step.Type, step.ID, step.Label)
+}
+
+// bulkDeleteSideEffects is the Tier B walk for linode_bulk_delete: one line
+// per step in run order, then a summary line.
+func bulkDeleteSideEffects(state any, args *bulkDeleteArgs) DryRunDetails {
+	plan, _ := state.(*bulkDeletePlan)
+	if plan == nil {
+		return DryRunDetails{}
+	}
+
+	details := DryRunDetails{SideEffects: make([]string, 0, len(plan.Steps)+1)}
+
+	for _, step := range plan.Steps {
+		line := fmt.Sprintf("%d. %s: %s.", step.Order, bulkDeleteStepSummary(step), step.Reason)
+		if step.OutsideSet {
+			line += " Needs force=true."
+		}
+
+		details.SideEffects = append(details.SideEffects, line)
+	}
+
+	details.SideEffects = append(details.SideEffects, fmt.Sprintf("%d resources would be deleted in %d steps, %d ms apart.",
+		len(plan.Targets), len(plan.Steps), args.Delay.Milliseconds()))
+
+	if outside := outsideSetSteps(plan.Steps); len(outside) > 0 && !args.Force {
+		details.Warnings = []string{fmt.Sprintf("%d steps change resources outside the set; the real call refuses them without force=true.",
+			len(outside))}
+	}
+
+	return details
+}
+
+// runBulkDeleteStep makes the API call for one step.
+func runBulkDeleteStep(ctx context.Context, client *linode.Client, step bulkDeleteStep) error {
+	switch step.Action {
+	case bulkDeleteDetachVolume:
+		return client.DetachVolume(ctx, step.ID)
+	case bulkDeleteDNSRecord:
+		return client.DeleteDomainRecord(ctx, step.DomainID, step.ID)
+	case bulkDeleteDomain:
+		return client.DeleteDomain(ctx, step.ID)
+	case bulkDeleteNodeBalancer:
+		return client.DeleteNodeBalancer(ctx, step.ID)
+	case bulkDeleteInstance:
+		return client.DeleteInstance(ctx, step.ID)
+	case bulkDeleteVolume:
+		return client.DeleteVolume(ctx, step.ID)
+	default:
+		return fmt.Errorf("%w: %s", errBulkDeleteUnsupportedType, step.Type)
+	}
+}
+
+// runBulkDelete executes the plan one step at a time, delay apart so a large
+// set stays inside the API rate limit. The first failure stops the run: later
+// steps may depend on it (a volume cannot be deleted while still attached), so
+// they are reported as not_run and the failure becomes an envelope warning.
+func runBulkDelete(ctx context.Context, client *linode.Client, plan *bulkDeletePlan, delay time.Duration) *linodev1.BulkDeleteResponse {
+	response := &linodev1.BulkDeleteResponse{
+		Targets: linodeIDToInt32(len(plan.Targets)),
+		Steps:   make([]*linodev1.BulkDeleteStep, 0, len(plan.Steps)),
+	}
+
+	var failedStep *linodev1.BulkDeleteStep
+
+	for i, step := range plan.Steps {
+		entry := &linodev1.BulkDeleteStep{
+			Order:      linodeIDToInt32(step.Order),
+			Action:     step.Action,
+			Type:       step.Type,
+			Id:         linodeIDToInt32(step.ID),
+			Label:      step.Label,
+			Reason:     step.Reason,
+			OutsideSet: step.OutsideSet,
+			Status:     bulkDeleteStatusPlanned,
+		}
+		if step.DomainID != 0 {
+			entry.DomainId = new(linodeIDToInt32(step.DomainID))
+		}
+
+		response.Steps = append(response.Steps, entry)
+
+		if failedStep != nil {
+			entry.Status = bulkDeleteStatusNotRun
+			response.NotRun++
+
+			continue
+		}
+
+		if i > 0 && delay > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(delay):
+			}
+		}
+
+		if err := runBulkDeleteStep(ctx, client, step); err != nil {
+			entry.Status = bulkDeleteStatusFailed
+			entry.Error = new(err.Error())
+			response.Failed++
+			failedStep = entry
+
+			AddWarning(ctx, "step %d (%s): %v", step.Order, bulkDeleteStepSummary(step), err)
+
+			continue
+		}
+
+		entry.Status = bulkDeleteStatusDone
+		response.Completed++
+	}
+
+	if failedStep != nil {
+		response.Message = fmt.Sprintf("Bulk delete stopped at step %d of %d (%s %s %d): %d steps done, %d not run",
+			failedStep.GetOrder(), len(plan.Steps), failedStep.GetAction(), failedStep.GetType(), failedStep.GetId(),
+			response.GetCompleted(), response.GetNotRun())
+	} else {
+		response.Message = fmt.Sprintf("Deleted %d resources in %d steps", len(plan.Targets), response.GetCompleted())
+	}
+
+	return response
+}
+
+func handleLinodeBulkDeleteRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	args, validationMessage := bulkDeleteArgsFromTool(request)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	if IsDryRun(request) {
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_bulk_delete", httpMethodDelete, "/{resource}/{id}",
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				return planBulkDelete(ctx, c, args)
+			},
+			func(_ context.Context, _ *linode.Client, state any) (DryRunDetails, error) {
+				return bulkDeleteSideEffects(state, args), nil
+			})
+	}
+
+	if result := requireDestroyConfirmation(ctx, request, "linode_bulk_delete",
+		"This deletes every resource in the set. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	plan, err := planBulkDelete(ctx, client, args)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to plan bulk delete: %v", err)), nil
+	}
+
+	if outside := outsideSetSteps(plan.Steps); len(outside) > 0 && !args.Force {
+		summaries := make([]string, 0, len(outside))
+		for _, step := range outside {
+			summaries = append(summaries, fmt.Sprintf("%s: %s", bulkDeleteStepSummary(step), step.Reason))
+		}
+
+		return mcp.NewToolResultError(fmt.Sprintf(
+			"The plan changes %d resources outside the set: %s. Review it with dry_run=true and pass force=true to proceed.",
+			len(outside), strings.Join(summaries, "; "))), nil
+	}
+
+	return MarshalProtoToolResponse(runBulkDelete(ctx, client, plan, args.Delay))
+}
@@ -0,0 +1,177 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// bulkDeleteServer serves instance 7, volume 9 attached to the kept instance
+// 8, and a kept domain whose www record points at instance 7. Deleting
+// instance 7 fails with a 400; every non-GET request is recorded.
+func bulkDeleteServer(t *testing.T, writes *[]string, mu *sync.Mutex) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method != http.MethodGet {
+			mu.Lock()
+			*writes = append(*writes, r.Method+" "+r.URL.Path)
+			mu.Unlock()
+		}
+
+		var body string
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/linode/instances/7":
+			body = `{"id":7,"label":"web-1","status":"running","ipv4":["45.33.1.10"],"ipv6":"2600:3c00::1/128"}`
+		case r.Method == http.MethodGet && r.URL.Path == "/volumes/9":
+			body = `{"id":9,"label":"data","size":20,"linode_id":8,"linode_label":"db-1"}`
+		case r.Method == http.MethodGet && r.URL.Path == "/domains":
+			body = `{"data":[{"id":3,"domain":"example.com","type":"master"}],"page":1,"pages":1,"results":1}`
+		case r.Method == http.MethodGet && r.URL.Path == "/domains/3/records":
+			body = `{"data":[` +
+				`{"id":11,"type":"A","name":"www","target":"45.33.1.10"},` +
+				`{"id":12,"type":"A","name":"mail","target":"45.33.1.20"}` +
+				`],"page":1,"pages":1,"results":2}`
+		case r.Method == http.MethodDelete && r.URL.Path == "/linode/instances/7":
+			w.WriteHeader(http.StatusBadRequest)
+
+			body = `{"errors":[{"reason":"instance busy"}]}`
+		default:
+			body = `{}`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func bulkDeleteArgs(force bool) map[string]any {
+	return map[string]any{
+		"resources": []any{
+			map[string]any{"type": "volume", "id": float64(9)},
+			map[string]any{"type": "instance", "id": float64(7)},
+		},
+		"force":                  force,
+		"delay_ms":               float64(0),
+		"confirm":                true,
+		"confirm_bypass_dry_run": true,
+	}
+}
+
+func TestLinodeBulkDeleteRefusesOutsideSetStepsWithoutForce(t *testing.T) {
+	t.Parallel()
+
+	var (
+		writes []string
+		mu     sync.Mutex
+	)
+
+	srv := bulkDeleteServer(t, &writes, &mu)
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeBulkDeleteTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, bulkDeleteArgs(false)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !result.IsError {
+		t.Fatal("result.IsError = false, want true")
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	for _, want := range []string{"2 resources outside the set", "detach_volume volume 9 (data)", "delete_dns_record domain_record 11 (www.example.com)", "force=true"} {
+		if !strings.Contains(textContent.Text, want) {
+			t.Errorf("text = %q, want it to contain %q", textContent.Text, want)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(writes) != 0 {
+		t.Errorf("writes = %v, want none", writes)
+	}
+}
+
+func TestLinodeBulkDeleteStopsAtFirstFailure(t *testing.T) {
+	t.Parallel()
+
+	var (
+		writes []string
+		mu     sync.Mutex
+	)
+
+	srv := bulkDeleteServer(t, &writes, &mu)
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeBulkDeleteTool(cfg)
+
+	result, err := handler(tools.WithWarnings(t.Context()), createRequestWithArgs(t, bulkDeleteArgs(true)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var report struct {
+		Completed int `json:"completed"`
+		Failed    int `json:"failed"`
+		NotRun    int `json:"not_run"`
+		Steps     []struct {
+			Action string `json:"action"`
+			Status string `json:"status"`
+		} `json:"steps"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &report); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.Completed != 2 || report.Failed != 1 || report.NotRun != 1 {
+		t.Errorf("completed/failed/not_run = %d/%d/%d, want 2/1/1", report.Completed, report.Failed, report.NotRun)
+	}
+
+	var steps []string
+	for _, step := range report.Steps {
+		steps = append(steps, step.Action+":"+step.Status)
+	}
+
+	wantSteps := []string{"detach_volume:done", "delete_dns_record:done", "delete_instance:failed", "delete_volume:not_run"}
+	if !slices.Equal(steps, wantSteps) {
+		t.Errorf("steps = %v, want %v", steps, wantSteps)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	wantWrites := []string{"POST /volumes/9/detach", "DELETE /domains/3/records/11", "DELETE /linode/instances/7"}
+	if !slices.Equal(writes, wantWrites) {
+		t.Errorf("writes = %v, want %v", writes, wantWrites)
+	}
+}
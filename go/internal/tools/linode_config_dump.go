@@ -0,0 +1,131 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v3"
+
+	"github.com/chadit/LinodeMCP/go/internal/audit"
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// NewLinodeConfigDumpTool returns the linode_config_dump query tool. It
+// prints the effective configuration the server runs with (the file after
+// defaults and environment overrides), keyed as the config file spells it,
+// with every secret redacted. CapMeta so it is available in every profile.
+// Takes no input parameters and makes no API call.
+func NewLinodeConfigDumpTool(
+	cfg *config.Config,
+) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_config_dump",
+		"Print the effective LinodeMCP configuration in use (file values plus "+
+			"defaults and environment overrides), keyed as in the config file. "+
+			"Tokens, header values, and the OAuth client secret are redacted.",
+		toolschemas.Schema("linode.mcp.v1.ConfigDumpInput"),
+	)
+
+	handler := func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		response, err := configDumpProto(resolveConfig(cfg))
+		if err != nil {
+			return mcp.NewToolResultErrorf("failed to dump configuration: %v", err), nil
+		}
+
+		return MarshalProtoToolResponse(response)
+	}
+
+	return tool, profiles.CapMeta, handler
+}
+
+// configDumpProto renders cfg through its yaml tags, the shape WriteAtomic
+// writes, then redacts the secrets in place.
+func configDumpProto(cfg *config.Config) (*linodev1.ConfigDumpResponse, error) {
+	response := &linodev1.ConfigDumpResponse{ConfigPath: config.Path()}
+
+	fields := map[string]any{}
+
+	if cfg != nil {
+		data, err := yaml.Marshal(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("encode config: %w", err)
+		}
+
+		if err := yaml.Unmarshal(data, &fields); err != nil {
+			return nil, fmt.Errorf("decode config: %w", err)
+		}
+	}
+
+	response.Redacted = redactConfigSecrets(fields)
+
+	dump, err := structpb.NewStruct(fields)
+	if err != nil {
+		return nil, fmt.Errorf("build config struct: %w", err)
+	}
+
+	response.Config = dump
+
+	return response, nil
+}
+
+// redactConfigSecrets replaces every set secret in the decoded config with
+// audit.RedactedValue and returns their dotted paths, sorted. Empty values
+// stay empty so the dump still shows which secrets are unset.
+func redactConfigSecrets(fields map[string]any) []string {
+	var redacted []string
+
+	redact := func(parent map[string]any, key, path string) {
+		if value, ok := parent[key].(string); ok && value != "" {
+			parent[key] = audit.RedactedValue
+			redacted = append(redacted, path)
+		}
+	}
+
+	redactAll := func(parent map[string]any, path string) {
+		for key := range parent {
+			redact(parent, key, path+"."+key)
+		}
+	}
+
+	environments, _ := fields["environments"].(map[string]any)
+	for name, raw := range environments {
+		env, _ := raw.(map[string]any)
+		linode, _ := env["linode"].(map[string]any)
+
+		if linode == nil {
+			continue
+		}
+
+		path := "environments." + name + ".linode"
+		redact(linode, "token", path+".token")
+
+		if headers, ok := linode["headers"].(map[string]any); ok {
+			redactAll(headers, path+".headers")
+		}
+	}
+
+	observability, _ := fields["observability"].(map[string]any)
+	tracing, _ := observability["tracing"].(map[string]any)
+
+	if headers, ok := tracing["headers"].(map[string]any); ok {
+		redactAll(headers, "observability.tracing.headers")
+	}
+
+	if oauth, ok := fields["oauth"].(map[string]any); ok {
+		redact(oauth, "client_secret", "oauth.client_secret")
+	}
+
+	sort.Strings(redacted)
+
+	return redacted
+}
@@ -0,0 +1,100 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/audit"
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeConfigDumpRedactsSecrets(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{
+		Server: config.ServerConfig{Name: "LinodeMCP", LogLevel: "info"},
+		Observability: config.ObservabilityConfig{Tracing: config.TracingConfig{
+			Headers: map[string]string{"x-api-key": "tracing-secret"},
+		}},
+		OAuth: config.OAuthConfig{ClientID: "mcp", ClientSecret: "oauth-secret"},
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{
+				APIURL:  "https://api.linode.com/v4",
+				Token:   tokenTest,
+				Headers: map[string]string{"X-Gateway-Key": "gateway-secret"},
+			}},
+			"staging": {Label: "Staging", Linode: config.LinodeConfig{APIURL: "https://api.linode.com/v4"}},
+		},
+	}
+	_, capability, handler := tools.NewLinodeConfigDumpTool(cfg)
+
+	if capability != profiles.CapMeta {
+		t.Errorf("capability = %s, want CapMeta", capability)
+	}
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	for _, secret := range []string{tokenTest, "tracing-secret", "oauth-secret", "gateway-secret"} {
+		if strings.Contains(textContent.Text, secret) {
+			t.Errorf("response leaks %q: %s", secret, textContent.Text)
+		}
+	}
+
+	var dump struct {
+		ConfigPath string `json:"config_path"`
+		Config     struct {
+			Server struct {
+				LogLevel string `json:"logLevel"`
+			} `json:"server"`
+			Environments map[string]struct {
+				Linode struct {
+					Token string `json:"token"`
+				} `json:"linode"`
+			} `json:"environments"`
+		} `json:"config"`
+		Redacted []string `json:"redacted"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &dump); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if dump.ConfigPath == "" {
+		t.Error("config_path is empty")
+	}
+
+	if dump.Config.Server.LogLevel != "info" {
+		t.Errorf("server.logLevel = %q, want %q", dump.Config.Server.LogLevel, "info")
+	}
+
+	if got := dump.Config.Environments[envKeyDefault].Linode.Token; got != audit.RedactedValue {
+		t.Errorf("default token = %q, want %q", got, audit.RedactedValue)
+	}
+
+	if got := dump.Config.Environments["staging"].Linode.Token; got != "" {
+		t.Errorf("staging token = %q, want it left empty", got)
+	}
+
+	want := []string{
+		"environments.default.linode.headers.X-Gateway-Key",
+		"environments.default.linode.token",
+		"oauth.client_secret",
+		"observability.tracing.headers.x-api-key",
+	}
+	if !slices.Equal(dump.Redacted, want) {
+		t.Errorf("redacted = %v, want %v", dump.Redacted, want)
+	}
+}
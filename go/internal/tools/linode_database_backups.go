@@ -0,0 +1,496 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+const (
+	paramDatabaseBackupTarget     = "target"
+	paramDatabaseSourceInstanceID = "source_instance_id"
+	paramDatabaseRestoreTime      = "restore_time"
+	paramDatabaseWait             = "wait"
+
+	databaseBackupLabelMaxLength = 32
+
+	// Pacing for a restore made with wait=true. A forked cluster provisions
+	// in ten to thirty minutes; past databaseWaitTimeout the call returns
+	// what it last saw with ready=false.
+	databaseWaitInterval   = 15 * time.Second
+	databaseWaitTimeout    = 30 * time.Minute
+	databaseStatusActive   = "active"
+	databaseRestoreTimeFmt = "2006-01-02T15:04:05"
+)
+
+// databaseBackupEngine holds the per-engine pieces of the backup and restore
+// tools so the MySQL and PostgreSQL handlers share one body each.
+type databaseBackupEngine struct {
+	Engine        string
+	InstancesPath string
+	MessagePrefix string
+	GetInstance   func(context.Context, *linode.Client, int) (*linode.DatabaseInstance, error)
+	GetProto      func(context.Context, *linode.Client, int) (*linodev1.DatabaseInstance, error)
+	CreateBackup  func(context.Context, *linode.Client, int, *linode.CreateDatabaseBackupRequest) error
+	Create        func(context.Context, *linode.Client, *linode.CreateDatabaseInstanceRequest) (*linodev1.DatabaseInstance, error)
+}
+
+var (
+	databaseBackupEngineMySQL = &databaseBackupEngine{
+		Engine:        "mysql",
+		InstancesPath: dbMySQLInstancesPath,
+		MessagePrefix: dbMessagePrefixMySQL,
+		GetInstance: func(ctx context.Context, c *linode.Client, id int) (*linode.DatabaseInstance, error) {
+			return c.GetDatabaseInstance(ctx, id)
+		},
+		GetProto: func(ctx context.Context, c *linode.Client, id int) (*linodev1.DatabaseInstance, error) {
+			return c.GetDatabaseInstanceProto(ctx, id)
+		},
+		CreateBackup: func(ctx context.Context, c *linode.Client, id int, req *linode.CreateDatabaseBackupRequest) error {
+			return c.CreateDatabaseBackup(ctx, id, req)
+		},
+		Create: func(ctx context.Context, c *linode.Client, req *linode.CreateDatabaseInstanceRequest) (*linodev1.DatabaseInstance, error) {
+			return c.CreateDatabaseInstanceProto(ctx, req)
+		},
+	}
+
+	databaseBackupEnginePostgreSQL = &databaseBackupEngine{
+		Engine:        "postgresql",
+		InstancesPath: dbPostgreSQLInstancesPath,
+		MessagePrefix: dbMessagePrefixPostgreSQL,
+		GetInstance: func(ctx context.Context, c *linode.Client, id int) (*linode.DatabaseInstance, error) {
+			return c.GetDatabasePostgreSQLInstance(ctx, id)
+		},
+		GetProto: func(ctx context.Context, c *linode.Client, id int) (*linodev1.DatabaseInstance, error) {
+			return c.GetDatabasePostgreSQLInstanceProto(ctx, id)
+		},
+		CreateBackup: func(ctx context.Context, c *linode.Client, id int, req *linode.CreateDatabaseBackupRequest) error {
+			return c.CreateDatabasePostgreSQLBackup(ctx, id, req)
+		},
+		Create: func(ctx context.Context, c *linode.Client, req *linode.CreateDatabaseInstanceRequest) (*linodev1.DatabaseInstance, error) {
+			return c.CreateDatabasePostgreSQLInstanceProto(ctx, req)
+		},
+	}
+)
+
+// NewLinodeDatabaseMySQLBackupListTool creates a tool for listing the backups of a MySQL Managed Database instance.
+func NewLinodeDatabaseMySQLBackupListTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	return newDatabaseBackupListTool(
+		cfg,
+		"linode_database_mysql_backup_list",
+		"Lists the automated and on-demand backups of a MySQL Managed Database instance.",
+		"linode.mcp.v1.DatabaseMySQLBackupListInput",
+		func(ctx context.Context, client *linode.Client, instanceID, page, pageSize int) ([]*linodev1.DatabaseBackup, error) {
+			return client.ListDatabaseBackupsProto(ctx, instanceID, page, pageSize)
+		},
+	)
+}
+
+// NewLinodeDatabasePostgreSQLBackupListTool creates a tool for listing the backups of a PostgreSQL Managed Database instance.
+func NewLinodeDatabasePostgreSQLBackupListTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	return newDatabaseBackupListTool(
+		cfg,
+		"linode_database_postgresql_backup_list",
+		"Lists the automated and on-demand backups of a PostgreSQL Managed Database instance.",
+		"linode.mcp.v1.DatabasePostgreSQLBackupListInput",
+		func(ctx context.Context, client *linode.Client, instanceID, page, pageSize int) ([]*linodev1.DatabaseBackup, error) {
+			return client.ListDatabasePostgreSQLBackupsProto(ctx, instanceID, page, pageSize)
+		},
+	)
+}
+
+func newDatabaseBackupListTool(
+	cfg *config.Config,
+	name, description, schemaName string,
+	apiCall func(ctx context.Context, client *linode.Client, instanceID, page, pageSize int) ([]*linodev1.DatabaseBackup, error),
+) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool, handler := newProtoListToolSubresourcePaginatedRawSchema(
+		cfg,
+		name,
+		description,
+		schemaName,
+		"Page number to retrieve",
+		"Number of results per page, from 25 through 500",
+		protoListPathID{
+			option: mcp.WithNumber(paramDatabaseInstanceID, mcp.Required(),
+				mcp.Description("The ID of the Managed Database instance whose backups should be listed")),
+			parse: databaseInstanceIDFromTool,
+		},
+		databaseInstancesPaginationFromTool,
+		apiCall,
+		nil,
+		databaseBackupListResponse,
+	)
+
+	return tool, profiles.CapRead, handler
+}
+
+func databaseBackupListResponse(items []*linodev1.DatabaseBackup, count int32, filter *string) *linodev1.DatabaseBackupListResponse {
+	return &linodev1.DatabaseBackupListResponse{Count: count, Filter: filter, Backups: items}
+}
+
+// NewLinodeDatabaseMySQLBackupCreateTool creates a tool for taking an on-demand backup of a MySQL Managed Database instance.
+func NewLinodeDatabaseMySQLBackupCreateTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	return newDatabaseBackupCreateTool(cfg, "linode_database_mysql_backup_create", "MySQL",
+		"linode.mcp.v1.DatabaseMySQLBackupCreateInput", databaseBackupEngineMySQL)
+}
+
+// NewLinodeDatabasePostgreSQLBackupCreateTool creates a tool for taking an on-demand backup of a PostgreSQL Managed Database instance.
+func NewLinodeDatabasePostgreSQLBackupCreateTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	return newDatabaseBackupCreateTool(cfg, "linode_database_postgresql_backup_create", "PostgreSQL",
+		"linode.mcp.v1.DatabasePostgreSQLBackupCreateInput", databaseBackupEnginePostgreSQL)
+}
+
+func newDatabaseBackupCreateTool(
+	cfg *config.Config,
+	name, engineName, schemaName string,
+	engine *databaseBackupEngine,
+) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		name,
+		"Takes an on-demand backup of a "+engineName+" Managed Database instance. Only clusters that support "+
+			"on-demand snapshots accept this; newer clusters keep daily backups with point-in-time recovery, "+
+			"restorable with linode_database_"+engine.Engine+"_instance_restore. Pass dry_run=true to preview without backing up.",
+		toolschemas.Schema(schemaName),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleDatabaseBackupCreateRequest(ctx, &request, cfg, name, engine)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+func handleDatabaseBackupCreateRequest(
+	ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config, toolName string, engine *databaseBackupEngine,
+) (*mcp.CallToolResult, error) {
+	instanceID, validationMessage := databaseInstanceIDFromTool(request)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	req, validationMessage := databaseBackupCreateRequestFromTool(request)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	path := fmt.Sprintf(engine.InstancesPath+"/%d/backups", instanceID)
+
+	if IsDryRun(request) {
+		return RunDryRunPreviewWithBody(ctx, request, cfg, toolName, httpMethodPost, path, req,
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				return engine.GetInstance(ctx, c, instanceID)
+			})
+	}
+
+	if result := RequireConfirm(request, "This takes an on-demand backup of a Managed Database instance. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if err := engine.CreateBackup(ctx, client, instanceID, req); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to back up %s instance %d: %v", engine.MessagePrefix, instanceID, err)), nil
+	}
+
+	return MarshalProtoToolResponse(&linodev1.DatabaseInstanceActionWriteResponse{
+		Message:    fmt.Sprintf("%s instance %d backup '%s' started", engine.MessagePrefix, instanceID, req.Label),
+		InstanceId: linodeIDToInt32(instanceID),
+	})
+}
+
+func databaseBackupCreateRequestFromTool(request *mcp.CallToolRequest) (*linode.CreateDatabaseBackupRequest, string) {
+	args := request.GetArguments()
+
+	label, ok := args[paramDatabaseLabel].(string)
+	if !ok || strings.TrimSpace(label) == "" {
+		return nil, "label is required"
+	}
+
+	if len(label) > databaseBackupLabelMaxLength {
+		return nil, fmt.Sprintf("label must be at most %d characters", databaseBackupLabelMaxLength)
+	}
+
+	req := &linode.CreateDatabaseBackupRequest{Label: label}
+
+	if raw, exists := args[paramDatabaseBackupTarget]; exists {
+		target, ok := raw.(string)
+		if !ok || (target != "primary" && target != "secondary") {
+			return nil, "target must be primary or secondary"
+		}
+
+		req.Target = target
+	}
+
+	return req, ""
+}
+
+// NewLinodeDatabaseMySQLInstanceRestoreTool creates a tool for restoring a MySQL Managed Database instance to a new cluster.
+func NewLinodeDatabaseMySQLInstanceRestoreTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	return newDatabaseInstanceRestoreTool(cfg, "linode_database_mysql_instance_restore", "MySQL",
+		"linode.mcp.v1.DatabaseMySQLInstanceRestoreInput", databaseBackupEngineMySQL)
+}
+
+// NewLinodeDatabasePostgreSQLInstanceRestoreTool creates a tool for restoring a PostgreSQL Managed Database instance to a new cluster.
+func NewLinodeDatabasePostgreSQLInstanceRestoreTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	return newDatabaseInstanceRestoreTool(cfg, "linode_database_postgresql_instance_restore", "PostgreSQL",
+		"linode.mcp.v1.DatabasePostgreSQLInstanceRestoreInput", databaseBackupEnginePostgreSQL)
+}
+
+func newDatabaseInstanceRestoreTool(
+	cfg *config.Config,
+	name, engineName, schemaName string,
+	engine *databaseBackupEngine,
+) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		name,
+		"Restores a "+engineName+" Managed Database instance from its backups into a new cluster, "+
+			"optionally at a point in time (restore_time). The source is left untouched; region, type, and "+
+			"cluster_size default to the source's. This creates a billable resource. Pass wait=true to return "+
+			"once the new cluster is active. Pass dry_run=true to preview without restoring.",
+		toolschemas.Schema(schemaName),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleDatabaseInstanceRestoreRequest(ctx, &request, cfg, name, engine)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+func handleDatabaseInstanceRestoreRequest(
+	ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config, toolName string, engine *databaseBackupEngine,
+) (*mcp.CallToolResult, error) {
+	sourceID, validationMessage := requiredIDArgument(request, paramDatabaseSourceInstanceID)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	options, validationMessage := databaseRestoreOptionsFromTool(request)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	if !IsDryRun(request) {
+		if result := RequireConfirm(request, "This restores into a new billable Managed Database instance. Set confirm=true to proceed."); result != nil {
+			return result, nil
+		}
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	source, err := engine.GetInstance(ctx, client, sourceID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve source %s instance %d: %v", engine.MessagePrefix, sourceID, err)), nil
+	}
+
+	req := databaseRestoreRequest(engine, source, options)
+
+	if IsDryRun(request) {
+		return BuildDryRunResponse(toolName, request.GetString(paramEnvironment, ""), httpMethodPost, engine.InstancesPath, source, req)
+	}
+
+	instance, err := engine.Create(ctx, client, req)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to restore %s instance %d: %v", engine.MessagePrefix, sourceID, err)), nil
+	}
+
+	response := &linodev1.DatabaseInstanceWriteResponse{
+		Message: fmt.Sprintf("%s instance '%s' (ID: %d) restoring from instance %d",
+			engine.MessagePrefix, instance.GetLabel(), instance.GetId(), sourceID),
+		DatabaseInstance: instance,
+	}
+
+	if request.GetBool(paramDatabaseWait, false) {
+		databaseRestoreWait(ctx, client, engine, response)
+	}
+
+	return MarshalProtoToolResponse(response)
+}
+
+// databaseRestoreOptions are the restore arguments that override what the new
+// cluster inherits from its source.
+type databaseRestoreOptions struct {
+	Label       string
+	RestoreTime string
+	Region      string
+	Type        string
+	ClusterSize int
+}
+
+func databaseRestoreOptionsFromTool(request *mcp.CallToolRequest) (databaseRestoreOptions, string) {
+	args := request.GetArguments()
+
+	var options databaseRestoreOptions
+
+	label, ok := args[paramDatabaseLabel].(string)
+	if !ok || strings.TrimSpace(label) == "" {
+		return options, "label is required"
+	}
+
+	options.Label = label
+
+	for _, field := range []struct {
+		name string
+		dest *string
+	}{
+		{paramDatabaseRestoreTime, &options.RestoreTime},
+		{paramDatabaseRegion, &options.Region},
+		{paramDatabaseType, &options.Type},
+	} {
+		raw, exists := args[field.name]
+		if !exists {
+			continue
+		}
+
+		value, ok := raw.(string)
+		if !ok || strings.TrimSpace(value) == "" {
+			return options, field.name + " must be a non-empty string"
+		}
+
+		*field.dest = value
+	}
+
+	if options.RestoreTime != "" {
+		restoreTime, validationMessage := parseDatabaseRestoreTime(options.RestoreTime)
+		if validationMessage != "" {
+			return options, validationMessage
+		}
+
+		options.RestoreTime = restoreTime
+	}
+
+	if raw, exists := args[paramDatabaseClusterSize]; exists {
+		clusterSize, ok := numberArgToInt(raw)
+		if !ok || clusterSize < 1 {
+			return options, "cluster_size must be a positive integer"
+		}
+
+		options.ClusterSize = clusterSize
+	}
+
+	return options, ""
+}
+
+// parseDatabaseRestoreTime accepts an RFC 3339 timestamp, or one without a
+// zone read as UTC, and returns it in the zone-less UTC form the fork API
+// expects.
+func parseDatabaseRestoreTime(value string) (string, string) {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		parsed, err = time.Parse(databaseRestoreTimeFmt, value)
+	}
+
+	if err != nil {
+		return "", "restore_time must be an ISO 8601 timestamp such as 2025-01-02T15:04:05Z"
+	}
+
+	if parsed.After(time.Now()) {
+		return "", "restore_time must not be in the future"
+	}
+
+	return parsed.UTC().Format(databaseRestoreTimeFmt), ""
+}
+
+// databaseRestoreRequest builds the fork create body: the new cluster keeps
+// the source's engine major version and, unless overridden, its region, type,
+// and size.
+func databaseRestoreRequest(
+	engine *databaseBackupEngine, source *linode.DatabaseInstance, options databaseRestoreOptions,
+) *linode.CreateDatabaseInstanceRequest {
+	fork := map[string]any{"source": source.ID}
+	if options.RestoreTime != "" {
+		fork["restore_time"] = options.RestoreTime
+	}
+
+	major, _, _ := strings.Cut(source.Version, ".")
+
+	req := &linode.CreateDatabaseInstanceRequest{
+		Label:       options.Label,
+		Type:        source.Type,
+		Engine:      engine.Engine + "/" + major,
+		Region:      source.Region,
+		ClusterSize: source.ClusterSize,
+		Fork:        fork,
+	}
+
+	if options.Region != "" {
+		req.Region = options.Region
+	}
+
+	if options.Type != "" {
+		req.Type = options.Type
+	}
+
+	if options.ClusterSize != 0 {
+		req.ClusterSize = options.ClusterSize
+	}
+
+	return req
+}
+
+// databaseRestoreWait re-reads the restored instance until it is active or
+// databaseWaitTimeout passes. The first re-read is immediate and later ones
+// databaseWaitInterval apart. A wait that fails or times out leaves the
+// restore standing and is reported as a warning.
+func databaseRestoreWait(
+	ctx context.Context, client *linode.Client, engine *databaseBackupEngine, response *linodev1.DatabaseInstanceWriteResponse,
+) {
+	instance := response.GetDatabaseInstance()
+	ready := instance.GetStatus() == databaseStatusActive
+
+	waitCtx, cancel := context.WithTimeout(ctx, databaseWaitTimeout)
+	defer cancel()
+
+	for first := true; !ready; first = false {
+		if !first {
+			select {
+			case <-waitCtx.Done():
+			case <-time.After(databaseWaitInterval):
+			}
+		}
+
+		if waitCtx.Err() != nil {
+			AddWarning(ctx, "wait: %s instance %d was not active after %s", engine.MessagePrefix, instance.GetId(), databaseWaitTimeout)
+
+			break
+		}
+
+		latest, err := engine.GetProto(waitCtx, client, int(instance.GetId()))
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				continue
+			}
+
+			AddWarning(ctx, "wait: failed to re-read %s instance %d: %v", engine.MessagePrefix, instance.GetId(), err)
+
+			break
+		}
+
+		instance = latest
+		ready = instance.GetStatus() == databaseStatusActive
+	}
+
+	response.DatabaseInstance = instance
+	response.Ready = &ready
+
+	if ready {
+		response.Message += "; it is active"
+	}
+}
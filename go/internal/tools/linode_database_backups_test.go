@@ -0,0 +1,122 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeDatabaseMySQLInstanceRestoreForksAndWaits(t *testing.T) {
+	t.Parallel()
+
+	const source = `{"id":123,"label":"orders","status":"active","region":"us-east","type":"g6-dedicated-2","engine":"mysql","version":"8.0.35","cluster_size":3}`
+
+	var (
+		reads   atomic.Int32
+		created map[string]any
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body string
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/databases/mysql/instances/123":
+			body = source
+		case r.Method == http.MethodPost && r.URL.Path == "/databases/mysql/instances":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			body = `{"id":456,"label":"orders-restored","status":"provisioning","engine":"mysql","version":"8.0.35"}`
+		case r.Method == http.MethodGet && r.URL.Path == "/databases/mysql/instances/456":
+			reads.Add(1)
+
+			body = `{"id":456,"label":"orders-restored","status":"active","engine":"mysql","version":"8.0.35"}`
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	_, _, handler := tools.NewLinodeDatabaseMySQLInstanceRestoreTool(newTestConfig(srv.URL))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"source_instance_id": float64(123), "label": "orders-restored", "region": "us-west",
+		"restore_time": "2025-01-02T10:00:00+01:00", "confirm": true, "wait": true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true: %+v", result.Content)
+	}
+
+	var got struct {
+		Message          string `json:"message"`
+		Ready            *bool  `json:"ready"`
+		DatabaseInstance struct {
+			Status string `json:"status"`
+		} `json:"database_instance"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if created["engine"] != "mysql/8" || created["region"] != "us-west" || created["type"] != "g6-dedicated-2" || created["cluster_size"] != float64(3) {
+		t.Errorf("create body = %v, want the source's engine major, type, and size with the region override", created)
+	}
+
+	fork, _ := created["fork"].(map[string]any)
+	if fork["source"] != float64(123) || fork["restore_time"] != "2025-01-02T09:00:00" {
+		t.Errorf("fork = %v, want source 123 restored at 2025-01-02T09:00:00 UTC", fork)
+	}
+
+	if reads.Load() != 1 {
+		t.Errorf("restored instance reads = %d, want 1", reads.Load())
+	}
+
+	if got.Ready == nil || !*got.Ready || got.DatabaseInstance.Status != "active" {
+		t.Fatalf("ready = %v, status = %q, want an active instance", got.Ready, got.DatabaseInstance.Status)
+	}
+
+	if !strings.HasSuffix(got.Message, "restoring from instance 123; it is active") {
+		t.Errorf("message = %q", got.Message)
+	}
+}
+
+func TestLinodeDatabasePostgreSQLInstanceRestoreRejectsFutureRestoreTime(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	t.Cleanup(srv.Close)
+
+	_, _, handler := tools.NewLinodeDatabasePostgreSQLInstanceRestoreTool(newTestConfig(srv.URL))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"source_instance_id": float64(123), "label": "orders-restored",
+		"restore_time": "2999-01-01T00:00:00Z", "confirm": true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !result.IsError || result.Content[0].(mcp.TextContent).Text != "restore_time must not be in the future" {
+		t.Errorf("result = %+v, want the future restore_time rejected", result.Content)
+	}
+}
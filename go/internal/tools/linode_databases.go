@@ -583,7 +583,7 @@ func handleDatabaseEngineGetRequest(ctx context.Context, request *mcp.CallToolRe
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -597,7 +597,7 @@ func handleDatabaseEngineGetRequest(ctx context.Context, request *mcp.CallToolRe
 }
 
 func handleDatabaseMySQLConfigGetRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -611,7 +611,7 @@ func handleDatabaseMySQLConfigGetRequest(ctx context.Context, request *mcp.CallT
 }
 
 func handleDatabasePostgreSQLConfigGetRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -630,7 +630,7 @@ func handleDatabaseInstanceGetRequest(ctx context.Context, request *mcp.CallTool
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -649,7 +649,7 @@ func handleDatabasePostgreSQLInstanceGetRequest(ctx context.Context, request *mc
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -668,7 +668,7 @@ func handleDatabaseInstanceSSLGetRequest(ctx context.Context, request *mcp.CallT
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -687,7 +687,7 @@ func handleDatabasePostgreSQLInstanceSSLGetRequest(ctx context.Context, request
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -721,7 +721,7 @@ func handleDatabaseCredentialsGet(
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -810,7 +810,7 @@ func handleDatabaseInstanceCredentialsResetRequest(ctx context.Context, request
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -845,7 +845,7 @@ func handleDatabasePostgreSQLInstanceCredentialsResetRequest(ctx context.Context
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -871,7 +871,7 @@ func handleDatabaseTypeGetRequest(ctx context.Context, request *mcp.CallToolRequ
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -908,7 +908,7 @@ func runDatabaseInstanceCreate(
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -1019,7 +1019,7 @@ func handleDatabaseInstanceUpdateRequestWithClient(
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -1136,7 +1136,7 @@ func runDatabaseInstanceAction(ctx context.Context, request *mcp.CallToolRequest
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -0,0 +1,499 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// Cutover outcomes reported in DNSCutoverResponse.status.
+const (
+	dnsCutoverApplied    = "applied"
+	dnsCutoverRolledBack = "rolled_back"
+	dnsCutoverPartial    = "partial"
+)
+
+// publicResolverLookupTimeout bounds each public-resolver query so an
+// unreachable resolver delays the verification report, never the write itself.
+const publicResolverLookupTimeout = 3 * time.Second
+
+// defaultPublicResolvers are the public resolvers queried when the caller
+// names none: Cloudflare, Google, and Quad9.
+var defaultPublicResolvers = []string{"1.1.1.1", "8.8.8.8", "9.9.9.9"}
+
+// dnsCutoverArgs is the validated linode_dns_cutover input. names holds the
+// normalized record names (lowercase, "@" mapped to the apex's ""); targets
+// maps each canonical from-IP to its canonical replacement.
+type dnsCutoverArgs struct {
+	domainID  int
+	rawNames  []string
+	names     map[string]bool
+	targets   map[string]string
+	verify    bool
+	resolvers []string
+}
+
+// dnsCutoverRecordState is one matching record as the dry-run reports it in
+// current_state.
+type dnsCutoverRecordState struct {
+	ID     int32  `json:"id"`
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Target string `json:"target"`
+}
+
+// NewLinodeDNSCutoverTool creates a tool that repoints A/AAAA records from one
+// IP set to another, rolling back on failure and verifying via public resolvers.
+func NewLinodeDNSCutoverTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_dns_cutover",
+		"Blue/green DNS cutover: repoints the A/AAAA records named in names from from_ips[i] to to_ips[i]. "+
+			"Records are updated one by one; if any update fails, the records already moved are restored to their prior targets. "+
+			"The prior targets are returned for rollback, and each changed name is then checked against public resolvers. "+
+			"Pass dry_run=true to preview the target changes without updating.",
+		toolschemas.Schema("linode.mcp.v1.DNSCutoverInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeDNSCutoverRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// dnsRecordTypeForIP returns the record type that carries ip.
+func dnsRecordTypeForIP(ip net.IP) string {
+	if ip.To4() != nil {
+		return "A"
+	}
+
+	return "AAAA"
+}
+
+// parseDNSCutoverArgs validates the cutover input, returning the parsed args or
+// an error message. Shared by the real path and the dry-run preview.
+func parseDNSCutoverArgs(request *mcp.CallToolRequest) (*dnsCutoverArgs, string) {
+	args := &dnsCutoverArgs{
+		domainID:  request.GetInt("domain_id", 0),
+		rawNames:  request.GetStringSlice("names", nil),
+		names:     map[string]bool{},
+		targets:   map[string]string{},
+		verify:    request.GetBool("verify", true),
+		resolvers: request.GetStringSlice("resolvers", nil),
+	}
+
+	if args.domainID == 0 {
+		return nil, "domain_id is required"
+	}
+
+	if len(args.rawNames) == 0 {
+		return nil, "names is required"
+	}
+
+	for _, name := range args.rawNames {
+		args.names[normalizeCutoverName(name)] = true
+	}
+
+	fromIPs := request.GetStringSlice("from_ips", nil)
+	toIPs := request.GetStringSlice("to_ips", nil)
+
+	if len(fromIPs) == 0 || len(fromIPs) != len(toIPs) {
+		return nil, "from_ips and to_ips must be non-empty lists of the same length"
+	}
+
+	for idx := range fromIPs {
+		from := net.ParseIP(strings.TrimSpace(fromIPs[idx]))
+		if from == nil {
+			return nil, fmt.Sprintf("from_ips[%d] %q is not a valid IP address", idx, fromIPs[idx])
+		}
+
+		to := net.ParseIP(strings.TrimSpace(toIPs[idx]))
+		if to == nil {
+			return nil, fmt.Sprintf("to_ips[%d] %q is not a valid IP address", idx, toIPs[idx])
+		}
+
+		if dnsRecordTypeForIP(from) != dnsRecordTypeForIP(to) {
+			return nil, fmt.Sprintf("from_ips[%d] and to_ips[%d] must be the same address family", idx, idx)
+		}
+
+		if err := validateDNSRecordTarget(dnsRecordTypeForIP(to), to.String()); err != nil {
+			return nil, fmt.Sprintf("to_ips[%d]: %v", idx, err)
+		}
+
+		if _, dup := args.targets[from.String()]; dup {
+			return nil, fmt.Sprintf("from_ips[%d] %q is listed more than once", idx, fromIPs[idx])
+		}
+
+		args.targets[from.String()] = to.String()
+	}
+
+	if len(args.resolvers) == 0 {
+		args.resolvers = defaultPublicResolvers
+	}
+
+	return args, ""
+}
+
+// normalizeCutoverName maps a requested record name onto Linode's stored form:
+// case-folded, with "@" standing for the zone apex (stored as "").
+func normalizeCutoverName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "@" {
+		return ""
+	}
+
+	return name
+}
+
+// planDNSCutover selects the A/AAAA records the cutover moves and returns a
+// warning for each requested name with no record on a from-IP.
+func planDNSCutover(records []*linodev1.DomainRecord, args *dnsCutoverArgs) ([]*linodev1.DomainRecord, []string) {
+	var (
+		planned []*linodev1.DomainRecord
+		matched = map[string]bool{}
+	)
+
+	for _, record := range records {
+		recordType := strings.ToUpper(record.GetType())
+		if recordType != "A" && recordType != "AAAA" {
+			continue
+		}
+
+		name := strings.ToLower(record.GetName())
+		if !args.names[name] {
+			continue
+		}
+
+		if _, ok := args.targets[canonicalIP(record.GetTarget())]; !ok {
+			continue
+		}
+
+		planned = append(planned, record)
+		matched[name] = true
+	}
+
+	var warnings []string
+
+	seen := map[string]bool{}
+
+	for _, raw := range args.rawNames {
+		name := normalizeCutoverName(raw)
+		if matched[name] || seen[name] {
+			continue
+		}
+
+		seen[name] = true
+
+		warnings = append(warnings, fmt.Sprintf("No A/AAAA record named %q points at any of from_ips.", raw))
+	}
+
+	return planned, warnings
+}
+
+// canonicalIP returns the canonical text form of an IP, or the input unchanged
+// when it does not parse, so record targets compare against parsed from-IPs.
+func canonicalIP(value string) string {
+	if ip := net.ParseIP(value); ip != nil {
+		return ip.String()
+	}
+
+	return value
+}
+
+// dnsCutoverSideEffects is the Tier B walk: one line per record whose target
+// would change, then the rollback note.
+func dnsCutoverSideEffects(state any, args *dnsCutoverArgs) DryRunDetails {
+	records, _ := state.([]dnsCutoverRecordState)
+	sideEffects := make([]string, 0, len(records)+1)
+
+	for _, record := range records {
+		sideEffects = append(sideEffects, fmt.Sprintf("Record %d (%s %q) target changes from %s to %s.",
+			record.ID, record.Type, record.Name, record.Target, args.targets[canonicalIP(record.Target)]))
+	}
+
+	sideEffects = append(sideEffects, fmt.Sprintf(
+		"%d records are updated one by one; if any update fails, the records already moved are restored to their prior targets.",
+		len(records)))
+
+	return DryRunDetails{SideEffects: sideEffects}
+}
+
+func handleLinodeDNSCutoverRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if IsDryRun(request) {
+		args, msg := parseDNSCutoverArgs(request)
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		var warnings []string
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_dns_cutover", "PUT",
+			fmt.Sprintf("/domains/%d/records/{record_id}", args.domainID),
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				records, err := c.ListDomainRecordsProto(ctx, args.domainID)
+				if err != nil {
+					return nil, err
+				}
+
+				planned, planWarnings := planDNSCutover(records, args)
+				warnings = planWarnings
+
+				states := make([]dnsCutoverRecordState, 0, len(planned))
+				for _, record := range planned {
+					states = append(states, dnsCutoverRecordState{
+						ID: record.GetId(), Type: record.GetType(), Name: record.GetName(), Target: record.GetTarget(),
+					})
+				}
+
+				return states, nil
+			},
+			func(_ context.Context, _ *linode.Client, state any) (DryRunDetails, error) {
+				details := dnsCutoverSideEffects(state, args)
+				details.Warnings = warnings
+
+				return details, nil
+			})
+	}
+
+	if result := RequireConfirm(request, "This repoints live DNS records. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	args, msg := parseDNSCutoverArgs(request)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	records, err := client.ListDomainRecordsProto(ctx, args.domainID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve domain records: %v", err)), nil
+	}
+
+	planned, warnings := planDNSCutover(records, args)
+	if len(planned) == 0 {
+		return mcp.NewToolResultError("no A/AAAA records in names point at any of from_ips; nothing to cut over"), nil
+	}
+
+	for _, warning := range warnings {
+		AddWarning(ctx, "%s", warning)
+	}
+
+	response := applyDNSCutover(ctx, client, args, planned)
+	response.Warnings = warnings
+
+	if response.GetStatus() == dnsCutoverApplied && args.verify {
+		verifyDNSCutover(ctx, client, args, response)
+	}
+
+	return MarshalProtoToolResponse(response)
+}
+
+// applyDNSCutover moves each planned record to its new target in order. On the
+// first failure it stops and restores every record already moved, so the zone
+// ends either fully cut over or back where it started; only a failed restore
+// leaves it split, reported as status "partial".
+func applyDNSCutover(ctx context.Context, client *linode.Client, args *dnsCutoverArgs, planned []*linodev1.DomainRecord) *linodev1.DNSCutoverResponse {
+	response := &linodev1.DNSCutoverResponse{
+		DomainId:     linodeIDToInt32(args.domainID),
+		Status:       dnsCutoverApplied,
+		Changes:      make([]*linodev1.DNSCutoverChange, 0, len(planned)),
+		Verification: []*linodev1.DNSCutoverCheck{},
+	}
+
+	for _, record := range planned {
+		change := &linodev1.DNSCutoverChange{
+			RecordId:  record.GetId(),
+			Type:      record.GetType(),
+			Name:      record.GetName(),
+			OldTarget: record.GetTarget(),
+			NewTarget: args.targets[canonicalIP(record.GetTarget())],
+		}
+		response.Changes = append(response.Changes, change)
+
+		_, err := client.UpdateDomainRecordProto(ctx, args.domainID, int(record.GetId()),
+			&linode.UpdateDomainRecordRequest{Target: change.GetNewTarget()})
+		if err == nil {
+			continue
+		}
+
+		change.Error = new(err.Error())
+		response.Status = rollbackDNSCutover(ctx, client, args.domainID, response.GetChanges()[:len(response.GetChanges())-1])
+		response.Message = fmt.Sprintf("Cutover failed on record %d: %v. ", record.GetId(), err)
+
+		if response.GetStatus() == dnsCutoverRolledBack {
+			response.Message += "The records already moved were restored to their prior targets."
+		} else {
+			response.Message += "Rollback did not complete; records whose change has an error and rolled_back=false still point at the new targets."
+		}
+
+		AddWarning(ctx, "%s", response.GetMessage())
+
+		return response
+	}
+
+	response.Message = fmt.Sprintf("Cut over %d record(s) in domain %d. "+
+		"The prior targets are in changes[].old_target; to roll back, call again with from_ips and to_ips swapped.",
+		len(planned), args.domainID)
+
+	return response
+}
+
+// rollbackDNSCutover restores each moved record to its prior target, returning
+// "rolled_back" when every restore succeeded and "partial" otherwise.
+func rollbackDNSCutover(ctx context.Context, client *linode.Client, domainID int, moved []*linodev1.DNSCutoverChange) string {
+	status := dnsCutoverRolledBack
+
+	for _, change := range moved {
+		_, err := client.UpdateDomainRecordProto(ctx, domainID, int(change.GetRecordId()),
+			&linode.UpdateDomainRecordRequest{Target: change.GetOldTarget()})
+		if err != nil {
+			change.Error = new("rollback failed: " + err.Error())
+			status = dnsCutoverPartial
+
+			continue
+		}
+
+		change.RolledBack = true
+	}
+
+	return status
+}
+
+// dnsCutoverName is one changed name/type pair to verify, with the targets it
+// should now resolve to and the ones it should no longer return.
+type dnsCutoverName struct {
+	name     string
+	fqdn     string
+	typ      string
+	expected []string
+	replaced []string
+}
+
+// verifyDNSCutover queries every resolver for every changed name concurrently
+// and appends the answers to response.verification. Verification is advisory:
+// a lookup failure or stale answer becomes a check entry, never an error.
+func verifyDNSCutover(ctx context.Context, client *linode.Client, args *dnsCutoverArgs, response *linodev1.DNSCutoverResponse) {
+	domain, err := client.GetDomainProto(ctx, args.domainID)
+	if err != nil {
+		warning := fmt.Sprintf("verification skipped: failed to retrieve domain %d: %v", args.domainID, err)
+		response.Warnings = append(response.Warnings, warning)
+		AddWarning(ctx, "%s", warning)
+
+		return
+	}
+
+	names := dnsCutoverNames(domain.GetDomain(), response.GetChanges())
+	checks := make([]*linodev1.DNSCutoverCheck, len(names)*len(args.resolvers))
+
+	var wg sync.WaitGroup
+
+	for nameIdx, target := range names {
+		for resolverIdx, resolver := range args.resolvers {
+			wg.Go(func() {
+				checks[nameIdx*len(args.resolvers)+resolverIdx] = checkDNSCutoverName(ctx, resolver, target)
+			})
+		}
+	}
+
+	wg.Wait()
+
+	response.Verification = checks
+}
+
+// dnsCutoverNames groups the changes by name and record type, in first-seen
+// order, so each name is looked up once per resolver.
+func dnsCutoverNames(domain string, changes []*linodev1.DNSCutoverChange) []*dnsCutoverName {
+	var (
+		names []*dnsCutoverName
+		index = map[string]*dnsCutoverName{}
+	)
+
+	for _, change := range changes {
+		key := change.GetName() + "/" + change.GetType()
+
+		entry, ok := index[key]
+		if !ok {
+			fqdn := domain
+			if change.GetName() != "" {
+				fqdn = change.GetName() + "." + domain
+			}
+
+			entry = &dnsCutoverName{name: change.GetName(), fqdn: fqdn, typ: change.GetType()}
+			index[key] = entry
+			names = append(names, entry)
+		}
+
+		entry.expected = append(entry.expected, canonicalIP(change.GetNewTarget()))
+		entry.replaced = append(entry.replaced, canonicalIP(change.GetOldTarget()))
+	}
+
+	return names
+}
+
+// publicResolver returns a resolver that sends every query straight to
+// resolver, given as "ip" or "ip:port", instead of the system resolver.
+func publicResolver(resolver string) *net.Resolver {
+	address := resolver
+	if _, _, err := net.SplitHostPort(resolver); err != nil {
+		address = net.JoinHostPort(resolver, "53")
+	}
+
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+
+			return dialer.DialContext(ctx, network, address)
+		},
+	}
+}
+
+// checkDNSCutoverName asks one resolver for target's addresses and reports
+// whether the answer has moved to the new targets.
+func checkDNSCutoverName(ctx context.Context, resolver string, target *dnsCutoverName) *linodev1.DNSCutoverCheck {
+	check := &linodev1.DNSCutoverCheck{Name: target.fqdn, Type: target.typ, Resolver: resolver, Answers: []string{}}
+	lookup := publicResolver(resolver)
+
+	network := "ip4"
+	if target.typ == "AAAA" {
+		network = "ip6"
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, publicResolverLookupTimeout)
+	defer cancel()
+
+	ips, err := lookup.LookupIP(lookupCtx, network, target.fqdn+".")
+	if err != nil {
+		check.Error = new(err.Error())
+
+		return check
+	}
+
+	for _, ip := range ips {
+		check.Answers = append(check.Answers, ip.String())
+	}
+
+	slices.Sort(check.Answers)
+
+	check.Propagated = !slices.ContainsFunc(target.expected, func(ip string) bool { return !slices.Contains(check.GetAnswers(), ip) }) &&
+		!slices.ContainsFunc(target.replaced, func(ip string) bool { return slices.Contains(check.GetAnswers(), ip) })
+
+	return check
+}
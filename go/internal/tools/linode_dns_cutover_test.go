@@ -0,0 +1,171 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// dnsCutoverServer serves two A records for domain 5 on 45.33.1.10 and records
+// every PUT body by path. A PUT to failPath that would set the new target is
+// rejected with a 400, so the run must roll back.
+func dnsCutoverServer(t *testing.T, failPath string) (*httptest.Server, func() []string) {
+	t.Helper()
+
+	var (
+		mu   sync.Mutex
+		puts []string
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body string
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/domains/5":
+			body = `{"id":5,"domain":"example.com"}`
+		case r.Method == http.MethodGet:
+			body = `{"data":[` +
+				`{"id":41,"type":"A","name":"www","target":"45.33.1.10"},` +
+				`{"id":42,"type":"A","name":"api","target":"45.33.1.10"}` +
+				`],"page":1,"pages":1,"results":2}`
+		default:
+			payload, _ := io.ReadAll(r.Body)
+
+			mu.Lock()
+			puts = append(puts, r.URL.Path+" "+string(payload))
+			mu.Unlock()
+
+			if r.URL.Path == failPath && strings.Contains(string(payload), "45.33.2.10") {
+				w.WriteHeader(http.StatusBadRequest)
+
+				body = `{"errors":[{"reason":"invalid target"}]}`
+			} else {
+				body = `{}`
+			}
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]string{}, puts...)
+	}
+}
+
+// callDNSCutover runs linode_dns_cutover against apiURL and decodes the result.
+func callDNSCutover(t *testing.T, apiURL string, args map[string]any) map[string]any {
+	t.Helper()
+
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: apiURL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeDNSCutoverTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, args))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %s", textContent.Text)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(textContent.Text), &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return decoded
+}
+
+func TestLinodeDNSCutoverRollsBackOnFailure(t *testing.T) {
+	t.Parallel()
+
+	srv, puts := dnsCutoverServer(t, "/domains/5/records/42")
+	response := callDNSCutover(t, srv.URL, map[string]any{
+		"confirm": true, "verify": false, "domain_id": float64(5),
+		"names": []any{"www", "api"}, "from_ips": []any{"45.33.1.10"}, "to_ips": []any{"45.33.2.10"},
+	})
+
+	if response["status"] != "rolled_back" {
+		t.Errorf("status = %v, want rolled_back", response["status"])
+	}
+
+	want := []string{
+		`/domains/5/records/41 {"target":"45.33.2.10"}`,
+		`/domains/5/records/42 {"target":"45.33.2.10"}`,
+		`/domains/5/records/41 {"target":"45.33.1.10"}`,
+	}
+	if got := puts(); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("PUTs = %q, want %q", got, want)
+	}
+
+	changes, _ := response["changes"].([]any)
+	if len(changes) != 2 {
+		t.Fatalf("len(changes) = %d, want 2", len(changes))
+	}
+
+	first, _ := changes[0].(map[string]any)
+	if first["rolled_back"] != true {
+		t.Errorf("changes[0].rolled_back = %v, want true", first["rolled_back"])
+	}
+}
+
+func TestLinodeDNSCutoverVerificationFailureIsAdvisory(t *testing.T) {
+	t.Parallel()
+
+	// A UDP port that was just released answers with ICMP port unreachable,
+	// so the lookup fails fast without touching the network.
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resolver := conn.LocalAddr().String()
+	_ = conn.Close()
+
+	srv, _ := dnsCutoverServer(t, "")
+	response := callDNSCutover(t, srv.URL, map[string]any{
+		"confirm": true, "domain_id": float64(5), "resolvers": []any{resolver},
+		"names": []any{"www"}, "from_ips": []any{"45.33.1.10"}, "to_ips": []any{"45.33.2.10"},
+	})
+
+	if response["status"] != "applied" {
+		t.Errorf("status = %v, want applied", response["status"])
+	}
+
+	checks, _ := response["verification"].([]any)
+	if len(checks) != 1 {
+		t.Fatalf("len(verification) = %d, want 1", len(checks))
+	}
+
+	check, _ := checks[0].(map[string]any)
+	if check["name"] != "www.example.com" || check["resolver"] != resolver {
+		t.Errorf("check = %v, want www.example.com via %s", check, resolver)
+	}
+
+	if check["propagated"] != false || check["error"] == nil {
+		t.Errorf("check = %v, want an unpropagated check carrying an error", check)
+	}
+}
@@ -0,0 +1,391 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// Record actions reported in DNSPointAtInstanceRecord.action.
+const (
+	dnsPointCreated   = "created"
+	dnsPointUpdated   = "updated"
+	dnsPointUnchanged = "unchanged"
+)
+
+// dnsPointArgs is the validated linode_dns_point_at_instance input. name is
+// the stored record name (lowercase, the apex as "").
+type dnsPointArgs struct {
+	domainID int
+	hostname string
+	name     string
+	instance string
+	ttlSec   int
+}
+
+// dnsPointPlan is one record to write: existing is nil for a create.
+type dnsPointPlan struct {
+	recordType string
+	target     string
+	existing   *linodev1.DomainRecord
+}
+
+// dnsPointRecordState is one planned record as the dry-run reports it in
+// current_state.
+type dnsPointRecordState struct {
+	RecordID  int32  `json:"record_id"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	Target    string `json:"target"`
+	Action    string `json:"action"`
+	OldTarget string `json:"old_target"`
+}
+
+// NewLinodeDNSPointAtInstanceTool creates a tool that points a hostname's A and
+// AAAA records at an instance's current public addresses.
+func NewLinodeDNSPointAtInstanceTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_dns_point_at_instance",
+		"Points hostname in a domain at an instance (by ID or label): creates or updates the A record from the instance's public IPv4 "+
+			"and the AAAA record from its IPv6 in one call. Records already pointing at the instance are left alone. "+
+			"Refuses a hostname with more than one A (or AAAA) record; use linode_dns_cutover for those. "+
+			"Pass dry_run=true to preview the record changes without writing.",
+		toolschemas.Schema("linode.mcp.v1.DNSPointAtInstanceInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeDNSPointAtInstanceRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// parseDNSPointArgs validates the input, returning the parsed args or an
+// error message. Shared by the real path and the dry-run preview.
+func parseDNSPointArgs(request *mcp.CallToolRequest) (*dnsPointArgs, string) {
+	args := &dnsPointArgs{
+		domainID: request.GetInt("domain_id", 0),
+		hostname: strings.TrimSpace(request.GetString("hostname", "")),
+		instance: strings.TrimSpace(request.GetString("instance", "")),
+		ttlSec:   request.GetInt("ttl_sec", 0),
+	}
+
+	if args.domainID == 0 {
+		return nil, "domain_id is required"
+	}
+
+	if args.hostname == "" {
+		return nil, "hostname is required (use '@' for the zone apex)"
+	}
+
+	if err := validateDNSRecordName(args.hostname); err != nil {
+		return nil, err.Error()
+	}
+
+	if args.instance == "" {
+		return nil, "instance is required"
+	}
+
+	if args.ttlSec < 0 {
+		return nil, "ttl_sec must not be negative"
+	}
+
+	args.name = normalizeCutoverName(args.hostname)
+
+	return args, ""
+}
+
+// resolveInstanceRef finds an instance by numeric ID or, failing that, by
+// label (case-insensitive; labels are unique per account).
+func resolveInstanceRef(ctx context.Context, client *linode.Client, ref string) (*linodev1.Instance, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		instance, getErr := client.GetInstanceProto(ctx, id)
+		if getErr != nil {
+			return nil, fmt.Errorf("get instance %d: %w", id, getErr)
+		}
+
+		return instance, nil
+	}
+
+	instances, err := client.ListInstancesProto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list instances: %w", err)
+	}
+
+	for _, instance := range instances {
+		if strings.EqualFold(instance.GetLabel(), ref) {
+			return instance, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrInstanceLabelNotFound, ref)
+}
+
+// instancePublicAddresses returns the first public IPv4 and the SLAAC IPv6
+// (without its /128) of an instance; either is "" when the instance has none.
+func instancePublicAddresses(instance *linodev1.Instance) (string, string) {
+	ipv4 := ""
+
+	for _, address := range instance.GetIpv4() {
+		if ip := net.ParseIP(address); ip != nil && ip.To4() != nil && !isPrivateIPv4(ip) {
+			ipv4 = ip.String()
+
+			break
+		}
+	}
+
+	ipv6, _, _ := strings.Cut(instance.GetIpv6(), "/")
+
+	if ip := net.ParseIP(ipv6); ip == nil || ip.To4() != nil {
+		ipv6 = ""
+	} else {
+		ipv6 = ip.String()
+	}
+
+	return ipv4, ipv6
+}
+
+// planDNSPoint pairs each address with the record it lands in. A hostname
+// holding several records of one type is refused rather than guessing which
+// one to repoint.
+func planDNSPoint(records []*linodev1.DomainRecord, args *dnsPointArgs, ipv4, ipv6 string) ([]dnsPointPlan, error) {
+	var plans []dnsPointPlan
+
+	for _, pair := range [][2]string{{"A", ipv4}, {"AAAA", ipv6}} {
+		recordType, target := pair[0], pair[1]
+		if target == "" {
+			continue
+		}
+
+		var matches []*linodev1.DomainRecord
+
+		for _, record := range records {
+			if strings.EqualFold(record.GetType(), recordType) && strings.EqualFold(record.GetName(), args.name) {
+				matches = append(matches, record)
+			}
+		}
+
+		if len(matches) > 1 {
+			return nil, fmt.Errorf("%q has %d %s records: %w", args.hostname, len(matches), recordType, ErrDNSPointAmbiguous)
+		}
+
+		plan := dnsPointPlan{recordType: recordType, target: target}
+		if len(matches) == 1 {
+			plan.existing = matches[0]
+		}
+
+		plans = append(plans, plan)
+	}
+
+	return plans, nil
+}
+
+// action reports what writing the plan does to its record.
+func (p *dnsPointPlan) action() string {
+	switch {
+	case p.existing == nil:
+		return dnsPointCreated
+	case canonicalIP(p.existing.GetTarget()) == p.target:
+		return dnsPointUnchanged
+	default:
+		return dnsPointUpdated
+	}
+}
+
+// dnsPointResolve loads the instance and the domain's records and plans the
+// writes. The returned warnings name an address family the instance lacks.
+func dnsPointResolve(ctx context.Context, client *linode.Client, args *dnsPointArgs) (*linodev1.Instance, []dnsPointPlan, []string, error) {
+	instance, err := resolveInstanceRef(ctx, client, args.instance)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ipv4, ipv6 := instancePublicAddresses(instance)
+	if ipv4 == "" && ipv6 == "" {
+		return nil, nil, nil, fmt.Errorf("instance %d (%s): %w", instance.GetId(), instance.GetLabel(), ErrInstanceNoPublicAddress)
+	}
+
+	var warnings []string
+
+	if ipv4 == "" {
+		warnings = append(warnings, fmt.Sprintf("Instance %d (%s) has no public IPv4 address; no A record is written.", instance.GetId(), instance.GetLabel()))
+	}
+
+	if ipv6 == "" {
+		warnings = append(warnings, fmt.Sprintf("Instance %d (%s) has no public IPv6 address; no AAAA record is written.", instance.GetId(), instance.GetLabel()))
+	}
+
+	records, err := client.ListDomainRecordsProto(ctx, args.domainID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("list records of domain %d: %w", args.domainID, err)
+	}
+
+	plans, err := planDNSPoint(records, args, ipv4, ipv6)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return instance, plans, warnings, nil
+}
+
+// dnsPointSideEffects is the Tier B walk: one line per record written.
+func dnsPointSideEffects(plans []dnsPointPlan, args *dnsPointArgs) []string {
+	sideEffects := make([]string, 0, len(plans))
+
+	for _, plan := range plans {
+		switch plan.action() {
+		case dnsPointCreated:
+			sideEffects = append(sideEffects, fmt.Sprintf("Creates %s record %q pointing at %s.", plan.recordType, args.hostname, plan.target))
+		case dnsPointUpdated:
+			sideEffects = append(sideEffects, fmt.Sprintf("Record %d (%s %q) target changes from %s to %s.",
+				plan.existing.GetId(), plan.recordType, args.hostname, plan.existing.GetTarget(), plan.target))
+		default:
+			sideEffects = append(sideEffects, fmt.Sprintf("Record %d (%s %q) already points at %s; left alone.",
+				plan.existing.GetId(), plan.recordType, args.hostname, plan.target))
+		}
+	}
+
+	return sideEffects
+}
+
+func handleLinodeDNSPointAtInstanceRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if IsDryRun(request) {
+		args, msg := parseDNSPointArgs(request)
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		var (
+			plans    []dnsPointPlan
+			warnings []string
+		)
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_dns_point_at_instance", "POST", fmt.Sprintf("/domains/%d/records", args.domainID),
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				var err error
+
+				_, plans, warnings, err = dnsPointResolve(ctx, c, args)
+				if err != nil {
+					return nil, err
+				}
+
+				states := make([]dnsPointRecordState, 0, len(plans))
+				for _, plan := range plans {
+					states = append(states, dnsPointRecordState{
+						RecordID:  plan.existing.GetId(),
+						Type:      plan.recordType,
+						Name:      args.name,
+						Target:    plan.target,
+						Action:    plan.action(),
+						OldTarget: plan.existing.GetTarget(),
+					})
+				}
+
+				return states, nil
+			},
+			func(_ context.Context, _ *linode.Client, _ any) (DryRunDetails, error) {
+				return DryRunDetails{SideEffects: dnsPointSideEffects(plans, args), Warnings: warnings}, nil
+			})
+	}
+
+	if result := RequireConfirm(request, "This writes live DNS records. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	args, msg := parseDNSPointArgs(request)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	instance, plans, warnings, err := dnsPointResolve(ctx, client, args)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to point DNS at instance: %v", err)), nil
+	}
+
+	for _, warning := range warnings {
+		AddWarning(ctx, "%s", warning)
+	}
+
+	response := applyDNSPoint(ctx, client, args, plans)
+	response.InstanceId = instance.GetId()
+	response.InstanceLabel = instance.GetLabel()
+	response.Warnings = warnings
+
+	return MarshalProtoToolResponse(response)
+}
+
+// applyDNSPoint writes each plan that changes its record. A failed write is
+// recorded on its record and the other family is still attempted.
+func applyDNSPoint(ctx context.Context, client *linode.Client, args *dnsPointArgs, plans []dnsPointPlan) *linodev1.DNSPointAtInstanceResponse {
+	response := &linodev1.DNSPointAtInstanceResponse{
+		DomainId: linodeIDToInt32(args.domainID),
+		Records:  make([]*linodev1.DNSPointAtInstanceRecord, 0, len(plans)),
+	}
+
+	written, failed := 0, 0
+
+	for _, plan := range plans {
+		record := &linodev1.DNSPointAtInstanceRecord{
+			RecordId: plan.existing.GetId(),
+			Type:     plan.recordType,
+			Name:     args.name,
+			Target:   plan.target,
+			Action:   plan.action(),
+		}
+		response.Records = append(response.Records, record)
+
+		var (
+			result *linodev1.DomainRecord
+			err    error
+		)
+
+		switch record.GetAction() {
+		case dnsPointCreated:
+			result, err = client.CreateDomainRecordProto(ctx, args.domainID, &linode.CreateDomainRecordRequest{
+				Type: plan.recordType, Name: args.name, Target: plan.target, TTLSec: args.ttlSec,
+			})
+		case dnsPointUpdated:
+			record.OldTarget = new(plan.existing.GetTarget())
+			result, err = client.UpdateDomainRecordProto(ctx, args.domainID, int(plan.existing.GetId()), &linode.UpdateDomainRecordRequest{
+				Target: plan.target, TTLSec: args.ttlSec,
+			})
+		default:
+			continue
+		}
+
+		if err != nil {
+			record.Error = new(err.Error())
+			failed++
+
+			continue
+		}
+
+		record.RecordId = result.GetId()
+		written++
+	}
+
+	response.Message = fmt.Sprintf("Pointed %q at the instance: %d record(s) written, %d already matched.",
+		args.hostname, written, len(plans)-written-failed)
+
+	if failed > 0 {
+		response.Message += fmt.Sprintf(" %d record(s) failed, see records[].error.", failed)
+		AddWarning(ctx, "%s", response.GetMessage())
+	}
+
+	return response
+}
@@ -0,0 +1,64 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// A hostname with two A records is refused before any record is written.
+func TestLinodeDNSPointAtInstanceToolAmbiguousRecords(t *testing.T) {
+	t.Parallel()
+
+	var writes atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method != http.MethodGet:
+			writes.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		case r.URL.Path == "/linode/instances/123":
+			_, _ = w.Write([]byte(`{"id":123,"label":"web","ipv4":["45.33.1.10"],"ipv6":"2600:3c00::1/128"}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":[` +
+				`{"id":41,"type":"A","name":"www","target":"45.33.2.1"},` +
+				`{"id":42,"type":"A","name":"www","target":"45.33.2.2"}],"page":1,"pages":1,"results":2}`))
+		}
+	}))
+	defer srv.Close()
+
+	cfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+	_, _, handler := tools.NewLinodeDNSPointAtInstanceTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"confirm":   true,
+		"domain_id": float64(5),
+		"hostname":  "www",
+		"instance":  "123",
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !result.IsError || !ok || !strings.Contains(text.Text, `"www" has 2 A records`) {
+		t.Errorf("result = %v, want an ambiguous-record error", result.Content)
+	}
+
+	if got := writes.Load(); got != 0 {
+		t.Errorf("writes = %d, want 0", got)
+	}
+}
@@ -0,0 +1,878 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// bindDefaultTTL is the $TTL an export writes when the domain has no default
+// TTL of its own; it matches Linode's default.
+const bindDefaultTTL = 86400
+
+// bindTXTChunk is the longest character-string one TXT value may hold; longer
+// values are split into several quoted strings.
+const bindTXTChunk = 255
+
+// bindApex is how a zone file names the domain itself.
+const bindApex = "@"
+
+// bindHostnameTypes are the record types whose target is a host name.
+var bindHostnameTypes = []string{"NS", "CNAME", "MX", "SRV"}
+
+// bindRecordValues is how many values follow each supported record type.
+// TXT takes one or more.
+var bindRecordValues = map[string]int{
+	"A": 1, "AAAA": 1, "NS": 1, "CNAME": 1, "MX": 2, "TXT": 1, "SRV": 4, "CAA": 3,
+}
+
+// bindToken is one zone file token; quoted tokens are TXT and CAA strings.
+type bindToken struct {
+	text   string
+	quoted bool
+}
+
+// bindLine is one logical zone file line: physical lines joined by
+// parentheses, with comments removed.
+type bindLine struct {
+	line     int
+	indented bool
+	tokens   []bindToken
+}
+
+// bindEntry is one parsed zone file record. name is "@", a name relative to
+// the domain, or an absolute name ending in "."; origin is the $ORIGIN in
+// force, which relative targets are qualified against. skip is set for
+// entries the import never creates.
+type bindEntry struct {
+	line     int
+	name     string
+	origin   string
+	ttlSec   int
+	record   linode.CreateDomainRecordRequest
+	skip     string
+	rawValue string
+}
+
+// bindRecordState is one existing record as the
+// linode_domain_records_import_bind dry-run reports it in current_state.
+type bindRecordState struct {
+	ID     int32  `json:"id"`
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Target string `json:"target"`
+}
+
+// bindImportState is the linode_domain_records_import_bind dry-run
+// current_state: the domain name and its existing records.
+type bindImportState struct {
+	Domain  string            `json:"domain"`
+	Records []bindRecordState `json:"records"`
+}
+
+// NewLinodeDomainRecordsExportBindTool creates a tool that renders a domain's
+// records as BIND zone file text.
+func NewLinodeDomainRecordsExportBindTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_domain_records_export_bind",
+		"Renders a domain's DNS records as BIND zone file text ($ORIGIN, $TTL, one line per record) for "+
+			"migrating to another DNS provider or keeping a backup. The SOA and apex NS records are managed by "+
+			"Linode and are not included.",
+		toolschemas.Schema("linode.mcp.v1.DomainRecordsExportBindInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeDomainRecordsExportBindRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+// NewLinodeDomainRecordsImportBindTool creates a tool that parses BIND zone
+// file text and creates its records in a domain.
+func NewLinodeDomainRecordsImportBindTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_domain_records_import_bind",
+		"Parses pasted BIND zone file text and creates its A, AAAA, NS, MX, CNAME, TXT, SRV, and CAA records in "+
+			"a domain, e.g. when migrating from another DNS provider. Records that already exist, the SOA, apex NS "+
+			"records, names outside the domain, and unsupported types are skipped and listed. Pass dry_run=true "+
+			"to preview the per-record plan without creating anything.",
+		toolschemas.Schema("linode.mcp.v1.DomainRecordsImportBindInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeDomainRecordsImportBindRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+func handleLinodeDomainRecordsExportBindRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	domainID, validationMessage := requiredIDArgument(request, "domain_id")
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	domain, err := client.GetDomainProto(ctx, domainID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve domain %d: %v", domainID, err)), nil
+	}
+
+	records, err := client.ListDomainRecordsProto(ctx, domainID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve domain records: %v", err)), nil
+	}
+
+	return MarshalProtoToolResponse(&linodev1.DomainRecordsExportBindResponse{
+		Message:     fmt.Sprintf("Exported %d records from %s as a BIND zone file", len(records), domain.GetDomain()),
+		DomainId:    domain.GetId(),
+		Domain:      domain.GetDomain(),
+		RecordCount: linodeIDToInt32(len(records)),
+		ZoneFile:    renderBindZone(domain, records),
+	})
+}
+
+// renderBindZone renders the records as zone file text, one tab-separated
+// line per record in the order the API lists them.
+func renderBindZone(domain *linodev1.Domain, records []*linodev1.DomainRecord) string {
+	ttl := int(domain.GetTtlSec())
+	if ttl <= 0 {
+		ttl = bindDefaultTTL
+	}
+
+	var zone strings.Builder
+
+	fmt.Fprintf(&zone, "; %s exported from Linode domain %d.\n", domain.GetDomain(), domain.GetId())
+	zone.WriteString("; The SOA and apex NS records are managed by the DNS provider and are not included.\n")
+	fmt.Fprintf(&zone, "$ORIGIN %s.\n$TTL %d\n", domain.GetDomain(), ttl)
+
+	for _, record := range records {
+		fields := []string{bindRecordOwner(record)}
+		if record.GetTtlSec() > 0 {
+			fields = append(fields, strconv.Itoa(int(record.GetTtlSec())))
+		}
+
+		fields = append(fields, "IN", record.GetType())
+
+		switch record.GetType() {
+		case "MX":
+			fields = append(fields, strconv.Itoa(int(record.GetPriority())), bindHostname(record.GetTarget()))
+		case "SRV":
+			fields = append(fields, strconv.Itoa(int(record.GetPriority())), strconv.Itoa(int(record.GetWeight())),
+				strconv.Itoa(int(record.GetPort())), bindHostname(record.GetTarget()))
+		case "NS", "CNAME":
+			fields = append(fields, bindHostname(record.GetTarget()))
+		case "TXT":
+			fields = append(fields, bindQuoteTXT(record.GetTarget()))
+		case "CAA":
+			fields = append(fields, "0", record.GetTag(), bindQuote(record.GetTarget()))
+		default:
+			fields = append(fields, record.GetTarget())
+		}
+
+		zone.WriteString(strings.Join(fields, "\t") + "\n")
+	}
+
+	return zone.String()
+}
+
+// bindRecordOwner is a record's owner name relative to the zone. SRV owners
+// carry the service and protocol labels.
+func bindRecordOwner(record *linodev1.DomainRecord) string {
+	name := record.GetName()
+	if record.GetType() == "SRV" && !strings.HasPrefix(name, "_") {
+		owner := "_" + strings.TrimLeft(record.GetService(), "_") + "._" + strings.TrimLeft(record.GetProtocol(), "_")
+		if name != "" {
+			owner += "." + name
+		}
+
+		return owner
+	}
+
+	if name == "" {
+		return bindApex
+	}
+
+	return name
+}
+
+// bindHostname writes a dotted host name target as an absolute name; a bare
+// label stays relative to the zone.
+func bindHostname(target string) string {
+	if target == "" || strings.HasSuffix(target, ".") || !strings.Contains(target, ".") {
+		return target
+	}
+
+	return target + "."
+}
+
+// bindQuote quotes one character-string, escaping backslashes and quotes.
+func bindQuote(value string) string {
+	return `"` + strings.ReplaceAll(strings.ReplaceAll(value, `\`, `\\`), `"`, `\"`) + `"`
+}
+
+// bindQuoteTXT quotes a TXT value, splitting it into 255-byte strings.
+func bindQuoteTXT(value string) string {
+	if len(value) <= bindTXTChunk {
+		return bindQuote(value)
+	}
+
+	var chunks []string
+
+	for len(value) > bindTXTChunk {
+		chunks = append(chunks, bindQuote(value[:bindTXTChunk]))
+		value = value[bindTXTChunk:]
+	}
+
+	return strings.Join(append(chunks, bindQuote(value)), " ")
+}
+
+// tokenizeBindZone splits zone file text into logical lines, dropping
+// comments and joining lines inside parentheses. A non-empty string is a
+// validation message.
+func tokenizeBindZone(text string) ([]bindLine, string) {
+	var (
+		lines []bindLine
+		cur   bindLine
+		depth int
+	)
+
+	lineNo := 1
+	atLineStart := true
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+
+		if atLineStart && depth == 0 {
+			if len(cur.tokens) > 0 {
+				lines = append(lines, cur)
+			}
+
+			cur = bindLine{line: lineNo, indented: c == ' ' || c == '\t'}
+		}
+
+		atLineStart = false
+
+		switch c {
+		case '\n':
+			lineNo++
+			atLineStart = true
+		case ' ', '\t', '\r':
+		case ';':
+			for i+1 < len(text) && text[i+1] != '\n' {
+				i++
+			}
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return nil, fmt.Sprintf("zone_file line %d: unbalanced ')'", lineNo)
+			}
+
+			depth--
+		case '"':
+			var value strings.Builder
+
+			start := lineNo
+			closed := false
+
+			for i++; i < len(text); i++ {
+				if text[i] == '\\' && i+1 < len(text) {
+					i++
+				} else if text[i] == '"' {
+					closed = true
+
+					break
+				}
+
+				if text[i] == '\n' {
+					lineNo++
+				}
+
+				value.WriteByte(text[i])
+			}
+
+			if !closed {
+				return nil, fmt.Sprintf("zone_file line %d: unterminated quoted string", start)
+			}
+
+			cur.tokens = append(cur.tokens, bindToken{text: value.String(), quoted: true})
+		default:
+			start := i
+			for i+1 < len(text) && !strings.ContainsRune(" \t\r\n;()\"", rune(text[i+1])) {
+				i++
+			}
+
+			cur.tokens = append(cur.tokens, bindToken{text: text[start : i+1]})
+		}
+	}
+
+	if depth > 0 {
+		return nil, fmt.Sprintf("zone_file line %d: unbalanced '('", cur.line)
+	}
+
+	if len(cur.tokens) > 0 {
+		lines = append(lines, cur)
+	}
+
+	return lines, ""
+}
+
+// parseBindTTL reads a TTL in seconds, either bare or with BIND's s, m, h, d,
+// and w units (e.g. 1h30m).
+func parseBindTTL(value string) (int, bool) {
+	if n, err := strconv.Atoi(value); err == nil {
+		return n, n >= 0
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, digits := 0, ""
+
+	for i := range len(value) {
+		c := value[i] | 0x20
+
+		switch {
+		case value[i] >= '0' && value[i] <= '9':
+			digits += string(value[i])
+		case units[c] > 0 && digits != "":
+			n, _ := strconv.Atoi(digits)
+			total += n * units[c]
+			digits = ""
+		default:
+			return 0, false
+		}
+	}
+
+	return total, digits == ""
+}
+
+// qualifyBindName resolves an owner against the $ORIGIN in force: "@" is the
+// origin, a relative name gains it, and an absolute name is kept.
+func qualifyBindName(name, origin string) string {
+	name = strings.ToLower(name)
+
+	switch {
+	case name == bindApex:
+		if origin == "" {
+			return bindApex
+		}
+
+		return origin
+	case strings.HasSuffix(name, ".") || origin == "":
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+// parseBindZone parses zone file text into entries. Syntax errors, bad
+// values, and wrong value counts are validation messages; entries the import
+// never creates are returned with skip set.
+func parseBindZone(text string) ([]bindEntry, string) {
+	lines, validationMessage := tokenizeBindZone(text)
+	if validationMessage != "" {
+		return nil, validationMessage
+	}
+
+	var (
+		entries    []bindEntry
+		origin     string
+		defaultTTL int
+		lastOwner  string
+	)
+
+	for _, line := range lines {
+		tokens := line.tokens
+
+		if !tokens[0].quoted && strings.HasPrefix(tokens[0].text, "$") {
+			directive := strings.ToUpper(tokens[0].text)
+			if directive != "$ORIGIN" && directive != "$TTL" {
+				return nil, fmt.Sprintf("zone_file line %d: unsupported directive %s", line.line, tokens[0].text)
+			}
+
+			if len(tokens) != 2 {
+				return nil, fmt.Sprintf("zone_file line %d: %s takes one value", line.line, directive)
+			}
+
+			if directive == "$ORIGIN" {
+				if !strings.HasSuffix(tokens[1].text, ".") {
+					return nil, fmt.Sprintf("zone_file line %d: $ORIGIN must be an absolute name ending in '.'", line.line)
+				}
+
+				origin = strings.ToLower(tokens[1].text)
+
+				continue
+			}
+
+			ttl, ok := parseBindTTL(tokens[1].text)
+			if !ok {
+				return nil, fmt.Sprintf("zone_file line %d: invalid TTL %q", line.line, tokens[1].text)
+			}
+
+			defaultTTL = ttl
+
+			continue
+		}
+
+		entry := bindEntry{line: line.line, origin: origin, ttlSec: defaultTTL}
+
+		if line.indented {
+			if lastOwner == "" {
+				return nil, fmt.Sprintf("zone_file line %d: record has no owner name", line.line)
+			}
+
+			entry.name = lastOwner
+		} else {
+			entry.name = qualifyBindName(tokens[0].text, origin)
+			lastOwner = entry.name
+			tokens = tokens[1:]
+		}
+
+		for len(tokens) > 0 && !tokens[0].quoted {
+			word := tokens[0].text
+			if strings.EqualFold(word, "IN") {
+				tokens = tokens[1:]
+
+				continue
+			}
+
+			if word[0] < '0' || word[0] > '9' {
+				break
+			}
+
+			ttl, ok := parseBindTTL(word)
+			if !ok {
+				return nil, fmt.Sprintf("zone_file line %d: invalid TTL %q", line.line, word)
+			}
+
+			entry.ttlSec = ttl
+			tokens = tokens[1:]
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Sprintf("zone_file line %d: missing record type", line.line)
+		}
+
+		entry.record.Type = strings.ToUpper(tokens[0].text)
+
+		if msg := parseBindValues(&entry, tokens[1:]); msg != "" {
+			return nil, fmt.Sprintf("zone_file line %d: %s", line.line, msg)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, ""
+}
+
+// parseBindValues checks the values after the record type and fills in the
+// create request, returning a validation message or "".
+func parseBindValues(entry *bindEntry, values []bindToken) string {
+	recordType := entry.record.Type
+
+	want, supported := bindRecordValues[recordType]
+	if !supported {
+		if recordType == "SOA" {
+			entry.skip = "the SOA record is managed by Linode"
+		} else {
+			entry.skip = fmt.Sprintf("record type %s is not supported by Linode DNS", recordType)
+		}
+
+		return ""
+	}
+
+	if recordType == "TXT" {
+		if len(values) == 0 {
+			return "TXT record needs at least 1 value"
+		}
+	} else if len(values) != want {
+		return fmt.Sprintf("%s record needs %d values, got %d", recordType, want, len(values))
+	}
+
+	numbers := make([]int, 0, len(values))
+
+	for _, value := range values[:bindNumericValues(recordType)] {
+		n, err := strconv.Atoi(value.text)
+		if err != nil || n < 0 || n > 65535 {
+			return fmt.Sprintf("invalid %s value %q", recordType, value.text)
+		}
+
+		numbers = append(numbers, n)
+	}
+
+	last := values[len(values)-1].text
+
+	switch recordType {
+	case "A":
+		if ip := net.ParseIP(last); ip == nil || ip.To4() == nil || strings.Contains(last, ":") {
+			return fmt.Sprintf("invalid IPv4 address %q", last)
+		}
+	case "AAAA":
+		if ip := net.ParseIP(last); ip == nil || !strings.Contains(last, ":") {
+			return fmt.Sprintf("invalid IPv6 address %q", last)
+		}
+	case "MX":
+		entry.record.Priority = numbers[0]
+	case "SRV":
+		labels := strings.SplitN(entry.name, ".", 3)
+		if len(labels) < 2 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+			return fmt.Sprintf("SRV owner %q must start with _service._protocol", entry.name)
+		}
+
+		entry.record.Service = labels[0][1:]
+		entry.record.Protocol = labels[1][1:]
+		entry.name = bindApex
+
+		if len(labels) == 3 {
+			entry.name = labels[2]
+		}
+
+		entry.record.Priority, entry.record.Weight, entry.record.Port = numbers[0], numbers[1], numbers[2]
+	case "TXT":
+		var text strings.Builder
+		for _, value := range values {
+			text.WriteString(value.text)
+		}
+
+		last = text.String()
+	case "CAA":
+		entry.record.Tag = values[1].text
+	}
+
+	entry.rawValue = last
+
+	return ""
+}
+
+// bindNumericValues is how many leading values of a record type are numbers.
+func bindNumericValues(recordType string) int {
+	switch recordType {
+	case "MX":
+		return 1
+	case "SRV":
+		return 3
+	case "CAA":
+		return 1
+	default:
+		return 0
+	}
+}
+
+// relativeBindName makes a parsed name relative to the domain: "" is the apex
+// and ok is false for a name outside the domain.
+func relativeBindName(name, domain string) (string, bool) {
+	if name == bindApex {
+		return "", true
+	}
+
+	if !strings.HasSuffix(name, ".") {
+		return name, true
+	}
+
+	name = strings.TrimSuffix(name, ".")
+	if name == domain {
+		return "", true
+	}
+
+	if strings.HasSuffix(name, "."+domain) {
+		return strings.TrimSuffix(name, "."+domain), true
+	}
+
+	return "", false
+}
+
+// bindTarget resolves a host name target against the entry's origin (or the
+// domain when the zone set none), dropping the trailing dot Linode does not
+// store.
+func bindTarget(entry *bindEntry, domain string) string {
+	target := strings.ToLower(entry.rawValue)
+	base := strings.TrimSuffix(entry.origin, ".")
+
+	if base == "" {
+		base = domain
+	}
+
+	switch {
+	case target == bindApex:
+		return base
+	case strings.HasSuffix(target, "."):
+		return strings.TrimSuffix(target, ".")
+	default:
+		return target + "." + base
+	}
+}
+
+// planBindImport resolves the parsed entries against the domain and its
+// existing records into the records to create and the entries to skip.
+func planBindImport(domain string, entries []bindEntry, existing []bindRecordState) ([]*linodev1.DomainBindRecord, []*linodev1.DomainBindSkipped) {
+	creates := []*linodev1.DomainBindRecord{}
+	skipped := []*linodev1.DomainBindSkipped{}
+	domain = strings.ToLower(domain)
+
+	for i := range entries {
+		entry := &entries[i]
+		skip := &linodev1.DomainBindSkipped{Line: linodeIDToInt32(entry.line), Type: entry.record.Type, Name: entry.name}
+
+		name, inZone := relativeBindName(entry.name, domain)
+		if inZone {
+			skip.Name = name
+		}
+
+		if entry.skip != "" {
+			skip.Reason = entry.skip
+			skipped = append(skipped, skip)
+
+			continue
+		}
+
+		if !inZone {
+			skip.Reason = fmt.Sprintf("%s is outside domain %s", strings.TrimSuffix(entry.name, "."), domain)
+			skipped = append(skipped, skip)
+
+			continue
+		}
+
+		if entry.record.Type == "NS" && name == "" {
+			skip.Reason = "apex NS records are managed by Linode"
+			skipped = append(skipped, skip)
+
+			continue
+		}
+
+		target := entry.rawValue
+		if slices.Contains(bindHostnameTypes, entry.record.Type) {
+			target = bindTarget(entry, domain)
+		}
+
+		if id, found := findBindRecord(existing, entry.record.Type, name, target); found {
+			skip.Reason = fmt.Sprintf("already exists as record %d", id)
+			skipped = append(skipped, skip)
+
+			continue
+		}
+
+		creates = append(creates, &linodev1.DomainBindRecord{
+			Line:     linodeIDToInt32(entry.line),
+			Type:     entry.record.Type,
+			Name:     name,
+			Target:   target,
+			Priority: linodeIDToInt32(entry.record.Priority),
+			Weight:   linodeIDToInt32(entry.record.Weight),
+			Port:     linodeIDToInt32(entry.record.Port),
+			Service:  entry.record.Service,
+			Protocol: entry.record.Protocol,
+			Tag:      entry.record.Tag,
+			TtlSec:   linodeIDToInt32(entry.ttlSec),
+		})
+	}
+
+	return creates, skipped
+}
+
+// findBindRecord looks for an existing record with the same type, name, and
+// target, comparing names and targets case-insensitively. An existing SRV
+// name may carry its _service._protocol labels, which are dropped first.
+func findBindRecord(existing []bindRecordState, recordType, name, target string) (int32, bool) {
+	for _, record := range existing {
+		existingName := record.Name
+		if record.Type == "SRV" && strings.HasPrefix(existingName, "_") {
+			labels := strings.SplitN(existingName, ".", 3)
+			existingName = ""
+
+			if len(labels) == 3 {
+				existingName = labels[2]
+			}
+		}
+
+		if record.Type == recordType && strings.EqualFold(existingName, name) && strings.EqualFold(record.Target, target) {
+			return record.ID, true
+		}
+	}
+
+	return 0, false
+}
+
+// fetchBindImportState reads the domain and its records for the import plan.
+func fetchBindImportState(ctx context.Context, client *linode.Client, domainID int) (*bindImportState, error) {
+	domain, err := client.GetDomainProto(ctx, domainID)
+	if err != nil {
+		return nil, fmt.Errorf("domain %d: %w", domainID, err)
+	}
+
+	records, err := client.ListDomainRecordsProto(ctx, domainID)
+	if err != nil {
+		return nil, fmt.Errorf("domain %d records: %w", domainID, err)
+	}
+
+	state := &bindImportState{Domain: domain.GetDomain(), Records: make([]bindRecordState, 0, len(records))}
+	for _, record := range records {
+		state.Records = append(state.Records, bindRecordState{
+			ID: record.GetId(), Type: record.GetType(), Name: record.GetName(), Target: record.GetTarget(),
+		})
+	}
+
+	return state, nil
+}
+
+// bindImportSideEffects is the Tier B walk for
+// linode_domain_records_import_bind: one line per record to create, one per
+// skipped entry, then a summary line.
+func bindImportSideEffects(state any, entries []bindEntry) DryRunDetails {
+	importState, _ := state.(*bindImportState)
+	if importState == nil {
+		importState = &bindImportState{}
+	}
+
+	creates, skipped := planBindImport(importState.Domain, entries, importState.Records)
+	sideEffects := make([]string, 0, len(creates)+len(skipped)+1)
+
+	for _, record := range creates {
+		sideEffects = append(sideEffects, fmt.Sprintf("Line %d: creates %s record %q -> %q.",
+			record.GetLine(), record.GetType(), bindDisplayName(record.GetName()), record.GetTarget()))
+	}
+
+	for _, skip := range skipped {
+		sideEffects = append(sideEffects, fmt.Sprintf("Line %d: skips %s record %q: %s.",
+			skip.GetLine(), skip.GetType(), bindDisplayName(skip.GetName()), skip.GetReason()))
+	}
+
+	sideEffects = append(sideEffects, fmt.Sprintf("%d records would be created in %s; %d zone file entries are skipped.",
+		len(creates), importState.Domain, len(skipped)))
+
+	return DryRunDetails{SideEffects: sideEffects}
+}
+
+// bindDisplayName shows the apex as "@".
+func bindDisplayName(name string) string {
+	if name == "" {
+		return bindApex
+	}
+
+	return name
+}
+
+// bindImportArgs validates the import args and parses the zone file,
+// returning a validation message on the first problem.
+func bindImportArgs(request *mcp.CallToolRequest) (int, []bindEntry, string) {
+	domainID, validationMessage := requiredIDArgument(request, "domain_id")
+	if validationMessage != "" {
+		return 0, nil, validationMessage
+	}
+
+	zoneFile := request.GetString("zone_file", "")
+	if strings.TrimSpace(zoneFile) == "" {
+		return 0, nil, "zone_file is required"
+	}
+
+	entries, validationMessage := parseBindZone(zoneFile)
+	if validationMessage != "" {
+		return 0, nil, validationMessage
+	}
+
+	if len(entries) == 0 {
+		return 0, nil, "zone_file contains no records"
+	}
+
+	return domainID, entries, ""
+}
+
+func handleLinodeDomainRecordsImportBindRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if IsDryRun(request) {
+		domainID, entries, validationMessage := bindImportArgs(request)
+		if validationMessage != "" {
+			return mcp.NewToolResultError(validationMessage), nil
+		}
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_domain_records_import_bind", httpMethodPost,
+			fmt.Sprintf("/domains/%d/records", domainID),
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				return fetchBindImportState(ctx, c, domainID)
+			},
+			func(_ context.Context, _ *linode.Client, state any) (DryRunDetails, error) {
+				return bindImportSideEffects(state, entries), nil
+			})
+	}
+
+	if result := RequireConfirm(request, "This creates DNS records from the zone file. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	domainID, entries, validationMessage := bindImportArgs(request)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	state, err := fetchBindImportState(ctx, client, domainID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve %v", err)), nil
+	}
+
+	creates, skipped := planBindImport(state.Domain, entries, state.Records)
+
+	return MarshalProtoToolResponse(applyBindImport(ctx, client, domainID, state.Domain, creates, skipped))
+}
+
+// applyBindImport creates the planned records one at a time so the calls stay
+// inside the client's rate limit. A failed record does not stop the run: it
+// is reported on its entry and as an envelope warning.
+func applyBindImport(ctx context.Context, client *linode.Client, domainID int, domain string, creates []*linodev1.DomainBindRecord, skipped []*linodev1.DomainBindSkipped) *linodev1.DomainRecordsImportBindResponse {
+	response := &linodev1.DomainRecordsImportBindResponse{
+		DomainId:       linodeIDToInt32(domainID),
+		Domain:         domain,
+		Skipped:        linodeIDToInt32(len(skipped)),
+		Records:        creates,
+		SkippedEntries: skipped,
+	}
+
+	for _, record := range creates {
+		created, err := client.CreateDomainRecordProto(ctx, domainID, &linode.CreateDomainRecordRequest{
+			Type:     record.GetType(),
+			Name:     record.GetName(),
+			Target:   record.GetTarget(),
+			Priority: int(record.GetPriority()),
+			Weight:   int(record.GetWeight()),
+			Port:     int(record.GetPort()),
+			Service:  record.GetService(),
+			Protocol: record.GetProtocol(),
+			TTLSec:   int(record.GetTtlSec()),
+			Tag:      record.GetTag(),
+		})
+		if err != nil {
+			record.Error = new(err.Error())
+			response.Failed++
+
+			AddWarning(ctx, "line %d (%s %s): %v", record.GetLine(), record.GetType(), bindDisplayName(record.GetName()), err)
+
+			continue
+		}
+
+		record.RecordId = new(created.GetId())
+		response.Created++
+	}
+
+	response.Message = fmt.Sprintf("Imported zone file into %s: %d records created, %d skipped, %d failed",
+		domain, response.GetCreated(), response.GetSkipped(), response.GetFailed())
+
+	return response
+}
@@ -0,0 +1,254 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+const bindTestZone = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.other.net. admin.example.com. (
+		2026100101 ; serial
+		7200 3600 1209600 300 )
+@		IN	NS	ns1.other.net.
+@	300	IN	A	192.0.2.10
+www		IN	CNAME	@
+		IN	TXT	"v=spf1 " "include:_spf.example.net ~all"
+mail.example.com.	IN	MX	10 mx1
+_sip._tcp	IN	SRV	10 60 5060 sip.example.com.
+@		IN	CAA	0 issue "letsencrypt.org"
+old.example.org.	IN	A	192.0.2.99
+@		IN	SSHFP	1 1 abcdef
+`
+
+func bindTestServer(t *testing.T, created *[]map[string]any, mu *sync.Mutex) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body string
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/domains/9":
+			body = `{"id":9,"domain":"example.com","type":"master","ttl_sec":0}`
+		case r.Method == http.MethodGet && r.URL.Path == "/domains/9/records":
+			body = `{"data":[` +
+				`{"id":1,"type":"A","name":"","target":"192.0.2.10","ttl_sec":300},` +
+				`{"id":2,"type":"SRV","name":"_sip._tcp","service":"sip","protocol":"tcp","priority":10,"weight":60,"port":5060,"target":"sip.example.com"},` +
+				`{"id":3,"type":"TXT","name":"txt","target":"say \"hi\""}` +
+				`],"page":1,"pages":1,"results":3}`
+		case r.Method == http.MethodPost && r.URL.Path == "/domains/9/records":
+			raw, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			var record map[string]any
+			if err := json.Unmarshal(raw, &record); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			mu.Lock()
+			*created = append(*created, record)
+			id := 100 + len(*created)
+			mu.Unlock()
+
+			out, err := json.Marshal(map[string]any{"id": id, "type": record["type"], "name": record["name"]})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			body = string(out)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+}
+
+func TestLinodeDomainRecordsExportBindRendersZone(t *testing.T) {
+	t.Parallel()
+
+	var (
+		created []map[string]any
+		mu      sync.Mutex
+	)
+
+	srv := bindTestServer(t, &created, &mu)
+	t.Cleanup(srv.Close)
+
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeDomainRecordsExportBindTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"domain_id": 9}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var export struct {
+		ZoneFile string `json:"zone_file"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &export); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"$ORIGIN example.com.\n$TTL 86400\n",
+		"@\t300\tIN\tA\t192.0.2.10\n",
+		"_sip._tcp\tIN\tSRV\t10\t60\t5060\tsip.example.com.\n",
+		"txt\tIN\tTXT\t\"say \\\"hi\\\"\"\n",
+	} {
+		if !strings.Contains(export.ZoneFile, want) {
+			t.Errorf("zone_file = %q, want it to contain %q", export.ZoneFile, want)
+		}
+	}
+}
+
+func TestLinodeDomainRecordsImportBindCreatesMissingRecords(t *testing.T) {
+	t.Parallel()
+
+	var (
+		created []map[string]any
+		mu      sync.Mutex
+	)
+
+	srv := bindTestServer(t, &created, &mu)
+	t.Cleanup(srv.Close)
+
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeDomainRecordsImportBindTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"domain_id": 9, "zone_file": bindTestZone, "confirm": true}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var imported struct {
+		Message        string `json:"message"`
+		SkippedEntries []struct {
+			Line   int    `json:"line"`
+			Reason string `json:"reason"`
+		} `json:"skipped_entries"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &imported); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if imported.Message != "Imported zone file into example.com: 4 records created, 6 skipped, 0 failed" {
+		t.Errorf("message = %q", imported.Message)
+	}
+
+	wantCreated := []string{
+		`{"name":"www","target":"example.com","ttl_sec":3600,"type":"CNAME"}`,
+		`{"name":"www","target":"v=spf1 include:_spf.example.net ~all","ttl_sec":3600,"type":"TXT"}`,
+		`{"name":"mail","priority":10,"target":"mx1.example.com","ttl_sec":3600,"type":"MX"}`,
+		`{"tag":"issue","target":"letsencrypt.org","ttl_sec":3600,"type":"CAA"}`,
+	}
+
+	if len(created) != len(wantCreated) {
+		t.Fatalf("created %d records, want %d: %v", len(created), len(wantCreated), created)
+	}
+
+	for i, want := range wantCreated {
+		got, err := json.Marshal(created[i])
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if string(got) != want {
+			t.Errorf("created[%d] = %s, want %s", i, got, want)
+		}
+	}
+
+	reasons := make([]string, 0, len(imported.SkippedEntries))
+	for _, skip := range imported.SkippedEntries {
+		reasons = append(reasons, skip.Reason)
+	}
+
+	for _, want := range []string{
+		"the SOA record is managed by Linode",
+		"apex NS records are managed by Linode",
+		"already exists as record 1",
+		"already exists as record 2",
+		"old.example.org is outside domain example.com",
+		"record type SSHFP is not supported by Linode DNS",
+	} {
+		if !strings.Contains(strings.Join(reasons, "\n"), want) {
+			t.Errorf("skip reasons = %q, want %q", reasons, want)
+		}
+	}
+}
+
+func TestLinodeDomainRecordsImportBindRejectsBadZone(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: "http://127.0.0.1:0", Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeDomainRecordsImportBindTool(cfg)
+
+	tests := []struct {
+		name, zone, want string
+	}{
+		{name: "bad address", zone: "www IN A 300.1.1.1\n", want: `zone_file line 1: invalid IPv4 address "300.1.1.1"`},
+		{name: "missing values", zone: "@ IN MX mail\n", want: "zone_file line 1: MX record needs 2 values, got 1"},
+		{name: "unterminated", zone: "@ IN TXT \"open\n", want: "zone_file line 1: unterminated quoted string"},
+		{name: "no owner", zone: "  IN A 192.0.2.1\n", want: "zone_file line 1: record has no owner name"},
+		{name: "relative origin", zone: "$ORIGIN example.com\n", want: "zone_file line 1: $ORIGIN must be an absolute name ending in '.'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"domain_id": 9, "zone_file": tt.zone, "confirm": true}))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !result.IsError {
+				t.Fatal("result.IsError = false, want true")
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatal("ok = false, want true")
+			}
+
+			if textContent.Text != tt.want {
+				t.Errorf("error = %q, want %q", textContent.Text, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// Delegation outcomes reported in DomainDelegationCheckResponse.status.
+const (
+	delegationDelegated    = "delegated"
+	delegationPartial      = "partial"
+	delegationNotDelegated = "not_delegated"
+	delegationUnknown      = "unknown"
+)
+
+// linodeNameservers are the nameservers Linode answers hosted domains from. A
+// domain is delegated to Linode once its NS records name only these.
+var linodeNameservers = []string{
+	"ns1.linode.com", "ns2.linode.com", "ns3.linode.com", "ns4.linode.com", "ns5.linode.com",
+}
+
+// NewLinodeDomainDelegationCheckTool creates a tool that asks public resolvers
+// whether a domain's NS records point at Linode's nameservers.
+func NewLinodeDomainDelegationCheckTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_domain_delegation_check",
+		"Checks whether a domain is delegated to Linode: asks public resolvers for the domain's NS records "+
+			"and reports whether they name Linode's nameservers (ns1.linode.com through ns5.linode.com) yet. "+
+			"Records in a Linode zone only take effect once the registrar delegates the domain.",
+		toolschemas.Schema("linode.mcp.v1.DomainDelegationCheckInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeDomainDelegationCheckRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLinodeDomainDelegationCheckRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	domainID := request.GetInt("domain_id", 0)
+	if domainID == 0 {
+		return mcp.NewToolResultError("domain_id is required"), nil
+	}
+
+	resolvers := request.GetStringSlice("resolvers", nil)
+	if len(resolvers) == 0 {
+		resolvers = defaultPublicResolvers
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	domain, err := client.GetDomainProto(ctx, domainID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve domain: %v", err)), nil
+	}
+
+	return MarshalProtoToolResponse(checkDomainDelegation(ctx, domain, resolvers))
+}
+
+// checkDomainDelegation asks every resolver for domain's NS records
+// concurrently and summarizes whether they name Linode's nameservers. Lookup
+// failures become check entries, never errors, so the report is advisory.
+func checkDomainDelegation(ctx context.Context, domain *linodev1.Domain, resolvers []string) *linodev1.DomainDelegationCheckResponse {
+	checks := make([]*linodev1.DomainDelegationResolverCheck, len(resolvers))
+
+	var wg sync.WaitGroup
+
+	for idx, resolver := range resolvers {
+		wg.Go(func() {
+			checks[idx] = checkDelegationResolver(ctx, resolver, domain.GetDomain())
+		})
+	}
+
+	wg.Wait()
+
+	var answered, delegated int
+
+	for _, check := range checks {
+		if check.Error == nil {
+			answered++
+		}
+
+		if check.GetDelegated() {
+			delegated++
+		}
+	}
+
+	response := &linodev1.DomainDelegationCheckResponse{
+		DomainId:            domain.GetId(),
+		Domain:              domain.GetDomain(),
+		ExpectedNameservers: linodeNameservers,
+		Checks:              checks,
+	}
+
+	switch {
+	case answered == 0:
+		response.Status = delegationUnknown
+		response.Message = fmt.Sprintf("Delegation of %s could not be checked: no resolver answered", domain.GetDomain())
+	case delegated == answered:
+		response.Status = delegationDelegated
+		response.Message = fmt.Sprintf("Domain %s is delegated to Linode's nameservers", domain.GetDomain())
+	case delegated > 0:
+		response.Status = delegationPartial
+		response.Message = fmt.Sprintf("Domain %s is delegated to Linode on %d of %d resolvers; the change is still propagating",
+			domain.GetDomain(), delegated, answered)
+	default:
+		response.Status = delegationNotDelegated
+		response.Message = fmt.Sprintf("Domain %s is not delegated to Linode yet: set its nameservers at the registrar to %s through %s",
+			domain.GetDomain(), linodeNameservers[0], linodeNameservers[len(linodeNameservers)-1])
+	}
+
+	return response
+}
+
+// checkDelegationResolver asks one resolver for domain's NS records. The
+// domain is delegated there when every nameserver it names is Linode's.
+func checkDelegationResolver(ctx context.Context, resolver, domain string) *linodev1.DomainDelegationResolverCheck {
+	check := &linodev1.DomainDelegationResolverCheck{Resolver: resolver, Nameservers: []string{}}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, publicResolverLookupTimeout)
+	defer cancel()
+
+	records, err := publicResolver(resolver).LookupNS(lookupCtx, domain+".")
+	if err != nil {
+		check.Error = new(err.Error())
+
+		return check
+	}
+
+	for _, record := range records {
+		check.Nameservers = append(check.Nameservers, strings.ToLower(strings.TrimSuffix(record.Host, ".")))
+	}
+
+	slices.Sort(check.Nameservers)
+
+	check.Delegated = len(check.Nameservers) > 0 &&
+		!slices.ContainsFunc(check.GetNameservers(), func(host string) bool { return !slices.Contains(linodeNameservers, host) })
+
+	return check
+}
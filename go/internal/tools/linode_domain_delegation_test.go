@@ -0,0 +1,140 @@
+package tools_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// fakeNSResolver serves NS answers naming hosts for every query on a local
+// UDP port and returns its address.
+func fakeNSResolver(t *testing.T, hosts ...string) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	go func() {
+		buf := make([]byte, 512)
+
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			// The question ends at the root label, then carries type and class.
+			end := 12
+			for end < n && buf[end] != 0 {
+				end += int(buf[end]) + 1
+			}
+
+			end += 5
+
+			reply := append([]byte{}, buf[:2]...)
+			reply = append(reply, 0x81, 0x80, 0, 1)
+			reply = binary.BigEndian.AppendUint16(reply, uint16(len(hosts)))
+			reply = append(reply, 0, 0, 0, 0)
+			reply = append(reply, buf[12:end]...)
+
+			for _, host := range hosts {
+				var name []byte
+				for label := range strings.SplitSeq(host, ".") {
+					name = append(name, byte(len(label)))
+					name = append(name, label...)
+				}
+
+				name = append(name, 0)
+
+				reply = append(reply, 0xc0, 12, 0, 2, 0, 1, 0, 0, 0x0e, 0x10)
+				reply = binary.BigEndian.AppendUint16(reply, uint16(len(name)))
+				reply = append(reply, name...)
+			}
+
+			_, _ = conn.WriteTo(reply, addr)
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+func TestLinodeDomainDelegationCheckTool(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/domains/5" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":5,"domain":"example.com","type":"master","status":"active"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	linodeNS := fakeNSResolver(t, "ns1.linode.com", "ns2.linode.com")
+	registrarNS := fakeNSResolver(t, "ns1.registrar.example", "ns2.registrar.example")
+
+	tests := []struct {
+		name       string
+		resolvers  []any
+		wantStatus string
+	}{
+		{name: "delegated", resolvers: []any{linodeNS}, wantStatus: "delegated"},
+		{name: "propagating", resolvers: []any{linodeNS, registrarNS}, wantStatus: "partial"},
+		{name: "not delegated", resolvers: []any{registrarNS}, wantStatus: "not_delegated"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, handler := tools.NewLinodeDomainDelegationCheckTool(newTestConfig(srv.URL))
+
+			result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+				"domain_id": float64(5), "resolvers": tt.resolvers,
+			}))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text, ok := result.Content[0].(mcp.TextContent)
+			if result.IsError || !ok {
+				t.Fatalf("result = %v, want a report", result.Content)
+			}
+
+			var report struct {
+				Status string `json:"status"`
+				Checks []struct {
+					Resolver    string   `json:"resolver"`
+					Nameservers []string `json:"nameservers"`
+					Error       *string  `json:"error"`
+				} `json:"checks"`
+			}
+			if err := json.Unmarshal([]byte(text.Text), &report); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if report.Status != tt.wantStatus || len(report.Checks) != len(tt.resolvers) {
+				t.Fatalf("report = %+v, want status %s with one check per resolver", report, tt.wantStatus)
+			}
+
+			for _, check := range report.Checks {
+				if check.Error != nil || len(check.Nameservers) != 2 {
+					t.Errorf("check = %+v, want two nameservers and no error", check)
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"google.golang.org/protobuf/proto"
@@ -19,7 +20,9 @@ import (
 func NewLinodeDomainRecordCreateTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
 	tool := mcp.NewToolWithRawSchema(
 		"linode_domain_record_create",
-		"Creates a new DNS record within a domain. Supports A, AAAA, NS, MX, CNAME, TXT, SRV, CAA, and PTR record types.",
+		"Creates a new DNS record within a domain. Supports A, AAAA, NS, MX, CNAME, TXT, SRV, CAA, and PTR record types. "+
+			"A, AAAA, and CNAME records are checked against the domain's records first, and a conflict (a CNAME "+
+			"sharing its name, or an identical record) is reported with the ID of the record in the way.",
 		toolschemas.Schema("linode.mcp.v1.DomainRecordCreateInput"),
 	)
 
@@ -53,6 +56,43 @@ func validateDomainRecordCreateArgs(domainID int, recordType, name, target strin
 	return ""
 }
 
+// domainRecordConflictChecked reports whether a create of recordType is
+// checked against the domain's existing records first. Only the address and
+// alias types are: a CNAME cannot share its name with any other record, which
+// the API rejects without saying which record is in the way.
+func domainRecordConflictChecked(recordType string) bool {
+	switch strings.ToUpper(recordType) {
+	case "A", "AAAA", "CNAME":
+		return true
+	default:
+		return false
+	}
+}
+
+// domainRecordConflict returns why a new record would clash with one of
+// records, naming the record in the way, or "". A CNAME clashes with every
+// record at its name and every record clashes with a CNAME; an A or AAAA
+// record also clashes with an identical one.
+func domainRecordConflict(records []linode.DomainRecord, recordType, name, target string) string {
+	for _, record := range records {
+		if !strings.EqualFold(record.Name, name) {
+			continue
+		}
+
+		if strings.EqualFold(recordType, "CNAME") || strings.EqualFold(record.Type, "CNAME") {
+			return fmt.Sprintf("%s record %q conflicts with existing %s record (ID: %d): a CNAME cannot share its name with any other record",
+				strings.ToUpper(recordType), name, record.Type, record.ID)
+		}
+
+		if strings.EqualFold(record.Type, recordType) && strings.EqualFold(record.Target, target) {
+			return fmt.Sprintf("%s record %q conflicts with existing %s record (ID: %d): it already points at %s",
+				strings.ToUpper(recordType), name, record.Type, record.ID, record.Target)
+		}
+	}
+
+	return ""
+}
+
 func handleLinodeDomainRecordCreateRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
 	domainID := request.GetInt("domain_id", 0)
 	recordType := request.GetString("type", "")
@@ -86,11 +126,22 @@ func handleLinodeDomainRecordCreateRequest(ctx context.Context, request *mcp.Cal
 		return mcp.NewToolResultError(msg), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
+	if domainRecordConflictChecked(recordType) {
+		records, err := client.ListDomainRecords(ctx, domainID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create domain record: %v", err)), nil
+		}
+
+		if msg := domainRecordConflict(records, recordType, name, target); msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+	}
+
 	req := linode.CreateDomainRecordRequest{
 		Type:     recordType,
 		Name:     name,
@@ -173,7 +224,7 @@ func handleLinodeDomainRecordUpdateRequest(ctx context.Context, request *mcp.Cal
 		return mcp.NewToolResultError("record_id is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
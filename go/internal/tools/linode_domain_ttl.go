@@ -0,0 +1,201 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// domainTTLRecordState is one matching record as the linode_domain_ttl_set
+// dry-run reports it in current_state: just the fields the plan keys on, so
+// the preview stays identical across languages.
+type domainTTLRecordState struct {
+	ID     int32  `json:"id"`
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	TTLSec int32  `json:"ttl_sec"`
+}
+
+// NewLinodeDomainTTLSetTool creates a tool that sets one TTL on every record
+// (or every filtered record) in a domain.
+func NewLinodeDomainTTLSetTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_domain_ttl_set",
+		"Sets the TTL on all DNS records in a domain, optionally narrowed by record type or name, "+
+			"e.g. lowering everything to 300 before a migration. Records already at the target TTL are skipped. "+
+			"Pass dry_run=true to preview the per-record plan without updating.",
+		toolschemas.Schema("linode.mcp.v1.DomainTTLSetInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeDomainTTLSetRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// validateDomainTTLSetArgs validates the bulk TTL args, returning an error
+// message or "". Shared by the real path and the dry-run preview.
+func validateDomainTTLSetArgs(domainID, ttlSec int) string {
+	if domainID == 0 {
+		return "domain_id is required"
+	}
+
+	if ttlSec <= 0 {
+		return "ttl_sec must be a positive number of seconds"
+	}
+
+	return ""
+}
+
+// matchingDomainTTLRecords lists the domain's records and applies the type and
+// name_contains filters with linode_domain_record_list's semantics.
+func matchingDomainTTLRecords(ctx context.Context, client *linode.Client, domainID int, recordType, nameContains string) ([]*linodev1.DomainRecord, error) {
+	records, err := client.ListDomainRecordsProto(ctx, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if recordType != "" {
+		records = FilterByField(records, recordType, func(r *linodev1.DomainRecord) string { return r.GetType() })
+	}
+
+	if nameContains != "" {
+		records = FilterByContains(records, nameContains, func(r *linodev1.DomainRecord) string { return r.GetName() })
+	}
+
+	return records, nil
+}
+
+// domainTTLSetSideEffects is the Tier B walk for linode_domain_ttl_set: one
+// line per record whose TTL would change, then a summary line.
+func domainTTLSetSideEffects(state any, ttlSec int) DryRunDetails {
+	records, _ := state.([]domainTTLRecordState)
+	sideEffects := make([]string, 0, len(records)+1)
+	changing := 0
+
+	for _, record := range records {
+		if int(record.TTLSec) == ttlSec {
+			continue
+		}
+
+		changing++
+
+		sideEffects = append(sideEffects, fmt.Sprintf("Record %d (%s %q) TTL changes from %d to %d.",
+			record.ID, record.Type, record.Name, record.TTLSec, ttlSec))
+	}
+
+	sideEffects = append(sideEffects, fmt.Sprintf("%d of %d matching records change TTL to %d; %d already at %d.",
+		changing, len(records), ttlSec, len(records)-changing, ttlSec))
+
+	return DryRunDetails{SideEffects: sideEffects}
+}
+
+func handleLinodeDomainTTLSetRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	domainID := request.GetInt("domain_id", 0)
+	ttlSec := request.GetInt("ttl_sec", 0)
+	recordType := request.GetString("type", "")
+	nameContains := request.GetString("name_contains", "")
+
+	if IsDryRun(request) {
+		if msg := validateDomainTTLSetArgs(domainID, ttlSec); msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_domain_ttl_set", "PUT",
+			fmt.Sprintf("/domains/%d/records/{record_id}", domainID),
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				records, err := matchingDomainTTLRecords(ctx, c, domainID, recordType, nameContains)
+				if err != nil {
+					return nil, err
+				}
+
+				states := make([]domainTTLRecordState, 0, len(records))
+				for _, record := range records {
+					states = append(states, domainTTLRecordState{
+						ID: record.GetId(), Type: record.GetType(), Name: record.GetName(), TTLSec: record.GetTtlSec(),
+					})
+				}
+
+				return states, nil
+			},
+			func(_ context.Context, _ *linode.Client, state any) (DryRunDetails, error) {
+				return domainTTLSetSideEffects(state, ttlSec), nil
+			})
+	}
+
+	if result := RequireConfirm(request, "This updates the TTL of every matching DNS record. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	if msg := validateDomainTTLSetArgs(domainID, ttlSec); msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	records, err := matchingDomainTTLRecords(ctx, client, domainID, recordType, nameContains)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve domain records: %v", err)), nil
+	}
+
+	response := applyDomainTTL(ctx, client, domainID, ttlSec, records)
+
+	return MarshalProtoToolResponse(response)
+}
+
+// applyDomainTTL updates each record not already at ttlSec, one at a time so
+// the calls stay inside the client's rate limit. A failed record does not stop
+// the run: it is reported in its change entry and as an envelope warning.
+func applyDomainTTL(ctx context.Context, client *linode.Client, domainID, ttlSec int, records []*linodev1.DomainRecord) *linodev1.DomainTTLSetResponse {
+	target := linodeIDToInt32(ttlSec)
+	response := &linodev1.DomainTTLSetResponse{
+		DomainId: linodeIDToInt32(domainID),
+		TtlSec:   target,
+		Matched:  linodeIDToInt32(len(records)),
+		Changes:  []*linodev1.DomainTTLChange{},
+	}
+
+	for _, record := range records {
+		if record.GetTtlSec() == target {
+			response.Unchanged++
+
+			continue
+		}
+
+		change := &linodev1.DomainTTLChange{
+			RecordId:  record.GetId(),
+			Type:      record.GetType(),
+			Name:      record.GetName(),
+			OldTtlSec: record.GetTtlSec(),
+			NewTtlSec: target,
+		}
+
+		_, err := client.UpdateDomainRecordProto(ctx, domainID, int(record.GetId()), &linode.UpdateDomainRecordRequest{TTLSec: ttlSec})
+		if err != nil {
+			change.Error = new(err.Error())
+			response.Failed++
+
+			AddWarning(ctx, "record %d: %v", record.GetId(), err)
+		} else {
+			response.Updated++
+		}
+
+		response.Changes = append(response.Changes, change)
+	}
+
+	response.Message = fmt.Sprintf("TTL set to %d on domain %d: %d updated, %d already at target, %d failed",
+		ttlSec, domainID, response.GetUpdated(), response.GetUnchanged(), response.GetFailed())
+
+	return response
+}
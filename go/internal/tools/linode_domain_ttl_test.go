@@ -0,0 +1,102 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// domainTTLServer serves three records for domain 5 and rejects the PUT for
+// record 43 with a 400 so the bulk run sees one non-retryable failure.
+func domainTTLServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body string
+
+		switch {
+		case r.Method == http.MethodGet:
+			body = `{"data":[` +
+				`{"id":41,"type":"A","name":"www","ttl_sec":3600},` +
+				`{"id":42,"type":"A","name":"api","ttl_sec":120},` +
+				`{"id":43,"type":"MX","name":"","ttl_sec":3600}` +
+				`],"page":1,"pages":1,"results":3}`
+		case r.URL.Path == "/domains/5/records/43":
+			w.WriteHeader(http.StatusBadRequest)
+
+			body = `{"errors":[{"reason":"invalid record"}]}`
+		default:
+			body = `{}`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestLinodeDomainTTLSetContinuesPastFailedRecord(t *testing.T) {
+	t.Parallel()
+
+	srv := domainTTLServer(t)
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeDomainTTLSetTool(cfg)
+
+	ctx := tools.WithWarnings(t.Context())
+	req := createRequestWithArgs(t, map[string]any{"domain_id": float64(5), "ttl_sec": float64(300), "confirm": true})
+
+	result, err := handler(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var summary struct {
+		Matched int `json:"matched"`
+		Updated int `json:"updated"`
+		Failed  int `json:"failed"`
+		Changes []struct {
+			RecordID int    `json:"record_id"`
+			Error    string `json:"error"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &summary); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if summary.Matched != 3 || summary.Updated != 2 || summary.Failed != 1 {
+		t.Errorf("matched/updated/failed = %d/%d/%d, want 3/2/1", summary.Matched, summary.Updated, summary.Failed)
+	}
+
+	if len(summary.Changes) != 3 || summary.Changes[2].RecordID != 43 || summary.Changes[2].Error == "" {
+		t.Errorf("changes = %+v, want record 43 last with an error", summary.Changes)
+	}
+
+	tools.FinalizeEnvelope(ctx, result, &req, time.Now())
+
+	env := tools.EnvelopeFromResult(result)
+	if env == nil || len(env.Warnings) != 1 || !strings.HasPrefix(env.Warnings[0], "record 43: ") {
+		t.Errorf("envelope warnings = %v, want one record 43 warning", env)
+	}
+}
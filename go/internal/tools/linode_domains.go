@@ -61,7 +61,7 @@ func handleLinodeDomainGetRequest(ctx context.Context, request *mcp.CallToolRequ
 		return mcp.NewToolResultError("domain_id is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -96,7 +96,7 @@ func handleLinodeDomainZoneFileGetRequest(ctx context.Context, request *mcp.Call
 		return mcp.NewToolResultError("domain_id must be a positive integer"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -136,7 +136,7 @@ func handleLinodeDomainRecordGetRequest(ctx context.Context, request *mcp.CallTo
 		return mcp.NewToolResultError("record_id must be a positive integer"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -58,7 +58,7 @@ func handleLinodeDomainImportRequest(ctx context.Context, request *mcp.CallToolR
 		return mcp.NewToolResultError("remote_nameserver is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -126,7 +126,7 @@ func handleLinodeDomainCloneRequest(ctx context.Context, request *mcp.CallToolRe
 		return mcp.NewToolResultError("domain is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -150,7 +150,8 @@ func handleLinodeDomainCloneRequest(ctx context.Context, request *mcp.CallToolRe
 func NewLinodeDomainCreateTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
 	tool := mcp.NewToolWithRawSchema(
 		"linode_domain_create",
-		"Creates a new DNS domain. Use type 'master' for domains you control, 'slave' for secondary DNS.",
+		"Creates a new DNS domain. Use type 'master' for domains you control, 'slave' for secondary DNS. "+
+			"Pass verify_delegation=true to also report whether public DNS delegates the domain to Linode's nameservers yet.",
 		toolschemas.Schema("linode.mcp.v1.DomainCreateInput"),
 	)
 
@@ -195,7 +196,7 @@ func handleLinodeDomainCreateRequest(ctx context.Context, request *mcp.CallToolR
 		return mcp.NewToolResultError("type is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -218,6 +219,10 @@ func handleLinodeDomainCreateRequest(ctx context.Context, request *mcp.CallToolR
 		Domain:  createdDomain,
 	}
 
+	if request.GetBool("verify_delegation", false) {
+		response.Delegation = checkDomainDelegation(ctx, createdDomain, defaultPublicResolvers)
+	}
+
 	return MarshalProtoToolResponse(response)
 }
 
@@ -257,7 +262,7 @@ func handleLinodeDomainUpdateRequest(ctx context.Context, request *mcp.CallToolR
 		return mcp.NewToolResultError("domain_id is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -292,7 +297,7 @@ func handleLinodeDomainUpdateDryRun(ctx context.Context, request *mcp.CallToolRe
 		return mcp.NewToolResultError("domain_id is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -0,0 +1,276 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+const allowInstanceDefaultProtocol = "TCP"
+
+// linodePrivateIPv4 is the range Linode assigns private IPv4 addresses from.
+// Those addresses only reach other instances in the same data center, so the
+// allow rule leaves them out.
+var linodePrivateIPv4 = netip.MustParsePrefix("192.168.128.0/17")
+
+// allowInstanceArgs is the validated linode_firewall_allow_instance input.
+type allowInstanceArgs struct {
+	firewallID int
+	linodeID   int
+	ports      string
+	protocol   string
+	label      string
+}
+
+// allowInstanceState is the dry-run current_state: the addresses the rule
+// would cover and whether it replaces an earlier rule with the same label.
+type allowInstanceState struct {
+	FirewallID       int32    `json:"firewall_id"`
+	Label            string   `json:"label"`
+	LinodeID         int32    `json:"linode_id"`
+	IPv4             []string `json:"ipv4"`
+	IPv6             []string `json:"ipv6"`
+	InboundRuleCount int      `json:"inbound_rule_count"`
+	ReplacesExisting bool     `json:"replaces_existing"`
+}
+
+// NewLinodeFirewallAllowInstanceTool creates a tool that opens a firewall to
+// one instance over both IPv4 and IPv6.
+func NewLinodeFirewallAllowInstanceTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_firewall_allow_instance",
+		"Opens a Cloud Firewall to one instance: adds a single inbound ACCEPT rule, ahead of the existing rules, covering the public IPv4 "+
+			"addresses (/32) and the IPv6 address (/128) of linode_id on ports (default TCP, every port when omitted). Private IPv4 addresses "+
+			"are left out. Repeating the call with the same label replaces that rule, so it follows the instance's current addresses. "+
+			"Pass dry_run=true to preview.",
+		toolschemas.Schema("linode.mcp.v1.FirewallAllowInstanceInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeFirewallAllowInstanceRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// parseAllowInstanceArgs validates the input, returning the parsed args or an
+// error message. Shared by the real path and the dry-run preview.
+func parseAllowInstanceArgs(request *mcp.CallToolRequest) (*allowInstanceArgs, string) {
+	firewallID, msg := requiredIDArgument(request, paramFirewallID)
+	if msg != "" {
+		return nil, msg
+	}
+
+	linodeID, msg := requiredIDArgument(request, paramLinodeID)
+	if msg != "" {
+		return nil, msg
+	}
+
+	args := &allowInstanceArgs{
+		firewallID: firewallID,
+		linodeID:   linodeID,
+		ports:      strings.TrimSpace(request.GetString("ports", "")),
+	}
+
+	protocol, msg := optionalEnumChoice(request, "protocol", linodev1.FirewallAllowInstanceProtocol_Value_value)
+	if msg != "" {
+		return nil, msg
+	}
+
+	args.protocol = protocol
+	if args.protocol == "" {
+		args.protocol = allowInstanceDefaultProtocol
+	}
+
+	args.label = strings.TrimSpace(request.GetString("label", ""))
+	if args.label == "" {
+		args.label = fmt.Sprintf("allow-linode-%d", linodeID)
+	}
+
+	if !tempAllowLabelPattern.MatchString(args.label) {
+		return nil, "label must be 3-32 letters, digits, '.', '_', or '-'"
+	}
+
+	return args, ""
+}
+
+// instanceFirewallAddresses returns the instance's public IPv4 addresses as
+// /32 CIDRs and its IPv6 address as a /128, the forms the firewall API takes.
+func instanceFirewallAddresses(instance *linodev1.Instance) ([]string, []string) {
+	ipv4, ipv6 := []string{}, []string{}
+
+	for _, raw := range instance.GetIpv4() {
+		addr, err := netip.ParseAddr(raw)
+		if err != nil || !addr.Is4() || linodePrivateIPv4.Contains(addr) {
+			continue
+		}
+
+		ipv4 = append(ipv4, netip.PrefixFrom(addr, addr.BitLen()).String())
+	}
+
+	// The API reports the SLAAC address with its /128 (older responses with a
+	// /64); either way the rule wants the single host.
+	raw, _, _ := strings.Cut(instance.GetIpv6(), "/")
+	if addr, err := netip.ParseAddr(raw); err == nil && addr.Is6() && !addr.Is4In6() {
+		ipv6 = append(ipv6, netip.PrefixFrom(addr, addr.BitLen()).String())
+	}
+
+	return ipv4, ipv6
+}
+
+// allowInstanceWarnings names the address family the rule cannot cover. With
+// neither family there is no rule to add, which only a dry run reports as a
+// warning; the real call fails.
+func allowInstanceWarnings(linodeID int, ipv4, ipv6 []string) []string {
+	warnings := []string{}
+
+	switch {
+	case len(ipv4) == 0 && len(ipv6) == 0:
+		return append(warnings, fmt.Sprintf("Linode %d has no public IPv4 or IPv6 address to allow; the call would fail.", linodeID))
+	case len(ipv4) == 0:
+		warnings = append(warnings, fmt.Sprintf("Linode %d has no public IPv4 address; the rule covers IPv6 only.", linodeID))
+	case len(ipv6) == 0:
+		warnings = append(warnings, fmt.Sprintf("Linode %d has no IPv6 address; the rule covers IPv4 only.", linodeID))
+	}
+
+	return warnings
+}
+
+// allowInstanceRule builds the inbound rule accepting the instance's addresses.
+func allowInstanceRule(args *allowInstanceArgs, instance *linodev1.Instance, ipv4, ipv6 []string) *linodev1.FirewallRule {
+	return &linodev1.FirewallRule{
+		Action:      "ACCEPT",
+		Protocol:    args.protocol,
+		Ports:       args.ports,
+		Addresses:   &linodev1.FirewallAddresses{Ipv4: ipv4, Ipv6: ipv6},
+		Label:       args.label,
+		Description: fmt.Sprintf("Addresses of Linode %s (%d)", instance.GetLabel(), instance.GetId()),
+	}
+}
+
+// allowInstanceAccess describes the access the rule grants, e.g.
+// "TCP 22 from 203.0.113.5/32, 2600:3c00::1/128".
+func allowInstanceAccess(args *allowInstanceArgs, ipv4, ipv6 []string) string {
+	ports := args.ports
+	if ports == "" {
+		ports = "(every port)"
+	}
+
+	return fmt.Sprintf("%s %s from %s", args.protocol, ports, strings.Join(slices.Concat(ipv4, ipv6), ", "))
+}
+
+func handleLinodeFirewallAllowInstanceRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if IsDryRun(request) {
+		args, msg := parseAllowInstanceArgs(request)
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_firewall_allow_instance", "PUT",
+			fmt.Sprintf("/networking/firewalls/%d/rules", args.firewallID),
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				instance, err := c.GetInstanceProto(ctx, args.linodeID)
+				if err != nil {
+					return nil, err
+				}
+
+				firewall, err := c.GetFirewallProto(ctx, args.firewallID)
+				if err != nil {
+					return nil, err
+				}
+
+				ipv4, ipv6 := instanceFirewallAddresses(instance)
+
+				return allowInstanceState{
+					FirewallID:       firewall.GetId(),
+					Label:            firewall.GetLabel(),
+					LinodeID:         instance.GetId(),
+					IPv4:             ipv4,
+					IPv6:             ipv6,
+					InboundRuleCount: len(firewall.GetRules().GetInbound()),
+					ReplacesExisting: slices.ContainsFunc(firewall.GetRules().GetInbound(), func(rule *linodev1.FirewallRule) bool { return rule.GetLabel() == args.label }),
+				}, nil
+			},
+			func(_ context.Context, _ *linode.Client, state any) (DryRunDetails, error) {
+				current, _ := state.(allowInstanceState)
+				warnings := allowInstanceWarnings(args.linodeID, current.IPv4, current.IPv6)
+
+				if len(current.IPv4) == 0 && len(current.IPv6) == 0 {
+					return DryRunDetails{SideEffects: []string{}, Warnings: warnings}, nil
+				}
+
+				sideEffects := []string{fmt.Sprintf("Inbound rule %s accepting %s is added ahead of the %d existing rule(s).",
+					args.label, allowInstanceAccess(args, current.IPv4, current.IPv6), current.InboundRuleCount)}
+				if current.ReplacesExisting {
+					sideEffects = append(sideEffects, fmt.Sprintf("The existing inbound rule %s is replaced.", args.label))
+				}
+
+				return DryRunDetails{SideEffects: sideEffects, Warnings: warnings}, nil
+			})
+	}
+
+	if result := RequireConfirm(request, "This opens the firewall to the instance's addresses. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	args, msg := parseAllowInstanceArgs(request)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	instance, err := client.GetInstanceProto(ctx, args.linodeID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to update firewall %d rules: %v", args.firewallID, err)), nil
+	}
+
+	ipv4, ipv6 := instanceFirewallAddresses(instance)
+	if len(ipv4) == 0 && len(ipv6) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("Linode %d has no public IPv4 or IPv6 address to allow", args.linodeID)), nil
+	}
+
+	firewall, err := client.GetFirewallProto(ctx, args.firewallID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to update firewall %d rules: %v", args.firewallID, err)), nil
+	}
+
+	rule := allowInstanceRule(args, instance, ipv4, ipv6)
+	req, replaced := prependInboundRule(firewall.GetRules(), rule, func(existing *linodev1.FirewallRule) bool {
+		return existing.GetLabel() == args.label
+	})
+
+	rules, err := client.UpdateFirewallRulesProto(ctx, args.firewallID, req)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to update firewall %d rules: %v", args.firewallID, err)), nil
+	}
+
+	warnings := allowInstanceWarnings(args.linodeID, ipv4, ipv6)
+	for _, warning := range warnings {
+		AddWarning(ctx, "%s", warning)
+	}
+
+	return MarshalProtoToolResponse(&linodev1.FirewallAllowInstanceResponse{
+		Message: fmt.Sprintf("Firewall %d accepts %s (rule %s).",
+			args.firewallID, allowInstanceAccess(args, ipv4, ipv6), args.label),
+		FirewallId: linodeIDToInt32(args.firewallID),
+		LinodeId:   linodeIDToInt32(args.linodeID),
+		Rule:       rule,
+		Replaced:   replaced,
+		Rules:      rules,
+		Warnings:   warnings,
+	})
+}
@@ -0,0 +1,158 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// allowInstanceFirewall already holds a rule for instance 5 under the default
+// label, next to an unrelated web rule.
+const allowInstanceFirewall = `{"id":7,"label":"db","rules":{"inbound":[` +
+	`{"action":"ACCEPT","protocol":"TCP","ports":"5432","addresses":{"ipv4":["198.51.100.9/32"]},"label":"allow-linode-5"},` +
+	`{"action":"ACCEPT","protocol":"TCP","ports":"443","addresses":{"ipv4":["0.0.0.0/0"],"ipv6":["::/0"]},"label":"web"}],` +
+	`"outbound":[]}}`
+
+// allowInstanceServer serves instance 5 with the given addresses and
+// allowInstanceFirewall, and records the rules PUT.
+func allowInstanceServer(t *testing.T, instance string, put *struct{ Inbound, Outbound []map[string]any }) *config.Config {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /linode/instances/5":
+			_, _ = w.Write([]byte(instance))
+		case "GET /networking/firewalls/7":
+			_, _ = w.Write([]byte(allowInstanceFirewall))
+		case "PUT /networking/firewalls/7/rules":
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, put); err != nil {
+				t.Errorf("decode PUT body: %v", err)
+			}
+
+			_, _ = w.Write(body)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+}
+
+// One call covers the public IPv4 and the IPv6 address in a single rule,
+// leaves the private address out, and replaces the instance's earlier rule.
+func TestLinodeFirewallAllowInstanceToolCoversBothFamilies(t *testing.T) {
+	t.Parallel()
+
+	var put struct{ Inbound, Outbound []map[string]any }
+
+	instance := `{"id":5,"label":"app-1","ipv4":["203.0.113.5","192.168.140.2"],"ipv6":"2600:3C00::F03C:91FF:FE24:3A2F/128"}`
+	_, _, handler := tools.NewLinodeFirewallAllowInstanceTool(allowInstanceServer(t, instance, &put))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"confirm":     true,
+		"firewall_id": float64(7),
+		"linode_id":   float64(5),
+		"ports":       "5432",
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want success", result.Content)
+	}
+
+	var response struct {
+		Replaced bool     `json:"replaced"`
+		Warnings []string `json:"warnings"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if !response.Replaced || len(response.Warnings) != 0 {
+		t.Errorf("replaced = %v, warnings = %q, want replaced without warnings", response.Replaced, response.Warnings)
+	}
+
+	if len(put.Inbound) != 2 || put.Inbound[1]["label"] != "web" {
+		t.Fatalf("inbound = %v, want the new rule then web", put.Inbound)
+	}
+
+	wantAddresses := map[string]any{"ipv4": []any{"203.0.113.5/32"}, "ipv6": []any{"2600:3c00::f03c:91ff:fe24:3a2f/128"}}
+	if got := put.Inbound[0]["addresses"]; !reflect.DeepEqual(got, wantAddresses) {
+		t.Errorf("addresses = %v, want %v", got, wantAddresses)
+	}
+
+	if put.Inbound[0]["label"] != "allow-linode-5" || put.Inbound[0]["ports"] != "5432" || put.Inbound[0]["protocol"] != "TCP" {
+		t.Errorf("rule = %v, want TCP 5432 labelled allow-linode-5", put.Inbound[0])
+	}
+}
+
+// An instance without IPv6 still gets its IPv4 rule, with a warning naming
+// the missing family; with no public address at all the call fails unchanged.
+func TestLinodeFirewallAllowInstanceToolMissingFamilies(t *testing.T) {
+	t.Parallel()
+
+	var put struct{ Inbound, Outbound []map[string]any }
+
+	_, _, handler := tools.NewLinodeFirewallAllowInstanceTool(allowInstanceServer(t,
+		`{"id":5,"label":"app-1","ipv4":["203.0.113.5"],"ipv6":null}`, &put))
+
+	args := map[string]any{"confirm": true, "firewall_id": float64(7), "linode_id": float64(5), "label": "app-v4"}
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, args))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want success", result.Content)
+	}
+
+	var response struct {
+		Warnings []string `json:"warnings"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if want := []string{"Linode 5 has no IPv6 address; the rule covers IPv4 only."}; !reflect.DeepEqual(response.Warnings, want) {
+		t.Errorf("warnings = %q, want %q", response.Warnings, want)
+	}
+
+	if len(put.Inbound) != 3 || put.Inbound[0]["ports"] != nil {
+		t.Errorf("inbound = %v, want the new every-port rule ahead of both existing rules", put.Inbound)
+	}
+
+	_, _, handler = tools.NewLinodeFirewallAllowInstanceTool(allowInstanceServer(t,
+		`{"id":5,"label":"app-1","ipv4":["192.168.140.2"],"ipv6":""}`, &put))
+
+	result, err = handler(t.Context(), createRequestWithArgs(t, args))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, _ = result.Content[0].(mcp.TextContent)
+	if !result.IsError || text.Text != "Linode 5 has no public IPv4 or IPv6 address to allow" {
+		t.Errorf("result = %q, want the no-address error", text.Text)
+	}
+}
@@ -276,3 +276,45 @@ func TestLinodeFirewallDeviceCreateToolCreateClientError(t *testing.T) {
 		t.Errorf("error text %q does not contain %q", text.Text, "Failed to create linode_firewall_device_create")
 	}
 }
+
+func TestLinodeFirewallDeviceCreateToolNodeBalancerAlreadyProtected(t *testing.T) {
+	t.Parallel()
+
+	var posted atomic.Bool
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			posted.Store(true)
+		}
+
+		if r.URL.Path != "/nodebalancers/456/firewalls" {
+			t.Errorf("r.URL.Path = %v, want %v", r.URL.Path, "/nodebalancers/456/firewalls")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if _, err := w.Write([]byte(`{"data":[{"id":9,"label":"edge"}],"page":1,"pages":1,"results":1}`)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	_, _, handler := tools.NewLinodeFirewallDeviceCreateTool(newTestConfig(srv.URL))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{keyFirewallID: float64(123), keyBetaID: float64(456), keyType: fwDeviceTypeNodeBalancer, keyConfirm: true}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !result.IsError {
+		t.Fatal("result.IsError = false, want true")
+	}
+
+	if text, ok := result.Content[0].(mcp.TextContent); !ok || !strings.Contains(text.Text, "already assigned to firewall 9 (edge)") {
+		t.Errorf("error text %q does not name the existing firewall", text.Text)
+	}
+
+	if posted.Load() {
+		t.Error("posted.Load() = true, want false")
+	}
+}
@@ -0,0 +1,223 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// Rule directions a firewall diff reports.
+const (
+	firewallDirectionInbound  = "inbound"
+	firewallDirectionOutbound = "outbound"
+)
+
+// NewLinodeFirewallDiffTool creates a tool that compares the rules of two
+// firewalls, or of a firewall and a firewall template.
+func NewLinodeFirewallDiffTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_firewall_diff",
+		"Compares the rules of firewall_id against another firewall (other_firewall_id) or a firewall template "+
+			"(template) and reports what would change to make firewall_id match: default policy changes, rules "+
+			"added, rules removed, and labeled rules whose fields changed. Use it before promoting staging firewall "+
+			"changes to production. Rules match by label; rule order is not compared.",
+		toolschemas.Schema("linode.mcp.v1.FirewallDiffInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeFirewallDiffRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLinodeFirewallDiffRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	firewallID, validationMessage := requiredIDArgument(request, paramFirewallID)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	otherID := request.GetInt("other_firewall_id", 0)
+	template := request.GetString("template", "")
+
+	if (otherID > 0) == (template != "") {
+		return mcp.NewToolResultError("pass exactly one of other_firewall_id or template"), nil
+	}
+
+	if template != "" {
+		if msg := enumChoiceError(template, "template", linodev1.FirewallTemplateSlug_Value_value); msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	base, err := client.ListFirewallRulesProto(ctx, firewallID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve rules for firewall %d: %v", firewallID, err)), nil
+	}
+
+	var (
+		target      *linodev1.FirewallRules
+		targetLabel string
+	)
+
+	if template != "" {
+		targetLabel = "template " + template
+
+		tmpl, err := client.GetFirewallTemplateProto(ctx, template, 0, 0)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve firewall template %s: %v", template, err)), nil
+		}
+
+		target = tmpl.GetRules()
+	} else {
+		targetLabel = fmt.Sprintf("firewall %d", otherID)
+
+		target, err = client.ListFirewallRulesProto(ctx, otherID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve rules for firewall %d: %v", otherID, err)), nil
+		}
+	}
+
+	return MarshalProtoToolResponse(firewallDiff(fmt.Sprintf("firewall %d", firewallID), targetLabel, base, target))
+}
+
+// firewallDiff reports what would change to make base match target, one
+// direction at a time.
+func firewallDiff(baseLabel, targetLabel string, base, target *linodev1.FirewallRules) *linodev1.FirewallDiffResponse {
+	response := &linodev1.FirewallDiffResponse{Base: baseLabel, Target: targetLabel}
+
+	for _, direction := range []string{firewallDirectionInbound, firewallDirectionOutbound} {
+		basePolicy, baseRules := base.GetInboundPolicy(), base.GetInbound()
+		targetPolicy, targetRules := target.GetInboundPolicy(), target.GetInbound()
+
+		if direction == firewallDirectionOutbound {
+			basePolicy, baseRules = base.GetOutboundPolicy(), base.GetOutbound()
+			targetPolicy, targetRules = target.GetOutboundPolicy(), target.GetOutbound()
+		}
+
+		if basePolicy != targetPolicy {
+			response.PolicyChanges = append(response.PolicyChanges, &linodev1.FirewallPolicyChange{
+				Direction: direction, Before: basePolicy, After: targetPolicy,
+			})
+		}
+
+		diffFirewallRules(response, direction, baseRules, targetRules)
+	}
+
+	differences := len(response.GetPolicyChanges()) + len(response.GetAdded()) + len(response.GetRemoved()) + len(response.GetChanged())
+	response.Identical = differences == 0
+
+	if response.GetIdentical() {
+		response.Message = fmt.Sprintf("%s and %s have identical rules", baseLabel, targetLabel)
+	} else {
+		response.Message = fmt.Sprintf("%s differs from %s: %d policy changes, %d rules added, %d removed, %d changed",
+			baseLabel, targetLabel, len(response.GetPolicyChanges()), len(response.GetAdded()),
+			len(response.GetRemoved()), len(response.GetChanged()))
+	}
+
+	return response
+}
+
+// diffFirewallRules pairs one direction's rules by key, in order, and records
+// the unpaired rules as removed or added and paired labeled rules whose fields
+// differ as changed.
+func diffFirewallRules(response *linodev1.FirewallDiffResponse, direction string, base, target []*linodev1.FirewallRule) {
+	unmatched := make(map[string][]*linodev1.FirewallRule, len(base))
+	for _, rule := range base {
+		key := firewallRuleKey(rule)
+		unmatched[key] = append(unmatched[key], rule)
+	}
+
+	for _, rule := range target {
+		key := firewallRuleKey(rule)
+
+		candidates := unmatched[key]
+		if len(candidates) == 0 {
+			response.Added = append(response.Added, &linodev1.FirewallRuleDiff{Direction: direction, Rule: rule})
+
+			continue
+		}
+
+		before := candidates[0]
+		unmatched[key] = candidates[1:]
+
+		if fields := firewallRuleChangedFields(before, rule); len(fields) > 0 {
+			response.Changed = append(response.Changed, &linodev1.FirewallRuleChange{
+				Direction: direction, Label: rule.GetLabel(), Fields: fields, Before: before, After: rule,
+			})
+		}
+	}
+
+	// Walk base again so removed rules keep their base order.
+	for _, rule := range base {
+		key := firewallRuleKey(rule)
+		if slices.Contains(unmatched[key], rule) {
+			response.Removed = append(response.Removed, &linodev1.FirewallRuleDiff{Direction: direction, Rule: rule})
+		}
+	}
+}
+
+// firewallRuleKey identifies a rule across firewalls: its label, or for an
+// unlabeled rule every field, so unlabeled rules only ever pair when equal.
+func firewallRuleKey(rule *linodev1.FirewallRule) string {
+	if rule.GetLabel() != "" {
+		return "label:" + rule.GetLabel()
+	}
+
+	return strings.Join([]string{
+		"rule:", rule.GetAction(), rule.GetProtocol(), rule.GetPorts(),
+		strings.Join(sortedAddresses(rule.GetAddresses().GetIpv4()), ","),
+		strings.Join(sortedAddresses(rule.GetAddresses().GetIpv6()), ","),
+		rule.GetDescription(),
+	}, "|")
+}
+
+// firewallRuleChangedFields lists the fields that differ between two paired
+// rules. Address lists compare as sets.
+func firewallRuleChangedFields(before, after *linodev1.FirewallRule) []string {
+	var fields []string
+
+	if before.GetAction() != after.GetAction() {
+		fields = append(fields, "action")
+	}
+
+	if before.GetProtocol() != after.GetProtocol() {
+		fields = append(fields, "protocol")
+	}
+
+	if before.GetPorts() != after.GetPorts() {
+		fields = append(fields, "ports")
+	}
+
+	if !slices.Equal(sortedAddresses(before.GetAddresses().GetIpv4()), sortedAddresses(after.GetAddresses().GetIpv4())) {
+		fields = append(fields, "addresses.ipv4")
+	}
+
+	if !slices.Equal(sortedAddresses(before.GetAddresses().GetIpv6()), sortedAddresses(after.GetAddresses().GetIpv6())) {
+		fields = append(fields, "addresses.ipv6")
+	}
+
+	if before.GetDescription() != after.GetDescription() {
+		fields = append(fields, "description")
+	}
+
+	return fields
+}
+
+// sortedAddresses returns a sorted copy of an address list.
+func sortedAddresses(addresses []string) []string {
+	return slices.Sorted(slices.Values(addresses))
+}
@@ -0,0 +1,90 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeFirewallDiffAgainstTemplate(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body string
+
+		switch r.URL.Path {
+		case "/networking/firewalls/1/rules":
+			body = `{"inbound":[` +
+				`{"action":"ACCEPT","protocol":"TCP","ports":"22","addresses":{"ipv4":["10.0.0.0/8"]},"label":"ssh"},` +
+				`{"action":"DROP","protocol":"ICMP","addresses":{"ipv4":["0.0.0.0/0"]}}` +
+				`],"inbound_policy":"DROP","outbound":[],"outbound_policy":"ACCEPT"}`
+		case "/networking/firewalls/templates/public":
+			body = `{"slug":"public","rules":{"inbound":[` +
+				`{"action":"ACCEPT","protocol":"TCP","ports":"22","addresses":{"ipv4":["0.0.0.0/0"],"ipv6":["::/0"]},"label":"ssh"},` +
+				`{"action":"DROP","protocol":"ICMP","addresses":{"ipv4":["0.0.0.0/0"]}}` +
+				`],"inbound_policy":"DROP","outbound":[],"outbound_policy":"ACCEPT"}}`
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeFirewallDiffTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"firewall_id": 1, "template": "public"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var diff struct {
+		Target    string `json:"target"`
+		Identical bool   `json:"identical"`
+		Added     []any  `json:"added"`
+		Removed   []any  `json:"removed"`
+		Changed   []struct {
+			Label  string   `json:"label"`
+			Fields []string `json:"fields"`
+		} `json:"changed"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &diff); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if diff.Target != "template public" || diff.Identical {
+		t.Errorf("target/identical = %q/%v, want \"template public\"/false", diff.Target, diff.Identical)
+	}
+
+	// The unlabeled ICMP rule is equal on both sides, so it pairs silently.
+	if len(diff.Added) != 0 || len(diff.Removed) != 0 {
+		t.Errorf("added/removed = %v/%v, want none", diff.Added, diff.Removed)
+	}
+
+	if len(diff.Changed) != 1 || diff.Changed[0].Label != "ssh" ||
+		!slices.Equal(diff.Changed[0].Fields, []string{"addresses.ipv4", "addresses.ipv6"}) {
+		t.Errorf("changed = %+v, want ssh with addresses.ipv4 and addresses.ipv6", diff.Changed)
+	}
+}
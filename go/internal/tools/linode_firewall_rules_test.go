@@ -412,6 +412,57 @@ func TestLinodeFirewallRulesUpdateToolForwardsRuleObjectsVerbatim(t *testing.T)
 	}
 }
 
+// IPv6 entries go out in canonical form, so every spelling of the open
+// network reaches the API as "::/0".
+func TestLinodeFirewallRulesUpdateToolNormalizesIPv6(t *testing.T) {
+	t.Parallel()
+
+	sent := make(chan []byte, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		sent <- raw
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"inbound_policy":"DROP","outbound_policy":"ACCEPT","inbound":[],"outbound":[]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{
+		envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+	}}
+	_, _, handler := tools.NewLinodeFirewallRulesUpdateTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		keyFirewallID: float64(123),
+		keyInbound: []any{map[string]any{
+			"action": policyAccept, "protocol": "TCP", "ports": "443",
+			"addresses": map[string]any{keyIPv4: []any{"0.0.0.0/0"}, "ipv6": []any{"0::/0", "2001:DB8:0:0::/64"}},
+		}},
+		keyOutbound: []any{},
+		keyConfirm:  true,
+	}))
+	if err != nil || result.IsError {
+		t.Fatalf("result = %v, err = %v, want success", result, err)
+	}
+
+	var body struct {
+		Inbound []struct {
+			Addresses map[string][]string `json:"addresses"`
+		} `json:"inbound"`
+	}
+	if err := json.Unmarshal(<-sent, &body); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := body.Inbound[0].Addresses["ipv6"], []string{"::/0", "2001:db8::/64"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sent ipv6 = %v, want %v", got, want)
+	}
+
+	if got := body.Inbound[0].Addresses[keyIPv4]; !reflect.DeepEqual(got, []string{"0.0.0.0/0"}) {
+		t.Errorf("sent ipv4 = %v, want it unchanged", got)
+	}
+}
+
 func TestLinodeFirewallRulesUpdateToolRequiresExplicitConfirmBeforeClientCall(t *testing.T) {
 	t.Parallel()
 
@@ -488,6 +539,9 @@ func TestLinodeFirewallRulesUpdateToolRejectsInvalidInputsBeforeClientCall(t *te
 		"missing inbound":           {args: map[string]any{keyFirewallID: float64(123), keyOutbound: databaseJSONArray, keyConfirm: true}, want: "inbound is required"},
 		"invalid inbound":           {args: map[string]any{keyFirewallID: float64(123), keyInbound: jsonObjectEmpty, keyOutbound: databaseJSONArray, keyConfirm: true}, want: "inbound must be an array of objects"},
 		"null outbound":             {args: map[string]any{keyFirewallID: float64(123), keyInbound: databaseJSONArray, keyOutbound: `null`, keyConfirm: true}, want: "outbound must be an array of objects"},
+		"ipv6 host without prefix":  {args: map[string]any{keyFirewallID: float64(123), keyInbound: []any{map[string]any{"addresses": map[string]any{"ipv6": []any{"2001:db8::1"}}}}, keyOutbound: []any{}, keyConfirm: true}, want: `inbound[0].addresses.ipv6[0] "2001:db8::1" has no prefix length; use 2001:db8::1/128 for a single host`},
+		"ipv4 listed as ipv6":       {args: map[string]any{keyFirewallID: float64(123), keyInbound: []any{}, keyOutbound: []any{map[string]any{"addresses": map[string]any{"ipv6": []any{"::/0", "192.0.2.0/24"}}}}, keyConfirm: true}, want: `outbound[0].addresses.ipv6[1] "192.0.2.0/24" is an IPv4 address; list it under addresses.ipv4`},
+		"ipv6 host bits set":        {args: map[string]any{keyFirewallID: float64(123), keyInbound: []any{map[string]any{"addresses": map[string]any{"ipv6": []any{"2001:db8::1/64"}}}}, keyOutbound: []any{}, keyConfirm: true}, want: `inbound[0].addresses.ipv6[0] "2001:db8::1/64" has host bits set; use 2001:db8::/64`},
 	}
 
 	for name, tt := range cases {
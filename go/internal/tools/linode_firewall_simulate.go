@@ -0,0 +1,272 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+const (
+	firewallSimulateDefaultProtocol = "TCP"
+	firewallSimulateMaxPort         = 65535
+)
+
+// simulatedConnection is the connection a firewall simulation evaluates.
+type simulatedConnection struct {
+	direction string
+	address   netip.Addr
+	protocol  string
+	port      int
+}
+
+// NewLinodeFirewallSimulateTool creates a tool that evaluates a firewall's
+// rules against one connection and reports which rule decides it.
+func NewLinodeFirewallSimulateTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_firewall_simulate",
+		"Answers \"why is my connection blocked\" without trial and error: evaluates firewall_id's rules in order "+
+			"against a connection from source_ip to port over protocol (default TCP, inbound) and reports the first "+
+			"rule that matches, or the default policy when none does, with why each earlier rule did not match. "+
+			"Nothing on the firewall changes.",
+		toolschemas.Schema("linode.mcp.v1.FirewallSimulateInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeFirewallSimulateRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+// parseFirewallSimulateArgs validates the input, returning the connection to
+// simulate or an error message.
+func parseFirewallSimulateArgs(request *mcp.CallToolRequest) (*simulatedConnection, string) {
+	source := strings.TrimSpace(request.GetString("source_ip", ""))
+	if source == "" {
+		return nil, "source_ip is required"
+	}
+
+	address, err := netip.ParseAddr(source)
+	if err != nil {
+		return nil, fmt.Sprintf("source_ip %q is not an IP address", source)
+	}
+
+	conn := &simulatedConnection{address: address.Unmap()}
+
+	protocol, msg := optionalEnumChoice(request, "protocol", linodev1.FirewallSimulateProtocol_Value_value)
+	if msg != "" {
+		return nil, msg
+	}
+
+	conn.protocol = protocol
+	if conn.protocol == "" {
+		conn.protocol = firewallSimulateDefaultProtocol
+	}
+
+	direction, msg := optionalEnumChoice(request, "direction", linodev1.FirewallSimulateDirection_Value_value)
+	if msg != "" {
+		return nil, msg
+	}
+
+	conn.direction = direction
+	if conn.direction == "" {
+		conn.direction = firewallDirectionInbound
+	}
+
+	if conn.protocol == "TCP" || conn.protocol == "UDP" {
+		port, msg := boundedIntArgument(request, "port", 1, firewallSimulateMaxPort,
+			fmt.Sprintf("port must be from 1 through %d for %s", firewallSimulateMaxPort, conn.protocol))
+		if msg != "" {
+			return nil, msg
+		}
+
+		conn.port = port
+	}
+
+	return conn, ""
+}
+
+func handleLinodeFirewallSimulateRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	firewallID, validationMessage := requiredIDArgument(request, paramFirewallID)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	conn, msg := parseFirewallSimulateArgs(request)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	rules, err := client.ListFirewallRulesProto(ctx, firewallID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve rules for firewall %d: %v", firewallID, err)), nil
+	}
+
+	return MarshalProtoToolResponse(simulateFirewall(firewallID, rules, conn))
+}
+
+// simulateFirewall walks the connection's direction in rule order; the first
+// matching rule decides, and with no match the default policy does.
+func simulateFirewall(firewallID int, rules *linodev1.FirewallRules, conn *simulatedConnection) *linodev1.FirewallSimulateResponse {
+	list, policy := rules.GetInbound(), rules.GetInboundPolicy()
+	if conn.direction == firewallDirectionOutbound {
+		list, policy = rules.GetOutbound(), rules.GetOutboundPolicy()
+	}
+
+	response := &linodev1.FirewallSimulateResponse{
+		FirewallId:    linodeIDToInt32(firewallID),
+		Direction:     conn.direction,
+		SourceIp:      conn.address.String(),
+		Protocol:      conn.protocol,
+		Action:        policy,
+		DefaultPolicy: policy,
+		Steps:         []*linodev1.FirewallSimulateStep{},
+	}
+
+	if conn.port > 0 {
+		response.Port = new(linodeIDToInt32(conn.port))
+	}
+
+	for i, rule := range list {
+		step := &linodev1.FirewallSimulateStep{
+			Position: linodeIDToInt32(i + 1),
+			Label:    rule.GetLabel(),
+			Action:   rule.GetAction(),
+		}
+		response.Steps = append(response.Steps, step)
+
+		if reason := firewallRuleMismatch(rule, conn); reason != "" {
+			step.Reason = new(reason)
+
+			continue
+		}
+
+		step.Matched = true
+		response.MatchedRule = true
+		response.RulePosition = new(step.GetPosition())
+		response.Rule = rule
+		response.Action = rule.GetAction()
+
+		break
+	}
+
+	response.Message = firewallSimulateMessage(response, conn)
+
+	return response
+}
+
+// firewallSimulateMessage is the one-line verdict, naming the deciding rule.
+func firewallSimulateMessage(response *linodev1.FirewallSimulateResponse, conn *simulatedConnection) string {
+	target := conn.protocol
+	if conn.port > 0 {
+		target = fmt.Sprintf("%s port %d", conn.protocol, conn.port)
+	}
+
+	verdict := fmt.Sprintf("Firewall %d would %s %s %s from %s", response.GetFirewallId(),
+		strings.ToLower(response.GetAction()), conn.direction, target, response.GetSourceIp())
+
+	if !response.GetMatchedRule() {
+		return fmt.Sprintf("%s: no %s rule matches, so the default %s policy applies", verdict, conn.direction, response.GetDefaultPolicy())
+	}
+
+	label := response.GetRule().GetLabel()
+	if label == "" {
+		label = "unlabeled"
+	}
+
+	return fmt.Sprintf("%s: %s rule %d (%s) is the first match", verdict, conn.direction, response.GetRulePosition(), label)
+}
+
+// firewallRuleMismatch says why a rule does not match the connection, or
+// returns "" when it does.
+func firewallRuleMismatch(rule *linodev1.FirewallRule, conn *simulatedConnection) string {
+	if !strings.EqualFold(rule.GetProtocol(), conn.protocol) {
+		return fmt.Sprintf("protocol %s, not %s", rule.GetProtocol(), conn.protocol)
+	}
+
+	if conn.port > 0 && rule.GetPorts() != "" {
+		inRange, badEntry := firewallPortsContain(rule.GetPorts(), conn.port)
+		if badEntry != "" {
+			return fmt.Sprintf("ports %q do not parse at %q", rule.GetPorts(), badEntry)
+		}
+
+		if !inRange {
+			return fmt.Sprintf("port %d is not in %s", conn.port, rule.GetPorts())
+		}
+	}
+
+	family, addresses := "ipv4", rule.GetAddresses().GetIpv4()
+	if conn.address.Is6() {
+		family, addresses = "ipv6", rule.GetAddresses().GetIpv6()
+	}
+
+	if !firewallAddressesContain(addresses, conn.address) {
+		return fmt.Sprintf("%s is not in the rule's %s addresses", conn.address, family)
+	}
+
+	return ""
+}
+
+// firewallPortsContain reports whether a rule's ports field, a comma list of
+// ports and ranges such as "22, 80, 8000-8080", covers port. An entry that
+// does not parse is returned so the caller can name it.
+func firewallPortsContain(ports string, port int) (bool, string) {
+	for entry := range strings.SplitSeq(ports, ",") {
+		entry = strings.TrimSpace(entry)
+		low, high, isRange := strings.Cut(entry, "-")
+
+		first, err := strconv.Atoi(strings.TrimSpace(low))
+		if err != nil {
+			return false, entry
+		}
+
+		last := first
+		if isRange {
+			if last, err = strconv.Atoi(strings.TrimSpace(high)); err != nil {
+				return false, entry
+			}
+		}
+
+		if port >= first && port <= last {
+			return true, ""
+		}
+	}
+
+	return false, ""
+}
+
+// firewallAddressesContain reports whether any rule address, a CIDR or a bare
+// host address, covers address. Entries that do not parse never match.
+func firewallAddressesContain(addresses []string, address netip.Addr) bool {
+	for _, raw := range addresses {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(raw))
+		if err != nil {
+			host, hostErr := netip.ParseAddr(strings.TrimSpace(raw))
+			if hostErr != nil {
+				continue
+			}
+
+			prefix = netip.PrefixFrom(host.Unmap(), host.Unmap().BitLen())
+		}
+
+		if prefix.Masked().Contains(address) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,104 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeFirewallSimulateToolEvaluatesRuleOrder(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/networking/firewalls/5/rules" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"inbound":[` +
+			`{"action":"ACCEPT","protocol":"TCP","ports":"22, ssh","addresses":{"ipv4":["0.0.0.0/0"]},"label":"typo"},` +
+			`{"action":"ACCEPT","protocol":"ICMP","addresses":{"ipv4":["198.51.100.7"]},"label":"ping"},` +
+			`{"action":"ACCEPT","protocol":"TCP","ports":"1000-2000","addresses":{"ipv4":["198.51.100.0/24"]},"label":"range"}` +
+			`],"inbound_policy":"DROP",` +
+			`"outbound":[{"action":"DROP","protocol":"UDP","ports":"53","addresses":{"ipv4":["0.0.0.0/0"]},"label":"no-dns"}],` +
+			`"outbound_policy":"ACCEPT"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	tests := []struct {
+		name         string
+		args         map[string]any
+		wantAction   string
+		wantPosition int
+		wantReason   string
+	}{
+		{
+			name:         "ICMP ignores ports and matches a bare host",
+			args:         map[string]any{"source_ip": "198.51.100.7", "protocol": "ICMP"},
+			wantAction:   "ACCEPT",
+			wantPosition: 2,
+			wantReason:   "protocol TCP, not ICMP",
+		},
+		{
+			name:         "port inside a range",
+			args:         map[string]any{"source_ip": "198.51.100.9", "port": 1500},
+			wantAction:   "ACCEPT",
+			wantPosition: 3,
+			wantReason:   `ports "22, ssh" do not parse at "ssh"`,
+		},
+		{
+			name:         "outbound uses the outbound rules",
+			args:         map[string]any{"source_ip": "8.8.8.8", "port": 53, "protocol": "UDP", "direction": "outbound"},
+			wantAction:   "DROP",
+			wantPosition: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.args["firewall_id"] = 5
+
+			_, _, handler := tools.NewLinodeFirewallSimulateTool(newTestConfig(srv.URL))
+
+			result, err := handler(t.Context(), createRequestWithArgs(t, tt.args))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text, ok := result.Content[0].(mcp.TextContent)
+			if result.IsError || !ok {
+				t.Fatalf("result = %v, want a simulation", result.Content)
+			}
+
+			var simulation struct {
+				Action       string `json:"action"`
+				RulePosition int    `json:"rule_position"`
+				Steps        []struct {
+					Reason string `json:"reason"`
+				} `json:"steps"`
+			}
+			if err := json.Unmarshal([]byte(text.Text), &simulation); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if simulation.Action != tt.wantAction || simulation.RulePosition != tt.wantPosition {
+				t.Errorf("action = %s at rule %d, want %s at rule %d",
+					simulation.Action, simulation.RulePosition, tt.wantAction, tt.wantPosition)
+			}
+
+			if tt.wantReason != "" && (len(simulation.Steps) == 0 || simulation.Steps[0].Reason != tt.wantReason) {
+				t.Errorf("steps = %+v, want the first reason %q", simulation.Steps, tt.wantReason)
+			}
+		})
+	}
+}
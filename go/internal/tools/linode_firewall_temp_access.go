@@ -0,0 +1,559 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// tempRuleTag starts the description of every rule linode_firewall_temp_allow
+// adds; the RFC 3339 UTC expiry follows it. linode_firewall_expire_rules only
+// ever removes rules carrying it.
+const tempRuleTag = "linodemcp-temp expires="
+
+const (
+	tempAllowDefaultLabel    = "temp-allow"
+	tempAllowDefaultProtocol = "TCP"
+	tempAllowMaxMinutes      = 7 * 24 * 60
+)
+
+// Expiry outcomes reported in FirewallExpireRulesResponse.status.
+const (
+	expireRulesApplied = "applied"
+	expireRulesPartial = "partial"
+)
+
+// tempAllowLabelPattern is the firewall rule label format the API accepts.
+var tempAllowLabelPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{3,32}$`)
+
+// tempAllowArgs is the validated linode_firewall_temp_allow input.
+type tempAllowArgs struct {
+	firewallID int
+	source     string
+	ipv6       bool
+	ports      string
+	protocol   string
+	duration   time.Duration
+	label      string
+}
+
+// tempAllowState is the dry-run current_state: the firewall and whether an
+// earlier temporary rule for the same access would be replaced.
+type tempAllowState struct {
+	FirewallID       int32  `json:"firewall_id"`
+	Label            string `json:"label"`
+	InboundRuleCount int    `json:"inbound_rule_count"`
+	ReplacesExisting bool   `json:"replaces_existing"`
+}
+
+// expireRulesState is one firewall in the expire dry-run current_state.
+type expireRulesState struct {
+	FirewallID   int32    `json:"firewall_id"`
+	Label        string   `json:"label"`
+	RemovedRules []string `json:"removed_rules"`
+	KeptCount    int32    `json:"kept_count"`
+}
+
+// NewLinodeFirewallTempAllowTool creates a tool that opens a firewall to one
+// source for a limited time.
+func NewLinodeFirewallTempAllowTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_firewall_temp_allow",
+		"Temporarily opens a Cloud Firewall: adds an inbound ACCEPT rule for source on ports (default TCP) ahead of the existing rules, "+
+			"tagged in its description with an expiry duration_minutes from now (e.g. SSH from your IP for 120 minutes). "+
+			"The rule stays until linode_firewall_expire_rules removes it after the expiry. Repeating the call for the same "+
+			"label, source, protocol, and ports replaces the rule, extending its expiry. Pass dry_run=true to preview.",
+		toolschemas.Schema("linode.mcp.v1.FirewallTempAllowInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeFirewallTempAllowRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// NewLinodeFirewallExpireRulesTool creates a tool that removes the expired
+// rules linode_firewall_temp_allow added.
+func NewLinodeFirewallExpireRulesTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_firewall_expire_rules",
+		"Removes expired temporary rules added by linode_firewall_temp_allow from one firewall (firewall_id) or every firewall. "+
+			"Only rules whose description carries the temporary-rule expiry tag are considered; every other rule, and temporary "+
+			"rules not yet expired, are kept. Pass dry_run=true to list what would be removed.",
+		toolschemas.Schema("linode.mcp.v1.FirewallExpireRulesInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeFirewallExpireRulesRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// parseTempAllowArgs validates the input, returning the parsed args or an
+// error message. Shared by the real path and the dry-run preview.
+func parseTempAllowArgs(request *mcp.CallToolRequest) (*tempAllowArgs, string) {
+	firewallID, msg := requiredIDArgument(request, paramFirewallID)
+	if msg != "" {
+		return nil, msg
+	}
+
+	args := &tempAllowArgs{firewallID: firewallID}
+
+	source := strings.TrimSpace(request.GetString("source", ""))
+	if source == "" {
+		return nil, "source is required"
+	}
+
+	prefix, msg := parseAllowlistCIDR(source)
+	if msg != "" {
+		return nil, fmt.Sprintf("source %q %s", source, msg)
+	}
+
+	args.source, args.ipv6 = prefix.String(), !prefix.Addr().Is4()
+
+	if args.ports = strings.TrimSpace(request.GetString("ports", "")); args.ports == "" {
+		return nil, "ports is required"
+	}
+
+	protocol, msg := optionalEnumChoice(request, "protocol", linodev1.FirewallTempAllowProtocol_Value_value)
+	if msg != "" {
+		return nil, msg
+	}
+
+	args.protocol = protocol
+	if args.protocol == "" {
+		args.protocol = tempAllowDefaultProtocol
+	}
+
+	minutes, msg := boundedIntArgument(request, "duration_minutes", 1, tempAllowMaxMinutes,
+		fmt.Sprintf("duration_minutes must be between 1 and %d (7 days)", tempAllowMaxMinutes))
+	if msg != "" {
+		if _, exists := request.GetArguments()["duration_minutes"]; !exists {
+			return nil, "duration_minutes is required"
+		}
+
+		return nil, msg
+	}
+
+	args.duration = time.Duration(minutes) * time.Minute
+
+	args.label = strings.TrimSpace(request.GetString("label", ""))
+	if args.label == "" {
+		args.label = tempAllowDefaultLabel
+	}
+
+	if !tempAllowLabelPattern.MatchString(args.label) {
+		return nil, "label must be 3-32 letters, digits, '.', '_', or '-'"
+	}
+
+	return args, ""
+}
+
+// tempRuleExpiry reads the expiry tag from a rule description. ok is false
+// for a rule without the tag; err is set when the tag is present but the
+// timestamp does not parse.
+func tempRuleExpiry(description string) (time.Time, bool, error) {
+	raw, ok := strings.CutPrefix(description, tempRuleTag)
+	if !ok {
+		return time.Time{}, false, nil
+	}
+
+	raw, _, _ = strings.Cut(raw, " ")
+
+	expires, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, true, fmt.Errorf("unreadable expiry %q", raw)
+	}
+
+	return expires, true, nil
+}
+
+// sameTempAccess reports whether rule is a temporary rule granting exactly
+// the access args asks for, so a repeated call replaces it.
+func sameTempAccess(rule *linodev1.FirewallRule, args *tempAllowArgs) bool {
+	if _, temporary, _ := tempRuleExpiry(rule.GetDescription()); !temporary {
+		return false
+	}
+
+	addresses := slices.Concat(rule.GetAddresses().GetIpv4(), rule.GetAddresses().GetIpv6())
+
+	return rule.GetLabel() == args.label &&
+		strings.EqualFold(rule.GetProtocol(), args.protocol) &&
+		rule.GetPorts() == args.ports &&
+		len(addresses) == 1 && canonicalAllowlistAddress(addresses[0]) == args.source
+}
+
+// tempAllowRule builds the temporary rule expiring at expires.
+func tempAllowRule(args *tempAllowArgs, expires time.Time) *linodev1.FirewallRule {
+	addresses := &linodev1.FirewallAddresses{Ipv4: []string{args.source}}
+	if args.ipv6 {
+		addresses = &linodev1.FirewallAddresses{Ipv6: []string{args.source}}
+	}
+
+	return &linodev1.FirewallRule{
+		Action:      "ACCEPT",
+		Protocol:    args.protocol,
+		Ports:       args.ports,
+		Addresses:   addresses,
+		Label:       args.label,
+		Description: tempRuleTag + expires.UTC().Format(time.RFC3339),
+	}
+}
+
+// tempAllowRulesReplace puts rule first in the inbound list, dropping any
+// temporary rule it replaces; outbound rules are sent back unchanged.
+func tempAllowRulesReplace(rules *linodev1.FirewallRules, rule *linodev1.FirewallRule, args *tempAllowArgs) (*linode.FirewallRulesReplaceRequest, bool) {
+	return prependInboundRule(rules, rule, func(existing *linodev1.FirewallRule) bool { return sameTempAccess(existing, args) })
+}
+
+// prependInboundRule rebuilds a ruleset with rule first in the inbound list,
+// dropping the inbound rules replaces matches; outbound rules are sent back
+// unchanged. replaced reports whether any rule was dropped.
+func prependInboundRule(rules *linodev1.FirewallRules, rule *linodev1.FirewallRule, replaces func(*linodev1.FirewallRule) bool) (*linode.FirewallRulesReplaceRequest, bool) {
+	req := &linode.FirewallRulesReplaceRequest{
+		Inbound:  []map[string]any{allowlistRuleMap(rule, rule.GetAddresses().GetIpv4(), rule.GetAddresses().GetIpv6())},
+		Outbound: make([]map[string]any, 0, len(rules.GetOutbound())),
+	}
+
+	replaced := false
+
+	for _, existing := range rules.GetInbound() {
+		if replaces(existing) {
+			replaced = true
+
+			continue
+		}
+
+		req.Inbound = append(req.Inbound, allowlistRuleMap(existing, existing.GetAddresses().GetIpv4(), existing.GetAddresses().GetIpv6()))
+	}
+
+	for _, existing := range rules.GetOutbound() {
+		req.Outbound = append(req.Outbound, allowlistRuleMap(existing, existing.GetAddresses().GetIpv4(), existing.GetAddresses().GetIpv6()))
+	}
+
+	return req, replaced
+}
+
+// tempAllowAccess describes the access a rule grants, e.g. "TCP 22 from 203.0.113.7/32".
+func tempAllowAccess(args *tempAllowArgs) string {
+	return fmt.Sprintf("%s %s from %s", args.protocol, args.ports, args.source)
+}
+
+func handleLinodeFirewallTempAllowRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if IsDryRun(request) {
+		args, msg := parseTempAllowArgs(request)
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_firewall_temp_allow", "PUT",
+			fmt.Sprintf("/networking/firewalls/%d/rules", args.firewallID),
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				firewall, err := c.GetFirewallProto(ctx, args.firewallID)
+				if err != nil {
+					return nil, err
+				}
+
+				return tempAllowState{
+					FirewallID:       firewall.GetId(),
+					Label:            firewall.GetLabel(),
+					InboundRuleCount: len(firewall.GetRules().GetInbound()),
+					ReplacesExisting: slices.ContainsFunc(firewall.GetRules().GetInbound(), func(rule *linodev1.FirewallRule) bool { return sameTempAccess(rule, args) }),
+				}, nil
+			},
+			func(_ context.Context, _ *linode.Client, state any) (DryRunDetails, error) {
+				current, _ := state.(tempAllowState)
+
+				sideEffects := []string{fmt.Sprintf("Inbound rule %s accepting %s is added ahead of the %d existing rule(s) and expires %d minute(s) after the call.",
+					args.label, tempAllowAccess(args), current.InboundRuleCount, int(args.duration.Minutes()))}
+				if current.ReplacesExisting {
+					sideEffects = append(sideEffects, fmt.Sprintf("The existing temporary rule %s for the same access is replaced.", args.label))
+				}
+
+				return DryRunDetails{SideEffects: sideEffects}, nil
+			})
+	}
+
+	if result := RequireConfirm(request, "This opens the firewall to source until the rule expires. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	args, msg := parseTempAllowArgs(request)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	firewall, err := client.GetFirewallProto(ctx, args.firewallID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to update firewall %d rules: %v", args.firewallID, err)), nil
+	}
+
+	expires := time.Now().UTC().Add(args.duration).Truncate(time.Second)
+	rule := tempAllowRule(args, expires)
+	req, replaced := tempAllowRulesReplace(firewall.GetRules(), rule, args)
+
+	rules, err := client.UpdateFirewallRulesProto(ctx, args.firewallID, req)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to update firewall %d rules: %v", args.firewallID, err)), nil
+	}
+
+	expiresAt := expires.Format(time.RFC3339)
+
+	return MarshalProtoToolResponse(&linodev1.FirewallTempAllowResponse{
+		Message: fmt.Sprintf("Firewall %d accepts %s until %s (rule %s); run linode_firewall_expire_rules after that to remove it.",
+			args.firewallID, tempAllowAccess(args), expiresAt, args.label),
+		FirewallId: linodeIDToInt32(args.firewallID),
+		Rule:       rule,
+		ExpiresAt:  expiresAt,
+		Replaced:   replaced,
+		Rules:      rules,
+	})
+}
+
+// expirePlan is one firewall's temporary rules split by expiry.
+type expirePlan struct {
+	firewall *linodev1.Firewall
+	report   *linodev1.FirewallExpiredRules
+	keep     []*linodev1.FirewallRule
+}
+
+// planExpireRules sorts every temporary inbound rule on firewalls into
+// removed (expired at now) or kept. Only firewalls with an expired rule get a
+// plan; the kept count across all firewalls is returned alongside.
+func planExpireRules(firewalls []*linodev1.Firewall, now time.Time) ([]*expirePlan, int, []string) {
+	var (
+		plans    []*expirePlan
+		kept     int
+		warnings []string
+	)
+
+	for _, firewall := range firewalls {
+		plan := &expirePlan{
+			firewall: firewall,
+			report: &linodev1.FirewallExpiredRules{
+				FirewallId: firewall.GetId(),
+				Label:      firewall.GetLabel(),
+				Removed:    []*linodev1.FirewallTemporaryRule{},
+			},
+		}
+
+		for _, rule := range firewall.GetRules().GetInbound() {
+			expires, temporary, err := tempRuleExpiry(rule.GetDescription())
+
+			switch {
+			case err != nil:
+				warnings = append(warnings, fmt.Sprintf("Firewall %d (%s) rule %s has an %v; kept.", firewall.GetId(), firewall.GetLabel(), rule.GetLabel(), err))
+
+				fallthrough
+			case !temporary:
+				plan.keep = append(plan.keep, rule)
+			case expires.After(now):
+				plan.keep = append(plan.keep, rule)
+				plan.report.Kept++
+			default:
+				plan.report.Removed = append(plan.report.Removed, &linodev1.FirewallTemporaryRule{
+					Label:     rule.GetLabel(),
+					Protocol:  rule.GetProtocol(),
+					Ports:     rule.GetPorts(),
+					Addresses: rule.GetAddresses(),
+					ExpiresAt: expires.UTC().Format(time.RFC3339),
+				})
+			}
+		}
+
+		kept += int(plan.report.GetKept())
+
+		if len(plan.report.GetRemoved()) > 0 {
+			plans = append(plans, plan)
+		}
+	}
+
+	return plans, kept, warnings
+}
+
+// expireRulesFirewalls lists the firewalls to check: the one named, or all.
+func expireRulesFirewalls(ctx context.Context, client *linode.Client, firewallID int) ([]*linodev1.Firewall, error) {
+	if firewallID == 0 {
+		firewalls, err := client.ListFirewallsProto(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list firewalls: %w", err)
+		}
+
+		return firewalls, nil
+	}
+
+	firewall, err := client.GetFirewallProto(ctx, firewallID)
+	if err != nil {
+		return nil, fmt.Errorf("get firewall %d: %w", firewallID, err)
+	}
+
+	return []*linodev1.Firewall{firewall}, nil
+}
+
+// expireRulesFirewallID reads the optional firewall_id; 0 means every firewall.
+func expireRulesFirewallID(request *mcp.CallToolRequest) (int, string) {
+	if _, exists := request.GetArguments()[paramFirewallID]; !exists {
+		return 0, ""
+	}
+
+	return requiredIDArgument(request, paramFirewallID)
+}
+
+// expireRulesSideEffects is the Tier B walk: one line per expired rule, then
+// the count of temporary rules that stay.
+func expireRulesSideEffects(plans []*expirePlan, kept int) []string {
+	var sideEffects []string
+
+	for _, plan := range plans {
+		for _, rule := range plan.report.GetRemoved() {
+			addresses := slices.Concat(rule.GetAddresses().GetIpv4(), rule.GetAddresses().GetIpv6())
+			sideEffects = append(sideEffects, fmt.Sprintf("Firewall %d (%s) loses rule %s accepting %s %s from %s, expired %s.",
+				plan.report.GetFirewallId(), plan.report.GetLabel(), rule.GetLabel(), rule.GetProtocol(), rule.GetPorts(),
+				strings.Join(addresses, ", "), rule.GetExpiresAt()))
+		}
+	}
+
+	return append(sideEffects, fmt.Sprintf("%d temporary rule(s) not yet expired are kept.", kept))
+}
+
+func handleLinodeFirewallExpireRulesRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	firewallID, msg := expireRulesFirewallID(request)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	if IsDryRun(request) {
+		var (
+			plans    []*expirePlan
+			kept     int
+			warnings []string
+		)
+
+		path := "/networking/firewalls/{firewall_id}/rules"
+		if firewallID != 0 {
+			path = fmt.Sprintf("/networking/firewalls/%d/rules", firewallID)
+		}
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_firewall_expire_rules", "PUT", path,
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				firewalls, err := expireRulesFirewalls(ctx, c, firewallID)
+				if err != nil {
+					return nil, err
+				}
+
+				plans, kept, warnings = planExpireRules(firewalls, time.Now())
+
+				state := make([]expireRulesState, 0, len(plans))
+				for _, plan := range plans {
+					removed := make([]string, 0, len(plan.report.GetRemoved()))
+					for _, rule := range plan.report.GetRemoved() {
+						removed = append(removed, rule.GetLabel())
+					}
+
+					state = append(state, expireRulesState{
+						FirewallID:   plan.report.GetFirewallId(),
+						Label:        plan.report.GetLabel(),
+						RemovedRules: removed,
+						KeptCount:    plan.report.GetKept(),
+					})
+				}
+
+				return state, nil
+			},
+			func(_ context.Context, _ *linode.Client, _ any) (DryRunDetails, error) {
+				return DryRunDetails{SideEffects: expireRulesSideEffects(plans, kept), Warnings: warnings}, nil
+			})
+	}
+
+	if result := RequireConfirm(request, "This removes expired temporary firewall rules. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	firewalls, err := expireRulesFirewalls(ctx, client, firewallID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to find temporary rules: %v", err)), nil
+	}
+
+	plans, kept, warnings := planExpireRules(firewalls, time.Now())
+	for _, warning := range warnings {
+		AddWarning(ctx, "%s", warning)
+	}
+
+	response := applyExpireRules(ctx, client, plans)
+	response.KeptCount = linodeIDToInt32(kept)
+	response.Warnings = warnings
+
+	return MarshalProtoToolResponse(response)
+}
+
+// applyExpireRules rewrites each planned firewall without its expired rules.
+// Firewalls are independent, so a failure is recorded on its report and the
+// rest still run; nothing is rolled back.
+func applyExpireRules(ctx context.Context, client *linode.Client, plans []*expirePlan) *linodev1.FirewallExpireRulesResponse {
+	response := &linodev1.FirewallExpireRulesResponse{
+		Status:    expireRulesApplied,
+		Firewalls: make([]*linodev1.FirewallExpiredRules, 0, len(plans)),
+	}
+
+	failed := 0
+
+	for _, plan := range plans {
+		response.Firewalls = append(response.Firewalls, plan.report)
+
+		req := &linode.FirewallRulesReplaceRequest{
+			Inbound:  make([]map[string]any, 0, len(plan.keep)),
+			Outbound: make([]map[string]any, 0, len(plan.firewall.GetRules().GetOutbound())),
+		}
+
+		for _, rule := range plan.keep {
+			req.Inbound = append(req.Inbound, allowlistRuleMap(rule, rule.GetAddresses().GetIpv4(), rule.GetAddresses().GetIpv6()))
+		}
+
+		for _, rule := range plan.firewall.GetRules().GetOutbound() {
+			req.Outbound = append(req.Outbound, allowlistRuleMap(rule, rule.GetAddresses().GetIpv4(), rule.GetAddresses().GetIpv6()))
+		}
+
+		if _, err := client.UpdateFirewallRulesProto(ctx, int(plan.report.GetFirewallId()), req); err != nil {
+			plan.report.Error = new(err.Error())
+			failed++
+
+			continue
+		}
+
+		response.RemovedCount += linodeIDToInt32(len(plan.report.GetRemoved()))
+	}
+
+	response.Message = fmt.Sprintf("Removed %d expired temporary rule(s) from %d firewall(s).", response.GetRemovedCount(), len(plans)-failed)
+
+	if failed > 0 {
+		response.Status = expireRulesPartial
+		response.Message = fmt.Sprintf("Removed %d expired temporary rule(s) from %d firewall(s); %d firewall(s) failed and keep their rules, see firewalls[].error.",
+			response.GetRemovedCount(), len(plans)-failed, failed)
+		AddWarning(ctx, "%s", response.GetMessage())
+	}
+
+	return response
+}
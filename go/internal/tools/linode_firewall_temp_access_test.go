@@ -0,0 +1,163 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// tempAccessFirewall holds a temporary SSH rule that expired long ago, one
+// that expires far in the future, an untagged rule, and a tagged rule whose
+// expiry does not parse.
+const tempAccessFirewall = `{"id":7,"label":"edge","rules":{"inbound":[` +
+	`{"action":"ACCEPT","protocol":"TCP","ports":"22","addresses":{"ipv4":["203.0.113.7/32"]},"label":"temp-allow","description":"linodemcp-temp expires=2000-01-01T00:00:00Z"},` +
+	`{"action":"ACCEPT","protocol":"TCP","ports":"22","addresses":{"ipv4":["198.51.100.9/32"]},"label":"later","description":"linodemcp-temp expires=2999-01-01T00:00:00Z"},` +
+	`{"action":"ACCEPT","protocol":"TCP","ports":"443","addresses":{"ipv4":["0.0.0.0/0"]},"label":"web","description":"public"},` +
+	`{"action":"ACCEPT","protocol":"TCP","ports":"8080","addresses":{"ipv4":["192.0.2.1/32"]},"label":"odd","description":"linodemcp-temp expires=soon"}],` +
+	`"outbound":[{"action":"ACCEPT","protocol":"TCP","ports":"53","addresses":{"ipv4":["0.0.0.0/0"]},"label":"dns"}]}}`
+
+// tempAccessServer serves tempAccessFirewall and records the rules PUT.
+func tempAccessServer(t *testing.T, put *struct{ Inbound, Outbound []map[string]any }) *config.Config {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /networking/firewalls/7":
+			_, _ = w.Write([]byte(tempAccessFirewall))
+		case "GET /networking/firewalls":
+			_, _ = w.Write([]byte(`{"data":[` + tempAccessFirewall + `],"page":1,"pages":1,"results":1}`))
+		case "PUT /networking/firewalls/7/rules":
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, put); err != nil {
+				t.Errorf("decode PUT body: %v", err)
+			}
+
+			_, _ = w.Write(body)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+}
+
+// Repeating a grant replaces the matching temporary rule, puts the new rule
+// first, and keeps every other rule as it was.
+func TestLinodeFirewallTempAllowToolReplacesRule(t *testing.T) {
+	t.Parallel()
+
+	var put struct{ Inbound, Outbound []map[string]any }
+
+	_, _, handler := tools.NewLinodeFirewallTempAllowTool(tempAccessServer(t, &put))
+
+	before := time.Now().UTC()
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"confirm":          true,
+		"firewall_id":      float64(7),
+		"source":           "203.0.113.7",
+		"ports":            "22",
+		"duration_minutes": float64(120),
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want success", result.Content)
+	}
+
+	var response struct {
+		ExpiresAt string `json:"expires_at"`
+		Replaced  bool   `json:"replaced"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	expires, err := time.Parse(time.RFC3339, response.ExpiresAt)
+	if err != nil || expires.Before(before.Add(119*time.Minute)) || expires.After(before.Add(121*time.Minute)) {
+		t.Errorf("expires_at = %q, want about 120 minutes from now", response.ExpiresAt)
+	}
+
+	if !response.Replaced {
+		t.Error("replaced = false, want true")
+	}
+
+	labels := make([]string, 0, len(put.Inbound))
+	for _, rule := range put.Inbound {
+		labels = append(labels, rule["label"].(string))
+	}
+
+	if got := strings.Join(labels, ","); got != "temp-allow,later,web,odd" {
+		t.Errorf("inbound labels = %s, want temp-allow,later,web,odd", got)
+	}
+
+	if got := put.Inbound[0]["description"]; got != "linodemcp-temp expires="+response.ExpiresAt {
+		t.Errorf("new rule description = %v, want the expiry tag", got)
+	}
+
+	if len(put.Outbound) != 1 {
+		t.Errorf("outbound = %v, want the dns rule kept", put.Outbound)
+	}
+}
+
+// Only the expired temporary rule goes; an unparsable tag is kept with a
+// warning.
+func TestLinodeFirewallExpireRulesToolRemovesExpired(t *testing.T) {
+	t.Parallel()
+
+	var put struct{ Inbound, Outbound []map[string]any }
+
+	_, _, handler := tools.NewLinodeFirewallExpireRulesTool(tempAccessServer(t, &put))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"confirm": true}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want success", result.Content)
+	}
+
+	var response struct {
+		Status       string   `json:"status"`
+		RemovedCount int      `json:"removed_count"`
+		KeptCount    int      `json:"kept_count"`
+		Warnings     []string `json:"warnings"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if response.Status != "applied" || response.RemovedCount != 1 || response.KeptCount != 1 {
+		t.Errorf("response = %+v, want 1 removed and 1 kept", response)
+	}
+
+	if len(response.Warnings) != 1 || !strings.Contains(response.Warnings[0], `unreadable expiry "soon"`) {
+		t.Errorf("warnings = %v, want the unreadable expiry", response.Warnings)
+	}
+
+	if len(put.Inbound) != 3 || put.Inbound[0]["label"] != "later" || len(put.Outbound) != 1 {
+		t.Errorf("PUT = %+v, want later, web, odd inbound and dns outbound", put)
+	}
+}
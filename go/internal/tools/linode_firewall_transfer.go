@@ -0,0 +1,494 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// firewallDocumentFormat versions the portable firewall document. An import
+// refuses any other format rather than guessing at its layout.
+const firewallDocumentFormat = "linodemcp.firewall/v1"
+
+// Keys each level of a firewall document may carry; anything else is
+// rejected so a typo cannot silently drop a rule field.
+var (
+	firewallDocumentFields  = []string{"firewall", "format", firewallDirectionInbound, firewallDirectionOutbound}
+	firewallChainFields     = []string{"policy", "rules"}
+	firewallRuleFields      = []string{"action", "addresses", "description", "label", "ports", "protocol"}
+	firewallAddressesFields = []string{"ipv4", "ipv6"}
+)
+
+// firewallImport is a validated firewall document: the rules as the diff
+// compares them and the replace request that applies them.
+type firewallImport struct {
+	rules   *linodev1.FirewallRules
+	replace linode.FirewallRulesReplaceRequest
+}
+
+// NewLinodeFirewallExportTool creates a tool that writes a firewall's rules
+// as a portable JSON document.
+func NewLinodeFirewallExportTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_firewall_export",
+		"Exports a Cloud Firewall's rules as a portable JSON document: an inbound and an outbound chain, each a "+
+			"default policy and its rules in evaluation order, tagged format \""+firewallDocumentFormat+"\". Keep the "+
+			"document in version control to review rule changes, then apply it with linode_firewall_import.",
+		toolschemas.Schema("linode.mcp.v1.FirewallExportInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeFirewallExportRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLinodeFirewallExportRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	firewallID, validationMessage := requiredIDArgument(request, paramFirewallID)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	firewall, err := client.GetFirewallProto(ctx, firewallID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve firewall %d: %v", firewallID, err)), nil
+	}
+
+	rules := firewall.GetRules()
+
+	return MarshalProtoToolResponse(&linodev1.FirewallExportResponse{
+		Message: fmt.Sprintf("Exported firewall %d (%s): %d inbound and %d outbound rules",
+			firewallID, firewall.GetLabel(), len(rules.GetInbound()), len(rules.GetOutbound())),
+		FirewallId: linodeIDToInt32(firewallID),
+		Document: &linodev1.FirewallDocument{
+			Format:   firewallDocumentFormat,
+			Firewall: firewall.GetLabel(),
+			Inbound:  &linodev1.FirewallChain{Policy: rules.GetInboundPolicy(), Rules: rules.GetInbound()},
+			Outbound: &linodev1.FirewallChain{Policy: rules.GetOutboundPolicy(), Rules: rules.GetOutbound()},
+		},
+	})
+}
+
+// NewLinodeFirewallImportTool creates a tool that replaces a firewall's rules
+// and default policies with a portable JSON document.
+func NewLinodeFirewallImportTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_firewall_import",
+		"Replaces a Cloud Firewall's rules and default policies with a document written by linode_firewall_export. "+
+			"The whole document is validated first (format, policies, rule actions and protocols, ports, labels, and "+
+			"addresses) and nothing is sent if any part is wrong. Requires confirm=true; pass dry_run=true to see "+
+			"what the import would add, remove, and change.",
+		toolschemas.Schema("linode.mcp.v1.FirewallImportInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeFirewallImportRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+func handleLinodeFirewallImportRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	firewallID, validationMessage := requiredIDArgument(request, paramFirewallID)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	imported, validationMessage := firewallDocumentFromTool(request.GetArguments()["document"])
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	if IsDryRun(request) {
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_firewall_import", httpMethodPut,
+			fmt.Sprintf("/networking/firewalls/%d/rules", firewallID),
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				return c.ListFirewallRulesProto(ctx, firewallID)
+			},
+			func(_ context.Context, _ *linode.Client, state any) (DryRunDetails, error) {
+				current, _ := state.(*linodev1.FirewallRules)
+
+				return firewallImportSideEffects(firewallID, current, imported.rules), nil
+			})
+	}
+
+	if result := RequireConfirm(request, "This replaces the firewall's rules and default policies with the document. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	rules, err := client.UpdateFirewallRulesProto(ctx, firewallID, &imported.replace)
+	if err != nil {
+		return mcp.NewToolResultError(formatFirewallRulesUpdateError(err)), nil
+	}
+
+	return MarshalProtoToolResponse(&linodev1.FirewallRulesWriteResponse{
+		Message: fmt.Sprintf("Firewall %d rules imported: %d inbound and %d outbound rules",
+			firewallID, len(rules.GetInbound()), len(rules.GetOutbound())),
+		FirewallId: linodeIDToInt32(firewallID),
+		Rules:      rules,
+	})
+}
+
+// firewallImportSideEffects is the dry-run preview: what replacing the
+// current rules with the document would change, from the shared diff.
+func firewallImportSideEffects(firewallID int, current, imported *linodev1.FirewallRules) DryRunDetails {
+	var details DryRunDetails
+
+	diff := firewallDiff(fmt.Sprintf("firewall %d", firewallID), "the document", current, imported)
+	if diff.GetIdentical() {
+		details.Warnings = append(details.Warnings,
+			fmt.Sprintf("Firewall %d already matches the document; the import changes nothing.", firewallID))
+
+		return details
+	}
+
+	for _, change := range diff.GetPolicyChanges() {
+		details.SideEffects = append(details.SideEffects,
+			fmt.Sprintf("The %s default policy will change from %s to %s.", change.GetDirection(), change.GetBefore(), change.GetAfter()))
+	}
+
+	for _, added := range diff.GetAdded() {
+		details.SideEffects = append(details.SideEffects,
+			fmt.Sprintf("The %s rule %s will be added.", added.GetDirection(), firewallRuleName(added.GetRule())))
+	}
+
+	for _, removed := range diff.GetRemoved() {
+		details.SideEffects = append(details.SideEffects,
+			fmt.Sprintf("The %s rule %s will be removed.", removed.GetDirection(), firewallRuleName(removed.GetRule())))
+	}
+
+	for _, changed := range diff.GetChanged() {
+		details.SideEffects = append(details.SideEffects,
+			fmt.Sprintf("The %s rule '%s' will change: %s.", changed.GetDirection(), changed.GetLabel(), strings.Join(changed.GetFields(), ", ")))
+	}
+
+	return details
+}
+
+// firewallRuleName names a rule in a preview: its label, or for an unlabeled
+// rule what it matches.
+func firewallRuleName(rule *linodev1.FirewallRule) string {
+	if rule.GetLabel() != "" {
+		return "'" + rule.GetLabel() + "'"
+	}
+
+	name := rule.GetAction() + " " + rule.GetProtocol()
+	if rule.GetPorts() != "" {
+		name += " " + rule.GetPorts()
+	}
+
+	return "(" + name + ")"
+}
+
+// firewallDocumentFromTool validates a firewall document, given as an object
+// or a JSON string, returning a validation message naming the first problem.
+func firewallDocumentFromTool(raw any) (*firewallImport, string) {
+	encoded, msg := objectJSONFromToolArg(raw, "document")
+	if msg != "" {
+		return nil, msg
+	}
+
+	if encoded == "" {
+		return nil, "document is required"
+	}
+
+	var document map[string]any
+	if err := json.Unmarshal([]byte(encoded), &document); err != nil || document == nil {
+		return nil, "document must be an object"
+	}
+
+	if msg := firewallUnknownField(document, firewallDocumentFields, "document"); msg != "" {
+		return nil, msg
+	}
+
+	if format, _ := document["format"].(string); format != firewallDocumentFormat {
+		return nil, fmt.Sprintf("document.format must be %q", firewallDocumentFormat)
+	}
+
+	if label, present := document["firewall"]; present {
+		if _, ok := label.(string); !ok {
+			return nil, "document.firewall must be a string"
+		}
+	}
+
+	imported := &firewallImport{rules: &linodev1.FirewallRules{}}
+
+	for _, direction := range []string{firewallDirectionInbound, firewallDirectionOutbound} {
+		policy, rules, protos, msg := firewallChainFromDocument(document[direction], "document."+direction)
+		if msg != "" {
+			return nil, msg
+		}
+
+		if direction == firewallDirectionInbound {
+			imported.rules.InboundPolicy, imported.rules.Inbound = policy, protos
+			imported.replace.InboundPolicy, imported.replace.Inbound = policy, rules
+		} else {
+			imported.rules.OutboundPolicy, imported.rules.Outbound = policy, protos
+			imported.replace.OutboundPolicy, imported.replace.Outbound = policy, rules
+		}
+	}
+
+	return imported, ""
+}
+
+// firewallChainFromDocument validates one direction of a document, returning
+// its policy and rules both as request objects and as protos for the diff.
+func firewallChainFromDocument(raw any, key string) (string, []map[string]any, []*linodev1.FirewallRule, string) {
+	if raw == nil {
+		return "", nil, nil, key + " is required"
+	}
+
+	chain, ok := raw.(map[string]any)
+	if !ok {
+		return "", nil, nil, key + " must be an object"
+	}
+
+	if msg := firewallUnknownField(chain, firewallChainFields, key); msg != "" {
+		return "", nil, nil, msg
+	}
+
+	policy, msg := firewallDocumentEnum(chain["policy"], key+".policy", linodev1.FirewallPolicy_Value_value)
+	if msg != "" {
+		return "", nil, nil, msg
+	}
+
+	entries, ok := chain["rules"].([]any)
+	if !ok && chain["rules"] != nil {
+		return "", nil, nil, key + ".rules must be an array of objects"
+	}
+
+	rules := make([]map[string]any, 0, len(entries))
+	protos := make([]*linodev1.FirewallRule, 0, len(entries))
+
+	for idx, entry := range entries {
+		ruleObject, ok := entry.(map[string]any)
+		if !ok {
+			return "", nil, nil, key + ".rules must be an array of objects"
+		}
+
+		rule, proto, msg := firewallRuleFromDocument(ruleObject, fmt.Sprintf("%s.rules[%d]", key, idx))
+		if msg != "" {
+			return "", nil, nil, msg
+		}
+
+		rules = append(rules, rule)
+		protos = append(protos, proto)
+	}
+
+	return policy, rules, protos, ""
+}
+
+// firewallRuleFromDocument validates one document rule. The request object
+// carries only the fields the rule sets, so an empty ports, label, or
+// description (as an export writes for a rule without one) is left out.
+func firewallRuleFromDocument(document map[string]any, key string) (map[string]any, *linodev1.FirewallRule, string) {
+	if msg := firewallUnknownField(document, firewallRuleFields, key); msg != "" {
+		return nil, nil, msg
+	}
+
+	action, msg := firewallDocumentEnum(document["action"], key+".action", linodev1.FirewallPolicy_Value_value)
+	if msg != "" {
+		return nil, nil, msg
+	}
+
+	protocol, msg := firewallDocumentEnum(document["protocol"], key+".protocol", linodev1.FirewallSimulateProtocol_Value_value)
+	if msg != "" {
+		return nil, nil, msg
+	}
+
+	rule := map[string]any{"action": action, "protocol": protocol}
+	proto := &linodev1.FirewallRule{Action: action, Protocol: protocol}
+
+	for _, field := range []string{"ports", "label", "description"} {
+		value, msg := firewallDocumentString(document[field], key+"."+field)
+		if msg != "" {
+			return nil, nil, msg
+		}
+
+		if value == "" {
+			continue
+		}
+
+		switch field {
+		case "ports":
+			if protocol != "TCP" && protocol != "UDP" {
+				return nil, nil, key + ".ports applies only to TCP and UDP"
+			}
+
+			if _, badEntry := firewallPortsContain(value, 0); badEntry != "" {
+				return nil, nil, fmt.Sprintf("%s.ports %q do not parse at %q", key, value, badEntry)
+			}
+
+			proto.Ports = value
+		case "label":
+			if !tempAllowLabelPattern.MatchString(value) {
+				return nil, nil, key + ".label must be 3-32 letters, digits, '.', '_', or '-'"
+			}
+
+			proto.Label = value
+		default:
+			proto.Description = value
+		}
+
+		rule[field] = value
+	}
+
+	addresses, protoAddresses, msg := firewallAddressesFromDocument(document["addresses"], key+".addresses")
+	if msg != "" {
+		return nil, nil, msg
+	}
+
+	rule["addresses"] = addresses
+	proto.Addresses = protoAddresses
+
+	return rule, proto, ""
+}
+
+// firewallAddressesFromDocument validates a rule's addresses: IPv4 entries
+// under ipv4 and IPv6 CIDRs under ipv6, at least one in all, each rewritten
+// to its canonical form.
+func firewallAddressesFromDocument(raw any, key string) (map[string]any, *linodev1.FirewallAddresses, string) {
+	document, ok := raw.(map[string]any)
+	if !ok {
+		return nil, nil, key + " must be an object"
+	}
+
+	if msg := firewallUnknownField(document, firewallAddressesFields, key); msg != "" {
+		return nil, nil, msg
+	}
+
+	addresses := map[string]any{}
+	proto := &linodev1.FirewallAddresses{}
+
+	for _, family := range firewallAddressesFields {
+		raw, present := document[family]
+		if !present {
+			continue
+		}
+
+		entries, ok := raw.([]any)
+		if !ok {
+			return nil, nil, fmt.Sprintf("%s.%s must be an array of strings", key, family)
+		}
+
+		canonical := make([]string, 0, len(entries))
+
+		for idx, entry := range entries {
+			value, ok := entry.(string)
+			if !ok {
+				return nil, nil, fmt.Sprintf("%s.%s must be an array of strings", key, family)
+			}
+
+			address, msg := firewallDocumentAddress(family, value)
+			if msg != "" {
+				return nil, nil, fmt.Sprintf("%s.%s[%d] %q %s", key, family, idx, value, msg)
+			}
+
+			canonical = append(canonical, address)
+		}
+
+		addresses[family] = canonical
+
+		if family == "ipv4" {
+			proto.Ipv4 = canonical
+		} else {
+			proto.Ipv6 = canonical
+		}
+	}
+
+	if len(proto.GetIpv4())+len(proto.GetIpv6()) == 0 {
+		return nil, nil, key + " must list at least one ipv4 or ipv6 address"
+	}
+
+	return addresses, proto, ""
+}
+
+// firewallDocumentAddress canonicalizes one address entry of the given
+// family, returning the reason it is unusable otherwise. A bare IPv4 address
+// becomes a /32; IPv6 entries must already be CIDRs, as the API wants.
+func firewallDocumentAddress(family, raw string) (string, string) {
+	if family == "ipv6" {
+		prefix, msg := parseFirewallIPv6(raw)
+		if msg != "" {
+			return "", msg
+		}
+
+		return prefix.String(), ""
+	}
+
+	prefix, msg := parseAllowlistCIDR(raw)
+	if msg != "" {
+		return "", msg
+	}
+
+	if !prefix.Addr().Is4() {
+		return "", "is an IPv6 address; list it under addresses.ipv6"
+	}
+
+	return prefix.String(), ""
+}
+
+// firewallDocumentEnum reads a required document field that must name a value
+// of a proto enum.
+func firewallDocumentEnum(raw any, key string, valueMap map[string]int32) (string, string) {
+	value, _ := raw.(string)
+	if value == "" {
+		return "", fmt.Sprintf("%s must be one of: %s", key, strings.Join(enumValueNames(valueMap), ", "))
+	}
+
+	return value, enumChoiceError(value, key, valueMap)
+}
+
+// firewallDocumentString reads an optional document string field.
+func firewallDocumentString(raw any, key string) (string, string) {
+	if raw == nil {
+		return "", ""
+	}
+
+	value, ok := raw.(string)
+	if !ok {
+		return "", key + " must be a string"
+	}
+
+	return strings.TrimSpace(value), ""
+}
+
+// firewallUnknownField names the first field of object, in sorted order, that
+// is not in allowed.
+func firewallUnknownField(object map[string]any, allowed []string, key string) string {
+	fields := make([]string, 0, len(object))
+	for field := range object {
+		if !slices.Contains(allowed, field) {
+			fields = append(fields, field)
+		}
+	}
+
+	if len(fields) == 0 {
+		return ""
+	}
+
+	slices.Sort(fields)
+
+	return fmt.Sprintf("%s has unknown field %q", key, fields[0])
+}
@@ -0,0 +1,159 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// An exported document imports back as the same rules and policies, with the
+// empty fields the export writes left off the request.
+func TestLinodeFirewallExportImportRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var put map[string]any
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /networking/firewalls/5":
+			_, _ = w.Write([]byte(`{"id":5,"label":"web-prod","status":"enabled","rules":{"inbound":[` +
+				`{"action":"ACCEPT","protocol":"TCP","ports":"22, 443","addresses":{"ipv4":["198.51.100.0/24"]},"label":"allow-web"},` +
+				`{"action":"ACCEPT","protocol":"ICMP","addresses":{"ipv6":["::/0"]},"description":"ping"}` +
+				`],"inbound_policy":"DROP","outbound":[],"outbound_policy":"ACCEPT"}}`))
+		case "PUT /networking/firewalls/5/rules":
+			if err := json.NewDecoder(r.Body).Decode(&put); err != nil {
+				t.Errorf("request body should decode: %v", err)
+			}
+
+			_, _ = w.Write([]byte(`{"inbound":[],"inbound_policy":"DROP","outbound":[],"outbound_policy":"ACCEPT"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	cfg := newTestConfig(srv.URL)
+
+	_, _, export := tools.NewLinodeFirewallExportTool(cfg)
+
+	result, err := export(t.Context(), createRequestWithArgs(t, map[string]any{keyFirewallID: float64(5)}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("export result = %v, want a document", result.Content)
+	}
+
+	var exported struct {
+		Document map[string]any `json:"document"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &exported); err != nil {
+		t.Fatalf("decode export: %v", err)
+	}
+
+	_, _, importer := tools.NewLinodeFirewallImportTool(cfg)
+
+	result, err = importer(t.Context(), createRequestWithArgs(t, map[string]any{
+		keyFirewallID: float64(5), "document": exported.Document, keyConfirm: true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("import result = %v, want success", result.Content)
+	}
+
+	want := map[string]any{
+		"inbound": []any{
+			map[string]any{
+				"action": "ACCEPT", "protocol": "TCP", "ports": "22, 443", "label": "allow-web",
+				"addresses": map[string]any{"ipv4": []any{"198.51.100.0/24"}, "ipv6": []any{}},
+			},
+			map[string]any{
+				"action": "ACCEPT", "protocol": "ICMP", "description": "ping",
+				"addresses": map[string]any{"ipv4": []any{}, "ipv6": []any{"::/0"}},
+			},
+		},
+		"inbound_policy":  "DROP",
+		"outbound":        []any{},
+		"outbound_policy": "ACCEPT",
+	}
+	if !reflect.DeepEqual(put, want) {
+		t.Errorf("PUT body = %v, want %v", put, want)
+	}
+}
+
+func TestLinodeFirewallImportToolValidation(t *testing.T) {
+	t.Parallel()
+
+	_, _, handler := tools.NewLinodeFirewallImportTool(newTestConfig("https://api.linode.com/v4"))
+
+	chain := func(rules ...any) map[string]any {
+		return map[string]any{"policy": "DROP", "rules": rules}
+	}
+
+	document := func(rules ...any) map[string]any {
+		return map[string]any{"format": "linodemcp.firewall/v1", "inbound": chain(rules...), "outbound": chain()}
+	}
+
+	rule := func(fields map[string]any) map[string]any {
+		merged := map[string]any{
+			"action": "ACCEPT", "protocol": "TCP", "ports": "22",
+			"addresses": map[string]any{"ipv4": []any{"0.0.0.0/0"}},
+		}
+		for key, value := range fields {
+			merged[key] = value
+		}
+
+		return merged
+	}
+
+	tests := []struct {
+		name     string
+		document any
+		want     string
+	}{
+		{name: "missing", document: nil, want: "document is required"},
+		{name: "not json", document: "{", want: "document must be an object"},
+		{name: "unknown top-level field", document: map[string]any{"format": "linodemcp.firewall/v1", "chains": []any{}}, want: `document has unknown field "chains"`},
+		{name: "missing outbound", document: map[string]any{"format": "linodemcp.firewall/v1", "inbound": chain()}, want: "document.outbound is required"},
+		{name: "bad action", document: document(rule(map[string]any{"action": "REJECT"})), want: "document.inbound.rules[0].action must be one of: ACCEPT, DROP"},
+		{name: "bad ports", document: document(rule(map[string]any{"ports": "22, ssh"})), want: `document.inbound.rules[0].ports "22, ssh" do not parse at "ssh"`},
+		{name: "bad label", document: document(rule(map[string]any{"label": "a b"})), want: "document.inbound.rules[0].label must be 3-32 letters, digits, '.', '_', or '-'"},
+		{name: "no addresses", document: document(rule(map[string]any{"addresses": map[string]any{"ipv4": []any{}}})), want: "document.inbound.rules[0].addresses must list at least one ipv4 or ipv6 address"},
+		{name: "host bits", document: document(rule(map[string]any{"addresses": map[string]any{"ipv4": []any{"10.0.0.1/8"}}})), want: `document.inbound.rules[0].addresses.ipv4[0] "10.0.0.1/8" has host bits set; use 10.0.0.0/8`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := map[string]any{keyFirewallID: float64(5), keyConfirm: true}
+			if tc.document != nil {
+				args["document"] = tc.document
+			}
+
+			result, err := handler(t.Context(), createRequestWithArgs(t, args))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text, _ := result.Content[0].(mcp.TextContent)
+			if !result.IsError || text.Text != tc.want {
+				t.Errorf("result = %v, want error %q", result.Content, tc.want)
+			}
+		})
+	}
+}
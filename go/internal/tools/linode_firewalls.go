@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"net/netip"
 	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -30,16 +31,17 @@ func firewallDeviceDeleteProto(firewallID, deviceID int) proto.Message {
 }
 
 const (
-	firewallDefaultLinodeKey  = "linode"
-	paramDefaultFirewallIDs   = "default_firewall_ids"
-	paramDeviceID             = "id"
-	paramDeviceType           = "type"
-	paramFirewallDeviceID     = "device_id"
-	paramFirewallID           = "firewall_id"
-	paramFirewallRuleInbound  = "inbound"
-	paramFirewallRuleOutbound = "outbound"
-	paramFirewallRuleVersion  = "version"
-	paramSlug                 = "slug"
+	firewallDefaultLinodeKey       = "linode"
+	paramDefaultFirewallIDs        = "default_firewall_ids"
+	paramDeviceID                  = "id"
+	paramDeviceType                = "type"
+	firewallDeviceTypeNodeBalancer = "nodebalancer"
+	paramFirewallDeviceID          = "device_id"
+	paramFirewallID                = "firewall_id"
+	paramFirewallRuleInbound       = "inbound"
+	paramFirewallRuleOutbound      = "outbound"
+	paramFirewallRuleVersion       = "version"
+	paramSlug                      = "slug"
 )
 
 // NewLinodeFirewallListTool creates a tool for listing firewalls.
@@ -89,7 +91,7 @@ func handleLinodeFirewallGetRequest(ctx context.Context, request *mcp.CallToolRe
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -249,7 +251,7 @@ func handleLinodeFirewallRulesListRequest(ctx context.Context, request *mcp.Call
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -301,7 +303,7 @@ func handleLinodeFirewallRulesUpdateRequest(ctx context.Context, request *mcp.Ca
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -372,9 +374,83 @@ func firewallRuleSetFromTool(request *mcp.CallToolRequest, name string) ([]map[s
 		return nil, name + " must be an array of objects"
 	}
 
+	for idx, rule := range rules {
+		if msg := normalizeFirewallRuleIPv6(rule); msg != "" {
+			return nil, fmt.Sprintf("%s[%d].%s", name, idx, msg)
+		}
+	}
+
 	return rules, ""
 }
 
+// normalizeFirewallRuleIPv6 checks a rule's addresses.ipv6 entries and
+// rewrites each to its canonical form, so "0::/0" goes out as "::/0". The API
+// wants every IPv6 entry as a CIDR, so a bare host address is rejected with
+// the /128 spelling to use, as is an IPv4 (or IPv4-mapped) address listed
+// there. A missing or non-array ipv6 is left for the API to judge.
+func normalizeFirewallRuleIPv6(rule map[string]any) string {
+	addresses, ok := rule["addresses"].(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	entries, ok := addresses["ipv6"].([]any)
+	if !ok {
+		return ""
+	}
+
+	for idx, entry := range entries {
+		raw, ok := entry.(string)
+		if !ok {
+			return fmt.Sprintf("addresses.ipv6[%d] must be a string", idx)
+		}
+
+		prefix, msg := parseFirewallIPv6(raw)
+		if msg != "" {
+			return fmt.Sprintf("addresses.ipv6[%d] %q %s", idx, raw, msg)
+		}
+
+		entries[idx] = prefix.String()
+	}
+
+	return ""
+}
+
+// parseFirewallIPv6 parses one addresses.ipv6 entry, returning the reason it
+// is unusable when it is not an IPv6 CIDR without host bits. Zoned
+// (link-local "%eth0") addresses have no meaning to a firewall and are
+// rejected.
+func parseFirewallIPv6(raw string) (netip.Prefix, string) {
+	raw = strings.TrimSpace(raw)
+	if strings.Contains(raw, "%") {
+		return netip.Prefix{}, "is not a valid IPv6 CIDR"
+	}
+
+	prefix, err := netip.ParsePrefix(raw)
+	if err != nil {
+		addr, addrErr := netip.ParseAddr(raw)
+
+		switch {
+		case addrErr != nil:
+			return netip.Prefix{}, "is not a valid IPv6 CIDR"
+		case addr.Is4() || addr.Is4In6():
+			return netip.Prefix{}, "is an IPv4 address; list it under addresses.ipv4"
+		default:
+			return netip.Prefix{}, "has no prefix length; use " + netip.PrefixFrom(addr, addr.BitLen()).String() + " for a single host"
+		}
+	}
+
+	if prefix.Addr().Is4() || prefix.Addr().Is4In6() {
+		return netip.Prefix{}, "is an IPv4 address; list it under addresses.ipv4"
+	}
+
+	if masked := prefix.Masked(); masked != prefix {
+		return netip.Prefix{}, "has host bits set; use " + masked.String()
+	}
+
+	return prefix, ""
+}
+
 // NewLinodeFirewallRuleVersionsListTool creates a tool for retrieving rule-version history for a Cloud Firewall.
 func NewLinodeFirewallRuleVersionsListTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
 	tool, handler := newProtoListToolSubresourceRawSchema(
@@ -427,7 +503,7 @@ func handleLinodeFirewallRuleVersionGetRequest(ctx context.Context, request *mcp
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -509,7 +585,7 @@ func handleLinodeFirewallDeviceGetRequest(ctx context.Context, request *mcp.Call
 		return mcp.NewToolResultError(linode.ErrFirewallDeviceIDPositive.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -526,7 +602,8 @@ func handleLinodeFirewallDeviceGetRequest(ctx context.Context, request *mcp.Call
 func NewLinodeFirewallDeviceCreateTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
 	tool := mcp.NewToolWithRawSchema(
 		"linode_firewall_device_create",
-		"Assigns a Linode, Linode interface, or NodeBalancer device to a Cloud Firewall.",
+		"Assigns a Linode, Linode interface, or NodeBalancer device to a Cloud Firewall. A NodeBalancer can have "+
+			"only one firewall, so one already assigned elsewhere is rejected before the call.",
 		toolschemas.Schema("linode.mcp.v1.FirewallDeviceCreateInput"),
 	)
 
@@ -570,11 +647,17 @@ func handleLinodeFirewallDeviceCreateRequest(ctx context.Context, request *mcp.C
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
+	if req.Type == firewallDeviceTypeNodeBalancer {
+		if conflictMessage := nodeBalancerFirewallConflict(ctx, client, req.ID, firewallID); conflictMessage != "" {
+			return mcp.NewToolResultError(conflictMessage), nil
+		}
+	}
+
 	device, failureMessage := createFirewallDevice(ctx, client, firewallID, req)
 	if failureMessage != "" {
 		return mcp.NewToolResultError(failureMessage), nil
@@ -604,6 +687,32 @@ func firewallDeviceCreateRequestFromTool(request *mcp.CallToolRequest) (*linode.
 	return &linode.CreateFirewallDeviceRequest{ID: deviceID, Type: deviceType}, ""
 }
 
+// nodeBalancerFirewallConflict checks a NodeBalancer about to be assigned to
+// firewallID. A NodeBalancer sits behind at most one Cloud Firewall, so one
+// that is missing or already protected would only fail at the API; the message
+// says which and how to move it instead. It returns "" when the assignment can
+// go ahead.
+func nodeBalancerFirewallConflict(ctx context.Context, client *linode.Client, nodeBalancerID, firewallID int) string {
+	firewalls, err := client.ListNodeBalancerFirewalls(ctx, nodeBalancerID, 0, 0)
+	if err != nil {
+		return fmt.Sprintf("Failed to look up NodeBalancer %d: %v", nodeBalancerID, err)
+	}
+
+	for i := range firewalls {
+		if firewalls[i].ID == firewallID {
+			return fmt.Sprintf("NodeBalancer %d is already assigned to firewall %d", nodeBalancerID, firewallID)
+		}
+	}
+
+	if len(firewalls) > 0 {
+		return fmt.Sprintf("NodeBalancer %d is already assigned to firewall %d (%s); a NodeBalancer can have only one "+
+			"firewall, so remove that assignment with linode_firewall_device_delete or replace it with "+
+			"linode_nodebalancer_firewall_update", nodeBalancerID, firewalls[0].ID, firewalls[0].Label)
+	}
+
+	return ""
+}
+
 func validateFirewallDeviceType(deviceType string) string {
 	return enumChoiceError(deviceType, paramDeviceType, linodev1.FirewallDeviceType_Value_value)
 }
@@ -684,7 +793,7 @@ func NewLinodeFirewallSettingsListTool(cfg *config.Config) (mcp.Tool, profiles.C
 }
 
 func handleLinodeFirewallSettingsListRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -749,7 +858,7 @@ func handleLinodeFirewallTemplateGetRequest(ctx context.Context, request *mcp.Ca
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -805,7 +914,7 @@ func handleLinodeFirewallSettingsUpdateRequest(ctx context.Context, request *mcp
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -858,7 +967,7 @@ func firewallSettingsUpdateRequestFromTool(request *mcp.CallToolRequest) (*linod
 		switch key {
 		case firewallDefaultLinodeKey:
 			req.DefaultFirewallIDs.Linode = &value
-		case "nodebalancer":
+		case firewallDeviceTypeNodeBalancer:
 			req.DefaultFirewallIDs.NodeBalancer = &value
 		case "public_interface":
 			req.DefaultFirewallIDs.PublicInterface = &value
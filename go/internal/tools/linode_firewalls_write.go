@@ -72,7 +72,7 @@ func handleLinodeFirewallCreateRequest(ctx context.Context, request *mcp.CallToo
 		return mcp.NewToolResultError(msg), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -159,7 +159,7 @@ func handleLinodeFirewallUpdateRequest(ctx context.Context, request *mcp.CallToo
 		return mcp.NewToolResultError(msg), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -36,7 +36,9 @@ func NewLinodeImageListTool(cfg *config.Config) (mcp.Tool, profiles.Capability,
 	tool, handler := newProtoListToolRawSchema(
 		cfg,
 		"linode_image_list",
-		"Lists all available Linode images (OS images and custom images) with optional filtering by type, public status, or deprecated status",
+		"Lists all available Linode images (OS images and custom images) with optional filtering by type, public status, "+
+			"deprecated status, vendor, or label. Set latest_per_distro=true to get only the newest image of each distro, "+
+			"which is usually the one to create an instance from.",
 		"linode.mcp.v1.ImageListInput",
 		func(ctx context.Context, client *linode.Client) ([]*linodev1.Image, error) {
 			return client.ListImagesProto(ctx)
@@ -48,6 +50,11 @@ func NewLinodeImageListTool(cfg *config.Config) (mcp.Tool, profiles.Capability,
 				func(img *linodev1.Image) bool { return img.GetIsPublic() }),
 			boolFilter("deprecated", "Filter by deprecated status (true, false)",
 				func(img *linodev1.Image) bool { return img.GetDeprecated() }),
+			fieldFilter("vendor", "Filter images by vendor, the distro family (for example Ubuntu, Debian)",
+				func(img *linodev1.Image) string { return img.GetVendor() }),
+			containsFilter("label", "Filter images whose label contains this text (case-insensitive)",
+				func(img *linodev1.Image) string { return img.GetLabel() }),
+			latestPerDistroFilter(),
 		},
 		imageListResponse,
 	)
@@ -55,6 +62,39 @@ func NewLinodeImageListTool(cfg *config.Config) (mcp.Tool, profiles.Capability,
 	return tool, profiles.CapRead, handler
 }
 
+// latestPerDistroFilter is linode_image_list's latest_per_distro mode. When
+// the argument is "true" (case-insensitive) it keeps only the newest image of
+// each vendor by created time, in list order, and drops images with no vendor.
+// Other values keep every image, as boolFilter's "false" would.
+func latestPerDistroFilter() listFilterParam[*linodev1.Image] {
+	return listFilterParam[*linodev1.Image]{
+		paramName:   "latest_per_distro",
+		description: "When true, return only the newest image (by created) of each vendor",
+		matchFunc: func(items []*linodev1.Image, value string) []*linodev1.Image {
+			if !strings.EqualFold(value, boolTrue) {
+				return items
+			}
+
+			newest := make(map[string]*linodev1.Image)
+
+			for _, img := range items {
+				vendor := strings.ToLower(img.GetVendor())
+				if vendor == "" {
+					continue
+				}
+
+				if current, ok := newest[vendor]; !ok || img.GetCreated() > current.GetCreated() {
+					newest[vendor] = img
+				}
+			}
+
+			return slices.DeleteFunc(items, func(img *linodev1.Image) bool {
+				return newest[strings.ToLower(img.GetVendor())] != img
+			})
+		},
+	}
+}
+
 func imageListResponse(items []*linodev1.Image, count int32, filter *string) *linodev1.ImageListResponse {
 	return &linodev1.ImageListResponse{Count: count, Filter: filter, Images: items}
 }
@@ -434,7 +474,7 @@ func handleImageShareGroupGetRequest(ctx context.Context, request *mcp.CallToolR
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -453,7 +493,7 @@ func handleImageGetRequest(ctx context.Context, request *mcp.CallToolRequest, cf
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -477,7 +517,7 @@ func handleImageShareGroupMemberTokenGetRequest(ctx context.Context, request *mc
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -576,7 +616,7 @@ func handleImageShareGroupTokenGetRequest(ctx context.Context, request *mcp.Call
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -650,7 +690,7 @@ func handleImageShareGroupByTokenGetRequest(ctx context.Context, request *mcp.Ca
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -107,7 +107,7 @@ func handleLinodeImageUploadRequest(ctx context.Context, request *mcp.CallToolRe
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -200,7 +200,7 @@ func handleLinodeImageUpdateRequest(ctx context.Context, request *mcp.CallToolRe
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -411,7 +411,7 @@ func handleLinodeImageShareGroupCreateRequest(ctx context.Context, request *mcp.
 
 	label := strings.TrimSpace(request.GetString("label", ""))
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -492,7 +492,7 @@ func handleLinodeImageShareGroupImagesAddRequest(ctx context.Context, request *m
 		request.GetArguments()[paramEnvironment] = environment
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -599,7 +599,7 @@ func handleLinodeImageShareGroupImageUpdateRequest(ctx context.Context, request
 		request.GetArguments()[paramEnvironment] = environment
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -724,7 +724,7 @@ func handleLinodeImageShareGroupMembersAddRequest(ctx context.Context, request *
 		request.GetArguments()[paramEnvironment] = environment
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -874,7 +874,7 @@ func handleLinodeImageShareGroupUpdateRequest(ctx context.Context, request *mcp.
 		request.GetArguments()[paramEnvironment] = environment
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -1035,7 +1035,7 @@ func handleLinodeImageShareGroupTokenUpdateRequest(ctx context.Context, request
 		request.GetArguments()[paramEnvironment] = environment
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -1110,7 +1110,7 @@ func handleLinodeImageShareGroupMemberUpdateRequest(ctx context.Context, request
 		request.GetArguments()[paramEnvironment] = environment
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -1195,7 +1195,7 @@ func handleLinodeImageShareGroupTokenCreateRequest(ctx context.Context, request
 		request.GetArguments()[paramEnvironment] = environment
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -1258,7 +1258,7 @@ func handleLinodeImageReplicateRequest(ctx context.Context, request *mcp.CallToo
 		request.GetArguments()[paramEnvironment] = environment
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -1377,7 +1377,7 @@ func handleLinodeImageCreateRequest(ctx context.Context, request *mcp.CallToolRe
 		request.GetArguments()[paramEnvironment] = environment
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -88,7 +88,7 @@ func handleInstanceCloneRequest(ctx context.Context, request *mcp.CallToolReques
 		req.Disks = disks
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -110,7 +110,8 @@ func handleInstanceCloneRequest(ctx context.Context, request *mcp.CallToolReques
 func NewLinodeInstanceMigrateTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
 	tool := mcp.NewToolWithRawSchema(
 		"linode_instance_migrate",
-		"Migrates a Linode instance to a new region. If no region is specified, Linode picks the destination.",
+		"Migrates a Linode instance to a new region. If no region is specified, Linode picks the destination. "+
+			"Set plan=true to get a migration plan instead: the expected downtime type (cold or warm), the disk size to transfer, and any migration Linode has already queued.",
 		toolschemas.Schema("linode.mcp.v1.InstanceMigrateInput"),
 	)
 
@@ -124,6 +125,19 @@ func NewLinodeInstanceMigrateTool(cfg *config.Config) (mcp.Tool, profiles.Capabi
 func handleInstanceMigrateRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
 	linodeID := request.GetInt("linode_id", 0)
 
+	migrationType := request.GetString(paramMigrationType, "")
+	if migrationType != "" && migrationType != migrationTypeCold && migrationType != migrationTypeWarm {
+		return mcp.NewToolResultError(`type must be "cold" or "warm"`), nil
+	}
+
+	if plan, ok := request.GetArguments()[paramMigratePlan].(bool); ok && plan {
+		if linodeID == 0 {
+			return mcp.NewToolResultError("linode_id is required"), nil
+		}
+
+		return handleInstanceMigratePlan(ctx, request, cfg, linodeID, migrationType)
+	}
+
 	if IsDryRun(request) {
 		if linodeID == 0 {
 			return mcp.NewToolResultError("linode_id is required"), nil
@@ -147,12 +161,12 @@ func handleInstanceMigrateRequest(ctx context.Context, request *mcp.CallToolRequ
 
 	region := request.GetString("region", "")
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	if err := client.MigrateInstance(ctx, linodeID, region); err != nil {
+	if err := client.MigrateInstance(ctx, linodeID, linode.MigrateInstanceRequest{Region: region, Type: migrationType}); err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Failed to migrate instance %d: %v", linodeID, err)), nil
 	}
 
@@ -224,7 +238,7 @@ func handleInstanceMutateRequest(ctx context.Context, request *mcp.CallToolReque
 		mutateReq.AllowAutoDiskResize = &allowAutoDiskResize
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -394,7 +408,7 @@ func handleInstanceRescueRequest(ctx context.Context, request *mcp.CallToolReque
 		}
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
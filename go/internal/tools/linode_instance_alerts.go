@@ -0,0 +1,334 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// instanceAlertNames lists the instance alert thresholds in the order the
+// alert tools read and report them.
+var instanceAlertNames = []string{"cpu", "io", "network_in", "network_out", "transfer_quota"}
+
+// Upper bounds Linode enforces on the percentage thresholds. The CPU bound is
+// per vCPU: a 4-core instance accepts up to 400.
+const (
+	alertCPUPercentPerVCPU   = 100
+	alertTransferQuotaMaxPct = 100
+)
+
+// instanceAlertsState is the linode_instance_alerts_update dry-run
+// current_state: the thresholds it merges into and the vCPU count that bounds
+// cpu, so the preview stays identical across languages.
+type instanceAlertsState struct {
+	Alerts linode.Alerts `json:"alerts"`
+	VCPUs  int           `json:"vcpus"`
+}
+
+// instanceAlertField returns a pointer to the named threshold in alerts.
+func instanceAlertField(alerts *linode.Alerts, name string) *int {
+	switch name {
+	case "cpu":
+		return &alerts.CPU
+	case "io":
+		return &alerts.IO
+	case "network_in":
+		return &alerts.NetworkIn
+	case "network_out":
+		return &alerts.NetworkOut
+	default:
+		return &alerts.TransferQuota
+	}
+}
+
+// NewLinodeInstanceAlertsUpdateTool creates a tool that sets an instance's
+// alert thresholds, leaving the ones not passed unchanged.
+func NewLinodeInstanceAlertsUpdateTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_instance_alerts_update",
+		"Sets alert thresholds on a Linode instance: cpu (percent, up to 100 per vCPU), io (ops/sec), "+
+			"network_in and network_out (Mb/s), and transfer_quota (percent of the monthly quota). "+
+			"Thresholds not passed keep their current values; 0 disables that alert. "+
+			"Pass dry_run=true to preview the changes without updating.",
+		toolschemas.Schema("linode.mcp.v1.InstanceAlertsUpdateInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeInstanceAlertsUpdateRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// instanceAlertsFromTool collects the thresholds passed in the tool args,
+// keyed by name. Returns a validation message when none was passed or one is
+// out of range; cpu's upper bound depends on the instance and is checked by
+// validateInstanceAlertsCPU.
+func instanceAlertsFromTool(request *mcp.CallToolRequest) (map[string]int, string) {
+	args := request.GetArguments()
+	thresholds := make(map[string]int, len(instanceAlertNames))
+
+	for _, name := range instanceAlertNames {
+		raw, exists := args[name]
+		if !exists {
+			continue
+		}
+
+		value, ok := numberArgToInt(raw)
+		if !ok || value < 0 {
+			return nil, name + " must be an integer of 0 or greater; 0 disables the alert"
+		}
+
+		thresholds[name] = value
+	}
+
+	if len(thresholds) == 0 {
+		return nil, "at least one threshold is required: " + strings.Join(instanceAlertNames, ", ")
+	}
+
+	if value, ok := thresholds["transfer_quota"]; ok && value > alertTransferQuotaMaxPct {
+		return nil, fmt.Sprintf("transfer_quota must be from 0 through %d percent", alertTransferQuotaMaxPct)
+	}
+
+	return thresholds, ""
+}
+
+// validateInstanceAlertsCPU checks a requested cpu threshold against the
+// instance's vCPU count. An unknown count (0) skips the check.
+func validateInstanceAlertsCPU(thresholds map[string]int, vcpus int) string {
+	value, ok := thresholds["cpu"]
+	if !ok || vcpus == 0 || value <= vcpus*alertCPUPercentPerVCPU {
+		return ""
+	}
+
+	return fmt.Sprintf("cpu must be from 0 through %d for an instance with %d vCPUs", vcpus*alertCPUPercentPerVCPU, vcpus)
+}
+
+// mergeInstanceAlerts overlays the requested thresholds on the current ones;
+// the API replaces the whole alerts object, so every field is sent.
+func mergeInstanceAlerts(current linode.Alerts, thresholds map[string]int) linode.Alerts {
+	merged := current
+	for name, value := range thresholds {
+		*instanceAlertField(&merged, name) = value
+	}
+
+	return merged
+}
+
+// instanceAlertChanges describes each threshold that differs between before
+// and after, in instanceAlertNames order.
+func instanceAlertChanges(before, after linode.Alerts) []string {
+	var changes []string
+
+	for _, name := range instanceAlertNames {
+		from, to := *instanceAlertField(&before, name), *instanceAlertField(&after, name)
+		if from == to {
+			continue
+		}
+
+		if to == 0 {
+			changes = append(changes, fmt.Sprintf("%s alert disabled (was %d)", name, from))
+		} else {
+			changes = append(changes, fmt.Sprintf("%s threshold %d -> %d", name, from, to))
+		}
+	}
+
+	return changes
+}
+
+// instanceAlertsUpdateSideEffects is the Tier B walk for
+// linode_instance_alerts_update: one line per threshold that changes, plus a
+// warning when cpu exceeds what the instance's vCPU count allows.
+func instanceAlertsUpdateSideEffects(state any, thresholds map[string]int) DryRunDetails {
+	current, _ := state.(instanceAlertsState)
+
+	var details DryRunDetails
+
+	if msg := validateInstanceAlertsCPU(thresholds, current.VCPUs); msg != "" {
+		details.Warnings = append(details.Warnings, msg+"; the update would be rejected.")
+	}
+
+	changes := instanceAlertChanges(current.Alerts, mergeInstanceAlerts(current.Alerts, thresholds))
+	if len(changes) == 0 {
+		details.SideEffects = []string{"No alert thresholds change."}
+
+		return details
+	}
+
+	for _, change := range changes {
+		details.SideEffects = append(details.SideEffects, "Instance "+change+".")
+	}
+
+	return details
+}
+
+func handleLinodeInstanceAlertsUpdateRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if IsDryRun(request) {
+		instanceID, validationMessage := requiredIDArgument(request, "instance_id")
+		if validationMessage != "" {
+			return mcp.NewToolResultError(validationMessage), nil
+		}
+
+		thresholds, validationMessage := instanceAlertsFromTool(request)
+		if validationMessage != "" {
+			return mcp.NewToolResultError(validationMessage), nil
+		}
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_instance_alerts_update", httpMethodPut,
+			fmt.Sprintf("/linode/instances/%d", instanceID),
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				instance, err := c.GetInstance(ctx, instanceID)
+				if err != nil {
+					return nil, err
+				}
+
+				return instanceAlertsState{Alerts: instance.Alerts, VCPUs: instance.Specs.VCPUs}, nil
+			},
+			func(_ context.Context, _ *linode.Client, state any) (DryRunDetails, error) {
+				return instanceAlertsUpdateSideEffects(state, thresholds), nil
+			})
+	}
+
+	if result := RequireConfirm(request, "This changes the alert thresholds of a Linode instance. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	instanceID, validationMessage := requiredIDArgument(request, "instance_id")
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	thresholds, validationMessage := instanceAlertsFromTool(request)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	current, err := client.GetInstance(ctx, instanceID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve instance %d: %v", instanceID, err)), nil
+	}
+
+	if msg := validateInstanceAlertsCPU(thresholds, current.Specs.VCPUs); msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	merged := mergeInstanceAlerts(current.Alerts, thresholds)
+
+	instance, err := client.UpdateInstanceProto(ctx, instanceID, &linode.UpdateInstanceRequest{Alerts: &merged})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to update alerts on instance %d: %v", instanceID, err)), nil
+	}
+
+	message := fmt.Sprintf("Instance %d alerts updated: no thresholds changed", instanceID)
+	if changes := instanceAlertChanges(current.Alerts, merged); len(changes) > 0 {
+		message = fmt.Sprintf("Instance %d alerts updated: %s", instanceID, strings.Join(changes, ", "))
+	}
+
+	return MarshalProtoToolResponse(&linodev1.InstanceAlertsUpdateResponse{
+		Message:    message,
+		InstanceId: linodeIDToInt32(instanceID),
+		Previous:   instanceAlertsToProto(current.Alerts),
+		Alerts:     instance.GetAlerts(),
+	})
+}
+
+// instanceAlertsToProto converts the typed alerts into their proto message.
+func instanceAlertsToProto(alerts linode.Alerts) *linodev1.Alerts {
+	return &linodev1.Alerts{
+		Cpu:           linodeIDToInt32(alerts.CPU),
+		Io:            linodeIDToInt32(alerts.IO),
+		NetworkIn:     linodeIDToInt32(alerts.NetworkIn),
+		NetworkOut:    linodeIDToInt32(alerts.NetworkOut),
+		TransferQuota: linodeIDToInt32(alerts.TransferQuota),
+	}
+}
+
+// NewLinodeAlertsAuditTool creates a tool that flags instances with their
+// alerts disabled.
+func NewLinodeAlertsAuditTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_alerts_audit",
+		"Checks every Linode instance's alert thresholds and flags instances with every alert disabled "+
+			"(all thresholds 0), which would go unnoticed if they ran hot or lost traffic. Instances with only "+
+			"some alerts disabled are listed separately with the disabled alert names. "+
+			"Use linode_instance_alerts_update to turn alerts back on.",
+		toolschemas.Schema("linode.mcp.v1.AlertsAuditInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeAlertsAuditRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLinodeAlertsAuditRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	instances, err := client.ListInstancesProto(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve instances: %v", err)), nil
+	}
+
+	return MarshalProtoToolResponse(alertsAudit(instances))
+}
+
+// alertsAudit sorts instances into those with every alert disabled and those
+// with only some disabled; instances with all alerts on are only counted.
+func alertsAudit(instances []*linodev1.Instance) *linodev1.AlertsAuditResponse {
+	response := &linodev1.AlertsAuditResponse{
+		Checked:           linodeIDToInt32(len(instances)),
+		Disabled:          []*linodev1.AlertsAuditInstance{},
+		PartiallyDisabled: []*linodev1.AlertsAuditInstance{},
+	}
+
+	for _, instance := range instances {
+		alerts := instance.GetAlerts()
+		thresholds := []int32{
+			alerts.GetCpu(), alerts.GetIo(), alerts.GetNetworkIn(), alerts.GetNetworkOut(), alerts.GetTransferQuota(),
+		}
+
+		var disabled []string
+
+		for i, value := range thresholds {
+			if value == 0 {
+				disabled = append(disabled, instanceAlertNames[i])
+			}
+		}
+
+		if len(disabled) == 0 {
+			continue
+		}
+
+		entry := &linodev1.AlertsAuditInstance{
+			Id: instance.GetId(), Label: instance.GetLabel(), Region: instance.GetRegion(), DisabledAlerts: disabled,
+		}
+
+		if len(disabled) == len(instanceAlertNames) {
+			response.Disabled = append(response.Disabled, entry)
+		} else {
+			response.PartiallyDisabled = append(response.PartiallyDisabled, entry)
+		}
+	}
+
+	response.Message = fmt.Sprintf("%d of %d instances have every alert disabled; %d more have some alerts disabled",
+		len(response.GetDisabled()), response.GetChecked(), len(response.GetPartiallyDisabled()))
+
+	return response
+}
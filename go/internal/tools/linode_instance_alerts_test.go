@@ -0,0 +1,131 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// alertsInstanceServer serves instance 7 (2 vCPUs) with the given alerts and
+// records the body of any update PUT.
+func alertsInstanceServer(t *testing.T, alerts string, updated *map[string]any, mu *sync.Mutex) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/linode/instances/7":
+		case r.Method == http.MethodPut && r.URL.Path == "/linode/instances/7":
+			mu.Lock()
+			if err := json.NewDecoder(r.Body).Decode(updated); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if raw, err := json.Marshal((*updated)["alerts"]); err == nil {
+				alerts = string(raw)
+			}
+			mu.Unlock()
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		body := `{"id":7,"label":"web-1","region":"us-east","specs":{"vcpus":2},"alerts":` + alerts + `}`
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestLinodeInstanceAlertsUpdateSendsFullAlerts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		updated map[string]any
+		mu      sync.Mutex
+	)
+
+	srv := alertsInstanceServer(t, `{"cpu":180,"io":10000,"network_in":10,"network_out":10,"transfer_quota":80}`, &updated, &mu)
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeInstanceAlertsUpdateTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"confirm": true, "instance_id": 7, "network_out": 0}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// The API replaces the whole alerts object, so untouched thresholds must
+	// be sent at their current values rather than dropped to 0.
+	want := map[string]any{
+		"cpu": float64(180), "io": float64(10000), "network_in": float64(10),
+		"network_out": float64(0), "transfer_quota": float64(80),
+	}
+
+	alerts, ok := updated["alerts"].(map[string]any)
+	if !ok {
+		t.Fatalf("update body alerts = %v, want an object", updated["alerts"])
+	}
+
+	for name, value := range want {
+		if alerts[name] != value {
+			t.Errorf("update body alerts[%s] = %v, want %v", name, alerts[name], value)
+		}
+	}
+}
+
+func TestLinodeInstanceAlertsUpdateRejectsCPUOverVCPULimit(t *testing.T) {
+	t.Parallel()
+
+	var (
+		updated map[string]any
+		mu      sync.Mutex
+	)
+
+	srv := alertsInstanceServer(t, `{"cpu":180,"io":10000,"network_in":10,"network_out":10,"transfer_quota":80}`, &updated, &mu)
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeInstanceAlertsUpdateTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"confirm": true, "instance_id": 7, "cpu": 250}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !result.IsError {
+		t.Fatal("result.IsError = false, want true")
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	if !strings.Contains(textContent.Text, "cpu must be from 0 through 200 for an instance with 2 vCPUs") {
+		t.Errorf("error = %q, want the 2-vCPU cpu bound", textContent.Text)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if updated != nil {
+		t.Errorf("update body = %v, want no update PUT", updated)
+	}
+}
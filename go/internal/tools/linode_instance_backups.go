@@ -36,7 +36,7 @@ func handleInstanceBackupsListRequest(ctx context.Context, request *mcp.CallTool
 		return mcp.NewToolResultError(ErrLinodeIDRequired.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -75,7 +75,7 @@ func handleInstanceBackupGetRequest(ctx context.Context, request *mcp.CallToolRe
 		return mcp.NewToolResultError(ErrBackupIDRequired.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -125,7 +125,7 @@ func handleInstanceBackupCreateRequest(ctx context.Context, request *mcp.CallToo
 		return mcp.NewToolResultError(ErrLinodeIDRequired.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -209,7 +209,7 @@ func handleInstanceBackupRestoreRequest(ctx context.Context, request *mcp.CallTo
 		return mcp.NewToolResultError(msg), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -268,7 +268,7 @@ func handleInstanceBackupsEnableRequest(ctx context.Context, request *mcp.CallTo
 		return mcp.NewToolResultError(ErrLinodeIDRequired.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -325,7 +325,7 @@ func handleInstanceFirewallsApplyRequest(ctx context.Context, request *mcp.CallT
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
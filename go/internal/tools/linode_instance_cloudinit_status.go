@@ -0,0 +1,197 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// Provisioning outcomes reported in linode_instance_cloudinit_status.
+const (
+	cloudInitNotApplicable = "not_applicable"
+	cloudInitUnknown       = "unknown"
+	cloudInitInProgress    = "in_progress"
+	cloudInitFailed        = "failed"
+	cloudInitPendingBoot   = "pending_boot"
+	cloudInitCompleted     = "completed"
+)
+
+// cloudInitEventScan is how much of the newest-first event feed is scanned for
+// the instance's provisioning and boot events.
+const cloudInitEventScan = 100
+
+// instanceProvisionActions are the event actions that deploy an image, and so
+// hand user_data to cloud-init on the next boot.
+var instanceProvisionActions = []string{"linode_create", "linode_rebuild"}
+
+// NewLinodeInstanceCloudInitStatusTool creates a tool that reports whether an
+// instance's user_data provisioning has run, as far as its events show.
+func NewLinodeInstanceCloudInitStatusTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_instance_cloudinit_status",
+		"Reports whether an instance's user_data (cloud-init) provisioning has run. The metadata service only "+
+			"answers from inside the instance, so cloud-init's own result is not available through the API; this "+
+			"reads the instance's create or rebuild event and the first boot after it, and returns "+
+			"provisioning_status completed, pending_boot, in_progress, failed, unknown, or not_applicable (no user_data).",
+		toolschemas.Schema("linode.mcp.v1.InstanceCloudInitStatusInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeInstanceCloudInitStatusRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLinodeInstanceCloudInitStatusRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	instanceID, validationMessage := requiredIDArgument(request, "instance_id")
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	instance, err := client.GetInstanceProto(ctx, instanceID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve instance %d: %v", instanceID, err)), nil
+	}
+
+	var warnings []string
+
+	events, err := client.ListAccountEventsProto(ctx, 1, cloudInitEventScan)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("Events unavailable, so provisioning progress is unknown: %v", err))
+	}
+
+	return MarshalProtoToolResponse(instanceCloudInitStatus(instance, events, warnings))
+}
+
+// instanceCloudInitStatus infers provisioning progress from the newest page of
+// account events: the latest create or rebuild of the instance, then the
+// oldest boot after it, which is the boot cloud-init applies user_data on.
+func instanceCloudInitStatus(instance *linodev1.Instance, events []*linodev1.AccountEvent, warnings []string) *linodev1.InstanceCloudInitStatusResponse {
+	response := &linodev1.InstanceCloudInitStatusResponse{
+		InstanceId:  instance.GetId(),
+		Label:       instance.GetLabel(),
+		Status:      instance.GetStatus(),
+		HasUserData: instance.GetHasUserData(),
+		Guidance:    []string{},
+		Warnings:    warnings,
+	}
+
+	var boots []*linodev1.AccountEvent
+
+	for _, event := range events {
+		entity := event.GetEntity()
+		if entity.GetType() != "linode" || int32(entity.GetId().GetNumberValue()) != instance.GetId() {
+			continue
+		}
+
+		if slices.Contains(instanceProvisionActions, event.GetAction()) {
+			response.ProvisioningEvent = event
+
+			break
+		}
+
+		if slices.Contains(instanceBootActions, event.GetAction()) {
+			boots = append(boots, event)
+		}
+	}
+
+	if len(boots) > 0 && response.GetProvisioningEvent() != nil {
+		response.FirstBoot = boots[len(boots)-1]
+	}
+
+	response.ProvisioningStatus = cloudInitProvisioningStatus(response)
+	response.Message = cloudInitStatusMessage(response)
+
+	if response.GetProvisioningStatus() != cloudInitNotApplicable {
+		response.Guidance = append(response.Guidance,
+			"The API cannot see cloud-init's result; run `cloud-init status --long` on the instance "+
+				"(over SSH, or Lish via linode_instance_console) to confirm every user_data step succeeded.")
+	}
+
+	switch response.GetProvisioningStatus() {
+	case cloudInitUnknown:
+		response.Guidance = append(response.Guidance, fmt.Sprintf(
+			"No create or rebuild of the instance is in the newest %d account events; it was provisioned too long ago to tell from events.",
+			cloudInitEventScan))
+	case cloudInitPendingBoot:
+		response.Guidance = append(response.Guidance,
+			"Boot the instance with linode_instance_boot; cloud-init applies user_data on the first boot after provisioning.")
+	case cloudInitFailed:
+		response.Guidance = append(response.Guidance,
+			"Provisioning failed before cloud-init could run; check the failed event and the console with linode_instance_console.")
+	}
+
+	return response
+}
+
+// cloudInitProvisioningStatus maps the provisioning and first-boot events to
+// a provisioning_status value.
+func cloudInitProvisioningStatus(response *linodev1.InstanceCloudInitStatusResponse) string {
+	if !response.GetHasUserData() {
+		return cloudInitNotApplicable
+	}
+
+	provision := response.GetProvisioningEvent()
+	if provision == nil {
+		return cloudInitUnknown
+	}
+
+	step := provision
+	if provision.GetStatus() == "finished" {
+		step = response.GetFirstBoot()
+		if step == nil {
+			return cloudInitPendingBoot
+		}
+	}
+
+	switch step.GetStatus() {
+	case "finished":
+		return cloudInitCompleted
+	case "failed":
+		return cloudInitFailed
+	default:
+		return cloudInitInProgress
+	}
+}
+
+// cloudInitStatusMessage is the one-line summary for a provisioning_status.
+func cloudInitStatusMessage(response *linodev1.InstanceCloudInitStatusResponse) string {
+	prefix := fmt.Sprintf("Instance %d (%s)", response.GetInstanceId(), response.GetLabel())
+
+	switch response.GetProvisioningStatus() {
+	case cloudInitNotApplicable:
+		return prefix + " has no user_data, so there is no cloud-init provisioning to verify"
+	case cloudInitUnknown:
+		return prefix + " has user_data, but its provisioning events are not in the recent event feed"
+	case cloudInitPendingBoot:
+		return prefix + " was provisioned but has not booted since, so cloud-init has not applied user_data yet"
+	case cloudInitFailed:
+		return prefix + " provisioning failed (" + cloudInitFailedEvent(response).GetAction() + ")"
+	case cloudInitCompleted:
+		return prefix + " finished its first boot after provisioning, when cloud-init applies user_data"
+	default:
+		return prefix + " is still provisioning; cloud-init runs once the first boot finishes"
+	}
+}
+
+// cloudInitFailedEvent is the event a failed provisioning_status stems from.
+func cloudInitFailedEvent(response *linodev1.InstanceCloudInitStatusResponse) *linodev1.AccountEvent {
+	if response.GetProvisioningEvent().GetStatus() == "failed" {
+		return response.GetProvisioningEvent()
+	}
+
+	return response.GetFirstBoot()
+}
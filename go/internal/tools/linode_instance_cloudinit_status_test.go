@@ -0,0 +1,113 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeInstanceCloudInitStatusFromEvents(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		instance      string
+		events        string
+		wantStatus    string
+		wantFirstBoot int
+	}{
+		{
+			name:     "failed first boot after rebuild",
+			instance: `{"id":42,"label":"edge-1","status":"offline","has_user_data":true}`,
+			events: `{"id":5,"action":"linode_reboot","status":"finished","entity":{"id":42,"type":"linode"}},` +
+				`{"id":4,"action":"linode_boot","status":"failed","entity":{"id":42,"type":"linode"}},` +
+				`{"id":3,"action":"linode_rebuild","status":"finished","entity":{"id":42,"type":"linode"}},` +
+				`{"id":2,"action":"linode_boot","status":"finished","entity":{"id":42,"type":"linode"}},` +
+				`{"id":1,"action":"linode_create","status":"finished","entity":{"id":42,"type":"linode"}}`,
+			wantStatus:    "failed",
+			wantFirstBoot: 4,
+		},
+		{
+			name:       "create still running",
+			instance:   `{"id":42,"label":"edge-1","status":"provisioning","has_user_data":true}`,
+			events:     `{"id":1,"action":"linode_create","status":"started","entity":{"id":42,"type":"linode"}}`,
+			wantStatus: "in_progress",
+		},
+		{
+			name:       "provisioned before the scanned events",
+			instance:   `{"id":42,"label":"edge-1","status":"running","has_user_data":true}`,
+			events:     `{"id":1,"action":"linode_boot","status":"finished","entity":{"id":42,"type":"linode"}}`,
+			wantStatus: "unknown",
+		},
+		{
+			name:       "no user data",
+			instance:   `{"id":42,"label":"edge-1","status":"running","has_user_data":false}`,
+			events:     `{"id":1,"action":"linode_create","status":"finished","entity":{"id":42,"type":"linode"}}`,
+			wantStatus: "not_applicable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				var body string
+
+				switch r.URL.Path {
+				case "/linode/instances/42":
+					body = tt.instance
+				case "/account/events":
+					body = `{"data":[` + tt.events + `],"page":1,"pages":1,"results":1}`
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+
+				if _, err := w.Write([]byte(body)); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+			_, _, handler := tools.NewLinodeInstanceCloudInitStatusTool(cfg)
+
+			result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"instance_id": 42}))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if result.IsError || !ok {
+				t.Fatalf("result = %v, want a status report", result.Content)
+			}
+
+			var status struct {
+				ProvisioningStatus string `json:"provisioning_status"`
+				FirstBoot          struct {
+					ID int `json:"id"`
+				} `json:"first_boot"`
+			}
+			if err := json.Unmarshal([]byte(textContent.Text), &status); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if status.ProvisioningStatus != tt.wantStatus {
+				t.Errorf("provisioning_status = %q, want %q", status.ProvisioningStatus, tt.wantStatus)
+			}
+
+			if status.FirstBoot.ID != tt.wantFirstBoot {
+				t.Errorf("first_boot.id = %d, want %d", status.FirstBoot.ID, tt.wantFirstBoot)
+			}
+		})
+	}
+}
@@ -27,8 +27,9 @@ const (
 	interfaceJSONObjRequired      = "interface must be a JSON object"
 	paramConfigInterfaceID        = "interface_id"
 
-	configInterfacePurposeVLAN = "vlan"
-	configInterfacePurposeVPC  = "vpc"
+	configInterfacePurposePublic = "public"
+	configInterfacePurposeVLAN   = "vlan"
+	configInterfacePurposeVPC    = "vpc"
 )
 
 // NewLinodeInstanceConfigListTool creates a tool for listing configuration profiles on a Linode instance.
@@ -103,7 +104,7 @@ func handleInstanceConfigDeleteRequest(ctx context.Context, request *mcp.CallToo
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -169,7 +170,7 @@ func handleInstanceConfigGetRequest(ctx context.Context, request *mcp.CallToolRe
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -265,11 +266,17 @@ func handleInstanceConfigCreateRequest(ctx context.Context, request *mcp.CallToo
 		return mcp.NewToolResultError(errText), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
+	if interfaces, ok, errText := helperConfigInterfaces(ctx, request, client); errText != "" {
+		return mcp.NewToolResultError(errText), nil
+	} else if ok {
+		createReq.Interfaces = interfaces
+	}
+
 	createdConfig, err := client.CreateInstanceConfigProto(ctx, linodeID, &createReq)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Failed to create configuration profile for instance %d: %v", linodeID, err)), nil
@@ -328,7 +335,7 @@ func handleInstanceConfigInterfaceAddRequest(ctx context.Context, request *mcp.C
 		return mcp.NewToolResultError(errText), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -519,7 +526,7 @@ func handleInstanceConfigInterfaceUpdateRequest(ctx context.Context, request *mc
 		return mcp.NewToolResultError(errText), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -619,7 +626,7 @@ func handleInstanceConfigInterfacesReorderRequest(ctx context.Context, request *
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -696,7 +703,7 @@ func handleInstanceConfigInterfaceGetRequest(ctx context.Context, request *mcp.C
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -756,7 +763,7 @@ func handleInstanceConfigInterfaceDeleteRequest(ctx context.Context, request *mc
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -824,11 +831,17 @@ func handleInstanceConfigUpdateRequest(ctx context.Context, request *mcp.CallToo
 		return mcp.NewToolResultError(errText), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
+	if interfaces, ok, errText := helperConfigInterfaces(ctx, request, client); errText != "" {
+		return mcp.NewToolResultError(errText), nil
+	} else if ok {
+		updateReq.Interfaces = &interfaces
+	}
+
 	updatedConfig, err := client.UpdateInstanceConfigProto(ctx, linodeID, configID, updateReq)
 	if err != nil {
 		return mcp.NewToolResultError(formatUpdateConfigError(linodeID, configID, err)), nil
@@ -999,7 +1012,8 @@ func applyUpdateConfigJSONOptions(request *mcp.CallToolRequest, req *linode.Upda
 		*fields++
 	}
 
-	if rawInterfaces, exists := request.GetArguments()["interfaces"]; exists {
+	rawInterfaces, hasInterfaces := request.GetArguments()["interfaces"]
+	if hasInterfaces {
 		interfaces, errText := parseConfigInterfaces(rawInterfaces)
 		if errText != "" {
 			return errText
@@ -1009,6 +1023,20 @@ func applyUpdateConfigJSONOptions(request *mcp.CallToolRequest, req *linode.Upda
 		*fields++
 	}
 
+	helpers, validationMessage := networkHelpersFromTool(request)
+	if validationMessage != "" {
+		return validationMessage
+	}
+
+	if helpers.Set {
+		if hasInterfaces {
+			return errNetworkHelpersAndList
+		}
+
+		// The interface list itself is resolved once a client exists.
+		*fields++
+	}
+
 	return ""
 }
 
@@ -1256,6 +1284,15 @@ func applyConfigJSONOptions(request *mcp.CallToolRequest, req *linode.CreateConf
 		req.Interfaces = interfaces
 	}
 
+	helpers, validationMessage := networkHelpersFromTool(request)
+	if validationMessage != "" {
+		return validationMessage
+	}
+
+	if helpers.Set && interfacesJSON != "" {
+		return errNetworkHelpersAndList
+	}
+
 	return ""
 }
 
@@ -1320,5 +1357,9 @@ func parseConfigInterfaces(raw any) ([]linode.ConfigInterface, string) {
 		}
 	}
 
+	if message := validateConfigInterfaceLayout(*interfaces); message != "" {
+		return nil, message
+	}
+
 	return *interfaces, ""
 }
@@ -0,0 +1,182 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// linode_instance_console bounds. The account event feed is newest first and
+// has no per-entity filter in the client, so one page of it is scanned for
+// the instance's events.
+const (
+	instanceConsoleEventsDefault = 10
+	instanceConsoleEventsMax     = 50
+	instanceConsoleEventScan     = 100
+)
+
+// instanceBootActions are the event actions that boot an instance.
+var instanceBootActions = []string{"linode_boot", "linode_reboot", "lassie_reboot"}
+
+// lishLegacyGateways maps the regions that predate region-named Lish
+// gateways to their data center gateway name. Every other region's gateway is
+// lish-<region>.linode.com.
+var lishLegacyGateways = map[string]string{
+	"us-east":      "newark",
+	"us-southeast": "atlanta",
+	"us-central":   "dallas",
+	"us-west":      "fremont",
+	"eu-west":      "london",
+	"eu-central":   "frankfurt",
+	"ap-south":     "singapore",
+	"ap-northeast": "tokyo2",
+	"ap-west":      "mumbai1",
+	"ap-southeast": "sydney",
+	"ca-central":   "toronto1",
+}
+
+// NewLinodeInstanceConsoleTool creates a tool that gathers what is known about
+// an instance's console: its recent events, last boot, and Lish access.
+func NewLinodeInstanceConsoleTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_instance_console",
+		"Answers \"what did the console say\" for an instance. The Linode API does not expose serial console or "+
+			"boot log output, so this returns the instance's status, its most recent events (events, default 10) "+
+			"with the last boot highlighted, and how to read the console live through Lish: the SSH gateway and "+
+			"command for the current profile, plus the Weblish and Glish URLs.",
+		toolschemas.Schema("linode.mcp.v1.InstanceConsoleInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeInstanceConsoleRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLinodeInstanceConsoleRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	instanceID, validationMessage := requiredIDArgument(request, "instance_id")
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	limit := request.GetInt("events", instanceConsoleEventsDefault)
+	if limit < 1 || limit > instanceConsoleEventsMax {
+		return mcp.NewToolResultError(fmt.Sprintf("events must be from 1 through %d", instanceConsoleEventsMax)), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	instance, err := client.GetInstanceProto(ctx, instanceID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve instance %d: %v", instanceID, err)), nil
+	}
+
+	var warnings []string
+
+	username, warning := lishUsername(ctx, client)
+	if warning != "" {
+		warnings = append(warnings, warning)
+	}
+
+	events, err := client.ListAccountEventsProto(ctx, 1, instanceConsoleEventScan)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("Recent events unavailable: %v", err))
+	}
+
+	return MarshalProtoToolResponse(instanceConsole(instance, username, events, limit, warnings))
+}
+
+// instanceConsole assembles the response from the instance, the profile
+// username, and the newest page of account events.
+func instanceConsole(instance *linodev1.Instance, username string, events []*linodev1.AccountEvent, limit int, warnings []string) *linodev1.InstanceConsoleResponse {
+	response := &linodev1.InstanceConsoleResponse{
+		InstanceId:   instance.GetId(),
+		Label:        instance.GetLabel(),
+		Region:       instance.GetRegion(),
+		Status:       instance.GetStatus(),
+		RecentEvents: []*linodev1.AccountEvent{},
+		Lish:         instanceLishAccess(instance, username),
+		Guidance: []string{
+			"The Linode API does not expose console or boot log output; read it through Lish.",
+			"Run the SSH command to attach to the console; in the Lish shell, logview shows the console output kept from recent boots.",
+		},
+		Warnings: warnings,
+	}
+
+	for _, event := range events {
+		entity := event.GetEntity()
+		if entity.GetType() != "linode" || int32(entity.GetId().GetNumberValue()) != instance.GetId() {
+			continue
+		}
+
+		if response.LastBoot == nil && slices.Contains(instanceBootActions, event.GetAction()) {
+			response.LastBoot = event
+		}
+
+		if len(response.GetRecentEvents()) < limit {
+			response.RecentEvents = append(response.RecentEvents, event)
+		}
+	}
+
+	if boot := response.GetLastBoot(); boot.GetStatus() == "failed" {
+		response.Guidance = append(response.Guidance, fmt.Sprintf(
+			"The last boot (event %d) failed; check the boot config with linode_instance_config_list and watch the next boot in Lish.",
+			boot.GetId()))
+	}
+
+	if instance.GetStatus() == "offline" {
+		response.Guidance = append(response.Guidance,
+			"The instance is offline; boot it with linode_instance_boot while attached to Lish to watch the console.")
+	}
+
+	response.Message = fmt.Sprintf("Instance %d (%s) is %s; the API does not expose console output, so %d recent events and Lish access details are listed",
+		instance.GetId(), instance.GetLabel(), instance.GetStatus(), len(response.GetRecentEvents()))
+
+	return response
+}
+
+// instanceLishAccess is how the given profile user reaches an instance's
+// console through Lish.
+func instanceLishAccess(instance *linodev1.Instance, username string) *linodev1.InstanceLishAccess {
+	gateway := lishGateway(instance.GetRegion())
+
+	return &linodev1.InstanceLishAccess{
+		SshGateway: gateway,
+		SshCommand: fmt.Sprintf("ssh -t %s@%s %s", username, gateway, instance.GetLabel()),
+		WeblishUrl: fmt.Sprintf("https://cloud.linode.com/linodes/%d/lish/weblish", instance.GetId()),
+		GlishUrl:   fmt.Sprintf("https://cloud.linode.com/linodes/%d/lish/glish", instance.GetId()),
+	}
+}
+
+// lishUsername is the current profile's username for the Lish command. When
+// the profile cannot be read the command gets a <username> placeholder and the
+// second value is a warning saying so.
+func lishUsername(ctx context.Context, client *linode.Client) (string, string) {
+	profile, err := client.GetProfileProto(ctx)
+	if err != nil {
+		return "<username>", fmt.Sprintf("Profile unavailable, so the Lish command has a <username> placeholder: %v", err)
+	}
+
+	return profile.GetUsername(), ""
+}
+
+// lishGateway is the Lish SSH gateway host for a region.
+func lishGateway(region string) string {
+	if name, ok := lishLegacyGateways[region]; ok {
+		return "lish-" + name + ".linode.com"
+	}
+
+	return "lish-" + region + ".linode.com"
+}
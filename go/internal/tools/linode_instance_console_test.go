@@ -0,0 +1,96 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeInstanceConsoleFallsBackWhenProfileUnavailable(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body string
+
+		switch r.URL.Path {
+		case "/linode/instances/42":
+			body = `{"id":42,"label":"edge-1","region":"us-ord","status":"running"}`
+		case "/profile":
+			w.WriteHeader(http.StatusForbidden)
+
+			body = `{"errors":[{"reason":"Unauthorized"}]}`
+		case "/account/events":
+			body = `{"data":[` +
+				`{"id":3,"action":"linode_reboot","status":"finished","entity":{"id":42,"type":"linode"}},` +
+				`{"id":2,"action":"linode_boot","status":"finished","entity":{"id":42,"type":"linode"}},` +
+				`{"id":1,"action":"linode_boot","status":"finished","entity":{"id":7,"type":"linode"}}` +
+				`],"page":1,"pages":1,"results":3}`
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeInstanceConsoleTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"instance_id": 42, "events": 1}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var console struct {
+		LastBoot struct {
+			ID int `json:"id"`
+		} `json:"last_boot"`
+		RecentEvents []struct {
+			ID int `json:"id"`
+		} `json:"recent_events"`
+		Lish struct {
+			SSHCommand string `json:"ssh_command"`
+		} `json:"lish"`
+		Warnings []string `json:"warnings"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &console); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if console.LastBoot.ID != 3 {
+		t.Errorf("last_boot.id = %d, want 3 (the newest reboot)", console.LastBoot.ID)
+	}
+
+	if len(console.RecentEvents) != 1 || console.RecentEvents[0].ID != 3 {
+		t.Errorf("recent_events = %+v, want only event 3", console.RecentEvents)
+	}
+
+	if console.Lish.SSHCommand != "ssh -t <username>@lish-us-ord.linode.com edge-1" {
+		t.Errorf("lish.ssh_command = %q, want the placeholder username on the region gateway", console.Lish.SSHCommand)
+	}
+
+	if len(console.Warnings) != 1 || !strings.HasPrefix(console.Warnings[0], "Profile unavailable") {
+		t.Errorf("warnings = %q, want the profile warning", console.Warnings)
+	}
+}
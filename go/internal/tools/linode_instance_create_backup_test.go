@@ -0,0 +1,151 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// backupCreateServer serves backup 55 of instance 7 with the given body (or a
+// 404 when it is empty) and records the body of any instance create POST.
+func backupCreateServer(t *testing.T, backup string, created *map[string]any, mu *sync.Mutex) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body string
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/linode/instances/7/backups/55":
+			if backup == "" {
+				w.WriteHeader(http.StatusNotFound)
+
+				body = `{"errors":[{"reason":"Not found"}]}`
+			} else {
+				body = backup
+			}
+		case r.Method == http.MethodPost && r.URL.Path == "/linode/instances":
+			mu.Lock()
+			if err := json.NewDecoder(r.Body).Decode(created); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			mu.Unlock()
+
+			body = `{"id":100,"label":"restored","region":"us-east","type":"g6-nanode-1","status":"provisioning"}`
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func backupCreateArgs() map[string]any {
+	return map[string]any{
+		"confirm": true, "region": regionUSEast, "type": typeG6Nanode1, "firewall_id": 123,
+		"backup_id": 55, "linode_id": 7,
+	}
+}
+
+func TestLinodeInstanceCreateFromBackupSendsBackupID(t *testing.T) {
+	t.Parallel()
+
+	var (
+		created map[string]any
+		mu      sync.Mutex
+	)
+
+	srv := backupCreateServer(t, `{"id":55,"status":"successful","available":true,"region":"us-east"}`, &created, &mu)
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeInstanceCreateTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, backupCreateArgs()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if created["backup_id"] != float64(55) {
+		t.Errorf("create body backup_id = %v, want 55", created["backup_id"])
+	}
+
+	if _, ok := created["image"]; ok {
+		t.Errorf("create body carries image %v, want none", created["image"])
+	}
+}
+
+func TestLinodeInstanceCreateRejectsUnusableBackup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		backup  string
+		wantErr string
+	}{
+		{name: "not on account", backup: "", wantErr: "backup not found on this account"},
+		{name: "still running", backup: `{"id":55,"status":"running","available":false,"region":"us-east"}`, wantErr: "only completed"},
+		{name: "expired", backup: `{"id":55,"status":"successful","available":false,"region":"us-east"}`, wantErr: "no longer available"},
+		{name: "other region", backup: `{"id":55,"status":"successful","available":true,"region":"eu-west"}`, wantErr: "is in region eu-west"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				created map[string]any
+				mu      sync.Mutex
+			)
+
+			srv := backupCreateServer(t, tt.backup, &created, &mu)
+			cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+			_, _, handler := tools.NewLinodeInstanceCreateTool(cfg)
+
+			result, err := handler(t.Context(), createRequestWithArgs(t, backupCreateArgs()))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !result.IsError {
+				t.Fatal("result.IsError = false, want true")
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatal("ok = false, want true")
+			}
+
+			if !strings.Contains(textContent.Text, tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", textContent.Text, tt.wantErr)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if created != nil {
+				t.Errorf("create body = %v, want no create POST", created)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeInstanceCreateAppliesDefaultKeys(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		labels    []string
+		extraArgs map[string]any
+		wantKeys  []any
+		wantError string
+	}{
+		{
+			name:     "labels resolve to profile keys",
+			labels:   []string{"ops", "ci"},
+			wantKeys: []any{"ssh-ed25519 AAAA ops-1", "ssh-ed25519 AAAA ops-2", "ssh-ed25519 AAAA ci"},
+		},
+		{
+			name:      "authorized_keys overrides the defaults",
+			labels:    []string{"ops"},
+			extraArgs: map[string]any{"authorized_keys": []any{"ssh-ed25519 AAAA mine"}},
+			wantKeys:  []any{"ssh-ed25519 AAAA mine"},
+		},
+		{
+			name:      "a missing label fails the create",
+			labels:    []string{"ops", "gone"},
+			wantError: "instance_defaults.authorized_key_labels names SSH keys not on the profile: gone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				created map[string]any
+				mu      sync.Mutex
+			)
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				var body string
+
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/profile/sshkeys":
+					body = `{"data":[` +
+						`{"id":1,"label":"ops","ssh_key":"ssh-ed25519 AAAA ops-1"},` +
+						`{"id":2,"label":"ci","ssh_key":"ssh-ed25519 AAAA ci"},` +
+						`{"id":3,"label":"ops","ssh_key":"ssh-ed25519 AAAA ops-2"}` +
+						`],"page":1,"pages":1,"results":3}`
+				case r.Method == http.MethodPost && r.URL.Path == "/linode/instances":
+					mu.Lock()
+					if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+						t.Errorf("unexpected error: %v", err)
+					}
+					mu.Unlock()
+
+					body = `{"id":100,"label":"web-1","region":"us-east","type":"g6-nanode-1","status":"provisioning"}`
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+
+				if _, err := w.Write([]byte(body)); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			cfg := &config.Config{
+				Environments:     map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}},
+				InstanceDefaults: config.InstanceDefaultsConfig{AuthorizedKeyLabels: tt.labels},
+			}
+			_, _, handler := tools.NewLinodeInstanceCreateTool(cfg)
+
+			args := map[string]any{
+				"confirm": true, "region": regionUSEast, "type": typeG6Nanode1, "firewall_id": 123,
+				"image": "linode/debian12", "root_pass": "Sup3r-Secret-Pass!",
+			}
+			for key, value := range tt.extraArgs {
+				args[key] = value
+			}
+
+			result, err := handler(t.Context(), createRequestWithArgs(t, args))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("content = %T, want text", result.Content[0])
+			}
+
+			if tt.wantError != "" {
+				if !result.IsError || !strings.Contains(text.Text, tt.wantError) {
+					t.Errorf("result = %q, want an error containing %q", text.Text, tt.wantError)
+				}
+
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("result = %q, want a created instance", text.Text)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			keys, _ := created["authorized_keys"].([]any)
+			if !slices.Equal(keys, tt.wantKeys) {
+				t.Errorf("authorized_keys = %v, want %v", keys, tt.wantKeys)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+const paramInclude = "include"
+
+// Related resources linode_instance_get can embed via include. The order of
+// instanceIncludeOrder is the canonical echo order in the response.
+const (
+	includeVolumes                 = "volumes"
+	includeFirewalls               = "firewalls"
+	includeBackups                 = "backups"
+	includeConfigs                 = "configs"
+	includeNodeBalancerMemberships = "nodebalancer_memberships"
+)
+
+var instanceIncludeOrder = []string{
+	includeVolumes,
+	includeFirewalls,
+	includeBackups,
+	includeConfigs,
+	includeNodeBalancerMemberships,
+}
+
+// parseInstanceIncludes splits the include argument into the requested related
+// resources, deduplicated and in canonical order. Blank entries are skipped. A
+// non-empty string is a validation message naming the first unknown entry.
+func parseInstanceIncludes(raw string) ([]string, string) {
+	requested := map[string]bool{}
+
+	for _, part := range strings.Split(raw, ",") {
+		name := strings.TrimSpace(part)
+		if name == "" {
+			continue
+		}
+
+		if !slices.Contains(instanceIncludeOrder, name) {
+			return nil, fmt.Sprintf("include must be a comma-separated list of: %s (got '%s')",
+				strings.Join(instanceIncludeOrder, ", "), name)
+		}
+
+		requested[name] = true
+	}
+
+	var includes []string
+
+	for _, name := range instanceIncludeOrder {
+		if requested[name] {
+			includes = append(includes, name)
+		}
+	}
+
+	return includes, ""
+}
+
+// handleLinodeInstanceDetail fetches the instance and every requested related
+// resource concurrently and assembles an InstanceGetDetailResponse. Only the
+// instance fetch is fatal; a failed related fetch leaves its section empty and
+// is reported both in the payload's warnings and on the result envelope.
+func handleLinodeInstanceDetail(ctx context.Context, client *linode.Client, instanceID int, includes []string) (*mcp.CallToolResult, error) {
+	detail := &linodev1.InstanceGetDetailResponse{Include: includes}
+	failures := make(map[string]error, len(includes))
+
+	var (
+		wg          sync.WaitGroup
+		mu          sync.Mutex
+		instanceErr error
+	)
+
+	wg.Go(func() {
+		instance, err := client.GetInstanceProto(ctx, instanceID)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		detail.Instance, instanceErr = instance, err
+	})
+
+	for _, name := range includes {
+		wg.Go(func() {
+			err := fetchInstanceInclude(ctx, client, instanceID, name, detail, &mu)
+			if err != nil {
+				mu.Lock()
+				failures[name] = err
+				mu.Unlock()
+			}
+		})
+	}
+
+	wg.Wait()
+
+	if instanceErr != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve Linode instance: %v", instanceErr)), nil
+	}
+
+	for _, name := range includes {
+		if err, failed := failures[name]; failed {
+			warning := fmt.Sprintf("%s: %v", name, err)
+			detail.Warnings = append(detail.Warnings, warning)
+			AddWarning(ctx, "%s", warning)
+		}
+	}
+
+	return MarshalProtoToolResponse(detail)
+}
+
+// fetchInstanceInclude fetches one related resource and stores it on detail
+// under mu. Paginated sub-resources are read with the API's default page.
+func fetchInstanceInclude(
+	ctx context.Context,
+	client *linode.Client,
+	instanceID int,
+	name string,
+	detail *linodev1.InstanceGetDetailResponse,
+	mu *sync.Mutex,
+) error {
+	var store func()
+
+	switch name {
+	case includeVolumes:
+		volumes, err := client.ListInstanceVolumesProto(ctx, instanceID, 0, 0)
+		if err != nil {
+			return err
+		}
+
+		store = func() { detail.Volumes = volumes }
+	case includeFirewalls:
+		firewalls, err := client.ListInstanceFirewallsProto(ctx, instanceID, 0, 0)
+		if err != nil {
+			return err
+		}
+
+		store = func() { detail.Firewalls = firewalls }
+	case includeBackups:
+		backups, err := client.ListInstanceBackupsProto(ctx, instanceID)
+		if err != nil {
+			return err
+		}
+
+		store = func() { detail.Backups = backups }
+	case includeConfigs:
+		configs, err := client.ListInstanceConfigsProto(ctx, instanceID, 0, 0)
+		if err != nil {
+			return err
+		}
+
+		store = func() { detail.Configs = configs }
+	case includeNodeBalancerMemberships:
+		nodeBalancers, err := client.ListInstanceNodeBalancersProto(ctx, instanceID)
+		if err != nil {
+			return err
+		}
+
+		store = func() { detail.NodebalancerMemberships = nodeBalancers }
+	default:
+		return nil
+	}
+
+	mu.Lock()
+	store()
+	mu.Unlock()
+
+	return nil
+}
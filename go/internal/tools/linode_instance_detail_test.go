@@ -0,0 +1,150 @@
+package tools_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// instanceDetailServer answers the instance GET and its sub-resources, failing
+// any path listed in forbidden with a 403 so the failure is not retried.
+func instanceDetailServer(t *testing.T, forbidden ...string) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if slices.Contains(forbidden, r.URL.Path) {
+			w.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+
+		var body string
+
+		switch r.URL.Path {
+		case "/linode/instances/5":
+			body = `{"id":5,"label":"web-1"}`
+		case "/linode/instances/5/backups":
+			body = `{"automatic":[],"snapshot":{}}`
+		default:
+			body = `{"data":[{"id":9,"label":"attached"}],"page":1,"pages":1,"results":1}`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// callInstanceGet dispatches linode_instance_get against apiURL under ctx.
+func callInstanceGet(ctx context.Context, t *testing.T, apiURL string, req mcp.CallToolRequest) *mcp.CallToolResult {
+	t.Helper()
+
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: apiURL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeInstanceGetTool(cfg)
+
+	result, err := handler(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return result
+}
+
+func TestLinodeInstanceGetIncludeEmbedsEveryResource(t *testing.T) {
+	t.Parallel()
+
+	srv := instanceDetailServer(t)
+	result := callInstanceGet(t.Context(), t, srv.URL, createRequestWithArgs(t, map[string]any{
+		"instance_id": "5",
+		"include":     "volumes,firewalls,backups,configs,nodebalancer_memberships",
+	}))
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var detail map[string]any
+	if err := json.Unmarshal([]byte(textContent.Text), &detail); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, section := range []string{"volumes", "firewalls", "configs", "nodebalancer_memberships"} {
+		items, _ := detail[section].([]any)
+		if len(items) != 1 {
+			t.Errorf("len(detail[%q]) = %d, want 1", section, len(items))
+		}
+	}
+
+	if _, ok := detail["backups"].(map[string]any); !ok {
+		t.Errorf("detail[\"backups\"] = %v, want an object", detail["backups"])
+	}
+}
+
+func TestLinodeInstanceGetIncludePartialFailureWarns(t *testing.T) {
+	t.Parallel()
+
+	srv := instanceDetailServer(t, "/linode/instances/5/firewalls")
+	ctx := tools.WithWarnings(t.Context())
+	req := createRequestWithArgs(t, map[string]any{"instance_id": "5", "include": "firewalls,volumes"})
+
+	result := callInstanceGet(ctx, t, srv.URL, req)
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	if !strings.Contains(textContent.Text, `"firewalls: `) {
+		t.Errorf("payload warnings do not name the failed include: %s", textContent.Text)
+	}
+
+	tools.FinalizeEnvelope(ctx, result, &req, time.Now())
+
+	env := tools.EnvelopeFromResult(result)
+	if env == nil || len(env.Warnings) != 1 || !strings.HasPrefix(env.Warnings[0], "firewalls: ") {
+		t.Errorf("envelope warnings = %v, want one firewalls warning", env)
+	}
+}
+
+func TestLinodeInstanceGetIncludeInstanceFailureIsError(t *testing.T) {
+	t.Parallel()
+
+	srv := instanceDetailServer(t, "/linode/instances/5")
+	result := callInstanceGet(t.Context(), t, srv.URL, createRequestWithArgs(t, map[string]any{"instance_id": "5", "include": "volumes"}))
+
+	if !result.IsError {
+		t.Fatal("result.IsError = false, want true")
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	if !strings.HasPrefix(textContent.Text, "Failed to retrieve Linode instance") {
+		t.Errorf("textContent.Text = %q, want the instance failure", textContent.Text)
+	}
+}
@@ -83,7 +83,7 @@ func handleInstanceDiskGetRequest(ctx context.Context, request *mcp.CallToolRequ
 		return mcp.NewToolResultError(ErrDiskIDRequired.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -184,7 +184,7 @@ func handleInstanceDiskCreateRequest(ctx context.Context, request *mcp.CallToolR
 		req.AuthorizedUsers = splitCommaSeparated(usersStr)
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -260,7 +260,7 @@ func handleInstanceDiskUpdateRequest(ctx context.Context, request *mcp.CallToolR
 		req.Label = label
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -368,7 +368,7 @@ func handleInstanceDiskCloneRequest(ctx context.Context, request *mcp.CallToolRe
 		return mcp.NewToolResultError(msg), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -436,7 +436,7 @@ func handleInstanceDiskResizeRequest(ctx context.Context, request *mcp.CallToolR
 		return mcp.NewToolResultError("size is required and must be greater than 0"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -504,7 +504,7 @@ func handleInstanceDiskPasswordResetRequest(ctx context.Context, request *mcp.Ca
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
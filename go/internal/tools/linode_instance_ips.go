@@ -37,7 +37,7 @@ func handleInstanceIPsListRequest(ctx context.Context, request *mcp.CallToolRequ
 		return mcp.NewToolResultError(ErrLinodeIDRequired.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -80,7 +80,7 @@ func handleInstanceIPGetRequest(ctx context.Context, request *mcp.CallToolReques
 		return mcp.NewToolResultError("address must be a valid IP address"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -151,7 +151,7 @@ func handleInstanceIPAllocateRequest(ctx context.Context, request *mcp.CallToolR
 		Public: public,
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -229,7 +229,7 @@ func handleInstanceIPUpdateRDNSRequest(ctx context.Context, request *mcp.CallToo
 		return mcp.NewToolResultError(msg), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -0,0 +1,124 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+const (
+	paramMigrationType = "type"
+	paramMigratePlan   = "plan"
+
+	migrationTypeCold = "cold"
+	migrationTypeWarm = "warm"
+	downtimeTypeNone  = "none"
+
+	instanceStatusOffline   = "offline"
+	instanceStatusMigrating = "migrating"
+)
+
+// migrationNoticeTypes are the account notification types Linode raises for a
+// migration it has queued against an instance.
+var migrationNoticeTypes = map[string]bool{
+	"migration_scheduled": true,
+	"migration_pending":   true,
+	"migration_imminent":  true,
+}
+
+// handleInstanceMigratePlan answers plan=true: it reads the instance, its disks,
+// and the account notifications, and reports what a migration would cost in
+// downtime and transfer without starting one. The notification read only adds
+// queue detail, so a failure there is a warning rather than an error.
+func handleInstanceMigratePlan(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config, linodeID int, migrationType string) (*mcp.CallToolResult, error) {
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	instance, err := client.GetInstance(ctx, linodeID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get instance %d: %v", linodeID, err)), nil
+	}
+
+	disks, err := client.ListInstanceDisks(ctx, linodeID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list disks for instance %d: %v", linodeID, err)), nil
+	}
+
+	var notices []*linodev1.InstanceMigrationNotice
+
+	notifications, err := client.ListAccountNotificationsProto(ctx, 0, 0)
+	if err != nil {
+		AddWarning(ctx, "queued migrations unknown: failed to list account notifications: %v", err)
+	}
+
+	for _, notification := range notifications {
+		entity := notification.GetEntity()
+		if !migrationNoticeTypes[notification.GetType()] || entity.GetType() != "linode" || int(entity.GetId().GetNumberValue()) != linodeID {
+			continue
+		}
+
+		notices = append(notices, &linodev1.InstanceMigrationNotice{
+			Type:    notification.GetType(),
+			Message: notification.GetMessage(),
+			When:    notification.When,
+			Until:   notification.Until,
+		})
+	}
+
+	return MarshalProtoToolResponse(buildInstanceMigratePlan(instance, disks, request.GetString("region", ""), migrationType, notices))
+}
+
+// buildInstanceMigratePlan assembles the plan from what was read. A warm
+// migration of an offline instance has nothing running to keep up, so an
+// offline instance reports no downtime whatever the type.
+func buildInstanceMigratePlan(instance *linode.Instance, disks []linode.InstanceDisk, region, migrationType string, notices []*linodev1.InstanceMigrationNotice) *linodev1.InstanceMigratePlanResponse {
+	if migrationType == "" {
+		migrationType = migrationTypeCold
+	}
+
+	var diskSize int
+	for _, disk := range disks {
+		diskSize += disk.Size
+	}
+
+	plan := &linodev1.InstanceMigratePlanResponse{
+		LinodeId:            linodeIDToInt32(instance.ID),
+		Status:              instance.Status,
+		FromRegion:          instance.Region,
+		MigrationType:       migrationType,
+		DiskCount:           linodeIDToInt32(len(disks)),
+		DiskSizeMb:          linodeIDToInt32(diskSize),
+		MigrationInProgress: instance.Status == instanceStatusMigrating,
+		QueuedMigrations:    notices,
+	}
+
+	switch {
+	case instance.Status == instanceStatusOffline:
+		plan.DowntimeType = downtimeTypeNone
+		plan.Downtime = "The instance is offline, so the migration takes nothing down; it stays offline for the transfer."
+	case migrationType == migrationTypeWarm:
+		plan.DowntimeType = migrationTypeWarm
+		plan.Downtime = "The instance keeps running during the disk transfer and reboots once at the end."
+	default:
+		plan.DowntimeType = migrationTypeCold
+		plan.Downtime = "The instance is shut down for the whole disk transfer and booted again afterwards."
+	}
+
+	destination := "a region Linode picks"
+	if region != "" {
+		plan.ToRegion = &region
+		destination = region
+	}
+
+	plan.Message = fmt.Sprintf("Migration plan for instance %d: %s migration from %s to %s transfers %d MB across %d disks",
+		instance.ID, migrationType, instance.Region, destination, diskSize, len(disks))
+
+	return plan
+}
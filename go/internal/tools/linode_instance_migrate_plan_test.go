@@ -0,0 +1,70 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeInstanceMigratePlanOfflineInstanceWithoutNotifications(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body string
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/linode/instances/5":
+			body = `{"id":5,"label":"web","status":"offline","region":"us-east"}`
+		case r.Method == http.MethodGet && r.URL.Path == "/linode/instances/5/disks":
+			body = `{"data":[{"id":11,"label":"boot","size":25600}],"page":1,"pages":1,"results":1}`
+		case r.Method == http.MethodGet && r.URL.Path == "/account/notifications":
+			w.WriteHeader(http.StatusForbidden)
+
+			body = `{"errors":[{"reason":"Unauthorized"}]}`
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	_, _, handler := tools.NewLinodeInstanceMigrateTool(newTestConfig(srv.URL))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"linode_id": float64(5), "plan": true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true: %+v", result.Content)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got["migration_type"] != "cold" || got["downtime_type"] != "none" || got["disk_size_mb"] != float64(25600) {
+		t.Errorf("plan = %v, want a cold migration with no downtime moving 25600 MB", got)
+	}
+
+	if _, ok := got["to_region"]; ok {
+		t.Errorf("to_region = %v, want it omitted when Linode picks the destination", got["to_region"])
+	}
+
+	if queued, _ := got["queued_migrations"].([]any); len(queued) != 0 {
+		t.Errorf("queued_migrations = %v, want none when notifications cannot be read", queued)
+	}
+}
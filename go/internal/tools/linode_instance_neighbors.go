@@ -0,0 +1,154 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// Plan classes reported in linode_instance_neighbors.
+const (
+	planClassShared    = "shared"
+	planClassDedicated = "dedicated"
+	planClassUnknown   = "unknown"
+)
+
+// sharedPlanMarkers are the type ID fragments of the Shared CPU plans, whose
+// cores other tenants also schedule on.
+var sharedPlanMarkers = []string{"-standard-", "-nanode-"}
+
+// NewLinodeInstanceNeighborsTool creates a tool that lists the account's other
+// instances on the same physical host as an instance.
+func NewLinodeInstanceNeighborsTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_instance_neighbors",
+		"Helps diagnose a noisy-neighbor suspicion: reports the instance's host_uuid, whether its plan is Shared CPU "+
+			"(cores shared with other tenants) or Dedicated CPU, and the other instances on this account that run on "+
+			"the same physical host. The API only reports hosts for the account's own instances, so other customers "+
+			"on the host are never listed.",
+		toolschemas.Schema("linode.mcp.v1.InstanceNeighborsInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeInstanceNeighborsRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLinodeInstanceNeighborsRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	instanceID, validationMessage := requiredIDArgument(request, "instance_id")
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	instance, err := client.GetInstanceProto(ctx, instanceID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve instance %d: %v", instanceID, err)), nil
+	}
+
+	var (
+		warnings []string
+		others   []*linodev1.Instance
+	)
+
+	if instance.GetHostUuid() != "" {
+		others, err = client.ListInstancesProto(ctx)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("Instances unavailable, so no neighbors are listed: %v", err))
+		}
+	}
+
+	for _, warning := range warnings {
+		AddWarning(ctx, "%s", warning)
+	}
+
+	return MarshalProtoToolResponse(instanceNeighbors(instance, others, warnings))
+}
+
+// instanceNeighbors builds the report from the instance and the account's
+// instance list.
+func instanceNeighbors(instance *linodev1.Instance, others []*linodev1.Instance, warnings []string) *linodev1.InstanceNeighborsResponse {
+	response := &linodev1.InstanceNeighborsResponse{
+		InstanceId: instance.GetId(),
+		Label:      instance.GetLabel(),
+		Type:       instance.GetType(),
+		PlanClass:  instancePlanClass(instance.GetType()),
+		HostUuid:   instance.GetHostUuid(),
+		Neighbors:  []*linodev1.InstanceNeighbor{},
+		Guidance:   []string{},
+		Warnings:   warnings,
+	}
+
+	for _, other := range others {
+		if other.GetId() == instance.GetId() || response.GetHostUuid() == "" || other.GetHostUuid() != response.GetHostUuid() {
+			continue
+		}
+
+		response.Neighbors = append(response.Neighbors, &linodev1.InstanceNeighbor{
+			Id:        other.GetId(),
+			Label:     other.GetLabel(),
+			Type:      other.GetType(),
+			PlanClass: instancePlanClass(other.GetType()),
+			Status:    other.GetStatus(),
+		})
+	}
+
+	prefix := fmt.Sprintf("Instance %d (%s)", response.GetInstanceId(), response.GetLabel())
+
+	switch {
+	case response.GetHostUuid() == "":
+		response.Message = prefix + " has no host_uuid in the API response, so its host cannot be compared"
+	default:
+		response.Message = fmt.Sprintf("%s runs on host %s with %d other instance(s) from this account",
+			prefix, response.GetHostUuid(), len(response.GetNeighbors()))
+	}
+
+	switch response.GetPlanClass() {
+	case planClassShared:
+		response.Guidance = append(response.Guidance,
+			"This is a Shared CPU plan: other customers' instances, which the API does not list, use the same cores. "+
+				"Sustained CPU steal in linode_instance_stats_get is the sign of a noisy neighbor; resizing to a "+
+				"Dedicated CPU plan removes it.")
+	case planClassDedicated:
+		response.Guidance = append(response.Guidance,
+			"This plan reserves its CPU cores, so another tenant cannot take CPU time from it; look at disk and "+
+				"network contention in linode_instance_stats_get instead.")
+	}
+
+	if len(response.GetNeighbors()) > 0 {
+		response.Guidance = append(response.Guidance,
+			"The listed instances share this host's hardware; an anti-affinity placement group "+
+				"(linode_placement_group_create) spreads instances that should not fail or contend together across hosts.")
+	}
+
+	return response
+}
+
+// instancePlanClass reports whether a plan type shares its CPU cores with
+// other tenants.
+func instancePlanClass(planType string) string {
+	if planType == "" {
+		return planClassUnknown
+	}
+
+	for _, marker := range sharedPlanMarkers {
+		if strings.Contains(planType+"-", marker) {
+			return planClassShared
+		}
+	}
+
+	return planClassDedicated
+}
@@ -0,0 +1,124 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeInstanceNeighborsPlanClass(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		planType string
+		want     string
+	}{
+		{planType: "g6-nanode-1", want: "shared"},
+		{planType: "g6-standard-2", want: "shared"},
+		{planType: "g6-dedicated-4", want: "dedicated"},
+		{planType: "g7-highmem-1", want: "dedicated"},
+		{planType: "g7-premium-2", want: "dedicated"},
+		{planType: "", want: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.planType, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				if r.URL.Path != "/linode/instances/42" {
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+
+				_, _ = w.Write([]byte(`{"id":42,"label":"edge-1","type":"` + tt.planType + `"}`))
+			}))
+			defer srv.Close()
+
+			cfg := &config.Config{
+				Environments: map[string]config.EnvironmentConfig{
+					envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+				},
+			}
+			_, _, handler := tools.NewLinodeInstanceNeighborsTool(cfg)
+
+			result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"instance_id": float64(42)}))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text, ok := result.Content[0].(mcp.TextContent)
+			if result.IsError || !ok {
+				t.Fatalf("result = %v, want a report", result.Content)
+			}
+
+			var response struct {
+				PlanClass string `json:"plan_class"`
+			}
+			if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+
+			if response.PlanClass != tt.want {
+				t.Errorf("plan_class = %q, want %q", response.PlanClass, tt.want)
+			}
+		})
+	}
+}
+
+// A failed instance list is a warning, not an error: the instance's own
+// host and plan are still reported.
+func TestLinodeInstanceNeighborsListFailure(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/linode/instances" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":[{"reason":"Unauthorized"}]}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":42,"label":"edge-1","type":"g6-standard-1","host_uuid":"3f2a"}`))
+	}))
+	defer srv.Close()
+
+	cfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+	_, _, handler := tools.NewLinodeInstanceNeighborsTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"instance_id": float64(42)}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want a report", result.Content)
+	}
+
+	var response struct {
+		HostUUID  string            `json:"host_uuid"`
+		Neighbors []json.RawMessage `json:"neighbors"`
+		Warnings  []string          `json:"warnings"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if response.HostUUID != "3f2a" || len(response.Neighbors) != 0 || len(response.Warnings) != 1 {
+		t.Errorf("response = %+v, want host 3f2a, no neighbors, and one warning", response)
+	}
+}
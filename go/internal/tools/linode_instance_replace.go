@@ -0,0 +1,637 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// Pacing for the wait_running step. A new instance boots from its image
+// within a minute or two; past instanceReplaceWaitTimeout the step fails and
+// the new instance is kept.
+const (
+	instanceReplaceWaitInterval = 10 * time.Second
+	instanceReplaceWaitTimeout  = 10 * time.Minute
+)
+
+// Plan step actions, in the order a plan runs them: the new instance is
+// created and running before anything moves to it, and the old instance goes
+// last, only with force=true.
+const (
+	instanceReplaceCreate    = "create_instance"
+	instanceReplaceWait      = "wait_running"
+	instanceReplaceFirewall  = "attach_firewall"
+	instanceReplaceDNSRecord = "update_dns_record"
+	instanceReplaceDelete    = "delete_instance"
+)
+
+// Resource types a step acts on.
+const (
+	instanceReplaceTypeInstance     = "instance"
+	instanceReplaceTypeFirewall     = "firewall"
+	instanceReplaceTypeDomainRecord = "domain_record"
+)
+
+// Step statuses in a run report. A step is planned until the run reaches it;
+// skipped is the delete step without force=true.
+const (
+	instanceReplaceStatusPlanned = "planned"
+	instanceReplaceStatusDone    = "done"
+	instanceReplaceStatusFailed  = "failed"
+	instanceReplaceStatusNotRun  = "not_run"
+	instanceReplaceStatusSkipped = "skipped"
+)
+
+// instanceReplaceArgs is the validated linode_instance_replace input.
+type instanceReplaceArgs struct {
+	linodeID       int
+	label          string
+	image          string
+	rootPass       string
+	authorizedKeys []string
+	firewallID     int
+	force          bool
+}
+
+// instanceReplaceStep is one planned step, as the dry-run reports it in
+// current_state and the real run executes it.
+type instanceReplaceStep struct {
+	Order      int    `json:"order"`
+	Action     string `json:"action"`
+	Type       string `json:"type"`
+	ID         int    `json:"id"`
+	Label      string `json:"label"`
+	Reason     string `json:"reason"`
+	DomainID   int    `json:"domain_id,omitempty"`
+	RecordType string `json:"record_type,omitempty"`
+}
+
+// instanceReplacePlan is the old instance's spec as the new one copies it and
+// the ordered steps that replace it. Tags and the backup setting are copied
+// too but left out of the preview.
+type instanceReplacePlan struct {
+	LinodeID   int                   `json:"linode_id"`
+	Label      string                `json:"label"`
+	NewLabel   string                `json:"new_label"`
+	Region     string                `json:"region"`
+	Type       string                `json:"type"`
+	Image      string                `json:"image"`
+	FirewallID int                   `json:"firewall_id"`
+	Steps      []instanceReplaceStep `json:"steps"`
+
+	tags           []string
+	backupsEnabled bool
+}
+
+// NewLinodeInstanceReplaceTool creates a tool that replaces an instance with
+// a fresh one of the same spec, moving its DNS records and firewalls over.
+func NewLinodeInstanceReplaceTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_instance_replace",
+		"Replaces an instance with a fresh one: creates a new instance labeled label with the old one's type, region, "+
+			"tags, backup setting, and image (unless image is passed), behind its first firewall (unless firewall_id is "+
+			"passed), waits until it is running, attaches the old instance's other firewalls, repoints the A and AAAA "+
+			"records that target the old instance's public addresses, and deletes the old instance. The old instance is "+
+			"deleted only with force=true; without it the old instance is kept, still behind its firewalls. Runs one step "+
+			"at a time, stops at the first failure, and reports every step. WARNING: the new instance is billed from "+
+			"creation. Pass dry_run=true to see the plan first.",
+		toolschemas.Schema("linode.mcp.v1.InstanceReplaceInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeInstanceReplaceRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapDestroy, handler
+}
+
+func instanceReplaceArgsFromTool(request *mcp.CallToolRequest) (*instanceReplaceArgs, string) {
+	args := request.GetArguments()
+	parsed := &instanceReplaceArgs{
+		linodeID:   request.GetInt("linode_id", 0),
+		label:      strings.TrimSpace(request.GetString("label", "")),
+		image:      strings.TrimSpace(request.GetString("image", "")),
+		rootPass:   request.GetString("root_pass", ""),
+		firewallID: request.GetInt("firewall_id", 0),
+	}
+	parsed.force, _ = args["force"].(bool)
+
+	if parsed.linodeID <= 0 {
+		return nil, "linode_id is required"
+	}
+
+	if parsed.label == "" {
+		return nil, "label is required"
+	}
+
+	if _, present := args["firewall_id"]; present && parsed.firewallID <= 0 {
+		return nil, "firewall_id must be a positive integer"
+	}
+
+	if err := validateRootPassword(parsed.rootPass); err != nil {
+		return nil, err.Error()
+	}
+
+	if raw, exists := args["authorized_keys"]; exists {
+		keys, validationMessage := stringSliceFromToolArg(raw, "authorized_keys")
+		if validationMessage != "" {
+			return nil, validationMessage
+		}
+
+		parsed.authorizedKeys = keys
+	}
+
+	return parsed, ""
+}
+
+// instancePublicIPv4 reports whether address is a public IPv4 address, the
+// kind an A record points at.
+func instancePublicIPv4(address string) bool {
+	parsed, err := netip.ParseAddr(address)
+
+	return err == nil && parsed.Is4() && !parsed.IsPrivate()
+}
+
+// instanceReplaceAddresses maps the old instance's public IPv4 addresses and
+// its SLAAC IPv6 address to the record type that points at each.
+func instanceReplaceAddresses(instance *linodev1.Instance) map[string]string {
+	addresses := map[string]string{}
+
+	for _, address := range instance.GetIpv4() {
+		if instancePublicIPv4(address) {
+			addresses[address] = "A"
+		}
+	}
+
+	if ipv6, _, _ := strings.Cut(instance.GetIpv6(), "/"); ipv6 != "" {
+		addresses[ipv6] = "AAAA"
+	}
+
+	return addresses
+}
+
+// instanceReplaceTarget is the new instance's address for a record of
+// recordType: its first public IPv4 address for A, its SLAAC IPv6 address for
+// AAAA. A new instance has one public IPv4 address, which is why planning
+// refuses an old instance whose A records point at more than one.
+func instanceReplaceTarget(instance *linodev1.Instance, recordType string) string {
+	if recordType == "AAAA" {
+		ipv6, _, _ := strings.Cut(instance.GetIpv6(), "/")
+
+		return ipv6
+	}
+
+	for _, address := range instance.GetIpv4() {
+		if instancePublicIPv4(address) {
+			return address
+		}
+	}
+
+	return ""
+}
+
+// instanceReplaceDNSSteps plans repointing the A and AAAA records, in every
+// domain, that target a public address of the old instance. A records that
+// point at more than one of its IPv4 addresses are refused: the new instance
+// has only one, so moving them would collapse them onto a single address.
+func instanceReplaceDNSSteps(ctx context.Context, client *linode.Client, old *linodev1.Instance) ([]instanceReplaceStep, error) {
+	addresses := instanceReplaceAddresses(old)
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+
+	domains, err := client.ListDomainsProto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list domains: %w", err)
+	}
+
+	var (
+		steps       []instanceReplaceStep
+		targetsIPv4 []string
+	)
+
+	for _, domain := range domains {
+		domainID := int(domain.GetId())
+
+		records, err := client.ListDomainRecordsProto(ctx, domainID)
+		if err != nil {
+			return nil, fmt.Errorf("list records of domain %d: %w", domainID, err)
+		}
+
+		for _, record := range records {
+			recordType, ok := addresses[record.GetTarget()]
+			if !ok || record.GetType() != recordType {
+				continue
+			}
+
+			label := domain.GetDomain()
+			if record.GetName() != "" {
+				label = record.GetName() + "." + label
+			}
+
+			if recordType == "A" && !slices.Contains(targetsIPv4, record.GetTarget()) {
+				targetsIPv4 = append(targetsIPv4, record.GetTarget())
+			}
+
+			steps = append(steps, instanceReplaceStep{
+				Action:     instanceReplaceDNSRecord,
+				Type:       instanceReplaceTypeDomainRecord,
+				ID:         int(record.GetId()),
+				Label:      label,
+				Reason:     fmt.Sprintf("%s record points at %s on instance %d (%s)", recordType, record.GetTarget(), old.GetId(), old.GetLabel()),
+				DomainID:   domainID,
+				RecordType: recordType,
+			})
+		}
+	}
+
+	if len(targetsIPv4) > 1 {
+		return nil, fmt.Errorf("instance %d (%s): %w: %s",
+			old.GetId(), old.GetLabel(), errInstanceReplaceManyIPv4, strings.Join(targetsIPv4, ", "))
+	}
+
+	return steps, nil
+}
+
+// planInstanceReplace reads the old instance, its firewalls, and the DNS
+// records that point at it, and orders the steps that replace it.
+func planInstanceReplace(ctx context.Context, client *linode.Client, args *instanceReplaceArgs) (*instanceReplacePlan, error) {
+	old, err := client.GetInstanceProto(ctx, args.linodeID)
+	if err != nil {
+		return nil, fmt.Errorf("instance %d: %w", args.linodeID, err)
+	}
+
+	plan := &instanceReplacePlan{
+		LinodeID:       args.linodeID,
+		Label:          old.GetLabel(),
+		NewLabel:       args.label,
+		Region:         old.GetRegion(),
+		Type:           old.GetType(),
+		Image:          args.image,
+		FirewallID:     args.firewallID,
+		Steps:          []instanceReplaceStep{},
+		tags:           old.GetTags(),
+		backupsEnabled: old.GetBackups().GetEnabled(),
+	}
+
+	if plan.Image == "" {
+		plan.Image = old.GetImage()
+	}
+
+	if plan.Image == "" {
+		return nil, fmt.Errorf("instance %d (%s): %w", plan.LinodeID, plan.Label, errInstanceReplaceNoImage)
+	}
+
+	firewalls, err := client.ListInstanceFirewallsProto(ctx, args.linodeID, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("list firewalls of instance %d: %w", args.linodeID, err)
+	}
+
+	if plan.FirewallID == 0 && len(firewalls) > 0 {
+		plan.FirewallID = int(firewalls[0].GetId())
+	}
+
+	if plan.FirewallID == 0 {
+		return nil, fmt.Errorf("instance %d (%s): %w", plan.LinodeID, plan.Label, errInstanceReplaceNoFirewall)
+	}
+
+	plan.Steps = append(plan.Steps,
+		instanceReplaceStep{
+			Action: instanceReplaceCreate, Type: instanceReplaceTypeInstance, Label: plan.NewLabel,
+			Reason: fmt.Sprintf("%s in %s from %s behind firewall %d, copying instance %d (%s)",
+				plan.Type, plan.Region, plan.Image, plan.FirewallID, plan.LinodeID, plan.Label),
+		},
+		instanceReplaceStep{
+			Action: instanceReplaceWait, Type: instanceReplaceTypeInstance, Label: plan.NewLabel,
+			Reason: "DNS records and firewalls move only to a running instance",
+		})
+
+	for _, firewall := range firewalls {
+		if int(firewall.GetId()) == plan.FirewallID {
+			continue
+		}
+
+		plan.Steps = append(plan.Steps, instanceReplaceStep{
+			Action: instanceReplaceFirewall, Type: instanceReplaceTypeFirewall, ID: int(firewall.GetId()), Label: firewall.GetLabel(),
+			Reason: fmt.Sprintf("protects instance %d (%s)", plan.LinodeID, plan.Label),
+		})
+	}
+
+	dnsSteps, err := instanceReplaceDNSSteps(ctx, client, old)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.Steps = append(plan.Steps, dnsSteps...)
+	plan.Steps = append(plan.Steps, instanceReplaceStep{
+		Action: instanceReplaceDelete, Type: instanceReplaceTypeInstance, ID: plan.LinodeID, Label: plan.Label,
+		Reason: "replaced by " + plan.NewLabel,
+	})
+
+	for i := range plan.Steps {
+		plan.Steps[i].Order = i + 1
+	}
+
+	return plan, nil
+}
+
+func instanceReplaceStepSummary(action, resourceType string, id int, label string) string {
+	if id == 0 {
+		return fmt.Sprintf("%s %s (%s)", action, resourceType, label)
+	}
+
+	return fmt.Sprintf("%s %s %d (%s)", action, resourceType, id, label)
+}
+
+// instanceReplaceSideEffects is the Tier B walk for linode_instance_replace:
+// one line per step in run order, then a summary line.
+func instanceReplaceSideEffects(state any, args *instanceReplaceArgs) DryRunDetails {
+	plan, _ := state.(*instanceReplacePlan)
+	if plan == nil {
+		return DryRunDetails{}
+	}
+
+	details := DryRunDetails{SideEffects: make([]string, 0, len(plan.Steps)+1)}
+
+	for _, step := range plan.Steps {
+		line := fmt.Sprintf("%d. %s: %s.", step.Order, instanceReplaceStepSummary(step.Action, step.Type, step.ID, step.Label), step.Reason)
+		if step.Action == instanceReplaceDelete && !args.force {
+			line += " Skipped without force=true."
+		}
+
+		details.SideEffects = append(details.SideEffects, line)
+	}
+
+	details.SideEffects = append(details.SideEffects, fmt.Sprintf("Instance %d (%s) would be replaced by %s in %d steps.",
+		plan.LinodeID, plan.Label, plan.NewLabel, len(plan.Steps)))
+
+	details.Warnings = []string{"The new instance is billed from creation; both instances are billed until the old one is deleted."}
+	if !args.force {
+		details.Warnings = append(details.Warnings,
+			"Without force=true the old instance is kept; delete it with linode_instance_delete once the new one checks out.")
+	}
+
+	return details
+}
+
+// awaitInstanceRunning re-reads the new instance until it is running or
+// instanceReplaceWaitTimeout passes. The first re-read is immediate and later
+// ones instanceReplaceWaitInterval apart. It returns the last state read and
+// whether the instance is running; a failed re-read ends the wait with its
+// error.
+func awaitInstanceRunning(ctx context.Context, client *linode.Client, instance *linodev1.Instance) (*linodev1.Instance, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, instanceReplaceWaitTimeout)
+	defer cancel()
+
+	for first := true; ; first = false {
+		if !first {
+			select {
+			case <-ctx.Done():
+				return instance, false, nil
+			case <-time.After(instanceReplaceWaitInterval):
+			}
+		}
+
+		latest, err := client.GetInstanceProto(ctx, int(instance.GetId()))
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return instance, false, nil
+			}
+
+			return instance, false, fmt.Errorf("failed to re-read instance %d: %w", instance.GetId(), err)
+		}
+
+		instance = latest
+
+		if instance.GetStatus() == statusRunning {
+			return instance, true, nil
+		}
+	}
+}
+
+// runInstanceReplaceStep makes the API calls for one step. The create and
+// wait steps record the new instance on response; later steps act on it.
+func runInstanceReplaceStep(
+	ctx context.Context, client *linode.Client, plan *instanceReplacePlan, args *instanceReplaceArgs,
+	response *linodev1.InstanceReplaceResponse, entry *linodev1.InstanceReplaceStep,
+) error {
+	switch entry.GetAction() {
+	case instanceReplaceCreate:
+		helpers := networkHelpers{PublicInternet: true}
+
+		instance, err := client.CreateInstanceProto(ctx, &linode.CreateInstanceRequest{
+			Region:              plan.Region,
+			Type:                plan.Type,
+			Label:               plan.NewLabel,
+			Image:               plan.Image,
+			RootPass:            args.rootPass,
+			AuthorizedKeys:      args.authorizedKeys,
+			BackupsEnabled:      plan.backupsEnabled,
+			Tags:                plan.tags,
+			InterfaceGeneration: linode.CurrentInterfaceGeneration,
+			Interfaces:          helpers.instanceInterfaces(0, plan.FirewallID, true, true),
+		})
+		if err != nil {
+			return err
+		}
+
+		response.Instance = instance
+		entry.Id = instance.GetId()
+
+		return nil
+	case instanceReplaceWait:
+		entry.Id = response.GetInstance().GetId()
+
+		instance, running, err := awaitInstanceRunning(ctx, client, response.GetInstance())
+		response.Instance = instance
+
+		switch {
+		case err != nil:
+			return err
+		case !running:
+			return fmt.Errorf("%w after %d minutes; last status %s",
+				errInstanceReplaceNotRunning, int(instanceReplaceWaitTimeout.Minutes()), instance.GetStatus())
+		}
+
+		return nil
+	case instanceReplaceFirewall:
+		_, err := client.CreateFirewallDeviceProto(ctx, int(entry.GetId()), &linode.CreateFirewallDeviceRequest{
+			ID: int(response.GetInstance().GetId()), Type: firewallDefaultLinodeKey,
+		})
+
+		return err
+	case instanceReplaceDNSRecord:
+		target := instanceReplaceTarget(response.GetInstance(), entry.GetRecordType())
+		if target == "" {
+			return errInstanceReplaceNoAddress
+		}
+
+		if _, err := client.UpdateDomainRecordProto(ctx, int(entry.GetDomainId()), int(entry.GetId()),
+			&linode.UpdateDomainRecordRequest{Target: target}); err != nil {
+			return err
+		}
+
+		entry.Target = new(target)
+
+		return nil
+	case instanceReplaceDelete:
+		return client.DeleteInstance(ctx, plan.LinodeID)
+	default:
+		return fmt.Errorf("%w: %s", errInstanceReplaceUnknownStep, entry.GetAction())
+	}
+}
+
+// runInstanceReplace executes the plan one step at a time. The first failure
+// stops the run, since every later step depends on the new instance being up,
+// so the rest are reported as not_run and the failure becomes an envelope
+// warning. Without force the delete step is skipped.
+func runInstanceReplace(ctx context.Context, client *linode.Client, plan *instanceReplacePlan, args *instanceReplaceArgs) *linodev1.InstanceReplaceResponse {
+	response := &linodev1.InstanceReplaceResponse{
+		LinodeId: linodeIDToInt32(plan.LinodeID),
+		Steps:    make([]*linodev1.InstanceReplaceStep, 0, len(plan.Steps)),
+	}
+
+	var failedStep *linodev1.InstanceReplaceStep
+
+	for _, step := range plan.Steps {
+		entry := &linodev1.InstanceReplaceStep{
+			Order:  linodeIDToInt32(step.Order),
+			Action: step.Action,
+			Type:   step.Type,
+			Id:     linodeIDToInt32(step.ID),
+			Label:  step.Label,
+			Reason: step.Reason,
+			Status: instanceReplaceStatusPlanned,
+		}
+		if step.DomainID != 0 {
+			entry.DomainId = new(linodeIDToInt32(step.DomainID))
+		}
+
+		if step.RecordType != "" {
+			entry.RecordType = new(step.RecordType)
+		}
+
+		response.Steps = append(response.Steps, entry)
+
+		switch {
+		case failedStep != nil:
+			entry.Status = instanceReplaceStatusNotRun
+			response.NotRun++
+
+			continue
+		case step.Action == instanceReplaceDelete && !args.force:
+			entry.Status = instanceReplaceStatusSkipped
+			response.Skipped++
+
+			continue
+		}
+
+		if err := runInstanceReplaceStep(ctx, client, plan, args, response, entry); err != nil {
+			entry.Status = instanceReplaceStatusFailed
+			entry.Error = new(err.Error())
+			response.Failed++
+			failedStep = entry
+
+			AddWarning(ctx, "step %d (%s): %v", step.Order,
+				instanceReplaceStepSummary(entry.GetAction(), entry.GetType(), int(entry.GetId()), entry.GetLabel()), err)
+
+			continue
+		}
+
+		entry.Status = instanceReplaceStatusDone
+		response.Completed++
+	}
+
+	response.Message = instanceReplaceMessage(plan, response, failedStep)
+
+	return response
+}
+
+func instanceReplaceMessage(plan *instanceReplacePlan, response *linodev1.InstanceReplaceResponse, failedStep *linodev1.InstanceReplaceStep) string {
+	created := response.GetInstance()
+
+	switch {
+	case failedStep != nil && created == nil:
+		return fmt.Sprintf("Instance replace failed at step %d of %d (%s); nothing was changed",
+			failedStep.GetOrder(), len(plan.Steps), failedStep.GetAction())
+	case failedStep != nil:
+		return fmt.Sprintf("Instance replace stopped at step %d of %d (%s): %d steps done, %d not run; new instance %d (%s) is kept",
+			failedStep.GetOrder(), len(plan.Steps), failedStep.GetAction(), response.GetCompleted(), response.GetNotRun(),
+			created.GetId(), created.GetLabel())
+	case response.GetSkipped() > 0:
+		return fmt.Sprintf("Moved instance %d (%s) to new instance %d (%s) in %d steps; the old instance is kept without force=true, "+
+			"delete it with linode_instance_delete once the new one checks out",
+			plan.LinodeID, plan.Label, created.GetId(), created.GetLabel(), response.GetCompleted())
+	default:
+		return fmt.Sprintf("Replaced instance %d (%s) with %d (%s) in %d steps",
+			plan.LinodeID, plan.Label, created.GetId(), created.GetLabel(), response.GetCompleted())
+	}
+}
+
+// instanceReplaceDeleteAction is the old instance's delete as the
+// confirm_label check sees it. With force=true the run deletes the old
+// instance, so it gets the same label echo linode_instance_delete asks for,
+// checked before anything is created.
+func instanceReplaceDeleteAction(linodeID int) *DestructiveAction {
+	return &DestructiveAction{
+		ToolName: "linode_instance_replace",
+		Method:   httpMethodDelete,
+		Path:     fmt.Sprintf("/linode/instances/%d", linodeID),
+		FetchState: func(ctx context.Context, c *linode.Client) (any, error) {
+			return c.GetInstance(ctx, linodeID)
+		},
+		ConfirmLabel: instanceConfirmLabel,
+	}
+}
+
+func handleLinodeInstanceReplaceRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	args, validationMessage := instanceReplaceArgsFromTool(request)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	if IsDryRun(request) {
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_instance_replace", httpMethodPost, "/linode/instances",
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				return planInstanceReplace(ctx, c, args)
+			},
+			func(_ context.Context, _ *linode.Client, state any) (DryRunDetails, error) {
+				return instanceReplaceSideEffects(state, args), nil
+			})
+	}
+
+	if result := requireDestroyConfirmation(ctx, request, "linode_instance_replace",
+		"This creates a replacement instance and moves DNS records and firewalls to it; with force=true it also deletes the old instance. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	if args.force {
+		if result := requireConfirmLabel(ctx, request, cfg, instanceReplaceDeleteAction(args.linodeID), nil); result != nil {
+			return result, nil
+		}
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	plan, err := planInstanceReplace(ctx, client, args)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to plan instance replace: %v", err)), nil
+	}
+
+	return MarshalProtoToolResponse(runInstanceReplace(ctx, client, plan, args))
+}
@@ -0,0 +1,263 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// instanceReplaceServer serves instance 7 behind firewalls 5 and 6, a domain
+// whose www and apex records point at its public addresses (and whose mail
+// and internal records do not), and new instance 8, which is running by its
+// first re-read. Every non-GET request is recorded with its body.
+func instanceReplaceServer(t *testing.T, writes *[]string, mu *sync.Mutex) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method != http.MethodGet {
+			payload, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Errorf("read body: %v", err)
+			}
+
+			mu.Lock()
+			*writes = append(*writes, strings.TrimSpace(r.Method+" "+r.URL.Path+" "+string(payload)))
+			mu.Unlock()
+		}
+
+		var body string
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/linode/instances/7":
+			body = `{"id":7,"label":"web-1","status":"running","type":"g6-standard-1","region":"us-east","image":"linode/debian12",` +
+				`"ipv4":["45.33.1.10","192.168.130.5"],"ipv6":"2600:3c00::1/128","tags":["web"]}`
+		case r.Method == http.MethodGet && r.URL.Path == "/linode/instances/7/firewalls":
+			body = `{"data":[{"id":5,"label":"web-fw"},{"id":6,"label":"ops-fw"}],"page":1,"pages":1,"results":2}`
+		case r.Method == http.MethodGet && r.URL.Path == "/domains":
+			body = `{"data":[{"id":3,"domain":"example.com","type":"master"}],"page":1,"pages":1,"results":1}`
+		case r.Method == http.MethodGet && r.URL.Path == "/domains/3/records":
+			body = `{"data":[` +
+				`{"id":11,"type":"A","name":"www","target":"45.33.1.10"},` +
+				`{"id":12,"type":"AAAA","name":"","target":"2600:3c00::1"},` +
+				`{"id":13,"type":"A","name":"mail","target":"45.33.1.20"},` +
+				`{"id":14,"type":"A","name":"internal","target":"192.168.130.5"}` +
+				`],"page":1,"pages":1,"results":4}`
+		case r.Method == http.MethodPost && r.URL.Path == "/linode/instances":
+			body = `{"id":8,"label":"web-2","status":"provisioning","ipv4":[],"ipv6":""}`
+		case r.Method == http.MethodGet && r.URL.Path == "/linode/instances/8":
+			body = `{"id":8,"label":"web-2","status":"running","ipv4":["45.33.2.20","192.168.130.9"],"ipv6":"2600:3c00::2/128"}`
+		default:
+			body = `{}`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func instanceReplaceArgs(force bool) map[string]any {
+	return map[string]any{
+		"linode_id":              float64(7),
+		"label":                  "web-2",
+		"force":                  force,
+		"confirm":                true,
+		"confirm_bypass_dry_run": true,
+	}
+}
+
+type instanceReplaceReport struct {
+	Message   string `json:"message"`
+	Completed int    `json:"completed"`
+	Skipped   int    `json:"skipped"`
+	Steps     []struct {
+		Action string `json:"action"`
+		Status string `json:"status"`
+		Target string `json:"target"`
+	} `json:"steps"`
+}
+
+func callInstanceReplace(t *testing.T, force bool) (instanceReplaceReport, []string) {
+	t.Helper()
+
+	var (
+		writes []string
+		mu     sync.Mutex
+	)
+
+	srv := instanceReplaceServer(t, &writes, &mu)
+	_, _, handler := tools.NewLinodeInstanceReplaceTool(newTestConfig(srv.URL))
+
+	result, err := handler(tools.WithWarnings(t.Context()), createRequestWithArgs(t, instanceReplaceArgs(force)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want a report", result.Content)
+	}
+
+	var report instanceReplaceReport
+	if err := json.Unmarshal([]byte(textContent.Text), &report); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	return report, slices.Clone(writes)
+}
+
+// The new instance copies the old one's spec behind its first firewall, gets
+// the second firewall attached, and takes over the records that pointed at
+// the old public addresses; the private record is left alone.
+func TestLinodeInstanceReplaceMovesDNSAndFirewallsThenDeletes(t *testing.T) {
+	t.Parallel()
+
+	report, writes := callInstanceReplace(t, true)
+
+	var steps []string
+	for _, step := range report.Steps {
+		steps = append(steps, step.Action+":"+step.Status+":"+step.Target)
+	}
+
+	wantSteps := []string{
+		"create_instance:done:", "wait_running:done:", "attach_firewall:done:",
+		"update_dns_record:done:45.33.2.20", "update_dns_record:done:2600:3c00::2", "delete_instance:done:",
+	}
+	if !slices.Equal(steps, wantSteps) {
+		t.Errorf("steps = %v, want %v", steps, wantSteps)
+	}
+
+	if report.Message != "Replaced instance 7 (web-1) with 8 (web-2) in 6 steps" {
+		t.Errorf("message = %q", report.Message)
+	}
+
+	if len(writes) != 5 {
+		t.Fatalf("writes = %v, want 5", writes)
+	}
+
+	create := writes[0]
+	for _, want := range []string{`POST /linode/instances {`, `"region":"us-east"`, `"type":"g6-standard-1"`, `"label":"web-2"`,
+		`"image":"linode/debian12"`, `"tags":["web"]`, `"firewall_id":5`} {
+		if !strings.Contains(create, want) {
+			t.Errorf("create = %s, want it to contain %s", create, want)
+		}
+	}
+
+	wantWrites := []string{
+		`POST /networking/firewalls/6/devices {"id":8,"type":"linode"}`,
+		`PUT /domains/3/records/11 {"target":"45.33.2.20"}`,
+		`PUT /domains/3/records/12 {"target":"2600:3c00::2"}`,
+		`DELETE /linode/instances/7`,
+	}
+	if !slices.Equal(writes[1:], wantWrites) {
+		t.Errorf("writes = %v, want %v", writes[1:], wantWrites)
+	}
+}
+
+func TestLinodeInstanceReplaceKeepsOldInstanceWithoutForce(t *testing.T) {
+	t.Parallel()
+
+	report, writes := callInstanceReplace(t, false)
+
+	if report.Completed != 5 || report.Skipped != 1 {
+		t.Errorf("completed/skipped = %d/%d, want 5/1", report.Completed, report.Skipped)
+	}
+
+	if last := report.Steps[len(report.Steps)-1]; last.Action != "delete_instance" || last.Status != "skipped" {
+		t.Errorf("last step = %+v, want a skipped delete_instance", last)
+	}
+
+	if !strings.Contains(report.Message, "the old instance is kept without force=true") {
+		t.Errorf("message = %q, want it to say the old instance is kept", report.Message)
+	}
+
+	if slices.ContainsFunc(writes, func(write string) bool { return strings.HasPrefix(write, "DELETE ") }) {
+		t.Errorf("writes = %v, want no delete", writes)
+	}
+}
+
+// With force=true the run deletes the old instance, so the confirm_label
+// check applies before anything is created; without force it does not.
+func TestLinodeInstanceReplaceForceRequiresConfirmLabel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		force     bool
+		label     string
+		wantError string
+	}{
+		{name: "missing", force: true, wantError: "linode_instance_replace requires confirm_label"},
+		{name: "mismatch", force: true, label: "web-9", wantError: `confirm_label "web-9" does not match the label of /linode/instances/7`},
+		{name: "match", force: true, label: "web-1"},
+		{name: "no force", force: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				writes []string
+				mu     sync.Mutex
+			)
+
+			srv := instanceReplaceServer(t, &writes, &mu)
+			cfg := newTestConfig(srv.URL)
+			cfg.ConfirmLabel.Enabled = true
+
+			args := instanceReplaceArgs(tt.force)
+			if tt.label != "" {
+				args["confirm_label"] = tt.label
+			}
+
+			_, _, handler := tools.NewLinodeInstanceReplaceTool(cfg)
+
+			result, err := handler(tools.WithWarnings(t.Context()), createRequestWithArgs(t, args))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("content = %v, want text", result.Content)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if tt.wantError == "" {
+				if result.IsError {
+					t.Fatalf("result = %s, want a report", textContent.Text)
+				}
+
+				return
+			}
+
+			if !result.IsError || !strings.Contains(textContent.Text, tt.wantError) {
+				t.Errorf("result = %s, want an error containing %q", textContent.Text, tt.wantError)
+			}
+
+			if len(writes) != 0 {
+				t.Errorf("writes = %v, want none", writes)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// sshHostKeyVerifyCommand prints the fingerprint of every host key on the
+// instance; it is run in the Lish console.
+const sshHostKeyVerifyCommand = `for key in /etc/ssh/ssh_host_*_key.pub; do ssh-keygen -lf "$key"; done`
+
+// NewLinodeInstanceSSHFingerprintsTool creates a tool that prepares the first
+// SSH connection to an instance: its public addresses, the commands that
+// record its host keys in known_hosts, and how to verify them through Lish.
+func NewLinodeInstanceSSHFingerprintsTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_instance_ssh_fingerprints",
+		"Prepares the first SSH connection to a new instance. The Linode API does not expose SSH host keys, so "+
+			"this returns the instance's public addresses, a ready-to-run block that clears stale known_hosts "+
+			"entries and records the host keys with ssh-keyscan, and the command to run in the Lish console "+
+			"(with the Lish SSH command for the current profile) that prints the real fingerprints to compare "+
+			"against.",
+		toolschemas.Schema("linode.mcp.v1.InstanceSshFingerprintsInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeInstanceSSHFingerprintsRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLinodeInstanceSSHFingerprintsRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	instanceID, validationMessage := requiredIDArgument(request, "instance_id")
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	instance, err := client.GetInstanceProto(ctx, instanceID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve instance %d: %v", instanceID, err)), nil
+	}
+
+	var warnings []string
+
+	username, warning := lishUsername(ctx, client)
+	if warning != "" {
+		warnings = append(warnings, warning)
+	}
+
+	return MarshalProtoToolResponse(instanceSSHFingerprints(instance, username, warnings))
+}
+
+// instanceSSHFingerprints assembles the known_hosts and verification commands
+// for the instance's public addresses.
+func instanceSSHFingerprints(instance *linodev1.Instance, username string, warnings []string) *linodev1.InstanceSshFingerprintsResponse {
+	var addresses []string
+
+	ipv4, ipv6 := instancePublicAddresses(instance)
+	for _, address := range []string{ipv4, ipv6} {
+		if address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+
+	response := &linodev1.InstanceSshFingerprintsResponse{
+		InstanceId:         instance.GetId(),
+		Label:              instance.GetLabel(),
+		Status:             instance.GetStatus(),
+		Addresses:          []string{},
+		KnownHostsCommands: []string{},
+		VerifyCommands:     []string{sshHostKeyVerifyCommand},
+		Lish:               instanceLishAccess(instance, username),
+		Guidance: []string{
+			"The Linode API does not expose SSH host keys. Run the verify command in the Lish console and check " +
+				"that the fingerprints printed by the known_hosts commands match before trusting the scanned keys.",
+		},
+		Warnings: warnings,
+	}
+
+	if len(addresses) == 0 {
+		response.Warnings = append(response.Warnings, "The instance has no public address to scan; connect through Lish instead.")
+		response.Message = fmt.Sprintf("Instance %d (%s) has no public address; the API does not expose SSH host keys, so Lish verification details are listed",
+			instance.GetId(), instance.GetLabel())
+
+		return response
+	}
+
+	response.Addresses = addresses
+
+	for _, address := range addresses {
+		response.KnownHostsCommands = append(response.KnownHostsCommands, "ssh-keygen -R "+address)
+	}
+
+	response.KnownHostsCommands = append(response.KnownHostsCommands,
+		fmt.Sprintf("ssh-keyscan -t ed25519,ecdsa,rsa %s >> ~/.ssh/known_hosts", strings.Join(addresses, " ")))
+
+	for _, address := range addresses {
+		response.KnownHostsCommands = append(response.KnownHostsCommands, "ssh-keygen -l -F "+address)
+	}
+
+	response.Guidance = append(response.Guidance,
+		"Linode reuses addresses, so ssh-keygen -R first removes any host key a previous instance left in known_hosts.",
+		fmt.Sprintf("Once the fingerprints match, connect with ssh root@%s.", addresses[0]))
+
+	if instance.GetStatus() != "running" {
+		response.Guidance = append(response.Guidance, fmt.Sprintf(
+			"The instance is %s; ssh-keyscan gets no answer until it is running and sshd has started.", instance.GetStatus()))
+	}
+
+	response.Message = fmt.Sprintf("Instance %d (%s) has %d public addresses; the API does not expose SSH host keys, so ssh-keyscan and Lish verification commands are listed",
+		instance.GetId(), instance.GetLabel(), len(addresses))
+
+	return response
+}
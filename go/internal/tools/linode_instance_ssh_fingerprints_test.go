@@ -0,0 +1,93 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeInstanceSSHFingerprintsScansPublicAddresses(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body string
+
+		switch r.URL.Path {
+		case "/linode/instances/42":
+			body = `{"id":42,"label":"edge-1","region":"us-east","status":"provisioning",` +
+				`"ipv4":["192.168.130.7","45.33.1.10"],"ipv6":"2600:3c00::f03c:91ff:fe24:3a2f/128"}`
+		case "/profile":
+			body = `{"username":"alice"}`
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeInstanceSSHFingerprintsTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"instance_id": 42}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var fingerprints struct {
+		Addresses          []string `json:"addresses"`
+		KnownHostsCommands []string `json:"known_hosts_commands"`
+		Lish               struct {
+			SSHCommand string `json:"ssh_command"`
+		} `json:"lish"`
+		Guidance []string `json:"guidance"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &fingerprints); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantAddresses := []string{"45.33.1.10", "2600:3c00::f03c:91ff:fe24:3a2f"}
+	if !slices.Equal(fingerprints.Addresses, wantAddresses) {
+		t.Errorf("addresses = %q, want %q (the private IPv4 left out)", fingerprints.Addresses, wantAddresses)
+	}
+
+	wantCommands := []string{
+		"ssh-keygen -R 45.33.1.10",
+		"ssh-keygen -R 2600:3c00::f03c:91ff:fe24:3a2f",
+		"ssh-keyscan -t ed25519,ecdsa,rsa 45.33.1.10 2600:3c00::f03c:91ff:fe24:3a2f >> ~/.ssh/known_hosts",
+		"ssh-keygen -l -F 45.33.1.10",
+		"ssh-keygen -l -F 2600:3c00::f03c:91ff:fe24:3a2f",
+	}
+	if !slices.Equal(fingerprints.KnownHostsCommands, wantCommands) {
+		t.Errorf("known_hosts_commands = %q, want %q", fingerprints.KnownHostsCommands, wantCommands)
+	}
+
+	if fingerprints.Lish.SSHCommand != "ssh -t alice@lish-newark.linode.com edge-1" {
+		t.Errorf("lish.ssh_command = %q, want the profile user on the Newark gateway", fingerprints.Lish.SSHCommand)
+	}
+
+	if last := fingerprints.Guidance[len(fingerprints.Guidance)-1]; last != "The instance is provisioning; ssh-keyscan gets no answer until it is running and sshd has started." {
+		t.Errorf("last guidance = %q, want the not-running note", last)
+	}
+}
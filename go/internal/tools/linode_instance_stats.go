@@ -32,7 +32,7 @@ func handleInstanceStatsGetRequest(ctx context.Context, request *mcp.CallToolReq
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
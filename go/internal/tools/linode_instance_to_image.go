@@ -0,0 +1,301 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// Pacing for the capture wait. Linode captures a disk in a few minutes per
+// few gigabytes; past imageCaptureWaitTimeout the call returns what it last
+// saw with ready=false and the capture carries on.
+const (
+	imageCaptureWaitInterval = 10 * time.Second
+	imageCaptureWaitTimeout  = 30 * time.Minute
+	imageStatusAvailable     = "available"
+	instanceDiskSwap         = "swap"
+)
+
+// instanceToImageArgs is the validated linode_instance_to_image input.
+type instanceToImageArgs struct {
+	linodeID int
+	diskID   int
+	regions  []string
+	tags     []string
+	wait     bool
+}
+
+// NewLinodeInstanceToImageTool creates a tool that captures an instance's
+// disk as a private image, waits for it, and optionally replicates it.
+func NewLinodeInstanceToImageTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_instance_to_image",
+		"Captures a Linode instance as a private image in one call: picks the instance's largest disk that is not swap "+
+			"(or disk_id), creates the image, and unless wait=false waits for it to become available, recording each "+
+			"status change with the seconds elapsed in progress. With regions, replicates the available image to those "+
+			"regions. Requires confirm=true; pass dry_run=true to see which disk would be captured.",
+		toolschemas.Schema("linode.mcp.v1.InstanceToImageInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeInstanceToImageRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// instanceToImageArgsFromTool validates the tool args, returning a
+// validation message on the first problem.
+func instanceToImageArgsFromTool(request *mcp.CallToolRequest) (*instanceToImageArgs, string) {
+	linodeID, msg := requiredIDArgument(request, paramLinodeID)
+	if msg != "" {
+		return nil, msg
+	}
+
+	args := &instanceToImageArgs{linodeID: linodeID, wait: request.GetBool("wait", true)}
+
+	if _, exists := request.GetArguments()["disk_id"]; exists {
+		if args.diskID, msg = requiredIDArgument(request, "disk_id"); msg != "" {
+			return nil, msg
+		}
+	}
+
+	if _, exists := request.GetArguments()["regions"]; exists {
+		if args.regions, msg = requiredImageReplicationRegionsFromTool(request); msg != "" {
+			return nil, msg
+		}
+
+		if !args.wait {
+			return nil, "regions needs wait=true: an image replicates only once it is available"
+		}
+	}
+
+	if args.tags, _, msg = optionalTagsField(request.GetArguments()); msg != "" {
+		return nil, msg
+	}
+
+	return args, ""
+}
+
+func handleLinodeInstanceToImageRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	args, validationMessage := instanceToImageArgsFromTool(request)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	if IsDryRun(request) {
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_instance_to_image", httpMethodPost, "/images",
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				return c.ListInstanceDisksProto(ctx, args.linodeID)
+			},
+			func(_ context.Context, _ *linode.Client, state any) (DryRunDetails, error) {
+				return instanceToImageSideEffects(args, state)
+			})
+	}
+
+	if result := RequireConfirm(request, "This captures an image from the instance's disk. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	disks, err := client.ListInstanceDisksProto(ctx, args.linodeID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list disks for instance %d: %v", args.linodeID, err)), nil
+	}
+
+	disk, msg := instanceToImageDisk(disks, args)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	start := time.Now()
+
+	image, err := client.CreateImageProto(ctx, &linode.CreateImageRequest{
+		DiskID:      int(disk.GetId()),
+		Label:       request.GetString("label", ""),
+		Description: request.GetString("description", ""),
+		CloudInit:   request.GetBool("cloud_init", false),
+		Tags:        args.tags,
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create image: %v", err)), nil
+	}
+
+	response := &linodev1.InstanceToImageResponse{
+		Message: fmt.Sprintf("Image '%s' (%s) is being captured from disk %d (%s) of instance %d",
+			image.GetLabel(), image.GetId(), disk.GetId(), disk.GetLabel(), args.linodeID),
+		LinodeId:  linodeIDToInt32(args.linodeID),
+		DiskId:    disk.GetId(),
+		DiskLabel: disk.GetLabel(),
+		Image:     image,
+		Progress: []*linodev1.InstanceToImageProgress{{
+			Status: image.GetStatus(),
+			Detail: fmt.Sprintf("image %s created from disk %d (%s)", image.GetId(), disk.GetId(), disk.GetLabel()),
+		}},
+	}
+
+	if args.wait {
+		instanceToImageWait(ctx, client, response, start, args.regions)
+	}
+
+	for _, warning := range response.GetWarnings() {
+		AddWarning(ctx, "%s", warning)
+	}
+
+	return MarshalProtoToolResponse(response)
+}
+
+// instanceToImageDisk picks the disk to capture: disk_id when given, which
+// must belong to the instance, or else the largest disk that is not swap.
+func instanceToImageDisk(disks []*linodev1.InstanceDisk, args *instanceToImageArgs) (*linodev1.InstanceDisk, string) {
+	var picked *linodev1.InstanceDisk
+
+	for _, disk := range disks {
+		if args.diskID != 0 {
+			if int(disk.GetId()) == args.diskID {
+				return disk, ""
+			}
+
+			continue
+		}
+
+		if disk.GetFilesystem() != instanceDiskSwap && (picked == nil || disk.GetSize() > picked.GetSize()) {
+			picked = disk
+		}
+	}
+
+	if args.diskID != 0 {
+		return nil, fmt.Sprintf("disk %d is not a disk of instance %d", args.diskID, args.linodeID)
+	}
+
+	if picked == nil {
+		return nil, fmt.Sprintf("instance %d has no disk to capture", args.linodeID)
+	}
+
+	return picked, ""
+}
+
+// instanceToImageSideEffects is the dry-run preview: the disk that would be
+// captured, read from the instance's disks, and any replication.
+func instanceToImageSideEffects(args *instanceToImageArgs, state any) (DryRunDetails, error) {
+	var details DryRunDetails
+
+	disks, _ := state.([]*linodev1.InstanceDisk)
+
+	disk, msg := instanceToImageDisk(disks, args)
+	if msg != "" {
+		details.Warnings = append(details.Warnings, msg+".")
+
+		return details, nil
+	}
+
+	details.SideEffects = append(details.SideEffects,
+		fmt.Sprintf("A new image will be captured from disk %d (%s) of instance %d.", disk.GetId(), disk.GetLabel(), args.linodeID))
+
+	if len(args.regions) > 0 {
+		details.SideEffects = append(details.SideEffects,
+			fmt.Sprintf("Once available, the image will be replicated to %s.", strings.Join(args.regions, ", ")))
+	}
+
+	return details, nil
+}
+
+// instanceToImageWait re-reads the new image until it is available or
+// imageCaptureWaitTimeout passes, recording each status change, then starts
+// replication to regions. The first re-read is immediate and later ones
+// imageCaptureWaitInterval apart. A wait or replication that fails leaves the
+// image standing and is reported as a warning.
+func instanceToImageWait(ctx context.Context, client *linode.Client, response *linodev1.InstanceToImageResponse, start time.Time, regions []string) {
+	image := response.GetImage()
+	ready := image.GetStatus() == imageStatusAvailable
+
+	waitCtx, cancel := context.WithTimeout(ctx, imageCaptureWaitTimeout)
+	defer cancel()
+
+	for first := true; !ready; first = false {
+		if !first {
+			select {
+			case <-waitCtx.Done():
+			case <-time.After(imageCaptureWaitInterval):
+			}
+		}
+
+		if waitCtx.Err() != nil {
+			response.Warnings = append(response.Warnings, fmt.Sprintf("wait: image %s was not available after %s", image.GetId(), imageCaptureWaitTimeout))
+
+			break
+		}
+
+		latest, err := client.GetImageProto(waitCtx, image.GetId())
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				continue
+			}
+
+			response.Warnings = append(response.Warnings, fmt.Sprintf("wait: failed to re-read image %s: %v", image.GetId(), err))
+
+			break
+		}
+
+		if latest.GetStatus() != image.GetStatus() {
+			response.Progress = append(response.Progress, &linodev1.InstanceToImageProgress{
+				ElapsedSeconds: linodeIDToInt32(int(time.Since(start).Seconds())),
+				Status:         latest.GetStatus(),
+				Detail:         fmt.Sprintf("image %s is %s", latest.GetId(), latest.GetStatus()),
+			})
+		}
+
+		image = latest
+		ready = image.GetStatus() == imageStatusAvailable
+	}
+
+	response.Image = image
+	response.Ready = &ready
+
+	if ready {
+		response.Message += "; it is available"
+	}
+
+	if len(regions) > 0 {
+		instanceToImageReplicate(ctx, client, response, start, regions)
+	}
+}
+
+// instanceToImageReplicate starts replication of an available image to
+// regions; the copies finish in the background.
+func instanceToImageReplicate(ctx context.Context, client *linode.Client, response *linodev1.InstanceToImageResponse, start time.Time, regions []string) {
+	image := response.GetImage()
+	if !response.GetReady() {
+		response.Warnings = append(response.Warnings, fmt.Sprintf("replicate: skipped because image %s is not available; run linode_image_replicate once it is", image.GetId()))
+
+		return
+	}
+
+	if _, err := client.ReplicateImageProto(ctx, image.GetId(), &linode.ReplicateImageRequest{Regions: regions}); err != nil {
+		response.Warnings = append(response.Warnings, fmt.Sprintf("replicate: failed to replicate image %s: %v", image.GetId(), err))
+
+		return
+	}
+
+	response.ReplicatedRegions = regions
+	response.Message += " and replicating to " + strings.Join(regions, ", ")
+	response.Progress = append(response.Progress, &linodev1.InstanceToImageProgress{
+		ElapsedSeconds: linodeIDToInt32(int(time.Since(start).Seconds())),
+		Status:         "replicating",
+		Detail:         fmt.Sprintf("image %s replicating to %s", image.GetId(), strings.Join(regions, ", ")),
+	})
+}
@@ -0,0 +1,132 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// The capture skips the swap disk for the largest one, waits for the image to
+// become available, records each status change, and then replicates it.
+func TestLinodeInstanceToImageToolCapturesWaitsAndReplicates(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body map[string]any
+		if r.Method == http.MethodPost {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("request body should decode: %v", err)
+			}
+		}
+
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "GET /linode/instances/5/disks":
+			_, _ = w.Write([]byte(`{"data":[{"id":11,"label":"swap","filesystem":"swap","size":512},` +
+				`{"id":12,"label":"ubuntu","filesystem":"ext4","size":25000},` +
+				`{"id":13,"label":"scratch","filesystem":"ext4","size":1024}],"page":1,"pages":1,"results":3}`))
+		case "POST /images":
+			if body["disk_id"] != float64(12) || body["label"] != "golden" {
+				t.Errorf("create body = %v, want disk_id 12 labeled golden", body)
+			}
+
+			_, _ = w.Write([]byte(`{"id":"private/9","label":"golden","status":"creating"}`))
+		case "GET /images/private%2F9":
+			_, _ = w.Write([]byte(`{"id":"private/9","label":"golden","status":"available"}`))
+		case "POST /images/private%2F9/regions":
+			if !reflect.DeepEqual(body["regions"], []any{"us-west"}) {
+				t.Errorf("replicate body = %v, want regions [us-west]", body)
+			}
+
+			_, _ = w.Write([]byte(`{"id":"private/9","label":"golden","status":"available"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.EscapedPath())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	_, _, handler := tools.NewLinodeInstanceToImageTool(newTestConfig(srv.URL))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		keyLinodeID: float64(5), keyLabel: "golden", keyRegions: []any{"us-west"}, keyConfirm: true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want a capture", result.Content)
+	}
+
+	var response struct {
+		Message           string   `json:"message"`
+		DiskID            int      `json:"disk_id"`
+		Ready             bool     `json:"ready"`
+		ReplicatedRegions []string `json:"replicated_regions"`
+		Progress          []struct {
+			Status string `json:"status"`
+		} `json:"progress"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if response.DiskID != 12 || !response.Ready || !reflect.DeepEqual(response.ReplicatedRegions, []string{"us-west"}) {
+		t.Errorf("response = %+v, want disk 12 ready and replicating to us-west", response)
+	}
+
+	statuses := make([]string, 0, len(response.Progress))
+	for _, step := range response.Progress {
+		statuses = append(statuses, step.Status)
+	}
+
+	if want := []string{"creating", "available", "replicating"}; !reflect.DeepEqual(statuses, want) {
+		t.Errorf("progress statuses = %v, want %v", statuses, want)
+	}
+
+	if want := "Image 'golden' (private/9) is being captured from disk 12 (ubuntu) of instance 5; it is available and replicating to us-west"; response.Message != want {
+		t.Errorf("message = %q, want %q", response.Message, want)
+	}
+}
+
+func TestLinodeInstanceToImageToolValidation(t *testing.T) {
+	t.Parallel()
+
+	_, _, handler := tools.NewLinodeInstanceToImageTool(newTestConfig("https://api.linode.com/v4"))
+
+	tests := []struct {
+		name string
+		args map[string]any
+		want string
+	}{
+		{name: "missing linode_id", args: map[string]any{keyConfirm: true}, want: "linode_id is required"},
+		{name: "bad disk_id", args: map[string]any{keyLinodeID: float64(5), "disk_id": float64(0), keyConfirm: true}, want: "disk_id must be a positive integer"},
+		{name: "regions without wait", args: map[string]any{keyLinodeID: float64(5), keyRegions: []any{"us-west"}, "wait": false, keyConfirm: true}, want: "regions needs wait=true: an image replicates only once it is available"},
+		{name: "missing confirm", args: map[string]any{keyLinodeID: float64(5)}, want: "This captures an image from the instance's disk. Set confirm=true to proceed."},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := handler(t.Context(), createRequestWithArgs(t, tc.args))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text, _ := result.Content[0].(mcp.TextContent)
+			if !result.IsError || text.Text != tc.want {
+				t.Errorf("result = %v, want error %q", result.Content, tc.want)
+			}
+		})
+	}
+}
@@ -48,7 +48,7 @@ func handleInstanceTransferMonthGetRequest(ctx context.Context, request *mcp.Cal
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -71,7 +71,7 @@ func handleLinodeInstanceTransferGetRequest(ctx context.Context, request *mcp.Ca
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -90,11 +90,20 @@ func handleLinodeInstanceGetRequest(ctx context.Context, request *mcp.CallToolRe
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	includes, validationMessage := parseInstanceIncludes(request.GetString(paramInclude, ""))
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
+	if len(includes) > 0 {
+		return handleLinodeInstanceDetail(ctx, client, instanceID, includes)
+	}
+
 	instance, err := client.GetInstanceProto(ctx, instanceID)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve Linode instance: %v", err)), nil
@@ -121,7 +130,7 @@ func NewLinodeInstanceListTool(cfg *config.Config) (mcp.Tool, profiles.Capabilit
 func handleLinodeInstancesRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
 	statusFilter := request.GetString("status", "")
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -184,7 +193,7 @@ func handleInstanceStatsByYearMonthRequest(ctx context.Context, request *mcp.Cal
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -279,7 +288,7 @@ func handleInstanceInterfaceAddRequest(ctx context.Context, request *mcp.CallToo
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -392,7 +401,7 @@ func handleInstanceInterfaceGetRequest(ctx context.Context, request *mcp.CallToo
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -451,7 +460,7 @@ func handleInstanceInterfaceDeleteRequest(ctx context.Context, request *mcp.Call
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -492,7 +501,7 @@ func handleInstanceInterfaceSettingsGetRequest(ctx context.Context, request *mcp
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -547,7 +556,7 @@ func handleInstanceInterfaceSettingsUpdateRequest(ctx context.Context, request *
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -647,7 +656,7 @@ func handleInstanceInterfaceUpdateRequest(ctx context.Context, request *mcp.Call
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -810,7 +819,7 @@ func handleLinodeInterfacesUpgradeRequest(ctx context.Context, request *mcp.Call
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -885,11 +894,7 @@ func instanceInterfaceListResponse(items []*linodev1.InstanceInterface, count in
 
 func selectEnvironment(cfg *config.Config, environment string) (*config.EnvironmentConfig, error) {
 	if environment != "" {
-		if env, exists := cfg.Environments[environment]; exists {
-			return &env, nil
-		}
-
-		return nil, fmt.Errorf("%w: %s", ErrEnvironmentNotFound, environment)
+		return cfg.ResolveEnvironment(environment)
 	}
 
 	selectedEnv, err := cfg.SelectEnvironment("default")
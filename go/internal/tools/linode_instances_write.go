@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"google.golang.org/protobuf/proto"
@@ -63,7 +64,7 @@ func handleInstancePowerAction(
 		return mcp.NewToolResultError("instance_id is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -153,7 +154,7 @@ func handleLinodeInstanceShutdownRequest(ctx context.Context, request *mcp.CallT
 func NewLinodeInstanceCreateTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
 	tool := mcp.NewToolWithRawSchema(
 		"linode_instance_create",
-		"Creates a new Linode instance under the current Linode Interfaces generation. WARNING: Billing starts immediately upon creation. Requires firewall_id (get one from linode_firewall_list or create with linode_firewall_create). Note: VPC attachment via the current interface model is not yet supported by this tool; use linode_vpc_* tools after create.",
+		"Creates a new Linode instance under the current Linode Interfaces generation. WARNING: Billing starts immediately upon creation. Requires firewall_id (get one from linode_firewall_list or create with linode_firewall_create). Pass vpc_subnet (a subnet ID or \"<vpc label>/<subnet label>\") to attach a VPC interface instead of a public one; public_internet=true (the default) gives it a 1:1 NAT public address, false keeps it private. Pass backup_id with linode_id (the instance the backup was taken from) to restore a completed backup onto the new instance instead of deploying an image. Without authorized_keys, an image deployment authorizes the profile SSH keys named in the instance_defaults config; an empty list authorizes none.",
 		toolschemas.Schema("linode.mcp.v1.InstanceCreateInput"),
 	)
 
@@ -188,6 +189,98 @@ func validateInstanceCreateArgs(region, instanceType, rootPass string, firewallI
 	return ""
 }
 
+// backupStatusSuccessful is the status of a backup that completed and can be
+// restored.
+const backupStatusSuccessful = "successful"
+
+// validateInstanceCreateSource checks the optional backup source, returning an
+// error message or "". A backup supplies the disks, so it cannot be combined
+// with the image-only fields.
+func validateInstanceCreateSource(backupID, linodeID int, image, rootPass string, hasKeys bool) string {
+	if backupID == 0 && linodeID == 0 {
+		return ""
+	}
+
+	if backupID <= 0 || linodeID <= 0 {
+		return "backup_id and linode_id must be passed together: linode_id is the instance the backup was taken from"
+	}
+
+	if image != "" || rootPass != "" || hasKeys {
+		return "image, root_pass, and authorized_keys cannot be combined with backup_id: the backup supplies the disks"
+	}
+
+	return ""
+}
+
+// instanceCreateBackup fetches the backup an instance create restores from
+// and checks that the account can read it, that it completed, that it is still
+// available, and that it lives in the region the instance is created in.
+func instanceCreateBackup(ctx context.Context, client *linode.Client, linodeID, backupID int, region string) (*linode.InstanceBackup, error) {
+	backup, err := client.GetInstanceBackup(ctx, linodeID, backupID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: backup %d of instance %d: %w", ErrBackupNotOnAccount, backupID, linodeID, err)
+	}
+
+	switch {
+	case backup.Status != backupStatusSuccessful:
+		return nil, fmt.Errorf("%w: backup %d has status %q, only completed (successful) backups restore",
+			ErrBackupNotRestorable, backupID, backup.Status)
+	case !backup.Available:
+		return nil, fmt.Errorf("%w: backup %d is no longer available", ErrBackupNotRestorable, backupID)
+	case backup.Region != "" && backup.Region != region:
+		return nil, fmt.Errorf("%w: backup %d is in region %s, the instance must be created there too",
+			ErrBackupNotRestorable, backupID, backup.Region)
+	}
+
+	return backup, nil
+}
+
+// instanceCreateDefaultKeyLabels returns the instance_defaults SSH key labels a
+// create authorizes: none when the call passes authorized_keys, which replaces
+// them, or when the instance is not deployed from an image, the only source
+// that takes authorized keys.
+func instanceCreateDefaultKeyLabels(cfg *config.Config, request *mcp.CallToolRequest, image string) []string {
+	if _, passed := request.GetArguments()["authorized_keys"]; passed || image == "" {
+		return nil
+	}
+
+	return cfg.InstanceDefaults.AuthorizedKeyLabels
+}
+
+// resolveDefaultAuthorizedKeys looks the labels up among the profile's SSH
+// keys and returns their public keys in label order; every key carrying a
+// label is authorized. A label with no key fails the create, naming each one
+// missing, so an instance never comes up without a key the team counts on.
+func resolveDefaultAuthorizedKeys(ctx context.Context, client *linode.Client, labels []string) ([]string, error) {
+	keys, err := client.ListSSHKeysProto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list profile SSH keys for instance_defaults: %w", err)
+	}
+
+	var resolved, missing []string
+
+	for _, label := range labels {
+		found := false
+
+		for _, key := range keys {
+			if key.GetLabel() == label {
+				resolved = append(resolved, key.GetSshKey())
+				found = true
+			}
+		}
+
+		if !found {
+			missing = append(missing, label)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrDefaultKeyLabelNotFound, strings.Join(missing, ", "))
+	}
+
+	return resolved, nil
+}
+
 func handleLinodeInstanceCreateRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
 	region := request.GetString("region", "")
 	instanceType := request.GetString("type", "")
@@ -198,15 +291,50 @@ func handleLinodeInstanceCreateRequest(ctx context.Context, request *mcp.CallToo
 	firewallID := request.GetInt("firewall_id", 0)
 	routeIPv4 := request.GetBool("route_ipv4", true)
 	routeIPv6 := request.GetBool("route_ipv6", true)
+	backupID := request.GetInt("backup_id", 0)
+	sourceLinodeID := request.GetInt("linode_id", 0)
+	_, hasKeys := request.GetArguments()["authorized_keys"]
+	defaultKeyLabels := instanceCreateDefaultKeyLabels(cfg, request, image)
+
+	helpers, helpersMessage := networkHelpersFromTool(request)
 
 	if IsDryRun(request) {
 		if msg := validateInstanceCreateArgs(region, instanceType, rootPass, firewallID); msg != "" {
 			return mcp.NewToolResultError(msg), nil
 		}
 
-		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_instance_create", httpMethodPost, "/linode/instances", nil,
+		if msg := validateInstanceCreateSource(backupID, sourceLinodeID, image, rootPass, hasKeys); msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		if helpersMessage != "" {
+			return mcp.NewToolResultError(helpersMessage), nil
+		}
+
+		source := ""
+		if image != "" {
+			source = "image " + image
+		}
+
+		var fetchBackup func(ctx context.Context, client *linode.Client) (any, error)
+
+		if backupID > 0 {
+			source = fmt.Sprintf("backup %d of instance %d", backupID, sourceLinodeID)
+			fetchBackup = func(ctx context.Context, client *linode.Client) (any, error) {
+				return instanceCreateBackup(ctx, client, sourceLinodeID, backupID, region)
+			}
+		}
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_instance_create", httpMethodPost, "/linode/instances", fetchBackup,
 			func(ctx context.Context, _ *linode.Client, _ any) (DryRunDetails, error) {
-				return instanceCreateSideEffects(ctx, instanceType, region, image)
+				details, err := instanceCreateSideEffects(ctx, instanceType, region, source)
+				if err == nil && len(defaultKeyLabels) > 0 {
+					details.SideEffects = append(details.SideEffects, fmt.Sprintf(
+						"The profile SSH keys labeled %s (instance_defaults) will be authorized for root.",
+						strings.Join(defaultKeyLabels, ", ")))
+				}
+
+				return details, err
 			})
 	}
 
@@ -218,11 +346,33 @@ func handleLinodeInstanceCreateRequest(ctx context.Context, request *mcp.CallToo
 		return mcp.NewToolResultError(msg), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	if msg := validateInstanceCreateSource(backupID, sourceLinodeID, image, rootPass, hasKeys); msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	if helpersMessage != "" {
+		return mcp.NewToolResultError(helpersMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
+	if backupID > 0 {
+		if _, err := instanceCreateBackup(ctx, client, sourceLinodeID, backupID, region); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+
+	var subnetID int
+
+	if helpers.SubnetRef != "" {
+		if subnetID, err = resolveVPCSubnet(ctx, client, helpers.SubnetRef); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+
 	req := linode.CreateInstanceRequest{
 		Region:              region,
 		Type:                instanceType,
@@ -231,13 +381,11 @@ func handleLinodeInstanceCreateRequest(ctx context.Context, request *mcp.CallToo
 		RootPass:            rootPass,
 		BackupsEnabled:      backupsEnabled,
 		InterfaceGeneration: linode.CurrentInterfaceGeneration,
-		Interfaces: []linode.InstanceInterface{
-			{
-				Public:       &linode.InterfacePublicConfig{},
-				DefaultRoute: buildDefaultRoute(routeIPv4, routeIPv6),
-				FirewallID:   &firewallID,
-			},
-		},
+		Interfaces:          helpers.instanceInterfaces(subnetID, firewallID, routeIPv4, routeIPv6),
+	}
+
+	if backupID > 0 {
+		req.BackupID = &backupID
 	}
 
 	if raw, exists := request.GetArguments()["authorized_keys"]; exists {
@@ -249,6 +397,12 @@ func handleLinodeInstanceCreateRequest(ctx context.Context, request *mcp.CallToo
 		req.AuthorizedKeys = keys
 	}
 
+	if len(defaultKeyLabels) > 0 {
+		if req.AuthorizedKeys, err = resolveDefaultAuthorizedKeys(ctx, client, defaultKeyLabels); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+
 	// Only set Booted when the caller explicitly passed it: the MCP schema
 	// delivers booleans as false by default, so checking the raw arguments map
 	// distinguishes "not provided" from an explicit false.
@@ -267,6 +421,10 @@ func handleLinodeInstanceCreateRequest(ctx context.Context, request *mcp.CallToo
 		Instance: instance,
 	}
 
+	if len(defaultKeyLabels) > 0 {
+		response.Message += " with the default SSH keys " + strings.Join(defaultKeyLabels, ", ")
+	}
+
 	return MarshalProtoToolResponse(response)
 }
 
@@ -327,7 +485,7 @@ func handleLinodeInstanceUpdateRequest(ctx context.Context, request *mcp.CallToo
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -492,9 +650,20 @@ func handleLinodeInstanceDeleteRequest(ctx context.Context, request *mcp.CallToo
 		Execute:        func(ctx context.Context, c *linode.Client, id int) error { return c.DeleteInstance(ctx, id) },
 		DependencyWalk: instanceDeleteDependencyWalk,
 		HashIgnore:     twostage.HashIgnoreFields("Instance"),
+		ConfirmLabel:   instanceConfirmLabel,
 	})
 }
 
+// instanceConfirmLabel reads the instance label the confirm_label check
+// compares against.
+func instanceConfirmLabel(state any) string {
+	if instance, ok := state.(*linode.Instance); ok {
+		return instance.Label
+	}
+
+	return ""
+}
+
 // toolInstanceResize is the resize tool's name, referenced by the constructor,
 // the two-stage action, and the dry-run preview, so it lives in one place.
 const toolInstanceResize = "linode_instance_resize"
@@ -652,7 +821,7 @@ func handleLinodeInstanceResizeRequest(ctx context.Context, request *mcp.CallToo
 		return mcp.NewToolResultError("type is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -69,7 +69,7 @@ func handleIPv6RangeGetRequest(ctx context.Context, request *mcp.CallToolRequest
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -139,7 +139,7 @@ func handleLinodeIPv6RangeCreateRequest(ctx context.Context, request *mcp.CallTo
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
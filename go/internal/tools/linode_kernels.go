@@ -66,7 +66,7 @@ func handleKernelGetRequest(ctx context.Context, request *mcp.CallToolRequest, c
 		return mcp.NewToolResultError(errKernelIDIdentifier), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
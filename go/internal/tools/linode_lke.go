@@ -60,7 +60,7 @@ func handleLKEClusterGetRequest(ctx context.Context, request *mcp.CallToolReques
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -137,7 +137,7 @@ func handleLKEPoolGetRequest(ctx context.Context, request *mcp.CallToolRequest,
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -176,7 +176,7 @@ func handleLKENodeGetRequest(ctx context.Context, request *mcp.CallToolRequest,
 		return mcp.NewToolResultError("node_id is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -210,7 +210,7 @@ func handleLKEKubeconfigGetRequest(ctx context.Context, request *mcp.CallToolReq
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -244,7 +244,7 @@ func handleLKEDashboardGetRequest(ctx context.Context, request *mcp.CallToolRequ
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -304,7 +304,7 @@ func handleLKEACLGetRequest(ctx context.Context, request *mcp.CallToolRequest, c
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -359,7 +359,7 @@ func handleLKEVersionGetRequest(ctx context.Context, request *mcp.CallToolReques
 		return mcp.NewToolResultError(errMsg), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -463,7 +463,7 @@ func handleLKETierVersionGetRequest(ctx context.Context, request *mcp.CallToolRe
 		return mcp.NewToolResultError(errMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -0,0 +1,168 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// NewLinodeLKENodeInstancesTool creates a tool that maps every LKE node to
+// the Linode instance backing it.
+func NewLinodeLKENodeInstancesTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_lke_node_instances",
+		"Maps every LKE node to its backing Linode instance (ID, label, status, IPs, plan, region) across all clusters, or one cluster with cluster_id. "+
+			"The instance label is the Kubernetes node name, so pass node=<kubectl node name> (or an LKE node ID) to find the instance behind one node.",
+		toolschemas.Schema("linode.mcp.v1.LKENodeInstancesInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLKENodeInstancesRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLKENodeInstancesRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	clusterIDRaw, validationMessage := stringArgument(request, "cluster_id", false)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	var clusterID int
+
+	if clusterIDRaw != "" {
+		id, err := strconv.Atoi(clusterIDRaw)
+		if err != nil {
+			return mcp.NewToolResultError(ErrLKEClusterIDInvalid.Error()), nil
+		}
+
+		clusterID = id
+	}
+
+	nodeFilter, validationMessage := stringArgument(request, "node", false)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	nodeFilter = strings.TrimSpace(nodeFilter)
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	nodes, err := lkeNodeInstances(ctx, client, clusterID, nodeFilter)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to map LKE nodes to instances: %v", err)), nil
+	}
+
+	var count int32
+	if n := len(nodes); n <= math.MaxInt32 {
+		count = int32(n)
+	}
+
+	response := &linodev1.LKENodeInstancesResponse{Count: count, Nodes: nodes}
+	if nodeFilter != "" {
+		response.Filter = &nodeFilter
+	}
+
+	result, err := MarshalProtoToolResponse(response)
+	if err != nil {
+		return nil, err
+	}
+
+	setEnvelopeCount(result, count)
+
+	return result, nil
+}
+
+// lkeNodeInstances walks the clusters (or the one cluster when clusterID is
+// set) and their pools, joining each node to its instance from a single
+// instance listing. nodeFilter, when set, keeps only the node whose ID or
+// instance label matches it case-insensitively.
+func lkeNodeInstances(ctx context.Context, client *linode.Client, clusterID int, nodeFilter string) ([]*linodev1.LKENodeInstance, error) {
+	var clusters []*linodev1.LKECluster
+
+	if clusterID != 0 {
+		cluster, err := client.GetLKEClusterProto(ctx, clusterID)
+		if err != nil {
+			return nil, fmt.Errorf("get cluster %d: %w", clusterID, err)
+		}
+
+		clusters = []*linodev1.LKECluster{cluster}
+	} else {
+		listed, err := client.ListLKEClustersProto(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list clusters: %w", err)
+		}
+
+		clusters = listed
+	}
+
+	instances, err := client.ListInstancesProto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list instances: %w", err)
+	}
+
+	byID := make(map[int32]*linodev1.Instance, len(instances))
+	for _, instance := range instances {
+		byID[instance.GetId()] = instance
+	}
+
+	nodes := []*linodev1.LKENodeInstance{}
+
+	for _, cluster := range clusters {
+		pools, err := client.ListLKENodePoolsProto(ctx, int(cluster.GetId()))
+		if err != nil {
+			return nil, fmt.Errorf("list node pools for cluster %d: %w", cluster.GetId(), err)
+		}
+
+		for _, pool := range pools {
+			for _, node := range pool.GetNodes() {
+				entry := lkeNodeInstance(cluster, pool, node, byID[node.GetInstanceId()])
+				if nodeFilter != "" && !strings.EqualFold(entry.GetNodeId(), nodeFilter) && !strings.EqualFold(entry.GetInstanceLabel(), nodeFilter) {
+					continue
+				}
+
+				nodes = append(nodes, entry)
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+// lkeNodeInstance builds one mapping entry. instance is nil when the node's
+// instance is not on the account yet (provisioning) or any longer (recycled).
+func lkeNodeInstance(cluster *linodev1.LKECluster, pool *linodev1.LKENodePool, node *linodev1.LKENode, instance *linodev1.Instance) *linodev1.LKENodeInstance {
+	entry := &linodev1.LKENodeInstance{
+		ClusterId:    cluster.GetId(),
+		ClusterLabel: cluster.GetLabel(),
+		PoolId:       pool.GetId(),
+		NodeId:       node.GetId(),
+		NodeStatus:   node.GetStatus(),
+		InstanceId:   node.GetInstanceId(),
+	}
+
+	if instance != nil {
+		entry.InstanceLabel = instance.GetLabel()
+		entry.InstanceStatus = instance.GetStatus()
+		entry.Type = instance.GetType()
+		entry.Region = instance.GetRegion()
+		entry.Ipv4 = instance.GetIpv4()
+		entry.Ipv6 = instance.GetIpv6()
+	}
+
+	return entry
+}
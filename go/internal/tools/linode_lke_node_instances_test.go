@@ -0,0 +1,51 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// A pool listing failure names the cluster it happened on instead of
+// returning a partial mapping.
+func TestLinodeLKENodeInstancesToolPoolListFailure(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/lke/clusters":
+			_, _ = w.Write([]byte(`{"data":[{"id":10,"label":"prod"}],"page":1,"pages":1,"results":1}`))
+		case "/linode/instances":
+			_, _ = w.Write([]byte(`{"data":[],"page":1,"pages":1,"results":0}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"errors":[{"reason":"boom"}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	cfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+	_, _, handler := tools.NewLinodeLKENodeInstancesTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !result.IsError || !ok || !strings.Contains(text.Text, "list node pools for cluster 10") {
+		t.Errorf("result = %v, want a pool list failure naming cluster 10", result.Content)
+	}
+}
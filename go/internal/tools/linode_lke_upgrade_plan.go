@@ -0,0 +1,462 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// Per-cluster plan verdicts reported in LKEUpgradePlanCluster.upgrade.
+const (
+	lkeUpgradeUpToDate    = "up_to_date"
+	lkeUpgradeAvailable   = "upgrade_available"
+	lkeUpgradeUnsupported = "unsupported"
+	lkeUpgradeUnknown     = "unknown"
+)
+
+// Per-cluster outcomes reported in LKEUpgradePlanCluster.outcome when the
+// call executed.
+const (
+	lkeUpgradeOutcomeUpgraded = "upgraded"
+	lkeUpgradeOutcomeFailed   = "failed"
+	lkeUpgradeOutcomeTimedOut = "timed_out"
+	lkeUpgradeOutcomeSkipped  = "skipped"
+)
+
+// Pacing for execute with wait. A control-plane upgrade settles within
+// minutes, but a recycle replaces nodes one at a time; past
+// lkeUpgradeWaitTimeout the cluster is reported timed_out and the run stops.
+const (
+	lkeUpgradeWaitInterval = 15 * time.Second
+	lkeUpgradeWaitTimeout  = 30 * time.Minute
+	lkeStatusReady         = "ready"
+)
+
+// lkeUpgradePlanArgs is the validated linode_lke_upgrade_plan input.
+type lkeUpgradePlanArgs struct {
+	clusterIDs []int
+	execute    bool
+	recycle    bool
+	wait       bool
+}
+
+// NewLinodeLKEUpgradePlanTool creates a tool that plans, and optionally runs,
+// Kubernetes version upgrades across LKE clusters.
+func NewLinodeLKEUpgradePlanTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_lke_upgrade_plan",
+		"Plans Kubernetes upgrades across LKE clusters: lists each cluster (every cluster, or cluster_ids) with its current version, "+
+			"the supported versions newer than it, the next version LKE can move it to (one minor version per upgrade), and "+
+			"whether its node pools need recycling to run the new version. With execute=true and confirm=true, upgrades each "+
+			"cluster to its next version one at a time, recycling its nodes when recycle=true and, unless wait=false, waiting "+
+			"for it to be ready before the next; the first failure stops the run. Pass dry_run=true to preview the upgrades.",
+		toolschemas.Schema("linode.mcp.v1.LKEUpgradePlanInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeLKEUpgradePlanRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// lkeUpgradePlanArgsFromTool validates the tool args, returning a validation
+// message on the first problem.
+func lkeUpgradePlanArgsFromTool(request *mcp.CallToolRequest) (*lkeUpgradePlanArgs, string) {
+	args := &lkeUpgradePlanArgs{
+		clusterIDs: request.GetIntSlice("cluster_ids", nil),
+		execute:    request.GetBool("execute", false),
+		recycle:    request.GetBool("recycle", false),
+		wait:       request.GetBool("wait", true),
+	}
+
+	for idx, id := range args.clusterIDs {
+		if id <= 0 {
+			return nil, fmt.Sprintf("cluster_ids[%d] must be a positive integer", idx)
+		}
+	}
+
+	return args, ""
+}
+
+func handleLinodeLKEUpgradePlanRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	args, validationMessage := lkeUpgradePlanArgsFromTool(request)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	if IsDryRun(request) {
+		var plan *linodev1.LKEUpgradePlanResponse
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_lke_upgrade_plan", httpMethodPut, lkeClustersPath+"/{cluster_id}",
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				var err error
+
+				plan, err = buildLKEUpgradePlan(ctx, c, args.clusterIDs)
+
+				return nil, err
+			},
+			func(_ context.Context, _ *linode.Client, _ any) (DryRunDetails, error) {
+				return lkeUpgradePlanSideEffects(plan, args.recycle), nil
+			})
+	}
+
+	if args.execute {
+		if result := RequireConfirm(request, "This upgrades the Kubernetes version of every planned LKE cluster. Set confirm=true to proceed."); result != nil {
+			return result, nil
+		}
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	plan, err := buildLKEUpgradePlan(ctx, client, args.clusterIDs)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to plan LKE upgrades: %v", err)), nil
+	}
+
+	if args.execute {
+		executeLKEUpgradePlan(ctx, client, plan, args)
+	}
+
+	return MarshalProtoToolResponse(plan)
+}
+
+// buildLKEUpgradePlan reads the supported versions, the clusters, and each
+// cluster's node pools, and assembles the plan. clusterIDs limits the plan
+// to those clusters, read in the order given.
+func buildLKEUpgradePlan(ctx context.Context, client *linode.Client, clusterIDs []int) (*linodev1.LKEUpgradePlanResponse, error) {
+	versions, err := client.ListLKEVersionsProto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list LKE versions: %w", err)
+	}
+
+	supported := make([]string, 0, len(versions))
+	for _, version := range versions {
+		supported = append(supported, version.GetId())
+	}
+
+	slices.SortFunc(supported, compareK8sVersions)
+
+	var clusters []*linodev1.LKECluster
+
+	if len(clusterIDs) == 0 {
+		clusters, err = client.ListLKEClustersProto(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list LKE clusters: %w", err)
+		}
+	}
+
+	for _, id := range clusterIDs {
+		cluster, err := client.GetLKEClusterProto(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get LKE cluster %d: %w", id, err)
+		}
+
+		clusters = append(clusters, cluster)
+	}
+
+	plan := &linodev1.LKEUpgradePlanResponse{SupportedVersions: supported}
+
+	var available, recycles int
+
+	for _, cluster := range clusters {
+		pools, err := client.ListLKENodePoolsProto(ctx, int(cluster.GetId()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list node pools for LKE cluster %d: %w", cluster.GetId(), err)
+		}
+
+		entry := lkeUpgradePlanCluster(cluster, pools, supported)
+		if entry.NextVersion != nil {
+			available++
+		}
+
+		if entry.GetNeedsRecycle() {
+			recycles++
+		}
+
+		plan.Clusters = append(plan.Clusters, entry)
+	}
+
+	plan.Message = fmt.Sprintf("%d of %d LKE clusters have a Kubernetes upgrade available (%d with nodes to recycle afterwards)",
+		available, len(clusters), recycles)
+
+	return plan, nil
+}
+
+// lkeUpgradePlanCluster is one cluster's plan entry. supported must be sorted
+// oldest first.
+func lkeUpgradePlanCluster(cluster *linodev1.LKECluster, pools []*linodev1.LKENodePool, supported []string) *linodev1.LKEUpgradePlanCluster {
+	entry := &linodev1.LKEUpgradePlanCluster{
+		ClusterId:      cluster.GetId(),
+		Label:          cluster.GetLabel(),
+		Region:         cluster.GetRegion(),
+		Status:         cluster.GetStatus(),
+		CurrentVersion: cluster.GetK8SVersion(),
+		PoolCount:      linodeIDToInt32(len(pools)),
+	}
+
+	for _, pool := range pools {
+		entry.NodeCount += pool.GetCount()
+	}
+
+	if _, _, ok := parseK8sVersion(entry.GetCurrentVersion()); !ok {
+		entry.Upgrade = lkeUpgradeUnknown
+
+		return entry
+	}
+
+	for _, version := range supported {
+		if compareK8sVersions(version, entry.GetCurrentVersion()) > 0 {
+			entry.TargetVersions = append(entry.TargetVersions, version)
+		}
+	}
+
+	switch {
+	case len(entry.GetTargetVersions()) == 0:
+		entry.Upgrade = lkeUpgradeUpToDate
+	case !slices.Contains(supported, entry.GetCurrentVersion()):
+		entry.Upgrade = lkeUpgradeUnsupported
+	default:
+		entry.Upgrade = lkeUpgradeAvailable
+	}
+
+	if len(entry.GetTargetVersions()) > 0 {
+		entry.NextVersion = new(entry.GetTargetVersions()[0])
+		entry.NeedsRecycle = entry.GetNodeCount() > 0
+	}
+
+	return entry
+}
+
+// parseK8sVersion reads the major and minor numbers of a Kubernetes version
+// such as "1.31"; any patch part is ignored.
+func parseK8sVersion(version string) (int, int, bool) {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return major, minor, true
+}
+
+// compareK8sVersions orders Kubernetes versions by major then minor number.
+// Versions that do not parse sort first, by their text.
+func compareK8sVersions(a, b string) int {
+	aMajor, aMinor, aOK := parseK8sVersion(a)
+	bMajor, bMinor, bOK := parseK8sVersion(b)
+
+	switch {
+	case !aOK && !bOK:
+		return strings.Compare(a, b)
+	case !aOK:
+		return -1
+	case !bOK:
+		return 1
+	}
+
+	return cmp.Or(cmp.Compare(aMajor, bMajor), cmp.Compare(aMinor, bMinor))
+}
+
+// lkeUpgradePlanSideEffects is the Tier B preview for an executed plan: one
+// line per cluster it would upgrade, and a warning for each cluster whose
+// nodes would stay on the old version because recycle is off.
+func lkeUpgradePlanSideEffects(plan *linodev1.LKEUpgradePlanResponse, recycle bool) DryRunDetails {
+	var details DryRunDetails
+
+	for _, entry := range plan.GetClusters() {
+		if entry.NextVersion == nil {
+			continue
+		}
+
+		effect := fmt.Sprintf("LKE cluster %d (%s) upgrades from Kubernetes %s to %s.",
+			entry.GetClusterId(), entry.GetLabel(), entry.GetCurrentVersion(), entry.GetNextVersion())
+
+		switch {
+		case recycle && entry.GetNeedsRecycle():
+			effect = strings.TrimSuffix(effect, ".") + fmt.Sprintf(", then its %d nodes are recycled.", entry.GetNodeCount())
+		case entry.GetNeedsRecycle():
+			details.Warnings = append(details.Warnings, fmt.Sprintf(
+				"LKE cluster %d keeps its %d nodes on Kubernetes %s until they are recycled.",
+				entry.GetClusterId(), entry.GetNodeCount(), entry.GetCurrentVersion()))
+		}
+
+		details.SideEffects = append(details.SideEffects, effect)
+	}
+
+	return details
+}
+
+// executeLKEUpgradePlan upgrades each cluster that has a next version, one at
+// a time and in plan order. The first cluster that fails or, with wait, does
+// not become ready in time stops the run; the clusters after it are skipped.
+func executeLKEUpgradePlan(ctx context.Context, client *linode.Client, plan *linodev1.LKEUpgradePlanResponse, args *lkeUpgradePlanArgs) {
+	plan.Executed = true
+
+	var (
+		planned int
+		stopped *linodev1.LKEUpgradePlanCluster
+	)
+
+	for _, entry := range plan.GetClusters() {
+		if entry.NextVersion == nil {
+			continue
+		}
+
+		planned++
+
+		if stopped != nil {
+			entry.Outcome = new(lkeUpgradeOutcomeSkipped)
+
+			continue
+		}
+
+		upgradeLKECluster(ctx, client, entry, args)
+
+		if entry.GetOutcome() == lkeUpgradeOutcomeUpgraded {
+			plan.Upgraded++
+		} else {
+			stopped = entry
+		}
+	}
+
+	plan.Message = fmt.Sprintf("Upgraded %d of %d LKE clusters with an upgrade available", plan.GetUpgraded(), planned)
+	if stopped != nil {
+		plan.Message += fmt.Sprintf("; stopped at cluster %d (%s)", stopped.GetClusterId(), stopped.GetOutcome())
+	}
+}
+
+// upgradeLKECluster moves one cluster to its next version, recycles its
+// nodes when asked, and waits for it when asked, recording the outcome on
+// the entry.
+func upgradeLKECluster(ctx context.Context, client *linode.Client, entry *linodev1.LKEUpgradePlanCluster, args *lkeUpgradePlanArgs) {
+	clusterID := int(entry.GetClusterId())
+
+	cluster, err := client.UpdateLKEClusterProto(ctx, clusterID, linode.UpdateLKEClusterRequest{K8sVersion: entry.GetNextVersion()})
+	if err != nil {
+		entry.Outcome = new(lkeUpgradeOutcomeFailed)
+		entry.Error = new(err.Error())
+
+		return
+	}
+
+	entry.Status = cluster.GetStatus()
+
+	if args.recycle && entry.GetNeedsRecycle() {
+		if err := client.RecycleLKECluster(ctx, clusterID); err != nil {
+			entry.Outcome = new(lkeUpgradeOutcomeFailed)
+			entry.Error = new(fmt.Sprintf("upgraded, but the recycle failed: %v", err))
+
+			return
+		}
+
+		entry.Recycled = true
+	}
+
+	if args.wait {
+		ready, err := awaitLKEClusterReady(ctx, client, clusterID, entry.GetRecycled())
+		entry.Ready = &ready
+
+		switch {
+		case err != nil:
+			entry.Outcome = new(lkeUpgradeOutcomeFailed)
+			entry.Error = new(fmt.Sprintf("upgraded, but the wait failed: %v", err))
+
+			return
+		case !ready:
+			entry.Outcome = new(lkeUpgradeOutcomeTimedOut)
+			entry.Error = new(fmt.Sprintf("not ready after %s", lkeUpgradeWaitTimeout))
+
+			return
+		}
+
+		entry.Status = lkeStatusReady
+	}
+
+	entry.Outcome = new(lkeUpgradeOutcomeUpgraded)
+}
+
+// awaitLKEClusterReady re-reads a cluster until it, and every node in its
+// pools when recycled, reports ready, or lkeUpgradeWaitTimeout passes. The
+// first read is immediate except after a recycle, which may not have marked
+// any node yet; later reads are lkeUpgradeWaitInterval apart.
+func awaitLKEClusterReady(ctx context.Context, client *linode.Client, clusterID int, recycled bool) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, lkeUpgradeWaitTimeout)
+	defer cancel()
+
+	for first := !recycled; ; first = false {
+		if !first {
+			select {
+			case <-ctx.Done():
+				return false, nil
+			case <-time.After(lkeUpgradeWaitInterval):
+			}
+		}
+
+		ready, err := lkeClusterReady(ctx, client, clusterID, recycled)
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		if ready {
+			return true, nil
+		}
+	}
+}
+
+// lkeClusterReady reports whether a cluster is ready and, when nodes
+// matters, whether every node in its pools is too.
+func lkeClusterReady(ctx context.Context, client *linode.Client, clusterID int, nodes bool) (bool, error) {
+	cluster, err := client.GetLKEClusterProto(ctx, clusterID)
+	if err != nil {
+		return false, fmt.Errorf("failed to re-read LKE cluster %d: %w", clusterID, err)
+	}
+
+	if cluster.GetStatus() != lkeStatusReady || !nodes {
+		return cluster.GetStatus() == lkeStatusReady, nil
+	}
+
+	pools, err := client.ListLKENodePoolsProto(ctx, clusterID)
+	if err != nil {
+		return false, fmt.Errorf("failed to list node pools for LKE cluster %d: %w", clusterID, err)
+	}
+
+	for _, pool := range pools {
+		for _, node := range pool.GetNodes() {
+			if node.GetStatus() != lkeStatusReady {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,141 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeLKEUpgradePlanExecutesInOrderAndStopsAtFailure(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		failCluster  string
+		wantPuts     []string
+		wantOutcomes []string
+		wantUpgraded int
+		wantMessage  string
+	}{
+		{
+			name:         "every planned cluster upgrades",
+			wantPuts:     []string{"/lke/clusters/1", "/lke/clusters/3"},
+			wantOutcomes: []string{"upgraded", "", "upgraded"},
+			wantUpgraded: 2,
+			wantMessage:  "Upgraded 2 of 2 LKE clusters with an upgrade available",
+		},
+		{
+			name:         "a failure skips the clusters after it",
+			failCluster:  "/lke/clusters/1",
+			wantPuts:     []string{"/lke/clusters/1"},
+			wantOutcomes: []string{"failed", "", "skipped"},
+			wantMessage:  "Upgraded 0 of 2 LKE clusters with an upgrade available; stopped at cluster 1 (failed)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu   sync.Mutex
+				puts []string
+			)
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				var body string
+
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/lke/versions":
+					body = `{"data":[{"id":"1.31"},{"id":"1.30"}],"page":1,"pages":1,"results":2}`
+				case r.Method == http.MethodGet && r.URL.Path == "/lke/clusters":
+					body = `{"data":[` +
+						`{"id":1,"label":"prod","region":"us-east","k8s_version":"1.30","status":"ready"},` +
+						`{"id":2,"label":"dev","region":"us-east","k8s_version":"1.31","status":"ready"},` +
+						`{"id":3,"label":"legacy","region":"us-east","k8s_version":"1.29","status":"ready"}` +
+						`],"page":1,"pages":1,"results":3}`
+				case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/pools"):
+					body = `{"data":[{"id":10,"type":"g6-standard-2","count":2}],"page":1,"pages":1,"results":1}`
+				case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/lke/clusters/"):
+					body = `{"id":1,"label":"prod","k8s_version":"1.31","status":"ready"}`
+				case r.Method == http.MethodPut:
+					mu.Lock()
+					puts = append(puts, r.URL.Path)
+					mu.Unlock()
+
+					if r.URL.Path == tt.failCluster {
+						w.WriteHeader(http.StatusBadRequest)
+
+						body = `{"errors":[{"reason":"upgrade blocked"}]}`
+
+						break
+					}
+
+					body = `{"id":1,"status":"ready"}`
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+
+				if _, err := w.Write([]byte(body)); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			_, _, handler := tools.NewLinodeLKEUpgradePlanTool(newTestConfig(srv.URL))
+
+			result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"execute": true, "confirm": true}))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text, ok := result.Content[0].(mcp.TextContent)
+			if !ok || result.IsError {
+				t.Fatalf("result = %v, want a plan", result.Content)
+			}
+
+			var plan struct {
+				Message  string `json:"message"`
+				Executed bool   `json:"executed"`
+				Upgraded int    `json:"upgraded"`
+				Clusters []struct {
+					Outcome string `json:"outcome"`
+				} `json:"clusters"`
+			}
+			if err := json.Unmarshal([]byte(text.Text), &plan); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			outcomes := make([]string, 0, len(plan.Clusters))
+			for _, cluster := range plan.Clusters {
+				outcomes = append(outcomes, cluster.Outcome)
+			}
+
+			if !plan.Executed || plan.Upgraded != tt.wantUpgraded || plan.Message != tt.wantMessage {
+				t.Errorf("plan = %+v, want %d upgraded and message %q", plan, tt.wantUpgraded, tt.wantMessage)
+			}
+
+			if !slices.Equal(outcomes, tt.wantOutcomes) {
+				t.Errorf("outcomes = %q, want %q", outcomes, tt.wantOutcomes)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if !slices.Equal(puts, tt.wantPuts) {
+				t.Errorf("PUTs = %q, want %q", puts, tt.wantPuts)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/kubeprobe"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// lkeWorkloadPingDisabledMessage is returned while lke.workload_ping is off.
+const lkeWorkloadPingDisabledMessage = "linode_lke_workload_ping is disabled; set lke.workload_ping: true " +
+	"in the config to let it use cluster kubeconfigs"
+
+// NewLinodeLKEWorkloadPingTool returns linode_lke_workload_ping, which fetches
+// an LKE cluster's kubeconfig and asks the cluster's Kubernetes API server
+// for /version and the node list. It is off until lke.workload_ping is set,
+// since it is the only tool that uses cluster credentials.
+func NewLinodeLKEWorkloadPingTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_lke_workload_ping",
+		"Checks that an LKE cluster's Kubernetes API server is actually serving: fetches the "+
+			"cluster kubeconfig and calls the API server's /version and node list endpoints, "+
+			"reporting the Kubernetes version, node readiness, and each request's status and "+
+			"latency. A deeper readiness signal than the cluster's Linode status. Requires "+
+			"lke.workload_ping: true in the config; the kubeconfig is never returned.",
+		toolschemas.Schema("linode.mcp.v1.LKEWorkloadPingInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLKEWorkloadPingRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLKEWorkloadPingRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	clusterID, err := parseLKEClusterID(request.GetString("cluster_id", ""))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if !resolveConfig(cfg).LKE.WorkloadPing {
+		return mcp.NewToolResultError(lkeWorkloadPingDisabledMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	kubeconfig, err := client.GetLKEKubeconfigProto(ctx, clusterID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve kubeconfig for cluster %d: %v", clusterID, err)), nil
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(kubeconfig.GetKubeconfig())
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to read kubeconfig for cluster %d: not valid base64: %v", clusterID, err)), nil
+	}
+
+	target, err := kubeprobe.ParseKubeconfig(raw)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to read kubeconfig for cluster %d: %v", clusterID, err)), nil
+	}
+
+	result, err := kubeprobe.Ping(ctx, target)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to read kubeconfig for cluster %d: %v", clusterID, err)), nil
+	}
+
+	return MarshalProtoToolResponse(lkeWorkloadPingProto(clusterID, target.Server, result))
+}
+
+func lkeWorkloadPingProto(clusterID int, server string, result kubeprobe.Result) *linodev1.LKEWorkloadPingResponse {
+	response := &linodev1.LKEWorkloadPingResponse{
+		ClusterId:         linodeIDToInt32(clusterID),
+		ApiServer:         server,
+		Serving:           result.Serving(),
+		KubernetesVersion: result.Version,
+		NodeCount:         linodeIDToInt32(len(result.Nodes)),
+		Nodes:             make([]*linodev1.LKEWorkloadNode, 0, len(result.Nodes)),
+		Checks:            make([]*linodev1.LKEWorkloadPingCheck, 0, len(result.Checks)),
+	}
+
+	for _, node := range result.Nodes {
+		if node.Ready {
+			response.ReadyNodeCount++
+		}
+
+		response.Nodes = append(response.Nodes, &linodev1.LKEWorkloadNode{
+			Name:           node.Name,
+			Ready:          node.Ready,
+			KubeletVersion: node.KubeletVersion,
+		})
+	}
+
+	for _, check := range result.Checks {
+		response.Checks = append(response.Checks, &linodev1.LKEWorkloadPingCheck{
+			Name:       check.Name,
+			Path:       check.Path,
+			Ok:         check.OK,
+			StatusCode: linodeIDToInt32(check.StatusCode),
+			LatencyMs:  check.Latency.Milliseconds(),
+			Error:      check.Err,
+		})
+	}
+
+	if failed, ok := result.FirstFailure(); ok {
+		response.Message = fmt.Sprintf("LKE cluster %d API server is not serving: %s check failed: %s", clusterID, failed.Name, failed.Err)
+	} else {
+		response.Message = fmt.Sprintf("LKE cluster %d API server is serving: Kubernetes %s, %d of %d nodes Ready",
+			clusterID, result.Version, response.GetReadyNodeCount(), response.GetNodeCount())
+	}
+
+	return response
+}
@@ -0,0 +1,178 @@
+package tools_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+type workloadPingOutput struct {
+	ClusterID         int    `json:"cluster_id"`
+	APIServer         string `json:"api_server"`
+	Serving           bool   `json:"serving"`
+	Message           string `json:"message"`
+	KubernetesVersion string `json:"kubernetes_version"`
+	NodeCount         int    `json:"node_count"`
+	ReadyNodeCount    int    `json:"ready_node_count"`
+	Checks            []struct {
+		Name       string `json:"name"`
+		OK         bool   `json:"ok"`
+		StatusCode int    `json:"status_code"`
+		Error      string `json:"error"`
+	} `json:"checks"`
+}
+
+// workloadPingServers starts a TLS Kubernetes API server and a Linode API
+// server whose cluster 123 kubeconfig points at it. nodesStatus is what the
+// node list answers.
+func workloadPingServers(t *testing.T, nodesStatus int) string {
+	t.Helper()
+
+	kube := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer kube-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		switch r.URL.Path {
+		case "/version":
+			_, _ = w.Write([]byte(`{"gitVersion":"v1.31.1"}`))
+		case "/api/v1/nodes":
+			w.WriteHeader(nodesStatus)
+			_, _ = w.Write([]byte(`{"items":[{"metadata":{"name":"lke123-1"},` +
+				`"status":{"conditions":[{"type":"Ready","status":"True"}],"nodeInfo":{"kubeletVersion":"v1.31.1"}}}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(kube.Close)
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: kube.Certificate().Raw})
+	kubeconfig := fmt.Sprintf("apiVersion: v1\nclusters:\n- name: lke123\n  cluster:\n"+
+		"    certificate-authority-data: %s\n    server: %s\n"+
+		"users:\n- name: lke123-admin\n  user:\n    token: kube-token\n"+
+		"contexts:\n- name: lke123-ctx\n  context:\n    cluster: lke123\n    user: lke123-admin\n"+
+		"current-context: lke123-ctx\n", base64.StdEncoding.EncodeToString(ca), kube.URL)
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/lke/clusters/123/kubeconfig" {
+			t.Errorf("r.URL.Path = %v, want %v", r.URL.Path, "/lke/clusters/123/kubeconfig")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"kubeconfig": base64.StdEncoding.EncodeToString([]byte(kubeconfig))})
+	}))
+	t.Cleanup(api.Close)
+
+	return api.URL
+}
+
+func workloadPingConfig(apiURL string, enabled bool) *config.Config {
+	return &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: apiURL, Token: tokenTest}},
+		},
+		LKE: config.LKEConfig{WorkloadPing: enabled},
+	}
+}
+
+func callWorkloadPing(t *testing.T, cfg *config.Config) workloadPingOutput {
+	t.Helper()
+
+	_, _, handler := tools.NewLinodeLKEWorkloadPingTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{keyClusterID: "123"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true: %s", text.Text)
+	}
+
+	if strings.Contains(text.Text, "kube-token") {
+		t.Errorf("response leaks the kubeconfig token: %s", text.Text)
+	}
+
+	var out workloadPingOutput
+	if err := json.Unmarshal([]byte(text.Text), &out); err != nil {
+		t.Fatalf("unmarshal output: %v", err)
+	}
+
+	return out
+}
+
+func TestLinodeLKEWorkloadPingToolDefinition(t *testing.T) {
+	t.Parallel()
+
+	tool, capability, _ := tools.NewLinodeLKEWorkloadPingTool(&config.Config{})
+
+	if tool.Name != "linode_lke_workload_ping" || capability != profiles.CapRead {
+		t.Errorf("tool = %q, %v, want linode_lke_workload_ping, %v", tool.Name, capability, profiles.CapRead)
+	}
+}
+
+func TestLinodeLKEWorkloadPingDisabledByDefault(t *testing.T) {
+	t.Parallel()
+
+	_, _, handler := tools.NewLinodeLKEWorkloadPingTool(workloadPingConfig(apiURLLinodeV4, false))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{keyClusterID: "123"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok || !result.IsError || !strings.Contains(text.Text, "lke.workload_ping: true") {
+		t.Errorf("result = %+v, want the disabled error", result)
+	}
+}
+
+func TestLinodeLKEWorkloadPingServing(t *testing.T) {
+	t.Parallel()
+
+	out := callWorkloadPing(t, workloadPingConfig(workloadPingServers(t, http.StatusOK), true))
+
+	if !out.Serving || out.KubernetesVersion != "v1.31.1" || out.NodeCount != 1 || out.ReadyNodeCount != 1 {
+		t.Errorf("output = %+v, want serving with one ready node", out)
+	}
+
+	if out.Message != "LKE cluster 123 API server is serving: Kubernetes v1.31.1, 1 of 1 nodes Ready" {
+		t.Errorf("message = %q", out.Message)
+	}
+
+	if len(out.Checks) != 2 || !out.Checks[0].OK || !out.Checks[1].OK {
+		t.Errorf("checks = %+v, want version and nodes both ok", out.Checks)
+	}
+}
+
+func TestLinodeLKEWorkloadPingNotServing(t *testing.T) {
+	t.Parallel()
+
+	out := callWorkloadPing(t, workloadPingConfig(workloadPingServers(t, http.StatusServiceUnavailable), true))
+
+	if out.Serving || out.KubernetesVersion != "v1.31.1" {
+		t.Errorf("output = %+v, want not serving with the version still reported", out)
+	}
+
+	if !strings.HasPrefix(out.Message, "LKE cluster 123 API server is not serving: nodes check failed: HTTP 503") {
+		t.Errorf("message = %q", out.Message)
+	}
+}
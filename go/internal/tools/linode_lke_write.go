@@ -52,7 +52,7 @@ func handleLKESubResourceAction[ID int | string](
 		return mcp.NewToolResultError(idParam + " is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -157,7 +157,7 @@ func handleLKEClusterCreateRequest(ctx context.Context, request *mcp.CallToolReq
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -237,7 +237,7 @@ func handleLKEClusterUpdateRequest(ctx context.Context, request *mcp.CallToolReq
 		req.ControlPlane = &linode.LKEControlPlane{HighAvailability: highAvailability}
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -297,9 +297,20 @@ func handleLKEClusterDeleteRequest(ctx context.Context, request *mcp.CallToolReq
 		},
 		DependencyWalk: lkeClusterDeleteDependencyWalk,
 		HashIgnore:     twostage.HashIgnoreFields("LKECluster"),
+		ConfirmLabel:   lkeClusterConfirmLabel,
 	})
 }
 
+// lkeClusterConfirmLabel reads the cluster label the confirm_label check
+// compares against.
+func lkeClusterConfirmLabel(state any) string {
+	if cluster, ok := state.(*linode.LKECluster); ok {
+		return cluster.Label
+	}
+
+	return ""
+}
+
 // NewLinodeLKEClusterRecycleTool creates a tool for recycling all nodes in an LKE cluster.
 func NewLinodeLKEClusterRecycleTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
 	tool := mcp.NewToolWithRawSchema(
@@ -354,7 +365,7 @@ func runLKEClusterAction(ctx context.Context, request *mcp.CallToolRequest, cfg
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -483,7 +494,7 @@ func handleLKEPoolCreateRequest(ctx context.Context, request *mcp.CallToolReques
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -570,7 +581,7 @@ func handleLKEPoolUpdateRequest(ctx context.Context, request *mcp.CallToolReques
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -984,7 +995,7 @@ func handleLKEACLUpdateRequest(ctx context.Context, request *mcp.CallToolRequest
 		ACL: acl,
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -1036,7 +1047,7 @@ func handleLKEACLDeleteRequest(ctx context.Context, request *mcp.CallToolRequest
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
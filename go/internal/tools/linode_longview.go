@@ -37,7 +37,7 @@ func NewLinodeLongviewPlanTool(cfg *config.Config) (mcp.Tool, profiles.Capabilit
 }
 
 func handleLinodeLongviewPlanRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -150,7 +150,7 @@ func handleLinodeLongviewClientCreateRequest(ctx context.Context, request *mcp.C
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -232,7 +232,7 @@ func handleLinodeLongviewPlanUpdateRequest(ctx context.Context, request *mcp.Cal
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
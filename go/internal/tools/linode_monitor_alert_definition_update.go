@@ -57,7 +57,7 @@ func handleLinodeMonitorServiceAlertDefinitionUpdateRequest(ctx context.Context,
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
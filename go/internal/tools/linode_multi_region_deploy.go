@@ -0,0 +1,332 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// linode_multi_region_deploy on_failure modes.
+const (
+	multiRegionOnFailureKeep     = "keep"
+	multiRegionOnFailureRollback = "rollback"
+)
+
+// Deploy outcomes reported in MultiRegionDeployResponse.status.
+const (
+	multiRegionStatusCreated    = "created"
+	multiRegionStatusPartial    = "partial"
+	multiRegionStatusRolledBack = "rolled_back"
+	multiRegionStatusFailed     = "failed"
+)
+
+// multiRegionMaxRegions caps one deploy; every region is created concurrently.
+const multiRegionMaxRegions = 25
+
+// instanceLabelMaxLength is the longest label Linode accepts on an instance.
+const instanceLabelMaxLength = 64
+
+// multiRegionDeployArgs is the validated linode_multi_region_deploy input.
+type multiRegionDeployArgs struct {
+	regions        []string
+	instanceType   string
+	image          string
+	labelPrefix    string
+	firewallID     int
+	rootPass       string
+	authorizedKeys []string
+	tags           []string
+	backupsEnabled bool
+	onFailure      string
+}
+
+// label is the instance label for one region.
+func (a *multiRegionDeployArgs) label(region string) string {
+	return a.labelPrefix + "-" + region
+}
+
+// NewLinodeMultiRegionDeployTool creates a tool that creates one instance of
+// the same spec in each of several regions.
+func NewLinodeMultiRegionDeployTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_multi_region_deploy",
+		"Creates one instance of the same type and image in each region in regions, in parallel, labeled "+
+			"\"<label_prefix>-<region>\" and sharing firewall_id, authorized_keys, root_pass, and tags. Useful for "+
+			"edge and latency testing. When some regions fail, on_failure=keep (default) leaves the instances that "+
+			"were created and on_failure=rollback deletes them. WARNING: every instance is billed from creation. "+
+			"Pass dry_run=true to preview the per-region plan.",
+		toolschemas.Schema("linode.mcp.v1.MultiRegionDeployInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeMultiRegionDeployRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// multiRegionDeployArgsFromTool validates the tool args, returning a
+// validation message on the first problem. Shared by the real path and the
+// dry-run preview.
+func multiRegionDeployArgsFromTool(request *mcp.CallToolRequest) (*multiRegionDeployArgs, string) {
+	args := request.GetArguments()
+	parsed := &multiRegionDeployArgs{
+		instanceType:   request.GetString("type", ""),
+		image:          request.GetString("image", ""),
+		labelPrefix:    request.GetString("label_prefix", ""),
+		firewallID:     request.GetInt("firewall_id", 0),
+		rootPass:       request.GetString("root_pass", ""),
+		backupsEnabled: request.GetBool("backups_enabled", false),
+		onFailure:      request.GetString("on_failure", multiRegionOnFailureKeep),
+	}
+
+	rawRegions, exists := args["regions"]
+	if !exists {
+		return nil, "regions is required"
+	}
+
+	regions, validationMessage := stringSliceFromToolArg(rawRegions, "regions")
+	if validationMessage != "" {
+		return nil, validationMessage
+	}
+
+	if len(regions) == 0 || len(regions) > multiRegionMaxRegions {
+		return nil, fmt.Sprintf("regions must list 1 to %d regions", multiRegionMaxRegions)
+	}
+
+	seen := make(map[string]bool, len(regions))
+	for _, region := range regions {
+		if region == "" {
+			return nil, "regions must not contain empty region IDs"
+		}
+
+		if seen[region] {
+			return nil, fmt.Sprintf("region %s is listed more than once", region)
+		}
+
+		seen[region] = true
+	}
+
+	parsed.regions = regions
+
+	if parsed.image == "" {
+		return nil, "image is required"
+	}
+
+	if parsed.labelPrefix == "" {
+		return nil, "label_prefix is required"
+	}
+
+	if msg := validateInstanceCreateArgs(regions[0], parsed.instanceType, parsed.rootPass, parsed.firewallID); msg != "" {
+		return nil, msg
+	}
+
+	for _, region := range regions {
+		if msg := validateMultiRegionLabel(parsed.label(region)); msg != "" {
+			return nil, msg
+		}
+	}
+
+	if parsed.onFailure != multiRegionOnFailureKeep && parsed.onFailure != multiRegionOnFailureRollback {
+		return nil, "on_failure must be keep or rollback"
+	}
+
+	if raw, exists := args["authorized_keys"]; exists {
+		keys, validationMessage := stringSliceFromToolArg(raw, "authorized_keys")
+		if validationMessage != "" {
+			return nil, validationMessage
+		}
+
+		parsed.authorizedKeys = keys
+	}
+
+	tags, _, validationMessage := optionalTagsField(args)
+	if validationMessage != "" {
+		return nil, validationMessage
+	}
+
+	parsed.tags = tags
+
+	return parsed, ""
+}
+
+// validateMultiRegionLabel checks a derived instance label against Linode's
+// label rules, so a bad label_prefix fails before any instance is created.
+func validateMultiRegionLabel(label string) string {
+	if len(label) > instanceLabelMaxLength {
+		return fmt.Sprintf("label %q is longer than %d characters: shorten label_prefix", label, instanceLabelMaxLength)
+	}
+
+	if i := strings.IndexFunc(label, func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && !strings.ContainsRune("-_.", r)
+	}); i >= 0 {
+		return fmt.Sprintf("label %q contains invalid character %q: use letters, digits, '-', '_', and '.'", label, label[i])
+	}
+
+	return ""
+}
+
+// multiRegionDeploySideEffects is the Tier B preview for
+// linode_multi_region_deploy: one line per instance it would create (arg-only,
+// no fetch) and the on_failure behavior.
+func multiRegionDeploySideEffects(ctx context.Context, args *multiRegionDeployArgs) (DryRunDetails, error) {
+	var details DryRunDetails
+
+	if err := ctx.Err(); err != nil {
+		return details, fmt.Errorf("multi-region-deploy side-effect walk canceled: %w", err)
+	}
+
+	for _, region := range args.regions {
+		details.SideEffects = append(details.SideEffects, fmt.Sprintf("A new %s instance %q will be created in region %s from image %s.",
+			args.instanceType, args.label(region), region, args.image))
+	}
+
+	if args.onFailure == multiRegionOnFailureRollback {
+		details.SideEffects = append(details.SideEffects, "If any region fails, the instances already created are deleted.")
+	} else {
+		details.SideEffects = append(details.SideEffects, "If any region fails, the instances already created are kept.")
+	}
+
+	details.Warnings = append(details.Warnings,
+		fmt.Sprintf("Billing for each of the %d instances starts immediately on creation.", len(args.regions)))
+
+	return details, nil
+}
+
+func handleLinodeMultiRegionDeployRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if IsDryRun(request) {
+		args, validationMessage := multiRegionDeployArgsFromTool(request)
+		if validationMessage != "" {
+			return mcp.NewToolResultError(validationMessage), nil
+		}
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_multi_region_deploy", httpMethodPost, "/linode/instances", nil,
+			func(ctx context.Context, _ *linode.Client, _ any) (DryRunDetails, error) {
+				return multiRegionDeploySideEffects(ctx, args)
+			})
+	}
+
+	if result := RequireConfirm(request, "This creates a billable instance in every listed region. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	args, validationMessage := multiRegionDeployArgsFromTool(request)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return MarshalProtoToolResponse(multiRegionDeploy(ctx, client, args))
+}
+
+// multiRegionDeploy creates every region's instance concurrently, then, when
+// some failed and on_failure is rollback, deletes the ones that were created.
+func multiRegionDeploy(ctx context.Context, client *linode.Client, args *multiRegionDeployArgs) *linodev1.MultiRegionDeployResponse {
+	results := make([]*linodev1.MultiRegionDeployResult, len(args.regions))
+
+	var wg sync.WaitGroup
+
+	for i, region := range args.regions {
+		wg.Go(func() {
+			results[i] = createMultiRegionInstance(ctx, client, args, region)
+		})
+	}
+
+	wg.Wait()
+
+	response := &linodev1.MultiRegionDeployResponse{Results: results}
+
+	for _, result := range results {
+		if result.Instance != nil {
+			response.Created++
+		} else {
+			response.Failed++
+		}
+	}
+
+	switch {
+	case response.GetFailed() == 0:
+		response.Status = multiRegionStatusCreated
+		response.Message = fmt.Sprintf("Created %d instances across %d regions", response.GetCreated(), len(results))
+	case response.GetCreated() == 0:
+		response.Status = multiRegionStatusFailed
+		response.Message = fmt.Sprintf("All %d regions failed; no instances were created", len(results))
+	case args.onFailure == multiRegionOnFailureRollback:
+		rollbackMultiRegionDeploy(ctx, client, results)
+
+		response.Status = multiRegionStatusRolledBack
+		response.Message = fmt.Sprintf("%d of %d regions failed; rolled back the %d instances that were created",
+			response.GetFailed(), len(results), response.GetCreated())
+	default:
+		response.Status = multiRegionStatusPartial
+		response.Message = fmt.Sprintf("%d of %d regions failed; kept the %d instances that were created",
+			response.GetFailed(), len(results), response.GetCreated())
+	}
+
+	return response
+}
+
+// createMultiRegionInstance creates one region's instance with a single
+// public interface behind the shared firewall.
+func createMultiRegionInstance(ctx context.Context, client *linode.Client, args *multiRegionDeployArgs, region string) *linodev1.MultiRegionDeployResult {
+	result := &linodev1.MultiRegionDeployResult{Region: region, Label: args.label(region)}
+
+	helpers := networkHelpers{PublicInternet: true}
+	req := linode.CreateInstanceRequest{
+		Region:              region,
+		Type:                args.instanceType,
+		Label:               result.GetLabel(),
+		Image:               args.image,
+		RootPass:            args.rootPass,
+		AuthorizedKeys:      args.authorizedKeys,
+		BackupsEnabled:      args.backupsEnabled,
+		Tags:                args.tags,
+		InterfaceGeneration: linode.CurrentInterfaceGeneration,
+		Interfaces:          helpers.instanceInterfaces(0, args.firewallID, true, true),
+	}
+
+	instance, err := client.CreateInstanceProto(ctx, &req)
+	if err != nil {
+		result.Error = new(err.Error())
+
+		return result
+	}
+
+	result.Instance = instance
+
+	return result
+}
+
+// rollbackMultiRegionDeploy deletes the instances a partially failed deploy
+// created, one at a time. A failed delete is recorded on its result and as an
+// envelope warning so the caller knows which instance is still billing.
+func rollbackMultiRegionDeploy(ctx context.Context, client *linode.Client, results []*linodev1.MultiRegionDeployResult) {
+	for _, result := range results {
+		if result.Instance == nil {
+			continue
+		}
+
+		if err := client.DeleteInstance(ctx, int(result.GetInstance().GetId())); err != nil {
+			result.RollbackError = new(err.Error())
+
+			AddWarning(ctx, "rollback of instance %d in %s failed: %v", result.GetInstance().GetId(), result.GetRegion(), err)
+
+			continue
+		}
+
+		result.RolledBack = true
+	}
+}
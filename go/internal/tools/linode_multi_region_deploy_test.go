@@ -0,0 +1,179 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeMultiRegionDeployRollsBackPartialFailure(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		deleted []string
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/linode/instances":
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if strings.Contains(string(body), `"region":"eu-west"`) {
+				w.WriteHeader(http.StatusBadRequest)
+
+				if _, err := w.Write([]byte(`{"errors":[{"reason":"Region is at capacity"}]}`)); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if _, err := w.Write([]byte(`{"id":101,"label":"edge-us-east","region":"us-east","status":"provisioning"}`)); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		case r.Method == http.MethodDelete:
+			mu.Lock()
+			deleted = append(deleted, r.URL.Path)
+			mu.Unlock()
+
+			if _, err := w.Write([]byte(`{}`)); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeMultiRegionDeployTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"regions":      []any{"us-east", "eu-west"},
+		"type":         "g6-nanode-1",
+		"image":        "linode/debian12",
+		"label_prefix": "edge",
+		"firewall_id":  5,
+		"on_failure":   "rollback",
+		"confirm":      true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var deploy struct {
+		Status  string `json:"status"`
+		Created int    `json:"created"`
+		Failed  int    `json:"failed"`
+		Results []struct {
+			Region     string  `json:"region"`
+			Label      string  `json:"label"`
+			Error      *string `json:"error"`
+			RolledBack bool    `json:"rolled_back"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &deploy); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if deploy.Status != "rolled_back" || deploy.Created != 1 || deploy.Failed != 1 {
+		t.Errorf("status/created/failed = %s/%d/%d, want rolled_back/1/1", deploy.Status, deploy.Created, deploy.Failed)
+	}
+
+	if len(deploy.Results) != 2 || deploy.Results[0].Label != "edge-us-east" || !deploy.Results[0].RolledBack {
+		t.Fatalf("results = %+v, want edge-us-east rolled back first", deploy.Results)
+	}
+
+	if deploy.Results[1].Region != "eu-west" || deploy.Results[1].Error == nil || !strings.Contains(*deploy.Results[1].Error, "capacity") {
+		t.Errorf("results[1] = %+v, want the eu-west capacity error", deploy.Results[1])
+	}
+
+	if len(deleted) != 1 || deleted[0] != "/linode/instances/101" {
+		t.Errorf("deleted = %v, want [/linode/instances/101]", deleted)
+	}
+}
+
+func TestLinodeMultiRegionDeployKeepsPartialFailureByDefault(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		if strings.Contains(string(body), `"region":"ap-south"`) {
+			w.WriteHeader(http.StatusBadRequest)
+
+			if _, err := w.Write([]byte(`{"errors":[{"reason":"Region is at capacity"}]}`)); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			return
+		}
+
+		if _, err := w.Write([]byte(`{"id":101,"label":"edge","status":"provisioning"}`)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeMultiRegionDeployTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"regions":      []any{"us-east", "eu-west", "ap-south"},
+		"type":         "g6-nanode-1",
+		"image":        "linode/debian12",
+		"label_prefix": "edge",
+		"firewall_id":  5,
+		"confirm":      true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	if !strings.Contains(textContent.Text, "1 of 3 regions failed; kept the 2 instances that were created") {
+		t.Errorf("result = %s, want the kept partial-failure message", textContent.Text)
+	}
+}
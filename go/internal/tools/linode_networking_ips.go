@@ -6,6 +6,7 @@ import (
 	"math"
 	"net/netip"
 	"slices"
+	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"google.golang.org/protobuf/proto"
@@ -22,6 +23,10 @@ const (
 	paramAddress      = "address"
 	paramRDNS         = "rdns"
 	paramIPs          = "ips"
+
+	// ipRegionMismatchReason ends every region-check refusal from the assign
+	// and share tools.
+	ipRegionMismatchReason = "; IP addresses can only be assigned or shared between Linodes in the same region"
 )
 
 // NewLinodeNetworkingIPListTool creates a tool for listing account IP addresses.
@@ -47,7 +52,7 @@ func handleLinodeNetworkingIPListRequest(ctx context.Context, request *mcp.CallT
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -85,7 +90,7 @@ func handleLinodeNetworkingIPGetRequest(ctx context.Context, request *mcp.CallTo
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -146,7 +151,7 @@ func handleLinodeNetworkingIPUpdateRDNSRequest(ctx context.Context, request *mcp
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -204,7 +209,7 @@ func handleLinodeNetworkingIPAllocateRequest(ctx context.Context, request *mcp.C
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -224,7 +229,8 @@ func handleLinodeNetworkingIPAllocateRequest(ctx context.Context, request *mcp.C
 func NewLinodeNetworkingIPAssignTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
 	tool := mcp.NewToolWithRawSchema(
 		"linode_networking_ip_assign",
-		"Assigns IP addresses to Linodes in a region. WARNING: This changes IP ownership assignments.",
+		"Assigns IP addresses to Linodes in a region. Every Linode and address must already be in that region; the tool "+
+			"checks before assigning. WARNING: This changes IP ownership assignments.",
 		toolschemas.Schema("linode.mcp.v1.NetworkingIPAssignInput"),
 	)
 
@@ -244,6 +250,9 @@ type networkingIPWriteSpec struct {
 	ConfirmMessage string
 	SuccessMessage string
 	FailureLabel   string
+	// Precheck, when set, runs after confirm and before the mutation. A
+	// non-empty message refuses the call with that message.
+	Precheck func(ctx context.Context, client *linode.Client) (string, error)
 }
 
 // runNetworkingIPWrite is the shared dry-run/confirm/execute flow for the
@@ -279,11 +288,22 @@ func runNetworkingIPWrite(
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
+	if spec.Precheck != nil {
+		msg, err := spec.Precheck(ctx, client)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("%s: %v", spec.FailureLabel, err)), nil
+		}
+
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+	}
+
 	// The assign and share endpoints return an opaque body; the helper performs
 	// the action and discards it, then builds the id-echo response from the
 	// already-parsed request.
@@ -303,6 +323,9 @@ func handleLinodeNetworkingIPAssignRequest(ctx context.Context, request *mcp.Cal
 		ConfirmMessage: "This assigns IP addresses to Linodes. Set confirm=true to proceed.",
 		SuccessMessage: "Networking IP assignments updated",
 		FailureLabel:   "Failed to assign networking IPs",
+		Precheck: func(ctx context.Context, client *linode.Client) (string, error) {
+			return networkingIPAssignRegionCheck(ctx, client, req)
+		},
 	}, validationMessage, func(ctx context.Context, client *linode.Client) (map[string]any, error) {
 		return client.AssignNetworkingIPs(ctx, req)
 	}, func() proto.Message {
@@ -378,7 +401,8 @@ func handleLinodeNetworkingIPv4ShareRequest(ctx context.Context, request *mcp.Ca
 func NewLinodeNetworkingIPShareTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
 	tool := mcp.NewToolWithRawSchema(
 		"linode_networking_ip_share",
-		"Shares IP addresses with a primary Linode. Set ips to a JSON string array; an empty array removes all shared IP addresses.",
+		"Shares IP addresses with a primary Linode. Set ips to a JSON string array; an empty array removes all shared IP addresses. "+
+			"Every address must be in the primary Linode's region; the tool checks before sharing.",
 		toolschemas.Schema("linode.mcp.v1.NetworkingIPShareInput"),
 	)
 
@@ -398,6 +422,9 @@ func handleLinodeNetworkingIPShareRequest(ctx context.Context, request *mcp.Call
 		ConfirmMessage: "This changes shared IP assignments. Set confirm=true to proceed.",
 		SuccessMessage: "Networking IP sharing updated",
 		FailureLabel:   "Failed to share networking IPs",
+		Precheck: func(ctx context.Context, client *linode.Client) (string, error) {
+			return networkingIPShareRegionCheck(ctx, client, req)
+		},
 	}, validationMessage, func(ctx context.Context, client *linode.Client) (map[string]any, error) {
 		return client.ShareNetworkingIPs(ctx, req)
 	}, func() proto.Message {
@@ -405,6 +432,88 @@ func handleLinodeNetworkingIPShareRequest(ctx context.Context, request *mcp.Call
 	})
 }
 
+// networkingIPAssignRegionCheck checks that every Linode and address an
+// assign names is in req.Region, so a failover plan that crosses regions is
+// refused before any address moves. A Linode named twice is fetched once.
+func networkingIPAssignRegionCheck(ctx context.Context, client *linode.Client, req linode.AssignNetworkingIPsRequest) (string, error) {
+	instances := make(map[int]*linode.Instance, len(req.Assignments))
+
+	for _, assignment := range req.Assignments {
+		instance, fetched := instances[assignment.LinodeID]
+		if !fetched {
+			var err error
+
+			instance, err = client.GetInstance(ctx, assignment.LinodeID)
+			if err != nil {
+				return "", fmt.Errorf("get Linode %d: %w", assignment.LinodeID, err)
+			}
+
+			instances[assignment.LinodeID] = instance
+		}
+
+		if instance.Region != req.Region {
+			return fmt.Sprintf("Linode %d (%s) is in %s, not %s%s",
+				instance.ID, instance.Label, instance.Region, req.Region, ipRegionMismatchReason), nil
+		}
+
+		address, err := client.GetNetworkingIP(ctx, assignment.Address)
+		if err != nil {
+			return "", fmt.Errorf("get address %s: %w", assignment.Address, err)
+		}
+
+		if address.Region != req.Region {
+			return fmt.Sprintf("address %s is in %s, not %s%s",
+				assignment.Address, address.Region, req.Region, ipRegionMismatchReason), nil
+		}
+	}
+
+	return "", nil
+}
+
+// networkingIPShareRegionCheck checks that every address a share names is in
+// the primary Linode's region.
+func networkingIPShareRegionCheck(ctx context.Context, client *linode.Client, req linode.ShareNetworkingIPsRequest) (string, error) {
+	primary, err := client.GetInstance(ctx, req.LinodeID)
+	if err != nil {
+		return "", fmt.Errorf("get Linode %d: %w", req.LinodeID, err)
+	}
+
+	for _, ip := range req.IPs {
+		region, err := sharedAddressRegion(ctx, client, ip)
+		if err != nil {
+			return "", err
+		}
+
+		if region != primary.Region {
+			return fmt.Sprintf("address %s is in %s, but Linode %d (%s) is in %s%s",
+				ip, region, primary.ID, primary.Label, primary.Region, ipRegionMismatchReason), nil
+		}
+	}
+
+	return "", nil
+}
+
+// sharedAddressRegion looks up the region of one share entry: an address, or
+// an IPv6 range written with its prefix length (e.g. "2600:3c00:e000::/64"),
+// which the range lookup takes as written.
+func sharedAddressRegion(ctx context.Context, client *linode.Client, ip string) (string, error) {
+	if strings.Contains(ip, "/") {
+		ipv6Range, err := client.GetIPv6Range(ctx, ip)
+		if err != nil {
+			return "", fmt.Errorf("get IPv6 range %s: %w", ip, err)
+		}
+
+		return ipv6Range.Region, nil
+	}
+
+	address, err := client.GetNetworkingIP(ctx, ip)
+	if err != nil {
+		return "", fmt.Errorf("get address %s: %w", ip, err)
+	}
+
+	return address.Region, nil
+}
+
 // linodeIDToInt32 narrows a Linode ID to the proto int32 field, returning 0 for
 // the rare out-of-range value so the bounded conversion never overflows.
 func linodeIDToInt32(id int) int32 {
@@ -840,6 +840,10 @@ func TestLinodeNetworkingIPAssignToolSuccess(t *testing.T) {
 	t.Parallel()
 
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveNetworkingIPRegionLookups(t, w, r, regionUSEast) {
+			return
+		}
+
 		if r.Method != http.MethodPost {
 			t.Errorf("r.Method = %v, want %v", r.Method, http.MethodPost)
 		}
@@ -961,6 +965,69 @@ func assertNetworkingIPAssignResponse(t *testing.T, text, wantMessage string) {
 	}
 }
 
+// serveNetworkingIPRegionLookups answers the GETs the assign and share tools
+// make to check regions, placing every Linode and address in region. It
+// reports whether it handled r.
+func serveNetworkingIPRegionLookups(t *testing.T, w http.ResponseWriter, r *http.Request, region string) bool {
+	t.Helper()
+
+	if r.Method != http.MethodGet {
+		return false
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/linode/instances/"):
+		_, _ = w.Write([]byte(`{"id":123,"label":"web-2","region":"` + region + `"}`))
+	case strings.HasPrefix(r.URL.Path, "/networking/ips/"):
+		_, _ = w.Write([]byte(`{"address":"` + strings.TrimPrefix(r.URL.Path, "/networking/ips/") + `","region":"` + region + `"}`))
+	default:
+		t.Errorf("unexpected region lookup %s", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+
+	return true
+}
+
+// An address outside the primary Linode's region is refused before anything
+// is shared.
+func TestLinodeNetworkingIPShareToolRefusesCrossRegion(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /linode/instances/123":
+			_, _ = w.Write([]byte(`{"id":123,"label":"web-2","region":"us-east"}`))
+		case "GET /networking/ips/" + networkingIPAddressFixture:
+			_, _ = w.Write([]byte(`{"address":"` + networkingIPAddressFixture + `","region":"us-west"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	_, _, handler := tools.NewLinodeNetworkingIPShareTool(newTestConfig(srv.URL))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		keyLinodeID: 123,
+		keyIPs:      networkingIPShareJSON,
+		keyConfirm:  true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "address " + networkingIPAddressFixture + " is in us-west, but Linode 123 (web-2) is in us-east; " +
+		"IP addresses can only be assigned or shared between Linodes in the same region"
+	if text := dryRunResultText(t, result); !result.IsError || text != want {
+		t.Errorf("result = %q, want error %q", text, want)
+	}
+}
+
 func TestLinodeNetworkingIPAssignToolApiError(t *testing.T) {
 	t.Parallel()
 
@@ -1432,6 +1499,10 @@ func TestLinodeNetworkingIPShareToolSuccess(t *testing.T) {
 	t.Parallel()
 
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveNetworkingIPRegionLookups(t, w, r, regionUSEast) {
+			return
+		}
+
 		if r.Method != http.MethodPost {
 			t.Errorf("r.Method = %v, want %v", r.Method, http.MethodPost)
 		}
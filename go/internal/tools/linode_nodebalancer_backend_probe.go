@@ -0,0 +1,222 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/backendprobe"
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// nodeBalancerBackendProbeDisabledMessage is returned while
+// nodebalancer_probe.allowed_cidrs is empty.
+const nodeBalancerBackendProbeDisabledMessage = "linode_nodebalancer_backend_probe is disabled; set " +
+	"nodebalancer_probe.allowed_cidrs in the config to the networks it may probe"
+
+// Backend probe verdicts; see NodeBalancerBackendProbeNode in the proto.
+const (
+	backendVerdictHealthy        = "healthy"
+	backendVerdictAppSide        = "app_side"
+	backendVerdictLBSide         = "lb_side"
+	backendVerdictHostSide       = "host_side"
+	backendVerdictNotAllowlisted = "not_allowlisted"
+	backendVerdictUndetermined   = "undetermined"
+)
+
+// NodeBalancer node statuses as the API reports them.
+const (
+	nodeBalancerNodeStatusUp   = "UP"
+	nodeBalancerNodeStatusDown = "DOWN"
+)
+
+// NewLinodeNodeBalancerBackendProbeTool returns
+// linode_nodebalancer_backend_probe, which runs each backend's health check
+// directly from the MCP host and compares the result with the status the
+// NodeBalancer reports, so a failing backend is pinned on the application or
+// on the NodeBalancer's path to it. It only connects to addresses inside
+// nodebalancer_probe.allowed_cidrs and is off while that list is empty.
+func NewLinodeNodeBalancerBackendProbeTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_nodebalancer_backend_probe",
+		"Checks each NodeBalancer backend directly from the MCP host, running the config's "+
+			"health check (HTTP GET of check_path, or a TCP connect), and compares the result "+
+			"with the node status the NodeBalancer reports. Each backend gets a verdict: "+
+			"healthy, app_side (fails from both sides), lb_side (passes here but the "+
+			"NodeBalancer marks it DOWN), or host_side (this host cannot reach it). Only "+
+			"addresses inside nodebalancer_probe.allowed_cidrs in the config are probed.",
+		toolschemas.Schema("linode.mcp.v1.NodeBalancerBackendProbeInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleNodeBalancerBackendProbeRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleNodeBalancerBackendProbeRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	nodeBalancerID, validationMessage := nodeBalancerIDFromTool(request)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	configID := 0
+
+	if _, exists := request.GetArguments()[nodeBalancerKeyConfigID]; exists {
+		configID, validationMessage = nodeBalancerConfigIDFromTool(request)
+		if validationMessage != "" {
+			return mcp.NewToolResultError(validationMessage), nil
+		}
+	}
+
+	allowed, err := resolveConfig(cfg).NodeBalancerProbe.ProbePrefixes()
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if len(allowed) == 0 {
+		return mcp.NewToolResultError(nodeBalancerBackendProbeDisabledMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	configs, err := client.ListNodeBalancerConfigs(ctx, nodeBalancerID, 0, 0)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list configs for NodeBalancer %d: %v", nodeBalancerID, err)), nil
+	}
+
+	if configID != 0 {
+		configs = slices.DeleteFunc(configs, func(nbConfig linode.NodeBalancerConfig) bool { return nbConfig.ID != configID })
+		if len(configs) == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("NodeBalancer %d has no config %d", nodeBalancerID, configID)), nil
+		}
+	}
+
+	response := &linodev1.NodeBalancerBackendProbeResponse{
+		NodebalancerId: linodeIDToInt32(nodeBalancerID),
+		Verdicts:       map[string]int32{},
+		Nodes:          []*linodev1.NodeBalancerBackendProbeNode{},
+	}
+
+	for _, nbConfig := range configs {
+		nodes, err := client.ListNodeBalancerConfigNodes(ctx, nodeBalancerID, nbConfig.ID, 0, 0)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list nodes for NodeBalancer %d config %d: %v", nodeBalancerID, nbConfig.ID, err)), nil
+		}
+
+		for _, node := range nodes.Data {
+			probed := probeNodeBalancerBackend(ctx, nbConfig, node, allowed)
+			response.Nodes = append(response.Nodes, probed)
+			response.Verdicts[probed.GetVerdict()]++
+		}
+	}
+
+	response.NodeCount = linodeIDToInt32(len(response.GetNodes()))
+	response.Message = nodeBalancerBackendProbeMessage(nodeBalancerID, response)
+
+	return MarshalProtoToolResponse(response)
+}
+
+// probeNodeBalancerBackend probes one backend when its address is inside the
+// allowlist and returns it with a verdict.
+func probeNodeBalancerBackend(ctx context.Context, nbConfig linode.NodeBalancerConfig, node linode.NodeBalancerConfigNode, allowed []netip.Prefix) *linodev1.NodeBalancerBackendProbeNode {
+	check := nbConfig.Check
+	if check == "" {
+		check = backendprobe.CheckNone
+	}
+
+	out := &linodev1.NodeBalancerBackendProbeNode{
+		ConfigId:   linodeIDToInt32(nbConfig.ID),
+		ConfigPort: linodeIDToInt32(nbConfig.Port),
+		NodeId:     linodeIDToInt32(node.ID),
+		Label:      node.Label,
+		Address:    node.Address,
+		LbStatus:   node.Status,
+		Check:      check,
+	}
+
+	address, err := netip.ParseAddrPort(node.Address)
+	if err != nil {
+		out.Error = fmt.Sprintf("address %q is not an ip:port", node.Address)
+		out.Verdict = backendVerdictNotAllowlisted
+
+		return out
+	}
+
+	if !slices.ContainsFunc(allowed, func(prefix netip.Prefix) bool { return prefix.Contains(address.Addr().Unmap()) }) {
+		out.Error = fmt.Sprintf("%s is outside nodebalancer_probe.allowed_cidrs", address.Addr())
+		out.Verdict = backendVerdictNotAllowlisted
+
+		return out
+	}
+
+	result := backendprobe.Probe(ctx, backendprobe.Target{
+		Address: address,
+		Check:   check,
+		Path:    nbConfig.CheckPath,
+		Body:    nbConfig.CheckBody,
+		Timeout: time.Duration(nbConfig.CheckTimeout) * time.Second,
+	})
+
+	out.Probed = true
+	out.ProbeMethod = result.Method
+	out.ProbeUrl = result.URL
+	out.ProbeOk = result.OK
+	out.StatusCode = linodeIDToInt32(result.StatusCode)
+	out.LatencyMs = result.Latency.Milliseconds()
+	out.Error = result.Err
+	out.Verdict = backendVerdict(node.Status, result.OK)
+
+	return out
+}
+
+// backendVerdict compares the NodeBalancer's view of a backend with the
+// probe's.
+func backendVerdict(lbStatus string, probeOK bool) string {
+	switch {
+	case lbStatus == nodeBalancerNodeStatusUp && probeOK:
+		return backendVerdictHealthy
+	case lbStatus == nodeBalancerNodeStatusUp:
+		return backendVerdictHostSide
+	case lbStatus == nodeBalancerNodeStatusDown && probeOK:
+		return backendVerdictLBSide
+	case lbStatus == nodeBalancerNodeStatusDown:
+		return backendVerdictAppSide
+	default:
+		return backendVerdictUndetermined
+	}
+}
+
+// nodeBalancerBackendProbeMessage summarizes the verdict counts, naming the
+// failure kinds first.
+func nodeBalancerBackendProbeMessage(nodeBalancerID int, response *linodev1.NodeBalancerBackendProbeResponse) string {
+	if response.GetNodeCount() == 0 {
+		return fmt.Sprintf("NodeBalancer %d has no backend nodes to probe", nodeBalancerID)
+	}
+
+	parts := make([]string, 0, len(response.GetVerdicts()))
+
+	for _, verdict := range []string{
+		backendVerdictAppSide, backendVerdictLBSide, backendVerdictHostSide,
+		backendVerdictNotAllowlisted, backendVerdictUndetermined, backendVerdictHealthy,
+	} {
+		if count := response.GetVerdicts()[verdict]; count > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", count, verdict))
+		}
+	}
+
+	return fmt.Sprintf("Probed NodeBalancer %d backends: %s", nodeBalancerID, strings.Join(parts, ", "))
+}
@@ -0,0 +1,145 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// Each backend's probe outcome is compared with the NodeBalancer's status:
+// a passing backend the NodeBalancer marks DOWN is lb_side, a failing one
+// app_side, an unreachable one it marks UP host_side, and an address outside
+// the allowlist is reported without being probed.
+func TestLinodeNodeBalancerBackendProbeToolVerdicts(t *testing.T) {
+	t.Parallel()
+
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		_, _ = w.Write([]byte("ok"))
+	}))
+	t.Cleanup(backend.Close)
+
+	// A listener closed right away leaves a local port nothing answers on.
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	closedAddress := closed.Addr().String()
+	_ = closed.Close()
+
+	live := strings.TrimPrefix(backend.URL, "http://")
+	nodes := fmt.Sprintf(`{"data":[
+		{"id":1,"label":"web-1","address":%q,"status":"UP"},
+		{"id":2,"label":"web-2","address":%q,"status":"DOWN"},
+		{"id":3,"label":"web-3","address":%q,"status":"UP"},
+		{"id":4,"label":"web-4","address":"192.168.255.9:80","status":"UP"}
+	],"page":1,"pages":1,"results":4}`, live, live, closedAddress)
+	appNodes := fmt.Sprintf(`{"data":[{"id":5,"label":"api-1","address":%q,"status":"DOWN"}],"page":1,"pages":1,"results":1}`, live)
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/nodebalancers/7/configs":
+			_, _ = w.Write([]byte(`{"data":[
+				{"id":10,"port":80,"check":"http","check_path":"/healthz","check_timeout":3},
+				{"id":11,"port":8080,"check":"http_body","check_path":"/healthz","check_body":"^healthy$"}
+			],"page":1,"pages":1,"results":2}`))
+		case "/nodebalancers/7/configs/10/nodes":
+			_, _ = w.Write([]byte(nodes))
+		case "/nodebalancers/7/configs/11/nodes":
+			_, _ = w.Write([]byte(appNodes))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(api.Close)
+
+	cfg := &config.Config{
+		NodeBalancerProbe: config.NodeBalancerProbeConfig{AllowedCIDRs: []string{"127.0.0.0/8"}},
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: api.URL, Token: tokenTest}},
+		},
+	}
+	_, _, handler := tools.NewLinodeNodeBalancerBackendProbeTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"nodebalancer_id": float64(7)}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want success", result.Content)
+	}
+
+	var response struct {
+		Message  string         `json:"message"`
+		Verdicts map[string]int `json:"verdicts"`
+		Nodes    []struct {
+			NodeID  int    `json:"node_id"`
+			Probed  bool   `json:"probed"`
+			Verdict string `json:"verdict"`
+			Error   string `json:"error"`
+		} `json:"nodes"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[int]string{1: "healthy", 2: "lb_side", 3: "host_side", 4: "not_allowlisted", 5: "app_side"}
+	for _, node := range response.Nodes {
+		if node.Verdict != want[node.NodeID] {
+			t.Errorf("node %d verdict = %q (%s), want %q", node.NodeID, node.Verdict, node.Error, want[node.NodeID])
+		}
+
+		if node.NodeID == 4 && node.Probed {
+			t.Error("node 4 outside the allowlist was probed")
+		}
+	}
+
+	if len(response.Nodes) != len(want) {
+		t.Errorf("got %d nodes, want %d", len(response.Nodes), len(want))
+	}
+
+	wantMessage := "Probed NodeBalancer 7 backends: 1 app_side, 1 lb_side, 1 host_side, 1 not_allowlisted, 1 healthy"
+	if response.Message != wantMessage {
+		t.Errorf("message = %q, want %q", response.Message, wantMessage)
+	}
+}
+
+// With no allowlist the tool refuses before calling the API.
+func TestLinodeNodeBalancerBackendProbeToolDisabledWithoutAllowlist(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{
+		envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: "http://127.0.0.1:1", Token: tokenTest}},
+	}}
+	_, _, handler := tools.NewLinodeNodeBalancerBackendProbeTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"nodebalancer_id": float64(7)}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !result.IsError || !ok || !strings.Contains(text.Text, "nodebalancer_probe.allowed_cidrs") {
+		t.Errorf("result = %v, want the disabled error", result.Content)
+	}
+}
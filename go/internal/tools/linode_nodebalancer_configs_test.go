@@ -913,6 +913,10 @@ func TestLinodeNodeBalancerConfigCreateToolValidation(t *testing.T) {
 		{name: "invalid check", args: map[string]any{keyNodeBalancerID: float64(123), keyPort: float64(80), keyCheck: "ping", keyConfirm: true}, wantContains: "check must be one of"},
 		{name: "invalid cipher suite", args: map[string]any{keyNodeBalancerID: float64(123), keyPort: float64(80), keyCipherSuite: "custom", keyConfirm: true}, wantContains: "cipher_suite must be one of"},
 		{name: "missing https tls", args: map[string]any{keyNodeBalancerID: float64(123), keyPort: float64(443), keyProtocol: protocolHTTPS, keyConfirm: true}, wantContains: "ssl_cert and ssl_key are required"},
+		{name: "ring_hash on default http", args: map[string]any{keyNodeBalancerID: float64(123), keyPort: float64(80), keyAlgorithm: "ring_hash", keyConfirm: true}, wantContains: "algorithm ring_hash requires protocol udp"},
+		{name: "table stickiness with udp", args: map[string]any{keyNodeBalancerID: float64(123), keyPort: float64(80), keyProtocol: "udp", keyStickiness: "table", keyConfirm: true}, wantContains: "stickiness table is not supported with protocol udp"},
+		{name: "proxy protocol with http", args: map[string]any{keyNodeBalancerID: float64(123), keyPort: float64(80), keyProtocol: protocolHTTP, keyProxyProtocol: valueProxyV2, keyConfirm: true}, wantContains: "proxy_protocol v2 requires protocol tcp"},
+		{name: "udp check port with tcp", args: map[string]any{keyNodeBalancerID: float64(123), keyPort: float64(80), keyProtocol: "tcp", keyUDPCheckPort: float64(8080), keyConfirm: true}, wantContains: "udp_check_port requires protocol udp"},
 		{name: "invalid check interval", args: map[string]any{keyNodeBalancerID: float64(123), keyPort: float64(80), keyCheckInterval: "ten", keyConfirm: true}, wantContains: "check_interval must be an integer"},
 		{name: "negative check timeout", args: map[string]any{keyNodeBalancerID: float64(123), keyPort: float64(80), keyCheckTimeout: float64(-1), keyConfirm: true}, wantContains: "check_timeout must be an integer greater than or equal to 1"},
 		{name: "invalid check attempts", args: map[string]any{keyNodeBalancerID: float64(123), keyPort: float64(80), keyCheckAttempts: "three", keyConfirm: true}, wantContains: "check_attempts must be an integer"},
@@ -975,8 +979,7 @@ func TestLinodeNodeBalancerConfigCreateToolSuccess(t *testing.T) {
 			keyPort:          float64(80),
 			keyCheckInterval: float64(10),
 			keyCheckPath:     tcHealth,
-			keyProxyProtocol: valueProxyV2,
-			keyUDPCheckPort:  float64(8080),
+			keyProxyProtocol: valueNone,
 		} {
 			if !reflect.DeepEqual(body[key], want) {
 				t.Errorf("body[%v] = %v, want %v", key, body[key], want)
@@ -998,7 +1001,7 @@ func TestLinodeNodeBalancerConfigCreateToolSuccess(t *testing.T) {
 	}
 	_, _, srvHandler := tools.NewLinodeNodeBalancerConfigCreateTool(srvCfg)
 
-	result, err := srvHandler(t.Context(), createRequestWithArgs(t, map[string]any{keyNodeBalancerID: float64(123), keyPort: float64(80), keyProtocol: protocolHTTP, keyAlgorithm: valueRoundRobin, keyStickiness: valueNone, keyCheck: protocolHTTP, keyCheckInterval: float64(10), keyCheckTimeout: float64(5), keyCheckAttempts: float64(3), keyCheckPath: tcHealth, keyCheckBody: statusOK, keyCheckPassive: true, keyCipherSuite: valueRecommended, keyProxyProtocol: valueProxyV2, keyUDPCheckPort: float64(8080), keyConfirm: true}))
+	result, err := srvHandler(t.Context(), createRequestWithArgs(t, map[string]any{keyNodeBalancerID: float64(123), keyPort: float64(80), keyProtocol: protocolHTTP, keyAlgorithm: valueRoundRobin, keyStickiness: valueNone, keyCheck: protocolHTTP, keyCheckInterval: float64(10), keyCheckTimeout: float64(5), keyCheckAttempts: float64(3), keyCheckPath: tcHealth, keyCheckBody: statusOK, keyCheckPassive: true, keyCipherSuite: valueRecommended, keyProxyProtocol: valueNone, keyConfirm: true}))
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
@@ -1234,7 +1237,7 @@ func TestLinodeNodeBalancerConfigCreateToolClientError(t *testing.T) {
 	}
 	_, _, srvHandler := tools.NewLinodeNodeBalancerConfigCreateTool(srvCfg)
 
-	result, err := srvHandler(t.Context(), createRequestWithArgs(t, map[string]any{keyNodeBalancerID: float64(123), keyPort: float64(80), keyProtocol: protocolHTTP, keyAlgorithm: valueRoundRobin, keyStickiness: valueNone, keyCheck: protocolHTTP, keyCheckInterval: float64(10), keyCheckTimeout: float64(5), keyCheckAttempts: float64(3), keyCheckPath: tcHealth, keyCheckBody: statusOK, keyCheckPassive: true, keyCipherSuite: valueRecommended, keyProxyProtocol: valueProxyV2, keyUDPCheckPort: float64(8080), keyConfirm: true}))
+	result, err := srvHandler(t.Context(), createRequestWithArgs(t, map[string]any{keyNodeBalancerID: float64(123), keyPort: float64(80), keyProtocol: protocolHTTP, keyAlgorithm: valueRoundRobin, keyStickiness: valueNone, keyCheck: protocolHTTP, keyCheckInterval: float64(10), keyCheckTimeout: float64(5), keyCheckAttempts: float64(3), keyCheckPath: tcHealth, keyCheckBody: statusOK, keyCheckPassive: true, keyCipherSuite: valueRecommended, keyProxyProtocol: valueNone, keyConfirm: true}))
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
@@ -2813,6 +2816,7 @@ func TestLinodeNodeBalancerConfigUpdateToolValidation(t *testing.T) {
 		{name: "negative check timeout", args: map[string]any{keyNodeBalancerID: float64(123), keyConfigID: float64(456), keyPort: float64(443), keyCheckTimeout: float64(-1), keyConfirm: true}, wantContains: "check_timeout must be an integer greater than or equal to 1"},
 		{name: "invalid check attempts", args: map[string]any{keyNodeBalancerID: float64(123), keyConfigID: float64(456), keyPort: float64(443), keyCheckAttempts: "three", keyConfirm: true}, wantContains: "check_attempts must be an integer"},
 		{name: "missing https tls", args: map[string]any{keyNodeBalancerID: float64(123), keyConfigID: float64(456), keyPort: float64(443), keyProtocol: protocolHTTPS, keyConfirm: true}, wantContains: "ssl_cert and ssl_key are required"},
+		{name: "session stickiness with tcp", args: map[string]any{keyNodeBalancerID: float64(123), keyConfigID: float64(456), keyProtocol: "tcp", keyStickiness: "session", keyConfirm: true}, wantContains: "stickiness session requires protocol udp"},
 		{name: "invalid check passive", args: map[string]any{keyNodeBalancerID: float64(123), keyConfigID: float64(456), keyPort: float64(443), keyCheckPassive: boolStringTrue, keyConfirm: true}, wantContains: "check_passive must be a boolean"},
 	}
 	for _, tt := range validationTests {
@@ -40,7 +40,7 @@ func handleLinodeNodeBalancerConfigDeleteRequest(ctx context.Context, request *m
 	}
 
 	if IsDryRun(request) {
-		client, err := prepareClient(request, cfg)
+		client, err := prepareClient(ctx, request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
@@ -66,7 +66,7 @@ func handleLinodeNodeBalancerConfigDeleteRequest(ctx context.Context, request *m
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
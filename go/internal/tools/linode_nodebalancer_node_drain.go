@@ -0,0 +1,287 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/nodedrain"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+const (
+	nodeDrainDefaultMode    = "drain"
+	nodeDrainDefaultRestore = "accept"
+	nodeDrainMaxMinutes     = 1440
+	nodeDrainModeReject     = "reject"
+)
+
+type nodeDrainsCtxKey struct{}
+
+// WithNodeDrains attaches the server's drain store to a context so
+// linode_nodebalancer_node_drain can record the mode it replaced and
+// linode_nodebalancer_node_undrain can put it back. The server middleware
+// sets it on every call.
+func WithNodeDrains(ctx context.Context, store *nodedrain.Store) context.Context {
+	return context.WithValue(ctx, nodeDrainsCtxKey{}, store)
+}
+
+// nodeDrainsFromContext returns the drain store the server attached, or nil.
+func nodeDrainsFromContext(ctx context.Context) *nodedrain.Store {
+	store, _ := ctx.Value(nodeDrainsCtxKey{}).(*nodedrain.Store)
+
+	return store
+}
+
+// NewLinodeNodeBalancerNodeDrainTool creates a tool that takes a NodeBalancer
+// backend node out of rotation for maintenance, remembering its mode.
+func NewLinodeNodeBalancerNodeDrainTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_nodebalancer_node_drain",
+		"Takes a NodeBalancer backend node out of rotation for maintenance: sets mode drain (existing connections "+
+			"finish, no new ones) or reject, and remembers the mode it had so linode_nodebalancer_node_undrain can "+
+			"put it back. With duration_minutes the node is restored automatically; the timer lives in server "+
+			"memory and a restart cancels it. WARNING: The node stops receiving new traffic.",
+		toolschemas.Schema("linode.mcp.v1.NodeBalancerNodeDrainInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeNodeBalancerNodeDrainRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// NewLinodeNodeBalancerNodeUndrainTool creates a tool that puts a drained
+// NodeBalancer backend node back into rotation.
+func NewLinodeNodeBalancerNodeUndrainTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_nodebalancer_node_undrain",
+		"Puts a NodeBalancer backend node back into rotation after linode_nodebalancer_node_drain: restores the "+
+			"mode it had before the drain, or mode when given (accept when this server did not record the drain), "+
+			"and cancels any pending timed restore.",
+		toolschemas.Schema("linode.mcp.v1.NodeBalancerNodeUndrainInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeNodeBalancerNodeUndrainRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// nodeDrainKeyFromTool reads the nodebalancer_id, config_id, and node_id a
+// drain or undrain targets, under the caller's store partition.
+func nodeDrainKeyFromTool(ctx context.Context, request *mcp.CallToolRequest) (nodedrain.Key, string) {
+	partition, err := storePartition(ctx)
+	if err != nil {
+		return nodedrain.Key{}, err.Error()
+	}
+
+	nodeBalancerID, msg := nodeBalancerIDFromTool(request)
+	if msg != "" {
+		return nodedrain.Key{}, msg
+	}
+
+	configID, msg := nodeBalancerConfigIDFromTool(request)
+	if msg != "" {
+		return nodedrain.Key{}, msg
+	}
+
+	nodeID, msg := nodeBalancerConfigNodeIDFromTool(request)
+	if msg != "" {
+		return nodedrain.Key{}, msg
+	}
+
+	return nodedrain.Key{Partition: partition, NodeBalancerID: nodeBalancerID, ConfigID: configID, NodeID: nodeID}, ""
+}
+
+func nodeDrainPath(key nodedrain.Key) string {
+	return fmt.Sprintf("/nodebalancers/%d/configs/%d/nodes/%d", key.NodeBalancerID, key.ConfigID, key.NodeID)
+}
+
+func nodeDrainDryRun(request *mcp.CallToolRequest, toolName string, key nodedrain.Key, mode string) (*mcp.CallToolResult, error) {
+	return BuildDryRunResponse(
+		toolName,
+		request.GetString(paramEnvironment, ""),
+		"PUT",
+		nodeDrainPath(key),
+		map[string]any{nodeBalancerKeyID: key.NodeBalancerID, nodeBalancerKeyConfigID: key.ConfigID, nodeBalancerKeyNodeID: key.NodeID},
+		map[string]any{"mode": mode},
+	)
+}
+
+func handleLinodeNodeBalancerNodeDrainRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	key, msg := nodeDrainKeyFromTool(ctx, request)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	mode, msg := optionalEnumChoice(request, "mode", linodev1.NodeBalancerNodeDrainMode_Value_value)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	if mode == "" {
+		mode = nodeDrainDefaultMode
+	}
+
+	minutes, msg := optionalPaginationInt(request.GetArguments(), "duration_minutes", 1, nodeDrainMaxMinutes)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	if IsDryRun(request) {
+		return nodeDrainDryRun(request, "linode_nodebalancer_node_drain", key, mode)
+	}
+
+	if result := RequireConfirm(request, "This takes a NodeBalancer node out of rotation. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	store := nodeDrainsFromContext(ctx)
+	if store == nil && minutes > 0 {
+		return mcp.NewToolResultError("duration_minutes needs the server's drain store, which this call does not have"), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	current, err := client.GetNodeBalancerConfigNodeProto(ctx, key.NodeBalancerID, key.ConfigID, key.NodeID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve node %d for NodeBalancer %d config %d: %v",
+			key.NodeID, key.NodeBalancerID, key.ConfigID, err)), nil
+	}
+
+	response := &linodev1.NodeBalancerNodeDrainResponse{
+		PreviousMode: current.GetMode(),
+		RestoreMode:  current.GetMode(),
+		Warnings:     []string{},
+	}
+
+	if _, recorded := nodeDrainRecord(store, key); !recorded && (response.GetPreviousMode() == nodeDrainDefaultMode || response.GetPreviousMode() == nodeDrainModeReject) {
+		response.RestoreMode = nodeDrainDefaultRestore
+		response.Warnings = append(response.Warnings, fmt.Sprintf(
+			"node %d was already in %s mode and this server has no record of draining it, so undrain restores %s unless given a mode",
+			key.NodeID, response.GetPreviousMode(), nodeDrainDefaultRestore))
+	}
+
+	node, msg := updateNodeBalancerNode(ctx, client, key.NodeBalancerID, key.ConfigID, key.NodeID, &linode.UpdateNodeBalancerNodeRequest{Mode: mode})
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	response.Node = node
+
+	if store != nil {
+		drain := store.Put(nodedrain.Drain{Key: key, Mode: mode, RestoreMode: response.GetRestoreMode()},
+			time.Duration(minutes)*time.Minute, nodeDrainRestorer(client))
+		response.RestoreMode = drain.RestoreMode
+
+		if !drain.RestoreAt.IsZero() {
+			response.RestoreAt = new(drain.RestoreAt.Format(time.RFC3339))
+		}
+	}
+
+	response.Message = fmt.Sprintf("NodeBalancer node %d is now in %s mode; linode_nodebalancer_node_undrain restores %s",
+		key.NodeID, mode, response.GetRestoreMode())
+	if response.RestoreAt != nil {
+		response.Message = fmt.Sprintf("%s, and it is restored automatically at %s unless the server restarts first",
+			response.GetMessage(), response.GetRestoreAt())
+	}
+
+	return MarshalProtoToolResponse(response)
+}
+
+func handleLinodeNodeBalancerNodeUndrainRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	key, msg := nodeDrainKeyFromTool(ctx, request)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	mode, msg := optionalEnumChoice(request, "mode", linodev1.NodeBalancerNodeRestoreMode_Value_value)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	store := nodeDrainsFromContext(ctx)
+	drain, recorded := nodeDrainRecord(store, key)
+
+	if mode == "" {
+		mode = nodeDrainDefaultRestore
+		if recorded {
+			mode = drain.RestoreMode
+		}
+	}
+
+	if IsDryRun(request) {
+		return nodeDrainDryRun(request, "linode_nodebalancer_node_undrain", key, mode)
+	}
+
+	if result := RequireConfirm(request, "This puts a NodeBalancer node back into rotation. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	node, msg := updateNodeBalancerNode(ctx, client, key.NodeBalancerID, key.ConfigID, key.NodeID, &linode.UpdateNodeBalancerNodeRequest{Mode: mode})
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	response := &linodev1.NodeBalancerNodeUndrainResponse{
+		Message:       fmt.Sprintf("NodeBalancer node %d is back in %s mode", key.NodeID, mode),
+		Node:          node,
+		RestoredMode:  mode,
+		RecordedDrain: recorded,
+		Warnings:      []string{},
+	}
+
+	if recorded {
+		store.Take(key)
+
+		if !drain.RestoreAt.IsZero() {
+			response.CancelledRestoreAt = new(drain.RestoreAt.Format(time.RFC3339))
+		}
+
+		if drain.RestoreError != "" {
+			response.Warnings = append(response.Warnings, "the timed restore had failed: "+drain.RestoreError)
+		}
+	}
+
+	return MarshalProtoToolResponse(response)
+}
+
+// nodeDrainRecord returns the drain the store holds for key, tolerating a
+// call that has no store.
+func nodeDrainRecord(store *nodedrain.Store, key nodedrain.Key) (nodedrain.Drain, bool) {
+	if store == nil {
+		return nodedrain.Drain{}, false
+	}
+
+	return store.Get(key)
+}
+
+// nodeDrainRestorer returns the timed restore for drains made through client.
+func nodeDrainRestorer(client *linode.Client) nodedrain.RestoreFunc {
+	return func(ctx context.Context, drain nodedrain.Drain) error {
+		_, err := client.UpdateNodeBalancerNodeProto(ctx, drain.NodeBalancerID, drain.ConfigID, drain.NodeID,
+			&linode.UpdateNodeBalancerNodeRequest{Mode: drain.RestoreMode})
+		if err != nil {
+			return fmt.Errorf("restore node %d to %s: %w", drain.NodeID, drain.RestoreMode, err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,100 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/nodedrain"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeNodeBalancerNodeDrainThenUndrainRestoresMode(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu   sync.Mutex
+		mode = "backup"
+		puts []string
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/nodebalancers/1/configs/2/nodes/3" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			puts = append(puts, string(body))
+
+			var update struct {
+				Mode string `json:"mode"`
+			}
+			if err := json.Unmarshal(body, &update); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			mode = update.Mode
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":3,"address":"192.0.2.10:80","label":"web-1","mode":"` + mode + `","nodebalancer_id":1,"config_id":2}`))
+	}))
+	defer srv.Close()
+
+	ctx := tools.WithNodeDrains(t.Context(), nodedrain.NewStore())
+	args := map[string]any{"nodebalancer_id": 1, "config_id": 2, "node_id": 3, "confirm": true}
+
+	_, _, drain := tools.NewLinodeNodeBalancerNodeDrainTool(newTestConfig(srv.URL))
+
+	result, err := drain(ctx, createRequestWithArgs(t, args))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if text, ok := result.Content[0].(mcp.TextContent); result.IsError || !ok {
+		t.Fatalf("drain result = %v", result.Content)
+	} else {
+		var drained struct {
+			PreviousMode string `json:"previous_mode"`
+			RestoreMode  string `json:"restore_mode"`
+		}
+		if err := json.Unmarshal([]byte(text.Text), &drained); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if drained.PreviousMode != "backup" || drained.RestoreMode != "backup" {
+			t.Errorf("drained = %+v, want backup recorded for restore", drained)
+		}
+	}
+
+	_, _, undrain := tools.NewLinodeNodeBalancerNodeUndrainTool(newTestConfig(srv.URL))
+
+	result, err = undrain(ctx, createRequestWithArgs(t, args))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("undrain result = %v", result.Content)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	want := []string{`{"mode":"drain"}`, `{"mode":"backup"}`}
+	if len(puts) != len(want) || puts[0] != want[0] || puts[1] != want[1] {
+		t.Errorf("PUT bodies = %q, want %q", puts, want)
+	}
+}
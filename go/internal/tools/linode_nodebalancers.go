@@ -31,11 +31,15 @@ const (
 	nodeBalancerConfigKeyUDPCheckPort  = "udp_check_port"
 	nodeBalancerConfigKeyNodes         = "nodes"
 	nodeBalancerNodeKeySubnetID        = "subnet_id"
-	// nodeBalancerConfigProtocolHTTPS gates the ssl_cert/ssl_key requirement; the
-	// protocol/algorithm/stickiness/check/cipher_suite choice sets now come from
-	// the generated proto enums (linodev1.NodeBalancer*_Value_value), not
-	// hand-maintained constants.
+	// The protocol names gate the ssl_cert/ssl_key requirement and the
+	// protocol-dependent combinations in nodeBalancerConfigComboMessage; the
+	// protocol/algorithm/stickiness/check/cipher_suite choice sets themselves
+	// come from the generated proto enums (linodev1.NodeBalancer*_Value_value),
+	// not hand-maintained constants.
+	nodeBalancerConfigProtocolHTTP     = "http"
 	nodeBalancerConfigProtocolHTTPS    = "https"
+	nodeBalancerConfigProtocolTCP      = "tcp"
+	nodeBalancerConfigProtocolUDP      = "udp"
 	nodeBalancerConfigNodesPageSizeMin = 25
 	nodeBalancerConfigNodesPageSizeMax = 500
 	nodeBalancerKeyID                  = "nodebalancer_id"
@@ -450,7 +454,7 @@ func handleLinodeNodeBalancerFirewallUpdateRequest(ctx context.Context, request
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -502,7 +506,7 @@ func handleLinodeNodeBalancerConfigRebuildRequest(ctx context.Context, request *
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -531,7 +535,7 @@ func handleLinodeNodeBalancerVPCConfigGetRequest(ctx context.Context, request *m
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -555,7 +559,7 @@ func handleLinodeNodeBalancerConfigGetRequest(ctx context.Context, request *mcp.
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -584,7 +588,7 @@ func handleLinodeNodeBalancerConfigNodeGetRequest(ctx context.Context, request *
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -632,7 +636,7 @@ func handleLinodeNodeBalancerNodeUpdateRequest(ctx context.Context, request *mcp
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -678,7 +682,7 @@ func handleLinodeNodeBalancerConfigUpdateRequest(ctx context.Context, request *m
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -710,7 +714,7 @@ func listNodeBalancerConfigs(ctx context.Context, client *linode.Client, nodeBal
 }
 
 func handleLinodeNodeBalancerConfigUpdateDryRun(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config, nodeBalancerID, configID int) (*mcp.CallToolResult, error) {
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -776,7 +780,7 @@ func handleLinodeNodeBalancerConfigCreateRequest(ctx context.Context, request *m
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -799,7 +803,7 @@ func handleLinodeNodeBalancerConfigCreateRequest(ctx context.Context, request *m
 }
 
 func handleLinodeNodeBalancerConfigCreateDryRun(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config, nodeBalancerID int) (*mcp.CallToolResult, error) {
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -899,6 +903,15 @@ func nodeBalancerConfigCreateRequestFromTool(request *mcp.CallToolRequest) (lino
 		return linode.CreateNodeBalancerConfigRequest{}, message
 	}
 
+	protocol := req.Protocol
+	if protocol == "" {
+		protocol = nodeBalancerConfigProtocolHTTP
+	}
+
+	if message = nodeBalancerConfigComboMessage(protocol, req.Algorithm, req.Stickiness, req.ProxyProtocol, req.UDPCheckPort); message != "" {
+		return linode.CreateNodeBalancerConfigRequest{}, message
+	}
+
 	if raw, exists := args[nodeBalancerConfigKeyNodes]; exists {
 		nodes, nodesMessage := objectSliceFromToolArg[linode.CreateNodeBalancerNodeRequest](raw, nodeBalancerConfigKeyNodes)
 		if nodesMessage != "" {
@@ -983,6 +996,14 @@ func nodeBalancerConfigUpdateRequestFromTool(request *mcp.CallToolRequest) (lino
 		return linode.UpdateNodeBalancerConfigRequest{}, message
 	}
 
+	// Without a protocol in the call the config keeps its current one, which
+	// only the API knows, so the combinations are checked there instead.
+	if req.Protocol != "" {
+		if message = nodeBalancerConfigComboMessage(req.Protocol, req.Algorithm, req.Stickiness, req.ProxyProtocol, req.UDPCheckPort); message != "" {
+			return linode.UpdateNodeBalancerConfigRequest{}, message
+		}
+	}
+
 	if req == (linode.UpdateNodeBalancerConfigRequest{}) {
 		return linode.UpdateNodeBalancerConfigRequest{}, "at least one update field is required"
 	}
@@ -990,6 +1011,36 @@ func nodeBalancerConfigUpdateRequestFromTool(request *mcp.CallToolRequest) (lino
 	return req, ""
 }
 
+// nodeBalancerConfigComboMessage rejects choices the protocol does not
+// accept, which the API otherwise reports as a bare field error. UDP configs
+// balance with roundrobin, leastconn, or ring_hash and stick by session or
+// source_ip; TCP, HTTP, and HTTPS configs balance with roundrobin, leastconn,
+// or source and stick by table, or by http_cookie over HTTP(S). Only TCP
+// speaks the PROXY protocol, and only UDP has a udp_check_port. Empty
+// arguments and "none" fit every protocol.
+func nodeBalancerConfigComboMessage(protocol, algorithm, stickiness, proxyProtocol string, udpCheckPort int) string {
+	isUDP := protocol == nodeBalancerConfigProtocolUDP
+
+	switch {
+	case isUDP && algorithm == "source":
+		return "algorithm source is not supported with protocol udp (use roundrobin, leastconn, or ring_hash)"
+	case !isUDP && algorithm == "ring_hash":
+		return fmt.Sprintf("algorithm ring_hash requires protocol udp (protocol is %s)", protocol)
+	case isUDP && (stickiness == "table" || stickiness == "http_cookie"):
+		return fmt.Sprintf("stickiness %s is not supported with protocol udp (use none, session, or source_ip)", stickiness)
+	case !isUDP && (stickiness == "session" || stickiness == "source_ip"):
+		return fmt.Sprintf("stickiness %s requires protocol udp (protocol is %s)", stickiness, protocol)
+	case protocol == nodeBalancerConfigProtocolTCP && stickiness == "http_cookie":
+		return "stickiness http_cookie requires protocol http or https (protocol is tcp)"
+	case protocol != nodeBalancerConfigProtocolTCP && proxyProtocol != "" && proxyProtocol != "none":
+		return fmt.Sprintf("proxy_protocol %s requires protocol tcp (protocol is %s)", proxyProtocol, protocol)
+	case !isUDP && udpCheckPort != 0:
+		return fmt.Sprintf("udp_check_port requires protocol udp (protocol is %s)", protocol)
+	}
+
+	return ""
+}
+
 func optionalNodeBalancerConfigInt(args map[string]any, key string) (int, string) {
 	if _, exists := args[key]; !exists {
 		return 0, ""
@@ -1060,7 +1111,7 @@ func handleLinodeNodeBalancerNodeCreateRequest(ctx context.Context, request *mcp
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -1099,7 +1150,7 @@ func handleLinodeNodeBalancerNodeDeleteRequest(ctx context.Context, request *mcp
 	}
 
 	if IsDryRun(request) {
-		client, err := prepareClient(request, cfg)
+		client, err := prepareClient(ctx, request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
@@ -1122,7 +1173,7 @@ func handleLinodeNodeBalancerNodeDeleteRequest(ctx context.Context, request *mcp
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -1277,7 +1328,7 @@ func handleLinodeNodeBalancerStatsGetRequest(ctx context.Context, request *mcp.C
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -1296,7 +1347,7 @@ func handleLinodeNodeBalancerGetRequest(ctx context.Context, request *mcp.CallTo
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -53,7 +53,7 @@ func handleLinodeNodeBalancerCreateRequest(ctx context.Context, request *mcp.Cal
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -107,7 +107,7 @@ func nodeBalancerCreateRequestFromTool(request *mcp.CallToolRequest) (linode.Cre
 func NewLinodeNodeBalancerUpdateTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
 	tool := mcp.NewToolWithRawSchema(
 		"linode_nodebalancer_update",
-		"Updates an existing NodeBalancer. Can modify label and connection throttle.",
+		"Updates an existing NodeBalancer. Can modify label, connection throttle, and tags.",
 		toolschemas.Schema("linode.mcp.v1.NodeBalancerUpdateInput"),
 	)
 
@@ -126,6 +126,11 @@ func handleLinodeNodeBalancerUpdateRequest(ctx context.Context, request *mcp.Cal
 	label := request.GetString("label", "")
 	clientConnThrottle := request.GetInt("client_conn_throttle", notProvided)
 
+	tags, hasTags, validationMessage := optionalTagsField(request.GetArguments())
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
 	if IsDryRun(request) {
 		if nodeBalancerID == 0 {
 			return mcp.NewToolResultError("nodebalancer_id is required"), nil
@@ -137,7 +142,7 @@ func handleLinodeNodeBalancerUpdateRequest(ctx context.Context, request *mcp.Cal
 				return c.GetNodeBalancer(ctx, nodeBalancerID)
 			},
 			func(ctx context.Context, _ *linode.Client, state any) (DryRunDetails, error) {
-				return nodebalancerUpdateSideEffects(ctx, state, label, clientConnThrottle)
+				return nodebalancerUpdateSideEffects(ctx, state, label, clientConnThrottle, hasTags)
 			})
 	}
 
@@ -149,7 +154,7 @@ func handleLinodeNodeBalancerUpdateRequest(ctx context.Context, request *mcp.Cal
 		return mcp.NewToolResultError("nodebalancer_id is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -162,6 +167,10 @@ func handleLinodeNodeBalancerUpdateRequest(ctx context.Context, request *mcp.Cal
 		req.ClientConnThrottle = &clientConnThrottle
 	}
 
+	if hasTags {
+		req.Tags = tags
+	}
+
 	nodeBalancer, err := client.UpdateNodeBalancerProto(ctx, nodeBalancerID, req)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Failed to modify NodeBalancer %d: %v", nodeBalancerID, err)), nil
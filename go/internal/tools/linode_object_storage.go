@@ -68,7 +68,7 @@ func handleObjectStorageBucketsListByRegionRequest(ctx context.Context, request
 		return mcp.NewToolResultError("region must be a valid region or cluster ID"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -164,7 +164,7 @@ func handleObjectStorageBucketGetRequest(ctx context.Context, request *mcp.CallT
 		return mcp.NewToolResultError("label must be a valid bucket label"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -200,6 +200,8 @@ func handleObjectStorageBucketContentsRequest(ctx context.Context, request *mcp.
 	marker := request.GetString("marker", "")
 	pageSize := request.GetString("page_size", "")
 
+	format, formatMsg := optionalEnumChoice(request, "format", linodev1.ObjectStorageListingFormat_Value_value)
+
 	if region == "" {
 		return mcp.NewToolResultError("region is required"), nil
 	}
@@ -216,7 +218,11 @@ func handleObjectStorageBucketContentsRequest(ctx context.Context, request *mcp.
 		return mcp.NewToolResultError("label must be a valid bucket label"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	if formatMsg != "" {
+		return mcp.NewToolResultError(formatMsg), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -243,10 +249,13 @@ func handleObjectStorageBucketContentsRequest(ctx context.Context, request *mcp.
 		return mcp.NewToolResultError(fmt.Sprintf("Failed to list contents of bucket '%s' in region '%s': %v", label, region, err)), nil
 	}
 
-	return formatBucketContentsResponse(page, prefix, delimiter)
+	return formatBucketContentsResponse(page, prefix, delimiter, format)
 }
 
-func formatBucketContentsResponse(page *linode.ObjectStorageBucketContentsPage, prefix, delimiter string) (*mcp.CallToolResult, error) {
+// formatBucketContentsResponse builds the listing envelope. With format=csv
+// the page goes in the csv field instead of objects, so a large page reads as
+// one table rather than hundreds of JSON objects.
+func formatBucketContentsResponse(page *linode.ObjectStorageBucketContentsPage, prefix, delimiter, format string) (*mcp.CallToolResult, error) {
 	var count int32
 	if n := len(page.Objects); n <= math.MaxInt32 {
 		count = int32(n)
@@ -276,28 +285,39 @@ func formatBucketContentsResponse(page *linode.ObjectStorageBucketContentsPage,
 		response.Filter = &filter
 	}
 
+	if format == listingFormatCSV {
+		table, err := objectListingCSV(page.Objects)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Csv = &table
+		response.Objects = nil
+	}
+
 	return MarshalProtoToolResponse(response)
 }
 
 // NewLinodeObjectStorageEndpointListTool creates a tool for listing Object Storage endpoints.
 func NewLinodeObjectStorageEndpointListTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
-	_, handler := newProtoListTool(
+	tool, handler := newProtoListToolRawSchema(
 		cfg,
 		"linode_object_storage_endpoint_list",
-		"Lists Object Storage endpoints across regions",
+		"Lists Object Storage endpoints across regions with each endpoint's type (E0 through E3) and S3 hostname. "+
+			"The regions listed are the valid bucket regions. Can filter by region or endpoint_type.",
+		"linode.mcp.v1.ObjectStorageEndpointListInput",
 		func(ctx context.Context, client *linode.Client) ([]*linodev1.ObjectStorageEndpoint, error) {
 			return client.ListObjectStorageEndpointsProto(ctx)
 		},
-		nil,
+		[]listFilterParam[*linodev1.ObjectStorageEndpoint]{
+			fieldFilter("region", "Filter endpoints by region (exact match, case-insensitive)",
+				func(e *linodev1.ObjectStorageEndpoint) string { return e.GetRegion() }),
+			fieldFilter("endpoint_type", "Filter endpoints by endpoint type: E0, E1, E2, or E3 (exact match, case-insensitive)",
+				func(e *linodev1.ObjectStorageEndpoint) string { return e.GetEndpointType() }),
+		},
 		objectStorageEndpointListResponse,
 	)
 
-	tool := mcp.NewToolWithRawSchema(
-		"linode_object_storage_endpoint_list",
-		"Lists Object Storage endpoints across regions",
-		toolschemas.Schema("linode.mcp.v1.ObjectStorageEndpointListInput"),
-	)
-
 	return tool, profiles.CapRead, handler
 }
 
@@ -404,7 +424,7 @@ func handleObjectStorageKeyGetRequest(ctx context.Context, request *mcp.CallTool
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -443,7 +463,7 @@ func handleObjectStorageQuotaUsageRequest(ctx context.Context, request *mcp.Call
 		return mcp.NewToolResultError("obj_quota_id must not contain path separators, query separators, traversal segments, or unsupported characters"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -472,7 +492,7 @@ func NewLinodeObjectStorageTransferTool(cfg *config.Config) (mcp.Tool, profiles.
 }
 
 func handleObjectStorageTransferRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -510,7 +530,7 @@ func handleObjectStorageQuotaGetRequest(ctx context.Context, request *mcp.CallTo
 		return mcp.NewToolResultError("obj_quota_id must not contain path separators, query separators, fragments, or traversal segments"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -550,7 +570,7 @@ func handleObjectStorageBucketAccessGetRequest(ctx context.Context, request *mcp
 		return mcp.NewToolResultError("label is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -620,7 +640,7 @@ func handleObjectStoragePresignedURLRequest(ctx context.Context, request *mcp.Ca
 		}
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -671,7 +691,7 @@ func handleObjectStorageObjectACLGetRequest(ctx context.Context, request *mcp.Ca
 		return mcp.NewToolResultError(ErrObjectNameRequired.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -711,7 +731,7 @@ func handleObjectStorageSSLGetRequest(ctx context.Context, request *mcp.CallTool
 		return mcp.NewToolResultError("label is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -0,0 +1,190 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// cicdKeyLabelPrefix starts the label of every key
+// linode_object_storage_cicd_credentials creates, after any label_namespace;
+// cicdKeyExpiryTag and the UTC expiry date end it.
+const (
+	cicdKeyLabelPrefix = "ci-"
+	cicdKeyExpiryTag   = " expires="
+	cicdKeyMaxDays     = 365
+)
+
+// cicdCredentialsArgs is the validated linode_object_storage_cicd_credentials
+// input.
+type cicdCredentialsArgs struct {
+	bucket      string
+	region      string
+	permissions string
+	days        int
+	name        string
+}
+
+// NewLinodeObjectStorageCICDCredentialsTool creates a tool that vends a
+// least-privilege access key for a CI pipeline.
+func NewLinodeObjectStorageCICDCredentialsTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_object_storage_cicd_credentials",
+		"Creates a limited Object Storage access key for a CI pipeline in one call: the key can reach only bucket in region, "+
+			"with read_only or read_write permissions, and is labeled \"ci-<name> expires=<date>\" (after the environment's "+
+			"label_namespace) with the UTC date expires_in_days from now. Linode does not expire keys, so revoke it with "+
+			"linode_object_storage_key_delete once that date passes. Linode scopes limited keys to whole buckets; there is no "+
+			"per-prefix grant. WARNING: The secret_key is only shown ONCE in the response. Pass dry_run=true to preview.",
+		toolschemas.Schema("linode.mcp.v1.ObjectStorageCICDCredentialsInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleObjectStorageCICDCredentialsRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// parseCICDCredentialsArgs validates the input, returning the parsed args or
+// an error message. Shared by the real path and the dry-run preview.
+func parseCICDCredentialsArgs(request *mcp.CallToolRequest) (*cicdCredentialsArgs, string) {
+	args := &cicdCredentialsArgs{
+		bucket: strings.TrimSpace(request.GetString("bucket", "")),
+		region: strings.TrimSpace(request.GetString("region", "")),
+	}
+
+	if args.bucket == "" {
+		return nil, "bucket is required"
+	}
+
+	if args.region == "" {
+		return nil, "region is required"
+	}
+
+	if msg := requiredEnumChoice(request, "permissions", linodev1.ObjectStorageKeyPermission_Value_value); msg != "" {
+		return nil, msg
+	}
+
+	args.permissions = request.GetString("permissions", "")
+
+	days, msg := boundedIntArgument(request, "expires_in_days", 1, cicdKeyMaxDays,
+		fmt.Sprintf("expires_in_days must be between 1 and %d", cicdKeyMaxDays))
+	if msg != "" {
+		if _, exists := request.GetArguments()["expires_in_days"]; !exists {
+			return nil, "expires_in_days is required"
+		}
+
+		return nil, msg
+	}
+
+	args.days = days
+
+	args.name = strings.TrimSpace(request.GetString("name", ""))
+	if args.name == "" {
+		args.name = args.bucket
+	}
+
+	return args, ""
+}
+
+// cicdKeyExpiresOn is the UTC date args.days from now, as the label records it.
+func cicdKeyExpiresOn(args *cicdCredentialsArgs) string {
+	return time.Now().UTC().AddDate(0, 0, args.days).Format(time.DateOnly)
+}
+
+// cicdKeyLabel builds the key label for a key expiring on expiresOn, checking
+// it fits the key label limit.
+func cicdKeyLabel(namespace string, args *cicdCredentialsArgs, expiresOn string) (string, string) {
+	label := namespace + cicdKeyLabelPrefix + args.name + cicdKeyExpiryTag + expiresOn
+	if len(label) > maxKeyLabelLength {
+		return "", fmt.Sprintf("key label %q is %d characters, over the %d-character limit; pass a shorter name",
+			label, len(label), maxKeyLabelLength)
+	}
+
+	return label, ""
+}
+
+func handleObjectStorageCICDCredentialsRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if IsDryRun(request) {
+		args, msg := parseCICDCredentialsArgs(request)
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		namespace := labelNamespacePrefix(cfg, request.GetString(paramEnvironment, ""))
+		if _, msg := cicdKeyLabel(namespace, args, cicdKeyExpiresOn(args)); msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_object_storage_cicd_credentials", httpMethodPost, "/object-storage/keys", nil,
+			func(ctx context.Context, _ *linode.Client, _ any) (DryRunDetails, error) {
+				if err := ctx.Err(); err != nil {
+					return DryRunDetails{}, fmt.Errorf("object-storage-cicd-credentials side-effect walk canceled: %w", err)
+				}
+
+				return DryRunDetails{
+					SideEffects: []string{fmt.Sprintf("A new access key labeled %s%s%s will be created with %s access to bucket %s in %s only, "+
+						"its label recording an expiry %d day(s) after the call.",
+						namespace, cicdKeyLabelPrefix, args.name, args.permissions, args.bucket, args.region, args.days)},
+					Warnings: []string{"The secret key is returned only once, at creation time."},
+				}, nil
+			})
+	}
+
+	if result := RequireConfirm(request, "This creates an access key. The secret_key is only shown ONCE in the response. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	args, msg := parseCICDCredentialsArgs(request)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	expiresOn := cicdKeyExpiresOn(args)
+
+	label, msg := cicdKeyLabel(labelNamespacePrefix(cfg, request.GetString(paramEnvironment, "")), args, expiresOn)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	key, err := client.CreateObjectStorageKeyProto(ctx, linode.CreateObjectStorageKeyRequest{
+		Label: label,
+		BucketAccess: []linode.ObjectStorageKeyBucketAccess{
+			{BucketName: args.bucket, Region: args.region, Permissions: args.permissions},
+		},
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create access key: %v", err)), nil
+	}
+
+	var endpoint string
+
+	for _, region := range key.GetRegions() {
+		if region.GetId() == args.region {
+			endpoint = region.GetS3Endpoint()
+		}
+	}
+
+	return MarshalProtoToolResponse(&linodev1.ObjectStorageCICDCredentialsResponse{
+		Message: fmt.Sprintf("Access key '%s' (ID: %d) grants %s on bucket %s in %s; revoke it with linode_object_storage_key_delete after %s.",
+			key.GetLabel(), key.GetId(), args.permissions, args.bucket, args.region, expiresOn),
+		Warning:    "IMPORTANT: The secret_key below is shown ONLY ONCE. Save it now - it cannot be retrieved later.",
+		Key:        key,
+		ExpiresOn:  expiresOn,
+		S3Endpoint: endpoint,
+	})
+}
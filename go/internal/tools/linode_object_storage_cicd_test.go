@@ -0,0 +1,100 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// The key is limited to the one bucket, labeled with the expiry date, and the
+// response carries the bucket region's S3 endpoint.
+func TestLinodeObjectStorageCICDCredentialsCreatesScopedKey(t *testing.T) {
+	t.Parallel()
+
+	var body map[string]any
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/object-storage/keys" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("request body should decode: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":42,"label":"` + body["label"].(string) + `","access_key":"AK","secret_key":"SK","limited":true,` +
+			`"bucket_access":[{"bucket_name":"artifacts","region":"us-east-1","permissions":"read_write"}],` +
+			`"regions":[{"id":"us-east-1","s3_endpoint":"us-east-1.linodeobjects.com"}]}`))
+	}))
+	defer srv.Close()
+
+	_, _, handler := tools.NewLinodeObjectStorageCICDCredentialsTool(newTestConfig(srv.URL))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"bucket": "artifacts", "region": "us-east-1", "permissions": "read_write",
+		"expires_in_days": float64(30), "name": "deploy", keyConfirm: true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want a key", result.Content)
+	}
+
+	expiresOn := time.Now().UTC().AddDate(0, 0, 30).Format(time.DateOnly)
+
+	want := map[string]any{
+		"label": "ci-deploy expires=" + expiresOn,
+		"bucket_access": []any{
+			map[string]any{"bucket_name": "artifacts", "region": "us-east-1", "permissions": "read_write"},
+		},
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("POST body = %v, want %v", body, want)
+	}
+
+	var response struct {
+		ExpiresOn  string `json:"expires_on"`
+		S3Endpoint string `json:"s3_endpoint"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if response.ExpiresOn != expiresOn || response.S3Endpoint != "us-east-1.linodeobjects.com" {
+		t.Errorf("response = %+v, want expires_on %s and the us-east-1 endpoint", response, expiresOn)
+	}
+}
+
+func TestLinodeObjectStorageCICDCredentialsLabelTooLong(t *testing.T) {
+	t.Parallel()
+
+	_, _, handler := tools.NewLinodeObjectStorageCICDCredentialsTool(newTestConfig("https://api.linode.com/v4"))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"bucket": strings.Repeat("b", 40), "region": "us-east-1", "permissions": "read_only",
+		"expires_in_days": float64(7), keyConfirm: true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, _ := result.Content[0].(mcp.TextContent)
+	if !result.IsError || !strings.Contains(text.Text, "over the 50-character limit; pass a shorter name") {
+		t.Errorf("result = %v, want the label length error", result.Content)
+	}
+}
@@ -0,0 +1,280 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+const (
+	listingFormatJSON = "json"
+	listingFormatCSV  = "csv"
+
+	// maxManifestObjects caps one manifest export. The listing is held in
+	// memory and uploaded as a single PUT, so a larger bucket must be split
+	// by prefix.
+	maxManifestObjects = 100000
+	// manifestPageSize is the largest page the object-list endpoint serves.
+	manifestPageSize = "500"
+	// manifestURLExpirySeconds is how long the presigned upload URL lives;
+	// the upload starts as soon as the URL is issued.
+	manifestURLExpirySeconds = 300
+)
+
+// objectListingColumns is the CSV header for a bucket listing.
+var objectListingColumns = []string{"name", "size", "last_modified", "etag", "owner", "is_prefix"}
+
+// objectListingCSV renders objects as CSV with a header row.
+func objectListingCSV(objects []*linodev1.ObjectStorageObject) (string, error) {
+	var buf bytes.Buffer
+
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(objectListingColumns); err != nil {
+		return "", fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, object := range objects {
+		row := []string{
+			object.GetName(),
+			strconv.FormatInt(object.GetSize(), 10),
+			object.GetLastModified(),
+			object.GetEtag(),
+			object.GetOwner(),
+			strconv.FormatBool(object.GetIsPrefix()),
+		}
+		if err := writer.Write(row); err != nil {
+			return "", fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		return "", fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// NewLinodeObjectStorageBucketManifestExportTool creates a tool that writes a
+// bucket's full listing back into the bucket as a manifest object.
+func NewLinodeObjectStorageBucketManifestExportTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_object_storage_bucket_manifest_export",
+		"Lists every object in an Object Storage bucket (optionally under a prefix) and writes the listing into the "+
+			"bucket as a CSV or JSON manifest object through a presigned PUT URL. Use it for inventory or compliance "+
+			"exports too large to return in a tool result. Requires confirm=true to proceed.",
+		toolschemas.Schema("linode.mcp.v1.ObjectStorageBucketManifestExportInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleObjectStorageBucketManifestExportRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// validateManifestExportArgs validates the manifest export args, returning an
+// error message or "". Shared by the real path and the dry-run preview.
+func validateManifestExportArgs(request *mcp.CallToolRequest) string {
+	region := request.GetString("region", "")
+	label := request.GetString("label", "")
+
+	switch {
+	case region == "":
+		return errRegionRequired
+	case !isSafeObjectStorageRegion(region):
+		return "region must be a valid region or cluster ID"
+	case label == "":
+		return errLabelRequired
+	case !validObjectStorageBucketLabel(label):
+		return "label must be a valid bucket label"
+	case request.GetString("key", "") == "":
+		return "key is required"
+	}
+
+	_, msg := optionalEnumChoice(request, "format", linodev1.ObjectStorageListingFormat_Value_value)
+
+	return msg
+}
+
+// manifestFormat returns the requested manifest format, csv when unset.
+func manifestFormat(request *mcp.CallToolRequest) string {
+	if format := request.GetString("format", ""); format != "" {
+		return format
+	}
+
+	return listingFormatCSV
+}
+
+// collectBucketObjects pages through the bucket listing under prefix and
+// returns every object, failing once the listing passes maxManifestObjects.
+func collectBucketObjects(ctx context.Context, client *linode.Client, region, label, prefix string) ([]*linodev1.ObjectStorageObject, error) {
+	var (
+		objects []*linodev1.ObjectStorageObject
+		marker  string
+	)
+
+	for {
+		params := map[string]string{"page_size": manifestPageSize}
+		if prefix != "" {
+			params["prefix"] = prefix
+		}
+
+		if marker != "" {
+			params["marker"] = marker
+		}
+
+		page, err := client.ListObjectStorageBucketContentsProto(ctx, region, label, params)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, page.Objects...)
+		if len(objects) > maxManifestObjects {
+			return nil, fmt.Errorf("%w: more than %d objects, narrow it with prefix", errManifestTooManyObjects, maxManifestObjects)
+		}
+
+		if !page.IsTruncated || page.NextMarker == "" {
+			return objects, nil
+		}
+
+		marker = page.NextMarker
+	}
+}
+
+// manifestListing summarizes a collected listing for the dry-run preview and
+// the response.
+type manifestListing struct {
+	Objects   int   `json:"objects"`
+	TotalSize int64 `json:"total_size"`
+	KeyExists bool  `json:"key_exists"`
+}
+
+func summarizeManifestListing(objects []*linodev1.ObjectStorageObject, key string) manifestListing {
+	summary := manifestListing{Objects: len(objects)}
+
+	for _, object := range objects {
+		summary.TotalSize += object.GetSize()
+		if object.GetName() == key {
+			summary.KeyExists = true
+		}
+	}
+
+	return summary
+}
+
+// renderManifest encodes objects in format and returns the bytes with the
+// content type the upload must carry.
+func renderManifest(objects []*linodev1.ObjectStorageObject, format string) ([]byte, string, error) {
+	if format == listingFormatJSON {
+		data, err := MarshalProtoJSON(&linodev1.ObjectStorageObjectListResponse{Count: linodeIDToInt32(len(objects)), Objects: objects})
+		if err != nil {
+			return nil, "", err
+		}
+
+		return data, "application/json", nil
+	}
+
+	table, err := objectListingCSV(objects)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return []byte(table), "text/csv", nil
+}
+
+func handleObjectStorageBucketManifestExportRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	region := request.GetString("region", "")
+	label := request.GetString("label", "")
+	prefix := request.GetString("prefix", "")
+	key := request.GetString("key", "")
+	format := manifestFormat(request)
+
+	if IsDryRun(request) {
+		if msg := validateManifestExportArgs(request); msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_object_storage_bucket_manifest_export", httpMethodPost,
+			fmt.Sprintf("/object-storage/buckets/%s/%s/object-url", region, label),
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				objects, err := collectBucketObjects(ctx, c, region, label, prefix)
+				if err != nil {
+					return nil, err
+				}
+
+				return summarizeManifestListing(objects, key), nil
+			},
+			func(_ context.Context, _ *linode.Client, state any) (DryRunDetails, error) {
+				summary, _ := state.(manifestListing)
+				details := DryRunDetails{
+					SideEffects: []string{fmt.Sprintf("Writes a %s manifest of %d objects to %s in bucket %s", format, summary.Objects, key, label)},
+				}
+
+				if summary.KeyExists {
+					details.Warnings = append(details.Warnings, fmt.Sprintf("Object %s already exists and will be overwritten", key))
+				}
+
+				return details, nil
+			})
+	}
+
+	if result := RequireConfirm(request, "This writes a manifest object into the bucket. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	if msg := validateManifestExportArgs(request); msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	objects, err := collectBucketObjects(ctx, client, region, label, prefix)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list contents of bucket '%s' in region '%s': %v", label, region, err)), nil
+	}
+
+	data, contentType, err := renderManifest(objects, format)
+	if err != nil {
+		return nil, err
+	}
+
+	presigned, err := client.CreatePresignedURLProto(ctx, region, label, linode.PresignedURLRequest{
+		Method:      "PUT",
+		Name:        key,
+		ExpiresIn:   manifestURLExpirySeconds,
+		ContentType: contentType,
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to generate presigned URL for '%s' in bucket '%s': %v", key, label, err)), nil
+	}
+
+	if err := client.PutPresignedObject(ctx, presigned.GetUrl(), contentType, data); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to upload manifest '%s' to bucket '%s': %v", key, label, err)), nil
+	}
+
+	summary := summarizeManifestListing(objects, key)
+
+	return MarshalProtoToolResponse(&linodev1.ObjectStorageBucketManifestExportResponse{
+		Message:      fmt.Sprintf("Manifest of %d objects written to '%s' in bucket '%s'", summary.Objects, key, label),
+		Key:          key,
+		Format:       format,
+		Objects:      linodeIDToInt32(summary.Objects),
+		TotalSize:    summary.TotalSize,
+		ManifestSize: int64(len(data)),
+	})
+}
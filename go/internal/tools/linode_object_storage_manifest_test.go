@@ -0,0 +1,295 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+const (
+	manifestBucketPath = "/object-storage/buckets/us-east-1/my-bucket"
+	manifestKey        = "manifests/inventory.csv"
+	manifestUploadPath = "/upload"
+)
+
+// manifestServer fakes the object-list, object-url, and presigned upload
+// endpoints. The listing spans two pages so the export must follow the marker.
+type manifestServer struct {
+	srv *httptest.Server
+
+	mu          sync.Mutex
+	urlRequest  map[string]any
+	upload      string
+	contentType string
+	requests    []string
+}
+
+func newManifestServer(t *testing.T) *manifestServer {
+	t.Helper()
+
+	fake := &manifestServer{}
+	fake.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+
+		fake.requests = append(fake.requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		var body string
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == manifestBucketPath+"/object-list":
+			if r.URL.Query().Get("marker") == "" {
+				body = objectStorageContentsBody(`{"name":"a.txt","size":10,"etag":"e1"}`, true, "a.txt")
+			} else {
+				body = objectStorageContentsBody(`{"name":"`+manifestKey+`","size":32}`, false, "")
+			}
+		case r.Method == http.MethodPost && r.URL.Path == manifestBucketPath+"/object-url":
+			if err := json.NewDecoder(r.Body).Decode(&fake.urlRequest); err != nil {
+				t.Errorf("decode object-url body: %v", err)
+			}
+
+			body = `{"url":"` + fake.srv.URL + manifestUploadPath + `"}`
+		case r.Method == http.MethodPut && r.URL.Path == manifestUploadPath:
+			if r.Header.Get("Authorization") != "" {
+				t.Error("presigned upload sent the API token")
+			}
+
+			data, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Errorf("read upload body: %v", err)
+			}
+
+			fake.upload = string(data)
+			fake.contentType = r.Header.Get("Content-Type")
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(fake.srv.Close)
+
+	return fake
+}
+
+func (m *manifestServer) config() *config.Config {
+	return &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: m.srv.URL, Token: tokenTest}},
+		},
+	}
+}
+
+func TestLinodeObjectStorageBucketManifestExportToolDefinition(t *testing.T) {
+	t.Parallel()
+
+	tool, capability, _ := tools.NewLinodeObjectStorageBucketManifestExportTool(&config.Config{})
+
+	if tool.Name != "linode_object_storage_bucket_manifest_export" {
+		t.Errorf("tool.Name = %q", tool.Name)
+	}
+
+	if capability != profiles.CapWrite {
+		t.Errorf("capability = %v, want %v", capability, profiles.CapWrite)
+	}
+
+	for _, key := range []string{"key", "prefix", "format", keyConfirm, keyDryRun} {
+		if !strings.Contains(string(tool.RawInputSchema), `"`+key+`"`) {
+			t.Errorf("RawInputSchema missing key %q", key)
+		}
+	}
+}
+
+func TestLinodeObjectStorageBucketManifestExportToolWritesCSV(t *testing.T) {
+	t.Parallel()
+
+	fake := newManifestServer(t)
+	_, _, handler := tools.NewLinodeObjectStorageBucketManifestExportTool(fake.config())
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		keyRegion:  regionUSEast1,
+		keyLabel:   bucketTest,
+		"key":      manifestKey,
+		keyConfirm: true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true: %s", text.Text)
+	}
+
+	wantCSV := "name,size,last_modified,etag,owner,is_prefix\n" +
+		"a.txt,10,,e1,,false\n" +
+		manifestKey + ",32,,,,false\n"
+	if fake.upload != wantCSV {
+		t.Errorf("uploaded manifest = %q, want %q", fake.upload, wantCSV)
+	}
+
+	if fake.contentType != "text/csv" {
+		t.Errorf("upload Content-Type = %q, want text/csv", fake.contentType)
+	}
+
+	if fake.urlRequest["method"] != "PUT" || fake.urlRequest["name"] != manifestKey || fake.urlRequest["content_type"] != "text/csv" {
+		t.Errorf("object-url request = %v", fake.urlRequest)
+	}
+
+	var out struct {
+		Message      string `json:"message"`
+		Format       string `json:"format"`
+		Objects      int    `json:"objects"`
+		TotalSize    int64  `json:"total_size"`
+		ManifestSize int64  `json:"manifest_size"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &out); err != nil {
+		t.Fatalf("unmarshal output: %v", err)
+	}
+
+	if out.Objects != 2 || out.TotalSize != 42 || out.Format != "csv" || out.ManifestSize != int64(len(wantCSV)) {
+		t.Errorf("response = %+v", out)
+	}
+
+	if !strings.Contains(out.Message, "Manifest of 2 objects written to '"+manifestKey+"'") {
+		t.Errorf("message = %q", out.Message)
+	}
+}
+
+func TestLinodeObjectStorageBucketManifestExportToolWritesJSON(t *testing.T) {
+	t.Parallel()
+
+	fake := newManifestServer(t)
+	_, _, handler := tools.NewLinodeObjectStorageBucketManifestExportTool(fake.config())
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		keyRegion:  regionUSEast1,
+		keyLabel:   bucketTest,
+		"key":      "manifests/inventory.json",
+		"format":   "json",
+		keyConfirm: true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatal("result.IsError = true, want false")
+	}
+
+	if fake.contentType != "application/json" {
+		t.Errorf("upload Content-Type = %q, want application/json", fake.contentType)
+	}
+
+	var manifest struct {
+		Count   int `json:"count"`
+		Objects []struct {
+			Name string `json:"name"`
+		} `json:"objects"`
+	}
+	if err := json.Unmarshal([]byte(fake.upload), &manifest); err != nil {
+		t.Fatalf("uploaded manifest is not JSON: %v", err)
+	}
+
+	if manifest.Count != 2 || len(manifest.Objects) != 2 || manifest.Objects[0].Name != "a.txt" {
+		t.Errorf("manifest = %+v", manifest)
+	}
+}
+
+func TestLinodeObjectStorageBucketManifestExportToolDryRun(t *testing.T) {
+	t.Parallel()
+
+	fake := newManifestServer(t)
+	_, _, handler := tools.NewLinodeObjectStorageBucketManifestExportTool(fake.config())
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		keyRegion: regionUSEast1,
+		keyLabel:  bucketTest,
+		"key":     manifestKey,
+		keyDryRun: true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatal("result.IsError = true, want false")
+	}
+
+	for _, request := range fake.requests {
+		if !strings.HasPrefix(request, http.MethodGet+" ") {
+			t.Errorf("dry run sent %s", request)
+		}
+	}
+
+	var body struct {
+		SideEffects []string `json:"side_effects"`
+		Warnings    []string `json:"warnings"`
+	}
+	if err := json.Unmarshal([]byte(dryRunResultText(t, result)), &body); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(body.SideEffects) != 1 || !strings.Contains(body.SideEffects[0], "csv manifest of 2 objects") {
+		t.Errorf("side_effects = %v", body.SideEffects)
+	}
+
+	if !strings.Contains(strings.Join(body.Warnings, "\n"), manifestKey+" already exists and will be overwritten") {
+		t.Errorf("warnings = %v, want an overwrite warning", body.Warnings)
+	}
+}
+
+func TestLinodeObjectStorageBucketManifestExportToolValidation(t *testing.T) {
+	t.Parallel()
+
+	_, _, handler := tools.NewLinodeObjectStorageBucketManifestExportTool(dryRunNoCallServer(t))
+
+	tests := []struct {
+		name     string
+		args     map[string]any
+		contains string
+	}{
+		{name: "requires confirm", args: map[string]any{keyRegion: regionUSEast1, keyLabel: bucketTest, "key": manifestKey}, contains: "confirm=true"},
+		{name: "requires key", args: map[string]any{keyRegion: regionUSEast1, keyLabel: bucketTest, keyConfirm: true}, contains: "key is required"},
+		{name: "rejects malformed region", args: map[string]any{keyRegion: regionSlashUSEast1, keyLabel: bucketTest, "key": manifestKey, keyConfirm: true}, contains: msgRegionInvalidClusterID},
+		{name: "rejects unknown format", args: map[string]any{keyRegion: regionUSEast1, keyLabel: bucketTest, "key": manifestKey, "format": "xml", keyConfirm: true}, contains: "format must be one of: json, csv"},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := handler(t.Context(), createRequestWithArgs(t, testCase.args))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result == nil || !result.IsError {
+				t.Fatal("expected an error result")
+			}
+
+			text, ok := result.Content[0].(mcp.TextContent)
+			if !ok || !strings.Contains(text.Text, testCase.contains) {
+				t.Errorf("error text %q does not contain %q", text.Text, testCase.contains)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package tools_test
 import (
 	"encoding/json"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -684,6 +685,70 @@ func TestLinodeObjectStorageBucketContentsToolTruncated(t *testing.T) {
 	}
 }
 
+// With format=csv the page comes back as one CSV table in the csv field and
+// the objects array is dropped.
+func TestLinodeObjectStorageBucketContentsToolCSVFormat(t *testing.T) {
+	t.Parallel()
+
+	body := objectStorageContentsBody(`{"name":"a,b.txt","size":1024,"etag":"e1"},{"name":"logs/","is_prefix":true}`, false, "")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if _, writeErr := w.Write([]byte(body)); writeErr != nil {
+			t.Errorf("unexpected error: %v", writeErr)
+		}
+	}))
+	defer srv.Close()
+
+	srvCfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+	_, _, srvHandler := tools.NewLinodeObjectStorageBucketContentsTool(srvCfg)
+
+	req := createRequestWithArgs(t, map[string]any{keyRegion: regionUSEast1, keyLabel: bucketTest, "format": "csv"})
+
+	result, err := srvHandler(t.Context(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result == nil || result.IsError {
+		t.Fatalf("expected a success result, got %#v", result)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var out struct {
+		Count   int               `json:"count"`
+		CSV     string            `json:"csv"`
+		Objects []json.RawMessage `json:"objects"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &out); err != nil {
+		t.Fatalf("unmarshal output: %v", err)
+	}
+
+	want := "name,size,last_modified,etag,owner,is_prefix\n" +
+		"\"a,b.txt\",1024,,e1,,false\n" +
+		"logs/,0,,,,true\n"
+	if out.CSV != want {
+		t.Errorf("csv = %q, want %q", out.CSV, want)
+	}
+
+	if out.Count != 2 {
+		t.Errorf("count = %d, want 2", out.Count)
+	}
+
+	if len(out.Objects) != 0 {
+		t.Errorf("objects = %d entries, want none with format=csv", len(out.Objects))
+	}
+}
+
 func TestLinodeObjectStorageBucketContentsToolCaseMissingRegion(t *testing.T) {
 	cfg := &config.Config{
 		Environments: map[string]config.EnvironmentConfig{
@@ -736,6 +801,7 @@ func TestLinodeObjectStorageBucketReadFormatValidation(t *testing.T) {
 		{name: "get rejects uppercase label", args: map[string]any{keyRegion: regionUSEast1, keyLabel: "My_Bucket"}, contains: "label must be a valid bucket label"},
 		{name: "list rejects uppercase region", list: true, args: map[string]any{keyRegion: "US-EAST-1", keyLabel: bucketTest}, contains: msgRegionInvalidClusterID},
 		{name: "list rejects malformed label", list: true, args: map[string]any{keyRegion: regionUSEast1, keyLabel: "bad/label"}, contains: "label must be a valid bucket label"},
+		{name: "list rejects unknown format", list: true, args: map[string]any{keyRegion: regionUSEast1, keyLabel: bucketTest, "format": "xml"}, contains: "format must be one of: json, csv"},
 	}
 
 	for _, testCase := range tests {
@@ -2330,6 +2396,15 @@ func TestLinodeObjectStorageBucketCreateToolSuccess(t *testing.T) {
 	}
 
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		// The region is checked against the endpoint listing first.
+		if r.URL.Path == "/object-storage/endpoints" {
+			_, _ = w.Write([]byte(`{"data":[{"region":"` + regionUSEast1 + `","endpoint_type":"E1"}],"page":1,"pages":1,"results":1}`))
+
+			return
+		}
+
 		if r.URL.Path != "/object-storage/buckets" {
 			t.Errorf("r.URL.Path = %v, want %v", r.URL.Path, "/object-storage/buckets")
 		}
@@ -2338,8 +2413,6 @@ func TestLinodeObjectStorageBucketCreateToolSuccess(t *testing.T) {
 			t.Errorf("r.Method = %v, want %v", r.Method, http.MethodPost)
 		}
 
-		w.Header().Set("Content-Type", "application/json")
-
 		if err := json.NewEncoder(w).Encode(bucket); err != nil {
 			t.Errorf("unexpected error: %v", err)
 		}
@@ -2387,6 +2460,180 @@ func TestLinodeObjectStorageBucketCreateToolSuccess(t *testing.T) {
 	}
 }
 
+// A region without an Object Storage endpoint is rejected with the valid
+// regions before anything is created.
+func TestLinodeObjectStorageBucketCreateToolRejectsRegionWithoutEndpoint(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("r.Method = %v, want only the endpoint GET", r.Method)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"region":"us-ord","endpoint_type":"E1"},{"region":"us-east","endpoint_type":"E0"},` +
+			`{"region":"us-ord","endpoint_type":"E3"}],"page":1,"pages":1,"results":3}`))
+	}))
+	defer srv.Close()
+
+	srvCfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+	_, _, handler := tools.NewLinodeObjectStorageBucketCreateTool(srvCfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		keyLabel:   bucketTest,
+		keyRegion:  "mars-1",
+		keyConfirm: true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `region "mars-1" has no Object Storage endpoint; valid regions: us-east, us-ord (see linode_object_storage_endpoint_list)`
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !result.IsError || !ok || text.Text != want {
+		t.Errorf("result = %v, want %q", result.Content, want)
+	}
+}
+
+// region=auto takes the first configured preferred region that has an
+// endpoint and reports how it chose.
+func TestLinodeObjectStorageBucketCreateToolAutoRegionPreferred(t *testing.T) {
+	t.Parallel()
+
+	var created map[string]any
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/object-storage/endpoints" {
+			_, _ = w.Write([]byte(`{"data":[{"region":"us-ord","endpoint_type":"E1"},{"region":"us-east","endpoint_type":"E0"}],` +
+				`"page":1,"pages":1,"results":2}`))
+
+			return
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		_, _ = w.Write([]byte(`{"label":"` + bucketTest + `","region":"us-east"}`))
+	}))
+	defer srv.Close()
+
+	srvCfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+		ObjectStorage: config.ObjectStorageConfig{PreferredRegions: []string{"mars-1", "US-EAST", "us-ord"}},
+	}
+	_, _, handler := tools.NewLinodeObjectStorageBucketCreateTool(srvCfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		keyLabel:   bucketTest,
+		keyRegion:  "auto",
+		keyConfirm: true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, content = %v", result.Content)
+	}
+
+	if created[keyRegion] != "us-east" {
+		t.Errorf("created region = %v, want us-east", created[keyRegion])
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatalf("content type = %T, want mcp.TextContent", result.Content[0])
+	}
+
+	var response map[string]any
+	if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := "first object_storage.preferred_regions entry with an endpoint"; response["region_selection"] != want {
+		t.Errorf("region_selection = %v, want %q", response["region_selection"], want)
+	}
+}
+
+// Without preferred regions, region=auto picks the region whose endpoint
+// accepts a connection; an unreachable endpoint is skipped.
+func TestLinodeObjectStorageBucketCreateToolAutoRegionLatency(t *testing.T) {
+	t.Parallel()
+
+	listener, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer listener.Close()
+
+	closed, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	unreachable := closed.Addr().String()
+	_ = closed.Close()
+
+	var created map[string]any
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/object-storage/endpoints" {
+			_, _ = w.Write([]byte(`{"data":[{"region":"us-ord","endpoint_type":"E1","s3_endpoint":"` + unreachable + `"},` +
+				`{"region":"us-east","endpoint_type":"E0","s3_endpoint":"` + listener.Addr().String() + `"}],"page":1,"pages":1,"results":2}`))
+
+			return
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		_, _ = w.Write([]byte(`{"label":"` + bucketTest + `","region":"us-east"}`))
+	}))
+	defer srv.Close()
+
+	srvCfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+	_, _, handler := tools.NewLinodeObjectStorageBucketCreateTool(srvCfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		keyLabel:   bucketTest,
+		keyRegion:  "auto",
+		keyConfirm: true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, content = %v", result.Content)
+	}
+
+	if created[keyRegion] != "us-east" {
+		t.Errorf("created region = %v, want us-east", created[keyRegion])
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok || !strings.Contains(text.Text, "lowest endpoint latency ("+listener.Addr().String()) {
+		t.Errorf("result = %v, want the latency selection naming %s", result.Content, listener.Addr())
+	}
+}
+
 // End-to-end verification of object storage bucket deletion.
 func TestLinodeObjectStorageBucketDeleteToolDefinition(t *testing.T) {
 	cfg := &config.Config{
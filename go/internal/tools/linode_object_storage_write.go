@@ -4,7 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"google.golang.org/protobuf/proto"
@@ -52,6 +56,148 @@ func validateBucketCreateArgs(label, region, acl string) string {
 	return ""
 }
 
+// bucketRegionError checks region against the Object Storage endpoints and
+// returns an error message listing the valid regions, or "". A failed (warned)
+// or empty endpoint listing skips the check rather than blocking the create;
+// the API still rejects a region it does not serve.
+func bucketRegionError(ctx context.Context, client *linode.Client, region string) string {
+	endpoints, err := client.ListObjectStorageEndpointsProto(ctx)
+	if err != nil {
+		AddWarning(ctx, "region %q was not checked against the Object Storage endpoints: %v", region, err)
+
+		return ""
+	}
+
+	if len(endpoints) == 0 {
+		return ""
+	}
+
+	var valid []string
+
+	for _, endpoint := range endpoints {
+		if strings.EqualFold(endpoint.GetRegion(), region) {
+			return ""
+		}
+
+		if !slices.Contains(valid, endpoint.GetRegion()) {
+			valid = append(valid, endpoint.GetRegion())
+		}
+	}
+
+	slices.Sort(valid)
+
+	return fmt.Sprintf("region %q has no Object Storage endpoint; valid regions: %s (see linode_object_storage_endpoint_list)",
+		region, strings.Join(valid, ", "))
+}
+
+// bucketRegionAuto is the region value that has bucket create pick the region.
+const bucketRegionAuto = "auto"
+
+// bucketEndpointProbeTimeout bounds each endpoint connection timed by
+// region=auto.
+const bucketEndpointProbeTimeout = 3 * time.Second
+
+// pickBucketRegion resolves region=auto against the Object Storage endpoints:
+// the first preferred region with an endpoint, else the region whose S3
+// endpoint accepts a TCP connection fastest. It returns the region and how it
+// was chosen, or an error message.
+func pickBucketRegion(ctx context.Context, client *linode.Client, preferred []string) (region, selection, errMsg string) {
+	endpoints, err := client.ListObjectStorageEndpointsProto(ctx)
+	if err != nil {
+		return "", "", fmt.Sprintf("Failed to list Object Storage endpoints for region=auto: %v", err)
+	}
+
+	// hosts maps each region to its first S3 hostname, in first-seen order.
+	var (
+		regions []string
+		hosts   = map[string]string{}
+	)
+
+	for _, endpoint := range endpoints {
+		if _, ok := hosts[endpoint.GetRegion()]; !ok {
+			regions = append(regions, endpoint.GetRegion())
+			hosts[endpoint.GetRegion()] = ""
+		}
+
+		if hosts[endpoint.GetRegion()] == "" {
+			hosts[endpoint.GetRegion()] = endpoint.GetS3Endpoint()
+		}
+	}
+
+	if len(regions) == 0 {
+		return "", "", "region=auto found no Object Storage endpoints; pass an explicit region"
+	}
+
+	for _, want := range preferred {
+		for _, candidate := range regions {
+			if strings.EqualFold(candidate, want) {
+				return candidate, "first object_storage.preferred_regions entry with an endpoint", ""
+			}
+		}
+	}
+
+	if len(preferred) > 0 {
+		AddWarning(ctx, "no object_storage.preferred_regions entry (%s) has an Object Storage endpoint; picked by latency instead",
+			strings.Join(preferred, ", "))
+	}
+
+	latencies := make([]time.Duration, len(regions))
+
+	var wg sync.WaitGroup
+
+	for i, candidate := range regions {
+		wg.Go(func() {
+			latencies[i] = probeBucketEndpoint(ctx, hosts[candidate])
+		})
+	}
+
+	wg.Wait()
+
+	best := -1
+
+	for i, latency := range latencies {
+		if latency >= 0 && (best < 0 || latency < latencies[best]) {
+			best = i
+		}
+	}
+
+	if best < 0 {
+		slices.Sort(regions)
+
+		return "", "", fmt.Sprintf("region=auto could not reach any Object Storage endpoint; pass an explicit region (valid regions: %s)",
+			strings.Join(regions, ", "))
+	}
+
+	return regions[best], fmt.Sprintf("lowest endpoint latency (%s answered in %dms)", hosts[regions[best]], latencies[best].Milliseconds()), ""
+}
+
+// probeBucketEndpoint times a TCP connection to an S3 endpoint hostname (port
+// 443 unless it carries one), returning -1 when there is no hostname or the
+// connection fails.
+func probeBucketEndpoint(ctx context.Context, host string) time.Duration {
+	if host == "" {
+		return -1
+	}
+
+	address := host
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		address = net.JoinHostPort(host, "443")
+	}
+
+	dialer := net.Dialer{Timeout: bucketEndpointProbeTimeout}
+	start := time.Now()
+
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return -1
+	}
+
+	elapsed := time.Since(start)
+	_ = conn.Close()
+
+	return elapsed
+}
+
 func handleObjectStorageBucketCreateRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
 	label := request.GetString("label", "")
 	region := request.GetString("region", "")
@@ -76,11 +222,24 @@ func handleObjectStorageBucketCreateRequest(ctx context.Context, request *mcp.Ca
 		return mcp.NewToolResultError(msg), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
+	var selection string
+
+	if strings.EqualFold(region, bucketRegionAuto) {
+		var msg string
+
+		region, selection, msg = pickBucketRegion(ctx, client, cfg.ObjectStorage.PreferredRegions)
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+	} else if msg := bucketRegionError(ctx, client, region); msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
 	req := linode.CreateObjectStorageBucketRequest{
 		Label:  label,
 		Region: region,
@@ -102,6 +261,10 @@ func handleObjectStorageBucketCreateRequest(ctx context.Context, request *mcp.Ca
 		Bucket:  bucket,
 	}
 
+	if selection != "" {
+		response.RegionSelection = new(selection)
+	}
+
 	return MarshalProtoToolResponse(response)
 }
 
@@ -169,7 +332,7 @@ func handleObjectStorageCancelRequest(ctx context.Context, request *mcp.CallTool
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -258,7 +421,7 @@ func handleObjectStorageBucketAccessAllowRequest(ctx context.Context, request *m
 		return mcp.NewToolResultError(msg), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -353,7 +516,7 @@ func handleObjectStorageBucketAccessUpdateRequest(ctx context.Context, request *
 		return mcp.NewToolResultError(msg), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -453,7 +616,7 @@ func handleObjectStorageKeyCreateRequest(ctx context.Context, request *mcp.CallT
 		return mcp.NewToolResultError(msg), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -540,7 +703,7 @@ func handleObjectStorageKeyUpdateRequest(ctx context.Context, request *mcp.CallT
 
 	bucketAccess, _ := parseObjectStorageKeyBucketAccess(bucketAccessJSON)
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -679,7 +842,7 @@ func handleObjectStorageObjectACLUpdateRequest(ctx context.Context, request *mcp
 		return mcp.NewToolResultError(msg), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -810,7 +973,7 @@ func handleObjectStorageSSLUploadRequest(ctx context.Context, request *mcp.CallT
 		return mcp.NewToolResultError(msg), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
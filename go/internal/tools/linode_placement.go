@@ -58,7 +58,7 @@ func handlePlacementGroupAssignRequest(ctx context.Context, request *mcp.CallToo
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -147,7 +147,7 @@ func handlePlacementGroupGetRequest(ctx context.Context, request *mcp.CallToolRe
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -100,7 +100,7 @@ func handlePlacementGroupUpdateRequest(ctx context.Context, request *mcp.CallToo
 		return result, nil
 	}
 
-	client, prepareFailure := prepareClient(request, cfg)
+	client, prepareFailure := prepareClient(ctx, request, cfg)
 	if prepareFailure != nil {
 		return mcp.NewToolResultError(prepareFailure.Error()), nil
 	}
@@ -205,7 +205,7 @@ func handleLinodePlacementGroupCreateRequest(ctx context.Context, request *mcp.C
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -297,7 +297,7 @@ func handleLinodePlacementGroupUnassignRequest(ctx context.Context, request *mcp
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
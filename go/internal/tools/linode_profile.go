@@ -46,7 +46,7 @@ func NewLinodeProfilePreferencesTool(cfg *config.Config) (mcp.Tool, profiles.Cap
 	)
 
 	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		client, err := prepareClient(&request, cfg)
+		client, err := prepareClient(ctx, &request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
@@ -120,7 +120,7 @@ func handleLinodeProfilePreferencesUpdateRequest(ctx context.Context, request *m
 		return result, nil
 	}
 
-	client, prepErr := prepareClient(request, cfg)
+	client, prepErr := prepareClient(ctx, request, cfg)
 	if prepErr != nil {
 		return mcp.NewToolResultError(prepErr.Error()), nil
 	}
@@ -240,7 +240,7 @@ func handleLinodeProfileTokenCreateRequest(ctx context.Context, request *mcp.Cal
 		return result, nil
 	}
 
-	client, prepErr := prepareClient(request, cfg)
+	client, prepErr := prepareClient(ctx, request, cfg)
 	if prepErr != nil {
 		return mcp.NewToolResultError(prepErr.Error()), nil
 	}
@@ -333,7 +333,7 @@ func handleLinodeProfileTokenDeleteRequest(ctx context.Context, request *mcp.Cal
 		return result, nil
 	}
 
-	client, prepErr := prepareClient(request, cfg)
+	client, prepErr := prepareClient(ctx, request, cfg)
 	if prepErr != nil {
 		return mcp.NewToolResultError(prepErr.Error()), nil
 	}
@@ -391,7 +391,7 @@ func handleLinodeProfileTokenUpdateRequest(ctx context.Context, request *mcp.Cal
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
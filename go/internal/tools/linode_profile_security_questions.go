@@ -56,7 +56,7 @@ func handleLinodeProfileSecurityQuestionsAnswerRequest(ctx context.Context, requ
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
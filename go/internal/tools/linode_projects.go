@@ -0,0 +1,356 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// Where project membership comes from. projectSourceBoth also marks a
+// resource that both its group and a project tag put in the same project.
+const (
+	projectSourceGroup = "group"
+	projectSourceTag   = "tag"
+	projectSourceBoth  = "both"
+
+	projectsTagPrefixDefault = "project:"
+)
+
+// projectKinds are the resource kinds a project holds, in report order.
+var projectKinds = []string{"instance", "volume", "nodebalancer", "domain"}
+
+// projectsArgs are the membership rules shared by linode_projects_list and
+// linode_projects_get.
+type projectsArgs struct {
+	tagPrefix string
+	source    string
+}
+
+// NewLinodeProjectsListTool creates a tool that groups the account's resources
+// into projects by instance group and project tag.
+func NewLinodeProjectsListTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_projects_list",
+		"Groups the account's instances, volumes, NodeBalancers, and domains into projects and lists each "+
+			"project's resource counts and monthly list-price cost. A project is an instance's legacy group "+
+			"value or a tag starting with tag_prefix (default \"project:\", so project:billing is the billing "+
+			"project); source picks group, tag, or both (default). Also counts the resources in no project.",
+		toolschemas.Schema("linode.mcp.v1.ProjectsListInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeProjectsListRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+// NewLinodeProjectsGetTool creates a tool that returns one project's
+// resources and cost.
+func NewLinodeProjectsGetTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_projects_get",
+		"Returns one project's inventory: every instance, volume, NodeBalancer, and domain in it with its "+
+			"region, type or size, monthly list-price cost, and whether the group or the project tag put it "+
+			"there, plus the project totals. Projects are found the same way as linode_projects_list.",
+		toolschemas.Schema("linode.mcp.v1.ProjectsGetInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeProjectsGetRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLinodeProjectsListRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	args, validationMessage := projectsArgsFromRequest(request)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	projects, unassigned, warnings, err := collectProjects(ctx, client, args)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list %v", err)), nil
+	}
+
+	response := &linodev1.ProjectsListResponse{
+		Count:      linodeIDToInt32(len(projects)),
+		Projects:   []*linodev1.ProjectSummary{},
+		Unassigned: linodeIDToInt32(unassigned),
+		Warnings:   warnings,
+	}
+
+	for _, name := range projectNames(projects) {
+		response.Projects = append(response.Projects, projectSummary(name, projects[name]))
+	}
+
+	response.Message = fmt.Sprintf("Found %d projects; %d resources belong to no project", len(projects), unassigned)
+
+	return MarshalProtoToolResponse(response)
+}
+
+func handleLinodeProjectsGetRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	name := strings.TrimSpace(request.GetString("project", ""))
+	if name == "" {
+		return mcp.NewToolResultError("project is required"), nil
+	}
+
+	args, validationMessage := projectsArgsFromRequest(request)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	projects, _, warnings, err := collectProjects(ctx, client, args)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list %v", err)), nil
+	}
+
+	resources, ok := projects[name]
+	if !ok {
+		known := "none"
+		if len(projects) > 0 {
+			known = strings.Join(projectNames(projects), ", ")
+		}
+
+		return mcp.NewToolResultError(fmt.Sprintf("No project named %q; known projects: %s", name, known)), nil
+	}
+
+	slices.SortStableFunc(resources, func(a, b *linodev1.ProjectResource) int {
+		if order := slices.Index(projectKinds, a.GetKind()) - slices.Index(projectKinds, b.GetKind()); order != 0 {
+			return order
+		}
+
+		return int(a.GetId() - b.GetId())
+	})
+
+	summary := projectSummary(name, resources)
+
+	return MarshalProtoToolResponse(&linodev1.ProjectsGetResponse{
+		Message: fmt.Sprintf("Project %s has %d resources costing $%.2f a month",
+			name, len(resources), summary.GetMonthlyCost()),
+		Project:   summary,
+		Resources: resources,
+		Warnings:  warnings,
+	})
+}
+
+// projectsArgsFromRequest reads and validates tag_prefix and source.
+func projectsArgsFromRequest(request *mcp.CallToolRequest) (projectsArgs, string) {
+	args := projectsArgs{
+		tagPrefix: request.GetString("tag_prefix", projectsTagPrefixDefault),
+		source:    request.GetString("source", projectSourceBoth),
+	}
+
+	if strings.TrimSpace(args.tagPrefix) == "" {
+		return args, "tag_prefix must not be empty"
+	}
+
+	if !slices.Contains([]string{projectSourceGroup, projectSourceTag, projectSourceBoth}, args.source) {
+		return args, "source must be one of: group, tag, both"
+	}
+
+	return args, ""
+}
+
+// projectMemberships maps each project a resource belongs to onto the source
+// that put it there. The group counts only for instances and when source
+// allows it; a project tag matches tag_prefix case-insensitively.
+func projectMemberships(args projectsArgs, group string, tags []string) map[string]string {
+	memberships := map[string]string{}
+
+	if group = strings.TrimSpace(group); group != "" && args.source != projectSourceTag {
+		memberships[group] = projectSourceGroup
+	}
+
+	if args.source == projectSourceGroup {
+		return memberships
+	}
+
+	for _, tag := range tags {
+		if len(tag) < len(args.tagPrefix) || !strings.EqualFold(tag[:len(args.tagPrefix)], args.tagPrefix) {
+			continue
+		}
+
+		name := strings.TrimSpace(tag[len(args.tagPrefix):])
+
+		switch memberships[name] {
+		case "":
+			if name != "" {
+				memberships[name] = projectSourceTag
+			}
+		case projectSourceGroup:
+			memberships[name] = projectSourceBoth
+		}
+	}
+
+	return memberships
+}
+
+// collectProjects lists the instances, volumes, NodeBalancers, and domains one
+// collection at a time so the calls stay inside the client's rate limit, and
+// files each resource under its projects. It also returns how many resources
+// are in no project. A missing price table is an envelope warning, not an
+// error, and a collection the token may not read is skipped with a returned
+// warning, so a restricted token still sees the projects it can.
+func collectProjects(ctx context.Context, client *linode.Client, args projectsArgs) (map[string][]*linodev1.ProjectResource, int, []string, error) {
+	prices := fetchPriceTables(ctx, client)
+	projects := map[string][]*linodev1.ProjectResource{}
+	unassigned := 0
+	warnings := []string{}
+
+	file := func(resource *linodev1.ProjectResource, group string, tags []string) {
+		memberships := projectMemberships(args, group, tags)
+		if len(memberships) == 0 {
+			unassigned++
+
+			return
+		}
+
+		for name, source := range memberships {
+			entry := &linodev1.ProjectResource{
+				Kind:        resource.GetKind(),
+				Id:          resource.GetId(),
+				Label:       resource.GetLabel(),
+				Region:      resource.GetRegion(),
+				Type:        resource.GetType(),
+				SizeGb:      resource.GetSizeGb(),
+				Source:      source,
+				MonthlyCost: resource.GetMonthlyCost(),
+			}
+			projects[name] = append(projects[name], entry)
+		}
+	}
+
+	instances, err := client.ListInstancesProto(ctx)
+	if warning, gap := permissionGap("instances", err); gap {
+		warnings = append(warnings, warning)
+	} else if err != nil {
+		return nil, 0, nil, fmt.Errorf("instances: %w", err)
+	}
+
+	for _, instance := range instances {
+		file(&linodev1.ProjectResource{
+			Kind:        "instance",
+			Id:          instance.GetId(),
+			Label:       instance.GetLabel(),
+			Region:      instance.GetRegion(),
+			Type:        instance.GetType(),
+			MonthlyCost: prices.instance(instance),
+		}, instance.GetGroup(), instance.GetTags())
+	}
+
+	volumes, err := client.ListVolumesProto(ctx)
+	if warning, gap := permissionGap("volumes", err); gap {
+		warnings = append(warnings, warning)
+	} else if err != nil {
+		return nil, 0, nil, fmt.Errorf("volumes: %w", err)
+	}
+
+	for _, volume := range volumes {
+		file(&linodev1.ProjectResource{
+			Kind:        "volume",
+			Id:          volume.GetId(),
+			Label:       volume.GetLabel(),
+			Region:      volume.GetRegion(),
+			SizeGb:      volume.GetSize(),
+			MonthlyCost: prices.volumeMonthly(int(volume.GetSize()), volume.GetRegion()),
+		}, "", volume.GetTags())
+	}
+
+	nodeBalancers, err := client.ListNodeBalancersProto(ctx)
+	if warning, gap := permissionGap("NodeBalancers", err); gap {
+		warnings = append(warnings, warning)
+	} else if err != nil {
+		return nil, 0, nil, fmt.Errorf("NodeBalancers: %w", err)
+	}
+
+	for _, nodeBalancer := range nodeBalancers {
+		file(&linodev1.ProjectResource{
+			Kind:        "nodebalancer",
+			Id:          nodeBalancer.GetId(),
+			Label:       nodeBalancer.GetLabel(),
+			Region:      nodeBalancer.GetRegion(),
+			MonthlyCost: prices.nodeBalancerMonthly(nodeBalancer.GetRegion()),
+		}, "", nodeBalancer.GetTags())
+	}
+
+	domains, err := client.ListDomainsProto(ctx)
+	if warning, gap := permissionGap("domains", err); gap {
+		warnings = append(warnings, warning)
+	} else if err != nil {
+		return nil, 0, nil, fmt.Errorf("domains: %w", err)
+	}
+
+	for _, domain := range domains {
+		file(&linodev1.ProjectResource{
+			Kind:  "domain",
+			Id:    domain.GetId(),
+			Label: domain.GetDomain(),
+			Type:  domain.GetType(),
+		}, "", domain.GetTags())
+	}
+
+	for _, warning := range warnings {
+		AddWarning(ctx, "%s", warning)
+	}
+
+	return projects, unassigned, warnings, nil
+}
+
+// projectNames returns the project names in order.
+func projectNames(projects map[string][]*linodev1.ProjectResource) []string {
+	names := make([]string, 0, len(projects))
+	for name := range projects {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// projectSummary counts a project's resources by kind and totals their cost.
+func projectSummary(name string, resources []*linodev1.ProjectResource) *linodev1.ProjectSummary {
+	summary := &linodev1.ProjectSummary{Name: name}
+
+	var cost float64
+
+	for _, resource := range resources {
+		switch resource.GetKind() {
+		case "instance":
+			summary.Instances++
+		case "volume":
+			summary.Volumes++
+		case "nodebalancer":
+			summary.Nodebalancers++
+		case "domain":
+			summary.Domains++
+		}
+
+		cost += resource.GetMonthlyCost()
+	}
+
+	summary.MonthlyCost = roundCents(cost)
+
+	return summary
+}
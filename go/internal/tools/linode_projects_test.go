@@ -0,0 +1,218 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// newProjectsTestServer serves an account with a billing project (one
+// instance in both the group and the tag, a volume, and a domain), a web
+// project (one tagged instance and a NodeBalancer), and one resource in no
+// project. Each path in forbidden answers 403, as it would for a restricted
+// token.
+func newProjectsTestServer(t *testing.T, forbidden ...string) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if slices.Contains(forbidden, r.URL.Path) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":[{"reason":"Unauthorized"}]}`))
+
+			return
+		}
+
+		var body string
+
+		switch r.URL.Path {
+		case "/linode/types":
+			body = `{"data":[{"id":"g6-standard-2","price":{"hourly":0.036,"monthly":24},` +
+				`"addons":{"backups":{"price":{"hourly":0.0075,"monthly":5}}}}],"page":1,"pages":1,"results":1}`
+		case "/volumes/types":
+			body = `{"data":[{"id":"volume","price":{"hourly":0.00015,"monthly":0.1},` +
+				`"region_prices":[{"id":"br-gru","hourly":0.00021,"monthly":0.14}]}],"page":1,"pages":1,"results":1}`
+		case "/nodebalancers/types":
+			body = `{"data":[{"id":"nodebalancer","price":{"hourly":0.015,"monthly":10},"region_prices":[]}],"page":1,"pages":1,"results":1}`
+		case "/linode/instances":
+			body = `{"data":[` +
+				`{"id":1,"label":"api","region":"br-gru","type":"g6-standard-2","group":"billing","tags":["project:billing"],"backups":{"enabled":true}},` +
+				`{"id":2,"label":"web","region":"us-east","type":"g6-standard-2","tags":["Project:web","env:prod"]},` +
+				`{"id":3,"label":"scratch","region":"us-east","type":"g6-standard-2","tags":[]}` +
+				`],"page":1,"pages":1,"results":3}`
+		case "/volumes":
+			body = `{"data":[{"id":10,"label":"ledger","region":"br-gru","size":50,"tags":["project:billing"]}],"page":1,"pages":1,"results":1}`
+		case "/nodebalancers":
+			body = `{"data":[{"id":20,"label":"web-lb","region":"us-east","tags":["project:web"]}],"page":1,"pages":1,"results":1}`
+		case "/domains":
+			body = `{"data":[{"id":30,"domain":"billing.example.com","type":"master","tags":["project:billing"]}],"page":1,"pages":1,"results":1}`
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestLinodeProjectsListSummarizesProjects(t *testing.T) {
+	t.Parallel()
+
+	srv := newProjectsTestServer(t)
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeProjectsListTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var list struct {
+		Projects []struct {
+			Name          string  `json:"name"`
+			Instances     int     `json:"instances"`
+			Volumes       int     `json:"volumes"`
+			Nodebalancers int     `json:"nodebalancers"`
+			Domains       int     `json:"domains"`
+			MonthlyCost   float64 `json:"monthly_cost"`
+		} `json:"projects"`
+		Unassigned int `json:"unassigned"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(list.Projects) != 2 || list.Projects[0].Name != "billing" || list.Projects[1].Name != "web" {
+		t.Fatalf("projects = %+v, want billing then web", list.Projects)
+	}
+
+	// api (24 + 5 backups) plus a 50 GB volume at the br-gru price of 0.14.
+	billing := list.Projects[0]
+	if billing.Instances != 1 || billing.Volumes != 1 || billing.Domains != 1 || billing.MonthlyCost != 36 {
+		t.Errorf("billing = %+v, want 1 instance, 1 volume, 1 domain costing 36", billing)
+	}
+
+	web := list.Projects[1]
+	if web.Instances != 1 || web.Nodebalancers != 1 || web.MonthlyCost != 34 {
+		t.Errorf("web = %+v, want 1 instance and 1 NodeBalancer costing 34", web)
+	}
+
+	if list.Unassigned != 1 {
+		t.Errorf("unassigned = %d, want 1", list.Unassigned)
+	}
+}
+
+func TestLinodeProjectsGetListsResources(t *testing.T) {
+	t.Parallel()
+
+	srv := newProjectsTestServer(t)
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeProjectsGetTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"project": "billing", "source": "group"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var project struct {
+		Resources []struct {
+			Kind   string `json:"kind"`
+			ID     int    `json:"id"`
+			Source string `json:"source"`
+		} `json:"resources"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &project); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(project.Resources) != 1 || project.Resources[0].ID != 1 || project.Resources[0].Source != "group" {
+		t.Errorf("resources = %+v, want only instance 1 from its group", project.Resources)
+	}
+
+	result, err = handler(t.Context(), createRequestWithArgs(t, map[string]any{"project": "mobile"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !result.IsError {
+		t.Fatal("result.IsError = false, want true for an unknown project")
+	}
+
+	textContent, ok = result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	if textContent.Text != `No project named "mobile"; known projects: billing, web` {
+		t.Errorf("error = %q, want the known projects listed", textContent.Text)
+	}
+}
+
+// A collection the token may not read is skipped with a warning naming it,
+// and the projects built from the rest are still returned.
+func TestLinodeProjectsListSkipsForbiddenCollections(t *testing.T) {
+	t.Parallel()
+
+	srv := newProjectsTestServer(t, "/volumes", "/domains")
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeProjectsListTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want partial projects", result.Content)
+	}
+
+	var list struct {
+		Projects []struct {
+			Name    string `json:"name"`
+			Volumes int    `json:"volumes"`
+			Domains int    `json:"domains"`
+		} `json:"projects"`
+		Warnings []string `json:"warnings"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(list.Projects) != 2 || list.Projects[0].Volumes != 0 || list.Projects[0].Domains != 0 {
+		t.Errorf("projects = %+v, want billing and web without volumes or domains", list.Projects)
+	}
+
+	if len(list.Warnings) != 2 || !strings.HasPrefix(list.Warnings[0], "volumes skipped: the token lacks permission") ||
+		!strings.HasPrefix(list.Warnings[1], "domains skipped: the token lacks permission") {
+		t.Errorf("warnings = %q, want volumes then domains skipped", list.Warnings)
+	}
+}
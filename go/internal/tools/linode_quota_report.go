@@ -0,0 +1,186 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// Where a linode_quota_report limit came from.
+const (
+	quotaLimitSourceConfig       = "config"
+	quotaLimitSourceCapabilities = "capabilities"
+	quotaLimitSourceUnknown      = "unknown"
+)
+
+// quotaResource is one entity type linode_quota_report counts: the collection
+// endpoint whose "results" total is the count, and the account capability
+// the account needs to create any (empty when none gates it).
+type quotaResource struct {
+	name       string
+	endpoint   string
+	capability string
+}
+
+// quotaResources lists the counted entity types in report order. The
+// Python server keeps the same table in linode_quota_report.py.
+var quotaResources = []quotaResource{
+	{name: "instances", endpoint: "/linode/instances", capability: "Linodes"},
+	{name: "volumes", endpoint: "/volumes", capability: "Block Storage"},
+	{name: "nodebalancers", endpoint: "/nodebalancers", capability: "NodeBalancers"},
+	{name: "lke_clusters", endpoint: "/lke/clusters", capability: "Kubernetes"},
+	{name: "firewalls", endpoint: "/networking/firewalls", capability: "Cloud Firewall"},
+	{name: "vpcs", endpoint: "/vpcs", capability: "VPCs"},
+	{name: "domains", endpoint: "/domains"},
+	{name: "databases", endpoint: "/databases/instances", capability: "Managed Databases"},
+	{name: "placement_groups", endpoint: "/placement/groups", capability: "Placement Group"},
+	{name: "buckets", endpoint: "/object-storage/buckets", capability: "Object Storage"},
+}
+
+// NewLinodeQuotaReportTool creates a tool that reports the account's entity
+// counts against their known limits.
+func NewLinodeQuotaReportTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_quota_report",
+		"Counts the account's instances, volumes, NodeBalancers, LKE clusters, firewalls, VPCs, domains, "+
+			"databases, placement groups, and buckets, and reports each count against its limit and the "+
+			"remaining headroom. Limits come from the quotas.limits config; a resource the account lacks the "+
+			"capability for has a limit of 0. Pass resource (and optionally additional, default 1) to check "+
+			"whether that many more would fit before creating them.",
+		toolschemas.Schema("linode.mcp.v1.QuotaReportInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeQuotaReportRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLinodeQuotaReportRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	resource := strings.TrimSpace(request.GetString("resource", ""))
+	if resource != "" && !slices.ContainsFunc(quotaResources, func(r quotaResource) bool { return r.name == resource }) {
+		return mcp.NewToolResultError("resource must be one of: " + quotaResourceNames()), nil
+	}
+
+	additional := request.GetInt("additional", 1)
+	if additional < 1 {
+		return mcp.NewToolResultError("additional must be a positive integer"), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// A nil capabilities slice means they are unknown, so no resource is
+	// zeroed for lacking one.
+	var capabilities []string
+
+	account, err := client.GetAccountProto(ctx)
+	if err != nil {
+		AddWarning(ctx, "account capabilities unavailable, limits come from config only: %v", err)
+	} else {
+		capabilities = append([]string{}, account.GetCapabilities()...)
+	}
+
+	response := &linodev1.QuotaReportResponse{
+		Usage: quotaUsage(ctx, client, resolveConfig(cfg).Quotas.Limits, capabilities),
+	}
+
+	if resource != "" {
+		response.Check = quotaCheck(response.GetUsage(), resource, additional)
+	}
+
+	return MarshalProtoToolResponse(response)
+}
+
+// quotaResourceNames is the comma-separated list of valid resource values.
+func quotaResourceNames() string {
+	names := make([]string, 0, len(quotaResources))
+	for _, r := range quotaResources {
+		names = append(names, r.name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// quotaUsage counts every resource, one request at a time so the calls stay
+// inside the client's rate limit. A resource the account lacks the capability
+// for has a limit of 0; otherwise the configured limit applies when there is
+// one. A failed count is reported on its entry and as an envelope warning.
+func quotaUsage(ctx context.Context, client *linode.Client, limits map[string]int, capabilities []string) []*linodev1.QuotaUsage {
+	usage := make([]*linodev1.QuotaUsage, 0, len(quotaResources))
+
+	for _, r := range quotaResources {
+		entry := &linodev1.QuotaUsage{Resource: r.name, LimitSource: quotaLimitSourceUnknown}
+
+		count, err := client.CountResults(ctx, r.endpoint)
+		if err != nil {
+			entry.Error = new(err.Error())
+
+			AddWarning(ctx, "%s count unavailable: %v", r.name, err)
+		}
+
+		entry.Count = linodeIDToInt32(count)
+
+		limit, configured := limits[r.name]
+
+		switch {
+		case capabilities != nil && r.capability != "" && !slices.Contains(capabilities, r.capability):
+			entry.Limit = new(int32(0))
+			entry.LimitSource = quotaLimitSourceCapabilities
+		case configured:
+			entry.Limit = new(linodeIDToInt32(limit))
+			entry.LimitSource = quotaLimitSourceConfig
+		}
+
+		if entry.Limit != nil {
+			entry.Headroom = new(max(entry.GetLimit()-entry.GetCount(), 0))
+		}
+
+		usage = append(usage, entry)
+	}
+
+	return usage
+}
+
+// quotaCheck answers whether additional more of resource fit under its limit.
+// fits is left unset when the limit or the count is unknown.
+func quotaCheck(usage []*linodev1.QuotaUsage, resource string, additional int) *linodev1.QuotaCheck {
+	check := &linodev1.QuotaCheck{Resource: resource, Additional: linodeIDToInt32(additional)}
+
+	index := slices.IndexFunc(usage, func(u *linodev1.QuotaUsage) bool { return u.GetResource() == resource })
+	if index < 0 {
+		return check
+	}
+
+	entry := usage[index]
+
+	switch {
+	case entry.Error != nil:
+		check.Message = fmt.Sprintf("Cannot check %s: the current count is unavailable", resource)
+	case entry.Limit == nil:
+		check.Message = fmt.Sprintf("No known limit for %s (%d in use); set quotas.limits.%s to check it",
+			resource, entry.GetCount(), resource)
+	case entry.GetCount()+check.GetAdditional() <= entry.GetLimit():
+		check.Fits = new(true)
+		check.Message = fmt.Sprintf("%d more %s fit: %d of %d in use", additional, resource,
+			entry.GetCount(), entry.GetLimit())
+	default:
+		check.Fits = new(false)
+		check.Message = fmt.Sprintf("%d more %s do not fit: %d of %d in use leaves room for %d", additional,
+			resource, entry.GetCount(), entry.GetLimit(), entry.GetHeadroom())
+	}
+
+	return check
+}
@@ -0,0 +1,165 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// quotaServer reports an account without the Kubernetes capability and
+// three of every counted collection.
+func quotaServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		body := `{"data":[],"page":1,"pages":1,"results":3}`
+		if r.URL.Path == "/account" {
+			body = `{"capabilities":["Linodes","Block Storage","NodeBalancers","Cloud Firewall","VPCs",` +
+				`"Managed Databases","Placement Group","Object Storage"]}`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+type quotaReport struct {
+	Usage []struct {
+		Resource    string `json:"resource"`
+		Count       int    `json:"count"`
+		Limit       *int   `json:"limit"`
+		Headroom    *int   `json:"headroom"`
+		LimitSource string `json:"limit_source"`
+	} `json:"usage"`
+	Check *struct {
+		Fits    *bool  `json:"fits"`
+		Message string `json:"message"`
+	} `json:"check"`
+}
+
+func runQuotaReport(t *testing.T, limits map[string]int, args map[string]any) quotaReport {
+	t.Helper()
+
+	srv := quotaServer(t)
+	cfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}},
+		Quotas:       config.QuotaConfig{Limits: limits},
+	}
+	_, _, handler := tools.NewLinodeQuotaReportTool(cfg)
+
+	result, err := handler(tools.WithWarnings(t.Context()), createRequestWithArgs(t, args))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var report quotaReport
+	if err := json.Unmarshal([]byte(textContent.Text), &report); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return report
+}
+
+func TestLinodeQuotaReportLimitSources(t *testing.T) {
+	t.Parallel()
+
+	report := runQuotaReport(t, map[string]int{"instances": 5}, map[string]any{})
+
+	if len(report.Usage) != 10 {
+		t.Fatalf("len(report.Usage) = %d, want 10", len(report.Usage))
+	}
+
+	sources := map[string]string{}
+	for _, usage := range report.Usage {
+		sources[usage.Resource] = usage.LimitSource
+
+		if usage.Count != 3 {
+			t.Errorf("%s count = %d, want 3", usage.Resource, usage.Count)
+		}
+	}
+
+	want := map[string]string{"instances": "config", "lke_clusters": "capabilities", "volumes": "unknown"}
+	for resource, source := range want {
+		if sources[resource] != source {
+			t.Errorf("%s limit_source = %q, want %q", resource, sources[resource], source)
+		}
+	}
+
+	instances := report.Usage[0]
+	if instances.Limit == nil || *instances.Limit != 5 || instances.Headroom == nil || *instances.Headroom != 2 {
+		t.Errorf("instances limit/headroom = %v/%v, want 5/2", instances.Limit, instances.Headroom)
+	}
+
+	if report.Check != nil {
+		t.Errorf("report.Check = %+v, want nil without resource", report.Check)
+	}
+}
+
+func TestLinodeQuotaReportCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args map[string]any
+		fits *bool
+	}{
+		{name: "fits", args: map[string]any{"resource": "instances", "additional": 2}, fits: new(true)},
+		{name: "exceeds", args: map[string]any{"resource": "instances", "additional": 10}, fits: new(false)},
+		{name: "missing capability", args: map[string]any{"resource": "lke_clusters"}, fits: new(false)},
+		{name: "unknown limit", args: map[string]any{"resource": "volumes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			report := runQuotaReport(t, map[string]int{"instances": 5}, tt.args)
+			if report.Check == nil {
+				t.Fatal("report.Check = nil, want a check")
+			}
+
+			switch {
+			case tt.fits == nil && report.Check.Fits != nil:
+				t.Errorf("fits = %v, want unset", *report.Check.Fits)
+			case tt.fits != nil && (report.Check.Fits == nil || *report.Check.Fits != *tt.fits):
+				t.Errorf("fits = %v, want %v (%s)", report.Check.Fits, *tt.fits, report.Check.Message)
+			}
+		})
+	}
+}
+
+func TestLinodeQuotaReportRejectsUnknownResource(t *testing.T) {
+	t.Parallel()
+
+	_, _, handler := tools.NewLinodeQuotaReportTool(&config.Config{})
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"resource": "gpus"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !result.IsError {
+		t.Error("result.IsError = false, want true")
+	}
+}
@@ -56,7 +56,7 @@ func NewLinodeRegionGetTool(cfg *config.Config) (mcp.Tool, profiles.Capability,
 			return mcp.NewToolResultError(validationMessage), nil
 		}
 
-		client, err := prepareClient(&request, cfg)
+		client, err := prepareClient(ctx, &request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
@@ -107,7 +107,7 @@ func NewLinodeRegionAvailabilityGetTool(cfg *config.Config) (mcp.Tool, profiles.
 			return mcp.NewToolResultError(validationMessage), nil
 		}
 
-		client, err := prepareClient(&request, cfg)
+		client, err := prepareClient(ctx, &request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
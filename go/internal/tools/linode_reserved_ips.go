@@ -100,7 +100,7 @@ func NewLinodeReservedIPListTool(cfg *config.Config) (mcp.Tool, profiles.Capabil
 			return mcp.NewToolResultError(validationMessage), nil
 		}
 
-		client, err := prepareClient(&request, cfg)
+		client, err := prepareClient(ctx, &request, cfg)
 		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
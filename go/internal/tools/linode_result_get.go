@@ -0,0 +1,88 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/resultstore"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// NewLinodeResultGetTool returns the linode_result_get query tool. It returns
+// a full tool result that result_summary replaced with a summary, by the
+// result_id the summary named. CapMeta so it is available in every profile.
+// Reads the server's in-memory result store and makes no API call.
+func NewLinodeResultGetTool(
+	_ *config.Config,
+) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_result_get",
+		"Get the full result behind a summarized tool result, by the result_id its note names. "+
+			"Results are kept in memory for the latest summaries only and do not survive a server restart.",
+		toolschemas.Schema("linode.mcp.v1.ResultGetInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		id, validationMessage := requiredStringArg(request.GetArguments(), "result_id")
+		if validationMessage != "" {
+			return mcp.NewToolResultError(validationMessage), nil
+		}
+
+		partition, err := storePartition(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		store := resultStoreFromContext(ctx)
+		if store == nil {
+			return mcp.NewToolResultErrorf("result %s was not found", id), nil
+		}
+
+		entry, ok := store.Get(partition, id)
+		if !ok {
+			return mcp.NewToolResultErrorf("result %s was not found; only the latest %d summarized results are kept, "+
+				"and none survive a server restart", id, resultstore.MaxResults), nil
+		}
+
+		return MarshalProtoToolResponse(resultGetProto(&entry))
+	}
+
+	return tool, profiles.CapMeta, handler
+}
+
+// resultGetProto renders a stored result, parsing each text item that is JSON
+// so the full result reads as it did before it was summarized.
+func resultGetProto(entry *resultstore.Entry) *linodev1.ResultGetResponse {
+	response := &linodev1.ResultGetResponse{
+		ResultId: entry.ID,
+		Tool:     entry.Tool,
+		StoredAt: entry.StoredAt.Format(time.RFC3339),
+		Content:  make([]*structpb.Value, 0, len(entry.Content)),
+	}
+
+	for _, text := range entry.Content {
+		response.Content = append(response.Content, resultContentValue(text))
+	}
+
+	return response
+}
+
+// resultContentValue is a text item parsed as JSON, or the text itself when
+// it is not JSON.
+func resultContentValue(text string) *structpb.Value {
+	var parsed any
+	if json.Unmarshal([]byte(text), &parsed) == nil {
+		if value, err := structpb.NewValue(parsed); err == nil {
+			return value
+		}
+	}
+
+	return structpb.NewStringValue(text)
+}
@@ -0,0 +1,539 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+const (
+	securityPostureTokenAgeDefault = 90
+	securityPostureTokenAgeLimit   = 3650
+	securityPostureLoginDefault    = 30
+	securityPostureLoginLimit      = 365
+
+	// securityPosturePageSize reads users, tokens, and logins in one page of
+	// the API's largest size.
+	securityPosturePageSize = 500
+
+	securityPostureMaxScore = 100
+)
+
+// Statuses a security posture check reports.
+const (
+	postureStatusPass    = "pass"
+	postureStatusFail    = "fail"
+	postureStatusUnknown = "unknown"
+)
+
+// Severities a security posture check carries, with the points a failure
+// costs.
+const (
+	postureSeverityHigh   = "high"
+	postureSeverityMedium = "medium"
+	postureSeverityLow    = "low"
+
+	posturePenaltyHigh   = 25
+	posturePenaltyMedium = 15
+	posturePenaltyLow    = 5
+)
+
+// postureBroadScopes are the token scopes that reach every resource or the
+// account itself (users, billing, and other tokens).
+var postureBroadScopes = []string{"*", "account:read_write"}
+
+// postureBucketPublicACLs are the bucket ACLs that let someone outside the
+// account read (or write) objects.
+var postureBucketPublicACLs = map[string]bool{
+	"public-read":        true,
+	"public-read-write":  true,
+	"authenticated-read": true,
+}
+
+// postureLegacyCipherSuite is the NodeBalancer HTTPS cipher suite that still
+// accepts older TLS versions and weaker ciphers.
+const postureLegacyCipherSuite = "legacy"
+
+// securityPostureData is everything the report reads. A nil error field with
+// an empty slice means the collection was read and is empty; a set error
+// turns the matching check unknown.
+type securityPostureData struct {
+	profile        *linodev1.Profile
+	users          []*linodev1.AccountUser
+	usersErr       error
+	tokens         []*linodev1.PersonalAccessToken
+	tokensErr      error
+	keys           []*linodev1.SSHKey
+	keysErr        error
+	events         []*linodev1.AccountEvent
+	eventsErr      error
+	publicBuckets  []string
+	bucketsErr     error
+	logins         []*linodev1.AccountLogin
+	loginsErr      error
+	legacyTLSPorts []string
+	httpsPorts     int
+	tlsErr         error
+	unreadBuckets  int
+	eventsComplete bool
+}
+
+// NewLinodeSecurityPostureTool creates a tool that scores the account's
+// security posture and names the tools that fix each finding.
+func NewLinodeSecurityPostureTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_security_posture",
+		"Scores the account's security posture from 0 to 100 in one report: two-factor authentication on the profile "+
+			"and on every other user, restricted versus unrestricted users, personal access tokens with broad scopes "+
+			"(* or account:read_write) older than token_max_age_days (default 90), profile SSH keys with no instance "+
+			"deployed since they were added, public Object Storage buckets, NodeBalancer HTTPS ports on the legacy "+
+			"cipher suite, and failed account logins in the last login_days (default 30). Each failed check lists what failed and remediation steps naming the tool that "+
+			"fixes it; checks the token cannot read are reported unknown instead of failing the report.",
+		toolschemas.Schema("linode.mcp.v1.SecurityPostureInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeSecurityPostureRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLinodeSecurityPostureRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	tokenMaxAge := securityPostureTokenAgeDefault
+	if _, exists := request.GetArguments()["token_max_age_days"]; exists {
+		value, msg := boundedIntArgument(request, "token_max_age_days", 1, securityPostureTokenAgeLimit,
+			fmt.Sprintf("token_max_age_days must be from 1 through %d", securityPostureTokenAgeLimit))
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		tokenMaxAge = value
+	}
+
+	loginDays := securityPostureLoginDefault
+	if _, exists := request.GetArguments()["login_days"]; exists {
+		value, msg := boundedIntArgument(request, "login_days", 1, securityPostureLoginLimit,
+			fmt.Sprintf("login_days must be from 1 through %d", securityPostureLoginLimit))
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		loginDays = value
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	profile, err := client.GetProfileProto(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to build security posture report: %v", err)), nil
+	}
+
+	data := securityPostureData{profile: profile}
+	data.users, data.usersErr = client.ListAccountUsersProto(ctx, 1, securityPosturePageSize)
+	data.tokens, data.tokensErr = client.ListProfileTokensProto(ctx, 1, securityPosturePageSize)
+	data.keys, data.keysErr = client.ListSSHKeysProto(ctx)
+
+	if data.keysErr == nil {
+		data.events, data.eventsComplete, data.eventsErr = sshKeyUsageEvents(ctx, client)
+	}
+
+	data.publicBuckets, data.unreadBuckets, data.bucketsErr = securityPosturePublicBuckets(ctx, client)
+	data.legacyTLSPorts, data.httpsPorts, data.tlsErr = securityPostureLegacyTLSPorts(ctx, client)
+	data.logins, data.loginsErr = client.ListAccountLoginsProto(ctx, 1, securityPosturePageSize)
+
+	response := securityPostureReport(&data, time.Now().UTC(), tokenMaxAge, loginDays)
+	for _, warning := range response.GetWarnings() {
+		AddWarning(ctx, "%s", warning)
+	}
+
+	return MarshalProtoToolResponse(response)
+}
+
+// securityPosturePublicBuckets lists the buckets whose ACL is public as
+// "region/label (acl)". unread counts buckets whose access settings could not
+// be read; they are left out rather than failing the check.
+func securityPosturePublicBuckets(ctx context.Context, client *linode.Client) ([]string, int, error) {
+	buckets, err := client.ListObjectStorageBucketsProto(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	public := []string{}
+	unread := 0
+
+	for _, bucket := range buckets {
+		region := bucket.GetRegion()
+		if region == "" {
+			region = bucket.GetCluster()
+		}
+
+		access, err := client.GetObjectStorageBucketAccessProto(ctx, region, bucket.GetLabel())
+		if err != nil {
+			unread++
+
+			continue
+		}
+
+		if postureBucketPublicACLs[access.GetAcl()] {
+			public = append(public, fmt.Sprintf("%s/%s (%s)", region, bucket.GetLabel(), access.GetAcl()))
+		}
+	}
+
+	return public, unread, nil
+}
+
+// securityPostureLegacyTLSPorts lists the NodeBalancer HTTPS ports whose
+// cipher suite is legacy, naming each port's active suite, and counts every
+// HTTPS port read.
+func securityPostureLegacyTLSPorts(ctx context.Context, client *linode.Client) ([]string, int, error) {
+	nodeBalancers, err := client.ListNodeBalancersProto(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	legacy := []string{}
+	https := 0
+
+	for _, nodeBalancer := range nodeBalancers {
+		configs, err := client.ListNodeBalancerConfigsProto(ctx, int(nodeBalancer.GetId()), 1, securityPosturePageSize)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, nbConfig := range configs {
+			if nbConfig.GetProtocol() != "https" {
+				continue
+			}
+
+			https++
+
+			if nbConfig.GetCipherSuite() == postureLegacyCipherSuite {
+				legacy = append(legacy, fmt.Sprintf("%s port %d (nodebalancer %d, config %d, cipher_suite %s)",
+					nodeBalancer.GetLabel(), nbConfig.GetPort(), nodeBalancer.GetId(), nbConfig.GetId(), nbConfig.GetCipherSuite()))
+			}
+		}
+	}
+
+	return legacy, https, nil
+}
+
+// securityPostureReport builds the scored report as of now.
+func securityPostureReport(data *securityPostureData, now time.Time, tokenMaxAge, loginDays int) *linodev1.SecurityPostureResponse {
+	response := &linodev1.SecurityPostureResponse{Score: securityPostureMaxScore, Warnings: []string{}}
+	username := data.profile.GetUsername()
+
+	for _, user := range data.users {
+		if user.GetRestricted() {
+			response.RestrictedUsers++
+		} else {
+			response.UnrestrictedUsers++
+		}
+	}
+
+	response.Checks = []*linodev1.SecurityPostureCheck{
+		postureProfileTFACheck(data.profile),
+		postureUsersTFACheck(data, username),
+		postureRestrictedUsersCheck(data, response),
+		postureBroadTokensCheck(data, now, tokenMaxAge),
+		postureUnusedSSHKeysCheck(data, username),
+		posturePublicBucketsCheck(data),
+		postureLegacyTLSCheck(data),
+		postureFailedLoginsCheck(data, now, loginDays),
+	}
+
+	if data.unreadBuckets > 0 {
+		response.Warnings = append(response.Warnings, fmt.Sprintf("Access settings for %d bucket(s) could not be read, so they were not checked for public ACLs.", data.unreadBuckets))
+	}
+
+	if data.eventsErr == nil && data.keysErr == nil && !data.eventsComplete {
+		response.Warnings = append(response.Warnings, fmt.Sprintf("Only the newest %d events were scanned; keys deployed before then count as unused.",
+			sshKeyUsageEventPageSize*sshKeyUsageEventPages))
+	}
+
+	for _, check := range response.GetChecks() {
+		switch check.GetStatus() {
+		case postureStatusFail:
+			response.FailedCount++
+			response.Score -= check.GetPenalty()
+		case postureStatusUnknown:
+			response.UnknownCount++
+			response.Warnings = append(response.Warnings, fmt.Sprintf("%s: %s", check.GetId(), check.GetDetail()))
+		}
+	}
+
+	response.Score = max(response.GetScore(), 0)
+	response.Message = fmt.Sprintf("Security posture score %d/%d: %d of %d checks failed, %d unknown",
+		response.GetScore(), securityPostureMaxScore, response.GetFailedCount(), len(response.GetChecks()), response.GetUnknownCount())
+
+	return response
+}
+
+// newPostureCheck returns a check with the penalty its severity carries. It
+// starts passing; the caller fails it or marks it unknown.
+func newPostureCheck(id, title, severity string) *linodev1.SecurityPostureCheck {
+	var penalty int32 = posturePenaltyLow
+
+	switch severity {
+	case postureSeverityHigh:
+		penalty = posturePenaltyHigh
+	case postureSeverityMedium:
+		penalty = posturePenaltyMedium
+	}
+
+	return &linodev1.SecurityPostureCheck{
+		Id:          id,
+		Title:       title,
+		Status:      postureStatusPass,
+		Severity:    severity,
+		Penalty:     penalty,
+		Items:       []string{},
+		Remediation: []*linodev1.SecurityPostureRemediation{},
+	}
+}
+
+// unknownPostureCheck marks check unknown because its data could not be read.
+func unknownPostureCheck(check *linodev1.SecurityPostureCheck, what string, err error) *linodev1.SecurityPostureCheck {
+	check.Status = postureStatusUnknown
+	check.Detail = fmt.Sprintf("could not read %s: %v", what, err)
+
+	return check
+}
+
+// failPostureCheck fails check with items and the steps that fix it.
+func failPostureCheck(check *linodev1.SecurityPostureCheck, detail string, items []string, steps ...*linodev1.SecurityPostureRemediation) *linodev1.SecurityPostureCheck {
+	check.Status = postureStatusFail
+	check.Detail = detail
+	check.Items = items
+	check.Remediation = steps
+
+	return check
+}
+
+func postureStep(tool, step string) *linodev1.SecurityPostureRemediation {
+	return &linodev1.SecurityPostureRemediation{Tool: tool, Step: step}
+}
+
+func postureProfileTFACheck(profile *linodev1.Profile) *linodev1.SecurityPostureCheck {
+	check := newPostureCheck("profile_2fa", "Two-factor authentication on this profile", postureSeverityHigh)
+	if profile.GetTwoFactorAuth() {
+		check.Detail = fmt.Sprintf("Two-factor authentication is enabled for %s.", profile.GetUsername())
+
+		return check
+	}
+
+	return failPostureCheck(check, fmt.Sprintf("Two-factor authentication is not enabled for %s; a leaked password is enough to log in.", profile.GetUsername()),
+		[]string{profile.GetUsername()},
+		postureStep("linode_profile_security_question_answer", "Answer the security questions; Linode requires them before two-factor authentication can be enabled."),
+		postureStep("linode_profile_tfa_enable", "Generate the two-factor secret and add it to an authenticator app."),
+		postureStep("linode_profile_tfa_enable_confirm", "Confirm with a code from the app to turn two-factor authentication on."),
+	)
+}
+
+func postureUsersTFACheck(data *securityPostureData, username string) *linodev1.SecurityPostureCheck {
+	check := newPostureCheck("users_2fa", "Two-factor authentication on other users", postureSeverityHigh)
+	if data.usersErr != nil {
+		return unknownPostureCheck(check, "account users", data.usersErr)
+	}
+
+	missing := []string{}
+
+	for _, user := range data.users {
+		if user.GetUsername() != username && !user.GetTfaEnabled() {
+			missing = append(missing, user.GetUsername())
+		}
+	}
+
+	if len(missing) == 0 {
+		check.Detail = "Every other user has two-factor authentication enabled."
+
+		return check
+	}
+
+	return failPostureCheck(check, fmt.Sprintf("%d other user(s) log in without two-factor authentication.", len(missing)), missing,
+		postureStep("linode_account_user_update", "Set restricted=true on each listed user until they enable two-factor authentication; only the user can enable it on their own profile."),
+		postureStep("linode_account_user_grants_update", "Grant each restricted user only the entities they need."),
+	)
+}
+
+func postureRestrictedUsersCheck(data *securityPostureData, response *linodev1.SecurityPostureResponse) *linodev1.SecurityPostureCheck {
+	check := newPostureCheck("restricted_users", "Unrestricted users", postureSeverityLow)
+	if data.usersErr != nil {
+		return unknownPostureCheck(check, "account users", data.usersErr)
+	}
+
+	detail := fmt.Sprintf("%d restricted and %d unrestricted user(s).", response.GetRestrictedUsers(), response.GetUnrestrictedUsers())
+	if response.GetUnrestrictedUsers() <= 1 {
+		check.Detail = detail
+
+		return check
+	}
+
+	unrestricted := []string{}
+
+	for _, user := range data.users {
+		if !user.GetRestricted() {
+			unrestricted = append(unrestricted, user.GetUsername())
+		}
+	}
+
+	return failPostureCheck(check, detail+" Each unrestricted user can change billing, users, and every resource.", unrestricted,
+		postureStep("linode_account_user_update", "Set restricted=true on users who do not need full account access."),
+		postureStep("linode_account_user_grants_update", "Grant each restricted user only the entities they need."),
+	)
+}
+
+func postureBroadTokensCheck(data *securityPostureData, now time.Time, maxAge int) *linodev1.SecurityPostureCheck {
+	check := newPostureCheck("broad_tokens", "Old personal access tokens with broad scopes", postureSeverityHigh)
+	if data.tokensErr != nil {
+		return unknownPostureCheck(check, "personal access tokens", data.tokensErr)
+	}
+
+	flagged := []string{}
+
+	for _, token := range data.tokens {
+		created, err := time.Parse(linodeTimeLayout, token.GetCreated())
+		if err != nil || !postureBroadScope(token.GetScopes()) {
+			continue
+		}
+
+		age := int(now.Sub(created).Hours() / 24)
+		if age >= maxAge {
+			flagged = append(flagged, fmt.Sprintf("%s (id %d, scopes %s, %d days old)", token.GetLabel(), token.GetId(), token.GetScopes(), age))
+		}
+	}
+
+	if len(flagged) == 0 {
+		check.Detail = fmt.Sprintf("No token with broad scopes is %d or more days old.", maxAge)
+
+		return check
+	}
+
+	return failPostureCheck(check, fmt.Sprintf("%d token(s) with broad scopes are %d or more days old.", len(flagged), maxAge), flagged,
+		postureStep("linode_profile_token_create", "Create a replacement with only the scopes its user needs and an expiry."),
+		postureStep("linode_profile_token_delete", "Revoke the old token once its users have switched to the replacement."),
+	)
+}
+
+// postureBroadScope reports whether a token's comma-separated scopes include
+// one of postureBroadScopes.
+func postureBroadScope(scopes string) bool {
+	for scope := range strings.SplitSeq(scopes, ",") {
+		for _, broad := range postureBroadScopes {
+			if strings.TrimSpace(scope) == broad {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func postureUnusedSSHKeysCheck(data *securityPostureData, username string) *linodev1.SecurityPostureCheck {
+	check := newPostureCheck("unused_ssh_keys", "Profile SSH keys without recent use", postureSeverityLow)
+	if data.keysErr != nil {
+		return unknownPostureCheck(check, "profile SSH keys", data.keysErr)
+	}
+
+	if data.eventsErr != nil {
+		return unknownPostureCheck(check, "the event feed", data.eventsErr)
+	}
+
+	unused := []string{}
+
+	for _, key := range data.keys {
+		if len(sshKeyInstances(data.events, username, key.GetCreated())) == 0 {
+			unused = append(unused, fmt.Sprintf("%s (id %d)", key.GetLabel(), key.GetId()))
+		}
+	}
+
+	if len(unused) == 0 {
+		check.Detail = "Every profile SSH key was deployed to an instance in the events scanned."
+
+		return check
+	}
+
+	return failPostureCheck(check, fmt.Sprintf("%d profile SSH key(s) were not deployed to any instance in the events scanned.", len(unused)), unused,
+		postureStep("linode_sshkey_usage_report", "Review each key's age, fingerprint, and likely uses."),
+		postureStep("linode_sshkey_delete", "Delete the keys nobody deploys with any more."),
+	)
+}
+
+func posturePublicBucketsCheck(data *securityPostureData) *linodev1.SecurityPostureCheck {
+	check := newPostureCheck("public_buckets", "Public Object Storage buckets", postureSeverityMedium)
+	if data.bucketsErr != nil {
+		return unknownPostureCheck(check, "Object Storage buckets", data.bucketsErr)
+	}
+
+	if len(data.publicBuckets) == 0 {
+		check.Detail = "No bucket has a public ACL."
+
+		return check
+	}
+
+	return failPostureCheck(check, fmt.Sprintf("%d bucket(s) have a public ACL, so anyone with the URL can list or read objects.", len(data.publicBuckets)), data.publicBuckets,
+		postureStep("linode_object_storage_bucket_access_get", "Confirm whether the bucket is meant to be public."),
+		postureStep("linode_object_storage_bucket_access_update", "Set acl=private, and share single objects with linode_object_storage_presigned_url_create instead."),
+	)
+}
+
+func postureLegacyTLSCheck(data *securityPostureData) *linodev1.SecurityPostureCheck {
+	check := newPostureCheck("nodebalancer_tls", "NodeBalancer HTTPS ports on the legacy cipher suite", postureSeverityMedium)
+	if data.tlsErr != nil {
+		return unknownPostureCheck(check, "NodeBalancer configs", data.tlsErr)
+	}
+
+	if len(data.legacyTLSPorts) == 0 {
+		check.Detail = fmt.Sprintf("%d HTTPS NodeBalancer port(s) use the recommended cipher suite.", data.httpsPorts)
+
+		return check
+	}
+
+	return failPostureCheck(check, fmt.Sprintf("%d of %d HTTPS NodeBalancer port(s) use the legacy cipher suite, which still accepts older TLS versions and weaker ciphers.",
+		len(data.legacyTLSPorts), data.httpsPorts), data.legacyTLSPorts,
+		postureStep("linode_nodebalancer_config_get", "Confirm no client of the port still needs an older TLS version."),
+		postureStep("linode_nodebalancer_config_update", "Set cipher_suite=recommended on each listed port."),
+	)
+}
+
+func postureFailedLoginsCheck(data *securityPostureData, now time.Time, days int) *linodev1.SecurityPostureCheck {
+	check := newPostureCheck("failed_logins", "Failed account logins", postureSeverityMedium)
+	if data.loginsErr != nil {
+		return unknownPostureCheck(check, "account logins", data.loginsErr)
+	}
+
+	since := now.AddDate(0, 0, -days).Format(linodeTimeLayout)
+	failed := []string{}
+
+	for _, login := range data.logins {
+		if login.GetStatus() != "failed" || login.GetDatetime() < since {
+			continue
+		}
+
+		failed = append(failed, fmt.Sprintf("%s from %s at %s", login.GetUsername(), login.GetIp(), login.GetDatetime()))
+	}
+
+	if len(failed) == 0 {
+		check.Detail = fmt.Sprintf("No failed logins in the last %d days.", days)
+
+		return check
+	}
+
+	return failPostureCheck(check, fmt.Sprintf("%d failed login(s) in the last %d days.", len(failed), days), failed,
+		postureStep("linode_account_login_list", "Review the failed logins and where they came from."),
+		postureStep("linode_account_user_update", "Restrict any targeted user who does not need full access."),
+		postureStep("linode_profile_tfa_enable", "Enable two-factor authentication so a guessed password is not enough."),
+	)
+}
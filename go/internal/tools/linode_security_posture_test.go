@@ -0,0 +1,165 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// Every check fails on an account with no 2FA, an unprotected admin, an old
+// wildcard token, an unused key, a public bucket, a legacy HTTPS cipher suite,
+// and a recent failed login, so the score bottoms out and each check names its remediation tools.
+func TestLinodeSecurityPostureToolFailsEveryCheck(t *testing.T) {
+	t.Parallel()
+
+	recent := time.Now().UTC().Add(-time.Hour).Format("2006-01-02T15:04:05")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body string
+
+		switch r.URL.Path {
+		case "/profile":
+			body = `{"username":"alice","two_factor_auth":false}`
+		case "/account/users":
+			body = `{"data":[{"username":"alice","restricted":false,"tfa_enabled":false},` +
+				`{"username":"bob","restricted":false,"tfa_enabled":false},` +
+				`{"username":"carol","restricted":true,"tfa_enabled":true}],"page":1,"pages":1,"results":3}`
+		case "/profile/tokens":
+			body = `{"data":[{"id":7,"label":"ci","scopes":"*","created":"2020-01-01T00:00:00"},` +
+				`{"id":8,"label":"ro","scopes":"linodes:read_only","created":"2020-01-01T00:00:00"}],"page":1,"pages":1,"results":2}`
+		case "/profile/sshkeys":
+			body = `{"data":[{"id":3,"label":"laptop","created":"2021-01-01T00:00:00","ssh_key":"ssh-ed25519 AAAA laptop"}],"page":1,"pages":1,"results":1}`
+		case "/account/events":
+			body = `{"data":[],"page":1,"pages":1,"results":0}`
+		case "/object-storage/buckets":
+			body = `{"data":[{"label":"assets","region":"us-east-1"},{"label":"backups","region":"us-east-1"}],"page":1,"pages":1,"results":2}`
+		case "/object-storage/buckets/us-east-1/assets/access":
+			body = `{"acl":"public-read","cors_enabled":false}`
+		case "/object-storage/buckets/us-east-1/backups/access":
+			body = `{"acl":"private","cors_enabled":false}`
+		case "/nodebalancers":
+			body = `{"data":[{"id":5,"label":"edge-lb"}],"page":1,"pages":1,"results":1}`
+		case "/nodebalancers/5/configs":
+			body = `{"data":[{"id":9,"port":443,"protocol":"https","cipher_suite":"legacy"},` +
+				`{"id":10,"port":80,"protocol":"http","cipher_suite":"legacy"}],"page":1,"pages":1,"results":2}`
+		case "/account/logins":
+			body = `{"data":[{"id":1,"username":"bob","ip":"198.51.100.7","status":"failed","datetime":"` + recent + `"},` +
+				`{"id":2,"username":"bob","ip":"198.51.100.7","status":"failed","datetime":"2001-01-01T00:00:00"}],"page":1,"pages":1,"results":2}`
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		_, _ = w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	_, _, handler := tools.NewLinodeSecurityPostureTool(newTestConfig(srv.URL))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want a report", result.Content)
+	}
+
+	var report struct {
+		Score       int `json:"score"`
+		FailedCount int `json:"failed_count"`
+		Checks      []struct {
+			ID          string   `json:"id"`
+			Status      string   `json:"status"`
+			Items       []string `json:"items"`
+			Remediation []struct {
+				Tool string `json:"tool"`
+			} `json:"remediation"`
+		} `json:"checks"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &report); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.Score != 0 || report.FailedCount != 8 {
+		t.Errorf("score = %d, failed = %d, want 0 and 8", report.Score, report.FailedCount)
+	}
+
+	wantItems := map[string]string{
+		"profile_2fa":      "alice",
+		"users_2fa":        "bob",
+		"restricted_users": "alice",
+		"broad_tokens":     "ci (id 7, scopes *, ",
+		"unused_ssh_keys":  "laptop (id 3)",
+		"public_buckets":   "us-east-1/assets (public-read)",
+		"nodebalancer_tls": "edge-lb port 443 (nodebalancer 5, config 9, cipher_suite legacy)",
+		"failed_logins":    "bob from 198.51.100.7 at " + recent,
+	}
+
+	for _, check := range report.Checks {
+		if check.Status != "fail" || len(check.Items) == 0 || len(check.Remediation) == 0 {
+			t.Errorf("check %s = %+v, want a failure with items and remediation", check.ID, check)
+
+			continue
+		}
+
+		if want := wantItems[check.ID]; !strings.HasPrefix(check.Items[0], want) {
+			t.Errorf("check %s items = %v, want first to start with %q", check.ID, check.Items, want)
+		}
+	}
+}
+
+// A check whose data the token cannot read is unknown and costs nothing.
+func TestLinodeSecurityPostureToolReportsUnreadableChecksUnknown(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/profile":
+			_, _ = w.Write([]byte(`{"username":"alice","two_factor_auth":true}`))
+		case "/profile/tokens", "/profile/sshkeys", "/account/events", "/object-storage/buckets", "/nodebalancers":
+			_, _ = w.Write([]byte(`{"data":[],"page":1,"pages":1,"results":0}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":[{"reason":"Unauthorized"}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	_, _, handler := tools.NewLinodeSecurityPostureTool(newTestConfig(srv.URL))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want a report", result.Content)
+	}
+
+	var report struct {
+		Score        int      `json:"score"`
+		UnknownCount int      `json:"unknown_count"`
+		Warnings     []string `json:"warnings"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &report); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.Score != 100 || report.UnknownCount != 3 || len(report.Warnings) != 3 {
+		t.Errorf("report = %+v, want score 100 with users_2fa, restricted_users, and failed_logins unknown", report)
+	}
+}
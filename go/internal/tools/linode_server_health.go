@@ -0,0 +1,101 @@
+package tools
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/appinfo"
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+	"github.com/chadit/LinodeMCP/go/internal/twostage"
+)
+
+// NewLinodeServerHealthTool returns the linode_server_health query tool.
+// It reports the server's own state for long-lived deployments: uptime,
+// build version, transport, the environments the live config carries, the
+// resilience limits API clients run under, and the two-stage plan store's
+// fill level. CapMeta so it is available in every profile. Takes no input
+// parameters and makes no API call.
+func NewLinodeServerHealthTool(
+	cfg *config.Config,
+) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_server_health",
+		"Report LinodeMCP's own health: uptime, version, transport, configured "+
+			"environments (tokens are never shown), rate-limit and retry "+
+			"settings, and in-memory plan cache usage.",
+		toolschemas.Schema("linode.mcp.v1.ServerHealthInput"),
+	)
+
+	handler := func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		return MarshalProtoToolResponse(ServerHealthProto(ctx, cfg))
+	}
+
+	return tool, profiles.CapMeta, handler
+}
+
+// ServerHealthProto builds the ServerHealthResponse from the live config and
+// the plan store on ctx. The linode_server_health tool and the health
+// server's /healthz diagnostics both serialize this message, so the two
+// surfaces report the same fields.
+func ServerHealthProto(ctx context.Context, cfg *config.Config) *linodev1.ServerHealthResponse {
+	cfg = resolveConfig(cfg)
+	started := appinfo.StartTime()
+
+	response := &linodev1.ServerHealthResponse{
+		Status:        "ok",
+		Version:       VersionResponseProto(),
+		StartedAt:     started.UTC().Format(time.RFC3339),
+		UptimeSeconds: int64(time.Since(started).Seconds()),
+		Transport:     config.DefaultTransport,
+		Cache:         &linodev1.ServerHealthCache{PlansCapacity: twostage.MaxOutstandingPlans},
+	}
+
+	if store := PlanStoreFromContext(ctx); store != nil {
+		response.Cache.PlansOutstanding = linodeIDToInt32(store.Len())
+	}
+
+	if cfg == nil {
+		return response
+	}
+
+	if cfg.Server.Transport != "" {
+		response.Transport = cfg.Server.Transport
+	}
+
+	names := make([]string, 0, len(cfg.Environments))
+	for name := range cfg.Environments {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		env := cfg.Environments[name]
+		response.Environments = append(response.Environments, &linodev1.ServerHealthEnvironment{
+			Name:            name,
+			Label:           env.Label,
+			ApiUrl:          env.Linode.APIURL,
+			TokenConfigured: env.Linode.Token != "",
+		})
+	}
+
+	resilience := cfg.Resilience
+	response.RateLimit = &linodev1.ServerHealthRateLimit{
+		RequestsPerMinute:            linodeIDToInt32(resilience.RateLimitPerMinute),
+		MaxRetries:                   linodeIDToInt32(resilience.MaxRetries),
+		CircuitBreakerThreshold:      linodeIDToInt32(resilience.CircuitBreakerThreshold),
+		CircuitBreakerTimeoutSeconds: linodeIDToInt32(int(resilience.CircuitBreakerTimeout / time.Second)),
+	}
+
+	return response
+}
@@ -0,0 +1,86 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+	"github.com/chadit/LinodeMCP/go/internal/twostage"
+)
+
+func TestLinodeServerHealthReportsConfigWithoutTokens(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{
+		Server:     config.ServerConfig{Transport: "stdio"},
+		Resilience: config.ResilienceConfig{RateLimitPerMinute: 700, MaxRetries: 3, CircuitBreakerThreshold: 5, CircuitBreakerTimeout: 30 * time.Second},
+		Environments: map[string]config.EnvironmentConfig{
+			"staging":     {Label: "Staging", Linode: config.LinodeConfig{APIURL: "https://api.linode.com/v4"}},
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: "https://api.linode.com/v4", Token: tokenTest}},
+		},
+	}
+	_, capability, handler := tools.NewLinodeServerHealthTool(cfg)
+
+	if capability != profiles.CapMeta {
+		t.Errorf("capability = %s, want CapMeta", capability)
+	}
+
+	ctx := tools.WithPlanStore(t.Context(), twostage.NewPlanStore())
+
+	result, err := handler(ctx, createRequestWithArgs(t, map[string]any{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	if strings.Contains(textContent.Text, tokenTest) {
+		t.Errorf("response leaks the token: %s", textContent.Text)
+	}
+
+	var health struct {
+		Status       string `json:"status"`
+		Transport    string `json:"transport"`
+		Environments []struct {
+			Name            string `json:"name"`
+			TokenConfigured bool   `json:"token_configured"`
+		} `json:"environments"`
+		RateLimit struct {
+			RequestsPerMinute            int `json:"requests_per_minute"`
+			CircuitBreakerTimeoutSeconds int `json:"circuit_breaker_timeout_seconds"`
+		} `json:"rate_limit"`
+		Cache struct {
+			PlansOutstanding int `json:"plans_outstanding"`
+			PlansCapacity    int `json:"plans_capacity"`
+		} `json:"cache"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &health); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if health.Status != "ok" || health.Transport != "stdio" {
+		t.Errorf("status/transport = %s/%s, want ok/stdio", health.Status, health.Transport)
+	}
+
+	if len(health.Environments) != 2 || health.Environments[0].Name != envKeyDefault ||
+		!health.Environments[0].TokenConfigured || health.Environments[1].TokenConfigured {
+		t.Errorf("environments = %+v, want sorted with only %s carrying a token", health.Environments, envKeyDefault)
+	}
+
+	if health.RateLimit.RequestsPerMinute != 700 || health.RateLimit.CircuitBreakerTimeoutSeconds != 30 {
+		t.Errorf("rate_limit = %+v, want 700/min and a 30s breaker timeout", health.RateLimit)
+	}
+
+	if health.Cache.PlansOutstanding != 0 || health.Cache.PlansCapacity != twostage.MaxOutstandingPlans {
+		t.Errorf("cache = %+v, want 0 of %d", health.Cache, twostage.MaxOutstandingPlans)
+	}
+}
@@ -0,0 +1,236 @@
+package tools
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+const (
+	sshKeyUsageMaxAgeDefault = 365
+	sshKeyUsageMaxAgeLimit   = 3650
+
+	// sshKeyUsageEventPageSize and sshKeyUsageEventPages cap the event scan
+	// at the newest 2000 events.
+	sshKeyUsageEventPageSize = 500
+	sshKeyUsageEventPages    = 4
+
+	// linodeTimeLayout is the API's timestamp format: UTC without a zone.
+	linodeTimeLayout = "2006-01-02T15:04:05"
+)
+
+// Flags linode_sshkey_usage_report sets on a key due for rotation.
+const (
+	sshKeyFlagOld    = "old"
+	sshKeyFlagUnused = "unused"
+)
+
+// sshKeyDeployActions are the events that deploy profile keys to an instance.
+var sshKeyDeployActions = []string{"linode_create", "linode_rebuild"}
+
+// NewLinodeSSHKeyUsageReportTool creates a tool that reports each profile SSH
+// key's age and the instances likely deployed with it.
+func NewLinodeSSHKeyUsageReportTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_sshkey_usage_report",
+		"Reports every SSH key on the profile with its SHA256 fingerprint, age, and the instances the profile user "+
+			"created or rebuilt since the key was added (from the account event feed, which covers about 90 days). "+
+			"Keys at least max_age_days old (default 365) are flagged old, and keys with no such instance are flagged "+
+			"unused, as candidates for rotation. The API does not record which keys an instance was deployed with, so "+
+			"the instances are likely, not certain, uses.",
+		toolschemas.Schema("linode.mcp.v1.SSHKeyUsageReportInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeSSHKeyUsageReportRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLinodeSSHKeyUsageReportRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	maxAge := sshKeyUsageMaxAgeDefault
+	if _, exists := request.GetArguments()["max_age_days"]; exists {
+		value, msg := boundedIntArgument(request, "max_age_days", 1, sshKeyUsageMaxAgeLimit,
+			fmt.Sprintf("max_age_days must be from 1 through %d", sshKeyUsageMaxAgeLimit))
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		maxAge = value
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	keys, err := client.ListSSHKeysProto(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to build SSH key usage report: %v", err)), nil
+	}
+
+	profile, err := client.GetProfileProto(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to build SSH key usage report: %v", err)), nil
+	}
+
+	var warnings []string
+
+	events, complete, err := sshKeyUsageEvents(ctx, client)
+
+	eventsKnown := err == nil
+	if !eventsKnown {
+		warnings = append(warnings, fmt.Sprintf("Events unavailable, so no key is matched to instances or flagged unused: %v", err))
+	} else if !complete {
+		warnings = append(warnings, fmt.Sprintf("Only the newest %d events were scanned; older instance deployments are not matched.",
+			sshKeyUsageEventPageSize*sshKeyUsageEventPages))
+	}
+
+	for _, warning := range warnings {
+		AddWarning(ctx, "%s", warning)
+	}
+
+	return MarshalProtoToolResponse(sshKeyUsageReport(keys, profile.GetUsername(), events, eventsKnown, warnings, time.Now().UTC(), maxAge))
+}
+
+// sshKeyUsageEvents reads the event feed newest first, up to the scan cap.
+// complete is false when the cap cut the scan short.
+func sshKeyUsageEvents(ctx context.Context, client *linode.Client) ([]*linodev1.AccountEvent, bool, error) {
+	var events []*linodev1.AccountEvent
+
+	for page := 1; page <= sshKeyUsageEventPages; page++ {
+		batch, err := client.ListAccountEventsProto(ctx, page, sshKeyUsageEventPageSize)
+		if err != nil {
+			return nil, false, err
+		}
+
+		events = append(events, batch...)
+
+		if len(batch) < sshKeyUsageEventPageSize {
+			return events, true, nil
+		}
+	}
+
+	return events, false, nil
+}
+
+// sshKeyUsageReport builds the report as of now. eventsKnown is false when the
+// event feed could not be read, in which case no key is flagged unused.
+func sshKeyUsageReport(keys []*linodev1.SSHKey, username string, events []*linodev1.AccountEvent, eventsKnown bool, warnings []string, now time.Time, maxAge int) *linodev1.SSHKeyUsageReportResponse {
+	response := &linodev1.SSHKeyUsageReportResponse{
+		Username:      username,
+		EventsScanned: linodeIDToInt32(len(events)),
+		SshKeys:       make([]*linodev1.SSHKeyUsage, 0, len(keys)),
+		Warnings:      warnings,
+	}
+
+	if len(events) > 0 {
+		response.EventsSince = events[len(events)-1].GetCreated()
+	}
+
+	oldCount, unusedCount := 0, 0
+
+	for _, key := range keys {
+		usage := &linodev1.SSHKeyUsage{
+			Id:          key.GetId(),
+			Label:       key.GetLabel(),
+			Fingerprint: sshKeyFingerprint(key.GetSshKey()),
+			Created:     key.GetCreated(),
+			Instances:   []*linodev1.SSHKeyUsageInstance{},
+			Flags:       []string{},
+		}
+
+		created, err := time.Parse(linodeTimeLayout, key.GetCreated())
+		if err == nil {
+			usage.AgeDays = linodeIDToInt32(int(now.Sub(created).Hours() / 24))
+		}
+
+		usage.Instances = sshKeyInstances(events, username, key.GetCreated())
+
+		if err == nil && int(usage.GetAgeDays()) >= maxAge {
+			usage.Flags = append(usage.Flags, sshKeyFlagOld)
+			oldCount++
+		}
+
+		if eventsKnown && len(usage.GetInstances()) == 0 {
+			usage.Flags = append(usage.Flags, sshKeyFlagUnused)
+			unusedCount++
+		}
+
+		if len(usage.GetFlags()) > 0 {
+			response.FlaggedCount++
+		}
+
+		response.SshKeys = append(response.SshKeys, usage)
+	}
+
+	response.Message = fmt.Sprintf("%d SSH key(s) on profile %s; %d flagged for rotation (%d old, %d unused) from %d event(s) scanned",
+		len(keys), username, response.GetFlaggedCount(), oldCount, unusedCount, response.GetEventsScanned())
+
+	return response
+}
+
+// sshKeyInstances lists the instances username created or rebuilt at or after
+// created, once each, newest event first. Linode timestamps share one fixed
+// layout, so they compare as strings.
+func sshKeyInstances(events []*linodev1.AccountEvent, username, created string) []*linodev1.SSHKeyUsageInstance {
+	instances := []*linodev1.SSHKeyUsageInstance{}
+	seen := map[int32]bool{}
+
+	for _, event := range events {
+		if event.GetUsername() != username || event.GetStatus() == "failed" || event.GetCreated() < created {
+			continue
+		}
+
+		if !slices.Contains(sshKeyDeployActions, event.GetAction()) || event.GetEntity().GetType() != "linode" {
+			continue
+		}
+
+		id := int32(event.GetEntity().GetId().GetNumberValue())
+		if seen[id] {
+			continue
+		}
+
+		seen[id] = true
+
+		instances = append(instances, &linodev1.SSHKeyUsageInstance{
+			Id:     id,
+			Label:  event.GetEntity().GetLabel(),
+			Action: event.GetAction(),
+			At:     event.GetCreated(),
+		})
+	}
+
+	return instances
+}
+
+// sshKeyFingerprint is the key's SHA256 fingerprint as ssh-keygen -l prints
+// it, or "" when the key body is not base64.
+func sshKeyFingerprint(publicKey string) string {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return ""
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(blob)
+
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,99 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// A key is matched to the instances its owner created or rebuilt after it
+// was added; a key added after every such event is flagged unused, and both
+// keys are old.
+func TestLinodeSSHKeyUsageReportToolFlagsKeys(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/profile/sshkeys":
+			_, _ = w.Write([]byte(`{"data":[` +
+				`{"id":1,"label":"laptop","created":"2000-01-01T00:00:00","ssh_key":"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAABAgMEBQYHCAkKCwwNDg8QERITFBUWFxgZGhscHR4f laptop"},` +
+				`{"id":2,"label":"ci","created":"2021-01-01T00:00:00","ssh_key":"ssh-ed25519 !!! ci"}],"page":1,"pages":1,"results":2}`))
+		case "/profile":
+			_, _ = w.Write([]byte(`{"username":"alice"}`))
+		case "/account/events":
+			_, _ = w.Write([]byte(`{"data":[` +
+				`{"id":13,"action":"linode_create","created":"2022-03-01T00:00:00","username":"bob","status":"finished","entity":{"id":9,"label":"other","type":"linode"}},` +
+				`{"id":12,"action":"linode_rebuild","created":"2020-06-01T00:00:00","username":"alice","status":"finished","entity":{"id":5,"label":"web","type":"linode"}},` +
+				`{"id":11,"action":"linode_create","created":"2020-05-01T00:00:00","username":"alice","status":"finished","entity":{"id":5,"label":"web","type":"linode"}}` +
+				`],"page":1,"pages":1,"results":3}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	cfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+	_, _, handler := tools.NewLinodeSSHKeyUsageReportTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want a report", result.Content)
+	}
+
+	var response struct {
+		EventsScanned int    `json:"events_scanned"`
+		EventsSince   string `json:"events_since"`
+		FlaggedCount  int    `json:"flagged_count"`
+		SSHKeys       []struct {
+			Fingerprint string `json:"fingerprint"`
+			Instances   []struct {
+				ID     int    `json:"id"`
+				Action string `json:"action"`
+			} `json:"instances"`
+			Flags []string `json:"flags"`
+		} `json:"ssh_keys"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if response.EventsScanned != 3 || response.EventsSince != "2020-05-01T00:00:00" || response.FlaggedCount != 2 || len(response.SSHKeys) != 2 {
+		t.Fatalf("response = %+v, want 3 events scanned and 2 flagged keys", response)
+	}
+
+	laptop, ci := response.SSHKeys[0], response.SSHKeys[1]
+
+	if laptop.Fingerprint != "SHA256:ZkAslGjFiUHdGf/WUL8rQvkib4PTvQatUV0OUQSncCA" {
+		t.Errorf("laptop fingerprint = %q", laptop.Fingerprint)
+	}
+
+	if len(laptop.Instances) != 1 || laptop.Instances[0].ID != 5 || laptop.Instances[0].Action != "linode_rebuild" {
+		t.Errorf("laptop instances = %+v, want instance 5 once, newest event first", laptop.Instances)
+	}
+
+	if len(laptop.Flags) != 1 || laptop.Flags[0] != "old" {
+		t.Errorf("laptop flags = %v, want [old]", laptop.Flags)
+	}
+
+	if ci.Fingerprint != "" || len(ci.Instances) != 0 || len(ci.Flags) != 2 || ci.Flags[1] != "unused" {
+		t.Errorf("ci = %+v, want no fingerprint, no instances, and flags [old unused]", ci)
+	}
+}
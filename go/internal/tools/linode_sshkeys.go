@@ -35,7 +35,7 @@ func handleLinodeSSHKeyGetRequest(ctx context.Context, request *mcp.CallToolRequ
 		return mcp.NewToolResultError("ssh_key_id must be a positive integer"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
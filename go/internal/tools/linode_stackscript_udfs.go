@@ -0,0 +1,105 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// NewLinodeStackScriptUDFsGetTool creates a tool that returns a StackScript's
+// user-defined fields in a normalized form, so an agent knows exactly which
+// stackscript_data inputs a deploy needs.
+func NewLinodeStackScriptUDFsGetTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_stackscript_udfs_get",
+		"Gets the deploy-time inputs (user-defined fields) a StackScript declares: each field's name, label, "+
+			"example, default, allowed values, whether several values may be chosen, and whether it is required "+
+			"(it has no default). Pass the values as stackscript_data when creating an instance from the StackScript.",
+		toolschemas.Schema("linode.mcp.v1.StackScriptUDFsGetInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		stackScriptID, validationMessage := stackScriptIDFromTool(&request)
+		if validationMessage != "" {
+			return mcp.NewToolResultError(validationMessage), nil
+		}
+
+		client, err := prepareClient(ctx, &request, cfg)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		script, err := client.GetStackScript(ctx, stackScriptID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve StackScript: %v", err)), nil
+		}
+
+		return MarshalProtoToolResponse(stackScriptUDFsResponse(script))
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+// stackScriptUDFsResponse normalizes a StackScript's user-defined fields,
+// keeping their declaration order.
+func stackScriptUDFsResponse(script *linode.StackScript) *linodev1.StackScriptUDFsGetResponse {
+	response := &linodev1.StackScriptUDFsGetResponse{
+		StackscriptId:     linodeIDToInt32(script.ID),
+		Label:             script.Label,
+		UserDefinedFields: make([]*linodev1.StackScriptUDFField, 0, len(script.UserDefinedFields)),
+	}
+
+	for i := range script.UserDefinedFields {
+		field := stackScriptUDFField(&script.UserDefinedFields[i])
+
+		response.Count++
+		if field.GetRequired() {
+			response.RequiredCount++
+		}
+
+		response.UserDefinedFields = append(response.UserDefinedFields, field)
+	}
+
+	return response
+}
+
+// stackScriptUDFField normalizes one UDF. A manyOf list allows several values
+// and takes precedence over oneOf; a field without a default is required.
+func stackScriptUDFField(udf *linode.UDF) *linodev1.StackScriptUDFField {
+	field := &linodev1.StackScriptUDFField{
+		Name:          udf.Name,
+		Label:         udf.Label,
+		Example:       udf.Example,
+		Default:       udf.Default,
+		AllowedValues: splitUDFValues(udf.OneOf),
+		Required:      udf.Default == nil,
+	}
+
+	if values := splitUDFValues(udf.ManyOf); len(values) > 0 {
+		field.AllowedValues = values
+		field.Multiple = true
+	}
+
+	return field
+}
+
+// splitUDFValues splits the API's comma-separated value list, dropping blanks.
+func splitUDFValues(list string) []string {
+	values := []string{}
+
+	for value := range strings.SplitSeq(list, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,100 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeStackScriptUDFsGetNormalizesFields(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/linode/stackscripts/123" {
+			t.Errorf("r.URL.Path = %v, want %v", r.URL.Path, "/linode/stackscripts/123")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if _, err := w.Write([]byte(`{"id": 123, "label": "wordpress", "user_defined_fields": [
+			{"name": "db_password", "label": "Database password"},
+			{"name": "webserver", "label": "Web server", "oneOf": "nginx, apache", "default": "nginx"},
+			{"name": "modules", "label": "PHP modules", "manyOf": "gd,curl,,intl", "default": ""}
+		]}`)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	_, capability, handler := tools.NewLinodeStackScriptUDFsGetTool(newTestConfig(srv.URL))
+	if capability != profiles.CapRead {
+		t.Errorf("capability = %v, want %v", capability, profiles.CapRead)
+	}
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"stackscript_id": float64(123)}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true: %+v", result.Content)
+	}
+
+	var got struct {
+		StackScriptID int32 `json:"stackscript_id"`
+		Count         int32 `json:"count"`
+		RequiredCount int32 `json:"required_count"`
+		Fields        []struct {
+			Name          string   `json:"name"`
+			Default       *string  `json:"default"`
+			AllowedValues []string `json:"allowed_values"`
+			Multiple      bool     `json:"multiple"`
+			Required      bool     `json:"required"`
+		} `json:"user_defined_fields"`
+	}
+
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.StackScriptID != 123 || got.Count != 3 || got.RequiredCount != 1 || len(got.Fields) != 3 {
+		t.Fatalf("response = %+v, want 3 fields with 1 required", got)
+	}
+
+	password, webserver, modules := got.Fields[0], got.Fields[1], got.Fields[2]
+
+	if !password.Required || password.Default != nil || len(password.AllowedValues) != 0 {
+		t.Errorf("db_password = %+v, want required free text", password)
+	}
+
+	if webserver.Required || webserver.Multiple || webserver.Default == nil || *webserver.Default != "nginx" ||
+		len(webserver.AllowedValues) != 2 || webserver.AllowedValues[1] != "apache" {
+		t.Errorf("webserver = %+v, want one of nginx, apache defaulting to nginx", webserver)
+	}
+
+	if modules.Required || !modules.Multiple || modules.Default == nil || len(modules.AllowedValues) != 3 {
+		t.Errorf("modules = %+v, want several of gd, curl, intl with an empty default", modules)
+	}
+}
+
+func TestLinodeStackScriptUDFsGetRequiresID(t *testing.T) {
+	t.Parallel()
+
+	_, _, handler := tools.NewLinodeStackScriptUDFsGetTool(&config.Config{})
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !result.IsError {
+		t.Error("result.IsError = false, want true")
+	}
+}
@@ -69,7 +69,7 @@ func handleLinodeStackScriptGetRequest(ctx context.Context, request *mcp.CallToo
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
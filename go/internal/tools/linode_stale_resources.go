@@ -0,0 +1,276 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+const (
+	staleMonthsDefault   = 6
+	staleMonthsLimit     = 120
+	staleImageAgeDefault = 365
+	staleImageAgeLimit   = 3650
+
+	// staleDaysPerMonth keeps the cutoff a whole number of days, so both
+	// implementations compute the same one.
+	staleDaysPerMonth = 30
+)
+
+// NewLinodeStaleResourcesTool creates a tool that flags instances, domains,
+// SSH keys, and private images nobody has touched in a while.
+func NewLinodeStaleResourcesTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_stale_resources",
+		"Reports account resources that look abandoned, as a starting point for an infrastructure-hygiene review: "+
+			"instances not booted, rebooted, or updated in the last months (default 6), domains whose zone has not "+
+			"changed in that time, profile SSH keys at least that old that the profile user has not deployed to an "+
+			"instance, and private images at least image_age_days old (default 365). Boots and key deployments come "+
+			"from the account event feed, which covers about 90 days, so older ones are not seen.",
+		toolschemas.Schema("linode.mcp.v1.StaleResourcesInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeStaleResourcesRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+// staleResourcesData is what the report is built from. A nil error field
+// means that section was read.
+type staleResourcesData struct {
+	instances    []*linodev1.Instance
+	instancesErr error
+	images       []*linodev1.Image
+	imagesErr    error
+	domains      []*linodev1.Domain
+	domainsErr   error
+	keys         []*linodev1.SSHKey
+	keysErr      error
+	username     string
+	profileErr   error
+	events       []*linodev1.AccountEvent
+	eventsErr    error
+	complete     bool
+}
+
+func handleLinodeStaleResourcesRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	months := staleMonthsDefault
+	if _, exists := request.GetArguments()["months"]; exists {
+		value, msg := boundedIntArgument(request, "months", 1, staleMonthsLimit,
+			fmt.Sprintf("months must be from 1 through %d", staleMonthsLimit))
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		months = value
+	}
+
+	imageAge := staleImageAgeDefault
+	if _, exists := request.GetArguments()["image_age_days"]; exists {
+		value, msg := boundedIntArgument(request, "image_age_days", 1, staleImageAgeLimit,
+			fmt.Sprintf("image_age_days must be from 1 through %d", staleImageAgeLimit))
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		imageAge = value
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	var data staleResourcesData
+
+	data.instances, data.instancesErr = client.ListInstancesProto(ctx)
+	data.images, data.imagesErr = client.ListImagesProto(ctx)
+	data.domains, data.domainsErr = client.ListDomainsProto(ctx)
+	data.keys, data.keysErr = client.ListSSHKeysProto(ctx)
+
+	if data.instancesErr != nil && data.imagesErr != nil && data.domainsErr != nil && data.keysErr != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to build stale resource report: %v", data.instancesErr)), nil
+	}
+
+	profile, err := client.GetProfileProto(ctx)
+	if err != nil {
+		data.profileErr = err
+	} else {
+		data.username = profile.GetUsername()
+	}
+
+	data.events, data.complete, data.eventsErr = sshKeyUsageEvents(ctx, client)
+
+	response := staleResourcesReport(&data, time.Now().UTC(), months, imageAge)
+	for _, warning := range response.GetWarnings() {
+		AddWarning(ctx, "%s", warning)
+	}
+
+	return MarshalProtoToolResponse(response)
+}
+
+// staleResourcesReport builds the report as of now. Linode timestamps share
+// one fixed layout, so they compare as strings.
+func staleResourcesReport(data *staleResourcesData, now time.Time, months, imageAge int) *linodev1.StaleResourcesResponse {
+	cutoff := now.AddDate(0, 0, -months*staleDaysPerMonth).Format(linodeTimeLayout)
+
+	response := &linodev1.StaleResourcesResponse{
+		Months:        linodeIDToInt32(months),
+		ImageAgeDays:  linodeIDToInt32(imageAge),
+		Cutoff:        cutoff,
+		EventsScanned: linodeIDToInt32(len(data.events)),
+		Instances:     []*linodev1.StaleResource{},
+		Images:        []*linodev1.StaleImage{},
+		Domains:       []*linodev1.StaleResource{},
+		SshKeys:       []*linodev1.StaleResource{},
+		Warnings:      staleResourcesWarnings(data),
+	}
+
+	if len(data.events) > 0 {
+		response.EventsSince = data.events[len(data.events)-1].GetCreated()
+	}
+
+	boots := staleLastBoots(data.events)
+
+	for _, instance := range data.instances {
+		last := max(instance.GetCreated(), instance.GetUpdated(), boots[instance.GetId()])
+		if last < cutoff {
+			response.Instances = append(response.Instances,
+				staleResource(instance.GetId(), instance.GetLabel(), last, now, "not booted, rebooted, or updated since "+last))
+		}
+	}
+
+	for _, domain := range data.domains {
+		last := max(domain.GetCreated(), domain.GetUpdated())
+		if last < cutoff {
+			response.Domains = append(response.Domains,
+				staleResource(domain.GetId(), domain.GetDomain(), last, now, "zone not modified since "+last))
+		}
+	}
+
+	if data.eventsErr == nil && data.profileErr == nil {
+		for _, key := range data.keys {
+			if key.GetCreated() >= cutoff || len(sshKeyInstances(data.events, data.username, key.GetCreated())) > 0 {
+				continue
+			}
+
+			response.SshKeys = append(response.SshKeys, staleResource(key.GetId(), key.GetLabel(), key.GetCreated(), now,
+				fmt.Sprintf("added %s and not deployed by %s in the events scanned", key.GetCreated(), data.username)))
+		}
+	}
+
+	for _, image := range data.images {
+		if image.GetIsPublic() {
+			continue
+		}
+
+		if ageDays := staleDaysSince(image.GetCreated(), now); ageDays >= imageAge {
+			response.Images = append(response.Images, &linodev1.StaleImage{
+				Id:      image.GetId(),
+				Label:   image.GetLabel(),
+				Created: image.GetCreated(),
+				AgeDays: linodeIDToInt32(ageDays),
+				Size:    image.GetSize(),
+			})
+		}
+	}
+
+	byLastActivity := func(a, b *linodev1.StaleResource) int { return cmp.Compare(a.GetLastActivity(), b.GetLastActivity()) }
+	slices.SortStableFunc(response.Instances, byLastActivity)
+	slices.SortStableFunc(response.Domains, byLastActivity)
+	slices.SortStableFunc(response.SshKeys, byLastActivity)
+	slices.SortStableFunc(response.Images, func(a, b *linodev1.StaleImage) int { return cmp.Compare(a.GetCreated(), b.GetCreated()) })
+
+	response.StaleCount = linodeIDToInt32(len(response.GetInstances()) + len(response.GetImages()) +
+		len(response.GetDomains()) + len(response.GetSshKeys()))
+	response.Message = fmt.Sprintf("%d stale resource(s) with no activity since %s: %d instance(s), %d domain(s), "+
+		"%d unused SSH key(s), and %d private image(s) at least %d days old",
+		response.GetStaleCount(), cutoff, len(response.GetInstances()), len(response.GetDomains()),
+		len(response.GetSshKeys()), len(response.GetImages()), imageAge)
+
+	return response
+}
+
+// staleResourcesWarnings explains each section the report could not cover.
+func staleResourcesWarnings(data *staleResourcesData) []string {
+	warnings := []string{}
+
+	for _, section := range []struct {
+		name string
+		err  error
+	}{
+		{"Instances", data.instancesErr},
+		{"Images", data.imagesErr},
+		{"Domains", data.domainsErr},
+		{"SSH keys", data.keysErr},
+	} {
+		if section.err != nil {
+			warnings = append(warnings, fmt.Sprintf("%s unavailable: %v", section.name, section.err))
+		}
+	}
+
+	switch {
+	case data.eventsErr != nil:
+		warnings = append(warnings, fmt.Sprintf("Events unavailable, so instance boots are not counted and no SSH key is flagged: %v", data.eventsErr))
+	case !data.complete:
+		warnings = append(warnings, fmt.Sprintf("Only the newest %d events were scanned; older instance boots and key deployments are not counted.",
+			sshKeyUsageEventPageSize*sshKeyUsageEventPages))
+	}
+
+	if data.profileErr != nil {
+		warnings = append(warnings, fmt.Sprintf("Profile unavailable, so no SSH key is flagged: %v", data.profileErr))
+	}
+
+	return warnings
+}
+
+// staleLastBoots maps each instance to its newest successful boot or reboot
+// in the events, which arrive newest first.
+func staleLastBoots(events []*linodev1.AccountEvent) map[int32]string {
+	boots := map[int32]string{}
+
+	for _, event := range events {
+		if event.GetStatus() == "failed" || event.GetEntity().GetType() != "linode" || !slices.Contains(instanceBootActions, event.GetAction()) {
+			continue
+		}
+
+		id := int32(event.GetEntity().GetId().GetNumberValue())
+		if _, seen := boots[id]; !seen {
+			boots[id] = event.GetCreated()
+		}
+	}
+
+	return boots
+}
+
+func staleResource(id int32, label, last string, now time.Time, reason string) *linodev1.StaleResource {
+	return &linodev1.StaleResource{
+		Id:           id,
+		Label:        label,
+		LastActivity: last,
+		IdleDays:     linodeIDToInt32(staleDaysSince(last, now)),
+		Reason:       reason,
+	}
+}
+
+// staleDaysSince counts whole days from an API timestamp to now, or 0 when
+// the timestamp does not parse.
+func staleDaysSince(timestamp string, now time.Time) int {
+	at, err := time.Parse(linodeTimeLayout, timestamp)
+	if err != nil {
+		return 0
+	}
+
+	return int(now.Sub(at).Hours() / 24)
+}
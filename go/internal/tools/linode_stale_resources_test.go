@@ -0,0 +1,113 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// Old instances, domains, and private images are flagged, oldest first; an
+// instance booted since the cutoff, a public image, and a key its owner
+// deployed are not. A section whose list call fails becomes a warning.
+func TestLinodeStaleResourcesToolFlagsIdleResources(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/linode/instances":
+			_, _ = w.Write([]byte(`{"data":[` +
+				`{"id":2,"label":"idle","created":"2001-01-01T00:00:00","updated":"2005-01-01T00:00:00"},` +
+				`{"id":1,"label":"older","created":"2000-01-01T00:00:00","updated":"2000-01-01T00:00:00"},` +
+				`{"id":3,"label":"booted","created":"2000-01-01T00:00:00","updated":"2000-01-01T00:00:00"}` +
+				`],"page":1,"pages":1,"results":3}`))
+		case "/images":
+			_, _ = w.Write([]byte(`{"data":[` +
+				`{"id":"private/7","label":"golden","created":"2010-01-01T00:00:00","is_public":false,"size":2500},` +
+				`{"id":"linode/debian12","label":"Debian 12","created":"2010-01-01T00:00:00","is_public":true}` +
+				`],"page":1,"pages":1,"results":2}`))
+		case "/domains":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":[{"reason":"Unauthorized"}]}`))
+		case "/profile/sshkeys":
+			_, _ = w.Write([]byte(`{"data":[` +
+				`{"id":4,"label":"laptop","created":"2000-01-01T00:00:00","ssh_key":"ssh-ed25519 AAAA laptop"},` +
+				`{"id":5,"label":"ci","created":"2021-01-01T00:00:00","ssh_key":"ssh-ed25519 AAAA ci"}` +
+				`],"page":1,"pages":1,"results":2}`))
+		case "/profile":
+			_, _ = w.Write([]byte(`{"username":"alice"}`))
+		case "/account/events":
+			_, _ = w.Write([]byte(`{"data":[` +
+				`{"id":12,"action":"linode_boot","created":"2999-01-01T00:00:00","username":"alice","status":"finished","entity":{"id":3,"label":"booted","type":"linode"}},` +
+				`{"id":11,"action":"linode_rebuild","created":"2020-05-01T00:00:00","username":"alice","status":"finished","entity":{"id":2,"label":"idle","type":"linode"}}` +
+				`],"page":1,"pages":1,"results":2}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	cfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+	_, _, handler := tools.NewLinodeStaleResourcesTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want a report", result.Content)
+	}
+
+	type staleResource struct {
+		ID     int    `json:"id"`
+		Reason string `json:"reason"`
+	}
+
+	var response struct {
+		StaleCount int             `json:"stale_count"`
+		Instances  []staleResource `json:"instances"`
+		Domains    []staleResource `json:"domains"`
+		SSHKeys    []staleResource `json:"ssh_keys"`
+		Images     []struct {
+			ID string `json:"id"`
+		} `json:"images"`
+		Warnings []string `json:"warnings"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if len(response.Instances) != 2 || response.Instances[0].ID != 1 || response.Instances[1].ID != 2 {
+		t.Errorf("instances = %+v, want 1 then 2", response.Instances)
+	}
+
+	if len(response.Images) != 1 || response.Images[0].ID != "private/7" {
+		t.Errorf("images = %+v, want only private/7", response.Images)
+	}
+
+	if len(response.SSHKeys) != 1 || response.SSHKeys[0].ID != 5 {
+		t.Errorf("ssh_keys = %+v, want only the undeployed key 5", response.SSHKeys)
+	}
+
+	if len(response.Domains) != 0 || len(response.Warnings) != 1 {
+		t.Errorf("domains = %+v, warnings = %v, want no domains and one warning", response.Domains, response.Warnings)
+	}
+
+	if response.StaleCount != 4 {
+		t.Errorf("stale_count = %d, want 4", response.StaleCount)
+	}
+}
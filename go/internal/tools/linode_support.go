@@ -42,7 +42,7 @@ func handleLinodeSupportTicketGetRequest(ctx context.Context, request *mcp.CallT
 		return mcp.NewToolResultError(validationMessage), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -132,7 +132,7 @@ func handleLinodeSupportTicketCloseRequest(ctx context.Context, request *mcp.Cal
 		return result, nil
 	}
 
-	client, prepErr := prepareClient(request, cfg)
+	client, prepErr := prepareClient(ctx, request, cfg)
 	if prepErr != nil {
 		return mcp.NewToolResultError(prepErr.Error()), nil
 	}
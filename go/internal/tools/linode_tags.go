@@ -170,7 +170,7 @@ func handleLinodeTagCreateRequest(ctx context.Context, request *mcp.CallToolRequ
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
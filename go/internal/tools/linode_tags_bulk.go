@@ -0,0 +1,386 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+const (
+	tagFromParam = "from_tag"
+	tagToParam   = "to_tag"
+)
+
+// taggedObjectState is one object carrying the tag being renamed, as the
+// linode_tags_retag dry-run reports it in current_state: just the fields the
+// plan keys on, so the preview stays identical across languages.
+type taggedObjectState struct {
+	Type  string   `json:"type"`
+	ID    int      `json:"id"`
+	Label string   `json:"label"`
+	Tags  []string `json:"tags"`
+}
+
+// NewLinodeTagsRetagTool creates a tool that renames a tag across every object
+// that carries it.
+func NewLinodeTagsRetagTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_tags_retag",
+		"Renames a tag across the account: every Linode, domain, volume, and NodeBalancer tagged from_tag "+
+			"is updated to carry to_tag instead, and from_tag is deleted once every object has moved. "+
+			"Pass dry_run=true to preview the per-object tag changes without updating.",
+		toolschemas.Schema("linode.mcp.v1.TagRetagInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeTagsRetagRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapWrite, handler
+}
+
+// retagArgsFromTool validates from_tag and to_tag, returning them or an error
+// message. Shared by the real path and the dry-run preview.
+func retagArgsFromTool(request *mcp.CallToolRequest) (string, string, string) {
+	args := request.GetArguments()
+
+	fromTag, validationMessage := requiredStringArg(args, tagFromParam)
+	if validationMessage != "" {
+		return "", "", validationMessage
+	}
+
+	if strings.ContainsAny(fromTag, "?#") || strings.Contains(fromTag, "..") {
+		return "", "", "from_tag must not contain '?', '#', or '..'"
+	}
+
+	toTag, validationMessage := requiredStringArg(args, tagToParam)
+	if validationMessage != "" {
+		return "", "", validationMessage
+	}
+
+	toTag = strings.TrimSpace(toTag)
+	if toTag == fromTag {
+		return "", "", "to_tag must differ from from_tag"
+	}
+
+	return fromTag, toTag, ""
+}
+
+// listAllTaggedObjects walks every page of GET /tags/{label}.
+func listAllTaggedObjects(ctx context.Context, client *linode.Client, tagLabel string) ([]linode.TaggedObject, error) {
+	resp, err := client.ListTaggedObjects(ctx, tagLabel, 0, tagsPageSizeMax)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// taggedObjectStateOf flattens one GET /tags/{label} entry. Domains carry
+// their name in "domain" rather than "label".
+func taggedObjectStateOf(object linode.TaggedObject) taggedObjectState {
+	state := taggedObjectState{Tags: []string{}}
+	state.Type, _ = object["type"].(string)
+
+	data, _ := object["data"].(map[string]any)
+	if id, ok := data["id"].(float64); ok {
+		state.ID = int(id)
+	}
+
+	state.Label, _ = data["label"].(string)
+	if state.Label == "" {
+		state.Label, _ = data["domain"].(string)
+	}
+
+	tags, _ := data["tags"].([]any)
+	for _, tag := range tags {
+		if text, ok := tag.(string); ok {
+			state.Tags = append(state.Tags, text)
+		}
+	}
+
+	return state
+}
+
+// retaggedTags returns tags with fromTag replaced by toTag in place, dropping
+// the replacement when the object already carries toTag.
+func retaggedTags(tags []string, fromTag, toTag string) []string {
+	retagged := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		switch {
+		case tag == fromTag && !slices.Contains(tags, toTag):
+			retagged = append(retagged, toTag)
+		case tag == fromTag:
+		default:
+			retagged = append(retagged, tag)
+		}
+	}
+
+	return retagged
+}
+
+// retagSideEffects is the Tier B walk for linode_tags_retag: one line per
+// object, then a summary line.
+func retagSideEffects(state any, fromTag, toTag string) DryRunDetails {
+	objects, _ := state.([]taggedObjectState)
+	sideEffects := make([]string, 0, len(objects)+1)
+
+	for _, object := range objects {
+		sideEffects = append(sideEffects, fmt.Sprintf("%s %d (%q) tags change from [%s] to [%s].",
+			object.Type, object.ID, object.Label, strings.Join(object.Tags, ", "),
+			strings.Join(retaggedTags(object.Tags, fromTag, toTag), ", ")))
+	}
+
+	sideEffects = append(sideEffects, fmt.Sprintf("%d objects move from tag %q to %q; %q is deleted once all of them succeed.",
+		len(objects), fromTag, toTag, fromTag))
+
+	return DryRunDetails{SideEffects: sideEffects}
+}
+
+func handleLinodeTagsRetagRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if IsDryRun(request) {
+		fromTag, toTag, validationMessage := retagArgsFromTool(request)
+		if validationMessage != "" {
+			return mcp.NewToolResultError(validationMessage), nil
+		}
+
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_tags_retag", httpMethodPut, "/{resource}/{id}",
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				objects, err := listAllTaggedObjects(ctx, c, fromTag)
+				if err != nil {
+					return nil, err
+				}
+
+				states := make([]taggedObjectState, 0, len(objects))
+				for _, object := range objects {
+					states = append(states, taggedObjectStateOf(object))
+				}
+
+				return states, nil
+			},
+			func(_ context.Context, _ *linode.Client, state any) (DryRunDetails, error) {
+				return retagSideEffects(state, fromTag, toTag), nil
+			})
+	}
+
+	if result := RequireConfirm(request, "This moves every object tagged from_tag to to_tag. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	fromTag, toTag, validationMessage := retagArgsFromTool(request)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	objects, err := listAllTaggedObjects(ctx, client, fromTag)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list tagged objects: %v", err)), nil
+	}
+
+	return MarshalProtoToolResponse(applyRetag(ctx, client, fromTag, toTag, objects))
+}
+
+// updateObjectTags writes tags onto one tagged object through its resource's
+// update endpoint.
+func updateObjectTags(ctx context.Context, client *linode.Client, object taggedObjectState, tags []string) error {
+	var err error
+
+	switch object.Type {
+	case "linode":
+		_, err = client.UpdateInstanceProto(ctx, object.ID, &linode.UpdateInstanceRequest{Tags: tags})
+	case "domain":
+		_, err = client.UpdateDomainProto(ctx, object.ID, &linode.UpdateDomainRequest{Tags: tags})
+	case "volume":
+		_, err = client.UpdateVolumeProto(ctx, object.ID, &linode.UpdateVolumeRequest{Tags: tags})
+	case "nodebalancer":
+		_, err = client.UpdateNodeBalancerProto(ctx, object.ID, linode.UpdateNodeBalancerRequest{Tags: tags})
+	default:
+		return fmt.Errorf("%w: %s", errTagRetagUnsupportedType, object.Type)
+	}
+
+	return err
+}
+
+// applyRetag moves each object from fromTag to toTag, one at a time so the
+// calls stay inside the client's rate limit. A failed object does not stop the
+// run: it is reported in its change entry and as an envelope warning, and
+// fromTag is kept so the rename can be retried.
+func applyRetag(ctx context.Context, client *linode.Client, fromTag, toTag string, objects []linode.TaggedObject) *linodev1.TagRetagResponse {
+	response := &linodev1.TagRetagResponse{
+		FromTag: fromTag,
+		ToTag:   toTag,
+		Matched: linodeIDToInt32(len(objects)),
+		Changes: []*linodev1.TagRetagChange{},
+	}
+
+	for _, raw := range objects {
+		object := taggedObjectStateOf(raw)
+		newTags := retaggedTags(object.Tags, fromTag, toTag)
+		change := &linodev1.TagRetagChange{
+			Type:    object.Type,
+			Id:      linodeIDToInt32(object.ID),
+			Label:   object.Label,
+			OldTags: object.Tags,
+			NewTags: newTags,
+		}
+
+		if err := updateObjectTags(ctx, client, object, newTags); err != nil {
+			change.Error = new(err.Error())
+			response.Failed++
+
+			AddWarning(ctx, "%s %d: %v", object.Type, object.ID, err)
+		} else {
+			response.Updated++
+		}
+
+		response.Changes = append(response.Changes, change)
+	}
+
+	if response.GetUpdated() > 0 && response.GetFailed() == 0 {
+		if err := client.DeleteTag(ctx, fromTag); err != nil {
+			AddWarning(ctx, "tag %q was not deleted: %v", fromTag, err)
+		} else {
+			response.OldTagDeleted = true
+		}
+	}
+
+	response.Message = fmt.Sprintf("Retagged %q to %q: %d updated, %d failed", fromTag, toTag,
+		response.GetUpdated(), response.GetFailed())
+
+	return response
+}
+
+// NewLinodeTagsCleanupTool creates a tool that deletes every tag with no
+// tagged objects.
+func NewLinodeTagsCleanupTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_tags_cleanup",
+		"Deletes every tag on the account that has no tagged objects. "+
+			"Pass dry_run=true to list the empty tags without deleting them.",
+		toolschemas.Schema("linode.mcp.v1.TagCleanupInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeTagsCleanupRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapDestroy, handler
+}
+
+// emptyTags lists every tag and returns the labels of those with no tagged
+// objects, plus the number of tags scanned.
+func emptyTags(ctx context.Context, client *linode.Client) ([]string, int, error) {
+	var tags []*linodev1.Tag
+
+	for page := 1; ; page++ {
+		items, err := client.ListTagsProto(ctx, page, tagsPageSizeMax)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		tags = append(tags, items...)
+
+		if len(items) < tagsPageSizeMax {
+			break
+		}
+	}
+
+	empty := []string{}
+
+	for _, tag := range tags {
+		objects, err := client.ListTaggedObjects(ctx, tag.GetLabel(), 1, tagsPageSizeMin)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if objects.Results == 0 {
+			empty = append(empty, tag.GetLabel())
+		}
+	}
+
+	return empty, len(tags), nil
+}
+
+// tagsCleanupSideEffects is the Tier B walk for linode_tags_cleanup: one line
+// per empty tag, then a summary line.
+func tagsCleanupSideEffects(state any) DryRunDetails {
+	empty, _ := state.([]string)
+	sideEffects := make([]string, 0, len(empty)+1)
+
+	for _, tag := range empty {
+		sideEffects = append(sideEffects, fmt.Sprintf("Tag %q has no tagged objects and is deleted.", tag))
+	}
+
+	sideEffects = append(sideEffects, fmt.Sprintf("%d empty tags would be deleted.", len(empty)))
+
+	return DryRunDetails{SideEffects: sideEffects}
+}
+
+func handleLinodeTagsCleanupRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	if IsDryRun(request) {
+		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_tags_cleanup", httpMethodDelete, "/tags/{tag_label}",
+			func(ctx context.Context, c *linode.Client) (any, error) {
+				empty, _, err := emptyTags(ctx, c)
+
+				return empty, err
+			},
+			func(_ context.Context, _ *linode.Client, state any) (DryRunDetails, error) {
+				return tagsCleanupSideEffects(state), nil
+			})
+	}
+
+	if result := requireDestroyConfirmation(ctx, request, "linode_tags_cleanup",
+		"This deletes every tag with no tagged objects. Set confirm=true to proceed."); result != nil {
+		return result, nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	empty, scanned, err := emptyTags(ctx, client)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to scan tags: %v", err)), nil
+	}
+
+	response := &linodev1.TagCleanupResponse{
+		Scanned:     linodeIDToInt32(scanned),
+		DeletedTags: []string{},
+		Failures:    []*linodev1.TagCleanupFailure{},
+	}
+
+	for _, tag := range empty {
+		if err := client.DeleteTag(ctx, tag); err != nil {
+			response.Failures = append(response.Failures, &linodev1.TagCleanupFailure{Tag: tag, Error: err.Error()})
+			response.Failed++
+
+			AddWarning(ctx, "tag %q: %v", tag, err)
+
+			continue
+		}
+
+		response.DeletedTags = append(response.DeletedTags, tag)
+		response.Deleted++
+	}
+
+	response.Message = fmt.Sprintf("Scanned %d tags: %d empty tags deleted, %d failed",
+		scanned, response.GetDeleted(), response.GetFailed())
+
+	return MarshalProtoToolResponse(response)
+}
@@ -0,0 +1,112 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// retagServer serves two objects tagged "old" and rejects the volume update
+// with a 400, recording every non-GET request it sees.
+func retagServer(t *testing.T, writes *[]string, mu *sync.Mutex) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method != http.MethodGet {
+			mu.Lock()
+			*writes = append(*writes, r.Method+" "+r.URL.Path)
+			mu.Unlock()
+		}
+
+		var body string
+
+		switch {
+		case r.Method == http.MethodGet:
+			body = `{"data":[` +
+				`{"type":"linode","data":{"id":7,"label":"web-1","tags":["old","prod"]}},` +
+				`{"type":"volume","data":{"id":9,"label":"data","tags":["old"]}}` +
+				`],"page":1,"pages":1,"results":2}`
+		case r.URL.Path == "/volumes/9":
+			w.WriteHeader(http.StatusBadRequest)
+
+			body = `{"errors":[{"reason":"volume busy"}]}`
+		default:
+			body = `{}`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestLinodeTagsRetagKeepsOldTagAfterFailure(t *testing.T) {
+	t.Parallel()
+
+	var (
+		writes []string
+		mu     sync.Mutex
+	)
+
+	srv := retagServer(t, &writes, &mu)
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeTagsRetagTool(cfg)
+
+	req := createRequestWithArgs(t, map[string]any{"from_tag": "old", "to_tag": "new", "confirm": true})
+
+	result, err := handler(tools.WithWarnings(t.Context()), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var summary struct {
+		Updated       int  `json:"updated"`
+		Failed        int  `json:"failed"`
+		OldTagDeleted bool `json:"old_tag_deleted"`
+		Changes       []struct {
+			ID      int      `json:"id"`
+			NewTags []string `json:"new_tags"`
+			Error   string   `json:"error"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &summary); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if summary.Updated != 1 || summary.Failed != 1 || summary.OldTagDeleted {
+		t.Errorf("updated/failed/old_tag_deleted = %d/%d/%v, want 1/1/false", summary.Updated, summary.Failed, summary.OldTagDeleted)
+	}
+
+	if len(summary.Changes) != 2 || !slices.Equal(summary.Changes[0].NewTags, []string{"new", "prod"}) || summary.Changes[1].Error == "" {
+		t.Errorf("changes = %+v, want linode 7 retagged in place and volume 9 failed", summary.Changes)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if slices.Contains(writes, "DELETE /tags/old") {
+		t.Errorf("writes = %v, want no tag delete after a failed object", writes)
+	}
+}
@@ -0,0 +1,336 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+const (
+	timelineDaysDefault = 7
+	timelineDaysLimit   = 90
+
+	// timelineEventPageSize and timelineEventPages cap the feed read at the
+	// newest 5000 events in the window. The API filters the feed to the
+	// window, so the cap only bites on an account that busy.
+	timelineEventPageSize = 500
+	timelineEventPages    = 10
+
+	// timelineArrow joins a resource's steps in its narrative.
+	timelineArrow = " → "
+)
+
+// timelineVerbs puts an action's final word in the past tense, so
+// linode_boot reads "booted" and disk_create reads "disk created". Words not
+// listed are kept as they are.
+var timelineVerbs = map[string]string{
+	"add":      "added",
+	"attach":   "attached",
+	"boot":     "booted",
+	"cancel":   "canceled",
+	"clone":    "cloned",
+	"create":   "created",
+	"delete":   "deleted",
+	"detach":   "detached",
+	"disable":  "disabled",
+	"enable":   "enabled",
+	"migrate":  "migrated",
+	"reboot":   "rebooted",
+	"rebuild":  "rebuilt",
+	"remove":   "removed",
+	"resize":   "resized",
+	"restore":  "restored",
+	"shutdown": "shut down",
+	"update":   "updated",
+	"upgrade":  "upgraded",
+}
+
+// timelineDeviceActions are the firewall events told from the device's side:
+// the event's entity is the firewall and its secondary entity the instance
+// or NodeBalancer, which is where a reader looks for "firewall attached".
+var timelineDeviceActions = map[string]string{
+	"firewall_device_add":    "attached",
+	"firewall_device_remove": "detached",
+}
+
+// NewLinodeTimelineTool creates a tool that narrates recent account events
+// per resource.
+func NewLinodeTimelineTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_timeline",
+		"Renders the last days of account events (default 7, up to 90) as a chronological narrative per "+
+			"resource, such as \"web-1: created → booted → firewall edge attached\", with each step's time, "+
+			"user, and status. Repeated events collapse into one step with a count. Pass entity_type (for "+
+			"example linode or volume) to narrate only that kind of resource. The window is read up to its "+
+			"newest 5000 events; pass page (and page_size) to narrate it one page at a time instead. Use "+
+			"linode_account_event_list for the raw events.",
+		toolschemas.Schema("linode.mcp.v1.TimelineInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeTimelineRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLinodeTimelineRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	days := timelineDaysDefault
+	if _, exists := request.GetArguments()["days"]; exists {
+		value, msg := boundedIntArgument(request, "days", 1, timelineDaysLimit,
+			fmt.Sprintf("days must be from 1 through %d", timelineDaysLimit))
+		if msg != "" {
+			return mcp.NewToolResultError(msg), nil
+		}
+
+		days = value
+	}
+
+	entityType := strings.TrimSpace(request.GetString("entity_type", ""))
+
+	page, pageSize, msg := timelinePaginationFromTool(request)
+	if msg != "" {
+		return mcp.NewToolResultError(msg), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	since := time.Now().UTC().AddDate(0, 0, -days).Format(linodeTimeLayout)
+
+	events, complete, err := timelineEvents(ctx, client, since, page, pageSize)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to build timeline: %v", err)), nil
+	}
+
+	var warnings []string
+
+	if !complete {
+		warning := fmt.Sprintf("Only the newest %d events were read; pass page %d for earlier events in the window.",
+			timelineEventPageSize*timelineEventPages, timelineEventPages+1)
+		if page > 0 {
+			warning = fmt.Sprintf("Only page %d of the window's events was read; pass page %d for earlier events.", page, page+1)
+		}
+
+		warnings = append(warnings, warning)
+
+		AddWarning(ctx, "%s", warning)
+	}
+
+	return MarshalProtoToolResponse(timelineReport(events, since, entityType, warnings))
+}
+
+func timelinePaginationFromTool(request *mcp.CallToolRequest) (int, int, string) {
+	args := request.GetArguments()
+
+	page, validationMessage := optionalPaginationInt(args, "page", 1, 0)
+	if validationMessage != "" {
+		return 0, 0, validationMessage
+	}
+
+	pageSize, validationMessage := optionalPaginationInt(args, "page_size", accountEventsPageSizeMin, accountEventsPageSizeMax)
+	if validationMessage != "" {
+		return 0, 0, validationMessage
+	}
+
+	if pageSize == 0 {
+		pageSize = timelineEventPageSize
+	}
+
+	return page, pageSize, ""
+}
+
+// timelineEvents reads the event feed newest first until it passes since or
+// reaches the timeline's scan cap, or reads the one page asked for. complete
+// is false when the cap or the page boundary cut the read short. The API is
+// asked for the window alone (timelineListOptions); the since check still
+// stops a read whose filter was not applied.
+func timelineEvents(ctx context.Context, client *linode.Client, since string, page, pageSize int) ([]*linodev1.AccountEvent, bool, error) {
+	ctx = linode.WithListOptions(ctx, timelineListOptions(since))
+
+	if page > 0 {
+		events, err := client.ListAccountEventsProto(ctx, page, pageSize)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return events, len(events) < pageSize || events[len(events)-1].GetCreated() < since, nil
+	}
+
+	var events []*linodev1.AccountEvent
+
+	for feedPage := 1; feedPage <= timelineEventPages; feedPage++ {
+		batch, err := client.ListAccountEventsProto(ctx, feedPage, timelineEventPageSize)
+		if err != nil {
+			return nil, false, err
+		}
+
+		events = append(events, batch...)
+
+		if len(batch) < timelineEventPageSize || batch[len(batch)-1].GetCreated() < since {
+			return events, true, nil
+		}
+	}
+
+	return events, false, nil
+}
+
+// timelineListOptions asks the API for the events created at or after since,
+// newest first.
+func timelineListOptions(since string) linode.ListOptions {
+	return linode.ListOptions{
+		Filter:  map[string]any{"created": map[string]any{"+gte": since}},
+		OrderBy: "created",
+		Order:   "desc",
+	}
+}
+
+// timelineReport narrates the events created at or after since, oldest
+// first, grouped by the resource each one happened to. An event read twice
+// (the feed shifts while it is paged) counts once.
+func timelineReport(events []*linodev1.AccountEvent, since, entityType string, warnings []string) *linodev1.TimelineResponse {
+	window := make([]*linodev1.AccountEvent, 0, len(events))
+	seen := map[int32]bool{}
+
+	for _, event := range events {
+		if event.GetCreated() < since || seen[event.GetId()] {
+			continue
+		}
+
+		seen[event.GetId()] = true
+
+		window = append(window, event)
+	}
+
+	slices.SortStableFunc(window, func(a, b *linodev1.AccountEvent) int {
+		return cmp.Or(cmp.Compare(a.GetCreated(), b.GetCreated()), cmp.Compare(a.GetId(), b.GetId()))
+	})
+
+	var resources []*linodev1.TimelineResource
+
+	byKey := map[string]*linodev1.TimelineResource{}
+	narrated := 0
+
+	for _, event := range window {
+		subject, summary := timelineSubject(event)
+		if subject.GetType() == "" || (entityType != "" && subject.GetType() != entityType) {
+			continue
+		}
+
+		narrated++
+
+		id := int32(subject.GetId().GetNumberValue())
+		key := subject.GetType() + "/" + strconv.Itoa(int(id))
+
+		resource, ok := byKey[key]
+		if !ok {
+			resource = &linodev1.TimelineResource{EntityType: subject.GetType(), EntityId: id, FirstAt: event.GetCreated()}
+			byKey[key] = resource
+			resources = append(resources, resource)
+		}
+
+		if subject.GetLabel() != "" {
+			resource.Label = subject.GetLabel()
+		}
+
+		resource.LastAt = event.GetCreated()
+
+		if last := lastTimelineStep(resource); last != nil && last.GetSummary() == summary && last.GetStatus() == event.GetStatus() {
+			last.Count++
+
+			continue
+		}
+
+		resource.Steps = append(resource.Steps, &linodev1.TimelineStep{
+			Action:   event.GetAction(),
+			Summary:  summary,
+			At:       event.GetCreated(),
+			Username: event.GetUsername(),
+			Status:   event.GetStatus(),
+			Count:    1,
+		})
+	}
+
+	for _, resource := range resources {
+		resource.Narrative = timelineNarrative(resource)
+	}
+
+	return &linodev1.TimelineResponse{
+		Message: fmt.Sprintf("%d event(s) across %d resource(s) since %s",
+			narrated, len(resources), since),
+		EventsScanned: linodeIDToInt32(len(events)),
+		Since:         since,
+		ResourceCount: linodeIDToInt32(len(resources)),
+		Resources:     resources,
+		Warnings:      warnings,
+	}
+}
+
+func lastTimelineStep(resource *linodev1.TimelineResource) *linodev1.TimelineStep {
+	if len(resource.GetSteps()) == 0 {
+		return nil
+	}
+
+	return resource.GetSteps()[len(resource.GetSteps())-1]
+}
+
+// timelineSubject returns the resource an event happened to and the event
+// in words.
+func timelineSubject(event *linodev1.AccountEvent) (*linodev1.AccountEventEntity, string) {
+	if verb, ok := timelineDeviceActions[event.GetAction()]; ok && event.GetSecondaryEntity().GetType() != "" {
+		return event.GetSecondaryEntity(), strings.TrimSpace("firewall "+event.GetEntity().GetLabel()) + " " + verb
+	}
+
+	return event.GetEntity(), timelineSummary(event.GetAction(), event.GetEntity().GetType())
+}
+
+// timelineSummary puts an action in words, dropping the entity type it
+// starts with: linode_boot on a linode reads "booted".
+func timelineSummary(action, entityType string) string {
+	words := strings.Split(strings.TrimPrefix(action, entityType+"_"), "_")
+	if past, ok := timelineVerbs[words[len(words)-1]]; ok {
+		words[len(words)-1] = past
+	}
+
+	return strings.Join(words, " ")
+}
+
+// timelineNarrative renders a resource's steps on one line, marking failed
+// steps and repeats.
+func timelineNarrative(resource *linodev1.TimelineResource) string {
+	label := resource.GetLabel()
+	if label == "" {
+		label = fmt.Sprintf("%s %d", resource.GetEntityType(), resource.GetEntityId())
+	}
+
+	parts := make([]string, 0, len(resource.GetSteps()))
+
+	for _, step := range resource.GetSteps() {
+		part := step.GetSummary()
+		if step.GetStatus() == "failed" {
+			part += " (failed)"
+		}
+
+		if step.GetCount() > 1 {
+			part += fmt.Sprintf(" ×%d", step.GetCount())
+		}
+
+		parts = append(parts, part)
+	}
+
+	return label + ": " + strings.Join(parts, timelineArrow)
+}
@@ -0,0 +1,127 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// Events are narrated oldest first per resource: a firewall attachment lands
+// on the instance, repeated boots collapse, an event read twice counts once,
+// and an event older than the window is left out. The window is also sent as
+// the feed's X-Filter.
+func TestLinodeTimelineToolNarratesResources(t *testing.T) {
+	t.Parallel()
+
+	at := func(ago time.Duration) string {
+		return time.Now().UTC().Add(-ago).Format("2006-01-02T15:04:05")
+	}
+
+	events := fmt.Sprintf(`{"data":[`+
+		`{"id":6,"action":"firewall_device_add","created":%q,"username":"alice","status":"finished","entity":{"id":3,"label":"edge","type":"firewall"},"secondary_entity":{"id":5,"label":"web-1","type":"linode"}},`+
+		`{"id":5,"action":"linode_boot","created":%q,"username":"alice","status":"finished","entity":{"id":5,"label":"web-1","type":"linode"}},`+
+		`{"id":4,"action":"linode_boot","created":%q,"username":"alice","status":"failed","entity":{"id":5,"label":"web-1","type":"linode"}},`+
+		`{"id":4,"action":"linode_boot","created":%q,"username":"alice","status":"failed","entity":{"id":5,"label":"web-1","type":"linode"}},`+
+		`{"id":3,"action":"volume_create","created":%q,"username":"bob","status":"finished","entity":{"id":8,"label":"data","type":"volume"}},`+
+		`{"id":2,"action":"linode_create","created":%q,"username":"alice","status":"finished","entity":{"id":5,"label":"web-1","type":"linode"}},`+
+		`{"id":1,"action":"linode_delete","created":%q,"username":"alice","status":"finished","entity":{"id":4,"label":"old","type":"linode"}}`+
+		`],"page":1,"pages":1,"results":7}`,
+		at(time.Hour), at(2*time.Hour), at(3*time.Hour), at(3*time.Hour), at(4*time.Hour), at(5*time.Hour), at(30*24*time.Hour))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/account/events" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		var filter struct {
+			Created map[string]string `json:"created"`
+			Order   string            `json:"+order"`
+		}
+		if err := json.Unmarshal([]byte(r.Header.Get("X-Filter")), &filter); err != nil || filter.Created["+gte"] == "" || filter.Order != "desc" {
+			t.Errorf("X-Filter = %q, want the window start newest first", r.Header.Get("X-Filter"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(events))
+	}))
+	defer srv.Close()
+
+	cfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+	_, _, handler := tools.NewLinodeTimelineTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if result.IsError || !ok {
+		t.Fatalf("result = %v, want a timeline", result.Content)
+	}
+
+	var response struct {
+		EventsScanned int `json:"events_scanned"`
+		Resources     []struct {
+			EntityType string `json:"entity_type"`
+			EntityID   int    `json:"entity_id"`
+			Narrative  string `json:"narrative"`
+		} `json:"resources"`
+	}
+	if err := json.Unmarshal([]byte(text.Text), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if response.EventsScanned != 7 || len(response.Resources) != 2 {
+		t.Fatalf("response = %+v, want 7 events scanned and 2 resources", response)
+	}
+
+	if got, want := response.Resources[0].Narrative, "web-1: created → booted (failed) → booted → firewall edge attached"; got != want {
+		t.Errorf("web-1 narrative = %q, want %q", got, want)
+	}
+
+	if got, want := response.Resources[1].Narrative, "data: created"; got != want {
+		t.Errorf("volume narrative = %q, want %q", got, want)
+	}
+
+	volumes, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"entity_type": "volume"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if text, _ := volumes.Content[0].(mcp.TextContent); !json.Valid([]byte(text.Text)) || volumes.IsError {
+		t.Fatalf("entity_type result = %v, want a timeline", volumes.Content)
+	}
+}
+
+func TestLinodeTimelineToolRejectsDays(t *testing.T) {
+	t.Parallel()
+
+	_, _, handler := tools.NewLinodeTimelineTool(newTestConfig("https://api.linode.com/v4"))
+
+	for _, days := range []float64{0, 91} {
+		result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"days": days}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		text, _ := result.Content[0].(mcp.TextContent)
+		if !result.IsError || text.Text != "days must be from 1 through 90" {
+			t.Errorf("days %v: result = %v, want the days error", days, result.Content)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+)
+
+// linode_transfer_forecast bounds and units. The account pool is reported in
+// GB and instance transfer in bytes; Linode's transfer GB is decimal.
+const (
+	transferForecastTopDefault = 5
+	transferForecastTopMax     = 50
+	transferBytesPerGB         = 1e9
+	transferPercentScale       = 100
+	transferTenthScale         = 10
+)
+
+// NewLinodeTransferForecastTool creates a tool that projects the account's
+// network transfer pool usage to the end of the month.
+func NewLinodeTransferForecastTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_transfer_forecast",
+		"Projects the account's month-to-date network transfer to the end of the month (a linear "+
+			"extrapolation over the UTC calendar month) and warns when the pool quota is likely to be "+
+			"exceeded. Lists the instances using the most transfer (top, default 5) with their own "+
+			"projections and share of the pool.",
+		toolschemas.Schema("linode.mcp.v1.TransferForecastInput"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeTransferForecastRequest(ctx, &request, cfg)
+	}
+
+	return tool, profiles.CapRead, handler
+}
+
+func handleLinodeTransferForecastRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	top := request.GetInt("top", transferForecastTopDefault)
+	if top < 1 || top > transferForecastTopMax {
+		return mcp.NewToolResultError(fmt.Sprintf("top must be from 1 through %d", transferForecastTopMax)), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	pool, err := client.GetAccountTransferProto(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve account transfer: %v", err)), nil
+	}
+
+	instances, err := client.ListInstancesProto(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve instances: %v", err)), nil
+	}
+
+	contributors, warnings := instanceTransferUsage(ctx, client, instances)
+
+	return MarshalProtoToolResponse(transferForecast(pool, contributors, warnings, time.Now().UTC(), top))
+}
+
+// instanceTransferUsage reads each instance's month-to-date transfer, one at a
+// time so the calls stay inside the client's rate limit. An instance whose
+// transfer cannot be read is skipped with a warning rather than failing the
+// forecast.
+func instanceTransferUsage(ctx context.Context, client *linode.Client, instances []*linodev1.Instance) ([]*linodev1.TransferContributor, []string) {
+	contributors := make([]*linodev1.TransferContributor, 0, len(instances))
+
+	var warnings []string
+
+	for _, instance := range instances {
+		transfer, err := client.GetInstanceTransferProto(ctx, int(instance.GetId()))
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("Instance %d (%s) transfer unavailable: %v", instance.GetId(), instance.GetLabel(), err))
+
+			continue
+		}
+
+		contributors = append(contributors, &linodev1.TransferContributor{
+			Id:     instance.GetId(),
+			Label:  instance.GetLabel(),
+			Region: instance.GetRegion(),
+			UsedGb: float64(transfer.GetUsed()) / transferBytesPerGB,
+		})
+	}
+
+	return contributors, warnings
+}
+
+// transferForecast extrapolates the pool and each contributor linearly over
+// the UTC calendar month containing now, then keeps the top heaviest
+// contributors.
+func transferForecast(pool *linodev1.AccountTransfer, contributors []*linodev1.TransferContributor, warnings []string, now time.Time, top int) *linodev1.TransferForecastResponse {
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	monthLength := float64(monthStart.AddDate(0, 1, 0).Sub(monthStart))
+
+	// Count at least an hour so a forecast in the month's first minutes
+	// stays finite.
+	elapsed := max(float64(now.Sub(monthStart)), float64(time.Hour)) / monthLength
+
+	projected := float64(pool.GetUsed()) / elapsed
+	response := &linodev1.TransferForecastResponse{
+		QuotaGb:            pool.GetQuota(),
+		UsedGb:             pool.GetUsed(),
+		BillableGb:         pool.GetBillable(),
+		MonthElapsedPct:    roundTenth(elapsed * transferPercentScale),
+		ProjectedGb:        roundTenth(projected),
+		ProjectedOverageGb: roundTenth(max(projected-float64(pool.GetQuota()), 0)),
+		InstancesChecked:   linodeIDToInt32(len(contributors)),
+		TopContributors:    []*linodev1.TransferContributor{},
+	}
+	response.OverageLikely = response.GetProjectedOverageGb() > 0
+
+	slices.SortStableFunc(contributors, func(a, b *linodev1.TransferContributor) int {
+		return cmp.Or(cmp.Compare(b.GetUsedGb(), a.GetUsedGb()), cmp.Compare(a.GetId(), b.GetId()))
+	})
+
+	for _, contributor := range contributors[:min(top, len(contributors))] {
+		if pool.GetUsed() > 0 {
+			contributor.SharePct = roundTenth(contributor.GetUsedGb() / float64(pool.GetUsed()) * transferPercentScale)
+		}
+
+		contributor.ProjectedGb = roundTenth(contributor.GetUsedGb() / elapsed)
+		contributor.UsedGb = roundTenth(contributor.GetUsedGb())
+		response.TopContributors = append(response.TopContributors, contributor)
+	}
+
+	if pool.GetBillable() > 0 {
+		response.Warnings = append(response.Warnings, fmt.Sprintf(
+			"The pool is already %d GB over quota; overage is billed per GB.", pool.GetBillable()))
+	}
+
+	if response.GetOverageLikely() {
+		response.Warnings = append(response.Warnings, fmt.Sprintf(
+			"At the current pace the pool reaches %.1f GB by month end, %.1f GB over the %d GB quota.",
+			response.GetProjectedGb(), response.GetProjectedOverageGb(), pool.GetQuota()))
+		response.Message = fmt.Sprintf("Transfer overage likely: projected %.1f GB against a %d GB pool (%.1f%% of the month elapsed)",
+			response.GetProjectedGb(), pool.GetQuota(), response.GetMonthElapsedPct())
+	} else {
+		response.Message = fmt.Sprintf("Transfer on pace for %.1f GB of the %d GB pool (%.1f%% of the month elapsed)",
+			response.GetProjectedGb(), pool.GetQuota(), response.GetMonthElapsedPct())
+	}
+
+	response.Warnings = append(response.Warnings, warnings...)
+
+	return response
+}
+
+// roundTenth rounds to one decimal place so both languages report the same
+// figures.
+func roundTenth(value float64) float64 {
+	return math.Round(value*transferTenthScale) / transferTenthScale
+}
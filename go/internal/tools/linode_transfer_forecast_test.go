@@ -0,0 +1,100 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeTransferForecastRanksContributors(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body string
+
+		switch r.URL.Path {
+		case "/account/transfer":
+			body = `{"quota":1000,"used":500,"billable":20}`
+		case "/linode/instances":
+			body = `{"data":[` +
+				`{"id":1,"label":"small","region":"us-east"},` +
+				`{"id":2,"label":"big","region":"us-east"},` +
+				`{"id":3,"label":"gone","region":"eu-west"}` +
+				`],"page":1,"pages":1,"results":3}`
+		case "/linode/instances/1/transfer":
+			body = `{"used":50000000000,"quota":1000,"billable":0}`
+		case "/linode/instances/2/transfer":
+			body = `{"used":250000000000,"quota":1000,"billable":0}`
+		case "/linode/instances/3/transfer":
+			w.WriteHeader(http.StatusNotFound)
+
+			body = `{"errors":[{"reason":"Not found"}]}`
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+	_, _, handler := tools.NewLinodeTransferForecastTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{"top": 1}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var forecast struct {
+		UsedGB           int      `json:"used_gb"`
+		InstancesChecked int      `json:"instances_checked"`
+		Warnings         []string `json:"warnings"`
+		TopContributors  []struct {
+			ID       int     `json:"id"`
+			UsedGB   float64 `json:"used_gb"`
+			SharePct float64 `json:"share_pct"`
+		} `json:"top_contributors"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &forecast); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if forecast.UsedGB != 500 || forecast.InstancesChecked != 2 {
+		t.Errorf("used_gb/instances_checked = %d/%d, want 500/2", forecast.UsedGB, forecast.InstancesChecked)
+	}
+
+	if len(forecast.TopContributors) != 1 || forecast.TopContributors[0].ID != 2 ||
+		forecast.TopContributors[0].UsedGB != 250 || forecast.TopContributors[0].SharePct != 50 {
+		t.Errorf("top_contributors = %+v, want only instance 2 at 250 GB, 50%%", forecast.TopContributors)
+	}
+
+	joined := strings.Join(forecast.Warnings, "\n")
+	if !strings.Contains(joined, "already 20 GB over quota") {
+		t.Errorf("warnings = %q, want the billable overage warning", forecast.Warnings)
+	}
+
+	if !strings.Contains(joined, "Instance 3 (gone) transfer unavailable") {
+		t.Errorf("warnings = %q, want the unreadable instance warning", forecast.Warnings)
+	}
+}
@@ -3,43 +3,150 @@ package tools
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 
 	"github.com/chadit/LinodeMCP/go/internal/config"
 	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
-	"github.com/chadit/LinodeMCP/go/internal/linode"
 	"github.com/chadit/LinodeMCP/go/internal/profiles"
 	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
 )
 
 const (
 	paramTypeID               = "type_id"
+	paramTypeRegion           = "region"
 	errTypeIDRequired         = "type_id must be a non-empty string"
 	errTypeIDNoPathSeparators = "type_id must not contain '/', '?', '#', or '..'"
 )
 
 // NewLinodeTypeListTool creates a tool for listing Linode instance types.
 func NewLinodeTypeListTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
-	tool, handler := newProtoListToolRawSchema(
-		cfg,
+	tool := mcp.NewToolWithRawSchema(
 		"linode_type_list",
-		"Lists all available Linode instance types (plans) with pricing information. Can filter by class (standard, dedicated, gpu, highmem, premium).",
-		"linode.mcp.v1.InstanceTypeListInput",
-		func(ctx context.Context, client *linode.Client) ([]*linodev1.InstanceType, error) {
-			return client.ListTypesProto(ctx)
-		},
-		[]listFilterParam[*linodev1.InstanceType]{
-			fieldFilter("class", "Filter types by class (standard, dedicated, gpu, highmem, premium)",
-				func(t *linodev1.InstanceType) string { return t.GetClass() }),
-		},
-		instanceTypeListResponse,
+		"Lists all available Linode instance types (plans) with pricing information. Can filter by class "+
+			"(nanode, standard, dedicated, highmem, premium, gpu), by minimum vcpus and memory (MB), and by region, "+
+			"which leaves out plans the region reports as unavailable. Use class=gpu with a region to find GPU plans you can deploy there.",
+		toolschemas.Schema("linode.mcp.v1.InstanceTypeListInput"),
 	)
 
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLinodeTypeListRequest(ctx, &request, cfg)
+	}
+
 	return tool, profiles.CapRead, handler
 }
 
+// instanceTypeClasses are the class values linode_type_list accepts, in the
+// order its error message lists them.
+var instanceTypeClasses = []string{"nanode", "standard", "dedicated", "highmem", "premium", "gpu"}
+
+// typeListFilters are the validated linode_type_list filters. A zero field
+// means the filter was not given.
+type typeListFilters struct {
+	class     string
+	minVCPUs  int
+	minMemory int
+	region    string
+}
+
+func typeListFiltersFromTool(request *mcp.CallToolRequest) (typeListFilters, string) {
+	args := request.GetArguments()
+
+	var filters typeListFilters
+
+	if class := request.GetString("class", ""); class != "" {
+		if !slices.Contains(instanceTypeClasses, strings.ToLower(class)) {
+			return filters, "class must be one of " + strings.Join(instanceTypeClasses, ", ")
+		}
+
+		filters.class = class
+	}
+
+	var validationMessage string
+
+	if filters.minVCPUs, validationMessage = optionalPaginationInt(args, "min_vcpus", 1, 0); validationMessage != "" {
+		return filters, validationMessage
+	}
+
+	if filters.minMemory, validationMessage = optionalPaginationInt(args, "min_memory", 1, 0); validationMessage != "" {
+		return filters, validationMessage
+	}
+
+	if region := request.GetString(paramTypeRegion, ""); region != "" {
+		if err := validateRegionSlug(region); err != nil {
+			return filters, ErrRegionInvalid.Error()
+		}
+
+		filters.region = region
+	}
+
+	return filters, ""
+}
+
+func handleLinodeTypeListRequest(ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config) (*mcp.CallToolResult, error) {
+	filters, validationMessage := typeListFiltersFromTool(request)
+	if validationMessage != "" {
+		return mcp.NewToolResultError(validationMessage), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	types, err := client.ListTypesProto(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve items: %v", err)), nil
+	}
+
+	var appliedFilters []string
+
+	if filters.class != "" {
+		types = FilterByField(types, filters.class, (*linodev1.InstanceType).GetClass)
+		appliedFilters = append(appliedFilters, "class="+filters.class)
+	}
+
+	if filters.minVCPUs > 0 {
+		types = slices.DeleteFunc(types, func(t *linodev1.InstanceType) bool { return int(t.GetVcpus()) < filters.minVCPUs })
+		appliedFilters = append(appliedFilters, "min_vcpus="+strconv.Itoa(filters.minVCPUs))
+	}
+
+	if filters.minMemory > 0 {
+		types = slices.DeleteFunc(types, func(t *linodev1.InstanceType) bool { return int(t.GetMemory()) < filters.minMemory })
+		appliedFilters = append(appliedFilters, "min_memory="+strconv.Itoa(filters.minMemory))
+	}
+
+	if filters.region != "" {
+		availability, err := client.GetRegionAvailabilityProto(ctx, filters.region)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to retrieve availability for region %s: %v", filters.region, err)), nil
+		}
+
+		types = filterTypesAvailableIn(types, availability)
+		appliedFilters = append(appliedFilters, "region="+filters.region)
+	}
+
+	return marshalProtoList(types, appliedFilters, instanceTypeListResponse)
+}
+
+// filterTypesAvailableIn drops the types a region's availability list marks
+// unavailable. The list only covers plans with limited capacity, so a type it
+// does not mention is kept.
+func filterTypesAvailableIn(types []*linodev1.InstanceType, availability []*linodev1.RegionAvailability) []*linodev1.InstanceType {
+	unavailable := make(map[string]bool, len(availability))
+
+	for _, entry := range availability {
+		if !entry.GetAvailable() {
+			unavailable[entry.GetPlan()] = true
+		}
+	}
+
+	return slices.DeleteFunc(types, func(t *linodev1.InstanceType) bool { return unavailable[t.GetId()] })
+}
+
 func instanceTypeListResponse(items []*linodev1.InstanceType, count int32, filter *string) *linodev1.InstanceTypeListResponse {
 	return &linodev1.InstanceTypeListResponse{Count: count, Filter: filter, Types: items}
 }
@@ -65,7 +172,7 @@ func handleLinodeTypeGetRequest(ctx context.Context, request *mcp.CallToolReques
 		return mcp.NewToolResultError(validationErr), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
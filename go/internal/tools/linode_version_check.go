@@ -0,0 +1,73 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/appinfo"
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/toolschemas"
+	"github.com/chadit/LinodeMCP/go/internal/updatecheck"
+)
+
+// NewLinodeVersionCheckTool returns the linode_version_check query tool. It
+// asks the GitHub releases endpoint (update_check.url) whether a newer
+// LinodeMCP exists and returns the latest version, its release page, and the
+// first changelog bullets. With update_check.enabled: false it fetches
+// nothing and says so. CapMeta so it is available in every profile; talks to
+// GitHub, never the Linode API.
+func NewLinodeVersionCheckTool(
+	cfg *config.Config,
+) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
+	tool := mcp.NewToolWithRawSchema(
+		"linode_version_check",
+		"Check GitHub releases for a newer LinodeMCP version and summarize "+
+			"its changelog highlights. Reports that the check is disabled "+
+			"when update_check.enabled is false (air-gapped installs).",
+		toolschemas.Schema("linode.mcp.v1.VersionCheckInput"),
+	)
+
+	handler := func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		live := resolveConfig(cfg)
+		current := appinfo.Get().Version
+
+		if live == nil || live.UpdateCheck.Enabled == nil || !*live.UpdateCheck.Enabled {
+			return MarshalProtoToolResponse(&linodev1.VersionCheckResponse{
+				Message:        "Update check is disabled (update_check.enabled: false).",
+				CurrentVersion: current,
+			})
+		}
+
+		result, err := updatecheck.Check(ctx, nil, live.UpdateCheck.URL, current)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to check for updates: %v", err)), nil
+		}
+
+		return MarshalProtoToolResponse(versionCheckProto(&result))
+	}
+
+	return tool, profiles.CapMeta, handler
+}
+
+// versionCheckProto maps a completed check onto the wire response.
+func versionCheckProto(result *updatecheck.Result) *linodev1.VersionCheckResponse {
+	message := fmt.Sprintf("LinodeMCP %s is up to date (latest release %s).", result.Current, result.Latest)
+	if result.UpdateAvailable {
+		message = fmt.Sprintf("LinodeMCP %s is available (running %s).", result.Latest, result.Current)
+	}
+
+	return &linodev1.VersionCheckResponse{
+		Message:         message,
+		Checked:         true,
+		CurrentVersion:  result.Current,
+		LatestVersion:   result.Latest,
+		UpdateAvailable: result.UpdateAvailable,
+		ReleaseUrl:      result.ReleaseURL,
+		PublishedAt:     result.PublishedAt,
+		Highlights:      result.Highlights,
+	}
+}
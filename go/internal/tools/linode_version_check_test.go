@@ -0,0 +1,78 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// callVersionCheck runs linode_version_check with the given update_check
+// block and decodes the result.
+func callVersionCheck(t *testing.T, updateCheck config.UpdateCheckConfig) map[string]any {
+	t.Helper()
+
+	_, _, handler := tools.NewLinodeVersionCheckTool(&config.Config{UpdateCheck: updateCheck})
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %s", textContent.Text)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(textContent.Text), &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return decoded
+}
+
+func TestLinodeVersionCheckDisabledFetchesNothing(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		t.Error("releases endpoint called with update_check disabled")
+	}))
+	t.Cleanup(srv.Close)
+
+	response := callVersionCheck(t, config.UpdateCheckConfig{Enabled: new(false), URL: srv.URL})
+
+	if response["checked"] != false || response["update_available"] != false {
+		t.Errorf("response = %v, want an unchecked result", response)
+	}
+}
+
+func TestLinodeVersionCheckReportsNewerRelease(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"tag_name":"v99.0.0","html_url":"https://example.test/r/v99.0.0",` +
+			`"published_at":"2026-10-01T00:00:00Z","body":"## Features\n- Faster lists\n"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	response := callVersionCheck(t, config.UpdateCheckConfig{Enabled: new(true), URL: srv.URL})
+
+	if response["checked"] != true || response["update_available"] != true || response["latest_version"] != "99.0.0" {
+		t.Errorf("response = %v, want 99.0.0 available", response)
+	}
+
+	highlights, _ := response["highlights"].([]any)
+	if len(highlights) != 1 || highlights[0] != "Faster lists" {
+		t.Errorf("highlights = %v, want [Faster lists]", highlights)
+	}
+}
@@ -0,0 +1,151 @@
+package tools
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+)
+
+// The linode_volume_create and linode_volume_attach arguments that ask for a
+// bootstrap script, and how it lays the volume out.
+const (
+	paramVolumeBootstrap  = "bootstrap"
+	paramVolumeFilesystem = "filesystem"
+	paramVolumeMountPoint = "mount_point"
+
+	volumeBootstrapDefaultFilesystem = "ext4"
+)
+
+var (
+	volumeBootstrapFilesystems = []string{"ext4", "xfs"}
+
+	// volumeMountPointPattern keeps the mount point safe to paste into the
+	// script and /etc/fstab unquoted.
+	volumeMountPointPattern = regexp.MustCompile(`^(/[A-Za-z0-9._-]+)+$`)
+)
+
+// volumeBootstrapScript partitions, formats, and mounts a volume from inside
+// its Linode. It only writes to a volume with no filesystem and no partition
+// table, so running it on a volume that holds data just mounts that data.
+// Arguments: label, device, partition, mount point, filesystem.
+const volumeBootstrapScript = `#!/bin/bash
+# Partition, format, and mount Linode volume %[1]s at %[4]s.
+# Safe to re-run: a volume that already holds a filesystem is mounted as it
+# is, never reformatted.
+set -euo pipefail
+
+DEVICE="%[2]s"
+PARTITION="%[3]s"
+MOUNT_POINT="%[4]s"
+FILESYSTEM="%[5]s"
+
+# The device can take a few seconds to appear after the attach.
+for _ in $(seq 60); do [ -e "$DEVICE" ] && break; sleep 2; done
+if [ ! -e "$DEVICE" ]; then
+  echo "$DEVICE did not appear; is the volume attached?" >&2
+  exit 1
+fi
+
+if [ -n "$(blkid -o value -s TYPE "$DEVICE" || true)" ]; then
+  TARGET="$DEVICE"
+else
+  if [ ! -e "$PARTITION" ]; then
+    if [ -n "$(blkid -o value -s PTTYPE "$DEVICE" || true)" ]; then
+      echo "$DEVICE has a partition table but no $PARTITION; leaving it alone" >&2
+      exit 1
+    fi
+    parted -s "$DEVICE" mklabel gpt mkpart primary "$FILESYSTEM" 0%% 100%%
+    udevadm settle
+  fi
+  TARGET="$PARTITION"
+  if [ -z "$(blkid -o value -s TYPE "$TARGET" || true)" ]; then
+    "mkfs.$FILESYSTEM" "$TARGET"
+  fi
+fi
+
+mkdir -p "$MOUNT_POINT"
+if ! grep -qs " $MOUNT_POINT " /etc/fstab; then
+  TYPE="$(blkid -o value -s TYPE "$TARGET")"
+  echo "$TARGET $MOUNT_POINT $TYPE defaults,noatime,nofail 0 2" >> /etc/fstab
+fi
+mountpoint -q "$MOUNT_POINT" || mount "$MOUNT_POINT"
+`
+
+// volumeBootstrapOptions is what the caller asked the bootstrap script to
+// do; an empty mountPoint means /mnt/<label>.
+type volumeBootstrapOptions struct {
+	enabled    bool
+	filesystem string
+	mountPoint string
+}
+
+// volumeBootstrapFromTool reads and validates the bootstrap arguments,
+// returning an error message or "". attachTo is the Linode the volume ends
+// up on; a volume created without one has nothing to mount it.
+func volumeBootstrapFromTool(request *mcp.CallToolRequest, attachTo int) (volumeBootstrapOptions, string) {
+	opts := volumeBootstrapOptions{
+		enabled:    request.GetBool(paramVolumeBootstrap, false),
+		filesystem: request.GetString(paramVolumeFilesystem, ""),
+		mountPoint: request.GetString(paramVolumeMountPoint, ""),
+	}
+
+	if !opts.enabled {
+		if opts.filesystem != "" || opts.mountPoint != "" {
+			return opts, "filesystem and mount_point require bootstrap=true"
+		}
+
+		return opts, ""
+	}
+
+	if attachTo == 0 {
+		return opts, "bootstrap requires linode_id: the volume must be attached to an instance to be mounted"
+	}
+
+	if opts.filesystem == "" {
+		opts.filesystem = volumeBootstrapDefaultFilesystem
+	}
+
+	if !slices.Contains(volumeBootstrapFilesystems, opts.filesystem) {
+		return opts, fmt.Sprintf("filesystem must be one of %s", strings.Join(volumeBootstrapFilesystems, ", "))
+	}
+
+	if opts.mountPoint != "" && (!volumeMountPointPattern.MatchString(opts.mountPoint) || strings.Contains(opts.mountPoint, "/..")) {
+		return opts, "mount_point must be an absolute path such as /mnt/data"
+	}
+
+	return opts, ""
+}
+
+// volumeBootstrapResponse adds the bootstrap script to a create or attach
+// response. It runs whether or not the call waited: the script itself waits
+// for the device to appear.
+func volumeBootstrapResponse(response *linodev1.VolumeWriteResponse, opts volumeBootstrapOptions, linodeID int) {
+	if !opts.enabled {
+		return
+	}
+
+	volume := response.GetVolume()
+	device := volumeDevicePath(volume)
+
+	mountPoint := opts.mountPoint
+	if mountPoint == "" {
+		mountPoint = "/mnt/" + volume.GetLabel()
+	}
+
+	bootstrap := &linodev1.VolumeBootstrap{
+		DevicePath:    device,
+		PartitionPath: device + "-part1",
+		Filesystem:    opts.filesystem,
+		MountPoint:    mountPoint,
+	}
+	bootstrap.Script = fmt.Sprintf(volumeBootstrapScript,
+		volume.GetLabel(), bootstrap.GetDevicePath(), bootstrap.GetPartitionPath(), mountPoint, opts.filesystem)
+
+	response.Bootstrap = bootstrap
+	response.Message += fmt.Sprintf("; run bootstrap.script as root on Linode %d to mount it at %s", linodeID, mountPoint)
+}
@@ -0,0 +1,134 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestLinodeVolumeAttachBootstrapReturnsScript(t *testing.T) {
+	t.Parallel()
+
+	const volumePath = "/dev/disk/by-id/scsi-0Linode_Volume_data"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/volumes/7/attach" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		body := `{"id":7,"label":"data","status":"active","linode_id":42,"filesystem_path":"` + volumePath + `"}`
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	_, _, handler := tools.NewLinodeVolumeAttachTool(newTestConfig(srv.URL))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"volume_id": float64(7), "linode_id": float64(42), "confirm": true,
+		"bootstrap": true, "filesystem": "xfs", "mount_point": "/srv/data",
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true: %+v", result.Content)
+	}
+
+	var got struct {
+		Message   string `json:"message"`
+		Bootstrap *struct {
+			DevicePath    string `json:"device_path"`
+			PartitionPath string `json:"partition_path"`
+			Filesystem    string `json:"filesystem"`
+			MountPoint    string `json:"mount_point"`
+			Script        string `json:"script"`
+		} `json:"bootstrap"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Bootstrap == nil || got.Bootstrap.DevicePath != volumePath || got.Bootstrap.PartitionPath != volumePath+"-part1" ||
+		got.Bootstrap.Filesystem != "xfs" || got.Bootstrap.MountPoint != "/srv/data" {
+		t.Fatalf("bootstrap = %+v, want %s-part1 as xfs at /srv/data", got.Bootstrap, volumePath)
+	}
+
+	for _, want := range []string{`DEVICE="` + volumePath + `"`, `MOUNT_POINT="/srv/data"`, `FILESYSTEM="xfs"`, "0% 100%"} {
+		if !strings.Contains(got.Bootstrap.Script, want) {
+			t.Errorf("script missing %q:\n%s", want, got.Bootstrap.Script)
+		}
+	}
+
+	if !strings.HasSuffix(got.Message, "; run bootstrap.script as root on Linode 42 to mount it at /srv/data") {
+		t.Errorf("message = %q", got.Message)
+	}
+}
+
+func TestLinodeVolumeBootstrapValidatesBeforeClientCall(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		create  bool
+		args    map[string]any
+		wantErr string
+	}{
+		{
+			name:    "create without linode_id",
+			create:  true,
+			args:    map[string]any{"label": "data", "region": "us-east", "confirm": true, "bootstrap": true},
+			wantErr: "bootstrap requires linode_id: the volume must be attached to an instance to be mounted",
+		},
+		{
+			name:    "filesystem without bootstrap",
+			args:    map[string]any{"volume_id": float64(7), "linode_id": float64(42), "confirm": true, "filesystem": "xfs"},
+			wantErr: "filesystem and mount_point require bootstrap=true",
+		},
+		{
+			name:    "unsupported filesystem",
+			args:    map[string]any{"volume_id": float64(7), "linode_id": float64(42), "confirm": true, "bootstrap": true, "filesystem": "btrfs"},
+			wantErr: "filesystem must be one of ext4, xfs",
+		},
+		{
+			name:    "relative mount point",
+			args:    map[string]any{"volume_id": float64(7), "linode_id": float64(42), "confirm": true, "bootstrap": true, "mount_point": "mnt/data"},
+			wantErr: "mount_point must be an absolute path such as /mnt/data",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}))
+			t.Cleanup(srv.Close)
+
+			_, _, handler := tools.NewLinodeVolumeAttachTool(newTestConfig(srv.URL))
+			if tt.create {
+				_, _, handler = tools.NewLinodeVolumeCreateTool(newTestConfig(srv.URL))
+			}
+
+			result, err := handler(t.Context(), createRequestWithArgs(t, tt.args))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !result.IsError || result.Content[0].(mcp.TextContent).Text != tt.wantErr {
+				t.Errorf("result = %+v, want error %q", result.Content, tt.wantErr)
+			}
+		})
+	}
+}
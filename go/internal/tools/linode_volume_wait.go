@@ -0,0 +1,132 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// paramVolumeWait is the linode_volume_create and linode_volume_attach
+// argument that makes the call wait until the volume is usable.
+const paramVolumeWait = "wait"
+
+// Pacing for wait=true. Linode provisions and attaches a volume within
+// seconds to a couple of minutes; past volumeWaitTimeout the call returns
+// what it last saw with ready=false.
+const (
+	volumeWaitInterval = 3 * time.Second
+	volumeWaitTimeout  = 5 * time.Minute
+	volumeStatusActive = "active"
+)
+
+// volumeReady reports whether a volume is active and, when linodeID is set,
+// attached to that Linode.
+func volumeReady(volume *linodev1.Volume, linodeID int) bool {
+	if volume.GetStatus() != volumeStatusActive {
+		return false
+	}
+
+	return linodeID == 0 || int(volume.GetLinodeId()) == linodeID
+}
+
+// awaitVolumeReady re-reads a volume the call just wrote until volumeReady
+// holds or volumeWaitTimeout passes. The first re-read is immediate and later
+// ones volumeWaitInterval apart. It returns the last state read and whether
+// the volume became ready; a failed re-read ends the wait with its error.
+func awaitVolumeReady(
+	ctx context.Context, client *linode.Client, volume *linodev1.Volume, linodeID int,
+) (*linodev1.Volume, bool, error) {
+	if volumeReady(volume, linodeID) {
+		return volume, true, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, volumeWaitTimeout)
+	defer cancel()
+
+	for first := true; ; first = false {
+		if !first {
+			select {
+			case <-ctx.Done():
+				return volume, false, nil
+			case <-time.After(volumeWaitInterval):
+			}
+		}
+
+		latest, err := client.GetVolumeProto(ctx, int(volume.GetId()))
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return volume, false, nil
+			}
+
+			return volume, false, fmt.Errorf("failed to re-read volume %d: %w", volume.GetId(), err)
+		}
+
+		volume = latest
+
+		if volumeReady(volume, linodeID) {
+			return volume, true, nil
+		}
+	}
+}
+
+// volumeWaitResponse finishes a create or attach made with wait=true: it
+// waits for the volume, then records readiness and, for an attached volume,
+// how to mount it. A wait that fails or times out leaves the write standing
+// and is reported as a warning.
+func volumeWaitResponse(
+	ctx context.Context, request *mcp.CallToolRequest, client *linode.Client, response *linodev1.VolumeWriteResponse, linodeID int,
+) {
+	if !request.GetBool(paramVolumeWait, false) {
+		return
+	}
+
+	volume, ready, err := awaitVolumeReady(ctx, client, response.GetVolume(), linodeID)
+	if err != nil {
+		AddWarning(ctx, "wait: %v", err)
+	} else if !ready {
+		AddWarning(ctx, "wait: volume %d was not ready after %s", volume.GetId(), volumeWaitTimeout)
+	}
+
+	response.Volume = volume
+	response.Ready = &ready
+
+	if ready && volume.LinodeId != nil {
+		response.Mount = volumeMount(volume)
+		response.Message += fmt.Sprintf("; it is active on Linode %d at %s", volume.GetLinodeId(), response.Mount.GetFilesystemPath())
+	}
+}
+
+// volumeMount returns the commands that put an attached volume to use,
+// following the layout Cloud Manager suggests: the volume's by-id device
+// path, formatted as ext4 and mounted under /mnt/<label>.
+func volumeMount(volume *linodev1.Volume) *linodev1.VolumeMount {
+	path := volumeDevicePath(volume)
+	mountPoint := "/mnt/" + volume.GetLabel()
+
+	return &linodev1.VolumeMount{
+		FilesystemPath: path,
+		MountPoint:     mountPoint,
+		FormatCommand:  fmt.Sprintf(`mkfs.ext4 "%s"`, path),
+		MountCommands: []string{
+			fmt.Sprintf(`mkdir -p "%s"`, mountPoint),
+			fmt.Sprintf(`mount "%s" "%s"`, path, mountPoint),
+			fmt.Sprintf(`echo "%s %s ext4 defaults,noatime,nofail 0 2" >> /etc/fstab`, path, mountPoint),
+		},
+	}
+}
+
+// volumeDevicePath is the stable by-id device path of an attached volume,
+// derived from its label when the API response leaves filesystem_path out.
+func volumeDevicePath(volume *linodev1.Volume) string {
+	if path := volume.GetFilesystemPath(); path != "" {
+		return path
+	}
+
+	return "/dev/disk/by-id/scsi-0Linode_Volume_" + volume.GetLabel()
+}
@@ -0,0 +1,130 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+type volumeWaitResult struct {
+	Message string `json:"message"`
+	Volume  struct {
+		Status string `json:"status"`
+	} `json:"volume"`
+	Ready *bool `json:"ready"`
+	Mount *struct {
+		FilesystemPath string   `json:"filesystem_path"`
+		MountPoint     string   `json:"mount_point"`
+		FormatCommand  string   `json:"format_command"`
+		MountCommands  []string `json:"mount_commands"`
+	} `json:"mount"`
+}
+
+func TestLinodeVolumeCreateWaitsUntilActive(t *testing.T) {
+	t.Parallel()
+
+	const volumePath = "/dev/disk/by-id/scsi-0Linode_Volume_data"
+
+	var reads atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		status := "creating"
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/volumes":
+		case r.Method == http.MethodGet && r.URL.Path == "/volumes/7":
+			reads.Add(1)
+
+			status = "active"
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body := `{"id":7,"label":"data","status":"` + status + `","size":20,"region":"us-east","linode_id":42,"filesystem_path":"` + volumePath + `"}`
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	_, _, handler := tools.NewLinodeVolumeCreateTool(newTestConfig(srv.URL))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"label": "data", "linode_id": float64(42), "confirm": true, "wait": true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true: %+v", result.Content)
+	}
+
+	var got volumeWaitResult
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if reads.Load() != 1 {
+		t.Errorf("volume reads = %d, want 1", reads.Load())
+	}
+
+	if got.Ready == nil || !*got.Ready || got.Volume.Status != "active" {
+		t.Fatalf("ready = %v, status = %q, want an active volume", got.Ready, got.Volume.Status)
+	}
+
+	if got.Mount == nil || got.Mount.FilesystemPath != volumePath || got.Mount.MountPoint != "/mnt/data" {
+		t.Fatalf("mount = %+v, want %s mounted at /mnt/data", got.Mount, volumePath)
+	}
+
+	if got.Mount.FormatCommand != `mkfs.ext4 "`+volumePath+`"` {
+		t.Errorf("format_command = %q", got.Mount.FormatCommand)
+	}
+
+	if len(got.Mount.MountCommands) != 3 || got.Mount.MountCommands[1] != `mount "`+volumePath+`" "/mnt/data"` {
+		t.Errorf("mount_commands = %q", got.Mount.MountCommands)
+	}
+}
+
+func TestLinodeVolumeAttachWithoutWaitSkipsReadiness(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/volumes/7/attach" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if _, err := w.Write([]byte(`{"id":7,"label":"data","status":"active","linode_id":42}`)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	_, _, handler := tools.NewLinodeVolumeAttachTool(newTestConfig(srv.URL))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"volume_id": float64(7), "linode_id": float64(42), "confirm": true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got volumeWaitResult
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Ready != nil || got.Mount != nil {
+		t.Errorf("ready = %v, mount = %+v, want both unset without wait", got.Ready, got.Mount)
+	}
+}
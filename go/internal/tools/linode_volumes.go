@@ -34,7 +34,7 @@ func handleLinodeVolumeGetRequest(ctx context.Context, request *mcp.CallToolRequ
 		return mcp.NewToolResultError("volume_id is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
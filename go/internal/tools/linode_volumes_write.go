@@ -19,7 +19,10 @@ import (
 func NewLinodeVolumeCreateTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
 	tool := mcp.NewToolWithRawSchema(
 		"linode_volume_create",
-		"Creates a new block storage volume. WARNING: Billing starts immediately. Use linode_region_list to find valid regions.",
+		"Creates a new block storage volume. WARNING: Billing starts immediately. Use linode_region_list to find valid regions. "+
+			"Pass wait=true to return once the volume is active, with the commands that format and mount it. "+
+			"With linode_id, pass bootstrap=true for a re-runnable script that partitions, formats, and mounts it "+
+			"(filesystem, mount_point); it never reformats a volume that holds a filesystem.",
 		toolschemas.Schema("linode.mcp.v1.VolumeCreateInput"),
 	)
 
@@ -68,11 +71,17 @@ func handleLinodeVolumeCreateRequest(ctx context.Context, request *mcp.CallToolR
 	size := request.GetInt("size", 0)
 	linodeID := request.GetInt("linode_id", 0)
 
+	bootstrap, bootstrapMsg := volumeBootstrapFromTool(request, linodeID)
+
 	if IsDryRun(request) {
 		if msg := validateVolumeCreateArgs(label, region, size, linodeID); msg != "" {
 			return mcp.NewToolResultError(msg), nil
 		}
 
+		if bootstrapMsg != "" {
+			return mcp.NewToolResultError(bootstrapMsg), nil
+		}
+
 		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_volume_create", httpMethodPost, "/volumes", nil,
 			func(ctx context.Context, _ *linode.Client, _ any) (DryRunDetails, error) {
 				return volumeCreateSideEffects(ctx, label, region, size, linodeID)
@@ -87,7 +96,11 @@ func handleLinodeVolumeCreateRequest(ctx context.Context, request *mcp.CallToolR
 		return mcp.NewToolResultError(msg), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	if bootstrapMsg != "" {
+		return mcp.NewToolResultError(bootstrapMsg), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -112,6 +125,9 @@ func handleLinodeVolumeCreateRequest(ctx context.Context, request *mcp.CallToolR
 		Volume:  volume,
 	}
 
+	volumeWaitResponse(ctx, request, client, response, linodeID)
+	volumeBootstrapResponse(response, bootstrap, linodeID)
+
 	return MarshalProtoToolResponse(response)
 }
 
@@ -154,7 +170,7 @@ func handleLinodeVolumeCloneRequest(ctx context.Context, request *mcp.CallToolRe
 		return result, nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -176,7 +192,10 @@ func handleLinodeVolumeCloneRequest(ctx context.Context, request *mcp.CallToolRe
 func NewLinodeVolumeAttachTool(cfg *config.Config) (mcp.Tool, profiles.Capability, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)) {
 	tool := mcp.NewToolWithRawSchema(
 		"linode_volume_attach",
-		"Attaches a block storage volume to a Linode instance. The volume and instance must be in the same region.",
+		"Attaches a block storage volume to a Linode instance. The volume and instance must be in the same region. "+
+			"Pass wait=true to return once the volume is active on the instance, with the commands that mount it. "+
+			"Pass bootstrap=true for a re-runnable script that partitions, formats, and mounts it (filesystem, "+
+			"mount_point); it never reformats a volume that holds a filesystem.",
 		toolschemas.Schema("linode.mcp.v1.VolumeAttachInput"),
 	)
 
@@ -191,6 +210,7 @@ func handleLinodeVolumeAttachRequest(ctx context.Context, request *mcp.CallToolR
 	volumeID := request.GetInt("volume_id", 0)
 	linodeID := request.GetInt("linode_id", 0)
 	configID := request.GetInt("config_id", 0)
+	bootstrap, bootstrapMsg := volumeBootstrapFromTool(request, linodeID)
 
 	if IsDryRun(request) {
 		if volumeID == 0 {
@@ -201,6 +221,10 @@ func handleLinodeVolumeAttachRequest(ctx context.Context, request *mcp.CallToolR
 			return mcp.NewToolResultError("linode_id is required"), nil
 		}
 
+		if bootstrapMsg != "" {
+			return mcp.NewToolResultError(bootstrapMsg), nil
+		}
+
 		return RunDryRunPreviewDetailed(ctx, request, cfg, "linode_volume_attach", httpMethodPost,
 			fmt.Sprintf("/volumes/%d/attach", volumeID),
 			func(ctx context.Context, c *linode.Client) (any, error) { return c.GetVolume(ctx, volumeID) },
@@ -221,7 +245,11 @@ func handleLinodeVolumeAttachRequest(ctx context.Context, request *mcp.CallToolR
 		return mcp.NewToolResultError("linode_id is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	if bootstrapMsg != "" {
+		return mcp.NewToolResultError(bootstrapMsg), nil
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -245,6 +273,9 @@ func handleLinodeVolumeAttachRequest(ctx context.Context, request *mcp.CallToolR
 		Volume:  volume,
 	}
 
+	volumeWaitResponse(ctx, request, client, response, linodeID)
+	volumeBootstrapResponse(response, bootstrap, linodeID)
+
 	return MarshalProtoToolResponse(response)
 }
 
@@ -287,7 +318,7 @@ func handleLinodeVolumeDetachRequest(ctx context.Context, request *mcp.CallToolR
 		return mcp.NewToolResultError("volume_id is required"), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -350,7 +381,7 @@ func handleLinodeVolumeResizeRequest(ctx context.Context, request *mcp.CallToolR
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -431,7 +462,7 @@ func handleLinodeVolumeUpdateRequest(ctx context.Context, request *mcp.CallToolR
 		req.Tags = tags
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -61,7 +61,7 @@ func handleVPCGetRequest(ctx context.Context, request *mcp.CallToolRequest, cfg
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -194,7 +194,7 @@ func handleVPCSubnetGetRequest(ctx context.Context, request *mcp.CallToolRequest
 		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
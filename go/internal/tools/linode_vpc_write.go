@@ -86,7 +86,7 @@ func handleVPCCreateRequest(ctx context.Context, request *mcp.CallToolRequest, c
 		req.Subnets = subnets
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -154,7 +154,7 @@ func handleVPCUpdateRequest(ctx context.Context, request *mcp.CallToolRequest, c
 		req.Description = description
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -280,7 +280,7 @@ func handleVPCSubnetCreateRequest(ctx context.Context, request *mcp.CallToolRequ
 		IPv4:  ipv4,
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -358,7 +358,7 @@ func handleVPCSubnetUpdateRequest(ctx context.Context, request *mcp.CallToolRequ
 		Label: label,
 	}
 
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
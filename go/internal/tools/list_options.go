@@ -0,0 +1,160 @@
+package tools
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// Per-call list arguments every list tool accepts. The dispatch middleware
+// sends them to the API as the X-Filter header of each paginated list the
+// call reads, so filtering and sorting happen server-side instead of after
+// every page is fetched.
+const (
+	ParamFilter  = "filter"
+	ParamOrderBy = "order_by"
+	ParamOrder   = "order"
+)
+
+// Sentinel errors for malformed list arguments.
+var (
+	ErrListFilterNotObject = errors.New("filter must be a JSON object of Linode filter expressions")
+	ErrListOrderByNotText  = errors.New("order_by must be a field name")
+	ErrListOrderInvalid    = errors.New(`order must be "asc" or "desc"`)
+	ErrListOrderNoOrderBy  = errors.New("order needs order_by")
+)
+
+// listOptionsProperties are the schema entries AddListOptionsSchema adds.
+var listOptionsProperties = map[string]any{
+	ParamFilter: map[string]any{
+		"type":        "object",
+		"description": `Server-side Linode filter (sent as X-Filter), e.g. {"region": "us-east"} or {"+or": [{"label": "a"}, {"label": "b"}]}.`,
+	},
+	ParamOrderBy: map[string]any{
+		"type":        "string",
+		"description": "Field to sort by on the server, e.g. label or created.",
+	},
+	ParamOrder: map[string]any{
+		"type":        "string",
+		"description": `Sort direction for order_by: "asc" (default) or "desc".`,
+	},
+}
+
+// IsListTool reports whether toolName is a list tool, which accepts the
+// filter, order_by, and order arguments.
+func IsListTool(toolName string) bool {
+	return strings.HasSuffix(toolName, "_list")
+}
+
+// AddListOptionsSchema adds the filter, order_by, and order arguments to a
+// list tool's input schema. Other tools, and a schema that cannot be read,
+// are left unchanged.
+func AddListOptionsSchema(tool *mcp.Tool) {
+	if !IsListTool(tool.Name) {
+		return
+	}
+
+	addSchemaProperties(tool, listOptionsProperties)
+}
+
+// addSchemaProperties adds properties to tool's input schema, leaving a
+// schema that cannot be read unchanged.
+func addSchemaProperties(tool *mcp.Tool, added map[string]any) {
+	if len(tool.RawInputSchema) == 0 {
+		if tool.InputSchema.Properties == nil {
+			tool.InputSchema.Properties = map[string]any{}
+		}
+
+		for name, property := range added {
+			tool.InputSchema.Properties[name] = property
+		}
+
+		return
+	}
+
+	var schema map[string]any
+	if err := json.Unmarshal(tool.RawInputSchema, &schema); err != nil {
+		return
+	}
+
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		properties = map[string]any{}
+		schema["properties"] = properties
+	}
+
+	for name, property := range added {
+		properties[name] = property
+	}
+
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		return
+	}
+
+	tool.RawInputSchema = raw
+}
+
+// ResolveListOptions returns the list options a call to toolName asked for.
+// Only list tools read them. A malformed value is an error rather than a
+// warning: dropping a filter would return the unfiltered collection as if it
+// were the filtered one.
+func ResolveListOptions(toolName string, request *mcp.CallToolRequest) (linode.ListOptions, error) {
+	if !IsListTool(toolName) {
+		return linode.ListOptions{}, nil
+	}
+
+	args := request.GetArguments()
+
+	var opts linode.ListOptions
+
+	switch filter := args[ParamFilter].(type) {
+	case nil:
+	case map[string]any:
+		opts.Filter = filter
+	case string:
+		// Some clients send an object argument as its JSON text.
+		if strings.TrimSpace(filter) != "" {
+			if err := json.Unmarshal([]byte(filter), &opts.Filter); err != nil || opts.Filter == nil {
+				return linode.ListOptions{}, ErrListFilterNotObject
+			}
+		}
+	default:
+		return linode.ListOptions{}, ErrListFilterNotObject
+	}
+
+	if raw, ok := args[ParamOrderBy]; ok && raw != nil {
+		orderBy, isText := raw.(string)
+		if !isText {
+			return linode.ListOptions{}, ErrListOrderByNotText
+		}
+
+		opts.OrderBy = strings.TrimSpace(orderBy)
+	}
+
+	if raw, ok := args[ParamOrder]; ok && raw != nil {
+		order, isText := raw.(string)
+		order = strings.ToLower(strings.TrimSpace(order))
+
+		if !isText || (order != "asc" && order != "desc") {
+			return linode.ListOptions{}, ErrListOrderInvalid
+		}
+
+		if opts.OrderBy == "" {
+			return linode.ListOptions{}, ErrListOrderNoOrderBy
+		}
+
+		opts.Order = order
+	}
+
+	if _, err := opts.XFilter(); err != nil {
+		return linode.ListOptions{}, fmt.Errorf("%w: %w", ErrListFilterNotObject, err)
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,121 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestResolveListOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		tool    string
+		args    map[string]any
+		want    linode.ListOptions
+		wantErr error
+	}{
+		{name: "absent", tool: "linode_volume_list", args: map[string]any{}},
+		{
+			name: "filter object",
+			tool: "linode_volume_list",
+			args: map[string]any{tools.ParamFilter: map[string]any{"region": "us-east"}},
+			want: linode.ListOptions{Filter: map[string]any{"region": "us-east"}},
+		},
+		{
+			name: "filter as JSON text",
+			tool: "linode_volume_list",
+			args: map[string]any{tools.ParamFilter: `{"region":"us-east"}`},
+			want: linode.ListOptions{Filter: map[string]any{"region": "us-east"}},
+		},
+		{
+			name: "order normalized",
+			tool: "linode_volume_list",
+			args: map[string]any{tools.ParamOrderBy: "label", tools.ParamOrder: "DESC"},
+			want: linode.ListOptions{OrderBy: "label", Order: "desc"},
+		},
+		{
+			name: "non-list tool ignores them",
+			tool: "linode_volume_get",
+			args: map[string]any{tools.ParamFilter: "not json"},
+		},
+		{
+			name:    "filter not an object",
+			tool:    "linode_volume_list",
+			args:    map[string]any{tools.ParamFilter: `["region"]`},
+			wantErr: tools.ErrListFilterNotObject,
+		},
+		{
+			name:    "order_by not text",
+			tool:    "linode_volume_list",
+			args:    map[string]any{tools.ParamOrderBy: float64(1)},
+			wantErr: tools.ErrListOrderByNotText,
+		},
+		{
+			name:    "unknown order",
+			tool:    "linode_volume_list",
+			args:    map[string]any{tools.ParamOrderBy: "label", tools.ParamOrder: "up"},
+			wantErr: tools.ErrListOrderInvalid,
+		},
+		{
+			name:    "order without order_by",
+			tool:    "linode_volume_list",
+			args:    map[string]any{tools.ParamOrder: "asc"},
+			wantErr: tools.ErrListOrderNoOrderBy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := createRequestWithArgs(t, tt.args)
+
+			got, err := tools.ResolveListOptions(tt.tool, &req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ResolveListOptions() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ResolveListOptions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddListOptionsSchema(t *testing.T) {
+	t.Parallel()
+
+	cfg := newTestConfig("https://api.linode.com/v4")
+
+	listTool, _, _ := tools.NewLinodeVolumeListTool(cfg)
+	tools.AddListOptionsSchema(&listTool)
+
+	var schema struct {
+		Properties map[string]any `json:"properties"`
+	}
+
+	if err := json.Unmarshal(listTool.RawInputSchema, &schema); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, name := range []string{tools.ParamFilter, tools.ParamOrderBy, tools.ParamOrder, "region"} {
+		if _, ok := schema.Properties[name]; !ok {
+			t.Errorf("list schema is missing %q", name)
+		}
+	}
+
+	getTool, _, _ := tools.NewLinodeVolumeGetTool(cfg)
+	before := string(getTool.RawInputSchema)
+
+	tools.AddListOptionsSchema(&getTool)
+
+	if string(getTool.RawInputSchema) != before {
+		t.Error("AddListOptionsSchema changed a non-list tool's schema")
+	}
+}
@@ -0,0 +1,63 @@
+package tools
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// ParamMaxResults is the per-call argument that caps how many items each list
+// the call reads returns. List reads otherwise follow every page the API
+// reports. The dispatch middleware reads it for every tool, so it never
+// appears in a tool's own input schema.
+const ParamMaxResults = "max_results"
+
+// ResolveMaxResults returns the call's max_results cap, or 0 when it has none.
+// Only list tools take the cap: a composite that lists resources to act on
+// them must see every one, so max_results on any other tool is ignored with a
+// warning, as is one that is not a positive whole number.
+func ResolveMaxResults(ctx context.Context, toolName string, request *mcp.CallToolRequest) int {
+	raw, ok := request.GetArguments()[ParamMaxResults]
+	if !ok {
+		return 0
+	}
+
+	if !strings.HasSuffix(toolName, "_list") {
+		AddWarning(ctx, "%s only applies to list tools and was ignored", ParamMaxResults)
+
+		return 0
+	}
+
+	requested, isNumber := raw.(float64)
+	if !isNumber || requested < 1 || requested != math.Trunc(requested) || requested > math.MaxInt32 {
+		AddWarning(ctx, "%s must be a positive whole number and was ignored", ParamMaxResults)
+
+		return 0
+	}
+
+	return int(requested)
+}
+
+// WarnListTruncated adds a warning when a list the call read stopped at its
+// max_results cap with more results left, so a capped result is never taken
+// for the whole collection. ctx is the one linode.WithMaxResults returned.
+func WarnListTruncated(ctx context.Context, limit int) {
+	total, truncated := linode.ListTruncated(ctx)
+	if !truncated {
+		return
+	}
+
+	if total > limit {
+		AddWarning(ctx, "Results stop at %s=%d of the %d the API reports; raise or drop %s for the rest",
+			ParamMaxResults, limit, total, ParamMaxResults)
+
+		return
+	}
+
+	AddWarning(ctx, "Results stop at %s=%d and the API has more; raise or drop %s for the rest",
+		ParamMaxResults, limit, ParamMaxResults)
+}
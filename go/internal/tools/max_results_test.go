@@ -0,0 +1,42 @@
+package tools_test
+
+import (
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestResolveMaxResults(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		tool string
+		args map[string]any
+		want int
+	}{
+		{name: "absent", args: map[string]any{}},
+		{name: "whole number", args: map[string]any{tools.ParamMaxResults: float64(50)}, want: 50},
+		{name: "zero ignored", args: map[string]any{tools.ParamMaxResults: float64(0)}},
+		{name: "fractional ignored", args: map[string]any{tools.ParamMaxResults: 2.5}},
+		{name: "non-number ignored", args: map[string]any{tools.ParamMaxResults: "50"}},
+		{name: "non-list tool ignored", tool: "linode_tags_cleanup", args: map[string]any{tools.ParamMaxResults: float64(50)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tool := tt.tool
+			if tool == "" {
+				tool = "linode_volumes_list"
+			}
+
+			req := createRequestWithArgs(t, tt.args)
+
+			if got := tools.ResolveMaxResults(t.Context(), tool, &req); got != tt.want {
+				t.Errorf("ResolveMaxResults() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
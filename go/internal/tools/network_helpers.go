@@ -0,0 +1,264 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// The convenience flags the instance create and config create/update tools
+// accept in place of a hand-written interfaces array.
+const (
+	argPublicInternet = "public_internet"
+	argVPCSubnet      = "vpc_subnet"
+)
+
+const (
+	errPublicInternetNeedsVPC = "public_internet=false requires vpc_subnet: without a public interface or a VPC the instance has no network"
+	errVPCSubnetFormat        = `vpc_subnet must be a subnet ID or "<vpc label>/<subnet label>"`
+	errNetworkHelpersAndList  = "pass either interfaces or public_internet/vpc_subnet, not both"
+)
+
+// maxConfigInterfaces is how many interfaces a configuration profile holds
+// (eth0 through eth2).
+const maxConfigInterfaces = 3
+
+// natAny is the ipv4.nat_1_1 value that maps the instance's public IPv4
+// address onto its VPC address.
+const natAny = "any"
+
+// networkHelpers is the parsed public_internet / vpc_subnet pair. Set reports
+// whether the caller passed either flag; when it did not, the tool's own
+// interface defaults apply.
+type networkHelpers struct {
+	Set            bool
+	PublicInternet bool
+	SubnetRef      string
+}
+
+// networkHelpersFromTool reads and validates the convenience flags without
+// touching the API; the vpc_subnet label is resolved later by
+// resolveVPCSubnet. public_internet defaults to true.
+func networkHelpersFromTool(request *mcp.CallToolRequest) (networkHelpers, string) {
+	args := request.GetArguments()
+	helpers := networkHelpers{PublicInternet: true}
+
+	if raw, exists := args[argPublicInternet]; exists {
+		publicInternet, validationMessage := boolToolArg(raw, argPublicInternet)
+		if validationMessage != "" {
+			return networkHelpers{}, validationMessage
+		}
+
+		helpers.Set = true
+		helpers.PublicInternet = publicInternet
+	}
+
+	if _, exists := args[argVPCSubnet]; exists {
+		subnetRef, validationMessage := stringArgument(request, argVPCSubnet, false)
+		if validationMessage != "" {
+			return networkHelpers{}, validationMessage
+		}
+
+		if !validSubnetRef(subnetRef) {
+			return networkHelpers{}, errVPCSubnetFormat
+		}
+
+		helpers.Set = true
+		helpers.SubnetRef = strings.TrimSpace(subnetRef)
+	}
+
+	if helpers.Set && helpers.SubnetRef == "" && !helpers.PublicInternet {
+		return networkHelpers{}, errPublicInternetNeedsVPC
+	}
+
+	return helpers, ""
+}
+
+// validSubnetRef accepts a positive subnet ID or a "<vpc>/<subnet>" label
+// pair with both halves non-empty.
+func validSubnetRef(ref string) bool {
+	ref = strings.TrimSpace(ref)
+	if id, err := strconv.Atoi(ref); err == nil {
+		return id > 0
+	}
+
+	vpcLabel, subnetLabel, ok := strings.Cut(ref, "/")
+
+	return ok && strings.TrimSpace(vpcLabel) != "" && strings.TrimSpace(subnetLabel) != ""
+}
+
+// resolveVPCSubnet turns a vpc_subnet reference into a subnet ID. A numeric
+// reference is used as-is; a label pair is looked up in the account's VPCs,
+// matching labels case-insensitively as Linode does.
+func resolveVPCSubnet(ctx context.Context, client *linode.Client, ref string) (int, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		return id, nil
+	}
+
+	vpcLabel, subnetLabel, _ := strings.Cut(ref, "/")
+	vpcLabel, subnetLabel = strings.TrimSpace(vpcLabel), strings.TrimSpace(subnetLabel)
+
+	vpcs, err := client.ListVPCsProto(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("resolve vpc_subnet %q: %w", ref, err)
+	}
+
+	for _, vpc := range vpcs {
+		if !strings.EqualFold(vpc.GetLabel(), vpcLabel) {
+			continue
+		}
+
+		for _, subnet := range vpc.GetSubnets() {
+			if strings.EqualFold(subnet.GetLabel(), subnetLabel) {
+				return int(subnet.GetId()), nil
+			}
+		}
+
+		return 0, fmt.Errorf("%w: %s/%s", ErrVPCSubnetNotFound, vpcLabel, subnetLabel)
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrVPCNotFound, vpcLabel)
+}
+
+// configInterfaces renders the helpers as a legacy configuration profile
+// interface list. A VPC interface is primary so it owns the default route;
+// public_internet adds 1:1 NAT on it rather than a second, public interface,
+// which would otherwise have to come first and take the default route.
+func (h networkHelpers) configInterfaces(subnetID int) []linode.ConfigInterface {
+	primary := true
+
+	if h.SubnetRef == "" {
+		return []linode.ConfigInterface{{Purpose: configInterfacePurposePublic, Primary: &primary}}
+	}
+
+	ipv4 := map[string]string{"vpc": "auto"}
+	if h.PublicInternet {
+		ipv4["nat_1_1"] = natAny
+	}
+
+	encoded, _ := json.Marshal(ipv4)
+
+	return []linode.ConfigInterface{{
+		Purpose:  configInterfacePurposeVPC,
+		Primary:  &primary,
+		SubnetID: &subnetID,
+		IPv4:     encoded,
+	}}
+}
+
+// instanceInterfaces renders the helpers as a current-generation interface
+// list for instance create. Without vpc_subnet it is the usual public
+// interface; with it, a VPC interface owning the IPv4 default route, with a
+// 1:1 NAT public address when public_internet is true.
+func (h networkHelpers) instanceInterfaces(subnetID, firewallID int, routeIPv4, routeIPv6 bool) []linode.InstanceInterface {
+	if h.SubnetRef == "" {
+		return []linode.InstanceInterface{{
+			Public:       &linode.InterfacePublicConfig{},
+			DefaultRoute: buildDefaultRoute(routeIPv4, routeIPv6),
+			FirewallID:   &firewallID,
+		}}
+	}
+
+	address := linode.InterfaceIPv4Address{Address: "auto", Primary: true}
+	if h.PublicInternet {
+		address.NAT11Address = "auto"
+	}
+
+	return []linode.InstanceInterface{{
+		VPC: &linode.InterfaceVPCConfig{
+			SubnetID: subnetID,
+			IPv4:     &linode.InterfaceVPCIPv4{Addresses: []linode.InterfaceIPv4Address{address}},
+		},
+		DefaultRoute: buildDefaultRoute(routeIPv4, false),
+		FirewallID:   &firewallID,
+	}}
+}
+
+// helperConfigInterfaces resolves the helpers into a configuration profile
+// interface list. ok is false when the caller passed neither flag.
+func helperConfigInterfaces(ctx context.Context, request *mcp.CallToolRequest, client *linode.Client) ([]linode.ConfigInterface, bool, string) {
+	helpers, validationMessage := networkHelpersFromTool(request)
+	if validationMessage != "" || !helpers.Set {
+		return nil, false, validationMessage
+	}
+
+	var subnetID int
+
+	if helpers.SubnetRef != "" {
+		id, err := resolveVPCSubnet(ctx, client, helpers.SubnetRef)
+		if err != nil {
+			return nil, false, err.Error()
+		}
+
+		subnetID = id
+	}
+
+	return helpers.configInterfaces(subnetID), true, ""
+}
+
+// validateConfigInterfaceLayout checks the rules the API otherwise rejects
+// with a bare 400: at most three interfaces, one public and one VPC at most,
+// one primary at most, a public interface first unless another interface is
+// primary, and ipv4.nat_1_1 only on a VPC interface.
+func validateConfigInterfaceLayout(interfaces []linode.ConfigInterface) string {
+	if len(interfaces) > maxConfigInterfaces {
+		return fmt.Sprintf("a configuration profile holds at most %d interfaces", maxConfigInterfaces)
+	}
+
+	counts := map[string]int{}
+	publicIndex, primaries := -1, 0
+
+	for index, iface := range interfaces {
+		counts[iface.Purpose]++
+
+		if iface.Purpose == configInterfacePurposePublic {
+			publicIndex = index
+		}
+
+		if iface.Primary != nil && *iface.Primary {
+			primaries++
+		}
+
+		if iface.Purpose != configInterfacePurposeVPC && hasNAT11(iface.IPv4) {
+			return fmt.Sprintf("interfaces[%d]: ipv4.nat_1_1 applies only to vpc interfaces", index)
+		}
+	}
+
+	for _, purpose := range []string{configInterfacePurposePublic, configInterfacePurposeVPC} {
+		if counts[purpose] > 1 {
+			return fmt.Sprintf("a configuration profile holds at most one %s interface", purpose)
+		}
+	}
+
+	if primaries > 1 {
+		return "at most one interface can set primary=true"
+	}
+
+	if publicIndex > 0 && primaries == 0 {
+		return fmt.Sprintf("interfaces[%d]: the public interface must be first (eth0) unless another interface sets primary=true", publicIndex)
+	}
+
+	return ""
+}
+
+// hasNAT11 reports whether an interface's ipv4 object sets nat_1_1.
+func hasNAT11(ipv4 json.RawMessage) bool {
+	if len(ipv4) == 0 {
+		return false
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(ipv4, &fields); err != nil {
+		return false
+	}
+
+	value, ok := fields["nat_1_1"]
+
+	return ok && value != nil && value != ""
+}
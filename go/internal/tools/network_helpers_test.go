@@ -0,0 +1,194 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// networkHelperServer serves one VPC ("prod" with subnet "app" = 42) and
+// captures the body of every POST or PUT.
+func networkHelperServer(t *testing.T, captured *map[string]any) *config.Config {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodGet && r.URL.Path == "/vpcs" {
+			_, _ = w.Write([]byte(`{"data":[{"id":7,"label":"prod","region":"us-east",` +
+				`"subnets":[{"id":42,"label":"app","ipv4":"10.0.0.0/24"}]}],"page":1,"pages":1,"results":1}`))
+
+			return
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(captured); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		_, _ = w.Write([]byte(`{"id":789,"label":"boot-config","region":"us-east"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	return &config.Config{Environments: map[string]config.EnvironmentConfig{
+		envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+	}}
+}
+
+func firstInterface(t *testing.T, body map[string]any) map[string]any {
+	t.Helper()
+
+	interfaces, ok := body["interfaces"].([]any)
+	if !ok || len(interfaces) != 1 {
+		t.Fatalf("interfaces = %v, want one entry", body["interfaces"])
+	}
+
+	iface, ok := interfaces[0].(map[string]any)
+	if !ok {
+		t.Fatalf("interfaces[0] = %v, want an object", interfaces[0])
+	}
+
+	return iface
+}
+
+func TestLinodeInstanceCreateToolResolvesVPCSubnetLabel(t *testing.T) {
+	t.Parallel()
+
+	var captured map[string]any
+
+	_, _, handler := tools.NewLinodeInstanceCreateTool(networkHelperServer(t, &captured))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		keyRegion:     regionUSEast,
+		keyType:       typeG6Nanode1,
+		keyFirewallID: 12345,
+		"vpc_subnet":  "Prod/App",
+		keyConfirm:    true,
+	}))
+	if err != nil || result == nil || result.IsError {
+		t.Fatalf("result = %v, err = %v, want success", result, err)
+	}
+
+	iface := firstInterface(t, captured)
+	if _, hasPublic := iface["public"]; hasPublic {
+		t.Errorf("interface = %v, want no public block", iface)
+	}
+
+	vpc, _ := iface["vpc"].(map[string]any)
+	if vpc["subnet_id"] != float64(42) {
+		t.Errorf("vpc.subnet_id = %v, want 42", vpc["subnet_id"])
+	}
+
+	ipv4, _ := vpc["ipv4"].(map[string]any)
+	addresses, _ := ipv4["addresses"].([]any)
+
+	address, _ := addresses[0].(map[string]any)
+	if address["nat_1_1_address"] != "auto" {
+		t.Errorf("vpc address = %v, want nat_1_1_address=auto", address)
+	}
+}
+
+func TestLinodeInstanceConfigUpdateToolGeneratesPrivateVPCInterface(t *testing.T) {
+	t.Parallel()
+
+	var captured map[string]any
+
+	_, _, handler := tools.NewLinodeInstanceConfigUpdateTool(networkHelperServer(t, &captured))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		keyLinodeID:       float64(123),
+		"config_id":       float64(789),
+		"vpc_subnet":      "42",
+		"public_internet": false,
+		keyConfirm:        true,
+	}))
+	if err != nil || result == nil || result.IsError {
+		t.Fatalf("result = %v, err = %v, want success", result, err)
+	}
+
+	iface := firstInterface(t, captured)
+	if iface["purpose"] != "vpc" || iface["subnet_id"] != float64(42) || iface["primary"] != true {
+		t.Errorf("interface = %v, want primary vpc on subnet 42", iface)
+	}
+
+	if ipv4, _ := iface["ipv4"].(map[string]any); ipv4["nat_1_1"] != nil {
+		t.Errorf("ipv4 = %v, want no nat_1_1", ipv4)
+	}
+}
+
+func TestLinodeInstanceConfigCreateToolInterfaceLayoutValidation(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{
+		envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: apiURLLinodeV4, Token: tokenTest}},
+	}}
+	_, _, handler := tools.NewLinodeInstanceConfigCreateTool(cfg)
+
+	tests := []struct {
+		name         string
+		extra        map[string]any
+		wantContains string
+	}{
+		{
+			name:         "public not first",
+			extra:        map[string]any{keyInterfaces: `[{"purpose":"vlan","label":"v"},{"purpose":"public"}]`},
+			wantContains: "public interface must be first",
+		},
+		{
+			name:         "two primaries",
+			extra:        map[string]any{keyInterfaces: `[{"purpose":"public","primary":true},{"purpose":"vpc","subnet_id":1,"primary":true}]`},
+			wantContains: "at most one interface can set primary",
+		},
+		{
+			name:         "nat on public",
+			extra:        map[string]any{keyInterfaces: `[{"purpose":"public","ipv4":{"nat_1_1":"any"}}]`},
+			wantContains: "nat_1_1 applies only to vpc",
+		},
+		{
+			name:         "no network",
+			extra:        map[string]any{"public_internet": false},
+			wantContains: "requires vpc_subnet",
+		},
+		{
+			name:         "helpers and interfaces",
+			extra:        map[string]any{keyInterfaces: `[{"purpose":"public"}]`, "vpc_subnet": "prod/app"},
+			wantContains: "not both",
+		},
+		{
+			name:         "bad subnet reference",
+			extra:        map[string]any{"vpc_subnet": "prod"},
+			wantContains: "vpc_subnet must be",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := map[string]any{
+				keyLinodeID: float64(123),
+				keyLabel:    labelBootConfig,
+				keyDevices:  configDevicesSDAJSON,
+				keyConfirm:  true,
+			}
+			for key, value := range tt.extra {
+				args[key] = value
+			}
+
+			result, err := handler(t.Context(), createRequestWithArgs(t, args))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if text, ok := result.Content[0].(mcp.TextContent); !result.IsError || !ok || !strings.Contains(text.Text, tt.wantContains) {
+				t.Errorf("result = %v, want error containing %q", result.Content, tt.wantContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/audit"
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/offlinecache"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+)
+
+// OfflineCache is the offline cache resolved for one call. A nil store means
+// the call neither saves its result nor answers from saved ones.
+type OfflineCache struct {
+	store       *offlinecache.Store
+	environment string
+}
+
+// Enabled reports whether the call takes part in the offline cache.
+func (c OfflineCache) Enabled() bool {
+	return c.store != nil
+}
+
+// ResolveOfflineCache returns the offline cache for a call. Only read tools
+// take part, since replaying an old answer is only safe for a call that
+// changes nothing. A call naming an unknown environment gets none; the
+// handler reports that error itself. Under server.tokenPassthrough no call
+// takes part: saved answers are kept per environment, not per caller, so a
+// replay could hand one tenant another's inventory. Config load rejects that
+// combination; this check covers a config built without it.
+func ResolveOfflineCache(cfg *config.Config, capability profiles.Capability, request *mcp.CallToolRequest) OfflineCache {
+	if cfg == nil || !cfg.OfflineCache.Enabled || cfg.Server.TokenPassthrough || capability != profiles.CapRead {
+		return OfflineCache{}
+	}
+
+	environment, err := localStoreEnvironment(cfg, request.GetString(paramEnvironment, ""))
+	if err != nil {
+		return OfflineCache{}
+	}
+
+	dir := cfg.OfflineCache.Dir
+	if dir == "" {
+		dir = filepath.Join(audit.ResolveDefaultAuditDir(), "offline-cache")
+	}
+
+	return OfflineCache{store: offlinecache.NewStore(dir), environment: environment}
+}
+
+// ApplyOfflineCache saves a successful result as the latest answer for the
+// call. When the call failed because the Linode API could not be reached
+// (linode.APIUnreachable on ctx), it instead returns the last saved answer,
+// with a trailing text item and a warning giving the time it was saved. Any
+// other failure, or one with nothing saved, is returned unchanged.
+func ApplyOfflineCache(
+	ctx context.Context, cache OfflineCache, toolName string, request *mcp.CallToolRequest, result *mcp.CallToolResult, err error,
+) (*mcp.CallToolResult, error) {
+	if !cache.Enabled() {
+		return result, err
+	}
+
+	if err == nil && result != nil && !result.IsError {
+		if content, ok := resultTexts(result); ok {
+			if putErr := cache.store.Put(cache.environment, toolName, request.GetArguments(), content); putErr != nil {
+				AddWarning(ctx, "result was not saved to the offline cache: %v", putErr)
+			}
+		}
+
+		return result, err
+	}
+
+	if !linode.APIUnreachable(ctx) {
+		return result, err
+	}
+
+	entry, found, getErr := cache.store.Get(cache.environment, toolName, request.GetArguments())
+	if getErr != nil {
+		AddWarning(ctx, "offline cache could not be read: %v", getErr)
+
+		return result, err
+	}
+
+	if !found {
+		return result, err
+	}
+
+	warning := fmt.Sprintf("the Linode API is unreachable; this result is from the offline cache, stale as of %s", entry.CachedAt)
+	AddWarning(ctx, "%s", warning)
+
+	content := make([]mcp.Content, 0, len(entry.Content)+1)
+	for _, text := range entry.Content {
+		content = append(content, mcp.NewTextContent(text))
+	}
+
+	content = append(content, mcp.NewTextContent("Warning: "+warning))
+
+	return &mcp.CallToolResult{Content: content}, nil
+}
+
+// resultTexts returns a result's text items in order. The bool is false when
+// the result holds anything else, which the cache could not replay as-is.
+func resultTexts(result *mcp.CallToolResult) ([]string, bool) {
+	texts := make([]string, 0, len(result.Content))
+
+	for _, content := range result.Content {
+		text, ok := content.(mcp.TextContent)
+		if !ok {
+			return nil, false
+		}
+
+		texts = append(texts, text.Text)
+	}
+
+	return texts, true
+}
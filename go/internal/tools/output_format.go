@@ -0,0 +1,559 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+)
+
+// ParamOutputFormat is the per-call argument that overrides the configured
+// output_format. The dispatch middleware reads it for every tool, so it never
+// appears in a tool's own input schema.
+const ParamOutputFormat = "output_format"
+
+// Byte sizes of the units Linode reports sizes in and the units results can be
+// displayed in. Linode's "MB" and "GB" are binary (MiB, GiB).
+const (
+	bytesPerMiB = 1 << 20
+	bytesPerGiB = 1 << 30
+	bytesPerGB  = 1e9
+)
+
+// sizeFieldBytes maps the size fields every tool reports in the same unit to
+// the bytes one unit of the field holds.
+var sizeFieldBytes = map[string]float64{
+	"memory":       bytesPerMiB,
+	"memory_limit": bytesPerMiB,
+	"disk":         bytesPerMiB,
+	"transfer":     bytesPerGiB,
+}
+
+// toolSizeBytes gives the unit of the generic "size" field by tool-name
+// prefix, since volumes, images, disks, and buckets each measure it
+// differently. Tools matching no prefix leave "size" unannotated.
+var toolSizeBytes = []struct {
+	prefix string
+	bytes  float64
+}{
+	{"linode_volume_", bytesPerGiB},
+	{"linode_image_", bytesPerMiB},
+	{"linode_instance_disk", bytesPerMiB},
+	{"linode_object_storage_bucket", 1},
+}
+
+// cloudManagerBaseURL is where Cloud Manager serves resource pages.
+const cloudManagerBaseURL = "https://cloud.linode.com"
+
+// cloudManagerKeyPaths maps the keys tool results list resources under to the
+// Cloud Manager path of one of those resources. Objects in the list with a
+// numeric id gain a cloud_manager_url.
+var cloudManagerKeyPaths = map[string]string{
+	"instances":            "linodes",
+	"linodes":              "linodes",
+	"volumes":              "volumes",
+	"domains":              "domains",
+	"nodebalancers":        "nodebalancers",
+	"clusters":             "kubernetes/clusters",
+	"firewalls":            "firewalls",
+	"vpcs":                 "vpcs",
+	"placement_groups":     "placement-groups",
+	"stackscripts":         "stackscripts",
+	"mysql_instances":      "databases/mysql",
+	"postgresql_instances": "databases/postgresql",
+	"database_instances":   cloudManagerDatabasePath,
+}
+
+// cloudManagerDatabasePath marks a database list whose entries carry their
+// own engine, which completes the path.
+const cloudManagerDatabasePath = "databases"
+
+// cloudManagerToolPaths maps the tools that return a single resource as their
+// top-level object to that resource's Cloud Manager path.
+var cloudManagerToolPaths = map[string]string{
+	"linode_instance_get":                     "linodes",
+	"linode_volume_get":                       "volumes",
+	"linode_domain_get":                       "domains",
+	"linode_nodebalancer_get":                 "nodebalancers",
+	"linode_lke_cluster_get":                  "kubernetes/clusters",
+	"linode_firewall_get":                     "firewalls",
+	"linode_vpc_get":                          "vpcs",
+	"linode_placement_group_get":              "placement-groups",
+	"linode_stackscript_get":                  "stackscripts",
+	"linode_database_mysql_instance_get":      "databases/mysql",
+	"linode_database_postgresql_instance_get": "databases/postgresql",
+}
+
+// cloudManagerEntityPaths maps the entity types account events (and firewall
+// devices) name in their entity and secondary_entity objects to the Cloud
+// Manager path of that entity.
+var cloudManagerEntityPaths = map[string]string{
+	"linode":          "linodes",
+	"volume":          "volumes",
+	"domain":          "domains",
+	"nodebalancer":    "nodebalancers",
+	"lkecluster":      "kubernetes/clusters",
+	"firewall":        "firewalls",
+	"vpc":             "vpcs",
+	"placement_group": "placement-groups",
+	"stackscript":     "stackscripts",
+}
+
+// OutputFormat is the display formatting resolved for one call. An empty
+// SizeUnit or PricePeriod leaves that kind of field unannotated, and
+// CloudManagerLinks adds a cloud_manager_url to each resource.
+type OutputFormat struct {
+	SizeUnit          string
+	PricePeriod       string
+	CurrencySymbol    bool
+	CloudManagerLinks bool
+}
+
+// active reports whether the format adds any annotation.
+func (f OutputFormat) active() bool {
+	return f.SizeUnit != "" || f.PricePeriod != "" || f.CloudManagerLinks
+}
+
+// ResolveOutputFormat merges the call's output_format argument over the
+// configured defaults. An argument that is not an object or carries an
+// invalid value is ignored with a warning, keeping the configured format.
+func ResolveOutputFormat(ctx context.Context, defaults config.OutputFormatConfig, request *mcp.CallToolRequest) OutputFormat {
+	format := OutputFormat{
+		SizeUnit:          defaults.SizeUnit,
+		PricePeriod:       defaults.PricePeriod,
+		CurrencySymbol:    defaults.CurrencySymbol == nil || *defaults.CurrencySymbol,
+		CloudManagerLinks: defaults.CloudManagerLinks,
+	}
+
+	raw, ok := request.GetArguments()[ParamOutputFormat]
+	if !ok {
+		return format
+	}
+
+	override, ok := raw.(map[string]any)
+	if !ok {
+		AddWarning(ctx, "%s must be an object and was ignored", ParamOutputFormat)
+
+		return format
+	}
+
+	merged := format
+
+	if unit, ok := override["size_unit"].(string); ok && unit != "" {
+		merged.SizeUnit = unit
+	}
+
+	if period, ok := override["price_period"].(string); ok && period != "" {
+		merged.PricePeriod = period
+	}
+
+	if symbol, ok := override["currency_symbol"].(bool); ok {
+		merged.CurrencySymbol = symbol
+	}
+
+	if links, ok := override["cloud_manager_links"].(bool); ok {
+		merged.CloudManagerLinks = links
+	}
+
+	if err := config.ValidateOutputFormat(merged.SizeUnit, merged.PricePeriod); err != nil {
+		AddWarning(ctx, "%s was ignored: %v", ParamOutputFormat, err)
+
+		return format
+	}
+
+	return merged
+}
+
+// ApplyOutputFormat adds the display annotations format asks for to a
+// successful JSON result: a "<field>_display" string after each size field, a
+// "display" string in each price object, and a "cloud_manager_url" in each
+// resource Cloud Manager has a page for. Raw values are kept as-is, and
+// error, non-JSON, and unannotated results are left untouched.
+func ApplyOutputFormat(result *mcp.CallToolResult, toolName string, format OutputFormat) {
+	if result == nil || result.IsError || !format.active() {
+		return
+	}
+
+	for i, content := range result.Content {
+		text, ok := content.(mcp.TextContent)
+		if !ok {
+			continue
+		}
+
+		trimmed := strings.TrimSpace(text.Text)
+		if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+			continue
+		}
+
+		annotated, err := annotateDisplay([]byte(trimmed), toolName, format)
+		if err != nil {
+			continue
+		}
+
+		text.Text = string(annotated)
+		result.Content[i] = text
+	}
+}
+
+// displayWalker streams a JSON document, copying it while inserting display
+// annotations, so field order and number literals survive unchanged.
+type displayWalker struct {
+	decoder   *json.Decoder
+	buf       bytes.Buffer
+	format    OutputFormat
+	sizeBytes float64
+	rootPath  string
+	depth     int
+}
+
+// annotateDisplay returns data re-indented with the display annotations.
+func annotateDisplay(data []byte, toolName string, format OutputFormat) ([]byte, error) {
+	walker := &displayWalker{
+		decoder:  json.NewDecoder(bytes.NewReader(data)),
+		format:   format,
+		rootPath: cloudManagerToolPaths[toolName],
+	}
+	walker.decoder.UseNumber()
+
+	for _, entry := range toolSizeBytes {
+		if strings.HasPrefix(toolName, entry.prefix) {
+			walker.sizeBytes = entry.bytes
+
+			break
+		}
+	}
+
+	if err := walker.value("", false); err != nil {
+		return nil, err
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, walker.buf.Bytes(), "", "  "); err != nil {
+		return nil, fmt.Errorf("failed to indent annotated response: %w", err)
+	}
+
+	return indented.Bytes(), nil
+}
+
+// value copies one JSON value. key is the object key it sits under, if any,
+// or the key of the array holding it; member says it is an object member
+// rather than an array element, and only members gain size annotations.
+func (w *displayWalker) value(key string, member bool) error {
+	tok, err := w.decoder.Token()
+	if err != nil {
+		return fmt.Errorf("failed to read token: %w", err)
+	}
+
+	switch typed := tok.(type) {
+	case json.Delim:
+		if typed == '{' {
+			return w.object(key)
+		}
+
+		return w.array(key)
+	case string:
+		return writeJSONString(&w.buf, typed)
+	case json.Number:
+		w.buf.WriteString(typed.String())
+
+		if !member {
+			return nil
+		}
+
+		if display := w.sizeDisplay(key, typed); display != "" {
+			w.buf.WriteString(",")
+
+			if err := writeJSONString(&w.buf, key+"_display"); err != nil {
+				return err
+			}
+
+			w.buf.WriteByte(':')
+
+			return writeJSONString(&w.buf, display)
+		}
+
+		return nil
+	case bool:
+		w.buf.WriteString(strconv.FormatBool(typed))
+
+		return nil
+	default:
+		w.buf.WriteString("null")
+
+		return nil
+	}
+}
+
+// object copies an object whose opening brace was already consumed, adding a
+// "display" key at the end when it is a price object and a
+// "cloud_manager_url" key when it is a resource with a Cloud Manager page. key
+// is the object key the object (or the array holding it) sits under.
+func (w *displayWalker) object(key string) error {
+	w.buf.WriteByte('{')
+
+	var (
+		first           = true
+		hourly, monthly *float64
+		fields          = cloudManagerFields{}
+		path            = cloudManagerKeyPaths[key]
+	)
+
+	if w.depth == 0 && key == "" {
+		path = w.rootPath
+	}
+
+	w.depth++
+	defer func() { w.depth-- }()
+
+	for w.decoder.More() {
+		keyTok, err := w.decoder.Token()
+		if err != nil {
+			return fmt.Errorf("failed to read object key: %w", err)
+		}
+
+		member, ok := keyTok.(string)
+		if !ok {
+			return fmt.Errorf("%w: %v", errUnexpectedKeyToken, keyTok)
+		}
+
+		if !first {
+			w.buf.WriteByte(',')
+		}
+
+		first = false
+
+		if err := writeJSONString(&w.buf, member); err != nil {
+			return err
+		}
+
+		w.buf.WriteByte(':')
+
+		start := w.buf.Len()
+
+		if err := w.value(member, true); err != nil {
+			return err
+		}
+
+		raw := w.buf.Bytes()[start:]
+
+		switch member {
+		case "hourly", "monthly":
+			if number, err := strconv.ParseFloat(string(raw), 64); err == nil {
+				if member == "hourly" {
+					hourly = &number
+				} else {
+					monthly = &number
+				}
+			}
+		default:
+			fields.record(member, raw)
+		}
+	}
+
+	if _, err := w.decoder.Token(); err != nil {
+		return fmt.Errorf("failed to read object end: %w", err)
+	}
+
+	annotations := []struct{ key, value string }{
+		{"display", w.priceDisplay(hourly, monthly)},
+		{"cloud_manager_url", w.cloudManagerURL(key, path, fields)},
+	}
+
+	for _, annotation := range annotations {
+		if annotation.value == "" {
+			continue
+		}
+
+		if !first {
+			w.buf.WriteByte(',')
+		}
+
+		first = false
+
+		if err := writeJSONString(&w.buf, annotation.key); err != nil {
+			return err
+		}
+
+		w.buf.WriteByte(':')
+
+		if err := writeJSONString(&w.buf, annotation.value); err != nil {
+			return err
+		}
+	}
+
+	w.buf.WriteByte('}')
+
+	return nil
+}
+
+// array copies an array whose opening bracket was already consumed; key is the
+// object key the array sits under, which its elements inherit.
+func (w *displayWalker) array(key string) error {
+	w.buf.WriteByte('[')
+
+	first := true
+
+	w.depth++
+	defer func() { w.depth-- }()
+
+	for w.decoder.More() {
+		if !first {
+			w.buf.WriteByte(',')
+		}
+
+		first = false
+
+		if err := w.value(key, false); err != nil {
+			return err
+		}
+	}
+
+	if _, err := w.decoder.Token(); err != nil {
+		return fmt.Errorf("failed to read array end: %w", err)
+	}
+
+	w.buf.WriteByte(']')
+
+	return nil
+}
+
+// cloudManagerFields holds the members of an object that locate it in Cloud
+// Manager, as copied into the output.
+type cloudManagerFields struct {
+	id, entityType, engine, existingURL string
+}
+
+// record keeps member's raw JSON value when it is one of the locating fields.
+// A non-integer id or a non-string type or engine is dropped.
+func (f *cloudManagerFields) record(member string, raw []byte) {
+	switch member {
+	case "id":
+		if _, err := strconv.ParseInt(string(raw), 10, 64); err == nil {
+			f.id = string(raw)
+		}
+	case "type", "engine", "cloud_manager_url":
+		var text string
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return
+		}
+
+		switch member {
+		case "type":
+			f.entityType = text
+		case "engine":
+			f.engine = text
+		default:
+			f.existingURL = text
+		}
+	}
+}
+
+// cloudManagerURL returns the Cloud Manager page of an object sitting under
+// key, or "" when links are off or the object is not a resource Cloud Manager
+// shows. path is the object's resource path from its key or its tool; an event
+// entity names its own type instead.
+func (w *displayWalker) cloudManagerURL(key, path string, fields cloudManagerFields) string {
+	if !w.format.CloudManagerLinks || fields.id == "" || fields.existingURL != "" {
+		return ""
+	}
+
+	if key == "entity" || key == "secondary_entity" {
+		path = cloudManagerEntityPaths[fields.entityType]
+	}
+
+	if path == cloudManagerDatabasePath {
+		if fields.engine == "" {
+			return ""
+		}
+
+		path += "/" + fields.engine
+	}
+
+	if path == "" {
+		return ""
+	}
+
+	return cloudManagerBaseURL + "/" + path + "/" + fields.id
+}
+
+// sizeDisplay renders a size field in the configured unit, or "" when the key
+// is not a size field or sizes are not annotated.
+func (w *displayWalker) sizeDisplay(key string, number json.Number) string {
+	if w.format.SizeUnit == "" {
+		return ""
+	}
+
+	unitBytes, ok := sizeFieldBytes[key]
+	if key == "size" {
+		unitBytes, ok = w.sizeBytes, w.sizeBytes != 0
+	}
+
+	if !ok {
+		return ""
+	}
+
+	value, err := number.Float64()
+	if err != nil {
+		return ""
+	}
+
+	divisor := float64(bytesPerGiB)
+	if w.format.SizeUnit == config.SizeUnitGB {
+		divisor = bytesPerGB
+	}
+
+	return trimDecimals(strconv.FormatFloat(value*unitBytes/divisor, 'f', 2, 64), 0) + " " + w.format.SizeUnit
+}
+
+// priceDisplay renders a price object for the configured period, or "" when
+// prices are not annotated or the object lacks the period's number.
+func (w *displayWalker) priceDisplay(hourly, monthly *float64) string {
+	hourlyText := func() string {
+		return w.money(trimDecimals(strconv.FormatFloat(*hourly, 'f', 4, 64), 2)) + "/hr"
+	}
+	monthlyText := func() string {
+		return w.money(strconv.FormatFloat(*monthly, 'f', 2, 64)) + "/mo"
+	}
+
+	switch {
+	case w.format.PricePeriod == config.PricePeriodHourly && hourly != nil:
+		return hourlyText()
+	case w.format.PricePeriod == config.PricePeriodMonthly && monthly != nil:
+		return monthlyText()
+	case w.format.PricePeriod == config.PricePeriodBoth && hourly != nil && monthly != nil:
+		return monthlyText() + " (" + hourlyText() + ")"
+	default:
+		return ""
+	}
+}
+
+// money prefixes an amount with the dollar sign, or suffixes the currency code
+// when symbols are off.
+func (w *displayWalker) money(amount string) string {
+	if w.format.CurrencySymbol {
+		return "$" + amount
+	}
+
+	return amount + " USD"
+}
+
+// trimDecimals drops trailing zeros from a fixed-point number, keeping at
+// least keep decimals (and the point only when decimals remain).
+func trimDecimals(number string, keep int) string {
+	point := strings.IndexByte(number, '.')
+	if point < 0 {
+		return number
+	}
+
+	for len(number)-point-1 > keep && strings.HasSuffix(number, "0") {
+		number = number[:len(number)-1]
+	}
+
+	return strings.TrimSuffix(number, ".")
+}
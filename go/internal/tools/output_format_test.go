@@ -0,0 +1,276 @@
+package tools_test
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestApplyOutputFormat(t *testing.T) {
+	t.Parallel()
+
+	const typeJSON = `{"id":"g6-nanode-1","memory":1024,"disk":25600,"transfer":1000,"price":{"hourly":0.0075,"monthly":5}}`
+
+	tests := []struct {
+		name     string
+		toolName string
+		input    string
+		format   tools.OutputFormat
+		want     string
+	}{
+		{
+			name:     "GiB sizes and monthly price",
+			toolName: "linode_type_get",
+			input:    typeJSON,
+			format:   tools.OutputFormat{SizeUnit: config.SizeUnitGiB, PricePeriod: config.PricePeriodMonthly, CurrencySymbol: true},
+			want: `{
+  "id": "g6-nanode-1",
+  "memory": 1024,
+  "memory_display": "1 GiB",
+  "disk": 25600,
+  "disk_display": "25 GiB",
+  "transfer": 1000,
+  "transfer_display": "1000 GiB",
+  "price": {
+    "hourly": 0.0075,
+    "monthly": 5,
+    "display": "$5.00/mo"
+  }
+}`,
+		},
+		{
+			name:     "GB sizes and both periods without symbol",
+			toolName: "linode_type_get",
+			input:    typeJSON,
+			format:   tools.OutputFormat{SizeUnit: config.SizeUnitGB, PricePeriod: config.PricePeriodBoth},
+			want: `{
+  "id": "g6-nanode-1",
+  "memory": 1024,
+  "memory_display": "1.07 GB",
+  "disk": 25600,
+  "disk_display": "26.84 GB",
+  "transfer": 1000,
+  "transfer_display": "1073.74 GB",
+  "price": {
+    "hourly": 0.0075,
+    "monthly": 5,
+    "display": "5.00 USD/mo (0.0075 USD/hr)"
+  }
+}`,
+		},
+		{
+			name:     "volume size is GiB and hourly price",
+			toolName: "linode_volume_list",
+			input:    `[{"id":1,"size":20,"price":{"hourly":0.003,"monthly":2}}]`,
+			format:   tools.OutputFormat{SizeUnit: config.SizeUnitGiB, PricePeriod: config.PricePeriodHourly, CurrencySymbol: true},
+			want: `[
+  {
+    "id": 1,
+    "size": 20,
+    "size_display": "20 GiB",
+    "price": {
+      "hourly": 0.003,
+      "monthly": 2,
+      "display": "$0.003/hr"
+    }
+  }
+]`,
+		},
+		{
+			name:     "size of an unknown tool is left alone",
+			toolName: "linode_nodebalancer_get",
+			input:    `{"size":20}`,
+			format:   tools.OutputFormat{SizeUnit: config.SizeUnitGiB},
+			want:     "{\n  \"size\": 20\n}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := mcp.NewToolResultText(tt.input)
+			tools.ApplyOutputFormat(result, tt.toolName, tt.format)
+
+			if got := dryRunResultText(t, result); got != tt.want {
+				t.Errorf("text = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyOutputFormatCloudManagerLinks(t *testing.T) {
+	t.Parallel()
+
+	format := tools.OutputFormat{CloudManagerLinks: true}
+
+	tests := []struct {
+		name     string
+		toolName string
+		input    string
+		want     string
+	}{
+		{
+			name:     "single resource from its get tool",
+			toolName: "linode_instance_get",
+			input:    `{"id":123,"label":"web","specs":{"disk":25600}}`,
+			want: `{
+  "id": 123,
+  "label": "web",
+  "specs": {
+    "disk": 25600
+  },
+  "cloud_manager_url": "https://cloud.linode.com/linodes/123"
+}`,
+		},
+		{
+			name:     "list entries by their key",
+			toolName: "linode_lke_cluster_list",
+			input:    `{"count":1,"clusters":[{"id":7,"label":"prod"}]}`,
+			want: `{
+  "count": 1,
+  "clusters": [
+    {
+      "id": 7,
+      "label": "prod",
+      "cloud_manager_url": "https://cloud.linode.com/kubernetes/clusters/7"
+    }
+  ]
+}`,
+		},
+		{
+			name:     "database entries carry their engine",
+			toolName: "linode_database_list",
+			input:    `{"database_instances":[{"id":9,"engine":"postgresql"},{"id":10}]}`,
+			want: `{
+  "database_instances": [
+    {
+      "id": 9,
+      "engine": "postgresql",
+      "cloud_manager_url": "https://cloud.linode.com/databases/postgresql/9"
+    },
+    {
+      "id": 10
+    }
+  ]
+}`,
+		},
+		{
+			name:     "event entities by their type",
+			toolName: "linode_account_event_list",
+			input:    `{"events":[{"id":1,"entity":{"id":55,"type":"volume"},"secondary_entity":{"id":2,"type":"ticket"}}]}`,
+			want: `{
+  "events": [
+    {
+      "id": 1,
+      "entity": {
+        "id": 55,
+        "type": "volume",
+        "cloud_manager_url": "https://cloud.linode.com/volumes/55"
+      },
+      "secondary_entity": {
+        "id": 2,
+        "type": "ticket"
+      }
+    }
+  ]
+}`,
+		},
+		{
+			name:     "nested objects of a get tool are not the resource",
+			toolName: "linode_instance_disk_get",
+			input:    `{"id":4,"size":100}`,
+			want:     "{\n  \"id\": 4,\n  \"size\": 100\n}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := mcp.NewToolResultText(tt.input)
+			tools.ApplyOutputFormat(result, tt.toolName, format)
+
+			if got := dryRunResultText(t, result); got != tt.want {
+				t.Errorf("text = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyOutputFormatLeavesOtherResults(t *testing.T) {
+	t.Parallel()
+
+	format := tools.OutputFormat{SizeUnit: config.SizeUnitGiB, PricePeriod: config.PricePeriodMonthly}
+
+	tests := []struct {
+		name   string
+		result *mcp.CallToolResult
+		format tools.OutputFormat
+	}{
+		{name: "no format configured", result: mcp.NewToolResultText(`{"memory":1024}`)},
+		{name: "error result", result: mcp.NewToolResultError(`{"memory":1024}`), format: format},
+		{name: "plain text", result: mcp.NewToolResultText("memory: 1024"), format: format},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			before := dryRunResultText(t, tt.result)
+			tools.ApplyOutputFormat(tt.result, "linode_type_get", tt.format)
+
+			if after := dryRunResultText(t, tt.result); after != before {
+				t.Errorf("text = %q, want unchanged %q", after, before)
+			}
+		})
+	}
+}
+
+func TestResolveOutputFormat(t *testing.T) {
+	t.Parallel()
+
+	defaults := config.OutputFormatConfig{SizeUnit: config.SizeUnitGiB, PricePeriod: config.PricePeriodMonthly}
+
+	tests := []struct {
+		name string
+		args map[string]any
+		want tools.OutputFormat
+	}{
+		{
+			name: "config defaults",
+			args: map[string]any{},
+			want: tools.OutputFormat{SizeUnit: config.SizeUnitGiB, PricePeriod: config.PricePeriodMonthly, CurrencySymbol: true},
+		},
+		{
+			name: "per-call override",
+			args: map[string]any{tools.ParamOutputFormat: map[string]any{"size_unit": "GB", "currency_symbol": false}},
+			want: tools.OutputFormat{SizeUnit: config.SizeUnitGB, PricePeriod: config.PricePeriodMonthly},
+		},
+		{
+			name: "per-call cloud manager links",
+			args: map[string]any{tools.ParamOutputFormat: map[string]any{"cloud_manager_links": true}},
+			want: tools.OutputFormat{SizeUnit: config.SizeUnitGiB, PricePeriod: config.PricePeriodMonthly, CurrencySymbol: true, CloudManagerLinks: true},
+		},
+		{
+			name: "invalid override is ignored",
+			args: map[string]any{tools.ParamOutputFormat: map[string]any{"price_period": "weekly"}},
+			want: tools.OutputFormat{SizeUnit: config.SizeUnitGiB, PricePeriod: config.PricePeriodMonthly, CurrencySymbol: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := createRequestWithArgs(t, tt.args)
+
+			if got := tools.ResolveOutputFormat(t.Context(), defaults, &req); got != tt.want {
+				t.Errorf("ResolveOutputFormat() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
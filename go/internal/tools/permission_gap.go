@@ -0,0 +1,21 @@
+package tools
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// permissionGap reports whether err is the API refusing the token one section
+// of a composite report (HTTP 403). A restricted token still gets the
+// sections it can read: the caller skips this one and reports the returned
+// warning instead of failing the whole call. Any other error stays fatal.
+func permissionGap(section string, err error) (string, bool) {
+	apiErr, ok := errors.AsType[*linode.APIError](err)
+	if !ok || !apiErr.IsForbiddenError() {
+		return "", false
+	}
+
+	return fmt.Sprintf("%s skipped: the token lacks permission to read them (%v)", section, err), true
+}
@@ -0,0 +1,111 @@
+package tools
+
+import (
+	"context"
+	"math"
+
+	linodev1 "github.com/chadit/LinodeMCP/go/internal/genpb/linode/mcp/v1"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// priceTables are the monthly list prices Linode publishes for the billable
+// resources the project rollup and write cost attribution price: instance
+// types, Block Storage, and NodeBalancers. A price table that could not be
+// fetched is nil and costs 0.
+type priceTables struct {
+	instanceTypes map[string]*linodev1.InstanceType
+	volume        *linodev1.LinodeType
+	nodeBalancer  *linodev1.LinodeType
+}
+
+// fetchPriceTables reads the instance, volume, and NodeBalancer price tables.
+// Volumes and NodeBalancers have one type each. A table that cannot be read is
+// an envelope warning, not an error.
+func fetchPriceTables(ctx context.Context, client *linode.Client) priceTables {
+	var prices priceTables
+
+	instanceTypes, err := client.ListTypesProto(ctx)
+	if err != nil {
+		AddWarning(ctx, "instance prices unavailable, instance costs are reported as 0: %v", err)
+	} else {
+		prices.instanceTypes = instanceTypesByID(instanceTypes)
+	}
+
+	volumeTypes, err := client.ListVolumeTypesProto(ctx)
+	if err != nil {
+		AddWarning(ctx, "volume prices unavailable, volume costs are reported as 0: %v", err)
+	} else if len(volumeTypes) > 0 {
+		prices.volume = volumeTypes[0]
+	}
+
+	nodeBalancerTypes, err := client.ListNodeBalancerTypesProto(ctx)
+	if err != nil {
+		AddWarning(ctx, "NodeBalancer prices unavailable, NodeBalancer costs are reported as 0: %v", err)
+	} else if len(nodeBalancerTypes) > 0 {
+		prices.nodeBalancer = nodeBalancerTypes[0]
+	}
+
+	return prices
+}
+
+// instanceTypesByID indexes the instance type table by type ID.
+func instanceTypesByID(instanceTypes []*linodev1.InstanceType) map[string]*linodev1.InstanceType {
+	byID := make(map[string]*linodev1.InstanceType, len(instanceTypes))
+	for _, instanceType := range instanceTypes {
+		byID[instanceType.GetId()] = instanceType
+	}
+
+	return byID
+}
+
+// instance is an instance's monthly list price, with the backups add-on when
+// backups are enabled.
+func (p priceTables) instance(instance *linodev1.Instance) float64 {
+	monthly, _ := p.instanceTypeMonthly(instance.GetType(), instance.GetBackups().GetEnabled())
+
+	return monthly
+}
+
+// instanceTypeMonthly is the monthly list price of an instance of typeID, with
+// the backups add-on when backups is set. It reports false for a type the
+// table does not list.
+func (p priceTables) instanceTypeMonthly(typeID string, backups bool) (float64, bool) {
+	instanceType, ok := p.instanceTypes[typeID]
+	if !ok {
+		return 0, false
+	}
+
+	monthly := instanceType.GetPrice().GetMonthly()
+	if backups {
+		monthly += instanceType.GetAddons().GetBackups().GetPrice().GetMonthly()
+	}
+
+	return roundCents(monthly), true
+}
+
+// volumeMonthly is the monthly list price of a sizeGB volume in region.
+func (p priceTables) volumeMonthly(sizeGB int, region string) float64 {
+	return roundCents(float64(sizeGB) * linodeTypeMonthly(p.volume, region))
+}
+
+// nodeBalancerMonthly is the monthly list price of a NodeBalancer in region.
+func (p priceTables) nodeBalancerMonthly(region string) float64 {
+	return roundCents(linodeTypeMonthly(p.nodeBalancer, region))
+}
+
+// linodeTypeMonthly is a type's monthly price in region, falling back to its
+// base price when the region has no price of its own.
+func linodeTypeMonthly(linodeType *linodev1.LinodeType, region string) float64 {
+	for _, price := range linodeType.GetRegionPrices() {
+		if price.GetId() == region {
+			return price.GetMonthly()
+		}
+	}
+
+	return linodeType.GetPrice().GetMonthly()
+}
+
+// roundCents rounds a dollar amount to whole cents.
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,308 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+)
+
+// ParamReadAfterWrite is the per-call argument that turns the read-after-write
+// check on or off, overriding read_after_write.enabled. The dispatch
+// middleware reads it for every tool, so it never appears in a tool's own
+// input schema.
+const ParamReadAfterWrite = "read_after_write"
+
+// ReadAfterWrite is the read-after-write check resolved for one call. A zero
+// Attempts means the check is off.
+type ReadAfterWrite struct {
+	Attempts int
+	Interval time.Duration
+}
+
+// Enabled reports whether the call re-reads what it wrote.
+func (r ReadAfterWrite) Enabled() bool {
+	return r.Attempts > 0
+}
+
+// ResolveReadAfterWrite merges the call's read_after_write argument over the
+// configured setting. Read tools and dry runs write nothing, so the check is
+// always off for them. A non-boolean argument is ignored with a warning.
+func ResolveReadAfterWrite(
+	ctx context.Context, settings config.ReadAfterWriteConfig, capability profiles.Capability, request *mcp.CallToolRequest,
+) ReadAfterWrite {
+	enabled := settings.Enabled
+
+	if raw, ok := request.GetArguments()[ParamReadAfterWrite]; ok {
+		override, isBool := raw.(bool)
+		if isBool {
+			enabled = override
+		} else {
+			AddWarning(ctx, "%s must be a boolean and was ignored", ParamReadAfterWrite)
+		}
+	}
+
+	if !enabled || capability == profiles.CapRead || capability == profiles.CapMeta || IsDryRun(request) {
+		return ReadAfterWrite{}
+	}
+
+	check := ReadAfterWrite{
+		Attempts: config.DefaultReadAfterWriteAttempts,
+		Interval: config.DefaultReadAfterWriteIntervalMS * time.Millisecond,
+	}
+
+	if settings.Attempts != nil {
+		check.Attempts = *settings.Attempts
+	}
+
+	if settings.IntervalMS != nil {
+		check.Interval = time.Duration(*settings.IntervalMS) * time.Millisecond
+	}
+
+	return check
+}
+
+// writeProbe is one resource to re-read after a call: the endpoint to GET and
+// the test that the fetched state reflects the write.
+type writeProbe struct {
+	method   string
+	endpoint string
+	visible  func(body json.RawMessage, err error) (bool, error)
+}
+
+// AwaitReadAfterWrite re-reads every resource the call wrote until each write
+// is visible or check.Attempts reads have been spent. The first read happens
+// immediately and later ones check.Interval apart. Writes that stay stale, or
+// whose re-read fails outright, are reported as warnings; the result itself
+// is returned unchanged because the write already succeeded.
+func AwaitReadAfterWrite(
+	ctx context.Context, request *mcp.CallToolRequest, cfg *config.Config, check ReadAfterWrite, result *mcp.CallToolResult,
+) {
+	if !check.Enabled() || result == nil || result.IsError {
+		return
+	}
+
+	probes := writeProbes(linode.WritesFromContext(ctx))
+	if len(probes) == 0 {
+		return
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		AddWarning(ctx, "read_after_write: %v", err)
+
+		return
+	}
+
+	pending := probes
+
+	for attempt := 1; attempt <= check.Attempts && len(pending) > 0; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				AddWarning(ctx, "read_after_write: stopped waiting: %v", ctx.Err())
+
+				return
+			case <-time.After(check.Interval):
+			}
+		}
+
+		var stale []writeProbe
+
+		for _, probe := range pending {
+			body, getErr := client.GetRaw(ctx, probe.endpoint)
+
+			visible, probeErr := probe.visible(body, getErr)
+			if probeErr != nil {
+				AddWarning(ctx, "read_after_write: re-reading %s failed: %v", probe.endpoint, probeErr)
+
+				continue
+			}
+
+			if !visible {
+				stale = append(stale, probe)
+			}
+		}
+
+		pending = stale
+	}
+
+	for _, probe := range pending {
+		AddWarning(ctx, "read_after_write: %s %s still showed the old state after %d reads",
+			probe.method, probe.endpoint, check.Attempts)
+	}
+}
+
+// writeProbes turns the recorded writes into re-read probes, one per
+// endpoint with the last write winning. Actions (POST to a resource's
+// sub-path, such as /linode/instances/{id}/boot) have no readable state of
+// their own and are skipped.
+func writeProbes(writes []linode.WriteRecord) []writeProbe {
+	var (
+		probes []writeProbe
+		index  = map[string]int{}
+	)
+
+	for _, write := range writes {
+		endpoint, _, _ := strings.Cut(write.Endpoint, "?")
+
+		probe, ok := probeFor(write, endpoint)
+		if !ok {
+			continue
+		}
+
+		if i, seen := index[probe.endpoint]; seen {
+			probes[i] = probe
+
+			continue
+		}
+
+		index[probe.endpoint] = len(probes)
+		probes = append(probes, probe)
+	}
+
+	return probes
+}
+
+func probeFor(write linode.WriteRecord, endpoint string) (writeProbe, bool) {
+	switch write.Method {
+	case http.MethodPut:
+		var want any
+		if len(bytes.TrimSpace(write.Body)) == 0 || json.Unmarshal(write.Body, &want) != nil {
+			return writeProbe{}, false
+		}
+
+		return writeProbe{method: write.Method, endpoint: endpoint, visible: func(body json.RawMessage, err error) (bool, error) {
+			if err != nil {
+				return false, err
+			}
+
+			var got any
+			if err := json.Unmarshal(body, &got); err != nil {
+				return false, err
+			}
+
+			return reflectsWrite(want, got), nil
+		}}, true
+	case http.MethodDelete:
+		return writeProbe{method: write.Method, endpoint: endpoint, visible: func(_ json.RawMessage, err error) (bool, error) {
+			if err == nil {
+				return false, nil
+			}
+
+			if isNotFound(err) {
+				return true, nil
+			}
+
+			return false, err
+		}}, true
+	case http.MethodPost:
+		if hasIDSegment(endpoint) {
+			return writeProbe{}, false
+		}
+
+		var created struct {
+			ID *int64 `json:"id"`
+		}
+		if json.Unmarshal(write.Response, &created) != nil || created.ID == nil {
+			return writeProbe{}, false
+		}
+
+		endpoint = strings.TrimSuffix(endpoint, "/") + "/" + strconv.FormatInt(*created.ID, 10)
+
+		return writeProbe{method: write.Method, endpoint: endpoint, visible: func(_ json.RawMessage, err error) (bool, error) {
+			if err == nil {
+				return true, nil
+			}
+
+			if isNotFound(err) {
+				return false, nil
+			}
+
+			return false, err
+		}}, true
+	default:
+		return writeProbe{}, false
+	}
+}
+
+// reflectsWrite reports whether got shows every field of want. Fields the
+// API does not echo back (passwords, write-only settings) are skipped; a
+// list matches when it has the same length and each wanted element matches
+// some fetched element.
+func reflectsWrite(want, got any) bool {
+	switch wantValue := want.(type) {
+	case map[string]any:
+		gotMap, ok := got.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		for key, value := range wantValue {
+			gotField, present := gotMap[key]
+			if !present {
+				continue
+			}
+
+			if !reflectsWrite(value, gotField) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		gotList, ok := got.([]any)
+		if !ok || len(gotList) != len(wantValue) {
+			return false
+		}
+
+		for _, element := range wantValue {
+			matched := false
+
+			for _, candidate := range gotList {
+				if reflectsWrite(element, candidate) {
+					matched = true
+
+					break
+				}
+			}
+
+			if !matched {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(want, got)
+	}
+}
+
+// hasIDSegment reports whether endpoint names an existing resource, which
+// marks a POST to it as an action rather than a create.
+func hasIDSegment(endpoint string) bool {
+	for segment := range strings.SplitSeq(strings.Trim(endpoint, "/"), "/") {
+		if _, err := strconv.ParseInt(segment, 10, 64); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNotFound(err error) bool {
+	var apiErr *linode.APIError
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,173 @@
+package tools_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// readAfterWriteServer accepts a PUT and a DELETE on volume 9. GETs of the
+// volume show the old label for the first staleReads reads and then the new
+// one; once deleted, the volume answers 404 only after staleReads more reads.
+func readAfterWriteServer(t *testing.T, staleReads int32) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var (
+		reads   atomic.Int32
+		deleted atomic.Bool
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		body := `{"id":9,"label":"data-new","size":20}`
+
+		switch r.Method {
+		case http.MethodDelete:
+			deleted.Store(true)
+
+			body = `{}`
+		case http.MethodGet:
+			stale := reads.Add(1) <= staleReads
+
+			switch {
+			case deleted.Load() && !stale:
+				w.WriteHeader(http.StatusNotFound)
+
+				body = `{"errors":[{"reason":"Not found"}]}`
+			case stale && !deleted.Load():
+				body = `{"id":9,"label":"data","size":20}`
+			}
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &reads
+}
+
+func TestAwaitReadAfterWrite(t *testing.T) {
+	t.Parallel()
+
+	check := tools.ReadAfterWrite{Attempts: 3, Interval: time.Millisecond}
+
+	tests := []struct {
+		name        string
+		staleReads  int32
+		args        map[string]any
+		newTool     func(*config.Config) (mcp.Tool, profiles.Capability, func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error))
+		wantReads   int32
+		wantWarning string
+	}{
+		{
+			name:       "update visible after a stale read",
+			staleReads: 1,
+			args:       map[string]any{"volume_id": float64(9), "label": "data-new", "confirm": true},
+			newTool:    tools.NewLinodeVolumeUpdateTool,
+			wantReads:  2,
+		},
+		{
+			name:        "update never visible",
+			staleReads:  10,
+			args:        map[string]any{"volume_id": float64(9), "label": "data-new", "confirm": true},
+			newTool:     tools.NewLinodeVolumeUpdateTool,
+			wantReads:   3,
+			wantWarning: "read_after_write: PUT /volumes/9 still showed the old state after 3 reads",
+		},
+		{
+			name:       "delete visible once the volume is gone",
+			staleReads: 2,
+			args:       map[string]any{"volume_id": float64(9), "confirm": true, "confirm_bypass_dry_run": true},
+			newTool:    tools.NewLinodeVolumeDeleteTool,
+			wantReads:  3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv, reads := readAfterWriteServer(t, tt.staleReads)
+			cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+			_, _, handler := tt.newTool(cfg)
+
+			ctx := linode.WithWriteLog(tools.WithWarnings(t.Context()))
+			req := createRequestWithArgs(t, tt.args)
+
+			result, err := handler(ctx, req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			tools.AwaitReadAfterWrite(ctx, &req, cfg, check, result)
+			tools.FinalizeEnvelope(ctx, result, &req, time.Now())
+
+			if got := reads.Load(); got != tt.wantReads {
+				t.Errorf("reads = %d, want %d", got, tt.wantReads)
+			}
+
+			warnings := strings.Join(tools.EnvelopeFromResult(result).Warnings, "\n")
+			if tt.wantWarning == "" && warnings != "" {
+				t.Errorf("warnings = %q, want none", warnings)
+			}
+
+			if !strings.Contains(warnings, tt.wantWarning) {
+				t.Errorf("warnings = %q, want it to contain %q", warnings, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestResolveReadAfterWrite(t *testing.T) {
+	t.Parallel()
+
+	attempts, interval := 4, 250
+	settings := config.ReadAfterWriteConfig{Enabled: true, Attempts: &attempts, IntervalMS: &interval}
+	enabled := tools.ReadAfterWrite{Attempts: 4, Interval: 250 * time.Millisecond}
+
+	tests := []struct {
+		name       string
+		settings   config.ReadAfterWriteConfig
+		capability profiles.Capability
+		args       map[string]any
+		want       tools.ReadAfterWrite
+	}{
+		{name: "enabled write", settings: settings, capability: profiles.CapWrite, args: map[string]any{}, want: enabled},
+		{name: "read tool", settings: settings, capability: profiles.CapRead, args: map[string]any{}},
+		{name: "dry run", settings: settings, capability: profiles.CapWrite, args: map[string]any{"dry_run": true}},
+		{name: "per-call off", settings: settings, capability: profiles.CapWrite, args: map[string]any{tools.ParamReadAfterWrite: false}},
+		{
+			name:       "per-call on uses defaults",
+			capability: profiles.CapDestroy,
+			args:       map[string]any{tools.ParamReadAfterWrite: true},
+			want:       tools.ReadAfterWrite{Attempts: config.DefaultReadAfterWriteAttempts, Interval: config.DefaultReadAfterWriteIntervalMS * time.Millisecond},
+		},
+		{name: "non-boolean ignored", settings: settings, capability: profiles.CapWrite, args: map[string]any{tools.ParamReadAfterWrite: "yes"}, want: enabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := createRequestWithArgs(t, tt.args)
+
+			if got := tools.ResolveReadAfterWrite(t.Context(), tt.settings, tt.capability, &req); got != tt.want {
+				t.Errorf("ResolveReadAfterWrite() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
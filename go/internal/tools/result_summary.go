@@ -0,0 +1,142 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/resultstore"
+)
+
+// resultSummarySystemPrompt tells the client's model what a summary is for.
+// The Python server sends the same text.
+const resultSummarySystemPrompt = "You summarize JSON results from Linode API tools for an agent that manages a Linode account. " +
+	"Keep every resource ID, label, status, and region, give counts, and call out anything failed, " +
+	"degraded, or unusual. Reply with the summary only."
+
+// Sampler asks the client's model for a completion through an MCP sampling
+// request and returns the text it wrote. The server builds one for each call
+// whose client declared the sampling capability.
+type Sampler func(ctx context.Context, systemPrompt, text string, maxTokens int) (string, error)
+
+type resultStoreCtxKey struct{}
+
+// WithResultStore attaches the server's result store to a context so
+// ApplyResultSummary can save full results and linode_result_get can read
+// them back. The server middleware sets it on every call.
+func WithResultStore(ctx context.Context, store *resultstore.Store) context.Context {
+	return context.WithValue(ctx, resultStoreCtxKey{}, store)
+}
+
+// resultStoreFromContext returns the result store the server attached, or nil.
+func resultStoreFromContext(ctx context.Context) *resultstore.Store {
+	store, _ := ctx.Value(resultStoreCtxKey{}).(*resultstore.Store)
+
+	return store
+}
+
+// ResultSummary is the result summary resolved for one call. A nil sampler
+// means the result is returned in full.
+type ResultSummary struct {
+	sampler        Sampler
+	thresholdBytes int
+	maxTokens      int
+}
+
+// ResolveResultSummary returns the result summary for a call. It is off when
+// result_summary is disabled, when the client cannot sample (sampler is nil),
+// and for meta tools, whose results are local state; linode_result_get is
+// one of them, so a full result is never summarized again.
+func ResolveResultSummary(settings config.ResultSummaryConfig, capability profiles.Capability, sampler Sampler) ResultSummary {
+	if !settings.Enabled || sampler == nil || capability == profiles.CapMeta {
+		return ResultSummary{}
+	}
+
+	summary := ResultSummary{
+		sampler:        sampler,
+		thresholdBytes: settings.ThresholdBytes,
+		maxTokens:      settings.MaxTokens,
+	}
+
+	if summary.thresholdBytes == 0 {
+		summary.thresholdBytes = config.DefaultResultSummaryThresholdBytes
+	}
+
+	if summary.maxTokens == 0 {
+		summary.maxTokens = config.DefaultResultSummaryMaxTokens
+	}
+
+	return summary
+}
+
+// ApplyResultSummary replaces a successful result whose text is larger than
+// the threshold with a summary from the client's model, followed by a note
+// naming the result_id linode_result_get returns the full result under. The
+// result keeps its _meta. If the result cannot be stored or the sampling
+// request fails, the full result is returned with a warning.
+func ApplyResultSummary(ctx context.Context, summary ResultSummary, toolName string, result *mcp.CallToolResult) {
+	if summary.sampler == nil || result == nil || result.IsError {
+		return
+	}
+
+	content, ok := resultTexts(result)
+	if !ok {
+		return
+	}
+
+	size := 0
+	for _, text := range content {
+		size += len(text)
+	}
+
+	if size <= summary.thresholdBytes {
+		return
+	}
+
+	store := resultStoreFromContext(ctx)
+	if store == nil {
+		return
+	}
+
+	partition, err := storePartition(ctx)
+	if err != nil {
+		AddWarning(ctx, "result was not summarized: %v", err)
+
+		return
+	}
+
+	id, err := store.Put(partition, toolName, content)
+	if err != nil {
+		AddWarning(ctx, "result was not summarized: %v", err)
+
+		return
+	}
+
+	text, err := summary.sampler(ctx, resultSummarySystemPrompt, resultSummaryPrompt(toolName, content), summary.maxTokens)
+	if err != nil {
+		AddWarning(ctx, "result was not summarized: %v", err)
+
+		return
+	}
+
+	result.Content = []mcp.Content{
+		mcp.NewTextContent(text),
+		mcp.NewTextContent(resultSummaryNote(size, id)),
+	}
+}
+
+// resultSummaryPrompt is the user message of the sampling request: the tool
+// name and the result's text items.
+func resultSummaryPrompt(toolName string, content []string) string {
+	return "Summarize this " + toolName + " result:\n\n" + strings.Join(content, "\n\n")
+}
+
+// resultSummaryNote closes a summarized result.
+func resultSummaryNote(size int, id string) string {
+	return fmt.Sprintf("Note: this is a summary of a %d-byte result. "+
+		"Call linode_result_get with result_id %q for the full result.", size, id)
+}
@@ -0,0 +1,205 @@
+package tools_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/profiles"
+	"github.com/chadit/LinodeMCP/go/internal/resultstore"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+var errSamplingRefused = errors.New("user rejected sampling request")
+
+var resultIDPattern = regexp.MustCompile(`result_id "(result_[0-9a-f-]+)"`)
+
+func TestApplyResultSummaryReplacesLargeResult(t *testing.T) {
+	t.Parallel()
+
+	ctx := tools.WithResultStore(t.Context(), resultstore.NewStore())
+	full := `{"instances":[` + strings.Repeat(`{"id":1,"label":"web"},`, 20) + `{"id":2}]}`
+
+	var prompt string
+
+	sampler := func(_ context.Context, _, text string, maxTokens int) (string, error) {
+		prompt = text
+
+		if maxTokens != config.DefaultResultSummaryMaxTokens {
+			t.Errorf("maxTokens = %d, want %d", maxTokens, config.DefaultResultSummaryMaxTokens)
+		}
+
+		return "21 instances, all running.", nil
+	}
+	summary := tools.ResolveResultSummary(config.ResultSummaryConfig{Enabled: true, ThresholdBytes: 64}, profiles.CapRead, sampler)
+
+	result := mcp.NewToolResultText(full)
+	tools.ApplyResultSummary(ctx, summary, "linode_instance_list", result)
+
+	if !strings.Contains(prompt, full) {
+		t.Errorf("sampling prompt = %q, want it to carry the full result", prompt)
+	}
+
+	if len(result.Content) != 2 {
+		t.Fatalf("content = %+v, want the summary and a note", result.Content)
+	}
+
+	if text := result.Content[0].(mcp.TextContent).Text; text != "21 instances, all running." {
+		t.Errorf("summary = %q", text)
+	}
+
+	match := resultIDPattern.FindStringSubmatch(result.Content[1].(mcp.TextContent).Text)
+	if match == nil {
+		t.Fatalf("note = %q, want a result_id", result.Content[1].(mcp.TextContent).Text)
+	}
+
+	_, _, handler := tools.NewLinodeResultGetTool(nil)
+
+	got, err := handler(ctx, createRequestWithArgs(t, map[string]any{"result_id": match[1]}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text := got.Content[0].(mcp.TextContent).Text
+	if got.IsError || !strings.Contains(text, `"tool": "linode_instance_list"`) || !strings.Contains(text, `"label": "web"`) {
+		t.Errorf("linode_result_get = %s, want the full parsed result", text)
+	}
+}
+
+func TestApplyResultSummaryKeepsResult(t *testing.T) {
+	t.Parallel()
+
+	sampler := func(context.Context, string, string, int) (string, error) {
+		return "", errSamplingRefused
+	}
+	large := strings.Repeat("x", 128)
+
+	tests := []struct {
+		name       string
+		settings   config.ResultSummaryConfig
+		capability profiles.Capability
+		result     *mcp.CallToolResult
+		warning    string
+	}{
+		{
+			name:       "disabled",
+			settings:   config.ResultSummaryConfig{ThresholdBytes: 64},
+			capability: profiles.CapRead,
+			result:     mcp.NewToolResultText(large),
+		},
+		{
+			name:       "under threshold",
+			settings:   config.ResultSummaryConfig{Enabled: true},
+			capability: profiles.CapRead,
+			result:     mcp.NewToolResultText(large),
+		},
+		{
+			name:       "meta tool",
+			settings:   config.ResultSummaryConfig{Enabled: true, ThresholdBytes: 64},
+			capability: profiles.CapMeta,
+			result:     mcp.NewToolResultText(large),
+		},
+		{
+			name:       "error result",
+			settings:   config.ResultSummaryConfig{Enabled: true, ThresholdBytes: 64},
+			capability: profiles.CapRead,
+			result:     mcp.NewToolResultError(large),
+		},
+		{
+			name:       "sampling fails",
+			settings:   config.ResultSummaryConfig{Enabled: true, ThresholdBytes: 64},
+			capability: profiles.CapRead,
+			result:     mcp.NewToolResultText(large),
+			warning:    "result was not summarized: " + errSamplingRefused.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := tools.WithWarnings(tools.WithResultStore(t.Context(), resultstore.NewStore()))
+			tools.ApplyResultSummary(ctx, tools.ResolveResultSummary(tt.settings, tt.capability, sampler), "linode_instance_list", tt.result)
+
+			if len(tt.result.Content) != 1 || tt.result.Content[0].(mcp.TextContent).Text != large {
+				t.Errorf("content = %+v, want the full result", tt.result.Content)
+			}
+
+			tools.FinalizeEnvelope(ctx, tt.result, &mcp.CallToolRequest{}, time.Now())
+
+			warnings := tools.EnvelopeFromResult(tt.result).Warnings
+			if tt.warning == "" && len(warnings) != 0 || tt.warning != "" && (len(warnings) != 1 || warnings[0] != tt.warning) {
+				t.Errorf("warnings = %v, want %q", warnings, tt.warning)
+			}
+		})
+	}
+}
+
+func TestLinodeResultGetUnknownID(t *testing.T) {
+	t.Parallel()
+
+	ctx := tools.WithResultStore(t.Context(), resultstore.NewStore())
+	_, _, handler := tools.NewLinodeResultGetTool(nil)
+
+	result, err := handler(ctx, createRequestWithArgs(t, map[string]any{"result_id": "result_missing"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "result result_missing was not found") {
+		t.Errorf("result = %+v, want a not-found error", result)
+	}
+}
+
+// TestLinodeResultGetKeepsTenantsApart verifies that under token passthrough
+// a result one token's call stored is not found for another token.
+func TestLinodeResultGetKeepsTenantsApart(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{Server: config.ServerConfig{TokenPassthrough: true}}
+	store := resultstore.NewStore()
+	sampler := func(context.Context, string, string, int) (string, error) { return "summary", nil }
+	summary := tools.ResolveResultSummary(config.ResultSummaryConfig{Enabled: true, ThresholdBytes: 8}, profiles.CapRead, sampler)
+
+	tenantRequest := func(token string) mcp.CallToolRequest {
+		request := createRequestWithArgs(t, map[string]any{})
+		request.Header = http.Header{tools.TokenHeader: {token}}
+
+		return request
+	}
+
+	alice := tenantRequest("alice-token")
+	aliceCtx := tools.WithTokenPartition(tools.WithResultStore(t.Context(), store), cfg, &alice)
+
+	result := mcp.NewToolResultText(`{"instances":[{"label":"alice-prod"}]}`)
+	tools.ApplyResultSummary(aliceCtx, summary, "linode_instance_list", result)
+
+	match := resultIDPattern.FindStringSubmatch(result.Content[len(result.Content)-1].(mcp.TextContent).Text)
+	if match == nil {
+		t.Fatalf("content = %+v, want a note naming a result_id", result.Content)
+	}
+
+	_, _, handler := tools.NewLinodeResultGetTool(cfg)
+
+	for token, wantFound := range map[string]bool{"alice-token": true, "bob-token": false} {
+		request := tenantRequest(token)
+		request.Params.Arguments = map[string]any{"result_id": match[1]}
+		ctx := tools.WithTokenPartition(tools.WithResultStore(t.Context(), store), cfg, &request)
+
+		got, err := handler(ctx, request)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if found := !got.IsError && strings.Contains(got.Content[0].(mcp.TextContent).Text, "alice-prod"); found != wantFound {
+			t.Errorf("%s found the result = %v, want %v", token, found, wantFound)
+		}
+	}
+}
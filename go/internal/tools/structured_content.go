@@ -0,0 +1,63 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// structuredResultURIPrefix starts the URI of a result's embedded resource,
+// which is completed with the tool name and the call's correlation ID. The
+// server does not serve these URIs; they only name the result.
+const structuredResultURIPrefix = "linodemcp://results/"
+
+// ApplyStructuredContent gives a successful result whose content is a single
+// JSON object text a machine-readable copy of it: the object as the result's
+// structuredContent and, with EmbeddedResource set, an application/json
+// embedded resource after the text. The text stays first and unchanged, so a
+// client that reads only text sees what it always did. Errors, and results
+// whose text is not a JSON object (a summary, a plain message), are left
+// alone.
+func ApplyStructuredContent(ctx context.Context, settings config.StructuredContentConfig, toolName string, result *mcp.CallToolResult) {
+	if settings.Enabled != nil && !*settings.Enabled {
+		return
+	}
+
+	if result == nil || result.IsError || len(result.Content) != 1 {
+		return
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		return
+	}
+
+	// The text is passed through as raw JSON rather than decoded, so the
+	// structured copy keeps the text's exact numbers and field order.
+	raw := []byte(strings.TrimSpace(text.Text))
+	if len(raw) == 0 || raw[0] != '{' || !json.Valid(raw) {
+		return
+	}
+
+	result.StructuredContent = json.RawMessage(raw)
+
+	if !settings.EmbeddedResource {
+		return
+	}
+
+	uri := structuredResultURIPrefix + toolName
+	if id := linode.CorrelationIDFromContext(ctx); id != "" {
+		uri += "/" + id
+	}
+
+	result.Content = append(result.Content, mcp.NewEmbeddedResource(mcp.TextResourceContents{
+		URI:      uri,
+		MIMEType: "application/json",
+		Text:     text.Text,
+	}))
+}
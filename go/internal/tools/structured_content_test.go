@@ -0,0 +1,84 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestApplyStructuredContent(t *testing.T) {
+	t.Parallel()
+
+	disabled := false
+	object := `{"count":1,"instances":[{"id":123456789012,"label":"web"}]}`
+
+	tests := []struct {
+		name           string
+		settings       config.StructuredContentConfig
+		result         *mcp.CallToolResult
+		wantStructured string
+		wantContent    int
+	}{
+		{name: "json object", result: mcp.NewToolResultText(object), wantStructured: object, wantContent: 1},
+		{
+			name:     "embedded resource",
+			settings: config.StructuredContentConfig{EmbeddedResource: true},
+			result:   mcp.NewToolResultText(object), wantStructured: object, wantContent: 2,
+		},
+		{
+			name:     "disabled",
+			settings: config.StructuredContentConfig{Enabled: &disabled, EmbeddedResource: true},
+			result:   mcp.NewToolResultText(object), wantContent: 1,
+		},
+		{name: "error", result: mcp.NewToolResultError(`{"reason":"nope"}`), wantContent: 1},
+		{name: "plain text", result: mcp.NewToolResultText("Instance 42 rebooted"), wantContent: 1},
+		{name: "json array", result: mcp.NewToolResultText(`[1,2]`), wantContent: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := linode.WithCorrelationID(t.Context(), "evt_1")
+			tools.ApplyStructuredContent(ctx, tt.settings, "linode_instances_list", tt.result)
+
+			var structured string
+			if tt.result.StructuredContent != nil {
+				raw, err := json.Marshal(tt.result.StructuredContent)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				structured = string(raw)
+			}
+
+			if structured != tt.wantStructured {
+				t.Errorf("structuredContent = %s, want %s", structured, tt.wantStructured)
+			}
+
+			if len(tt.result.Content) != tt.wantContent {
+				t.Fatalf("content has %d items, want %d", len(tt.result.Content), tt.wantContent)
+			}
+
+			if tt.wantContent < 2 {
+				return
+			}
+
+			embedded, ok := tt.result.Content[1].(mcp.EmbeddedResource)
+			if !ok {
+				t.Fatalf("content[1] = %T, want an embedded resource", tt.result.Content[1])
+			}
+
+			resource, ok := embedded.Resource.(mcp.TextResourceContents)
+			if !ok || resource.MIMEType != "application/json" || resource.Text != object ||
+				resource.URI != "linodemcp://results/linode_instances_list/evt_1" {
+				t.Errorf("resource = %+v, want the JSON text under the call's URI", embedded.Resource)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// passthroughConfig points the default environment at apiURL with no token
+// and turns on server.tokenPassthrough.
+func passthroughConfig(apiURL string) *config.Config {
+	return &config.Config{
+		Server: config.ServerConfig{TokenPassthrough: true},
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: apiURL}},
+		},
+	}
+}
+
+// TestTokenPassthroughUsesRequestHeader checks each call authenticates with
+// the token from its own X-Linode-Token header, so two callers sharing the
+// server never see each other's credentials.
+func TestTokenPassthroughUsesRequestHeader(t *testing.T) {
+	t.Parallel()
+
+	seen := make(chan string, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen <- r.Header.Get("Authorization")
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"username": "user"})
+	}))
+	t.Cleanup(srv.Close)
+
+	_, _, handler := tools.NewLinodeProfileTool(passthroughConfig(srv.URL))
+
+	for _, token := range []string{"alice-token", "bob-token"} {
+		request := createRequestWithArgs(t, map[string]any{})
+		request.Header = http.Header{}
+		request.Header.Set(tools.TokenHeader, token)
+
+		result, err := handler(t.Context(), request)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if result.IsError {
+			t.Fatalf("result.IsError = true, want false: %v", result.Content)
+		}
+
+		if got := <-seen; got != "Bearer "+token {
+			t.Errorf("Authorization = %q, want %q", got, "Bearer "+token)
+		}
+	}
+}
+
+func TestTokenPassthroughRequiresHeader(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		t.Error("Linode API called without a passthrough token")
+	}))
+	t.Cleanup(srv.Close)
+
+	_, _, handler := tools.NewLinodeProfileTool(passthroughConfig(srv.URL))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	if !result.IsError || !strings.Contains(textContent.Text, tools.TokenHeader) {
+		t.Errorf("result = %q, want an error naming %s", textContent.Text, tools.TokenHeader)
+	}
+}
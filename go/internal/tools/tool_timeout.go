@@ -0,0 +1,108 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// ParamTimeoutSeconds is the per-call argument that sets the call's timeout,
+// overriding tool_timeouts and clamped to tool_timeouts.max_seconds. The
+// dispatch middleware reads it for every tool, so AddToolTimeoutSchema adds
+// it to every tool's input schema rather than each proto declaring it.
+const ParamTimeoutSeconds = "timeout_seconds"
+
+// toolTimeoutProperties are the schema entries AddToolTimeoutSchema adds.
+var toolTimeoutProperties = map[string]any{
+	ParamTimeoutSeconds: map[string]any{
+		"type":        "integer",
+		"minimum":     1,
+		"description": "Seconds this call may run before it fails, overriding tool_timeouts; clamped to tool_timeouts.max_seconds.",
+	},
+}
+
+// AddToolTimeoutSchema adds the timeout_seconds argument to tool's input
+// schema. A schema that cannot be read is left unchanged.
+func AddToolTimeoutSchema(tool *mcp.Tool) {
+	addSchemaProperties(tool, toolTimeoutProperties)
+}
+
+// ResolveToolTimeout returns the timeout for one call of toolName: the call's
+// timeout_seconds argument, else the tool's tool_timeouts.tools entry, else
+// tool_timeouts.default_seconds. Zero means the call has no timeout of its
+// own. An argument above the configured maximum is clamped with a warning,
+// and one that is not a positive whole number is ignored with a warning.
+func ResolveToolTimeout(
+	ctx context.Context, settings config.ToolTimeoutConfig, toolName string, request *mcp.CallToolRequest,
+) time.Duration {
+	seconds := settings.DefaultSeconds
+	if override, ok := settings.Tools[toolName]; ok {
+		seconds = override
+	}
+
+	raw, ok := request.GetArguments()[ParamTimeoutSeconds]
+	if !ok {
+		return time.Duration(seconds) * time.Second
+	}
+
+	requested, isNumber := raw.(float64)
+	if !isNumber || requested < 1 || requested != math.Trunc(requested) {
+		AddWarning(ctx, "%s must be a positive whole number of seconds and was ignored", ParamTimeoutSeconds)
+
+		return time.Duration(seconds) * time.Second
+	}
+
+	limit := settings.MaxSeconds
+	if limit == 0 {
+		limit = config.DefaultToolTimeoutMaxSeconds
+	}
+
+	if requested > float64(limit) {
+		AddWarning(ctx, "%s %g is above the %d-second limit (tool_timeouts.max_seconds); using %d",
+			ParamTimeoutSeconds, requested, limit, limit)
+
+		return time.Duration(limit) * time.Second
+	}
+
+	return time.Duration(requested) * time.Second
+}
+
+// WithToolTimeout bounds a call by timeout: the returned context expires when
+// it passes, and each API request the call makes may take up to timeout
+// instead of the default 30 seconds. A zero timeout leaves ctx unbounded.
+func WithToolTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	ctx = linode.WithRequestTimeout(ctx, timeout)
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// ApplyToolTimeout replaces the failure of a call whose timeout expired with
+// an error that names the timeout and how to raise it, rather than whatever
+// the interrupted API request reported. ctx is the context WithToolTimeout
+// returned. A call that succeeded before the deadline is left alone.
+func ApplyToolTimeout(
+	ctx context.Context, toolName string, timeout time.Duration, result *mcp.CallToolResult, err error,
+) (*mcp.CallToolResult, error) {
+	if timeout <= 0 || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return result, err
+	}
+
+	if err == nil && (result == nil || !result.IsError) {
+		return result, err
+	}
+
+	return mcp.NewToolResultError(fmt.Sprintf(
+		"%s did not finish within its %s timeout; pass %s or set tool_timeouts.tools.%s to allow longer",
+		toolName, timeout, ParamTimeoutSeconds, toolName)), nil
+}
@@ -0,0 +1,126 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+func TestResolveToolTimeout(t *testing.T) {
+	t.Parallel()
+
+	settings := config.ToolTimeoutConfig{
+		DefaultSeconds: 20,
+		MaxSeconds:     600,
+		Tools:          map[string]int{"linode_lke_cluster_create": 300},
+	}
+
+	tests := []struct {
+		name     string
+		settings config.ToolTimeoutConfig
+		tool     string
+		args     map[string]any
+		want     time.Duration
+	}{
+		{name: "no config", tool: "linode_instances_list", args: map[string]any{}},
+		{name: "default", settings: settings, tool: "linode_instances_list", args: map[string]any{}, want: 20 * time.Second},
+		{name: "per tool", settings: settings, tool: "linode_lke_cluster_create", args: map[string]any{}, want: 300 * time.Second},
+		{
+			name: "argument wins", settings: settings, tool: "linode_lke_cluster_create",
+			args: map[string]any{tools.ParamTimeoutSeconds: float64(5)}, want: 5 * time.Second,
+		},
+		{
+			name: "argument clamped", settings: settings, tool: "linode_instances_list",
+			args: map[string]any{tools.ParamTimeoutSeconds: float64(3600)}, want: 600 * time.Second,
+		},
+		{
+			name: "argument clamped to built-in max", tool: "linode_instances_list",
+			args: map[string]any{tools.ParamTimeoutSeconds: float64(7200)}, want: config.DefaultToolTimeoutMaxSeconds * time.Second,
+		},
+		{
+			name: "fractional argument ignored", settings: settings, tool: "linode_instances_list",
+			args: map[string]any{tools.ParamTimeoutSeconds: 1.5}, want: 20 * time.Second,
+		},
+		{
+			name: "non-number argument ignored", settings: settings, tool: "linode_instances_list",
+			args: map[string]any{tools.ParamTimeoutSeconds: "60"}, want: 20 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := createRequestWithArgs(t, tt.args)
+
+			if got := tools.ResolveToolTimeout(t.Context(), tt.settings, tt.tool, &req); got != tt.want {
+				t.Errorf("ResolveToolTimeout() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// A call that fails because its timeout passed reports the timeout; one that
+// succeeded, or that has no timeout, is left alone.
+func TestApplyToolTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := tools.WithToolTimeout(t.Context(), time.Millisecond)
+	defer cancel()
+
+	<-ctx.Done()
+
+	if got := linode.RequestTimeoutFromContext(ctx); got != time.Millisecond {
+		t.Errorf("request timeout = %s, want the call's 1ms", got)
+	}
+
+	failed := mcp.NewToolResultError("Get \"https://api.linode.com/v4/lke/clusters\": context deadline exceeded")
+
+	result, err := tools.ApplyToolTimeout(ctx, "linode_lke_cluster_create", time.Millisecond, failed, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, _ := result.Content[0].(mcp.TextContent)
+	if !result.IsError || !strings.Contains(text.Text, "did not finish within its 1ms timeout") ||
+		!strings.Contains(text.Text, "tool_timeouts.tools.linode_lke_cluster_create") {
+		t.Errorf("result = %q, want the timeout named with how to raise it", text.Text)
+	}
+
+	succeeded := mcp.NewToolResultText("{}")
+	if got, _ := tools.ApplyToolTimeout(ctx, "linode_lke_cluster_create", time.Millisecond, succeeded, nil); got != succeeded {
+		t.Error("a successful result was replaced")
+	}
+
+	if got, _ := tools.ApplyToolTimeout(t.Context(), "linode_lke_cluster_create", 0, failed, nil); got != failed {
+		t.Error("a failure without a timeout was replaced")
+	}
+}
+
+func TestAddToolTimeoutSchema(t *testing.T) {
+	t.Parallel()
+
+	tool, _, _ := tools.NewLinodeVolumeGetTool(newTestConfig("https://api.linode.com/v4"))
+	tools.AddToolTimeoutSchema(&tool)
+
+	var schema struct {
+		Properties map[string]any `json:"properties"`
+	}
+
+	if err := json.Unmarshal(tool.RawInputSchema, &schema); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, name := range []string{tools.ParamTimeoutSeconds, "volume_id"} {
+		if _, ok := schema.Properties[name]; !ok {
+			t.Errorf("schema is missing %q", name)
+		}
+	}
+}
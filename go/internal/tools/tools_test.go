@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"testing"
@@ -14295,6 +14296,96 @@ func TestLinodeTypesListToolFilterByClass(t *testing.T) {
 	}
 }
 
+func TestLinodeTypesListToolGPUInRegion(t *testing.T) {
+	t.Parallel()
+
+	types := []linode.InstanceType{
+		{ID: typeG6Standard2, Class: classStandard, VCPUs: 2, Memory: 4096},
+		{ID: "g1-gpu-rtx6000-1", Class: "gpu", VCPUs: 8, Memory: 32768, GPUs: 1},
+		{ID: "g1-gpu-rtx6000-2", Class: "gpu", VCPUs: 16, Memory: 65536, GPUs: 2},
+		{ID: "g2-gpu-rtx4000a1-s", Class: "gpu", VCPUs: 4, Memory: 16384, GPUs: 1},
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body any = map[string]any{keyData: types, keyPage: 1, keyPages: 1, keyResults: len(types)}
+		if r.URL.Path == "/regions/us-east/availability" {
+			body = []map[string]any{
+				{"region": "us-east", "plan": "g1-gpu-rtx6000-1", "available": true},
+				{"region": "us-east", "plan": "g1-gpu-rtx6000-2", "available": false},
+			}
+		}
+
+		if err := json.NewEncoder(w).Encode(body); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	cfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+	_, _, handler := tools.NewLinodeTypeListTool(cfg)
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		"class": "GPU", "min_vcpus": float64(8), "min_memory": float64(32768), keyRegion: "us-east",
+	}))
+	if err != nil || result == nil || result.IsError {
+		t.Fatalf("handler = %+v, %v, want a result", result, err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	var decoded struct {
+		Filter string `json:"filter"`
+		Types  []struct {
+			ID string `json:"id"`
+		} `json:"types"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	if len(decoded.Types) != 1 || decoded.Types[0].ID != "g1-gpu-rtx6000-1" {
+		t.Errorf("types = %+v, want only g1-gpu-rtx6000-1", decoded.Types)
+	}
+
+	if want := "class=GPU, min_vcpus=8, min_memory=32768, region=us-east"; decoded.Filter != want {
+		t.Errorf("filter = %q, want %q", decoded.Filter, want)
+	}
+}
+
+func TestLinodeTypesListToolRejectsInvalidFilters(t *testing.T) {
+	t.Parallel()
+
+	_, _, handler := tools.NewLinodeTypeListTool(&config.Config{})
+
+	for _, tc := range []struct {
+		args map[string]any
+		want string
+	}{
+		{map[string]any{"class": "gpus"}, "class must be one of nanode, standard, dedicated, highmem, premium, gpu"},
+		{map[string]any{"min_vcpus": float64(0)}, "min_vcpus must be an integer greater than or equal to 1"},
+		{map[string]any{"min_memory": 1.5}, "min_memory must be an integer"},
+		{map[string]any{keyRegion: "US East"}, "region must contain only lowercase letters, numbers, and hyphens"},
+	} {
+		result, err := handler(t.Context(), createRequestWithArgs(t, tc.args))
+		if err != nil || result == nil || !result.IsError {
+			t.Fatalf("%v: handler = %+v, %v, want a tool error", tc.args, result, err)
+		}
+
+		if text := result.Content[0].(mcp.TextContent).Text; text != tc.want {
+			t.Errorf("%v: error = %q, want %q", tc.args, text, tc.want)
+		}
+	}
+}
+
 // End-to-end verification of volume type listing.
 func TestLinodeVolumeTypesListToolDefinition(t *testing.T) {
 	t.Parallel()
@@ -14884,6 +14975,73 @@ func TestLinodeImagesListToolFilterByDeprecated(t *testing.T) {
 	}
 }
 
+func TestLinodeImagesListToolLatestPerDistro(t *testing.T) {
+	t.Parallel()
+
+	images := []linode.Image{
+		{ID: "linode/ubuntu22.04", Label: "Ubuntu 22.04 LTS", Vendor: "Ubuntu", Created: "2022-04-21T00:00:00", IsPublic: true},
+		{ID: "linode/ubuntu24.04", Label: "Ubuntu 24.04 LTS", Vendor: "Ubuntu", Created: "2024-04-25T00:00:00", IsPublic: true},
+		{ID: "linode/debian12", Label: "Debian 12", Vendor: "Debian", Created: "2023-06-12T00:00:00", IsPublic: true},
+		{ID: "linode/debian11", Label: "Debian 11", Vendor: "Debian", Created: "2021-08-16T00:00:00", IsPublic: true},
+		{ID: "private/123", Label: "golden", Created: "2025-01-01T00:00:00", Type: "manual"},
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if err := json.NewEncoder(w).Encode(map[string]any{keyData: images, keyPage: 1, keyPages: 1, keyResults: len(images)}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	cfg := &config.Config{
+		Environments: map[string]config.EnvironmentConfig{
+			envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}},
+		},
+	}
+	_, _, handler := tools.NewLinodeImageListTool(cfg)
+
+	for _, tc := range []struct {
+		args       map[string]any
+		wantIDs    []string
+		wantFilter string
+	}{
+		{map[string]any{"latest_per_distro": boolStringTrue}, []string{"linode/ubuntu24.04", "linode/debian12"}, "latest_per_distro=true"},
+		{map[string]any{"vendor": "debian"}, []string{"linode/debian12", "linode/debian11"}, "vendor=debian"},
+		{map[string]any{"label": "lts", "latest_per_distro": boolStringTrue}, []string{"linode/ubuntu24.04"}, "label=lts, latest_per_distro=true"},
+	} {
+		result, err := handler(t.Context(), createRequestWithArgs(t, tc.args))
+		if err != nil || result == nil || result.IsError {
+			t.Fatalf("%v: handler = %+v, %v, want a result", tc.args, result, err)
+		}
+
+		textContent, ok := result.Content[0].(mcp.TextContent)
+		if !ok {
+			t.Fatal("ok = false, want true")
+		}
+
+		var decoded struct {
+			Filter string `json:"filter"`
+			Images []struct {
+				ID string `json:"id"`
+			} `json:"images"`
+		}
+		if err := json.Unmarshal([]byte(textContent.Text), &decoded); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+
+		ids := make([]string, 0, len(decoded.Images))
+		for _, img := range decoded.Images {
+			ids = append(ids, img.ID)
+		}
+
+		if !slices.Equal(ids, tc.wantIDs) || decoded.Filter != tc.wantFilter {
+			t.Errorf("%v: images = %v, filter = %q, want %v, %q", tc.args, ids, decoded.Filter, tc.wantIDs, tc.wantFilter)
+		}
+	}
+}
+
 func TestLinodeImageShareGroupTokensListToolDefinition(t *testing.T) {
 	t.Parallel()
 
@@ -3558,12 +3558,20 @@ func TestLinodeDomainRecordCreateToolSuccessfulCreation(t *testing.T) {
 			t.Errorf("r.URL.Path = %v, want %v", r.URL.Path, "/domains/111/records")
 		}
 
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodGet {
+			if _, err := w.Write([]byte(`{"data":[],"page":1,"pages":1,"results":0}`)); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			return
+		}
+
 		if r.Method != http.MethodPost {
 			t.Errorf("r.Method = %v, want %v", r.Method, http.MethodPost)
 		}
 
-		w.Header().Set("Content-Type", "application/json")
-
 		if err := json.NewEncoder(w).Encode(record); err != nil {
 			t.Errorf("unexpected error: %v", err)
 		}
@@ -3606,6 +3614,151 @@ func TestLinodeDomainRecordCreateToolSuccessfulCreation(t *testing.T) {
 	}
 }
 
+func TestLinodeDomainRecordCreateToolReportsConflicts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		recordType string
+		target     string
+		wantError  string
+	}{
+		{
+			name:       "CNAME over an A record",
+			recordType: "CNAME",
+			target:     "web.example.net",
+			wantError:  `CNAME record "www" conflicts with existing A record (ID: 7): a CNAME cannot share its name with any other record`,
+		},
+		{
+			name:       "identical A record",
+			recordType: "A",
+			target:     "8.8.8.8",
+			wantError:  `A record "www" conflicts with existing A record (ID: 7): it already points at 8.8.8.8`,
+		},
+		{
+			name:       "second A record",
+			recordType: "A",
+			target:     "8.8.4.4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var posted atomic.Bool
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				body := `{"id":8,"type":"A","name":"www","target":"8.8.4.4"}`
+				if r.Method == http.MethodGet {
+					body = `{"data":[{"id":7,"type":"A","name":"www","target":"8.8.8.8"}],"page":1,"pages":1,"results":1}`
+				} else {
+					posted.Store(true)
+				}
+
+				if _, err := w.Write([]byte(body)); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			_, _, handler := tools.NewLinodeDomainRecordCreateTool(newTestConfig(srv.URL))
+
+			result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+				keyDomainID: float64(111),
+				keyType:     tt.recordType,
+				keyName:     hostWWW,
+				keyTarget:   tt.target,
+				keyConfirm:  true,
+			}))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatal("ok = false, want true")
+			}
+
+			if tt.wantError == "" {
+				if result.IsError || !posted.Load() {
+					t.Errorf("result = %q (posted %v), want a created record", text.Text, posted.Load())
+				}
+
+				return
+			}
+
+			if !result.IsError || text.Text != tt.wantError {
+				t.Errorf("result = %q, want error %q", text.Text, tt.wantError)
+			}
+
+			if posted.Load() {
+				t.Error("the record was created despite the conflict")
+			}
+		})
+	}
+}
+
+// TestLinodeDomainRecordCreateToolReportsConflictOnLaterPage verifies the
+// conflict check reads every page of the domain's records, so a conflicting
+// record past the first page still stops the create.
+func TestLinodeDomainRecordCreateToolReportsConflictOnLaterPage(t *testing.T) {
+	t.Parallel()
+
+	var posted atomic.Bool
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body string
+
+		switch {
+		case r.Method != http.MethodGet:
+			posted.Store(true)
+
+			body = `{"id":8,"type":"CNAME","name":"www","target":"web.example.net"}`
+		case r.URL.Query().Get("page") == "2":
+			body = `{"data":[{"id":7,"type":"A","name":"www","target":"8.8.8.8"}],"page":2,"pages":2,"results":2}`
+		default:
+			body = `{"data":[{"id":6,"type":"A","name":"mail","target":"8.8.4.4"}],"page":1,"pages":2,"results":2}`
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	_, _, handler := tools.NewLinodeDomainRecordCreateTool(newTestConfig(srv.URL))
+
+	result, err := handler(t.Context(), createRequestWithArgs(t, map[string]any{
+		keyDomainID: float64(111),
+		keyType:     "CNAME",
+		keyName:     hostWWW,
+		keyTarget:   "web.example.net",
+		keyConfirm:  true,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	wantError := `CNAME record "www" conflicts with existing A record (ID: 7): a CNAME cannot share its name with any other record`
+	if !result.IsError || text.Text != wantError {
+		t.Errorf("result = %q, want error %q", text.Text, wantError)
+	}
+
+	if posted.Load() {
+		t.Error("the record was created despite the conflict")
+	}
+}
+
 // End-to-end verification of the domain record update workflow.
 func TestLinodeDomainRecordUpdateToolDefinition(t *testing.T) {
 	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{
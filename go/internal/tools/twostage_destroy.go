@@ -82,8 +82,9 @@ func runTwoStageBranch(
 	return runApply(ctx, request, cfg, action, store), true
 }
 
-// runPlan fetches the current state, hashes it, stores a single-use plan whose
-// apply callback re-runs the destroy, and returns the plan preview.
+// runPlan fetches the current state, checks confirm_label, hashes the state,
+// stores a single-use plan whose apply callback re-runs the destroy, and
+// returns the plan preview.
 func runPlan(
 	ctx context.Context,
 	request *mcp.CallToolRequest,
@@ -91,7 +92,12 @@ func runPlan(
 	action *DestructiveAction,
 	store *twostage.PlanStore,
 ) *mcp.CallToolResult {
-	client, err := prepareClient(request, cfg)
+	partition, err := storePartition(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error())
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error())
 	}
@@ -101,6 +107,10 @@ func runPlan(
 		return mcp.NewToolResultError(fmt.Sprintf("Failed to fetch state for plan: %v", fetchErr))
 	}
 
+	if result := requireConfirmLabel(ctx, request, cfg, action, state); result != nil {
+		return result
+	}
+
 	hash, fields, hashErr := stateHashAndFields(state, action.HashIgnore)
 	if hashErr != nil {
 		return mcp.NewToolResultError(hashErr.Error())
@@ -122,6 +132,7 @@ func runPlan(
 
 	store.Put(&twostage.PlanEntry{
 		ID:          planID,
+		Partition:   partition,
 		Tool:        action.ToolName,
 		Environment: env,
 		Args:        nonControlArgs(request.GetArguments()),
@@ -201,7 +212,12 @@ func classifyPlan(
 	store *twostage.PlanStore,
 	planID string,
 ) (twostage.PlanLookup, *twostage.PlanEntry, []string, *mcp.CallToolResult) {
-	entry, lookupErr := store.Get(planID)
+	partition, partitionErr := storePartition(ctx)
+	if partitionErr != nil {
+		return twostage.PlanLookupNotApplicable, nil, nil, mcp.NewToolResultError(partitionErr.Error())
+	}
+
+	entry, lookupErr := store.Get(partition, planID)
 	if errors.Is(lookupErr, twostage.ErrPlanExpired) {
 		return twostage.PlanLookupExpired, nil, nil, nil
 	}
@@ -215,7 +231,7 @@ func classifyPlan(
 		return twostage.PlanLookupArgsMismatch, entry, nil, nil
 	}
 
-	client, clientErr := prepareClient(request, cfg)
+	client, clientErr := prepareClient(ctx, request, cfg)
 	if clientErr != nil {
 		return twostage.PlanLookupNotApplicable, nil, nil, mcp.NewToolResultError(clientErr.Error())
 	}
@@ -247,7 +263,7 @@ func executeDestroy(
 	cfg *config.Config,
 	action *DestructiveAction,
 ) (*mcp.CallToolResult, error) {
-	client, err := prepareClient(request, cfg)
+	client, err := prepareClient(ctx, request, cfg)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -433,6 +449,7 @@ func nonControlArgs(args map[string]any) map[string]any {
 		paramDryRun:              {},
 		paramConfirmedDryRun:     {},
 		paramConfirmBypassDryRun: {},
+		paramConfirmLabel:        {},
 		paramYolo:                {},
 	}
 
@@ -0,0 +1,243 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/linode"
+)
+
+// volumeDefaultSizeGB is the size the API gives a volume created without one.
+const volumeDefaultSizeGB = 20
+
+// writeCoster estimates, before a write runs, how much it would change the
+// account's monthly list-price bill: positive for a create, negative for a
+// delete, the difference for a resize.
+type writeCoster func(ctx context.Context, client *linode.Client, request *mcp.CallToolRequest) (float64, error)
+
+// writeCosts are the writes cost attribution prices, keyed by tool name. They
+// are the writes that add, remove, or resize a resource with a list price;
+// any other write leaves its audit event's cost delta null.
+var writeCosts = map[string]writeCoster{
+	"linode_instance_create":     instanceCreateCost,
+	"linode_instance_delete":     instanceDeleteCost,
+	toolInstanceResize:           instanceResizeCost,
+	"linode_volume_create":       volumeCreateCost,
+	"linode_volume_delete":       volumeDeleteCost,
+	"linode_volume_resize":       volumeResizeCost,
+	"linode_nodebalancer_create": nodeBalancerCreateCost,
+	"linode_nodebalancer_delete": nodeBalancerDeleteCost,
+}
+
+// WriteCostDelta estimates the monthly cost change of one toolName call from
+// the Linode price tables, reading the resource a delete or resize targets
+// before the call changes it. The audit middleware runs it ahead of the
+// handler when audit.cost_attribution is on and records the result only if
+// the write succeeds. It reports false for a tool writeCosts does not price
+// and for a price it could not read, which is logged rather than surfaced:
+// attribution never fails the call it describes.
+func WriteCostDelta(ctx context.Context, cfg *config.Config, toolName string, request *mcp.CallToolRequest) (float64, bool) {
+	coster, ok := writeCosts[toolName]
+	if !ok {
+		return 0, false
+	}
+
+	client, err := prepareClient(ctx, request, cfg)
+	if err != nil {
+		slog.DebugContext(ctx, "write cost not attributed", "tool", toolName, "error", err.Error())
+
+		return 0, false
+	}
+
+	delta, err := coster(ctx, client, request)
+	if err != nil {
+		slog.DebugContext(ctx, "write cost not attributed", "tool", toolName, "error", err.Error())
+
+		return 0, false
+	}
+
+	return roundCents(delta), true
+}
+
+// instanceCreateCost prices the new instance's type, with the backups add-on
+// when backups_enabled is set.
+func instanceCreateCost(ctx context.Context, client *linode.Client, request *mcp.CallToolRequest) (float64, error) {
+	return instanceTypeCost(ctx, client, request.GetString("type", ""), request.GetBool("backups_enabled", false))
+}
+
+// instanceDeleteCost credits the instance's current price.
+func instanceDeleteCost(ctx context.Context, client *linode.Client, request *mcp.CallToolRequest) (float64, error) {
+	instance, err := client.GetInstanceProto(ctx, request.GetInt("instance_id", 0))
+	if err != nil {
+		return 0, fmt.Errorf("get instance: %w", err)
+	}
+
+	monthly, err := instanceTypeCost(ctx, client, instance.GetType(), instance.GetBackups().GetEnabled())
+	if err != nil {
+		return 0, err
+	}
+
+	return -monthly, nil
+}
+
+// instanceResizeCost is the new type's price less the current one, both with
+// the instance's backups setting.
+func instanceResizeCost(ctx context.Context, client *linode.Client, request *mcp.CallToolRequest) (float64, error) {
+	instance, err := client.GetInstanceProto(ctx, request.GetInt("instance_id", 0))
+	if err != nil {
+		return 0, fmt.Errorf("get instance: %w", err)
+	}
+
+	instanceTypes, err := client.ListTypesProto(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list instance types: %w", err)
+	}
+
+	prices := priceTables{instanceTypes: instanceTypesByID(instanceTypes)}
+	backups := instance.GetBackups().GetEnabled()
+
+	current, ok := prices.instanceTypeMonthly(instance.GetType(), backups)
+	if !ok {
+		return 0, fmt.Errorf("%w %q", errNoInstanceTypePrice, instance.GetType())
+	}
+
+	target, ok := prices.instanceTypeMonthly(request.GetString("type", ""), backups)
+	if !ok {
+		return 0, fmt.Errorf("%w %q", errNoInstanceTypePrice, request.GetString("type", ""))
+	}
+
+	return target - current, nil
+}
+
+// instanceTypeCost reads the instance price table and prices one instance of
+// typeID.
+func instanceTypeCost(ctx context.Context, client *linode.Client, typeID string, backups bool) (float64, error) {
+	instanceTypes, err := client.ListTypesProto(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list instance types: %w", err)
+	}
+
+	prices := priceTables{instanceTypes: instanceTypesByID(instanceTypes)}
+
+	monthly, ok := prices.instanceTypeMonthly(typeID, backups)
+	if !ok {
+		return 0, fmt.Errorf("%w %q", errNoInstanceTypePrice, typeID)
+	}
+
+	return monthly, nil
+}
+
+// volumeCreateCost prices the new volume in its region, or in the region of
+// the instance it attaches to when only linode_id is given.
+func volumeCreateCost(ctx context.Context, client *linode.Client, request *mcp.CallToolRequest) (float64, error) {
+	region := request.GetString("region", "")
+
+	if linodeID := request.GetInt("linode_id", 0); region == "" && linodeID > 0 {
+		instance, err := client.GetInstanceProto(ctx, linodeID)
+		if err != nil {
+			return 0, fmt.Errorf("get instance: %w", err)
+		}
+
+		region = instance.GetRegion()
+	}
+
+	if region == "" {
+		return 0, errNoCostRegion
+	}
+
+	prices, err := volumePrices(ctx, client)
+	if err != nil {
+		return 0, err
+	}
+
+	return prices.volumeMonthly(request.GetInt("size", volumeDefaultSizeGB), region), nil
+}
+
+// volumeDeleteCost credits the volume's current price.
+func volumeDeleteCost(ctx context.Context, client *linode.Client, request *mcp.CallToolRequest) (float64, error) {
+	volume, err := client.GetVolumeProto(ctx, request.GetInt("volume_id", 0))
+	if err != nil {
+		return 0, fmt.Errorf("get volume: %w", err)
+	}
+
+	prices, err := volumePrices(ctx, client)
+	if err != nil {
+		return 0, err
+	}
+
+	return -prices.volumeMonthly(int(volume.GetSize()), volume.GetRegion()), nil
+}
+
+// volumeResizeCost prices the gigabytes the resize adds.
+func volumeResizeCost(ctx context.Context, client *linode.Client, request *mcp.CallToolRequest) (float64, error) {
+	volume, err := client.GetVolumeProto(ctx, request.GetInt("volume_id", 0))
+	if err != nil {
+		return 0, fmt.Errorf("get volume: %w", err)
+	}
+
+	prices, err := volumePrices(ctx, client)
+	if err != nil {
+		return 0, err
+	}
+
+	current := prices.volumeMonthly(int(volume.GetSize()), volume.GetRegion())
+
+	return prices.volumeMonthly(request.GetInt("size", 0), volume.GetRegion()) - current, nil
+}
+
+// volumePrices reads the Block Storage price table.
+func volumePrices(ctx context.Context, client *linode.Client) (priceTables, error) {
+	volumeTypes, err := client.ListVolumeTypesProto(ctx)
+	if err != nil {
+		return priceTables{}, fmt.Errorf("list volume types: %w", err)
+	}
+
+	if len(volumeTypes) == 0 {
+		return priceTables{}, fmt.Errorf("volume types: %w", errNoPriceTable)
+	}
+
+	return priceTables{volume: volumeTypes[0]}, nil
+}
+
+// nodeBalancerCreateCost prices a NodeBalancer in the requested region.
+func nodeBalancerCreateCost(ctx context.Context, client *linode.Client, request *mcp.CallToolRequest) (float64, error) {
+	prices, err := nodeBalancerPrices(ctx, client)
+	if err != nil {
+		return 0, err
+	}
+
+	return prices.nodeBalancerMonthly(request.GetString("region", "")), nil
+}
+
+// nodeBalancerDeleteCost credits the NodeBalancer's price in its region.
+func nodeBalancerDeleteCost(ctx context.Context, client *linode.Client, request *mcp.CallToolRequest) (float64, error) {
+	nodeBalancer, err := client.GetNodeBalancerProto(ctx, request.GetInt("nodebalancer_id", 0))
+	if err != nil {
+		return 0, fmt.Errorf("get NodeBalancer: %w", err)
+	}
+
+	prices, err := nodeBalancerPrices(ctx, client)
+	if err != nil {
+		return 0, err
+	}
+
+	return -prices.nodeBalancerMonthly(nodeBalancer.GetRegion()), nil
+}
+
+// nodeBalancerPrices reads the NodeBalancer price table.
+func nodeBalancerPrices(ctx context.Context, client *linode.Client) (priceTables, error) {
+	nodeBalancerTypes, err := client.ListNodeBalancerTypesProto(ctx)
+	if err != nil {
+		return priceTables{}, fmt.Errorf("list NodeBalancer types: %w", err)
+	}
+
+	if len(nodeBalancerTypes) == 0 {
+		return priceTables{}, fmt.Errorf("NodeBalancer types: %w", errNoPriceTable)
+	}
+
+	return priceTables{nodeBalancer: nodeBalancerTypes[0]}, nil
+}
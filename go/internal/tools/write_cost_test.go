@@ -0,0 +1,106 @@
+package tools_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/tools"
+)
+
+// newWriteCostTestServer serves the price tables plus instance 1 (a
+// g6-standard-2 with backups in br-gru), instance 2 (a g6-standard-1 without
+// backups), volume 10 (50 GB in br-gru), and NodeBalancer 20 (in us-east).
+func newWriteCostTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var body string
+
+		switch r.URL.Path {
+		case "/linode/types":
+			body = `{"data":[` +
+				`{"id":"g6-standard-1","price":{"hourly":0.018,"monthly":12},"addons":{"backups":{"price":{"hourly":0.004,"monthly":2.5}}}},` +
+				`{"id":"g6-standard-2","price":{"hourly":0.036,"monthly":24},"addons":{"backups":{"price":{"hourly":0.0075,"monthly":5}}}}` +
+				`],"page":1,"pages":1,"results":2}`
+		case "/volumes/types":
+			body = `{"data":[{"id":"volume","price":{"hourly":0.00015,"monthly":0.1},` +
+				`"region_prices":[{"id":"br-gru","hourly":0.00021,"monthly":0.14}]}],"page":1,"pages":1,"results":1}`
+		case "/nodebalancers/types":
+			body = `{"data":[{"id":"nodebalancer","price":{"hourly":0.015,"monthly":10},"region_prices":[]}],"page":1,"pages":1,"results":1}`
+		case "/linode/instances/1":
+			body = `{"id":1,"label":"api","region":"br-gru","type":"g6-standard-2","backups":{"enabled":true}}`
+		case "/linode/instances/2":
+			body = `{"id":2,"label":"web","region":"us-east","type":"g6-standard-1","backups":{"enabled":false}}`
+		case "/volumes/10":
+			body = `{"id":10,"label":"ledger","region":"br-gru","size":50}`
+		case "/nodebalancers/20":
+			body = `{"id":20,"label":"web-lb","region":"us-east"}`
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestWriteCostDelta(t *testing.T) {
+	t.Parallel()
+
+	srv := newWriteCostTestServer(t)
+	cfg := &config.Config{Environments: map[string]config.EnvironmentConfig{envKeyDefault: {Label: envLabelDefault, Linode: config.LinodeConfig{APIURL: srv.URL, Token: tokenTest}}}}
+
+	tests := []struct {
+		name   string
+		tool   string
+		args   map[string]any
+		want   float64
+		priced bool
+	}{
+		{
+			name: "instance create with backups", tool: "linode_instance_create",
+			args: map[string]any{"type": "g6-standard-2", "region": "us-east", "backups_enabled": true}, want: 29, priced: true,
+		},
+		{name: "instance delete", tool: "linode_instance_delete", args: map[string]any{"instance_id": float64(1)}, want: -29, priced: true},
+		{
+			name: "instance resize", tool: "linode_instance_resize",
+			args: map[string]any{"instance_id": float64(2), "type": "g6-standard-2"}, want: 12, priced: true,
+		},
+		{
+			name: "volume create in the instance's region", tool: "linode_volume_create",
+			args: map[string]any{"label": "logs", "linode_id": float64(1), "size": float64(50)}, want: 7, priced: true,
+		},
+		{
+			name: "volume create at the default size", tool: "linode_volume_create",
+			args: map[string]any{"label": "logs", "region": "us-east"}, want: 2, priced: true,
+		},
+		{name: "volume resize", tool: "linode_volume_resize", args: map[string]any{"volume_id": float64(10), "size": float64(100)}, want: 7, priced: true},
+		{name: "volume delete", tool: "linode_volume_delete", args: map[string]any{"volume_id": float64(10)}, want: -7, priced: true},
+		{name: "NodeBalancer create", tool: "linode_nodebalancer_create", args: map[string]any{"region": "us-east"}, want: 10, priced: true},
+		{name: "NodeBalancer delete", tool: "linode_nodebalancer_delete", args: map[string]any{"nodebalancer_id": float64(20)}, want: -10, priced: true},
+		{name: "unknown instance type", tool: "linode_instance_create", args: map[string]any{"type": "g6-unknown"}},
+		{name: "unpriced write", tool: "linode_instance_reboot", args: map[string]any{"instance_id": float64(1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := createRequestWithArgs(t, tt.args)
+
+			got, priced := tools.WriteCostDelta(t.Context(), cfg, tt.tool, &req)
+			if priced != tt.priced || got != tt.want {
+				t.Errorf("WriteCostDelta() = %v, %v, want %v, %v", got, priced, tt.want, tt.priced)
+			}
+		})
+	}
+}
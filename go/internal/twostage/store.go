@@ -21,9 +21,13 @@ type ApplyFunc func(ctx context.Context) (*mcp.CallToolResult, error)
 
 // PlanEntry is one outstanding plan held in process memory.
 type PlanEntry struct {
-	Apply       ApplyFunc
-	Args        map[string]any
-	ID          string
+	Apply ApplyFunc
+	Args  map[string]any
+	ID    string
+	// Partition is the tenant that made the plan: empty unless
+	// server.tokenPassthrough has tenants share one server, where only the
+	// same tenant can look the plan up or apply it.
+	Partition   string
 	Tool        string
 	Environment string
 	StateHash   string
@@ -85,14 +89,15 @@ func (s *PlanStore) Put(entry *PlanEntry) {
 	s.plans[entry.ID] = entry
 }
 
-// Get returns a plan without consuming it. It reports ErrPlanNotFound for an
-// unknown ID and ErrPlanExpired when the TTL has elapsed.
-func (s *PlanStore) Get(planID string) (*PlanEntry, error) {
+// Get returns a partition's plan without consuming it. It reports
+// ErrPlanNotFound for an unknown ID or another partition's plan and
+// ErrPlanExpired when the TTL has elapsed.
+func (s *PlanStore) Get(partition, planID string) (*PlanEntry, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	entry, ok := s.plans[planID]
-	if !ok {
+	if !ok || entry.Partition != partition {
 		return nil, ErrPlanNotFound
 	}
 
@@ -103,15 +108,17 @@ func (s *PlanStore) Get(planID string) (*PlanEntry, error) {
 	return entry, nil
 }
 
-// Take returns a plan and removes it in the same locked section, giving the
-// apply path single-use semantics and preventing a concurrent double-apply.
-// An expired plan is still removed; the caller receives ErrPlanExpired.
-func (s *PlanStore) Take(planID string) (*PlanEntry, error) {
+// Take returns a partition's plan and removes it in the same locked section,
+// giving the apply path single-use semantics and preventing a concurrent
+// double-apply. An expired plan is still removed; the caller receives
+// ErrPlanExpired. Another partition's plan is left in place and reported as
+// ErrPlanNotFound.
+func (s *PlanStore) Take(partition, planID string) (*PlanEntry, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	entry, ok := s.plans[planID]
-	if !ok {
+	if !ok || entry.Partition != partition {
 		return nil, ErrPlanNotFound
 	}
 
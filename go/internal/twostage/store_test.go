@@ -26,7 +26,7 @@ func TestPlanStorePutAndGet(t *testing.T) {
 	entry := &twostage.PlanEntry{ID: planA, ExpiresAt: now.Add(time.Minute)}
 	store.Put(entry)
 
-	got, err := store.Get(planA)
+	got, err := store.Get("", planA)
 	if err != nil {
 		t.Fatalf("Get returned error: %v", err)
 	}
@@ -79,7 +79,7 @@ func TestPlanStoreGetUnknownReturnsNotFound(t *testing.T) {
 
 	store := twostage.NewPlanStore()
 
-	if _, err := store.Get("plan_missing"); !errors.Is(err, twostage.ErrPlanNotFound) {
+	if _, err := store.Get("", "plan_missing"); !errors.Is(err, twostage.ErrPlanNotFound) {
 		t.Fatalf("err = %v, want ErrPlanNotFound", err)
 	}
 }
@@ -94,7 +94,7 @@ func TestPlanStoreGetExpiredReturnsExpired(t *testing.T) {
 
 	current = current.Add(2 * time.Minute)
 
-	if _, err := store.Get(planA); !errors.Is(err, twostage.ErrPlanExpired) {
+	if _, err := store.Get("", planA); !errors.Is(err, twostage.ErrPlanExpired) {
 		t.Fatalf("err = %v, want ErrPlanExpired", err)
 	}
 }
@@ -107,7 +107,7 @@ func TestPlanStoreTakeIsSingleUse(t *testing.T) {
 
 	store.Put(&twostage.PlanEntry{ID: planX, ExpiresAt: now.Add(time.Minute)})
 
-	got, err := store.Take(planX)
+	got, err := store.Take("", planX)
 	if err != nil {
 		t.Fatalf("first Take returned error: %v", err)
 	}
@@ -116,7 +116,7 @@ func TestPlanStoreTakeIsSingleUse(t *testing.T) {
 		t.Errorf("Take returned ID %q, want %q", got.ID, planX)
 	}
 
-	if _, err := store.Take(planX); !errors.Is(err, twostage.ErrPlanNotFound) {
+	if _, err := store.Take("", planX); !errors.Is(err, twostage.ErrPlanNotFound) {
 		t.Fatalf("second Take err = %v, want ErrPlanNotFound", err)
 	}
 
@@ -125,6 +125,27 @@ func TestPlanStoreTakeIsSingleUse(t *testing.T) {
 	}
 }
 
+func TestPlanStoreKeepsPartitionsApart(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	store := twostage.NewPlanStore(twostage.WithClock(func() time.Time { return now }))
+
+	store.Put(&twostage.PlanEntry{ID: planX, Partition: "0a1b2c3d4e5f6071", ExpiresAt: now.Add(time.Minute)})
+
+	if _, err := store.Get("8192a3b4c5d6e7f8", planX); !errors.Is(err, twostage.ErrPlanNotFound) {
+		t.Fatalf("Get from another partition err = %v, want ErrPlanNotFound", err)
+	}
+
+	if _, err := store.Take("8192a3b4c5d6e7f8", planX); !errors.Is(err, twostage.ErrPlanNotFound) {
+		t.Fatalf("Take from another partition err = %v, want ErrPlanNotFound", err)
+	}
+
+	if _, err := store.Take("0a1b2c3d4e5f6071", planX); err != nil {
+		t.Fatalf("Take from the planning partition returned error: %v", err)
+	}
+}
+
 func TestPlanStoreTakeExpiredStillRemoves(t *testing.T) {
 	t.Parallel()
 
@@ -135,7 +156,7 @@ func TestPlanStoreTakeExpiredStillRemoves(t *testing.T) {
 
 	current = current.Add(2 * time.Minute)
 
-	if _, err := store.Take(planX); !errors.Is(err, twostage.ErrPlanExpired) {
+	if _, err := store.Take("", planX); !errors.Is(err, twostage.ErrPlanExpired) {
 		t.Fatalf("err = %v, want ErrPlanExpired", err)
 	}
 
@@ -177,7 +198,7 @@ func TestPlanStoreSweepDropsOnlyExpired(t *testing.T) {
 		t.Errorf("Len = %d, want 1", store.Len())
 	}
 
-	if _, err := store.Get("plan_long"); err != nil {
+	if _, err := store.Get("", "plan_long"); err != nil {
 		t.Errorf("the long-lived plan should survive the sweep: %v", err)
 	}
 }
@@ -210,11 +231,11 @@ func TestPlanStorePutEvictsOldestAtCeiling(t *testing.T) {
 		t.Errorf("Len = %d, want %d (ceiling holds after eviction)", store.Len(), twostage.MaxOutstandingPlans)
 	}
 
-	if _, err := store.Get("plan_0000"); !errors.Is(err, twostage.ErrPlanNotFound) {
+	if _, err := store.Get("", "plan_0000"); !errors.Is(err, twostage.ErrPlanNotFound) {
 		t.Errorf("oldest plan should have been evicted, err = %v", err)
 	}
 
-	if _, err := store.Get("plan_newest"); err != nil {
+	if _, err := store.Get("", "plan_newest"); err != nil {
 		t.Errorf("newest plan should be present: %v", err)
 	}
 }
@@ -0,0 +1,170 @@
+// Package updatecheck asks GitHub whether a newer LinodeMCP release exists.
+// The server runs one check at startup and the linode_version_check tool runs
+// one on demand; update_check.enabled: false turns both off for air-gapped
+// installs. Nothing here downloads or installs anything.
+package updatecheck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxHighlights caps how many changelog bullets a Result carries.
+const MaxHighlights = 5
+
+// requestTimeout bounds one releases lookup so a slow or filtered network
+// never holds up startup or a tool call for long.
+const requestTimeout = 5 * time.Second
+
+// maxBodyBytes caps the release document read; release bodies are a few KB.
+const maxBodyBytes = 1 << 20
+
+// ErrUnexpectedStatus is returned when the releases endpoint answers with a
+// non-200 status (rate limited, no releases yet, wrong URL).
+var ErrUnexpectedStatus = errors.New("unexpected status from releases endpoint")
+
+// Result is the outcome of one check. UpdateAvailable is true only when both
+// versions parse and the latest release is strictly newer than Current.
+type Result struct {
+	Current         string
+	Latest          string
+	UpdateAvailable bool
+	ReleaseURL      string
+	PublishedAt     string
+	Highlights      []string
+}
+
+// release is the subset of GitHub's release document the check reads.
+type release struct {
+	TagName     string `json:"tag_name"`
+	HTMLURL     string `json:"html_url"`
+	PublishedAt string `json:"published_at"`
+	Body        string `json:"body"`
+}
+
+// Check fetches the latest release from url and compares it with current.
+// A nil client uses http.DefaultClient. The request carries its own timeout
+// on top of ctx.
+func Check(ctx context.Context, client *http.Client, url, current string) (Result, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return Result{}, fmt.Errorf("build releases request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("User-Agent", "LinodeMCP/"+current)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Result{}, fmt.Errorf("fetch latest release: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return Result{}, fmt.Errorf("%w: %d", ErrUnexpectedStatus, resp.StatusCode)
+	}
+
+	var latest release
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxBodyBytes)).Decode(&latest); err != nil {
+		return Result{}, fmt.Errorf("decode latest release: %w", err)
+	}
+
+	return Result{
+		Current:         current,
+		Latest:          strings.TrimPrefix(latest.TagName, "v"),
+		UpdateAvailable: Newer(latest.TagName, current),
+		ReleaseURL:      latest.HTMLURL,
+		PublishedAt:     latest.PublishedAt,
+		Highlights:      Highlights(latest.Body, MaxHighlights),
+	}, nil
+}
+
+// Highlights returns up to limit bullet lines from a release body, in order.
+// The release workflow groups notes under Features, Bug Fixes, and so on, so
+// the first bullets are the most notable changes.
+func Highlights(body string, limit int) []string {
+	var out []string
+
+	for line := range strings.SplitSeq(body, "\n") {
+		if len(out) == limit {
+			break
+		}
+
+		line = strings.TrimSpace(line)
+
+		item, ok := strings.CutPrefix(line, "- ")
+		if !ok {
+			item, ok = strings.CutPrefix(line, "* ")
+		}
+
+		if item = strings.TrimSpace(item); ok && item != "" {
+			out = append(out, item)
+		}
+	}
+
+	return out
+}
+
+// Newer reports whether version latest is strictly newer than current. Both
+// take an optional "v" prefix. A release without a pre-release suffix beats
+// the same core version with one (0.2.0 > 0.2.0-rc1). Anything that does not
+// parse as MAJOR.MINOR.PATCH compares as not newer.
+func Newer(latest, current string) bool {
+	latestCore, latestPre, ok := parseVersion(latest)
+	if !ok {
+		return false
+	}
+
+	currentCore, currentPre, ok := parseVersion(current)
+	if !ok {
+		return false
+	}
+
+	for i := range latestCore {
+		if latestCore[i] != currentCore[i] {
+			return latestCore[i] > currentCore[i]
+		}
+	}
+
+	return latestPre == "" && currentPre != ""
+}
+
+// parseVersion splits "v1.2.3-rc1" into its numeric core and pre-release tag.
+func parseVersion(version string) ([3]int, string, bool) {
+	var core [3]int
+
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	version, _, _ = strings.Cut(version, "+")
+	version, pre, _ := strings.Cut(version, "-")
+
+	parts := strings.Split(version, ".")
+	if len(parts) != len(core) {
+		return core, "", false
+	}
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return core, "", false
+		}
+
+		core[i] = n
+	}
+
+	return core, pre, true
+}
@@ -0,0 +1,89 @@
+package updatecheck_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/chadit/LinodeMCP/go/internal/updatecheck"
+)
+
+func TestNewer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		latest, current string
+		want            bool
+	}{
+		{"v0.2.0", "0.1.0", true},
+		{"v0.1.10", "0.1.9", true},
+		{"v0.1.0", "0.1.0", false},
+		{"v0.1.0", "0.2.0", false},
+		{"v0.2.0", "0.2.0-rc1", true},
+		{"v0.2.0-rc2", "0.2.0", false},
+		{"v1.0.0", "dev", false},
+		{"latest", "0.1.0", false},
+	}
+
+	for _, tt := range tests {
+		if got := updatecheck.Newer(tt.latest, tt.current); got != tt.want {
+			t.Errorf("Newer(%q, %q) = %v, want %v", tt.latest, tt.current, got, tt.want)
+		}
+	}
+}
+
+func TestHighlightsTakesLeadingBullets(t *testing.T) {
+	t.Parallel()
+
+	body := "Summary paragraph.\n\n## Features\n- Add DNS cutover\n- Add TTL bulk set\n\n## Bug Fixes\n* Fix retry jitter\n-\n- Fix pager\n"
+
+	got := updatecheck.Highlights(body, 3)
+	want := []string{"Add DNS cutover", "Add TTL bulk set", "Fix retry jitter"}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("Highlights = %q, want %q", got, want)
+	}
+}
+
+func TestCheckReportsNewerRelease(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") != "LinodeMCP/0.1.0" {
+			t.Errorf("User-Agent = %q, want LinodeMCP/0.1.0", r.Header.Get("User-Agent"))
+		}
+
+		_, _ = w.Write([]byte(`{"tag_name":"v0.3.0","html_url":"https://example.test/r/v0.3.0",` +
+			`"published_at":"2026-10-01T00:00:00Z","body":"## Features\n- Faster lists\n"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	result, err := updatecheck.Check(t.Context(), srv.Client(), srv.URL, "0.1.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !result.UpdateAvailable || result.Latest != "0.3.0" || result.ReleaseURL != "https://example.test/r/v0.3.0" {
+		t.Errorf("result = %+v, want 0.3.0 available", result)
+	}
+
+	if !slices.Equal(result.Highlights, []string{"Faster lists"}) {
+		t.Errorf("highlights = %q, want [Faster lists]", result.Highlights)
+	}
+}
+
+func TestCheckRejectsNonOKStatus(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(srv.Close)
+
+	_, err := updatecheck.Check(t.Context(), srv.Client(), srv.URL, "0.1.0")
+	if !errors.Is(err, updatecheck.ErrUnexpectedStatus) {
+		t.Errorf("err = %v, want ErrUnexpectedStatus", err)
+	}
+}
@@ -0,0 +1,95 @@
+// Package linodemcp embeds LinodeMCP's Linode operations in another Go
+// program without speaking MCP. An Engine registers the same tools the server
+// does, filtered by the config's active profile, and runs every call through
+// the server's dispatch path: argument limits, confirm and dry-run gates,
+// OAuth scope checks when enabled, audit, and the result envelope. A call
+// from Go is held to exactly the rules a call from an MCP client is.
+//
+// The API is the Engine methods and the contracts.Tool interface; everything
+// it is built from stays internal and may change between releases.
+package linodemcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/internal/config"
+	"github.com/chadit/LinodeMCP/go/internal/server"
+	"github.com/chadit/LinodeMCP/go/pkg/contracts"
+)
+
+// ErrToolNotFound is returned by Call for a tool the active profile does not
+// expose.
+var ErrToolNotFound = server.ErrToolNotFound
+
+// Engine is an embedded LinodeMCP server. It is safe for concurrent use.
+type Engine struct {
+	srv *server.Server
+}
+
+// Open loads the LinodeMCP config file at configPath and builds an Engine
+// from it. An empty configPath reads LINODEMCP_CONFIG_PATH, then the default
+// location, as the linodemcp binary does; LINODEMCP_* overrides apply either
+// way.
+func Open(configPath string) (*Engine, error) {
+	if configPath == "" {
+		configPath = config.Path()
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+
+	srv, err := server.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("create server: %w", err)
+	}
+
+	return &Engine{srv: srv}, nil
+}
+
+// Tools lists the tools the active profile exposes. Each one's Execute is
+// the same as calling Call with its name.
+func (e *Engine) Tools() []contracts.Tool {
+	return e.srv.Tools()
+}
+
+// Profile returns the name of the profile filtering the tool set.
+func (e *Engine) Profile() string {
+	return e.srv.ActiveProfile().Name
+}
+
+// Call runs the named tool with args, which take the same JSON-shaped values
+// as an MCP tools/call (numbers as float64, objects as map[string]any). A
+// tool-level failure, including a missing confirm, comes back as a result
+// with IsError set; the error return is for calls refused before the tool
+// ran.
+func (e *Engine) Call(ctx context.Context, name string, args map[string]any) (*mcp.CallToolResult, error) {
+	result, err := e.srv.CallTool(ctx, name, args)
+	if err != nil {
+		return nil, fmt.Errorf("call %s: %w", name, err)
+	}
+
+	return result, nil
+}
+
+// HandleMessage dispatches one raw MCP JSON-RPC message (initialize,
+// tools/list, tools/call, ...) in process, for embedders that relay JSON-RPC
+// from their own transport.
+func (e *Engine) HandleMessage(ctx context.Context, message json.RawMessage) mcp.JSONRPCMessage {
+	return e.srv.HandleMessage(ctx, message)
+}
+
+// Close stops accepting calls and waits for in-flight ones to finish or for
+// ctx to end.
+func (e *Engine) Close(ctx context.Context) error {
+	if err := e.srv.Shutdown(ctx); err != nil {
+		return fmt.Errorf("close engine: %w", err)
+	}
+
+	return nil
+}
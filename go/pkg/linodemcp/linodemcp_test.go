@@ -0,0 +1,87 @@
+package linodemcp_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/chadit/LinodeMCP/go/pkg/linodemcp"
+)
+
+const engineConfig = `
+environments:
+  default:
+    label: "Default"
+    linode:
+      apiUrl: "https://api.linode.com/v4"
+      token: "tok"
+`
+
+func openEngine(t *testing.T) *linodemcp.Engine {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yml")
+	if err := os.WriteFile(path, []byte(engineConfig), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	engine, err := linodemcp.Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	baseCtx := t.Context()
+
+	t.Cleanup(func() {
+		// Detach from the test context (already canceled by cleanup time) so
+		// Close gets a live deadline of its own.
+		closeCtx, cancel := context.WithTimeout(context.WithoutCancel(baseCtx), 5*time.Second)
+		defer cancel()
+
+		if err := engine.Close(closeCtx); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	return engine
+}
+
+func TestEngineCall(t *testing.T) {
+	t.Parallel()
+
+	engine := openEngine(t)
+
+	result, err := engine.Call(t.Context(), "hello", map[string]any{"name": "Embedder"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.IsError {
+		t.Fatalf("result.IsError = true, want false: %v", result.Content)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatal("ok = false, want true")
+	}
+
+	if !strings.Contains(textContent.Text, "Hello, Embedder!") {
+		t.Errorf("text = %s, want it to greet Embedder", textContent.Text)
+	}
+}
+
+func TestEngineCallUnknownTool(t *testing.T) {
+	t.Parallel()
+
+	engine := openEngine(t)
+
+	if _, err := engine.Call(t.Context(), "linode_no_such_tool", nil); !errors.Is(err, linodemcp.ErrToolNotFound) {
+		t.Errorf("error = %v, want %v", err, linodemcp.ErrToolNotFound)
+	}
+}
@@ -0,0 +1,44 @@
+"""Local key-value annotations on Linode resources.
+
+Mirrors ``go/internal/annotations``. Notes live in one JSON file per
+environment and never reach the Linode API; the file layout is shared with
+the Go server, so either server reads the notes the other wrote.
+"""
+
+from __future__ import annotations
+
+from linodemcp.annotations.store import (
+    MAX_KEY_LENGTH,
+    MAX_PER_RESOURCE,
+    MAX_VALUE_LENGTH,
+    AnnotationsError,
+    CorruptStoreError,
+    Entry,
+    InvalidEnvironmentError,
+    InvalidKeyError,
+    Ref,
+    Store,
+    TooManyAnnotationsError,
+    ValueTooLongError,
+    parse_ref,
+    validate_key,
+    validate_value,
+)
+
+__all__ = [
+    "MAX_KEY_LENGTH",
+    "MAX_PER_RESOURCE",
+    "MAX_VALUE_LENGTH",
+    "AnnotationsError",
+    "CorruptStoreError",
+    "Entry",
+    "InvalidEnvironmentError",
+    "InvalidKeyError",
+    "Ref",
+    "Store",
+    "TooManyAnnotationsError",
+    "ValueTooLongError",
+    "parse_ref",
+    "validate_key",
+    "validate_value",
+]
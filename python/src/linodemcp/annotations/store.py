@@ -0,0 +1,238 @@
+"""Per-environment annotation files.
+
+Mirrors ``go/internal/annotations/store.go``. The layout is::
+
+    {"version": 1, "resources": {"instance/123": {"resize-reason":
+      {"value": "...", "updated_at": "2026-10-15T12:00:00Z"}}}}
+"""
+
+from __future__ import annotations
+
+import json
+import os
+import re
+import tempfile
+import threading
+from collections.abc import Callable
+from dataclasses import dataclass
+from datetime import UTC, datetime
+from pathlib import Path
+from typing import Any, cast
+
+# Store limits. They keep one environment's file small enough to rewrite on
+# every set.
+MAX_KEY_LENGTH = 64
+MAX_VALUE_LENGTH = 1024
+MAX_PER_RESOURCE = 50
+
+_FILE_VERSION = 1
+_DIR_MODE = 0o700
+_FILE_MODE = 0o600
+
+_KEY_PATTERN = re.compile(r"[a-z0-9][a-z0-9._-]*")
+
+# Serializes read-modify-write cycles within the process. Every Store shares
+# it, since the set and get tools each open their own Store over the same
+# files. Writes land by rename, so a concurrent reader never sees a partial
+# file.
+_write_lock = threading.Lock()
+
+
+class AnnotationsError(Exception):
+    """Base class for annotation store errors."""
+
+
+class InvalidEnvironmentError(AnnotationsError):
+    """An environment name that cannot be used as a store file name."""
+
+
+class InvalidKeyError(AnnotationsError):
+    """A key outside the allowed character set or length."""
+
+    def __init__(self) -> None:
+        super().__init__(
+            "key must be 1-64 lowercase letters, digits, '.', '_', or '-', "
+            "starting with a letter or digit"
+        )
+
+
+class ValueTooLongError(AnnotationsError):
+    """A value over MAX_VALUE_LENGTH characters."""
+
+    def __init__(self) -> None:
+        super().__init__(f"value must be at most {MAX_VALUE_LENGTH} characters")
+
+
+class TooManyAnnotationsError(AnnotationsError):
+    """A set that would give a resource more than MAX_PER_RESOURCE notes."""
+
+    def __init__(self) -> None:
+        super().__init__(
+            "resource already has the maximum of "
+            f"{MAX_PER_RESOURCE} annotations"
+        )
+
+
+class CorruptStoreError(AnnotationsError):
+    """A store file that is not valid annotations JSON."""
+
+
+@dataclass(frozen=True)
+class Ref:
+    """An annotated resource."""
+
+    type: str
+    id: int
+
+    def __str__(self) -> str:
+        """The resource's key in the store file, e.g. "instance/123"."""
+        return f"{self.type}/{self.id}"
+
+
+def parse_ref(key: str) -> Ref | None:
+    """Reverse str(Ref), returning None for a malformed key."""
+    kind, sep, raw_id = key.partition("/")
+    if not sep or not kind:
+        return None
+    try:
+        return Ref(type=kind, id=int(raw_id))
+    except ValueError:
+        return None
+
+
+@dataclass(frozen=True)
+class Entry:
+    """One stored annotation value."""
+
+    value: str
+    updated_at: str
+
+
+def validate_key(key: str) -> None:
+    """Raise InvalidKeyError unless key may name an annotation."""
+    if len(key) > MAX_KEY_LENGTH or not _KEY_PATTERN.fullmatch(key):
+        raise InvalidKeyError
+
+
+def validate_value(value: str) -> None:
+    """Raise ValueTooLongError unless value fits in an annotation."""
+    if len(value) > MAX_VALUE_LENGTH:
+        raise ValueTooLongError
+
+
+class Store:
+    """Reads and writes the annotation files under one directory.
+
+    The directory is created on the first set, so reading an environment that
+    was never annotated does not touch the filesystem.
+    """
+
+    def __init__(
+        self, directory: str | Path, now: Callable[[], datetime] | None = None
+    ) -> None:
+        self._dir = Path(directory)
+        self._now = now or (lambda: datetime.now(UTC))
+
+    def get(self, environment: str) -> dict[str, dict[str, Entry]]:
+        """Return every annotated resource in environment. An environment with
+        no file yet has no annotations."""
+        return _read_resources(self._path(environment))
+
+    def set(self, environment: str, ref: Ref, key: str, value: str) -> dict[str, Entry]:
+        """Store value under key on ref and return the resource's annotations
+        afterwards. An empty value removes the key, and a resource left with
+        no annotations is dropped from the file."""
+        validate_key(key)
+        validate_value(value)
+        path = self._path(environment)
+
+        with _write_lock:
+            resources = _read_resources(path)
+            entries = resources.get(str(ref), {})
+
+            if not value:
+                entries.pop(key, None)
+            else:
+                if key not in entries and len(entries) >= MAX_PER_RESOURCE:
+                    raise TooManyAnnotationsError
+                stamp = self._now().astimezone(UTC).strftime("%Y-%m-%dT%H:%M:%SZ")
+                entries[key] = Entry(value=value, updated_at=stamp)
+
+            if entries:
+                resources[str(ref)] = entries
+            else:
+                resources.pop(str(ref), None)
+
+            _write_resources(path, resources)
+        return entries
+
+    def _path(self, environment: str) -> Path:
+        """The store file for environment. Environment names come from the
+        config's keys, so this only guards against one that would escape the
+        directory."""
+        if environment in ("", ".", "..") or any(c in environment for c in "/\\"):
+            msg = (
+                "environment name cannot be used as an annotations file name: "
+                f"{json.dumps(environment)}"
+            )
+            raise InvalidEnvironmentError(msg)
+        return self._dir / f"{environment}.json"
+
+
+def _read_resources(path: Path) -> dict[str, dict[str, Entry]]:
+    try:
+        raw = path.read_text(encoding="utf-8")
+    except FileNotFoundError:
+        return {}
+
+    try:
+        parsed = json.loads(raw)
+        resources = cast("dict[str, Any]", parsed.get("resources") or {})
+        return {
+            ref_key: {
+                key: Entry(
+                    value=str(entry.get("value", "")),
+                    updated_at=str(entry.get("updated_at", "")),
+                )
+                for key, entry in cast("dict[str, Any]", entries).items()
+            }
+            for ref_key, entries in resources.items()
+        }
+    except (ValueError, AttributeError) as exc:
+        msg = f"annotations file is not valid JSON: {path}: {exc}"
+        raise CorruptStoreError(msg) from exc
+
+
+def _write_resources(path: Path, resources: dict[str, dict[str, Entry]]) -> None:
+    """Replace the store file through a temp file and rename, so a crash
+    mid-write leaves the previous file intact."""
+    data = {
+        "version": _FILE_VERSION,
+        "resources": {
+            ref_key: {
+                key: {"value": entry.value, "updated_at": entry.updated_at}
+                for key, entry in sorted(entries.items())
+            }
+            for ref_key, entries in sorted(resources.items())
+        },
+    }
+    path.parent.mkdir(mode=_DIR_MODE, parents=True, exist_ok=True)
+
+    with tempfile.NamedTemporaryFile(
+        mode="w",
+        encoding="utf-8",
+        dir=path.parent,
+        prefix=f".{path.name}.tmp.",
+        delete=False,
+    ) as tmp:
+        tmp.write(json.dumps(data, indent=2) + "\n")
+        tmp.flush()
+        os.fsync(tmp.fileno())
+        tmp_path = Path(tmp.name)
+
+    try:
+        tmp_path.chmod(_FILE_MODE)
+        tmp_path.replace(path)
+    except OSError:
+        tmp_path.unlink(missing_ok=True)
+        raise
@@ -73,6 +73,11 @@ class Event:
     Field names match the JSON wire shape via the ``to_dict`` method.
     Dataclass is mutable so the capture middleware can fill in the
     outcome fields after the handler returns.
+
+    ``monthly_cost_delta_usd`` is the estimated change in the account's
+    monthly list-price bill a successful write caused (negative for a
+    delete), set only when audit.cost_attribution is on and the tool is
+    one the pricing table covers; every other event carries None.
     """
 
     ts: datetime
@@ -93,6 +98,7 @@ class Event:
     linodemcp_version: str
     session_id: str
     credential_generation: int
+    monthly_cost_delta_usd: float | None = None
 
     def finalize(
         self,
@@ -117,6 +123,12 @@ class Event:
         self.mode = mode
         self.plan_id = plan_id or None
 
+    def set_monthly_cost_delta(self, usd: float) -> None:
+        """Record the estimated monthly cost change of a successful write.
+        The capture middleware calls it only for writes the pricing table
+        covers, after the handler succeeds."""
+        self.monthly_cost_delta_usd = usd
+
     def to_dict(self) -> dict[str, Any]:
         """Serialize to a JSON-ready dict.
 
@@ -143,6 +155,7 @@ class Event:
             "linodemcp_version": self.linodemcp_version,
             "session_id": self.session_id,
             "credential_generation": self.credential_generation,
+            "monthly_cost_delta_usd": self.monthly_cost_delta_usd,
         }
 
     @classmethod
@@ -152,8 +165,10 @@ class Event:
         Inverse of :meth:`to_dict`, used by the JSONL reader to parse
         log lines back into events. The ``ts`` field accepts the
         trailing ``Z`` form that ``to_dict`` writes. Enum fields parse
-        through their value constructors.
+        through their value constructors. A line written before
+        ``monthly_cost_delta_usd`` existed reads it as None.
         """
+        cost_delta = data.get("monthly_cost_delta_usd")
         return cls(
             ts=datetime.fromisoformat(str(data["ts"])),
             ts_unix_ns=int(data["ts_unix_ns"]),
@@ -173,6 +188,7 @@ class Event:
             linodemcp_version=str(data["linodemcp_version"]),
             session_id=str(data["session_id"]),
             credential_generation=int(data["credential_generation"]),
+            monthly_cost_delta_usd=None if cost_delta is None else float(cost_delta),
         )
 
 
@@ -50,6 +50,7 @@ _CSV_HEADER = [
     "plan_id",
     "session_id",
     "credential_generation",
+    "monthly_cost_delta_usd",
     "args_redacted",
     "args",
 ]
@@ -89,7 +90,8 @@ def _export_from_sqlite(path: str, query: RecentQuery) -> list[Event]:
             "SELECT event_id, ts_unix_ns, tool, tool_capability, environment, "
             "profile, mode, plan_id, status, latency_ms, result_summary, error, "
             "linodemcp_version, session_id, credential_generation, args_json, "
-            "args_redacted_json FROM events WHERE ts_unix_ns >= ? "
+            "args_redacted_json, monthly_cost_delta_usd FROM events "
+            "WHERE ts_unix_ns >= ? "
             "ORDER BY ts_unix_ns DESC",
             (since_ns,),
         )
@@ -116,6 +118,7 @@ def _export_from_sqlite(path: str, query: RecentQuery) -> list[Event]:
         credential_generation,
         args_json,
         args_redacted_json,
+        monthly_cost_delta_usd,
     ) in rows:
         event = _event_from_export_row(
             event_id,
@@ -135,6 +138,7 @@ def _export_from_sqlite(path: str, query: RecentQuery) -> list[Event]:
             credential_generation,
             args_json,
             args_redacted_json,
+            monthly_cost_delta_usd,
         )
         if not event_matches(query, event):
             continue
@@ -164,6 +168,7 @@ def _event_from_export_row(
     credential_generation: int,
     args_json: str,
     args_redacted_json: str,
+    monthly_cost_delta_usd: float | None,
 ) -> Event:
     """Reconstruct a full Event from a SQLite export row, decoding the
     args/args_redacted JSON columns and rebuilding ts from ts_unix_ns.
@@ -187,6 +192,7 @@ def _event_from_export_row(
         linodemcp_version=linodemcp_version,
         session_id=session_id,
         credential_generation=credential_generation,
+        monthly_cost_delta_usd=monthly_cost_delta_usd,
     )
 
 
@@ -223,9 +229,10 @@ def _encode_csv(events: list[Event]) -> str:
 
 def _export_csv_row(event: Event) -> list[str]:
     """Flatten an event into CSV cells in _CSV_HEADER order. Nullable
-    plan_id/error render as empty cells; args and args_redacted are
-    compact JSON.
+    plan_id/error/monthly_cost_delta_usd render as empty cells; args and
+    args_redacted are compact JSON.
     """
+    cost_delta = event.monthly_cost_delta_usd
     return [
         event.ts.isoformat().replace("+00:00", "Z"),
         event.event_id,
@@ -241,6 +248,7 @@ def _export_csv_row(event: Event) -> list[str]:
         event.plan_id or "",
         event.session_id,
         str(event.credential_generation),
+        "" if cost_delta is None else f"{cost_delta:.2f}",
         json.dumps(event.args_redacted or []),
         json.dumps(event.args or {}),
     ]
@@ -46,7 +46,8 @@ CREATE TABLE IF NOT EXISTS events (
     session_id TEXT NOT NULL,
     credential_generation INTEGER NOT NULL,
     args_json TEXT NOT NULL,
-    args_redacted_json TEXT NOT NULL
+    args_redacted_json TEXT NOT NULL,
+    monthly_cost_delta_usd REAL
 );
 CREATE INDEX IF NOT EXISTS idx_events_ts ON events(ts_unix_ns DESC);
 CREATE INDEX IF NOT EXISTS idx_events_tool ON events(tool, ts_unix_ns DESC);
@@ -63,10 +64,15 @@ INSERT OR IGNORE INTO events (
     event_id, ts_unix_ns, tool, tool_capability, environment, profile,
     mode, plan_id, status, latency_ms, result_summary, error,
     linodemcp_version, session_id, credential_generation,
-    args_json, args_redacted_json
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    args_json, args_redacted_json, monthly_cost_delta_usd
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 """
 
+# Columns later releases appended to the events table, with their DDL type.
+# CREATE TABLE IF NOT EXISTS leaves an existing table alone, so _migrate_schema
+# adds any of these an older audit.db lacks (Go's migrateSchema).
+_ADDED_COLUMNS = (("monthly_cost_delta_usd", "REAL"),)
+
 # Milliseconds-per-second divisor for the connect timeout, which
 # sqlite3 takes in seconds while the config carries milliseconds.
 _MS_PER_SECOND = 1000.0
@@ -95,6 +101,7 @@ class SQLiteSink:
             check_same_thread=False,
         )
         self._conn.executescript(_CREATE_SCHEMA)
+        _migrate_schema(self._conn)
         self._conn.commit()
 
     def write(self, event: Event) -> None:
@@ -124,6 +131,7 @@ class SQLiteSink:
             event.credential_generation,
             args_json,
             redacted_json,
+            event.monthly_cost_delta_usd,
         )
 
         try:
@@ -192,3 +200,12 @@ class SQLiteSink:
     def connection(self) -> sqlite3.Connection:
         """Expose the connection for the Phase 3d/3e query tools."""
         return self._conn
+
+
+def _migrate_schema(conn: sqlite3.Connection) -> None:
+    """Add the _ADDED_COLUMNS a database created before them lacks, so an
+    older audit.db keeps accepting inserts (Go's migrateSchema)."""
+    existing = {row[1] for row in conn.execute("PRAGMA table_info(events)")}
+    for name, column_type in _ADDED_COLUMNS:
+        if name not in existing:
+            conn.execute(f"ALTER TABLE events ADD COLUMN {name} {column_type}")
@@ -0,0 +1,11 @@
+"""Plan sold-out status remembered by linode_availability_watch.
+
+Mirrors ``go/internal/availwatch``. Observations live in process memory only:
+a restart forgets them and the next call starts the watch over.
+"""
+
+from __future__ import annotations
+
+from linodemcp.availwatch.store import Key, Observation, Store
+
+__all__ = ["Key", "Observation", "Store"]
@@ -0,0 +1,63 @@
+"""In-memory store of the plan statuses linode_availability_watch has seen.
+
+Mirrors ``go/internal/availwatch/store.go``.
+"""
+
+from __future__ import annotations
+
+import threading
+from collections.abc import Callable
+from dataclasses import dataclass
+from datetime import UTC, datetime
+
+
+@dataclass(frozen=True)
+class Key:
+    """Identifies one watched plan in one region of one environment. Under
+    server.tokenPassthrough, ``environment`` also carries a hash of the
+    caller's token, so each tenant watches on its own."""
+
+    environment: str
+    region: str
+    plan: str
+
+
+@dataclass(frozen=True)
+class Observation:
+    """The last status seen for a watched plan. ``since`` is when the status
+    was first seen and moves only when it changes; ``checked_at`` is when it
+    was last read."""
+
+    status: str
+    since: datetime
+    checked_at: datetime
+
+
+class Store:
+    """Holds the observations in process memory."""
+
+    def __init__(self, now: Callable[[], datetime] | None = None) -> None:
+        self._now = now or (lambda: datetime.now(UTC))
+        self._seen: dict[Key, Observation] = {}
+        self._lock = threading.Lock()
+
+    def record(
+        self, key: Key, status: str
+    ) -> tuple[Observation, Observation | None]:
+        """Store status as the latest observation for key. Returns the
+        observation as stored and the one it replaced, None when key had not
+        been seen before."""
+        with self._lock:
+            now = self._now().astimezone(UTC)
+            previous = self._seen.get(key)
+            since = now
+            if previous is not None and previous.status == status:
+                since = previous.since
+            current = Observation(status=status, since=since, checked_at=now)
+            self._seen[key] = current
+            return current, previous
+
+    def forget(self, key: Key) -> None:
+        """Drop the observation for key, so the next record starts over."""
+        with self._lock:
+            self._seen.pop(key, None)
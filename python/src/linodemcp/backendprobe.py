@@ -0,0 +1,149 @@
+"""Run a NodeBalancer's health check against one backend from this host.
+
+linode_nodebalancer_backend_probe compares the outcome with the status the
+NodeBalancer reports for the node, which tells an application failing its
+check apart from a NodeBalancer that cannot reach a healthy backend.
+
+Mirrors ``go/internal/backendprobe``.
+"""
+
+from __future__ import annotations
+
+import asyncio
+import re
+import time
+from dataclasses import dataclass
+
+import httpx
+
+# NodeBalancer config check types. "none" and "connection" are probed with a
+# TCP connect; "http" and "http_body" with an HTTP GET.
+CHECK_NONE = "none"
+CHECK_CONNECTION = "connection"
+CHECK_HTTP = "http"
+CHECK_HTTP_BODY = "http_body"
+
+# Probe methods reported in Result.method.
+METHOD_TCP = "tcp"
+METHOD_HTTP = "http"
+
+# DEFAULT_TIMEOUT bounds a probe whose config sets no check timeout, and
+# MAX_TIMEOUT caps a configured one so a backend list cannot hold the tool
+# call for minutes.
+DEFAULT_TIMEOUT = 5.0
+MAX_TIMEOUT = 10.0
+
+# Caps the response body read for a http_body match.
+_MAX_BODY_BYTES = 1 << 20
+
+
+@dataclass
+class Target:
+    """One backend and the check its NodeBalancer config runs.
+
+    ``host`` and ``port`` are the backend address; an empty ``check`` is
+    treated as "none", an empty ``path`` as "/", and a zero ``timeout`` as
+    DEFAULT_TIMEOUT. ``body`` is the regular expression a http_body check
+    matches the response body against.
+    """
+
+    host: str
+    port: int
+    check: str = CHECK_NONE
+    path: str = ""
+    body: str = ""
+    timeout: float = 0.0
+
+    def address(self) -> str:
+        """The "ip:port" form, bracketing an IPv6 host."""
+        host = f"[{self.host}]" if ":" in self.host else self.host
+        return f"{host}:{self.port}"
+
+
+@dataclass
+class Result:
+    """Outcome of one probe; ``status_code`` is 0 for TCP or no response."""
+
+    method: str
+    url: str = ""
+    ok: bool = False
+    status_code: int = 0
+    latency_ms: int = 0
+    error: str = ""
+
+
+async def probe(
+    target: Target, transport: httpx.AsyncBaseTransport | None = None
+) -> Result:
+    """Run target's check once. A failure is recorded in the result, never
+    raised. The HTTP client does not follow redirects or use a proxy, so
+    nothing is contacted beyond the backend address. ``transport`` is for
+    tests."""
+    timeout = target.timeout if target.timeout > 0 else DEFAULT_TIMEOUT
+    timeout = min(timeout, MAX_TIMEOUT)
+    if target.check in (CHECK_HTTP, CHECK_HTTP_BODY):
+        return await _probe_http(target, timeout, transport)
+    return await _probe_tcp(target, timeout)
+
+
+async def _probe_tcp(target: Target, timeout: float) -> Result:
+    result = Result(method=METHOD_TCP)
+    start = time.monotonic()
+    try:
+        _, writer = await asyncio.wait_for(
+            asyncio.open_connection(target.host, target.port), timeout
+        )
+    except (OSError, TimeoutError) as exc:
+        result.latency_ms = int((time.monotonic() - start) * 1000)
+        result.error = str(exc) or type(exc).__name__
+        return result
+    result.latency_ms = int((time.monotonic() - start) * 1000)
+    writer.close()
+    result.ok = True
+    return result
+
+
+async def _probe_http(
+    target: Target, timeout: float, transport: httpx.AsyncBaseTransport | None
+) -> Result:
+    result = Result(
+        method=METHOD_HTTP, url=f"http://{target.address()}{target.path or '/'}"
+    )
+
+    pattern: re.Pattern[bytes] | None = None
+    if target.check == CHECK_HTTP_BODY:
+        try:
+            pattern = re.compile(target.body.encode())
+        except re.error as exc:
+            result.error = f"check_body is not a valid regular expression: {exc}"
+            return result
+
+    start = time.monotonic()
+    try:
+        async with httpx.AsyncClient(
+            timeout=timeout,
+            follow_redirects=False,
+            trust_env=False,
+            transport=transport,
+        ) as client:
+            response = await client.get(result.url)
+            body = response.content[:_MAX_BODY_BYTES]
+    except httpx.HTTPError as exc:
+        result.latency_ms = int((time.monotonic() - start) * 1000)
+        result.error = str(exc) or type(exc).__name__
+        return result
+    result.latency_ms = int((time.monotonic() - start) * 1000)
+    result.status_code = response.status_code
+
+    # The NodeBalancer counts any 2xx or 3xx answer as healthy.
+    if not httpx.codes.OK <= response.status_code < httpx.codes.BAD_REQUEST:
+        reason = httpx.codes.get_reason_phrase(response.status_code)
+        result.error = f"HTTP {response.status_code} {reason}"
+        return result
+
+    if pattern is not None and not pattern.search(body):
+        result.error = f'response body does not match check_body "{target.body}"'
+        return result
+
+    result.ok = True
+    return result
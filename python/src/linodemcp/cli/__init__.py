@@ -7,6 +7,7 @@ invocation stays the MCP stdio server. Surfaces:
     linodemcp tools [--all]          list the tool surface; `show <tool>` detail
     linodemcp audit <sub> [flags]    read the audit log via the audit tools
     linodemcp profile <sub> [args]   manage profiles (list/show/use/...)
+    linodemcp install-service        register the server as a systemd unit
 
 The ``call`` and ``audit`` commands never reimplement tool logic; they build a
 tool call and feed it to ``Server.dispatch``, so they get the same audit,
@@ -34,6 +35,7 @@ from linodemcp.cli.profile import (
     run_profile_show,
     run_profile_use,
 )
+from linodemcp.cli.service import run_install_service_command
 from linodemcp.cli.tools import run_tools_command
 
 __all__ = [
@@ -43,6 +45,7 @@ __all__ = [
     "resolve_active_name",
     "run_audit_command",
     "run_call_command",
+    "run_install_service_command",
     "run_profile_clone",
     "run_profile_command",
     "run_profile_delete",
@@ -0,0 +1,200 @@
+"""``linodemcp install-service`` - register the server as a background service.
+
+Writes a systemd unit that runs ``linodemcp serve`` with the config path
+pinned through ``LINODEMCP_CONFIG_PATH``, a working directory (the config
+directory by default), restart on failure, and stderr logging to journald
+under the service name. systemd stops the unit with SIGTERM, which the server
+already treats as a graceful shutdown. ``--print`` renders the unit without
+writing it; enabling the unit is left to the operator.
+
+Windows services need the SCM handshake the Go binary implements, so this
+twin reports Windows as unsupported.
+
+Mirrors ``go/internal/cli/service_cmd.go``.
+"""
+
+from __future__ import annotations
+
+import argparse
+import shutil
+import sys
+from pathlib import Path
+from typing import TextIO
+
+from linodemcp.cli._shared import EXIT_SUCCESS, EXIT_TOOL_ERROR, EXIT_USAGE_ERROR
+from linodemcp.config import ConfigError, get_config_path, load_from_file
+
+INSTALL_SERVICE_USAGE = """\
+Usage: linodemcp install-service [flags]
+
+Register linodemcp as a managed background service: a systemd unit on Linux,
+a Windows service on Windows. The service runs "linodemcp serve" with the
+config path pinned, so it does not depend on the service account's home.
+
+  --name name        Service name (default "linodemcp").
+  --config path      Config file the service loads (default: the resolved
+                     config path for the installing user).
+  --working-dir dir  Working directory (default: the config file's directory).
+  --user             Linux: install a systemd user unit instead of a system one.
+  --print            Print the unit (or Windows commands) instead of installing.
+  --force            Overwrite an existing unit file.\
+"""
+
+_DEFAULT_SERVICE_NAME = "linodemcp"
+
+# System-wide units live here; --user units under ~/.config/systemd/user.
+_SYSTEM_UNIT_DIR = Path("/etc/systemd/system")
+
+_UNIT_FILE_MODE = 0o644
+
+_UNIT_TEMPLATE = """\
+[Unit]
+Description=LinodeMCP server
+Documentation=https://github.com/chadit/LinodeMCP
+After=network-online.target
+Wants=network-online.target
+
+[Service]
+Type=simple
+ExecStart={exe} serve
+WorkingDirectory={working_dir}
+Environment={environment}
+Restart=on-failure
+RestartSec=5
+KillSignal=SIGTERM
+TimeoutStopSec=30
+StandardOutput=journal
+StandardError=journal
+SyslogIdentifier={name}
+
+[Install]
+WantedBy={wanted_by}
+"""
+
+
+def _build_parser() -> argparse.ArgumentParser:
+    """Flags for ``install-service``; names match the Go FlagSet."""
+    parser = argparse.ArgumentParser(prog="linodemcp install-service")
+    parser.add_argument("--name", default=_DEFAULT_SERVICE_NAME)
+    parser.add_argument("--config", default="")
+    parser.add_argument("--working-dir", default="")
+    parser.add_argument("--user", action="store_true")
+    parser.add_argument("--print", dest="print_only", action="store_true")
+    parser.add_argument("--force", action="store_true")
+    return parser
+
+
+def run_install_service_command(
+    argv: list[str], stdout: TextIO, stderr: TextIO
+) -> int:
+    """Handle ``linodemcp install-service`` and return the exit code."""
+    try:
+        ns = _build_parser().parse_args(argv)
+    except SystemExit:
+        # argparse already wrote its own message to stderr.
+        return EXIT_USAGE_ERROR
+    if not ns.name:
+        stderr.write(f"{INSTALL_SERVICE_USAGE}\n")
+        return EXIT_USAGE_ERROR
+
+    config_path = Path(ns.config) if ns.config else get_config_path()
+    config_path = config_path.expanduser().absolute()
+    working_dir = config_path.parent
+    if ns.working_dir:
+        working_dir = Path(ns.working_dir).absolute()
+
+    _warn_stdio_transport(stderr, config_path)
+
+    if not sys.platform.startswith("linux"):
+        stderr.write(
+            f"install-service is not supported on this platform: {sys.platform}"
+            " (the Windows service runner ships in the Go binary)\n"
+        )
+        return EXIT_TOOL_ERROR
+
+    unit = systemd_unit(
+        name=ns.name,
+        executable=_executable(),
+        config_path=config_path,
+        working_dir=working_dir,
+        user=ns.user,
+    )
+    if ns.print_only:
+        stdout.write(unit)
+        return EXIT_SUCCESS
+    return _install_unit(
+        ns.name, unit, user=ns.user, force=ns.force, out=stdout, err=stderr
+    )
+
+
+def _executable() -> str:
+    """Absolute path of the installed ``linodemcp`` entry point."""
+    found = shutil.which("linodemcp")
+    return str(Path(found or sys.argv[0]).resolve())
+
+
+def _warn_stdio_transport(stderr: TextIO, config_path: Path) -> None:
+    """Flag a stdio transport: a service has no stdin client, so it would exit."""
+    try:
+        cfg = load_from_file(config_path)
+    except (ConfigError, OSError) as exc:
+        stderr.write(
+            f"warning: could not load {config_path} ({exc}); "
+            "the service will fail until it exists\n"
+        )
+        return
+    if cfg.server.transport == "stdio":
+        stderr.write(
+            f'warning: server.transport is "stdio" in {config_path}; a service has '
+            "no stdin client, so configure a network transport before starting it\n"
+        )
+
+
+def _install_unit(
+    name: str, unit: str, *, user: bool, force: bool, out: TextIO, err: TextIO
+) -> int:
+    """Write the unit and print the systemctl steps to enable it."""
+    unit_dir = _SYSTEM_UNIT_DIR
+    if user:
+        unit_dir = Path.home() / ".config" / "systemd" / "user"
+    path = unit_dir / f"{name}.service"
+    if path.exists() and not force:
+        err.write(f"{path} already exists; pass --force to overwrite\n")
+        return EXIT_TOOL_ERROR
+    try:
+        unit_dir.mkdir(parents=True, exist_ok=True)
+        path.write_text(unit, encoding="utf-8")
+        path.chmod(_UNIT_FILE_MODE)
+    except OSError as exc:
+        err.write(f"write {path}: {exc}\n")
+        return EXIT_TOOL_ERROR
+
+    systemctl = "systemctl --user" if user else "systemctl"
+    journalctl = "journalctl --user" if user else "journalctl"
+    out.write(
+        f"Wrote {path}\n\nEnable and start it with:\n  {systemctl} daemon-reload\n"
+        f"  {systemctl} enable --now {name}\n"
+        f"Follow its logs with:\n  {journalctl} -u {name} -f\n"
+    )
+    return EXIT_SUCCESS
+
+
+def systemd_unit(
+    *, name: str, executable: str, config_path: Path, working_dir: Path, user: bool
+) -> str:
+    """Render the unit file (Go's systemdUnit)."""
+    return _UNIT_TEMPLATE.format(
+        exe=_systemd_quote(executable),
+        working_dir=_systemd_quote(str(working_dir)),
+        environment=_systemd_quote(f"LINODEMCP_CONFIG_PATH={config_path}"),
+        name=name,
+        wanted_by="default.target" if user else "multi-user.target",
+    )
+
+
+def _systemd_quote(value: str) -> str:
+    """Double-quote a unit value holding whitespace, quotes, or backslashes."""
+    if not any(ch in value for ch in " \t\"'\\"):
+        return value
+    escaped = value.replace("\\", "\\\\").replace('"', '\\"')
+    return f'"{escaped}"'
@@ -1,6 +1,8 @@
 """Configuration management for LinodeMCP."""
 
 import contextlib
+import fnmatch
+import functools
 import json
 import logging
 import os
@@ -8,8 +10,10 @@ import re
 import tempfile
 from dataclasses import dataclass, field
 from datetime import datetime
+from ipaddress import IPv4Network, IPv6Network, ip_network
 from pathlib import Path
 from typing import Any, cast
+from urllib.parse import urlsplit
 
 import yaml
 
@@ -42,13 +46,28 @@ class PathValidationError(ConfigError):
 
 @dataclass
 class ServerConfig:
-    """Core server settings."""
+    """Core server settings.
+
+    ``token_passthrough`` makes one shared HTTP deployment serve many users:
+    each tool call authenticates to Linode with the token the client sends in
+    the X-Linode-Token header, never a configured one, so environments must
+    not carry a token when it is set. Over stdio there are no headers, so
+    every Linode call fails; it is an HTTP-transport setting.
+
+    ``auth_token``, when set, is the shared secret remote clients of the sse
+    and http transports must send as "Authorization: Bearer <token>". It is
+    the simple alternative to the oauth block, which reads the same header, so
+    the two cannot both be set. Only the Go server serves those transports;
+    this server speaks stdio.
+    """
 
     name: str = "LinodeMCP"
     log_level: str = "info"
     transport: str = "stdio"
     host: str = "127.0.0.1"
     port: int = 8080
+    token_passthrough: bool = False
+    auth_token: str = ""
 
 
 @dataclass
@@ -110,6 +129,9 @@ class ResilienceConfig:
     max_retries: int = 3
     base_retry_delay: float = 1.0
     max_retry_delay: float = 30.0
+    # Bounds how long one call keeps retrying, waits included; 0 leaves
+    # max_retries as the only bound.
+    max_retry_elapsed: float = 0.0
     pool_max_connections: int = 10
     pool_max_keepalive_connections: int = 10
     pool_keepalive_expiry: float = 30.0
@@ -145,18 +167,189 @@ class ObservabilityConfig:
 
 @dataclass
 class LinodeConfig:
-    """Linode API settings."""
+    """Linode API settings.
+
+    ``api_url`` must be an http(s) URL ending in an API version path such as
+    https://api.linode.com/v4. ``api_version``, when set, pins the version by
+    replacing the URL's version segment (see ``base_url``). ``headers`` are
+    extra HTTP headers attached to every API request, for deployments that
+    front the API with a gateway expecting its own auth or tracing headers.
+
+    ``backend`` picks what answers the environment's API calls: ``"api"``
+    (the default) sends them to ``api_url``, which also covers API-compatible
+    stand-ins such as a local simulator or an internal proxy, and
+    ``"fixtures"`` answers them from recorded JSON files under
+    ``fixtures_dir`` without touching the network.
+    """
 
     api_url: str = ""
     token: str = ""
+    api_version: str = ""
+    headers: dict[str, str] = field(default_factory=dict[str, str])
+    backend: str = ""
+    fixtures_dir: str = ""
+
+    def base_url(self) -> str:
+        """The URL API requests are built on: api_url with its version
+        segment replaced by the pinned api_version (Go's BaseURL)."""
+        return with_api_version(self.api_url, self.api_version)
+
+
+# A Linode API version path segment: v4, v4beta.
+_API_VERSION_RE = re.compile(r"^v[0-9]+(beta)?$")
+
+# An HTTP header name (an RFC 9110 token).
+_HEADER_NAME_RE = re.compile(r"^[!#$%&'*+\-.^_`|~0-9A-Za-z]+$")
+
+# Headers the client sets on every request, so configuring them would be
+# silently overwritten.
+_RESERVED_HEADERS = ("Authorization", "Content-Type", "Host", "User-Agent")
+
+
+def with_api_version(api_url: str, version: str) -> str:
+    """Return api_url with its trailing version segment replaced by version.
+
+    An empty version, or a URL without a version segment, returns api_url
+    with only its trailing slash trimmed. Mirrors Go's WithAPIVersion.
+    """
+    api_url = api_url.rstrip("/")
+    if not version:
+        return api_url
+    head, sep, last = api_url.rpartition("/")
+    if not sep or not _API_VERSION_RE.match(last):
+        return api_url
+    return f"{head}/{version}"
+
+
+def validate_api_version(version: str) -> None:
+    """Check a pinned API version such as v4 or v4beta. Shared by config
+    validation and the per-call API version override header."""
+    if not _API_VERSION_RE.match(version):
+        msg = f"API version must look like 'v4' or 'v4beta': got {version!r}"
+        raise ConfigInvalidError(msg)
+
+
+def _validate_api_url(env_name: str, linode: LinodeConfig) -> None:
+    """Check an environment's API URL is an absolute http(s) URL ending in
+    an API version segment, and that a pinned version is well formed."""
+    prefix = f"invalid Linode API URL: environment '{env_name}'"
+    parsed = urlsplit(linode.api_url)
+    if parsed.scheme not in ("http", "https"):
+        msg = f"{prefix}: scheme must be http or https, got {parsed.scheme!r}"
+        raise ConfigInvalidError(msg)
+    if not parsed.netloc:
+        msg = f"{prefix}: missing host"
+        raise ConfigInvalidError(msg)
+    if parsed.query or parsed.fragment:
+        msg = f"{prefix}: must not carry a query or fragment"
+        raise ConfigInvalidError(msg)
+    last = parsed.path.strip("/").rpartition("/")[2]
+    if not _API_VERSION_RE.match(last):
+        msg = (
+            f"{prefix}: path {parsed.path!r} must end in an API version "
+            "such as /v4"
+        )
+        raise ConfigInvalidError(msg)
+    if linode.api_version:
+        try:
+            validate_api_version(linode.api_version)
+        except ConfigInvalidError as exc:
+            msg = f"environment '{env_name}': {exc}"
+            raise ConfigInvalidError(msg) from exc
+
+
+# Environment backends. See LinodeConfig.
+BACKEND_API = "api"
+BACKEND_FIXTURES = "fixtures"
+
+
+def _validate_backend(env_name: str, linode: LinodeConfig) -> None:
+    """Check an environment's backend is a known one, and that fixtures_dir
+    is set exactly when the fixtures backend reads it. Mirrors Go's
+    validateBackend."""
+    prefix = f"invalid Linode API backend: environment '{env_name}'"
+    if linode.backend in ("", BACKEND_API):
+        if linode.fixtures_dir:
+            msg = (
+                f"{prefix}: fixturesDir is only read by the "
+                f"{BACKEND_FIXTURES!r} backend"
+            )
+            raise ConfigInvalidError(msg)
+        return
+    if linode.backend == BACKEND_FIXTURES:
+        if not linode.fixtures_dir:
+            msg = f"{prefix}: the {BACKEND_FIXTURES!r} backend needs fixturesDir"
+            raise ConfigInvalidError(msg)
+        return
+    msg = (
+        f"{prefix}: got {linode.backend!r}, want {BACKEND_API!r} or "
+        f"{BACKEND_FIXTURES!r}"
+    )
+    raise ConfigInvalidError(msg)
+
+
+def _validate_headers(env_name: str, headers: dict[str, str]) -> None:
+    """Check an environment's extra API headers: each name must be a valid
+    header name the client does not set itself, and no value may carry CR,
+    LF, or NUL. Mirrors Go's validateHeaders."""
+    prefix = f"invalid Linode API header: environment '{env_name}'"
+    for name in sorted(headers):
+        value = headers[name]
+        if not _HEADER_NAME_RE.match(name):
+            msg = f"{prefix}: {name!r} is not a valid header name"
+            raise ConfigInvalidError(msg)
+        for reserved in _RESERVED_HEADERS:
+            if name.lower() == reserved.lower():
+                msg = (
+                    f"{prefix}: {reserved} is set by the client and cannot be "
+                    "overridden"
+                )
+                raise ConfigInvalidError(msg)
+        if any(char in value for char in "\r\n\x00"):
+            msg = f"{prefix}: value of {name} must not contain CR, LF, or NUL"
+            raise ConfigInvalidError(msg)
+
+
+# Keeps room in Linode's 32 to 64 character label limits for the part of the
+# label the caller chooses.
+MAX_LABEL_NAMESPACE_LENGTH = 16
+
+# A label_namespace: letters, digits, and '-', '_', '.', starting and ending
+# with a letter or digit, so the prefixed label is still a valid Linode label.
+_LABEL_NAMESPACE_RE = re.compile(r"^[A-Za-z0-9]([A-Za-z0-9_.-]*[A-Za-z0-9])?$")
+
+
+def _validate_label_namespace(env_name: str, namespace: str) -> None:
+    """Check an environment's label_namespace can prefix a Linode label.
+    Mirrors Go's validateLabelNamespace."""
+    if not namespace:
+        return
+    if len(namespace) > MAX_LABEL_NAMESPACE_LENGTH or not (
+        _LABEL_NAMESPACE_RE.fullmatch(namespace)
+    ):
+        msg = (
+            f"invalid label_namespace: environment '{env_name}': got "
+            f"{namespace!r}: use at most {MAX_LABEL_NAMESPACE_LENGTH} letters, "
+            "digits, '-', '_', or '.', starting and ending with a letter or digit"
+        )
+        raise ConfigInvalidError(msg)
 
 
 @dataclass
 class EnvironmentConfig:
-    """Settings for a named environment."""
+    """Settings for a named environment. ``label_namespace``, when set,
+    scopes the environment to one team or agent on a shared account: create
+    tools prefix every label with "<namespace>-" and list tools called with
+    namespace_only=true return only resources carrying that prefix."""
 
     label: str = ""
     linode: LinodeConfig = field(default_factory=LinodeConfig)
+    label_namespace: str = ""
+
+    def label_namespace_prefix(self) -> str:
+        """The prefix create tools put on labels, or "" without a
+        label_namespace."""
+        return f"{self.label_namespace}-" if self.label_namespace else ""
 
 
 @dataclass(frozen=True)
@@ -268,18 +461,23 @@ class AuditConfig:
 
     The JSONL sink is always on (Phase 2); these fields tune retention,
     the optional SQLite sink (Phase 3b), the optional PII redaction tier
-    (Phase 4c), and named custom reports (Phase 4a/b).
+    (Phase 4c), named custom reports (Phase 4a/b), and cost attribution.
     ``retention_days`` of 0 means "never delete"; an absent key defaults
     to DEFAULT_AUDIT_RETENTION_DAYS. ``redact_pii`` defaults to True so
     PII fields are redacted alongside credentials; operators opt out by
     setting it to False. Both absent-vs-explicit distinctions are
     handled at parse time via ``dict.get`` returning None.
+    ``cost_attribution`` prices each instance, volume, and NodeBalancer
+    create/resize/delete and records the estimated monthly cost change
+    on its audit event; off by default because pricing costs extra API
+    reads per write.
     """
 
     retention_days: int = DEFAULT_AUDIT_RETENTION_DAYS
     redact_pii: bool = DEFAULT_AUDIT_REDACT_PII
     sqlite: AuditSQLiteConfig = field(default_factory=AuditSQLiteConfig)
     reports: dict[str, ReportConfig] = field(default_factory=dict[str, ReportConfig])
+    cost_attribution: bool = False
 
 
 @dataclass
@@ -300,6 +498,374 @@ class TwoStageConfig:
     opt_in: dict[str, bool] = field(default_factory=dict[str, bool])
 
 
+# Schema drift modes; mirrors Go's config.SchemaDrift*. Lenient (the default)
+# ignores response fields the proto models do not declare, warn decodes
+# leniently but logs the first unknown field per response, and strict fails
+# the call so drift surfaces immediately.
+SCHEMA_DRIFT_LENIENT = "lenient"
+SCHEMA_DRIFT_WARN = "warn"
+SCHEMA_DRIFT_STRICT = "strict"
+_SCHEMA_DRIFT_MODES = (SCHEMA_DRIFT_LENIENT, SCHEMA_DRIFT_WARN, SCHEMA_DRIFT_STRICT)
+
+
+@dataclass
+class SchemaDriftConfig:
+    """How tool output reacts when a Linode response carries fields the proto
+    models don't capture, so maintainers learn when Linode adds fields."""
+
+    mode: str = SCHEMA_DRIFT_LENIENT
+
+
+# Releases endpoint the version check queries when update_check.url is unset.
+DEFAULT_UPDATE_CHECK_URL = (
+    "https://api.github.com/repos/chadit/LinodeMCP/releases/latest"
+)
+
+
+@dataclass
+class UpdateCheckConfig:
+    """GitHub releases check run at startup and by linode_version_check.
+
+    ``enabled`` defaults to True; air-gapped installs set it False so
+    nothing is fetched. ``url`` overrides the releases endpoint for mirrors.
+    """
+
+    enabled: bool = True
+    url: str = DEFAULT_UPDATE_CHECK_URL
+
+
+# Linode status page summary linode_api_status reads when status_page.url is
+# unset.
+DEFAULT_STATUS_PAGE_URL = "https://status.linode.com/api/v2/summary.json"
+
+
+@dataclass
+class StatusPageConfig:
+    """Points linode_api_status at Linode's status page.
+
+    ``url`` is the Statuspage summary document; override it for mirrors and
+    tests.
+    """
+
+    url: str = DEFAULT_STATUS_PAGE_URL
+
+
+# OAuth scopes granting the read (meta and read tools) and write (every
+# tool) toolsets when oauth.read_scope / oauth.write_scope are unset.
+DEFAULT_OAUTH_READ_SCOPE = "linodemcp:read"
+DEFAULT_OAUTH_WRITE_SCOPE = "linodemcp:write"
+
+
+@dataclass
+class OAuthTokenExchangeConfig:
+    """Per-call Linode token minting via OAuth token exchange (RFC 8693).
+
+    The caller's access token is exchanged at ``token_url`` for a token
+    scoped to ``audience``. Requires server.tokenPassthrough, so no shared
+    Linode token is configured.
+    """
+
+    enabled: bool = False
+    token_url: str = ""
+    audience: str = ""
+
+
+@dataclass
+class OAuthConfig:
+    """MCP authorization for the HTTP transport.
+
+    With ``enabled`` every tool call must carry an ``Authorization: Bearer``
+    access token issued by ``issuer`` for ``resource`` (this server's URL),
+    validated at ``introspection_url`` (RFC 7662) with the client
+    credentials. ``read_scope`` grants the meta and read tools,
+    ``write_scope`` every tool.
+    """
+
+    enabled: bool = False
+    issuer: str = ""
+    resource: str = ""
+    introspection_url: str = ""
+    client_id: str = ""
+    client_secret: str = ""
+    read_scope: str = DEFAULT_OAUTH_READ_SCOPE
+    write_scope: str = DEFAULT_OAUTH_WRITE_SCOPE
+    token_exchange: OAuthTokenExchangeConfig = field(
+        default_factory=OAuthTokenExchangeConfig
+    )
+
+
+@dataclass
+class ObjectStorageConfig:
+    """Object Storage defaults.
+
+    ``preferred_regions`` is the order linode_object_storage_bucket_create
+    tries for region=auto: the first entry with an Object Storage endpoint
+    wins. When it is empty (or no entry has an endpoint) the region with the
+    lowest measured endpoint latency is picked instead.
+    """
+
+    preferred_regions: list[str] = field(default_factory=list[str])
+
+
+# Output format values. "GiB" reports sizes in binary units (the units Linode
+# measures in) and "GB" in decimal units; "both" shows the monthly price with
+# the hourly one after it.
+SIZE_UNITS = ("GiB", "GB")
+PRICE_PERIODS = ("monthly", "hourly", "both")
+
+
+@dataclass
+class OutputFormatConfig:
+    """Default display annotations for tool results.
+
+    With ``size_unit`` set, size fields gain a ``<field>_display`` string in
+    that unit; with ``price_period`` set, price objects gain a ``display``
+    string for that period. Raw values are never changed, and both empty (the
+    default) leaves results untouched. ``cloud_manager_links`` adds a
+    ``cloud_manager_url`` to each resource Cloud Manager has a page for. A
+    call can override any field with its ``output_format`` argument.
+    """
+
+    size_unit: str = ""
+    price_period: str = ""
+    currency_symbol: bool = True
+    cloud_manager_links: bool = False
+
+
+# Read-after-write defaults and bounds. The check reads at most
+# MAX_READ_AFTER_WRITE_ATTEMPTS times, so with the largest interval a call
+# waits well under a minute for a write that never shows up.
+DEFAULT_READ_AFTER_WRITE_ATTEMPTS = 5
+DEFAULT_READ_AFTER_WRITE_INTERVAL_MS = 500
+MAX_READ_AFTER_WRITE_ATTEMPTS = 20
+MAX_READ_AFTER_WRITE_INTERVAL_MS = 5000
+
+
+@dataclass
+class ReadAfterWriteConfig:
+    """Read-after-write check for write tools.
+
+    Some Linode endpoints keep serving the old state for a moment after a
+    write; with ``enabled`` set, a write tool re-reads every resource it
+    created, updated, or deleted, up to ``attempts`` times ``interval_ms``
+    apart, until the change is visible, and only then returns. A call can turn
+    the check on or off with its ``read_after_write`` argument.
+    """
+
+    enabled: bool = False
+    attempts: int = DEFAULT_READ_AFTER_WRITE_ATTEMPTS
+    interval_ms: int = DEFAULT_READ_AFTER_WRITE_INTERVAL_MS
+
+
+@dataclass
+class QuotaConfig:
+    """Per-resource account limits linode_quota_report checks counts against.
+
+    The Linode API does not expose most entity limits, so ``limits`` carries
+    the ones the operator knows, keyed by the report's resource names
+    (instances, volumes, nodebalancers, lke_clusters, ...).
+    """
+
+    limits: dict[str, int] = field(default_factory=dict[str, int])
+
+
+@dataclass
+class AnnotationsConfig:
+    """Local store behind linode_annotations_set and linode_annotations_get.
+
+    Each environment's annotations live in ``<dir>/<environment>.json``; an
+    empty ``dir`` means "annotations" under the audit log directory.
+    """
+
+    dir: str = ""
+
+
+@dataclass
+class LKEConfig:
+    """Opt-in LKE behavior.
+
+    ``workload_ping`` lets linode_lke_workload_ping fetch a cluster's
+    kubeconfig and use its admin credentials against the Kubernetes API; it is
+    off by default because the other LKE tools only talk to the Linode API.
+    """
+
+    workload_ping: bool = False
+
+
+@dataclass
+class InstanceDefaultsConfig:
+    """What linode_instance_create applies when a call leaves it out.
+
+    ``authorized_key_labels`` names SSH keys on the token's profile (see
+    linode_sshkey_list) whose public keys are authorized on every instance
+    deployed from an image, so each instance an agent creates is reachable by
+    the team's keys. A call that passes ``authorized_keys``, even an empty
+    list, replaces them. Labels resolve at create time, and a label with no
+    matching key fails the create rather than leaving a key off.
+    """
+
+    authorized_key_labels: list[str] = field(default_factory=list[str])
+
+
+# The syntax of a tools entry once an enabled entry's leading "!" is removed: a
+# tool name or a glob of one.
+_TOOL_PATTERN = re.compile(r"^[a-z0-9_*?]+$")
+
+
+@dataclass
+class ToolsConfig:
+    """Tailors the registered tool surface below what the active profile allows.
+
+    Entries are tool names or globs of them using ``*`` and ``?``
+    (``linode_lke_*``). With any plain ``enabled`` entry, only matching tools
+    stay; an ``enabled`` entry starting with ``!`` (``!linode_*_delete``) and
+    every ``disabled`` entry remove matching tools. Like ``read_only`` it
+    applies on every profile resolution, so a profile switch cannot bring a
+    filtered tool back.
+    """
+
+    enabled: list[str] = field(default_factory=list[str])
+    disabled: list[str] = field(default_factory=list[str])
+
+    def filters(self) -> bool:
+        """Report whether any tools entry is set."""
+        return bool(self.enabled or self.disabled)
+
+    def allows(self, name: str) -> bool:
+        """Report whether the named tool survives the filter (Go's Allows)."""
+        kept, restricted = False, False
+        for pattern in self.enabled:
+            if pattern.startswith("!"):
+                if fnmatch.fnmatchcase(name, pattern[1:]):
+                    return False
+                continue
+            restricted = True
+            kept = kept or fnmatch.fnmatchcase(name, pattern)
+        if any(fnmatch.fnmatchcase(name, pattern) for pattern in self.disabled):
+            return False
+        return kept or not restricted
+
+
+@dataclass
+class ConfirmLabelConfig:
+    """The label echo for the highest-risk deletes.
+
+    With ``enabled`` set, a confirmed linode_instance_delete or
+    linode_lke_cluster_delete call, or a two-stage plan for one, must also pass
+    the resource's exact label as ``confirm_label``, the way GitHub asks for a
+    repository's name before deleting it, so a mixed-up ID fails instead of
+    deleting the wrong resource.
+    """
+
+    enabled: bool = False
+
+
+@dataclass
+class NodeBalancerProbeConfig:
+    """The allowlist behind linode_nodebalancer_backend_probe.
+
+    The tool connects from this host straight to NodeBalancer backends;
+    ``allowed_cidrs`` lists the networks it may reach (IPv4 or IPv6 CIDRs, or
+    bare addresses), and a backend outside them is reported, not probed. The
+    tool is off while the list is empty.
+    """
+
+    allowed_cidrs: list[str] = field(default_factory=list[str])
+
+    def probe_networks(self) -> list[IPv4Network | IPv6Network]:
+        """Parse ``allowed_cidrs``; a bare address becomes a single-host
+        network (Go's ProbePrefixes)."""
+        networks: list[IPv4Network | IPv6Network] = []
+        for raw in self.allowed_cidrs:
+            try:
+                networks.append(ip_network(str(raw).strip(), strict=False))
+            except ValueError as exc:
+                msg = (
+                    "nodebalancer_probe.allowed_cidrs entry must be a CIDR "
+                    f'or IP address: "{raw}"'
+                )
+                raise ConfigInvalidError(msg) from exc
+        return networks
+
+
+@dataclass
+class OfflineCacheConfig:
+    """The offline cache behind degraded-mode read tools.
+
+    With ``enabled`` set, each successful read tool result is saved under
+    ``<dir>/<environment>/``, and when the Linode API cannot be reached a read
+    tool answers from the last result saved for the same arguments, marked
+    with when it was saved. An empty ``dir`` means "offline-cache" under the
+    audit log directory. Off by default because it keeps copies of API
+    responses on disk.
+    """
+
+    enabled: bool = False
+    dir: str = ""
+
+
+DEFAULT_RESULT_SUMMARY_THRESHOLD_BYTES = 65536
+DEFAULT_RESULT_SUMMARY_MAX_TOKENS = 1024
+
+
+@dataclass
+class ResultSummaryConfig:
+    """Summaries of oversized tool results.
+
+    With ``enabled`` set, a successful result whose text is larger than
+    ``threshold_bytes`` is replaced by a summary of at most ``max_tokens`` that
+    the client's own model writes through an MCP sampling request, and the full
+    result is kept in memory for ``linode_result_get``. A client that does not
+    support sampling gets the full result. A zero ``threshold_bytes`` or
+    ``max_tokens`` means the default.
+    """
+
+    enabled: bool = False
+    threshold_bytes: int = 0
+    max_tokens: int = 0
+
+
+# Caps a call's timeout_seconds argument when tool_timeouts.max_seconds is 0.
+# It matches the longest built-in wait (a Managed Database restore), so no
+# wait-style tool is cut short by default.
+DEFAULT_TOOL_TIMEOUT_MAX_SECONDS = 1800
+
+
+@dataclass
+class ToolTimeoutConfig:
+    """How long a tool call may run.
+
+    Without a timeout a call has no overall deadline and each Linode API
+    request it makes is bounded at 30 seconds. With one, the whole call must
+    finish within it and each API request may take up to that long. ``tools``
+    sets the timeout per tool by name and ``default_seconds`` for every other
+    tool (0 keeps the built-in behavior). A call can ask for its own with the
+    ``timeout_seconds`` argument, clamped to ``max_seconds``; a zero
+    ``max_seconds`` means the default.
+    """
+
+    default_seconds: int = 0
+    max_seconds: int = 0
+    tools: dict[str, int] = field(default_factory=dict[str, int])
+
+
+@dataclass
+class StructuredContentConfig:
+    """Machine-readable copies of a tool result.
+
+    With ``enabled`` set (the default), a successful result whose text is a
+    JSON object also carries that object as the MCP result's
+    ``structuredContent``; clients that predate structured content ignore it
+    and read the text. ``embedded_resource`` also attaches the JSON as an
+    ``application/json`` embedded resource. It is off by default because a
+    client that does not understand embedded resources may show the result
+    twice.
+    """
+
+    enabled: bool = True
+    embedded_resource: bool = False
+
+
 @dataclass
 class Config:
     """Full LinodeMCP configuration."""
@@ -311,6 +877,10 @@ class Config:
         default_factory=dict[str, EnvironmentConfig]
     )
     active_profile: str = ""
+    # read_only keeps every tool that can change Linode state off the server,
+    # whatever the active profile allows (LINODEMCP_READ_ONLY sets it too).
+    read_only: bool = False
+    tools: ToolsConfig = field(default_factory=ToolsConfig)
     profiles: dict[str, UserProfileConfig] = field(
         default_factory=dict[str, UserProfileConfig]
     )
@@ -319,6 +889,31 @@ class Config:
     )
     audit: AuditConfig = field(default_factory=AuditConfig)
     two_stage: TwoStageConfig = field(default_factory=TwoStageConfig)
+    schema_drift: SchemaDriftConfig = field(default_factory=SchemaDriftConfig)
+    update_check: UpdateCheckConfig = field(default_factory=UpdateCheckConfig)
+    status_page: StatusPageConfig = field(default_factory=StatusPageConfig)
+    oauth: OAuthConfig = field(default_factory=OAuthConfig)
+    object_storage: ObjectStorageConfig = field(default_factory=ObjectStorageConfig)
+    output_format: OutputFormatConfig = field(default_factory=OutputFormatConfig)
+    quotas: QuotaConfig = field(default_factory=QuotaConfig)
+    read_after_write: ReadAfterWriteConfig = field(
+        default_factory=ReadAfterWriteConfig
+    )
+    annotations: AnnotationsConfig = field(default_factory=AnnotationsConfig)
+    lke: LKEConfig = field(default_factory=LKEConfig)
+    nodebalancer_probe: NodeBalancerProbeConfig = field(
+        default_factory=NodeBalancerProbeConfig
+    )
+    offline_cache: OfflineCacheConfig = field(default_factory=OfflineCacheConfig)
+    result_summary: ResultSummaryConfig = field(default_factory=ResultSummaryConfig)
+    tool_timeouts: ToolTimeoutConfig = field(default_factory=ToolTimeoutConfig)
+    structured_content: StructuredContentConfig = field(
+        default_factory=StructuredContentConfig
+    )
+    instance_defaults: InstanceDefaultsConfig = field(
+        default_factory=InstanceDefaultsConfig
+    )
+    confirm_label: ConfirmLabelConfig = field(default_factory=ConfirmLabelConfig)
 
     def select_environment(self, user_input: str) -> EnvironmentConfig:
         """Select a Linode environment from the config."""
@@ -340,6 +935,54 @@ class Config:
 
         return next(iter(self.environments.values()))
 
+    def resolve_environment(self, name: str) -> EnvironmentConfig:
+        """Look up the environment a tool call names.
+
+        An exact key match wins, then a case-insensitive one. Unlike
+        select_environment this never falls back to another environment: an
+        unknown name raises EnvironmentNotFoundError listing the configured
+        names and, when one is close, suggesting it.
+        """
+        return self.environments[self.resolve_environment_name(name)]
+
+    def resolve_environment_name(self, name: str) -> str:
+        """Return the configured key for the environment a call names.
+
+        resolve_environment returning the key instead of the environment, for
+        callers that store state per environment and need one spelling of its
+        name.
+        """
+        trimmed = name.strip()
+        if not trimmed:
+            msg = "environment name cannot be empty"
+            raise ValueError(msg)
+        if trimmed in self.environments:
+            return trimmed
+
+        names = self.environment_names()
+        if not names:
+            msg = (
+                "environment not found in configuration: "
+                "no provider environments configured"
+            )
+            raise EnvironmentNotFoundError(msg)
+        for env_name in names:
+            if env_name.lower() == trimmed.lower():
+                return env_name
+
+        msg = (
+            f"environment not found in configuration: {json.dumps(trimmed)}; "
+            f"valid environments: {', '.join(names)}"
+        )
+        suggestion = _closest_environment_name(trimmed, names)
+        if suggestion:
+            msg += f"; did you mean {json.dumps(suggestion)}?"
+        raise EnvironmentNotFoundError(msg)
+
+    def environment_names(self) -> list[str]:
+        """Return the configured environment names, sorted."""
+        return sorted(self.environments)
+
     def get_linode_environment(self, environment_name: str) -> LinodeConfig:
         """Get the LinodeConfig for a named environment."""
         if not self.environments:
@@ -436,12 +1079,14 @@ def get_config_path() -> Path:
 
 
 def _parse_config_data(data: str) -> dict[str, Any]:
-    """Parse configuration data from JSON or YAML."""
+    """Parse configuration data from JSON or YAML, rejecting keys no setting
+    reads (see ``_check_unknown_keys``)."""
     data_stripped = data.strip()
     if data_stripped.startswith("{"):
         try:
             parsed: object = json.loads(data_stripped)
             if isinstance(parsed, dict):
+                _check_unknown_keys(data)
                 return cast("dict[str, Any]", parsed)
         except json.JSONDecodeError:
             pass
@@ -449,6 +1094,7 @@ def _parse_config_data(data: str) -> dict[str, Any]:
     try:
         parsed = yaml.safe_load(data_stripped)
         if isinstance(parsed, dict):
+            _check_unknown_keys(data)
             return cast("dict[str, Any]", parsed)
         msg = "config must be a YAML mapping, not a scalar or list"
         raise ConfigMalformedError(msg)
@@ -457,6 +1103,72 @@ def _parse_config_data(data: str) -> dict[str, Any]:
         raise ConfigMalformedError(msg) from e
 
 
+@functools.cache
+def config_schema() -> dict[str, Any]:
+    """Return the config file's JSON Schema.
+
+    A copy of docs/contracts/config.schema.json, which ``make config-schema``
+    generates from the Go config structs, so both loaders accept the same keys.
+    """
+    path = Path(__file__).resolve().parent / "config.schema.json"
+    loaded: dict[str, Any] = json.loads(path.read_text(encoding="utf-8"))
+    return loaded
+
+
+def _check_unknown_keys(data: str) -> None:
+    """Fail when the config carries a key no setting reads, so a misspelled
+    setting is an error at load instead of a silently ignored line. Every
+    unknown key is reported with its dotted path and line; mirrors Go's
+    checkUnknownKeys. A JSON file PyYAML cannot compose is not checked."""
+    try:
+        root = yaml.compose(data)
+    except yaml.YAMLError:
+        return
+    if root is None:
+        return
+
+    unknown: list[str] = []
+    _walk_known_keys(root, config_schema(), "", unknown)
+    if unknown:
+        msg = f"unknown configuration key: {', '.join(unknown)}"
+        raise ConfigMalformedError(msg)
+
+
+_YAML_MERGE_TAG = "tag:yaml.org,2002:merge"
+
+
+def _walk_known_keys(
+    node: yaml.Node, schema: dict[str, Any], path: str, unknown: list[str]
+) -> None:
+    """Record every mapping key under node the schema does not declare. Map
+    keys (environment and profile names) are free-form, so only their values
+    are walked; a merge key contributes the merged mapping's keys."""
+    if isinstance(node, yaml.MappingNode):
+        properties = schema.get("properties")
+        values = schema.get("additionalProperties")
+        for key_node, value_node in node.value:
+            if key_node.tag == _YAML_MERGE_TAG:
+                _walk_known_keys(value_node, schema, path, unknown)
+                continue
+            key = str(key_node.value)
+            key_path = f"{path}.{key}" if path else key
+            if isinstance(properties, dict):
+                if key not in properties:
+                    unknown.append(f"{key_path} (line {key_node.start_mark.line + 1})")
+                    continue
+                _walk_known_keys(value_node, properties[key], key_path, unknown)
+            elif isinstance(values, dict):
+                _walk_known_keys(value_node, values, key_path, unknown)
+    elif isinstance(node, yaml.SequenceNode):
+        items = schema.get("items")
+        for index, item in enumerate(node.value):
+            if isinstance(items, dict):
+                _walk_known_keys(item, items, f"{path}[{index}]", unknown)
+            elif isinstance(item, yaml.MappingNode):
+                # A merge key's list of mappings, each merged in turn.
+                _walk_known_keys(item, schema, path, unknown)
+
+
 def _apply_defaults(data: dict[str, Any]) -> None:
     """Apply default values to configuration."""
     data.setdefault("server", {})
@@ -490,6 +1202,9 @@ def _apply_environment_overrides(data: dict[str, Any]) -> None:
         data.setdefault("server", {})
         data["server"]["logLevel"] = log_level
 
+    if read_only := os.getenv("LINODEMCP_READ_ONLY"):
+        data["read_only"] = read_only.lower() in ("true", "1")
+
     data.setdefault("environments", {})
     data["environments"].setdefault("default", {})
 
@@ -506,6 +1221,103 @@ def _apply_environment_overrides(data: dict[str, Any]) -> None:
             data["environments"]["default"]["label"] = "Default"
 
     _apply_audit_overrides(data)
+    _apply_env_environments(data)
+
+
+# Per-environment variables that configure Linode environments without a
+# config file: LINODEMCP_ENV_<NAME>_{URL,API_VERSION,TOKEN,TOKEN_FILE,LABEL}.
+# <NAME> is lowercased, so LINODEMCP_ENV_PROD_TOKEN configures "prod". The names are
+# dynamic, so they are found by scanning os.environ; env-vars.txt documents
+# the family instead of listing it. Suffixes go longest first so _TOKEN_FILE
+# is not read as _TOKEN. Mirrors go/internal/config/env_environments.go.
+_ENV_ENVIRONMENT_PREFIX = "LINODEMCP_ENV_"
+_ENV_ENVIRONMENT_SUFFIXES = (
+    "_API_VERSION",
+    "_TOKEN_FILE",
+    "_TOKEN",
+    "_URL",
+    "_LABEL",
+)
+
+
+def _env_environments_configured() -> bool:
+    """Whether any LINODEMCP_ENV_* variable is set (Go's envEnvironmentsConfigured).
+
+    ``load_from_file`` uses it to accept a missing config file: a container
+    configured entirely from the environment has nothing to mount.
+    """
+    return any(
+        key.startswith(_ENV_ENVIRONMENT_PREFIX) and value
+        for key, value in os.environ.items()
+    )
+
+
+def _collect_env_environments() -> dict[str, dict[str, str]]:
+    """Group the set LINODEMCP_ENV_* variables by environment name."""
+    found: dict[str, dict[str, str]] = {}
+    for key, value in os.environ.items():
+        if not key.startswith(_ENV_ENVIRONMENT_PREFIX) or not value:
+            continue
+        rest = key.removeprefix(_ENV_ENVIRONMENT_PREFIX)
+        for suffix in _ENV_ENVIRONMENT_SUFFIXES:
+            if not rest.endswith(suffix):
+                continue
+            upper = rest.removesuffix(suffix)
+            if upper:
+                found.setdefault(upper.lower(), {})[suffix] = value
+            break
+    return found
+
+
+def _apply_env_environments(data: dict[str, Any]) -> None:
+    """Merge LINODEMCP_ENV_* variables into the raw environments dict.
+
+    A variable overrides the matching file value, and an environment the file
+    does not define is created, labelled with its name unless _LABEL is set.
+    A token given both inline and as a file, or a token file that cannot be
+    read, raises ConfigInvalidError rather than silently picking one.
+    """
+    found = _collect_env_environments()
+    if not found:
+        return
+
+    environments = data.setdefault("environments", {})
+    for name in sorted(found):
+        values = found[name]
+        env = environments.setdefault(name, {})
+        if values.get("_LABEL"):
+            env["label"] = values["_LABEL"]
+        elif not env.get("label"):
+            env["label"] = name
+
+        linode = env.setdefault("linode", {})
+        if values.get("_URL"):
+            linode["apiUrl"] = values["_URL"]
+        if values.get("_API_VERSION"):
+            linode["apiVersion"] = values["_API_VERSION"]
+
+        token = _resolve_env_token(name, values)
+        if token:
+            linode["token"] = token
+
+
+def _resolve_env_token(name: str, values: dict[str, str]) -> str:
+    """The inline token, or the trimmed contents of the token file.
+
+    Secrets mounted by Kubernetes and Docker end in a newline, which would
+    otherwise become part of the bearer token.
+    """
+    token_file = values.get("_TOKEN_FILE", "")
+    if not token_file:
+        return values.get("_TOKEN", "")
+    if values.get("_TOKEN"):
+        msg = f"set either _TOKEN or _TOKEN_FILE, not both: environment '{name}'"
+        raise ConfigInvalidError(msg)
+    try:
+        return Path(token_file).read_text(encoding="utf-8").strip()
+    except OSError as e:
+        msg = f"cannot read token file: environment '{name}': {e}"
+        raise ConfigInvalidError(msg) from e
 
 
 def _apply_audit_overrides(data: dict[str, Any]) -> None:
@@ -553,6 +1365,18 @@ def validate_config(cfg: Config) -> None:
         msg = "log level cannot be empty"
         raise ConfigInvalidError(msg)
 
+    validate_transport(cfg.server.transport)
+    if cfg.schema_drift.mode not in _SCHEMA_DRIFT_MODES:
+        msg = (
+            "schema_drift.mode must be 'lenient', 'warn', or 'strict': "
+            f"got {cfg.schema_drift.mode!r}"
+        )
+        raise ConfigInvalidError(msg)
+
+    if cfg.server.auth_token and cfg.oauth.enabled:
+        msg = "server.authToken cannot be combined with oauth.enabled"
+        raise ConfigInvalidError(msg)
+
     if not cfg.environments:
         msg = "no environments defined in configuration"
         raise ConfigInvalidError(msg)
@@ -562,6 +1386,22 @@ def validate_config(cfg: Config) -> None:
             msg = "environment name cannot be empty"
             raise ConfigInvalidError(msg)
 
+        if env.linode.api_url:
+            _validate_api_url(env_name, env.linode)
+
+        _validate_headers(env_name, env.linode.headers)
+        _validate_backend(env_name, env.linode)
+        _validate_label_namespace(env_name, env.label_namespace)
+
+        if cfg.server.token_passthrough:
+            if env.linode.token:
+                msg = (
+                    f"environment '{env_name}': server.tokenPassthrough is "
+                    "set, so environments must not carry a token"
+                )
+                raise ConfigInvalidError(msg)
+            continue
+
         if env.linode.api_url or env.linode.token:
             if not env.linode.api_url:
                 msg = (
@@ -580,9 +1420,188 @@ def validate_config(cfg: Config) -> None:
         msg = "audit.retention_days cannot be negative"
         raise ConfigInvalidError(msg)
 
+    _validate_oauth(cfg)
+    if cfg.offline_cache.enabled and cfg.server.token_passthrough:
+        msg = "offline_cache cannot be combined with server.tokenPassthrough"
+        raise ConfigInvalidError(msg)
+    validate_output_format(cfg.output_format.size_unit, cfg.output_format.price_period)
+    for resource, limit in cfg.quotas.limits.items():
+        if limit < 0:
+            msg = (
+                "quota limits cannot be negative: "
+                f"quotas.limits.{resource} is {limit}"
+            )
+            raise ConfigInvalidError(msg)
+    _validate_read_after_write(cfg.read_after_write)
+    if cfg.result_summary.threshold_bytes < 0:
+        msg = (
+            "result_summary.threshold_bytes cannot be negative: "
+            f"got {cfg.result_summary.threshold_bytes}"
+        )
+        raise ConfigInvalidError(msg)
+    if cfg.result_summary.max_tokens < 0:
+        msg = (
+            "result_summary.max_tokens cannot be negative: "
+            f"got {cfg.result_summary.max_tokens}"
+        )
+        raise ConfigInvalidError(msg)
+    _validate_tool_timeouts(cfg.tool_timeouts)
+    _validate_tool_patterns(cfg.tools)
+    for index, label in enumerate(cfg.instance_defaults.authorized_key_labels):
+        if not label.strip():
+            msg = (
+                "instance_defaults.authorized_key_labels entries cannot be "
+                f"blank: entry {index}"
+            )
+            raise ConfigInvalidError(msg)
+    cfg.nodebalancer_probe.probe_networks()
     _validate_reports(cfg.audit.reports)
 
 
+def _validate_tool_patterns(tools: ToolsConfig) -> None:
+    """Check every tools entry is a tool name or a glob of one; only enabled
+    entries may start with "!" (Go's validateToolPatterns)."""
+    entries = [
+        ("enabled", index, pattern, pattern.removeprefix("!"))
+        for index, pattern in enumerate(tools.enabled)
+    ] + [
+        ("disabled", index, pattern, pattern)
+        for index, pattern in enumerate(tools.disabled)
+    ]
+    for list_name, index, pattern, body in entries:
+        if not _TOOL_PATTERN.match(body):
+            msg = (
+                "tools entries must be tool names or globs using '*' and '?': "
+                f'tools.{list_name}[{index}] is "{pattern}"'
+            )
+            raise ConfigInvalidError(msg)
+
+
+# MCP transports server.transport accepts; mirrors Go's config.Transport*.
+_TRANSPORTS = ("stdio", "sse", "http")
+
+
+def validate_transport(transport: str) -> None:
+    """Check an MCP transport name from server.transport."""
+    if transport not in _TRANSPORTS:
+        msg = (
+            "server.transport must be 'stdio', 'sse', or 'http': "
+            f"got {transport!r}"
+        )
+        raise ConfigInvalidError(msg)
+
+
+def _validate_oauth(cfg: Config) -> None:
+    """Check an enabled oauth block names everything token validation
+    needs, and that token exchange runs only with server.tokenPassthrough.
+    """
+    oauth = cfg.oauth
+    if not oauth.enabled:
+        return
+    if not (oauth.issuer and oauth.resource and oauth.introspection_url):
+        msg = (
+            "oauth configuration is incomplete: issuer, resource, and "
+            "introspection_url are required"
+        )
+        raise ConfigInvalidError(msg)
+    if not oauth.token_exchange.enabled:
+        return
+    if not oauth.token_exchange.token_url:
+        msg = (
+            "oauth configuration is incomplete: "
+            "token_exchange.token_url is required"
+        )
+        raise ConfigInvalidError(msg)
+    if not cfg.server.token_passthrough:
+        msg = "oauth.token_exchange requires server.tokenPassthrough"
+        raise ConfigInvalidError(msg)
+
+
+def _validate_read_after_write(raw: ReadAfterWriteConfig) -> None:
+    """Check read_after_write.attempts and interval_ms are within bounds."""
+    if not 1 <= raw.attempts <= MAX_READ_AFTER_WRITE_ATTEMPTS:
+        msg = (
+            "read_after_write.attempts must be from 1 through "
+            f"{MAX_READ_AFTER_WRITE_ATTEMPTS}: got {raw.attempts}"
+        )
+        raise ConfigInvalidError(msg)
+    if not 0 <= raw.interval_ms <= MAX_READ_AFTER_WRITE_INTERVAL_MS:
+        msg = (
+            "read_after_write.interval_ms must be from 0 through "
+            f"{MAX_READ_AFTER_WRITE_INTERVAL_MS}: got {raw.interval_ms}"
+        )
+        raise ConfigInvalidError(msg)
+
+
+def _validate_tool_timeouts(raw: ToolTimeoutConfig) -> None:
+    """Check the tool_timeouts values are not negative and every per-tool
+    entry is a positive number of seconds."""
+    for name, value in (
+        ("default_seconds", raw.default_seconds),
+        ("max_seconds", raw.max_seconds),
+    ):
+        if value < 0:
+            msg = f"invalid tool_timeouts value: {name} is {value}"
+            raise ConfigInvalidError(msg)
+    for tool, seconds in raw.tools.items():
+        if seconds < 1:
+            msg = f"invalid tool_timeouts value: tools.{tool} is {seconds}"
+            raise ConfigInvalidError(msg)
+
+
+# How many single-character edits a misspelled environment name may be from a
+# configured one and still be suggested.
+_MAX_SUGGESTION_DISTANCE = 2
+
+
+def _closest_environment_name(name: str, names: list[str]) -> str:
+    """Return the configured name nearest to ``name``, or "" when none is
+    close. Comparison ignores case. A name that starts with ``name`` (or that
+    ``name`` starts with) counts as close, so "prod" suggests "production";
+    otherwise the name must be within _MAX_SUGGESTION_DISTANCE edits. Ties go
+    to the name that sorts first."""
+    lowered = name.lower()
+    best, best_distance = "", _MAX_SUGGESTION_DISTANCE + 1
+    for candidate in names:
+        folded = candidate.lower()
+        distance = _edit_distance(lowered, folded)
+        if folded.startswith(lowered) or lowered.startswith(folded):
+            distance = min(distance, _MAX_SUGGESTION_DISTANCE)
+        if distance < best_distance:
+            best, best_distance = candidate, distance
+    return best
+
+
+def _edit_distance(a: str, b: str) -> int:
+    """Levenshtein distance between a and b."""
+    previous = list(range(len(b) + 1))
+    for i, source_char in enumerate(a, start=1):
+        current = [i]
+        for j, target_char in enumerate(b, start=1):
+            cost = 0 if source_char == target_char else 1
+            current.append(
+                min(previous[j] + 1, current[j - 1] + 1, previous[j - 1] + cost)
+            )
+        previous = current
+    return previous[-1]
+
+
+def validate_output_format(size_unit: str, price_period: str) -> None:
+    """Check an output_format size unit and price period, either of which may
+    be empty (no annotation). Shared by config validation and the per-call
+    output_format argument.
+    """
+    if size_unit and size_unit not in SIZE_UNITS:
+        msg = f"output_format.size_unit must be 'GiB' or 'GB': got {size_unit!r}"
+        raise ConfigInvalidError(msg)
+    if price_period and price_period not in PRICE_PERIODS:
+        msg = (
+            "output_format.price_period must be 'monthly', 'hourly', or 'both': "
+            f"got {price_period!r}"
+        )
+        raise ConfigInvalidError(msg)
+
+
 def _validate_reports(reports: dict[str, ReportConfig]) -> None:
     """Validate each custom report's structural grammar: a known output
     mode, a parseable since_offset, parseable since/until timestamps, and
@@ -683,6 +1702,10 @@ def _data_to_config(data: dict[str, Any]) -> Config:
         transport=data.get("server", {}).get("transport", "stdio"),
         host=data.get("server", {}).get("host", "127.0.0.1"),
         port=data.get("server", {}).get("port", 8080),
+        token_passthrough=bool(
+            data.get("server", {}).get("tokenPassthrough", False)
+        ),
+        auth_token=data.get("server", {}).get("authToken", ""),
     )
 
     tracing_data = data.get("observability", {}).get("tracing", {})
@@ -751,6 +1774,10 @@ def _data_to_config(data: dict[str, Any]) -> Config:
             resilience_data.get("maxRetryDelay", 30),
             "resilience.maxRetryDelay",
         ),
+        max_retry_elapsed=_parse_duration_seconds(
+            resilience_data.get("maxRetryElapsed", 0),
+            "resilience.maxRetryElapsed",
+        ),
         pool_max_connections=resilience_data.get("poolMaxConnections", 10),
         pool_max_keepalive_connections=resilience_data.get(
             "poolMaxKeepaliveConnections", 10
@@ -764,10 +1791,18 @@ def _data_to_config(data: dict[str, Any]) -> Config:
         linode_cfg = LinodeConfig(
             api_url=linode_data.get("apiUrl", ""),
             token=linode_data.get("token", ""),
+            api_version=str(linode_data.get("apiVersion") or ""),
+            headers={
+                str(name): str(value)
+                for name, value in (linode_data.get("headers") or {}).items()
+            },
+            backend=str(linode_data.get("backend") or ""),
+            fixtures_dir=str(linode_data.get("fixturesDir") or ""),
         )
         environments[env_name] = EnvironmentConfig(
             label=env_data.get("label", ""),
             linode=linode_cfg,
+            label_namespace=str(env_data.get("label_namespace") or ""),
         )
 
     active_profile_raw = data.get("active_profile", "")
@@ -779,15 +1814,241 @@ def _data_to_config(data: dict[str, Any]) -> Config:
         resilience=resilience,
         environments=environments,
         active_profile=active_profile,
+        read_only=data.get("read_only") is True,
+        tools=_parse_tools(data.get("tools")),
         profiles=_parse_user_profiles(data.get("profiles")),
         profiles_builtin_overrides=_parse_builtin_overrides(
             data.get("profiles_builtin_overrides")
         ),
         audit=_parse_audit(data.get("audit")),
         two_stage=_parse_two_stage(data.get("two_stage")),
+        schema_drift=_parse_schema_drift(data.get("schema_drift")),
+        update_check=_parse_update_check(data.get("update_check")),
+        status_page=_parse_status_page(data.get("status_page")),
+        oauth=_parse_oauth(data.get("oauth")),
+        object_storage=_parse_object_storage(data.get("object_storage")),
+        output_format=_parse_output_format(data.get("output_format")),
+        quotas=_parse_quotas(data.get("quotas")),
+        read_after_write=_parse_read_after_write(data.get("read_after_write")),
+        annotations=_parse_annotations(data.get("annotations")),
+        lke=_parse_lke(data.get("lke")),
+        nodebalancer_probe=_parse_nodebalancer_probe(data.get("nodebalancer_probe")),
+        offline_cache=_parse_offline_cache(data.get("offline_cache")),
+        result_summary=_parse_result_summary(data.get("result_summary")),
+        tool_timeouts=_parse_tool_timeouts(data.get("tool_timeouts")),
+        structured_content=_parse_structured_content(data.get("structured_content")),
+        instance_defaults=_parse_instance_defaults(data.get("instance_defaults")),
+        confirm_label=_parse_confirm_label(data.get("confirm_label")),
+    )
+
+
+def _parse_object_storage(raw: Any) -> ObjectStorageConfig:
+    """Build an ObjectStorageConfig from the raw ``object_storage`` block."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    regions_raw = data.get("preferred_regions")
+    regions = (
+        cast("list[Any]", regions_raw) if isinstance(regions_raw, list) else []
+    )
+    return ObjectStorageConfig(preferred_regions=[str(r) for r in regions])
+
+
+def _parse_annotations(raw: Any) -> AnnotationsConfig:
+    """Build an AnnotationsConfig from the raw ``annotations`` block."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    return AnnotationsConfig(dir=str(data.get("dir") or ""))
+
+
+def _parse_lke(raw: Any) -> LKEConfig:
+    """Build an LKEConfig from the raw ``lke`` block."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    return LKEConfig(workload_ping=data.get("workload_ping") is True)
+
+
+def _parse_nodebalancer_probe(raw: Any) -> NodeBalancerProbeConfig:
+    """Build a NodeBalancerProbeConfig from the raw ``nodebalancer_probe``
+    block."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    cidrs_raw = data.get("allowed_cidrs")
+    cidrs = cast("list[Any]", cidrs_raw) if isinstance(cidrs_raw, list) else []
+    return NodeBalancerProbeConfig(allowed_cidrs=[str(c) for c in cidrs])
+
+
+def _parse_offline_cache(raw: Any) -> OfflineCacheConfig:
+    """Build an OfflineCacheConfig from the raw ``offline_cache`` block."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    return OfflineCacheConfig(
+        enabled=data.get("enabled") is True, dir=str(data.get("dir") or "")
+    )
+
+
+def _parse_result_summary(raw: Any) -> ResultSummaryConfig:
+    """Build a ResultSummaryConfig from the raw ``result_summary`` block."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    threshold = data.get("threshold_bytes")
+    max_tokens = data.get("max_tokens")
+    return ResultSummaryConfig(
+        enabled=data.get("enabled") is True,
+        threshold_bytes=threshold if isinstance(threshold, int) else 0,
+        max_tokens=max_tokens if isinstance(max_tokens, int) else 0,
+    )
+
+
+def _parse_tool_timeouts(raw: Any) -> ToolTimeoutConfig:
+    """Build a ToolTimeoutConfig from the raw ``tool_timeouts`` block, keeping
+    only integer per-tool timeouts."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    default = data.get("default_seconds")
+    maximum = data.get("max_seconds")
+    tools_raw = data.get("tools")
+    tools = cast("dict[Any, Any]", tools_raw) if isinstance(tools_raw, dict) else {}
+    return ToolTimeoutConfig(
+        default_seconds=default if isinstance(default, int) else 0,
+        max_seconds=maximum if isinstance(maximum, int) else 0,
+        tools={
+            str(tool): seconds
+            for tool, seconds in tools.items()
+            if isinstance(seconds, int) and not isinstance(seconds, bool)
+        },
+    )
+
+
+def _parse_structured_content(raw: Any) -> StructuredContentConfig:
+    """Build a StructuredContentConfig from the raw ``structured_content``
+    block. Only an explicit false turns structured content off."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    return StructuredContentConfig(
+        enabled=data.get("enabled") is not False,
+        embedded_resource=data.get("embedded_resource") is True,
+    )
+
+
+def _parse_instance_defaults(raw: Any) -> InstanceDefaultsConfig:
+    """Build an InstanceDefaultsConfig from the raw ``instance_defaults``
+    block."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    labels_raw = data.get("authorized_key_labels")
+    labels = cast("list[Any]", labels_raw) if isinstance(labels_raw, list) else []
+    return InstanceDefaultsConfig(
+        authorized_key_labels=[str(label) for label in labels]
+    )
+
+
+def _parse_tools(raw: Any) -> ToolsConfig:
+    """Build a ToolsConfig from the raw ``tools`` block."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    lists: dict[str, list[str]] = {}
+    for key in ("enabled", "disabled"):
+        entries = data.get(key)
+        items = cast("list[Any]", entries) if isinstance(entries, list) else []
+        lists[key] = [str(entry) for entry in items]
+    return ToolsConfig(enabled=lists["enabled"], disabled=lists["disabled"])
+
+
+def _parse_confirm_label(raw: Any) -> ConfirmLabelConfig:
+    """Build a ConfirmLabelConfig from the raw ``confirm_label`` block."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    return ConfirmLabelConfig(enabled=data.get("enabled") is True)
+
+
+def _parse_output_format(raw: Any) -> OutputFormatConfig:
+    """Build an OutputFormatConfig from the raw ``output_format`` block."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    symbol = data.get("currency_symbol")
+    return OutputFormatConfig(
+        size_unit=str(data.get("size_unit") or ""),
+        price_period=str(data.get("price_period") or ""),
+        currency_symbol=symbol if isinstance(symbol, bool) else True,
+        cloud_manager_links=data.get("cloud_manager_links") is True,
+    )
+
+
+def _parse_read_after_write(raw: Any) -> ReadAfterWriteConfig:
+    """Build a ReadAfterWriteConfig from the raw ``read_after_write`` block.
+    An explicit 0 interval is kept; only absent values take the defaults."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    attempts = data.get("attempts")
+    interval = data.get("interval_ms")
+    return ReadAfterWriteConfig(
+        enabled=data.get("enabled") is True,
+        attempts=(
+            attempts
+            if isinstance(attempts, int)
+            else DEFAULT_READ_AFTER_WRITE_ATTEMPTS
+        ),
+        interval_ms=(
+            interval
+            if isinstance(interval, int)
+            else DEFAULT_READ_AFTER_WRITE_INTERVAL_MS
+        ),
+    )
+
+
+def _parse_quotas(raw: Any) -> QuotaConfig:
+    """Build a QuotaConfig from the raw ``quotas`` block, keeping only
+    integer limits."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    limits_raw = data.get("limits")
+    limits = cast("dict[Any, Any]", limits_raw) if isinstance(limits_raw, dict) else {}
+    return QuotaConfig(
+        limits={
+            str(resource): limit
+            for resource, limit in limits.items()
+            if isinstance(limit, int) and not isinstance(limit, bool)
+        }
     )
 
 
+def _parse_oauth(raw: Any) -> OAuthConfig:
+    """Build an OAuthConfig from the raw ``oauth`` block.
+
+    Empty scopes fall back to DEFAULT_OAUTH_READ_SCOPE and
+    DEFAULT_OAUTH_WRITE_SCOPE.
+    """
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    exchange_raw = data.get("token_exchange")
+    exchange = (
+        cast("dict[str, Any]", exchange_raw)
+        if isinstance(exchange_raw, dict)
+        else {}
+    )
+    return OAuthConfig(
+        enabled=bool(data.get("enabled", False)),
+        issuer=str(data.get("issuer") or ""),
+        resource=str(data.get("resource") or ""),
+        introspection_url=str(data.get("introspection_url") or ""),
+        client_id=str(data.get("client_id") or ""),
+        client_secret=str(data.get("client_secret") or ""),
+        read_scope=str(data.get("read_scope") or DEFAULT_OAUTH_READ_SCOPE),
+        write_scope=str(data.get("write_scope") or DEFAULT_OAUTH_WRITE_SCOPE),
+        token_exchange=OAuthTokenExchangeConfig(
+            enabled=bool(exchange.get("enabled", False)),
+            token_url=str(exchange.get("token_url") or ""),
+            audience=str(exchange.get("audience") or ""),
+        ),
+    )
+
+
+def _parse_update_check(raw: Any) -> UpdateCheckConfig:
+    """Build an UpdateCheckConfig from the raw ``update_check`` block.
+
+    An absent ``enabled`` key keeps the check on; an explicit false is
+    preserved. An empty ``url`` falls back to DEFAULT_UPDATE_CHECK_URL.
+    """
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    enabled_raw = data.get("enabled")
+    return UpdateCheckConfig(
+        enabled=True if enabled_raw is None else bool(enabled_raw),
+        url=str(data.get("url") or DEFAULT_UPDATE_CHECK_URL),
+    )
+
+
+def _parse_status_page(raw: Any) -> StatusPageConfig:
+    """Build a StatusPageConfig from the raw ``status_page`` block. An empty
+    ``url`` falls back to DEFAULT_STATUS_PAGE_URL."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    return StatusPageConfig(url=str(data.get("url") or DEFAULT_STATUS_PAGE_URL))
+
+
 def _parse_two_stage(raw: Any) -> TwoStageConfig:
     """Build a TwoStageConfig from the raw ``two_stage`` block.
 
@@ -819,6 +2080,14 @@ def _parse_two_stage(raw: Any) -> TwoStageConfig:
     )
 
 
+def _parse_schema_drift(raw: Any) -> SchemaDriftConfig:
+    """Build a SchemaDriftConfig from the raw ``schema_drift`` block. An
+    absent or empty mode resolves to lenient."""
+    data = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    mode = data.get("mode")
+    return SchemaDriftConfig(mode=str(mode) if mode else SCHEMA_DRIFT_LENIENT)
+
+
 def _parse_audit(raw: Any) -> AuditConfig:
     """Build an AuditConfig from the raw ``audit`` block.
 
@@ -854,6 +2123,7 @@ def _parse_audit(raw: Any) -> AuditConfig:
             ),
         ),
         reports=_parse_reports(audit_data.get("reports")),
+        cost_attribution=bool(audit_data.get("cost_attribution", False)),
     )
 
 
@@ -984,11 +2254,31 @@ def _format_duration_go(seconds: float) -> str:
 
 
 def load_from_file(path: Path) -> Config:
-    """Load configuration from a file."""
+    """Load configuration from a file.
+
+    A missing file is accepted when LINODEMCP_ENV_* variables are set: the
+    config is then built from defaults plus the environment, the way a
+    container deployment supplies it.
+    """
     if not path.exists():
-        msg = f"configuration file not found: {path}"
-        raise ConfigFileNotFoundError(msg)
+        if not _env_environments_configured():
+            msg = f"configuration file not found: {path}"
+            raise ConfigFileNotFoundError(msg)
+        data: dict[str, Any] = {}
+    else:
+        data = _read_config_file(path)
+
+    _apply_defaults(data)
+    _apply_environment_overrides(data)
+
+    cfg = _data_to_config(data)
+    validate_config(cfg)
 
+    return cfg
+
+
+def _read_config_file(path: Path) -> dict[str, Any]:
+    """Validate, read, and parse an existing config file."""
     try:
         validate_path(path)
     except PathValidationError as e:
@@ -1001,14 +2291,7 @@ def load_from_file(path: Path) -> Config:
         msg = f"failed to read config file: {path}"
         raise ConfigError(msg) from e
 
-    data = _parse_config_data(content)
-    _apply_defaults(data)
-    _apply_environment_overrides(data)
-
-    cfg = _data_to_config(data)
-    validate_config(cfg)
-
-    return cfg
+    return _parse_config_data(content)
 
 
 def load() -> Config:
@@ -1016,11 +2299,12 @@ def load() -> Config:
     return load_from_file(get_config_path())
 
 
-def _config_to_data(cfg: Config) -> dict[str, Any]:
+def config_to_data(cfg: Config) -> dict[str, Any]:
     """Convert a Config back into the parsed-dict shape ``_data_to_config``
     consumes. The keys match the on-disk schema (camelCase for server
     fields, snake_case for the profile maps) so the round-trip is
-    byte-for-byte symmetric with ``load_from_file``.
+    byte-for-byte symmetric with ``load_from_file``. ``linode_config_dump``
+    renders the effective config from it.
     """
     environments: dict[str, Any] = {}
     for name, env in cfg.environments.items():
@@ -1031,6 +2315,16 @@ def _config_to_data(cfg: Config) -> dict[str, Any]:
                 "token": env.linode.token,
             },
         }
+        if env.linode.api_version:
+            environments[name]["linode"]["apiVersion"] = env.linode.api_version
+        if env.linode.headers:
+            environments[name]["linode"]["headers"] = dict(env.linode.headers)
+        if env.linode.backend:
+            environments[name]["linode"]["backend"] = env.linode.backend
+        if env.linode.fixtures_dir:
+            environments[name]["linode"]["fixturesDir"] = env.linode.fixtures_dir
+        if env.label_namespace:
+            environments[name]["label_namespace"] = env.label_namespace
 
     profiles: dict[str, Any] = {}
     for name, prof in (cfg.profiles or {}).items():
@@ -1047,6 +2341,21 @@ def _config_to_data(cfg: Config) -> dict[str, Any]:
     for name, override in (cfg.profiles_builtin_overrides or {}).items():
         overrides[name] = {"disabled": override.disabled}
 
+    resilience: dict[str, Any] = {
+        "rateLimitPerMinute": cfg.resilience.rate_limit_per_minute,
+        "circuitBreakerThreshold": cfg.resilience.circuit_breaker_threshold,
+        "circuitBreakerTimeout": _format_duration_go(
+            cfg.resilience.circuit_breaker_timeout
+        ),
+        "maxRetries": cfg.resilience.max_retries,
+        "baseRetryDelay": _format_duration_go(cfg.resilience.base_retry_delay),
+        "maxRetryDelay": _format_duration_go(cfg.resilience.max_retry_delay),
+    }
+    if cfg.resilience.max_retry_elapsed:
+        resilience["maxRetryElapsed"] = _format_duration_go(
+            cfg.resilience.max_retry_elapsed
+        )
+
     return {
         "server": {
             "name": cfg.server.name,
@@ -1054,6 +2363,8 @@ def _config_to_data(cfg: Config) -> dict[str, Any]:
             "transport": cfg.server.transport,
             "host": cfg.server.host,
             "port": cfg.server.port,
+            "tokenPassthrough": cfg.server.token_passthrough,
+            "authToken": cfg.server.auth_token,
         },
         "observability": {
             "tracing": {
@@ -1062,7 +2373,7 @@ def _config_to_data(cfg: Config) -> dict[str, Any]:
                 "protocol": cfg.observability.tracing.protocol,
                 "insecure": cfg.observability.tracing.insecure,
                 "sampleRate": cfg.observability.tracing.sample_rate,
-                "headers": cfg.observability.tracing.headers,
+                "headers": dict(cfg.observability.tracing.headers),
             },
             "metrics": {
                 "enabled": cfg.observability.metrics.enabled,
@@ -1086,18 +2397,14 @@ def _config_to_data(cfg: Config) -> dict[str, Any]:
                 "path": cfg.observability.health.path,
             },
         },
-        "resilience": {
-            "rateLimitPerMinute": cfg.resilience.rate_limit_per_minute,
-            "circuitBreakerThreshold": cfg.resilience.circuit_breaker_threshold,
-            "circuitBreakerTimeout": _format_duration_go(
-                cfg.resilience.circuit_breaker_timeout
-            ),
-            "maxRetries": cfg.resilience.max_retries,
-            "baseRetryDelay": _format_duration_go(cfg.resilience.base_retry_delay),
-            "maxRetryDelay": _format_duration_go(cfg.resilience.max_retry_delay),
-        },
+        "resilience": resilience,
         "environments": environments,
         "active_profile": cfg.active_profile,
+        "read_only": cfg.read_only,
+        "tools": {
+            "enabled": list(cfg.tools.enabled),
+            "disabled": list(cfg.tools.disabled),
+        },
         "profiles": profiles,
         "profiles_builtin_overrides": overrides,
         "audit": {
@@ -1109,6 +2416,73 @@ def _config_to_data(cfg: Config) -> dict[str, Any]:
                 "busy_timeout_ms": cfg.audit.sqlite.busy_timeout_ms,
             },
         },
+        "schema_drift": {"mode": cfg.schema_drift.mode},
+        "update_check": {
+            "enabled": cfg.update_check.enabled,
+            "url": cfg.update_check.url,
+        },
+        "status_page": {
+            "url": cfg.status_page.url,
+        },
+        "oauth": {
+            "enabled": cfg.oauth.enabled,
+            "issuer": cfg.oauth.issuer,
+            "resource": cfg.oauth.resource,
+            "introspection_url": cfg.oauth.introspection_url,
+            "client_id": cfg.oauth.client_id,
+            "client_secret": cfg.oauth.client_secret,
+            "read_scope": cfg.oauth.read_scope,
+            "write_scope": cfg.oauth.write_scope,
+            "token_exchange": {
+                "enabled": cfg.oauth.token_exchange.enabled,
+                "token_url": cfg.oauth.token_exchange.token_url,
+                "audience": cfg.oauth.token_exchange.audience,
+            },
+        },
+        "object_storage": {
+            "preferred_regions": list(cfg.object_storage.preferred_regions),
+        },
+        "output_format": {
+            "size_unit": cfg.output_format.size_unit,
+            "price_period": cfg.output_format.price_period,
+            "currency_symbol": cfg.output_format.currency_symbol,
+            "cloud_manager_links": cfg.output_format.cloud_manager_links,
+        },
+        "quotas": {"limits": dict(cfg.quotas.limits)},
+        "read_after_write": {
+            "enabled": cfg.read_after_write.enabled,
+            "attempts": cfg.read_after_write.attempts,
+            "interval_ms": cfg.read_after_write.interval_ms,
+        },
+        "annotations": {"dir": cfg.annotations.dir},
+        "lke": {"workload_ping": cfg.lke.workload_ping},
+        "nodebalancer_probe": {
+            "allowed_cidrs": list(cfg.nodebalancer_probe.allowed_cidrs),
+        },
+        "offline_cache": {
+            "enabled": cfg.offline_cache.enabled,
+            "dir": cfg.offline_cache.dir,
+        },
+        "result_summary": {
+            "enabled": cfg.result_summary.enabled,
+            "threshold_bytes": cfg.result_summary.threshold_bytes,
+            "max_tokens": cfg.result_summary.max_tokens,
+        },
+        "tool_timeouts": {
+            "default_seconds": cfg.tool_timeouts.default_seconds,
+            "max_seconds": cfg.tool_timeouts.max_seconds,
+            "tools": dict(cfg.tool_timeouts.tools),
+        },
+        "structured_content": {
+            "enabled": cfg.structured_content.enabled,
+            "embedded_resource": cfg.structured_content.embedded_resource,
+        },
+        "instance_defaults": {
+            "authorized_key_labels": list(
+                cfg.instance_defaults.authorized_key_labels
+            ),
+        },
+        "confirm_label": {"enabled": cfg.confirm_label.enabled},
     }
 
 
@@ -1124,7 +2498,7 @@ def write_atomic(path: Path, cfg: Config) -> None:
     (PyYAML, like Go's yaml.v3 in non-Node mode, drops them). This
     trade-off is documented in the CLI usage block.
     """
-    data = _config_to_data(cfg)
+    data = config_to_data(cfg)
     _apply_defaults(data)
 
     candidate = _data_to_config(data)
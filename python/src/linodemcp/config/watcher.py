@@ -47,7 +47,9 @@ class ConfigWatcher:
         # Initial load: blocks if the file is unreadable. Caller decides
         # how to handle ConfigError.
         self._current: Config = load_from_file(path)
-        self._last_mtime = path.stat().st_mtime
+        # An env-only config (no file, LINODEMCP_ENV_* set) starts at 0.0 so
+        # a file created later is picked up by the first poll.
+        self._last_mtime = path.stat().st_mtime if path.exists() else 0.0
         self._task: asyncio.Task[None] | None = None
         self._stop = asyncio.Event()
         self._on_change: OnChangeCallback | None = None
@@ -100,6 +102,12 @@ class ConfigWatcher:
     async def _check_and_reload(self) -> None:
         try:
             mtime = self._path.stat().st_mtime
+        except FileNotFoundError as exc:
+            # Still running env-only with no file yet: nothing to reload.
+            if self._last_mtime == 0.0:
+                return
+            logger.warning("config watcher stat failed: %s", exc)
+            return
         except OSError as exc:
             logger.warning("config watcher stat failed: %s", exc)
             return
@@ -0,0 +1,262 @@
+"""Check that a Kubernetes API server is actually serving.
+
+linode_lke_workload_ping runs this against an LKE cluster's kubeconfig as a
+deeper readiness signal than the cluster's Linode status: it asks the API
+server for /version and the node list, and reports each request's outcome
+rather than failing on the first.
+
+Mirrors ``go/internal/kubeprobe``.
+"""
+
+from __future__ import annotations
+
+import base64
+import binascii
+import ssl
+import time
+from dataclasses import dataclass, field
+from typing import Any, cast
+
+import httpx
+import yaml
+
+# Check names, in the order ping runs them.
+CHECK_VERSION = "version"
+CHECK_NODES = "nodes"
+
+_VERSION_PATH = "/version"
+_NODES_PATH = "/api/v1/nodes"
+
+# An unreachable control plane fails the check instead of holding the call.
+_REQUEST_TIMEOUT = 10.0
+
+
+class KubeconfigError(ValueError):
+    """The kubeconfig cannot be used to reach its API server."""
+
+
+@dataclass
+class Target:
+    """Where and how to reach one API server.
+
+    An empty ``ca_data`` means the system roots.
+    """
+
+    server: str
+    token: str
+    ca_data: bytes = b""
+
+
+@dataclass
+class Check:
+    """Outcome of one API request; ``status_code`` is 0 with no response."""
+
+    name: str
+    path: str
+    ok: bool = False
+    status_code: int = 0
+    latency_ms: int = 0
+    error: str = ""
+
+
+@dataclass
+class Node:
+    """One node from the node list."""
+
+    name: str
+    ready: bool
+    kubelet_version: str
+
+
+@dataclass
+class Result:
+    """Outcome of a ping; version and nodes come from checks that passed."""
+
+    version: str = ""
+    nodes: list[Node] = field(default_factory=list[Node])
+    checks: list[Check] = field(default_factory=list[Check])
+
+    def serving(self) -> bool:
+        """Whether every check succeeded."""
+        return bool(self.checks) and all(c.ok for c in self.checks)
+
+    def first_failure(self) -> Check | None:
+        """The first failed check, or None when all passed."""
+        return next((c for c in self.checks if not c.ok), None)
+
+
+def _named(items: Any, name: str, key: str) -> dict[str, Any] | None:
+    """The ``key`` block of the list entry called ``name``."""
+    if not isinstance(items, list):
+        return None
+    for item in cast("list[Any]", items):
+        if isinstance(item, dict) and item.get("name") == name:
+            block = cast("dict[str, Any]", item).get(key)
+            return cast("dict[str, Any]", block) if isinstance(block, dict) else {}
+    return None
+
+
+def parse_kubeconfig(data: bytes | str) -> Target:
+    """Read the server, CA, and token of the kubeconfig's current context.
+
+    A kubeconfig without current-context must have exactly one context. Only
+    bearer-token users are supported, which is what LKE issues.
+    """
+    try:
+        parsed = yaml.safe_load(data)
+    except yaml.YAMLError as exc:
+        msg = f"kubeconfig is not valid: {exc}"
+        raise KubeconfigError(msg) from exc
+    doc = cast("dict[str, Any]", parsed) if isinstance(parsed, dict) else {}
+
+    current_name = str(doc.get("current-context") or "")
+    current = _named(doc.get("contexts"), current_name, "context")
+    contexts = doc.get("contexts")
+    if (
+        current is None
+        and not current_name
+        and isinstance(contexts, list)
+        and len(cast("list[Any]", contexts)) == 1
+    ):
+        only = cast("list[Any]", contexts)[0]
+        block = only.get("context") if isinstance(only, dict) else None
+        current = cast("dict[str, Any]", block) if isinstance(block, dict) else {}
+    if current is None:
+        msg = f'kubeconfig has no usable current context: "{current_name}"'
+        raise KubeconfigError(msg)
+
+    cluster_name = str(current.get("cluster") or "")
+    cluster = _named(doc.get("clusters"), cluster_name, "cluster") or {}
+    server = str(cluster.get("server") or "").rstrip("/")
+    if not server:
+        msg = f'kubeconfig cluster has no server URL: cluster "{cluster_name}"'
+        raise KubeconfigError(msg)
+
+    ca_data = b""
+    if cluster.get("certificate-authority-data"):
+        try:
+            ca_data = base64.b64decode(
+                str(cluster["certificate-authority-data"]), validate=True
+            )
+        except (binascii.Error, ValueError) as exc:
+            msg = f"kubeconfig is not valid: certificate-authority-data: {exc}"
+            raise KubeconfigError(msg) from exc
+
+    user_name = str(current.get("user") or "")
+    user = _named(doc.get("users"), user_name, "user") or {}
+    token = str(user.get("token") or "")
+    if not token:
+        msg = f'kubeconfig user has no bearer token: user "{user_name}"'
+        raise KubeconfigError(msg)
+
+    return Target(server=server, token=token, ca_data=ca_data)
+
+
+def _verify(target: Target) -> ssl.SSLContext | bool:
+    """TLS verification for target: its CA bundle, else the system roots."""
+    if not target.ca_data:
+        return True
+    try:
+        return ssl.create_default_context(cadata=target.ca_data.decode())
+    except (ssl.SSLError, ValueError) as exc:
+        msg = "kubeconfig certificate-authority-data holds no PEM certificate"
+        raise KubeconfigError(msg) from exc
+
+
+async def ping(
+    target: Target, transport: httpx.AsyncBaseTransport | None = None
+) -> Result:
+    """Run the version check and then the node list check against target.
+
+    A failed check is recorded in the result, not raised; KubeconfigError is
+    only for a target that cannot be used at all (an unparsable CA bundle).
+    ``transport`` is for tests.
+    """
+    verify = _verify(target)
+    headers = {
+        "Accept": "application/json",
+        "Authorization": f"Bearer {target.token}",
+    }
+    result = Result()
+    async with httpx.AsyncClient(
+        base_url=target.server,
+        headers=headers,
+        timeout=_REQUEST_TIMEOUT,
+        verify=verify,
+        transport=transport,
+    ) as client:
+        check, body = await _get(client, CHECK_VERSION, _VERSION_PATH)
+        if check.ok:
+            result.version = str(body.get("gitVersion") or "")
+        result.checks.append(check)
+
+        check, body = await _get(client, CHECK_NODES, _NODES_PATH)
+        if check.ok:
+            result.nodes = _nodes(body)
+        result.checks.append(check)
+    return result
+
+
+async def _get(
+    client: httpx.AsyncClient, name: str, path: str
+) -> tuple[Check, dict[str, Any]]:
+    """Fetch path and decode its JSON object body, timing the request."""
+    check = Check(name=name, path=path)
+    start = time.monotonic()
+    try:
+        response = await client.get(path)
+    except httpx.HTTPError as exc:
+        check.latency_ms = int((time.monotonic() - start) * 1000)
+        check.error = str(exc) or type(exc).__name__
+        return check, {}
+    check.latency_ms = int((time.monotonic() - start) * 1000)
+    check.status_code = response.status_code
+
+    try:
+        body: Any = response.json()
+    except ValueError as exc:
+        body = None
+        decode_error = str(exc)
+    else:
+        decode_error = ""
+
+    if response.status_code != httpx.codes.OK:
+        message = body.get("message") if isinstance(body, dict) else None
+        if message:
+            check.error = f"HTTP {response.status_code}: {message}"
+        else:
+            reason = httpx.codes.get_reason_phrase(response.status_code)
+            check.error = f"HTTP {response.status_code} {reason}"
+        return check, {}
+    if not isinstance(body, dict):
+        check.error = f"decode response: {decode_error or 'not a JSON object'}"
+        return check, {}
+
+    check.ok = True
+    return check, cast("dict[str, Any]", body)
+
+
+def _nodes(body: dict[str, Any]) -> list[Node]:
+    """Nodes from a /api/v1/nodes list, ready when the Ready condition is True."""
+    items = body.get("items")
+    nodes: list[Node] = []
+    for raw in cast("list[Any]", items) if isinstance(items, list) else []:
+        item = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+        metadata = item.get("metadata") or {}
+        status = item.get("status") or {}
+        conditions = status.get("conditions") or []
+        ready = any(
+            c.get("type") == "Ready" and c.get("status") == "True"
+            for c in conditions
+            if isinstance(c, dict)
+        )
+        nodes.append(
+            Node(
+                name=str(metadata.get("name") or ""),
+                ready=ready,
+                kubelet_version=str(
+                    (status.get("nodeInfo") or {}).get("kubeletVersion") or ""
+                ),
+            )
+        )
+    return nodes
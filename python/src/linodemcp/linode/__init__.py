@@ -12,14 +12,23 @@ import time
 from collections.abc import Awaitable, Callable
 from dataclasses import dataclass
 from dataclasses import field as dc_field
-from datetime import datetime
+from datetime import UTC, datetime
+from email.utils import parsedate_to_datetime
 from pathlib import Path
 from typing import Any, BinaryIO, TypeGuard, TypeVar, cast
-from urllib.parse import quote, urlencode
+from urllib.parse import parse_qs, quote, urlencode, urlsplit
 
 import httpx
 
+from linodemcp.linode.api_errors import record_api_error
+from linodemcp.linode.correlation import correlation_headers, get_correlation_id
+from linodemcp.linode.label_prefix import get_label_prefix, keep_label_prefix
+from linodemcp.linode.list_options import list_filter_headers, list_read
+from linodemcp.linode.max_results import get_max_results, record_truncated
 from linodemcp.linode.metrics import get_api_recorder, metrics_endpoint
+from linodemcp.linode.reachability import record_reachability
+from linodemcp.linode.request_timeout import apply_request_timeout
+from linodemcp.linode.writelog import record_write
 
 _MANAGED_SERVICE_TIMEOUT_MAX = 255
 
@@ -127,6 +136,20 @@ def _paginated_endpoint(base: str, page: int | None, page_size: int | None) -> s
     return f"{base}?{urlencode(params)}"
 
 
+def _is_page_envelope(data: Any) -> TypeGuard[dict[str, Any]]:
+    """Whether a response body is a list page: a data[] array with an integer
+    pages count."""
+    if not isinstance(data, dict):
+        return False
+    envelope = cast("dict[str, Any]", data)
+    pages = envelope.get("pages")
+    return (
+        isinstance(envelope.get("data"), list)
+        and isinstance(pages, int)
+        and not isinstance(pages, bool)
+    )
+
+
 def _validate_managed_linode_settings_ssh(value: object) -> dict[str, Any]:
     """Validate Managed Linode SSH settings payload."""
     if not isinstance(value, dict):
@@ -491,6 +514,7 @@ def build_profile_security_questions_body(
 HTTP_BAD_REQUEST = 400
 HTTP_UNAUTHORIZED = 401
 HTTP_FORBIDDEN = 403
+HTTP_NOT_FOUND = 404
 HTTP_TOO_MANY_REQUESTS = 429
 HTTP_SERVER_ERROR = 500
 HTTP_SERVER_ERROR_MAX = 600
@@ -500,6 +524,8 @@ __all__ = [
     "VPC",
     "VPCIP",
     "APIError",
+    "APIUnreachableError",
+    "APIVersionNotFoundError",
     "Account",
     "Addons",
     "Alerts",
@@ -546,12 +572,14 @@ __all__ = [
     "RetryableError",
     "SSHKey",
     "Schedule",
+    "SchemaDriftError",
     "Specs",
     "StackScript",
     "Transfer",
     "VPCSubnet",
     "Volume",
     "is_retryable",
+    "probe_api_url",
     "validate_disk_size",
     "validate_dns_record_name",
     "validate_dns_record_target",
@@ -576,12 +604,19 @@ class CircuitOpenError(LinodeError):
 
 
 class APIError(LinodeError):
-    """Linode API error."""
+    """Linode API error.
 
-    def __init__(self, status_code: int, message: str, field: str = "") -> None:
+    retry_after carries the server's Retry-After hint in seconds when present
+    so the retry loop can honor it instead of computing its own backoff.
+    """
+
+    def __init__(
+        self, status_code: int, message: str, field: str = "", retry_after: float = 0
+    ) -> None:
         self.status_code = status_code
         self.message = message
         self.field = field
+        self.retry_after = retry_after
         super().__init__(self._format_message())
 
     def _format_message(self) -> str:
@@ -630,6 +665,53 @@ class RetryableError(LinodeError):
         super().__init__(msg)
 
 
+class SchemaDriftError(LinodeError):
+    """A response carried a field its proto model does not declare while
+    schema_drift.mode is strict. Mirrors Go's ErrSchemaDrift."""
+
+    def __init__(self, model: str, error: Exception) -> None:
+        self.model = model
+        self.error = error
+        super().__init__(f"response schema drift: {model}: {error}")
+
+
+class APIUnreachableError(LinodeError):
+    """The API host did not answer at all (DNS failure, refused connection,
+    timeout). Raised by probe_api_url."""
+
+
+class APIVersionNotFoundError(LinodeError):
+    """The API host answered but the URL's version path does not exist.
+    Raised by probe_api_url."""
+
+
+# Bounds the startup reachability check of one API URL.
+_PROBE_TIMEOUT_SECONDS = 5.0
+
+
+async def probe_api_url(base_url: str, headers: dict[str, str] | None = None) -> None:
+    """Check a Linode API base URL answers at its version path.
+
+    Requests the public GET /regions without credentials: any response other
+    than 404 proves both the host and the version path, while a 404 means the
+    host is up but the version segment is wrong. headers are the environment's
+    extra headers, so a gateway in front of the API lets the probe through.
+    Mirrors Go's ProbeAPIURL.
+    """
+    url = f"{base_url}/regions"
+    try:
+        async with httpx.AsyncClient(timeout=_PROBE_TIMEOUT_SECONDS) as client:
+            response = await client.get(
+                url, headers={**(headers or {}), "User-Agent": "LinodeMCP/1.0"}
+            )
+    except httpx.HTTPError as exc:
+        msg = f"linode API URL is unreachable: {exc}"
+        raise APIUnreachableError(msg) from exc
+    if response.status_code == HTTP_NOT_FOUND:
+        msg = f"linode API version path not found: GET {url} returned 404"
+        raise APIVersionNotFoundError(msg)
+
+
 @dataclass
 class Profile:
     """Linode user profile.
@@ -1920,9 +2002,14 @@ class Client:
         max_connections: int = 10,
         max_keepalive_connections: int = 10,
         keepalive_expiry: float = 30.0,
+        headers: dict[str, str] | None = None,
+        transport: httpx.AsyncBaseTransport | None = None,
     ) -> None:
         self.base_url = api_url
         self.token = token
+        # Extra headers for every API request (Go's WithHeaders), such as the
+        # auth or tracing headers a gateway in front of the API expects.
+        self.headers = dict(headers or {})
         # Retain the Limits object so observability and tests can read back
         # what was actually configured. httpx.AsyncClient consumes Limits
         # internally and does not expose it.
@@ -1931,9 +2018,15 @@ class Client:
             max_keepalive_connections=max_keepalive_connections,
             keepalive_expiry=keepalive_expiry,
         )
+        # A tool call with its own timeout replaces the 30-second default per
+        # request (mirrors the Go WithRequestTimeout ctx). A transport, such
+        # as the fixtures backend's, answers in place of the network (Go's
+        # WithTransport).
         self.client = httpx.AsyncClient(
             timeout=30.0,
             limits=self.limits,
+            event_hooks={"request": [apply_request_timeout]},
+            transport=transport,
         )
 
     async def close(self) -> None:
@@ -2088,8 +2181,7 @@ class Client:
     async def list_instances(self) -> list[Instance]:
         """List Linode instances."""
         try:
-            response = await self.make_request("GET", "/linode/instances")
-            data = response.json()
+            data = await self._get_pages("/linode/instances")
             return [self._parse_instance(inst) for inst in data.get("data", [])]
         except httpx.HTTPError as e:
             raise NetworkError("ListInstances", e) from e
@@ -2196,7 +2288,10 @@ class Client:
         page: int | None = None,
         page_size: int | None = None,
     ) -> dict[str, Any]:
-        """List configuration profiles for a Linode instance."""
+        """List configuration profiles for a Linode instance.
+
+        Asked for no page, it returns every page's items (see _get_pages).
+        """
         encoded_linode_id = quote(str(linode_id), safe="")
         endpoint = f"/linode/instances/{encoded_linode_id}/configs"
         params: dict[str, int] = {}
@@ -2207,8 +2302,7 @@ class Client:
         if params:
             endpoint += "?" + urlencode(params)
         try:
-            response = await self.make_request("GET", endpoint)
-            data: dict[str, Any] = response.json()
+            data: dict[str, Any] = await self._get_pages(endpoint)
             return data
         except httpx.HTTPError as e:
             raise NetworkError("ListInstanceConfigs", e) from e
@@ -2860,9 +2954,10 @@ class Client:
         endpoint = f"/account/oauth-clients/{encoded_client_id}/thumbnail"
         url = self.base_url + endpoint
         headers = {
+            **self.headers,
             "Authorization": f"Bearer {self.token}",
             "Content-Type": "image/png",
-            "User-Agent": "LinodeMCP/1.0",
+            **correlation_headers(),
         }
         try:
             response = await self.client.request(
@@ -2880,7 +2975,9 @@ class Client:
     async def list_account_events(
         self, page: int | None = None, page_size: int | None = None
     ) -> dict[str, Any]:
-        """List events on the Linode account."""
+        """List events on the Linode account. The request is a list page read,
+        so it carries the call's X-Filter (see list_options.py), as Go's
+        listProtoElementsPaginated does."""
         endpoint = "/account/events"
         params: dict[str, int] = {}
         if page is not None:
@@ -2890,7 +2987,8 @@ class Client:
         if params:
             endpoint += "?" + urlencode(params)
         try:
-            response = await self.make_request("GET", endpoint)
+            with list_read():
+                response = await self.make_request("GET", endpoint)
             data: dict[str, Any] = response.json()
             return data
         except httpx.HTTPError as e:
@@ -3722,9 +3820,10 @@ class Client:
         endpoint = f"/account/oauth-clients/{encoded_client_id}/thumbnail"
         url = self.base_url + endpoint
         headers = {
+            **self.headers,
             "Authorization": f"Bearer {self.token}",
             "Accept": "image/png",
-            "User-Agent": "LinodeMCP/1.0",
+            **correlation_headers(),
         }
         try:
             response = await self.client.request("GET", url, headers=headers)
@@ -3883,8 +3982,7 @@ class Client:
     async def list_regions(self) -> list[Region]:
         """List Linode regions."""
         try:
-            response = await self.make_request("GET", "/regions")
-            data = response.json()
+            data = await self._get_pages("/regions")
             return [self._parse_region(r) for r in data.get("data", [])]
         except httpx.HTTPError as e:
             raise NetworkError("ListRegions", e) from e
@@ -3930,8 +4028,7 @@ class Client:
     async def list_types(self) -> list[InstanceType]:
         """List Linode instance types."""
         try:
-            response = await self.make_request("GET", "/linode/types")
-            data = response.json()
+            data = await self._get_pages("/linode/types")
             return [self._parse_instance_type(t) for t in data.get("data", [])]
         except httpx.HTTPError as e:
             raise NetworkError("ListTypes", e) from e
@@ -4038,8 +4135,7 @@ class Client:
     async def list_volumes(self) -> list[Volume]:
         """List Linode block storage volumes."""
         try:
-            response = await self.make_request("GET", "/volumes")
-            data = response.json()
+            data = await self._get_pages("/volumes")
             return [self._parse_volume(v) for v in data.get("data", [])]
         except httpx.HTTPError as e:
             raise NetworkError("ListVolumes", e) from e
@@ -4047,8 +4143,7 @@ class Client:
     async def list_volume_types(self) -> list[dict[str, Any]]:
         """List Linode block storage volume types."""
         try:
-            response = await self.make_request("GET", "/volumes/types")
-            data = response.json()
+            data = await self._get_pages("/volumes/types")
             volume_types: list[dict[str, Any]] = data.get("data", [])
             return volume_types
         except httpx.HTTPError as e:
@@ -4066,8 +4161,7 @@ class Client:
     async def list_images(self) -> list[Image]:
         """List Linode images."""
         try:
-            response = await self.make_request("GET", "/images")
-            data = response.json()
+            data = await self._get_pages("/images")
             return [self._parse_image(i) for i in data.get("data", [])]
         except httpx.HTTPError as e:
             raise NetworkError("ListImages", e) from e
@@ -4624,8 +4718,7 @@ class Client:
     async def list_ssh_keys(self) -> list[SSHKey]:
         """List SSH keys."""
         try:
-            response = await self.make_request("GET", "/profile/sshkeys")
-            data = response.json()
+            data = await self._get_pages("/profile/sshkeys")
             return [self._parse_ssh_key(k) for k in data.get("data", [])]
         except httpx.HTTPError as e:
             raise NetworkError("ListSSHKeys", e) from e
@@ -4642,8 +4735,7 @@ class Client:
     async def list_domains(self) -> list[Domain]:
         """List domains."""
         try:
-            response = await self.make_request("GET", "/domains")
-            data = response.json()
+            data = await self._get_pages("/domains")
             return [self._parse_domain(d) for d in data.get("data", [])]
         except httpx.HTTPError as e:
             raise NetworkError("ListDomains", e) from e
@@ -4677,8 +4769,7 @@ class Client:
         """List domain records for a domain."""
         endpoint = f"/domains/{domain_id}/records"
         try:
-            response = await self.make_request("GET", endpoint)
-            data = response.json()
+            data = await self._get_pages(endpoint)
             return [self._parse_domain_record(r) for r in data.get("data", [])]
         except httpx.HTTPError as e:
             raise NetworkError("ListDomainRecords", e) from e
@@ -4696,8 +4787,7 @@ class Client:
     async def list_firewalls(self) -> list[Firewall]:
         """List firewalls."""
         try:
-            response = await self.make_request("GET", "/networking/firewalls")
-            data = response.json()
+            data = await self._get_pages("/networking/firewalls")
             return [self._parse_firewall(f) for f in data.get("data", [])]
         except httpx.HTTPError as e:
             raise NetworkError("ListFirewalls", e) from e
@@ -4860,7 +4950,10 @@ class Client:
         page: int | None = None,
         page_size: int | None = None,
     ) -> dict[str, Any]:
-        """List devices attached to a Cloud Firewall."""
+        """List devices attached to a Cloud Firewall.
+
+        Asked for no page, it returns every page's items (see _get_pages).
+        """
         safe_firewall_id = quote(str(firewall_id), safe="")
         endpoint = f"/networking/firewalls/{safe_firewall_id}/devices"
         params: dict[str, Any] = {}
@@ -4871,8 +4964,7 @@ class Client:
         if params:
             endpoint = f"{endpoint}?{urlencode(params)}"
         try:
-            response = await self.make_request("GET", endpoint)
-            result: dict[str, Any] = response.json()
+            result: dict[str, Any] = await self._get_pages(endpoint)
             return result
         except httpx.HTTPError as e:
             raise NetworkError("ListFirewallDevices", e) from e
@@ -4909,8 +5001,7 @@ class Client:
         if params:
             endpoint += "?" + urlencode(params)
         try:
-            response = await self.make_request("GET", endpoint)
-            data = response.json()
+            data = await self._get_pages(endpoint)
             vlans: list[dict[str, Any]] = data.get("data", [])
             return vlans
         except httpx.HTTPError as e:
@@ -4996,7 +5087,10 @@ class Client:
     async def list_tagged_objects(
         self, tag_label: str, page: int | None = None, page_size: int | None = None
     ) -> dict[str, Any]:
-        """List objects assigned to a tag."""
+        """List objects assigned to a tag.
+
+        Asked for no page, it returns every page's items (see _get_pages).
+        """
         encoded_label = quote(tag_label, safe="")
         endpoint = f"/tags/{encoded_label}"
         params: dict[str, int] = {}
@@ -5007,8 +5101,7 @@ class Client:
         if params:
             endpoint += "?" + urlencode(params)
         try:
-            response = await self.make_request("GET", endpoint)
-            data: dict[str, Any] = response.json()
+            data: dict[str, Any] = await self._get_pages(endpoint)
             return data
         except httpx.HTTPError as e:
             raise NetworkError("ListTaggedObjects", e) from e
@@ -5677,8 +5770,7 @@ class Client:
     async def list_nodebalancers(self) -> list[NodeBalancer]:
         """List NodeBalancers."""
         try:
-            response = await self.make_request("GET", "/nodebalancers")
-            data = response.json()
+            data = await self._get_pages("/nodebalancers")
             return [self._parse_nodebalancer(nb) for nb in data.get("data", [])]
         except httpx.HTTPError as e:
             raise NetworkError("ListNodeBalancers", e) from e
@@ -5686,8 +5778,7 @@ class Client:
     async def list_nodebalancer_types(self) -> list[dict[str, Any]]:
         """List NodeBalancer types."""
         try:
-            response = await self.make_request("GET", "/nodebalancers/types")
-            data = response.json()
+            data = await self._get_pages("/nodebalancers/types")
             types: list[dict[str, Any]] = data.get("data", [])
             return types
         except httpx.HTTPError as e:
@@ -6114,8 +6205,7 @@ class Client:
     async def list_stackscripts(self) -> list[StackScript]:
         """List StackScripts."""
         try:
-            response = await self.make_request("GET", "/linode/stackscripts")
-            data = response.json()
+            data = await self._get_pages("/linode/stackscripts")
             return [self._parse_stackscript(s) for s in data.get("data", [])]
         except httpx.HTTPError as e:
             raise NetworkError("ListStackScripts", e) from e
@@ -6237,8 +6327,7 @@ class Client:
     async def list_object_storage_buckets(self) -> list[dict[str, Any]]:
         """List Object Storage buckets."""
         try:
-            response = await self.make_request("GET", "/object-storage/buckets")
-            data = response.json()
+            data = await self._get_pages("/object-storage/buckets")
             buckets: list[dict[str, Any]] = data.get("data", [])
             return buckets
         except httpx.HTTPError as e:
@@ -6250,10 +6339,9 @@ class Client:
         """List Object Storage buckets in a region."""
         encoded_region_id = quote(str(region_id), safe="")
         try:
-            response = await self.make_request(
-                "GET", f"/object-storage/buckets/{encoded_region_id}"
+            data = await self._get_pages(
+                f"/object-storage/buckets/{encoded_region_id}"
             )
-            data = response.json()
             buckets: list[dict[str, Any]] = data.get("data", [])
             return buckets
         except httpx.HTTPError as e:
@@ -6302,8 +6390,7 @@ class Client:
     async def list_object_storage_endpoints(self) -> list[dict[str, Any]]:
         """List Object Storage endpoints."""
         try:
-            response = await self.make_request("GET", "/object-storage/endpoints")
-            data = response.json()
+            data = await self._get_pages("/object-storage/endpoints")
             endpoints: list[dict[str, Any]] = data.get("data", [])
             return endpoints
         except httpx.HTTPError as e:
@@ -6312,8 +6399,7 @@ class Client:
     async def list_object_storage_types(self) -> list[dict[str, Any]]:
         """List Object Storage types/pricing."""
         try:
-            response = await self.make_request("GET", "/object-storage/types")
-            data = response.json()
+            data = await self._get_pages("/object-storage/types")
             types: list[dict[str, Any]] = data.get("data", [])
             return types
         except httpx.HTTPError as e:
@@ -6322,8 +6408,7 @@ class Client:
     async def list_object_storage_keys(self) -> list[dict[str, Any]]:
         """List all Object Storage access keys."""
         try:
-            response = await self.make_request("GET", "/object-storage/keys")
-            data = response.json()
+            data = await self._get_pages("/object-storage/keys")
             keys: list[dict[str, Any]] = data.get("data", [])
             return keys
         except httpx.HTTPError as e:
@@ -6369,8 +6454,7 @@ class Client:
     async def list_object_storage_quotas(self) -> list[dict[str, Any]]:
         """List Object Storage quotas."""
         try:
-            response = await self.make_request("GET", "/object-storage/quotas")
-            data = response.json()
+            data = await self._get_pages("/object-storage/quotas")
             quotas: list[dict[str, Any]] = data.get("data", [])
             return quotas
         except httpx.HTTPError as e:
@@ -6538,11 +6622,13 @@ class Client:
         name: str,
         method: str,
         expires_in: int | None = None,
+        content_type: str = "",
     ) -> dict[str, Any]:
         """Generate a presigned URL for an object.
 
         expires_in is sent only when provided; the API applies its documented
-        default (3600) otherwise.
+        default (3600) otherwise. content_type, when set, is signed into a PUT
+        URL, so the upload must send the same Content-Type.
         """
         endpoint = f"/object-storage/buckets/{region}/{label}/object-url"
         body: dict[str, Any] = {
@@ -6551,12 +6637,37 @@ class Client:
         }
         if expires_in is not None:
             body["expires_in"] = expires_in
+        if content_type:
+            body["content_type"] = content_type
         try:
             response = await self.make_request("POST", endpoint, body)
             return dict(response.json())
         except httpx.HTTPError as e:
             raise NetworkError("CreatePresignedURL", e) from e
 
+    async def put_presigned_object(
+        self, presigned_url: str, content_type: str, data: bytes
+    ) -> None:
+        """Upload data to a presigned PUT URL.
+
+        The URL carries its own signature, so the request goes straight to the
+        Object Storage endpoint without the API token. S3 answers errors in
+        XML, so a failure is reported by status only.
+        """
+        headers = {"Content-Type": content_type, "User-Agent": "LinodeMCP/1.0"}
+        try:
+            response = await self.client.put(
+                presigned_url, headers=headers, content=data
+            )
+        except httpx.HTTPError as e:
+            raise NetworkError("PutPresignedObject", e) from e
+        if response.status_code >= HTTP_BAD_REQUEST:
+            raise APIError(
+                response.status_code,
+                "upload to presigned URL failed: "
+                f"{response.status_code} {response.reason_phrase}",
+            )
+
     async def get_object_acl(
         self, region: str, label: str, name: str
     ) -> dict[str, Any]:
@@ -8300,13 +8411,16 @@ class Client:
         route_ipv4: bool = True,
         route_ipv6: bool = True,
         tags: list[str] | None = None,
+        interfaces: list[dict[str, Any]] | None = None,
+        backup_id: int | None = None,
     ) -> dict[str, Any]:
         """Create an instance and return the full raw API body.
 
         Validates the label and root password, then posts the interface-bearing
-        create body. The proto-backed write handler decodes the full JSON into
-        the write proto so Python output matches Go, which decodes the same
-        full API JSON.
+        create body; interfaces, when given, replaces the default single
+        public interface. The proto-backed write handler decodes the full JSON
+        into the write proto so Python output matches Go, which decodes the
+        same full API JSON.
         """
         validate_label(label)
         validate_root_password(root_pass)
@@ -8316,7 +8430,8 @@ class Client:
                 "region": region,
                 "type": instance_type,
                 "interface_generation": CURRENT_INTERFACE_GENERATION,
-                "interfaces": [
+                "interfaces": interfaces
+                or [
                     _build_public_interface_entry(firewall_id, route_ipv4, route_ipv6),
                 ],
             }
@@ -8331,6 +8446,8 @@ class Client:
                 body["backups_enabled"] = True
             if image:
                 body["image"] = image
+            if backup_id:
+                body["backup_id"] = backup_id
             if label:
                 body["label"] = label
             if root_pass:
@@ -8601,18 +8718,25 @@ class Client:
         firewall_id: int,
         inbound: list[dict[str, Any]],
         outbound: list[dict[str, Any]],
+        inbound_policy: str | None = None,
+        outbound_policy: str | None = None,
     ) -> dict[str, Any]:
         """Replace a firewall's rules and return the raw API body.
 
         Proto-backed write tools decode this full ruleset into the FirewallRules
         proto element, so their output matches the Go implementation's write
         envelope. Unlike update_firewall_rules, this keeps the inbound_policy and
-        outbound_policy fields the proto element carries.
+        outbound_policy fields the proto element carries. A policy given here is
+        replaced along with the rules; one left out keeps its current value.
         """
         _validate_firewall_rules_update_request(firewall_id, inbound, outbound)
 
         endpoint = f"/networking/firewalls/{firewall_id}/rules"
         body: dict[str, Any] = {"inbound": inbound, "outbound": outbound}
+        if inbound_policy:
+            body["inbound_policy"] = inbound_policy
+        if outbound_policy:
+            body["outbound_policy"] = outbound_policy
 
         try:
             response = await self.make_request("PUT", endpoint, body)
@@ -9214,8 +9338,7 @@ class Client:
     async def list_lke_clusters(self) -> list[dict[str, Any]]:
         """List LKE clusters."""
         try:
-            response = await self.make_request("GET", "/lke/clusters")
-            data = response.json()
+            data = await self._get_pages("/lke/clusters")
             clusters: list[dict[str, Any]] = data.get("data", [])
             return clusters
         except httpx.HTTPError as e:
@@ -9312,8 +9435,7 @@ class Client:
         """List node pools for an LKE cluster."""
         endpoint = f"/lke/clusters/{cluster_id}/pools"
         try:
-            response = await self.make_request("GET", endpoint)
-            data = response.json()
+            data = await self._get_pages(endpoint)
             pools: list[dict[str, Any]] = data.get("data", [])
             return pools
         except httpx.HTTPError as e:
@@ -9452,8 +9574,7 @@ class Client:
         """List API endpoints for an LKE cluster."""
         endpoint = f"/lke/clusters/{cluster_id}/api-endpoints"
         try:
-            response = await self.make_request("GET", endpoint)
-            data = response.json()
+            data = await self._get_pages(endpoint)
             endpoints: list[dict[str, Any]] = data.get("data", [])
             return endpoints
         except httpx.HTTPError as e:
@@ -9524,8 +9645,7 @@ class Client:
     async def list_lke_versions(self) -> list[dict[str, Any]]:
         """List available LKE Kubernetes versions."""
         try:
-            response = await self.make_request("GET", "/lke/versions")
-            data = response.json()
+            data = await self._get_pages("/lke/versions")
             versions: list[dict[str, Any]] = data.get("data", [])
             return versions
         except httpx.HTTPError as e:
@@ -9544,8 +9664,7 @@ class Client:
     async def list_lke_types(self) -> list[dict[str, Any]]:
         """List available LKE node types."""
         try:
-            response = await self.make_request("GET", "/lke/types")
-            data = response.json()
+            data = await self._get_pages("/lke/types")
             types: list[dict[str, Any]] = data.get("data", [])
             return types
         except httpx.HTTPError as e:
@@ -9556,8 +9675,7 @@ class Client:
         encoded_tier = quote(tier, safe="")
         endpoint = f"/lke/tiers/{encoded_tier}/versions"
         try:
-            response = await self.make_request("GET", endpoint)
-            data = response.json()
+            data = await self._get_pages(endpoint)
             versions: list[dict[str, Any]] = data.get("data", [])
             return versions
         except httpx.HTTPError as e:
@@ -9580,8 +9698,7 @@ class Client:
     async def list_vpcs(self) -> list[dict[str, Any]]:
         """List VPCs."""
         try:
-            response = await self.make_request("GET", "/vpcs")
-            data = response.json()
+            data = await self._get_pages("/vpcs")
             vpcs: list[dict[str, Any]] = data.get("data", [])
             return vpcs
         except httpx.HTTPError as e:
@@ -9651,8 +9768,7 @@ class Client:
     async def list_vpc_ips(self) -> list[dict[str, Any]]:
         """List all VPC IP addresses."""
         try:
-            response = await self.make_request("GET", "/vpcs/ips")
-            data = response.json()
+            data = await self._get_pages("/vpcs/ips")
             ips: list[dict[str, Any]] = data.get("data", [])
             return ips
         except httpx.HTTPError as e:
@@ -9662,8 +9778,7 @@ class Client:
         """List IP addresses for a specific VPC."""
         endpoint = f"/vpcs/{vpc_id}/ips"
         try:
-            response = await self.make_request("GET", endpoint)
-            data = response.json()
+            data = await self._get_pages(endpoint)
             ips: list[dict[str, Any]] = data.get("data", [])
             return ips
         except httpx.HTTPError as e:
@@ -9673,8 +9788,7 @@ class Client:
         """List subnets for a VPC."""
         endpoint = f"/vpcs/{vpc_id}/subnets"
         try:
-            response = await self.make_request("GET", endpoint)
-            data = response.json()
+            data = await self._get_pages(endpoint)
             subnets: list[dict[str, Any]] = data.get("data", [])
             return subnets
         except httpx.HTTPError as e:
@@ -9960,7 +10074,10 @@ class Client:
     async def list_reserved_ips(
         self, page: int | None = None, page_size: int | None = None
     ) -> dict[str, Any]:
-        """List reserved public IPv4 addresses."""
+        """List reserved public IPv4 addresses.
+
+        Asked for no page, it returns every page's items (see _get_pages).
+        """
         params: dict[str, int] = {}
         if page is not None:
             params["page"] = page
@@ -9973,8 +10090,7 @@ class Client:
             else "/networking/reserved/ips"
         )
         try:
-            response = await self.make_request("GET", endpoint)
-            result: dict[str, Any] = response.json()
+            result: dict[str, Any] = await self._get_pages(endpoint)
             return result
         except httpx.HTTPError as e:
             raise NetworkError("ListReservedIPs", e) from e
@@ -10098,8 +10214,7 @@ class Client:
         """List disks for an instance."""
         endpoint = f"/linode/instances/{instance_id}/disks"
         try:
-            response = await self.make_request("GET", endpoint)
-            data = response.json()
+            data = await self._get_pages(endpoint)
             disks: list[dict[str, Any]] = data.get("data", [])
             return disks
         except httpx.HTTPError as e:
@@ -10413,8 +10528,7 @@ class Client:
                 if query_parts:
                     endpoint += "?" + "&".join(query_parts)
 
-                response = await self.make_request("GET", endpoint)
-                data = response.json()
+                data = await self._get_pages(endpoint)
                 ips: list[dict[str, Any]] = data.get("data", [])
                 all_ips.extend(ips)
 
@@ -10511,6 +10625,7 @@ class Client:
         self,
         instance_id: int,
         region: str | None = None,
+        migration_type: str | None = None,
     ) -> None:
         """Migrate an instance to a new region."""
         endpoint = f"/linode/instances/{instance_id}/migrate"
@@ -10518,6 +10633,8 @@ class Client:
             body: dict[str, Any] = {}
             if region is not None:
                 body["region"] = region
+            if migration_type is not None:
+                body["type"] = migration_type
             await self.make_request("POST", endpoint, body)
         except httpx.HTTPError as e:
             raise NetworkError("MigrateInstance", e) from e
@@ -10580,9 +10697,11 @@ class Client:
         """Make an HTTP request to the Linode API."""
         url = self.base_url + endpoint
         headers = {
+            **self.headers,
             "Authorization": f"Bearer {self.token}",
             "Content-Type": "application/json",
-            "User-Agent": "LinodeMCP/1.0",
+            **correlation_headers(),
+            **list_filter_headers(),
         }
 
         start = time.monotonic()
@@ -10600,6 +10719,12 @@ class Client:
             else:
                 response = await self.client.request(method, url, headers=headers)
             status = response.status_code
+            record_reachability(reached=True)
+            record_api_error(method, metrics_endpoint(endpoint), status)
+        except httpx.TransportError:
+            record_reachability(reached=False)
+            record_api_error(method, metrics_endpoint(endpoint), 0)
+            raise
         finally:
             recorder = get_api_recorder()
             if recorder is not None:
@@ -10609,10 +10734,20 @@ class Client:
                     status,
                     time.monotonic() - start,
                 )
+            logger.debug(
+                "linode api request",
+                extra={
+                    "method": method,
+                    "endpoint": metrics_endpoint(endpoint),
+                    "status": status,
+                    "correlation_id": get_correlation_id(),
+                },
+            )
 
         if response.status_code >= HTTP_BAD_REQUEST:
             self._handle_error_response(response)
 
+        record_write(method, endpoint, body, response)
         return response
 
     async def get_raw(self, endpoint: str) -> Any:
@@ -10620,11 +10755,67 @@ class Client:
 
         Proto-backed read tools decode this raw response straight into the proto
         message, so their output matches the Go implementation, which decodes the
-        same full API JSON. Bypasses the typed dataclass parsing.
+        same full API JSON. Bypasses the typed dataclass parsing. A list
+        endpoint asked for no page comes back with every page's items, as Go's
+        proto list fetchers return them.
+        """
+        return await self._get_pages(endpoint)
+
+    async def _get_pages(self, endpoint: str) -> Any:
+        """GET endpoint, following the remaining pages when the body is a page
+        envelope and endpoint names no page.
+
+        The items of every page are merged into one envelope that reads as a
+        single page holding them all; results keeps the API's total. The walk
+        stops after the last page, on an empty page, or at the call's
+        max_results cap (see max_results.py), which it records when results
+        were left behind. Every page request carries the call's X-Filter (see
+        list_options.py), and items outside the call's label prefix are
+        dropped before the cap counts them (see label_prefix.py); the API's
+        results total then counts dropped items, so it is not recorded.
+        Mirrors Go's listProtoPages.
         """
+        with list_read():
+            return await self._walk_pages(endpoint)
+
+    async def _walk_pages(self, endpoint: str) -> Any:
+        """The page walk behind _get_pages."""
         response = await self.make_request("GET", endpoint)
         data: Any = response.json()
-        return data
+        if not _is_page_envelope(data) or "page" in parse_qs(urlsplit(endpoint).query):
+            return data
+
+        limit = get_max_results()
+        page_items: list[Any] = list(data["data"])
+        items: list[Any] = keep_label_prefix(page_items)
+        pages = data["pages"]
+        page = 1
+        while True:
+            if limit and len(items) >= limit:
+                if len(items) > limit or page < pages:
+                    results = data.get("results")
+                    if get_label_prefix() or not isinstance(results, int):
+                        results = 0
+                    record_truncated(results)
+                items = items[:limit]
+                break
+            if page >= pages or not page_items:
+                if page == 1 and len(items) == len(page_items):
+                    return data
+                break
+            page += 1
+            separator = "&" if "?" in endpoint else "?"
+            response = await self.make_request(
+                "GET", f"{endpoint}{separator}page={page}"
+            )
+            next_page: Any = response.json()
+            if not _is_page_envelope(next_page):
+                break
+            page_items = list(next_page["data"])
+            items.extend(keep_label_prefix(page_items))
+            pages = next_page["pages"]
+
+        return {**data, "data": items, "page": 1, "pages": 1}
 
     async def post_raw(self, endpoint: str, body: dict[str, Any] | None = None) -> Any:
         """POST to an endpoint and return its decoded JSON body.
@@ -10654,8 +10845,9 @@ class Client:
         url = self.base_url + endpoint
         path = Path(file_path)
         headers = {
+            **self.headers,
             "Authorization": f"Bearer {self.token}",
-            "User-Agent": "LinodeMCP/1.0",
+            **correlation_headers(),
         }
 
         with path.open("rb") as file_obj:
@@ -10674,6 +10866,7 @@ class Client:
 
     def _handle_error_response(self, response: httpx.Response) -> None:
         """Handle error responses from the API."""
+        retry_after = _parse_retry_after(response.headers.get("Retry-After", ""))
         try:
             error_data = response.json()
             errors = error_data.get("errors", [])
@@ -10682,6 +10875,7 @@ class Client:
                     status_code=response.status_code,
                     message=errors[0].get("reason", "Unknown error"),
                     field=errors[0].get("field", ""),
+                    retry_after=retry_after,
                 )
         except (ValueError, KeyError) as e:
             logger.debug("Failed to parse error response body: %s", e)
@@ -10696,11 +10890,10 @@ class Client:
                 "Access forbidden. Your API token may not have sufficient permissions.",
             )
         if response.status_code == HTTP_TOO_MANY_REQUESTS:
-            retry_after = response.headers.get("Retry-After", "")
             message = "Rate limit exceeded. Please try again later."
-            if retry_after:
-                message = f"Rate limit exceeded. Retry after {retry_after}."
-            raise APIError(HTTP_TOO_MANY_REQUESTS, message)
+            if retry_after > 0:
+                message = f"Rate limit exceeded. Retry after {retry_after:g}s."
+            raise APIError(HTTP_TOO_MANY_REQUESTS, message, retry_after=retry_after)
         if response.status_code >= HTTP_SERVER_ERROR:
             raise APIError(
                 response.status_code, "Internal server error. Please try again later."
@@ -11041,11 +11234,28 @@ class RetryConfig:
     pool_max_connections: int = 10
     pool_max_keepalive_connections: int = 10
     pool_keepalive_expiry: float = 30.0
+    # Stop retrying once the next wait would carry the call past this many
+    # seconds. Zero leaves max_retries as the only bound.
+    max_elapsed: float = 0.0
 
 
 _SECONDS_PER_MINUTE = 60.0
 
 
+def _parse_retry_after(value: str) -> float:
+    """Seconds a Retry-After header asks to wait, given as delta-seconds or
+    an HTTP date; 0 when absent or unreadable (Go's parseRetryAfter)."""
+    if not value:
+        return 0.0
+    if value.isdigit():
+        return float(value)
+    try:
+        when = parsedate_to_datetime(value)
+    except (TypeError, ValueError):
+        return 0.0
+    return max((when - datetime.now(UTC)).total_seconds(), 0.0)
+
+
 class RateLimiter:
     """Asyncio token-bucket rate limiter.
 
@@ -11170,7 +11380,12 @@ class RetryableClient:
     """Linode API client with retry functionality and a circuit breaker."""
 
     def __init__(
-        self, api_url: str, token: str, retry_config: RetryConfig | None = None
+        self,
+        api_url: str,
+        token: str,
+        retry_config: RetryConfig | None = None,
+        headers: dict[str, str] | None = None,
+        transport: httpx.AsyncBaseTransport | None = None,
     ) -> None:
         self.retry_config = retry_config or RetryConfig()
         self.client = Client(
@@ -11179,6 +11394,8 @@ class RetryableClient:
             max_connections=self.retry_config.pool_max_connections,
             max_keepalive_connections=self.retry_config.pool_max_keepalive_connections,
             keepalive_expiry=self.retry_config.pool_keepalive_expiry,
+            headers=headers,
+            transport=transport,
         )
         self._request_semaphore = asyncio.Semaphore(10)
         self._circuit = CircuitBreaker(
@@ -13563,6 +13780,7 @@ class RetryableClient:
         name: str,
         method: str,
         expires_in: int | None = None,
+        content_type: str = "",
     ) -> dict[str, Any]:
         """Generate presigned URL with retry."""
         result: dict[str, Any] = await self._execute_with_retry(
@@ -13572,9 +13790,18 @@ class RetryableClient:
             name,
             method,
             expires_in,
+            content_type,
         )
         return result
 
+    async def put_presigned_object(
+        self, presigned_url: str, content_type: str, data: bytes
+    ) -> None:
+        """Upload to a presigned PUT URL with retry."""
+        await self._execute_with_retry(
+            self.client.put_presigned_object, presigned_url, content_type, data
+        )
+
     async def get_object_acl(
         self, region: str, label: str, name: str
     ) -> dict[str, Any]:
@@ -14050,6 +14277,8 @@ class RetryableClient:
         route_ipv4: bool = True,
         route_ipv6: bool = True,
         tags: list[str] | None = None,
+        interfaces: list[dict[str, Any]] | None = None,
+        backup_id: int | None = None,
     ) -> dict[str, Any]:
         """Create instance with retry, returning the full raw API body."""
         result: dict[str, Any] = await self._execute_with_retry(
@@ -14067,6 +14296,8 @@ class RetryableClient:
             route_ipv4,
             route_ipv6,
             tags,
+            interfaces,
+            backup_id,
         )
         return result
 
@@ -14166,6 +14397,8 @@ class RetryableClient:
         firewall_id: int,
         inbound: list[dict[str, Any]],
         outbound: list[dict[str, Any]],
+        inbound_policy: str | None = None,
+        outbound_policy: str | None = None,
     ) -> dict[str, Any]:
         """Replace firewall rules and return the raw API body with retry."""
         result: dict[str, Any] = await self._execute_with_retry(
@@ -14173,6 +14406,8 @@ class RetryableClient:
             firewall_id,
             inbound,
             outbound,
+            inbound_policy,
+            outbound_policy,
         )
         return result
 
@@ -15233,12 +15468,14 @@ class RetryableClient:
         self,
         instance_id: int,
         region: str | None = None,
+        migration_type: str | None = None,
     ) -> None:
         """Migrate instance with retry."""
         await self._execute_with_retry(
             self.client.migrate_instance,
             instance_id,
             region,
+            migration_type,
         )
 
     async def rebuild_instance(
@@ -15314,10 +15551,16 @@ class RetryableClient:
 
         async with self._request_semaphore:
             last_error: Exception | None = None
+            start = time.monotonic()
+            max_elapsed = self.retry_config.max_elapsed
 
             for attempt in range(self.retry_config.max_retries + 1):
                 if attempt > 0:
-                    delay = self._calculate_delay(attempt)
+                    delay = self._delay_for_attempt(attempt, last_error)
+                    if max_elapsed > 0 and (
+                        time.monotonic() - start + delay > max_elapsed
+                    ):
+                        break
                     await asyncio.sleep(delay)
 
                 # Gate the network attempt on the per-client rate limiter so
@@ -15338,11 +15581,19 @@ class RetryableClient:
                     self._circuit.record_success()
                     return result
 
-            # Retries exhausted on a retryable failure: this is the signal
-            # the breaker tracks.
+            # Retries exhausted on a retryable failure, by count or by
+            # elapsed time: this is the signal the breaker tracks.
             self._circuit.record_failure()
             raise last_error or LinodeError("Unknown retry error")
 
+    def _delay_for_attempt(self, attempt: int, last_error: Exception | None) -> float:
+        """Honor the server's Retry-After hint (typically on 429), clamped to
+        max_delay, and otherwise back off exponentially with jitter. Mirrors
+        Go's delayForAttempt."""
+        if isinstance(last_error, APIError) and last_error.retry_after > 0:
+            return min(last_error.retry_after, self.retry_config.max_delay)
+        return self._calculate_delay(attempt)
+
     def _calculate_delay(self, attempt: int) -> float:
         """Calculate delay for retry with exponential backoff and jitter."""
         delay = self.retry_config.base_delay * (
@@ -0,0 +1,92 @@
+"""Recent failed Linode API requests, kept for linode_api_status.
+
+The server binds one log into every dispatch context so the client adds each
+request that failed in a way an outage would explain. Mirrors the Go
+linode/api_errors.go.
+"""
+
+import contextvars
+import threading
+from dataclasses import dataclass
+from datetime import UTC, datetime
+
+# How many failed requests an APIErrorLog keeps.
+DEFAULT_API_ERROR_LOG_SIZE = 200
+
+_HTTP_TOO_MANY_REQUESTS = 429
+_HTTP_INTERNAL_SERVER_ERROR = 500
+
+
+@dataclass(frozen=True)
+class APIErrorRecord:
+    """One request that failed in a way an outage would explain: no response
+    at all (status 0), a 429, or a 5xx. Client errors such as a 404 or a
+    validation 400 are the caller's and are not recorded."""
+
+    at: datetime
+    method: str
+    endpoint: str
+    status: int
+
+
+class APIErrorLog:
+    """The most recent failed API requests across every call the server
+    handles. Process memory only; drops the oldest record once full."""
+
+    def __init__(self, size: int = 0) -> None:
+        self._size = size if size > 0 else DEFAULT_API_ERROR_LOG_SIZE
+        self._records: list[APIErrorRecord] = []
+        self._lock = threading.Lock()
+
+    def add(self, record: APIErrorRecord) -> None:
+        """Record one failed request."""
+        with self._lock:
+            if len(self._records) == self._size:
+                self._records.pop(0)
+            self._records.append(record)
+
+    def since(self, since: datetime) -> list[APIErrorRecord]:
+        """Return the records at or after since, oldest first."""
+        with self._lock:
+            return [r for r in self._records if r.at >= since]
+
+
+_api_error_log: contextvars.ContextVar[APIErrorLog | None] = contextvars.ContextVar(
+    "linode_api_error_log", default=None
+)
+
+
+def set_api_error_log(
+    log: APIErrorLog | None,
+) -> contextvars.Token[APIErrorLog | None]:
+    """Bind the log for the current context; returns a reset token."""
+    return _api_error_log.set(log)
+
+
+def reset_api_error_log(token: contextvars.Token[APIErrorLog | None]) -> None:
+    """Restore the log bound before the matching set_api_error_log."""
+    _api_error_log.reset(token)
+
+
+def get_api_error_log() -> APIErrorLog | None:
+    """Return the log bound for the current context, or None."""
+    return _api_error_log.get()
+
+
+def record_api_error(method: str, endpoint: str, status: int) -> None:
+    """Add a finished request to the bound log when its status is one an
+    outage would explain."""
+    log = _api_error_log.get()
+    if log is None:
+        return
+    if (
+        status != 0
+        and status != _HTTP_TOO_MANY_REQUESTS
+        and status < _HTTP_INTERNAL_SERVER_ERROR
+    ):
+        return
+    log.add(
+        APIErrorRecord(
+            at=datetime.now(UTC), method=method, endpoint=endpoint, status=status
+        )
+    )
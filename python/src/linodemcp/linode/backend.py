@@ -0,0 +1,78 @@
+"""Environment backends other than the Linode API.
+
+An environment on the ``fixtures`` backend answers every API call from
+recorded JSON files instead of the network. Mirrors Go's
+linode/backend.go: the same file layout, so one fixtures directory serves
+both servers.
+"""
+
+import json
+import posixpath
+from pathlib import Path
+from urllib.parse import urlsplit
+
+import httpx
+
+from linodemcp.config import BACKEND_FIXTURES, LinodeConfig
+
+
+def environment_transport(linode: LinodeConfig) -> httpx.AsyncBaseTransport | None:
+    """Return the transport an environment's backend calls for, or None for
+    the API itself (Go's EnvironmentOptions)."""
+    if linode.backend == BACKEND_FIXTURES:
+        return FixtureTransport(linode.fixtures_dir, linode.api_url)
+    return None
+
+
+class FixtureTransport(httpx.AsyncBaseTransport):
+    """Answers API requests from recorded JSON files under a directory.
+
+    Files are named for the endpoint below the API version: GET
+    /v4/linode/instances/123 reads ``linode/instances/123.json``, and any
+    other method reads the file with the method added, such as
+    ``linode/instances/123/reboot.post.json``. Query strings are ignored
+    except for a GET's page past the first, which reads the file with the
+    page added, such as ``linode/instances_page2.json``, so a page walk over
+    a recorded list ends where the recording does. A request with no
+    recorded file gets the API's 404 error envelope naming the file it
+    looked for.
+    """
+
+    def __init__(self, fixtures_dir: str, api_url: str) -> None:
+        self._root = Path(fixtures_dir).resolve()
+        # The API URL's path ahead of its version segment, such as /proxy
+        # for https://gateway.internal/proxy/v4.
+        path = urlsplit(api_url).path.rstrip("/")
+        self._prefix = posixpath.dirname(path).rstrip("/")
+
+    def _fixture_name(self, method: str, url_path: str, page: str) -> str:
+        """The request's fixture file, relative to the fixtures directory.
+        The path is normalized first so it cannot climb out of it."""
+        path = url_path.removeprefix(self._prefix).lstrip("/")
+        _, _, endpoint = path.partition("/")
+        name = posixpath.normpath("/" + endpoint).lstrip("/")
+        if method != "GET":
+            name += "." + method.lower()
+        elif page.isascii() and page.isdigit() and int(page) > 1:
+            name += f"_page{int(page)}"
+        return name + ".json"
+
+    async def handle_async_request(self, request: httpx.Request) -> httpx.Response:
+        name = self._fixture_name(
+            request.method, request.url.path, request.url.params.get("page", "")
+        )
+        path = (self._root / name).resolve()
+        if path.is_relative_to(self._root) and path.is_file():
+            return httpx.Response(
+                200,
+                content=path.read_bytes(),
+                headers={"Content-Type": "application/json"},
+                request=request,
+            )
+        missing = {"errors": [{"reason": f"no fixture recorded at {name}"}]}
+        return httpx.Response(
+            404,
+            content=json.dumps(missing).encode(),
+            headers={"Content-Type": "application/json"},
+            request=request,
+        )
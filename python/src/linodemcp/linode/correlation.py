@@ -0,0 +1,49 @@
+"""Per-call correlation ID sent with every Linode API request.
+
+The server binds the tool call's audit event ID into the dispatch context so
+a failing API call can be matched to the audit entry and the server logs
+during a support investigation. Mirrors the Go linode/correlation.go context
+wiring.
+"""
+
+import contextvars
+
+# Header carrying the correlation ID (Go's CorrelationIDHeader).
+CORRELATION_ID_HEADER = "X-LinodeMCP-Correlation-ID"
+
+_USER_AGENT = "LinodeMCP/1.0"
+
+_correlation_id: contextvars.ContextVar[str] = contextvars.ContextVar(
+    "linode_correlation_id", default=""
+)
+
+
+def set_correlation_id(correlation_id: str) -> contextvars.Token[str]:
+    """Bind the correlation ID for the current context; returns a reset token."""
+    return _correlation_id.set(correlation_id)
+
+
+def reset_correlation_id(token: contextvars.Token[str]) -> None:
+    """Restore the ID bound before the matching set_correlation_id."""
+    _correlation_id.reset(token)
+
+
+def get_correlation_id() -> str:
+    """Return the ID bound for the current context, or "" when none is."""
+    return _correlation_id.get()
+
+
+def correlation_headers() -> dict[str, str]:
+    """User-Agent and correlation headers for one API request.
+
+    A bound ID rides as its own header and as a second User-Agent product
+    token, so it reaches Linode's own request logs even where custom headers
+    are dropped.
+    """
+    correlation_id = get_correlation_id()
+    if not correlation_id:
+        return {"User-Agent": _USER_AGENT}
+    return {
+        "User-Agent": f"{_USER_AGENT} correlation/{correlation_id}",
+        CORRELATION_ID_HEADER: correlation_id,
+    }
@@ -0,0 +1,49 @@
+"""Per-call label prefix every list read keeps.
+
+A list tool called with namespace_only binds its environment's label
+namespace here so each list the client walks drops the items outside it as
+each page arrives, before the max_results cap counts them. Mirrors the Go
+linode/label_prefix.go context wiring.
+"""
+
+import contextvars
+from typing import Any, cast
+
+_label_prefix: contextvars.ContextVar[str] = contextvars.ContextVar(
+    "linode_label_prefix", default=""
+)
+
+
+def set_label_prefix(prefix: str) -> contextvars.Token[str]:
+    """Bind the prefix for the current context; returns a reset token. An
+    empty prefix keeps every item."""
+    return _label_prefix.set(prefix)
+
+
+def reset_label_prefix(token: contextvars.Token[str]) -> None:
+    """Restore the prefix bound before the matching set_label_prefix."""
+    _label_prefix.reset(token)
+
+
+def get_label_prefix() -> str:
+    """Return the prefix bound for the current context, or ""."""
+    return _label_prefix.get()
+
+
+def keep_label_prefix(items: list[Any]) -> list[Any]:
+    """The items of one list page whose label starts with the bound prefix.
+    A page whose items do not all carry a string label lists something that
+    is not a labeled resource and is kept whole (Go's filterLabelPrefix)."""
+    prefix = _label_prefix.get()
+    if not prefix:
+        return items
+    kept: list[Any] = []
+    for item in items:
+        if not isinstance(item, dict):
+            return items
+        label = cast("dict[str, Any]", item).get("label")
+        if not isinstance(label, str):
+            return items
+        if label.startswith(prefix):
+            kept.append(item)
+    return kept
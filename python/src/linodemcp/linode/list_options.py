@@ -0,0 +1,85 @@
+"""Per-call server-side filter and sort order for list reads.
+
+A list tool call with filter, order_by, or order arguments binds them here so
+each paginated list the client walks sends them as the X-Filter header, and
+the API filters and sorts before paging. Mirrors the Go linode/list_options.go
+context wiring.
+"""
+
+import contextvars
+import json
+from collections.abc import Iterator
+from contextlib import contextmanager
+from dataclasses import dataclass, field
+from typing import Any
+
+# Header carrying a list read's filter and sort order (Go's XFilterHeader).
+X_FILTER_HEADER = "X-Filter"
+
+
+@dataclass(frozen=True)
+class ListOptions:
+    """A Linode filter expression such as {"region": "us-east"} or
+    {"+or": [{"label": "a"}, {"label": "b"}]}; order_by and order become its
+    +order_by and +order keys."""
+
+    filter: dict[str, Any] = field(default_factory=dict[str, Any])
+    order_by: str = ""
+    order: str = ""
+
+    def is_zero(self) -> bool:
+        """Whether these options ask for no filtering or ordering."""
+        return not self.filter and not self.order_by and not self.order
+
+    def x_filter(self) -> str:
+        """The X-Filter header value, or "" when the options are zero. Keys
+        are sorted and compact, matching the Go encoding byte for byte."""
+        if self.is_zero():
+            return ""
+        expression = dict(self.filter)
+        if self.order_by:
+            expression["+order_by"] = self.order_by
+        if self.order:
+            expression["+order"] = self.order
+        return json.dumps(expression, sort_keys=True, separators=(",", ":"))
+
+
+_list_options: contextvars.ContextVar[ListOptions | None] = contextvars.ContextVar(
+    "linode_list_options", default=None
+)
+
+# Set while the client walks a paginated list, the only requests that carry
+# the X-Filter header.
+_list_read: contextvars.ContextVar[bool] = contextvars.ContextVar(
+    "linode_list_read", default=False
+)
+
+
+def set_list_options(opts: ListOptions) -> contextvars.Token[ListOptions | None]:
+    """Bind the options for the current context; returns a reset token. Zero
+    options bind nothing."""
+    return _list_options.set(None if opts.is_zero() else opts)
+
+
+def reset_list_options(token: contextvars.Token[ListOptions | None]) -> None:
+    """Restore the options bound before the matching set_list_options."""
+    _list_options.reset(token)
+
+
+@contextmanager
+def list_read() -> Iterator[None]:
+    """Mark the requests made inside the block as list page requests."""
+    token = _list_read.set(True)
+    try:
+        yield
+    finally:
+        _list_read.reset(token)
+
+
+def list_filter_headers() -> dict[str, str]:
+    """The X-Filter header for one API request: the bound options on a list
+    page request, and nothing otherwise."""
+    opts = _list_options.get()
+    if opts is None or not _list_read.get():
+        return {}
+    return {X_FILTER_HEADER: opts.x_filter()}
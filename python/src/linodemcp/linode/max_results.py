@@ -0,0 +1,58 @@
+"""Per-call cap on how many items each list read returns.
+
+A tool call with a max_results argument binds it here so each list the client
+walks stops at the cap instead of following the remaining pages, and records
+whether results were left behind. Mirrors the Go linode/max_results.go
+context wiring.
+"""
+
+import contextvars
+from dataclasses import dataclass
+
+
+@dataclass
+class _ListCap:
+    limit: int
+    truncated: bool = False
+    total: int = 0
+
+
+_list_cap: contextvars.ContextVar[_ListCap | None] = contextvars.ContextVar(
+    "linode_max_results", default=None
+)
+
+
+def set_max_results(limit: int) -> contextvars.Token[_ListCap | None]:
+    """Bind the cap for the current context; returns a reset token. A limit
+    below 1 binds no cap."""
+    return _list_cap.set(_ListCap(limit) if limit >= 1 else None)
+
+
+def reset_max_results(token: contextvars.Token[_ListCap | None]) -> None:
+    """Restore the cap bound before the matching set_max_results."""
+    _list_cap.reset(token)
+
+
+def get_max_results() -> int:
+    """Return the cap bound for the current context, or 0 when there is
+    none."""
+    capped = _list_cap.get()
+    return capped.limit if capped is not None else 0
+
+
+def record_truncated(total: int) -> None:
+    """Note that a list stopped at the cap with results left on the API
+    side."""
+    capped = _list_cap.get()
+    if capped is not None:
+        capped.truncated = True
+        capped.total = max(capped.total, total)
+
+
+def list_truncated() -> tuple[int, bool]:
+    """Whether a list in the current context stopped at the cap, and the
+    largest result total the API reported for such a list."""
+    capped = _list_cap.get()
+    if capped is None:
+        return 0, False
+    return capped.total, capped.truncated
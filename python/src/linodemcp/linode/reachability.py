@@ -0,0 +1,46 @@
+"""Per-call record of whether the Linode API answered the latest request.
+
+The server binds a tracker into the dispatch context only for calls the
+offline cache may answer, so other calls pay nothing. Mirrors the Go
+linode/reachability.go context wiring.
+"""
+
+import contextvars
+from dataclasses import dataclass
+
+
+@dataclass
+class _Reachability:
+    unreachable: bool = False
+
+
+_reachability: contextvars.ContextVar[_Reachability | None] = contextvars.ContextVar(
+    "linode_reachability", default=None
+)
+
+
+def set_reachability() -> contextvars.Token[_Reachability | None]:
+    """Bind a fresh tracker for the current context; returns a reset token."""
+    return _reachability.set(_Reachability())
+
+
+def reset_reachability(token: contextvars.Token[_Reachability | None]) -> None:
+    """Restore the tracker bound before the matching set_reachability."""
+    _reachability.reset(token)
+
+
+def record_reachability(*, reached: bool) -> None:
+    """Note whether the latest request got an HTTP response, if a tracker is
+    bound."""
+    tracker = _reachability.get()
+    if tracker is not None:
+        tracker.unreachable = not reached
+
+
+def api_unreachable() -> bool:
+    """Whether the latest request in the current context failed before any
+    HTTP response arrived (DNS, connect, TLS, or a transport timeout). An
+    HTTP error status means the API was reached, so it does not count. False
+    when no tracker is bound."""
+    tracker = _reachability.get()
+    return tracker is not None and tracker.unreachable
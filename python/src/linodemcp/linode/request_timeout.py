@@ -0,0 +1,38 @@
+"""Per-call timeout for each Linode API request.
+
+A tool call with its own timeout binds it here so each API request it makes
+may take up to that long instead of the client's 30-second default. Mirrors
+the Go linode/request_timeout.go context wiring.
+"""
+
+import contextvars
+
+import httpx
+
+_request_timeout: contextvars.ContextVar[float | None] = contextvars.ContextVar(
+    "linode_request_timeout", default=None
+)
+
+
+def set_request_timeout(seconds: float) -> contextvars.Token[float | None]:
+    """Bind the per-request timeout for the current context; returns a reset
+    token."""
+    return _request_timeout.set(seconds)
+
+
+def reset_request_timeout(token: contextvars.Token[float | None]) -> None:
+    """Restore the timeout bound before the matching set_request_timeout."""
+    _request_timeout.reset(token)
+
+
+def get_request_timeout() -> float | None:
+    """Return the timeout bound for the current context, or None when the
+    client default applies."""
+    return _request_timeout.get()
+
+
+async def apply_request_timeout(request: httpx.Request) -> None:
+    """httpx request hook that swaps in the bound timeout, if any."""
+    seconds = get_request_timeout()
+    if seconds is not None:
+        request.extensions["timeout"] = httpx.Timeout(seconds).as_dict()
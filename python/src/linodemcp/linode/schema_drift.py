@@ -0,0 +1,29 @@
+"""Per-call schema drift mode for decoding Linode responses.
+
+The server binds the configured schema_drift.mode here for each tool call, so
+the proto serializer that decodes raw API objects can tell whether a field the
+proto model does not declare is ignored, logged, or an error. Mirrors the Go
+client's driftMode.
+"""
+
+import contextvars
+
+_schema_drift: contextvars.ContextVar[str] = contextvars.ContextVar(
+    "linode_schema_drift", default="lenient"
+)
+
+
+def set_schema_drift(mode: str) -> contextvars.Token[str]:
+    """Bind the drift mode for the current context; returns a reset token."""
+    return _schema_drift.set(mode)
+
+
+def reset_schema_drift(token: contextvars.Token[str]) -> None:
+    """Restore the mode bound before the matching set_schema_drift."""
+    _schema_drift.reset(token)
+
+
+def get_schema_drift() -> str:
+    """Return the drift mode bound for the current context; lenient when
+    none is bound."""
+    return _schema_drift.get()
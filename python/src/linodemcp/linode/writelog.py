@@ -0,0 +1,60 @@
+"""Per-call log of the writes the Linode API accepted.
+
+The server binds a log into the dispatch context only when the
+read-after-write check is on, so other calls pay nothing. Mirrors the Go
+linode/writelog.go context wiring.
+"""
+
+import contextvars
+import json
+from dataclasses import dataclass
+from typing import Any
+
+import httpx
+
+
+@dataclass(frozen=True)
+class WriteRecord:
+    """One mutating request the API accepted: its method, endpoint, JSON
+    request body (None when none), and decoded response body. The
+    read-after-write check replays a GET against what these name."""
+
+    method: str
+    endpoint: str
+    body: dict[str, Any] | None
+    response: Any
+
+
+_write_log: contextvars.ContextVar[list[WriteRecord] | None] = (
+    contextvars.ContextVar("linode_write_log", default=None)
+)
+
+
+def set_write_log() -> contextvars.Token[list[WriteRecord] | None]:
+    """Bind a fresh write log for the current context; returns a reset token."""
+    return _write_log.set([])
+
+
+def reset_write_log(token: contextvars.Token[list[WriteRecord] | None]) -> None:
+    """Restore the write log bound before the matching set_write_log."""
+    _write_log.reset(token)
+
+
+def record_write(
+    method: str, endpoint: str, body: dict[str, Any] | None, response: httpx.Response
+) -> None:
+    """Append an accepted write to the bound log, if any. GETs are ignored,
+    and a response body that is not JSON is recorded as None."""
+    log = _write_log.get()
+    if log is None or method == "GET":
+        return
+    try:
+        decoded: Any = response.json()
+    except json.JSONDecodeError:
+        decoded = None
+    log.append(WriteRecord(method, endpoint, body, decoded))
+
+
+def writes_recorded() -> list[WriteRecord]:
+    """Return the writes recorded in the current context, in order."""
+    return list(_write_log.get() or [])
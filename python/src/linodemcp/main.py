@@ -21,11 +21,17 @@ from linodemcp.audit import (
 from linodemcp.cli import (
     run_audit_command,
     run_call_command,
+    run_install_service_command,
     run_profile_command,
     run_tools_command,
 )
-from linodemcp.config import Config, ConfigError, get_config_path
+from linodemcp.config import BACKEND_FIXTURES, Config, ConfigError, get_config_path
 from linodemcp.config.watcher import ConfigWatcher
+from linodemcp.linode import (
+    APIUnreachableError,
+    APIVersionNotFoundError,
+    probe_api_url,
+)
 from linodemcp.observability import Observability
 from linodemcp.profiles import (
     TokenNotConfiguredError,
@@ -37,6 +43,7 @@ from linodemcp.tools.linode_audit_report import set_audit_reports
 from linodemcp.tools.linode_audit_summary import set_audit_sqlite_path
 from linodemcp.tools.version import version_response_dict
 from linodemcp.tui import run_tui
+from linodemcp.updatecheck import UpdateCheckError, check
 from linodemcp.version import get_version_info
 
 # Number of positional arguments required before sys.argv[1] is safe to
@@ -289,9 +296,88 @@ def _build_server(cfg: Config, obs: Observability) -> Server:
     """
     server = Server(cfg)
     server.set_metrics_recorder(obs)
+    # /healthz embeds the same self-report the linode_server_health tool
+    # returns, for probes and dashboards watching a long-lived server.
+    obs.set_diagnostics(server.health_diagnostics)
     return server
 
 
+async def _check_api_endpoints(
+    cfg: Config, log: structlog.stdlib.BoundLogger
+) -> bool:
+    """Probe every configured environment's API URL (with its pinned version)
+    at startup. Returns False when startup should abort.
+
+    A version path that 404s fails startup, naming every misconfigured
+    environment, since every tool call would 404 too. An unreachable host
+    logs an error naming the environment but continues: the network may come
+    back, and other environments may be fine. Mirrors Go's checkAPIEndpoints.
+    """
+    # A fixtures backend answers from disk, so its URL is never dialed.
+    names = [
+        name
+        for name, env in cfg.environments.items()
+        if env.linode.api_url and env.linode.backend != BACKEND_FIXTURES
+    ]
+    results = await asyncio.gather(
+        *(
+            probe_api_url(
+                cfg.environments[name].linode.base_url(),
+                cfg.environments[name].linode.headers,
+            )
+            for name in names
+        ),
+        return_exceptions=True,
+    )
+    misconfigured: list[str] = []
+    for name, result in zip(names, results, strict=True):
+        if isinstance(result, APIVersionNotFoundError):
+            misconfigured.append(name)
+            log.error(
+                "Linode API version path not found",
+                environment=name,
+                error=str(result),
+            )
+        elif isinstance(result, APIUnreachableError):
+            log.error(
+                "Linode API URL unreachable; tool calls for this environment "
+                "will fail until it answers",
+                environment=name,
+                error=str(result),
+            )
+    if misconfigured:
+        log.error(
+            "refusing to start: fix environments.<name>.linode.apiUrl or "
+            "apiVersion",
+            environments=sorted(misconfigured),
+        )
+        return False
+    return True
+
+
+async def _check_for_update(cfg: Config, log: structlog.stdlib.BoundLogger) -> None:
+    """Run the startup release check and log a newer version when one exists.
+
+    Advisory: a disabled check returns at once, and a failed lookup (offline,
+    rate limited) logs at debug so it never alarms anyone.
+    """
+    if not cfg.update_check.enabled:
+        return
+    try:
+        result = await check(cfg.update_check.url, get_version_info().version)
+    except UpdateCheckError as exc:
+        log.debug("update check failed", error=str(exc))
+        return
+    if result.update_available:
+        log.info(
+            "newer LinodeMCP version available",
+            current=result.current,
+            latest=result.latest,
+            url=result.release_url,
+            highlights=result.highlights,
+        )
+
+
 async def async_main() -> int:
     """Async main function."""
     path = get_config_path()
@@ -329,6 +415,7 @@ async def async_main() -> int:
     jsonl_sink: JSONLSink | None = None
     sqlite_sink: SQLiteSink | None = None
     audit_tasks: list[asyncio.Task[None]] = []
+    update_task: asyncio.Task[None] | None = None
     try:
         server = _build_server(cfg, obs)
 
@@ -340,20 +427,35 @@ async def async_main() -> int:
 
         _wire_profile_hot_reload(watcher, server, log)
 
+        if not await _check_api_endpoints(cfg, log):
+            return 1
+
         # Phase 6.4c: validate the active token's scopes against the
         # active profile's required scopes. Missing scopes always fail
         # load; an API failure or missing token fails for elevated
         # profiles and warns-and-continues for read-only ones. Excess
         # scopes warn only.
-        if not await _run_scope_validation(server, log):
+        #
+        # With server.tokenPassthrough there is no server token to check:
+        # each caller's own token authorizes their calls, so it is skipped.
+        if cfg.server.token_passthrough:
+            log.info(
+                "token passthrough enabled; Linode tokens come from each "
+                "request's X-Linode-Token header"
+            )
+        elif not await _run_scope_validation(server, log):
             return 1
 
         watcher.start()
+        update_task = asyncio.create_task(_check_for_update(cfg, log))
         await server.start()
     except Exception as exc:
         log.exception("server error", error=str(exc))
         return 1
     finally:
+        if update_task is not None:
+            update_task.cancel()
+
         if server is not None:
             try:
                 drained = await server.shutdown(timeout=10.0)
@@ -414,12 +516,16 @@ def _run_subcommand(sub: str, rest: list[str]) -> int:
         return run_audit_command(rest, sys.stdout, sys.stderr)
     if sub == "tui":
         return run_tui()
+    if sub == "install-service":
+        return run_install_service_command(rest, sys.stdout, sys.stderr)
     return print_version(sys.stdout)
 
 
 # Subcommands handled without starting the stdio server. ``serve`` is absent on
 # purpose: it (and bare invocation) fall through to the server runtime below.
-_CLI_SUBCOMMANDS = frozenset({"profile", "tools", "call", "audit", "tui", "version"})
+_CLI_SUBCOMMANDS = frozenset(
+    {"profile", "tools", "call", "audit", "tui", "version", "install-service"}
+)
 
 
 def main() -> None:
@@ -428,9 +534,10 @@ def main() -> None:
     Bare invocation (``linodemcp``) starts the MCP server via stdio; ``serve``
     is an explicit alias for the same path so existing host configs keep
     working. The non-interactive subcommands (``call``, ``tools``, ``audit``,
-    ``profile``, ``version``) dispatch to the CLI handlers and exit without
-    starting the long-running server, its metrics endpoint, or the config
-    watcher. Only the server path pays the event-loop and observability cost.
+    ``profile``, ``version``, ``install-service``) dispatch to the CLI handlers
+    and exit without starting the long-running server, its metrics endpoint,
+    or the config watcher. Only the server path pays the event-loop and
+    observability cost.
     """
     if len(sys.argv) >= _MIN_ARGV_FOR_SUBCOMMAND:
         sub = sys.argv[1]
@@ -0,0 +1,17 @@
+"""NodeBalancer backend nodes held out of rotation for maintenance.
+
+Mirrors ``go/internal/nodedrain``. Drains live in process memory only: a
+restart forgets them and cancels any pending restore.
+"""
+
+from __future__ import annotations
+
+from linodemcp.nodedrain.store import (
+    RESTORE_TIMEOUT_SECONDS,
+    Drain,
+    Key,
+    RestoreFunc,
+    Store,
+)
+
+__all__ = ["RESTORE_TIMEOUT_SECONDS", "Drain", "Key", "RestoreFunc", "Store"]
@@ -0,0 +1,153 @@
+"""In-memory store of drained NodeBalancer nodes and their timed restores.
+
+Mirrors ``go/internal/nodedrain/store.go``.
+"""
+
+from __future__ import annotations
+
+import asyncio
+import dataclasses
+import logging
+import threading
+from collections.abc import Awaitable, Callable
+from dataclasses import dataclass
+from datetime import UTC, datetime, timedelta
+
+logger = logging.getLogger(__name__)
+
+# Bounds one automatic restore call.
+RESTORE_TIMEOUT_SECONDS = 30.0
+
+
+@dataclass(frozen=True)
+class Key:
+    """Identifies one backend node as one tenant drained it. ``partition``
+    is empty unless server.tokenPassthrough has tenants share one server,
+    where each tenant sees only its own drains."""
+
+    nodebalancer_id: int
+    config_id: int
+    node_id: int
+    partition: str = ""
+
+
+@dataclass(frozen=True)
+class Drain:
+    """One node held out of rotation. ``mode`` is the mode the drain set,
+    ``restore_mode`` the one the node had before it was first drained.
+    ``restore_at`` is None when the node waits for
+    linode_nodebalancer_node_undrain; ``restore_error`` is why the last
+    automatic restore failed, which keeps the drain so undrain can retry."""
+
+    key: Key
+    mode: str
+    restore_mode: str
+    drained_at: datetime | None = None
+    restore_at: datetime | None = None
+    restore_error: str = ""
+
+
+# Puts a drained node back into its restore_mode.
+RestoreFunc = Callable[[Drain], Awaitable[None]]
+
+
+@dataclass
+class _Entry:
+    drain: Drain
+    task: asyncio.Task[None] | None = None
+
+
+class Store:
+    """Holds the drains in process memory."""
+
+    def __init__(self, now: Callable[[], datetime] | None = None) -> None:
+        self._now = now or (lambda: datetime.now(UTC))
+        self._drains: dict[Key, _Entry] = {}
+        self._lock = threading.Lock()
+
+    def put(
+        self,
+        drain: Drain,
+        after: timedelta | None = None,
+        restore: RestoreFunc | None = None,
+    ) -> Drain:
+        """Record a drain, replacing any earlier one for the same node and
+        cancelling its timer. Draining a node again keeps the restore_mode of
+        the first drain. When after is positive, restore runs once it elapses
+        on the running event loop; on success the drain is forgotten. Returns
+        the drain as stored."""
+        with self._lock:
+            previous = self._drains.get(drain.key)
+            restore_mode = drain.restore_mode
+            if previous is not None:
+                _cancel(previous)
+                restore_mode = previous.drain.restore_mode
+
+            drained_at = self._now().astimezone(UTC)
+            current = _Entry(
+                drain=dataclasses.replace(
+                    drain,
+                    restore_mode=restore_mode,
+                    drained_at=drained_at,
+                    restore_at=None,
+                    restore_error="",
+                )
+            )
+            if after is not None and after > timedelta(0) and restore is not None:
+                current.drain = dataclasses.replace(
+                    current.drain, restore_at=drained_at + after
+                )
+                current.task = asyncio.get_running_loop().create_task(
+                    self._restore_after(current, after, restore)
+                )
+            self._drains[drain.key] = current
+            return current.drain
+
+    def get(self, key: Key) -> Drain | None:
+        """Return the drain recorded for a node, or None."""
+        with self._lock:
+            current = self._drains.get(key)
+            return current.drain if current is not None else None
+
+    def take(self, key: Key) -> Drain | None:
+        """Forget the drain recorded for a node and cancel its timer,
+        returning what was recorded."""
+        with self._lock:
+            current = self._drains.pop(key, None)
+            if current is None:
+                return None
+            _cancel(current)
+            return current.drain
+
+    async def _restore_after(
+        self, current: _Entry, after: timedelta, restore: RestoreFunc
+    ) -> None:
+        """Run a timed restore. The drain is forgotten only when it is still
+        the one the timer belongs to, so a later put or take wins."""
+        await asyncio.sleep(after.total_seconds())
+        error = ""
+        try:
+            async with asyncio.timeout(RESTORE_TIMEOUT_SECONDS):
+                await restore(current.drain)
+        except Exception as exc:  # noqa: BLE001 - recorded for undrain to report
+            error = str(exc) or type(exc).__name__
+            logger.warning(
+                "timed NodeBalancer node restore failed",
+                extra={"node_id": current.drain.key.node_id, "error": error},
+            )
+
+        with self._lock:
+            if self._drains.get(current.drain.key) is not current:
+                return
+            current.task = None
+            if error:
+                current.drain = dataclasses.replace(
+                    current.drain, restore_error=error, restore_at=None
+                )
+                return
+            del self._drains[current.drain.key]
+
+
+def _cancel(current: _Entry) -> None:
+    if current.task is not None and not current.task.done():
+        current.task.cancel()
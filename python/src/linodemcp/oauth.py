@@ -0,0 +1,257 @@
+"""MCP authorization for the HTTP transport.
+
+A hosted server acts as an OAuth 2.1 resource server: every tool call
+carries a bearer access token, the token is validated by introspection
+(RFC 7662), and its scopes decide which toolset the call may use.
+Optionally the access token is exchanged (RFC 8693) for a downstream Linode
+token per call, so no shared Linode credential lives on the server at all.
+
+Mirrors ``go/internal/oauth``.
+"""
+
+from __future__ import annotations
+
+import hashlib
+import json
+import time
+from contextvars import ContextVar, Token
+from dataclasses import dataclass, field
+from typing import TYPE_CHECKING, Any
+
+import httpx
+
+from linodemcp.profiles import Capability
+
+if TYPE_CHECKING:
+    from linodemcp.config import OAuthConfig
+
+# Where the protected resource metadata document (RFC 9728) is served.
+METADATA_PATH = "/.well-known/oauth-protected-resource"
+
+_TOKEN_EXCHANGE_GRANT = "urn:ietf:params:oauth:grant-type:token-exchange"
+_ACCESS_TOKEN_TYPE = "urn:ietf:params:oauth:token-type:access_token"
+
+# One introspection or exchange round trip.
+_REQUEST_TIMEOUT = 10.0
+
+# An introspection result is reused at most this long, so a revoked token
+# stops working within a minute even if it has not expired.
+_MAX_CACHE_TTL = 60.0
+
+# The Linode token minted by token exchange for the call being dispatched.
+# tools.helpers prefers it over the request's X-Linode-Token header.
+_exchanged_token: ContextVar[str] = ContextVar("linodemcp_exchanged_token", default="")
+
+
+class OAuthError(ValueError):
+    """A call was refused: missing, invalid, or under-scoped access token.
+
+    A ValueError so dispatch audits it as refused, like an unknown tool.
+    """
+
+
+class AuthorizationServerError(OAuthError):
+    """The introspection or token endpoint could not be used."""
+
+
+@dataclass
+class Grant:
+    """A validated access token: who it was issued to and what it allows."""
+
+    subject: str = ""
+    scopes: list[str] = field(default_factory=list[str])
+
+
+def bearer_token(authorization: str) -> str:
+    """The access token of an ``Authorization: Bearer`` header, or "".
+
+    The scheme is matched case-insensitively per RFC 6750.
+    """
+    scheme, _, token = authorization.partition(" ")
+    if scheme.lower() != "bearer":
+        return ""
+    return token.strip()
+
+
+def exchanged_token() -> str:
+    """The Linode token minted for the current call, or ""."""
+    return _exchanged_token.get()
+
+
+def set_exchanged_token(token: str) -> Token[str]:
+    """Bind the minted Linode token for the current call."""
+    return _exchanged_token.set(token)
+
+
+def reset_exchanged_token(token: Token[str]) -> None:
+    """Undo set_exchanged_token once the call finishes."""
+    _exchanged_token.reset(token)
+
+
+class Authorizer:
+    """Validates access tokens against one authorization server and maps
+    their scopes onto tool capabilities."""
+
+    def __init__(self, cfg: OAuthConfig) -> None:
+        self._cfg = cfg
+        self._cache: dict[bytes, tuple[Grant, float]] = {}
+
+    @property
+    def exchange_enabled(self) -> bool:
+        """Whether calls run on an exchanged Linode token."""
+        return self._cfg.token_exchange.enabled
+
+    def required_scope(self, capability: Capability) -> str:
+        """The scope a tool with ``capability`` needs."""
+        if capability in (Capability.Read, Capability.Meta):
+            return self._cfg.read_scope
+        return self._cfg.write_scope
+
+    async def authorize(self, authorization: str, capability: Capability) -> Grant:
+        """Validate the bearer token and check it grants ``capability``.
+
+        The read scope covers meta and read tools; the write scope covers
+        every tool and implies read.
+        """
+        token = bearer_token(authorization)
+        if not token:
+            msg = (
+                "oauth is enabled: send an access token in the "
+                "Authorization: Bearer header"
+            )
+            raise OAuthError(msg)
+
+        grant = await self._validate(token)
+        required = self.required_scope(capability)
+        if required not in grant.scopes and self._cfg.write_scope not in grant.scopes:
+            msg = f"access token lacks the required scope: {required}"
+            raise OAuthError(msg)
+        return grant
+
+    async def _validate(self, token: str) -> Grant:
+        """Introspect ``token``, reusing a recent result for the same token."""
+        key = hashlib.sha256(token.encode()).digest()
+        now = time.time()
+        cached = self._cache.get(key)
+        if cached is not None and now < cached[1]:
+            return cached[0]
+
+        result = await self._post(
+            self._cfg.introspection_url,
+            {"token": token, "token_type_hint": "access_token"},
+            "introspect access token",
+        )
+        self._check_claims(result, now)
+        grant = Grant(
+            subject=str(result.get("sub") or ""),
+            scopes=str(result.get("scope") or "").split(),
+        )
+
+        expires = now + _MAX_CACHE_TTL
+        exp = result.get("exp")
+        if isinstance(exp, int | float) and exp > 0:
+            expires = min(expires, float(exp))
+        self._cache = {k: v for k, v in self._cache.items() if now < v[1]}
+        self._cache[key] = (grant, expires)
+        return grant
+
+    def _check_claims(self, result: dict[str, Any], now: float) -> None:
+        """Reject an inactive or expired token, one from another issuer, and
+        one whose audience does not name this server."""
+        exp = result.get("exp")
+        expired = isinstance(exp, int | float) and 0 < exp <= now
+        if result.get("active") is not True or expired:
+            msg = "access token is not active"
+            raise OAuthError(msg)
+
+        issuer = str(result.get("iss") or "")
+        if issuer and issuer.rstrip("/") != self._cfg.issuer.rstrip("/"):
+            msg = (
+                "access token was issued by a different authorization "
+                f"server: {issuer}"
+            )
+            raise OAuthError(msg)
+
+        audience = result.get("aud")
+        audiences = [audience] if isinstance(audience, str) else audience
+        if not isinstance(audiences, list) or self._cfg.resource not in audiences:
+            msg = (
+                "access token was not issued for this server: expected "
+                f"audience {self._cfg.resource}"
+            )
+            raise OAuthError(msg)
+
+    async def exchange(self, subject_token: str) -> str:
+        """Trade the caller's access token for a downstream Linode token at
+        the configured token endpoint (RFC 8693)."""
+        exchange = self._cfg.token_exchange
+        form = {
+            "grant_type": _TOKEN_EXCHANGE_GRANT,
+            "subject_token": subject_token,
+            "subject_token_type": _ACCESS_TOKEN_TYPE,
+            "requested_token_type": _ACCESS_TOKEN_TYPE,
+        }
+        if exchange.audience:
+            form["audience"] = exchange.audience
+
+        result = await self._post(
+            exchange.token_url, form, "exchange access token"
+        )
+        token = str(result.get("access_token") or "")
+        if not token:
+            msg = "token exchange returned no access_token"
+            raise AuthorizationServerError(msg)
+        return token
+
+    async def _post(
+        self, endpoint: str, form: dict[str, str], action: str
+    ) -> dict[str, Any]:
+        """POST ``form`` with client credentials and decode the JSON reply."""
+        auth = None
+        if self._cfg.client_id:
+            auth = httpx.BasicAuth(self._cfg.client_id, self._cfg.client_secret)
+        try:
+            async with httpx.AsyncClient(timeout=_REQUEST_TIMEOUT) as client:
+                response = await client.post(
+                    endpoint,
+                    data=form,
+                    auth=auth,
+                    headers={"Accept": "application/json"},
+                )
+        except httpx.HTTPError as exc:
+            msg = f"{action}: {exc}"
+            raise AuthorizationServerError(msg) from exc
+
+        if response.status_code != httpx.codes.OK:
+            msg = (
+                f"{action}: unexpected status from authorization server: "
+                f"{response.status_code}"
+            )
+            raise AuthorizationServerError(msg)
+
+        try:
+            body = response.json()
+        except ValueError as exc:
+            msg = f"{action}: decode response: {exc}"
+            raise AuthorizationServerError(msg) from exc
+        if not isinstance(body, dict):
+            msg = f"{action}: decode response: not a JSON object"
+            raise AuthorizationServerError(msg)
+        return dict(body)
+
+    def metadata(self) -> str:
+        """The protected resource metadata document (RFC 9728) served at
+        METADATA_PATH."""
+        return json.dumps(
+            {
+                "resource": self._cfg.resource,
+                "authorization_servers": [self._cfg.issuer],
+                "scopes_supported": [self._cfg.read_scope, self._cfg.write_scope],
+                "bearer_methods_supported": ["header"],
+            }
+        )
+
+    def challenge(self) -> str:
+        """The WWW-Authenticate value an HTTP transport sends with a 401."""
+        resource = self._cfg.resource.rstrip("/")
+        return f'Bearer resource_metadata="{resource}{METADATA_PATH}"'
@@ -6,6 +6,7 @@ test harnesses, multi-tenant). Stage 6 originally shipped a singleton/init()
 pattern; that's been removed.
 """
 
+import json
 import os
 import sys
 import threading
@@ -93,6 +94,7 @@ class Observability:
         self._tracer: trace.Tracer = trace.get_tracer("linodemcp")
         self._shutdown_funcs: list[Callable[[], None]] = []
         self._health_server: HTTPServer | None = None
+        self._diagnostics: Callable[[], dict[str, Any]] | None = None
         self._metrics_server: HTTPServer | None = None
         self._requests_total: metrics.Counter | None = None
         self._request_duration: metrics.Histogram | None = None
@@ -418,7 +420,30 @@ class Observability:
                 duration_seconds, {"endpoint": endpoint, "method": method}
             )
 
+    def set_diagnostics(self, fn: Callable[[], dict[str, Any]] | None) -> None:
+        """Set the function /healthz calls to embed the server's self-report.
+
+        Passing None drops the diagnostics block from the response.
+        """
+        self._diagnostics = fn
+
+    def collect_diagnostics(self) -> dict[str, Any] | None:
+        """Call the diagnostics function, if any.
+
+        A failure is logged and the block omitted; it never turns a healthy
+        server unhealthy.
+        """
+        if self._diagnostics is None:
+            return None
+        try:
+            return self._diagnostics()
+        except Exception as exc:  # noqa: BLE001 - advisory block, never fatal
+            if self.logger:
+                self.logger.warning("health diagnostics failed", error=str(exc))
+            return None
+
     def _init_health(self, config: HealthConfig) -> None:
+        observability = self
         try:
 
             class HealthHandler(BaseHTTPRequestHandler):
@@ -435,12 +460,15 @@ class Observability:
                         self.send_response(200)
                         self.send_header("Content-Type", "application/json")
                         self.end_headers()
-                        body = (
-                            '{"status": "healthy", "timestamp": "'
-                            + str(time.time())
-                            + '"}'
-                        )
-                        self.wfile.write(body.encode())
+                        body: dict[str, Any] = {
+                            "status": "healthy",
+                            "timestamp": str(time.time()),
+                        }
+                        if self.path == config.path + "/healthz":
+                            diagnostics = observability.collect_diagnostics()
+                            if diagnostics is not None:
+                                body["diagnostics"] = diagnostics
+                        self.wfile.write(json.dumps(body).encode())
                     else:
                         self.send_response(404)
                         self.end_headers()
@@ -0,0 +1,24 @@
+"""Last successful read tool results, kept on disk for degraded mode.
+
+Mirrors ``go/internal/offlinecache``. Entries live in one directory per
+environment; the file layout is shared with the Go server, so either server
+can answer from entries the other saved.
+"""
+
+from __future__ import annotations
+
+from linodemcp.offlinecache.store import (
+    CorruptEntryError,
+    Entry,
+    InvalidEnvironmentError,
+    OfflineCacheError,
+    Store,
+)
+
+__all__ = [
+    "CorruptEntryError",
+    "Entry",
+    "InvalidEnvironmentError",
+    "OfflineCacheError",
+    "Store",
+]
@@ -0,0 +1,183 @@
+"""Per-environment offline cache entries.
+
+Mirrors ``go/internal/offlinecache/store.go``. The layout is::
+
+    <dir>/<environment>/<tool>.<sha256 of tool and arguments>.json
+    {"version": 1, "tool": "linode_instance_list", "arguments": {},
+     "cached_at": "2026-10-16T12:00:00Z", "content": ["{...}"]}
+"""
+
+from __future__ import annotations
+
+import hashlib
+import json
+import os
+import tempfile
+from collections.abc import Callable
+from dataclasses import dataclass
+from datetime import UTC, datetime
+from pathlib import Path
+from typing import Any, cast
+
+_FILE_VERSION = 1
+_DIR_MODE = 0o700
+_FILE_MODE = 0o600
+
+# Never part of an entry's key: they select the environment (already the
+# directory) or only change how the result is displayed.
+_IGNORED_ARGUMENTS = ("environment", "output_format", "read_after_write")
+
+
+class OfflineCacheError(Exception):
+    """Base class for offline cache errors."""
+
+
+class InvalidEnvironmentError(OfflineCacheError):
+    """An environment name that cannot be used as a cache directory name."""
+
+
+class CorruptEntryError(OfflineCacheError):
+    """A cache file that is not valid cache entry JSON."""
+
+
+@dataclass(frozen=True)
+class Entry:
+    """One saved tool result. ``content`` holds the result's text items in
+    order, as the handler returned them."""
+
+    tool: str
+    arguments: dict[str, Any]
+    cached_at: str
+    content: list[str]
+
+
+class Store:
+    """Reads and writes the cache entries under one directory.
+
+    Directories are created on the first put, so a server that never saves
+    anything does not touch the filesystem.
+    """
+
+    def __init__(
+        self, directory: str | Path, now: Callable[[], datetime] | None = None
+    ) -> None:
+        self._dir = Path(directory)
+        self._now = now or (lambda: datetime.now(UTC))
+
+    def put(
+        self,
+        environment: str,
+        tool: str,
+        arguments: dict[str, Any],
+        content: list[str],
+    ) -> None:
+        """Save content as the latest result of tool called with arguments in
+        environment, replacing any earlier entry for the same call."""
+        path, key_args = self._path(environment, tool, arguments)
+        stamp = self._now().astimezone(UTC).strftime("%Y-%m-%dT%H:%M:%SZ")
+        _write_entry(
+            path,
+            {
+                "version": _FILE_VERSION,
+                "tool": tool,
+                "arguments": key_args,
+                "cached_at": stamp,
+                "content": content,
+            },
+        )
+
+    def get(
+        self, environment: str, tool: str, arguments: dict[str, Any]
+    ) -> Entry | None:
+        """Return the entry saved for tool called with arguments in
+        environment, or None when nothing was saved for that call."""
+        path, _ = self._path(environment, tool, arguments)
+        try:
+            raw = path.read_text(encoding="utf-8")
+        except FileNotFoundError:
+            return None
+
+        try:
+            parsed = cast("dict[str, Any]", json.loads(raw))
+            entry = Entry(
+                tool=str(parsed.get("tool", "")),
+                arguments=cast("dict[str, Any]", parsed.get("arguments") or {}),
+                cached_at=str(parsed.get("cached_at", "")),
+                content=[str(c) for c in cast("list[Any]", parsed["content"] or [])],
+            )
+        except (ValueError, AttributeError, KeyError, TypeError) as exc:
+            msg = f"offline cache file is not valid JSON: {path}: {exc}"
+            raise CorruptEntryError(msg) from exc
+
+        if entry.tool != tool:
+            return None
+        return entry
+
+    def _path(
+        self, environment: str, tool: str, arguments: dict[str, Any]
+    ) -> tuple[Path, dict[str, Any]]:
+        """The cache file for one call, along with the arguments its key is
+        built from. Environment names come from the config's keys, so this
+        only guards against one that would escape the directory."""
+        if environment in ("", ".", "..") or any(c in environment for c in "/\\"):
+            msg = (
+                "environment name cannot be used as an offline cache directory "
+                f"name: {json.dumps(environment)}"
+            )
+            raise InvalidEnvironmentError(msg)
+        key_args = {
+            k: v for k, v in (arguments or {}).items() if k not in _IGNORED_ARGUMENTS
+        }
+        digest = hashlib.sha256(
+            f"{tool}\n{_canonical_json(key_args)}".encode()
+        ).hexdigest()
+        return self._dir / environment / f"{tool}.{digest}.json", key_args
+
+
+def _canonical_json(arguments: dict[str, Any]) -> str:
+    """Encode arguments compactly with sorted keys, the same bytes Go's
+    encoder (HTML escaping off) produces for them. Go decodes every JSON
+    number as a float64 and writes a whole one without a fraction, so whole
+    floats are written as ints here."""
+    return json.dumps(
+        _whole_floats_as_ints(arguments),
+        sort_keys=True,
+        separators=(",", ":"),
+        ensure_ascii=False,
+    )
+
+
+def _whole_floats_as_ints(value: Any) -> Any:
+    if isinstance(value, float) and value.is_integer():
+        return int(value)
+    if isinstance(value, dict):
+        items = cast("dict[str, Any]", value).items()
+        return {k: _whole_floats_as_ints(v) for k, v in items}
+    if isinstance(value, list):
+        return [_whole_floats_as_ints(v) for v in cast("list[Any]", value)]
+    return value
+
+
+def _write_entry(path: Path, entry: dict[str, Any]) -> None:
+    """Replace a cache file through a temp file and rename, so a crash
+    mid-write leaves the previous entry intact."""
+    path.parent.mkdir(mode=_DIR_MODE, parents=True, exist_ok=True)
+
+    with tempfile.NamedTemporaryFile(
+        mode="w",
+        encoding="utf-8",
+        dir=path.parent,
+        prefix=f".{path.name}.tmp.",
+        delete=False,
+    ) as tmp:
+        tmp.write(json.dumps(entry, indent=2) + "\n")
+        tmp.flush()
+        os.fsync(tmp.fileno())
+        tmp_path = Path(tmp.name)
+
+    try:
+        tmp_path.chmod(_FILE_MODE)
+        tmp_path.replace(path)
+    except OSError:
+        tmp_path.unlink(missing_ok=True)
+        raise
@@ -67,6 +67,7 @@ _TOOL_CATEGORIES: tuple[tuple[str, tuple[str, ...]], ...] = (
     (
         "compute_deep",
         (
+            "linode_backup_schedule_",
             "linode_instance_backup_",
             "linode_instance_backups_",
             "linode_instance_disk_",
@@ -78,8 +79,10 @@ _TOOL_CATEGORIES: tuple[tuple[str, tuple[str, ...]], ...] = (
     (
         "compute",
         (
+            "linode_alerts_",
             "linode_instance_",
             "linode_instances_",
+            "linode_multi_region_",
             "linode_placement_group_",
             "linode_placement_groups_",
             "linode_region_",
@@ -97,6 +100,11 @@ _TOOL_CATEGORIES: tuple[tuple[str, tuple[str, ...]], ...] = (
             "linode_account_",
             "linode_managed_",
             "linode_tag_",
+            "linode_tags_",
+            "linode_quota_",
+            "linode_transfer_",
+            "linode_projects_",
+            "linode_bulk_",
             "linode_support_ticket_",
             "linode_profile_app_",
             "linode_profile_preferences_",
@@ -111,17 +119,23 @@ _TOOL_CATEGORIES: tuple[tuple[str, tuple[str, ...]], ...] = (
     ("databases", ("linode_database_", "linode_databases_")),
     ("object_storage", ("linode_object_storage_",)),
     # dns lists ``linode_domain_record_`` before ``linode_domain_`` for the
-    # same longest-prefix reason; all three share the same category so the
+    # same longest-prefix reason; they all share the same category so the
     # order is cosmetic here, but kept for clarity.
     (
         "dns",
-        ("linode_domain_record_", "linode_domain_", "linode_domains_"),
+        (
+            "linode_domain_record_",
+            "linode_domain_",
+            "linode_domains_",
+            "linode_dns_",
+        ),
     ),
     (
         "networking",
         (
             "linode_firewall_",
             "linode_firewalls_",
+            "linode_access_",
             "linode_nodebalancer_",
             "linode_nodebalancers_",
             "linode_vlan_",
@@ -133,7 +147,7 @@ _TOOL_CATEGORIES: tuple[tuple[str, tuple[str, ...]], ...] = (
             "linode_networking_reserved_ip_",
         ),
     ),
-    ("lke", ("linode_lke_",)),
+    ("lke", ("linode_lke_", "linode_access_")),
     ("vpcs", ("linode_vpc_", "linode_vpcs_")),
     ("security", ("linode_sshkey_", "linode_sshkeys_")),
     ("monitor", ("linode_monitor_",)),
@@ -165,6 +165,11 @@ def _prefix_table() -> list[tuple[tuple[str, ...], str]]:
                 "linode_account_",
                 "linode_managed_",
                 "linode_tag_",
+                "linode_tags_",
+                "linode_quota_",
+                "linode_transfer_",
+                "linode_projects_",
+                "linode_bulk_",
                 "linode_support_ticket_",
                 # The whole profile subtree is account-gated in the API
                 # docs, including /profile/tokens which the docs gate
@@ -191,7 +196,7 @@ def _prefix_table() -> list[tuple[tuple[str, ...], str]]:
         (("linode_longview_",), _CAT_LONGVIEW),
         (("linode_nodebalancer_", "linode_nodebalancers_"), _CAT_NODEBALANCERS),
         (("linode_firewall_", "linode_firewalls_"), _CAT_FIREWALL),
-        (("linode_domain_", "linode_domains_"), _CAT_DOMAINS),
+        (("linode_domain_", "linode_domains_", "linode_dns_"), _CAT_DOMAINS),
         (("linode_volume_", "linode_volumes_"), _CAT_VOLUMES),
         (("linode_stackscript_", "linode_stackscripts_"), _CAT_STACKSCRIPTS),
         (("linode_vpc_", "linode_vpcs_"), _CAT_VPC),
@@ -202,8 +207,11 @@ def _prefix_table() -> list[tuple[tuple[str, ...], str]]:
         (("linode_sshkey_", "linode_sshkeys_"), _CAT_ACCOUNT),
         (
             (
+                "linode_alerts_",
+                "linode_backup_schedule_",
                 "linode_instance_",
                 "linode_instances_",
+                "linode_multi_region_",
                 "linode_placement_group_",
                 "linode_placement_groups_",
                 "linode_region_",
@@ -275,13 +283,15 @@ def _is_scopeless_route(tool_name: str) -> bool:
     """
     return tool_name in (
         # Catalog and pricing routes: kernels, regions, instance types,
-        # per-service type/price lists, database engines and types.
+        # per-service type/price lists, database engines and types. The
+        # availability watch only reads region availability.
         "linode_kernel_get",
         "linode_kernel_list",
         "linode_region_get",
         "linode_region_list",
         "linode_region_availability_get",
         "linode_region_availability_list",
+        "linode_availability_watch",
         "linode_type_get",
         "linode_type_list",
         "linode_database_engine_get",
@@ -352,6 +362,7 @@ def _scope_overrides() -> dict[str, list[Scope]]:
         "linode_instance_interface_get": [Scope.LinodesReadWrite],
         "linode_instance_interface_list": [Scope.LinodesReadWrite],
         "linode_lke_kubeconfig_get": [Scope.LKEReadWrite],
+        "linode_lke_workload_ping": [Scope.LKEReadWrite],
         "linode_lke_node_get": [Scope.LKEReadWrite],
         "linode_nodebalancer_config_node_get": [Scope.NodeBalancersReadWrite],
         # The docs put this instance-interface read under the
@@ -368,6 +379,99 @@ def _scope_overrides() -> dict[str, list[Scope]]:
         # VPC IP listings are documented under ips:*, not vpc:*.
         "linode_vpc_ip_list": [Scope.IPsReadOnly],
         "linode_vpc_ip_all_list": [Scope.IPsReadOnly],
+        # The allowlist updater rewrites LKE control-plane ACLs and
+        # Cloud Firewall rules in one call, so it needs both write scopes.
+        "linode_access_allowlist_update": [Scope.LKEReadWrite, Scope.FirewallReadWrite],
+        # Retagging rewrites the tags on every Linode, domain, volume, and
+        # NodeBalancer that carries the tag, then deletes the old tag.
+        "linode_tags_retag": [
+            Scope.AccountReadWrite,
+            Scope.LinodesReadWrite,
+            Scope.DomainsReadWrite,
+            Scope.VolumesReadWrite,
+            Scope.NodeBalancersReadWrite,
+        ],
+        # The quota report counts every entity collection it covers.
+        "linode_quota_report": [
+            Scope.AccountReadOnly,
+            Scope.LinodesReadOnly,
+            Scope.VolumesReadOnly,
+            Scope.NodeBalancersReadOnly,
+            Scope.LKEReadOnly,
+            Scope.FirewallReadOnly,
+            Scope.VPCReadOnly,
+            Scope.DomainsReadOnly,
+            Scope.DatabasesReadOnly,
+            Scope.ObjectStorageReadOnly,
+        ],
+        # The transfer forecast reads the account pool and every instance's
+        # share of it.
+        "linode_transfer_forecast": [Scope.AccountReadOnly, Scope.LinodesReadOnly],
+        # Project views read every collection a project can hold.
+        "linode_projects_list": [
+            Scope.LinodesReadOnly,
+            Scope.VolumesReadOnly,
+            Scope.NodeBalancersReadOnly,
+            Scope.DomainsReadOnly,
+        ],
+        "linode_projects_get": [
+            Scope.LinodesReadOnly,
+            Scope.VolumesReadOnly,
+            Scope.NodeBalancersReadOnly,
+            Scope.DomainsReadOnly,
+        ],
+        # Bulk delete resolves a tag, then detaches volumes, removes DNS
+        # records, and deletes instances, volumes, NodeBalancers, and domains.
+        "linode_bulk_delete": [
+            Scope.AccountReadOnly,
+            Scope.LinodesReadWrite,
+            Scope.DomainsReadWrite,
+            Scope.VolumesReadWrite,
+            Scope.NodeBalancersReadWrite,
+        ],
+        # The console view reads the instance and scans the event feed for its
+        # events.
+        "linode_instance_console": [Scope.LinodesReadOnly, Scope.EventsReadOnly],
+        # Capturing an instance reads its disks, then creates and replicates
+        # the image.
+        "linode_instance_to_image": [Scope.LinodesReadOnly, Scope.ImagesReadWrite],
+        # Replacing an instance creates and deletes instances, attaches the
+        # old one's firewalls to the new one, and repoints its DNS records.
+        "linode_instance_replace": [
+            Scope.LinodesReadWrite,
+            Scope.FirewallReadWrite,
+            Scope.DomainsReadWrite,
+        ],
+        # The cloud-init status reads the instance and scans the event feed
+        # for its provisioning and boot events.
+        "linode_instance_cloudinit_status": [
+            Scope.LinodesReadOnly,
+            Scope.EventsReadOnly,
+        ],
+        # The SSH key report reads the profile and its keys, then scans the
+        # event feed for the instances deployed since each key was added.
+        "linode_sshkey_usage_report": [Scope.AccountReadOnly, Scope.EventsReadOnly],
+        # The stale resource report lists instances, images, domains, and SSH
+        # keys, reads the profile, and scans the event feed for boots and key
+        # deployments.
+        "linode_stale_resources": [
+            Scope.LinodesReadOnly,
+            Scope.ImagesReadOnly,
+            Scope.DomainsReadOnly,
+            Scope.AccountReadOnly,
+            Scope.EventsReadOnly,
+        ],
+        # The security posture report reads the profile, users, tokens, logins,
+        # and SSH keys, scans the event feed, reads every bucket's ACL, and
+        # reads every NodeBalancer's configs for its HTTPS cipher suite.
+        "linode_security_posture": [
+            Scope.AccountReadOnly,
+            Scope.EventsReadOnly,
+            Scope.ObjectStorageReadOnly,
+            Scope.NodeBalancersReadOnly,
+        ],
+        # The timeline only scans the event feed, gated by events:*.
+        "linode_timeline": [Scope.EventsReadOnly],
     }
 
 
@@ -0,0 +1,12 @@
+"""Full tool results that were replaced by a summary.
+
+Mirrors ``go/internal/resultstore``. Results live in process memory only:
+they do not survive a restart, and the store holds at most ``MAX_RESULTS`` of
+them.
+"""
+
+from __future__ import annotations
+
+from linodemcp.resultstore.store import MAX_RESULTS, RESULT_ID_PREFIX, Entry, Store
+
+__all__ = ["MAX_RESULTS", "RESULT_ID_PREFIX", "Entry", "Store"]
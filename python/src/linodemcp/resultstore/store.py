@@ -0,0 +1,69 @@
+"""In-memory store of summarized tool results.
+
+Mirrors ``go/internal/resultstore/store.go``.
+"""
+
+from __future__ import annotations
+
+import threading
+import uuid
+from collections import OrderedDict
+from collections.abc import Callable
+from dataclasses import dataclass
+from datetime import UTC, datetime
+
+# Once the store holds this many results, the next put evicts the oldest.
+MAX_RESULTS = 32
+
+# Marks stored-result identifiers so the model can tell them apart from plan
+# IDs and Linode IDs.
+RESULT_ID_PREFIX = "result_"
+
+
+@dataclass(frozen=True)
+class Entry:
+    """One stored result. ``content`` holds the result's text items in
+    order, as the handler returned them. ``partition`` is the tenant that
+    stored it: empty unless server.tokenPassthrough has tenants share one
+    server, where only the same tenant reads it back."""
+
+    id: str
+    partition: str
+    tool: str
+    stored_at: datetime
+    content: list[str]
+
+
+class Store:
+    """Holds summarized results in process memory."""
+
+    def __init__(self, now: Callable[[], datetime] | None = None) -> None:
+        self._now = now or (lambda: datetime.now(UTC))
+        self._entries: OrderedDict[str, Entry] = OrderedDict()
+        self._lock = threading.Lock()
+
+    def put(self, partition: str, tool: str, content: list[str]) -> str:
+        """Store the text items of one tool result for a partition and return
+        their ID. The oldest result is evicted first when the store is full."""
+        entry = Entry(
+            id=f"{RESULT_ID_PREFIX}{uuid.uuid4()}",
+            partition=partition,
+            tool=tool,
+            stored_at=self._now().astimezone(UTC),
+            content=list(content),
+        )
+        with self._lock:
+            while len(self._entries) >= MAX_RESULTS:
+                self._entries.popitem(last=False)
+            self._entries[entry.id] = entry
+        return entry.id
+
+    def get(self, partition: str, result_id: str) -> Entry | None:
+        """Return the stored result with the given ID, or None when it is
+        unknown, was evicted, predates a restart, or belongs to another
+        partition."""
+        with self._lock:
+            entry = self._entries.get(result_id)
+        if entry is None or entry.partition != partition:
+            return None
+        return entry
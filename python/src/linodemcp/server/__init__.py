@@ -4,23 +4,57 @@ from __future__ import annotations
 
 import asyncio
 import inspect
+import json
 import logging
 import time
 from collections.abc import Awaitable, Callable
-from dataclasses import dataclass
+from dataclasses import dataclass, replace
 from datetime import timedelta
 from typing import TYPE_CHECKING, Any, Protocol, cast
 
 from mcp.server import Server as MCPServer
 from mcp.server.stdio import stdio_server
-from mcp.types import TextContent, Tool
+from mcp.types import (
+    CallToolResult,
+    ClientCapabilities,
+    SamplingCapability,
+    SamplingMessage,
+    TextContent,
+    Tool,
+)
 
 import linodemcp.tools as tools_module
 from linodemcp.audit import Capability as AuditCapability
 from linodemcp.audit import Mode, NoopSink, Sink, Status, new_event
+from linodemcp.availwatch import Store as AvailabilityWatchStore
 from linodemcp.config import get_config_path
 from linodemcp.linode import RetryableClient
+from linodemcp.linode.api_errors import (
+    APIErrorLog,
+    reset_api_error_log,
+    set_api_error_log,
+)
+from linodemcp.linode.backend import environment_transport
+from linodemcp.linode.correlation import reset_correlation_id, set_correlation_id
+from linodemcp.linode.list_options import (
+    ListOptions,
+    reset_list_options,
+    set_list_options,
+)
+from linodemcp.linode.label_prefix import reset_label_prefix, set_label_prefix
+from linodemcp.linode.max_results import reset_max_results, set_max_results
 from linodemcp.linode.metrics import reset_api_recorder, set_api_recorder
+from linodemcp.linode.reachability import reset_reachability, set_reachability
+from linodemcp.linode.request_timeout import reset_request_timeout, set_request_timeout
+from linodemcp.linode.schema_drift import reset_schema_drift, set_schema_drift
+from linodemcp.linode.writelog import reset_write_log, set_write_log
+from linodemcp.nodedrain import Store as NodeDrainStore
+from linodemcp.oauth import (
+    Authorizer,
+    bearer_token,
+    reset_exchanged_token,
+    set_exchanged_token,
+)
 from linodemcp.profiles import (
     Capability,
     Profile,
@@ -33,10 +67,34 @@ from linodemcp.profiles import (
     validate_scopes,
 )
 from linodemcp.profiles.builder import Registry as DraftRegistry
+from linodemcp.resultstore import Store as ResultStore
 from linodemcp.tools import (
     handle_hello,
     handle_version,
 )
+from linodemcp.tools.argument_limits import validate_argument_limits
+from linodemcp.tools.envelope import (
+    ENVELOPE_META_KEY,
+    add_warning,
+    finalize_envelope,
+    reset_envelope,
+    set_envelope,
+)
+from linodemcp.tools.error_hints import append_error_hint
+from linodemcp.tools.label_namespace import (
+    PARAM_NAMESPACE_ONLY,
+    apply_label_namespace,
+    resolve_label_namespace,
+    validate_label_namespace,
+)
+from linodemcp.tools.linode_availability_watch import (
+    reset_availability_watch,
+    set_availability_watch,
+)
+from linodemcp.tools.linode_nodebalancer_node_drain import (
+    reset_node_drains,
+    set_node_drains,
+)
 from linodemcp.tools.linode_profile_builder import set_tool_catalog_provider
 from linodemcp.tools.linode_profile_can_run import (
     set_can_run_active_profile_provider,
@@ -48,12 +106,60 @@ from linodemcp.tools.linode_profile_draft import (
 )
 from linodemcp.tools.linode_profile_draft_mutate import set_mutator_catalog_provider
 from linodemcp.tools.linode_profile_draft_save import set_save_config_path_provider
+from linodemcp.tools.offline_cache import apply_offline_cache, resolve_offline_cache
+from linodemcp.tools.output_format import (
+    PARAM_OUTPUT_FORMAT,
+    apply_output_format,
+    resolve_output_format,
+)
+from linodemcp.tools.read_after_write import (
+    PARAM_READ_AFTER_WRITE,
+    await_read_after_write,
+    resolve_read_after_write,
+)
+from linodemcp.tools.result_summary import (
+    apply_result_summary,
+    reset_result_store,
+    resolve_result_summary,
+    set_result_store,
+)
+from linodemcp.tools.structured_content import (
+    apply_embedded_resource,
+    structured_content,
+)
+from linodemcp.tools.list_options import (
+    ListOptionsError,
+    add_list_options_schema,
+    resolve_list_options,
+    validate_list_options,
+)
+from linodemcp.tools.max_results import (
+    PARAM_MAX_RESULTS,
+    resolve_max_results,
+    warn_list_truncated,
+)
+from linodemcp.tools.tool_timeout import (
+    add_tool_timeout_schema,
+    resolve_tool_timeout,
+    timeout_response,
+)
+from linodemcp.tools.helpers import (
+    is_error_response,
+    request_header,
+    reset_token_partition,
+    set_token_partition,
+)
+from linodemcp.tools.linode_server_health import server_health_dict
+from linodemcp.tools.write_cost import write_cost_delta
 from linodemcp.twostage import reset_plan_store, set_plan_store
 from linodemcp.twostage.store import PlanStore
 from linodemcp.version import VERSION as LINODEMCP_VERSION
 
 if TYPE_CHECKING:
+    from contextvars import Token
+
     from linodemcp.config import Config
+    from linodemcp.tools.result_summary import Sampler
 
 __all__ = ["Server", "ToolEntry", "get_tool_registry"]
 
@@ -67,10 +173,25 @@ ListToolsDecorator = Callable[
     Callable[[], Awaitable[list[Tool]]],
 ]
 CallToolDecorator = Callable[
-    [Callable[..., Awaitable[list[Any]]]],
-    Callable[..., Awaitable[list[Any]]],
+    [Callable[..., Awaitable[CallToolResult]]],
+    Callable[..., Awaitable[CallToolResult]],
 ]
 
+# Arguments the dispatch path and the destroy gate read for every tool, so
+# they never appear in a tool's own input schema and are never reported as
+# ignored (mirrors the Go gateArguments).
+_GATE_ARGUMENTS = frozenset(
+    {
+        "yolo",
+        "confirmed_dry_run",
+        "confirm_bypass_dry_run",
+        PARAM_MAX_RESULTS,
+        PARAM_NAMESPACE_ONLY,
+        PARAM_OUTPUT_FORMAT,
+        PARAM_READ_AFTER_WRITE,
+    }
+)
+
 # Each tool factory now returns (Tool, Capability). We invoke every factory
 # once at module import time and store the resolved Tool plus its capability,
 # matching the Go side's "factory called once at registration" semantics.
@@ -138,6 +259,7 @@ def _build_tool_registry() -> list[ToolEntry]:
             logger.warning("No handler found for tool: %s", tool_name)
             continue
         tool, capability = create_fn()
+        tool = add_tool_timeout_schema(add_list_options_schema(tool))
         entries.append(
             ToolEntry(
                 name=tool_name,
@@ -221,6 +343,35 @@ def _elapsed_ms(start_ns: int) -> int:
     return (time.monotonic_ns() - start_ns) // 1_000_000
 
 
+def _resolve_profile(config: Config, descriptors: list[ToolDescriptor]) -> Profile:
+    """Resolve the active profile, clamped to read tools when read_only is set.
+
+    With ``read_only``, only Read and Meta tools survive, so a write, destroy,
+    or admin tool is never registered and the model cannot even see it,
+    whatever the profile grants. Startup and reload both resolve through here,
+    so a profile switch cannot lift the clamp. Mirrors Go's
+    resolveProfileLocked.
+
+    The ``tools`` config then narrows what is left, holding across reloads
+    the same way.
+    """
+    profile = resolve_active_profile(config, descriptors)
+    if config.read_only:
+        capabilities = {d.name: d.capability for d in descriptors}
+        allowed = tuple(
+            name
+            for name in profile.allowed_tools
+            if capabilities.get(name) in (Capability.Read, Capability.Meta)
+        )
+        profile = replace(profile, allowed_tools=allowed, elevated=False)
+    if config.tools.filters():
+        allowed = tuple(
+            name for name in profile.allowed_tools if config.tools.allows(name)
+        )
+        profile = replace(profile, allowed_tools=allowed)
+    return profile
+
+
 def _audit_capability(capability: Capability) -> AuditCapability:
     """Translate the profiles capability tag into the audit-wire form.
 
@@ -314,6 +465,21 @@ class Server:
         # operator opts out).
         self._audit_redact_pii: bool = False
         self._plan_store = PlanStore()
+        # Full results that result_summary replaced with a summary, for
+        # linode_result_get. Like the plan store it lives in process memory.
+        self._result_store = ResultStore()
+        # NodeBalancer nodes linode_nodebalancer_node_drain took out of
+        # rotation and their timed restores, also in process memory only.
+        self._node_drains = NodeDrainStore()
+        # Plan statuses linode_availability_watch last saw, also in process
+        # memory only.
+        self._availability_watch = AvailabilityWatchStore()
+        # Recent Linode API requests that failed in a way an outage would
+        # explain, for linode_api_status; the client adds to it.
+        self._api_errors = APIErrorLog()
+        # OAuth gate: validates each call's access token and checks its
+        # scopes against the tool's capability. None unless oauth.enabled.
+        self._authorizer = Authorizer(config.oauth) if config.oauth.enabled else None
         self._idle = asyncio.Event()
         self._idle.set()
 
@@ -361,7 +527,7 @@ class Server:
         # self._active_profile at call time, so it reflects reload_profile.
         set_can_run_catalog_provider(lambda: self._descriptors)
         set_can_run_active_profile_provider(lambda: self._active_profile)
-        self._active_profile = resolve_active_profile(config, self._descriptors)
+        self._active_profile = _resolve_profile(config, self._descriptors)
         self._allowed_tool_names = frozenset(self._active_profile.allowed_tools)
         # _allowed_entries and _config_handlers are declared+initialized
         # inside _apply_active_profile so the type annotations live in one
@@ -406,11 +572,44 @@ class Server:
         return Mode.NORMAL
 
     async def dispatch(self, name: str, arguments: dict[str, Any]) -> list[Any]:
+        """Invoke a registered tool handler and return its content.
+
+        The response envelope is dropped; call_tool_result returns it with
+        the content.
+        """
+        content, _ = await self._dispatch_enveloped(name, arguments)
+        return content
+
+    async def call_tool_result(
+        self, name: str, arguments: dict[str, Any]
+    ) -> CallToolResult:
+        """Invoke a registered tool handler and build its MCP result.
+
+        The result carries the content, the JSON object a successful text
+        holds as structuredContent, and the response envelope in _meta under
+        ``linodemcp/envelope`` (mirrors the Go FinalizeEnvelope).
+        """
+        content, envelope = await self._dispatch_enveloped(name, arguments)
+        return CallToolResult.model_validate(
+            {
+                "content": content,
+                "structuredContent": structured_content(
+                    self.config.structured_content, content
+                ),
+                "_meta": {ENVELOPE_META_KEY: envelope},
+            }
+        )
+
+    async def _dispatch_enveloped(
+        self, name: str, arguments: dict[str, Any]
+    ) -> tuple[list[Any], dict[str, Any]]:
         """Invoke a registered tool handler with in-flight tracking.
 
         Wraps the handler call so shutdown() can drain active requests
-        before the process exits. Public so tests can drive the dispatch
-        path without going through the stdio MCP transport.
+        before the process exits, and returns the content with the call's
+        response envelope. dispatch and call_tool_result are the public
+        entry points, so tests can drive the dispatch path without going
+        through the stdio MCP transport.
 
         Phase 1b adds audit-event capture around the inner dispatch:
         every reaching tool call builds an Event at entry and writes
@@ -438,21 +637,103 @@ class Server:
         )
         event.set_mode(self._execution_mode(arguments), "")
 
+        # Collect the call's warnings for its envelope (mirrors the Go
+        # WithWarnings ctx).
+        envelope_token = set_envelope()
+        self._warn_ignored_arguments(name, arguments)
+
         plan_store_token = set_plan_store(self._plan_store)
+        result_store_token = set_result_store(self._result_store)
+        node_drains_token = set_node_drains(self._node_drains)
+        availability_watch_token = set_availability_watch(self._availability_watch)
         # Bind the API recorder for this dispatch so the client records each
         # Linode API round trip it makes (mirrors the Go WithAPIRecorder ctx).
         api_recorder_token = set_api_recorder(self._metrics)
+        api_error_log_token = set_api_error_log(self._api_errors)
+        # Decode API objects under the configured schema_drift mode (mirrors
+        # the Go client's driftMode).
+        schema_drift_token = set_schema_drift(self.config.schema_drift.mode)
+        # The audit event ID doubles as the call's correlation ID, sent with
+        # every Linode API request (mirrors the Go WithCorrelationID ctx).
+        correlation_token = set_correlation_id(event.event_id)
+        # Record accepted writes only when the read-after-write check will
+        # re-read them (mirrors the Go WithWriteLog ctx).
+        capability = next(
+            (e.capability for e in self._allowed_entries if e.name == name),
+            Capability.Unknown,
+        )
+        # Prefix a create tool's label with the environment's label_namespace
+        # before the handler sees it (mirrors the Go ResolveLabelNamespace).
+        namespace = resolve_label_namespace(self.config, name, arguments)
+        # Keep only the namespace's items in every list the call walks, before
+        # the max_results cap counts them (mirrors the Go WithLabelPrefix ctx).
+        label_prefix_token = set_label_prefix(namespace.list_prefix())
+        read_after_write = resolve_read_after_write(
+            self.config.read_after_write, capability, arguments
+        )
+        write_log_token = set_write_log() if read_after_write.enabled else None
+        # Note whether the API answered only when the offline cache may stand
+        # in for it (mirrors the Go WithReachability ctx).
+        offline_cache = resolve_offline_cache(self.config, capability, arguments)
+        reachability_token = set_reachability() if offline_cache.enabled else None
+        # Cap every list a list tool walks (mirrors the Go WithMaxResults ctx).
+        max_results = resolve_max_results(name, arguments)
+        max_results_token = set_max_results(max_results)
+        # Filter and sort every list the call walks on the API side (mirrors
+        # the Go WithListOptions ctx). Malformed options bind nothing;
+        # _dispatch_inner reports them before the handler runs.
+        try:
+            list_options = resolve_list_options(name, arguments)
+        except ListOptionsError:
+            list_options = ListOptions()
+        list_options_token = set_list_options(list_options)
+        result_summary = resolve_result_summary(
+            self.config.result_summary, capability, self._sampler()
+        )
+        exchanged: Token[str] | None = None
+        token_partition_token: Token[tuple[str, str]] | None = None
         try:
-            result = await self._dispatch_inner(name, arguments)
+            exchanged = await self._authorize(name)
+            # Keep each passthrough tenant's results, plans, and drains apart
+            # (mirrors the Go WithTokenPartition ctx). It follows _authorize,
+            # whose token exchange may replace the caller's token.
+            token_partition_token = set_token_partition(self.config)
+            cost_delta = await self._write_cost_delta(name, arguments, event.mode)
+            result = await self._dispatch_with_timeout(
+                name,
+                arguments,
+                resolve_tool_timeout(self.config.tool_timeouts, name, arguments),
+            )
+            if cost_delta is not None and not is_error_response(result):
+                event.set_monthly_cost_delta(cost_delta)
+            await await_read_after_write(
+                self.config, arguments, read_after_write, result
+            )
+            result = apply_offline_cache(offline_cache, name, arguments, result)
+            warn_list_truncated(max_results)
+            result = apply_label_namespace(result, namespace)
+            result = append_error_hint(result)
+            result = apply_output_format(
+                result,
+                name,
+                resolve_output_format(self.config.output_format, arguments),
+            )
+            result = await apply_result_summary(result_summary, name, result)
+            result = apply_embedded_resource(
+                self.config.structured_content, name, result
+            )
             elapsed_ms = _elapsed_ms(start_ns)
+            envelope = finalize_envelope(environment, elapsed_ms, event.event_id)
             event.finalize(Status.SUCCESS, elapsed_ms, "", "")
             self._audit_sink.write(event)
             self._metrics.record_tool_call(name, elapsed_ms / 1000.0, error=False)
-            return result
+            return result, envelope
         except ValueError as exc:
             # _dispatch_inner raises ValueError for unknown / filtered
-            # tool names. Audit as refused, not error: the handler
-            # never ran, so no tool-call metric is recorded.
+            # tool names, and _authorize raises OAuthError (a ValueError)
+            # for a missing or under-scoped token. Audit as refused, not
+            # error: the handler never ran, so no tool-call metric is
+            # recorded.
             event.finalize(Status.REFUSED, _elapsed_ms(start_ns), str(exc), "")
             self._audit_sink.write(event)
             raise
@@ -463,12 +744,98 @@ class Server:
             self._metrics.record_tool_call(name, elapsed_ms / 1000.0, error=True)
             raise
         finally:
+            if token_partition_token is not None:
+                reset_token_partition(token_partition_token)
+            if exchanged is not None:
+                reset_exchanged_token(exchanged)
+            if write_log_token is not None:
+                reset_write_log(write_log_token)
+            if reachability_token is not None:
+                reset_reachability(reachability_token)
+            reset_list_options(list_options_token)
+            reset_max_results(max_results_token)
+            reset_label_prefix(label_prefix_token)
+            reset_correlation_id(correlation_token)
+            reset_schema_drift(schema_drift_token)
+            reset_api_error_log(api_error_log_token)
             reset_api_recorder(api_recorder_token)
+            reset_availability_watch(availability_watch_token)
+            reset_node_drains(node_drains_token)
+            reset_result_store(result_store_token)
             reset_plan_store(plan_store_token)
+            reset_envelope(envelope_token)
+            logger.debug(
+                "tool call finished",
+                extra={
+                    "tool": name,
+                    "status": str(event.status),
+                    "correlation_id": event.event_id,
+                    "elapsed_ms": event.latency_ms,
+                },
+            )
             self._inflight -= 1
             if self._inflight == 0:
                 self._idle.set()
 
+    async def _write_cost_delta(
+        self, name: str, arguments: dict[str, Any], mode: Mode
+    ) -> float | None:
+        """Price a call for its audit event when audit.cost_attribution is
+        on (mirrors the Go writeCostDelta). It runs before the handler so a
+        delete or resize is priced from the resource as it was; a dry run or
+        plan changes nothing and is never priced."""
+        if not self.config.audit.cost_attribution or mode in (
+            Mode.DRY_RUN,
+            Mode.PLAN,
+        ):
+            return None
+        return await write_cost_delta(self.config, name, arguments)
+
+    def _warn_ignored_arguments(self, name: str, arguments: dict[str, Any]) -> None:
+        """Record an envelope warning for each argument the tool's schema does
+        not declare (mirrors the Go warnIgnoredArguments). Handlers read only
+        the arguments they know, so an unknown one (a misspelled filter, a
+        field from another tool) would otherwise be dropped without a trace.
+        A tool that is not registered, or whose schema has no properties, is
+        left alone."""
+        entry = next((e for e in self._allowed_entries if e.name == name), None)
+        if entry is None or not arguments:
+            return
+        properties = entry.tool.inputSchema.get("properties")
+        if not isinstance(properties, dict):
+            return
+        for argument in sorted(arguments):
+            if argument not in properties and argument not in _GATE_ARGUMENTS:
+                add_warning(
+                    "argument %s is not accepted by %s and was ignored",
+                    json.dumps(argument),
+                    name,
+                )
+
+    async def _authorize(self, name: str) -> Token[str] | None:
+        """Enforce OAuth when it is enabled (Go's authorizeCall).
+
+        The call's access token must be valid and carry the scope for the
+        tool's capability. With token exchange on, the access token is
+        traded for a Linode token bound for this call, which replaces any
+        X-Linode-Token the client sent. Unknown tools are left to
+        _dispatch_inner to refuse.
+        """
+        if self._authorizer is None:
+            return None
+        capability = next(
+            (e.capability for e in self._allowed_entries if e.name == name), None
+        )
+        if capability is None:
+            return None
+
+        authorization = request_header("Authorization")
+        await self._authorizer.authorize(authorization, capability)
+        if not self._authorizer.exchange_enabled:
+            return None
+        linode_token = await self._authorizer.exchange(bearer_token(authorization))
+        return set_exchanged_token(linode_token)
+
     def set_audit_sink(self, sink: Sink | None) -> None:
         """Swap the audit sink.
 
@@ -489,6 +856,14 @@ class Server:
         """
         self._metrics = recorder if recorder is not None else NoopMetricsRecorder()
 
+    def health_diagnostics(self) -> dict[str, Any]:
+        """Render the linode_server_health report for /healthz.
+
+        Reads the same live config and plan store a tool call would see, so
+        the endpoint and the tool agree (Go's Server.HealthDiagnostics).
+        """
+        return server_health_dict(self.config, self._plan_store)
+
     def set_audit_redact_pii(self, redact_pii: bool) -> None:
         """Select the redaction tier the capture middleware applies to
         event args (Phase 4c). Main wires this to
@@ -497,6 +872,36 @@ class Server:
         """
         self._audit_redact_pii = redact_pii
 
+    def _sampler(self) -> Sampler | None:
+        """Return a Sampler that sends MCP sampling requests to the calling
+        client, or None outside a request or when the client did not declare
+        the sampling capability (Go's Server.sampler)."""
+        try:
+            session = self.mcp.request_context.session
+        except LookupError:
+            return None
+        if not session.check_client_capability(
+            ClientCapabilities(sampling=SamplingCapability())
+        ):
+            return None
+
+        async def sample(system_prompt: str, text: str, max_tokens: int) -> str:
+            result = await session.create_message(
+                messages=[
+                    SamplingMessage(
+                        role="user", content=TextContent(type="text", text=text)
+                    )
+                ],
+                max_tokens=max_tokens,
+                system_prompt=system_prompt,
+            )
+            if not isinstance(result.content, TextContent):
+                msg = "sampling result is not text"
+                raise TypeError(msg)
+            return result.content.text
+
+        return sample
+
     def _capability_for(self, name: str) -> AuditCapability:
         """Translate the registered tool's capability into the audit wire form.
 
@@ -536,7 +941,12 @@ class Server:
 
         required = [Scope(s) for s in self._active_profile.required_token_scopes]
 
-        client = RetryableClient(env.linode.api_url, env.linode.token)
+        client = RetryableClient(
+            env.linode.base_url(),
+            env.linode.token,
+            headers=env.linode.headers,
+            transport=environment_transport(env.linode),
+        )
         try:
             return await validate_scopes(client, required)
         finally:
@@ -556,6 +966,27 @@ class Server:
             return False
         return True
 
+    async def _dispatch_with_timeout(
+        self, name: str, arguments: dict[str, Any], seconds: int
+    ) -> list[Any]:
+        """Run the call under its tool timeout, if it has one.
+
+        The whole call must finish within ``seconds``, and each API request it
+        makes may take that long instead of the client's 30-second default
+        (mirrors the Go tools.WithToolTimeout ctx). A call cut off by the
+        timeout returns an error naming it.
+        """
+        if seconds <= 0:
+            return await self._dispatch_inner(name, arguments)
+        request_timeout_token = set_request_timeout(float(seconds))
+        try:
+            async with asyncio.timeout(seconds):
+                return await self._dispatch_inner(name, arguments)
+        except TimeoutError:
+            return timeout_response(name, seconds)
+        finally:
+            reset_request_timeout(request_timeout_token)
+
     async def _dispatch_inner(self, name: str, arguments: dict[str, Any]) -> list[Any]:
         """Resolve a tool name to its handler and await the result.
 
@@ -566,6 +997,17 @@ class Server:
         if name not in self._allowed_tool_names:
             msg = f"Unknown tool: {name}"
             raise ValueError(msg)
+        # Malformed arguments never reach the handler (mirrors the Go
+        # tools.ValidateArgumentLimits check in addTool).
+        limit_error = validate_argument_limits(arguments)
+        if limit_error is not None:
+            return [TextContent(type="text", text=f"Error: {limit_error}")]
+        list_options_error = validate_list_options(name, arguments)
+        if list_options_error is not None:
+            return [TextContent(type="text", text=f"Error: {list_options_error}")]
+        namespace_error = validate_label_namespace(self.config, name, arguments)
+        if namespace_error is not None:
+            return [TextContent(type="text", text=f"Error: {namespace_error}")]
         match name:
             case "hello":
                 return await handle_hello(arguments)
@@ -615,7 +1057,9 @@ class Server:
 
         for entry in _TOOL_REGISTRY:
             if entry.name not in self._allowed_tool_names:
-                if emit_filter_log:
+                if emit_filter_log and not self.config.tools.allows(entry.name):
+                    logger.info("tools config filtered out tool: %s", entry.name)
+                elif emit_filter_log:
                     logger.info(
                         "[profile=%s] filtered out tool: %s",
                         self._active_profile.name,
@@ -656,9 +1100,11 @@ class Server:
 
         _list_tools_method()(_list_tools)
 
-        async def _call_tool(name: str, arguments: dict[str, Any]) -> list[Any]:
-            """Dispatch via the tracked path so Shutdown can drain it."""
-            return await self.dispatch(name, arguments)
+        async def _call_tool(name: str, arguments: dict[str, Any]) -> CallToolResult:
+            """Dispatch via the tracked path so Shutdown can drain it. The
+            result carries structuredContent and the response envelope, which
+            the MCP library sends as they are."""
+            return await self.call_tool_result(name, arguments)
 
         cast("CallToolDecorator", self.mcp.call_tool())(_call_tool)
 
@@ -680,7 +1126,7 @@ class Server:
         and tools/list requests.
         """
         async with self._reload_lock:
-            new_profile = resolve_active_profile(config, self._descriptors)
+            new_profile = _resolve_profile(config, self._descriptors)
 
             previous = self._active_profile.name
             self._active_profile = new_profile
@@ -697,6 +1143,12 @@ class Server:
 
     async def start(self) -> None:
         """Start the MCP server using stdio transport."""
+        if self.config.server.transport != "stdio":
+            logger.warning(
+                "server.transport %r is served by the Go server only; "
+                "serving stdio",
+                self.config.server.transport,
+            )
         logger.info(
             "Starting LinodeMCP server with %d tools (profile=%s)",
             len(self._allowed_entries),
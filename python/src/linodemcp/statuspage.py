@@ -0,0 +1,106 @@
+"""Read Linode's public status page for linode_api_status.
+
+The page is an Atlassian Statuspage; its summary document carries the
+overall status and the incidents not yet resolved. Nothing here talks to the
+Linode API.
+
+Mirrors ``go/internal/statuspage``.
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass, field
+from typing import Any, cast
+
+import httpx
+
+# An unreachable status page never holds up the tool call for long.
+_REQUEST_TIMEOUT = 5.0
+
+
+class StatusPageError(Exception):
+    """The status page could not be reached or answered badly."""
+
+
+@dataclass
+class Incident:
+    """One unresolved incident. ``components`` names the status page
+    components it affects, which carry the product and region (for example
+    "Object Storage - US-East (Newark)"); ``latest_update`` is the text of
+    its most recent update."""
+
+    name: str
+    status: str
+    impact: str
+    short_link: str = ""
+    created_at: str = ""
+    updated_at: str = ""
+    components: list[str] = field(default_factory=list[str])
+    latest_update: str = ""
+
+
+@dataclass
+class Summary:
+    """The overall status and the unresolved incidents. ``indicator`` is
+    none, minor, major, or critical."""
+
+    indicator: str
+    description: str
+    incidents: list[Incident] = field(default_factory=list[Incident])
+
+
+def _dicts(raw: Any) -> list[dict[str, Any]]:
+    items = cast("list[Any]", raw) if isinstance(raw, list) else []
+    return [cast("dict[str, Any]", item) for item in items if isinstance(item, dict)]
+
+
+async def fetch(url: str, user_agent: str) -> Summary:
+    """Read the summary document at url."""
+    headers = {"Accept": "application/json", "User-Agent": user_agent}
+    try:
+        async with httpx.AsyncClient(timeout=_REQUEST_TIMEOUT) as client:
+            response = await client.get(url, headers=headers)
+    except httpx.HTTPError as exc:
+        msg = f"fetch status page: {exc}"
+        raise StatusPageError(msg) from exc
+
+    if response.status_code != httpx.codes.OK:
+        msg = f"unexpected status from status page: {response.status_code}"
+        raise StatusPageError(msg)
+
+    try:
+        raw: Any = response.json()
+    except ValueError as exc:
+        msg = f"decode status page: {exc}"
+        raise StatusPageError(msg) from exc
+
+    doc = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    status_raw = doc.get("status")
+    status = cast("dict[str, Any]", status_raw) if isinstance(status_raw, dict) else {}
+
+    incidents: list[Incident] = []
+    for item in _dicts(doc.get("incidents")):
+        # Statuspage lists updates newest first.
+        updates = _dicts(item.get("incident_updates"))
+        incidents.append(
+            Incident(
+                name=str(item.get("name") or ""),
+                status=str(item.get("status") or ""),
+                impact=str(item.get("impact") or ""),
+                short_link=str(item.get("shortlink") or ""),
+                created_at=str(item.get("created_at") or ""),
+                updated_at=str(item.get("updated_at") or ""),
+                components=[
+                    str(c.get("name") or "") for c in _dicts(item.get("components"))
+                ],
+                latest_update=str(updates[0].get("body") or "").strip()
+                if updates
+                else "",
+            )
+        )
+
+    return Summary(
+        indicator=str(status.get("indicator") or ""),
+        description=str(status.get("description") or ""),
+        incidents=incidents,
+    )
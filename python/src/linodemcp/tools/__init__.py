@@ -6,6 +6,10 @@ from linodemcp.tools.hello import (
     create_hello_tool,
     handle_hello,
 )
+from linodemcp.tools.linode_access_allowlist import (
+    create_linode_access_allowlist_update_tool,
+    handle_linode_access_allowlist_update,
+)
 from linodemcp.tools.linode_account import (
     create_linode_account_agreement_acknowledge_tool,
     create_linode_account_agreement_list_tool,
@@ -196,6 +200,16 @@ from linodemcp.tools.linode_account import (
     handle_linode_tag_list,
     handle_linode_tag_object_list,
 )
+from linodemcp.tools.linode_annotations import (
+    create_linode_annotations_get_tool,
+    create_linode_annotations_set_tool,
+    handle_linode_annotations_get,
+    handle_linode_annotations_set,
+)
+from linodemcp.tools.linode_api_status import (
+    create_linode_api_status_tool,
+    handle_linode_api_status,
+)
 from linodemcp.tools.linode_audit_export import (
     create_linode_audit_export_tool,
     handle_linode_audit_export,
@@ -216,10 +230,42 @@ from linodemcp.tools.linode_audit_summary import (
     create_linode_audit_summary_tool,
     handle_linode_audit_summary,
 )
+from linodemcp.tools.linode_availability_watch import (
+    create_linode_availability_watch_tool,
+    handle_linode_availability_watch,
+)
+from linodemcp.tools.linode_backup_schedule import (
+    create_linode_backup_schedule_audit_tool,
+    create_linode_backup_schedule_update_tool,
+    handle_linode_backup_schedule_audit,
+    handle_linode_backup_schedule_update,
+)
 from linodemcp.tools.linode_betas import (
     create_linode_beta_get_tool,
     handle_linode_beta_get,
 )
+from linodemcp.tools.linode_bulk_delete import (
+    create_linode_bulk_delete_tool,
+    handle_linode_bulk_delete,
+)
+from linodemcp.tools.linode_config_dump import (
+    create_linode_config_dump_tool,
+    handle_linode_config_dump,
+)
+from linodemcp.tools.linode_database_backups import (
+    create_linode_database_mysql_backup_create_tool,
+    create_linode_database_mysql_backup_list_tool,
+    create_linode_database_mysql_instance_restore_tool,
+    create_linode_database_postgresql_backup_create_tool,
+    create_linode_database_postgresql_backup_list_tool,
+    create_linode_database_postgresql_instance_restore_tool,
+    handle_linode_database_mysql_backup_create,
+    handle_linode_database_mysql_backup_list,
+    handle_linode_database_mysql_instance_restore,
+    handle_linode_database_postgresql_backup_create,
+    handle_linode_database_postgresql_backup_list,
+    handle_linode_database_postgresql_instance_restore,
+)
 from linodemcp.tools.linode_databases import (
     create_linode_database_engine_get_tool,
     create_linode_database_engine_list_tool,
@@ -280,6 +326,24 @@ from linodemcp.tools.linode_databases import (
     handle_linode_database_type_get,
     handle_linode_database_type_list,
 )
+from linodemcp.tools.linode_dns_cutover import (
+    create_linode_dns_cutover_tool,
+    handle_linode_dns_cutover,
+)
+from linodemcp.tools.linode_dns_point_at_instance import (
+    create_linode_dns_point_at_instance_tool,
+    handle_linode_dns_point_at_instance,
+)
+from linodemcp.tools.linode_domain_bind import (
+    create_linode_domain_records_export_bind_tool,
+    create_linode_domain_records_import_bind_tool,
+    handle_linode_domain_records_export_bind,
+    handle_linode_domain_records_import_bind,
+)
+from linodemcp.tools.linode_domain_delegation_check import (
+    create_linode_domain_delegation_check_tool,
+    handle_linode_domain_delegation_check,
+)
 from linodemcp.tools.linode_domain_records import (
     create_linode_domain_record_create_tool,
     create_linode_domain_record_delete_tool,
@@ -292,6 +356,10 @@ from linodemcp.tools.linode_domain_records import (
     handle_linode_domain_record_list,
     handle_linode_domain_record_update,
 )
+from linodemcp.tools.linode_domain_ttl import (
+    create_linode_domain_ttl_set_tool,
+    handle_linode_domain_ttl_set,
+)
 from linodemcp.tools.linode_domains import (
     create_linode_domain_get_tool,
     create_linode_domain_list_tool,
@@ -312,6 +380,30 @@ from linodemcp.tools.linode_domains_write import (
     handle_linode_domain_import,
     handle_linode_domain_update,
 )
+from linodemcp.tools.linode_firewall_allow_instance import (
+    create_linode_firewall_allow_instance_tool,
+    handle_linode_firewall_allow_instance,
+)
+from linodemcp.tools.linode_firewall_diff import (
+    create_linode_firewall_diff_tool,
+    handle_linode_firewall_diff,
+)
+from linodemcp.tools.linode_firewall_simulate import (
+    create_linode_firewall_simulate_tool,
+    handle_linode_firewall_simulate,
+)
+from linodemcp.tools.linode_firewall_temp_access import (
+    create_linode_firewall_expire_rules_tool,
+    create_linode_firewall_temp_allow_tool,
+    handle_linode_firewall_expire_rules,
+    handle_linode_firewall_temp_allow,
+)
+from linodemcp.tools.linode_firewall_transfer import (
+    create_linode_firewall_export_tool,
+    create_linode_firewall_import_tool,
+    handle_linode_firewall_export,
+    handle_linode_firewall_import,
+)
 from linodemcp.tools.linode_firewalls import (
     create_linode_firewall_device_get_tool,
     create_linode_firewall_device_list_tool,
@@ -424,6 +516,12 @@ from linodemcp.tools.linode_instance_actions import (
     handle_linode_instance_rebuild,
     handle_linode_instance_rescue,
 )
+from linodemcp.tools.linode_instance_alerts import (
+    create_linode_alerts_audit_tool,
+    create_linode_instance_alerts_update_tool,
+    handle_linode_alerts_audit,
+    handle_linode_instance_alerts_update,
+)
 from linodemcp.tools.linode_instance_backups import (
     create_linode_instance_backup_create_tool,
     create_linode_instance_backup_get_tool,
@@ -438,6 +536,14 @@ from linodemcp.tools.linode_instance_backups import (
     handle_linode_instance_backups_cancel,
     handle_linode_instance_backups_enable,
 )
+from linodemcp.tools.linode_instance_cloudinit_status import (
+    create_linode_instance_cloudinit_status_tool,
+    handle_linode_instance_cloudinit_status,
+)
+from linodemcp.tools.linode_instance_console import (
+    create_linode_instance_console_tool,
+    handle_linode_instance_console,
+)
 from linodemcp.tools.linode_instance_disks import (
     create_linode_instance_config_create_tool,
     create_linode_instance_disk_clone_tool,
@@ -486,6 +592,22 @@ from linodemcp.tools.linode_instance_ips import (
     handle_linode_networking_ip_list,
     handle_linode_networking_ip_update,
 )
+from linodemcp.tools.linode_instance_neighbors import (
+    create_linode_instance_neighbors_tool,
+    handle_linode_instance_neighbors,
+)
+from linodemcp.tools.linode_instance_replace import (
+    create_linode_instance_replace_tool,
+    handle_linode_instance_replace,
+)
+from linodemcp.tools.linode_instance_ssh_fingerprints import (
+    create_linode_instance_ssh_fingerprints_tool,
+    handle_linode_instance_ssh_fingerprints,
+)
+from linodemcp.tools.linode_instance_to_image import (
+    create_linode_instance_to_image_tool,
+    handle_linode_instance_to_image,
+)
 from linodemcp.tools.linode_instance_write import (
     create_linode_instance_boot_tool,
     create_linode_instance_create_tool,
@@ -596,6 +718,18 @@ from linodemcp.tools.linode_lke import (
     handle_linode_lke_version_get,
     handle_linode_lke_version_list,
 )
+from linodemcp.tools.linode_lke_node_instances import (
+    create_linode_lke_node_instances_tool,
+    handle_linode_lke_node_instances,
+)
+from linodemcp.tools.linode_lke_upgrade_plan import (
+    create_linode_lke_upgrade_plan_tool,
+    handle_linode_lke_upgrade_plan,
+)
+from linodemcp.tools.linode_lke_workload_ping import (
+    create_linode_lke_workload_ping_tool,
+    handle_linode_lke_workload_ping,
+)
 from linodemcp.tools.linode_lke_write import (
     create_linode_lke_acl_delete_tool,
     create_linode_lke_acl_update_tool,
@@ -682,6 +816,10 @@ from linodemcp.tools.linode_monitor_write import (
     handle_linode_monitor_service_metric_query,
     handle_linode_monitor_service_token_create,
 )
+from linodemcp.tools.linode_multi_region_deploy import (
+    create_linode_multi_region_deploy_tool,
+    handle_linode_multi_region_deploy,
+)
 from linodemcp.tools.linode_network_transfer import (
     create_linode_network_transfer_price_list_tool,
     handle_linode_network_transfer_price_list,
@@ -700,6 +838,16 @@ from linodemcp.tools.linode_networking import (
     handle_linode_vlan_delete,
     handle_linode_vlan_list,
 )
+from linodemcp.tools.linode_nodebalancer_backend_probe import (
+    create_linode_nodebalancer_backend_probe_tool,
+    handle_linode_nodebalancer_backend_probe,
+)
+from linodemcp.tools.linode_nodebalancer_node_drain import (
+    create_linode_nodebalancer_node_drain_tool,
+    create_linode_nodebalancer_node_undrain_tool,
+    handle_linode_nodebalancer_node_drain,
+    handle_linode_nodebalancer_node_undrain,
+)
 from linodemcp.tools.linode_nodebalancers import (
     create_linode_nodebalancer_config_get_tool,
     create_linode_nodebalancer_config_list_tool,
@@ -776,6 +924,14 @@ from linodemcp.tools.linode_object_storage import (
     handle_linode_object_storage_transfer_get,
     handle_linode_object_storage_type_list,
 )
+from linodemcp.tools.linode_object_storage_cicd import (
+    create_linode_object_storage_cicd_credentials_tool,
+    handle_linode_object_storage_cicd_credentials,
+)
+from linodemcp.tools.linode_object_storage_manifest import (
+    create_linode_object_storage_bucket_manifest_export_tool,
+    handle_linode_object_storage_bucket_manifest_export,
+)
 from linodemcp.tools.linode_object_storage_write import (
     create_linode_object_storage_bucket_access_allow_tool,
     create_linode_object_storage_bucket_access_update_tool,
@@ -904,6 +1060,16 @@ from linodemcp.tools.linode_profile_draft_save import (
     create_linode_profile_draft_save_tool,
     handle_linode_profile_draft_save,
 )
+from linodemcp.tools.linode_projects import (
+    create_linode_projects_get_tool,
+    create_linode_projects_list_tool,
+    handle_linode_projects_get,
+    handle_linode_projects_list,
+)
+from linodemcp.tools.linode_quota_report import (
+    create_linode_quota_report_tool,
+    handle_linode_quota_report,
+)
 from linodemcp.tools.linode_regions import (
     create_linode_region_availability_get_tool,
     create_linode_region_availability_list_tool,
@@ -928,6 +1094,22 @@ from linodemcp.tools.linode_reserved_ips import (
     handle_linode_networking_reserved_ip_type_list,
     handle_linode_networking_reserved_ip_update,
 )
+from linodemcp.tools.linode_result_get import (
+    create_linode_result_get_tool,
+    handle_linode_result_get,
+)
+from linodemcp.tools.linode_security_posture import (
+    create_linode_security_posture_tool,
+    handle_linode_security_posture,
+)
+from linodemcp.tools.linode_server_health import (
+    create_linode_server_health_tool,
+    handle_linode_server_health,
+)
+from linodemcp.tools.linode_sshkey_usage_report import (
+    create_linode_sshkey_usage_report_tool,
+    handle_linode_sshkey_usage_report,
+)
 from linodemcp.tools.linode_sshkeys import (
     create_linode_sshkey_get_tool,
     create_linode_sshkey_list_tool,
@@ -942,6 +1124,10 @@ from linodemcp.tools.linode_sshkeys_write import (
     handle_linode_sshkey_delete,
     handle_linode_sshkey_update,
 )
+from linodemcp.tools.linode_stackscript_udfs import (
+    create_linode_stackscript_udfs_get_tool,
+    handle_linode_stackscript_udfs_get,
+)
 from linodemcp.tools.linode_stackscripts import (
     create_linode_stackscript_create_tool,
     create_linode_stackscript_delete_tool,
@@ -954,12 +1140,34 @@ from linodemcp.tools.linode_stackscripts import (
     handle_linode_stackscript_list,
     handle_linode_stackscript_update,
 )
+from linodemcp.tools.linode_stale_resources import (
+    create_linode_stale_resources_tool,
+    handle_linode_stale_resources,
+)
+from linodemcp.tools.linode_tags_bulk import (
+    create_linode_tags_cleanup_tool,
+    create_linode_tags_retag_tool,
+    handle_linode_tags_cleanup,
+    handle_linode_tags_retag,
+)
+from linodemcp.tools.linode_timeline import (
+    create_linode_timeline_tool,
+    handle_linode_timeline,
+)
+from linodemcp.tools.linode_transfer_forecast import (
+    create_linode_transfer_forecast_tool,
+    handle_linode_transfer_forecast,
+)
 from linodemcp.tools.linode_types import (
     create_linode_type_get_tool,
     create_linode_type_list_tool,
     handle_linode_type_get,
     handle_linode_type_list,
 )
+from linodemcp.tools.linode_version_check import (
+    create_linode_version_check_tool,
+    handle_linode_version_check,
+)
 from linodemcp.tools.linode_volumes import (
     create_linode_volume_get_tool,
     create_linode_volume_list_tool,
@@ -1034,6 +1242,7 @@ __all__ = [
     "SSH_KEY_TRUNCATE_LIMIT",
     "RetryableClient",
     "create_hello_tool",
+    "create_linode_access_allowlist_update_tool",
     "create_linode_account_agreement_acknowledge_tool",
     "create_linode_account_agreement_list_tool",
     "create_linode_account_availability_get_tool",
@@ -1090,16 +1299,27 @@ __all__ = [
     "create_linode_account_user_grants_update_tool",
     "create_linode_account_user_list_tool",
     "create_linode_account_user_update_tool",
+    "create_linode_alerts_audit_tool",
+    "create_linode_annotations_get_tool",
+    "create_linode_annotations_set_tool",
+    "create_linode_api_status_tool",
     "create_linode_audit_export_tool",
     "create_linode_audit_health_tool",
     "create_linode_audit_recent_tool",
     "create_linode_audit_report_tool",
     "create_linode_audit_summary_tool",
+    "create_linode_availability_watch_tool",
+    "create_linode_backup_schedule_audit_tool",
+    "create_linode_backup_schedule_update_tool",
     "create_linode_beta_get_tool",
     "create_linode_beta_list_tool",
+    "create_linode_bulk_delete_tool",
+    "create_linode_config_dump_tool",
     "create_linode_database_engine_get_tool",
     "create_linode_database_engine_list_tool",
     "create_linode_database_instance_list_tool",
+    "create_linode_database_mysql_backup_create_tool",
+    "create_linode_database_mysql_backup_list_tool",
     "create_linode_database_mysql_config_get_tool",
     "create_linode_database_mysql_instance_create_tool",
     "create_linode_database_mysql_instance_credentials_get_tool",
@@ -1108,10 +1328,13 @@ __all__ = [
     "create_linode_database_mysql_instance_get_tool",
     "create_linode_database_mysql_instance_list_tool",
     "create_linode_database_mysql_instance_patch_tool",
+    "create_linode_database_mysql_instance_restore_tool",
     "create_linode_database_mysql_instance_resume_tool",
     "create_linode_database_mysql_instance_ssl_get_tool",
     "create_linode_database_mysql_instance_suspend_tool",
     "create_linode_database_mysql_instance_update_tool",
+    "create_linode_database_postgresql_backup_create_tool",
+    "create_linode_database_postgresql_backup_list_tool",
     "create_linode_database_postgresql_config_get_tool",
     "create_linode_database_postgresql_instance_create_tool",
     "create_linode_database_postgresql_instance_credentials_get_tool",
@@ -1120,14 +1343,18 @@ __all__ = [
     "create_linode_database_postgresql_instance_get_tool",
     "create_linode_database_postgresql_instance_list_tool",
     "create_linode_database_postgresql_instance_patch_tool",
+    "create_linode_database_postgresql_instance_restore_tool",
     "create_linode_database_postgresql_instance_resume_tool",
     "create_linode_database_postgresql_instance_ssl_get_tool",
     "create_linode_database_postgresql_instance_suspend_tool",
     "create_linode_database_postgresql_instance_update_tool",
     "create_linode_database_type_get_tool",
     "create_linode_database_type_list_tool",
+    "create_linode_dns_cutover_tool",
+    "create_linode_dns_point_at_instance_tool",
     "create_linode_domain_clone_tool",
     "create_linode_domain_create_tool",
+    "create_linode_domain_delegation_check_tool",
     "create_linode_domain_delete_tool",
     "create_linode_domain_get_tool",
     "create_linode_domain_import_tool",
@@ -1137,15 +1364,23 @@ __all__ = [
     "create_linode_domain_record_get_tool",
     "create_linode_domain_record_list_tool",
     "create_linode_domain_record_update_tool",
+    "create_linode_domain_records_export_bind_tool",
+    "create_linode_domain_records_import_bind_tool",
+    "create_linode_domain_ttl_set_tool",
     "create_linode_domain_update_tool",
     "create_linode_domain_zone_file_get_tool",
+    "create_linode_firewall_allow_instance_tool",
     "create_linode_firewall_create_tool",
     "create_linode_firewall_delete_tool",
     "create_linode_firewall_device_create_tool",
     "create_linode_firewall_device_delete_tool",
     "create_linode_firewall_device_get_tool",
     "create_linode_firewall_device_list_tool",
+    "create_linode_firewall_diff_tool",
+    "create_linode_firewall_expire_rules_tool",
+    "create_linode_firewall_export_tool",
     "create_linode_firewall_get_tool",
+    "create_linode_firewall_import_tool",
     "create_linode_firewall_list_tool",
     "create_linode_firewall_rule_version_get_tool",
     "create_linode_firewall_rule_version_list_tool",
@@ -1153,6 +1388,8 @@ __all__ = [
     "create_linode_firewall_rules_update_tool",
     "create_linode_firewall_settings_get_tool",
     "create_linode_firewall_settings_update_tool",
+    "create_linode_firewall_simulate_tool",
+    "create_linode_firewall_temp_allow_tool",
     "create_linode_firewall_template_get_tool",
     "create_linode_firewall_template_list_tool",
     "create_linode_firewall_update_tool",
@@ -1185,6 +1422,7 @@ __all__ = [
     "create_linode_image_sharegroup_update_tool",
     "create_linode_image_update_tool",
     "create_linode_image_upload_tool",
+    "create_linode_instance_alerts_update_tool",
     "create_linode_instance_backup_create_tool",
     "create_linode_instance_backup_get_tool",
     "create_linode_instance_backup_list_tool",
@@ -1193,6 +1431,7 @@ __all__ = [
     "create_linode_instance_backups_enable_tool",
     "create_linode_instance_boot_tool",
     "create_linode_instance_clone_tool",
+    "create_linode_instance_cloudinit_status_tool",
     "create_linode_instance_config_create_tool",
     "create_linode_instance_config_delete_tool",
     "create_linode_instance_config_get_tool",
@@ -1204,6 +1443,7 @@ __all__ = [
     "create_linode_instance_config_interface_update_tool",
     "create_linode_instance_config_list_tool",
     "create_linode_instance_config_update_tool",
+    "create_linode_instance_console_tool",
     "create_linode_instance_create_tool",
     "create_linode_instance_delete_tool",
     "create_linode_instance_disk_clone_tool",
@@ -1236,15 +1476,19 @@ __all__ = [
     "create_linode_instance_list_tool",
     "create_linode_instance_migrate_tool",
     "create_linode_instance_mutate_tool",
+    "create_linode_instance_neighbors_tool",
     "create_linode_instance_nodebalancer_list_tool",
     "create_linode_instance_password_reset_tool",
     "create_linode_instance_reboot_tool",
     "create_linode_instance_rebuild_tool",
+    "create_linode_instance_replace_tool",
     "create_linode_instance_rescue_tool",
     "create_linode_instance_resize_tool",
     "create_linode_instance_shutdown_tool",
+    "create_linode_instance_ssh_fingerprints_tool",
     "create_linode_instance_stats_get_tool",
     "create_linode_instance_stats_month_get_tool",
+    "create_linode_instance_to_image_tool",
     "create_linode_instance_transfer_get_tool",
     "create_linode_instance_transfer_month_get_tool",
     "create_linode_instance_update_tool",
@@ -1272,6 +1516,7 @@ __all__ = [
     "create_linode_lke_kubeconfig_get_tool",
     "create_linode_lke_node_delete_tool",
     "create_linode_lke_node_get_tool",
+    "create_linode_lke_node_instances_tool",
     "create_linode_lke_node_recycle_tool",
     "create_linode_lke_pool_create_tool",
     "create_linode_lke_pool_delete_tool",
@@ -1283,8 +1528,10 @@ __all__ = [
     "create_linode_lke_tier_version_get_tool",
     "create_linode_lke_tier_version_list_tool",
     "create_linode_lke_type_list_tool",
+    "create_linode_lke_upgrade_plan_tool",
     "create_linode_lke_version_get_tool",
     "create_linode_lke_version_list_tool",
+    "create_linode_lke_workload_ping_tool",
     "create_linode_longview_client_create_tool",
     "create_linode_longview_client_delete_tool",
     "create_linode_longview_client_get_tool",
@@ -1336,6 +1583,7 @@ __all__ = [
     "create_linode_monitor_service_metric_definition_list_tool",
     "create_linode_monitor_service_metric_query_tool",
     "create_linode_monitor_service_token_create_tool",
+    "create_linode_multi_region_deploy_tool",
     "create_linode_network_transfer_price_list_tool",
     "create_linode_networking_ip_allocate_tool",
     "create_linode_networking_ip_assign_tool",
@@ -1351,6 +1599,7 @@ __all__ = [
     "create_linode_networking_reserved_ip_list_tool",
     "create_linode_networking_reserved_ip_type_list_tool",
     "create_linode_networking_reserved_ip_update_tool",
+    "create_linode_nodebalancer_backend_probe_tool",
     "create_linode_nodebalancer_config_create_tool",
     "create_linode_nodebalancer_config_delete_tool",
     "create_linode_nodebalancer_config_get_tool",
@@ -1368,6 +1617,8 @@ __all__ = [
     "create_linode_nodebalancer_firewall_update_tool",
     "create_linode_nodebalancer_get_tool",
     "create_linode_nodebalancer_list_tool",
+    "create_linode_nodebalancer_node_drain_tool",
+    "create_linode_nodebalancer_node_undrain_tool",
     "create_linode_nodebalancer_stats_get_tool",
     "create_linode_nodebalancer_type_list_tool",
     "create_linode_nodebalancer_update_tool",
@@ -1381,8 +1632,10 @@ __all__ = [
     "create_linode_object_storage_bucket_delete_tool",
     "create_linode_object_storage_bucket_get_tool",
     "create_linode_object_storage_bucket_list_tool",
+    "create_linode_object_storage_bucket_manifest_export_tool",
     "create_linode_object_storage_bucket_object_list_tool",
     "create_linode_object_storage_cancel_tool",
+    "create_linode_object_storage_cicd_credentials_tool",
     "create_linode_object_storage_endpoint_list_tool",
     "create_linode_object_storage_key_create_tool",
     "create_linode_object_storage_key_delete_tool",
@@ -1441,20 +1694,29 @@ __all__ = [
     "create_linode_profile_token_get_tool",
     "create_linode_profile_token_list_tool",
     "create_linode_profile_token_update_tool",
+    "create_linode_projects_get_tool",
+    "create_linode_projects_list_tool",
+    "create_linode_quota_report_tool",
     "create_linode_region_availability_get_tool",
     "create_linode_region_availability_list_tool",
     "create_linode_region_get_tool",
     "create_linode_region_list_tool",
+    "create_linode_result_get_tool",
+    "create_linode_security_posture_tool",
+    "create_linode_server_health_tool",
     "create_linode_sshkey_create_tool",
     "create_linode_sshkey_delete_tool",
     "create_linode_sshkey_get_tool",
     "create_linode_sshkey_list_tool",
     "create_linode_sshkey_update_tool",
+    "create_linode_sshkey_usage_report_tool",
     "create_linode_stackscript_create_tool",
     "create_linode_stackscript_delete_tool",
     "create_linode_stackscript_get_tool",
     "create_linode_stackscript_list_tool",
+    "create_linode_stackscript_udfs_get_tool",
     "create_linode_stackscript_update_tool",
+    "create_linode_stale_resources_tool",
     "create_linode_support_ticket_attachment_create_tool",
     "create_linode_support_ticket_close_tool",
     "create_linode_support_ticket_create_tool",
@@ -1466,8 +1728,13 @@ __all__ = [
     "create_linode_tag_delete_tool",
     "create_linode_tag_list_tool",
     "create_linode_tag_object_list_tool",
+    "create_linode_tags_cleanup_tool",
+    "create_linode_tags_retag_tool",
+    "create_linode_timeline_tool",
+    "create_linode_transfer_forecast_tool",
     "create_linode_type_get_tool",
     "create_linode_type_list_tool",
+    "create_linode_version_check_tool",
     "create_linode_vlan_delete_tool",
     "create_linode_vlan_list_tool",
     "create_linode_volume_attach_tool",
@@ -1496,6 +1763,7 @@ __all__ = [
     "error_response",
     "execute_tool",
     "handle_hello",
+    "handle_linode_access_allowlist_update",
     "handle_linode_account_agreement_acknowledge",
     "handle_linode_account_agreement_list",
     "handle_linode_account_availability_get",
@@ -1552,16 +1820,27 @@ __all__ = [
     "handle_linode_account_user_grants_update",
     "handle_linode_account_user_list",
     "handle_linode_account_user_update",
+    "handle_linode_alerts_audit",
+    "handle_linode_annotations_get",
+    "handle_linode_annotations_set",
+    "handle_linode_api_status",
     "handle_linode_audit_export",
     "handle_linode_audit_health",
     "handle_linode_audit_recent",
     "handle_linode_audit_report",
     "handle_linode_audit_summary",
+    "handle_linode_availability_watch",
+    "handle_linode_backup_schedule_audit",
+    "handle_linode_backup_schedule_update",
     "handle_linode_beta_get",
     "handle_linode_beta_list",
+    "handle_linode_bulk_delete",
+    "handle_linode_config_dump",
     "handle_linode_database_engine_get",
     "handle_linode_database_engine_list",
     "handle_linode_database_instance_list",
+    "handle_linode_database_mysql_backup_create",
+    "handle_linode_database_mysql_backup_list",
     "handle_linode_database_mysql_config_get",
     "handle_linode_database_mysql_instance_create",
     "handle_linode_database_mysql_instance_credentials_get",
@@ -1570,10 +1849,13 @@ __all__ = [
     "handle_linode_database_mysql_instance_get",
     "handle_linode_database_mysql_instance_list",
     "handle_linode_database_mysql_instance_patch",
+    "handle_linode_database_mysql_instance_restore",
     "handle_linode_database_mysql_instance_resume",
     "handle_linode_database_mysql_instance_ssl_get",
     "handle_linode_database_mysql_instance_suspend",
     "handle_linode_database_mysql_instance_update",
+    "handle_linode_database_postgresql_backup_create",
+    "handle_linode_database_postgresql_backup_list",
     "handle_linode_database_postgresql_config_get",
     "handle_linode_database_postgresql_instance_create",
     "handle_linode_database_postgresql_instance_credentials_get",
@@ -1582,14 +1864,18 @@ __all__ = [
     "handle_linode_database_postgresql_instance_get",
     "handle_linode_database_postgresql_instance_list",
     "handle_linode_database_postgresql_instance_patch",
+    "handle_linode_database_postgresql_instance_restore",
     "handle_linode_database_postgresql_instance_resume",
     "handle_linode_database_postgresql_instance_ssl_get",
     "handle_linode_database_postgresql_instance_suspend",
     "handle_linode_database_postgresql_instance_update",
     "handle_linode_database_type_get",
     "handle_linode_database_type_list",
+    "handle_linode_dns_cutover",
+    "handle_linode_dns_point_at_instance",
     "handle_linode_domain_clone",
     "handle_linode_domain_create",
+    "handle_linode_domain_delegation_check",
     "handle_linode_domain_delete",
     "handle_linode_domain_get",
     "handle_linode_domain_import",
@@ -1599,15 +1885,23 @@ __all__ = [
     "handle_linode_domain_record_get",
     "handle_linode_domain_record_list",
     "handle_linode_domain_record_update",
+    "handle_linode_domain_records_export_bind",
+    "handle_linode_domain_records_import_bind",
+    "handle_linode_domain_ttl_set",
     "handle_linode_domain_update",
     "handle_linode_domain_zone_file_get",
+    "handle_linode_firewall_allow_instance",
     "handle_linode_firewall_create",
     "handle_linode_firewall_delete",
     "handle_linode_firewall_device_create",
     "handle_linode_firewall_device_delete",
     "handle_linode_firewall_device_get",
     "handle_linode_firewall_device_list",
+    "handle_linode_firewall_diff",
+    "handle_linode_firewall_expire_rules",
+    "handle_linode_firewall_export",
     "handle_linode_firewall_get",
+    "handle_linode_firewall_import",
     "handle_linode_firewall_list",
     "handle_linode_firewall_rule_version_get",
     "handle_linode_firewall_rule_version_list",
@@ -1615,6 +1909,8 @@ __all__ = [
     "handle_linode_firewall_rules_update",
     "handle_linode_firewall_settings_get",
     "handle_linode_firewall_settings_update",
+    "handle_linode_firewall_simulate",
+    "handle_linode_firewall_temp_allow",
     "handle_linode_firewall_template_get",
     "handle_linode_firewall_template_list",
     "handle_linode_firewall_update",
@@ -1647,6 +1943,7 @@ __all__ = [
     "handle_linode_image_sharegroup_update",
     "handle_linode_image_update",
     "handle_linode_image_upload",
+    "handle_linode_instance_alerts_update",
     "handle_linode_instance_backup_create",
     "handle_linode_instance_backup_get",
     "handle_linode_instance_backup_list",
@@ -1655,6 +1952,7 @@ __all__ = [
     "handle_linode_instance_backups_enable",
     "handle_linode_instance_boot",
     "handle_linode_instance_clone",
+    "handle_linode_instance_cloudinit_status",
     "handle_linode_instance_config_create",
     "handle_linode_instance_config_delete",
     "handle_linode_instance_config_get",
@@ -1666,6 +1964,7 @@ __all__ = [
     "handle_linode_instance_config_interface_update",
     "handle_linode_instance_config_list",
     "handle_linode_instance_config_update",
+    "handle_linode_instance_console",
     "handle_linode_instance_create",
     "handle_linode_instance_delete",
     "handle_linode_instance_disk_clone",
@@ -1698,15 +1997,19 @@ __all__ = [
     "handle_linode_instance_list",
     "handle_linode_instance_migrate",
     "handle_linode_instance_mutate",
+    "handle_linode_instance_neighbors",
     "handle_linode_instance_nodebalancer_list",
     "handle_linode_instance_password_reset",
     "handle_linode_instance_reboot",
     "handle_linode_instance_rebuild",
+    "handle_linode_instance_replace",
     "handle_linode_instance_rescue",
     "handle_linode_instance_resize",
     "handle_linode_instance_shutdown",
+    "handle_linode_instance_ssh_fingerprints",
     "handle_linode_instance_stats_get",
     "handle_linode_instance_stats_month_get",
+    "handle_linode_instance_to_image",
     "handle_linode_instance_transfer_get",
     "handle_linode_instance_transfer_month_get",
     "handle_linode_instance_update",
@@ -1734,6 +2037,7 @@ __all__ = [
     "handle_linode_lke_kubeconfig_get",
     "handle_linode_lke_node_delete",
     "handle_linode_lke_node_get",
+    "handle_linode_lke_node_instances",
     "handle_linode_lke_node_recycle",
     "handle_linode_lke_pool_create",
     "handle_linode_lke_pool_delete",
@@ -1745,8 +2049,10 @@ __all__ = [
     "handle_linode_lke_tier_version_get",
     "handle_linode_lke_tier_version_list",
     "handle_linode_lke_type_list",
+    "handle_linode_lke_upgrade_plan",
     "handle_linode_lke_version_get",
     "handle_linode_lke_version_list",
+    "handle_linode_lke_workload_ping",
     "handle_linode_longview_client_create",
     "handle_linode_longview_client_delete",
     "handle_linode_longview_client_get",
@@ -1798,6 +2104,7 @@ __all__ = [
     "handle_linode_monitor_service_metric_definition_list",
     "handle_linode_monitor_service_metric_query",
     "handle_linode_monitor_service_token_create",
+    "handle_linode_multi_region_deploy",
     "handle_linode_network_transfer_price_list",
     "handle_linode_networking_ip_allocate",
     "handle_linode_networking_ip_assign",
@@ -1813,6 +2120,7 @@ __all__ = [
     "handle_linode_networking_reserved_ip_list",
     "handle_linode_networking_reserved_ip_type_list",
     "handle_linode_networking_reserved_ip_update",
+    "handle_linode_nodebalancer_backend_probe",
     "handle_linode_nodebalancer_config_create",
     "handle_linode_nodebalancer_config_delete",
     "handle_linode_nodebalancer_config_get",
@@ -1830,6 +2138,8 @@ __all__ = [
     "handle_linode_nodebalancer_firewall_update",
     "handle_linode_nodebalancer_get",
     "handle_linode_nodebalancer_list",
+    "handle_linode_nodebalancer_node_drain",
+    "handle_linode_nodebalancer_node_undrain",
     "handle_linode_nodebalancer_stats_get",
     "handle_linode_nodebalancer_type_list",
     "handle_linode_nodebalancer_update",
@@ -1843,8 +2153,10 @@ __all__ = [
     "handle_linode_object_storage_bucket_delete",
     "handle_linode_object_storage_bucket_get",
     "handle_linode_object_storage_bucket_list",
+    "handle_linode_object_storage_bucket_manifest_export",
     "handle_linode_object_storage_bucket_object_list",
     "handle_linode_object_storage_cancel",
+    "handle_linode_object_storage_cicd_credentials",
     "handle_linode_object_storage_endpoint_list",
     "handle_linode_object_storage_key_create",
     "handle_linode_object_storage_key_delete",
@@ -1903,20 +2215,29 @@ __all__ = [
     "handle_linode_profile_token_get",
     "handle_linode_profile_token_list",
     "handle_linode_profile_token_update",
+    "handle_linode_projects_get",
+    "handle_linode_projects_list",
+    "handle_linode_quota_report",
     "handle_linode_region_availability_get",
     "handle_linode_region_availability_list",
     "handle_linode_region_get",
     "handle_linode_region_list",
+    "handle_linode_result_get",
+    "handle_linode_security_posture",
+    "handle_linode_server_health",
     "handle_linode_sshkey_create",
     "handle_linode_sshkey_delete",
     "handle_linode_sshkey_get",
     "handle_linode_sshkey_list",
     "handle_linode_sshkey_update",
+    "handle_linode_sshkey_usage_report",
     "handle_linode_stackscript_create",
     "handle_linode_stackscript_delete",
     "handle_linode_stackscript_get",
     "handle_linode_stackscript_list",
+    "handle_linode_stackscript_udfs_get",
     "handle_linode_stackscript_update",
+    "handle_linode_stale_resources",
     "handle_linode_support_ticket_attachment_create",
     "handle_linode_support_ticket_close",
     "handle_linode_support_ticket_create",
@@ -1928,8 +2249,13 @@ __all__ = [
     "handle_linode_tag_delete",
     "handle_linode_tag_list",
     "handle_linode_tag_object_list",
+    "handle_linode_tags_cleanup",
+    "handle_linode_tags_retag",
+    "handle_linode_timeline",
+    "handle_linode_transfer_forecast",
     "handle_linode_type_get",
     "handle_linode_type_list",
+    "handle_linode_version_check",
     "handle_linode_vlan_delete",
     "handle_linode_vlan_list",
     "handle_linode_volume_attach",
@@ -1956,6 +2282,7 @@ __all__ = [
     "handle_linode_vpc_update",
     "handle_version",
     "truncate_string",
+    # Re-exported helpers
 ]
 
 # Re-export shared utilities
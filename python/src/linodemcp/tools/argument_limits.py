@@ -0,0 +1,107 @@
+"""Argument limits the dispatcher applies before any tool handler runs.
+
+The limits sit well above anything the Linode API accepts, so they only catch
+malformed model output (a runaway string, a list of thousands of IDs, a
+control character in a label) that would otherwise reach the API as an odd
+failure or forge a log line. Mirrors Go's tools.ValidateArgumentLimits,
+including the error text.
+"""
+
+from __future__ import annotations
+
+import json
+import unicodedata
+from typing import Any
+
+MAX_ARGUMENTS_BYTES = 10 << 20
+MAX_ARGUMENT_STRING_LENGTH = 64 << 10
+MAX_ARGUMENT_CONTENT_LENGTH = 8 << 20
+MAX_ARGUMENT_ARRAY_ITEMS = 1000
+
+# Arguments carrying file-sized content (a StackScript body, a pasted zone
+# file, a base64 attachment) get the content limit instead of the string one.
+_CONTENT_ARGUMENTS = frozenset({"script", "zone_file", "file"})
+
+# Free-form prose where line breaks and tabs are expected; every other control
+# character is still rejected.
+_TEXT_ARGUMENTS = frozenset({"description", "summary", "body"})
+_TEXT_WHITESPACE = frozenset({"\n", "\r", "\t"})
+
+
+def _is_label_argument(name: str) -> bool:
+    """Report whether name holds a resource label or tag."""
+    return name in ("label", "tags", "tag") or name.endswith("_label")
+
+
+def _check_string(path: str, name: str, value: str) -> str | None:
+    limit = (
+        MAX_ARGUMENT_CONTENT_LENGTH
+        if name in _CONTENT_ARGUMENTS
+        else MAX_ARGUMENT_STRING_LENGTH
+    )
+    if len(value) > limit:
+        return (
+            f"argument is too long: {path} is {len(value)} characters, "
+            f"at most {limit}"
+        )
+    is_text = name in _TEXT_ARGUMENTS
+    if not _is_label_argument(name) and not is_text:
+        return None
+    for position, char in enumerate(value, start=1):
+        if unicodedata.category(char) != "Cc":
+            continue
+        if is_text and char in _TEXT_WHITESPACE:
+            continue
+        return (
+            f"argument contains a control character: {path} has "
+            f"U+{ord(char):04X} at character {position}"
+        )
+    return None
+
+
+def _check_value(path: str, name: str, value: Any) -> str | None:
+    if isinstance(value, str):
+        return _check_string(path, name, value)
+    if isinstance(value, dict):
+        return _check_object(path, value)
+    if isinstance(value, list):
+        if len(value) > MAX_ARGUMENT_ARRAY_ITEMS:
+            return (
+                f"argument has too many items: {path} has {len(value)} items, "
+                f"at most {MAX_ARGUMENT_ARRAY_ITEMS}"
+            )
+        for index, item in enumerate(value):
+            problem = _check_value(f"{path}[{index}]", name, item)
+            if problem is not None:
+                return problem
+    return None
+
+
+def _check_object(prefix: str, arguments: dict[str, Any]) -> str | None:
+    for name in sorted(arguments):
+        path = f"{prefix}.{name}" if prefix else name
+        problem = _check_value(path, name, arguments[name])
+        if problem is not None:
+            return problem
+    return None
+
+
+def validate_argument_limits(arguments: dict[str, Any] | None) -> str | None:
+    """Return the first limit violation in arguments, or None.
+
+    Keys are walked in sorted order so the reported violation is stable.
+    """
+    if not arguments:
+        return None
+    try:
+        size = len(
+            json.dumps(arguments, separators=(",", ":"), ensure_ascii=False).encode()
+        )
+    except (TypeError, ValueError):
+        size = 0
+    if size > MAX_ARGUMENTS_BYTES:
+        return (
+            f"arguments exceed the request size limit: {size} bytes, "
+            f"at most {MAX_ARGUMENTS_BYTES}"
+        )
+    return _check_object("", arguments)
@@ -0,0 +1,93 @@
+"""Response envelope: the per-call summary every tool result carries in _meta.
+
+Mirrors Go's tools/envelope.go. The text content stays the tool's data
+payload, byte-identical to the proto contract; the envelope rides alongside
+it under ``_meta["linodemcp/envelope"]`` so partial failures (an ignored
+argument, a skipped page) reach the caller as warnings without the whole
+result turning into an error. The count is set only by list tools.
+"""
+
+from __future__ import annotations
+
+import contextvars
+from dataclasses import dataclass, field
+from typing import Any
+
+# The _meta key every tool result carries its envelope under. Namespaced so it
+# cannot collide with protocol fields such as progressToken.
+ENVELOPE_META_KEY = "linodemcp/envelope"
+
+
+@dataclass
+class _CallEnvelope:
+    """Warnings and list count gathered during one tool call. Handlers that
+    fan out with asyncio.gather share the one instance, since child tasks copy
+    the context that points at it."""
+
+    warnings: list[str] = field(default_factory=list)
+    count: int | None = None
+
+
+_envelope: contextvars.ContextVar[_CallEnvelope | None] = contextvars.ContextVar(
+    "linodemcp_envelope", default=None
+)
+
+
+def set_envelope() -> contextvars.Token[_CallEnvelope | None]:
+    """Bind a fresh envelope for the current call; returns a reset token. The
+    server dispatch binds one per call (Go's WithWarnings)."""
+    return _envelope.set(_CallEnvelope())
+
+
+def reset_envelope(token: contextvars.Token[_CallEnvelope | None]) -> None:
+    """Restore the envelope bound before the matching set_envelope."""
+    _envelope.reset(token)
+
+
+def add_warning(message: str, *args: object) -> None:
+    """Record a non-fatal problem for the current call, formatted like a log
+    message (``message % args``). A no-op outside the server's dispatch path,
+    such as a unit test that calls a handler directly, so handlers call it
+    unconditionally (Go's AddWarning)."""
+    envelope = _envelope.get()
+    if envelope is None:
+        return
+    envelope.warnings.append(message % args if args else message)
+
+
+def warnings() -> list[str]:
+    """Return a copy of the warnings recorded so far in the current call."""
+    envelope = _envelope.get()
+    return list(envelope.warnings) if envelope is not None else []
+
+
+def set_envelope_count(count: int) -> None:
+    """Record a list tool's item count on the current call's envelope."""
+    envelope = _envelope.get()
+    if envelope is not None:
+        envelope.count = count
+
+
+def envelope_count() -> int | None:
+    """Return the list count recorded on the current call's envelope, or
+    None when no list tool set one."""
+    envelope = _envelope.get()
+    return envelope.count if envelope is not None else None
+
+
+def finalize_envelope(
+    environment: str, elapsed_ms: int, correlation_id: str
+) -> dict[str, Any]:
+    """Build the envelope for the current call: the list count when a list
+    tool set one, the warnings (always an array), the environment argument as
+    passed, the elapsed wall time, and the correlation ID (Go's
+    FinalizeEnvelope). Keys follow the Go struct's field order."""
+    envelope = _envelope.get() or _CallEnvelope()
+    built: dict[str, Any] = {}
+    if envelope.count is not None:
+        built["count"] = envelope.count
+    built["warnings"] = list(envelope.warnings)
+    built["environment"] = environment
+    built["elapsed_ms"] = elapsed_ms
+    built["correlation_id"] = correlation_id
+    return built
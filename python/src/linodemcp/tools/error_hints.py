@@ -0,0 +1,87 @@
+"""Remediation hints appended to Linode API error results.
+
+Mirrors Go's tools/error_hints.go: the catalog, its order, and the hint text
+match so both servers tell an agent the same next step.
+"""
+
+from __future__ import annotations
+
+from typing import Any
+
+from mcp.types import TextContent
+
+# Prefix APIError renders with. Hints are only matched against results that
+# carry it, so a local validation message sharing a phrase is left alone.
+_LINODE_API_ERROR_MARKER = "Linode API error (status"
+
+# Python error results have no isError flag; these are the framings
+# error_response and execute_tool use for them.
+_ERROR_PREFIXES = ("Error:", "Failed to ")
+
+# Checked in order; the first entry with a fragment (case-insensitive) in the
+# error text wins.
+_ERROR_HINTS: tuple[tuple[tuple[str, ...], str], ...] = (
+    (
+        ("insufficient funds", "not enough funds", "payment method"),
+        "The account cannot be billed. Check the balance with linode_account_get, "
+        "add a card with linode_account_payment_method_create or pay with "
+        "linode_account_payment_create, or pick a smaller plan.",
+    ),
+    (
+        (
+            "sold out",
+            "not available in this region",
+            "currently unavailable",
+            "not available for this region",
+        ),
+        "That plan or service cannot be deployed there right now. Use "
+        "linode_region_availability_list or linode_account_availability_list to "
+        "find a region with capacity, or choose another plan from linode_type_list.",
+    ),
+    (
+        ("must be unique", "already in use", "already exists"),
+        "Another resource already uses that label. Pick a different label, or "
+        "find the existing resource with the matching list tool and reuse it.",
+    ),
+    (
+        ("volume is attached", "currently attached", "must be detached"),
+        "Detach the volume with linode_volume_detach, wait for linode_volume_get "
+        "to show no linode_id, then retry.",
+    ),
+    (
+        ("linode busy", "linode is busy", "another operation is in progress"),
+        "The instance is running another job. Wait for it to finish (see "
+        "linode_account_event_list), then retry.",
+    ),
+    (
+        ("must be powered off", "must be offline", "while it is running"),
+        "Power the instance off with linode_instance_shutdown, wait for status "
+        "offline, then retry.",
+    ),
+)
+
+
+def error_hint_for(text: str) -> str:
+    """Return the remediation hint for a Linode API error text, or ""."""
+    if _LINODE_API_ERROR_MARKER not in text:
+        return ""
+    lower = text.lower()
+    for fragments, hint in _ERROR_HINTS:
+        if any(fragment in lower for fragment in fragments):
+            return hint
+    return ""
+
+
+def append_error_hint(result: list[Any]) -> list[Any]:
+    """Append a "Hint:" line to an error result's text when the Linode API
+    error it reports has a catalog entry. Other results are returned as-is."""
+    for i, content in enumerate(result):
+        if not isinstance(content, TextContent):
+            continue
+        if not content.text.startswith(_ERROR_PREFIXES):
+            continue
+        hint = error_hint_for(content.text)
+        if hint:
+            hinted = TextContent(type="text", text=f"{content.text}\nHint: {hint}")
+            return [*result[:i], hinted, *result[i + 1 :]]
+    return result
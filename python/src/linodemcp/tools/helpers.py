@@ -3,16 +3,25 @@
 from __future__ import annotations
 
 import dataclasses
+import hashlib
 import ipaddress
 import json
 import keyword
 import logging
+from contextvars import ContextVar, Token
 from typing import TYPE_CHECKING, Any, TypedDict, cast
 
 import httpx
+from mcp.server.lowlevel.server import request_ctx
 from mcp.types import TextContent
 
-from linodemcp.config import EnvironmentConfig, EnvironmentNotFoundError
+from linodemcp.config import (
+    ConfigInvalidError,
+    EnvironmentConfig,
+    EnvironmentNotFoundError,
+    validate_api_version,
+    with_api_version,
+)
 from linodemcp.genpb.linode.mcp.v1 import dryrun_pb2
 from linodemcp.linode import (
     APIError,
@@ -20,6 +29,8 @@ from linodemcp.linode import (
     RetryableClient,
     RetryConfig,
 )
+from linodemcp.linode.backend import environment_transport
+from linodemcp.oauth import exchanged_token
 from linodemcp.tools.proto_response import serialize_preview_envelope
 
 if TYPE_CHECKING:
@@ -256,26 +267,116 @@ def set_live_config_source(getter: Callable[[], Config] | None) -> None:
     _live_config_source = getter
 
 
-def _resolve_config(snapshot: Config) -> Config:
+def resolve_config(snapshot: Config) -> Config:
     """Return the live config when a source is registered, else snapshot."""
     if _live_config_source is not None:
         return _live_config_source()
     return snapshot
 
 
+def local_store_environment(cfg: Config, name: str) -> str:
+    """Resolve the environment a call names to its configured name for the
+    local stores kept per environment (annotations and the offline cache), so
+    "Production" and "production" share one file. With none given it is
+    "default", or the only configured environment when there is no
+    "default"."""
+    live = resolve_config(cfg)
+    if name:
+        return live.resolve_environment_name(name)
+    try:
+        return live.resolve_environment_name("default")
+    except EnvironmentNotFoundError:
+        names = live.environment_names()
+        if len(names) == 1:
+            return names[0]
+        raise
+
+
+def local_store_partition(cfg: Config, environment: str) -> str:
+    """The name a call's data is kept under in the local stores kept per
+    environment (annotations and the availability watch), mirroring Go's
+    localStorePartition. It is the environment itself, except under
+    server.tokenPassthrough, where tenants share one deployment: there it
+    carries a hash of the caller's Linode token, so no tenant reads or
+    overwrites what another left."""
+    partition = token_partition(cfg)
+    if not partition:
+        return environment
+    return f"{environment}@{partition}"
+
+
+def token_partition(cfg: Config) -> str:
+    """The tenant a call belongs to in the server's local state (Go's
+    tokenPartition). It is empty except under server.tokenPassthrough, where
+    tenants share one deployment: there it is a hash of the caller's Linode
+    token."""
+    if not resolve_config(cfg).server.token_passthrough:
+        return ""
+    token = _passthrough_token()
+    if not token:
+        raise ValueError(_PASSTHROUGH_TOKEN_MISSING)
+    return hashlib.sha256(token.encode()).hexdigest()[:16]
+
+
+# The call's tenant and, for a passthrough call that sent no token, why it
+# has none.
+_TOKEN_PARTITION: ContextVar[tuple[str, str]] = ContextVar(
+    "linodemcp_token_partition", default=("", "")
+)
+
+
+def set_token_partition(cfg: Config) -> Token[tuple[str, str]]:
+    """Bind the call's tenant so the server's in-memory stores (summarized
+    results, two-stage plans, and node drains) keep what one tenant left out
+    of another's reach (Go's WithTokenPartition). Returns a reset token."""
+    try:
+        binding = (token_partition(cfg), "")
+    except ValueError as exc:
+        binding = ("", str(exc))
+    return _TOKEN_PARTITION.set(binding)
+
+
+def reset_token_partition(token: Token[tuple[str, str]]) -> None:
+    """Restore the tenant bound before the matching set_token_partition."""
+    _TOKEN_PARTITION.reset(token)
+
+
+def store_partition() -> str:
+    """The tenant set_token_partition bound, or "" when none is bound. Raises
+    ValueError for a passthrough call that sent no token, which has none."""
+    partition, error = _TOKEN_PARTITION.get()
+    if error:
+        raise ValueError(error)
+    return partition
+
+
+# Python error results have no isError flag; these are the framings
+# error_response and execute_tool use for them.
+_ERROR_PREFIXES = ("Error:", "Failed to ")
+
+
+def is_error_response(result: list[Any]) -> bool:
+    """Whether a handler result reports a failure (Go's IsError)."""
+    return any(
+        isinstance(item, TextContent) and item.text.startswith(_ERROR_PREFIXES)
+        for item in result
+    )
+
+
 def _retry_config_from(cfg: Config) -> RetryConfig:
     """Build a RetryConfig from the loaded resilience settings.
 
     Threads rate-limit, circuit-breaker, retry, and HTTP pool tuning through
     to the client so operator-set values take effect instead of dataclass
-    defaults. Reads through `_resolve_config` so a registered live source
+    defaults. Reads through `resolve_config` so a registered live source
     (set by main.py from the ConfigWatcher) wins over the snapshot.
     """
-    res = _resolve_config(cfg).resilience
+    res = resolve_config(cfg).resilience
     return RetryConfig(
         max_retries=res.max_retries,
         base_delay=float(res.base_retry_delay),
         max_delay=float(res.max_retry_delay),
+        max_elapsed=float(res.max_retry_elapsed),
         circuit_breaker_threshold=res.circuit_breaker_threshold,
         circuit_breaker_timeout=float(res.circuit_breaker_timeout),
         rate_limit_per_minute=res.rate_limit_per_minute,
@@ -288,10 +389,7 @@ def _retry_config_from(cfg: Config) -> RetryConfig:
 def _select_environment(cfg: Config, environment: str) -> EnvironmentConfig:
     """Select an environment from configuration."""
     if environment:
-        if environment in cfg.environments:
-            return cfg.environments[environment]
-        msg = f"environment not found: {environment}"
-        raise EnvironmentNotFoundError(msg)
+        return cfg.resolve_environment(environment)
 
     return cfg.select_environment("default")
 
@@ -303,6 +401,89 @@ def _validate_linode_config(env: EnvironmentConfig) -> None:
         raise ValueError(msg)
 
 
+# Carries the caller's own Linode token when server.tokenPassthrough is set.
+# Deliberately not Authorization, which belongs to whatever authenticates the
+# client to this server. Mirrors Go's tools.TokenHeader.
+TOKEN_HEADER = "X-Linode-Token"
+
+
+# A call under server.tokenPassthrough that carries no token. Mirrors Go's
+# tools.ErrPassthroughTokenMissing.
+_PASSTHROUGH_TOKEN_MISSING = (
+    "token passthrough is enabled: send your Linode token in the "
+    f"{TOKEN_HEADER} header"
+)
+
+
+# Lets an HTTP caller override the environment's pinned API version for one
+# call. Mirrors Go's tools.APIVersionHeader.
+API_VERSION_HEADER = "X-LinodeMCP-API-Version"
+
+
+def _api_base_url(env: EnvironmentConfig) -> str:
+    """The environment's API base URL, with the version from
+    API_VERSION_HEADER when the request carries one or the configured pin
+    otherwise (Go's apiBaseURL)."""
+    version = request_header(API_VERSION_HEADER).strip()
+    if not version:
+        return env.linode.base_url()
+    try:
+        validate_api_version(version)
+    except ConfigInvalidError as exc:
+        msg = f"{API_VERSION_HEADER} header: {exc}"
+        raise ValueError(msg) from exc
+    return with_api_version(env.linode.api_url, version)
+
+
+def _linode_credentials(cfg: Config, env: EnvironmentConfig) -> tuple[str, str]:
+    """API base URL and token for one call (Go's prepareClient).
+
+    Normally both come from the environment's config. With
+    ``server.token_passthrough`` the token is the one the client sent with
+    this request, so nothing is cached or shared across requests, and a
+    request without the header fails instead of falling back to any other
+    credential.
+    """
+    base_url = _api_base_url(env)
+    if not cfg.server.token_passthrough:
+        _validate_linode_config(env)
+        return base_url, env.linode.token
+
+    token = _passthrough_token()
+    if not token:
+        raise ValueError(_PASSTHROUGH_TOKEN_MISSING)
+    if not env.linode.api_url:
+        msg = "linode configuration is incomplete: check your API URL and token"
+        raise ValueError(msg)
+    return base_url, token
+
+
+def _passthrough_token() -> str:
+    """The Linode token for this call under server.tokenPassthrough.
+
+    A token minted by OAuth token exchange wins; otherwise it is the
+    X-Linode-Token header of the HTTP request behind this tool call.
+    """
+    return exchanged_token() or request_header(TOKEN_HEADER).strip()
+
+
+def request_header(name: str) -> str:
+    """A header of the HTTP request behind this tool call, or "".
+
+    The MCP SDK exposes the transport request through its request context
+    only while a request is being handled; stdio and in-process dispatch
+    have no HTTP request, so they yield no headers.
+    """
+    try:
+        ctx = request_ctx.get()
+    except LookupError:
+        return ""
+    headers = getattr(getattr(ctx, "request", None), "headers", None)
+    if headers is None:
+        return ""
+    return str(headers.get(name, ""))
+
+
 async def execute_tool(
     cfg: Config,
     arguments: dict[str, Any],
@@ -313,11 +494,13 @@ async def execute_tool(
     environment = arguments.get("environment", "")
     try:
         selected_env = _select_environment(cfg, environment)
-        _validate_linode_config(selected_env)
+        api_url, token = _linode_credentials(cfg, selected_env)
         async with RetryableClient(
-            selected_env.linode.api_url,
-            selected_env.linode.token,
+            api_url,
+            token,
             _retry_config_from(cfg),
+            selected_env.linode.headers,
+            environment_transport(selected_env.linode),
         ) as client:
             response = await callback(client)
             return [TextContent(type="text", text=json.dumps(response, indent=2))]
@@ -343,11 +526,13 @@ async def with_client[T](
     """
     environment = arguments.get("environment", "")
     selected_env = _select_environment(cfg, environment)
-    _validate_linode_config(selected_env)
+    api_url, token = _linode_credentials(cfg, selected_env)
     async with RetryableClient(
-        selected_env.linode.api_url,
-        selected_env.linode.token,
+        api_url,
+        token,
         _retry_config_from(cfg),
+        selected_env.linode.headers,
+        environment_transport(selected_env.linode),
     ) as client:
         return await callback(client)
 
@@ -379,11 +564,13 @@ async def execute_dry_run(
     environment = arguments.get("environment", "")
     try:
         selected_env = _select_environment(cfg, environment)
-        _validate_linode_config(selected_env)
+        api_url, token = _linode_credentials(cfg, selected_env)
         async with RetryableClient(
-            selected_env.linode.api_url,
-            selected_env.linode.token,
+            api_url,
+            token,
             _retry_config_from(cfg),
+            selected_env.linode.headers,
+            environment_transport(selected_env.linode),
         ) as client:
             current_state = await fetch_state(client)
             details: DryRunDetails = {}
@@ -427,11 +614,13 @@ async def execute_tool_list(
     environment = arguments.get("environment", "")
     try:
         selected_env = _select_environment(cfg, environment)
-        _validate_linode_config(selected_env)
+        api_url, token = _linode_credentials(cfg, selected_env)
         async with RetryableClient(
-            selected_env.linode.api_url,
-            selected_env.linode.token,
+            api_url,
+            token,
             _retry_config_from(cfg),
+            selected_env.linode.headers,
+            environment_transport(selected_env.linode),
         ) as client:
             response = await callback(client)
             return [TextContent(type="text", text=json.dumps(response, indent=2))]
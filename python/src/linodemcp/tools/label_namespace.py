@@ -0,0 +1,228 @@
+"""Per-environment label namespaces for accounts shared by several agents.
+
+An environment's ``label_namespace`` makes every top-level resource create
+prefix the label it is given with "<namespace>-", and lets list tools called
+with ``namespace_only=true`` return only resources carrying that prefix. The
+dispatch path applies both for every tool.
+
+Mirrors Go's tools/label_namespace.go.
+"""
+
+from __future__ import annotations
+
+import json
+from dataclasses import dataclass
+from typing import TYPE_CHECKING, Any, cast
+
+from mcp.types import TextContent
+
+from linodemcp.config import ConfigError
+from linodemcp.tools.envelope import add_warning, envelope_count, set_envelope_count
+from linodemcp.tools.helpers import (
+    is_error_response,
+    local_store_environment,
+    resolve_config,
+)
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+
+# Per-call argument that limits a list tool's result to resources in the
+# environment's label_namespace. The dispatch path reads it for every tool,
+# so it never appears in a tool's input schema.
+PARAM_NAMESPACE_ONLY = "namespace_only"
+
+# The create tools whose label names a top-level resource, and so gets the
+# label_namespace prefix, with the argument carrying it and the longest label
+# the API accepts (Go's namespacedCreateLabels). Sub-resource labels (a disk,
+# a config profile, a backup) and names that are not labels at all (a bucket,
+# a tag) are left as given. linode_multi_region_deploy builds each instance
+# label from label_prefix, so that is what it prefixes.
+_NAMESPACED_CREATE_LABELS: dict[str, tuple[str, int]] = {
+    "linode_instance_create": ("label", 64),
+    "linode_instance_clone": ("label", 64),
+    "linode_instance_replace": ("label", 64),
+    "linode_multi_region_deploy": ("label_prefix", 64),
+    "linode_volume_create": ("label", 32),
+    "linode_volume_clone": ("label", 32),
+    "linode_nodebalancer_create": ("label", 32),
+    "linode_firewall_create": ("label", 32),
+    "linode_lke_cluster_create": ("label", 32),
+    "linode_vpc_create": ("label", 64),
+    "linode_image_create": ("label", 50),
+    "linode_instance_to_image": ("label", 50),
+    "linode_placement_group_create": ("label", 64),
+    "linode_database_mysql_instance_create": ("label", 32),
+    "linode_database_postgresql_instance_create": ("label", 32),
+}
+
+
+@dataclass(frozen=True)
+class LabelNamespace:
+    """The label_namespace handling resolved for one call. ``filter`` is set
+    when a list tool was called with namespace_only=true in an environment
+    that has a namespace."""
+
+    prefix: str = ""
+    filter: bool = False
+
+    def list_prefix(self) -> str:
+        """The label prefix the call's list reads keep, or "" when the call
+        did not ask for namespace_only."""
+        return self.prefix if self.filter else ""
+
+
+def _label_namespace_prefix(cfg: Config, name: str) -> str:
+    """The label prefix of the named environment, or "" when it has none or
+    cannot be resolved (the handler reports that)."""
+    try:
+        resolved = local_store_environment(cfg, name)
+    except ConfigError:
+        return ""
+    env = resolve_config(cfg).environments.get(resolved)
+    return env.label_namespace_prefix() if env is not None else ""
+
+
+def _environment_prefix(cfg: Config, arguments: dict[str, Any]) -> str:
+    """The label prefix of the environment the call names."""
+    environment = arguments.get("environment")
+    return _label_namespace_prefix(
+        cfg, environment if isinstance(environment, str) else ""
+    )
+
+
+def _namespaced_label(
+    prefix: str, tool_name: str, arguments: dict[str, Any]
+) -> tuple[str, str, int] | None:
+    """The argument carrying a top-level resource create's label, the label
+    it sends with prefix added, and the longest label the API accepts for it;
+    None when the call is not such a create, has no label, or its label
+    already carries the prefix."""
+    create = _NAMESPACED_CREATE_LABELS.get(tool_name)
+    if not prefix or create is None:
+        return None
+    param, limit = create
+    label = arguments.get(param)
+    if not isinstance(label, str) or not label or label.startswith(prefix):
+        return None
+    return param, prefix + label, limit
+
+
+def resolve_label_namespace(
+    cfg: Config, tool_name: str, arguments: dict[str, Any]
+) -> LabelNamespace:
+    """Look up the label_namespace of the environment the call names. The
+    label argument of a top-level resource create is prefixed with it in
+    place, before the handler (and any dry-run preview) sees it; a label that
+    already carries the prefix is left alone, and a create without a label is
+    left to the API. A prefixed label longer than the API accepts is left
+    unprefixed for validate_label_namespace to reject. namespace_only on a
+    tool that is not a list tool, or in an environment without a namespace,
+    is ignored with a warning."""
+    raw = arguments.get(PARAM_NAMESPACE_ONLY)
+    only = raw is True
+    if raw is not None and not isinstance(raw, bool):
+        add_warning("%s must be a boolean and was ignored", PARAM_NAMESPACE_ONLY)
+
+    prefix = _environment_prefix(cfg, arguments)
+
+    if only and not tool_name.endswith("_list"):
+        add_warning(
+            "%s only applies to list tools and was ignored", PARAM_NAMESPACE_ONLY
+        )
+        only = False
+    elif only and not prefix:
+        add_warning(
+            "%s was ignored: the environment has no label_namespace",
+            PARAM_NAMESPACE_ONLY,
+        )
+        only = False
+
+    if not prefix:
+        return LabelNamespace()
+
+    namespaced = _namespaced_label(prefix, tool_name, arguments)
+    if namespaced is not None and len(namespaced[1]) <= namespaced[2]:
+        arguments[namespaced[0]] = namespaced[1]
+
+    return LabelNamespace(prefix=prefix, filter=only)
+
+
+def validate_label_namespace(
+    cfg: Config, tool_name: str, arguments: dict[str, Any]
+) -> str | None:
+    """Return why a create's label is too long once the label_namespace
+    prefix is added, or None. The call then fails naming the namespace
+    instead of the API rejecting a label the caller never wrote (Go's
+    ErrLabelNamespaceTooLong)."""
+    namespaced = _namespaced_label(
+        _environment_prefix(cfg, arguments), tool_name, arguments
+    )
+    if namespaced is None or len(namespaced[1]) <= namespaced[2]:
+        return None
+    _, label, limit = namespaced
+    return (
+        "label is too long with the label_namespace prefix: "
+        f"{json.dumps(label, ensure_ascii=False)} is {len(label)} characters, "
+        f"and {tool_name} accepts at most {limit}"
+    )
+
+
+def _filter_labeled_items(
+    value: Any, prefix: str
+) -> tuple[list[Any], list[Any]] | None:
+    """The items of a non-empty array whose every item is an object with a
+    string label, and those whose label carries prefix; None otherwise."""
+    if not isinstance(value, list) or not value:
+        return None
+    items = cast("list[Any]", value)
+    kept: list[Any] = []
+    for item in items:
+        if not isinstance(item, dict):
+            return None
+        label = cast("dict[str, Any]", item).get("label")
+        if not isinstance(label, str):
+            return None
+        if label.startswith(prefix):
+            kept.append(item)
+    return items, kept
+
+
+def apply_label_namespace(
+    result: list[Any], namespace: LabelNamespace
+) -> list[Any]:
+    """Drop the resources outside the namespace from a list result when the
+    call asked for namespace_only. Every top-level array whose items all
+    carry a label is filtered, and a top-level count and envelope count that
+    counted such an array are updated. The client's page walk already drops
+    most of them (see linode/label_prefix.py); this catches lists read some
+    other way."""
+    if not namespace.filter or is_error_response(result):
+        return result
+    out: list[Any] = []
+    for content in result:
+        text = content.text.strip() if isinstance(content, TextContent) else ""
+        if not text.startswith("{"):
+            out.append(content)
+            continue
+        try:
+            data = cast("dict[str, Any]", json.loads(text))
+        except ValueError:
+            out.append(content)
+            continue
+        before = after = -1
+        for key, value in data.items():
+            filtered = _filter_labeled_items(value, namespace.prefix)
+            if filtered is None:
+                continue
+            items, kept = filtered
+            data[key] = kept
+            before, after = len(items), len(kept)
+        count = data.get("count")
+        if before >= 0 and not isinstance(count, bool) and count == before:
+            data["count"] = after
+        if before >= 0 and envelope_count() == before:
+            set_envelope_count(after)
+        text = json.dumps(data, indent=2, ensure_ascii=False)
+        out.append(TextContent(type="text", text=text))
+    return out
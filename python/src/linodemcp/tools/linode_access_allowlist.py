@@ -0,0 +1,399 @@
+"""linode_access_allowlist_update: one CIDR allowlist for LKE ACLs and firewalls.
+
+Mirrors ``go/internal/tools/linode_access_allowlist.go``.
+"""
+
+from __future__ import annotations
+
+import ipaddress
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import access_allowlist_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import Firewall, FirewallRule, RetryableClient
+
+_TARGET_LKE_ACL = "lke_acl"
+_TARGET_FIREWALL_RULE = "firewall_rule"
+
+_APPLIED = "applied"
+_PARTIAL = "partial"
+
+# The inbound rule label that marks a firewall's managed allowlist when the
+# caller names none. Mirrors Go's allowlistDefaultRuleLabel.
+_DEFAULT_RULE_LABEL = "allowlist"
+
+
+def create_linode_access_allowlist_update_tool() -> tuple[Tool, Capability]:
+    """Create the linode_access_allowlist_update tool."""
+    return Tool(
+        name="linode_access_allowlist_update",
+        description=(
+            "Sets one IP allowlist (cidrs) everywhere it is managed: the "
+            "control-plane ACL of each LKE cluster in cluster_ids (the ACL is "
+            "enabled), and the inbound rule labeled rule_label (default "
+            '"allowlist") on every Cloud Firewall tagged firewall_tag. The cidrs '
+            "replace each target's addresses; targets already matching are left "
+            "alone, and every change is reported with its prior addresses. Pass "
+            "dry_run=true to preview the changes without updating."
+        ),
+        inputSchema=schema("linode.mcp.v1.AccessAllowlistUpdateInput"),
+    ), Capability.Write
+
+
+def _parse_cidr(
+    raw: str,
+) -> tuple[ipaddress.IPv4Network | ipaddress.IPv6Network | None, str]:
+    """Parse a CIDR or bare address (a single host), rejecting host bits."""
+    raw = raw.strip()
+    try:
+        return ipaddress.ip_network(raw, strict=True), ""
+    except ValueError:
+        pass
+    try:
+        masked = ipaddress.ip_network(raw, strict=False)
+    except ValueError:
+        return None, "is not a valid IP address or CIDR"
+    return None, f"has host bits set; use {masked}"
+
+
+def _canonical_address(raw: str) -> str:
+    """Normalize a stored address so "10.0.0.1" and "10.0.0.1/32" match."""
+    network, _ = _parse_cidr(raw)
+    return str(network) if network is not None else raw
+
+
+def _same_allowlist(current: list[str], wanted: list[str]) -> bool:
+    """Whether current holds exactly the wanted addresses, in any order."""
+    return {_canonical_address(address) for address in current} == set(wanted)
+
+
+def _parse_args(arguments: dict[str, Any]) -> tuple[dict[str, Any], str]:
+    """Validate the input; mirrors Go's parseAccessAllowlistArgs."""
+    raw_cidrs = arguments.get("cidrs")
+    cidrs = (
+        [item for item in raw_cidrs if isinstance(item, str)]
+        if isinstance(raw_cidrs, list)
+        else []
+    )
+    if not cidrs:
+        return {}, "cidrs is required"
+
+    ipv4: list[str] = []
+    ipv6: list[str] = []
+    for idx, raw in enumerate(cidrs):
+        network, msg = _parse_cidr(raw)
+        if network is None:
+            return {}, f'cidrs[{idx}] "{raw}" {msg}'
+        family = ipv4 if network.version == 4 else ipv6  # noqa: PLR2004
+        if str(network) not in family:
+            family.append(str(network))
+
+    raw_ids = arguments.get("cluster_ids")
+    cluster_ids = (
+        [int(item) for item in raw_ids if isinstance(item, int | float)]
+        if isinstance(raw_ids, list)
+        else []
+    )
+    firewall_tag = str(arguments.get("firewall_tag") or "").strip()
+    if not cluster_ids and not firewall_tag:
+        return {}, "cluster_ids or firewall_tag is required"
+    for idx, cluster_id in enumerate(cluster_ids):
+        if cluster_id <= 0:
+            return {}, f"cluster_ids[{idx}] must be a positive integer"
+
+    return {
+        "ipv4": ipv4,
+        "ipv6": ipv6,
+        "addresses": ipv4 + ipv6,
+        "cluster_ids": cluster_ids,
+        "firewall_tag": firewall_tag,
+        "rule_label": str(arguments.get("rule_label") or "").strip()
+        or _DEFAULT_RULE_LABEL,
+    }, ""
+
+
+async def _plan(
+    client: RetryableClient, args: dict[str, Any]
+) -> tuple[list[dict[str, Any]], list[str]]:
+    """Resolve the targets and compare each against the allowlist.
+
+    Each target is {"change": ..., "firewall": ..., "rule_index": ...}; the
+    last two locate a firewall_rule target's managed rule. Mirrors Go's
+    planAccessAllowlist.
+    """
+    targets: list[dict[str, Any]] = []
+    warnings: list[str] = []
+    wanted = args["addresses"]
+
+    if args["cluster_ids"]:
+        labels = {
+            int(cluster.get("id", 0)): str(cluster.get("label", ""))
+            for cluster in await client.list_lke_clusters()
+        }
+        for cluster_id in args["cluster_ids"]:
+            if cluster_id not in labels:
+                msg = f"no LKE cluster with that ID: {cluster_id}"
+                raise ValueError(msg)
+        for cluster_id in args["cluster_ids"]:
+            label = labels[cluster_id]
+            acl = await client.get_lke_control_plane_acl(cluster_id)
+            addresses = acl.get("addresses") or {}
+            current = list(addresses.get("ipv4") or []) + list(
+                addresses.get("ipv6") or []
+            )
+            enabled = bool(acl.get("enabled"))
+            if not enabled:
+                warnings.append(
+                    f"The control-plane ACL of LKE cluster {cluster_id} ({label}) "
+                    "is disabled; updating it enables it, so only cidrs can reach "
+                    "the Kubernetes API."
+                )
+            targets.append(
+                {
+                    "change": {
+                        "target": _TARGET_LKE_ACL,
+                        "id": cluster_id,
+                        "label": label,
+                        "old_addresses": current,
+                        "new_addresses": wanted,
+                        "changed": not enabled
+                        or not _same_allowlist(current, wanted),
+                    }
+                }
+            )
+
+    if args["firewall_tag"]:
+        firewall_targets, firewall_warnings = _plan_firewalls(
+            await client.list_firewalls(), args
+        )
+        targets.extend(firewall_targets)
+        warnings.extend(firewall_warnings)
+
+    return targets, warnings
+
+
+def _plan_firewalls(
+    firewalls: list[Firewall], args: dict[str, Any]
+) -> tuple[list[dict[str, Any]], list[str]]:
+    """Find the managed rule on every firewall carrying the tag."""
+    targets: list[dict[str, Any]] = []
+    warnings: list[str] = []
+    tag = args["firewall_tag"].lower()
+    rule_label = args["rule_label"]
+    tagged = 0
+    for firewall in firewalls:
+        if tag not in (t.lower() for t in firewall.tags):
+            continue
+        tagged += 1
+        matches = [
+            idx
+            for idx, rule in enumerate(firewall.rules.inbound)
+            if rule.label == rule_label
+        ]
+        if len(matches) != 1:
+            warnings.append(
+                f"Firewall {firewall.id} ({firewall.label}) has {len(matches)} "
+                f'inbound rules labeled "{rule_label}", want exactly 1; skipped.'
+            )
+            continue
+        rule = firewall.rules.inbound[matches[0]]
+        current = list(rule.addresses.ipv4) + list(rule.addresses.ipv6)
+        targets.append(
+            {
+                "change": {
+                    "target": _TARGET_FIREWALL_RULE,
+                    "id": firewall.id,
+                    "label": firewall.label,
+                    "old_addresses": current,
+                    "new_addresses": args["addresses"],
+                    "changed": not _same_allowlist(current, args["addresses"]),
+                },
+                "firewall": firewall,
+                "rule_index": matches[0],
+            }
+        )
+    if tagged == 0:
+        warnings.append(f'No firewall is tagged "{args["firewall_tag"]}".')
+    return targets, warnings
+
+
+def _target_name(change: dict[str, Any]) -> str:
+    if change["target"] == _TARGET_LKE_ACL:
+        return (
+            f"LKE cluster {change['id']} ({change['label']}) control-plane ACL"
+        )
+    return f"Firewall {change['id']} ({change['label']}) allowlist rule"
+
+
+def _side_effects(changes: list[dict[str, Any]]) -> list[str]:
+    """Tier B walk: one line per changing target, then the unchanged count."""
+    side_effects = [
+        f"{_target_name(change)} addresses change from "
+        f"[{', '.join(change['old_addresses']) or '(none)'}] to "
+        f"[{', '.join(change['new_addresses'])}]."
+        for change in changes
+        if change["changed"]
+    ]
+    unchanged = sum(1 for change in changes if not change["changed"])
+    side_effects.append(
+        f"{unchanged} target(s) already match and are left alone."
+    )
+    return side_effects
+
+
+def _path(args: dict[str, Any]) -> str:
+    """The would-execute path for the dry-run preview."""
+    paths: list[str] = []
+    if args["cluster_ids"]:
+        paths.append("/lke/clusters/{cluster_id}/control_plane_acl")
+    if args["firewall_tag"]:
+        paths.append("/networking/firewalls/{firewall_id}/rules")
+    return " and ".join(paths)
+
+
+def _rule_dict(rule: FirewallRule, ipv4: list[str], ipv6: list[str]) -> dict[str, Any]:
+    """A rule in the replace-request wire form; mirrors Go's allowlistRuleMap."""
+    addresses: dict[str, Any] = {}
+    if ipv4:
+        addresses["ipv4"] = ipv4
+    if ipv6:
+        addresses["ipv6"] = ipv6
+    out: dict[str, Any] = {
+        "action": rule.action,
+        "protocol": rule.protocol,
+        "addresses": addresses,
+        "label": rule.label,
+    }
+    if rule.ports:
+        out["ports"] = rule.ports
+    if rule.description:
+        out["description"] = rule.description
+    return out
+
+
+async def _apply(
+    client: RetryableClient, args: dict[str, Any], targets: list[dict[str, Any]]
+) -> dict[str, Any]:
+    """Update every changed target; a failure is recorded, not rolled back."""
+    response: dict[str, Any] = {"status": _APPLIED, "changes": []}
+    updated = failed = 0
+    for target in targets:
+        change = target["change"]
+        response["changes"].append(change)
+        if not change["changed"]:
+            continue
+        try:
+            if change["target"] == _TARGET_LKE_ACL:
+                await client.update_lke_control_plane_acl(
+                    change["id"],
+                    {
+                        "enabled": True,
+                        "addresses": {"ipv4": args["ipv4"], "ipv6": args["ipv6"]},
+                    },
+                )
+            else:
+                rules = target["firewall"].rules
+                inbound = [
+                    _rule_dict(rule, args["ipv4"], args["ipv6"])
+                    if idx == target["rule_index"]
+                    else _rule_dict(
+                        rule, list(rule.addresses.ipv4), list(rule.addresses.ipv6)
+                    )
+                    for idx, rule in enumerate(rules.inbound)
+                ]
+                outbound = [
+                    _rule_dict(
+                        rule, list(rule.addresses.ipv4), list(rule.addresses.ipv6)
+                    )
+                    for rule in rules.outbound
+                ]
+                await client.update_firewall_rules_raw(change["id"], inbound, outbound)
+        except (APIError, NetworkError) as exc:
+            change["error"] = str(exc)
+            failed += 1
+            continue
+        updated += 1
+
+    unchanged = len(targets) - updated - failed
+    response["message"] = f"Updated {updated} target(s); {unchanged} already matched."
+    if failed:
+        response["status"] = _PARTIAL
+        response["message"] = (
+            f"Updated {updated} target(s); {unchanged} already matched; {failed} "
+            "failed and keep their previous addresses, see changes[].error."
+        )
+        add_warning(response["message"])
+    return response
+
+
+async def handle_linode_access_allowlist_update(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_access_allowlist_update tool request."""
+    if is_dry_run(arguments):
+        args, args_error = _parse_args(arguments)
+        if args_error:
+            return error_response(args_error)
+        warnings: list[str] = []
+
+        async def _fetch(client: RetryableClient) -> Any:
+            targets, plan_warnings = await _plan(client, args)
+            warnings.extend(plan_warnings)
+            return [target["change"] for target in targets]
+
+        async def _walk(_client: RetryableClient, state: Any) -> DryRunDetails:
+            return {"side_effects": _side_effects(state), "warnings": warnings}
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_access_allowlist_update",
+            "PUT",
+            _path(args),
+            _fetch,
+            _walk,
+        )
+
+    if not arguments.get("confirm"):
+        return error_response(
+            "This replaces the allowlist on LKE control planes and firewalls. "
+            "Set confirm=true to proceed."
+        )
+
+    args, args_error = _parse_args(arguments)
+    if args_error:
+        return error_response(args_error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        targets, warnings = await _plan(client, args)
+        if not targets:
+            msg = (
+                "no LKE cluster or tagged firewall to update; "
+                "see firewall_tag and rule_label"
+            )
+            raise ValueError(msg)
+        for warning in warnings:
+            add_warning(warning)
+        response = await _apply(client, args, targets)
+        response["warnings"] = warnings
+        return serialize_api_response(
+            response, access_allowlist_pb2.AccessAllowlistUpdateResponse()
+        )
+
+    return await execute_tool(cfg, arguments, "resolve allowlist targets", _call)
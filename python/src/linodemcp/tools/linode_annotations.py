@@ -0,0 +1,216 @@
+"""Resource annotation tools.
+
+``linode_annotations_set`` stores a key-value note on an instance or volume
+and ``linode_annotations_get`` reads it back. Notes live in a local file per
+environment, never on the resource, so both tools are CapMeta.
+
+Mirrors ``go/internal/tools/linode_annotations.go``.
+"""
+
+from __future__ import annotations
+
+import json
+from pathlib import Path
+from typing import Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.annotations import (
+    AnnotationsError,
+    Entry,
+    Ref,
+    Store,
+    parse_ref,
+    validate_key,
+    validate_value,
+)
+from linodemcp.audit import resolve_default_audit_dir
+from linodemcp.config import Config, EnvironmentNotFoundError
+from linodemcp.genpb.linode.mcp.v1 import annotation_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import (
+    error_response,
+    local_store_environment,
+    local_store_partition,
+    required_int_id,
+    resolve_config,
+)
+from linodemcp.tools.proto_enum import optional_enum_error, required_enum_error
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+
+def create_linode_annotations_set_tool() -> tuple[Tool, Capability]:
+    """Create the linode_annotations_set tool."""
+    return (
+        Tool(
+            name="linode_annotations_set",
+            description=(
+                "Stores a key-value annotation on an instance or volume (e.g. "
+                "key=resize-reason, value='resized 2024-06-01 due to OOM') so "
+                "later sessions can read it back with linode_annotations_get. "
+                "Annotations are kept locally per environment and never change "
+                "the resource. An empty value removes the annotation."
+            ),
+            inputSchema=schema("linode.mcp.v1.AnnotationsSetInput"),
+        ),
+        Capability.Meta,
+    )
+
+
+def create_linode_annotations_get_tool() -> tuple[Tool, Capability]:
+    """Create the linode_annotations_get tool."""
+    return (
+        Tool(
+            name="linode_annotations_get",
+            description=(
+                "Reads annotations stored with linode_annotations_set. With "
+                "resource_type and resource_id returns that resource's "
+                "annotations; without them, every annotated resource in the "
+                "environment. Optionally filter by key."
+            ),
+            inputSchema=schema("linode.mcp.v1.AnnotationsGetInput"),
+        ),
+        Capability.Meta,
+    )
+
+
+def _annotations_store(cfg: Config) -> Store:
+    """Open the configured annotations directory, defaulting to "annotations"
+    under the audit log directory."""
+    directory = resolve_config(cfg).annotations.dir
+    if not directory:
+        directory = str(Path(resolve_default_audit_dir()) / "annotations")
+    return Store(directory)
+
+
+def _resource_annotations(
+    ref: Ref, entries: dict[str, Entry], only_key: str = ""
+) -> dict[str, Any]:
+    """Convert one resource's entries, sorted by key and limited to only_key
+    when it is set."""
+    return {
+        "resource_type": ref.type,
+        "resource_id": ref.id,
+        "annotations": [
+            {"key": key, "value": entry.value, "updated_at": entry.updated_at}
+            for key, entry in sorted(entries.items())
+            if not only_key or key == only_key
+        ],
+    }
+
+
+async def handle_linode_annotations_set(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_annotations_set tool request."""
+    type_error = required_enum_error(
+        arguments, "resource_type", annotation_pb2.AnnotationResourceType.Value
+    )
+    if type_error:
+        return error_response(type_error)
+    resource_type = str(arguments["resource_type"])
+
+    resource_id, id_error = required_int_id(arguments, "resource_id")
+    if resource_id is None:
+        return error_response(id_error)
+
+    key = str(arguments.get("key") or "")
+    if not key:
+        return error_response("key is required")
+    try:
+        validate_key(key)
+    except AnnotationsError as exc:
+        return error_response(str(exc))
+    if "value" not in arguments:
+        return error_response(
+            "value is required (pass an empty value to remove the annotation)"
+        )
+    value = str(arguments.get("value") or "")
+
+    try:
+        validate_value(value)
+        environment = local_store_environment(
+            cfg, str(arguments.get("environment") or "")
+        )
+        partition = local_store_partition(cfg, environment)
+    except (AnnotationsError, EnvironmentNotFoundError, ValueError) as exc:
+        return error_response(str(exc))
+
+    ref = Ref(type=resource_type, id=resource_id)
+    try:
+        entries = _annotations_store(cfg).set(partition, ref, key, value)
+    except (AnnotationsError, OSError) as exc:
+        return error_response(f"Failed to set annotation: {exc}")
+
+    if value:
+        message = f"Annotation '{key}' set on {resource_type} {resource_id}"
+    else:
+        message = f"Annotation '{key}' removed from {resource_type} {resource_id}"
+
+    result = serialize_api_response(
+        {
+            "message": message,
+            "environment": environment,
+            "resource": _resource_annotations(ref, entries),
+        },
+        annotation_pb2.AnnotationsSetResponse(),
+    )
+    return [TextContent(type="text", text=json.dumps(result, indent=2))]
+
+
+async def handle_linode_annotations_get(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_annotations_get tool request."""
+    type_error = optional_enum_error(
+        arguments, "resource_type", annotation_pb2.AnnotationResourceType.Value
+    )
+    if type_error:
+        return error_response(type_error)
+    resource_type = str(arguments.get("resource_type") or "")
+
+    resource_id = 0
+    if "resource_id" in arguments:
+        if not resource_type:
+            return error_response("resource_id requires resource_type")
+        parsed_id, id_error = required_int_id(arguments, "resource_id")
+        if parsed_id is None:
+            return error_response(id_error)
+        resource_id = parsed_id
+
+    key = str(arguments.get("key") or "")
+
+    try:
+        environment = local_store_environment(
+            cfg, str(arguments.get("environment") or "")
+        )
+        partition = local_store_partition(cfg, environment)
+    except (EnvironmentNotFoundError, ValueError) as exc:
+        return error_response(str(exc))
+
+    try:
+        stored = _annotations_store(cfg).get(partition)
+    except (AnnotationsError, OSError) as exc:
+        return error_response(f"Failed to read annotations: {exc}")
+
+    refs = [
+        ref
+        for ref in (parse_ref(ref_key) for ref_key in stored)
+        if ref is not None
+        and (not resource_type or ref.type == resource_type)
+        and (not resource_id or ref.id == resource_id)
+    ]
+    refs.sort(key=lambda ref: (ref.type, ref.id))
+
+    resources: list[dict[str, Any]] = []
+    for ref in refs:
+        resource = _resource_annotations(ref, stored[str(ref)], key)
+        if resource["annotations"]:
+            resources.append(resource)
+
+    result = serialize_api_response(
+        {"environment": environment, "count": len(resources), "resources": resources},
+        annotation_pb2.AnnotationsGetResponse(),
+    )
+    return [TextContent(type="text", text=json.dumps(result, indent=2))]
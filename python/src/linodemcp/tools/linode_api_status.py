@@ -0,0 +1,244 @@
+"""Linode status page / API error correlation tool.
+
+``linode_api_status`` reads the Linode status page (``status_page.url``) and
+lines its open incidents up with the Linode API requests this server saw fail
+recently (no response, 429, or 5xx), grouped by product. The failure record
+is the server's in-memory APIErrorLog; a restart clears it. CapMeta, so every
+profile can read it.
+
+Mirrors ``go/internal/tools/linode_api_status.go``.
+"""
+
+from __future__ import annotations
+
+import json
+import re
+from dataclasses import dataclass
+from datetime import UTC, datetime, timedelta
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.config import DEFAULT_STATUS_PAGE_URL
+from linodemcp.genpb.linode.mcp.v1 import api_status_pb2
+from linodemcp.linode.api_errors import get_api_error_log
+from linodemcp.profiles import Capability
+from linodemcp.statuspage import StatusPageError, fetch
+from linodemcp.tools.helpers import (
+    error_response,
+    pagination_int_argument,
+    resolve_config,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+from linodemcp.version import get_version_info
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode.api_errors import APIErrorRecord
+    from linodemcp.statuspage import Incident
+
+_DEFAULT_WINDOW = 15
+_MAX_WINDOW = 1440
+_MAX_ENDPOINTS = 3
+_OTHER_PRODUCT = "Other"
+
+
+@dataclass(frozen=True)
+class _Product:
+    """An API path prefix and the words the status page uses for it."""
+
+    name: str
+    prefix: str
+    keywords: tuple[str, ...]
+
+
+# The first matching prefix wins, so the narrower /networking/firewalls comes
+# before /networking.
+_PRODUCTS = (
+    _Product("Object Storage", "/object-storage", ("object storage",)),
+    _Product("LKE", "/lke", ("kubernetes", "lke")),
+    _Product("NodeBalancers", "/nodebalancers", ("nodebalancer",)),
+    _Product("DNS", "/domains", ("dns", "domain")),
+    _Product("Managed Databases", "/databases", ("database",)),
+    _Product("Block Storage", "/volumes", ("block storage", "volume")),
+    _Product("Cloud Firewall", "/networking/firewalls", ("firewall",)),
+    _Product("Networking", "/networking", ("network",)),
+    _Product("VPC", "/vpcs", ("vpc",)),
+    _Product("Images", "/images", ("image",)),
+    _Product("Compute", "/linode", ("compute", "linodes", "backup")),
+    _Product("Monitor", "/monitor", ("monitor", "longview")),
+)
+
+# An incident about the API itself, which every failed request may be
+# explained by.
+_API_WORD = re.compile(r"\bapi\b", re.IGNORECASE)
+
+
+def create_linode_api_status_tool() -> tuple[Tool, Capability]:
+    """Build the ``linode_api_status`` MCP tool definition."""
+    return (
+        Tool(
+            name="linode_api_status",
+            description=(
+                "Reads the Linode status page (status_page.url) and lines its "
+                "open incidents up with the Linode API requests this server saw "
+                "fail recently (no response, 429, or 5xx), grouped by product, "
+                "so a run of failures can be put down to an incident instead of "
+                "retried blindly. The failure record lives in server memory; a "
+                "restart clears it."
+            ),
+            inputSchema=schema("linode.mcp.v1.APIStatusInput"),
+        ),
+        Capability.Meta,
+    )
+
+
+def _product_for(endpoint: str) -> str:
+    """Name the API area a request path belongs to."""
+    for product in _PRODUCTS:
+        if endpoint == product.prefix or endpoint.startswith(product.prefix + "/"):
+            return product.name
+    return _OTHER_PRODUCT
+
+
+def _incident_dict(incident: Incident) -> dict[str, Any]:
+    """Map an incident onto the wire, naming the API areas its name and
+    components mention. An incident about the API itself covers every area,
+    Other included."""
+    text = "\n".join([incident.name, *incident.components]).lower()
+    everything = _API_WORD.search(text) is not None
+    products = [
+        p.name
+        for p in _PRODUCTS
+        if everything or any(keyword in text for keyword in p.keywords)
+    ]
+    if everything:
+        products.append(_OTHER_PRODUCT)
+
+    entry: dict[str, Any] = {
+        "name": incident.name,
+        "status": incident.status,
+        "impact": incident.impact,
+        "url": incident.short_link,
+        "created_at": incident.created_at,
+        "updated_at": incident.updated_at,
+        "components": incident.components,
+        "products": products,
+        "matched_errors": 0,
+    }
+    if incident.latest_update:
+        entry["latest_update"] = incident.latest_update
+    return entry
+
+
+def _error_groups(records: list[APIErrorRecord]) -> list[dict[str, Any]]:
+    """Group failed requests by API area, busiest first."""
+    groups: dict[str, dict[str, Any]] = {}
+    for record in records:
+        name = _product_for(record.endpoint)
+        group = groups.setdefault(
+            name,
+            {"product": name, "count": 0, "statuses": [], "endpoints": []},
+        )
+        group["count"] += 1
+        group["last_at"] = record.at.astimezone(UTC).strftime("%Y-%m-%dT%H:%M:%SZ")
+        if record.status not in group["statuses"]:
+            group["statuses"].append(record.status)
+        endpoint = f"{record.method} {record.endpoint}"
+        if (
+            len(group["endpoints"]) < _MAX_ENDPOINTS
+            and endpoint not in group["endpoints"]
+        ):
+            group["endpoints"].append(endpoint)
+    return sorted(groups.values(), key=lambda g: -g["count"])
+
+
+def _message(payload: dict[str, Any], matched: int, window: int) -> str:
+    total = payload["recent_errors"]
+    incidents = payload["incidents"]
+    description = payload.get("description", "")
+    names = "; ".join(f"{i['name']} ({i['impact']})" for i in incidents)
+
+    if not incidents and total == 0:
+        return (
+            f"Linode reports {description} with no open incidents, and this "
+            f"server saw no failed API requests in the last {window} minutes"
+        )
+    if not incidents:
+        return (
+            f"Linode reports {description} with no open incidents, so the "
+            f"{total} failed API requests in the last {window} minutes are not "
+            "explained by an outage"
+        )
+    if matched > 0:
+        return (
+            f"{matched} of {total} failed API requests in the last {window} "
+            f"minutes match an open Linode incident: {names}"
+        )
+    if total == 0:
+        return (
+            f"Linode reports {len(incidents)} open incidents, and this server "
+            f"saw no failed API requests in the last {window} minutes: {names}"
+        )
+    return (
+        f"Linode reports {len(incidents)} open incidents, none matching the "
+        f"{total} failed API requests in the last {window} minutes: {names}"
+    )
+
+
+def _response(payload: dict[str, Any]) -> list[TextContent]:
+    body = serialize_api_response(payload, api_status_pb2.APIStatusResponse())
+    return [TextContent(type="text", text=json.dumps(body, indent=2))]
+
+
+async def handle_linode_api_status(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Correlate open status page incidents with recent failed API requests."""
+    try:
+        window = pagination_int_argument(arguments, "window_minutes", 1, _MAX_WINDOW)
+    except (TypeError, ValueError) as exc:
+        return error_response(str(exc))
+    if window is None:
+        window = _DEFAULT_WINDOW
+
+    log = get_api_error_log()
+    records = (
+        log.since(datetime.now(UTC) - timedelta(minutes=window))
+        if log is not None
+        else []
+    )
+
+    payload: dict[str, Any] = {
+        "window_minutes": window,
+        "recent_errors": len(records),
+        "error_groups": _error_groups(records),
+        "incidents": [],
+    }
+
+    live = resolve_config(cfg)
+    url = live.status_page.url or DEFAULT_STATUS_PAGE_URL
+    try:
+        summary = await fetch(url, f"LinodeMCP/{get_version_info().version}")
+    except StatusPageError as exc:
+        payload["status_error"] = str(exc)
+        payload["message"] = (
+            f"Could not read the Linode status page ({exc}); this server saw "
+            f"{len(records)} failed API requests in the last {window} minutes"
+        )
+        return _response(payload)
+
+    payload["indicator"] = summary.indicator
+    payload["description"] = summary.description
+    matched: set[int] = set()
+    for incident in summary.incidents:
+        entry = _incident_dict(incident)
+        for idx, record in enumerate(records):
+            if _product_for(record.endpoint) in entry["products"]:
+                entry["matched_errors"] += 1
+                matched.add(idx)
+        payload["incidents"].append(entry)
+
+    payload["message"] = _message(payload, len(matched), window)
+    return _response(payload)
@@ -0,0 +1,205 @@
+"""Linode plan availability watch tool.
+
+Mirrors ``go/internal/tools/linode_availability_watch.go``.
+"""
+
+from __future__ import annotations
+
+import re
+from contextvars import ContextVar, Token
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.availwatch import Key, Store
+from linodemcp.config import EnvironmentNotFoundError
+from linodemcp.genpb.linode.mcp.v1 import availability_watch_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import (
+    error_response,
+    execute_tool,
+    local_store_environment,
+    local_store_partition,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from datetime import datetime
+
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_STATUS_AVAILABLE = "available"
+_STATUS_SOLD_OUT = "sold_out"
+_STATUS_UNLISTED = "unlisted"
+_MAX_PLANS = 25
+_SLUG = re.compile(r"^[a-z0-9][a-z0-9-]*[a-z0-9]$")
+
+_AVAILABILITY_WATCH: ContextVar[Store | None] = ContextVar(
+    "linodemcp_availability_watch", default=None
+)
+
+
+def set_availability_watch(store: Store | None) -> Token[Store | None]:
+    """Publish the server's watch store, returning a token that resets it."""
+    return _AVAILABILITY_WATCH.set(store)
+
+
+def reset_availability_watch(token: Token[Store | None]) -> None:
+    """Restore the watch store the matching set_availability_watch call
+    replaced."""
+    _AVAILABILITY_WATCH.reset(token)
+
+
+def availability_watch_from_context() -> Store | None:
+    """Return the watch store the server published, or None when unset."""
+    return _AVAILABILITY_WATCH.get()
+
+
+def create_linode_availability_watch_tool() -> tuple[Tool, Capability]:
+    """Create the linode_availability_watch tool."""
+    return Tool(
+        name="linode_availability_watch",
+        description=(
+            "Watches whether plans (for example premium or GPU types) are sold "
+            "out in a region: records each plan's current status and, on later "
+            "calls with the same region and plans, reports which changed and "
+            "since when. Observations live in server memory; a restart starts "
+            "the watch over."
+        ),
+        inputSchema=schema("linode.mcp.v1.AvailabilityWatchInput"),
+    ), Capability.Read
+
+
+def _plans_from_arguments(arguments: dict[str, Any]) -> tuple[list[str], str]:
+    """Read the plans to watch, dropping repeats while keeping their order."""
+    raw = arguments.get("plans")
+    items = (
+        [item for item in raw if isinstance(item, str)]
+        if isinstance(raw, list)
+        else []
+    )
+    if not items:
+        return [], "plans is required"
+
+    plans: list[str] = []
+    for idx, item in enumerate(items):
+        plan = item.strip()
+        if not _SLUG.match(plan):
+            return [], (
+                f'plans[{idx}] "{plan}" is not a plan ID such as g6-dedicated-2'
+            )
+        if plan not in plans:
+            plans.append(plan)
+
+    if len(plans) > _MAX_PLANS:
+        return [], f"plans accepts at most {_MAX_PLANS} plans"
+    return plans, ""
+
+
+def _timestamp(moment: datetime) -> str:
+    return moment.strftime("%Y-%m-%dT%H:%M:%SZ")
+
+
+def _message(
+    plans: list[dict[str, Any]], region_id: str, first: int, changes: list[str]
+) -> str:
+    if first == len(plans):
+        current = ", ".join(f"{p['plan']} {p['status']}" for p in plans)
+        return (
+            f"Watching {len(plans)} plans in {region_id}: {current}; call again "
+            "with the same region and plans to see what changed"
+        )
+    if changes:
+        return (
+            f"{len(changes)} of {len(plans)} plans in {region_id} changed since "
+            f"the last check: {', '.join(changes)}"
+        )
+    return f"No change for {len(plans)} plans in {region_id} since the last check"
+
+
+async def handle_linode_availability_watch(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_availability_watch tool request."""
+    region_id = arguments.get("region_id")
+    if not isinstance(region_id, str) or not region_id.strip():
+        return error_response("region_id must be a non-empty string")
+    if not _SLUG.match(region_id):
+        return error_response(
+            f"region_id got '{region_id}': region must contain only lowercase "
+            "letters, numbers, and hyphens"
+        )
+
+    plans, msg = _plans_from_arguments(arguments)
+    if msg:
+        return error_response(msg)
+
+    store = availability_watch_from_context()
+    if store is None:
+        return error_response(
+            "linode_availability_watch needs the server's watch store, which "
+            "this call does not have"
+        )
+
+    try:
+        partition = local_store_partition(
+            cfg,
+            local_store_environment(cfg, str(arguments.get("environment") or "")),
+        )
+    except (EnvironmentNotFoundError, ValueError) as exc:
+        return error_response(str(exc))
+
+    reset = arguments.get("reset") is True
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        availability = await client.get_region_availability(region_id)
+        statuses = {
+            str(entry.get("plan", "")): (
+                _STATUS_AVAILABLE if entry.get("available") else _STATUS_SOLD_OUT
+            )
+            for entry in availability
+        }
+
+        entries: list[dict[str, Any]] = []
+        changes: list[str] = []
+        first = 0
+        checked_at = ""
+        for plan in plans:
+            key = Key(environment=partition, region=region_id, plan=plan)
+            if reset:
+                store.forget(key)
+            status = statuses.get(plan, _STATUS_UNLISTED)
+            current, previous = store.record(key, status)
+            checked_at = _timestamp(current.checked_at)
+            entry: dict[str, Any] = {
+                "plan": plan,
+                "status": status,
+                "since": _timestamp(current.since),
+                "changed": False,
+            }
+            if previous is None:
+                first += 1
+            else:
+                entry["previous_status"] = previous.status
+                entry["last_checked"] = _timestamp(previous.checked_at)
+                if previous.status != status:
+                    entry["changed"] = True
+                    changes.append(f"{plan} {previous.status} -> {status}")
+            entries.append(entry)
+
+        return serialize_api_response(
+            {
+                "message": _message(entries, region_id, first, changes),
+                "region_id": region_id,
+                "checked_at": checked_at,
+                "plans": entries,
+                "changed": len(changes),
+            },
+            availability_watch_pb2.AvailabilityWatchResponse(),
+        )
+
+    return await execute_tool(
+        cfg, arguments, f"watch availability for region {region_id}", _call
+    )
@@ -0,0 +1,266 @@
+"""linode_backup_schedule_update and linode_backup_schedule_audit.
+
+The update tool sets the day and window an instance is backed up in; the
+audit lists the instances whose backups are disabled or behind. Mirrors
+``go/internal/tools/linode_backup_schedule.go``.
+"""
+
+from __future__ import annotations
+
+from datetime import UTC, datetime, timedelta
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import backup_schedule_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+    required_int_id,
+)
+from linodemcp.tools.proto_enum import optional_enum_error
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import Instance, RetryableClient
+
+_MAX_AGE_DEFAULT = 48
+_MAX_AGE_LIMIT = 8760
+
+# The API's timestamp format: UTC without a zone.
+_LINODE_TIME_FORMAT = "%Y-%m-%dT%H:%M:%S"
+
+_ISSUE_DISABLED = "backups_disabled"
+_ISSUE_NO_SUCCESSFUL = "no_successful_backup"
+_ISSUE_STALE = "stale_backup"
+
+
+def create_linode_backup_schedule_update_tool() -> tuple[Tool, Capability]:
+    """Create the linode_backup_schedule_update tool."""
+    return Tool(
+        name="linode_backup_schedule_update",
+        description=(
+            "Sets when a Linode instance is backed up: day is the weekly "
+            "backup's day and window the two-hour UTC window (W0 = 00:00-02:00 "
+            "through W22) the daily backup starts in. Pass either or both; the "
+            "other keeps its value. Backups must already be enabled "
+            "(linode_instance_backups_enable). Pass dry_run=true to preview."
+        ),
+        inputSchema=schema("linode.mcp.v1.BackupScheduleUpdateInput"),
+    ), Capability.Write
+
+
+def create_linode_backup_schedule_audit_tool() -> tuple[Tool, Capability]:
+    """Create the linode_backup_schedule_audit tool."""
+    return Tool(
+        name="linode_backup_schedule_audit",
+        description=(
+            "Lists the Linode instances whose backups need attention: backups "
+            "disabled, enabled but with no successful backup yet, or a last "
+            "successful backup at least max_age_hours old (default 48). Each "
+            "finding carries the instance's backup schedule and last successful "
+            "backup time."
+        ),
+        inputSchema=schema("linode.mcp.v1.BackupScheduleAuditInput"),
+    ), Capability.Read
+
+
+def _parse_args(arguments: dict[str, Any]) -> tuple[int, dict[str, str], str]:
+    """Validate the input; mirrors Go's parseBackupScheduleArgs."""
+    linode_id, msg = required_int_id(arguments, "linode_id")
+    if linode_id is None:
+        return 0, {}, msg
+
+    enum_error = optional_enum_error(
+        arguments, "day", backup_schedule_pb2.BackupScheduleDay.Value
+    )
+    if enum_error:
+        return 0, {}, enum_error
+    enum_error = optional_enum_error(
+        arguments, "window", backup_schedule_pb2.BackupScheduleWindow.Value
+    )
+    if enum_error:
+        return 0, {}, enum_error
+
+    schedule = {
+        key: str(arguments[key]) for key in ("day", "window") if arguments.get(key)
+    }
+    if not schedule:
+        return 0, {}, "day or window is required"
+    return linode_id, schedule, ""
+
+
+def _schedule_dict(instance: Instance) -> dict[str, str]:
+    schedule = instance.backups.schedule
+    return {"day": schedule.day, "window": schedule.window}
+
+
+async def handle_linode_backup_schedule_update(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_backup_schedule_update tool request."""
+    if is_dry_run(arguments):
+        linode_id, schedule, args_error = _parse_args(arguments)
+        if args_error:
+            return error_response(args_error)
+
+        async def _fetch(client: RetryableClient) -> Any:
+            instance = await client.get_instance(linode_id)
+            return {
+                "linode_id": instance.id,
+                "label": instance.label,
+                "backups_enabled": instance.backups.enabled,
+                **_schedule_dict(instance),
+            }
+
+        async def _walk(_client: RetryableClient, state: Any) -> DryRunDetails:
+            after = {
+                "day": state["day"],
+                "window": state["window"],
+                **schedule,
+            }
+            warnings: list[str] = []
+            if not state["backups_enabled"]:
+                warnings.append(
+                    f"Backups are not enabled on Linode {linode_id}; "
+                    "the call would fail."
+                )
+            return {
+                "side_effects": [
+                    f"The backup schedule of Linode {state['label']} ({linode_id}) "
+                    f"changes from {state['day']} {state['window']} to "
+                    f"{after['day']} {after['window']}."
+                ],
+                "warnings": warnings,
+            }
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_backup_schedule_update",
+            "PUT",
+            f"/linode/instances/{linode_id}",
+            _fetch,
+            _walk,
+        )
+
+    if arguments.get("confirm") is not True:
+        return error_response(
+            "This changes the instance's backup schedule. "
+            "Set confirm=true to proceed."
+        )
+
+    linode_id, schedule, args_error = _parse_args(arguments)
+    if args_error:
+        return error_response(args_error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        before = await client.get_instance(linode_id)
+        if not before.backups.enabled:
+            msg = (
+                f"Backups are not enabled on Linode {linode_id}; enable them "
+                "with linode_instance_backups_enable first"
+            )
+            raise ValueError(msg)
+
+        instance = await client.update_instance_raw(
+            linode_id, backups={"schedule": schedule}
+        )
+        after = (instance.get("backups") or {}).get("schedule") or {}
+        after_schedule = {
+            "day": str(after.get("day") or ""),
+            "window": str(after.get("window") or ""),
+        }
+        label = str(instance.get("label") or "")
+        return serialize_api_response(
+            {
+                "message": (
+                    f"Backup schedule of Linode {label} ({linode_id}) set to "
+                    f"{after_schedule['day']} {after_schedule['window']}"
+                ),
+                "linode_id": linode_id,
+                "label": label,
+                "previous_schedule": _schedule_dict(before),
+                "schedule": after_schedule,
+            },
+            backup_schedule_pb2.BackupScheduleUpdateResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "update backup schedule", _call)
+
+
+def _audit(
+    instances: list[Instance], now: datetime, max_age: int
+) -> dict[str, Any]:
+    """Build the audit as of now; mirrors Go's backupScheduleAudit. A last
+    successful backup whose finish time does not parse is not judged stale."""
+    findings: list[dict[str, Any]] = []
+    for instance in instances:
+        backups = instance.backups
+        last = backups.last_successful.finished if backups.last_successful else ""
+        finding: dict[str, Any] = {
+            "linode_id": instance.id,
+            "label": instance.label,
+            "region": instance.region,
+            "schedule": _schedule_dict(instance),
+            "last_successful": last,
+        }
+        if not backups.enabled:
+            finding["issue"] = _ISSUE_DISABLED
+        elif not last:
+            finding["issue"] = _ISSUE_NO_SUCCESSFUL
+        else:
+            try:
+                finished = datetime.strptime(last, _LINODE_TIME_FORMAT).replace(
+                    tzinfo=UTC
+                )
+            except ValueError:
+                continue
+            if now - finished < timedelta(hours=max_age):
+                continue
+            finding["issue"] = _ISSUE_STALE
+        findings.append(finding)
+
+    return {
+        "message": (
+            f"{len(findings)} of {len(instances)} instances have backups "
+            f"disabled, missing, or at least {max_age} hours old"
+        ),
+        "checked": len(instances),
+        "count": len(findings),
+        "max_age_hours": max_age,
+        "findings": findings,
+    }
+
+
+async def handle_linode_backup_schedule_audit(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_backup_schedule_audit tool request."""
+    max_age = _MAX_AGE_DEFAULT
+    if "max_age_hours" in arguments:
+        value = arguments["max_age_hours"]
+        if (
+            isinstance(value, bool)
+            or not isinstance(value, int)
+            or not 1 <= value <= _MAX_AGE_LIMIT
+        ):
+            return error_response(
+                f"max_age_hours must be from 1 through {_MAX_AGE_LIMIT}"
+            )
+        max_age = value
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        instances = await client.list_instances()
+        return serialize_api_response(
+            _audit(instances, datetime.now(UTC), max_age),
+            backup_schedule_pb2.BackupScheduleAuditResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "audit backups", _call)
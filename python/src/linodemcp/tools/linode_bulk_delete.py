@@ -0,0 +1,390 @@
+"""Linode bulk delete tool: delete a set of resources in dependency order."""
+
+from __future__ import annotations
+
+import asyncio
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import bulk_delete_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+)
+from linodemcp.tools.linode_tags_bulk import all_tagged_objects, tagged_object_state
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_MAX_TARGETS = 50
+_DELAY_DEFAULT_MS = 1000
+_DELAY_MAX_MS = 10000
+# Resource types linode_bulk_delete accepts (mirrors Go bulkDeleteTypes).
+_TYPES = ("instance", "volume", "nodebalancer", "domain")
+# Delete steps run in this type order, after volume detaches and DNS record
+# removals; volumes go last so no instance still holds them.
+_DELETE_ORDER = ("domain", "nodebalancer", "instance", "volume")
+_RESOURCES_SHAPE = "resources must be an array of {type, id} objects"
+
+
+def create_linode_bulk_delete_tool() -> tuple[Tool, Capability]:
+    """Create the linode_bulk_delete tool."""
+    return Tool(
+        name="linode_bulk_delete",
+        description=(
+            "Deletes a set of instances, volumes, NodeBalancers, and domains, "
+            "named as typed IDs in resources or by a tag. It plans a safe order "
+            "(detach volumes, remove DNS records pointing at deleted instances, "
+            "then delete domains, NodeBalancers, instances, and volumes), runs "
+            "the calls one at a time delay_ms apart, stops at the first failure, "
+            "and reports every step. Steps that touch resources outside the set "
+            "(a volume attached to a kept instance, a record in a kept domain) "
+            "need force=true. Pass dry_run=true to see the plan first."
+        ),
+        inputSchema=schema("linode.mcp.v1.BulkDeleteInput"),
+    ), Capability.Destroy
+
+
+def _int_arg(value: Any) -> int | None:
+    """An integral JSON number as an int, else None (mirrors Go numberArgToInt)."""
+    if isinstance(value, bool) or not isinstance(value, (int, float)):
+        return None
+    if value != int(value):
+        return None
+    return int(value)
+
+
+def _bulk_delete_args(arguments: dict[str, Any]) -> tuple[dict[str, Any], str]:
+    """Validate the input; the second value is a validation message."""
+    tag = arguments.get("tag")
+    tag = tag.strip() if isinstance(tag, str) else ""
+    items = arguments.get("resources")
+    if items is None:
+        items = []
+    if not isinstance(items, list):
+        return {}, _RESOURCES_SHAPE
+
+    if items and tag:
+        return {}, "pass either resources or tag, not both"
+    if not items and not tag:
+        return {}, "resources or tag is required"
+    if len(items) > _MAX_TARGETS:
+        return {}, f"at most {_MAX_TARGETS} resources can be deleted at once"
+
+    targets: list[dict[str, Any]] = []
+    for i, item in enumerate(items):
+        if not isinstance(item, dict):
+            return {}, _RESOURCES_SHAPE
+        resource_type = item.get("type")
+        if resource_type not in _TYPES:
+            return {}, f"resources[{i}].type must be one of: {', '.join(_TYPES)}"
+        resource_id = _int_arg(item.get("id"))
+        if resource_id is None or resource_id <= 0:
+            return {}, f"resources[{i}].id must be a positive integer"
+        target = {"type": resource_type, "id": resource_id, "label": ""}
+        if target not in targets:
+            targets.append(target)
+
+    delay_ms = _DELAY_DEFAULT_MS
+    if arguments.get("delay_ms") is not None:
+        delay = _int_arg(arguments["delay_ms"])
+        if delay is None or not 0 <= delay <= _DELAY_MAX_MS:
+            return {}, f"delay_ms must be an integer from 0 through {_DELAY_MAX_MS}"
+        delay_ms = delay
+
+    return {
+        "targets": targets,
+        "tag": tag,
+        "force": arguments.get("force") is True,
+        "delay_ms": delay_ms,
+    }, ""
+
+
+async def _tagged_targets(client: RetryableClient, tag: str) -> list[dict[str, Any]]:
+    """Resolve a tag to the set it names; a tag that also covers a type this
+    tool cannot delete is refused (mirrors Go taggedBulkDeleteTargets)."""
+    targets: list[dict[str, Any]] = []
+    for obj in await all_tagged_objects(client, tag):
+        state = tagged_object_state(obj)
+        resource_type = "instance" if state["type"] == "linode" else state["type"]
+        if resource_type not in _TYPES:
+            msg = (
+                "linode_bulk_delete cannot delete tagged objects of type: "
+                f"{resource_type} {state['id']}"
+            )
+            raise ValueError(msg)
+        targets.append(
+            {"type": resource_type, "id": state["id"], "label": state["label"]}
+        )
+    if not targets:
+        msg = f'no resources carry the tag "{tag}"'
+        raise ValueError(msg)
+    if len(targets) > _MAX_TARGETS:
+        msg = (
+            f'too many resources for one bulk delete: tag "{tag}" covers '
+            f"{len(targets)}, at most {_MAX_TARGETS}"
+        )
+        raise ValueError(msg)
+    return targets
+
+
+async def _dns_steps(
+    client: RetryableClient,
+    addresses: dict[str, dict[str, Any]],
+    deleted_domains: list[int],
+) -> list[dict[str, Any]]:
+    """Plan removing the A and AAAA records in kept domains that point at an
+    address of an instance being deleted."""
+    steps: list[dict[str, Any]] = []
+    for domain in await client.list_domains():
+        if domain.id in deleted_domains:
+            continue
+        for record in await client.list_domain_records(domain.id):
+            instance = addresses.get(record.target)
+            if instance is None or record.type not in ("A", "AAAA"):
+                continue
+            label = f"{record.name}.{domain.domain}" if record.name else domain.domain
+            steps.append(
+                {
+                    "action": "delete_dns_record",
+                    "type": "domain_record",
+                    "id": record.id,
+                    "label": label,
+                    "reason": (
+                        f"{record.type} record points at instance "
+                        f"{instance['id']} ({instance['label']})"
+                    ),
+                    "outside_set": True,
+                    "domain_id": domain.id,
+                }
+            )
+    return steps
+
+
+async def _plan_bulk_delete(
+    client: RetryableClient, args: dict[str, Any]
+) -> dict[str, Any]:
+    """Resolve the set, read each resource, and order the steps that delete
+    it (mirrors Go planBulkDelete)."""
+    targets = args["targets"]
+    reason = "listed in resources"
+    if args["tag"]:
+        targets = await _tagged_targets(client, args["tag"])
+        reason = f'tagged "{args["tag"]}"'
+
+    planned: list[dict[str, Any]] = []
+    deletes: dict[str, list[dict[str, Any]]] = {}
+    detaches: list[dict[str, Any]] = []
+    addresses: dict[str, dict[str, Any]] = {}
+    deleted_domains: list[int] = []
+    instance_ids = [t["id"] for t in targets if t["type"] == "instance"]
+
+    for source in targets:
+        target = dict(source)
+        if target["type"] == "instance":
+            instance = await client.get_instance(target["id"])
+            target["label"] = instance.label
+            for address in instance.ipv4:
+                addresses[address] = target
+            ipv6 = (instance.ipv6 or "").split("/", 1)[0]
+            if ipv6:
+                addresses[ipv6] = target
+        elif target["type"] == "volume":
+            volume = await client.get_volume(target["id"])
+            target["label"] = volume.label
+            if volume.linode_id:
+                kept = volume.linode_id not in instance_ids
+                detaches.append(
+                    {
+                        "action": "detach_volume",
+                        "type": "volume",
+                        "id": target["id"],
+                        "label": target["label"],
+                        "reason": (
+                            f"attached to instance {volume.linode_id} "
+                            f"({volume.linode_label or ''}), which is "
+                            + ("kept" if kept else "also deleted")
+                        ),
+                        "outside_set": kept,
+                    }
+                )
+        elif target["type"] == "nodebalancer":
+            node_balancer = await client.get_nodebalancer(target["id"])
+            target["label"] = node_balancer.label
+        else:
+            domain = await client.get_domain(target["id"])
+            target["label"] = domain.domain
+            deleted_domains.append(target["id"])
+
+        planned.append(target)
+        deletes.setdefault(target["type"], []).append(
+            {
+                "action": f"delete_{target['type']}",
+                "type": target["type"],
+                "id": target["id"],
+                "label": target["label"],
+                "reason": reason,
+                "outside_set": False,
+            }
+        )
+
+    steps = list(detaches)
+    if addresses:
+        steps += await _dns_steps(client, addresses, deleted_domains)
+    for resource_type in _DELETE_ORDER:
+        steps += deletes.get(resource_type, [])
+    ordered = [{"order": i, **step} for i, step in enumerate(steps, start=1)]
+    return {"targets": planned, "steps": ordered}
+
+
+def _step_summary(step: dict[str, Any]) -> str:
+    return f"{step['action']} {step['type']} {step['id']} ({step['label']})"
+
+
+def _side_effects(plan: dict[str, Any], args: dict[str, Any]) -> DryRunDetails:
+    """One line per step in run order, then a summary line (mirrors Go
+    bulkDeleteSideEffects)."""
+    side_effects: list[str] = []
+    for step in plan["steps"]:
+        line = f"{step['order']}. {_step_summary(step)}: {step['reason']}."
+        if step["outside_set"]:
+            line += " Needs force=true."
+        side_effects.append(line)
+    side_effects.append(
+        f"{len(plan['targets'])} resources would be deleted in "
+        f"{len(plan['steps'])} steps, {args['delay_ms']} ms apart."
+    )
+    details: DryRunDetails = {"side_effects": side_effects}
+    outside = [step for step in plan["steps"] if step["outside_set"]]
+    if outside and not args["force"]:
+        details["warnings"] = [
+            f"{len(outside)} steps change resources outside the set; the real "
+            "call refuses them without force=true."
+        ]
+    return details
+
+
+async def _run_step(client: RetryableClient, step: dict[str, Any]) -> None:
+    """Make the API call for one step."""
+    action = step["action"]
+    if action == "detach_volume":
+        await client.detach_volume(step["id"])
+    elif action == "delete_dns_record":
+        await client.delete_domain_record(step["domain_id"], step["id"])
+    elif action == "delete_domain":
+        await client.delete_domain(step["id"])
+    elif action == "delete_nodebalancer":
+        await client.delete_nodebalancer(step["id"])
+    elif action == "delete_instance":
+        await client.delete_instance(step["id"])
+    else:
+        await client.delete_volume(step["id"])
+
+
+async def _run_bulk_delete(
+    client: RetryableClient, plan: dict[str, Any], delay_ms: int
+) -> dict[str, Any]:
+    """Execute the plan one step at a time, delay_ms apart; the first failure
+    stops the run and the later steps are not_run (mirrors Go runBulkDelete)."""
+    steps: list[dict[str, Any]] = []
+    completed = failed = not_run = 0
+    failed_step: dict[str, Any] | None = None
+    for i, step in enumerate(plan["steps"]):
+        entry = {**step, "status": "planned"}
+        steps.append(entry)
+        if failed_step is not None:
+            entry["status"] = "not_run"
+            not_run += 1
+            continue
+        if i > 0 and delay_ms > 0:
+            await asyncio.sleep(delay_ms / 1000)
+        try:
+            await _run_step(client, step)
+        except (APIError, NetworkError) as exc:
+            entry["status"] = "failed"
+            entry["error"] = str(exc)
+            failed += 1
+            failed_step = entry
+            add_warning("step %d (%s): %s", step["order"], _step_summary(step), exc)
+            continue
+        entry["status"] = "done"
+        completed += 1
+
+    if failed_step is not None:
+        message = (
+            f"Bulk delete stopped at step {failed_step['order']} of {len(steps)} "
+            f"({failed_step['action']} {failed_step['type']} {failed_step['id']}): "
+            f"{completed} steps done, {not_run} not run"
+        )
+    else:
+        message = f"Deleted {len(plan['targets'])} resources in {completed} steps"
+    return serialize_api_response(
+        {
+            "message": message,
+            "targets": len(plan["targets"]),
+            "completed": completed,
+            "failed": failed,
+            "not_run": not_run,
+            "steps": steps,
+        },
+        bulk_delete_pb2.BulkDeleteResponse(),
+    )
+
+
+async def handle_linode_bulk_delete(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_bulk_delete tool request."""
+    args, error = _bulk_delete_args(arguments)
+    if error:
+        return error_response(error)
+
+    if is_dry_run(arguments):
+
+        async def _fetch(client: RetryableClient) -> Any:
+            return await _plan_bulk_delete(client, args)
+
+        async def _walk(_client: RetryableClient, state: Any) -> DryRunDetails:
+            return _side_effects(state, args)
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_bulk_delete",
+            "DELETE",
+            "/{resource}/{id}",
+            _fetch,
+            _walk,
+        )
+
+    if not arguments.get("confirm"):
+        return error_response(
+            "This deletes every resource in the set. Set confirm=true to proceed."
+        )
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        plan = await _plan_bulk_delete(client, args)
+        outside = [step for step in plan["steps"] if step["outside_set"]]
+        if outside and not args["force"]:
+            summaries = "; ".join(
+                f"{_step_summary(step)}: {step['reason']}" for step in outside
+            )
+            msg = (
+                f"The plan changes {len(outside)} resources outside the set: "
+                f"{summaries}. Review it with dry_run=true and pass force=true "
+                "to proceed."
+            )
+            raise ValueError(msg)
+        return await _run_bulk_delete(client, plan, args["delay_ms"])
+
+    return await execute_tool(cfg, arguments, "plan bulk delete", _call)
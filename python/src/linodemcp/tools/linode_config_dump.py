@@ -0,0 +1,102 @@
+"""Effective configuration dump tool.
+
+``linode_config_dump`` prints the configuration the server runs with (the
+file after defaults and environment overrides), keyed as the config file
+spells it, with every secret redacted. CapMeta, so every profile can read it.
+Takes no input and makes no API call.
+
+Mirrors ``go/internal/tools/linode_config_dump.go``.
+"""
+
+from __future__ import annotations
+
+import json
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.audit import REDACTED_VALUE
+from linodemcp.config import config_to_data, get_config_path
+from linodemcp.genpb.linode.mcp.v1 import config_dump_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import resolve_config
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+
+
+def create_linode_config_dump_tool() -> tuple[Tool, Capability]:
+    """Build the ``linode_config_dump`` MCP tool definition."""
+    return (
+        Tool(
+            name="linode_config_dump",
+            description=(
+                "Print the effective LinodeMCP configuration in use (file "
+                "values plus defaults and environment overrides), keyed as in "
+                "the config file. Tokens, header values, and the OAuth client "
+                "secret are redacted."
+            ),
+            inputSchema=schema("linode.mcp.v1.ConfigDumpInput"),
+        ),
+        Capability.Meta,
+    )
+
+
+def _redact_config_secrets(fields: dict[str, Any]) -> list[str]:
+    """Replace every set secret with ``[REDACTED]`` and return their dotted
+    paths, sorted. Empty values stay empty so the dump still shows which
+    secrets are unset (Go's redactConfigSecrets)."""
+    redacted: list[str] = []
+
+    def redact(parent: dict[str, Any], key: str, path: str) -> None:
+        value = parent.get(key)
+        if isinstance(value, str) and value:
+            parent[key] = REDACTED_VALUE
+            redacted.append(path)
+
+    def redact_all(parent: dict[str, Any], path: str) -> None:
+        for key in list(parent):
+            redact(parent, key, f"{path}.{key}")
+
+    for name, env in fields.get("environments", {}).items():
+        linode = env.get("linode")
+        if not isinstance(linode, dict):
+            continue
+        path = f"environments.{name}.linode"
+        redact(linode, "token", f"{path}.token")
+        headers = linode.get("headers")
+        if isinstance(headers, dict):
+            redact_all(headers, f"{path}.headers")
+
+    tracing = fields.get("observability", {}).get("tracing", {})
+    headers = tracing.get("headers")
+    if isinstance(headers, dict):
+        redact_all(headers, "observability.tracing.headers")
+
+    oauth = fields.get("oauth")
+    if isinstance(oauth, dict):
+        redact(oauth, "client_secret", "oauth.client_secret")
+
+    return sorted(redacted)
+
+
+def config_dump_dict(cfg: Config) -> dict[str, Any]:
+    """The canonical ConfigDumpResponse payload as a dict."""
+    fields = config_to_data(resolve_config(cfg))
+    redacted = _redact_config_secrets(fields)
+    payload = {
+        "config_path": str(get_config_path()),
+        "config": fields,
+        "redacted": redacted,
+    }
+    return serialize_api_response(payload, config_dump_pb2.ConfigDumpResponse())
+
+
+async def handle_linode_config_dump(
+    _arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Report the effective, redacted configuration as proto-canonical JSON."""
+    result = config_dump_dict(cfg)
+    return [TextContent(type="text", text=json.dumps(result, indent=2))]
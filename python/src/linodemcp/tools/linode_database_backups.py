@@ -0,0 +1,465 @@
+"""Managed Database backup list, on-demand backup, and restore tools.
+
+A restore always lands on a new cluster forked from the source instance; the
+source is left untouched. Mirrors ``go/internal/tools/linode_database_backups.go``.
+"""
+
+from __future__ import annotations
+
+import asyncio
+import json
+import re
+import time
+from dataclasses import dataclass
+from datetime import UTC, datetime
+from typing import TYPE_CHECKING, Any
+from urllib.parse import urlencode
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import database_backup_pb2, database_instance_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import (
+    build_dry_run_response,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+    pagination_int_argument,
+    required_int_id,
+)
+from linodemcp.tools.proto_response import (
+    serialize_api_response,
+    serialize_list_response,
+)
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_BACKUP_LABEL_MAX_LENGTH = 32
+_BACKUP_TARGETS = ("primary", "secondary")
+
+# Pacing for a restore made with wait=true. A forked cluster provisions in ten
+# to thirty minutes; past the timeout the call returns what it last saw with
+# ready=false.
+_DATABASE_WAIT_INTERVAL_SECONDS = 15.0
+_DATABASE_WAIT_TIMEOUT_SECONDS = 1800.0
+_DATABASE_STATUS_ACTIVE = "active"
+
+# RFC 3339 with an optional zone; a zone-less timestamp is read as UTC.
+_RESTORE_TIME_PATTERN = re.compile(
+    r"^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})?$"
+)
+_RESTORE_TIME_FORMAT = "%Y-%m-%dT%H:%M:%S"
+
+
+@dataclass(frozen=True)
+class _Engine:
+    """Per-engine pieces of the backup and restore tools."""
+
+    engine: str
+    display: str
+    message_prefix: str
+
+    @property
+    def instances_path(self) -> str:
+        return f"/databases/{self.engine}/instances"
+
+
+_MYSQL = _Engine("mysql", "MySQL", "Managed Database")
+_POSTGRESQL = _Engine("postgresql", "PostgreSQL", "PostgreSQL Managed Database")
+
+
+def _backup_list_description(engine: _Engine) -> str:
+    return (
+        f"Lists the automated and on-demand backups of a {engine.display} "
+        "Managed Database instance."
+    )
+
+
+def _backup_create_description(engine: _Engine) -> str:
+    return (
+        f"Takes an on-demand backup of a {engine.display} Managed Database "
+        "instance. Only clusters that support on-demand snapshots accept "
+        "this; newer clusters keep daily backups with point-in-time "
+        "recovery, restorable with "
+        f"linode_database_{engine.engine}_instance_restore. Pass "
+        "dry_run=true to preview without backing up."
+    )
+
+
+def _instance_restore_description(engine: _Engine) -> str:
+    return (
+        f"Restores a {engine.display} Managed Database instance from its "
+        "backups into a new cluster, optionally at a point in time "
+        "(restore_time). The source is left untouched; region, type, and "
+        "cluster_size default to the source's. This creates a billable "
+        "resource. Pass wait=true to return once the new cluster is "
+        "active. Pass dry_run=true to preview without restoring."
+    )
+
+
+def create_linode_database_mysql_backup_list_tool() -> tuple[Tool, Capability]:
+    """Create the linode_database_mysql_backup_list tool."""
+    return Tool(
+        name="linode_database_mysql_backup_list",
+        description=_backup_list_description(_MYSQL),
+        inputSchema=schema("linode.mcp.v1.DatabaseMySQLBackupListInput"),
+    ), Capability.Read
+
+
+def create_linode_database_postgresql_backup_list_tool() -> tuple[Tool, Capability]:
+    """Create the linode_database_postgresql_backup_list tool."""
+    return Tool(
+        name="linode_database_postgresql_backup_list",
+        description=_backup_list_description(_POSTGRESQL),
+        inputSchema=schema("linode.mcp.v1.DatabasePostgreSQLBackupListInput"),
+    ), Capability.Read
+
+
+def create_linode_database_mysql_backup_create_tool() -> tuple[Tool, Capability]:
+    """Create the linode_database_mysql_backup_create tool."""
+    return Tool(
+        name="linode_database_mysql_backup_create",
+        description=_backup_create_description(_MYSQL),
+        inputSchema=schema("linode.mcp.v1.DatabaseMySQLBackupCreateInput"),
+    ), Capability.Write
+
+
+def create_linode_database_postgresql_backup_create_tool() -> tuple[
+    Tool, Capability
+]:
+    """Create the linode_database_postgresql_backup_create tool."""
+    return Tool(
+        name="linode_database_postgresql_backup_create",
+        description=_backup_create_description(_POSTGRESQL),
+        inputSchema=schema("linode.mcp.v1.DatabasePostgreSQLBackupCreateInput"),
+    ), Capability.Write
+
+
+def create_linode_database_mysql_instance_restore_tool() -> tuple[Tool, Capability]:
+    """Create the linode_database_mysql_instance_restore tool."""
+    return Tool(
+        name="linode_database_mysql_instance_restore",
+        description=_instance_restore_description(_MYSQL),
+        inputSchema=schema("linode.mcp.v1.DatabaseMySQLInstanceRestoreInput"),
+    ), Capability.Write
+
+
+def create_linode_database_postgresql_instance_restore_tool() -> tuple[
+    Tool, Capability
+]:
+    """Create the linode_database_postgresql_instance_restore tool."""
+    return Tool(
+        name="linode_database_postgresql_instance_restore",
+        description=_instance_restore_description(_POSTGRESQL),
+        inputSchema=schema("linode.mcp.v1.DatabasePostgreSQLInstanceRestoreInput"),
+    ), Capability.Write
+
+
+async def _handle_backup_list(
+    engine: _Engine, arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    instance_id, message = required_int_id(arguments, "instance_id")
+    if instance_id is None:
+        return error_response(message)
+    try:
+        page = pagination_int_argument(arguments, "page", 1)
+        page_size = pagination_int_argument(arguments, "page_size", 25, 500)
+    except (TypeError, ValueError) as exc:
+        return error_response(str(exc))
+
+    params = {
+        key: value
+        for key, value in (("page", page), ("page_size", page_size))
+        if value is not None
+    }
+    endpoint = f"{engine.instances_path}/{instance_id}/backups"
+    if params:
+        endpoint += "?" + urlencode(params)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        data = await client.get_raw(endpoint)
+        return serialize_list_response(
+            data, "backups", database_backup_pb2.DatabaseBackupListResponse()
+        )
+
+    return await execute_tool(
+        cfg, arguments, f"list {engine.message_prefix} backups", _call
+    )
+
+
+async def handle_linode_database_mysql_backup_list(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_database_mysql_backup_list tool request."""
+    return await _handle_backup_list(_MYSQL, arguments, cfg)
+
+
+async def handle_linode_database_postgresql_backup_list(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_database_postgresql_backup_list tool request."""
+    return await _handle_backup_list(_POSTGRESQL, arguments, cfg)
+
+
+def _backup_create_payload(
+    arguments: dict[str, Any],
+) -> tuple[dict[str, Any] | None, str]:
+    label = arguments.get("label")
+    if not isinstance(label, str) or not label.strip():
+        return None, "label is required"
+    if len(label) > _BACKUP_LABEL_MAX_LENGTH:
+        return None, f"label must be at most {_BACKUP_LABEL_MAX_LENGTH} characters"
+    payload: dict[str, Any] = {"label": label}
+    if "target" in arguments:
+        target = arguments["target"]
+        if target not in _BACKUP_TARGETS:
+            return None, "target must be primary or secondary"
+        payload["target"] = target
+    return payload, ""
+
+
+async def _handle_backup_create(
+    engine: _Engine, arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    tool_name = f"linode_database_{engine.engine}_backup_create"
+    instance_id, message = required_int_id(arguments, "instance_id")
+    if instance_id is None:
+        return error_response(message)
+    payload, message = _backup_create_payload(arguments)
+    if payload is None:
+        return error_response(message)
+
+    instance_path = f"{engine.instances_path}/{instance_id}"
+    path = f"{instance_path}/backups"
+
+    if is_dry_run(arguments):
+
+        async def _fetch(client: RetryableClient) -> Any:
+            return await client.get_raw(instance_path)
+
+        return await execute_dry_run(
+            cfg, arguments, tool_name, "POST", path, _fetch, request_body=payload
+        )
+
+    if arguments.get("confirm") is not True:
+        return error_response(
+            "This takes an on-demand backup of a Managed Database instance. Set "
+            "confirm=true to proceed."
+        )
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        await client.post_raw(path, payload)
+        return serialize_api_response(
+            {
+                "message": (
+                    f"{engine.message_prefix} instance {instance_id} backup "
+                    f"'{payload['label']}' started"
+                ),
+                "instance_id": instance_id,
+            },
+            database_instance_pb2.DatabaseInstanceActionWriteResponse(),
+        )
+
+    return await execute_tool(
+        cfg, arguments, f"back up {engine.message_prefix} instance", _call
+    )
+
+
+async def handle_linode_database_mysql_backup_create(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_database_mysql_backup_create tool request."""
+    return await _handle_backup_create(_MYSQL, arguments, cfg)
+
+
+async def handle_linode_database_postgresql_backup_create(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_database_postgresql_backup_create tool request."""
+    return await _handle_backup_create(_POSTGRESQL, arguments, cfg)
+
+
+def _parse_restore_time(value: str) -> tuple[str | None, str]:
+    """Accept an RFC 3339 timestamp, or one without a zone read as UTC, and
+    return it in the zone-less UTC form the fork API expects."""
+    invalid = (
+        None,
+        "restore_time must be an ISO 8601 timestamp such as 2025-01-02T15:04:05Z",
+    )
+    if not _RESTORE_TIME_PATTERN.match(value):
+        return invalid
+    try:
+        parsed = datetime.fromisoformat(value)
+    except ValueError:
+        return invalid
+    if parsed.tzinfo is None:
+        parsed = parsed.replace(tzinfo=UTC)
+    if parsed > datetime.now(UTC):
+        return None, "restore_time must not be in the future"
+    return parsed.astimezone(UTC).strftime(_RESTORE_TIME_FORMAT), ""
+
+
+def _restore_options(arguments: dict[str, Any]) -> tuple[dict[str, Any] | None, str]:
+    """The restore arguments that override what the new cluster inherits from
+    its source."""
+    label = arguments.get("label")
+    if not isinstance(label, str) or not label.strip():
+        return None, "label is required"
+    options: dict[str, Any] = {"label": label}
+    for name in ("restore_time", "region", "type"):
+        if name not in arguments:
+            continue
+        value = arguments[name]
+        if not isinstance(value, str) or not value.strip():
+            return None, f"{name} must be a non-empty string"
+        options[name] = value
+    if "restore_time" in options:
+        restore_time, message = _parse_restore_time(options["restore_time"])
+        if restore_time is None:
+            return None, message
+        options["restore_time"] = restore_time
+    if "cluster_size" in arguments:
+        cluster_size = arguments["cluster_size"]
+        if (
+            isinstance(cluster_size, bool)
+            or not isinstance(cluster_size, int)
+            or cluster_size < 1
+        ):
+            return None, "cluster_size must be a positive integer"
+        options["cluster_size"] = cluster_size
+    return options, ""
+
+
+def _restore_payload(
+    engine: _Engine, source: dict[str, Any], options: dict[str, Any]
+) -> dict[str, Any]:
+    """Build the fork create body: the new cluster keeps the source's engine
+    major version and, unless overridden, its region, type, and size."""
+    fork: dict[str, Any] = {"source": source.get("id", 0)}
+    if "restore_time" in options:
+        fork["restore_time"] = options["restore_time"]
+    major = str(source.get("version") or "").split(".", 1)[0]
+    payload: dict[str, Any] = {
+        "label": options["label"],
+        "type": options.get("type", source.get("type", "")),
+        "engine": f"{engine.engine}/{major}",
+        "region": options.get("region", source.get("region", "")),
+    }
+    cluster_size = options.get("cluster_size", source.get("cluster_size") or 0)
+    if cluster_size:
+        payload["cluster_size"] = cluster_size
+    payload["fork"] = fork
+    return payload
+
+
+async def _await_database_active(
+    client: RetryableClient, engine: _Engine, instance: dict[str, Any]
+) -> tuple[dict[str, Any], bool]:
+    """Re-read the restored instance until it is active or the timeout passes.
+    The first re-read is immediate and later ones an interval apart. A failed
+    re-read or a timeout leaves the restore standing and is a warning."""
+    if instance.get("status") == _DATABASE_STATUS_ACTIVE:
+        return instance, True
+    instance_id = int(instance.get("id", 0))
+    deadline = time.monotonic() + _DATABASE_WAIT_TIMEOUT_SECONDS
+    first = True
+    while True:
+        if not first:
+            if time.monotonic() + _DATABASE_WAIT_INTERVAL_SECONDS > deadline:
+                add_warning(
+                    "wait: %s instance %s was not active after %ss",
+                    engine.message_prefix,
+                    instance_id,
+                    int(_DATABASE_WAIT_TIMEOUT_SECONDS),
+                )
+                return instance, False
+            await asyncio.sleep(_DATABASE_WAIT_INTERVAL_SECONDS)
+        first = False
+        try:
+            instance = await client.get_raw(f"{engine.instances_path}/{instance_id}")
+        except Exception as exc:  # noqa: BLE001 - the restore itself was accepted
+            add_warning(
+                "wait: failed to re-read %s instance %s: %s",
+                engine.message_prefix,
+                instance_id,
+                exc,
+            )
+            return instance, False
+        if instance.get("status") == _DATABASE_STATUS_ACTIVE:
+            return instance, True
+
+
+async def _handle_instance_restore(
+    engine: _Engine, arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    tool_name = f"linode_database_{engine.engine}_instance_restore"
+    source_id, message = required_int_id(arguments, "source_instance_id")
+    if source_id is None:
+        return error_response(message)
+    options, message = _restore_options(arguments)
+    if options is None:
+        return error_response(message)
+
+    dry_run = is_dry_run(arguments)
+    if not dry_run and arguments.get("confirm") is not True:
+        return error_response(
+            "This restores into a new billable Managed Database instance. Set "
+            "confirm=true to proceed."
+        )
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        source = await client.get_raw(f"{engine.instances_path}/{source_id}")
+        payload = _restore_payload(engine, source, options)
+        if dry_run:
+            preview = build_dry_run_response(
+                tool_name,
+                arguments.get("environment", ""),
+                "POST",
+                engine.instances_path,
+                source,
+                request_body=payload,
+            )
+            result: dict[str, Any] = json.loads(preview[0].text)
+            return result
+
+        instance = await client.post_raw(engine.instances_path, payload)
+        raw: dict[str, Any] = {
+            "message": (
+                f"{engine.message_prefix} instance '{instance.get('label', '')}'"
+                f" (ID: {instance.get('id', 0)}) restoring from instance "
+                f"{source_id}"
+            ),
+            "database_instance": instance,
+        }
+        if arguments.get("wait") is True:
+            instance, ready = await _await_database_active(client, engine, instance)
+            raw["database_instance"] = instance
+            raw["ready"] = ready
+            if ready:
+                raw["message"] += "; it is active"
+        return serialize_api_response(
+            raw, database_instance_pb2.DatabaseInstanceWriteResponse()
+        )
+
+    return await execute_tool(
+        cfg, arguments, f"restore {engine.message_prefix} instance", _call
+    )
+
+
+async def handle_linode_database_mysql_instance_restore(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_database_mysql_instance_restore tool request."""
+    return await _handle_instance_restore(_MYSQL, arguments, cfg)
+
+
+async def handle_linode_database_postgresql_instance_restore(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_database_postgresql_instance_restore tool request."""
+    return await _handle_instance_restore(_POSTGRESQL, arguments, cfg)
@@ -0,0 +1,473 @@
+"""Linode blue/green DNS cutover tool."""
+
+from __future__ import annotations
+
+import asyncio
+import ipaddress
+import secrets
+import struct
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import domain_pb2
+from linodemcp.linode import APIError, NetworkError, validate_dns_record_target
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+    walk_page_items,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_APPLIED = "applied"
+_ROLLED_BACK = "rolled_back"
+_PARTIAL = "partial"
+
+# Public resolvers queried when the caller names none: Cloudflare, Google, and
+# Quad9. Mirrors Go's dnsCutoverDefaultResolvers.
+DEFAULT_RESOLVERS = ["1.1.1.1", "8.8.8.8", "9.9.9.9"]
+
+# Bounds each resolver query so an unreachable resolver delays the report,
+# never the cutover itself.
+LOOKUP_TIMEOUT_SECONDS = 3.0
+
+_DNS_PORT = 53
+_QTYPES = {"A": 1, "AAAA": 28}
+RCODE_NXDOMAIN = 3
+
+
+def create_linode_dns_cutover_tool() -> tuple[Tool, Capability]:
+    """Create the linode_dns_cutover tool."""
+    return Tool(
+        name="linode_dns_cutover",
+        description=(
+            "Blue/green DNS cutover: repoints the A/AAAA records named in names "
+            "from from_ips[i] to to_ips[i]. Records are updated one by one; if "
+            "any update fails, the records already moved are restored to their "
+            "prior targets. The prior targets are returned for rollback, and each "
+            "changed name is then checked against public resolvers. Pass "
+            "dry_run=true to preview the target changes without updating."
+        ),
+        inputSchema=schema("linode.mcp.v1.DNSCutoverInput"),
+    ), Capability.Write
+
+
+def _normalize_name(name: str) -> str:
+    """Map a requested name onto Linode's stored form ('@' is the apex '')."""
+    name = name.strip().lower()
+    return "" if name == "@" else name
+
+
+def _canonical_ip(value: str) -> str:
+    """Canonical text form of an IP, or the input when it does not parse."""
+    try:
+        return str(ipaddress.ip_address(value))
+    except ValueError:
+        return value
+
+
+def _record_type_for(ip: ipaddress.IPv4Address | ipaddress.IPv6Address) -> str:
+    return "A" if ip.version == 4 else "AAAA"  # noqa: PLR2004
+
+
+def string_list(value: Any) -> list[str]:
+    """Read a string-array argument, dropping non-string items as Go does."""
+    if not isinstance(value, list):
+        return []
+    return [item for item in value if isinstance(item, str)]
+
+
+def _parse_args(arguments: dict[str, Any]) -> tuple[dict[str, Any], str]:
+    """Validate the cutover input; mirrors Go's parseDNSCutoverArgs."""
+    domain_id = arguments.get("domain_id", 0)
+    if not domain_id:
+        return {}, "domain_id is required"
+
+    raw_names = string_list(arguments.get("names"))
+    if not raw_names:
+        return {}, "names is required"
+
+    from_ips = string_list(arguments.get("from_ips"))
+    to_ips = string_list(arguments.get("to_ips"))
+    if not from_ips or len(from_ips) != len(to_ips):
+        return {}, "from_ips and to_ips must be non-empty lists of the same length"
+
+    targets: dict[str, str] = {}
+    for idx, (raw_from, raw_to) in enumerate(zip(from_ips, to_ips, strict=True)):
+        try:
+            from_ip = ipaddress.ip_address(raw_from.strip())
+        except ValueError:
+            return {}, f'from_ips[{idx}] "{raw_from}" is not a valid IP address'
+        try:
+            to_ip = ipaddress.ip_address(raw_to.strip())
+        except ValueError:
+            return {}, f'to_ips[{idx}] "{raw_to}" is not a valid IP address'
+        if from_ip.version != to_ip.version:
+            return {}, (
+                f"from_ips[{idx}] and to_ips[{idx}] must be the same address family"
+            )
+        try:
+            validate_dns_record_target(_record_type_for(to_ip), str(to_ip))
+        except ValueError as exc:
+            return {}, f"to_ips[{idx}]: {exc}"
+        if str(from_ip) in targets:
+            return {}, f'from_ips[{idx}] "{raw_from}" is listed more than once'
+        targets[str(from_ip)] = str(to_ip)
+
+    verify = arguments.get("verify", True)
+    return {
+        "domain_id": int(domain_id),
+        "raw_names": raw_names,
+        "names": {_normalize_name(name) for name in raw_names},
+        "targets": targets,
+        "verify": verify is not False,
+        "resolvers": string_list(arguments.get("resolvers")) or DEFAULT_RESOLVERS,
+    }, ""
+
+
+def _plan(
+    records: list[dict[str, Any]], args: dict[str, Any]
+) -> tuple[list[dict[str, Any]], list[str]]:
+    """Select the records to move and warn on names with no match."""
+    planned: list[dict[str, Any]] = []
+    matched: set[str] = set()
+    for record in records:
+        if str(record.get("type", "")).upper() not in _QTYPES:
+            continue
+        name = str(record.get("name", "")).lower()
+        if name not in args["names"]:
+            continue
+        if _canonical_ip(str(record.get("target", ""))) not in args["targets"]:
+            continue
+        planned.append(record)
+        matched.add(name)
+
+    warnings: list[str] = []
+    seen: set[str] = set()
+    for raw in args["raw_names"]:
+        name = _normalize_name(raw)
+        if name in matched or name in seen:
+            continue
+        seen.add(name)
+        warnings.append(f'No A/AAAA record named "{raw}" points at any of from_ips.')
+    return planned, warnings
+
+
+def _record_state(record: dict[str, Any]) -> dict[str, Any]:
+    """The plan fields of one record, matching Go's dnsCutoverRecordState."""
+    return {
+        "id": record.get("id", 0),
+        "type": record.get("type", ""),
+        "name": record.get("name", ""),
+        "target": record.get("target", ""),
+    }
+
+
+def _side_effects(states: list[dict[str, Any]], args: dict[str, Any]) -> list[str]:
+    """Tier B walk: one line per record target change, then the rollback note."""
+    side_effects = [
+        f'Record {state["id"]} ({state["type"]} "{state["name"]}") target changes '
+        f"from {state['target']} to {args['targets'][_canonical_ip(state['target'])]}."
+        for state in states
+    ]
+    side_effects.append(
+        f"{len(states)} records are updated one by one; if any update fails, the "
+        "records already moved are restored to their prior targets."
+    )
+    return side_effects
+
+
+async def _rollback(
+    client: RetryableClient, domain_id: int, moved: list[dict[str, Any]]
+) -> str:
+    """Restore each moved record; mirrors Go's rollbackDNSCutover."""
+    status = _ROLLED_BACK
+    for change in moved:
+        try:
+            await client.put_raw(
+                f"/domains/{domain_id}/records/{change['record_id']}",
+                {"target": change["old_target"]},
+            )
+        except (APIError, NetworkError) as exc:
+            change["error"] = f"rollback failed: {exc}"
+            status = _PARTIAL
+            continue
+        change["rolled_back"] = True
+    return status
+
+
+async def _apply(
+    client: RetryableClient, args: dict[str, Any], planned: list[dict[str, Any]]
+) -> dict[str, Any]:
+    """Move each planned record in order, rolling back on the first failure."""
+    domain_id = args["domain_id"]
+    response: dict[str, Any] = {
+        "domain_id": domain_id,
+        "status": _APPLIED,
+        "changes": [],
+        "verification": [],
+    }
+    for record in planned:
+        old_target = str(record.get("target", ""))
+        change: dict[str, Any] = {
+            "record_id": record.get("id", 0),
+            "type": record.get("type", ""),
+            "name": record.get("name", ""),
+            "old_target": old_target,
+            "new_target": args["targets"][_canonical_ip(old_target)],
+            "rolled_back": False,
+        }
+        response["changes"].append(change)
+        try:
+            await client.put_raw(
+                f"/domains/{domain_id}/records/{change['record_id']}",
+                {"target": change["new_target"]},
+            )
+        except (APIError, NetworkError) as exc:
+            change["error"] = str(exc)
+            response["status"] = await _rollback(
+                client, domain_id, response["changes"][:-1]
+            )
+            message = f"Cutover failed on record {change['record_id']}: {exc}. "
+            if response["status"] == _ROLLED_BACK:
+                message += (
+                    "The records already moved were restored to their prior targets."
+                )
+            else:
+                message += (
+                    "Rollback did not complete; records whose change has an error "
+                    "and rolled_back=false still point at the new targets."
+                )
+            response["message"] = message
+            add_warning(message)
+            return response
+
+    response["message"] = (
+        f"Cut over {len(planned)} record(s) in domain {domain_id}. The prior "
+        "targets are in changes[].old_target; to roll back, call again with "
+        "from_ips and to_ips swapped."
+    )
+    return response
+
+
+class DNSProtocol(asyncio.DatagramProtocol):
+    """Resolve a future with the first datagram a resolver sends back."""
+
+    def __init__(self) -> None:
+        self.response: asyncio.Future[bytes] = (
+            asyncio.get_running_loop().create_future()
+        )
+
+    def datagram_received(self, data: bytes, addr: Any) -> None:  # noqa: ARG002
+        if not self.response.done():
+            self.response.set_result(data)
+
+    def error_received(self, exc: Exception) -> None:
+        if not self.response.done():
+            self.response.set_exception(exc)
+
+
+def build_query(query_id: int, fqdn: str, qtype: int) -> bytes:
+    """Encode a recursive single-question DNS query."""
+    header = struct.pack("!HHHHHH", query_id, 0x0100, 1, 0, 0, 0)
+    qname = b"".join(
+        bytes([len(label)]) + label.encode("ascii")
+        for label in fqdn.rstrip(".").split(".")
+    )
+    return header + qname + b"\x00" + struct.pack("!HH", qtype, 1)
+
+
+def skip_name(packet: bytes, offset: int) -> int:
+    """Return the offset just past the (possibly compressed) name at offset."""
+    while True:
+        length = packet[offset]
+        if length & 0xC0 == 0xC0:
+            return offset + 2
+        offset += 1
+        if length == 0:
+            return offset
+        offset += length
+
+
+def _parse_answers(packet: bytes, query_id: int, qtype: int) -> list[str]:
+    """Decode the addresses of the given type from a DNS response."""
+    response_id, flags, qdcount, ancount, _, _ = struct.unpack_from("!HHHHHH", packet)
+    if response_id != query_id:
+        msg = "DNS response ID mismatch"
+        raise ValueError(msg)
+    rcode = flags & 0x000F
+    if rcode == RCODE_NXDOMAIN:
+        msg = "no such host"
+        raise ValueError(msg)
+    if rcode:
+        msg = f"resolver returned rcode {rcode}"
+        raise ValueError(msg)
+
+    offset = 12
+    for _ in range(qdcount):
+        offset = skip_name(packet, offset) + 4
+    answers: list[str] = []
+    for _ in range(ancount):
+        offset = skip_name(packet, offset)
+        rtype, _, _, rdlength = struct.unpack_from("!HHIH", packet, offset)
+        offset += 10
+        if rtype == qtype:
+            rdata = packet[offset : offset + rdlength]
+            answers.append(str(ipaddress.ip_address(rdata)))
+        offset += rdlength
+    return answers
+
+
+def resolver_address(resolver: str) -> tuple[str, int]:
+    """Split 'ip' or 'ip:port' ('[v6]:port') into host and port."""
+    try:
+        ipaddress.ip_address(resolver)
+    except ValueError:
+        host, _, port = resolver.rpartition(":")
+        return host.strip("[]"), int(port)
+    return resolver, _DNS_PORT
+
+
+async def _lookup(resolver: str, fqdn: str, record_type: str) -> list[str]:
+    """Query one resolver directly over UDP for fqdn's A or AAAA records."""
+    host, port = resolver_address(resolver)
+    query_id = secrets.randbelow(0x10000)
+    qtype = _QTYPES[record_type]
+    loop = asyncio.get_running_loop()
+    transport, protocol = await loop.create_datagram_endpoint(
+        DNSProtocol, remote_addr=(host, port)
+    )
+    try:
+        transport.sendto(build_query(query_id, fqdn, qtype))
+        packet = await asyncio.wait_for(protocol.response, LOOKUP_TIMEOUT_SECONDS)
+    finally:
+        transport.close()
+    return sorted(_parse_answers(packet, query_id, qtype))
+
+
+async def _check(resolver: str, target: dict[str, Any]) -> dict[str, Any]:
+    """Ask one resolver for a changed name; mirrors Go's checkDNSCutoverName."""
+    check: dict[str, Any] = {
+        "name": target["fqdn"],
+        "type": target["type"],
+        "resolver": resolver,
+        "answers": [],
+        "propagated": False,
+    }
+    try:
+        answers = await _lookup(resolver, target["fqdn"], target["type"])
+    except (OSError, ValueError, TimeoutError, struct.error) as exc:
+        check["error"] = str(exc) or type(exc).__name__
+        return check
+    check["answers"] = answers
+    check["propagated"] = all(ip in answers for ip in target["expected"]) and not any(
+        ip in answers for ip in target["replaced"]
+    )
+    return check
+
+
+async def _verify(
+    client: RetryableClient, args: dict[str, Any], response: dict[str, Any]
+) -> None:
+    """Query every resolver for every changed name and record the answers."""
+    domain_id = args["domain_id"]
+    try:
+        domain = await client.get_raw(f"/domains/{domain_id}")
+    except (APIError, NetworkError) as exc:
+        warning = f"verification skipped: failed to retrieve domain {domain_id}: {exc}"
+        response["warnings"].append(warning)
+        add_warning(warning)
+        return
+
+    zone = str(domain.get("domain", "")) if isinstance(domain, dict) else ""
+    names: dict[str, dict[str, Any]] = {}
+    for change in response["changes"]:
+        key = f"{change['name']}/{change['type']}"
+        entry = names.get(key)
+        if entry is None:
+            fqdn = f"{change['name']}.{zone}" if change["name"] else zone
+            entry = {
+                "fqdn": fqdn,
+                "type": change["type"],
+                "expected": [],
+                "replaced": [],
+            }
+            names[key] = entry
+        entry["expected"].append(_canonical_ip(change["new_target"]))
+        entry["replaced"].append(_canonical_ip(change["old_target"]))
+
+    response["verification"] = await asyncio.gather(
+        *(
+            _check(resolver, target)
+            for target in names.values()
+            for resolver in args["resolvers"]
+        )
+    )
+
+
+async def handle_linode_dns_cutover(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_dns_cutover tool request."""
+    if is_dry_run(arguments):
+        args, args_error = _parse_args(arguments)
+        if args_error:
+            return error_response(args_error)
+        warnings: list[str] = []
+
+        async def _fetch(client: RetryableClient) -> Any:
+            raw = await client.get_raw(f"/domains/{args['domain_id']}/records")
+            planned, plan_warnings = _plan(walk_page_items(raw), args)
+            warnings.extend(plan_warnings)
+            return [_record_state(record) for record in planned]
+
+        async def _walk(_client: RetryableClient, state: Any) -> DryRunDetails:
+            return {"side_effects": _side_effects(state, args), "warnings": warnings}
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_dns_cutover",
+            "PUT",
+            f"/domains/{args['domain_id']}/records/{{record_id}}",
+            _fetch,
+            _walk,
+        )
+
+    if not arguments.get("confirm"):
+        return error_response(
+            "This repoints live DNS records. Set confirm=true to proceed."
+        )
+
+    args, args_error = _parse_args(arguments)
+    if args_error:
+        return error_response(args_error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        raw = await client.get_raw(f"/domains/{args['domain_id']}/records")
+        planned, warnings = _plan(walk_page_items(raw), args)
+        if not planned:
+            msg = (
+                "no A/AAAA records in names point at any of from_ips; "
+                "nothing to cut over"
+            )
+            raise ValueError(msg)
+        for warning in warnings:
+            add_warning(warning)
+        response = await _apply(client, args, planned)
+        response["warnings"] = warnings
+        if response["status"] == _APPLIED and args["verify"]:
+            await _verify(client, args, response)
+        return serialize_api_response(response, domain_pb2.DNSCutoverResponse())
+
+    return await execute_tool(cfg, arguments, "cut over DNS records", _call)
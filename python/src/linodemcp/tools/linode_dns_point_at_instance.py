@@ -0,0 +1,346 @@
+"""linode_dns_point_at_instance: point a hostname's A/AAAA records at an instance.
+
+Mirrors ``go/internal/tools/linode_dns_point_at_instance.go``.
+"""
+
+from __future__ import annotations
+
+import ipaddress
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import domain_pb2
+from linodemcp.linode import (
+    APIError,
+    NetworkError,
+    validate_dns_record_name,
+    validate_dns_record_target,
+)
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+    walk_page_items,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import Instance, RetryableClient
+
+_CREATED = "created"
+_UPDATED = "updated"
+_UNCHANGED = "unchanged"
+
+
+def create_linode_dns_point_at_instance_tool() -> tuple[Tool, Capability]:
+    """Create the linode_dns_point_at_instance tool."""
+    return Tool(
+        name="linode_dns_point_at_instance",
+        description=(
+            "Points hostname in a domain at an instance (by ID or label): creates "
+            "or updates the A record from the instance's public IPv4 and the AAAA "
+            "record from its IPv6 in one call. Records already pointing at the "
+            "instance are left alone. Refuses a hostname with more than one A (or "
+            "AAAA) record; use linode_dns_cutover for those. Pass dry_run=true to "
+            "preview the record changes without writing."
+        ),
+        inputSchema=schema("linode.mcp.v1.DNSPointAtInstanceInput"),
+    ), Capability.Write
+
+
+def _normalize_name(name: str) -> str:
+    """Map a requested name onto Linode's stored form ('@' is the apex '')."""
+    name = name.strip().lower()
+    return "" if name == "@" else name
+
+
+def _canonical_ip(value: str) -> str:
+    """Canonical text form of an IP, or the input when it does not parse."""
+    try:
+        return str(ipaddress.ip_address(value.strip()))
+    except ValueError:
+        return value
+
+
+def _parse_args(arguments: dict[str, Any]) -> tuple[dict[str, Any], str]:
+    """Validate the input; mirrors Go's parseDNSPointArgs."""
+    domain_id = arguments.get("domain_id", 0)
+    if not domain_id:
+        return {}, "domain_id is required"
+
+    hostname = str(arguments.get("hostname") or "").strip()
+    if not hostname:
+        return {}, "hostname is required (use '@' for the zone apex)"
+    try:
+        validate_dns_record_name(hostname)
+    except ValueError as exc:
+        return {}, str(exc)
+
+    instance = str(arguments.get("instance") or "").strip()
+    if not instance:
+        return {}, "instance is required"
+
+    ttl_sec = int(arguments.get("ttl_sec") or 0)
+    if ttl_sec < 0:
+        return {}, "ttl_sec must not be negative"
+
+    return {
+        "domain_id": int(domain_id),
+        "hostname": hostname,
+        "name": _normalize_name(hostname),
+        "instance": instance,
+        "ttl_sec": ttl_sec,
+    }, ""
+
+
+async def _resolve_instance(client: RetryableClient, ref: str) -> Instance:
+    """Find an instance by numeric ID or, failing that, by label."""
+    if ref.isdigit():
+        return await client.get_instance(int(ref))
+    for instance in await client.list_instances():
+        if instance.label.lower() == ref.lower():
+            return instance
+    msg = f'no instance with that label: "{ref}"'
+    raise ValueError(msg)
+
+
+def instance_public_addresses(instance: Instance) -> tuple[str, str]:
+    """The first public IPv4 and the SLAAC IPv6 (without its /128) of an
+    instance; either is "" when the instance has none."""
+    ipv4 = ""
+    for address in instance.ipv4:
+        try:
+            validate_dns_record_target("A", address)
+        except ValueError:
+            continue
+        ipv4 = str(ipaddress.ip_address(address))
+        break
+
+    ipv6 = ""
+    try:
+        parsed = ipaddress.ip_address((instance.ipv6 or "").split("/", 1)[0])
+    except ValueError:
+        pass
+    else:
+        if parsed.version == 6:  # noqa: PLR2004
+            ipv6 = str(parsed)
+    return ipv4, ipv6
+
+
+def _action(plan: dict[str, Any]) -> str:
+    existing = plan["existing"]
+    if existing is None:
+        return _CREATED
+    if _canonical_ip(str(existing.get("target", ""))) == plan["target"]:
+        return _UNCHANGED
+    return _UPDATED
+
+
+def _plan(
+    records: list[dict[str, Any]], args: dict[str, Any], ipv4: str, ipv6: str
+) -> list[dict[str, Any]]:
+    """Pair each address with its record; mirrors Go's planDNSPoint."""
+    plans: list[dict[str, Any]] = []
+    for record_type, target in (("A", ipv4), ("AAAA", ipv6)):
+        if not target:
+            continue
+        matches = [
+            record
+            for record in records
+            if str(record.get("type", "")).upper() == record_type
+            and str(record.get("name", "")).lower() == args["name"]
+        ]
+        if len(matches) > 1:
+            msg = (
+                f'"{args["hostname"]}" has {len(matches)} {record_type} records: '
+                "refusing to pick one; update them individually or use "
+                "linode_dns_cutover"
+            )
+            raise ValueError(msg)
+        plan = {
+            "type": record_type,
+            "target": target,
+            "existing": matches[0] if matches else None,
+        }
+        plan["action"] = _action(plan)
+        plans.append(plan)
+    return plans
+
+
+async def _resolve(
+    client: RetryableClient, args: dict[str, Any]
+) -> tuple[Instance, list[dict[str, Any]], list[str]]:
+    """Load the instance and the records and plan the writes."""
+    instance = await _resolve_instance(client, args["instance"])
+    ipv4, ipv6 = instance_public_addresses(instance)
+    if not ipv4 and not ipv6:
+        msg = (
+            f"instance {instance.id} ({instance.label}): "
+            "no public IPv4 or IPv6 address"
+        )
+        raise ValueError(msg)
+
+    warnings: list[str] = []
+    if not ipv4:
+        warnings.append(
+            f"Instance {instance.id} ({instance.label}) has no public IPv4 "
+            "address; no A record is written."
+        )
+    if not ipv6:
+        warnings.append(
+            f"Instance {instance.id} ({instance.label}) has no public IPv6 "
+            "address; no AAAA record is written."
+        )
+
+    raw = await client.get_raw(f"/domains/{args['domain_id']}/records")
+    return instance, _plan(walk_page_items(raw), args, ipv4, ipv6), warnings
+
+
+def _record_state(plan: dict[str, Any], args: dict[str, Any]) -> dict[str, Any]:
+    """The dry-run current_state entry; matches Go's dnsPointRecordState."""
+    existing = plan["existing"] or {}
+    return {
+        "record_id": existing.get("id", 0),
+        "type": plan["type"],
+        "name": args["name"],
+        "target": plan["target"],
+        "action": plan["action"],
+        "old_target": existing.get("target", ""),
+    }
+
+
+def _side_effects(plans: list[dict[str, Any]], args: dict[str, Any]) -> list[str]:
+    """Tier B walk: one line per record written."""
+    hostname = args["hostname"]
+    side_effects: list[str] = []
+    for plan in plans:
+        existing = plan["existing"] or {}
+        if plan["action"] == _CREATED:
+            side_effects.append(
+                f'Creates {plan["type"]} record "{hostname}" pointing at '
+                f"{plan['target']}."
+            )
+        elif plan["action"] == _UPDATED:
+            side_effects.append(
+                f'Record {existing.get("id", 0)} ({plan["type"]} "{hostname}") '
+                f"target changes from {existing.get('target', '')} to "
+                f"{plan['target']}."
+            )
+        else:
+            side_effects.append(
+                f'Record {existing.get("id", 0)} ({plan["type"]} "{hostname}") '
+                f"already points at {plan['target']}; left alone."
+            )
+    return side_effects
+
+
+async def _apply(
+    client: RetryableClient, args: dict[str, Any], plans: list[dict[str, Any]]
+) -> dict[str, Any]:
+    """Write each changing record; a failure is recorded on its record."""
+    domain_id = args["domain_id"]
+    response: dict[str, Any] = {"domain_id": domain_id, "records": []}
+    written = failed = 0
+    for plan in plans:
+        existing = plan["existing"] or {}
+        record: dict[str, Any] = {
+            "record_id": existing.get("id", 0),
+            "type": plan["type"],
+            "name": args["name"],
+            "target": plan["target"],
+            "action": plan["action"],
+        }
+        response["records"].append(record)
+        body: dict[str, Any] = {"target": plan["target"]}
+        if args["ttl_sec"]:
+            body["ttl_sec"] = args["ttl_sec"]
+        try:
+            if plan["action"] == _CREATED:
+                body.update(type=plan["type"], name=args["name"])
+                result = await client.post_raw(f"/domains/{domain_id}/records", body)
+            elif plan["action"] == _UPDATED:
+                record["old_target"] = existing.get("target", "")
+                result = await client.put_raw(
+                    f"/domains/{domain_id}/records/{existing.get('id', 0)}", body
+                )
+            else:
+                continue
+        except (APIError, NetworkError) as exc:
+            record["error"] = str(exc)
+            failed += 1
+            continue
+        if isinstance(result, dict):
+            record["record_id"] = result.get("id", record["record_id"])
+        written += 1
+
+    response["message"] = (
+        f'Pointed "{args["hostname"]}" at the instance: {written} record(s) '
+        f"written, {len(plans) - written - failed} already matched."
+    )
+    if failed:
+        response["message"] += f" {failed} record(s) failed, see records[].error."
+        add_warning(response["message"])
+    return response
+
+
+async def handle_linode_dns_point_at_instance(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_dns_point_at_instance tool request."""
+    if is_dry_run(arguments):
+        args, args_error = _parse_args(arguments)
+        if args_error:
+            return error_response(args_error)
+        plans: list[dict[str, Any]] = []
+        warnings: list[str] = []
+
+        async def _fetch(client: RetryableClient) -> Any:
+            _, planned, plan_warnings = await _resolve(client, args)
+            plans.extend(planned)
+            warnings.extend(plan_warnings)
+            return [_record_state(plan, args) for plan in planned]
+
+        async def _walk(_client: RetryableClient, _state: Any) -> DryRunDetails:
+            return {"side_effects": _side_effects(plans, args), "warnings": warnings}
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_dns_point_at_instance",
+            "POST",
+            f"/domains/{args['domain_id']}/records",
+            _fetch,
+            _walk,
+        )
+
+    if not arguments.get("confirm"):
+        return error_response(
+            "This writes live DNS records. Set confirm=true to proceed."
+        )
+
+    args, args_error = _parse_args(arguments)
+    if args_error:
+        return error_response(args_error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        instance, plans, warnings = await _resolve(client, args)
+        for warning in warnings:
+            add_warning(warning)
+        response = await _apply(client, args, plans)
+        response.update(
+            instance_id=instance.id,
+            instance_label=instance.label,
+            warnings=warnings,
+        )
+        return serialize_api_response(
+            response, domain_pb2.DNSPointAtInstanceResponse()
+        )
+
+    return await execute_tool(cfg, arguments, "point DNS at instance", _call)
@@ -0,0 +1,724 @@
+"""Linode domain BIND zone file tools: export records and import zone text."""
+
+from __future__ import annotations
+
+import ipaddress
+import json
+import re
+from dataclasses import dataclass, field
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import domain_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+    required_int_id,
+    walk_page_items,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+# The $TTL an export writes when the domain has no default TTL of its own;
+# it matches Linode's default.
+_DEFAULT_TTL = 86400
+# The longest character-string one TXT value may hold.
+_TXT_CHUNK = 255
+_APEX = "@"
+_HOSTNAME_TYPES = ("NS", "CNAME", "MX", "SRV")
+# How many values follow each supported record type; TXT takes one or more.
+_RECORD_VALUES = {
+    "A": 1,
+    "AAAA": 1,
+    "NS": 1,
+    "CNAME": 1,
+    "MX": 2,
+    "TXT": 1,
+    "SRV": 4,
+    "CAA": 3,
+}
+# How many leading values of a record type are numbers.
+_NUMERIC_VALUES = {"MX": 1, "SRV": 3, "CAA": 1}
+_TTL_UNITS = {"s": 1, "m": 60, "h": 3600, "d": 86400, "w": 604800}
+_MAX_NUMBER = 65535
+_TOKEN_DELIMITERS = ' \t\r\n;()"'
+
+
+@dataclass
+class _Line:
+    """One logical zone file line (mirrors Go bindLine)."""
+
+    line: int
+    indented: bool
+    tokens: list[tuple[str, bool]] = field(default_factory=list)
+
+
+@dataclass
+class _Entry:
+    """One parsed zone file record (mirrors Go bindEntry)."""
+
+    line: int
+    name: str
+    origin: str
+    ttl_sec: int
+    type: str = ""
+    priority: int = 0
+    weight: int = 0
+    port: int = 0
+    service: str = ""
+    protocol: str = ""
+    tag: str = ""
+    skip: str = ""
+    raw_value: str = ""
+
+
+def create_linode_domain_records_export_bind_tool() -> tuple[Tool, Capability]:
+    """Create the linode_domain_records_export_bind tool."""
+    return Tool(
+        name="linode_domain_records_export_bind",
+        description=(
+            "Renders a domain's DNS records as BIND zone file text ($ORIGIN, "
+            "$TTL, one line per record) for migrating to another DNS provider "
+            "or keeping a backup. The SOA and apex NS records are managed by "
+            "Linode and are not included."
+        ),
+        inputSchema=schema("linode.mcp.v1.DomainRecordsExportBindInput"),
+    ), Capability.Read
+
+
+def create_linode_domain_records_import_bind_tool() -> tuple[Tool, Capability]:
+    """Create the linode_domain_records_import_bind tool."""
+    return Tool(
+        name="linode_domain_records_import_bind",
+        description=(
+            "Parses pasted BIND zone file text and creates its A, AAAA, NS, MX, "
+            "CNAME, TXT, SRV, and CAA records in a domain, e.g. when migrating "
+            "from another DNS provider. Records that already exist, the SOA, "
+            "apex NS records, names outside the domain, and unsupported types "
+            "are skipped and listed. Pass dry_run=true to preview the "
+            "per-record plan without creating anything."
+        ),
+        inputSchema=schema("linode.mcp.v1.DomainRecordsImportBindInput"),
+    ), Capability.Write
+
+
+def _quote(value: str) -> str:
+    """Quote one character-string, escaping backslashes and quotes."""
+    return '"' + value.replace("\\", "\\\\").replace('"', '\\"') + '"'
+
+
+def _quote_txt(value: str) -> str:
+    """Quote a TXT value, splitting it into 255-character strings."""
+    chunks = [value[i : i + _TXT_CHUNK] for i in range(0, len(value), _TXT_CHUNK)]
+    return " ".join(_quote(chunk) for chunk in chunks or [""])
+
+
+def _hostname(target: str) -> str:
+    """Write a dotted host name target as an absolute name."""
+    if not target or target.endswith(".") or "." not in target:
+        return target
+    return target + "."
+
+
+def _record_owner(record: dict[str, Any]) -> str:
+    """A record's owner name relative to the zone (mirrors Go
+    bindRecordOwner)."""
+    name = str(record.get("name") or "")
+    if record.get("type") == "SRV" and not name.startswith("_"):
+        service = str(record.get("service") or "").lstrip("_")
+        protocol = str(record.get("protocol") or "").lstrip("_")
+        owner = f"_{service}._{protocol}"
+        return f"{owner}.{name}" if name else owner
+    return name or _APEX
+
+
+def _render_zone(domain: dict[str, Any], records: list[dict[str, Any]]) -> str:
+    """Render the records as zone file text (mirrors Go renderBindZone)."""
+    name = str(domain.get("domain") or "")
+    ttl = int(domain.get("ttl_sec") or 0)
+    if ttl <= 0:
+        ttl = _DEFAULT_TTL
+    lines = [
+        f"; {name} exported from Linode domain {domain.get('id', 0)}.",
+        "; The SOA and apex NS records are managed by the DNS provider and are "
+        "not included.",
+        f"$ORIGIN {name}.",
+        f"$TTL {ttl}",
+    ]
+    for record in records:
+        record_type = str(record.get("type") or "")
+        target = str(record.get("target") or "")
+        fields = [_record_owner(record)]
+        if int(record.get("ttl_sec") or 0) > 0:
+            fields.append(str(record["ttl_sec"]))
+        fields += ["IN", record_type]
+        if record_type == "MX":
+            fields += [str(record.get("priority") or 0), _hostname(target)]
+        elif record_type == "SRV":
+            fields += [
+                str(record.get("priority") or 0),
+                str(record.get("weight") or 0),
+                str(record.get("port") or 0),
+                _hostname(target),
+            ]
+        elif record_type in ("NS", "CNAME"):
+            fields.append(_hostname(target))
+        elif record_type == "TXT":
+            fields.append(_quote_txt(target))
+        elif record_type == "CAA":
+            fields += ["0", str(record.get("tag") or ""), _quote(target)]
+        else:
+            fields.append(target)
+        lines.append("\t".join(fields))
+    return "\n".join(lines) + "\n"
+
+
+def _tokenize(text: str) -> tuple[list[_Line], str]:
+    """Split zone text into logical lines, dropping comments and joining lines
+    inside parentheses (mirrors Go tokenizeBindZone)."""
+    lines: list[_Line] = []
+    cur = _Line(line=1, indented=False)
+    depth = 0
+    line_no = 1
+    at_line_start = True
+    i = 0
+    while i < len(text):
+        c = text[i]
+        if at_line_start and depth == 0:
+            if cur.tokens:
+                lines.append(cur)
+            cur = _Line(line=line_no, indented=c in " \t")
+        at_line_start = False
+
+        if c == "\n":
+            line_no += 1
+            at_line_start = True
+        elif c in " \t\r":
+            pass
+        elif c == ";":
+            while i + 1 < len(text) and text[i + 1] != "\n":
+                i += 1
+        elif c == "(":
+            depth += 1
+        elif c == ")":
+            if depth == 0:
+                return [], f"zone_file line {line_no}: unbalanced ')'"
+            depth -= 1
+        elif c == '"':
+            start = line_no
+            value: list[str] = []
+            closed = False
+            i += 1
+            while i < len(text):
+                if text[i] == "\\" and i + 1 < len(text):
+                    i += 1
+                elif text[i] == '"':
+                    closed = True
+                    break
+                if text[i] == "\n":
+                    line_no += 1
+                value.append(text[i])
+                i += 1
+            if not closed:
+                return [], f"zone_file line {start}: unterminated quoted string"
+            cur.tokens.append(("".join(value), True))
+        else:
+            start = i
+            while i + 1 < len(text) and text[i + 1] not in _TOKEN_DELIMITERS:
+                i += 1
+            cur.tokens.append((text[start : i + 1], False))
+        i += 1
+
+    if depth > 0:
+        return [], f"zone_file line {cur.line}: unbalanced '('"
+    if cur.tokens:
+        lines.append(cur)
+    return lines, ""
+
+
+def _atoi(value: str) -> int | None:
+    """Parse a decimal integer the way Go's strconv.Atoi does."""
+    if not re.fullmatch(r"[+-]?\d+", value):
+        return None
+    return int(value)
+
+
+def _parse_ttl(value: str) -> int | None:
+    """Read a TTL in seconds, bare or with s, m, h, d, and w units (mirrors
+    Go parseBindTTL); None when invalid."""
+    number = _atoi(value)
+    if number is not None:
+        return number if number >= 0 else None
+    total = 0
+    digits = ""
+    for char in value:
+        if "0" <= char <= "9":
+            digits += char
+        elif char.lower() in _TTL_UNITS and digits:
+            total += int(digits) * _TTL_UNITS[char.lower()]
+            digits = ""
+        else:
+            return None
+    return total if not digits else None
+
+
+def _qualify_name(name: str, origin: str) -> str:
+    """Resolve an owner against the $ORIGIN in force (mirrors Go
+    qualifyBindName)."""
+    name = name.lower()
+    if name == _APEX:
+        return origin or _APEX
+    if name.endswith(".") or not origin:
+        return name
+    return f"{name}.{origin}"
+
+
+def _go_quote(value: str) -> str:
+    """Quote a value for a message the way Go's %q does for plain text."""
+    return json.dumps(value, ensure_ascii=False)
+
+
+def _parse_values(entry: _Entry, values: list[tuple[str, bool]]) -> str:
+    """Check the values after the record type and fill in the entry (mirrors
+    Go parseBindValues); return a validation message or ""."""
+    record_type = entry.type
+    want = _RECORD_VALUES.get(record_type)
+    if want is None:
+        if record_type == "SOA":
+            entry.skip = "the SOA record is managed by Linode"
+        else:
+            entry.skip = f"record type {record_type} is not supported by Linode DNS"
+        return ""
+
+    if record_type == "TXT":
+        if not values:
+            return "TXT record needs at least 1 value"
+    elif len(values) != want:
+        return f"{record_type} record needs {want} values, got {len(values)}"
+
+    numbers: list[int] = []
+    for text, _ in values[: _NUMERIC_VALUES.get(record_type, 0)]:
+        number = _atoi(text)
+        if number is None or not 0 <= number <= _MAX_NUMBER:
+            return f"invalid {record_type} value {_go_quote(text)}"
+        numbers.append(number)
+
+    last = values[-1][0]
+    if record_type == "A":
+        try:
+            ipaddress.IPv4Address(last)
+        except ValueError:
+            return f"invalid IPv4 address {_go_quote(last)}"
+    elif record_type == "AAAA":
+        try:
+            ipaddress.IPv6Address(last)
+        except ValueError:
+            return f"invalid IPv6 address {_go_quote(last)}"
+    elif record_type == "MX":
+        entry.priority = numbers[0]
+    elif record_type == "SRV":
+        labels = entry.name.split(".", 2)
+        if (
+            len(labels) < 2  # noqa: PLR2004 - service and protocol labels
+            or not labels[0].startswith("_")
+            or not labels[1].startswith("_")
+        ):
+            return (
+                f"SRV owner {_go_quote(entry.name)} must start with "
+                "_service._protocol"
+            )
+        entry.service = labels[0][1:]
+        entry.protocol = labels[1][1:]
+        entry.name = labels[2] if len(labels) == 3 else _APEX  # noqa: PLR2004
+        entry.priority, entry.weight, entry.port = numbers
+    elif record_type == "TXT":
+        last = "".join(text for text, _ in values)
+    elif record_type == "CAA":
+        entry.tag = values[1][0]
+
+    entry.raw_value = last
+    return ""
+
+
+def _parse_directive(line: _Line, state: dict[str, Any]) -> str:
+    """Apply a $ORIGIN or $TTL line to the parser state; return a validation
+    message or ""."""
+    directive_token = line.tokens[0][0]
+    directive = directive_token.upper()
+    if directive not in ("$ORIGIN", "$TTL"):
+        return (
+            f"zone_file line {line.line}: unsupported directive {directive_token}"
+        )
+    if len(line.tokens) != 2:  # noqa: PLR2004 - the directive and its value
+        return f"zone_file line {line.line}: {directive} takes one value"
+    value = line.tokens[1][0]
+    if directive == "$ORIGIN":
+        if not value.endswith("."):
+            return (
+                f"zone_file line {line.line}: $ORIGIN must be an absolute name "
+                "ending in '.'"
+            )
+        state["origin"] = value.lower()
+        return ""
+    ttl = _parse_ttl(value)
+    if ttl is None:
+        return f"zone_file line {line.line}: invalid TTL {_go_quote(value)}"
+    state["ttl"] = ttl
+    return ""
+
+
+def _parse_zone(text: str) -> tuple[list[_Entry], str]:
+    """Parse zone text into entries (mirrors Go parseBindZone)."""
+    lines, error = _tokenize(text)
+    if error:
+        return [], error
+
+    entries: list[_Entry] = []
+    state: dict[str, Any] = {"origin": "", "ttl": 0}
+    last_owner = ""
+    for line in lines:
+        tokens = line.tokens
+        if not tokens[0][1] and tokens[0][0].startswith("$"):
+            error = _parse_directive(line, state)
+            if error:
+                return [], error
+            continue
+
+        entry = _Entry(
+            line=line.line, name="", origin=state["origin"], ttl_sec=state["ttl"]
+        )
+        if line.indented:
+            if not last_owner:
+                return [], f"zone_file line {line.line}: record has no owner name"
+            entry.name = last_owner
+        else:
+            entry.name = _qualify_name(tokens[0][0], state["origin"])
+            last_owner = entry.name
+            tokens = tokens[1:]
+
+        while tokens and not tokens[0][1]:
+            word = tokens[0][0]
+            if word.upper() == "IN":
+                tokens = tokens[1:]
+                continue
+            if not "0" <= word[0] <= "9":
+                break
+            ttl = _parse_ttl(word)
+            if ttl is None:
+                return [], f"zone_file line {line.line}: invalid TTL {_go_quote(word)}"
+            entry.ttl_sec = ttl
+            tokens = tokens[1:]
+
+        if not tokens:
+            return [], f"zone_file line {line.line}: missing record type"
+        entry.type = tokens[0][0].upper()
+        error = _parse_values(entry, tokens[1:])
+        if error:
+            return [], f"zone_file line {line.line}: {error}"
+        entries.append(entry)
+    return entries, ""
+
+
+def _relative_name(name: str, domain: str) -> str | None:
+    """Make a parsed name relative to the domain; None when outside it."""
+    if name == _APEX:
+        return ""
+    if not name.endswith("."):
+        return name
+    name = name[:-1]
+    if name == domain:
+        return ""
+    if name.endswith("." + domain):
+        return name[: -len(domain) - 1]
+    return None
+
+
+def _target(entry: _Entry, domain: str) -> str:
+    """Resolve a host name target against the entry's origin or the domain."""
+    target = entry.raw_value.lower()
+    base = entry.origin.removesuffix(".") or domain
+    if target == _APEX:
+        return base
+    if target.endswith("."):
+        return target[:-1]
+    return f"{target}.{base}"
+
+
+def _find_record(
+    existing: list[dict[str, Any]], record_type: str, name: str, target: str
+) -> int | None:
+    """Find an existing record with the same type, name, and target (mirrors
+    Go findBindRecord)."""
+    for record in existing:
+        existing_name = str(record.get("name") or "")
+        if record.get("type") == "SRV" and existing_name.startswith("_"):
+            labels = existing_name.split(".", 2)
+            existing_name = labels[2] if len(labels) == 3 else ""  # noqa: PLR2004
+        if (
+            record.get("type") == record_type
+            and existing_name.lower() == name.lower()
+            and str(record.get("target") or "").lower() == target.lower()
+        ):
+            return int(record.get("id") or 0)
+    return None
+
+
+def _plan_import(
+    domain: str, entries: list[_Entry], existing: list[dict[str, Any]]
+) -> tuple[list[dict[str, Any]], list[dict[str, Any]]]:
+    """Resolve entries into records to create and entries to skip (mirrors Go
+    planBindImport)."""
+    creates: list[dict[str, Any]] = []
+    skipped: list[dict[str, Any]] = []
+    domain = domain.lower()
+    for entry in entries:
+        name = _relative_name(entry.name, domain)
+        skip = {
+            "line": entry.line,
+            "type": entry.type,
+            "name": entry.name if name is None else name,
+        }
+        if entry.skip:
+            skipped.append({**skip, "reason": entry.skip})
+            continue
+        if name is None:
+            reason = f"{entry.name.removesuffix('.')} is outside domain {domain}"
+            skipped.append({**skip, "reason": reason})
+            continue
+        if entry.type == "NS" and not name:
+            reason = "apex NS records are managed by Linode"
+            skipped.append({**skip, "reason": reason})
+            continue
+        target = entry.raw_value
+        if entry.type in _HOSTNAME_TYPES:
+            target = _target(entry, domain)
+        existing_id = _find_record(existing, entry.type, name, target)
+        if existing_id is not None:
+            reason = f"already exists as record {existing_id}"
+            skipped.append({**skip, "reason": reason})
+            continue
+        creates.append(
+            {
+                "line": entry.line,
+                "type": entry.type,
+                "name": name,
+                "target": target,
+                "priority": entry.priority,
+                "weight": entry.weight,
+                "port": entry.port,
+                "service": entry.service,
+                "protocol": entry.protocol,
+                "tag": entry.tag,
+                "ttl_sec": entry.ttl_sec,
+            }
+        )
+    return creates, skipped
+
+
+def _display_name(name: str) -> str:
+    """Show the apex as "@"."""
+    return name or _APEX
+
+
+def _import_side_effects(state: Any, entries: list[_Entry]) -> DryRunDetails:
+    """Tier B walk: one line per record to create, one per skipped entry,
+    then a summary (mirrors Go bindImportSideEffects)."""
+    import_state = state if isinstance(state, dict) else {}
+    domain = str(import_state.get("domain") or "")
+    creates, skipped = _plan_import(domain, entries, import_state.get("records", []))
+    side_effects = [
+        f"Line {record['line']}: creates {record['type']} record "
+        f"{_go_quote(_display_name(record['name']))} -> "
+        f"{_go_quote(record['target'])}."
+        for record in creates
+    ]
+    side_effects += [
+        f"Line {skip['line']}: skips {skip['type']} record "
+        f"{_go_quote(_display_name(skip['name']))}: {skip['reason']}."
+        for skip in skipped
+    ]
+    side_effects.append(
+        f"{len(creates)} records would be created in {domain}; "
+        f"{len(skipped)} zone file entries are skipped."
+    )
+    return {"side_effects": side_effects}
+
+
+async def _fetch_import_state(client: RetryableClient, domain_id: int) -> Any:
+    """Read the domain and its records for the import plan (mirrors Go
+    fetchBindImportState)."""
+    domain = await client.get_raw(f"/domains/{domain_id}")
+    records = walk_page_items(await client.get_raw(f"/domains/{domain_id}/records"))
+    return {
+        "domain": str(domain.get("domain") or ""),
+        "records": [
+            {
+                "id": record.get("id", 0),
+                "type": record.get("type", ""),
+                "name": record.get("name", ""),
+                "target": record.get("target", ""),
+            }
+            for record in records
+        ],
+    }
+
+
+def _import_args(arguments: dict[str, Any]) -> tuple[int, list[_Entry], str]:
+    """Validate the import args and parse the zone file (mirrors Go
+    bindImportArgs)."""
+    domain_id, error = required_int_id(arguments, "domain_id")
+    if domain_id is None:
+        return 0, [], error
+    zone_file = arguments.get("zone_file")
+    if not isinstance(zone_file, str) or not zone_file.strip():
+        return 0, [], "zone_file is required"
+    entries, error = _parse_zone(zone_file)
+    if error:
+        return 0, [], error
+    if not entries:
+        return 0, [], "zone_file contains no records"
+    return domain_id, entries, ""
+
+
+async def _apply_import(
+    client: RetryableClient,
+    domain_id: int,
+    domain: str,
+    creates: list[dict[str, Any]],
+    skipped: list[dict[str, Any]],
+) -> dict[str, Any]:
+    """Create the planned records one at a time (mirrors Go applyBindImport).
+
+    A failed record does not stop the run; its entry carries the error.
+    """
+    created = failed = 0
+    for record in creates:
+        body: dict[str, Any] = {"type": record["type"], "target": record["target"]}
+        for key in (
+            "name",
+            "priority",
+            "weight",
+            "port",
+            "service",
+            "protocol",
+            "ttl_sec",
+            "tag",
+        ):
+            if record[key]:
+                body[key] = record[key]
+        try:
+            result = await client.post_raw(f"/domains/{domain_id}/records", body)
+            record["record_id"] = int(result.get("id") or 0)
+            created += 1
+        except (APIError, NetworkError) as exc:
+            record["error"] = str(exc)
+            failed += 1
+            add_warning(
+                "line %d (%s %s): %s",
+                record["line"],
+                record["type"],
+                _display_name(record["name"]),
+                exc,
+            )
+
+    return serialize_api_response(
+        {
+            "message": (
+                f"Imported zone file into {domain}: {created} records created, "
+                f"{len(skipped)} skipped, {failed} failed"
+            ),
+            "domain_id": domain_id,
+            "domain": domain,
+            "created": created,
+            "skipped": len(skipped),
+            "failed": failed,
+            "records": creates,
+            "skipped_entries": skipped,
+        },
+        domain_pb2.DomainRecordsImportBindResponse(),
+    )
+
+
+async def handle_linode_domain_records_export_bind(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_domain_records_export_bind tool request."""
+    domain_id, error = required_int_id(arguments, "domain_id")
+    if domain_id is None:
+        return error_response(error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        domain = await client.get_raw(f"/domains/{domain_id}")
+        records = walk_page_items(
+            await client.get_raw(f"/domains/{domain_id}/records")
+        )
+        return serialize_api_response(
+            {
+                "message": (
+                    f"Exported {len(records)} records from "
+                    f"{domain.get('domain', '')} as a BIND zone file"
+                ),
+                "domain_id": domain.get("id", 0),
+                "domain": domain.get("domain", ""),
+                "record_count": len(records),
+                "zone_file": _render_zone(domain, records),
+            },
+            domain_pb2.DomainRecordsExportBindResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "export domain records", _call)
+
+
+async def handle_linode_domain_records_import_bind(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_domain_records_import_bind tool request."""
+    if is_dry_run(arguments):
+        domain_id, entries, error = _import_args(arguments)
+        if error:
+            return error_response(error)
+
+        async def _fetch(client: RetryableClient) -> Any:
+            return await _fetch_import_state(client, domain_id)
+
+        async def _walk(_client: RetryableClient, state: Any) -> DryRunDetails:
+            return _import_side_effects(state, entries)
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_domain_records_import_bind",
+            "POST",
+            f"/domains/{domain_id}/records",
+            _fetch,
+            _walk,
+        )
+
+    if not arguments.get("confirm"):
+        return error_response(
+            "This creates DNS records from the zone file. Set confirm=true to proceed."
+        )
+
+    domain_id, entries, error = _import_args(arguments)
+    if error:
+        return error_response(error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        state = await _fetch_import_state(client, domain_id)
+        creates, skipped = _plan_import(state["domain"], entries, state["records"])
+        return await _apply_import(
+            client, domain_id, state["domain"], creates, skipped
+        )
+
+    return await execute_tool(cfg, arguments, "import domain records", _call)
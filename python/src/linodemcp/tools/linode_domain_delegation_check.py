@@ -0,0 +1,204 @@
+"""Linode MCP tool: check whether public DNS delegates a domain to Linode."""
+
+from __future__ import annotations
+
+import asyncio
+import secrets
+import struct
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import domain_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import error_response, execute_tool
+from linodemcp.tools.linode_dns_cutover import (
+    DEFAULT_RESOLVERS,
+    LOOKUP_TIMEOUT_SECONDS,
+    RCODE_NXDOMAIN,
+    DNSProtocol,
+    build_query,
+    resolver_address,
+    skip_name,
+    string_list,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+# Delegation outcomes reported in the response's status.
+_DELEGATED = "delegated"
+_PARTIAL = "partial"
+_NOT_DELEGATED = "not_delegated"
+_UNKNOWN = "unknown"
+
+# Nameservers Linode answers hosted domains from. Mirrors Go's
+# linodeNameservers.
+LINODE_NAMESERVERS = [
+    "ns1.linode.com",
+    "ns2.linode.com",
+    "ns3.linode.com",
+    "ns4.linode.com",
+    "ns5.linode.com",
+]
+
+_QTYPE_NS = 2
+
+
+def create_linode_domain_delegation_check_tool() -> tuple[Tool, Capability]:
+    """Create the linode_domain_delegation_check tool."""
+    return Tool(
+        name="linode_domain_delegation_check",
+        description=(
+            "Checks whether a domain is delegated to Linode: asks public "
+            "resolvers for the domain's NS records and reports whether they "
+            "name Linode's nameservers (ns1.linode.com through ns5.linode.com) "
+            "yet. Records in a Linode zone only take effect once the registrar "
+            "delegates the domain."
+        ),
+        inputSchema=schema("linode.mcp.v1.DomainDelegationCheckInput"),
+    ), Capability.Read
+
+
+def _read_name(packet: bytes, offset: int) -> str:
+    """Decode the (possibly compressed) name at offset."""
+    labels: list[str] = []
+    for _ in range(len(packet)):
+        length = packet[offset]
+        if length & 0xC0 == 0xC0:
+            offset = ((length & 0x3F) << 8) | packet[offset + 1]
+            continue
+        if length == 0:
+            return ".".join(labels)
+        labels.append(packet[offset + 1 : offset + 1 + length].decode("ascii"))
+        offset += 1 + length
+    msg = "DNS name compression loop"
+    raise ValueError(msg)
+
+
+def _parse_nameservers(packet: bytes, query_id: int) -> list[str]:
+    """Decode the NS hosts from a DNS response."""
+    response_id, flags, qdcount, ancount, _, _ = struct.unpack_from("!HHHHHH", packet)
+    if response_id != query_id:
+        msg = "DNS response ID mismatch"
+        raise ValueError(msg)
+    rcode = flags & 0x000F
+    if rcode == RCODE_NXDOMAIN:
+        msg = "no such host"
+        raise ValueError(msg)
+    if rcode:
+        msg = f"resolver returned rcode {rcode}"
+        raise ValueError(msg)
+
+    offset = 12
+    for _ in range(qdcount):
+        offset = skip_name(packet, offset) + 4
+    hosts: list[str] = []
+    for _ in range(ancount):
+        offset = skip_name(packet, offset)
+        rtype, _, _, rdlength = struct.unpack_from("!HHIH", packet, offset)
+        offset += 10
+        if rtype == _QTYPE_NS:
+            hosts.append(_read_name(packet, offset).lower())
+        offset += rdlength
+    return hosts
+
+
+async def _lookup_ns(resolver: str, domain: str) -> list[str]:
+    """Query one resolver directly over UDP for domain's NS records."""
+    host, port = resolver_address(resolver)
+    query_id = secrets.randbelow(0x10000)
+    loop = asyncio.get_running_loop()
+    transport, protocol = await loop.create_datagram_endpoint(
+        DNSProtocol, remote_addr=(host, port)
+    )
+    try:
+        transport.sendto(build_query(query_id, domain, _QTYPE_NS))
+        packet = await asyncio.wait_for(protocol.response, LOOKUP_TIMEOUT_SECONDS)
+    finally:
+        transport.close()
+    return sorted(_parse_nameservers(packet, query_id))
+
+
+async def _check_resolver(resolver: str, domain: str) -> dict[str, Any]:
+    """Ask one resolver for the domain's NS records; mirrors Go's
+    checkDelegationResolver."""
+    check: dict[str, Any] = {
+        "resolver": resolver,
+        "nameservers": [],
+        "delegated": False,
+    }
+    try:
+        nameservers = await _lookup_ns(resolver, domain)
+    except (OSError, ValueError, TimeoutError, struct.error) as exc:
+        check["error"] = str(exc) or type(exc).__name__
+        return check
+    check["nameservers"] = nameservers
+    check["delegated"] = bool(nameservers) and all(
+        host in LINODE_NAMESERVERS for host in nameservers
+    )
+    return check
+
+
+async def check_domain_delegation(
+    domain: dict[str, Any], resolvers: list[str]
+) -> dict[str, Any]:
+    """Ask every resolver for the domain's NS records and summarize whether
+    they name Linode's nameservers. Lookup failures become check entries,
+    never errors, so the report is advisory."""
+    name = str(domain.get("domain", ""))
+    checks = await asyncio.gather(
+        *(_check_resolver(resolver, name) for resolver in resolvers)
+    )
+    answered = sum(1 for check in checks if "error" not in check)
+    delegated = sum(1 for check in checks if check["delegated"])
+
+    if answered == 0:
+        status = _UNKNOWN
+        message = f"Delegation of {name} could not be checked: no resolver answered"
+    elif delegated == answered:
+        status = _DELEGATED
+        message = f"Domain {name} is delegated to Linode's nameservers"
+    elif delegated > 0:
+        status = _PARTIAL
+        message = (
+            f"Domain {name} is delegated to Linode on {delegated} of {answered} "
+            "resolvers; the change is still propagating"
+        )
+    else:
+        status = _NOT_DELEGATED
+        message = (
+            f"Domain {name} is not delegated to Linode yet: set its nameservers "
+            f"at the registrar to {LINODE_NAMESERVERS[0]} through "
+            f"{LINODE_NAMESERVERS[-1]}"
+        )
+    return {
+        "message": message,
+        "domain_id": domain.get("id", 0),
+        "domain": name,
+        "status": status,
+        "expected_nameservers": list(LINODE_NAMESERVERS),
+        "checks": list(checks),
+    }
+
+
+async def handle_linode_domain_delegation_check(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_domain_delegation_check tool request."""
+    domain_id = arguments.get("domain_id", 0)
+    if not domain_id:
+        return error_response("domain_id is required")
+    resolvers = string_list(arguments.get("resolvers")) or DEFAULT_RESOLVERS
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        domain = await client.get_raw(f"/domains/{domain_id}")
+        response = await check_domain_delegation(domain, resolvers)
+        return serialize_api_response(
+            response, domain_pb2.DomainDelegationCheckResponse()
+        )
+
+    return await execute_tool(cfg, arguments, "retrieve domain", _call)
@@ -28,7 +28,7 @@ from linodemcp.twostage.hash_ignore import hash_ignore_fields
 
 if TYPE_CHECKING:
     from linodemcp.config import Config
-    from linodemcp.linode import RetryableClient
+    from linodemcp.linode import DomainRecord, RetryableClient
 
 
 def create_linode_domain_record_list_tool() -> tuple[Tool, Capability]:
@@ -116,7 +116,12 @@ def create_linode_domain_record_create_tool() -> tuple[Tool, Capability]:
     """Create the linode_domain_record_create tool."""
     return Tool(
         name="linode_domain_record_create",
-        description="Creates a new DNS record for a domain.",
+        description=(
+            "Creates a new DNS record for a domain. A, AAAA, and CNAME records "
+            "are checked against the domain's records first, and a conflict (a "
+            "CNAME sharing its name, or an identical record) is reported with "
+            "the ID of the record in the way."
+        ),
         inputSchema=schema("linode.mcp.v1.DomainRecordCreateInput"),
     ), Capability.Write
 
@@ -147,6 +152,38 @@ def _validate_record_fields(
     return None
 
 
+# Record types a create checks against the domain's existing records first:
+# a CNAME cannot share its name with any other record, which the API rejects
+# without saying which record is in the way.
+_CONFLICT_CHECKED_TYPES = frozenset({"A", "AAAA", "CNAME"})
+
+
+def _domain_record_conflict(
+    records: list[DomainRecord], record_type: str, name: str, target: str
+) -> str:
+    """Return why a new record would clash with one of records, naming the
+    record in the way, or "". A CNAME clashes with every record at its name
+    and every record clashes with a CNAME; an A or AAAA record also clashes
+    with an identical one."""
+    new_type = record_type.upper()
+    for record in records:
+        if record.name.lower() != name.lower():
+            continue
+        if new_type == "CNAME" or record.type.upper() == "CNAME":
+            return (
+                f'{new_type} record "{name}" conflicts with existing '
+                f"{record.type} record (ID: {record.id}): a CNAME cannot share "
+                "its name with any other record"
+            )
+        if record.type.upper() == new_type and record.target.lower() == target.lower():
+            return (
+                f'{new_type} record "{name}" conflicts with existing '
+                f"{record.type} record (ID: {record.id}): it already points at "
+                f"{record.target}"
+            )
+    return ""
+
+
 def _domain_record_create_body(
     record_type: str, arguments: dict[str, Any]
 ) -> dict[str, Any]:
@@ -212,6 +249,16 @@ async def handle_linode_domain_record_create(
     body = _domain_record_create_body(record_type, arguments)
 
     async def _call(client: RetryableClient) -> dict[str, Any]:
+        if str(record_type).upper() in _CONFLICT_CHECKED_TYPES:
+            records = await client.list_domain_records(int(domain_id))
+            conflict = _domain_record_conflict(
+                records,
+                str(record_type),
+                str(arguments.get("name") or ""),
+                str(arguments.get("target") or ""),
+            )
+            if conflict:
+                raise ValueError(conflict)
         raw = await client.post_raw(f"/domains/{int(domain_id)}/records", body)
         rec_type = raw_str(raw, "type")
         rec_id = raw_int(raw, "id")
@@ -0,0 +1,193 @@
+"""Linode domain bulk TTL tool."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import domain_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+    walk_page_items,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+
+def create_linode_domain_ttl_set_tool() -> tuple[Tool, Capability]:
+    """Create the linode_domain_ttl_set tool."""
+    return Tool(
+        name="linode_domain_ttl_set",
+        description=(
+            "Sets the TTL on all DNS records in a domain, optionally narrowed by "
+            "record type or name, e.g. lowering everything to 300 before a "
+            "migration. Records already at the target TTL are skipped. Pass "
+            "dry_run=true to preview the per-record plan without updating."
+        ),
+        inputSchema=schema("linode.mcp.v1.DomainTTLSetInput"),
+    ), Capability.Write
+
+
+def _ttl_set_error(domain_id: Any, ttl_sec: Any) -> list[TextContent] | None:
+    """Validate the bulk TTL args; return an error response or None."""
+    if not domain_id:
+        return error_response("domain_id is required")
+    if not isinstance(ttl_sec, int) or ttl_sec <= 0:
+        return error_response("ttl_sec must be a positive number of seconds")
+    return None
+
+
+async def _matching_records(
+    client: RetryableClient, domain_id: int, arguments: dict[str, Any]
+) -> list[dict[str, Any]]:
+    """List the domain's records filtered with linode_domain_record_list's rules."""
+    type_filter = str(arguments.get("type") or "")
+    name_contains = str(arguments.get("name_contains") or "").lower()
+    raw = await client.get_raw(f"/domains/{domain_id}/records")
+
+    def _matches(record: dict[str, Any]) -> bool:
+        if type_filter and str(record.get("type", "")).upper() != type_filter.upper():
+            return False
+        return not name_contains or name_contains in str(record.get("name", "")).lower()
+
+    return [record for record in walk_page_items(raw) if _matches(record)]
+
+
+def _record_state(record: dict[str, Any]) -> dict[str, Any]:
+    """The plan fields of one record, matching Go's domainTTLRecordState."""
+    return {
+        "id": record.get("id", 0),
+        "type": record.get("type", ""),
+        "name": record.get("name", ""),
+        "ttl_sec": record.get("ttl_sec", 0),
+    }
+
+
+def _ttl_set_side_effects(states: list[dict[str, Any]], ttl_sec: int) -> DryRunDetails:
+    """Tier B walk: one line per record whose TTL changes, then a summary."""
+    side_effects: list[str] = []
+    for state in states:
+        if state["ttl_sec"] == ttl_sec:
+            continue
+        side_effects.append(
+            f'Record {state["id"]} ({state["type"]} "{state["name"]}") TTL '
+            f"changes from {state['ttl_sec']} to {ttl_sec}."
+        )
+    changing = len(side_effects)
+    side_effects.append(
+        f"{changing} of {len(states)} matching records change TTL to {ttl_sec}; "
+        f"{len(states) - changing} already at {ttl_sec}."
+    )
+    return {"side_effects": side_effects}
+
+
+async def _apply_ttl(
+    client: RetryableClient,
+    domain_id: int,
+    ttl_sec: int,
+    records: list[dict[str, Any]],
+) -> dict[str, Any]:
+    """Update each record not already at ttl_sec, one at a time.
+
+    A failed record does not stop the run; its change entry carries the error,
+    as Go's applyDomainTTL does.
+    """
+    changes: list[dict[str, Any]] = []
+    updated = unchanged = failed = 0
+    for record in records:
+        state = _record_state(record)
+        if state["ttl_sec"] == ttl_sec:
+            unchanged += 1
+            continue
+        change: dict[str, Any] = {
+            "record_id": state["id"],
+            "type": state["type"],
+            "name": state["name"],
+            "old_ttl_sec": state["ttl_sec"],
+            "new_ttl_sec": ttl_sec,
+        }
+        try:
+            await client.put_raw(
+                f"/domains/{domain_id}/records/{state['id']}", {"ttl_sec": ttl_sec}
+            )
+            updated += 1
+        except (APIError, NetworkError) as exc:
+            change["error"] = str(exc)
+            failed += 1
+            add_warning("record %d: %s", state["id"], exc)
+        changes.append(change)
+
+    return serialize_api_response(
+        {
+            "message": (
+                f"TTL set to {ttl_sec} on domain {domain_id}: {updated} updated, "
+                f"{unchanged} already at target, {failed} failed"
+            ),
+            "domain_id": domain_id,
+            "ttl_sec": ttl_sec,
+            "matched": len(records),
+            "updated": updated,
+            "unchanged": unchanged,
+            "failed": failed,
+            "changes": changes,
+        },
+        domain_pb2.DomainTTLSetResponse(),
+    )
+
+
+async def handle_linode_domain_ttl_set(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_domain_ttl_set tool request."""
+    domain_id = arguments.get("domain_id", 0)
+    ttl_sec = arguments.get("ttl_sec", 0)
+
+    if is_dry_run(arguments):
+        args_error = _ttl_set_error(domain_id, ttl_sec)
+        if args_error is not None:
+            return args_error
+
+        async def _fetch(client: RetryableClient) -> Any:
+            records = await _matching_records(client, int(domain_id), arguments)
+            return [_record_state(record) for record in records]
+
+        async def _walk(_client: RetryableClient, state: Any) -> DryRunDetails:
+            return _ttl_set_side_effects(state, ttl_sec)
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_domain_ttl_set",
+            "PUT",
+            f"/domains/{int(domain_id)}/records/{{record_id}}",
+            _fetch,
+            _walk,
+        )
+
+    if not arguments.get("confirm"):
+        return error_response(
+            "This updates the TTL of every matching DNS record. "
+            "Set confirm=true to proceed."
+        )
+
+    args_error = _ttl_set_error(domain_id, ttl_sec)
+    if args_error is not None:
+        return args_error
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        records = await _matching_records(client, int(domain_id), arguments)
+        return await _apply_ttl(client, int(domain_id), ttl_sec, records)
+
+    return await execute_tool(cfg, arguments, "retrieve domain records", _call)
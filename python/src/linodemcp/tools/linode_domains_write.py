@@ -18,6 +18,8 @@ from linodemcp.tools.helpers import (
     execute_tool,
     is_dry_run,
 )
+from linodemcp.tools.linode_dns_cutover import DEFAULT_RESOLVERS
+from linodemcp.tools.linode_domain_delegation_check import check_domain_delegation
 from linodemcp.tools.proto_response import raw_int, raw_str, serialize_api_response
 from linodemcp.tools.toolschemas import schema
 from linodemcp.tools.twostage_destroy import run_two_stage_destroy
@@ -178,7 +180,9 @@ def create_linode_domain_create_tool() -> tuple[Tool, Capability]:
     return Tool(
         name="linode_domain_create",
         description=(
-            "Creates a new DNS domain. Pass dry_run=true to preview without creating."
+            "Creates a new DNS domain. Pass dry_run=true to preview without creating. "
+            "Pass verify_delegation=true to also report whether public DNS "
+            "delegates the domain to Linode's nameservers yet."
         ),
         inputSchema=schema("linode.mcp.v1.DomainCreateInput"),
     ), Capability.Write
@@ -242,13 +246,15 @@ async def handle_linode_domain_create(
         raw = await client.post_raw("/domains", body)
         new_id = raw_int(raw, "id")
         new_label = raw_str(raw, "domain")
-        return serialize_api_response(
-            {
-                "message": f"Domain '{new_label}' (ID: {new_id}) created successfully",
-                "domain": raw,
-            },
-            domain_pb2.DomainWriteResponse(),
-        )
+        response: dict[str, Any] = {
+            "message": f"Domain '{new_label}' (ID: {new_id}) created successfully",
+            "domain": raw,
+        }
+        if arguments.get("verify_delegation"):
+            response["delegation"] = await check_domain_delegation(
+                raw, DEFAULT_RESOLVERS
+            )
+        return serialize_api_response(response, domain_pb2.DomainWriteResponse())
 
     return await execute_tool(cfg, arguments, "create domain", _call)
 
@@ -0,0 +1,275 @@
+"""linode_firewall_allow_instance: one inbound rule for an instance's addresses.
+
+The rule covers the instance's public IPv4 addresses (/32) and its IPv6
+address (/128) together, so a single "allow this instance" intent opens both
+families. Mirrors ``go/internal/tools/linode_firewall_allow_instance.go``.
+"""
+
+from __future__ import annotations
+
+import ipaddress
+import re
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import firewall_allow_instance_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+    required_int_id,
+)
+from linodemcp.tools.proto_enum import optional_enum_error
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import FirewallRule, Instance, RetryableClient
+
+_DEFAULT_PROTOCOL = "TCP"
+
+_LABEL_PATTERN = re.compile(r"^[A-Za-z0-9._-]{3,32}$")
+
+# The range Linode assigns private IPv4 addresses from; they only reach other
+# instances in the same data center, so the rule leaves them out.
+_PRIVATE_IPV4 = ipaddress.IPv4Network("192.168.128.0/17")
+
+
+def create_linode_firewall_allow_instance_tool() -> tuple[Tool, Capability]:
+    """Create the linode_firewall_allow_instance tool."""
+    return Tool(
+        name="linode_firewall_allow_instance",
+        description=(
+            "Opens a Cloud Firewall to one instance: adds a single inbound ACCEPT "
+            "rule, ahead of the existing rules, covering the public IPv4 addresses "
+            "(/32) and the IPv6 address (/128) of linode_id on ports (default TCP, "
+            "every port when omitted). Private IPv4 addresses are left out. "
+            "Repeating the call with the same label replaces that rule, so it "
+            "follows the instance's current addresses. Pass dry_run=true to "
+            "preview."
+        ),
+        inputSchema=schema("linode.mcp.v1.FirewallAllowInstanceInput"),
+    ), Capability.Write
+
+
+def _parse_args(arguments: dict[str, Any]) -> tuple[dict[str, Any], str]:
+    """Validate the input; mirrors Go's parseAllowInstanceArgs."""
+    firewall_id, msg = required_int_id(arguments, "firewall_id")
+    if firewall_id is None:
+        return {}, msg
+    linode_id, msg = required_int_id(arguments, "linode_id")
+    if linode_id is None:
+        return {}, msg
+
+    protocols = firewall_allow_instance_pb2.FirewallAllowInstanceProtocol.Value
+    enum_error = optional_enum_error(arguments, "protocol", protocols)
+    if enum_error:
+        return {}, enum_error
+
+    label = str(arguments.get("label") or "").strip() or f"allow-linode-{linode_id}"
+    if not _LABEL_PATTERN.match(label):
+        return {}, "label must be 3-32 letters, digits, '.', '_', or '-'"
+
+    return {
+        "firewall_id": firewall_id,
+        "linode_id": linode_id,
+        "ports": str(arguments.get("ports") or "").strip(),
+        "protocol": str(arguments.get("protocol") or "") or _DEFAULT_PROTOCOL,
+        "label": label,
+    }, ""
+
+
+def _instance_addresses(instance: Instance) -> tuple[list[str], list[str]]:
+    """Public IPv4 addresses as /32s and the IPv6 address as a /128;
+    mirrors Go's instanceFirewallAddresses."""
+    ipv4: list[str] = []
+    for raw in instance.ipv4:
+        try:
+            address = ipaddress.ip_address(raw)
+        except ValueError:
+            continue
+        if isinstance(address, ipaddress.IPv4Address) and address not in _PRIVATE_IPV4:
+            ipv4.append(f"{address}/32")
+
+    # The SLAAC address comes with its /128 (older responses with a /64);
+    # either way the rule wants the single host.
+    ipv6: list[str] = []
+    try:
+        address = ipaddress.ip_address((instance.ipv6 or "").split("/", 1)[0])
+    except ValueError:
+        return ipv4, ipv6
+    if isinstance(address, ipaddress.IPv6Address) and address.ipv4_mapped is None:
+        ipv6.append(f"{address}/128")
+    return ipv4, ipv6
+
+
+def _warnings(linode_id: int, ipv4: list[str], ipv6: list[str]) -> list[str]:
+    """Name the address family the rule cannot cover; mirrors Go's
+    allowInstanceWarnings."""
+    if not ipv4 and not ipv6:
+        return [
+            f"Linode {linode_id} has no public IPv4 or IPv6 address to allow; "
+            "the call would fail."
+        ]
+    if not ipv4:
+        return [
+            f"Linode {linode_id} has no public IPv4 address; the rule covers "
+            "IPv6 only."
+        ]
+    if not ipv6:
+        return [
+            f"Linode {linode_id} has no IPv6 address; the rule covers IPv4 only."
+        ]
+    return []
+
+
+def _rule_dict(rule: FirewallRule) -> dict[str, Any]:
+    """A rule in the replace-request wire form; mirrors Go's allowlistRuleMap."""
+    addresses: dict[str, Any] = {}
+    if rule.addresses.ipv4:
+        addresses["ipv4"] = list(rule.addresses.ipv4)
+    if rule.addresses.ipv6:
+        addresses["ipv6"] = list(rule.addresses.ipv6)
+    out: dict[str, Any] = {
+        "action": rule.action,
+        "protocol": rule.protocol,
+        "addresses": addresses,
+        "label": rule.label,
+    }
+    if rule.ports:
+        out["ports"] = rule.ports
+    if rule.description:
+        out["description"] = rule.description
+    return out
+
+
+def _access(args: dict[str, Any], ipv4: list[str], ipv6: list[str]) -> str:
+    ports = args["ports"] or "(every port)"
+    return f"{args['protocol']} {ports} from {', '.join(ipv4 + ipv6)}"
+
+
+async def handle_linode_firewall_allow_instance(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_firewall_allow_instance tool request."""
+    if is_dry_run(arguments):
+        args, args_error = _parse_args(arguments)
+        if args_error:
+            return error_response(args_error)
+
+        async def _fetch(client: RetryableClient) -> Any:
+            instance = await client.get_instance(args["linode_id"])
+            firewall = await client.get_firewall(args["firewall_id"])
+            ipv4, ipv6 = _instance_addresses(instance)
+            return {
+                "firewall_id": firewall.id,
+                "label": firewall.label,
+                "linode_id": instance.id,
+                "ipv4": ipv4,
+                "ipv6": ipv6,
+                "inbound_rule_count": len(firewall.rules.inbound),
+                "replaces_existing": any(
+                    rule.label == args["label"] for rule in firewall.rules.inbound
+                ),
+            }
+
+        async def _walk(_client: RetryableClient, state: Any) -> DryRunDetails:
+            warnings = _warnings(args["linode_id"], state["ipv4"], state["ipv6"])
+            if not state["ipv4"] and not state["ipv6"]:
+                return {"side_effects": [], "warnings": warnings}
+            side_effects = [
+                f"Inbound rule {args['label']} accepting "
+                f"{_access(args, state['ipv4'], state['ipv6'])} is added ahead of "
+                f"the {state['inbound_rule_count']} existing rule(s)."
+            ]
+            if state["replaces_existing"]:
+                side_effects.append(
+                    f"The existing inbound rule {args['label']} is replaced."
+                )
+            return {"side_effects": side_effects, "warnings": warnings}
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_firewall_allow_instance",
+            "PUT",
+            f"/networking/firewalls/{args['firewall_id']}/rules",
+            _fetch,
+            _walk,
+        )
+
+    if arguments.get("confirm") is not True:
+        return error_response(
+            "This opens the firewall to the instance's addresses. "
+            "Set confirm=true to proceed."
+        )
+
+    args, args_error = _parse_args(arguments)
+    if args_error:
+        return error_response(args_error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        instance = await client.get_instance(args["linode_id"])
+        ipv4, ipv6 = _instance_addresses(instance)
+        if not ipv4 and not ipv6:
+            msg = (
+                f"Linode {args['linode_id']} has no public IPv4 or IPv6 address "
+                "to allow"
+            )
+            raise ValueError(msg)
+
+        firewall = await client.get_firewall(args["firewall_id"])
+        addresses: dict[str, Any] = {}
+        if ipv4:
+            addresses["ipv4"] = ipv4
+        if ipv6:
+            addresses["ipv6"] = ipv6
+        rule: dict[str, Any] = {
+            "action": "ACCEPT",
+            "protocol": args["protocol"],
+            "addresses": addresses,
+            "label": args["label"],
+            "description": f"Addresses of Linode {instance.label} ({instance.id})",
+        }
+        if args["ports"]:
+            rule["ports"] = args["ports"]
+        inbound = [rule]
+        replaced = False
+        for existing in firewall.rules.inbound:
+            if existing.label == args["label"]:
+                replaced = True
+                continue
+            inbound.append(_rule_dict(existing))
+        outbound = [_rule_dict(existing) for existing in firewall.rules.outbound]
+
+        rules = await client.update_firewall_rules_raw(
+            args["firewall_id"], inbound, outbound
+        )
+        warnings = _warnings(args["linode_id"], ipv4, ipv6)
+        for warning in warnings:
+            add_warning(warning)
+        return serialize_api_response(
+            {
+                "message": (
+                    f"Firewall {args['firewall_id']} accepts "
+                    f"{_access(args, ipv4, ipv6)} (rule {args['label']})."
+                ),
+                "firewall_id": args["firewall_id"],
+                "linode_id": args["linode_id"],
+                "rule": rule,
+                "replaced": replaced,
+                "rules": rules,
+                "warnings": warnings,
+            },
+            firewall_allow_instance_pb2.FirewallAllowInstanceResponse(),
+        )
+
+    return await execute_tool(
+        cfg, arguments, f"update firewall {args['firewall_id']} rules", _call
+    )
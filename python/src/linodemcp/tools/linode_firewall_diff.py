@@ -0,0 +1,215 @@
+"""Linode firewall diff tool: compare the rules of two firewalls."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING, Any, cast
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import firewall_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import error_response, execute_tool, required_int_id
+from linodemcp.tools.proto_enum import enum_choice_error
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_DIRECTIONS = ("inbound", "outbound")
+
+
+def create_linode_firewall_diff_tool() -> tuple[Tool, Capability]:
+    """Create the linode_firewall_diff tool."""
+    return Tool(
+        name="linode_firewall_diff",
+        description=(
+            "Compares the rules of firewall_id against another firewall "
+            "(other_firewall_id) or a firewall template (template) and reports "
+            "what would change to make firewall_id match: default policy "
+            "changes, rules added, rules removed, and labeled rules whose "
+            "fields changed. Use it before promoting staging firewall changes "
+            "to production. Rules match by label; rule order is not compared."
+        ),
+        inputSchema=schema("linode.mcp.v1.FirewallDiffInput"),
+    ), Capability.Read
+
+
+def _addresses(rule: dict[str, Any], family: str) -> list[str]:
+    """Return a rule's sorted address list for one family."""
+    raw_addresses = rule.get("addresses")
+    addresses = (
+        cast("dict[str, Any]", raw_addresses) if isinstance(raw_addresses, dict) else {}
+    )
+    raw = addresses.get(family)
+    values = cast("list[Any]", raw) if isinstance(raw, list) else []
+    return sorted(str(v) for v in values)
+
+
+def _rule_key(rule: dict[str, Any]) -> str:
+    """Identify a rule across firewalls: its label, or for an unlabeled rule
+    every field, so unlabeled rules only ever pair when equal (mirrors Go
+    firewallRuleKey)."""
+    label = str(rule.get("label") or "")
+    if label:
+        return f"label:{label}"
+    return "|".join(
+        [
+            "rule:",
+            str(rule.get("action") or ""),
+            str(rule.get("protocol") or ""),
+            str(rule.get("ports") or ""),
+            ",".join(_addresses(rule, "ipv4")),
+            ",".join(_addresses(rule, "ipv6")),
+            str(rule.get("description") or ""),
+        ]
+    )
+
+
+def _changed_fields(before: dict[str, Any], after: dict[str, Any]) -> list[str]:
+    """List the fields that differ between two paired rules. Address lists
+    compare as sets."""
+    fields = [
+        name
+        for name in ("action", "protocol", "ports")
+        if str(before.get(name) or "") != str(after.get(name) or "")
+    ]
+    fields.extend(
+        f"addresses.{family}"
+        for family in ("ipv4", "ipv6")
+        if _addresses(before, family) != _addresses(after, family)
+    )
+    if str(before.get("description") or "") != str(after.get("description") or ""):
+        fields.append("description")
+    return fields
+
+
+def _rules(rules: dict[str, Any], direction: str) -> list[dict[str, Any]]:
+    """Return one direction's rules from a ruleset."""
+    raw = rules.get(direction)
+    items = cast("list[Any]", raw) if isinstance(raw, list) else []
+    return [cast("dict[str, Any]", r) for r in items if isinstance(r, dict)]
+
+
+def _diff_rules(
+    response: dict[str, Any],
+    direction: str,
+    base: list[dict[str, Any]],
+    target: list[dict[str, Any]],
+) -> None:
+    """Pair one direction's rules by key, in order, recording unpaired rules
+    as removed or added and paired rules whose fields differ as changed."""
+    unmatched: dict[str, list[int]] = {}
+    for index, rule in enumerate(base):
+        unmatched.setdefault(_rule_key(rule), []).append(index)
+
+    for rule in target:
+        candidates = unmatched.get(_rule_key(rule))
+        if not candidates:
+            response["added"].append({"direction": direction, "rule": rule})
+            continue
+        before = base[candidates.pop(0)]
+        fields = _changed_fields(before, rule)
+        if fields:
+            response["changed"].append(
+                {
+                    "direction": direction,
+                    "label": str(rule.get("label") or ""),
+                    "fields": fields,
+                    "before": before,
+                    "after": rule,
+                }
+            )
+
+    remaining = sorted(i for indexes in unmatched.values() for i in indexes)
+    response["removed"].extend(
+        {"direction": direction, "rule": base[i]} for i in remaining
+    )
+
+
+def _firewall_diff(
+    base_label: str,
+    target_label: str,
+    base: dict[str, Any],
+    target: dict[str, Any],
+) -> dict[str, Any]:
+    """Report what would change to make base match target (mirrors Go
+    firewallDiff)."""
+    response: dict[str, Any] = {
+        "base": base_label,
+        "target": target_label,
+        "policy_changes": [],
+        "added": [],
+        "removed": [],
+        "changed": [],
+    }
+    for direction in _DIRECTIONS:
+        before = str(base.get(f"{direction}_policy") or "")
+        after = str(target.get(f"{direction}_policy") or "")
+        if before != after:
+            response["policy_changes"].append(
+                {"direction": direction, "before": before, "after": after}
+            )
+        _diff_rules(
+            response, direction, _rules(base, direction), _rules(target, direction)
+        )
+
+    counts = [
+        len(response[key]) for key in ("policy_changes", "added", "removed", "changed")
+    ]
+    response["identical"] = sum(counts) == 0
+    if response["identical"]:
+        response["message"] = f"{base_label} and {target_label} have identical rules"
+    else:
+        response["message"] = (
+            f"{base_label} differs from {target_label}: {counts[0]} policy "
+            f"changes, {counts[1]} rules added, {counts[2]} removed, "
+            f"{counts[3]} changed"
+        )
+    return response
+
+
+async def handle_linode_firewall_diff(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_firewall_diff tool request."""
+    firewall_id, error = required_int_id(arguments, "firewall_id")
+    if firewall_id is None:
+        return error_response(error)
+
+    other_id = arguments.get("other_firewall_id") or 0
+    template = arguments.get("template") or ""
+    if (isinstance(other_id, int) and other_id > 0) == bool(template):
+        return error_response("pass exactly one of other_firewall_id or template")
+    if template:
+        if not isinstance(template, str):
+            return error_response("template must be a string")
+        slug_error = enum_choice_error(
+            template, "template", firewall_pb2.FirewallTemplateSlug.Value
+        )
+        if slug_error is not None:
+            return error_response(slug_error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        base = await client.get_raw(f"/networking/firewalls/{int(firewall_id)}/rules")
+        if template:
+            target_label = f"template {template}"
+            raw_template = await client.get_raw(
+                f"/networking/firewalls/templates/{template}"
+            )
+            raw_rules = raw_template.get("rules")
+            target = (
+                cast("dict[str, Any]", raw_rules) if isinstance(raw_rules, dict) else {}
+            )
+        else:
+            target_label = f"firewall {int(other_id)}"
+            target = await client.get_raw(
+                f"/networking/firewalls/{int(other_id)}/rules"
+            )
+        return serialize_api_response(
+            _firewall_diff(f"firewall {int(firewall_id)}", target_label, base, target),
+            firewall_pb2.FirewallDiffResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "compare firewall rules", _call)
@@ -0,0 +1,241 @@
+"""Linode firewall simulate tool: which rule decides a connection.
+
+Mirrors ``go/internal/tools/linode_firewall_simulate.go``.
+"""
+
+from __future__ import annotations
+
+import ipaddress
+from typing import TYPE_CHECKING, Any, cast
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import firewall_simulate_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import error_response, execute_tool, required_int_id
+from linodemcp.tools.proto_enum import enum_choice_error
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_DEFAULT_PROTOCOL = "TCP"
+_DEFAULT_DIRECTION = "inbound"
+_MAX_PORT = 65535
+_PORT_PROTOCOLS = ("TCP", "UDP")
+
+_Address = ipaddress.IPv4Address | ipaddress.IPv6Address
+
+
+def create_linode_firewall_simulate_tool() -> tuple[Tool, Capability]:
+    """Create the linode_firewall_simulate tool."""
+    return Tool(
+        name="linode_firewall_simulate",
+        description=(
+            'Answers "why is my connection blocked" without trial and error: '
+            "evaluates firewall_id's rules in order against a connection from "
+            "source_ip to port over protocol (default TCP, inbound) and reports "
+            "the first rule that matches, or the default policy when none does, "
+            "with why each earlier rule did not match. Nothing on the firewall "
+            "changes."
+        ),
+        inputSchema=schema("linode.mcp.v1.FirewallSimulateInput"),
+    ), Capability.Read
+
+
+def _parse_args(arguments: dict[str, Any]) -> tuple[dict[str, Any], str]:
+    """Validate the input, returning the connection to simulate or an error
+    message (mirrors Go parseFirewallSimulateArgs)."""
+    raw_source = arguments.get("source_ip")
+    source = raw_source.strip() if isinstance(raw_source, str) else ""
+    if not source:
+        return {}, "source_ip is required"
+    try:
+        address: _Address = ipaddress.ip_address(source)
+    except ValueError:
+        return {}, f'source_ip "{source}" is not an IP address'
+    if isinstance(address, ipaddress.IPv6Address) and address.ipv4_mapped:
+        address = address.ipv4_mapped
+
+    protocol = arguments.get("protocol") or ""
+    protocol_error = enum_choice_error(
+        protocol, "protocol", firewall_simulate_pb2.FirewallSimulateProtocol.Value
+    )
+    if protocol_error is not None:
+        return {}, protocol_error
+    direction = arguments.get("direction") or ""
+    direction_error = enum_choice_error(
+        direction, "direction", firewall_simulate_pb2.FirewallSimulateDirection.Value
+    )
+    if direction_error is not None:
+        return {}, direction_error
+
+    conn: dict[str, Any] = {
+        "address": address,
+        "protocol": str(protocol or _DEFAULT_PROTOCOL),
+        "direction": str(direction or _DEFAULT_DIRECTION),
+        "port": 0,
+    }
+    if conn["protocol"] in _PORT_PROTOCOLS:
+        port = arguments.get("port")
+        if (
+            not isinstance(port, int)
+            or isinstance(port, bool)
+            or not 1 <= port <= _MAX_PORT
+        ):
+            return {}, (
+                f"port must be from 1 through {_MAX_PORT} for {conn['protocol']}"
+            )
+        conn["port"] = port
+    return conn, ""
+
+
+def _ports_contain(ports: str, port: int) -> tuple[bool, str]:
+    """Report whether a rule's ports field, a comma list of ports and ranges
+    such as "22, 80, 8000-8080", covers port. An entry that does not parse is
+    returned so the caller can name it (mirrors Go firewallPortsContain)."""
+    for raw_entry in ports.split(","):
+        entry = raw_entry.strip()
+        low, sep, high = entry.partition("-")
+        try:
+            first = int(low.strip())
+            last = int(high.strip()) if sep else first
+        except ValueError:
+            return False, entry
+        if first <= port <= last:
+            return True, ""
+    return False, ""
+
+
+def _addresses_contain(addresses: list[Any], address: _Address) -> bool:
+    """Report whether any rule address, a CIDR or a bare host address, covers
+    address. Entries that do not parse never match."""
+    for raw in addresses:
+        try:
+            network = ipaddress.ip_network(str(raw).strip(), strict=False)
+        except ValueError:
+            continue
+        if network.version == address.version and address in network:
+            return True
+    return False
+
+
+def _rule_mismatch(rule: dict[str, Any], conn: dict[str, Any]) -> str:
+    """Say why a rule does not match the connection, or return "" when it
+    does (mirrors Go firewallRuleMismatch)."""
+    protocol = str(rule.get("protocol") or "")
+    if protocol.upper() != conn["protocol"]:
+        return f"protocol {protocol}, not {conn['protocol']}"
+
+    ports = str(rule.get("ports") or "")
+    if conn["port"] > 0 and ports:
+        in_range, bad_entry = _ports_contain(ports, conn["port"])
+        if bad_entry:
+            return f'ports "{ports}" do not parse at "{bad_entry}"'
+        if not in_range:
+            return f"port {conn['port']} is not in {ports}"
+
+    address: _Address = conn["address"]
+    family = "ipv4" if isinstance(address, ipaddress.IPv4Address) else "ipv6"
+    raw_addresses = rule.get("addresses")
+    addresses = (
+        cast("dict[str, Any]", raw_addresses) if isinstance(raw_addresses, dict) else {}
+    )
+    raw_family = addresses.get(family)
+    family_addresses = (
+        cast("list[Any]", raw_family) if isinstance(raw_family, list) else []
+    )
+    if not _addresses_contain(family_addresses, address):
+        return f"{address} is not in the rule's {family} addresses"
+    return ""
+
+
+def _simulate(
+    firewall_id: int, rules: dict[str, Any], conn: dict[str, Any]
+) -> dict[str, Any]:
+    """Walk the connection's direction in rule order; the first matching rule
+    decides, and with no match the default policy does (mirrors Go
+    simulateFirewall)."""
+    direction = conn["direction"]
+    raw_list = rules.get(direction)
+    rule_list = [
+        cast("dict[str, Any]", r)
+        for r in (cast("list[Any]", raw_list) if isinstance(raw_list, list) else [])
+        if isinstance(r, dict)
+    ]
+    policy = str(rules.get(f"{direction}_policy") or "")
+
+    response: dict[str, Any] = {
+        "firewall_id": firewall_id,
+        "direction": direction,
+        "source_ip": str(conn["address"]),
+        "protocol": conn["protocol"],
+        "action": policy,
+        "matched_rule": False,
+        "default_policy": policy,
+        "steps": [],
+    }
+    if conn["port"] > 0:
+        response["port"] = conn["port"]
+
+    for position, rule in enumerate(rule_list, start=1):
+        step: dict[str, Any] = {
+            "position": position,
+            "label": str(rule.get("label") or ""),
+            "action": str(rule.get("action") or ""),
+            "matched": False,
+        }
+        response["steps"].append(step)
+        reason = _rule_mismatch(rule, conn)
+        if reason:
+            step["reason"] = reason
+            continue
+        step["matched"] = True
+        response["matched_rule"] = True
+        response["rule_position"] = position
+        response["rule"] = rule
+        response["action"] = step["action"]
+        break
+
+    target = conn["protocol"]
+    if conn["port"] > 0:
+        target = f"{conn['protocol']} port {conn['port']}"
+    verdict = (
+        f"Firewall {firewall_id} would {response['action'].lower()} {direction} "
+        f"{target} from {response['source_ip']}"
+    )
+    if response["matched_rule"]:
+        label = str(response["rule"].get("label") or "") or "unlabeled"
+        response["message"] = (
+            f"{verdict}: {direction} rule {response['rule_position']} ({label}) "
+            "is the first match"
+        )
+    else:
+        response["message"] = (
+            f"{verdict}: no {direction} rule matches, so the default {policy} "
+            "policy applies"
+        )
+    return response
+
+
+async def handle_linode_firewall_simulate(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_firewall_simulate tool request."""
+    firewall_id, error = required_int_id(arguments, "firewall_id")
+    if firewall_id is None:
+        return error_response(error)
+    conn, message = _parse_args(arguments)
+    if message:
+        return error_response(message)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        rules = await client.get_raw(f"/networking/firewalls/{int(firewall_id)}/rules")
+        return serialize_api_response(
+            _simulate(int(firewall_id), rules, conn),
+            firewall_simulate_pb2.FirewallSimulateResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "retrieve firewall rules", _call)
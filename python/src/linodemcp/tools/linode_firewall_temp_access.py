@@ -0,0 +1,498 @@
+"""Temporary firewall access: linode_firewall_temp_allow and _expire_rules.
+
+linode_firewall_temp_allow adds an inbound ACCEPT rule whose description
+carries an expiry tag; linode_firewall_expire_rules removes the tagged rules
+once they have expired. Rules without the tag are never touched.
+
+Mirrors ``go/internal/tools/linode_firewall_temp_access.go``.
+"""
+
+from __future__ import annotations
+
+import ipaddress
+import re
+from datetime import UTC, datetime, timedelta
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import firewall_temp_access_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+    required_int_id,
+)
+from linodemcp.tools.proto_enum import optional_enum_error
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import Firewall, FirewallRule, RetryableClient
+
+# Starts the description of every temporary rule; the RFC 3339 UTC expiry
+# follows. Mirrors Go's tempRuleTag.
+_TEMP_RULE_TAG = "linodemcp-temp expires="
+
+_DEFAULT_LABEL = "temp-allow"
+_DEFAULT_PROTOCOL = "TCP"
+_MAX_MINUTES = 7 * 24 * 60
+
+_APPLIED = "applied"
+_PARTIAL = "partial"
+
+_LABEL_PATTERN = re.compile(r"^[A-Za-z0-9._-]{3,32}$")
+
+
+def create_linode_firewall_temp_allow_tool() -> tuple[Tool, Capability]:
+    """Create the linode_firewall_temp_allow tool."""
+    return Tool(
+        name="linode_firewall_temp_allow",
+        description=(
+            "Temporarily opens a Cloud Firewall: adds an inbound ACCEPT rule for "
+            "source on ports (default TCP) ahead of the existing rules, tagged in "
+            "its description with an expiry duration_minutes from now (e.g. SSH "
+            "from your IP for 120 minutes). The rule stays until "
+            "linode_firewall_expire_rules removes it after the expiry. Repeating "
+            "the call for the same label, source, protocol, and ports replaces "
+            "the rule, extending its expiry. Pass dry_run=true to preview."
+        ),
+        inputSchema=schema("linode.mcp.v1.FirewallTempAllowInput"),
+    ), Capability.Write
+
+
+def create_linode_firewall_expire_rules_tool() -> tuple[Tool, Capability]:
+    """Create the linode_firewall_expire_rules tool."""
+    return Tool(
+        name="linode_firewall_expire_rules",
+        description=(
+            "Removes expired temporary rules added by linode_firewall_temp_allow "
+            "from one firewall (firewall_id) or every firewall. Only rules whose "
+            "description carries the temporary-rule expiry tag are considered; "
+            "every other rule, and temporary rules not yet expired, are kept. "
+            "Pass dry_run=true to list what would be removed."
+        ),
+        inputSchema=schema("linode.mcp.v1.FirewallExpireRulesInput"),
+    ), Capability.Write
+
+
+def _parse_cidr(
+    raw: str,
+) -> tuple[ipaddress.IPv4Network | ipaddress.IPv6Network | None, str]:
+    """Parse a CIDR or bare address (a single host), rejecting host bits."""
+    raw = raw.strip()
+    try:
+        return ipaddress.ip_network(raw, strict=True), ""
+    except ValueError:
+        pass
+    try:
+        masked = ipaddress.ip_network(raw, strict=False)
+    except ValueError:
+        return None, "is not a valid IP address or CIDR"
+    return None, f"has host bits set; use {masked}"
+
+
+def _canonical_address(raw: str) -> str:
+    """Normalize a stored address so "10.0.0.1" and "10.0.0.1/32" match."""
+    network, _ = _parse_cidr(raw)
+    return str(network) if network is not None else raw
+
+
+def _format_time(value: datetime) -> str:
+    """RFC 3339 UTC with a Z suffix, as Go's time.RFC3339 writes it."""
+    return value.astimezone(UTC).strftime("%Y-%m-%dT%H:%M:%SZ")
+
+
+def _parse_args(arguments: dict[str, Any]) -> tuple[dict[str, Any], str]:
+    """Validate the input; mirrors Go's parseTempAllowArgs."""
+    firewall_id, msg = required_int_id(arguments, "firewall_id")
+    if firewall_id is None:
+        return {}, msg
+
+    source = str(arguments.get("source") or "").strip()
+    if not source:
+        return {}, "source is required"
+    network, msg = _parse_cidr(source)
+    if network is None:
+        return {}, f'source "{source}" {msg}'
+
+    ports = str(arguments.get("ports") or "").strip()
+    if not ports:
+        return {}, "ports is required"
+
+    protocols = firewall_temp_access_pb2.FirewallTempAllowProtocol.Value
+    enum_error = optional_enum_error(arguments, "protocol", protocols)
+    if enum_error:
+        return {}, enum_error
+    protocol = str(arguments.get("protocol") or "") or _DEFAULT_PROTOCOL
+
+    if "duration_minutes" not in arguments:
+        return {}, "duration_minutes is required"
+    minutes = arguments["duration_minutes"]
+    if (
+        isinstance(minutes, bool)
+        or not isinstance(minutes, int)
+        or not 1 <= minutes <= _MAX_MINUTES
+    ):
+        return {}, f"duration_minutes must be between 1 and {_MAX_MINUTES} (7 days)"
+
+    label = str(arguments.get("label") or "").strip() or _DEFAULT_LABEL
+    if not _LABEL_PATTERN.match(label):
+        return {}, "label must be 3-32 letters, digits, '.', '_', or '-'"
+
+    return {
+        "firewall_id": firewall_id,
+        "source": str(network),
+        "ipv6": network.version == 6,  # noqa: PLR2004
+        "ports": ports,
+        "protocol": protocol,
+        "minutes": minutes,
+        "label": label,
+    }, ""
+
+
+def _rule_expiry(description: str) -> tuple[datetime | None, bool, str]:
+    """Read the expiry tag: (expiry, tagged, error); mirrors tempRuleExpiry."""
+    if not description.startswith(_TEMP_RULE_TAG):
+        return None, False, ""
+    raw = description.removeprefix(_TEMP_RULE_TAG).split(" ", 1)[0]
+    try:
+        expires = datetime.fromisoformat(raw)
+    except ValueError:
+        return None, True, f'unreadable expiry "{raw}"'
+    if expires.tzinfo is None:
+        return None, True, f'unreadable expiry "{raw}"'
+    return expires, True, ""
+
+
+def _same_access(rule: FirewallRule, args: dict[str, Any]) -> bool:
+    """Whether rule is a temporary rule granting exactly args' access."""
+    _, tagged, _ = _rule_expiry(rule.description)
+    if not tagged:
+        return False
+    addresses = list(rule.addresses.ipv4) + list(rule.addresses.ipv6)
+    return (
+        rule.label == args["label"]
+        and rule.protocol.upper() == args["protocol"]
+        and rule.ports == args["ports"]
+        and len(addresses) == 1
+        and _canonical_address(addresses[0]) == args["source"]
+    )
+
+
+def _rule_dict(rule: FirewallRule) -> dict[str, Any]:
+    """A rule in the replace-request wire form; mirrors Go's allowlistRuleMap."""
+    addresses: dict[str, Any] = {}
+    if rule.addresses.ipv4:
+        addresses["ipv4"] = list(rule.addresses.ipv4)
+    if rule.addresses.ipv6:
+        addresses["ipv6"] = list(rule.addresses.ipv6)
+    out: dict[str, Any] = {
+        "action": rule.action,
+        "protocol": rule.protocol,
+        "addresses": addresses,
+        "label": rule.label,
+    }
+    if rule.ports:
+        out["ports"] = rule.ports
+    if rule.description:
+        out["description"] = rule.description
+    return out
+
+
+def _access(args: dict[str, Any]) -> str:
+    return f"{args['protocol']} {args['ports']} from {args['source']}"
+
+
+async def handle_linode_firewall_temp_allow(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_firewall_temp_allow tool request."""
+    if is_dry_run(arguments):
+        args, args_error = _parse_args(arguments)
+        if args_error:
+            return error_response(args_error)
+
+        async def _fetch(client: RetryableClient) -> Any:
+            firewall = await client.get_firewall(args["firewall_id"])
+            return {
+                "firewall_id": firewall.id,
+                "label": firewall.label,
+                "inbound_rule_count": len(firewall.rules.inbound),
+                "replaces_existing": any(
+                    _same_access(rule, args) for rule in firewall.rules.inbound
+                ),
+            }
+
+        async def _walk(_client: RetryableClient, state: Any) -> DryRunDetails:
+            side_effects = [
+                f"Inbound rule {args['label']} accepting {_access(args)} is added "
+                f"ahead of the {state['inbound_rule_count']} existing rule(s) and "
+                f"expires {args['minutes']} minute(s) after the call."
+            ]
+            if state["replaces_existing"]:
+                side_effects.append(
+                    f"The existing temporary rule {args['label']} for the same "
+                    "access is replaced."
+                )
+            return {"side_effects": side_effects, "warnings": []}
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_firewall_temp_allow",
+            "PUT",
+            f"/networking/firewalls/{args['firewall_id']}/rules",
+            _fetch,
+            _walk,
+        )
+
+    if not arguments.get("confirm"):
+        return error_response(
+            "This opens the firewall to source until the rule expires. "
+            "Set confirm=true to proceed."
+        )
+
+    args, args_error = _parse_args(arguments)
+    if args_error:
+        return error_response(args_error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        firewall = await client.get_firewall(args["firewall_id"])
+        expires = datetime.now(UTC).replace(microsecond=0) + timedelta(
+            minutes=args["minutes"]
+        )
+        expires_at = _format_time(expires)
+        family = "ipv6" if args["ipv6"] else "ipv4"
+        rule = {
+            "action": "ACCEPT",
+            "protocol": args["protocol"],
+            "ports": args["ports"],
+            "addresses": {family: [args["source"]]},
+            "label": args["label"],
+            "description": _TEMP_RULE_TAG + expires_at,
+        }
+        inbound = [rule]
+        replaced = False
+        for existing in firewall.rules.inbound:
+            if _same_access(existing, args):
+                replaced = True
+                continue
+            inbound.append(_rule_dict(existing))
+        outbound = [_rule_dict(existing) for existing in firewall.rules.outbound]
+
+        rules = await client.update_firewall_rules_raw(
+            args["firewall_id"], inbound, outbound
+        )
+        return serialize_api_response(
+            {
+                "message": (
+                    f"Firewall {args['firewall_id']} accepts {_access(args)} until "
+                    f"{expires_at} (rule {args['label']}); run "
+                    "linode_firewall_expire_rules after that to remove it."
+                ),
+                "firewall_id": args["firewall_id"],
+                "rule": rule,
+                "expires_at": expires_at,
+                "replaced": replaced,
+                "rules": rules,
+            },
+            firewall_temp_access_pb2.FirewallTempAllowResponse(),
+        )
+
+    return await execute_tool(
+        cfg, arguments, f"update firewall {args['firewall_id']} rules", _call
+    )
+
+
+def _plan(
+    firewalls: list[Firewall], now: datetime
+) -> tuple[list[dict[str, Any]], int, list[str]]:
+    """Split temporary inbound rules into removed and kept; see planExpireRules.
+
+    Each plan is {"firewall": ..., "report": ..., "keep": [...]}; only
+    firewalls with an expired rule get one.
+    """
+    plans: list[dict[str, Any]] = []
+    kept = 0
+    warnings: list[str] = []
+    for firewall in firewalls:
+        report: dict[str, Any] = {
+            "firewall_id": firewall.id,
+            "label": firewall.label,
+            "removed": [],
+            "kept": 0,
+        }
+        keep: list[FirewallRule] = []
+        for rule in firewall.rules.inbound:
+            expires, tagged, err = _rule_expiry(rule.description)
+            if err:
+                warnings.append(
+                    f"Firewall {firewall.id} ({firewall.label}) rule {rule.label} "
+                    f"has an {err}; kept."
+                )
+            if expires is None or not tagged:
+                keep.append(rule)
+            elif expires > now:
+                keep.append(rule)
+                report["kept"] += 1
+            else:
+                report["removed"].append(
+                    {
+                        "label": rule.label,
+                        "protocol": rule.protocol,
+                        "ports": rule.ports,
+                        "addresses": {
+                            "ipv4": list(rule.addresses.ipv4),
+                            "ipv6": list(rule.addresses.ipv6),
+                        },
+                        "expires_at": _format_time(expires),
+                    }
+                )
+        kept += report["kept"]
+        if report["removed"]:
+            plans.append({"firewall": firewall, "report": report, "keep": keep})
+    return plans, kept, warnings
+
+
+async def _firewalls(client: RetryableClient, firewall_id: int) -> list[Firewall]:
+    """The firewalls to check: the one named, or all."""
+    if firewall_id == 0:
+        return await client.list_firewalls()
+    return [await client.get_firewall(firewall_id)]
+
+
+def _side_effects(plans: list[dict[str, Any]], kept: int) -> list[str]:
+    """Tier B walk: one line per expired rule, then the kept count."""
+    side_effects: list[str] = []
+    for plan in plans:
+        report = plan["report"]
+        for rule in report["removed"]:
+            addresses = rule["addresses"]["ipv4"] + rule["addresses"]["ipv6"]
+            side_effects.append(
+                f"Firewall {report['firewall_id']} ({report['label']}) loses rule "
+                f"{rule['label']} accepting {rule['protocol']} {rule['ports']} "
+                f"from {', '.join(addresses)}, expired {rule['expires_at']}."
+            )
+    side_effects.append(f"{kept} temporary rule(s) not yet expired are kept.")
+    return side_effects
+
+
+async def _apply(
+    client: RetryableClient, plans: list[dict[str, Any]]
+) -> dict[str, Any]:
+    """Rewrite each planned firewall; a failure is recorded, not rolled back."""
+    response: dict[str, Any] = {
+        "status": _APPLIED,
+        "removed_count": 0,
+        "firewalls": [],
+    }
+    failed = 0
+    for plan in plans:
+        report = plan["report"]
+        response["firewalls"].append(report)
+        inbound = [_rule_dict(rule) for rule in plan["keep"]]
+        outbound = [_rule_dict(rule) for rule in plan["firewall"].rules.outbound]
+        try:
+            await client.update_firewall_rules_raw(
+                report["firewall_id"], inbound, outbound
+            )
+        except (APIError, NetworkError) as exc:
+            report["error"] = str(exc)
+            failed += 1
+            continue
+        response["removed_count"] += len(report["removed"])
+
+    updated = len(plans) - failed
+    response["message"] = (
+        f"Removed {response['removed_count']} expired temporary rule(s) "
+        f"from {updated} firewall(s)."
+    )
+    if failed:
+        response["status"] = _PARTIAL
+        response["message"] = (
+            f"Removed {response['removed_count']} expired temporary rule(s) "
+            f"from {updated} firewall(s); {failed} firewall(s) failed and keep "
+            "their rules, see firewalls[].error."
+        )
+        add_warning(response["message"])
+    return response
+
+
+async def handle_linode_firewall_expire_rules(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_firewall_expire_rules tool request."""
+    firewall_id = 0
+    if "firewall_id" in arguments:
+        parsed_id, msg = required_int_id(arguments, "firewall_id")
+        if parsed_id is None:
+            return error_response(msg)
+        firewall_id = parsed_id
+
+    if is_dry_run(arguments):
+        plans: list[dict[str, Any]] = []
+        warnings: list[str] = []
+        kept = 0
+
+        async def _fetch(client: RetryableClient) -> Any:
+            nonlocal kept
+            found, kept, found_warnings = _plan(
+                await _firewalls(client, firewall_id), datetime.now(UTC)
+            )
+            plans.extend(found)
+            warnings.extend(found_warnings)
+            return [
+                {
+                    "firewall_id": plan["report"]["firewall_id"],
+                    "label": plan["report"]["label"],
+                    "removed_rules": [
+                        rule["label"] for rule in plan["report"]["removed"]
+                    ],
+                    "kept_count": plan["report"]["kept"],
+                }
+                for plan in found
+            ]
+
+        async def _walk(_client: RetryableClient, _state: Any) -> DryRunDetails:
+            return {"side_effects": _side_effects(plans, kept), "warnings": warnings}
+
+        path = "/networking/firewalls/{firewall_id}/rules"
+        if firewall_id:
+            path = f"/networking/firewalls/{firewall_id}/rules"
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_firewall_expire_rules",
+            "PUT",
+            path,
+            _fetch,
+            _walk,
+        )
+
+    if not arguments.get("confirm"):
+        return error_response(
+            "This removes expired temporary firewall rules. "
+            "Set confirm=true to proceed."
+        )
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        plans, kept, warnings = _plan(
+            await _firewalls(client, firewall_id), datetime.now(UTC)
+        )
+        for warning in warnings:
+            add_warning(warning)
+        response = await _apply(client, plans)
+        response["kept_count"] = kept
+        response["warnings"] = warnings
+        return serialize_api_response(
+            response, firewall_temp_access_pb2.FirewallExpireRulesResponse()
+        )
+
+    return await execute_tool(cfg, arguments, "find temporary rules", _call)
@@ -0,0 +1,421 @@
+"""Linode firewall export and import tools: rules as a portable document.
+
+linode_firewall_export writes a firewall's rules as a JSON document, an
+inbound and an outbound chain of a default policy and its rules in order;
+linode_firewall_import validates such a document in full and replaces the
+firewall's rules and policies with it.
+
+Mirrors ``go/internal/tools/linode_firewall_transfer.go``.
+"""
+
+from __future__ import annotations
+
+import json
+from typing import TYPE_CHECKING, Any, cast
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import (
+    firewall_pb2,
+    firewall_simulate_pb2,
+    firewall_transfer_pb2,
+)
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+    required_int_id,
+)
+from linodemcp.tools.linode_firewall_diff import _firewall_diff
+from linodemcp.tools.linode_firewall_simulate import _ports_contain
+from linodemcp.tools.linode_firewall_temp_access import _LABEL_PATTERN, _parse_cidr
+from linodemcp.tools.linode_firewalls_write import _parse_firewall_ipv6
+from linodemcp.tools.proto_enum import enum_choice_error, enum_value_names
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from google.protobuf.internal.enum_type_wrapper import EnumTypeWrapper
+
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+# The document version. An import refuses any other format rather than
+# guessing at its layout.
+_FORMAT = "linodemcp.firewall/v1"
+_DIRECTIONS = ("inbound", "outbound")
+_PORT_PROTOCOLS = ("TCP", "UDP")
+
+# Keys each level of a document may carry; anything else is rejected so a typo
+# cannot silently drop a rule field.
+_DOCUMENT_FIELDS = ("firewall", "format", "inbound", "outbound")
+_CHAIN_FIELDS = ("policy", "rules")
+_RULE_FIELDS = ("action", "addresses", "description", "label", "ports", "protocol")
+_ADDRESS_FAMILIES = ("ipv4", "ipv6")
+
+_CONFIRM_MESSAGE = (
+    "This replaces the firewall's rules and default policies with the document. "
+    "Set confirm=true to proceed."
+)
+
+
+def create_linode_firewall_export_tool() -> tuple[Tool, Capability]:
+    """Create the linode_firewall_export tool."""
+    return Tool(
+        name="linode_firewall_export",
+        description=(
+            "Exports a Cloud Firewall's rules as a portable JSON document: an "
+            "inbound and an outbound chain, each a default policy and its rules "
+            f'in evaluation order, tagged format "{_FORMAT}". Keep the document '
+            "in version control to review rule changes, then apply it with "
+            "linode_firewall_import."
+        ),
+        inputSchema=schema("linode.mcp.v1.FirewallExportInput"),
+    ), Capability.Read
+
+
+def create_linode_firewall_import_tool() -> tuple[Tool, Capability]:
+    """Create the linode_firewall_import tool."""
+    return Tool(
+        name="linode_firewall_import",
+        description=(
+            "Replaces a Cloud Firewall's rules and default policies with a "
+            "document written by linode_firewall_export. The whole document is "
+            "validated first (format, policies, rule actions and protocols, "
+            "ports, labels, and addresses) and nothing is sent if any part is "
+            "wrong. Requires confirm=true; pass dry_run=true to see what the "
+            "import would add, remove, and change."
+        ),
+        inputSchema=schema("linode.mcp.v1.FirewallImportInput"),
+    ), Capability.Write
+
+
+async def handle_linode_firewall_export(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_firewall_export tool request."""
+    firewall_id, error = required_int_id(arguments, "firewall_id")
+    if firewall_id is None:
+        return error_response(error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        firewall = await client.get_raw(f"/networking/firewalls/{firewall_id}")
+        raw_rules = firewall.get("rules")
+        rules = cast("dict[str, Any]", raw_rules) if isinstance(raw_rules, dict) else {}
+        label = str(firewall.get("label") or "")
+        chains = {
+            direction: {
+                "policy": str(rules.get(f"{direction}_policy") or ""),
+                "rules": rules.get(direction) or [],
+            }
+            for direction in _DIRECTIONS
+        }
+        return serialize_api_response(
+            {
+                "message": (
+                    f"Exported firewall {firewall_id} ({label}): "
+                    f"{len(chains['inbound']['rules'])} inbound and "
+                    f"{len(chains['outbound']['rules'])} outbound rules"
+                ),
+                "firewall_id": firewall_id,
+                "document": {"format": _FORMAT, "firewall": label, **chains},
+            },
+            firewall_transfer_pb2.FirewallExportResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "export firewall", _call)
+
+
+async def handle_linode_firewall_import(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_firewall_import tool request."""
+    firewall_id, error = required_int_id(arguments, "firewall_id")
+    if firewall_id is None:
+        return error_response(error)
+
+    imported, error = _document_from_tool(arguments.get("document"))
+    if imported is None:
+        return error_response(error)
+
+    if is_dry_run(arguments):
+
+        async def _fetch(client: RetryableClient) -> dict[str, Any]:
+            return await client.get_raw(f"/networking/firewalls/{firewall_id}/rules")
+
+        async def _details(
+            _client: RetryableClient, current: dict[str, Any]
+        ) -> DryRunDetails:
+            return _import_side_effects(firewall_id, current, imported)
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_firewall_import",
+            "PUT",
+            f"/networking/firewalls/{firewall_id}/rules",
+            _fetch,
+            _details,
+        )
+
+    if arguments.get("confirm") is not True:
+        return error_response(_CONFIRM_MESSAGE)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        rules = await client.update_firewall_rules_raw(
+            firewall_id,
+            imported["inbound"],
+            imported["outbound"],
+            imported["inbound_policy"],
+            imported["outbound_policy"],
+        )
+        return serialize_api_response(
+            {
+                "message": (
+                    f"Firewall {firewall_id} rules imported: "
+                    f"{len(rules.get('inbound') or [])} inbound and "
+                    f"{len(rules.get('outbound') or [])} outbound rules"
+                ),
+                "firewall_id": firewall_id,
+                "rules": rules,
+            },
+            firewall_pb2.FirewallRulesWriteResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "import firewall rules", _call)
+
+
+def _import_side_effects(
+    firewall_id: int, current: dict[str, Any], imported: dict[str, Any]
+) -> DryRunDetails:
+    """What replacing the current rules with the document would change, from
+    the shared diff (mirrors Go firewallImportSideEffects)."""
+    diff = _firewall_diff(f"firewall {firewall_id}", "the document", current, imported)
+    if diff["identical"]:
+        return {
+            "warnings": [
+                f"Firewall {firewall_id} already matches the document; the "
+                "import changes nothing."
+            ]
+        }
+    effects = [
+        f"The {change['direction']} default policy will change from "
+        f"{change['before']} to {change['after']}."
+        for change in diff["policy_changes"]
+    ]
+    effects.extend(
+        f"The {added['direction']} rule {_rule_name(added['rule'])} will be added."
+        for added in diff["added"]
+    )
+    effects.extend(
+        f"The {removed['direction']} rule {_rule_name(removed['rule'])} will be "
+        "removed."
+        for removed in diff["removed"]
+    )
+    effects.extend(
+        f"The {changed['direction']} rule '{changed['label']}' will change: "
+        f"{', '.join(changed['fields'])}."
+        for changed in diff["changed"]
+    )
+    return {"side_effects": effects}
+
+
+def _rule_name(rule: dict[str, Any]) -> str:
+    """Name a rule in a preview: its label, or for an unlabeled rule what it
+    matches."""
+    label = str(rule.get("label") or "")
+    if label:
+        return f"'{label}'"
+    name = f"{rule.get('action') or ''} {rule.get('protocol') or ''}"
+    ports = str(rule.get("ports") or "")
+    if ports:
+        name += f" {ports}"
+    return f"({name})"
+
+
+def _document_from_tool(raw: Any) -> tuple[dict[str, Any] | None, str]:
+    """Validate a firewall document, given as an object or a JSON string,
+    returning the rules and policies to apply or a message naming the first
+    problem (mirrors Go firewallDocumentFromTool)."""
+    if isinstance(raw, str):
+        if not raw.strip():
+            return None, "document is required"
+        try:
+            raw = json.loads(raw)
+        except ValueError:
+            return None, "document must be an object"
+    elif raw is None:
+        return None, "document is required"
+    if not isinstance(raw, dict):
+        return None, "document must be an object"
+    document = cast("dict[str, Any]", raw)
+
+    unknown = _unknown_field(document, _DOCUMENT_FIELDS, "document")
+    if unknown:
+        return None, unknown
+    if document.get("format") != _FORMAT:
+        return None, f'document.format must be "{_FORMAT}"'
+    if "firewall" in document and not isinstance(document["firewall"], str):
+        return None, "document.firewall must be a string"
+
+    imported: dict[str, Any] = {}
+    for direction in _DIRECTIONS:
+        policy, rules, error = _chain_from_document(
+            document.get(direction), f"document.{direction}"
+        )
+        if error:
+            return None, error
+        imported[f"{direction}_policy"] = policy
+        imported[direction] = rules
+    return imported, ""
+
+
+def _chain_from_document(raw: Any, key: str) -> tuple[str, list[dict[str, Any]], str]:
+    """Validate one direction of a document, returning its policy and rules."""
+    if raw is None:
+        return "", [], f"{key} is required"
+    if not isinstance(raw, dict):
+        return "", [], f"{key} must be an object"
+    chain = cast("dict[str, Any]", raw)
+    unknown = _unknown_field(chain, _CHAIN_FIELDS, key)
+    if unknown:
+        return "", [], unknown
+
+    policy, error = _document_enum(
+        chain.get("policy"), f"{key}.policy", firewall_pb2.FirewallPolicy.Value
+    )
+    if error:
+        return "", [], error
+
+    entries = chain.get("rules")
+    if entries is None:
+        entries = []
+    if not isinstance(entries, list) or not all(
+        isinstance(entry, dict) for entry in cast("list[Any]", entries)
+    ):
+        return "", [], f"{key}.rules must be an array of objects"
+
+    rules: list[dict[str, Any]] = []
+    for idx, entry in enumerate(cast("list[dict[str, Any]]", entries)):
+        rule, error = _rule_from_document(entry, f"{key}.rules[{idx}]")
+        if error:
+            return "", [], error
+        rules.append(rule)
+    return policy, rules, ""
+
+
+def _rule_from_document(
+    document: dict[str, Any], key: str
+) -> tuple[dict[str, Any], str]:
+    """Validate one document rule. The result carries only the fields the rule
+    sets, so an empty ports, label, or description (as an export writes for a
+    rule without one) is left out."""
+    unknown = _unknown_field(document, _RULE_FIELDS, key)
+    if unknown:
+        return {}, unknown
+
+    action, error = _document_enum(
+        document.get("action"), f"{key}.action", firewall_pb2.FirewallPolicy.Value
+    )
+    if error:
+        return {}, error
+    protocol, error = _document_enum(
+        document.get("protocol"),
+        f"{key}.protocol",
+        firewall_simulate_pb2.FirewallSimulateProtocol.Value,
+    )
+    if error:
+        return {}, error
+
+    rule: dict[str, Any] = {"action": action, "protocol": protocol}
+    for field in ("ports", "label", "description"):
+        raw = document.get(field)
+        if raw is None:
+            continue
+        if not isinstance(raw, str):
+            return {}, f"{key}.{field} must be a string"
+        value = raw.strip()
+        if not value:
+            continue
+        if field == "ports":
+            if protocol not in _PORT_PROTOCOLS:
+                return {}, f"{key}.ports applies only to TCP and UDP"
+            _, bad_entry = _ports_contain(value, 0)
+            if bad_entry:
+                return {}, f'{key}.ports "{value}" do not parse at "{bad_entry}"'
+        elif field == "label" and not _LABEL_PATTERN.match(value):
+            return {}, f"{key}.label must be 3-32 letters, digits, '.', '_', or '-'"
+        rule[field] = value
+
+    addresses, error = _addresses_from_document(
+        document.get("addresses"), f"{key}.addresses"
+    )
+    if error:
+        return {}, error
+    rule["addresses"] = addresses
+    return rule, ""
+
+
+def _addresses_from_document(raw: Any, key: str) -> tuple[dict[str, Any], str]:
+    """Validate a rule's addresses: IPv4 entries under ipv4 and IPv6 CIDRs
+    under ipv6, at least one in all, each rewritten to its canonical form."""
+    if not isinstance(raw, dict):
+        return {}, f"{key} must be an object"
+    document = cast("dict[str, Any]", raw)
+    unknown = _unknown_field(document, _ADDRESS_FAMILIES, key)
+    if unknown:
+        return {}, unknown
+
+    addresses: dict[str, Any] = {}
+    for family in _ADDRESS_FAMILIES:
+        if family not in document:
+            continue
+        entries = document[family]
+        if not isinstance(entries, list) or not all(
+            isinstance(entry, str) for entry in cast("list[Any]", entries)
+        ):
+            return {}, f"{key}.{family} must be an array of strings"
+        canonical: list[str] = []
+        for idx, value in enumerate(cast("list[str]", entries)):
+            address, error = _document_address(family, value)
+            if error:
+                return {}, f'{key}.{family}[{idx}] "{value}" {error}'
+            canonical.append(address)
+        addresses[family] = canonical
+
+    if not any(addresses.values()):
+        return {}, f"{key} must list at least one ipv4 or ipv6 address"
+    return addresses, ""
+
+
+def _document_address(family: str, raw: str) -> tuple[str, str]:
+    """Canonicalize one address entry of the given family. A bare IPv4 address
+    becomes a /32; IPv6 entries must already be CIDRs, as the API wants."""
+    if family == "ipv6":
+        ipv6, error = _parse_firewall_ipv6(raw)
+        return (str(ipv6), "") if ipv6 is not None else ("", error)
+    network, error = _parse_cidr(raw)
+    if network is None:
+        return "", error
+    if network.version != 4:  # noqa: PLR2004
+        return "", "is an IPv6 address; list it under addresses.ipv6"
+    return str(network), ""
+
+
+def _document_enum(raw: Any, key: str, enum: EnumTypeWrapper) -> tuple[str, str]:
+    """Read a required document field that must name a value of a proto
+    enum."""
+    if not isinstance(raw, str) or not raw:
+        return "", f"{key} must be one of: {', '.join(enum_value_names(enum))}"
+    return raw, enum_choice_error(raw, key, enum) or ""
+
+
+def _unknown_field(document: dict[str, Any], allowed: tuple[str, ...], key: str) -> str:
+    """Name the first field of document, in sorted order, not in allowed."""
+    unknown = sorted(field for field in document if field not in allowed)
+    if not unknown:
+        return ""
+    return f'{key} has unknown field "{unknown[0]}"'
@@ -1,5 +1,6 @@
 from __future__ import annotations
 
+import ipaddress
 from typing import TYPE_CHECKING, Any, TypeGuard, cast
 
 import httpx
@@ -499,6 +500,55 @@ def create_linode_firewall_rules_update_tool() -> tuple[Tool, Capability]:
     ), Capability.Write
 
 
+def _parse_firewall_ipv6(raw: str) -> tuple[ipaddress.IPv6Network | None, str]:
+    """Parse one addresses.ipv6 entry; mirrors Go's parseFirewallIPv6."""
+    raw = raw.strip()
+    address, slash, length = raw.partition("/")
+    if "%" in raw or (slash and (not length.isdigit() or str(int(length)) != length)):
+        return None, "is not a valid IPv6 CIDR"
+    try:
+        host = ipaddress.ip_address(address)
+    except ValueError:
+        return None, "is not a valid IPv6 CIDR"
+    if host.version == 4 or host.ipv4_mapped is not None:  # noqa: PLR2004
+        return None, "is an IPv4 address; list it under addresses.ipv4"
+    if not slash:
+        return None, f"has no prefix length; use {host}/128 for a single host"
+    try:
+        network = ipaddress.IPv6Network(raw, strict=False)
+    except ValueError:
+        return None, "is not a valid IPv6 CIDR"
+    if network.network_address != host:
+        return None, f"has host bits set; use {network}"
+    return network, ""
+
+
+def _normalized_rule_set(
+    field: str, rules: list[dict[str, Any]]
+) -> tuple[list[dict[str, Any]], str | None]:
+    """Rewrite each rule's addresses.ipv6 entries to canonical form, so
+    "0::/0" goes out as "::/0"; mirrors Go's normalizeFirewallRuleIPv6."""
+    normalized: list[dict[str, Any]] = []
+    for idx, rule in enumerate(rules):
+        addresses = rule.get("addresses")
+        entries = addresses.get("ipv6") if isinstance(addresses, dict) else None
+        if not isinstance(entries, list):
+            normalized.append(rule)
+            continue
+        canonical: list[str] = []
+        for entry_idx, entry in enumerate(cast("list[Any]", entries)):
+            where = f"{field}[{idx}].addresses.ipv6[{entry_idx}]"
+            if not isinstance(entry, str):
+                return [], f"{where} must be a string"
+            network, msg = _parse_firewall_ipv6(entry)
+            if network is None:
+                return [], f'{where} "{entry}" {msg}'
+            canonical.append(str(network))
+        merged = {**cast("dict[str, Any]", addresses), "ipv6": canonical}
+        normalized.append({**rule, "addresses": merged})
+    return normalized, None
+
+
 def _firewall_rules_fields_error(arguments: dict[str, Any]) -> str | None:
     firewall_id = arguments.get("firewall_id", 0)
 
@@ -517,6 +567,9 @@ def _firewall_rules_fields_error(arguments: dict[str, Any]) -> str | None:
         # behavior fixtures assert one byte-identical message in both languages.
         if not _is_firewall_rule_list(rules_raw):
             return f"{field} must be an array of objects"
+        _, ipv6_error = _normalized_rule_set(field, rules_raw)
+        if ipv6_error is not None:
+            return ipv6_error
 
     return None
 
@@ -555,8 +608,12 @@ async def handle_linode_firewall_rules_update(
     if validation_error is not None:
         return error_response(validation_error)
 
-    inbound = cast("list[dict[str, Any]]", arguments.get("inbound"))
-    outbound = cast("list[dict[str, Any]]", arguments.get("outbound"))
+    inbound, _ = _normalized_rule_set(
+        "inbound", cast("list[dict[str, Any]]", arguments.get("inbound"))
+    )
+    outbound, _ = _normalized_rule_set(
+        "outbound", cast("list[dict[str, Any]]", arguments.get("outbound"))
+    )
 
     async def _call(client: RetryableClient) -> dict[str, Any]:
         result = await client.update_firewall_rules_raw(
@@ -706,8 +763,9 @@ def create_linode_firewall_device_create_tool() -> tuple[Tool, Capability]:
     return Tool(
         name="linode_firewall_device_create",
         description=(
-            "Creates a new device for a Cloud Firewall. "
-            "WARNING: This operation requires confirmation."
+            "Creates a new device for a Cloud Firewall. A NodeBalancer can have "
+            "only one firewall, so one already assigned elsewhere is rejected "
+            "before the call. WARNING: This operation requires confirmation."
         ),
         inputSchema=schema("linode.mcp.v1.FirewallDeviceCreateInput"),
     ), Capability.Write
@@ -751,6 +809,39 @@ def _firewall_device_create_fields_error(
     return None
 
 
+async def _check_nodebalancer_firewall_conflict(
+    client: RetryableClient, nodebalancer_id: int, firewall_id: int
+) -> None:
+    """Reject assigning a NodeBalancer that already sits behind a firewall.
+
+    A NodeBalancer can have at most one Cloud Firewall, so the API would refuse
+    the assignment anyway; the ValueError names the firewall and how to move
+    the NodeBalancer instead. A missing NodeBalancer surfaces as the lookup's
+    API error. Mirrors Go's nodeBalancerFirewallConflict.
+    """
+    response = await client.list_nodebalancer_firewalls(nodebalancer_id)
+    firewalls = [
+        cast("dict[str, Any]", firewall)
+        for firewall in response.get("data", [])
+        if isinstance(firewall, dict)
+    ]
+    if any(firewall.get("id") == firewall_id for firewall in firewalls):
+        msg = (
+            f"NodeBalancer {nodebalancer_id} is already assigned to "
+            f"firewall {firewall_id}"
+        )
+        raise ValueError(msg)
+    if firewalls:
+        msg = (
+            f"NodeBalancer {nodebalancer_id} is already assigned to firewall "
+            f"{firewalls[0].get('id')} ({firewalls[0].get('label', '')}); a "
+            "NodeBalancer can have only one firewall, so remove that assignment "
+            "with linode_firewall_device_delete or replace it with "
+            "linode_nodebalancer_firewall_update"
+        )
+        raise ValueError(msg)
+
+
 async def handle_linode_firewall_device_create(
     arguments: dict[str, Any], cfg: Config
 ) -> list[TextContent]:
@@ -787,6 +878,10 @@ async def handle_linode_firewall_device_create(
         return fields_error
 
     async def _call(client: RetryableClient) -> dict[str, Any]:
+        if arguments["type"] == "nodebalancer":
+            await _check_nodebalancer_firewall_conflict(
+                client, int(arguments["id"]), int(arguments["firewall_id"])
+            )
         raw = await client.create_firewall_device(
             firewall_id=int(arguments["firewall_id"]),
             device_id=int(arguments["id"]),
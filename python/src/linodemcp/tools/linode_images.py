@@ -25,6 +25,7 @@ from linodemcp.tools.helpers import (
     is_dry_run,
     pagination_int_argument,
     required_int_id,
+    walk_page_items,
 )
 from linodemcp.tools.proto_response import (
     raw_str,
@@ -49,7 +50,10 @@ def create_linode_image_list_tool() -> tuple[Tool, Capability]:
         name="linode_image_list",
         description=(
             "Lists all available Linode images (OS images and custom images) "
-            "with optional filtering by type, public status, or deprecated status"
+            "with optional filtering by type, public status, deprecated status, "
+            "vendor, or label. Set latest_per_distro=true to get only the newest "
+            "image of each distro, which is usually the one to create an "
+            "instance from."
         ),
         inputSchema=schema("linode.mcp.v1.ImageListInput"),
     ), Capability.Read
@@ -1927,10 +1931,18 @@ async def handle_linode_image_delete(
 async def handle_linode_image_list(
     arguments: dict[str, Any], cfg: Any
 ) -> list[TextContent]:
-    """Handle linode_image_list tool request."""
+    """Handle linode_image_list tool request.
+
+    latest_per_distro=true keeps only the newest image of each vendor by
+    created time, in list order, after the other filters; images with no
+    vendor are dropped. Mirrors Go's latestPerDistroFilter.
+    """
     type_filter = str(arguments.get("type", ""))
     is_public_filter = str(arguments.get("is_public", ""))
     deprecated_filter = str(arguments.get("deprecated", ""))
+    vendor_filter = str(arguments.get("vendor", ""))
+    label_filter = str(arguments.get("label", ""))
+    latest_filter = str(arguments.get("latest_per_distro", ""))
 
     def _matches(image: dict[str, Any]) -> bool:
         image_type = str(image.get("type", ""))
@@ -1940,11 +1952,15 @@ async def handle_linode_image_list(
             is_public_filter.lower() == "true"
         ):
             return False
-        return not (
-            deprecated_filter
-            and bool(image.get("deprecated", False))
-            != (deprecated_filter.lower() == "true")
-        )
+        if deprecated_filter and bool(image.get("deprecated", False)) != (
+            deprecated_filter.lower() == "true"
+        ):
+            return False
+        vendor = str(image.get("vendor") or "")
+        if vendor_filter and vendor.lower() != vendor_filter.lower():
+            return False
+        label = str(image.get("label") or "")
+        return not label_filter or label_filter.lower() in label.lower()
 
     filters: list[str] = []
     if type_filter:
@@ -1953,15 +1969,50 @@ async def handle_linode_image_list(
         filters.append(f"is_public={is_public_filter}")
     if deprecated_filter:
         filters.append(f"deprecated={deprecated_filter}")
+    if vendor_filter:
+        filters.append(f"vendor={vendor_filter}")
+    if label_filter:
+        filters.append(f"label={label_filter}")
+    if latest_filter:
+        filters.append(f"latest_per_distro={latest_filter}")
 
     async def _call(client: RetryableClient) -> dict[str, Any]:
         raw = await client.get_raw("/images")
+        newest: dict[str, dict[str, Any]] | None = None
+        if latest_filter.lower() == "true":
+            newest = _newest_per_vendor(
+                [image for image in walk_page_items(raw) if _matches(image)]
+            )
+
+        def _keep(image: dict[str, Any]) -> bool:
+            if not _matches(image):
+                return False
+            if newest is None:
+                return True
+            vendor = str(image.get("vendor") or "").lower()
+            return vendor in newest and newest[vendor].get("id") == image.get("id")
+
         return serialize_list_response(
             raw,
             "images",
             image_pb2.ImageListResponse(),
             filter_value=", ".join(filters) if filters else None,
-            item_filter=_matches,
+            item_filter=_keep,
         )
 
     return await execute_tool(cfg, arguments, "retrieve Linode images", _call)
+
+
+def _newest_per_vendor(images: list[dict[str, Any]]) -> dict[str, dict[str, Any]]:
+    """Map each lowercased vendor to its newest image by created time. The
+    first of equally new images wins, and images with no vendor are skipped."""
+    newest: dict[str, dict[str, Any]] = {}
+    for image in images:
+        vendor = str(image.get("vendor") or "").lower()
+        if not vendor:
+            continue
+        current = newest.get(vendor)
+        created = str(image.get("created") or "")
+        if current is None or created > str(current.get("created") or ""):
+            newest[vendor] = image
+    return newest
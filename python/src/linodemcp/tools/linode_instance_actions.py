@@ -16,6 +16,11 @@ from linodemcp.tools.helpers import (
     is_dry_run,
     preview_state_str,
 )
+from linodemcp.tools.linode_instance_migrate_plan import (
+    MIGRATION_TYPE_COLD,
+    MIGRATION_TYPE_WARM,
+    build_instance_migrate_plan,
+)
 from linodemcp.tools.proto_response import raw_int, raw_str, serialize_api_response
 from linodemcp.tools.toolschemas import schema
 from linodemcp.tools.twostage_destroy import run_two_stage_destroy
@@ -111,7 +116,12 @@ def create_linode_instance_migrate_tool() -> tuple[Tool, Capability]:
     """Create the linode_instance_migrate tool."""
     return Tool(
         name="linode_instance_migrate",
-        description=("Migrates a Linode instance to a new region"),
+        description=(
+            "Migrates a Linode instance to a new region. Set plan=true to get a "
+            "migration plan instead: the expected downtime type (cold or warm), "
+            "the disk size to transfer, and any migration Linode has already "
+            "queued."
+        ),
         inputSchema=schema("linode.mcp.v1.InstanceMigrateInput"),
     ), Capability.Write
 
@@ -144,6 +154,20 @@ async def handle_linode_instance_migrate(
     if isinstance(iid, list):
         return iid
 
+    migration_type = arguments.get("type", "")
+    if migration_type not in ("", MIGRATION_TYPE_COLD, MIGRATION_TYPE_WARM):
+        return _error_response('type must be "cold" or "warm"')
+
+    if arguments.get("plan") is True:
+        region = arguments.get("region", "")
+
+        async def _plan(client: RetryableClient) -> dict[str, Any]:
+            return await build_instance_migrate_plan(
+                client, iid, region, migration_type
+            )
+
+        return await execute_tool(cfg, arguments, "plan instance migration", _plan)
+
     if is_dry_run(arguments):
         region = arguments.get("region", "")
 
@@ -174,7 +198,12 @@ async def handle_linode_instance_migrate(
     async def _call(
         client: RetryableClient,
     ) -> dict[str, Any]:
-        await client.migrate_instance(iid, region=region or None)
+        if migration_type:
+            await client.migrate_instance(
+                iid, region=region or None, migration_type=migration_type
+            )
+        else:
+            await client.migrate_instance(iid, region=region or None)
         # Echo the target region only when the caller picked one; an omitted
         # region (Linode picks the destination) leaves the field unset so it
         # stays absent from the output, matching Go's InstanceMigrateWriteResponse.
@@ -0,0 +1,258 @@
+"""Linode instance alert tools: set thresholds and audit disabled alerts."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING, Any, cast
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import instance_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+    required_int_id,
+    walk_page_items,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+# Alert thresholds in the order the tools read and report them (mirrors Go
+# instanceAlertNames).
+_ALERT_NAMES = ("cpu", "io", "network_in", "network_out", "transfer_quota")
+
+# Upper bounds Linode enforces on the percentage thresholds. The CPU bound is
+# per vCPU: a 4-core instance accepts up to 400.
+_CPU_PERCENT_PER_VCPU = 100
+_TRANSFER_QUOTA_MAX_PCT = 100
+
+
+def create_linode_instance_alerts_update_tool() -> tuple[Tool, Capability]:
+    """Create the linode_instance_alerts_update tool."""
+    return Tool(
+        name="linode_instance_alerts_update",
+        description=(
+            "Sets alert thresholds on a Linode instance: cpu (percent, up to 100 "
+            "per vCPU), io (ops/sec), network_in and network_out (Mb/s), and "
+            "transfer_quota (percent of the monthly quota). Thresholds not "
+            "passed keep their current values; 0 disables that alert. Pass "
+            "dry_run=true to preview the changes without updating."
+        ),
+        inputSchema=schema("linode.mcp.v1.InstanceAlertsUpdateInput"),
+    ), Capability.Write
+
+
+def create_linode_alerts_audit_tool() -> tuple[Tool, Capability]:
+    """Create the linode_alerts_audit tool."""
+    return Tool(
+        name="linode_alerts_audit",
+        description=(
+            "Checks every Linode instance's alert thresholds and flags instances "
+            "with every alert disabled (all thresholds 0), which would go "
+            "unnoticed if they ran hot or lost traffic. Instances with only some "
+            "alerts disabled are listed separately with the disabled alert "
+            "names. Use linode_instance_alerts_update to turn alerts back on."
+        ),
+        inputSchema=schema("linode.mcp.v1.AlertsAuditInput"),
+    ), Capability.Read
+
+
+def _alert_thresholds(arguments: dict[str, Any]) -> tuple[dict[str, int], str]:
+    """Collect the thresholds passed in the args, keyed by name, or return a
+    validation message (mirrors Go instanceAlertsFromTool)."""
+    thresholds: dict[str, int] = {}
+    for name in _ALERT_NAMES:
+        if name not in arguments:
+            continue
+        value = arguments[name]
+        if isinstance(value, float) and value.is_integer():
+            value = int(value)
+        if not isinstance(value, int) or isinstance(value, bool) or value < 0:
+            return (
+                {},
+                f"{name} must be an integer of 0 or greater; 0 disables the alert",
+            )
+        thresholds[name] = value
+
+    if not thresholds:
+        return {}, "at least one threshold is required: " + ", ".join(_ALERT_NAMES)
+    if thresholds.get("transfer_quota", 0) > _TRANSFER_QUOTA_MAX_PCT:
+        return (
+            {},
+            f"transfer_quota must be from 0 through {_TRANSFER_QUOTA_MAX_PCT} percent",
+        )
+    return thresholds, ""
+
+
+def _cpu_error(thresholds: dict[str, int], vcpus: int) -> str:
+    """Check a requested cpu threshold against the vCPU count; an unknown
+    count (0) skips the check."""
+    value = thresholds.get("cpu")
+    limit = vcpus * _CPU_PERCENT_PER_VCPU
+    if value is None or vcpus == 0 or value <= limit:
+        return ""
+    return f"cpu must be from 0 through {limit} for an instance with {vcpus} vCPUs"
+
+
+def _current_alerts(instance: dict[str, Any]) -> dict[str, int]:
+    """Read all five thresholds from a raw instance, defaulting to 0."""
+    raw = instance.get("alerts")
+    alerts = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    return {name: int(alerts.get(name) or 0) for name in _ALERT_NAMES}
+
+
+def _vcpus(instance: dict[str, Any]) -> int:
+    """Read the vCPU count from a raw instance, 0 when unknown."""
+    raw = instance.get("specs")
+    specs = cast("dict[str, Any]", raw) if isinstance(raw, dict) else {}
+    return int(specs.get("vcpus") or 0)
+
+
+def _alert_changes(before: dict[str, int], after: dict[str, int]) -> list[str]:
+    """Describe each threshold that differs (mirrors Go instanceAlertChanges)."""
+    changes: list[str] = []
+    for name in _ALERT_NAMES:
+        if before[name] == after[name]:
+            continue
+        if after[name] == 0:
+            changes.append(f"{name} alert disabled (was {before[name]})")
+        else:
+            changes.append(f"{name} threshold {before[name]} -> {after[name]}")
+    return changes
+
+
+def _alerts_update_side_effects(
+    state: dict[str, Any], thresholds: dict[str, int]
+) -> DryRunDetails:
+    """Tier B walk: one line per threshold that changes, plus a warning when
+    cpu exceeds what the vCPU count allows."""
+    details: DryRunDetails = {}
+    cpu_error = _cpu_error(thresholds, state["vcpus"])
+    if cpu_error:
+        details["warnings"] = [f"{cpu_error}; the update would be rejected."]
+    changes = _alert_changes(state["alerts"], {**state["alerts"], **thresholds})
+    details["side_effects"] = (
+        [f"Instance {change}." for change in changes]
+        if changes
+        else ["No alert thresholds change."]
+    )
+    return details
+
+
+async def handle_linode_instance_alerts_update(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_instance_alerts_update tool request."""
+    if is_dry_run(arguments):
+        instance_id, error = required_int_id(arguments, "instance_id")
+        if instance_id is None:
+            return error_response(error)
+        thresholds, error = _alert_thresholds(arguments)
+        if error:
+            return error_response(error)
+
+        async def _fetch(client: RetryableClient) -> Any:
+            instance = await client.get_raw(f"/linode/instances/{instance_id}")
+            return {"alerts": _current_alerts(instance), "vcpus": _vcpus(instance)}
+
+        async def _walk(_client: RetryableClient, state: Any) -> DryRunDetails:
+            return _alerts_update_side_effects(state, thresholds)
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_instance_alerts_update",
+            "PUT",
+            f"/linode/instances/{instance_id}",
+            _fetch,
+            _walk,
+        )
+
+    if not arguments.get("confirm"):
+        return error_response(
+            "This changes the alert thresholds of a Linode instance. "
+            "Set confirm=true to proceed."
+        )
+
+    instance_id, error = required_int_id(arguments, "instance_id")
+    if instance_id is None:
+        return error_response(error)
+    thresholds, error = _alert_thresholds(arguments)
+    if error:
+        return error_response(error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        current = await client.get_raw(f"/linode/instances/{instance_id}")
+        cpu_error = _cpu_error(thresholds, _vcpus(current))
+        if cpu_error:
+            raise ValueError(cpu_error)
+
+        previous = _current_alerts(current)
+        merged = {**previous, **thresholds}
+        updated = await client.put_raw(
+            f"/linode/instances/{instance_id}", {"alerts": merged}
+        )
+        changes = _alert_changes(previous, merged)
+        summary = ", ".join(changes) if changes else "no thresholds changed"
+        return serialize_api_response(
+            {
+                "message": f"Instance {instance_id} alerts updated: {summary}",
+                "instance_id": instance_id,
+                "previous": previous,
+                "alerts": _current_alerts(updated),
+            },
+            instance_pb2.InstanceAlertsUpdateResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "update instance alerts", _call)
+
+
+def _alerts_audit(instances: list[dict[str, Any]]) -> dict[str, Any]:
+    """Sort instances into those with every alert disabled and those with
+    only some disabled (mirrors Go alertsAudit)."""
+    disabled: list[dict[str, Any]] = []
+    partial: list[dict[str, Any]] = []
+    for instance in instances:
+        alerts = _current_alerts(instance)
+        off = [name for name in _ALERT_NAMES if alerts[name] == 0]
+        if not off:
+            continue
+        entry = {
+            "id": instance.get("id", 0),
+            "label": instance.get("label", ""),
+            "region": instance.get("region", ""),
+            "disabled_alerts": off,
+        }
+        (disabled if len(off) == len(_ALERT_NAMES) else partial).append(entry)
+
+    return {
+        "message": (
+            f"{len(disabled)} of {len(instances)} instances have every alert "
+            f"disabled; {len(partial)} more have some alerts disabled"
+        ),
+        "checked": len(instances),
+        "disabled": disabled,
+        "partially_disabled": partial,
+    }
+
+
+async def handle_linode_alerts_audit(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_alerts_audit tool request."""
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        raw = await client.get_raw("/linode/instances")
+        return serialize_api_response(
+            _alerts_audit(walk_page_items(raw)),
+            instance_pb2.AlertsAuditResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "retrieve Linode instances", _call)
@@ -0,0 +1,213 @@
+"""Linode instance cloud-init status tool: provisioning progress from events."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import instance_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import (
+    error_response,
+    execute_tool,
+    required_int_id,
+    walk_page_items,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+# Provisioning outcomes reported in provisioning_status.
+_NOT_APPLICABLE = "not_applicable"
+_UNKNOWN = "unknown"
+_IN_PROGRESS = "in_progress"
+_FAILED = "failed"
+_PENDING_BOOT = "pending_boot"
+_COMPLETED = "completed"
+
+# How much of the newest-first event feed is scanned for the instance's
+# provisioning and boot events (mirrors Go cloudInitEventScan).
+_EVENT_SCAN = 100
+_PROVISION_ACTIONS = ("linode_create", "linode_rebuild")
+_BOOT_ACTIONS = ("linode_boot", "linode_reboot", "lassie_reboot")
+
+
+def create_linode_instance_cloudinit_status_tool() -> tuple[Tool, Capability]:
+    """Create the linode_instance_cloudinit_status tool."""
+    return Tool(
+        name="linode_instance_cloudinit_status",
+        description=(
+            "Reports whether an instance's user_data (cloud-init) provisioning "
+            "has run. The metadata service only answers from inside the "
+            "instance, so cloud-init's own result is not available through the "
+            "API; this reads the instance's create or rebuild event and the "
+            "first boot after it, and returns provisioning_status completed, "
+            "pending_boot, in_progress, failed, unknown, or not_applicable (no "
+            "user_data)."
+        ),
+        inputSchema=schema("linode.mcp.v1.InstanceCloudInitStatusInput"),
+    ), Capability.Read
+
+
+def _provisioning_status(
+    has_user_data: bool,
+    provision: dict[str, Any] | None,
+    first_boot: dict[str, Any] | None,
+) -> str:
+    """Map the provisioning and first-boot events to a provisioning_status
+    (mirrors Go cloudInitProvisioningStatus)."""
+    if not has_user_data:
+        return _NOT_APPLICABLE
+    if provision is None:
+        return _UNKNOWN
+    step = provision
+    if provision.get("status") == "finished":
+        if first_boot is None:
+            return _PENDING_BOOT
+        step = first_boot
+    status = step.get("status")
+    if status == "finished":
+        return _COMPLETED
+    if status == "failed":
+        return _FAILED
+    return _IN_PROGRESS
+
+
+def _status_message(
+    prefix: str,
+    status: str,
+    provision: dict[str, Any] | None,
+    first_boot: dict[str, Any] | None,
+) -> str:
+    """The one-line summary for a provisioning_status (mirrors Go
+    cloudInitStatusMessage)."""
+    if status == _NOT_APPLICABLE:
+        return (
+            f"{prefix} has no user_data, so there is no cloud-init provisioning "
+            "to verify"
+        )
+    if status == _UNKNOWN:
+        return (
+            f"{prefix} has user_data, but its provisioning events are not in "
+            "the recent event feed"
+        )
+    if status == _PENDING_BOOT:
+        return (
+            f"{prefix} was provisioned but has not booted since, so cloud-init "
+            "has not applied user_data yet"
+        )
+    if status == _FAILED:
+        failed = provision
+        if provision is None or provision.get("status") != "failed":
+            failed = first_boot
+        return f"{prefix} provisioning failed ({(failed or {}).get('action', '')})"
+    if status == _COMPLETED:
+        return (
+            f"{prefix} finished its first boot after provisioning, when "
+            "cloud-init applies user_data"
+        )
+    return (
+        f"{prefix} is still provisioning; cloud-init runs once the first boot "
+        "finishes"
+    )
+
+
+def _instance_cloudinit_status(
+    instance: dict[str, Any], events: list[dict[str, Any]], warnings: list[str]
+) -> dict[str, Any]:
+    """Infer provisioning progress from the newest page of account events: the
+    latest create or rebuild of the instance, then the oldest boot after it,
+    which is the boot cloud-init applies user_data on (mirrors Go
+    instanceCloudInitStatus)."""
+    instance_id = int(instance.get("id") or 0)
+    label = str(instance.get("label") or "")
+    has_user_data = bool(instance.get("has_user_data"))
+
+    provision: dict[str, Any] | None = None
+    boots: list[dict[str, Any]] = []
+    for event in events:
+        entity = event.get("entity") or {}
+        if entity.get("type") != "linode" or entity.get("id") != instance_id:
+            continue
+        if event.get("action") in _PROVISION_ACTIONS:
+            provision = event
+            break
+        if event.get("action") in _BOOT_ACTIONS:
+            boots.append(event)
+    first_boot = boots[-1] if boots and provision is not None else None
+
+    status = _provisioning_status(has_user_data, provision, first_boot)
+    guidance: list[str] = []
+    if status != _NOT_APPLICABLE:
+        guidance.append(
+            "The API cannot see cloud-init's result; run `cloud-init status "
+            "--long` on the instance (over SSH, or Lish via "
+            "linode_instance_console) to confirm every user_data step succeeded."
+        )
+    if status == _UNKNOWN:
+        guidance.append(
+            "No create or rebuild of the instance is in the newest "
+            f"{_EVENT_SCAN} account events; it was provisioned too long ago to "
+            "tell from events."
+        )
+    elif status == _PENDING_BOOT:
+        guidance.append(
+            "Boot the instance with linode_instance_boot; cloud-init applies "
+            "user_data on the first boot after provisioning."
+        )
+    elif status == _FAILED:
+        guidance.append(
+            "Provisioning failed before cloud-init could run; check the failed "
+            "event and the console with linode_instance_console."
+        )
+
+    response: dict[str, Any] = {
+        "message": _status_message(
+            f"Instance {instance_id} ({label})", status, provision, first_boot
+        ),
+        "instance_id": instance_id,
+        "label": label,
+        "status": str(instance.get("status") or ""),
+        "has_user_data": has_user_data,
+        "provisioning_status": status,
+        "guidance": guidance,
+        "warnings": warnings,
+    }
+    if provision is not None:
+        response["provisioning_event"] = provision
+    if first_boot is not None:
+        response["first_boot"] = first_boot
+    return response
+
+
+async def handle_linode_instance_cloudinit_status(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_instance_cloudinit_status tool request."""
+    instance_id, error = required_int_id(arguments, "instance_id")
+    if instance_id is None:
+        return error_response(error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        instance = await client.get_raw(f"/linode/instances/{instance_id}")
+        warnings: list[str] = []
+        events: list[dict[str, Any]] = []
+        try:
+            events = walk_page_items(
+                await client.get_raw(f"/account/events?page=1&page_size={_EVENT_SCAN}")
+            )
+        except (APIError, NetworkError) as exc:
+            warnings.append(
+                f"Events unavailable, so provisioning progress is unknown: {exc}"
+            )
+        return serialize_api_response(
+            _instance_cloudinit_status(instance, events, warnings),
+            instance_pb2.InstanceCloudInitStatusResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "read instance cloud-init status", _call)
@@ -0,0 +1,193 @@
+"""Linode instance console tool: recent events, last boot, and Lish access."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import instance_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import (
+    error_response,
+    execute_tool,
+    required_int_id,
+    walk_page_items,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_EVENTS_DEFAULT = 10
+_EVENTS_MAX = 50
+# The account event feed is newest first; one page of it is scanned for the
+# instance's events (mirrors Go instanceConsoleEventScan).
+_EVENT_SCAN = 100
+_BOOT_ACTIONS = ("linode_boot", "linode_reboot", "lassie_reboot")
+# Regions that predate region-named Lish gateways, mapped to their data
+# center gateway name. Every other region's gateway is lish-<region>.linode.com.
+_LISH_LEGACY_GATEWAYS = {
+    "us-east": "newark",
+    "us-southeast": "atlanta",
+    "us-central": "dallas",
+    "us-west": "fremont",
+    "eu-west": "london",
+    "eu-central": "frankfurt",
+    "ap-south": "singapore",
+    "ap-northeast": "tokyo2",
+    "ap-west": "mumbai1",
+    "ap-southeast": "sydney",
+    "ca-central": "toronto1",
+}
+
+
+def create_linode_instance_console_tool() -> tuple[Tool, Capability]:
+    """Create the linode_instance_console tool."""
+    return Tool(
+        name="linode_instance_console",
+        description=(
+            'Answers "what did the console say" for an instance. The Linode API '
+            "does not expose serial console or boot log output, so this returns "
+            "the instance's status, its most recent events (events, default 10) "
+            "with the last boot highlighted, and how to read the console live "
+            "through Lish: the SSH gateway and command for the current profile, "
+            "plus the Weblish and Glish URLs."
+        ),
+        inputSchema=schema("linode.mcp.v1.InstanceConsoleInput"),
+    ), Capability.Read
+
+
+def _lish_gateway(region: str) -> str:
+    """The Lish SSH gateway host for a region."""
+    return f"lish-{_LISH_LEGACY_GATEWAYS.get(region, region)}.linode.com"
+
+
+def instance_lish_access(
+    instance_id: int, label: str, region: str, username: str
+) -> dict[str, str]:
+    """How the given profile user reaches an instance's console through Lish
+    (mirrors Go instanceLishAccess)."""
+    gateway = _lish_gateway(region)
+    return {
+        "ssh_gateway": gateway,
+        "ssh_command": f"ssh -t {username}@{gateway} {label}",
+        "weblish_url": f"https://cloud.linode.com/linodes/{instance_id}/lish/weblish",
+        "glish_url": f"https://cloud.linode.com/linodes/{instance_id}/lish/glish",
+    }
+
+
+async def lish_username(client: RetryableClient) -> tuple[str, str]:
+    """The current profile's username for the Lish command, and a warning
+    when the profile cannot be read (mirrors Go lishUsername)."""
+    try:
+        profile = await client.get_raw("/profile")
+    except (APIError, NetworkError) as exc:
+        return (
+            "<username>",
+            "Profile unavailable, so the Lish command has a <username> "
+            f"placeholder: {exc}",
+        )
+    return str(profile.get("username") or ""), ""
+
+
+def _instance_console(
+    instance: dict[str, Any],
+    username: str,
+    events: list[dict[str, Any]],
+    limit: int,
+    warnings: list[str],
+) -> dict[str, Any]:
+    """Assemble the response from the instance, the profile username, and the
+    newest page of account events (mirrors Go instanceConsole)."""
+    instance_id = int(instance.get("id") or 0)
+    label = str(instance.get("label") or "")
+    region = str(instance.get("region") or "")
+    status = str(instance.get("status") or "")
+
+    last_boot: dict[str, Any] | None = None
+    recent: list[dict[str, Any]] = []
+    for event in events:
+        entity = event.get("entity") or {}
+        if entity.get("type") != "linode" or entity.get("id") != instance_id:
+            continue
+        if last_boot is None and event.get("action") in _BOOT_ACTIONS:
+            last_boot = event
+        if len(recent) < limit:
+            recent.append(event)
+
+    guidance = [
+        "The Linode API does not expose console or boot log output; read it "
+        "through Lish.",
+        "Run the SSH command to attach to the console; in the Lish shell, logview "
+        "shows the console output kept from recent boots.",
+    ]
+    if last_boot is not None and last_boot.get("status") == "failed":
+        guidance.append(
+            f"The last boot (event {last_boot.get('id')}) failed; check the boot "
+            "config with linode_instance_config_list and watch the next boot in "
+            "Lish."
+        )
+    if status == "offline":
+        guidance.append(
+            "The instance is offline; boot it with linode_instance_boot while "
+            "attached to Lish to watch the console."
+        )
+
+    response: dict[str, Any] = {
+        "message": (
+            f"Instance {instance_id} ({label}) is {status}; the API does not "
+            f"expose console output, so {len(recent)} recent events and Lish "
+            "access details are listed"
+        ),
+        "instance_id": instance_id,
+        "label": label,
+        "region": region,
+        "status": status,
+        "recent_events": recent,
+        "lish": instance_lish_access(instance_id, label, region, username),
+        "guidance": guidance,
+        "warnings": warnings,
+    }
+    if last_boot is not None:
+        response["last_boot"] = last_boot
+    return response
+
+
+async def handle_linode_instance_console(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_instance_console tool request."""
+    instance_id, error = required_int_id(arguments, "instance_id")
+    if instance_id is None:
+        return error_response(error)
+    limit = arguments.get("events", _EVENTS_DEFAULT)
+    if (
+        not isinstance(limit, int)
+        or isinstance(limit, bool)
+        or not 1 <= limit <= _EVENTS_MAX
+    ):
+        return error_response(f"events must be from 1 through {_EVENTS_MAX}")
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        instance = await client.get_raw(f"/linode/instances/{instance_id}")
+        warnings: list[str] = []
+        username, warning = await lish_username(client)
+        if warning:
+            warnings.append(warning)
+        events: list[dict[str, Any]] = []
+        try:
+            events = walk_page_items(
+                await client.get_raw(f"/account/events?page=1&page_size={_EVENT_SCAN}")
+            )
+        except (APIError, NetworkError) as exc:
+            warnings.append(f"Recent events unavailable: {exc}")
+        return serialize_api_response(
+            _instance_console(instance, username, events, limit, warnings),
+            instance_pb2.InstanceConsoleResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "read instance console", _call)
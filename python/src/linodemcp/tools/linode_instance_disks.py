@@ -22,6 +22,12 @@ from linodemcp.tools.helpers import (
     is_dry_run,
     walk_page_items,
 )
+from linodemcp.tools.network_helpers import (
+    HELPERS_AND_LIST,
+    helper_config_interfaces,
+    network_helpers_from_arguments,
+    validate_config_interface_layout,
+)
 from linodemcp.tools.proto_enum import enum_value_names, optional_enum_error
 from linodemcp.tools.proto_response import (
     serialize_api_response,
@@ -86,7 +92,8 @@ def _parse_config_helpers(raw: object) -> tuple[Any, str | None]:
 
 
 def _validate_config_interfaces(interfaces: Any) -> str | None:
-    """Validate each interface object's purpose; return an error or None."""
+    """Validate each interface object's purpose and the list's layout; return
+    an error or None."""
     purpose_values = enum_value_names(instance_pb2.ConfigInterfacePurpose.Value)
     for index, iface in enumerate(interfaces):
         if not _is_dict(iface):
@@ -95,7 +102,7 @@ def _validate_config_interfaces(interfaces: Any) -> str | None:
             return f"interfaces[{index}].purpose must be one of: " + ", ".join(
                 purpose_values
             )
-    return None
+    return validate_config_interface_layout(interfaces)
 
 
 def _parse_config_interfaces(raw: object) -> tuple[Any, str | None]:
@@ -123,13 +130,22 @@ def _parse_config_interfaces(raw: object) -> tuple[Any, str | None]:
 def _parse_config_json_options(
     arguments: dict[str, Any],
 ) -> tuple[Any, Any, str | None]:
-    """Parse the helpers and interfaces options; return (helpers, interfaces, error)."""
+    """Parse the helpers and interfaces options; return (helpers, interfaces, error).
+
+    public_internet / vpc_subnet are validated here too; they replace
+    interfaces, so passing both is an error.
+    """
     helpers, helpers_err = _parse_config_helpers(arguments.get("helpers"))
     if helpers_err is not None:
         return None, None, helpers_err
     interfaces, interfaces_err = _parse_config_interfaces(arguments.get("interfaces"))
     if interfaces_err is not None:
         return None, None, interfaces_err
+    network, network_err = network_helpers_from_arguments(arguments)
+    if network_err is not None:
+        return None, None, network_err
+    if network.set and interfaces is not None:
+        return None, None, HELPERS_AND_LIST
     return helpers, interfaces, None
 
 
@@ -462,6 +478,10 @@ async def _create_instance_config_live(
     async def _call(
         client: RetryableClient,
     ) -> dict[str, Any]:
+        interfaces = interfaces_payload
+        generated = await helper_config_interfaces(client, arguments)
+        if generated is not None:
+            interfaces = generated
         config = await client.create_instance_config(
             iid,
             label=label,
@@ -473,7 +493,7 @@ async def _create_instance_config_live(
             run_level=arguments.get("run_level"),
             virt_mode=arguments.get("virt_mode"),
             helpers=helpers_payload,
-            interfaces=interfaces_payload,
+            interfaces=interfaces,
         )
         return serialize_api_response(
             {
@@ -2,12 +2,14 @@
 
 from __future__ import annotations
 
+import asyncio
 from typing import TYPE_CHECKING, Any
 
 from mcp.types import TextContent, Tool
 
 from linodemcp.genpb.linode.mcp.v1 import instance_pb2
 from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
 from linodemcp.tools.helpers import error_response, execute_tool
 from linodemcp.tools.proto_response import serialize_api_response
 from linodemcp.tools.toolschemas import schema
@@ -15,6 +17,16 @@ from linodemcp.tools.toolschemas import schema
 if TYPE_CHECKING:
     from linodemcp.linode import RetryableClient
 
+# Related resources include can embed, in canonical echo order, mapped to the
+# instance sub-path each one is read from. Mirrors Go's instanceIncludeOrder.
+_INCLUDE_PATHS: dict[str, str] = {
+    "volumes": "volumes",
+    "firewalls": "firewalls",
+    "backups": "backups",
+    "configs": "configs",
+    "nodebalancer_memberships": "nodebalancers",
+}
+
 
 def create_linode_instance_get_tool() -> tuple[Tool, Capability]:
     """Create the linode_instance_get tool."""
@@ -25,13 +37,70 @@ def create_linode_instance_get_tool() -> tuple[Tool, Capability]:
     ), Capability.Read
 
 
+def _parse_includes(raw: Any) -> tuple[list[str], str]:
+    """Split the include argument into canonical-order related resources.
+
+    Blank entries are skipped and duplicates collapse. Returns ``(includes,
+    "")`` on success or ``([], message)`` naming the first unknown entry,
+    matching Go's parseInstanceIncludes. A non-string value reads as empty,
+    the same as Go's GetString default.
+    """
+    text = raw if isinstance(raw, str) else ""
+    requested: set[str] = set()
+    for part in text.split(","):
+        name = part.strip()
+        if not name:
+            continue
+        if name not in _INCLUDE_PATHS:
+            allowed = ", ".join(_INCLUDE_PATHS)
+            return [], (
+                f"include must be a comma-separated list of: {allowed} (got '{name}')"
+            )
+        requested.add(name)
+    return [name for name in _INCLUDE_PATHS if name in requested], ""
+
+
+async def _fetch_detail(
+    client: RetryableClient, instance_id: int, includes: list[str]
+) -> dict[str, Any]:
+    """Fetch the instance and each included resource concurrently.
+
+    Only the instance fetch is fatal; a failed related fetch leaves its
+    section empty and adds a warnings line, as Go does.
+    """
+    base = f"/linode/instances/{instance_id}"
+    results = await asyncio.gather(
+        client.get_raw(base),
+        *(client.get_raw(f"{base}/{_INCLUDE_PATHS[name]}") for name in includes),
+        return_exceptions=True,
+    )
+    instance = results[0]
+    if isinstance(instance, BaseException):
+        raise instance
+
+    detail: dict[str, Any] = {"instance": instance, "include": includes}
+    warnings: list[str] = []
+    for name, fetched in zip(includes, results[1:], strict=True):
+        if isinstance(fetched, BaseException):
+            warnings.append(f"{name}: {fetched}")
+            add_warning(f"{name}: {fetched}")
+            continue
+        if name == "backups":
+            detail["backups"] = fetched
+        elif isinstance(fetched, dict):
+            detail[name] = fetched.get("data", [])
+    detail["warnings"] = warnings
+    return serialize_api_response(detail, instance_pb2.InstanceGetDetailResponse())
+
+
 async def handle_linode_instance_get(
     arguments: dict[str, Any], cfg: Any
 ) -> list[TextContent]:
     """Handle linode_instance_get tool request.
 
     Args:
-        arguments: InstanceIDArgs - instance_id, environment (optional)
+        arguments: InstanceIDArgs - instance_id, environment (optional),
+            include (optional comma-separated related resources)
         cfg: Configuration object
     """
     instance_id_str = arguments.get("instance_id", "")
@@ -44,7 +113,13 @@ async def handle_linode_instance_get(
     except ValueError:
         return error_response("instance_id must be a valid integer")
 
+    includes, include_error = _parse_includes(arguments.get("include"))
+    if include_error:
+        return error_response(include_error)
+
     async def _call(client: RetryableClient) -> dict[str, Any]:
+        if includes:
+            return await _fetch_detail(client, instance_id, includes)
         raw = await client.get_raw(f"/linode/instances/{instance_id}")
         return serialize_api_response(raw, instance_pb2.Instance())
 
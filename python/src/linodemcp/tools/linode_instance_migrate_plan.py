@@ -0,0 +1,115 @@
+"""Migration plan for linode_instance_migrate with plan=true."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING, Any
+
+from linodemcp.genpb.linode.mcp.v1 import instance_pb2
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.proto_response import serialize_api_response
+
+if TYPE_CHECKING:
+    from linodemcp.linode import RetryableClient
+
+MIGRATION_TYPE_COLD = "cold"
+MIGRATION_TYPE_WARM = "warm"
+_DOWNTIME_TYPE_NONE = "none"
+_STATUS_OFFLINE = "offline"
+_STATUS_MIGRATING = "migrating"
+
+# The account notification types Linode raises for a migration it has queued
+# against an instance.
+_MIGRATION_NOTICE_TYPES = frozenset(
+    {"migration_scheduled", "migration_pending", "migration_imminent"}
+)
+
+
+async def _queued_migrations(
+    client: RetryableClient, linode_id: int
+) -> list[dict[str, Any]]:
+    """Read the migration notices for the instance. The read only adds queue
+    detail, so a failure is a warning and reported as no notices."""
+    try:
+        data = await client.get_raw("/account/notifications")
+    except Exception as exc:  # noqa: BLE001 - the plan stands without the queue
+        add_warning(
+            "queued migrations unknown: failed to list account notifications: %s",
+            exc,
+        )
+        return []
+    notices: list[dict[str, Any]] = []
+    for notification in data.get("data", []):
+        entity = notification.get("entity") or {}
+        if (
+            notification.get("type") not in _MIGRATION_NOTICE_TYPES
+            or entity.get("type") != "linode"
+            or entity.get("id") != linode_id
+        ):
+            continue
+        notice: dict[str, Any] = {
+            "type": notification.get("type", ""),
+            "message": notification.get("message", ""),
+        }
+        for key in ("when", "until"):
+            if notification.get(key) is not None:
+                notice[key] = notification[key]
+        notices.append(notice)
+    return notices
+
+
+def _downtime(status: str, migration_type: str) -> tuple[str, str]:
+    """Return the downtime type and description. An offline instance has
+    nothing running to keep up, so it reports no downtime whatever the type."""
+    if status == _STATUS_OFFLINE:
+        return _DOWNTIME_TYPE_NONE, (
+            "The instance is offline, so the migration takes nothing down; it "
+            "stays offline for the transfer."
+        )
+    if migration_type == MIGRATION_TYPE_WARM:
+        return MIGRATION_TYPE_WARM, (
+            "The instance keeps running during the disk transfer and reboots "
+            "once at the end."
+        )
+    return MIGRATION_TYPE_COLD, (
+        "The instance is shut down for the whole disk transfer and booted "
+        "again afterwards."
+    )
+
+
+async def build_instance_migrate_plan(
+    client: RetryableClient, linode_id: int, region: str, migration_type: str
+) -> dict[str, Any]:
+    """Read the instance, its disks, and any queued migration and report what
+    a migration would cost in downtime and transfer without starting one."""
+    migration_type = migration_type or MIGRATION_TYPE_COLD
+    instance = await client.get_raw(f"/linode/instances/{linode_id}")
+    disks = (await client.get_raw(f"/linode/instances/{linode_id}/disks")).get(
+        "data", []
+    )
+    notices = await _queued_migrations(client, linode_id)
+
+    status = str(instance.get("status", ""))
+    from_region = str(instance.get("region", ""))
+    disk_size = sum(int(disk.get("size", 0)) for disk in disks)
+    downtime_type, downtime = _downtime(status, migration_type)
+    destination = region or "a region Linode picks"
+    plan: dict[str, Any] = {
+        "message": (
+            f"Migration plan for instance {linode_id}: {migration_type} migration "
+            f"from {from_region} to {destination} transfers {disk_size} MB across "
+            f"{len(disks)} disks"
+        ),
+        "linode_id": linode_id,
+        "status": status,
+        "from_region": from_region,
+        "migration_type": migration_type,
+        "downtime_type": downtime_type,
+        "downtime": downtime,
+        "disk_count": len(disks),
+        "disk_size_mb": disk_size,
+        "migration_in_progress": status == _STATUS_MIGRATING,
+        "queued_migrations": notices,
+    }
+    if region:
+        plan["to_region"] = region
+    return serialize_api_response(plan, instance_pb2.InstanceMigratePlanResponse())
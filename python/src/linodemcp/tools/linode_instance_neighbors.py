@@ -0,0 +1,158 @@
+"""Linode instance neighbors tool: the account's instances on the same host.
+
+Mirrors ``go/internal/tools/linode_instance_neighbors.go``.
+"""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import instance_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import (
+    error_response,
+    execute_tool,
+    required_int_id,
+    walk_page_items,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_SHARED = "shared"
+_DEDICATED = "dedicated"
+_UNKNOWN = "unknown"
+
+# Type ID fragments of the Shared CPU plans, whose cores other tenants also
+# schedule on.
+_SHARED_PLAN_MARKERS = ("-standard-", "-nanode-")
+
+
+def create_linode_instance_neighbors_tool() -> tuple[Tool, Capability]:
+    """Create the linode_instance_neighbors tool."""
+    return Tool(
+        name="linode_instance_neighbors",
+        description=(
+            "Helps diagnose a noisy-neighbor suspicion: reports the instance's "
+            "host_uuid, whether its plan is Shared CPU (cores shared with other "
+            "tenants) or Dedicated CPU, and the other instances on this account "
+            "that run on the same physical host. The API only reports hosts for "
+            "the account's own instances, so other customers on the host are "
+            "never listed."
+        ),
+        inputSchema=schema("linode.mcp.v1.InstanceNeighborsInput"),
+    ), Capability.Read
+
+
+def _plan_class(plan_type: str) -> str:
+    """Whether a plan type shares its CPU cores with other tenants."""
+    if not plan_type:
+        return _UNKNOWN
+    if any(marker in plan_type + "-" for marker in _SHARED_PLAN_MARKERS):
+        return _SHARED
+    return _DEDICATED
+
+
+def _instance_neighbors(
+    instance: dict[str, Any], others: list[dict[str, Any]], warnings: list[str]
+) -> dict[str, Any]:
+    """Build the report; mirrors Go's instanceNeighbors."""
+    instance_id = int(instance.get("id") or 0)
+    label = str(instance.get("label") or "")
+    host = str(instance.get("host_uuid") or "")
+    plan_type = str(instance.get("type") or "")
+    neighbors = [
+        {
+            "id": int(other.get("id") or 0),
+            "label": str(other.get("label") or ""),
+            "type": str(other.get("type") or ""),
+            "plan_class": _plan_class(str(other.get("type") or "")),
+            "status": str(other.get("status") or ""),
+        }
+        for other in others
+        if host
+        and int(other.get("id") or 0) != instance_id
+        and other.get("host_uuid") == host
+    ]
+
+    prefix = f"Instance {instance_id} ({label})"
+    if not host:
+        message = (
+            f"{prefix} has no host_uuid in the API response, so its host cannot "
+            "be compared"
+        )
+    else:
+        message = (
+            f"{prefix} runs on host {host} with {len(neighbors)} other "
+            "instance(s) from this account"
+        )
+
+    plan_class = _plan_class(plan_type)
+    guidance: list[str] = []
+    if plan_class == _SHARED:
+        guidance.append(
+            "This is a Shared CPU plan: other customers' instances, which the "
+            "API does not list, use the same cores. Sustained CPU steal in "
+            "linode_instance_stats_get is the sign of a noisy neighbor; resizing "
+            "to a Dedicated CPU plan removes it."
+        )
+    elif plan_class == _DEDICATED:
+        guidance.append(
+            "This plan reserves its CPU cores, so another tenant cannot take CPU "
+            "time from it; look at disk and network contention in "
+            "linode_instance_stats_get instead."
+        )
+    if neighbors:
+        guidance.append(
+            "The listed instances share this host's hardware; an anti-affinity "
+            "placement group (linode_placement_group_create) spreads instances "
+            "that should not fail or contend together across hosts."
+        )
+
+    return {
+        "message": message,
+        "instance_id": instance_id,
+        "label": label,
+        "type": plan_type,
+        "plan_class": plan_class,
+        "host_uuid": host,
+        "neighbors": neighbors,
+        "guidance": guidance,
+        "warnings": warnings,
+    }
+
+
+async def handle_linode_instance_neighbors(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_instance_neighbors tool request."""
+    instance_id, error = required_int_id(arguments, "instance_id")
+    if instance_id is None:
+        return error_response(error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        instance = await client.get_raw(f"/linode/instances/{instance_id}")
+        warnings: list[str] = []
+        others: list[dict[str, Any]] = []
+        if instance.get("host_uuid"):
+            try:
+                others = walk_page_items(await client.get_raw("/linode/instances"))
+            except (APIError, NetworkError) as exc:
+                warnings.append(
+                    f"Instances unavailable, so no neighbors are listed: {exc}"
+                )
+        for warning in warnings:
+            add_warning(warning)
+        return serialize_api_response(
+            _instance_neighbors(instance, others, warnings),
+            instance_pb2.InstanceNeighborsResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "read instance neighbors", _call)
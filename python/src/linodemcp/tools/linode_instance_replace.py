@@ -0,0 +1,537 @@
+"""linode_instance_replace: swap an instance for a fresh one of the same spec.
+
+The replacement runbook as one call: create the new instance, wait until it
+is running, attach the old instance's firewalls, repoint the DNS records
+that target its public addresses, and, with force=true, delete the old
+instance.
+
+Mirrors ``go/internal/tools/linode_instance_replace.go``.
+"""
+
+from __future__ import annotations
+
+import asyncio
+import ipaddress
+import time
+from typing import TYPE_CHECKING, Any, cast
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import instance_replace_pb2
+from linodemcp.linode import (
+    APIError,
+    NetworkError,
+    instance_preview_state,
+    validate_root_password,
+)
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+from linodemcp.tools.twostage_destroy import require_confirm_label
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import Instance, RetryableClient
+
+# Pacing for the wait_running step. A new instance boots from its image within
+# a minute or two; past the timeout the step fails and the new instance is
+# kept.
+_WAIT_INTERVAL_SECONDS = 10.0
+_WAIT_TIMEOUT_SECONDS = 600.0
+_STATUS_RUNNING = "running"
+
+# Plan step actions, in the order a plan runs them.
+_ACTION_CREATE = "create_instance"
+_ACTION_WAIT = "wait_running"
+_ACTION_FIREWALL = "attach_firewall"
+_ACTION_DNS_RECORD = "update_dns_record"
+_ACTION_DELETE = "delete_instance"
+
+# Resource types a step acts on.
+_TYPE_INSTANCE = "instance"
+_TYPE_FIREWALL = "firewall"
+_TYPE_DOMAIN_RECORD = "domain_record"
+
+# Step statuses in a run report.
+_STATUS_PLANNED = "planned"
+_STATUS_DONE = "done"
+_STATUS_FAILED = "failed"
+_STATUS_NOT_RUN = "not_run"
+_STATUS_SKIPPED = "skipped"
+
+
+def create_linode_instance_replace_tool() -> tuple[Tool, Capability]:
+    """Create the linode_instance_replace tool."""
+    return Tool(
+        name="linode_instance_replace",
+        description=(
+            "Replaces an instance with a fresh one: creates a new instance labeled "
+            "label with the old one's type, region, tags, backup setting, and "
+            "image (unless image is passed), behind its first firewall (unless "
+            "firewall_id is passed), waits until it is running, attaches the old "
+            "instance's other firewalls, repoints the A and AAAA records that "
+            "target the old instance's public addresses, and deletes the old "
+            "instance. The old instance is deleted only with force=true; without "
+            "it the old instance is kept, still behind its firewalls. Runs one "
+            "step at a time, stops at the first failure, and reports every step. "
+            "WARNING: the new instance is billed from creation. Pass dry_run=true "
+            "to see the plan first."
+        ),
+        inputSchema=schema("linode.mcp.v1.InstanceReplaceInput"),
+    ), Capability.Destroy
+
+
+def _int_arg(value: Any) -> int | None:
+    """A JSON number as an int, truncated as Go's GetInt does, else None."""
+    if isinstance(value, bool) or not isinstance(value, (int, float)):
+        return None
+    return int(value)
+
+
+def _replace_args(arguments: dict[str, Any]) -> tuple[dict[str, Any], str]:
+    """Validate the input in the same order as Go instanceReplaceArgsFromTool;
+    the second value is a validation message."""
+    linode_id = _int_arg(arguments.get("linode_id"))
+    if linode_id is None or linode_id <= 0:
+        return {}, "linode_id is required"
+
+    label = str(arguments.get("label") or "").strip()
+    if not label:
+        return {}, "label is required"
+
+    firewall_id = 0
+    if "firewall_id" in arguments:
+        firewall_id = _int_arg(arguments["firewall_id"]) or 0
+        if firewall_id <= 0:
+            return {}, "firewall_id must be a positive integer"
+
+    root_pass = str(arguments.get("root_pass") or "")
+    try:
+        validate_root_password(root_pass or None)
+    except ValueError as exc:
+        return {}, str(exc)
+
+    authorized_keys: list[str] | None = None
+    if "authorized_keys" in arguments:
+        raw = arguments["authorized_keys"]
+        if not isinstance(raw, list) or not all(
+            isinstance(item, str) for item in cast("list[Any]", raw)
+        ):
+            return {}, "authorized_keys must be an array of strings"
+        authorized_keys = [str(item) for item in cast("list[Any]", raw)]
+
+    return {
+        "linode_id": linode_id,
+        "label": label,
+        "image": str(arguments.get("image") or "").strip(),
+        "root_pass": root_pass or None,
+        "authorized_keys": authorized_keys,
+        "firewall_id": firewall_id,
+        "force": arguments.get("force") is True,
+    }, ""
+
+
+def _public_ipv4(address: str) -> bool:
+    """Whether address is a public IPv4 address, the kind an A record points
+    at."""
+    try:
+        parsed = ipaddress.ip_address(address)
+    except ValueError:
+        return False
+    return parsed.version == 4 and not parsed.is_private
+
+
+def _old_addresses(instance: Instance) -> dict[str, str]:
+    """The old instance's public IPv4 addresses and SLAAC IPv6 address, mapped
+    to the record type that points at each."""
+    addresses = {address: "A" for address in instance.ipv4 if _public_ipv4(address)}
+    ipv6 = (instance.ipv6 or "").split("/", 1)[0]
+    if ipv6:
+        addresses[ipv6] = "AAAA"
+    return addresses
+
+
+def _new_target(instance: dict[str, Any], record_type: str) -> str:
+    """The new instance's address for a record: its first public IPv4 address
+    for A, its SLAAC IPv6 address for AAAA. A new instance has one public IPv4
+    address, which is why planning refuses an old instance whose A records
+    point at more than one."""
+    if record_type == "AAAA":
+        return str(instance.get("ipv6") or "").split("/", 1)[0]
+    for address in instance.get("ipv4") or []:
+        if isinstance(address, str) and _public_ipv4(address):
+            return address
+    return ""
+
+
+async def _dns_steps(client: RetryableClient, old: Instance) -> list[dict[str, Any]]:
+    """Plan repointing the A and AAAA records, in every domain, that target a
+    public address of the old instance. A records that point at more than one
+    of its IPv4 addresses are refused: the new instance has only one, so moving
+    them would collapse them onto a single address."""
+    addresses = _old_addresses(old)
+    if not addresses:
+        return []
+    steps: list[dict[str, Any]] = []
+    targets_ipv4: list[str] = []
+    for domain in await client.list_domains():
+        for record in await client.list_domain_records(domain.id):
+            record_type = addresses.get(record.target)
+            if record_type is None or record.type != record_type:
+                continue
+            label = f"{record.name}.{domain.domain}" if record.name else domain.domain
+            if record_type == "A" and record.target not in targets_ipv4:
+                targets_ipv4.append(record.target)
+            steps.append(
+                {
+                    "action": _ACTION_DNS_RECORD,
+                    "type": _TYPE_DOMAIN_RECORD,
+                    "id": record.id,
+                    "label": label,
+                    "reason": (
+                        f"{record_type} record points at {record.target} on "
+                        f"instance {old.id} ({old.label})"
+                    ),
+                    "domain_id": domain.id,
+                    "record_type": record_type,
+                }
+            )
+    if len(targets_ipv4) > 1:
+        msg = (
+            f"instance {old.id} ({old.label}): A records point at more than one "
+            "of its IPv4 addresses, and the new instance gets one; move them by "
+            f"hand: {', '.join(targets_ipv4)}"
+        )
+        raise ValueError(msg)
+    return steps
+
+
+async def _plan_replace(
+    client: RetryableClient, args: dict[str, Any]
+) -> dict[str, Any]:
+    """Read the old instance, its firewalls, and the DNS records that point at
+    it, and order the steps that replace it (mirrors Go planInstanceReplace).
+    The tags and backup setting the new instance copies travel under keys
+    starting with "_", which the preview drops."""
+    old = await client.get_instance(args["linode_id"])
+    image = args["image"] or old.image or ""
+    if not image:
+        msg = f"instance {old.id} ({old.label}): no image to rebuild from; pass image"
+        raise ValueError(msg)
+
+    firewalls = (
+        await client.get_raw(f"/linode/instances/{args['linode_id']}/firewalls")
+    ).get("data", [])
+    firewall_id = args["firewall_id"]
+    if not firewall_id and firewalls:
+        firewall_id = int(firewalls[0].get("id") or 0)
+    if not firewall_id:
+        msg = (
+            f"instance {old.id} ({old.label}): no firewall to carry over; "
+            "pass firewall_id"
+        )
+        raise ValueError(msg)
+
+    steps: list[dict[str, Any]] = [
+        {
+            "action": _ACTION_CREATE,
+            "type": _TYPE_INSTANCE,
+            "id": 0,
+            "label": args["label"],
+            "reason": (
+                f"{old.type} in {old.region} from {image} behind firewall "
+                f"{firewall_id}, copying instance {old.id} ({old.label})"
+            ),
+        },
+        {
+            "action": _ACTION_WAIT,
+            "type": _TYPE_INSTANCE,
+            "id": 0,
+            "label": args["label"],
+            "reason": "DNS records and firewalls move only to a running instance",
+        },
+    ]
+    steps += [
+        {
+            "action": _ACTION_FIREWALL,
+            "type": _TYPE_FIREWALL,
+            "id": int(firewall.get("id") or 0),
+            "label": str(firewall.get("label") or ""),
+            "reason": f"protects instance {old.id} ({old.label})",
+        }
+        for firewall in firewalls
+        if int(firewall.get("id") or 0) != firewall_id
+    ]
+    steps += await _dns_steps(client, old)
+    steps.append(
+        {
+            "action": _ACTION_DELETE,
+            "type": _TYPE_INSTANCE,
+            "id": old.id,
+            "label": old.label,
+            "reason": f"replaced by {args['label']}",
+        }
+    )
+    return {
+        "linode_id": old.id,
+        "label": old.label,
+        "new_label": args["label"],
+        "region": old.region,
+        "type": old.type,
+        "image": image,
+        "firewall_id": firewall_id,
+        "steps": [{"order": i, **step} for i, step in enumerate(steps, start=1)],
+        "_tags": list(old.tags),
+        "_backups_enabled": bool(old.backups.enabled),
+    }
+
+
+def _step_summary(step: dict[str, Any]) -> str:
+    if not step["id"]:
+        return f"{step['action']} {step['type']} ({step['label']})"
+    return f"{step['action']} {step['type']} {step['id']} ({step['label']})"
+
+
+def _preview(plan: dict[str, Any]) -> dict[str, Any]:
+    """The plan as current_state shows it, without the copied settings."""
+    return {key: value for key, value in plan.items() if not key.startswith("_")}
+
+
+def _side_effects(plan: dict[str, Any], args: dict[str, Any]) -> DryRunDetails:
+    """One line per step in run order, then a summary line (mirrors Go
+    instanceReplaceSideEffects)."""
+    side_effects: list[str] = []
+    for step in plan["steps"]:
+        line = f"{step['order']}. {_step_summary(step)}: {step['reason']}."
+        if step["action"] == _ACTION_DELETE and not args["force"]:
+            line += " Skipped without force=true."
+        side_effects.append(line)
+    side_effects.append(
+        f"Instance {plan['linode_id']} ({plan['label']}) would be replaced by "
+        f"{plan['new_label']} in {len(plan['steps'])} steps."
+    )
+    warnings = [
+        "The new instance is billed from creation; both instances are billed "
+        "until the old one is deleted."
+    ]
+    if not args["force"]:
+        warnings.append(
+            "Without force=true the old instance is kept; delete it with "
+            "linode_instance_delete once the new one checks out."
+        )
+    return {"side_effects": side_effects, "warnings": warnings}
+
+
+async def _await_running(
+    client: RetryableClient, instance: dict[str, Any]
+) -> tuple[dict[str, Any], bool]:
+    """Re-read the new instance until it is running or the timeout passes. The
+    first re-read is immediate and later ones an interval apart. Returns the
+    last state read and whether the instance is running; a failed re-read
+    propagates."""
+    deadline = time.monotonic() + _WAIT_TIMEOUT_SECONDS
+    first = True
+    while True:
+        if not first:
+            if time.monotonic() + _WAIT_INTERVAL_SECONDS > deadline:
+                return instance, False
+            await asyncio.sleep(_WAIT_INTERVAL_SECONDS)
+        first = False
+        instance = await client.get_raw(
+            f"/linode/instances/{int(instance.get('id') or 0)}"
+        )
+        if instance.get("status") == _STATUS_RUNNING:
+            return instance, True
+
+
+async def _run_step(
+    client: RetryableClient,
+    plan: dict[str, Any],
+    args: dict[str, Any],
+    report: dict[str, Any],
+    entry: dict[str, Any],
+) -> None:
+    """Make the API calls for one step. The create and wait steps record the
+    new instance on report; later steps act on it."""
+    action = entry["action"]
+    if action == _ACTION_CREATE:
+        report["instance"] = await client.create_instance_raw(
+            region=plan["region"],
+            instance_type=plan["type"],
+            firewall_id=plan["firewall_id"],
+            image=plan["image"],
+            label=plan["new_label"],
+            root_pass=args["root_pass"],
+            authorized_keys=args["authorized_keys"],
+            backups_enabled=plan["_backups_enabled"],
+            tags=plan["_tags"] or None,
+        )
+        entry["id"] = int(report["instance"].get("id") or 0)
+    elif action == _ACTION_WAIT:
+        entry["id"] = int(report["instance"].get("id") or 0)
+        report["instance"], running = await _await_running(client, report["instance"])
+        if not running:
+            msg = (
+                "the new instance was not running after "
+                f"{int(_WAIT_TIMEOUT_SECONDS // 60)} minutes; last status "
+                f"{report['instance'].get('status', '')}"
+            )
+            raise ValueError(msg)
+    elif action == _ACTION_FIREWALL:
+        await client.create_firewall_device(
+            entry["id"], int(report["instance"].get("id") or 0), "linode"
+        )
+    elif action == _ACTION_DNS_RECORD:
+        target = _new_target(report["instance"], entry["record_type"])
+        if not target:
+            msg = "the new instance has no public address for the record"
+            raise ValueError(msg)
+        await client.update_domain_record(
+            entry["domain_id"], entry["id"], target=target
+        )
+        entry["target"] = target
+    elif action == _ACTION_DELETE:
+        await client.delete_instance(plan["linode_id"])
+    else:
+        msg = f"unknown step action: {action}"
+        raise ValueError(msg)
+
+
+def _message(
+    plan: dict[str, Any], report: dict[str, Any], failed_step: dict[str, Any] | None
+) -> str:
+    created = report.get("instance")
+    if failed_step is not None and created is None:
+        return (
+            f"Instance replace failed at step {failed_step['order']} of "
+            f"{len(plan['steps'])} ({failed_step['action']}); nothing was changed"
+        )
+    created = created or {}
+    new = f"{created.get('id')} ({created.get('label', '')})"
+    if failed_step is not None:
+        return (
+            f"Instance replace stopped at step {failed_step['order']} of "
+            f"{len(plan['steps'])} ({failed_step['action']}): "
+            f"{report['completed']} steps done, {report['not_run']} not run; "
+            f"new instance {new} is kept"
+        )
+    if report["skipped"]:
+        return (
+            f"Moved instance {plan['linode_id']} ({plan['label']}) to new instance "
+            f"{new} in {report['completed']} steps; the old instance is kept "
+            "without force=true, delete it with linode_instance_delete once the "
+            "new one checks out"
+        )
+    return (
+        f"Replaced instance {plan['linode_id']} ({plan['label']}) with {new} in "
+        f"{report['completed']} steps"
+    )
+
+
+async def _run_replace(
+    client: RetryableClient, plan: dict[str, Any], args: dict[str, Any]
+) -> dict[str, Any]:
+    """Execute the plan one step at a time; the first failure stops the run
+    and the later steps are not_run, and without force the delete step is
+    skipped (mirrors Go runInstanceReplace)."""
+    report: dict[str, Any] = {
+        "linode_id": plan["linode_id"],
+        "completed": 0,
+        "failed": 0,
+        "skipped": 0,
+        "not_run": 0,
+        "steps": [],
+    }
+    failed_step: dict[str, Any] | None = None
+    for step in plan["steps"]:
+        entry = {**step, "status": _STATUS_PLANNED}
+        report["steps"].append(entry)
+        if failed_step is not None:
+            entry["status"] = _STATUS_NOT_RUN
+            report["not_run"] += 1
+            continue
+        if step["action"] == _ACTION_DELETE and not args["force"]:
+            entry["status"] = _STATUS_SKIPPED
+            report["skipped"] += 1
+            continue
+        try:
+            await _run_step(client, plan, args, report, entry)
+        except (APIError, NetworkError, ValueError) as exc:
+            entry["status"] = _STATUS_FAILED
+            entry["error"] = str(exc)
+            report["failed"] += 1
+            failed_step = entry
+            add_warning("step %d (%s): %s", entry["order"], _step_summary(entry), exc)
+            continue
+        entry["status"] = _STATUS_DONE
+        report["completed"] += 1
+
+    report["message"] = _message(plan, report, failed_step)
+    return serialize_api_response(
+        report, instance_replace_pb2.InstanceReplaceResponse()
+    )
+
+
+async def handle_linode_instance_replace(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_instance_replace tool request."""
+    args, error = _replace_args(arguments)
+    if error:
+        return error_response(error)
+
+    if is_dry_run(arguments):
+
+        async def _fetch(client: RetryableClient) -> Any:
+            return _preview(await _plan_replace(client, args))
+
+        async def _walk(_client: RetryableClient, state: Any) -> DryRunDetails:
+            return _side_effects(state, args)
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_instance_replace",
+            "POST",
+            "/linode/instances",
+            _fetch,
+            _walk,
+        )
+
+    if not arguments.get("confirm"):
+        return error_response(
+            "This creates a replacement instance and moves DNS records and "
+            "firewalls to it; with force=true it also deletes the old instance. "
+            "Set confirm=true to proceed."
+        )
+
+    if args["force"]:
+        # With force=true the run deletes the old instance, so it gets the
+        # same label echo linode_instance_delete asks for, before anything is
+        # created.
+        async def _fetch_state(client: RetryableClient) -> Any:
+            return instance_preview_state(await client.get_instance(args["linode_id"]))
+
+        label_error = await require_confirm_label(
+            cfg,
+            arguments,
+            tool_name="linode_instance_replace",
+            path=f"/linode/instances/{args['linode_id']}",
+            fetch_state=_fetch_state,
+        )
+        if label_error is not None:
+            return label_error
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        plan = await _plan_replace(client, args)
+        return await _run_replace(client, plan, args)
+
+    return await execute_tool(cfg, arguments, "plan instance replace", _call)
@@ -0,0 +1,127 @@
+"""Linode instance SSH fingerprints tool: known_hosts and verification commands."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import instance_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import error_response, execute_tool, required_int_id
+from linodemcp.tools.linode_dns_point_at_instance import instance_public_addresses
+from linodemcp.tools.linode_instance_console import (
+    instance_lish_access,
+    lish_username,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import Instance, RetryableClient
+
+# Prints the fingerprint of every host key on the instance; it is run in the
+# Lish console.
+_VERIFY_COMMAND = (
+    'for key in /etc/ssh/ssh_host_*_key.pub; do ssh-keygen -lf "$key"; done'
+)
+
+
+def create_linode_instance_ssh_fingerprints_tool() -> tuple[Tool, Capability]:
+    """Create the linode_instance_ssh_fingerprints tool."""
+    return Tool(
+        name="linode_instance_ssh_fingerprints",
+        description=(
+            "Prepares the first SSH connection to a new instance. The Linode API "
+            "does not expose SSH host keys, so this returns the instance's public "
+            "addresses, a ready-to-run block that clears stale known_hosts "
+            "entries and records the host keys with ssh-keyscan, and the command "
+            "to run in the Lish console (with the Lish SSH command for the "
+            "current profile) that prints the real fingerprints to compare "
+            "against."
+        ),
+        inputSchema=schema("linode.mcp.v1.InstanceSshFingerprintsInput"),
+    ), Capability.Read
+
+
+def _ssh_fingerprints(
+    instance: Instance, username: str, warnings: list[str]
+) -> dict[str, Any]:
+    """Assemble the known_hosts and verification commands for the instance's
+    public addresses (mirrors Go instanceSSHFingerprints)."""
+    addresses = [address for address in instance_public_addresses(instance) if address]
+    response: dict[str, Any] = {
+        "instance_id": instance.id,
+        "label": instance.label,
+        "status": instance.status,
+        "addresses": addresses,
+        "known_hosts_commands": [],
+        "verify_commands": [_VERIFY_COMMAND],
+        "lish": instance_lish_access(
+            instance.id, instance.label, instance.region, username
+        ),
+        "guidance": [
+            "The Linode API does not expose SSH host keys. Run the verify command "
+            "in the Lish console and check that the fingerprints printed by the "
+            "known_hosts commands match before trusting the scanned keys."
+        ],
+        "warnings": warnings,
+    }
+
+    if not addresses:
+        warnings.append(
+            "The instance has no public address to scan; connect through Lish "
+            "instead."
+        )
+        response["message"] = (
+            f"Instance {instance.id} ({instance.label}) has no public address; "
+            "the API does not expose SSH host keys, so Lish verification details "
+            "are listed"
+        )
+        return response
+
+    response["known_hosts_commands"] = [
+        *(f"ssh-keygen -R {address}" for address in addresses),
+        f"ssh-keyscan -t ed25519,ecdsa,rsa {' '.join(addresses)} "
+        ">> ~/.ssh/known_hosts",
+        *(f"ssh-keygen -l -F {address}" for address in addresses),
+    ]
+    response["guidance"] += [
+        "Linode reuses addresses, so ssh-keygen -R first removes any host key a "
+        "previous instance left in known_hosts.",
+        f"Once the fingerprints match, connect with ssh root@{addresses[0]}.",
+    ]
+    if instance.status != "running":
+        response["guidance"].append(
+            f"The instance is {instance.status}; ssh-keyscan gets no answer until "
+            "it is running and sshd has started."
+        )
+    response["message"] = (
+        f"Instance {instance.id} ({instance.label}) has {len(addresses)} public "
+        "addresses; the API does not expose SSH host keys, so ssh-keyscan and "
+        "Lish verification commands are listed"
+    )
+    return response
+
+
+async def handle_linode_instance_ssh_fingerprints(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_instance_ssh_fingerprints tool request."""
+    instance_id, error = required_int_id(arguments, "instance_id")
+    if instance_id is None:
+        return error_response(error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        instance = await client.get_instance(instance_id)
+        warnings: list[str] = []
+        username, warning = await lish_username(client)
+        if warning:
+            warnings.append(warning)
+        return serialize_api_response(
+            _ssh_fingerprints(instance, username, warnings),
+            instance_pb2.InstanceSshFingerprintsResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "read instance SSH fingerprints", _call)
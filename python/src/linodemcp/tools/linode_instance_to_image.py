@@ -0,0 +1,286 @@
+"""linode_instance_to_image: capture an instance as a private image.
+
+The call picks the instance's largest disk that is not swap (or disk_id),
+creates the image, and unless wait=false re-reads it until it is available,
+recording each status change with the seconds elapsed. With regions, the
+available image is then replicated. A wait or replication that fails leaves
+the image standing and is reported in warnings.
+
+Mirrors ``go/internal/tools/linode_instance_to_image.go``.
+"""
+
+from __future__ import annotations
+
+import asyncio
+import time
+from typing import TYPE_CHECKING, Any, cast
+from urllib.parse import quote
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import instance_to_image_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+    required_int_id,
+)
+from linodemcp.tools.linode_images import _image_create_tags, _image_regions_payload
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+# Pacing for the capture wait. Linode captures a disk in a few minutes per few
+# gigabytes; past the timeout the call returns what it last saw with
+# ready=false and the capture carries on.
+_WAIT_INTERVAL_SECONDS = 10.0
+_WAIT_TIMEOUT_SECONDS = 1800.0
+_STATUS_AVAILABLE = "available"
+_DISK_SWAP = "swap"
+
+_CONFIRM_MESSAGE = (
+    "This captures an image from the instance's disk. Set confirm=true to proceed."
+)
+
+
+def create_linode_instance_to_image_tool() -> tuple[Tool, Capability]:
+    """Create the linode_instance_to_image tool."""
+    return Tool(
+        name="linode_instance_to_image",
+        description=(
+            "Captures a Linode instance as a private image in one call: picks the "
+            "instance's largest disk that is not swap (or disk_id), creates the "
+            "image, and unless wait=false waits for it to become available, "
+            "recording each status change with the seconds elapsed in progress. "
+            "With regions, replicates the available image to those regions. "
+            "Requires confirm=true; pass dry_run=true to see which disk would be "
+            "captured."
+        ),
+        inputSchema=schema("linode.mcp.v1.InstanceToImageInput"),
+    ), Capability.Write
+
+
+def _pick_disk(
+    disks: list[dict[str, Any]], linode_id: int, disk_id: int
+) -> tuple[dict[str, Any] | None, str]:
+    """The disk to capture: disk_id when given, which must belong to the
+    instance, or else the largest disk that is not swap."""
+    if disk_id:
+        for disk in disks:
+            if int(disk.get("id") or 0) == disk_id:
+                return disk, ""
+        return None, f"disk {disk_id} is not a disk of instance {linode_id}"
+    picked: dict[str, Any] | None = None
+    for disk in disks:
+        if disk.get("filesystem") == _DISK_SWAP:
+            continue
+        if picked is None or int(disk.get("size") or 0) > int(picked.get("size") or 0):
+            picked = disk
+    if picked is None:
+        return None, f"instance {linode_id} has no disk to capture"
+    return picked, ""
+
+
+def _progress(start: float, status: str, detail: str) -> dict[str, Any]:
+    return {
+        "elapsed_seconds": int(time.monotonic() - start),
+        "status": status,
+        "detail": detail,
+    }
+
+
+async def _wait(
+    client: RetryableClient,
+    response: dict[str, Any],
+    start: float,
+    regions: list[str],
+) -> None:
+    """Re-read the new image until it is available or the timeout passes,
+    recording each status change, then start replication to regions. The first
+    re-read is immediate and later ones an interval apart."""
+    image: dict[str, Any] = response["image"]
+    image_id = str(image.get("id") or "")
+    ready = image.get("status") == _STATUS_AVAILABLE
+    deadline = time.monotonic() + _WAIT_TIMEOUT_SECONDS
+    first = True
+    while not ready:
+        if not first:
+            if time.monotonic() + _WAIT_INTERVAL_SECONDS > deadline:
+                response["warnings"].append(
+                    f"wait: image {image_id} was not available after "
+                    f"{int(_WAIT_TIMEOUT_SECONDS // 60)}m0s"
+                )
+                break
+            await asyncio.sleep(_WAIT_INTERVAL_SECONDS)
+        first = False
+        try:
+            latest = await client.get_raw(f"/images/{quote(image_id, safe='')}")
+        except (APIError, NetworkError) as exc:
+            response["warnings"].append(
+                f"wait: failed to re-read image {image_id}: {exc}"
+            )
+            break
+        if latest.get("status") != image.get("status"):
+            status = str(latest.get("status") or "")
+            response["progress"].append(
+                _progress(start, status, f"image {image_id} is {status}")
+            )
+        image = latest
+        ready = image.get("status") == _STATUS_AVAILABLE
+    response["image"] = image
+    response["ready"] = ready
+    if ready:
+        response["message"] += "; it is available"
+    if regions:
+        await _replicate(client, response, start, regions)
+
+
+async def _replicate(
+    client: RetryableClient,
+    response: dict[str, Any],
+    start: float,
+    regions: list[str],
+) -> None:
+    """Start replication of an available image to regions; the copies finish
+    in the background."""
+    image_id = str(response["image"].get("id") or "")
+    if not response["ready"]:
+        response["warnings"].append(
+            f"replicate: skipped because image {image_id} is not available; run "
+            "linode_image_replicate once it is"
+        )
+        return
+    try:
+        await client.replicate_image(image_id, regions)
+    except (APIError, NetworkError) as exc:
+        response["warnings"].append(
+            f"replicate: failed to replicate image {image_id}: {exc}"
+        )
+        return
+    joined = ", ".join(regions)
+    response["replicated_regions"] = regions
+    response["message"] += f" and replicating to {joined}"
+    response["progress"].append(
+        _progress(start, "replicating", f"image {image_id} replicating to {joined}")
+    )
+
+
+async def handle_linode_instance_to_image(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_instance_to_image tool request."""
+    linode_id, msg = required_int_id(arguments, "linode_id")
+    if linode_id is None:
+        return error_response(msg)
+    wait = arguments.get("wait") is not False
+    disk_id = 0
+    if "disk_id" in arguments:
+        checked, msg = required_int_id(arguments, "disk_id")
+        if checked is None:
+            return error_response(msg)
+        disk_id = checked
+    regions: list[str] = []
+    if "regions" in arguments:
+        checked_regions, regions_err = _image_regions_payload(arguments["regions"])
+        if regions_err is not None:
+            return error_response(regions_err)
+        regions = cast("list[str]", checked_regions)
+        if not wait:
+            return error_response(
+                "regions needs wait=true: an image replicates only once it is "
+                "available"
+            )
+    tags, tags_err = _image_create_tags(arguments.get("tags"))
+    if tags_err is not None:
+        return error_response(tags_err)
+
+    if is_dry_run(arguments):
+
+        async def _fetch(client: RetryableClient) -> list[dict[str, Any]]:
+            return await client.list_instance_disks(linode_id)
+
+        async def _details(
+            _client: RetryableClient, disks: list[dict[str, Any]]
+        ) -> DryRunDetails:
+            disk, problem = _pick_disk(disks, linode_id, disk_id)
+            if disk is None:
+                return {"warnings": [f"{problem}."]}
+            effects = [
+                f"A new image will be captured from disk {disk.get('id')} "
+                f"({disk.get('label') or ''}) of instance {linode_id}."
+            ]
+            if regions:
+                effects.append(
+                    "Once available, the image will be replicated to "
+                    f"{', '.join(regions)}."
+                )
+            return {"side_effects": effects}
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_instance_to_image",
+            "POST",
+            "/images",
+            _fetch,
+            _details,
+        )
+
+    if arguments.get("confirm") is not True:
+        return error_response(_CONFIRM_MESSAGE)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        disks = await client.list_instance_disks(linode_id)
+        disk, problem = _pick_disk(disks, linode_id, disk_id)
+        if disk is None:
+            raise ValueError(problem)
+        start = time.monotonic()
+        image = await client.create_image_raw(
+            disk_id=int(disk.get("id") or 0),
+            label=arguments.get("label"),
+            description=arguments.get("description"),
+            cloud_init=arguments.get("cloud_init"),
+            tags=tags,
+        )
+        image_id = str(image.get("id") or "")
+        disk_label = str(disk.get("label") or "")
+        response: dict[str, Any] = {
+            "message": (
+                f"Image '{image.get('label') or ''}' ({image_id}) is being "
+                f"captured from disk {disk.get('id')} ({disk_label}) of instance "
+                f"{linode_id}"
+            ),
+            "linode_id": linode_id,
+            "disk_id": int(disk.get("id") or 0),
+            "disk_label": disk_label,
+            "image": image,
+            "replicated_regions": [],
+            "progress": [
+                {
+                    "elapsed_seconds": 0,
+                    "status": str(image.get("status") or ""),
+                    "detail": (
+                        f"image {image_id} created from disk {disk.get('id')} "
+                        f"({disk_label})"
+                    ),
+                }
+            ],
+            "warnings": [],
+        }
+        if wait:
+            await _wait(client, response, start, regions)
+        for warning in response["warnings"]:
+            add_warning(warning)
+        return serialize_api_response(
+            response, instance_to_image_pb2.InstanceToImageResponse()
+        )
+
+    return await execute_tool(cfg, arguments, "capture instance image", _call)
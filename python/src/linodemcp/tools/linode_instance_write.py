@@ -22,6 +22,11 @@ from linodemcp.tools.helpers import (
     required_int_id,
     walk_page_items,
 )
+from linodemcp.tools.network_helpers import (
+    instance_vpc_interface,
+    network_helpers_from_arguments,
+    resolve_vpc_subnet,
+)
 from linodemcp.tools.proto_response import (
     raw_int,
     raw_str,
@@ -29,7 +34,10 @@ from linodemcp.tools.proto_response import (
     serialize_list_response,
 )
 from linodemcp.tools.toolschemas import schema
-from linodemcp.tools.twostage_destroy import run_two_stage_destroy
+from linodemcp.tools.twostage_destroy import (
+    require_confirm_label,
+    run_two_stage_destroy,
+)
 from linodemcp.twostage.hash_ignore import hash_ignore_fields
 
 if TYPE_CHECKING:
@@ -433,9 +441,15 @@ def create_linode_instance_create_tool() -> tuple[Tool, Capability]:
             "Creates a new Linode instance under the current Linode Interfaces "
             "generation. WARNING: Billing starts immediately. Requires "
             "firewall_id (get one from linode_firewall_list or create with "
-            "linode_firewall_create). Note: VPC attachment via the current "
-            "interface model is not yet supported by this tool; use "
-            "linode_vpc_* tools after create."
+            "linode_firewall_create). Pass vpc_subnet (a subnet ID or "
+            '"<vpc label>/<subnet label>") to attach a VPC interface instead '
+            "of a public one; public_internet=true (the default) gives it a "
+            "1:1 NAT public address, false keeps it private. Pass backup_id "
+            "with linode_id (the instance the backup was taken from) to "
+            "restore a completed backup onto the new instance instead of "
+            "deploying an image. Without authorized_keys, an image deployment "
+            "authorizes the profile SSH keys named in the instance_defaults "
+            "config; an empty list authorizes none."
         ),
         inputSchema=schema("linode.mcp.v1.InstanceCreateInput"),
     ), Capability.Write
@@ -457,6 +471,107 @@ def _instance_create_error(
     return None
 
 
+# Status of a backup that completed and can be restored.
+_BACKUP_STATUS_SUCCESSFUL = "successful"
+
+
+def _instance_create_source_error(
+    backup_id: Any, linode_id: Any, arguments: dict[str, Any]
+) -> str | None:
+    """Validate the optional backup source (mirrors Go
+    validateInstanceCreateSource). A backup supplies the disks, so it cannot
+    be combined with the image-only fields."""
+    if not backup_id and not linode_id:
+        return None
+    if not backup_id or backup_id <= 0 or not linode_id or linode_id <= 0:
+        return (
+            "backup_id and linode_id must be passed together: linode_id is the "
+            "instance the backup was taken from"
+        )
+    if (
+        arguments.get("image")
+        or arguments.get("root_pass")
+        or "authorized_keys" in arguments
+    ):
+        return (
+            "image, root_pass, and authorized_keys cannot be combined with "
+            "backup_id: the backup supplies the disks"
+        )
+    return None
+
+
+async def _instance_create_backup(
+    client: RetryableClient, linode_id: int, backup_id: int, region: str
+) -> dict[str, Any]:
+    """Fetch the backup an instance create restores from and check that the
+    account can read it, that it completed, that it is still available, and
+    that it lives in the instance's region (mirrors Go instanceCreateBackup)."""
+    try:
+        backup = await client.get_instance_backup(linode_id, backup_id)
+    except (APIError, NetworkError) as exc:
+        msg = (
+            f"backup not found on this account: backup {backup_id} of "
+            f"instance {linode_id}: {exc}"
+        )
+        raise ValueError(msg) from exc
+    status = str(backup.get("status") or "")
+    backup_region = str(backup.get("region") or "")
+    if status != _BACKUP_STATUS_SUCCESSFUL:
+        msg = (
+            f"backup cannot be restored: backup {backup_id} has status "
+            f'"{status}", only completed (successful) backups restore'
+        )
+        raise ValueError(msg)
+    if not backup.get("available"):
+        msg = (
+            f"backup cannot be restored: backup {backup_id} is no longer "
+            "available"
+        )
+        raise ValueError(msg)
+    if backup_region and backup_region != region:
+        msg = (
+            f"backup cannot be restored: backup {backup_id} is in region "
+            f"{backup_region}, the instance must be created there too"
+        )
+        raise ValueError(msg)
+    return backup
+
+
+def _default_key_labels(cfg: Config, arguments: dict[str, Any]) -> list[str]:
+    """Return the instance_defaults SSH key labels a create authorizes: none
+    when the call passes authorized_keys, which replaces them, or when the
+    instance is not deployed from an image, the only source that takes
+    authorized keys (mirrors Go instanceCreateDefaultKeyLabels)."""
+    if "authorized_keys" in arguments or not arguments.get("image"):
+        return []
+    return list(cfg.instance_defaults.authorized_key_labels)
+
+
+async def _resolve_default_keys(
+    client: RetryableClient, labels: list[str]
+) -> list[str]:
+    """Look the labels up among the profile's SSH keys and return their public
+    keys in label order; every key carrying a label is authorized. A label
+    with no key fails the create, naming each one missing (mirrors Go
+    resolveDefaultAuthorizedKeys)."""
+    keys = await client.list_ssh_keys()
+    resolved: list[str] = []
+    missing: list[str] = []
+    for label in labels:
+        matches = [key.ssh_key for key in keys if key.label == label]
+        if matches:
+            resolved.extend(matches)
+        else:
+            missing.append(label)
+    if missing:
+        msg = (
+            "instance_defaults.authorized_key_labels names SSH keys not on the "
+            f"profile: {', '.join(missing)}"
+        )
+        raise ValueError(msg)
+    return resolved
+
+
 async def handle_linode_instance_create(
     arguments: dict[str, Any], cfg: Config
 ) -> list[TextContent]:
@@ -464,22 +579,63 @@ async def handle_linode_instance_create(
     region = arguments.get("region", "")
     instance_type = arguments.get("type", "")
     firewall_id = arguments.get("firewall_id", 0)
+    backup_id = arguments.get("backup_id", 0)
+    source_linode_id = arguments.get("linode_id", 0)
+    helpers, helpers_error = network_helpers_from_arguments(arguments)
+    default_key_labels = _default_key_labels(cfg, arguments)
 
     if is_dry_run(arguments):
         fields_error = _instance_create_error(region, instance_type, firewall_id)
+        if fields_error is None:
+            fields_error = _instance_create_source_error(
+                backup_id, source_linode_id, arguments
+            )
         if fields_error is not None:
             return _error_response(fields_error)
+        if helpers_error is not None:
+            return _error_response(helpers_error)
         image = arguments.get("image")
         effect = f"A new {instance_type} instance will be created in region {region}"
         if image:
             effect += f" from image {image}"
+        side_effects: list[str] = []
+        if default_key_labels:
+            side_effects.append(
+                f"The profile SSH keys labeled {', '.join(default_key_labels)} "
+                "(instance_defaults) will be authorized for root."
+            )
+        if backup_id:
+            effect += f" from backup {backup_id} of instance {source_linode_id}"
+
+            async def _fetch(client: RetryableClient) -> Any:
+                return await _instance_create_backup(
+                    client, int(source_linode_id), int(backup_id), region
+                )
+
+            async def _walk(_client: RetryableClient, _state: Any) -> DryRunDetails:
+                return {
+                    "side_effects": [f"{effect}.", *side_effects],
+                    "warnings": [
+                        "Billing for the instance starts immediately on creation."
+                    ],
+                }
+
+            return await execute_dry_run(
+                cfg,
+                arguments,
+                "linode_instance_create",
+                "POST",
+                "/linode/instances",
+                _fetch,
+                _walk,
+            )
         return build_dry_run_response(
             "linode_instance_create",
             arguments.get("environment", ""),
             "POST",
             "/linode/instances",
             None,
-            side_effects=[f"{effect}."],
+            side_effects=[f"{effect}.", *side_effects],
             warnings=["Billing for the instance starts immediately on creation."],
         )
 
@@ -489,10 +645,31 @@ async def handle_linode_instance_create(
         )
 
     fields_error = _instance_create_error(region, instance_type, firewall_id)
+    if fields_error is None:
+        fields_error = _instance_create_source_error(
+            backup_id, source_linode_id, arguments
+        )
     if fields_error is not None:
         return _error_response(fields_error)
+    if helpers_error is not None:
+        return _error_response(helpers_error)
+
+    route_ipv4 = arguments.get("route_ipv4", True)
 
     async def _call(client: RetryableClient) -> dict[str, Any]:
+        if backup_id:
+            await _instance_create_backup(
+                client, int(source_linode_id), int(backup_id), region
+            )
+        interfaces = None
+        if helpers.subnet_ref:
+            subnet_id = await resolve_vpc_subnet(client, helpers.subnet_ref)
+            interfaces = [
+                instance_vpc_interface(helpers, subnet_id, firewall_id, route_ipv4)
+            ]
+        authorized_keys = arguments.get("authorized_keys")
+        if default_key_labels:
+            authorized_keys = await _resolve_default_keys(client, default_key_labels)
         raw = await client.create_instance_raw(
             region=region,
             instance_type=instance_type,
@@ -500,21 +677,23 @@ async def handle_linode_instance_create(
             image=arguments.get("image"),
             label=arguments.get("label"),
             root_pass=arguments.get("root_pass"),
-            authorized_keys=arguments.get("authorized_keys"),
+            authorized_keys=authorized_keys,
             booted=arguments.get("booted"),
             backups_enabled=arguments.get("backups_enabled", False),
-            route_ipv4=arguments.get("route_ipv4", True),
+            route_ipv4=route_ipv4,
             route_ipv6=arguments.get("route_ipv6", True),
+            interfaces=interfaces,
+            backup_id=int(backup_id) if backup_id else None,
+        )
+        message = (
+            f"Instance '{raw_str(raw, 'label')}' "
+            f"(ID: {raw_int(raw, 'id')}) "
+            f"created successfully in {raw_str(raw, 'region')}"
         )
+        if default_key_labels:
+            message += f" with the default SSH keys {', '.join(default_key_labels)}"
         return serialize_api_response(
-            {
-                "message": (
-                    f"Instance '{raw_str(raw, 'label')}' "
-                    f"(ID: {raw_int(raw, 'id')}) "
-                    f"created successfully in {raw_str(raw, 'region')}"
-                ),
-                "instance": raw,
-            },
+            {"message": message, "instance": raw},
             instance_pb2.InstanceWriteResponse(),
         )
 
@@ -774,6 +953,7 @@ async def _instance_delete_two_stage(
         execute=_ts_call,
         hash_ignore=hash_ignore_fields("Instance"),
         dependency_walk=_ts_walk,
+        confirm_label=True,
     )
 
 
@@ -819,6 +999,19 @@ async def handle_linode_instance_delete(
     if not instance_id:
         return _error_response("instance_id is required")
 
+    async def _fetch_state(client: RetryableClient) -> Any:
+        return instance_preview_state(await client.get_instance(int(instance_id)))
+
+    label_error = await require_confirm_label(
+        cfg,
+        arguments,
+        tool_name="linode_instance_delete",
+        path=f"/linode/instances/{int(instance_id)}",
+        fetch_state=_fetch_state,
+    )
+    if label_error is not None:
+        return label_error
+
     async def _call(client: RetryableClient) -> dict[str, Any]:
         await client.delete_instance(int(instance_id))
         return serialize_api_response(
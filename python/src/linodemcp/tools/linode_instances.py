@@ -28,6 +28,12 @@ from linodemcp.tools.helpers import (
     required_int_id,
 )
 from linodemcp.tools.linode_instance_disks import validate_device_slots
+from linodemcp.tools.network_helpers import (
+    HELPERS_AND_LIST,
+    helper_config_interfaces,
+    network_helpers_from_arguments,
+    validate_config_interface_layout,
+)
 from linodemcp.tools.proto_enum import enum_value_names, optional_enum_error
 from linodemcp.tools.proto_response import (
     serialize_api_response,
@@ -1332,9 +1338,10 @@ def _config_update_field_error(
 ) -> str | None:
     """Return the first config-update field error, or None when valid.
 
-    Follows the Go update handler's order (the run_level/virt_mode enums, then
-    the device slot names, then the at-least-one-field requirement) so both
-    languages surface the same error first for a given payload.
+    Follows the Go update handler's order (the run_level/virt_mode enums, the
+    device slot names, the interface layout and public_internet/vpc_subnet,
+    then the at-least-one-field requirement) so both languages surface the
+    same error first for a given payload.
     """
     for enum_key, enum in (
         ("run_level", instance_pb2.ConfigRunLevel.Value),
@@ -1350,7 +1357,22 @@ def _config_update_field_error(
         if slot_error is not None:
             return slot_error
 
-    if not fields:
+    interfaces = arguments.get("interfaces")
+    if isinstance(interfaces, list) and all(
+        isinstance(iface, dict) for iface in cast("list[Any]", interfaces)
+    ):
+        layout_error = validate_config_interface_layout(cast("list[Any]", interfaces))
+        if layout_error is not None:
+            return layout_error
+
+    network, network_error = network_helpers_from_arguments(arguments)
+    if network_error is not None:
+        return network_error
+    if network.set and "interfaces" in fields:
+        return HELPERS_AND_LIST
+
+    # The helpers' interface list is resolved once a client exists.
+    if not fields and not network.set:
         return "at least one update field is required"
     return None
 
@@ -1411,6 +1433,9 @@ async def handle_linode_instance_config_update(
         )
 
     async def _call(client: RetryableClient) -> dict[str, Any]:
+        generated = await helper_config_interfaces(client, arguments)
+        if generated is not None:
+            fields["interfaces"] = generated
         result = await client.update_instance_config(linode_id, config_id, fields)
         return serialize_api_response(
             {
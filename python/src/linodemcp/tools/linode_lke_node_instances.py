@@ -0,0 +1,127 @@
+"""linode_lke_node_instances: map LKE nodes to their backing instances.
+
+Mirrors ``go/internal/tools/linode_lke_node_instances.go``.
+"""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import lke_node_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import error_response, execute_tool
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import Instance, RetryableClient
+
+
+def create_linode_lke_node_instances_tool() -> tuple[Tool, Capability]:
+    """Create the linode_lke_node_instances tool."""
+    return Tool(
+        name="linode_lke_node_instances",
+        description=(
+            "Maps every LKE node to its backing Linode instance (ID, label, "
+            "status, IPs, plan, region) across all clusters, or one cluster "
+            "with cluster_id. The instance label is the Kubernetes node name, "
+            "so pass node=<kubectl node name> (or an LKE node ID) to find the "
+            "instance behind one node."
+        ),
+        inputSchema=schema("linode.mcp.v1.LKENodeInstancesInput"),
+    ), Capability.Read
+
+
+async def handle_linode_lke_node_instances(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_lke_node_instances tool request."""
+    cluster_id_raw = arguments.get("cluster_id", "")
+    if not isinstance(cluster_id_raw, str):
+        return error_response("cluster_id must be a string")
+    cluster_id = 0
+    if cluster_id_raw:
+        try:
+            cluster_id = int(cluster_id_raw)
+        except ValueError:
+            return error_response("cluster_id must be a valid integer")
+
+    node_filter = arguments.get("node", "")
+    if not isinstance(node_filter, str):
+        return error_response("node must be a string")
+    node_filter = node_filter.strip()
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        nodes = await _lke_node_instances(client, cluster_id, node_filter)
+        response: dict[str, Any] = {"count": len(nodes), "nodes": nodes}
+        if node_filter:
+            response["filter"] = node_filter
+        return serialize_api_response(
+            response, lke_node_pb2.LKENodeInstancesResponse()
+        )
+
+    return await execute_tool(cfg, arguments, "map LKE nodes to instances", _call)
+
+
+async def _lke_node_instances(
+    client: RetryableClient, cluster_id: int, node_filter: str
+) -> list[dict[str, Any]]:
+    """Walk the clusters (or the one cluster when cluster_id is set) and their
+    pools, joining each node to its instance from a single instance listing.
+
+    node_filter, when set, keeps only the node whose ID or instance label
+    matches it case-insensitively.
+    """
+    if cluster_id:
+        clusters = [await client.get_lke_cluster(cluster_id)]
+    else:
+        clusters = await client.list_lke_clusters()
+
+    by_id = {instance.id: instance for instance in await client.list_instances()}
+
+    wanted = node_filter.lower()
+    nodes: list[dict[str, Any]] = []
+    for cluster in clusters:
+        pools = await client.list_lke_node_pools(int(cluster.get("id", 0)))
+        for pool in pools:
+            for node in pool.get("nodes") or []:
+                instance = by_id.get(int(node.get("instance_id") or 0))
+                entry = _lke_node_instance(cluster, pool, node, instance)
+                if wanted and wanted not in (
+                    entry["node_id"].lower(),
+                    entry.get("instance_label", "").lower(),
+                ):
+                    continue
+                nodes.append(entry)
+    return nodes
+
+
+def _lke_node_instance(
+    cluster: dict[str, Any],
+    pool: dict[str, Any],
+    node: dict[str, Any],
+    instance: Instance | None,
+) -> dict[str, Any]:
+    """One mapping entry. instance is None when the node's instance is not on
+    the account yet (provisioning) or any longer (recycled)."""
+    entry: dict[str, Any] = {
+        "cluster_id": cluster.get("id", 0),
+        "cluster_label": cluster.get("label", ""),
+        "pool_id": pool.get("id", 0),
+        "node_id": str(node.get("id") or ""),
+        "node_status": node.get("status", ""),
+        "instance_id": node.get("instance_id") or 0,
+    }
+    if instance is not None:
+        entry.update(
+            instance_label=instance.label,
+            instance_status=instance.status,
+            type=instance.type,
+            region=instance.region,
+            ipv4=list(instance.ipv4),
+            ipv6=instance.ipv6,
+        )
+    return entry
@@ -0,0 +1,367 @@
+"""Linode LKE upgrade planner: versions across clusters, optionally upgraded.
+
+Mirrors ``go/internal/tools/linode_lke_upgrade_plan.go``.
+"""
+
+from __future__ import annotations
+
+import asyncio
+import functools
+import time
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import lke_upgrade_plan_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_UP_TO_DATE = "up_to_date"
+_AVAILABLE = "upgrade_available"
+_UNSUPPORTED = "unsupported"
+_UNKNOWN = "unknown"
+
+_OUTCOME_UPGRADED = "upgraded"
+_OUTCOME_FAILED = "failed"
+_OUTCOME_TIMED_OUT = "timed_out"
+_OUTCOME_SKIPPED = "skipped"
+
+# Pacing for execute with wait. A control-plane upgrade settles within
+# minutes, but a recycle replaces nodes one at a time; past the timeout the
+# cluster is reported timed_out and the run stops.
+_WAIT_INTERVAL_SECONDS = 15.0
+_WAIT_TIMEOUT_SECONDS = 1800.0
+_WAIT_TIMEOUT_TEXT = "30m0s"
+_STATUS_READY = "ready"
+
+
+def create_linode_lke_upgrade_plan_tool() -> tuple[Tool, Capability]:
+    """Create the linode_lke_upgrade_plan tool."""
+    return Tool(
+        name="linode_lke_upgrade_plan",
+        description=(
+            "Plans Kubernetes upgrades across LKE clusters: lists each cluster "
+            "(every cluster, or cluster_ids) with its current version, the "
+            "supported versions newer than it, the next version LKE can move it "
+            "to (one minor version per upgrade), and whether its node pools need "
+            "recycling to run the new version. With execute=true and "
+            "confirm=true, upgrades each cluster to its next version one at a "
+            "time, recycling its nodes when recycle=true and, unless wait=false, "
+            "waiting for it to be ready before the next; the first failure stops "
+            "the run. Pass dry_run=true to preview the upgrades."
+        ),
+        inputSchema=schema("linode.mcp.v1.LKEUpgradePlanInput"),
+    ), Capability.Write
+
+
+def _parse_version(version: str) -> tuple[int, int] | None:
+    """Read the major and minor numbers of a Kubernetes version such as
+    "1.31"; any patch part is ignored (mirrors Go parseK8sVersion)."""
+    parts = version.split(".", 2)
+    if len(parts) < 2:  # noqa: PLR2004 - major and minor
+        return None
+    try:
+        return int(parts[0]), int(parts[1])
+    except ValueError:
+        return None
+
+
+def _compare_versions(a: str, b: str) -> int:
+    """Order versions by major then minor number; versions that do not parse
+    sort first, by their text (mirrors Go compareK8sVersions)."""
+    a_parsed, b_parsed = _parse_version(a), _parse_version(b)
+    if a_parsed is None and b_parsed is None:
+        return (a > b) - (a < b)
+    if a_parsed is None:
+        return -1
+    if b_parsed is None:
+        return 1
+    return (a_parsed > b_parsed) - (a_parsed < b_parsed)
+
+
+def _cluster_ids(arguments: dict[str, Any]) -> tuple[list[int], str]:
+    """Return the cluster_ids argument, or a validation message."""
+    raw_ids = arguments.get("cluster_ids")
+    cluster_ids = (
+        [int(item) for item in raw_ids if isinstance(item, int | float)]
+        if isinstance(raw_ids, list)
+        else []
+    )
+    for idx, cluster_id in enumerate(cluster_ids):
+        if cluster_id <= 0:
+            return [], f"cluster_ids[{idx}] must be a positive integer"
+    return cluster_ids, ""
+
+
+def _plan_cluster(
+    cluster: dict[str, Any], pools: list[dict[str, Any]], supported: list[str]
+) -> dict[str, Any]:
+    """One cluster's plan entry; supported must be sorted oldest first
+    (mirrors Go lkeUpgradePlanCluster)."""
+    current = str(cluster.get("k8s_version") or "")
+    entry: dict[str, Any] = {
+        "cluster_id": int(cluster.get("id") or 0),
+        "label": cluster.get("label", ""),
+        "region": cluster.get("region", ""),
+        "status": cluster.get("status", ""),
+        "current_version": current,
+        "target_versions": [],
+        "pool_count": len(pools),
+        "node_count": sum(int(pool.get("count") or 0) for pool in pools),
+        "needs_recycle": False,
+    }
+    if _parse_version(current) is None:
+        entry["upgrade"] = _UNKNOWN
+        return entry
+
+    targets = [v for v in supported if _compare_versions(v, current) > 0]
+    entry["target_versions"] = targets
+    if not targets:
+        entry["upgrade"] = _UP_TO_DATE
+    elif current not in supported:
+        entry["upgrade"] = _UNSUPPORTED
+    else:
+        entry["upgrade"] = _AVAILABLE
+    if targets:
+        entry["next_version"] = targets[0]
+        entry["needs_recycle"] = entry["node_count"] > 0
+    return entry
+
+
+async def _build_plan(
+    client: RetryableClient, cluster_ids: list[int]
+) -> dict[str, Any]:
+    """Read the supported versions, the clusters, and each cluster's node
+    pools, and assemble the plan (mirrors Go buildLKEUpgradePlan)."""
+    versions = await client.list_lke_versions()
+    supported = sorted(
+        (str(version.get("id") or "") for version in versions),
+        key=functools.cmp_to_key(_compare_versions),
+    )
+
+    if cluster_ids:
+        clusters = [
+            await client.get_lke_cluster(cluster_id) for cluster_id in cluster_ids
+        ]
+    else:
+        clusters = await client.list_lke_clusters()
+
+    entries: list[dict[str, Any]] = []
+    for cluster in clusters:
+        pools = await client.list_lke_node_pools(int(cluster.get("id") or 0))
+        entries.append(_plan_cluster(cluster, pools, supported))
+
+    available = sum(1 for entry in entries if "next_version" in entry)
+    recycles = sum(1 for entry in entries if entry["needs_recycle"])
+    return {
+        "message": (
+            f"{available} of {len(entries)} LKE clusters have a Kubernetes "
+            f"upgrade available ({recycles} with nodes to recycle afterwards)"
+        ),
+        "supported_versions": supported,
+        "clusters": entries,
+        "executed": False,
+        "upgraded": 0,
+    }
+
+
+def _plan_side_effects(
+    plan: dict[str, Any], recycle: bool
+) -> tuple[list[str], list[str]]:
+    """One line per cluster an executed plan would upgrade, and a warning for
+    each cluster whose nodes would stay on the old version because recycle is
+    off (mirrors Go lkeUpgradePlanSideEffects)."""
+    side_effects: list[str] = []
+    warnings: list[str] = []
+    for entry in plan["clusters"]:
+        if "next_version" not in entry:
+            continue
+        effect = (
+            f"LKE cluster {entry['cluster_id']} ({entry['label']}) upgrades from "
+            f"Kubernetes {entry['current_version']} to {entry['next_version']}"
+        )
+        if recycle and entry["needs_recycle"]:
+            effect += f", then its {entry['node_count']} nodes are recycled"
+        elif entry["needs_recycle"]:
+            warnings.append(
+                f"LKE cluster {entry['cluster_id']} keeps its "
+                f"{entry['node_count']} nodes on Kubernetes "
+                f"{entry['current_version']} until they are recycled."
+            )
+        side_effects.append(effect + ".")
+    return side_effects, warnings
+
+
+async def _cluster_ready(
+    client: RetryableClient, cluster_id: int, nodes: bool
+) -> bool:
+    """Whether a cluster is ready and, when nodes is set, whether every node
+    in its pools is too (mirrors Go lkeClusterReady)."""
+    cluster = await client.get_lke_cluster(cluster_id)
+    if cluster.get("status") != _STATUS_READY:
+        return False
+    if not nodes:
+        return True
+    pools = await client.list_lke_node_pools(cluster_id)
+    return all(
+        node.get("status") == _STATUS_READY
+        for pool in pools
+        for node in pool.get("nodes") or []
+    )
+
+
+async def _await_ready(
+    client: RetryableClient, cluster_id: int, recycled: bool
+) -> bool:
+    """Re-read a cluster until it, and every node when recycled, is ready or
+    the timeout passes. The first read is immediate except after a recycle,
+    which may not have marked any node yet (mirrors Go
+    awaitLKEClusterReady)."""
+    deadline = time.monotonic() + _WAIT_TIMEOUT_SECONDS
+    first = not recycled
+    while True:
+        if not first:
+            if time.monotonic() + _WAIT_INTERVAL_SECONDS > deadline:
+                return False
+            await asyncio.sleep(_WAIT_INTERVAL_SECONDS)
+        first = False
+        if await _cluster_ready(client, cluster_id, recycled):
+            return True
+
+
+async def _upgrade_cluster(
+    client: RetryableClient, entry: dict[str, Any], recycle: bool, wait: bool
+) -> None:
+    """Move one cluster to its next version, recycle and wait when asked, and
+    record the outcome on the entry (mirrors Go upgradeLKECluster)."""
+    cluster_id = entry["cluster_id"]
+    try:
+        cluster = await client.update_lke_cluster(
+            cluster_id, k8s_version=entry["next_version"]
+        )
+    except (APIError, NetworkError) as exc:
+        entry["outcome"] = _OUTCOME_FAILED
+        entry["error"] = str(exc)
+        return
+    entry["status"] = cluster.get("status", "")
+
+    if recycle and entry["needs_recycle"]:
+        try:
+            await client.recycle_lke_cluster(cluster_id)
+        except (APIError, NetworkError) as exc:
+            entry["outcome"] = _OUTCOME_FAILED
+            entry["error"] = f"upgraded, but the recycle failed: {exc}"
+            return
+        entry["recycled"] = True
+
+    if wait:
+        try:
+            ready = await _await_ready(
+                client, cluster_id, bool(entry.get("recycled"))
+            )
+        except (APIError, NetworkError) as exc:
+            entry["ready"] = False
+            entry["outcome"] = _OUTCOME_FAILED
+            entry["error"] = f"upgraded, but the wait failed: {exc}"
+            return
+        entry["ready"] = ready
+        if not ready:
+            entry["outcome"] = _OUTCOME_TIMED_OUT
+            entry["error"] = f"not ready after {_WAIT_TIMEOUT_TEXT}"
+            return
+        entry["status"] = _STATUS_READY
+
+    entry["outcome"] = _OUTCOME_UPGRADED
+
+
+async def _execute_plan(
+    client: RetryableClient, plan: dict[str, Any], recycle: bool, wait: bool
+) -> None:
+    """Upgrade each cluster with a next version, one at a time in plan order;
+    the first that fails or times out stops the run and the rest are skipped
+    (mirrors Go executeLKEUpgradePlan)."""
+    plan["executed"] = True
+    planned = 0
+    stopped: dict[str, Any] | None = None
+    for entry in plan["clusters"]:
+        if "next_version" not in entry:
+            continue
+        planned += 1
+        if stopped is not None:
+            entry["outcome"] = _OUTCOME_SKIPPED
+            continue
+        await _upgrade_cluster(client, entry, recycle, wait)
+        if entry["outcome"] == _OUTCOME_UPGRADED:
+            plan["upgraded"] += 1
+        else:
+            stopped = entry
+
+    plan["message"] = (
+        f"Upgraded {plan['upgraded']} of {planned} LKE clusters with an "
+        "upgrade available"
+    )
+    if stopped is not None:
+        plan["message"] += (
+            f"; stopped at cluster {stopped['cluster_id']} ({stopped['outcome']})"
+        )
+
+
+async def handle_linode_lke_upgrade_plan(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_lke_upgrade_plan tool request."""
+    cluster_ids, error = _cluster_ids(arguments)
+    if error:
+        return error_response(error)
+    execute = arguments.get("execute") is True
+    recycle = arguments.get("recycle") is True
+    wait = arguments.get("wait", True) is not False
+
+    if is_dry_run(arguments):
+        plans: list[dict[str, Any]] = []
+
+        async def _fetch(client: RetryableClient) -> Any:
+            plans.append(await _build_plan(client, cluster_ids))
+
+        async def _walk(_client: RetryableClient, _state: Any) -> DryRunDetails:
+            side_effects, warnings = _plan_side_effects(plans[0], recycle)
+            return {"side_effects": side_effects, "warnings": warnings}
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_lke_upgrade_plan",
+            "PUT",
+            "/lke/clusters/{cluster_id}",
+            _fetch,
+            _walk,
+        )
+
+    if execute and arguments.get("confirm") is not True:
+        return error_response(
+            "This upgrades the Kubernetes version of every planned LKE "
+            "cluster. Set confirm=true to proceed."
+        )
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        plan = await _build_plan(client, cluster_ids)
+        if execute:
+            await _execute_plan(client, plan, recycle, wait)
+        return serialize_api_response(
+            plan, lke_upgrade_plan_pb2.LKEUpgradePlanResponse()
+        )
+
+    return await execute_tool(cfg, arguments, "plan LKE upgrades", _call)
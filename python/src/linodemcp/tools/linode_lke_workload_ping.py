@@ -0,0 +1,131 @@
+"""LKE workload ping tool.
+
+``linode_lke_workload_ping`` fetches an LKE cluster's kubeconfig and asks the
+cluster's Kubernetes API server for /version and the node list, a deeper
+readiness signal than the cluster's Linode status. It is off until
+``lke.workload_ping: true``, since it is the only tool that uses cluster
+credentials; the kubeconfig is never returned.
+
+Mirrors ``go/internal/tools/linode_lke_workload_ping.go``.
+"""
+
+from __future__ import annotations
+
+import base64
+import binascii
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import lke_kubeconfig_pb2
+from linodemcp.kubeprobe import KubeconfigError, Result, parse_kubeconfig, ping
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import error_response, execute_tool, resolve_config
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_DISABLED_MESSAGE = (
+    "linode_lke_workload_ping is disabled; set lke.workload_ping: true "
+    "in the config to let it use cluster kubeconfigs"
+)
+
+
+def create_linode_lke_workload_ping_tool() -> tuple[Tool, Capability]:
+    """Create the linode_lke_workload_ping tool."""
+    return Tool(
+        name="linode_lke_workload_ping",
+        description=(
+            "Checks that an LKE cluster's Kubernetes API server is actually "
+            "serving: fetches the cluster kubeconfig and calls the API server's "
+            "/version and node list endpoints, reporting the Kubernetes version, "
+            "node readiness, and each request's status and latency. A deeper "
+            "readiness signal than the cluster's Linode status. Requires "
+            "lke.workload_ping: true in the config; the kubeconfig is never "
+            "returned."
+        ),
+        inputSchema=schema("linode.mcp.v1.LKEWorkloadPingInput"),
+    ), Capability.Read
+
+
+def _workload_ping_dict(cluster_id: int, server: str, result: Result) -> dict[str, Any]:
+    """Map a ping onto the wire response (Go's lkeWorkloadPingProto)."""
+    ready = sum(1 for node in result.nodes if node.ready)
+    failed = result.first_failure()
+    if failed is not None:
+        message = (
+            f"LKE cluster {cluster_id} API server is not serving: "
+            f"{failed.name} check failed: {failed.error}"
+        )
+    else:
+        message = (
+            f"LKE cluster {cluster_id} API server is serving: Kubernetes "
+            f"{result.version}, {ready} of {len(result.nodes)} nodes Ready"
+        )
+    return {
+        "cluster_id": cluster_id,
+        "api_server": server,
+        "serving": result.serving(),
+        "message": message,
+        "kubernetes_version": result.version,
+        "node_count": len(result.nodes),
+        "ready_node_count": ready,
+        "nodes": [
+            {
+                "name": node.name,
+                "ready": node.ready,
+                "kubelet_version": node.kubelet_version,
+            }
+            for node in result.nodes
+        ],
+        "checks": [
+            {
+                "name": check.name,
+                "path": check.path,
+                "ok": check.ok,
+                "status_code": check.status_code,
+                "latency_ms": check.latency_ms,
+                "error": check.error,
+            }
+            for check in result.checks
+        ],
+    }
+
+
+async def handle_linode_lke_workload_ping(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_lke_workload_ping tool request."""
+    cluster_id_str = arguments.get("cluster_id", "")
+    if not cluster_id_str:
+        return error_response("cluster_id is required")
+    try:
+        cluster_id = int(cluster_id_str)
+    except ValueError:
+        return error_response("cluster_id must be a valid integer")
+
+    if not resolve_config(cfg).lke.workload_ping:
+        return error_response(_DISABLED_MESSAGE)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        kubeconfig = await client.get_lke_kubeconfig(cluster_id)
+        try:
+            raw = base64.b64decode(
+                str(kubeconfig.get("kubeconfig", "")), validate=True
+            )
+            target = parse_kubeconfig(raw)
+            result = await ping(target)
+        except (binascii.Error, KubeconfigError) as exc:
+            msg = f"failed to read kubeconfig for cluster {cluster_id}: {exc}"
+            raise ValueError(msg) from exc
+        return serialize_api_response(
+            _workload_ping_dict(cluster_id, target.server, result),
+            lke_kubeconfig_pb2.LKEWorkloadPingResponse(),
+        )
+
+    return await execute_tool(
+        cfg, arguments, f"retrieve kubeconfig for cluster {cluster_id}", _call
+    )
@@ -26,7 +26,10 @@ from linodemcp.tools.helpers import (
 )
 from linodemcp.tools.proto_response import serialize_api_response
 from linodemcp.tools.toolschemas import schema
-from linodemcp.tools.twostage_destroy import run_two_stage_destroy
+from linodemcp.tools.twostage_destroy import (
+    require_confirm_label,
+    run_two_stage_destroy,
+)
 from linodemcp.twostage.hash_ignore import hash_ignore_fields
 
 if TYPE_CHECKING:
@@ -306,6 +309,7 @@ async def _lke_cluster_delete_two_stage(
         execute=_ts_call,
         hash_ignore=hash_ignore_fields("LKECluster"),
         dependency_walk=_ts_walk,
+        confirm_label=True,
     )
 
 
@@ -350,6 +354,19 @@ async def handle_linode_lke_cluster_delete(
             "will be deleted. Set confirm=true to proceed."
         )
 
+    async def _fetch_state(client: RetryableClient) -> Any:
+        return await client.get_lke_cluster(cluster_id)
+
+    label_error = await require_confirm_label(
+        cfg,
+        arguments,
+        tool_name="linode_lke_cluster_delete",
+        path=f"/lke/clusters/{cluster_id}",
+        fetch_state=_fetch_state,
+    )
+    if label_error is not None:
+        return label_error
+
     async def _call(client: RetryableClient) -> dict[str, Any]:
         await client.delete_lke_cluster(cluster_id)
         return serialize_api_response(
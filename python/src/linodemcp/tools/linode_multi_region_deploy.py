@@ -0,0 +1,309 @@
+"""Linode multi-region deploy tool: one instance per region from one spec."""
+
+from __future__ import annotations
+
+import asyncio
+from dataclasses import dataclass
+from typing import TYPE_CHECKING, Any, cast
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import instance_pb2
+from linodemcp.linode import APIError, NetworkError, validate_root_password
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import (
+    build_dry_run_response,
+    error_response,
+    execute_tool,
+    is_dry_run,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_ON_FAILURE_KEEP = "keep"
+_ON_FAILURE_ROLLBACK = "rollback"
+# Every region is created concurrently, so one deploy is capped.
+_MAX_REGIONS = 25
+_LABEL_MAX_LENGTH = 64
+_FIREWALL_ID_REQUIRED = (
+    "firewall_id is required for instance creation. Get a firewall ID "
+    "from linode_firewall_list, or create one with linode_firewall_create."
+)
+
+
+@dataclass
+class _DeployArgs:
+    """The validated linode_multi_region_deploy input (mirrors Go
+    multiRegionDeployArgs)."""
+
+    regions: list[str]
+    instance_type: str
+    image: str
+    label_prefix: str
+    firewall_id: int
+    root_pass: str | None
+    authorized_keys: list[str] | None
+    tags: list[str] | None
+    backups_enabled: bool
+    on_failure: str
+
+    def label(self, region: str) -> str:
+        """The instance label for one region."""
+        return f"{self.label_prefix}-{region}"
+
+
+def create_linode_multi_region_deploy_tool() -> tuple[Tool, Capability]:
+    """Create the linode_multi_region_deploy tool."""
+    return Tool(
+        name="linode_multi_region_deploy",
+        description=(
+            "Creates one instance of the same type and image in each region in "
+            'regions, in parallel, labeled "<label_prefix>-<region>" and sharing '
+            "firewall_id, authorized_keys, root_pass, and tags. Useful for edge "
+            "and latency testing. When some regions fail, on_failure=keep "
+            "(default) leaves the instances that were created and "
+            "on_failure=rollback deletes them. WARNING: every instance is "
+            "billed from creation. Pass dry_run=true to preview the per-region "
+            "plan."
+        ),
+        inputSchema=schema("linode.mcp.v1.MultiRegionDeployInput"),
+    ), Capability.Write
+
+
+def _string_list(raw: Any, name: str) -> tuple[list[str] | None, str]:
+    """Return raw as a list of strings, or a validation message."""
+    if not isinstance(raw, list):
+        return None, f"{name} must be an array of strings"
+    items = cast("list[Any]", raw)
+    if not all(isinstance(item, str) for item in items):
+        return None, f"{name} must be an array of strings"
+    return [str(item) for item in items], ""
+
+
+def _label_error(label: str) -> str:
+    """Check a derived label against Linode's label rules (mirrors Go
+    validateMultiRegionLabel)."""
+    if len(label) > _LABEL_MAX_LENGTH:
+        return (
+            f'label "{label}" is longer than {_LABEL_MAX_LENGTH} characters: '
+            "shorten label_prefix"
+        )
+    for char in label:
+        if not (char.isascii() and (char.isalnum() or char in "-_.")):
+            return (
+                f"label \"{label}\" contains invalid character '{char}': use "
+                "letters, digits, '-', '_', and '.'"
+            )
+    return ""
+
+
+def _deploy_args(arguments: dict[str, Any]) -> tuple[_DeployArgs | None, str]:
+    """Validate the tool args in the same order as Go
+    multiRegionDeployArgsFromTool; return the args or a validation message."""
+    if "regions" not in arguments:
+        return None, "regions is required"
+    regions, error = _string_list(arguments["regions"], "regions")
+    if regions is None:
+        return None, error
+    if not 1 <= len(regions) <= _MAX_REGIONS:
+        return None, f"regions must list 1 to {_MAX_REGIONS} regions"
+    seen: set[str] = set()
+    for region in regions:
+        if not region:
+            return None, "regions must not contain empty region IDs"
+        if region in seen:
+            return None, f"region {region} is listed more than once"
+        seen.add(region)
+
+    image = str(arguments.get("image") or "")
+    label_prefix = str(arguments.get("label_prefix") or "")
+    instance_type = str(arguments.get("type") or "")
+    firewall_id = arguments.get("firewall_id", 0)
+    if not image:
+        return None, "image is required"
+    if not label_prefix:
+        return None, "label_prefix is required"
+    if not instance_type:
+        return None, "type is required"
+    if not isinstance(firewall_id, int) or firewall_id <= 0:
+        return None, _FIREWALL_ID_REQUIRED
+    try:
+        validate_root_password(arguments.get("root_pass"))
+    except ValueError as exc:
+        return None, str(exc)
+
+    args = _DeployArgs(
+        regions=regions,
+        instance_type=instance_type,
+        image=image,
+        label_prefix=label_prefix,
+        firewall_id=firewall_id,
+        root_pass=arguments.get("root_pass"),
+        authorized_keys=None,
+        tags=None,
+        backups_enabled=bool(arguments.get("backups_enabled", False)),
+        on_failure=str(arguments.get("on_failure") or _ON_FAILURE_KEEP),
+    )
+    for region in regions:
+        label_error = _label_error(args.label(region))
+        if label_error:
+            return None, label_error
+    if args.on_failure not in (_ON_FAILURE_KEEP, _ON_FAILURE_ROLLBACK):
+        return None, "on_failure must be keep or rollback"
+    if "authorized_keys" in arguments:
+        args.authorized_keys, error = _string_list(
+            arguments["authorized_keys"], "authorized_keys"
+        )
+        if args.authorized_keys is None:
+            return None, error
+    if "tags" in arguments:
+        args.tags, _ = _string_list(arguments["tags"], "tags")
+        if args.tags is None:
+            return None, "tags must be a list of strings"
+    return args, ""
+
+
+def _deploy_side_effects(args: _DeployArgs) -> list[str]:
+    """One line per instance the deploy would create, then the on_failure
+    behavior (mirrors Go multiRegionDeploySideEffects)."""
+    side_effects = [
+        f'A new {args.instance_type} instance "{args.label(region)}" will be '
+        f"created in region {region} from image {args.image}."
+        for region in args.regions
+    ]
+    if args.on_failure == _ON_FAILURE_ROLLBACK:
+        side_effects.append(
+            "If any region fails, the instances already created are deleted."
+        )
+    else:
+        side_effects.append(
+            "If any region fails, the instances already created are kept."
+        )
+    return side_effects
+
+
+async def _create_instance(
+    client: RetryableClient, args: _DeployArgs, region: str
+) -> dict[str, Any]:
+    """Create one region's instance with a single public interface behind the
+    shared firewall."""
+    result: dict[str, Any] = {"region": region, "label": args.label(region)}
+    try:
+        result["instance"] = await client.create_instance_raw(
+            region=region,
+            instance_type=args.instance_type,
+            firewall_id=args.firewall_id,
+            image=args.image,
+            label=args.label(region),
+            root_pass=args.root_pass,
+            authorized_keys=args.authorized_keys,
+            backups_enabled=args.backups_enabled,
+            tags=args.tags,
+        )
+    except (APIError, NetworkError, ValueError) as exc:
+        result["error"] = str(exc)
+    return result
+
+
+async def _rollback(client: RetryableClient, results: list[dict[str, Any]]) -> None:
+    """Delete the instances a partially failed deploy created, one at a time;
+    a failed delete is recorded on its result."""
+    for result in results:
+        if "instance" not in result:
+            continue
+        try:
+            await client.delete_instance(int(result["instance"].get("id") or 0))
+            result["rolled_back"] = True
+        except (APIError, NetworkError) as exc:
+            result["rollback_error"] = str(exc)
+            add_warning(
+                "rollback of instance %s in %s failed: %s",
+                result["instance"].get("id"),
+                result["region"],
+                exc,
+            )
+
+
+async def _deploy(client: RetryableClient, args: _DeployArgs) -> dict[str, Any]:
+    """Create every region's instance concurrently, rolling back on partial
+    failure when asked (mirrors Go multiRegionDeploy)."""
+    results = list(
+        await asyncio.gather(
+            *(_create_instance(client, args, region) for region in args.regions)
+        )
+    )
+    created = sum(1 for result in results if "instance" in result)
+    failed = len(results) - created
+
+    if failed == 0:
+        status = "created"
+        message = f"Created {created} instances across {len(results)} regions"
+    elif created == 0:
+        status = "failed"
+        message = f"All {len(results)} regions failed; no instances were created"
+    elif args.on_failure == _ON_FAILURE_ROLLBACK:
+        await _rollback(client, results)
+        status = "rolled_back"
+        message = (
+            f"{failed} of {len(results)} regions failed; rolled back the "
+            f"{created} instances that were created"
+        )
+    else:
+        status = "partial"
+        message = (
+            f"{failed} of {len(results)} regions failed; kept the {created} "
+            "instances that were created"
+        )
+
+    return serialize_api_response(
+        {
+            "message": message,
+            "status": status,
+            "created": created,
+            "failed": failed,
+            "results": results,
+        },
+        instance_pb2.MultiRegionDeployResponse(),
+    )
+
+
+async def handle_linode_multi_region_deploy(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_multi_region_deploy tool request."""
+    if is_dry_run(arguments):
+        args, error = _deploy_args(arguments)
+        if args is None:
+            return error_response(error)
+        return build_dry_run_response(
+            "linode_multi_region_deploy",
+            arguments.get("environment", ""),
+            "POST",
+            "/linode/instances",
+            None,
+            side_effects=_deploy_side_effects(args),
+            warnings=[
+                f"Billing for each of the {len(args.regions)} instances starts "
+                "immediately on creation."
+            ],
+        )
+
+    if not arguments.get("confirm"):
+        return error_response(
+            "This creates a billable instance in every listed region. "
+            "Set confirm=true to proceed."
+        )
+
+    args, error = _deploy_args(arguments)
+    if args is None:
+        return error_response(error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        return await _deploy(client, args)
+
+    return await execute_tool(cfg, arguments, "deploy instances", _call)
@@ -28,7 +28,7 @@ from linodemcp.twostage.hash_ignore import hash_ignore_fields
 
 if TYPE_CHECKING:
     from linodemcp.config import Config
-    from linodemcp.linode import RetryableClient
+    from linodemcp.linode import Instance, RetryableClient
 
 
 def create_linode_vlan_list_tool() -> tuple[Tool, Capability]:
@@ -276,11 +276,77 @@ async def handle_linode_networking_ipv4_share(
     return await execute_tool(cfg, arguments, "share IPv4 addresses", _call)
 
 
+# Ends every region-check refusal from the assign and share tools. Mirrors
+# Go's ipRegionMismatchReason.
+_IP_REGION_MISMATCH_REASON = (
+    "; IP addresses can only be assigned or shared between Linodes in the same"
+    " region"
+)
+
+
+async def _ip_assign_region_check(
+    client: RetryableClient, region: str, assignments: list[dict[str, Any]]
+) -> str:
+    """Check every Linode and address an assign names is in region; return
+    the refusal for the first that is not, or "". Mirrors Go's
+    networkingIPAssignRegionCheck."""
+    instances: dict[int, Instance] = {}
+    for assignment in assignments:
+        linode_id = int(assignment["linode_id"])
+        instance = instances.get(linode_id)
+        if instance is None:
+            instance = await client.get_instance(linode_id)
+            instances[linode_id] = instance
+        if instance.region != region:
+            return (
+                f"Linode {instance.id} ({instance.label}) is in {instance.region},"
+                f" not {region}{_IP_REGION_MISMATCH_REASON}"
+            )
+        address = str(assignment["address"])
+        address_region = str((await client.get_networking_ip(address)).get("region"))
+        if address_region != region:
+            return (
+                f"address {address} is in {address_region}, not {region}"
+                f"{_IP_REGION_MISMATCH_REASON}"
+            )
+    return ""
+
+
+async def _shared_address_region(client: RetryableClient, ip: str) -> str:
+    """The region of one share entry: an address, or an IPv6 range written
+    with its prefix length (e.g. "2600:3c00:e000::/64"), which the range
+    lookup takes as written."""
+    if "/" in ip:
+        ipv6_range = await client.get_ipv6_range(ip)
+        return str(ipv6_range.get("region"))
+    return str((await client.get_networking_ip(ip)).get("region"))
+
+
+async def _ip_share_region_check(
+    client: RetryableClient, linode_id: int, ips: list[str]
+) -> str:
+    """Check every address a share names is in the primary Linode's region.
+    Mirrors Go's networkingIPShareRegionCheck."""
+    primary = await client.get_instance(linode_id)
+    for ip in ips:
+        region = await _shared_address_region(client, ip)
+        if region != primary.region:
+            return (
+                f"address {ip} is in {region}, but Linode {primary.id}"
+                f" ({primary.label}) is in {primary.region}"
+                f"{_IP_REGION_MISMATCH_REASON}"
+            )
+    return ""
+
+
 def create_linode_networking_ip_share_tool() -> tuple[Tool, Capability]:
     """Create the linode_networking_ip_share tool."""
     return Tool(
         name="linode_networking_ip_share",
-        description="Shares IP addresses with a Linode",
+        description=(
+            "Shares IP addresses with a Linode. Every address must be in the"
+            " primary Linode's region; the tool checks before sharing."
+        ),
         inputSchema=schema("linode.mcp.v1.NetworkingIPShareInput"),
     ), Capability.Write
 
@@ -335,6 +401,9 @@ async def handle_linode_networking_ip_share(
     typed_ips, linode_id = parsed
 
     async def _call(client: RetryableClient) -> dict[str, Any]:
+        mismatch = await _ip_share_region_check(client, linode_id, typed_ips)
+        if mismatch:
+            raise ValueError(mismatch)
         await client.share_ips(typed_ips, linode_id)
         return serialize_api_response(
             {
@@ -453,8 +522,9 @@ def create_linode_networking_ip_assign_tool() -> tuple[Tool, Capability]:
     return Tool(
         name="linode_networking_ip_assign",
         description=(
-            "Assigns IP addresses to Linodes in a region. WARNING: This "
-            "changes IP ownership assignments."
+            "Assigns IP addresses to Linodes in a region. Every Linode and "
+            "address must already be in that region; the tool checks before "
+            "assigning. WARNING: This changes IP ownership assignments."
         ),
         inputSchema=schema("linode.mcp.v1.NetworkingIPAssignInput"),
     ), Capability.Write
@@ -490,6 +560,9 @@ async def handle_linode_networking_ip_assign(
     region, typed_assignments = parsed
 
     async def _call(client: RetryableClient) -> dict[str, Any]:
+        mismatch = await _ip_assign_region_check(client, region, typed_assignments)
+        if mismatch:
+            raise ValueError(mismatch)
         await client.assign_ips(region, typed_assignments)
         return serialize_api_response(
             {
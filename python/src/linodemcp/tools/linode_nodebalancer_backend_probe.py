@@ -0,0 +1,225 @@
+"""NodeBalancer backend probe tool.
+
+``linode_nodebalancer_backend_probe`` runs each backend's health check
+directly from the MCP host and compares the result with the status the
+NodeBalancer reports, so a failing backend is pinned on the application or on
+the NodeBalancer's path to it. It only connects to addresses inside
+``nodebalancer_probe.allowed_cidrs`` and is off while that list is empty.
+
+Mirrors ``go/internal/tools/linode_nodebalancer_backend_probe.go``.
+"""
+
+from __future__ import annotations
+
+from ipaddress import IPv4Network, IPv6Network, ip_address
+from typing import TYPE_CHECKING, Any, cast
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.backendprobe import CHECK_NONE, Target, probe
+from linodemcp.config import ConfigInvalidError
+from linodemcp.genpb.linode.mcp.v1 import nodebalancer_backend_probe_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import (
+    error_response,
+    execute_tool,
+    required_int_id,
+    resolve_config,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_DISABLED_MESSAGE = (
+    "linode_nodebalancer_backend_probe is disabled; set "
+    "nodebalancer_probe.allowed_cidrs in the config to the networks it may probe"
+)
+
+# Backend probe verdicts; see NodeBalancerBackendProbeNode in the proto.
+VERDICT_HEALTHY = "healthy"
+VERDICT_APP_SIDE = "app_side"
+VERDICT_LB_SIDE = "lb_side"
+VERDICT_HOST_SIDE = "host_side"
+VERDICT_NOT_ALLOWLISTED = "not_allowlisted"
+VERDICT_UNDETERMINED = "undetermined"
+
+# Order the summary message names verdicts in: failure kinds first.
+_MESSAGE_ORDER = (
+    VERDICT_APP_SIDE,
+    VERDICT_LB_SIDE,
+    VERDICT_HOST_SIDE,
+    VERDICT_NOT_ALLOWLISTED,
+    VERDICT_UNDETERMINED,
+    VERDICT_HEALTHY,
+)
+
+
+def create_linode_nodebalancer_backend_probe_tool() -> tuple[Tool, Capability]:
+    """Create the linode_nodebalancer_backend_probe tool."""
+    return Tool(
+        name="linode_nodebalancer_backend_probe",
+        description=(
+            "Checks each NodeBalancer backend directly from the MCP host, "
+            "running the config's health check (HTTP GET of check_path, or a "
+            "TCP connect), and compares the result with the node status the "
+            "NodeBalancer reports. Each backend gets a verdict: healthy, "
+            "app_side (fails from both sides), lb_side (passes here but the "
+            "NodeBalancer marks it DOWN), or host_side (this host cannot reach "
+            "it). Only addresses inside nodebalancer_probe.allowed_cidrs in "
+            "the config are probed."
+        ),
+        inputSchema=schema("linode.mcp.v1.NodeBalancerBackendProbeInput"),
+    ), Capability.Read
+
+
+def _split_address(address: str) -> tuple[str, int] | None:
+    """Split an "ip:port" (or "[ipv6]:port") address; None when it is not
+    one (Go's netip.ParseAddrPort)."""
+    host, sep, port = address.rpartition(":")
+    if not sep or not port.isdigit() or int(port) > 0xFFFF:
+        return None
+    if host.startswith("[") and host.endswith("]"):
+        host = host[1:-1]
+    elif ":" in host:
+        return None
+    try:
+        ip_address(host)
+    except ValueError:
+        return None
+    return host, int(port)
+
+
+def _backend_verdict(lb_status: str, probe_ok: bool) -> str:
+    """Compare the NodeBalancer's view of a backend with the probe's."""
+    if lb_status == "UP":
+        return VERDICT_HEALTHY if probe_ok else VERDICT_HOST_SIDE
+    if lb_status == "DOWN":
+        return VERDICT_LB_SIDE if probe_ok else VERDICT_APP_SIDE
+    return VERDICT_UNDETERMINED
+
+
+async def _probe_backend(
+    nb_config: dict[str, Any],
+    node: dict[str, Any],
+    allowed: list[IPv4Network | IPv6Network],
+) -> dict[str, Any]:
+    """Probe one backend when its address is inside the allowlist and return
+    it with a verdict (Go's probeNodeBalancerBackend)."""
+    check = str(nb_config.get("check") or CHECK_NONE)
+    address = str(node.get("address") or "")
+    out: dict[str, Any] = {
+        "config_id": nb_config.get("id", 0),
+        "config_port": nb_config.get("port", 0),
+        "node_id": node.get("id", 0),
+        "label": node.get("label", ""),
+        "address": address,
+        "lb_status": node.get("status", ""),
+        "check": check,
+    }
+
+    split = _split_address(address)
+    if split is None:
+        out["error"] = f'address "{address}" is not an ip:port'
+        out["verdict"] = VERDICT_NOT_ALLOWLISTED
+        return out
+    host, port = split
+    ip = ip_address(host)
+    mapped = getattr(ip, "ipv4_mapped", None)
+    if mapped is not None:
+        ip = mapped
+    if not any(ip in network for network in allowed):
+        out["error"] = f"{host} is outside nodebalancer_probe.allowed_cidrs"
+        out["verdict"] = VERDICT_NOT_ALLOWLISTED
+        return out
+
+    result = await probe(
+        Target(
+            host=host,
+            port=port,
+            check=check,
+            path=str(nb_config.get("check_path") or ""),
+            body=str(nb_config.get("check_body") or ""),
+            timeout=float(nb_config.get("check_timeout") or 0),
+        )
+    )
+    out.update(
+        probed=True,
+        probe_method=result.method,
+        probe_url=result.url,
+        probe_ok=result.ok,
+        status_code=result.status_code,
+        latency_ms=result.latency_ms,
+        error=result.error,
+        verdict=_backend_verdict(str(node.get("status") or ""), result.ok),
+    )
+    return out
+
+
+def _probe_message(nodebalancer_id: int, verdicts: dict[str, int]) -> str:
+    """Summarize the verdict counts, naming the failure kinds first."""
+    if not verdicts:
+        return f"NodeBalancer {nodebalancer_id} has no backend nodes to probe"
+    parts = [f"{verdicts[v]} {v}" for v in _MESSAGE_ORDER if verdicts.get(v)]
+    return f"Probed NodeBalancer {nodebalancer_id} backends: {', '.join(parts)}"
+
+
+async def handle_linode_nodebalancer_backend_probe(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_nodebalancer_backend_probe tool request."""
+    nodebalancer_id, message = required_int_id(arguments, "nodebalancer_id")
+    if nodebalancer_id is None:
+        return error_response(message)
+    config_id: int | None = None
+    if "config_id" in arguments:
+        config_id, message = required_int_id(arguments, "config_id")
+        if config_id is None:
+            return error_response(message)
+
+    try:
+        allowed = resolve_config(cfg).nodebalancer_probe.probe_networks()
+    except ConfigInvalidError as exc:
+        return error_response(str(exc))
+    if not allowed:
+        return error_response(_DISABLED_MESSAGE)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        listed = await client.list_nodebalancer_configs(nodebalancer_id)
+        configs = cast("list[dict[str, Any]]", listed.get("data") or [])
+        if config_id is not None:
+            configs = [c for c in configs if c.get("id") == config_id]
+            if not configs:
+                msg = f"NodeBalancer {nodebalancer_id} has no config {config_id}"
+                raise ValueError(msg)
+
+        nodes: list[dict[str, Any]] = []
+        verdicts: dict[str, int] = {}
+        for nb_config in configs:
+            page = await client.list_nodebalancer_config_nodes(
+                nodebalancer_id, int(nb_config.get("id") or 0)
+            )
+            for node in cast("list[dict[str, Any]]", page.get("data") or []):
+                probed = await _probe_backend(nb_config, node, allowed)
+                nodes.append(probed)
+                verdicts[probed["verdict"]] = verdicts.get(probed["verdict"], 0) + 1
+
+        return serialize_api_response(
+            {
+                "nodebalancer_id": nodebalancer_id,
+                "message": _probe_message(nodebalancer_id, verdicts),
+                "node_count": len(nodes),
+                "verdicts": verdicts,
+                "nodes": nodes,
+            },
+            nodebalancer_backend_probe_pb2.NodeBalancerBackendProbeResponse(),
+        )
+
+    return await execute_tool(
+        cfg,
+        arguments,
+        f"probe backends of NodeBalancer {nodebalancer_id}",
+        _call,
+    )
@@ -0,0 +1,302 @@
+"""Linode NodeBalancer node drain and undrain tools.
+
+Mirrors ``go/internal/tools/linode_nodebalancer_node_drain.go``.
+"""
+
+from __future__ import annotations
+
+from contextvars import ContextVar, Token
+from datetime import datetime, timedelta
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import nodebalancer_node_drain_pb2
+from linodemcp.nodedrain import Drain, Key, RestoreFunc, Store
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import (
+    build_dry_run_response,
+    error_response,
+    execute_tool,
+    is_dry_run,
+    pagination_int_argument,
+    required_int_id,
+    store_partition,
+    with_client,
+)
+from linodemcp.tools.proto_enum import enum_choice_error
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_DEFAULT_MODE = "drain"
+_DEFAULT_RESTORE = "accept"
+_MAX_MINUTES = 1440
+_REJECT_MODE = "reject"
+
+_NODE_DRAINS: ContextVar[Store | None] = ContextVar(
+    "linodemcp_node_drains", default=None
+)
+
+
+def set_node_drains(store: Store | None) -> Token[Store | None]:
+    """Publish the server's drain store, returning a token that resets it."""
+    return _NODE_DRAINS.set(store)
+
+
+def reset_node_drains(token: Token[Store | None]) -> None:
+    """Restore the drain store the matching set_node_drains call replaced."""
+    _NODE_DRAINS.reset(token)
+
+
+def node_drains_from_context() -> Store | None:
+    """Return the drain store the server published, or None when unset."""
+    return _NODE_DRAINS.get()
+
+
+def create_linode_nodebalancer_node_drain_tool() -> tuple[Tool, Capability]:
+    """Create the linode_nodebalancer_node_drain tool."""
+    return Tool(
+        name="linode_nodebalancer_node_drain",
+        description=(
+            "Takes a NodeBalancer backend node out of rotation for maintenance: "
+            "sets mode drain (existing connections finish, no new ones) or "
+            "reject, and remembers the mode it had so "
+            "linode_nodebalancer_node_undrain can put it back. With "
+            "duration_minutes the node is restored automatically; the timer "
+            "lives in server memory and a restart cancels it. WARNING: The node "
+            "stops receiving new traffic."
+        ),
+        inputSchema=schema("linode.mcp.v1.NodeBalancerNodeDrainInput"),
+    ), Capability.Write
+
+
+def create_linode_nodebalancer_node_undrain_tool() -> tuple[Tool, Capability]:
+    """Create the linode_nodebalancer_node_undrain tool."""
+    return Tool(
+        name="linode_nodebalancer_node_undrain",
+        description=(
+            "Puts a NodeBalancer backend node back into rotation after "
+            "linode_nodebalancer_node_drain: restores the mode it had before the "
+            "drain, or mode when given (accept when this server did not record "
+            "the drain), and cancels any pending timed restore."
+        ),
+        inputSchema=schema("linode.mcp.v1.NodeBalancerNodeUndrainInput"),
+    ), Capability.Write
+
+
+def _node_key(arguments: dict[str, Any]) -> tuple[Key | None, str]:
+    """Parse the nodebalancer_id, config_id, and node_id a drain or undrain
+    targets, under the caller's store partition (mirrors Go
+    nodeDrainKeyFromTool)."""
+    try:
+        partition = store_partition()
+    except ValueError as exc:
+        return None, str(exc)
+    ids: list[int] = []
+    for name in ("nodebalancer_id", "config_id", "node_id"):
+        value, error = required_int_id(arguments, name)
+        if value is None:
+            return None, error
+        ids.append(value)
+    key = Key(
+        nodebalancer_id=ids[0], config_id=ids[1], node_id=ids[2], partition=partition
+    )
+    return key, ""
+
+
+def _node_path(key: Key) -> str:
+    return (
+        f"/nodebalancers/{key.nodebalancer_id}/configs/{key.config_id}"
+        f"/nodes/{key.node_id}"
+    )
+
+
+def _dry_run(
+    arguments: dict[str, Any], tool_name: str, key: Key, mode: str
+) -> list[TextContent]:
+    return build_dry_run_response(
+        tool_name,
+        arguments.get("environment", ""),
+        "PUT",
+        _node_path(key),
+        {
+            "nodebalancer_id": key.nodebalancer_id,
+            "config_id": key.config_id,
+            "node_id": key.node_id,
+        },
+        request_body={"mode": mode},
+    )
+
+
+def _rfc3339(moment: datetime) -> str:
+    return moment.strftime("%Y-%m-%dT%H:%M:%SZ")
+
+
+def _node_target(key: Key) -> str:
+    return (
+        f"node {key.node_id} for NodeBalancer {key.nodebalancer_id} "
+        f"config {key.config_id}"
+    )
+
+
+def _restorer(cfg: Config, arguments: dict[str, Any]) -> RestoreFunc:
+    """Return the timed restore for drains made with these arguments' Linode
+    environment (mirrors Go nodeDrainRestorer)."""
+
+    async def _restore(drain: Drain) -> None:
+        async def _update(client: RetryableClient) -> None:
+            await client.update_nodebalancer_config_node(
+                drain.key.nodebalancer_id,
+                drain.key.config_id,
+                drain.key.node_id,
+                {"mode": drain.restore_mode},
+            )
+
+        await with_client(cfg, arguments, _update)
+
+    return _restore
+
+
+async def handle_linode_nodebalancer_node_drain(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_nodebalancer_node_drain tool request."""
+    key, error = _node_key(arguments)
+    if key is None:
+        return error_response(error)
+    mode = arguments.get("mode") or ""
+    mode_error = enum_choice_error(
+        mode, "mode", nodebalancer_node_drain_pb2.NodeBalancerNodeDrainMode.Value
+    )
+    if mode_error is not None:
+        return error_response(mode_error)
+    mode = str(mode or _DEFAULT_MODE)
+    try:
+        minutes = pagination_int_argument(
+            arguments, "duration_minutes", 1, _MAX_MINUTES
+        )
+    except (TypeError, ValueError) as exc:
+        return error_response(str(exc))
+
+    if is_dry_run(arguments):
+        return _dry_run(arguments, "linode_nodebalancer_node_drain", key, mode)
+    if arguments.get("confirm") is not True:
+        return error_response(
+            "This takes a NodeBalancer node out of rotation. "
+            "Set confirm=true to proceed."
+        )
+
+    store = node_drains_from_context()
+    if store is None and minutes:
+        return error_response(
+            "duration_minutes needs the server's drain store, "
+            "which this call does not have"
+        )
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        current = await client.get_nodebalancer_config_node(
+            key.nodebalancer_id, key.config_id, key.node_id
+        )
+        previous = str(current.get("mode") or "")
+        response: dict[str, Any] = {
+            "previous_mode": previous,
+            "restore_mode": previous,
+            "warnings": [],
+        }
+        recorded = store is not None and store.get(key) is not None
+        if not recorded and previous in (_DEFAULT_MODE, _REJECT_MODE):
+            response["restore_mode"] = _DEFAULT_RESTORE
+            response["warnings"].append(
+                f"node {key.node_id} was already in {previous} mode and this "
+                "server has no record of draining it, so undrain restores "
+                f"{_DEFAULT_RESTORE} unless given a mode"
+            )
+
+        response["node"] = await client.update_nodebalancer_config_node(
+            key.nodebalancer_id, key.config_id, key.node_id, {"mode": mode}
+        )
+
+        if store is not None:
+            drain = store.put(
+                Drain(key=key, mode=mode, restore_mode=response["restore_mode"]),
+                timedelta(minutes=minutes) if minutes else None,
+                _restorer(cfg, arguments),
+            )
+            response["restore_mode"] = drain.restore_mode
+            if drain.restore_at is not None:
+                response["restore_at"] = _rfc3339(drain.restore_at)
+
+        message = (
+            f"NodeBalancer node {key.node_id} is now in {mode} mode; "
+            "linode_nodebalancer_node_undrain restores "
+            f"{response['restore_mode']}"
+        )
+        if "restore_at" in response:
+            message = (
+                f"{message}, and it is restored automatically at "
+                f"{response['restore_at']} unless the server restarts first"
+            )
+        response["message"] = message
+        return serialize_api_response(
+            response, nodebalancer_node_drain_pb2.NodeBalancerNodeDrainResponse()
+        )
+
+    return await execute_tool(cfg, arguments, f"drain {_node_target(key)}", _call)
+
+
+async def handle_linode_nodebalancer_node_undrain(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_nodebalancer_node_undrain tool request."""
+    key, error = _node_key(arguments)
+    if key is None:
+        return error_response(error)
+    mode = arguments.get("mode") or ""
+    mode_error = enum_choice_error(
+        mode, "mode", nodebalancer_node_drain_pb2.NodeBalancerNodeRestoreMode.Value
+    )
+    if mode_error is not None:
+        return error_response(mode_error)
+
+    store = node_drains_from_context()
+    drain = store.get(key) if store is not None else None
+    if not mode:
+        mode = drain.restore_mode if drain is not None else _DEFAULT_RESTORE
+    mode = str(mode)
+
+    if is_dry_run(arguments):
+        return _dry_run(arguments, "linode_nodebalancer_node_undrain", key, mode)
+    if arguments.get("confirm") is not True:
+        return error_response(
+            "This puts a NodeBalancer node back into rotation. "
+            "Set confirm=true to proceed."
+        )
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        node = await client.update_nodebalancer_config_node(
+            key.nodebalancer_id, key.config_id, key.node_id, {"mode": mode}
+        )
+        response: dict[str, Any] = {
+            "message": f"NodeBalancer node {key.node_id} is back in {mode} mode",
+            "node": node,
+            "restored_mode": mode,
+            "recorded_drain": drain is not None,
+            "warnings": [],
+        }
+        if drain is not None and store is not None:
+            store.take(key)
+            if drain.restore_at is not None:
+                response["cancelled_restore_at"] = _rfc3339(drain.restore_at)
+            if drain.restore_error:
+                response["warnings"].append(
+                    f"the timed restore had failed: {drain.restore_error}"
+                )
+        return serialize_api_response(
+            response, nodebalancer_node_drain_pb2.NodeBalancerNodeUndrainResponse()
+        )
+
+    return await execute_tool(cfg, arguments, f"update {_node_target(key)}", _call)
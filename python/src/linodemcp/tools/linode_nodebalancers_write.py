@@ -533,16 +533,20 @@ def create_linode_nodebalancer_update_tool() -> tuple[Tool, Capability]:
     """Create the linode_nodebalancer_update tool."""
     return Tool(
         name="linode_nodebalancer_update",
-        description="Updates an existing NodeBalancer.",
+        description=(
+            "Updates an existing NodeBalancer. Can modify label, connection "
+            "throttle, and tags."
+        ),
         inputSchema=schema("linode.mcp.v1.NodeBalancerUpdateInput"),
     ), Capability.Write
 
 
 def _nodebalancer_update_side_effects(
-    state: Any, new_label: Any, new_throttle: Any
+    state: Any, new_label: Any, new_throttle: Any, new_tags: Any
 ) -> DryRunDetails:
     """Phase 2 Tier B walk for NodeBalancer update. Reports the label change
-    and a connection-throttle change against the fetched state.
+    and a connection-throttle change against the fetched state, and notes
+    when the tag set is replaced.
     """
     side_effects: list[str] = []
     if new_label:
@@ -556,6 +560,10 @@ def _nodebalancer_update_side_effects(
             f"Connection throttle is set to {new_throttle} connections per "
             "second per client IP."
         )
+    if new_tags is not None:
+        side_effects.append(
+            "The NodeBalancer's tag set is replaced with the provided tags."
+        )
     return {"side_effects": side_effects} if side_effects else {}
 
 
@@ -575,7 +583,10 @@ async def handle_linode_nodebalancer_update(
 
         async def _walk(_client: RetryableClient, state: Any) -> DryRunDetails:
             return _nodebalancer_update_side_effects(
-                state, arguments.get("label"), arguments.get("client_conn_throttle")
+                state,
+                arguments.get("label"),
+                arguments.get("client_conn_throttle"),
+                arguments.get("tags"),
             )
 
         return await execute_dry_run(
@@ -598,6 +609,7 @@ async def handle_linode_nodebalancer_update(
             nodebalancer_id=int(nodebalancer_id),
             label=arguments.get("label"),
             client_conn_throttle=arguments.get("client_conn_throttle"),
+            tags=arguments.get("tags"),
         )
         return serialize_api_response(
             {
@@ -1080,7 +1092,8 @@ def _config_body_error(
     Mirrors the checks Go performs in nodeBalancerConfig{Create,Update}RequestFromTool:
     port-required (create only), port range 1-65535, the protocol/algorithm/
     stickiness/check/cipher_suite/proxy_protocol choice enums, the
-    ssl-cert/key-when-https requirement, and update's at-least-one-field rule
+    ssl-cert/key-when-https requirement, the protocol-dependent combinations
+    (_config_combo_error), and update's at-least-one-field rule
     (require_field). The choice-enum value sets come from the generated proto
     enums (the same source the JSON Schema and the Go handler use), so they match
     the live API exactly. This replaces an earlier state where only `check` was
@@ -1104,6 +1117,13 @@ def _config_body_error(
         not arguments.get("ssl_cert") or not arguments.get("ssl_key")
     ):
         return "ssl_cert and ssl_key are required when protocol is https"
+    # Create defaults the protocol to http; an update without one keeps the
+    # config's current protocol, which only the API knows.
+    protocol = str(arguments.get("protocol") or ("http" if require_port else ""))
+    if protocol:
+        combo_error = _config_combo_error(protocol, arguments)
+        if combo_error is not None:
+            return combo_error
     if require_field and not any(
         arguments.get(key) is not None for key in NODE_CONFIG_UPDATE_FIELDS
     ):
@@ -1111,6 +1131,47 @@ def _config_body_error(
     return None
 
 
+def _config_combo_error(protocol: str, arguments: dict[str, Any]) -> str | None:
+    """Reject choices the protocol does not accept (Go's
+    nodeBalancerConfigComboMessage, same order and text).
+
+    UDP configs balance with roundrobin, leastconn, or ring_hash and stick by
+    session or source_ip; TCP, HTTP, and HTTPS configs balance with roundrobin,
+    leastconn, or source and stick by table, or by http_cookie over HTTP(S).
+    Only TCP speaks the PROXY protocol, and only UDP has a udp_check_port.
+    """
+    is_udp = protocol == "udp"
+    algorithm = arguments.get("algorithm")
+    stickiness = arguments.get("stickiness")
+    proxy_protocol = arguments.get("proxy_protocol")
+    if is_udp and algorithm == "source":
+        return (
+            "algorithm source is not supported with protocol udp "
+            "(use roundrobin, leastconn, or ring_hash)"
+        )
+    if not is_udp and algorithm == "ring_hash":
+        return f"algorithm ring_hash requires protocol udp (protocol is {protocol})"
+    if is_udp and stickiness in ("table", "http_cookie"):
+        return (
+            f"stickiness {stickiness} is not supported with protocol udp "
+            "(use none, session, or source_ip)"
+        )
+    if not is_udp and stickiness in ("session", "source_ip"):
+        return f"stickiness {stickiness} requires protocol udp (protocol is {protocol})"
+    if protocol == "tcp" and stickiness == "http_cookie":
+        return (
+            "stickiness http_cookie requires protocol http or https (protocol is tcp)"
+        )
+    if protocol != "tcp" and proxy_protocol and proxy_protocol != "none":
+        return (
+            f"proxy_protocol {proxy_protocol} requires protocol tcp "
+            f"(protocol is {protocol})"
+        )
+    if not is_udp and arguments.get("udp_check_port") is not None:
+        return f"udp_check_port requires protocol udp (protocol is {protocol})"
+    return None
+
+
 def create_linode_nodebalancer_config_create_tool() -> tuple[Tool, Capability]:
     """Create the linode_nodebalancer_config_create tool."""
     return Tool(
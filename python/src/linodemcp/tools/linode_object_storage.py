@@ -14,6 +14,7 @@ from linodemcp.genpb.linode.mcp.v1 import (
 )
 from linodemcp.profiles import Capability
 from linodemcp.tools.helpers import execute_tool
+from linodemcp.tools.proto_enum import optional_enum_error
 from linodemcp.tools.proto_response import (
     serialize_api_response,
     serialize_list_response,
@@ -34,12 +35,12 @@ _BUCKET_LABEL_RE = re.compile(r"^[a-z0-9][a-z0-9-]*[a-z0-9]$|^[a-z0-9]{1,2}$")
 _MAX_BUCKET_LABEL_LENGTH = 63
 
 
-def _valid_cluster_id(cluster_id: str) -> bool:
+def valid_cluster_id(cluster_id: str) -> bool:
     """Return whether a cluster ID is safe for the legacy cluster route."""
     return bool(_CLUSTER_ID_RE.fullmatch(cluster_id))
 
 
-def _valid_bucket_label(label: str) -> bool:
+def valid_bucket_label(label: str) -> bool:
     """Return whether a bucket label is safe for Object Storage bucket routes."""
     return (
         bool(_BUCKET_LABEL_RE.fullmatch(label))
@@ -91,7 +92,7 @@ async def handle_linode_object_storage_bucket_by_region_list(
 
     if not region:
         return _error_response("region is required")
-    if not isinstance(region, str) or not _valid_cluster_id(region):
+    if not isinstance(region, str) or not valid_cluster_id(region):
         return _error_response("region must be a valid region or cluster ID")
 
     async def _call(client: RetryableClient) -> dict[str, Any]:
@@ -125,11 +126,11 @@ async def handle_linode_object_storage_bucket_get(
 
     if not region:
         return _error_response("region is required")
-    if not isinstance(region, str) or not _valid_cluster_id(region):
+    if not isinstance(region, str) or not valid_cluster_id(region):
         return _error_response("region must be a valid region or cluster ID")
     if not label:
         return _error_response("label is required")
-    if not isinstance(label, str) or not _valid_bucket_label(label):
+    if not isinstance(label, str) or not valid_bucket_label(label):
         return _error_response("label must be a valid bucket label")
 
     async def _call(client: RetryableClient) -> dict[str, Any]:
@@ -169,6 +170,49 @@ def _build_bucket_params(
     return params
 
 
+LISTING_FORMAT_JSON = "json"
+LISTING_FORMAT_CSV = "csv"
+
+# CSV header for a bucket listing.
+_OBJECT_LISTING_COLUMNS = (
+    "name",
+    "size",
+    "last_modified",
+    "etag",
+    "owner",
+    "is_prefix",
+)
+
+
+def _csv_field(value: str) -> str:
+    """Quote a CSV field the way Go's encoding/csv does, so both servers
+    render byte-identical tables."""
+    needs_quotes = value == "\\." or (
+        value != ""
+        and (any(c in value for c in ',"\r\n') or value[0].isspace())
+    )
+    if not needs_quotes:
+        return value
+    return '"' + value.replace('"', '""') + '"'
+
+
+def object_listing_csv(objects: list[dict[str, Any]]) -> str:
+    """Render raw bucket objects as CSV with a header row."""
+    rows = [_OBJECT_LISTING_COLUMNS]
+    rows.extend(
+        (
+            str(obj.get("name") or ""),
+            str(int(obj.get("size") or 0)),
+            str(obj.get("last_modified") or ""),
+            str(obj.get("etag") or ""),
+            str(obj.get("owner") or ""),
+            "true" if obj.get("is_prefix") else "false",
+        )
+        for obj in objects
+    )
+    return "".join(",".join(_csv_field(f) for f in row) + "\n" for row in rows)
+
+
 async def handle_linode_object_storage_bucket_object_list(
     arguments: dict[str, Any], cfg: Config
 ) -> list[TextContent]:
@@ -182,12 +226,17 @@ async def handle_linode_object_storage_bucket_object_list(
 
     if not region:
         return _error_response("region is required")
-    if not isinstance(region, str) or not _valid_cluster_id(region):
+    if not isinstance(region, str) or not valid_cluster_id(region):
         return _error_response("region must be a valid region or cluster ID")
     if not label:
         return _error_response("label is required")
-    if not isinstance(label, str) or not _valid_bucket_label(label):
+    if not isinstance(label, str) or not valid_bucket_label(label):
         return _error_response("label must be a valid bucket label")
+    format_error = optional_enum_error(
+        arguments, "format", object_storage_pb2.ObjectStorageListingFormat.Value
+    )
+    if format_error is not None:
+        return _error_response(format_error)
 
     async def _call(client: RetryableClient) -> dict[str, Any]:
         params = _build_bucket_params(prefix, delimiter, marker, page_size)
@@ -215,6 +264,12 @@ async def handle_linode_object_storage_bucket_object_list(
         if filters:
             wrapper["filter"] = ", ".join(filters)
 
+        # With format=csv the page goes in the csv field instead of objects,
+        # so a large page reads as one table.
+        if arguments.get("format") == LISTING_FORMAT_CSV:
+            wrapper["csv"] = object_listing_csv(objects)
+            del wrapper["objects"]
+
         return serialize_api_response(
             wrapper,
             object_storage_pb2.ObjectStorageObjectListResponse(),
@@ -259,7 +314,11 @@ def create_linode_object_storage_endpoint_list_tool() -> tuple[Tool, Capability]
     """Create the linode_object_storage_endpoint_list tool."""
     return Tool(
         name="linode_object_storage_endpoint_list",
-        description="Lists Object Storage endpoints available to your account.",
+        description=(
+            "Lists Object Storage endpoints across regions with each endpoint's "
+            "type (E0 through E3) and S3 hostname. The regions listed are the "
+            "valid bucket regions. Can filter by region or endpoint_type."
+        ),
         inputSchema=schema("linode.mcp.v1.ObjectStorageEndpointListInput"),
     ), Capability.Read
 
@@ -267,7 +326,26 @@ def create_linode_object_storage_endpoint_list_tool() -> tuple[Tool, Capability]
 async def handle_linode_object_storage_endpoint_list(
     arguments: dict[str, Any], cfg: Config
 ) -> list[TextContent]:
-    """Handle linode_object_storage_endpoint_list tool request."""
+    """Handle linode_object_storage_endpoint_list tool request.
+
+    region and endpoint_type are case-insensitive exact matches, mirroring the
+    Go list tool's fieldFilter.
+    """
+    region_filter = arguments.get("region", "")
+    type_filter = arguments.get("endpoint_type", "")
+
+    def _matches(endpoint: dict[str, Any]) -> bool:
+        region = str(endpoint.get("region", ""))
+        if region_filter and region.lower() != region_filter.lower():
+            return False
+        endpoint_type = str(endpoint.get("endpoint_type", ""))
+        return not (type_filter and endpoint_type.lower() != type_filter.lower())
+
+    applied: list[str] = []
+    if region_filter:
+        applied.append(f"region={region_filter}")
+    if type_filter:
+        applied.append(f"endpoint_type={type_filter}")
 
     async def _call(client: RetryableClient) -> dict[str, Any]:
         endpoints = await client.list_object_storage_endpoints()
@@ -275,6 +353,8 @@ async def handle_linode_object_storage_endpoint_list(
             {"data": endpoints},
             "endpoints",
             object_storage_pb2.ObjectStorageEndpointListResponse(),
+            filter_value=", ".join(applied) if applied else None,
+            item_filter=_matches,
         )
 
     return await execute_tool(
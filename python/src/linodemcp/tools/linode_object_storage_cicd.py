@@ -0,0 +1,197 @@
+"""linode_object_storage_cicd_credentials: least-privilege keys for CI.
+
+The tool creates a limited access key that can reach one bucket, labeled
+"ci-<name> expires=<date>" so the date it should be revoked travels with
+the key. Linode does not expire keys itself.
+
+Mirrors ``go/internal/tools/linode_object_storage_cicd.go``.
+"""
+
+from __future__ import annotations
+
+from datetime import UTC, datetime, timedelta
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import object_storage_cicd_pb2, object_storage_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import (
+    build_dry_run_response,
+    error_response,
+    execute_tool,
+    is_dry_run,
+)
+from linodemcp.tools.label_namespace import _label_namespace_prefix
+from linodemcp.tools.linode_object_storage_write import _MAX_KEY_LABEL_LENGTH
+from linodemcp.tools.proto_enum import required_enum_error
+from linodemcp.tools.proto_response import raw_int, raw_str, serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+# Starts the label of every key the tool creates, after any label_namespace;
+# the expiry tag and the UTC expiry date end it. Mirrors Go's
+# cicdKeyLabelPrefix and cicdKeyExpiryTag.
+_LABEL_PREFIX = "ci-"
+_EXPIRY_TAG = " expires="
+_MAX_DAYS = 365
+
+
+def create_linode_object_storage_cicd_credentials_tool() -> tuple[Tool, Capability]:
+    """Create the linode_object_storage_cicd_credentials tool."""
+    return Tool(
+        name="linode_object_storage_cicd_credentials",
+        description=(
+            "Creates a limited Object Storage access key for a CI pipeline in one "
+            "call: the key can reach only bucket in region, with read_only or "
+            'read_write permissions, and is labeled "ci-<name> expires=<date>" '
+            "(after the environment's label_namespace) with the UTC date "
+            "expires_in_days from now. Linode does not expire keys, so revoke it "
+            "with linode_object_storage_key_delete once that date passes. Linode "
+            "scopes limited keys to whole buckets; there is no per-prefix grant. "
+            "WARNING: The secret_key is only shown ONCE in the response. Pass "
+            "dry_run=true to preview."
+        ),
+        inputSchema=schema("linode.mcp.v1.ObjectStorageCICDCredentialsInput"),
+    ), Capability.Write
+
+
+def _parse_args(arguments: dict[str, Any]) -> tuple[dict[str, Any], str]:
+    """Validate the input; mirrors Go's parseCICDCredentialsArgs."""
+    bucket = str(arguments.get("bucket") or "").strip()
+    if not bucket:
+        return {}, "bucket is required"
+
+    region = str(arguments.get("region") or "").strip()
+    if not region:
+        return {}, "region is required"
+
+    enum_error = required_enum_error(
+        arguments, "permissions", object_storage_pb2.ObjectStorageKeyPermission.Value
+    )
+    if enum_error:
+        return {}, enum_error
+
+    if "expires_in_days" not in arguments:
+        return {}, "expires_in_days is required"
+    days = arguments["expires_in_days"]
+    if (
+        isinstance(days, bool)
+        or not isinstance(days, int)
+        or not 1 <= days <= _MAX_DAYS
+    ):
+        return {}, f"expires_in_days must be between 1 and {_MAX_DAYS}"
+
+    name = str(arguments.get("name") or "").strip() or bucket
+
+    return {
+        "bucket": bucket,
+        "region": region,
+        "permissions": arguments["permissions"],
+        "days": days,
+        "name": name,
+    }, ""
+
+
+def _expires_on(args: dict[str, Any]) -> str:
+    """The UTC date args' days from now, as the label records it."""
+    return (datetime.now(UTC) + timedelta(days=args["days"])).date().isoformat()
+
+
+def _key_label(
+    namespace: str, args: dict[str, Any], expires_on: str
+) -> tuple[str, str]:
+    """Build the key label, checking it fits the key label limit."""
+    label = f"{namespace}{_LABEL_PREFIX}{args['name']}{_EXPIRY_TAG}{expires_on}"
+    if len(label) > _MAX_KEY_LABEL_LENGTH:
+        return "", (
+            f'key label "{label}" is {len(label)} characters, over the '
+            f"{_MAX_KEY_LABEL_LENGTH}-character limit; pass a shorter name"
+        )
+    return label, ""
+
+
+async def handle_linode_object_storage_cicd_credentials(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle the linode_object_storage_cicd_credentials tool."""
+    environment = arguments.get("environment", "")
+
+    if is_dry_run(arguments):
+        args, args_error = _parse_args(arguments)
+        if args_error:
+            return error_response(args_error)
+        namespace = _label_namespace_prefix(cfg, environment)
+        _, label_error = _key_label(namespace, args, _expires_on(args))
+        if label_error:
+            return error_response(label_error)
+        return build_dry_run_response(
+            "linode_object_storage_cicd_credentials",
+            environment,
+            "POST",
+            "/object-storage/keys",
+            None,
+            side_effects=[
+                f"A new access key labeled {namespace}{_LABEL_PREFIX}{args['name']}"
+                f" will be created with {args['permissions']} access to bucket"
+                f" {args['bucket']} in {args['region']} only, its label recording"
+                f" an expiry {args['days']} day(s) after the call."
+            ],
+            warnings=["The secret key is returned only once, at creation time."],
+        )
+
+    if not arguments.get("confirm"):
+        return error_response(
+            "This creates an access key. The secret_key is only shown ONCE"
+            " in the response. Set confirm=true to proceed."
+        )
+
+    args, args_error = _parse_args(arguments)
+    if args_error:
+        return error_response(args_error)
+
+    expires_on = _expires_on(args)
+    label, label_error = _key_label(
+        _label_namespace_prefix(cfg, environment), args, expires_on
+    )
+    if label_error:
+        return error_response(label_error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        key = await client.create_object_storage_key(
+            label=label,
+            bucket_access=[
+                {
+                    "bucket_name": args["bucket"],
+                    "region": args["region"],
+                    "permissions": args["permissions"],
+                }
+            ],
+        )
+        endpoint = ""
+        for region in key.get("regions") or []:
+            if isinstance(region, dict) and region.get("id") == args["region"]:
+                endpoint = str(region.get("s3_endpoint") or "")
+        return serialize_api_response(
+            {
+                "message": (
+                    f"Access key '{raw_str(key, 'label')}' (ID: {raw_int(key, 'id')})"
+                    f" grants {args['permissions']} on bucket {args['bucket']} in"
+                    f" {args['region']}; revoke it with"
+                    f" linode_object_storage_key_delete after {expires_on}."
+                ),
+                "warning": (
+                    "IMPORTANT: The secret_key below is shown ONLY ONCE. "
+                    "Save it now - it cannot be retrieved later."
+                ),
+                "key": key,
+                "expires_on": expires_on,
+                "s3_endpoint": endpoint,
+            },
+            object_storage_cicd_pb2.ObjectStorageCICDCredentialsResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "create access key", _call)
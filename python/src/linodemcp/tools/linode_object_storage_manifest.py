@@ -0,0 +1,215 @@
+"""Linode Object Storage bucket manifest export.
+
+Mirrors Go's tools/linode_object_storage_manifest.go: the bucket listing is
+collected page by page, rendered as CSV or JSON, and written back into the
+bucket through a presigned PUT URL.
+"""
+
+from __future__ import annotations
+
+import json
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import object_storage_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+)
+from linodemcp.tools.linode_object_storage import (
+    LISTING_FORMAT_CSV,
+    LISTING_FORMAT_JSON,
+    object_listing_csv,
+    valid_bucket_label,
+    valid_cluster_id,
+)
+from linodemcp.tools.proto_enum import optional_enum_error
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+# Caps one manifest export. The listing is held in memory and uploaded as a
+# single PUT, so a larger bucket must be split by prefix.
+_MAX_MANIFEST_OBJECTS = 100000
+# The largest page the object-list endpoint serves.
+_MANIFEST_PAGE_SIZE = "500"
+# How long the presigned upload URL lives; the upload starts as soon as the
+# URL is issued.
+_MANIFEST_URL_EXPIRY_SECONDS = 300
+
+
+def create_linode_object_storage_bucket_manifest_export_tool() -> tuple[
+    Tool, Capability
+]:
+    """Create the linode_object_storage_bucket_manifest_export tool."""
+    return Tool(
+        name="linode_object_storage_bucket_manifest_export",
+        description=(
+            "Lists every object in an Object Storage bucket (optionally under a"
+            " prefix) and writes the listing into the bucket as a CSV or JSON"
+            " manifest object through a presigned PUT URL. Use it for inventory"
+            " or compliance exports too large to return in a tool result."
+            " Requires confirm=true to proceed."
+        ),
+        inputSchema=schema("linode.mcp.v1.ObjectStorageBucketManifestExportInput"),
+    ), Capability.Write
+
+
+def _manifest_export_error(arguments: dict[str, Any]) -> str | None:
+    """Validate manifest export args; return an error message or None. Shared
+    by the real path and the dry-run preview."""
+    region = arguments.get("region", "")
+    label = arguments.get("label", "")
+    if not region:
+        return "region is required"
+    if not isinstance(region, str) or not valid_cluster_id(region):
+        return "region must be a valid region or cluster ID"
+    if not label:
+        return "label is required"
+    if not isinstance(label, str) or not valid_bucket_label(label):
+        return "label must be a valid bucket label"
+    if not arguments.get("key"):
+        return "key is required"
+    return optional_enum_error(
+        arguments, "format", object_storage_pb2.ObjectStorageListingFormat.Value
+    )
+
+
+async def _collect_bucket_objects(
+    client: RetryableClient, region: str, label: str, prefix: str
+) -> list[dict[str, Any]]:
+    """Page through the bucket listing under prefix and return every object,
+    failing once the listing passes _MAX_MANIFEST_OBJECTS."""
+    objects: list[dict[str, Any]] = []
+    marker = ""
+    while True:
+        params = {"page_size": _MANIFEST_PAGE_SIZE}
+        if prefix:
+            params["prefix"] = prefix
+        if marker:
+            params["marker"] = marker
+        page = await client.list_object_storage_bucket_contents(region, label, params)
+        objects.extend(page.get("data", []))
+        if len(objects) > _MAX_MANIFEST_OBJECTS:
+            msg = (
+                "bucket listing is too large for one manifest: more than"
+                f" {_MAX_MANIFEST_OBJECTS} objects, narrow it with prefix"
+            )
+            raise ValueError(msg)
+        marker = page.get("next_marker") or ""
+        if not page.get("is_truncated") or not marker:
+            return objects
+
+
+def _summarize_manifest_listing(
+    objects: list[dict[str, Any]], key: str
+) -> dict[str, Any]:
+    return {
+        "objects": len(objects),
+        "total_size": sum(int(obj.get("size") or 0) for obj in objects),
+        "key_exists": any(obj.get("name") == key for obj in objects),
+    }
+
+
+def _render_manifest(objects: list[dict[str, Any]], fmt: str) -> tuple[bytes, str]:
+    """Encode objects in fmt; return the bytes and the upload content type."""
+    if fmt == LISTING_FORMAT_JSON:
+        listing = serialize_api_response(
+            {"count": len(objects), "objects": objects},
+            object_storage_pb2.ObjectStorageObjectListResponse(),
+        )
+        return json.dumps(listing, indent=2).encode(), "application/json"
+    return object_listing_csv(objects).encode(), "text/csv"
+
+
+async def handle_linode_object_storage_bucket_manifest_export(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_object_storage_bucket_manifest_export tool request."""
+    region = arguments.get("region", "")
+    label = arguments.get("label", "")
+    prefix = arguments.get("prefix", "")
+    key = arguments.get("key", "")
+    fmt = arguments.get("format") or LISTING_FORMAT_CSV
+
+    if is_dry_run(arguments):
+        validation_err = _manifest_export_error(arguments)
+        if validation_err is not None:
+            return error_response(validation_err)
+
+        async def _fetch(client: RetryableClient) -> Any:
+            objects = await _collect_bucket_objects(client, region, label, prefix)
+            return _summarize_manifest_listing(objects, key)
+
+        async def _walk(_client: RetryableClient, state: Any) -> DryRunDetails:
+            details: DryRunDetails = {
+                "side_effects": [
+                    f"Writes a {fmt} manifest of {state['objects']} objects"
+                    f" to {key} in bucket {label}"
+                ]
+            }
+            if state["key_exists"]:
+                details["warnings"] = [
+                    f"Object {key} already exists and will be overwritten"
+                ]
+            return details
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_object_storage_bucket_manifest_export",
+            "POST",
+            f"/object-storage/buckets/{region}/{label}/object-url",
+            _fetch,
+            _walk,
+        )
+
+    if not arguments.get("confirm"):
+        return error_response(
+            "This writes a manifest object into the bucket. "
+            "Set confirm=true to proceed."
+        )
+
+    validation_err = _manifest_export_error(arguments)
+    if validation_err is not None:
+        return error_response(validation_err)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        objects = await _collect_bucket_objects(client, region, label, prefix)
+        data, content_type = _render_manifest(objects, fmt)
+        presigned = await client.create_presigned_url(
+            region,
+            label,
+            key,
+            "PUT",
+            _MANIFEST_URL_EXPIRY_SECONDS,
+            content_type,
+        )
+        await client.put_presigned_object(
+            str(presigned.get("url", "")), content_type, data
+        )
+        summary = _summarize_manifest_listing(objects, key)
+        return serialize_api_response(
+            {
+                "message": (
+                    f"Manifest of {summary['objects']} objects written to"
+                    f" '{key}' in bucket '{label}'"
+                ),
+                "key": key,
+                "format": fmt,
+                "objects": summary["objects"],
+                "total_size": summary["total_size"],
+                "manifest_size": len(data),
+            },
+            object_storage_pb2.ObjectStorageBucketManifestExportResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "export bucket manifest", _call)
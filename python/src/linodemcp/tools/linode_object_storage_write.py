@@ -2,6 +2,7 @@
 
 from __future__ import annotations
 
+import asyncio
 import json
 import re
 from typing import TYPE_CHECKING, Any
@@ -15,7 +16,9 @@ from linodemcp.genpb.linode.mcp.v1 import (
     object_acl_pb2,
     object_storage_pb2,
 )
+from linodemcp.linode import APIError, NetworkError
 from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
 from linodemcp.tools.helpers import (
     TWO_STAGE_NOTE,
     DryRunDetails,
@@ -140,6 +143,108 @@ def _bucket_create_error(label: str, region: str, acl: Any) -> str | None:
     return None
 
 
+async def _bucket_region_error(client: RetryableClient, region: str) -> str | None:
+    """Check region against the Object Storage endpoints; mirrors Go's
+    bucketRegionError. A failed or empty listing skips the check, since the
+    API still rejects a region it does not serve; a failed one is a warning."""
+    try:
+        endpoints = await client.list_object_storage_endpoints()
+    except (APIError, NetworkError) as e:
+        add_warning(
+            "region %s was not checked against the Object Storage endpoints: %s",
+            json.dumps(region),
+            e,
+        )
+        return None
+    regions = {str(endpoint.get("region", "")) for endpoint in endpoints}
+    if not regions or region.lower() in {r.lower() for r in regions}:
+        return None
+    return (
+        f'region "{region}" has no Object Storage endpoint; valid regions: '
+        f"{', '.join(sorted(regions))} (see linode_object_storage_endpoint_list)"
+    )
+
+
+# The region value that has bucket create pick the region.
+_BUCKET_REGION_AUTO = "auto"
+
+# Bounds each endpoint connection timed by region=auto, in seconds.
+_BUCKET_ENDPOINT_PROBE_TIMEOUT = 3.0
+
+
+async def _probe_bucket_endpoint(host: str) -> float | None:
+    """Time a TCP connection to an S3 endpoint hostname (port 443 unless it
+    carries one); None when there is no hostname or the connection fails."""
+    if not host:
+        return None
+    name, sep, port = host.rpartition(":")
+    if not sep or not port.isdigit():
+        name, port = host, "443"
+    loop = asyncio.get_running_loop()
+    start = loop.time()
+    try:
+        _, writer = await asyncio.wait_for(
+            asyncio.open_connection(name, int(port)),
+            _BUCKET_ENDPOINT_PROBE_TIMEOUT,
+        )
+    except (OSError, TimeoutError):
+        return None
+    elapsed = loop.time() - start
+    writer.close()
+    return elapsed
+
+
+async def _pick_bucket_region(
+    client: RetryableClient, preferred: list[str]
+) -> tuple[str, str]:
+    """Resolve region=auto; mirrors Go's pickBucketRegion. Returns the region
+    and how it was chosen, raising ValueError when none can be picked."""
+    try:
+        endpoints = await client.list_object_storage_endpoints()
+    except (APIError, NetworkError) as e:
+        msg = f"Failed to list Object Storage endpoints for region=auto: {e}"
+        raise ValueError(msg) from e
+    hosts: dict[str, str] = {}
+    for endpoint in endpoints:
+        region = str(endpoint.get("region", ""))
+        if not hosts.get(region):
+            hosts[region] = str(endpoint.get("s3_endpoint") or "")
+    if not hosts:
+        msg = "region=auto found no Object Storage endpoints; pass an explicit region"
+        raise ValueError(msg)
+    for want in preferred:
+        for candidate in hosts:
+            if candidate.lower() == want.lower():
+                return (
+                    candidate,
+                    "first object_storage.preferred_regions entry with an endpoint",
+                )
+    if preferred:
+        add_warning(
+            "no object_storage.preferred_regions entry (%s) has an Object Storage "
+            "endpoint; picked by latency instead",
+            ", ".join(preferred),
+        )
+    regions = list(hosts)
+    latencies = await asyncio.gather(
+        *(_probe_bucket_endpoint(hosts[r]) for r in regions)
+    )
+    reachable = [(lat, i) for i, lat in enumerate(latencies) if lat is not None]
+    if not reachable:
+        msg = (
+            "region=auto could not reach any Object Storage endpoint; pass an "
+            f"explicit region (valid regions: {', '.join(sorted(regions))})"
+        )
+        raise ValueError(msg)
+    latency, best = min(reachable)
+    region = regions[best]
+    return (
+        region,
+        f"lowest endpoint latency ({hosts[region]} answered in "
+        f"{int(latency * 1000)}ms)",
+    )
+
+
 async def handle_linode_object_storage_bucket_create(
     arguments: dict[str, Any], cfg: Config
 ) -> list[TextContent]:
@@ -153,6 +258,12 @@ async def handle_linode_object_storage_bucket_create(
         validation_err = _bucket_create_error(label, region, acl)
         if validation_err:
             return _error_response(validation_err)
+        where = region
+        if region.lower() == _BUCKET_REGION_AUTO:
+            where = (
+                "the region picked at apply time (first configured preferred "
+                "region with an endpoint, else lowest endpoint latency)"
+            )
         return build_dry_run_response(
             "linode_object_storage_bucket_create",
             arguments.get("environment", ""),
@@ -160,7 +271,7 @@ async def handle_linode_object_storage_bucket_create(
             "/object-storage/buckets",
             None,
             side_effects=[
-                f"A new Object Storage bucket {label!r} will be created in {region}."
+                f"A new Object Storage bucket {label!r} will be created in {where}."
             ],
             warnings=["Billing for Object Storage starts immediately on creation."],
         )
@@ -175,21 +286,33 @@ async def handle_linode_object_storage_bucket_create(
         return _error_response(validation_err)
 
     async def _call(client: RetryableClient) -> dict[str, Any]:
+        target = region
+        selection = None
+        if region.lower() == _BUCKET_REGION_AUTO:
+            target, selection = await _pick_bucket_region(
+                client, cfg.object_storage.preferred_regions
+            )
+        else:
+            region_err = await _bucket_region_error(client, region)
+            if region_err:
+                raise ValueError(region_err)
         bucket = await client.create_object_storage_bucket(
             label=label,
-            region=region,
+            region=target,
             acl=acl,
             cors_enabled=cors_enabled,
         )
+        response: dict[str, Any] = {
+            "message": (
+                f"Bucket '{raw_str(bucket, 'label')}' created successfully "
+                f"in {raw_str(bucket, 'region')}"
+            ),
+            "bucket": bucket,
+        }
+        if selection:
+            response["region_selection"] = selection
         return serialize_api_response(
-            {
-                "message": (
-                    f"Bucket '{raw_str(bucket, 'label')}' created successfully "
-                    f"in {raw_str(bucket, 'region')}"
-                ),
-                "bucket": bucket,
-            },
-            object_storage_pb2.ObjectStorageBucketWriteResponse(),
+            response, object_storage_pb2.ObjectStorageBucketWriteResponse()
         )
 
     return await execute_tool(cfg, arguments, "create bucket", _call)
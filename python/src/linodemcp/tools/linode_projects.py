@@ -0,0 +1,289 @@
+"""Linode project tools: resources grouped by instance group and project tag."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import tag_pb2
+from linodemcp.linode import APIError
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import error_response, execute_tool
+from linodemcp.tools.pricing import fetch_price_tables, round_cents
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from collections.abc import Awaitable
+
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+# Where project membership comes from. "both" also marks a resource that both
+# its group and a project tag put in the same project.
+_SOURCE_GROUP = "group"
+_SOURCE_TAG = "tag"
+_SOURCE_BOTH = "both"
+_TAG_PREFIX_DEFAULT = "project:"
+# Resource kinds a project holds, in report order (mirrors Go projectKinds).
+_KINDS = ("instance", "volume", "nodebalancer", "domain")
+
+
+def create_linode_projects_list_tool() -> tuple[Tool, Capability]:
+    """Create the linode_projects_list tool."""
+    return Tool(
+        name="linode_projects_list",
+        description=(
+            "Groups the account's instances, volumes, NodeBalancers, and domains "
+            "into projects and lists each project's resource counts and monthly "
+            "list-price cost. A project is an instance's legacy group value or a "
+            'tag starting with tag_prefix (default "project:", so project:billing '
+            "is the billing project); source picks group, tag, or both "
+            "(default). Also counts the resources in no project."
+        ),
+        inputSchema=schema("linode.mcp.v1.ProjectsListInput"),
+    ), Capability.Read
+
+
+def create_linode_projects_get_tool() -> tuple[Tool, Capability]:
+    """Create the linode_projects_get tool."""
+    return Tool(
+        name="linode_projects_get",
+        description=(
+            "Returns one project's inventory: every instance, volume, "
+            "NodeBalancer, and domain in it with its region, type or size, "
+            "monthly list-price cost, and whether the group or the project tag "
+            "put it there, plus the project totals. Projects are found the same "
+            "way as linode_projects_list."
+        ),
+        inputSchema=schema("linode.mcp.v1.ProjectsGetInput"),
+    ), Capability.Read
+
+
+def _projects_args(arguments: dict[str, Any]) -> tuple[str, str, str]:
+    """Read tag_prefix and source; the third value is a validation message."""
+    tag_prefix = arguments.get("tag_prefix", _TAG_PREFIX_DEFAULT)
+    source = arguments.get("source", _SOURCE_BOTH)
+    if not isinstance(tag_prefix, str) or not tag_prefix.strip():
+        return "", "", "tag_prefix must not be empty"
+    if source not in (_SOURCE_GROUP, _SOURCE_TAG, _SOURCE_BOTH):
+        return "", "", "source must be one of: group, tag, both"
+    return tag_prefix, source, ""
+
+
+def _memberships(
+    tag_prefix: str, source: str, group: str, tags: list[str]
+) -> dict[str, str]:
+    """Map each project a resource belongs to onto the source that put it
+    there (mirrors Go projectMemberships)."""
+    memberships: dict[str, str] = {}
+    group = group.strip()
+    if group and source != _SOURCE_TAG:
+        memberships[group] = _SOURCE_GROUP
+    if source == _SOURCE_GROUP:
+        return memberships
+    for tag in tags:
+        if tag[: len(tag_prefix)].lower() != tag_prefix.lower():
+            continue
+        name = tag[len(tag_prefix) :].strip()
+        current = memberships.get(name, "")
+        if not current and name:
+            memberships[name] = _SOURCE_TAG
+        elif current == _SOURCE_GROUP:
+            memberships[name] = _SOURCE_BOTH
+    return memberships
+
+
+async def _readable(
+    section: str, fetch: Awaitable[list[Any]], warnings: list[str]
+) -> list[Any]:
+    """Await one collection of a composite report, or skip it with a warning
+    when the token may not read it (HTTP 403). Any other error stays fatal
+    (mirrors Go permissionGap)."""
+    try:
+        return await fetch
+    except APIError as exc:
+        if not exc.is_forbidden_error():
+            raise
+        warnings.append(
+            f"{section} skipped: the token lacks permission to read them ({exc})"
+        )
+        return []
+
+
+async def _collect_projects(
+    client: RetryableClient, tag_prefix: str, source: str
+) -> tuple[dict[str, list[dict[str, Any]]], int, list[str]]:
+    """File every instance, volume, NodeBalancer, and domain under its
+    projects and count those in none (mirrors Go collectProjects). A
+    collection the token may not read is skipped with a returned warning."""
+    prices = await fetch_price_tables(client)
+    projects: dict[str, list[dict[str, Any]]] = {}
+    unassigned = 0
+    warnings: list[str] = []
+
+    def _file(resource: dict[str, Any], group: str, tags: list[str]) -> None:
+        nonlocal unassigned
+        memberships = _memberships(tag_prefix, source, group, tags)
+        if not memberships:
+            unassigned += 1
+            return
+        for name, member_source in memberships.items():
+            projects.setdefault(name, []).append(
+                {
+                    "kind": resource["kind"],
+                    "id": resource["id"],
+                    "label": resource["label"],
+                    "region": resource.get("region", ""),
+                    "type": resource.get("type", ""),
+                    "size_gb": resource.get("size_gb", 0),
+                    "source": member_source,
+                    "monthly_cost": resource.get("monthly_cost", 0.0),
+                }
+            )
+
+    for instance in await _readable(
+        "instances", client.list_instances(), warnings
+    ):
+        _file(
+            {
+                "kind": "instance",
+                "id": instance.id,
+                "label": instance.label,
+                "region": instance.region,
+                "type": instance.type,
+                "monthly_cost": prices.instance(instance),
+            },
+            instance.group or "",
+            instance.tags,
+        )
+    for volume in await _readable(
+        "volumes", client.list_volumes(), warnings
+    ):
+        _file(
+            {
+                "kind": "volume",
+                "id": volume.id,
+                "label": volume.label,
+                "region": volume.region,
+                "size_gb": volume.size,
+                "monthly_cost": prices.volume_monthly(volume.size, volume.region),
+            },
+            "",
+            volume.tags,
+        )
+    for node_balancer in await _readable(
+        "NodeBalancers", client.list_nodebalancers(), warnings
+    ):
+        _file(
+            {
+                "kind": "nodebalancer",
+                "id": node_balancer.id,
+                "label": node_balancer.label,
+                "region": node_balancer.region,
+                "monthly_cost": prices.node_balancer_monthly(node_balancer.region),
+            },
+            "",
+            node_balancer.tags,
+        )
+    for domain in await _readable(
+        "domains", client.list_domains(), warnings
+    ):
+        _file(
+            {
+                "kind": "domain",
+                "id": domain.id,
+                "label": domain.domain,
+                "type": domain.type,
+            },
+            "",
+            domain.tags,
+        )
+    for warning in warnings:
+        add_warning(warning)
+    return projects, unassigned, warnings
+
+
+def _summary(name: str, resources: list[dict[str, Any]]) -> dict[str, Any]:
+    """Count a project's resources by kind and total their cost."""
+    return {
+        "name": name,
+        "instances": sum(1 for r in resources if r["kind"] == "instance"),
+        "volumes": sum(1 for r in resources if r["kind"] == "volume"),
+        "nodebalancers": sum(1 for r in resources if r["kind"] == "nodebalancer"),
+        "domains": sum(1 for r in resources if r["kind"] == "domain"),
+        "monthly_cost": round_cents(sum(r["monthly_cost"] for r in resources)),
+    }
+
+
+async def handle_linode_projects_list(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_projects_list tool request."""
+    tag_prefix, source, error = _projects_args(arguments)
+    if error:
+        return error_response(error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        projects, unassigned, warnings = await _collect_projects(
+            client, tag_prefix, source
+        )
+        return serialize_api_response(
+            {
+                "message": (
+                    f"Found {len(projects)} projects; {unassigned} resources "
+                    "belong to no project"
+                ),
+                "count": len(projects),
+                "projects": [
+                    _summary(name, projects[name]) for name in sorted(projects)
+                ],
+                "unassigned": unassigned,
+                "warnings": warnings,
+            },
+            tag_pb2.ProjectsListResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "list projects", _call)
+
+
+async def handle_linode_projects_get(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_projects_get tool request."""
+    project = arguments.get("project")
+    name = project.strip() if isinstance(project, str) else ""
+    if not name:
+        return error_response("project is required")
+    tag_prefix, source, error = _projects_args(arguments)
+    if error:
+        return error_response(error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        projects, _, warnings = await _collect_projects(
+            client, tag_prefix, source
+        )
+        if name not in projects:
+            known = ", ".join(sorted(projects)) or "none"
+            msg = f'No project named "{name}"; known projects: {known}'
+            raise ValueError(msg)
+        resources = sorted(
+            projects[name], key=lambda r: (_KINDS.index(r["kind"]), r["id"])
+        )
+        summary = _summary(name, resources)
+        return serialize_api_response(
+            {
+                "message": (
+                    f"Project {name} has {len(resources)} resources costing "
+                    f"${summary['monthly_cost']:.2f} a month"
+                ),
+                "project": summary,
+                "resources": resources,
+                "warnings": warnings,
+            },
+            tag_pb2.ProjectsGetResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "get project", _call)
@@ -0,0 +1,173 @@
+"""Linode quota report tool: entity counts against known account limits."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING, Any, cast
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import account_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import error_response, execute_tool, resolve_config
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+# Smallest page_size Linode accepts; only the "results" total is needed.
+_COUNT_PAGE_SIZE = 25
+
+# Counted entity types in report order: (name, collection endpoint, account
+# capability needed to create any, or "" when none gates it). Mirrors Go's
+# quotaResources.
+_QUOTA_RESOURCES: tuple[tuple[str, str, str], ...] = (
+    ("instances", "/linode/instances", "Linodes"),
+    ("volumes", "/volumes", "Block Storage"),
+    ("nodebalancers", "/nodebalancers", "NodeBalancers"),
+    ("lke_clusters", "/lke/clusters", "Kubernetes"),
+    ("firewalls", "/networking/firewalls", "Cloud Firewall"),
+    ("vpcs", "/vpcs", "VPCs"),
+    ("domains", "/domains", ""),
+    ("databases", "/databases/instances", "Managed Databases"),
+    ("placement_groups", "/placement/groups", "Placement Group"),
+    ("buckets", "/object-storage/buckets", "Object Storage"),
+)
+
+
+def create_linode_quota_report_tool() -> tuple[Tool, Capability]:
+    """Create the linode_quota_report tool."""
+    return Tool(
+        name="linode_quota_report",
+        description=(
+            "Counts the account's instances, volumes, NodeBalancers, LKE "
+            "clusters, firewalls, VPCs, domains, databases, placement groups, "
+            "and buckets, and reports each count against its limit and the "
+            "remaining headroom. Limits come from the quotas.limits config; a "
+            "resource the account lacks the capability for has a limit of 0. "
+            "Pass resource (and optionally additional, default 1) to check "
+            "whether that many more would fit before creating them."
+        ),
+        inputSchema=schema("linode.mcp.v1.QuotaReportInput"),
+    ), Capability.Read
+
+
+async def _quota_usage(
+    client: RetryableClient,
+    limits: dict[str, int],
+    capabilities: list[str] | None,
+) -> list[dict[str, Any]]:
+    """Count every resource, one request at a time. A resource the account
+    lacks the capability for has a limit of 0; otherwise the configured limit
+    applies when there is one. None capabilities means they are unknown."""
+    usage: list[dict[str, Any]] = []
+    for name, endpoint, capability in _QUOTA_RESOURCES:
+        entry: dict[str, Any] = {
+            "resource": name,
+            "count": 0,
+            "limit_source": "unknown",
+        }
+        try:
+            raw = await client.get_raw(
+                f"{endpoint}?page=1&page_size={_COUNT_PAGE_SIZE}"
+            )
+            results = raw.get("results") if isinstance(raw, dict) else None
+            entry["count"] = results if isinstance(results, int) else 0
+        except (APIError, NetworkError) as exc:
+            entry["error"] = str(exc)
+            add_warning("%s count unavailable: %s", name, exc)
+
+        if (
+            capabilities is not None
+            and capability
+            and capability not in capabilities
+        ):
+            entry["limit"] = 0
+            entry["limit_source"] = "capabilities"
+        elif name in limits:
+            entry["limit"] = limits[name]
+            entry["limit_source"] = "config"
+
+        if "limit" in entry:
+            entry["headroom"] = max(entry["limit"] - entry["count"], 0)
+        usage.append(entry)
+    return usage
+
+
+def _quota_check(
+    usage: list[dict[str, Any]], resource: str, additional: int
+) -> dict[str, Any]:
+    """Answer whether ``additional`` more of resource fit under its limit,
+    mirroring Go's quotaCheck."""
+    check: dict[str, Any] = {"resource": resource, "additional": additional}
+    entry = next((u for u in usage if u["resource"] == resource), None)
+    if entry is None:
+        return check
+    count = entry["count"]
+    if "error" in entry:
+        check["message"] = (
+            f"Cannot check {resource}: the current count is unavailable"
+        )
+    elif "limit" not in entry:
+        check["message"] = (
+            f"No known limit for {resource} ({count} in use); "
+            f"set quotas.limits.{resource} to check it"
+        )
+    elif count + additional <= entry["limit"]:
+        check["fits"] = True
+        check["message"] = (
+            f"{additional} more {resource} fit: {count} of {entry['limit']} in use"
+        )
+    else:
+        check["fits"] = False
+        check["message"] = (
+            f"{additional} more {resource} do not fit: {count} of "
+            f"{entry['limit']} in use leaves room for {entry['headroom']}"
+        )
+    return check
+
+
+async def handle_linode_quota_report(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_quota_report tool request."""
+    resource = str(arguments.get("resource") or "").strip()
+    names = [name for name, _, _ in _QUOTA_RESOURCES]
+    if resource and resource not in names:
+        return error_response("resource must be one of: " + ", ".join(names))
+
+    additional = arguments.get("additional", 1)
+    if not isinstance(additional, int) or isinstance(additional, bool):
+        additional = 1
+    if additional < 1:
+        return error_response("additional must be a positive integer")
+
+    limits = resolve_config(cfg).quotas.limits
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        capabilities: list[str] | None = None
+        try:
+            account = await client.get_raw("/account")
+            raw_caps = (
+                account.get("capabilities") if isinstance(account, dict) else None
+            )
+            caps = cast("list[Any]", raw_caps) if isinstance(raw_caps, list) else []
+            capabilities = [str(c) for c in caps]
+        except (APIError, NetworkError) as exc:
+            capabilities = None
+            add_warning(
+                "account capabilities unavailable, limits come from config only: %s",
+                exc,
+            )
+
+        response: dict[str, Any] = {
+            "usage": await _quota_usage(client, limits, capabilities)
+        }
+        if resource:
+            response["check"] = _quota_check(response["usage"], resource, additional)
+        return serialize_api_response(response, account_pb2.QuotaReportResponse())
+
+    return await execute_tool(cfg, arguments, "build quota report", _call)
@@ -0,0 +1,86 @@
+"""Stored result retrieval tool.
+
+``linode_result_get`` returns a full tool result that result_summary replaced
+with a summary, by the result_id the summary named. CapMeta, so it is
+available in every profile. Reads the server's in-memory result store and
+makes no API call.
+
+Mirrors ``go/internal/tools/linode_result_get.go``.
+"""
+
+from __future__ import annotations
+
+import json
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import result_pb2
+from linodemcp.profiles import Capability
+from linodemcp.resultstore import MAX_RESULTS
+from linodemcp.tools.helpers import error_response, store_partition
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.result_summary import result_store_from_context
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.resultstore import Entry
+
+
+def create_linode_result_get_tool() -> tuple[Tool, Capability]:
+    """Build the ``linode_result_get`` MCP tool definition."""
+    return (
+        Tool(
+            name="linode_result_get",
+            description=(
+                "Get the full result behind a summarized tool result, by the "
+                "result_id its note names. Results are kept in memory for the "
+                "latest summaries only and do not survive a server restart."
+            ),
+            inputSchema=schema("linode.mcp.v1.ResultGetInput"),
+        ),
+        Capability.Meta,
+    )
+
+
+def _content_value(text: str) -> Any:
+    """A text item parsed as JSON, or the text itself when it is not JSON."""
+    try:
+        return json.loads(text)
+    except ValueError:
+        return text
+
+
+def result_get_dict(entry: Entry) -> dict[str, Any]:
+    """The canonical ResultGetResponse payload for a stored result."""
+    payload = {
+        "result_id": entry.id,
+        "tool": entry.tool,
+        "stored_at": entry.stored_at.strftime("%Y-%m-%dT%H:%M:%SZ"),
+        "content": [_content_value(text) for text in entry.content],
+    }
+    return serialize_api_response(payload, result_pb2.ResultGetResponse())
+
+
+async def handle_linode_result_get(arguments: dict[str, Any]) -> list[TextContent]:
+    """Return a stored full result as proto-canonical JSON."""
+    result_id = arguments.get("result_id", "")
+    if not isinstance(result_id, str) or not result_id.strip():
+        return error_response("result_id must be a non-empty string")
+
+    try:
+        partition = store_partition()
+    except ValueError as exc:
+        return error_response(str(exc))
+
+    store = result_store_from_context()
+    entry = store.get(partition, result_id) if store is not None else None
+    if entry is None:
+        return error_response(
+            f"result {result_id} was not found; only the latest {MAX_RESULTS} "
+            "summarized results are kept, and none survive a server restart"
+        )
+
+    return [
+        TextContent(type="text", text=json.dumps(result_get_dict(entry), indent=2))
+    ]
@@ -0,0 +1,576 @@
+"""linode_security_posture: one scored report of the account's security posture.
+
+Each check reads one collection (profile, users, tokens, SSH keys and events,
+buckets, NodeBalancer configs, logins); a collection the token cannot read
+turns its check unknown instead of failing the report. Failed checks carry
+remediation steps naming the tool that fixes them.
+
+Mirrors ``go/internal/tools/linode_security_posture.go``.
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass, field
+from datetime import UTC, datetime, timedelta
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import security_posture_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import error_response, execute_tool
+from linodemcp.tools.linode_sshkey_usage_report import (
+    _EVENT_PAGE_SIZE,
+    _EVENT_PAGES,
+    _LINODE_TIME_FORMAT,
+    _events,
+    _instances,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient, SSHKey
+
+_TOKEN_AGE_DEFAULT = 90
+_TOKEN_AGE_LIMIT = 3650
+_LOGIN_DAYS_DEFAULT = 30
+_LOGIN_DAYS_LIMIT = 365
+
+# Users, tokens, and logins are read in one page of the API's largest size.
+_PAGE_SIZE = 500
+
+_MAX_SCORE = 100
+
+_STATUS_PASS = "pass"
+_STATUS_FAIL = "fail"
+_STATUS_UNKNOWN = "unknown"
+
+_SEVERITY_HIGH = "high"
+_SEVERITY_MEDIUM = "medium"
+_SEVERITY_LOW = "low"
+
+# The points a failed check costs, by severity.
+_PENALTIES = {_SEVERITY_HIGH: 25, _SEVERITY_MEDIUM: 15, _SEVERITY_LOW: 5}
+
+# Token scopes that reach every resource or the account itself (users,
+# billing, and other tokens).
+_BROAD_SCOPES = frozenset({"*", "account:read_write"})
+
+# Bucket ACLs that let someone outside the account read (or write) objects.
+_PUBLIC_ACLS = frozenset({"public-read", "public-read-write", "authenticated-read"})
+
+# The NodeBalancer HTTPS cipher suite that still accepts older TLS versions and
+# weaker ciphers.
+_LEGACY_CIPHER_SUITE = "legacy"
+
+_ReadError = (APIError, NetworkError)
+
+
+@dataclass
+class _PostureData:
+    """Everything the report reads; a set error turns its check unknown."""
+
+    profile: dict[str, Any]
+    users: list[dict[str, Any]] = field(default_factory=list)
+    users_err: Exception | None = None
+    tokens: list[dict[str, Any]] = field(default_factory=list)
+    tokens_err: Exception | None = None
+    keys: list[SSHKey] = field(default_factory=list)
+    keys_err: Exception | None = None
+    events: list[dict[str, Any]] = field(default_factory=list)
+    events_err: Exception | None = None
+    events_complete: bool = True
+    public_buckets: list[str] = field(default_factory=list)
+    buckets_err: Exception | None = None
+    unread_buckets: int = 0
+    logins: list[dict[str, Any]] = field(default_factory=list)
+    logins_err: Exception | None = None
+    legacy_tls_ports: list[str] = field(default_factory=list)
+    https_ports: int = 0
+    tls_err: Exception | None = None
+
+
+def create_linode_security_posture_tool() -> tuple[Tool, Capability]:
+    """Create the linode_security_posture tool."""
+    return Tool(
+        name="linode_security_posture",
+        description=(
+            "Scores the account's security posture from 0 to 100 in one report: "
+            "two-factor authentication on the profile and on every other user, "
+            "restricted versus unrestricted users, personal access tokens with "
+            "broad scopes (* or account:read_write) older than "
+            "token_max_age_days (default 90), profile SSH keys with no instance "
+            "deployed since they were added, public Object Storage buckets, "
+            "NodeBalancer HTTPS ports on the legacy cipher suite, and failed "
+            "account logins in the last login_days (default 30). Each "
+            "failed check lists what failed and remediation steps naming the "
+            "tool that fixes it; checks the token cannot read are reported "
+            "unknown instead of failing the report."
+        ),
+        inputSchema=schema("linode.mcp.v1.SecurityPostureInput"),
+    ), Capability.Read
+
+
+def _check(check_id: str, title: str, severity: str) -> dict[str, Any]:
+    """A passing check carrying the penalty its severity costs."""
+    return {
+        "id": check_id,
+        "title": title,
+        "status": _STATUS_PASS,
+        "severity": severity,
+        "penalty": _PENALTIES[severity],
+        "detail": "",
+        "items": [],
+        "remediation": [],
+    }
+
+
+def _unknown(check: dict[str, Any], what: str, err: Exception) -> dict[str, Any]:
+    check["status"] = _STATUS_UNKNOWN
+    check["detail"] = f"could not read {what}: {err}"
+    return check
+
+
+def _fail(
+    check: dict[str, Any], detail: str, items: list[str], *steps: tuple[str, str]
+) -> dict[str, Any]:
+    check["status"] = _STATUS_FAIL
+    check["detail"] = detail
+    check["items"] = items
+    check["remediation"] = [{"tool": tool, "step": step} for tool, step in steps]
+    return check
+
+
+def _profile_tfa(profile: dict[str, Any]) -> dict[str, Any]:
+    check = _check(
+        "profile_2fa", "Two-factor authentication on this profile", _SEVERITY_HIGH
+    )
+    username = str(profile.get("username") or "")
+    if profile.get("two_factor_auth"):
+        check["detail"] = f"Two-factor authentication is enabled for {username}."
+        return check
+    return _fail(
+        check,
+        f"Two-factor authentication is not enabled for {username}; a leaked "
+        "password is enough to log in.",
+        [username],
+        (
+            "linode_profile_security_question_answer",
+            "Answer the security questions; Linode requires them before "
+            "two-factor authentication can be enabled.",
+        ),
+        (
+            "linode_profile_tfa_enable",
+            "Generate the two-factor secret and add it to an authenticator app.",
+        ),
+        (
+            "linode_profile_tfa_enable_confirm",
+            "Confirm with a code from the app to turn two-factor authentication on.",
+        ),
+    )
+
+
+def _users_tfa(data: _PostureData, username: str) -> dict[str, Any]:
+    check = _check(
+        "users_2fa", "Two-factor authentication on other users", _SEVERITY_HIGH
+    )
+    if data.users_err is not None:
+        return _unknown(check, "account users", data.users_err)
+    missing = [
+        str(user.get("username") or "")
+        for user in data.users
+        if user.get("username") != username and not user.get("tfa_enabled")
+    ]
+    if not missing:
+        check["detail"] = "Every other user has two-factor authentication enabled."
+        return check
+    return _fail(
+        check,
+        f"{len(missing)} other user(s) log in without two-factor authentication.",
+        missing,
+        (
+            "linode_account_user_update",
+            "Set restricted=true on each listed user until they enable two-factor "
+            "authentication; only the user can enable it on their own profile.",
+        ),
+        (
+            "linode_account_user_grants_update",
+            "Grant each restricted user only the entities they need.",
+        ),
+    )
+
+
+def _restricted_users(
+    data: _PostureData, restricted: int, unrestricted: int
+) -> dict[str, Any]:
+    check = _check("restricted_users", "Unrestricted users", _SEVERITY_LOW)
+    if data.users_err is not None:
+        return _unknown(check, "account users", data.users_err)
+    detail = f"{restricted} restricted and {unrestricted} unrestricted user(s)."
+    if unrestricted <= 1:
+        check["detail"] = detail
+        return check
+    return _fail(
+        check,
+        detail
+        + " Each unrestricted user can change billing, users, and every resource.",
+        [
+            str(user.get("username") or "")
+            for user in data.users
+            if not user.get("restricted")
+        ],
+        (
+            "linode_account_user_update",
+            "Set restricted=true on users who do not need full account access.",
+        ),
+        (
+            "linode_account_user_grants_update",
+            "Grant each restricted user only the entities they need.",
+        ),
+    )
+
+
+def _parse_time(value: str) -> datetime | None:
+    try:
+        return datetime.strptime(value, _LINODE_TIME_FORMAT).replace(tzinfo=UTC)
+    except ValueError:
+        return None
+
+
+def _broad_scope(scopes: str) -> bool:
+    return any(scope.strip() in _BROAD_SCOPES for scope in scopes.split(","))
+
+
+def _broad_tokens(data: _PostureData, now: datetime, max_age: int) -> dict[str, Any]:
+    check = _check(
+        "broad_tokens", "Old personal access tokens with broad scopes", _SEVERITY_HIGH
+    )
+    if data.tokens_err is not None:
+        return _unknown(check, "personal access tokens", data.tokens_err)
+    flagged: list[str] = []
+    for token in data.tokens:
+        scopes = str(token.get("scopes") or "")
+        created = _parse_time(str(token.get("created") or ""))
+        if created is None or not _broad_scope(scopes):
+            continue
+        age = (now - created).days
+        if age >= max_age:
+            flagged.append(
+                f"{token.get('label') or ''} (id {token.get('id') or 0}, "
+                f"scopes {scopes}, {age} days old)"
+            )
+    if not flagged:
+        check["detail"] = f"No token with broad scopes is {max_age} or more days old."
+        return check
+    return _fail(
+        check,
+        f"{len(flagged)} token(s) with broad scopes are {max_age} or more days old.",
+        flagged,
+        (
+            "linode_profile_token_create",
+            "Create a replacement with only the scopes its user needs and an expiry.",
+        ),
+        (
+            "linode_profile_token_delete",
+            "Revoke the old token once its users have switched to the replacement.",
+        ),
+    )
+
+
+def _unused_ssh_keys(data: _PostureData, username: str) -> dict[str, Any]:
+    check = _check(
+        "unused_ssh_keys", "Profile SSH keys without recent use", _SEVERITY_LOW
+    )
+    if data.keys_err is not None:
+        return _unknown(check, "profile SSH keys", data.keys_err)
+    if data.events_err is not None:
+        return _unknown(check, "the event feed", data.events_err)
+    unused = [
+        f"{key.label} (id {key.id})"
+        for key in data.keys
+        if not _instances(data.events, username, key.created)
+    ]
+    if not unused:
+        check["detail"] = (
+            "Every profile SSH key was deployed to an instance in the events scanned."
+        )
+        return check
+    return _fail(
+        check,
+        f"{len(unused)} profile SSH key(s) were not deployed to any instance in "
+        "the events scanned.",
+        unused,
+        (
+            "linode_sshkey_usage_report",
+            "Review each key's age, fingerprint, and likely uses.",
+        ),
+        ("linode_sshkey_delete", "Delete the keys nobody deploys with any more."),
+    )
+
+
+def _public_buckets(data: _PostureData) -> dict[str, Any]:
+    check = _check("public_buckets", "Public Object Storage buckets", _SEVERITY_MEDIUM)
+    if data.buckets_err is not None:
+        return _unknown(check, "Object Storage buckets", data.buckets_err)
+    if not data.public_buckets:
+        check["detail"] = "No bucket has a public ACL."
+        return check
+    return _fail(
+        check,
+        f"{len(data.public_buckets)} bucket(s) have a public ACL, so anyone with "
+        "the URL can list or read objects.",
+        data.public_buckets,
+        (
+            "linode_object_storage_bucket_access_get",
+            "Confirm whether the bucket is meant to be public.",
+        ),
+        (
+            "linode_object_storage_bucket_access_update",
+            "Set acl=private, and share single objects with "
+            "linode_object_storage_presigned_url_create instead.",
+        ),
+    )
+
+
+def _legacy_tls(data: _PostureData) -> dict[str, Any]:
+    check = _check(
+        "nodebalancer_tls",
+        "NodeBalancer HTTPS ports on the legacy cipher suite",
+        _SEVERITY_MEDIUM,
+    )
+    if data.tls_err is not None:
+        return _unknown(check, "NodeBalancer configs", data.tls_err)
+    if not data.legacy_tls_ports:
+        check["detail"] = (
+            f"{data.https_ports} HTTPS NodeBalancer port(s) use the recommended "
+            "cipher suite."
+        )
+        return check
+    return _fail(
+        check,
+        f"{len(data.legacy_tls_ports)} of {data.https_ports} HTTPS NodeBalancer "
+        "port(s) use the legacy cipher suite, which still accepts older TLS "
+        "versions and weaker ciphers.",
+        data.legacy_tls_ports,
+        (
+            "linode_nodebalancer_config_get",
+            "Confirm no client of the port still needs an older TLS version.",
+        ),
+        (
+            "linode_nodebalancer_config_update",
+            "Set cipher_suite=recommended on each listed port.",
+        ),
+    )
+
+
+def _failed_logins(data: _PostureData, now: datetime, days: int) -> dict[str, Any]:
+    check = _check("failed_logins", "Failed account logins", _SEVERITY_MEDIUM)
+    if data.logins_err is not None:
+        return _unknown(check, "account logins", data.logins_err)
+    since = (now - timedelta(days=days)).strftime(_LINODE_TIME_FORMAT)
+    failed = [
+        f"{login.get('username') or ''} from {login.get('ip') or ''} at "
+        f"{login.get('datetime') or ''}"
+        for login in data.logins
+        if login.get("status") == "failed"
+        and str(login.get("datetime") or "") >= since
+    ]
+    if not failed:
+        check["detail"] = f"No failed logins in the last {days} days."
+        return check
+    return _fail(
+        check,
+        f"{len(failed)} failed login(s) in the last {days} days.",
+        failed,
+        (
+            "linode_account_login_list",
+            "Review the failed logins and where they came from.",
+        ),
+        (
+            "linode_account_user_update",
+            "Restrict any targeted user who does not need full access.",
+        ),
+        (
+            "linode_profile_tfa_enable",
+            "Enable two-factor authentication so a guessed password is not enough.",
+        ),
+    )
+
+
+def _report(
+    data: _PostureData, now: datetime, token_max_age: int, login_days: int
+) -> dict[str, Any]:
+    """Build the scored report as of now; mirrors Go's securityPostureReport."""
+    username = str(data.profile.get("username") or "")
+    restricted = sum(1 for user in data.users if user.get("restricted"))
+    unrestricted = len(data.users) - restricted
+    checks = [
+        _profile_tfa(data.profile),
+        _users_tfa(data, username),
+        _restricted_users(data, restricted, unrestricted),
+        _broad_tokens(data, now, token_max_age),
+        _unused_ssh_keys(data, username),
+        _public_buckets(data),
+        _legacy_tls(data),
+        _failed_logins(data, now, login_days),
+    ]
+    warnings: list[str] = []
+    if data.unread_buckets:
+        warnings.append(
+            f"Access settings for {data.unread_buckets} bucket(s) could not be "
+            "read, so they were not checked for public ACLs."
+        )
+    if data.keys_err is None and data.events_err is None and not data.events_complete:
+        warnings.append(
+            f"Only the newest {_EVENT_PAGE_SIZE * _EVENT_PAGES} events were "
+            "scanned; keys deployed before then count as unused."
+        )
+    score = _MAX_SCORE
+    failed = unknown = 0
+    for check in checks:
+        if check["status"] == _STATUS_FAIL:
+            failed += 1
+            score -= check["penalty"]
+        elif check["status"] == _STATUS_UNKNOWN:
+            unknown += 1
+            warnings.append(f"{check['id']}: {check['detail']}")
+    score = max(score, 0)
+    return {
+        "message": (
+            f"Security posture score {score}/{_MAX_SCORE}: {failed} of "
+            f"{len(checks)} checks failed, {unknown} unknown"
+        ),
+        "score": score,
+        "failed_count": failed,
+        "unknown_count": unknown,
+        "restricted_users": restricted,
+        "unrestricted_users": unrestricted,
+        "checks": checks,
+        "warnings": warnings,
+    }
+
+
+async def _read_public_buckets(client: RetryableClient, data: _PostureData) -> None:
+    """Record buckets with a public ACL as "region/label (acl)"; buckets whose
+    access settings cannot be read are counted and left out."""
+    try:
+        buckets = await client.list_object_storage_buckets()
+    except _ReadError as exc:
+        data.buckets_err = exc
+        return
+    for bucket in buckets:
+        region = str(bucket.get("region") or bucket.get("cluster") or "")
+        label = str(bucket.get("label") or "")
+        try:
+            access = await client.get_object_storage_bucket_access(region, label)
+        except _ReadError:
+            data.unread_buckets += 1
+            continue
+        acl = str(access.get("acl") or "")
+        if acl in _PUBLIC_ACLS:
+            data.public_buckets.append(f"{region}/{label} ({acl})")
+
+
+async def _read_legacy_tls(client: RetryableClient, data: _PostureData) -> None:
+    """Record NodeBalancer HTTPS ports on the legacy cipher suite, naming each
+    port's active suite, and count every HTTPS port read."""
+    legacy: list[str] = []
+    https = 0
+    try:
+        for nodebalancer in await client.list_nodebalancers():
+            body = await client.list_nodebalancer_configs(
+                nodebalancer.id, 1, _PAGE_SIZE
+            )
+            for config in body.get("data") or []:
+                if config.get("protocol") != "https":
+                    continue
+                https += 1
+                suite = str(config.get("cipher_suite") or "")
+                if suite == _LEGACY_CIPHER_SUITE:
+                    legacy.append(
+                        f"{nodebalancer.label} port {config.get('port') or 0} "
+                        f"(nodebalancer {nodebalancer.id}, config "
+                        f"{config.get('id') or 0}, cipher_suite {suite})"
+                    )
+    except _ReadError as exc:
+        data.tls_err = exc
+        return
+    data.legacy_tls_ports = legacy
+    data.https_ports = https
+
+
+async def _read_posture_data(client: RetryableClient) -> _PostureData:
+    data = _PostureData(profile=await client.get_raw("/profile"))
+    try:
+        data.users = list(
+            (await client.list_account_users(1, _PAGE_SIZE)).get("data") or []
+        )
+    except _ReadError as exc:
+        data.users_err = exc
+    try:
+        data.tokens = await client.list_profile_tokens(1, _PAGE_SIZE)
+    except _ReadError as exc:
+        data.tokens_err = exc
+    try:
+        data.keys = await client.list_ssh_keys()
+    except _ReadError as exc:
+        data.keys_err = exc
+    if data.keys_err is None:
+        try:
+            data.events, data.events_complete = await _events(client)
+        except _ReadError as exc:
+            data.events_err = exc
+    await _read_public_buckets(client, data)
+    await _read_legacy_tls(client, data)
+    try:
+        data.logins = list(
+            (await client.list_account_logins(1, _PAGE_SIZE)).get("data") or []
+        )
+    except _ReadError as exc:
+        data.logins_err = exc
+    return data
+
+
+def _bounded(
+    arguments: dict[str, Any], key: str, default: int, limit: int
+) -> int | None:
+    """Return the argument, its default when absent, or None when out of range."""
+    if key not in arguments:
+        return default
+    value = arguments[key]
+    if isinstance(value, bool) or not isinstance(value, int) or not 1 <= value <= limit:
+        return None
+    return value
+
+
+async def handle_linode_security_posture(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_security_posture tool request."""
+    token_max_age = _bounded(
+        arguments, "token_max_age_days", _TOKEN_AGE_DEFAULT, _TOKEN_AGE_LIMIT
+    )
+    if token_max_age is None:
+        return error_response(
+            f"token_max_age_days must be from 1 through {_TOKEN_AGE_LIMIT}"
+        )
+    login_days = _bounded(
+        arguments, "login_days", _LOGIN_DAYS_DEFAULT, _LOGIN_DAYS_LIMIT
+    )
+    if login_days is None:
+        return error_response(f"login_days must be from 1 through {_LOGIN_DAYS_LIMIT}")
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        data = await _read_posture_data(client)
+        report = _report(data, datetime.now(UTC), token_max_age, login_days)
+        for warning in report["warnings"]:
+            add_warning(warning)
+        return serialize_api_response(
+            report, security_posture_pb2.SecurityPostureResponse()
+        )
+
+    return await execute_tool(cfg, arguments, "build security posture report", _call)
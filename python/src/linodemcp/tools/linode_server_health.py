@@ -0,0 +1,97 @@
+"""Server self-diagnostics tool.
+
+``linode_server_health`` reports the server's own state for long-lived
+deployments: uptime, build version, transport, the configured environments
+(never their tokens), the resilience limits API clients run under, and the
+two-stage plan store's fill level. CapMeta, so every profile can read it.
+Takes no input and makes no API call. The health server's ``/healthz`` route
+embeds the same payload through :func:`server_health_dict`.
+
+Mirrors ``go/internal/tools/linode_server_health.go``.
+"""
+
+from __future__ import annotations
+
+import json
+from datetime import UTC, datetime
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import server_health_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import resolve_config
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+from linodemcp.tools.version import version_response_dict
+from linodemcp.twostage import plan_store_from_context
+from linodemcp.twostage.store import MAX_OUTSTANDING_PLANS
+from linodemcp.version import process_start_time
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.twostage.store import PlanStore
+
+
+def create_linode_server_health_tool() -> tuple[Tool, Capability]:
+    """Build the ``linode_server_health`` MCP tool definition."""
+    return (
+        Tool(
+            name="linode_server_health",
+            description=(
+                "Report LinodeMCP's own health: uptime, version, transport, "
+                "configured environments (tokens are never shown), rate-limit "
+                "and retry settings, and in-memory plan cache usage."
+            ),
+            inputSchema=schema("linode.mcp.v1.ServerHealthInput"),
+        ),
+        Capability.Meta,
+    )
+
+
+def server_health_dict(cfg: Config, store: PlanStore | None) -> dict[str, Any]:
+    """The canonical ServerHealthResponse payload as a dict.
+
+    The tool handler and the /healthz diagnostics both serialize this, so the
+    two surfaces report the same fields (Go's ServerHealthProto).
+    """
+    cfg = resolve_config(cfg)
+    started = process_start_time()
+    resilience = cfg.resilience
+    payload = {
+        "status": "ok",
+        "version": version_response_dict(),
+        "started_at": started.strftime("%Y-%m-%dT%H:%M:%SZ"),
+        "uptime_seconds": int((datetime.now(UTC) - started).total_seconds()),
+        "transport": cfg.server.transport or "stdio",
+        "environments": [
+            {
+                "name": name,
+                "label": env.label,
+                "api_url": env.linode.api_url,
+                "token_configured": bool(env.linode.token),
+            }
+            for name, env in sorted(cfg.environments.items())
+        ],
+        "rate_limit": {
+            "requests_per_minute": resilience.rate_limit_per_minute,
+            "max_retries": resilience.max_retries,
+            "circuit_breaker_threshold": resilience.circuit_breaker_threshold,
+            "circuit_breaker_timeout_seconds": int(
+                resilience.circuit_breaker_timeout
+            ),
+        },
+        "cache": {
+            "plans_outstanding": store.size() if store is not None else 0,
+            "plans_capacity": MAX_OUTSTANDING_PLANS,
+        },
+    }
+    return serialize_api_response(payload, server_health_pb2.ServerHealthResponse())
+
+
+async def handle_linode_server_health(
+    _arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Report the server's own state as proto-canonical JSON."""
+    result = server_health_dict(cfg, plan_store_from_context())
+    return [TextContent(type="text", text=json.dumps(result, indent=2))]
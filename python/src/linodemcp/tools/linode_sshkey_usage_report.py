@@ -0,0 +1,235 @@
+"""linode_sshkey_usage_report: profile SSH key ages and likely uses.
+
+The API does not record which keys an instance was deployed with, so each key
+is matched to the instances the profile user created or rebuilt while it
+existed, taken from the account event feed.
+
+Mirrors ``go/internal/tools/linode_sshkey_usage_report.go``.
+"""
+
+from __future__ import annotations
+
+import base64
+import binascii
+import hashlib
+from datetime import UTC, datetime
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import sshkey_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import error_response, execute_tool
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient, SSHKey
+
+_MAX_AGE_DEFAULT = 365
+_MAX_AGE_LIMIT = 3650
+
+# The event scan stops after the newest 2000 events (mirrors Go's
+# sshKeyUsageEventPageSize and sshKeyUsageEventPages).
+_EVENT_PAGE_SIZE = 500
+_EVENT_PAGES = 4
+
+# The API's timestamp format: UTC without a zone.
+_LINODE_TIME_FORMAT = "%Y-%m-%dT%H:%M:%S"
+
+_FLAG_OLD = "old"
+_FLAG_UNUSED = "unused"
+
+# The events that deploy profile keys to an instance.
+_DEPLOY_ACTIONS = ("linode_create", "linode_rebuild")
+
+
+def create_linode_sshkey_usage_report_tool() -> tuple[Tool, Capability]:
+    """Create the linode_sshkey_usage_report tool."""
+    return Tool(
+        name="linode_sshkey_usage_report",
+        description=(
+            "Reports every SSH key on the profile with its SHA256 fingerprint, "
+            "age, and the instances the profile user created or rebuilt since "
+            "the key was added (from the account event feed, which covers about "
+            "90 days). Keys at least max_age_days old (default 365) are flagged "
+            "old, and keys with no such instance are flagged unused, as "
+            "candidates for rotation. The API does not record which keys an "
+            "instance was deployed with, so the instances are likely, not "
+            "certain, uses."
+        ),
+        inputSchema=schema("linode.mcp.v1.SSHKeyUsageReportInput"),
+    ), Capability.Read
+
+
+def _fingerprint(public_key: str) -> str:
+    """SHA256 fingerprint as ssh-keygen -l prints it, or "" if not base64."""
+    fields = public_key.split()
+    if len(fields) < 2:  # noqa: PLR2004
+        return ""
+    try:
+        blob = base64.b64decode(fields[1], validate=True)
+    except (binascii.Error, ValueError):
+        return ""
+    digest = base64.b64encode(hashlib.sha256(blob).digest()).decode()
+    return "SHA256:" + digest.rstrip("=")
+
+
+async def _events(client: RetryableClient) -> tuple[list[dict[str, Any]], bool]:
+    """Read the event feed newest first, up to the scan cap.
+
+    The flag is False when the cap cut the scan short.
+    """
+    events: list[dict[str, Any]] = []
+    for page in range(1, _EVENT_PAGES + 1):
+        body = await client.list_account_events(page, _EVENT_PAGE_SIZE)
+        batch = list(body.get("data") or [])
+        events.extend(batch)
+        if len(batch) < _EVENT_PAGE_SIZE:
+            return events, True
+    return events, False
+
+
+def _instances(
+    events: list[dict[str, Any]], username: str, created: str
+) -> list[dict[str, Any]]:
+    """Instances username created or rebuilt at or after created, once each.
+
+    Linode timestamps share one fixed layout, so they compare as strings.
+    """
+    instances: list[dict[str, Any]] = []
+    seen: set[int] = set()
+    for event in events:
+        entity = event.get("entity") or {}
+        if (
+            event.get("username") != username
+            or event.get("status") == "failed"
+            or str(event.get("created") or "") < created
+            or event.get("action") not in _DEPLOY_ACTIONS
+            or entity.get("type") != "linode"
+        ):
+            continue
+        instance_id = int(entity.get("id") or 0)
+        if instance_id in seen:
+            continue
+        seen.add(instance_id)
+        instances.append(
+            {
+                "id": instance_id,
+                "label": str(entity.get("label") or ""),
+                "action": event["action"],
+                "at": str(event.get("created") or ""),
+            }
+        )
+    return instances
+
+
+def _report(
+    keys: list[SSHKey],
+    username: str,
+    events: list[dict[str, Any]],
+    events_known: bool,  # noqa: FBT001
+    warnings: list[str],
+    now: datetime,
+    max_age: int,
+) -> dict[str, Any]:
+    """Build the report as of now; mirrors Go's sshKeyUsageReport."""
+    response: dict[str, Any] = {
+        "username": username,
+        "events_scanned": len(events),
+        "events_since": str(events[-1].get("created") or "") if events else "",
+        "flagged_count": 0,
+        "ssh_keys": [],
+        "warnings": warnings,
+    }
+    old = unused = 0
+    for key in keys:
+        usage: dict[str, Any] = {
+            "id": key.id,
+            "label": key.label,
+            "fingerprint": _fingerprint(key.ssh_key),
+            "created": key.created,
+            "age_days": 0,
+            "instances": _instances(events, username, key.created),
+            "flags": [],
+        }
+        try:
+            created = datetime.strptime(key.created, _LINODE_TIME_FORMAT).replace(
+                tzinfo=UTC
+            )
+        except ValueError:
+            created = None
+        if created is not None:
+            usage["age_days"] = (now - created).days
+            if usage["age_days"] >= max_age:
+                usage["flags"].append(_FLAG_OLD)
+                old += 1
+        if events_known and not usage["instances"]:
+            usage["flags"].append(_FLAG_UNUSED)
+            unused += 1
+        if usage["flags"]:
+            response["flagged_count"] += 1
+        response["ssh_keys"].append(usage)
+
+    response["message"] = (
+        f"{len(keys)} SSH key(s) on profile {username}; "
+        f"{response['flagged_count']} flagged for rotation ({old} old, "
+        f"{unused} unused) from {len(events)} event(s) scanned"
+    )
+    return response
+
+
+async def handle_linode_sshkey_usage_report(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_sshkey_usage_report tool request."""
+    max_age = _MAX_AGE_DEFAULT
+    if "max_age_days" in arguments:
+        value = arguments["max_age_days"]
+        if (
+            isinstance(value, bool)
+            or not isinstance(value, int)
+            or not 1 <= value <= _MAX_AGE_LIMIT
+        ):
+            return error_response(
+                f"max_age_days must be from 1 through {_MAX_AGE_LIMIT}"
+            )
+        max_age = value
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        keys = await client.list_ssh_keys()
+        profile = await client.get_raw("/profile")
+        warnings: list[str] = []
+        events_known = True
+        try:
+            events, complete = await _events(client)
+        except (APIError, NetworkError) as exc:
+            events, complete, events_known = [], True, False
+            warnings.append(
+                "Events unavailable, so no key is matched to instances or "
+                f"flagged unused: {exc}"
+            )
+        if not complete:
+            warnings.append(
+                f"Only the newest {_EVENT_PAGE_SIZE * _EVENT_PAGES} events were "
+                "scanned; older instance deployments are not matched."
+            )
+        for warning in warnings:
+            add_warning(warning)
+        return serialize_api_response(
+            _report(
+                keys,
+                str(profile.get("username") or ""),
+                events,
+                events_known,
+                warnings,
+                datetime.now(UTC),
+                max_age,
+            ),
+            sshkey_pb2.SSHKeyUsageReportResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "build SSH key usage report", _call)
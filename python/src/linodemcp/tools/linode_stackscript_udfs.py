@@ -0,0 +1,107 @@
+"""StackScript user-defined field introspection tool.
+
+``linode_stackscript_udfs_get`` returns the deploy-time inputs a StackScript
+declares in a normalized form: the API's comma-separated ``oneOf`` /
+``manyOf`` strings become lists, and a field without a default is marked
+required, so an agent knows exactly which ``stackscript_data`` to collect.
+
+Mirrors ``go/internal/tools/linode_stackscript_udfs.go``.
+"""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING, Any, cast
+from urllib.parse import quote
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import stackscript_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import error_response, execute_tool, required_int_id
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+
+def create_linode_stackscript_udfs_get_tool() -> tuple[Tool, Capability]:
+    """Create the linode_stackscript_udfs_get tool."""
+    return Tool(
+        name="linode_stackscript_udfs_get",
+        description=(
+            "Gets the deploy-time inputs (user-defined fields) a StackScript "
+            "declares: each field's name, label, example, default, allowed "
+            "values, whether several values may be chosen, and whether it is "
+            "required (it has no default). Pass the values as stackscript_data "
+            "when creating an instance from the StackScript."
+        ),
+        inputSchema=schema("linode.mcp.v1.StackScriptUDFsGetInput"),
+    ), Capability.Read
+
+
+def _split_udf_values(raw: Any) -> list[str]:
+    """Split the API's comma-separated value list, dropping blanks."""
+    if not isinstance(raw, str):
+        return []
+    return [value.strip() for value in raw.split(",") if value.strip()]
+
+
+def _udf_field(udf: dict[str, Any]) -> dict[str, Any]:
+    """Normalize one UDF. A manyOf list allows several values and takes
+    precedence over oneOf; a field without a default is required."""
+    field: dict[str, Any] = {
+        "name": str(udf.get("name", "")),
+        "label": str(udf.get("label", "")),
+        "example": str(udf.get("example", "")),
+        "allowed_values": _split_udf_values(udf.get("oneOf", udf.get("oneof"))),
+        "multiple": False,
+        "required": udf.get("default") is None,
+    }
+    if udf.get("default") is not None:
+        field["default"] = str(udf["default"])
+    many = _split_udf_values(udf.get("manyOf", udf.get("manyof")))
+    if many:
+        field["allowed_values"] = many
+        field["multiple"] = True
+    return field
+
+
+def stackscript_udfs_dict(raw: dict[str, Any]) -> dict[str, Any]:
+    """The canonical StackScriptUDFsGetResponse payload for a StackScript."""
+    udfs = raw.get("user_defined_fields")
+    fields = [
+        _udf_field(cast("dict[str, Any]", udf))
+        for udf in (cast("list[Any]", udfs) if isinstance(udfs, list) else [])
+        if isinstance(udf, dict)
+    ]
+    payload = {
+        "stackscript_id": raw.get("id", 0),
+        "label": raw.get("label", ""),
+        "count": len(fields),
+        "required_count": sum(1 for field in fields if field["required"]),
+        "user_defined_fields": fields,
+    }
+    return serialize_api_response(
+        payload, stackscript_pb2.StackScriptUDFsGetResponse()
+    )
+
+
+async def handle_linode_stackscript_udfs_get(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_stackscript_udfs_get tool request."""
+    stackscript_id, error = required_int_id(arguments, "stackscript_id")
+    if stackscript_id is None:
+        return error_response(error)
+
+    encoded_stackscript_id = quote(str(stackscript_id), safe="")
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        raw = await client.get_raw(f"/linode/stackscripts/{encoded_stackscript_id}")
+        return stackscript_udfs_dict(raw)
+
+    return await execute_tool(
+        cfg, arguments, f"retrieve StackScript {stackscript_id}", _call
+    )
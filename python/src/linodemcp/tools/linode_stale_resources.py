@@ -0,0 +1,320 @@
+"""linode_stale_resources: account resources that look abandoned.
+
+Flags instances not booted, rebooted, or updated in the last months, domains
+whose zone has not changed in that time, profile SSH keys the profile user
+has not deployed, and old private images. Boots and key deployments come from
+the account event feed.
+
+Mirrors ``go/internal/tools/linode_stale_resources.go``.
+"""
+
+from __future__ import annotations
+
+from datetime import UTC, datetime, timedelta
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import stale_resources_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import error_response, execute_tool
+from linodemcp.tools.linode_sshkey_usage_report import (
+    _EVENT_PAGE_SIZE,
+    _EVENT_PAGES,
+    _LINODE_TIME_FORMAT,
+    _events,
+    _instances,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import Domain, Image, Instance, RetryableClient, SSHKey
+
+_MONTHS_DEFAULT = 6
+_MONTHS_LIMIT = 120
+_IMAGE_AGE_DEFAULT = 365
+_IMAGE_AGE_LIMIT = 3650
+
+# Keeps the cutoff a whole number of days, so both implementations compute
+# the same one.
+_DAYS_PER_MONTH = 30
+
+# The events that count as an instance being used.
+_BOOT_ACTIONS = ("linode_boot", "linode_reboot", "lassie_reboot")
+
+_ListError = (APIError, NetworkError)
+
+
+def create_linode_stale_resources_tool() -> tuple[Tool, Capability]:
+    """Create the linode_stale_resources tool."""
+    return Tool(
+        name="linode_stale_resources",
+        description=(
+            "Reports account resources that look abandoned, as a starting point "
+            "for an infrastructure-hygiene review: instances not booted, "
+            "rebooted, or updated in the last months (default 6), domains whose "
+            "zone has not changed in that time, profile SSH keys at least that "
+            "old that the profile user has not deployed to an instance, and "
+            "private images at least image_age_days old (default 365). Boots "
+            "and key deployments come from the account event feed, which covers "
+            "about 90 days, so older ones are not seen."
+        ),
+        inputSchema=schema("linode.mcp.v1.StaleResourcesInput"),
+    ), Capability.Read
+
+
+class _Data:
+    """What the report is built from. A None error means that section was
+    read."""
+
+    def __init__(self) -> None:
+        self.instances: list[Instance] = []
+        self.instances_error: Exception | None = None
+        self.images: list[Image] = []
+        self.images_error: Exception | None = None
+        self.domains: list[Domain] = []
+        self.domains_error: Exception | None = None
+        self.keys: list[SSHKey] = []
+        self.keys_error: Exception | None = None
+        self.username = ""
+        self.profile_error: Exception | None = None
+        self.events: list[dict[str, Any]] = []
+        self.events_error: Exception | None = None
+        self.complete = True
+
+
+def _days_since(timestamp: str, now: datetime) -> int:
+    """Whole days from an API timestamp to now, or 0 when it does not
+    parse."""
+    try:
+        at = datetime.strptime(timestamp, _LINODE_TIME_FORMAT).replace(tzinfo=UTC)
+    except ValueError:
+        return 0
+    return (now - at).days
+
+
+def _resource(
+    resource_id: int, label: str, last: str, now: datetime, reason: str
+) -> dict[str, Any]:
+    return {
+        "id": resource_id,
+        "label": label,
+        "last_activity": last,
+        "idle_days": _days_since(last, now),
+        "reason": reason,
+    }
+
+
+def _last_boots(events: list[dict[str, Any]]) -> dict[int, str]:
+    """Each instance's newest successful boot or reboot in the events, which
+    arrive newest first."""
+    boots: dict[int, str] = {}
+    for event in events:
+        entity = event.get("entity") or {}
+        if (
+            event.get("status") == "failed"
+            or entity.get("type") != "linode"
+            or event.get("action") not in _BOOT_ACTIONS
+        ):
+            continue
+        boots.setdefault(int(entity.get("id") or 0), str(event.get("created") or ""))
+    return boots
+
+
+def _warnings(data: _Data) -> list[str]:
+    """Explain each section the report could not cover."""
+    warnings = [
+        f"{name} unavailable: {error}"
+        for name, error in (
+            ("Instances", data.instances_error),
+            ("Images", data.images_error),
+            ("Domains", data.domains_error),
+            ("SSH keys", data.keys_error),
+        )
+        if error is not None
+    ]
+    if data.events_error is not None:
+        warnings.append(
+            "Events unavailable, so instance boots are not counted and no SSH "
+            f"key is flagged: {data.events_error}"
+        )
+    elif not data.complete:
+        warnings.append(
+            f"Only the newest {_EVENT_PAGE_SIZE * _EVENT_PAGES} events were "
+            "scanned; older instance boots and key deployments are not counted."
+        )
+    if data.profile_error is not None:
+        warnings.append(
+            f"Profile unavailable, so no SSH key is flagged: {data.profile_error}"
+        )
+    return warnings
+
+
+def _report(
+    data: _Data, now: datetime, months: int, image_age: int
+) -> dict[str, Any]:
+    """Build the report as of now; mirrors Go's staleResourcesReport. Linode
+    timestamps share one fixed layout, so they compare as strings."""
+    cutoff = (now - timedelta(days=months * _DAYS_PER_MONTH)).strftime(
+        _LINODE_TIME_FORMAT
+    )
+    events = data.events
+    response: dict[str, Any] = {
+        "months": months,
+        "image_age_days": image_age,
+        "cutoff": cutoff,
+        "events_scanned": len(events),
+        "events_since": str(events[-1].get("created") or "") if events else "",
+        "instances": [],
+        "images": [],
+        "domains": [],
+        "ssh_keys": [],
+        "warnings": _warnings(data),
+    }
+
+    boots = _last_boots(events)
+    for instance in data.instances:
+        last = max(instance.created, instance.updated, boots.get(instance.id, ""))
+        if last < cutoff:
+            response["instances"].append(
+                _resource(
+                    instance.id,
+                    instance.label,
+                    last,
+                    now,
+                    f"not booted, rebooted, or updated since {last}",
+                )
+            )
+
+    for domain in data.domains:
+        last = max(domain.created, domain.updated)
+        if last < cutoff:
+            response["domains"].append(
+                _resource(
+                    domain.id,
+                    domain.domain,
+                    last,
+                    now,
+                    f"zone not modified since {last}",
+                )
+            )
+
+    if data.events_error is None and data.profile_error is None:
+        for key in data.keys:
+            if key.created >= cutoff or _instances(events, data.username, key.created):
+                continue
+            response["ssh_keys"].append(
+                _resource(
+                    key.id,
+                    key.label,
+                    key.created,
+                    now,
+                    f"added {key.created} and not deployed by {data.username} "
+                    "in the events scanned",
+                )
+            )
+
+    for image in data.images:
+        if image.is_public:
+            continue
+        age = _days_since(image.created, now)
+        if age >= image_age:
+            response["images"].append(
+                {
+                    "id": image.id,
+                    "label": image.label,
+                    "created": image.created,
+                    "age_days": age,
+                    "size": image.size,
+                }
+            )
+
+    for section in ("instances", "domains", "ssh_keys"):
+        response[section].sort(key=lambda r: r["last_activity"])
+    response["images"].sort(key=lambda i: i["created"])
+
+    counts = {
+        s: len(response[s]) for s in ("instances", "images", "domains", "ssh_keys")
+    }
+    response["stale_count"] = sum(counts.values())
+    response["message"] = (
+        f"{response['stale_count']} stale resource(s) with no activity since "
+        f"{cutoff}: {counts['instances']} instance(s), {counts['domains']} "
+        f"domain(s), {counts['ssh_keys']} unused SSH key(s), and "
+        f"{counts['images']} private image(s) at least {image_age} days old"
+    )
+    return response
+
+
+def _bounded(arguments: dict[str, Any], key: str, default: int, limit: int) -> int:
+    """The integer argument key, default when absent; raises ValueError with
+    the tool's message when it is out of range."""
+    if key not in arguments:
+        return default
+    value = arguments[key]
+    if isinstance(value, bool) or not isinstance(value, int) or not 1 <= value <= limit:
+        msg = f"{key} must be from 1 through {limit}"
+        raise ValueError(msg)
+    return value
+
+
+async def handle_linode_stale_resources(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_stale_resources tool request."""
+    try:
+        months = _bounded(arguments, "months", _MONTHS_DEFAULT, _MONTHS_LIMIT)
+        image_age = _bounded(
+            arguments, "image_age_days", _IMAGE_AGE_DEFAULT, _IMAGE_AGE_LIMIT
+        )
+    except ValueError as exc:
+        return error_response(str(exc))
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        data = _Data()
+        try:
+            data.instances = await client.list_instances()
+        except _ListError as exc:
+            data.instances_error = exc
+        try:
+            data.images = await client.list_images()
+        except _ListError as exc:
+            data.images_error = exc
+        try:
+            data.domains = await client.list_domains()
+        except _ListError as exc:
+            data.domains_error = exc
+        try:
+            data.keys = await client.list_ssh_keys()
+        except _ListError as exc:
+            data.keys_error = exc
+        if (
+            data.instances_error is not None
+            and data.images_error is not None
+            and data.domains_error is not None
+            and data.keys_error is not None
+        ):
+            raise data.instances_error
+
+        try:
+            profile = await client.get_raw("/profile")
+            data.username = str(profile.get("username") or "")
+        except _ListError as exc:
+            data.profile_error = exc
+        try:
+            data.events, data.complete = await _events(client)
+        except _ListError as exc:
+            data.events_error = exc
+
+        report = _report(data, datetime.now(UTC), months, image_age)
+        for warning in report["warnings"]:
+            add_warning(warning)
+        return serialize_api_response(
+            report, stale_resources_pb2.StaleResourcesResponse()
+        )
+
+    return await execute_tool(cfg, arguments, "build stale resource report", _call)
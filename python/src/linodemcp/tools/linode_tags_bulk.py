@@ -0,0 +1,335 @@
+"""Linode bulk tag tools: rename a tag everywhere and delete empty tags."""
+
+from __future__ import annotations
+
+import json
+from typing import TYPE_CHECKING, Any, cast
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import tag_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import (
+    DryRunDetails,
+    error_response,
+    execute_dry_run,
+    execute_tool,
+    is_dry_run,
+    walk_page_items,
+)
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_TAGS_PAGE_SIZE_MIN = 25
+_TAGS_PAGE_SIZE_MAX = 500
+
+# Update endpoint for each tagged-object type linode_tags_retag can rewrite.
+_RETAG_PATHS = {
+    "linode": "/linode/instances",
+    "domain": "/domains",
+    "volume": "/volumes",
+    "nodebalancer": "/nodebalancers",
+}
+
+
+def create_linode_tags_retag_tool() -> tuple[Tool, Capability]:
+    """Create the linode_tags_retag tool."""
+    return Tool(
+        name="linode_tags_retag",
+        description=(
+            "Renames a tag across the account: every Linode, domain, volume, and "
+            "NodeBalancer tagged from_tag is updated to carry to_tag instead, and "
+            "from_tag is deleted once every object has moved. Pass dry_run=true "
+            "to preview the per-object tag changes without updating."
+        ),
+        inputSchema=schema("linode.mcp.v1.TagRetagInput"),
+    ), Capability.Write
+
+
+def _retag_args(arguments: dict[str, Any]) -> tuple[str, str, str]:
+    """Validate from_tag and to_tag (mirrors Go retagArgsFromTool)."""
+    from_tag = arguments.get("from_tag")
+    if not isinstance(from_tag, str) or not from_tag.strip():
+        return "", "", "from_tag must be a non-empty string"
+    if "?" in from_tag or "#" in from_tag or ".." in from_tag:
+        return "", "", "from_tag must not contain '?', '#', or '..'"
+    to_tag = arguments.get("to_tag")
+    if not isinstance(to_tag, str) or not to_tag.strip():
+        return "", "", "to_tag must be a non-empty string"
+    to_tag = to_tag.strip()
+    if to_tag == from_tag:
+        return "", "", "to_tag must differ from from_tag"
+    return from_tag, to_tag, ""
+
+
+async def all_tagged_objects(
+    client: RetryableClient, tag_label: str
+) -> list[dict[str, Any]]:
+    """Walk every page of GET /tags/{label}."""
+    raw = await client.list_tagged_objects(tag_label, page_size=_TAGS_PAGE_SIZE_MAX)
+    return walk_page_items(raw)
+
+
+def tagged_object_state(obj: dict[str, Any]) -> dict[str, Any]:
+    """Flatten one tagged object, matching Go's taggedObjectState. Domains carry
+    their name in "domain" rather than "label"."""
+    raw_data = obj.get("data")
+    data = cast("dict[str, Any]", raw_data) if isinstance(raw_data, dict) else {}
+    raw_tags = data.get("tags")
+    tags = cast("list[Any]", raw_tags) if isinstance(raw_tags, list) else []
+    raw_id = data.get("id")
+    return {
+        "type": str(obj.get("type") or ""),
+        "id": raw_id if isinstance(raw_id, int) else 0,
+        "label": str(data.get("label") or data.get("domain") or ""),
+        "tags": [tag for tag in tags if isinstance(tag, str)],
+    }
+
+
+def _retagged_tags(tags: list[str], from_tag: str, to_tag: str) -> list[str]:
+    """Replace from_tag with to_tag in place, dropping the replacement when
+    the object already carries to_tag."""
+    retagged: list[str] = []
+    for tag in tags:
+        if tag != from_tag:
+            retagged.append(tag)
+        elif to_tag not in tags:
+            retagged.append(to_tag)
+    return retagged
+
+
+def _retag_side_effects(
+    states: list[dict[str, Any]], from_tag: str, to_tag: str
+) -> DryRunDetails:
+    """Tier B walk: one line per object, then a summary."""
+    side_effects = [
+        f'{state["type"]} {state["id"]} ("{state["label"]}") tags change from '
+        f"[{', '.join(state['tags'])}] to "
+        f"[{', '.join(_retagged_tags(state['tags'], from_tag, to_tag))}]."
+        for state in states
+    ]
+    side_effects.append(
+        f'{len(states)} objects move from tag "{from_tag}" to "{to_tag}"; '
+        f'"{from_tag}" is deleted once all of them succeed.'
+    )
+    return {"side_effects": side_effects}
+
+
+async def _apply_retag(
+    client: RetryableClient,
+    from_tag: str,
+    to_tag: str,
+    objects: list[dict[str, Any]],
+) -> dict[str, Any]:
+    """Move each object from from_tag to to_tag, one at a time.
+
+    A failed object does not stop the run; its change entry carries the error
+    and from_tag is kept so the rename can be retried, as Go's applyRetag does.
+    """
+    changes: list[dict[str, Any]] = []
+    updated = failed = 0
+    for obj in objects:
+        state = tagged_object_state(obj)
+        new_tags = _retagged_tags(state["tags"], from_tag, to_tag)
+        change: dict[str, Any] = {
+            "type": state["type"],
+            "id": state["id"],
+            "label": state["label"],
+            "old_tags": state["tags"],
+            "new_tags": new_tags,
+        }
+        base = _RETAG_PATHS.get(state["type"])
+        if base is None:
+            change["error"] = (
+                "retagging is not supported for tagged objects of type: "
+                f"{state['type']}"
+            )
+            failed += 1
+            add_warning("%s %s: %s", state["type"], state["id"], change["error"])
+        else:
+            try:
+                await client.put_raw(f"{base}/{state['id']}", {"tags": new_tags})
+                updated += 1
+            except (APIError, NetworkError) as exc:
+                change["error"] = str(exc)
+                failed += 1
+                add_warning("%s %s: %s", state["type"], state["id"], exc)
+        changes.append(change)
+
+    old_tag_deleted = False
+    if updated > 0 and failed == 0:
+        try:
+            await client.delete_tag(from_tag)
+            old_tag_deleted = True
+        except (APIError, NetworkError) as exc:
+            old_tag_deleted = False
+            add_warning("tag %s was not deleted: %s", json.dumps(from_tag), exc)
+
+    return serialize_api_response(
+        {
+            "message": (
+                f'Retagged "{from_tag}" to "{to_tag}": {updated} updated, '
+                f"{failed} failed"
+            ),
+            "from_tag": from_tag,
+            "to_tag": to_tag,
+            "matched": len(objects),
+            "updated": updated,
+            "failed": failed,
+            "old_tag_deleted": old_tag_deleted,
+            "changes": changes,
+        },
+        tag_pb2.TagRetagResponse(),
+    )
+
+
+async def handle_linode_tags_retag(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_tags_retag tool request."""
+    if is_dry_run(arguments):
+        from_tag, to_tag, args_error = _retag_args(arguments)
+        if args_error:
+            return error_response(args_error)
+
+        async def _fetch(client: RetryableClient) -> Any:
+            objects = await all_tagged_objects(client, from_tag)
+            return [tagged_object_state(obj) for obj in objects]
+
+        async def _walk(_client: RetryableClient, state: Any) -> DryRunDetails:
+            return _retag_side_effects(state, from_tag, to_tag)
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_tags_retag",
+            "PUT",
+            "/{resource}/{id}",
+            _fetch,
+            _walk,
+        )
+
+    if not arguments.get("confirm"):
+        return error_response(
+            "This moves every object tagged from_tag to to_tag. "
+            "Set confirm=true to proceed."
+        )
+
+    from_tag, to_tag, args_error = _retag_args(arguments)
+    if args_error:
+        return error_response(args_error)
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        objects = await all_tagged_objects(client, from_tag)
+        return await _apply_retag(client, from_tag, to_tag, objects)
+
+    return await execute_tool(cfg, arguments, "list tagged objects", _call)
+
+
+def create_linode_tags_cleanup_tool() -> tuple[Tool, Capability]:
+    """Create the linode_tags_cleanup tool."""
+    return Tool(
+        name="linode_tags_cleanup",
+        description=(
+            "Deletes every tag on the account that has no tagged objects. "
+            "Pass dry_run=true to list the empty tags without deleting them."
+        ),
+        inputSchema=schema("linode.mcp.v1.TagCleanupInput"),
+    ), Capability.Destroy
+
+
+async def _empty_tags(client: RetryableClient) -> tuple[list[str], int]:
+    """Return the labels of tags with no tagged objects and the number of
+    tags scanned."""
+    labels: list[str] = []
+    page = 1
+    while True:
+        items = walk_page_items(
+            await client.list_tags(page=page, page_size=_TAGS_PAGE_SIZE_MAX)
+        )
+        labels.extend(str(item.get("label") or "") for item in items)
+        if len(items) < _TAGS_PAGE_SIZE_MAX:
+            break
+        page += 1
+
+    empty: list[str] = []
+    for label in labels:
+        raw = await client.list_tagged_objects(
+            label, page=1, page_size=_TAGS_PAGE_SIZE_MIN
+        )
+        if not raw.get("results"):
+            empty.append(label)
+    return empty, len(labels)
+
+
+def _cleanup_side_effects(empty: list[str]) -> DryRunDetails:
+    """Tier B walk: one line per empty tag, then a summary."""
+    side_effects = [
+        f'Tag "{tag}" has no tagged objects and is deleted.' for tag in empty
+    ]
+    side_effects.append(f"{len(empty)} empty tags would be deleted.")
+    return {"side_effects": side_effects}
+
+
+async def handle_linode_tags_cleanup(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_tags_cleanup tool request."""
+    if is_dry_run(arguments):
+
+        async def _fetch(client: RetryableClient) -> Any:
+            empty, _ = await _empty_tags(client)
+            return empty
+
+        async def _walk(_client: RetryableClient, state: Any) -> DryRunDetails:
+            return _cleanup_side_effects(state)
+
+        return await execute_dry_run(
+            cfg,
+            arguments,
+            "linode_tags_cleanup",
+            "DELETE",
+            "/tags/{tag_label}",
+            _fetch,
+            _walk,
+        )
+
+    if not arguments.get("confirm"):
+        return error_response(
+            "This deletes every tag with no tagged objects. "
+            "Set confirm=true to proceed."
+        )
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        empty, scanned = await _empty_tags(client)
+        deleted: list[str] = []
+        failures: list[dict[str, Any]] = []
+        for tag in empty:
+            try:
+                await client.delete_tag(tag)
+                deleted.append(tag)
+            except (APIError, NetworkError) as exc:
+                failures.append({"tag": tag, "error": str(exc)})
+                add_warning("tag %s: %s", json.dumps(tag), exc)
+        return serialize_api_response(
+            {
+                "message": (
+                    f"Scanned {scanned} tags: {len(deleted)} empty tags deleted, "
+                    f"{len(failures)} failed"
+                ),
+                "scanned": scanned,
+                "deleted": len(deleted),
+                "failed": len(failures),
+                "deleted_tags": deleted,
+                "failures": failures,
+            },
+            tag_pb2.TagCleanupResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "scan tags", _call)
@@ -0,0 +1,287 @@
+"""linode_timeline: recent account events as a narrative per resource.
+
+Events in the window are read newest first, then told oldest first and grouped
+by the resource they happened to, such as "web-1: created → booted → firewall
+edge attached". Consecutive repeats collapse into one step with a count.
+
+Mirrors ``go/internal/tools/linode_timeline.go``.
+"""
+
+from __future__ import annotations
+
+from datetime import UTC, datetime, timedelta
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import timeline_pb2
+from linodemcp.linode.list_options import (
+    ListOptions,
+    reset_list_options,
+    set_list_options,
+)
+from linodemcp.profiles import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import (
+    error_response,
+    execute_tool,
+    pagination_int_argument,
+)
+from linodemcp.tools.linode_sshkey_usage_report import _LINODE_TIME_FORMAT
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_DAYS_DEFAULT = 7
+_DAYS_LIMIT = 90
+
+# The feed read stops after the newest 5000 events in the window. The API
+# filters the feed to the window, so the cap only bites on an account that
+# busy (mirrors Go's timelineEventPageSize and timelineEventPages).
+_EVENT_PAGE_SIZE = 500
+_EVENT_PAGES = 10
+
+# Joins a resource's steps in its narrative.
+_ARROW = " → "
+
+# An action's final word in the past tense, so linode_boot reads "booted" and
+# disk_create reads "disk created". Words not listed are kept as they are.
+_VERBS = {
+    "add": "added",
+    "attach": "attached",
+    "boot": "booted",
+    "cancel": "canceled",
+    "clone": "cloned",
+    "create": "created",
+    "delete": "deleted",
+    "detach": "detached",
+    "disable": "disabled",
+    "enable": "enabled",
+    "migrate": "migrated",
+    "reboot": "rebooted",
+    "rebuild": "rebuilt",
+    "remove": "removed",
+    "resize": "resized",
+    "restore": "restored",
+    "shutdown": "shut down",
+    "update": "updated",
+    "upgrade": "upgraded",
+}
+
+# Firewall events told from the device's side: the event's entity is the
+# firewall and its secondary entity the instance or NodeBalancer.
+_DEVICE_ACTIONS = {
+    "firewall_device_add": "attached",
+    "firewall_device_remove": "detached",
+}
+
+
+def create_linode_timeline_tool() -> tuple[Tool, Capability]:
+    """Create the linode_timeline tool."""
+    return Tool(
+        name="linode_timeline",
+        description=(
+            "Renders the last days of account events (default 7, up to 90) as a "
+            "chronological narrative per resource, such as \"web-1: created → "
+            'booted → firewall edge attached", with each step\'s time, user, and '
+            "status. Repeated events collapse into one step with a count. Pass "
+            "entity_type (for example linode or volume) to narrate only that "
+            "kind of resource. The window is read up to its newest 5000 events; "
+            "pass page (and page_size) to narrate it one page at a time instead. "
+            "Use linode_account_event_list for the raw events."
+        ),
+        inputSchema=schema("linode.mcp.v1.TimelineInput"),
+    ), Capability.Read
+
+
+async def _events(
+    client: RetryableClient,
+    since: str,
+    page: int | None = None,
+    page_size: int = _EVENT_PAGE_SIZE,
+) -> tuple[list[dict[str, Any]], bool]:
+    """Read the event feed newest first until it passes since or reaches the
+    timeline's scan cap, or read the one page asked for.
+
+    The flag is False when the cap or the page boundary cut the read short.
+    The API is asked for the window alone (_list_options); the since check
+    still stops a read whose filter was not applied.
+    """
+    events: list[dict[str, Any]] = []
+    token = set_list_options(_list_options(since))
+    try:
+        if page is not None:
+            body = await client.list_account_events(page, page_size)
+            events = list(body.get("data") or [])
+            last_created = str(events[-1].get("created") or "") if events else ""
+            return events, len(events) < page_size or last_created < since
+        for page in range(1, _EVENT_PAGES + 1):
+            body = await client.list_account_events(page, _EVENT_PAGE_SIZE)
+            batch = list(body.get("data") or [])
+            events.extend(batch)
+            last_created = str(batch[-1].get("created") or "") if batch else ""
+            if len(batch) < _EVENT_PAGE_SIZE or last_created < since:
+                return events, True
+        return events, False
+    finally:
+        reset_list_options(token)
+
+
+def _list_options(since: str) -> ListOptions:
+    """Ask the API for the events created at or after since, newest first."""
+    return ListOptions(
+        filter={"created": {"+gte": since}}, order_by="created", order="desc"
+    )
+
+
+def _summary(action: str, entity_type: str) -> str:
+    """An action in words, dropping the entity type it starts with."""
+    words = action.removeprefix(entity_type + "_").split("_")
+    words[-1] = _VERBS.get(words[-1], words[-1])
+    return " ".join(words)
+
+
+def _subject(event: dict[str, Any]) -> tuple[dict[str, Any], str]:
+    """The resource an event happened to and the event in words."""
+    action = str(event.get("action") or "")
+    entity = event.get("entity") or {}
+    secondary = event.get("secondary_entity") or {}
+    verb = _DEVICE_ACTIONS.get(action)
+    if verb is not None and secondary.get("type"):
+        firewall = ("firewall " + str(entity.get("label") or "")).strip()
+        return secondary, f"{firewall} {verb}"
+    return entity, _summary(action, str(entity.get("type") or ""))
+
+
+def _narrative(resource: dict[str, Any]) -> str:
+    """A resource's steps on one line, marking failed steps and repeats."""
+    label = resource["label"] or f"{resource['entity_type']} {resource['entity_id']}"
+    parts = []
+    for step in resource["steps"]:
+        part = step["summary"]
+        if step["status"] == "failed":
+            part += " (failed)"
+        if step["count"] > 1:
+            part += f" ×{step['count']}"
+        parts.append(part)
+    return f"{label}: {_ARROW.join(parts)}"
+
+
+def _report(
+    events: list[dict[str, Any]], since: str, entity_type: str, warnings: list[str]
+) -> dict[str, Any]:
+    """Narrate the events created at or after since, oldest first, grouped by
+    resource. An event read twice (the feed shifts while it is paged) counts
+    once."""
+    window: list[dict[str, Any]] = []
+    seen: set[int] = set()
+    for event in events:
+        event_id = int(event.get("id") or 0)
+        if str(event.get("created") or "") < since or event_id in seen:
+            continue
+        seen.add(event_id)
+        window.append(event)
+    window.sort(key=lambda e: (str(e.get("created") or ""), int(e.get("id") or 0)))
+
+    resources: dict[str, dict[str, Any]] = {}
+    narrated = 0
+    for event in window:
+        subject, summary = _subject(event)
+        kind = str(subject.get("type") or "")
+        if not kind or (entity_type and kind != entity_type):
+            continue
+        narrated += 1
+        created = str(event.get("created") or "")
+        entity_id = int(subject.get("id") or 0)
+        resource = resources.setdefault(
+            f"{kind}/{entity_id}",
+            {
+                "entity_type": kind,
+                "entity_id": entity_id,
+                "label": "",
+                "narrative": "",
+                "first_at": created,
+                "last_at": "",
+                "steps": [],
+            },
+        )
+        if subject.get("label"):
+            resource["label"] = str(subject["label"])
+        resource["last_at"] = created
+        status = str(event.get("status") or "")
+        steps = resource["steps"]
+        if steps and steps[-1]["summary"] == summary and steps[-1]["status"] == status:
+            steps[-1]["count"] += 1
+            continue
+        steps.append(
+            {
+                "action": str(event.get("action") or ""),
+                "summary": summary,
+                "at": created,
+                "username": str(event.get("username") or ""),
+                "status": status,
+                "count": 1,
+            }
+        )
+
+    for resource in resources.values():
+        resource["narrative"] = _narrative(resource)
+
+    return {
+        "message": (
+            f"{narrated} event(s) across {len(resources)} resource(s) since {since}"
+        ),
+        "events_scanned": len(events),
+        "since": since,
+        "resource_count": len(resources),
+        "resources": list(resources.values()),
+        "warnings": warnings,
+    }
+
+
+async def handle_linode_timeline(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_timeline tool request."""
+    days = _DAYS_DEFAULT
+    if "days" in arguments:
+        value = arguments["days"]
+        if (
+            isinstance(value, bool)
+            or not isinstance(value, int)
+            or not 1 <= value <= _DAYS_LIMIT
+        ):
+            return error_response(f"days must be from 1 through {_DAYS_LIMIT}")
+        days = value
+    entity_type = str(arguments.get("entity_type") or "").strip()
+    try:
+        page = pagination_int_argument(arguments, "page", 1)
+        page_size = pagination_int_argument(arguments, "page_size", 25, 500)
+    except (TypeError, ValueError) as exc:
+        return error_response(str(exc))
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        since = (datetime.now(UTC) - timedelta(days=days)).strftime(_LINODE_TIME_FORMAT)
+        events, complete = await _events(
+            client, since, page, page_size or _EVENT_PAGE_SIZE
+        )
+        warnings: list[str] = []
+        if not complete:
+            warnings.append(
+                f"Only the newest {_EVENT_PAGE_SIZE * _EVENT_PAGES} events were "
+                f"read; pass page {_EVENT_PAGES + 1} for earlier events in the "
+                "window."
+                if page is None
+                else f"Only page {page} of the window's events was read; pass "
+                f"page {page + 1} for earlier events."
+            )
+            add_warning(warnings[-1])
+        return serialize_api_response(
+            _report(events, since, entity_type, warnings),
+            timeline_pb2.TimelineResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "build timeline", _call)
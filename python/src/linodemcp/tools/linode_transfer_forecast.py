@@ -0,0 +1,176 @@
+"""Linode transfer forecast tool: project the transfer pool to month end."""
+
+from __future__ import annotations
+
+import math
+from datetime import UTC, datetime, timedelta
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import account_pb2
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import error_response, execute_tool, walk_page_items
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+_TOP_DEFAULT = 5
+_TOP_MAX = 50
+# The account pool is reported in GB and instance transfer in bytes;
+# Linode's transfer GB is decimal.
+_BYTES_PER_GB = 1e9
+
+
+def create_linode_transfer_forecast_tool() -> tuple[Tool, Capability]:
+    """Create the linode_transfer_forecast tool."""
+    return Tool(
+        name="linode_transfer_forecast",
+        description=(
+            "Projects the account's month-to-date network transfer to the end "
+            "of the month (a linear extrapolation over the UTC calendar month) "
+            "and warns when the pool quota is likely to be exceeded. Lists the "
+            "instances using the most transfer (top, default 5) with their own "
+            "projections and share of the pool."
+        ),
+        inputSchema=schema("linode.mcp.v1.TransferForecastInput"),
+    ), Capability.Read
+
+
+def _round_tenth(value: float) -> float:
+    """Round half away from zero to one decimal place, matching Go's
+    math.Round, so both languages report the same figures."""
+    return math.floor(value * 10 + 0.5) / 10
+
+
+async def _instance_transfer_usage(
+    client: RetryableClient, instances: list[dict[str, Any]]
+) -> tuple[list[dict[str, Any]], list[str]]:
+    """Read each instance's month-to-date transfer, one at a time. An instance
+    whose transfer cannot be read is skipped with a warning."""
+    contributors: list[dict[str, Any]] = []
+    warnings: list[str] = []
+    for instance in instances:
+        instance_id = int(instance.get("id") or 0)
+        label = str(instance.get("label") or "")
+        try:
+            transfer = await client.get_raw(f"/linode/instances/{instance_id}/transfer")
+        except (APIError, NetworkError) as exc:
+            warnings.append(
+                f"Instance {instance_id} ({label}) transfer unavailable: {exc}"
+            )
+            continue
+        used = transfer.get("used") if isinstance(transfer, dict) else 0
+        contributors.append(
+            {
+                "id": instance_id,
+                "label": label,
+                "region": str(instance.get("region") or ""),
+                "used_gb": (used if isinstance(used, int) else 0) / _BYTES_PER_GB,
+            }
+        )
+    return contributors, warnings
+
+
+def _transfer_forecast(
+    pool: dict[str, Any],
+    contributors: list[dict[str, Any]],
+    warnings: list[str],
+    now: datetime,
+    top: int,
+) -> dict[str, Any]:
+    """Extrapolate the pool and each contributor linearly over the UTC
+    calendar month containing now (mirrors Go transferForecast)."""
+    quota = int(pool.get("quota") or 0)
+    used = int(pool.get("used") or 0)
+    billable = int(pool.get("billable") or 0)
+
+    month_start = datetime(now.year, now.month, 1, tzinfo=UTC)
+    next_month = datetime(
+        now.year + now.month // 12, now.month % 12 + 1, 1, tzinfo=UTC
+    )
+    # Count at least an hour so a forecast in the month's first minutes stays
+    # finite.
+    elapsed = max(now - month_start, timedelta(hours=1)) / (next_month - month_start)
+
+    projected = _round_tenth(used / elapsed)
+    overage = _round_tenth(max(used / elapsed - quota, 0))
+    elapsed_pct = _round_tenth(elapsed * 100)
+
+    ranked = sorted(contributors, key=lambda c: (-c["used_gb"], c["id"]))
+    top_contributors = [
+        {
+            **contributor,
+            "used_gb": _round_tenth(contributor["used_gb"]),
+            "projected_gb": _round_tenth(contributor["used_gb"] / elapsed),
+            "share_pct": (
+                _round_tenth(contributor["used_gb"] / used * 100) if used > 0 else 0
+            ),
+        }
+        for contributor in ranked[:top]
+    ]
+
+    response_warnings: list[str] = []
+    if billable > 0:
+        response_warnings.append(
+            f"The pool is already {billable} GB over quota; overage is billed per GB."
+        )
+    if overage > 0:
+        response_warnings.append(
+            f"At the current pace the pool reaches {projected:.1f} GB by month "
+            f"end, {overage:.1f} GB over the {quota} GB quota."
+        )
+        message = (
+            f"Transfer overage likely: projected {projected:.1f} GB against a "
+            f"{quota} GB pool ({elapsed_pct:.1f}% of the month elapsed)"
+        )
+    else:
+        message = (
+            f"Transfer on pace for {projected:.1f} GB of the {quota} GB pool "
+            f"({elapsed_pct:.1f}% of the month elapsed)"
+        )
+    response_warnings.extend(warnings)
+
+    return {
+        "message": message,
+        "quota_gb": quota,
+        "used_gb": used,
+        "billable_gb": billable,
+        "month_elapsed_pct": elapsed_pct,
+        "projected_gb": projected,
+        "projected_overage_gb": overage,
+        "overage_likely": overage > 0,
+        "instances_checked": len(contributors),
+        "top_contributors": top_contributors,
+        "warnings": response_warnings,
+    }
+
+
+async def handle_linode_transfer_forecast(
+    arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Handle linode_transfer_forecast tool request."""
+    top = arguments.get("top", _TOP_DEFAULT)
+    if not isinstance(top, int) or isinstance(top, bool) or not 1 <= top <= _TOP_MAX:
+        return error_response(f"top must be from 1 through {_TOP_MAX}")
+
+    async def _call(client: RetryableClient) -> dict[str, Any]:
+        pool = await client.get_raw("/account/transfer")
+        instances = walk_page_items(await client.get_raw("/linode/instances"))
+        contributors, warnings = await _instance_transfer_usage(client, instances)
+        return serialize_api_response(
+            _transfer_forecast(
+                pool if isinstance(pool, dict) else {},
+                contributors,
+                warnings,
+                datetime.now(UTC),
+                top,
+            ),
+            account_pb2.TransferForecastResponse(),
+        )
+
+    return await execute_tool(cfg, arguments, "forecast account transfer", _call)
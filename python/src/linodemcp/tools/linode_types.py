@@ -2,13 +2,18 @@
 
 from __future__ import annotations
 
+import re
 from typing import TYPE_CHECKING, Any
 
 from mcp.types import TextContent, Tool
 
 from linodemcp.genpb.linode.mcp.v1 import type_pb2
 from linodemcp.profiles import Capability
-from linodemcp.tools.helpers import error_response, execute_tool
+from linodemcp.tools.helpers import (
+    error_response,
+    execute_tool,
+    pagination_int_argument,
+)
 from linodemcp.tools.proto_response import (
     serialize_api_response,
     serialize_list_response,
@@ -24,42 +29,113 @@ def _is_type_id(value: str) -> bool:
     return bool(value) and all(c.isalnum() or c == "-" for c in value)
 
 
+# The class values linode_type_list accepts, in the order its error message
+# lists them (mirrors Go's instanceTypeClasses).
+_INSTANCE_TYPE_CLASSES = (
+    "nanode",
+    "standard",
+    "dedicated",
+    "highmem",
+    "premium",
+    "gpu",
+)
+
+# Lowercase region slug (mirrors Go's validRegionSlugRegex).
+_REGION_SLUG_RE = re.compile(r"^[a-z0-9][a-z0-9-]*[a-z0-9]$")
+
+
 def create_linode_type_list_tool() -> tuple[Tool, Capability]:
     """Create the linode_type_list tool."""
     return Tool(
         name="linode_type_list",
         description=(
-            "Lists all available Linode instance types (plans) with pricing. "
-            "Can filter by class (standard, dedicated, gpu, highmem, premium)."
+            "Lists all available Linode instance types (plans) with pricing "
+            "information. Can filter by class (nanode, standard, dedicated, "
+            "highmem, premium, gpu), by minimum vcpus and memory (MB), and by "
+            "region, which leaves out plans the region reports as unavailable. "
+            "Use class=gpu with a region to find GPU plans you can deploy there."
         ),
         inputSchema=schema("linode.mcp.v1.InstanceTypeListInput"),
     ), Capability.Read
 
 
+def _type_list_filters(
+    arguments: dict[str, Any],
+) -> tuple[str, int | None, int | None, str]:
+    """Validate the linode_type_list filters as (class, min_vcpus, min_memory,
+    region). Raises ValueError or TypeError with the Go handler's message."""
+    class_filter = arguments.get("class", "")
+    if not isinstance(class_filter, str):
+        class_filter = ""
+    if class_filter and class_filter.lower() not in _INSTANCE_TYPE_CLASSES:
+        msg = "class must be one of " + ", ".join(_INSTANCE_TYPE_CLASSES)
+        raise ValueError(msg)
+    min_vcpus = pagination_int_argument(arguments, "min_vcpus", 1)
+    min_memory = pagination_int_argument(arguments, "min_memory", 1)
+    region = arguments.get("region", "")
+    if not isinstance(region, str):
+        region = ""
+    if region and not _REGION_SLUG_RE.fullmatch(region):
+        msg = "region must contain only lowercase letters, numbers, and hyphens"
+        raise ValueError(msg)
+    return class_filter, min_vcpus, min_memory, region
+
+
 async def handle_linode_type_list(
     arguments: dict[str, Any], cfg: Any
 ) -> list[TextContent]:
     """Handle linode_type_list tool request.
 
     Class is a case-insensitive exact match against the type's class field,
-    mirroring the Go list tool's fieldFilter. The output is the proto-canonical
+    mirroring the Go list tool's fieldFilter. min_vcpus and min_memory are
+    lower bounds, and region drops the types that region's availability list
+    marks unavailable; the list only covers plans with limited capacity, so a
+    type it does not mention is kept. The output is the proto-canonical
     InstanceType envelope: every type element carries the full field set, so the
     raw API page flows through serialize_list_response unmodified.
     """
-    class_filter: str = arguments.get("class", "")
-
-    def _matches(type_: dict[str, Any]) -> bool:
-        if not class_filter:
-            return True
-        return str(type_.get("class", "")).lower() == class_filter.lower()
+    try:
+        class_filter, min_vcpus, min_memory, region = _type_list_filters(arguments)
+    except (TypeError, ValueError) as exc:
+        return error_response(str(exc))
+
+    applied: list[str] = []
+    if class_filter:
+        applied.append(f"class={class_filter}")
+    if min_vcpus is not None:
+        applied.append(f"min_vcpus={min_vcpus}")
+    if min_memory is not None:
+        applied.append(f"min_memory={min_memory}")
+    if region:
+        applied.append(f"region={region}")
 
     async def _call(client: RetryableClient) -> dict[str, Any]:
         raw = await client.get_raw("/linode/types")
+        unavailable: set[str] = set()
+        if region:
+            availability = await client.get_region_availability(region)
+            unavailable = {
+                str(entry.get("plan", ""))
+                for entry in availability
+                if not entry.get("available", False)
+            }
+
+        def _matches(type_: dict[str, Any]) -> bool:
+            if class_filter and (
+                str(type_.get("class", "")).lower() != class_filter.lower()
+            ):
+                return False
+            if min_vcpus is not None and int(type_.get("vcpus") or 0) < min_vcpus:
+                return False
+            if min_memory is not None and int(type_.get("memory") or 0) < min_memory:
+                return False
+            return str(type_.get("id", "")) not in unavailable
+
         return serialize_list_response(
             raw,
             "types",
             type_pb2.InstanceTypeListResponse(),
-            filter_value=f"class={class_filter}" if class_filter else None,
+            filter_value=", ".join(applied) or None,
             item_filter=_matches,
         )
 
@@ -0,0 +1,90 @@
+"""Release version check tool.
+
+``linode_version_check`` asks the GitHub releases endpoint
+(``update_check.url``) whether a newer LinodeMCP exists and returns the
+latest version, its release page, and the first changelog bullets. With
+``update_check.enabled: false`` it fetches nothing and says so. CapMeta, so
+every profile can read it; talks to GitHub, never the Linode API.
+
+Mirrors ``go/internal/tools/linode_version_check.go``.
+"""
+
+from __future__ import annotations
+
+import json
+from typing import TYPE_CHECKING, Any
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.genpb.linode.mcp.v1 import version_pb2
+from linodemcp.profiles import Capability
+from linodemcp.tools.helpers import error_response, resolve_config
+from linodemcp.tools.proto_response import serialize_api_response
+from linodemcp.tools.toolschemas import schema
+from linodemcp.updatecheck import CheckResult, UpdateCheckError, check
+from linodemcp.version import get_version_info
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+
+
+def create_linode_version_check_tool() -> tuple[Tool, Capability]:
+    """Build the ``linode_version_check`` MCP tool definition."""
+    return (
+        Tool(
+            name="linode_version_check",
+            description=(
+                "Check GitHub releases for a newer LinodeMCP version and "
+                "summarize its changelog highlights. Reports that the check is "
+                "disabled when update_check.enabled is false (air-gapped "
+                "installs)."
+            ),
+            inputSchema=schema("linode.mcp.v1.VersionCheckInput"),
+        ),
+        Capability.Meta,
+    )
+
+
+def _version_check_dict(result: CheckResult) -> dict[str, Any]:
+    """Map a completed check onto the wire response (Go's versionCheckProto)."""
+    message = (
+        f"LinodeMCP {result.current} is up to date "
+        f"(latest release {result.latest})."
+    )
+    if result.update_available:
+        message = (
+            f"LinodeMCP {result.latest} is available (running {result.current})."
+        )
+    return {
+        "message": message,
+        "checked": True,
+        "current_version": result.current,
+        "latest_version": result.latest,
+        "update_available": result.update_available,
+        "release_url": result.release_url,
+        "published_at": result.published_at,
+        "highlights": result.highlights,
+    }
+
+
+async def handle_linode_version_check(
+    _arguments: dict[str, Any], cfg: Config
+) -> list[TextContent]:
+    """Check for a newer release and return proto-canonical JSON."""
+    live = resolve_config(cfg)
+    current = get_version_info().version
+
+    if not live.update_check.enabled:
+        payload: dict[str, Any] = {
+            "message": "Update check is disabled (update_check.enabled: false).",
+            "current_version": current,
+        }
+    else:
+        try:
+            result = await check(live.update_check.url, current)
+        except UpdateCheckError as exc:
+            return error_response(f"failed to check for updates: {exc}")
+        payload = _version_check_dict(result)
+
+    body = serialize_api_response(payload, version_pb2.VersionCheckResponse())
+    return [TextContent(type="text", text=json.dumps(body, indent=2))]
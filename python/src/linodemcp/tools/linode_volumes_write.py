@@ -20,6 +20,11 @@ from linodemcp.tools.helpers import (
 from linodemcp.tools.proto_response import raw_int, raw_str, serialize_api_response
 from linodemcp.tools.toolschemas import schema
 from linodemcp.tools.twostage_destroy import run_two_stage_destroy
+from linodemcp.tools.volume_bootstrap import (
+    volume_bootstrap_options,
+    volume_bootstrap_payload,
+)
+from linodemcp.tools.volume_wait import volume_wait_payload
 from linodemcp.twostage.hash_ignore import hash_ignore_fields
 
 if TYPE_CHECKING:
@@ -33,7 +38,11 @@ def create_linode_volume_create_tool() -> tuple[Tool, Capability]:
         name="linode_volume_create",
         description=(
             "Creates a new block storage volume. WARNING: Billing starts immediately."
-            " Pass dry_run=true to preview without creating."
+            " Pass dry_run=true to preview without creating. Pass wait=true to"
+            " return once the volume is active, with the commands that format"
+            " and mount it. With linode_id, pass bootstrap=true for a re-runnable"
+            " script that partitions, formats, and mounts it (filesystem,"
+            " mount_point); it never reformats a volume that holds a filesystem."
         ),
         inputSchema=schema("linode.mcp.v1.VolumeCreateInput"),
     ), Capability.Write
@@ -66,10 +75,15 @@ async def handle_linode_volume_create(
 ) -> list[TextContent]:
     """Handle linode_volume_create tool request."""
     label = arguments.get("label", "")
+    bootstrap, bootstrap_error = volume_bootstrap_options(
+        arguments, int(arguments.get("linode_id") or 0)
+    )
 
     if is_dry_run(arguments):
         if not label:
             return error_response("label is required")
+        if bootstrap_error:
+            return error_response(bootstrap_error)
         size = arguments.get("size", 20)
         region = arguments.get("region")
         attach_to = arguments.get("linode_id")
@@ -101,6 +115,8 @@ async def handle_linode_volume_create(
     fields_error = _volume_create_error(arguments)
     if fields_error is not None:
         return fields_error
+    if bootstrap_error:
+        return error_response(bootstrap_error)
 
     body: dict[str, Any] = {"label": label}
     # Send size only when the caller provided it; an omitted size lets the API
@@ -118,16 +134,17 @@ async def handle_linode_volume_create(
         vol_label = raw_str(raw, "label")
         vol_id = raw_int(raw, "id")
         vol_region = raw_str(raw, "region")
-        return serialize_api_response(
-            {
-                "message": (
-                    f"Volume '{vol_label}' (ID: {vol_id}) "
-                    f"created successfully in {vol_region}"
-                ),
-                "volume": raw,
-            },
-            volume_pb2.VolumeWriteResponse(),
-        )
+        payload: dict[str, Any] = {
+            "message": (
+                f"Volume '{vol_label}' (ID: {vol_id}) "
+                f"created successfully in {vol_region}"
+            ),
+            "volume": raw,
+        }
+        linode_id = int(arguments.get("linode_id") or 0)
+        await volume_wait_payload(client, arguments, payload, linode_id)
+        volume_bootstrap_payload(payload, bootstrap, linode_id)
+        return serialize_api_response(payload, volume_pb2.VolumeWriteResponse())
 
     return await execute_tool(cfg, arguments, "create volume", _call)
 
@@ -218,7 +235,13 @@ def create_linode_volume_attach_tool() -> tuple[Tool, Capability]:
     """Create the linode_volume_attach tool."""
     return Tool(
         name="linode_volume_attach",
-        description="Attaches a block storage volume to a Linode instance.",
+        description=(
+            "Attaches a block storage volume to a Linode instance. Pass wait=true"
+            " to return once the volume is active on the instance, with the"
+            " commands that mount it. Pass bootstrap=true for a re-runnable script"
+            " that partitions, formats, and mounts it (filesystem, mount_point);"
+            " it never reformats a volume that holds a filesystem."
+        ),
         inputSchema=schema("linode.mcp.v1.VolumeAttachInput"),
     ), Capability.Write
 
@@ -248,6 +271,9 @@ async def handle_linode_volume_attach(
         return error_response("volume_id is required")
     if not linode_id:
         return error_response("linode_id is required")
+    bootstrap, bootstrap_error = volume_bootstrap_options(arguments, int(linode_id))
+    if bootstrap_error:
+        return error_response(bootstrap_error)
 
     if is_dry_run(arguments):
 
@@ -284,15 +310,15 @@ async def handle_linode_volume_attach(
     async def _call(client: RetryableClient) -> dict[str, Any]:
         endpoint = f"/volumes/{int(volume_id)}/attach"
         raw = await client.post_raw(endpoint, body)
-        return serialize_api_response(
-            {
-                "message": (
-                    f"Volume {volume_id} attached to Linode {linode_id} successfully"
-                ),
-                "volume": raw,
-            },
-            volume_pb2.VolumeWriteResponse(),
-        )
+        payload: dict[str, Any] = {
+            "message": (
+                f"Volume {volume_id} attached to Linode {linode_id} successfully"
+            ),
+            "volume": raw,
+        }
+        await volume_wait_payload(client, arguments, payload, int(linode_id))
+        volume_bootstrap_payload(payload, bootstrap, int(linode_id))
+        return serialize_api_response(payload, volume_pb2.VolumeWriteResponse())
 
     return await execute_tool(cfg, arguments, "attach volume", _call)
 
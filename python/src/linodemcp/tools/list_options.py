@@ -0,0 +1,134 @@
+"""Per-call filter, order_by, and order arguments on every list tool.
+
+The dispatch path sends them to the API as the X-Filter header of each
+paginated list the call reads, so filtering and sorting happen server-side
+instead of after every page is fetched. Mirrors Go's tools/list_options.go:
+the same arguments, schema entries, and error text.
+"""
+
+from __future__ import annotations
+
+import json
+from typing import TYPE_CHECKING, Any, cast
+
+from linodemcp.linode.list_options import ListOptions
+
+if TYPE_CHECKING:
+    from mcp.types import Tool
+
+PARAM_FILTER = "filter"
+PARAM_ORDER_BY = "order_by"
+PARAM_ORDER = "order"
+
+ERR_LIST_FILTER_NOT_OBJECT = "filter must be a JSON object of Linode filter expressions"
+ERR_LIST_ORDER_BY_NOT_TEXT = "order_by must be a field name"
+ERR_LIST_ORDER_INVALID = 'order must be "asc" or "desc"'
+ERR_LIST_ORDER_NO_ORDER_BY = "order needs order_by"
+
+# The schema entries add_list_options_schema adds.
+_LIST_OPTIONS_PROPERTIES: dict[str, dict[str, str]] = {
+    PARAM_FILTER: {
+        "type": "object",
+        "description": (
+            'Server-side Linode filter (sent as X-Filter), e.g. {"region": '
+            '"us-east"} or {"+or": [{"label": "a"}, {"label": "b"}]}.'
+        ),
+    },
+    PARAM_ORDER_BY: {
+        "type": "string",
+        "description": "Field to sort by on the server, e.g. label or created.",
+    },
+    PARAM_ORDER: {
+        "type": "string",
+        "description": 'Sort direction for order_by: "asc" (default) or "desc".',
+    },
+}
+
+
+class ListOptionsError(Exception):
+    """A malformed filter, order_by, or order argument."""
+
+
+def is_list_tool(tool_name: str) -> bool:
+    """Whether tool_name is a list tool, which accepts the filter, order_by,
+    and order arguments."""
+    return tool_name.endswith("_list")
+
+
+def add_list_options_schema(tool: Tool) -> Tool:
+    """Return tool with the filter, order_by, and order arguments added to
+    its input schema. Other tools are returned unchanged."""
+    if not is_list_tool(tool.name):
+        return tool
+    schema = dict(tool.inputSchema)
+    properties = cast("dict[str, Any]", schema.get("properties") or {})
+    schema["properties"] = {
+        **properties,
+        **{name: dict(prop) for name, prop in _LIST_OPTIONS_PROPERTIES.items()},
+    }
+    return tool.model_copy(update={"inputSchema": schema})
+
+
+def _resolve_filter(raw: Any) -> dict[str, Any]:
+    if raw is None:
+        return {}
+    if isinstance(raw, str):
+        # Some clients send an object argument as its JSON text.
+        if not raw.strip():
+            return {}
+        try:
+            raw = json.loads(raw)
+        except ValueError as exc:
+            raise ListOptionsError(ERR_LIST_FILTER_NOT_OBJECT) from exc
+    if not isinstance(raw, dict):
+        raise ListOptionsError(ERR_LIST_FILTER_NOT_OBJECT)
+    return cast("dict[str, Any]", raw)
+
+
+def resolve_list_options(tool_name: str, arguments: dict[str, Any]) -> ListOptions:
+    """Return the list options a call to tool_name asked for. Only list tools
+    read them.
+
+    Raises ListOptionsError for a malformed value rather than dropping it:
+    dropping a filter would return the unfiltered collection as if it were
+    the filtered one.
+    """
+    if not is_list_tool(tool_name):
+        return ListOptions()
+    expression = _resolve_filter(arguments.get(PARAM_FILTER))
+
+    order_by = ""
+    raw_order_by = arguments.get(PARAM_ORDER_BY)
+    if raw_order_by is not None:
+        if not isinstance(raw_order_by, str):
+            raise ListOptionsError(ERR_LIST_ORDER_BY_NOT_TEXT)
+        order_by = raw_order_by.strip()
+
+    order = ""
+    raw_order = arguments.get(PARAM_ORDER)
+    if raw_order is not None:
+        if not isinstance(raw_order, str) or raw_order.strip().lower() not in (
+            "asc",
+            "desc",
+        ):
+            raise ListOptionsError(ERR_LIST_ORDER_INVALID)
+        if not order_by:
+            raise ListOptionsError(ERR_LIST_ORDER_NO_ORDER_BY)
+        order = raw_order.strip().lower()
+
+    opts = ListOptions(filter=expression, order_by=order_by, order=order)
+    try:
+        opts.x_filter()
+    except (TypeError, ValueError) as exc:
+        msg = f"{ERR_LIST_FILTER_NOT_OBJECT}: {exc}"
+        raise ListOptionsError(msg) from exc
+    return opts
+
+
+def validate_list_options(tool_name: str, arguments: dict[str, Any]) -> str | None:
+    """Return why the call's list options are malformed, or None."""
+    try:
+        resolve_list_options(tool_name, arguments)
+    except ListOptionsError as exc:
+        return str(exc)
+    return None
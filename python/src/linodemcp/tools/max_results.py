@@ -0,0 +1,74 @@
+"""Per-call max_results: cap how many items each list read returns.
+
+Mirrors Go's tools/max_results.go: the same argument, validation, and
+truncation notice, so a capped list reads the same on either server.
+"""
+
+from __future__ import annotations
+
+from typing import Any
+
+from linodemcp.linode.max_results import list_truncated
+from linodemcp.tools.envelope import add_warning
+
+# Per-call argument that caps how many items each list the call reads returns.
+# List reads otherwise follow every page the API reports. The dispatch path
+# reads it for every tool, so it never appears in a tool's input schema.
+PARAM_MAX_RESULTS = "max_results"
+
+# The largest cap accepted, matching Go's int32 bound.
+_MAX_RESULTS_LIMIT = 2**31 - 1
+
+
+def resolve_max_results(tool_name: str, arguments: dict[str, Any]) -> int:
+    """Return the call's max_results cap, or 0 when it has none. Only list
+    tools take the cap: a composite that lists resources to act on them must
+    see every one, so max_results on any other tool is ignored with an
+    envelope warning, as is one that is not a positive whole number."""
+    if PARAM_MAX_RESULTS not in arguments:
+        return 0
+    if not tool_name.endswith("_list"):
+        add_warning(
+            "%s only applies to list tools and was ignored", PARAM_MAX_RESULTS
+        )
+        return 0
+    requested = arguments[PARAM_MAX_RESULTS]
+    whole = isinstance(requested, int) or (
+        isinstance(requested, float) and requested.is_integer()
+    )
+    if (
+        isinstance(requested, bool)
+        or not whole
+        or not 1 <= requested <= _MAX_RESULTS_LIMIT
+    ):
+        add_warning(
+            "%s must be a positive whole number and was ignored", PARAM_MAX_RESULTS
+        )
+        return 0
+    return int(requested)
+
+
+def warn_list_truncated(limit: int) -> None:
+    """Warn when a list the call read stopped at its max_results cap with more
+    results left, so a capped result is never taken for the whole
+    collection."""
+    total, truncated = list_truncated()
+    if not truncated:
+        return
+    if total > limit:
+        add_warning(
+            "Results stop at %s=%d of the %d the API reports; raise or drop %s "
+            "for the rest",
+            PARAM_MAX_RESULTS,
+            limit,
+            total,
+            PARAM_MAX_RESULTS,
+        )
+        return
+    add_warning(
+        "Results stop at %s=%d and the API has more; raise or drop %s for the "
+        "rest",
+        PARAM_MAX_RESULTS,
+        limit,
+        PARAM_MAX_RESULTS,
+    )
@@ -0,0 +1,184 @@
+"""The public_internet / vpc_subnet flags that stand in for interface arrays.
+
+linode_instance_create and linode_instance_config_create/_update accept
+these in place of a hand-written interfaces list. Mirrors
+``go/internal/tools/network_helpers.go``.
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+from typing import TYPE_CHECKING, Any
+
+if TYPE_CHECKING:
+    from linodemcp.linode import RetryableClient
+
+PUBLIC_INTERNET_NEEDS_VPC = (
+    "public_internet=false requires vpc_subnet: without a public interface or "
+    "a VPC the instance has no network"
+)
+VPC_SUBNET_FORMAT = (
+    'vpc_subnet must be a subnet ID or "<vpc label>/<subnet label>"'
+)
+HELPERS_AND_LIST = "pass either interfaces or public_internet/vpc_subnet, not both"
+
+# A configuration profile holds eth0 through eth2.
+_MAX_CONFIG_INTERFACES = 3
+
+
+@dataclass(frozen=True)
+class NetworkHelpers:
+    """The parsed flags. ``set`` reports whether the caller passed either;
+    when not, the tool's own interface defaults apply."""
+
+    set: bool = False
+    public_internet: bool = True
+    subnet_ref: str = ""
+
+
+def network_helpers_from_arguments(
+    arguments: dict[str, Any],
+) -> tuple[NetworkHelpers, str | None]:
+    """Read and validate the flags without touching the API; the vpc_subnet
+    label is resolved later by resolve_vpc_subnet."""
+    is_set = False
+    public_internet = True
+    subnet_ref = ""
+
+    if "public_internet" in arguments:
+        raw = arguments["public_internet"]
+        if not isinstance(raw, bool):
+            return NetworkHelpers(), "public_internet must be a boolean"
+        is_set = True
+        public_internet = raw
+
+    if "vpc_subnet" in arguments:
+        raw = arguments["vpc_subnet"]
+        if not isinstance(raw, str):
+            return NetworkHelpers(), "vpc_subnet must be a string"
+        if not _valid_subnet_ref(raw):
+            return NetworkHelpers(), VPC_SUBNET_FORMAT
+        is_set = True
+        subnet_ref = raw.strip()
+
+    if is_set and not subnet_ref and not public_internet:
+        return NetworkHelpers(), PUBLIC_INTERNET_NEEDS_VPC
+    return NetworkHelpers(is_set, public_internet, subnet_ref), None
+
+
+def _valid_subnet_ref(ref: str) -> bool:
+    """A positive subnet ID or a "<vpc>/<subnet>" pair, both halves set."""
+    ref = ref.strip()
+    if ref.lstrip("-").isdigit():
+        return int(ref) > 0
+    vpc_label, sep, subnet_label = ref.partition("/")
+    return bool(sep and vpc_label.strip() and subnet_label.strip())
+
+
+async def resolve_vpc_subnet(client: RetryableClient, ref: str) -> int:
+    """Turn a vpc_subnet reference into a subnet ID.
+
+    A numeric reference is used as-is; a label pair is looked up in the
+    account's VPCs, matching labels case-insensitively as Linode does.
+    Raises ValueError when no VPC or subnet matches.
+    """
+    if ref.isdigit():
+        return int(ref)
+    vpc_label, _, subnet_label = ref.partition("/")
+    vpc_label, subnet_label = vpc_label.strip(), subnet_label.strip()
+
+    for vpc in await client.list_vpcs():
+        if str(vpc.get("label", "")).lower() != vpc_label.lower():
+            continue
+        for subnet in vpc.get("subnets") or []:
+            if str(subnet.get("label", "")).lower() == subnet_label.lower():
+                return int(subnet.get("id", 0))
+        msg = f"no subnet with that label in the VPC: {vpc_label}/{subnet_label}"
+        raise ValueError(msg)
+    msg = f"no VPC with that label: {vpc_label}"
+    raise ValueError(msg)
+
+
+def config_interfaces(helpers: NetworkHelpers, subnet_id: int) -> list[dict[str, Any]]:
+    """The flags as a legacy configuration profile interface list.
+
+    A VPC interface is primary so it owns the default route; public_internet
+    adds 1:1 NAT on it rather than a second, public interface, which would
+    otherwise have to come first and take the default route.
+    """
+    if not helpers.subnet_ref:
+        return [{"purpose": "public", "primary": True}]
+    ipv4 = {"vpc": "auto"}
+    if helpers.public_internet:
+        ipv4["nat_1_1"] = "any"
+    return [{"purpose": "vpc", "primary": True, "subnet_id": subnet_id, "ipv4": ipv4}]
+
+
+def instance_vpc_interface(
+    helpers: NetworkHelpers, subnet_id: int, firewall_id: int, route_ipv4: bool
+) -> dict[str, Any]:
+    """The current-generation VPC interface for instance create: it owns the
+    IPv4 default route and gets a 1:1 NAT public address when public_internet
+    is true."""
+    address: dict[str, Any] = {"address": "auto", "primary": True}
+    if helpers.public_internet:
+        address["nat_1_1_address"] = "auto"
+    entry: dict[str, Any] = {
+        "vpc": {"subnet_id": subnet_id, "ipv4": {"addresses": [address]}},
+        "firewall_id": firewall_id,
+    }
+    if route_ipv4:
+        entry["default_route"] = {"ipv4": True}
+    return entry
+
+
+async def helper_config_interfaces(
+    client: RetryableClient, arguments: dict[str, Any]
+) -> list[dict[str, Any]] | None:
+    """Resolve the flags into a configuration profile interface list, or
+    None when the caller passed neither. Call after validation passed."""
+    helpers, _ = network_helpers_from_arguments(arguments)
+    if not helpers.set:
+        return None
+    subnet_id = 0
+    if helpers.subnet_ref:
+        subnet_id = await resolve_vpc_subnet(client, helpers.subnet_ref)
+    return config_interfaces(helpers, subnet_id)
+
+
+def validate_config_interface_layout(interfaces: list[Any]) -> str | None:
+    """Check the rules the API otherwise rejects with a bare 400.
+
+    At most three interfaces, one public and one VPC at most, one primary at
+    most, a public interface first unless another is primary, and
+    ipv4.nat_1_1 only on a VPC interface.
+    """
+    if len(interfaces) > _MAX_CONFIG_INTERFACES:
+        return (
+            "a configuration profile holds at most "
+            f"{_MAX_CONFIG_INTERFACES} interfaces"
+        )
+    counts: dict[str, int] = {}
+    public_index, primaries = -1, 0
+    for index, iface in enumerate(interfaces):
+        purpose = str(iface.get("purpose", ""))
+        counts[purpose] = counts.get(purpose, 0) + 1
+        if purpose == "public":
+            public_index = index
+        if iface.get("primary") is True:
+            primaries += 1
+        ipv4 = iface.get("ipv4")
+        if purpose != "vpc" and isinstance(ipv4, dict) and ipv4.get("nat_1_1"):
+            return f"interfaces[{index}]: ipv4.nat_1_1 applies only to vpc interfaces"
+
+    for purpose in ("public", "vpc"):
+        if counts.get(purpose, 0) > 1:
+            return f"a configuration profile holds at most one {purpose} interface"
+    if primaries > 1:
+        return "at most one interface can set primary=true"
+    if public_index > 0 and primaries == 0:
+        return (
+            f"interfaces[{public_index}]: the public interface must be first "
+            "(eth0) unless another interface sets primary=true"
+        )
+    return None
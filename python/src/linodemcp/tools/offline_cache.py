@@ -0,0 +1,109 @@
+"""Offline cache: answer read tools from their last result when the API is down.
+
+Mirrors Go's tools/offline_cache.go: the same calls are saved under the same
+keys, and both servers replay a saved result with the same trailing
+stale-as-of warning when the Linode API cannot be reached.
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+from pathlib import Path
+from typing import Any
+
+from mcp.types import TextContent
+
+from linodemcp.audit import resolve_default_audit_dir
+from linodemcp.config import Config, EnvironmentNotFoundError
+from linodemcp.linode.reachability import api_unreachable
+from linodemcp.offlinecache import OfflineCacheError, Store
+from linodemcp.profiles.capability import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import is_error_response, local_store_environment
+
+
+@dataclass(frozen=True)
+class OfflineCache:
+    """The offline cache resolved for one call. No store means the call
+    neither saves its result nor answers from saved ones."""
+
+    store: Store | None = None
+    environment: str = ""
+
+    @property
+    def enabled(self) -> bool:
+        """Whether the call takes part in the offline cache."""
+        return self.store is not None
+
+
+def resolve_offline_cache(
+    cfg: Config, capability: Capability, arguments: dict[str, Any]
+) -> OfflineCache:
+    """Return the offline cache for a call. Only read tools take part, since
+    replaying an old answer is only safe for a call that changes nothing. A
+    call naming an unknown environment gets none; the handler reports that
+    error itself. Under server.tokenPassthrough no call takes part: saved
+    answers are kept per environment, not per caller, so a replay could hand
+    one tenant another's inventory. Config load rejects that combination;
+    this check covers a config built without it."""
+    if (
+        not cfg.offline_cache.enabled
+        or cfg.server.token_passthrough
+        or capability != Capability.Read
+    ):
+        return OfflineCache()
+    name = arguments.get("environment", "")
+    if not isinstance(name, str):
+        name = ""
+    try:
+        environment = local_store_environment(cfg, name)
+    except (EnvironmentNotFoundError, ValueError):
+        return OfflineCache()
+    directory = cfg.offline_cache.dir or str(
+        Path(resolve_default_audit_dir()) / "offline-cache"
+    )
+    return OfflineCache(store=Store(directory), environment=environment)
+
+
+def apply_offline_cache(
+    cache: OfflineCache,
+    tool: str,
+    arguments: dict[str, Any],
+    result: list[Any],
+) -> list[Any]:
+    """Save a successful result as the latest answer for the call. When the
+    call failed because the Linode API could not be reached, return the last
+    saved answer instead, with a trailing item giving the time it was saved.
+    Any other failure, or one with nothing saved, is returned unchanged."""
+    if cache.store is None:
+        return result
+
+    if not is_error_response(result):
+        texts = [item.text for item in result if isinstance(item, TextContent)]
+        if len(texts) == len(result):
+            try:
+                cache.store.put(cache.environment, tool, arguments, texts)
+            except (OfflineCacheError, OSError) as exc:
+                add_warning("result was not saved to the offline cache: %s", exc)
+        return result
+
+    if not api_unreachable():
+        return result
+
+    try:
+        entry = cache.store.get(cache.environment, tool, arguments)
+    except (OfflineCacheError, OSError) as exc:
+        add_warning("offline cache could not be read: %s", exc)
+        return result
+    if entry is None:
+        return result
+
+    warning = (
+        "the Linode API is unreachable; this result is from the offline cache, "
+        f"stale as of {entry.cached_at}"
+    )
+    add_warning(warning)
+    return [
+        *(TextContent(type="text", text=text) for text in entry.content),
+        TextContent(type="text", text=f"Warning: {warning}"),
+    ]
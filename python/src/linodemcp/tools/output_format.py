@@ -0,0 +1,317 @@
+"""Display annotations for sizes, prices, and Cloud Manager links in tool
+results.
+
+Mirrors Go's tools/output_format.go: the same fields are annotated with the
+same wording, so both servers render a plan's memory or a volume's price the
+same way for a given output_format.
+"""
+
+from __future__ import annotations
+
+import json
+from dataclasses import dataclass
+from typing import Any, cast
+
+from mcp.types import TextContent
+
+from linodemcp.config import (
+    ConfigInvalidError,
+    OutputFormatConfig,
+    validate_output_format,
+)
+from linodemcp.tools.envelope import add_warning
+
+# Per-call argument that overrides the configured output_format. The dispatch
+# path reads it for every tool, so it never appears in a tool's input schema.
+PARAM_OUTPUT_FORMAT = "output_format"
+
+# Byte sizes of the units Linode reports sizes in and the units results can be
+# displayed in. Linode's "MB" and "GB" are binary (MiB, GiB).
+_BYTES_PER_MIB = 1 << 20
+_BYTES_PER_GIB = 1 << 30
+_BYTES_PER_GB = 1e9
+
+# Size fields every tool reports in the same unit, mapped to the bytes one unit
+# of the field holds.
+_SIZE_FIELD_BYTES: dict[str, float] = {
+    "memory": _BYTES_PER_MIB,
+    "memory_limit": _BYTES_PER_MIB,
+    "disk": _BYTES_PER_MIB,
+    "transfer": _BYTES_PER_GIB,
+}
+
+# Unit of the generic "size" field by tool-name prefix, since volumes, images,
+# disks, and buckets each measure it differently. Tools matching no prefix
+# leave "size" unannotated.
+_TOOL_SIZE_BYTES: tuple[tuple[str, float], ...] = (
+    ("linode_volume_", _BYTES_PER_GIB),
+    ("linode_image_", _BYTES_PER_MIB),
+    ("linode_instance_disk", _BYTES_PER_MIB),
+    ("linode_object_storage_bucket", 1),
+)
+
+# Where Cloud Manager serves resource pages.
+_CLOUD_MANAGER_BASE_URL = "https://cloud.linode.com"
+
+# Marks a database list whose entries carry their own engine, which completes
+# the path.
+_CLOUD_MANAGER_DATABASE_PATH = "databases"
+
+# Keys tool results list resources under, mapped to the Cloud Manager path of
+# one of those resources.
+_CLOUD_MANAGER_KEY_PATHS: dict[str, str] = {
+    "instances": "linodes",
+    "linodes": "linodes",
+    "volumes": "volumes",
+    "domains": "domains",
+    "nodebalancers": "nodebalancers",
+    "clusters": "kubernetes/clusters",
+    "firewalls": "firewalls",
+    "vpcs": "vpcs",
+    "placement_groups": "placement-groups",
+    "stackscripts": "stackscripts",
+    "mysql_instances": "databases/mysql",
+    "postgresql_instances": "databases/postgresql",
+    "database_instances": _CLOUD_MANAGER_DATABASE_PATH,
+}
+
+# Tools that return a single resource as their top-level object, mapped to
+# that resource's Cloud Manager path.
+_CLOUD_MANAGER_TOOL_PATHS: dict[str, str] = {
+    "linode_instance_get": "linodes",
+    "linode_volume_get": "volumes",
+    "linode_domain_get": "domains",
+    "linode_nodebalancer_get": "nodebalancers",
+    "linode_lke_cluster_get": "kubernetes/clusters",
+    "linode_firewall_get": "firewalls",
+    "linode_vpc_get": "vpcs",
+    "linode_placement_group_get": "placement-groups",
+    "linode_stackscript_get": "stackscripts",
+    "linode_database_mysql_instance_get": "databases/mysql",
+    "linode_database_postgresql_instance_get": "databases/postgresql",
+}
+
+# Entity types account events (and firewall devices) name in their entity and
+# secondary_entity objects, mapped to the Cloud Manager path of that entity.
+_CLOUD_MANAGER_ENTITY_PATHS: dict[str, str] = {
+    "linode": "linodes",
+    "volume": "volumes",
+    "domain": "domains",
+    "nodebalancer": "nodebalancers",
+    "lkecluster": "kubernetes/clusters",
+    "firewall": "firewalls",
+    "vpc": "vpcs",
+    "placement_group": "placement-groups",
+    "stackscript": "stackscripts",
+}
+
+# Python error results have no isError flag; these are the framings
+# error_response and execute_tool use for them.
+_ERROR_PREFIXES = ("Error:", "Failed to ")
+
+
+@dataclass(frozen=True)
+class OutputFormat:
+    """Display formatting resolved for one call. An empty size_unit or
+    price_period leaves that kind of field unannotated, and
+    cloud_manager_links adds a cloud_manager_url to each resource."""
+
+    size_unit: str = ""
+    price_period: str = ""
+    currency_symbol: bool = True
+    cloud_manager_links: bool = False
+
+
+def resolve_output_format(
+    defaults: OutputFormatConfig, arguments: dict[str, Any]
+) -> OutputFormat:
+    """Merge the call's output_format argument over the configured defaults.
+    An argument that is not an object or carries an invalid value is ignored
+    with an envelope warning, keeping the configured format."""
+    configured = OutputFormat(
+        size_unit=defaults.size_unit,
+        price_period=defaults.price_period,
+        currency_symbol=defaults.currency_symbol,
+        cloud_manager_links=defaults.cloud_manager_links,
+    )
+    if PARAM_OUTPUT_FORMAT not in arguments:
+        return configured
+    raw = arguments[PARAM_OUTPUT_FORMAT]
+    if not isinstance(raw, dict):
+        add_warning("%s must be an object and was ignored", PARAM_OUTPUT_FORMAT)
+        return configured
+    override = cast("dict[str, Any]", raw)
+    unit = override.get("size_unit")
+    period = override.get("price_period")
+    symbol = override.get("currency_symbol")
+    links = override.get("cloud_manager_links")
+    merged = OutputFormat(
+        size_unit=unit if isinstance(unit, str) and unit else configured.size_unit,
+        price_period=(
+            period if isinstance(period, str) and period else configured.price_period
+        ),
+        currency_symbol=(
+            symbol if isinstance(symbol, bool) else configured.currency_symbol
+        ),
+        cloud_manager_links=(
+            links if isinstance(links, bool) else configured.cloud_manager_links
+        ),
+    )
+    try:
+        validate_output_format(merged.size_unit, merged.price_period)
+    except ConfigInvalidError as exc:
+        add_warning("%s was ignored: %s", PARAM_OUTPUT_FORMAT, exc)
+        return configured
+    return merged
+
+
+def apply_output_format(
+    result: list[Any], tool_name: str, fmt: OutputFormat
+) -> list[Any]:
+    """Add the display annotations ``fmt`` asks for to a successful JSON
+    result. Raw values are kept as-is; error, non-JSON, and unannotated
+    results are returned unchanged."""
+    if not fmt.size_unit and not fmt.price_period and not fmt.cloud_manager_links:
+        return result
+    size_bytes = next(
+        (b for prefix, b in _TOOL_SIZE_BYTES if tool_name.startswith(prefix)), 0.0
+    )
+    out: list[Any] = []
+    for content in result:
+        if not isinstance(content, TextContent) or content.text.startswith(
+            _ERROR_PREFIXES
+        ):
+            out.append(content)
+            continue
+        text = content.text.strip()
+        if not text.startswith(("{", "[")):
+            out.append(content)
+            continue
+        try:
+            data = json.loads(text)
+        except ValueError:
+            out.append(content)
+            continue
+        annotated = _annotate(
+            data, fmt, size_bytes, "", _CLOUD_MANAGER_TOOL_PATHS.get(tool_name, "")
+        )
+        out.append(
+            TextContent(
+                type="text", text=json.dumps(annotated, indent=2, ensure_ascii=False)
+            )
+        )
+    return out
+
+
+def _annotate(
+    value: Any,
+    fmt: OutputFormat,
+    size_bytes: float,
+    key: str = "",
+    root_path: str = "",
+) -> Any:
+    """Return value with size and price display strings and Cloud Manager
+    links inserted. key is the object key the value (or the list holding it)
+    sits under; root_path is the top-level object's Cloud Manager path."""
+    if isinstance(value, list):
+        return [
+            _annotate(item, fmt, size_bytes, key) for item in cast("list[Any]", value)
+        ]
+    if not isinstance(value, dict):
+        return value
+    out: dict[str, Any] = {}
+    for member, item in cast("dict[str, Any]", value).items():
+        out[member] = _annotate(item, fmt, size_bytes, member)
+        display = _size_display(member, item, fmt, size_bytes)
+        if display:
+            out[f"{member}_display"] = display
+    price = _price_display(out.get("hourly"), out.get("monthly"), fmt)
+    if price:
+        out["display"] = price
+    path = root_path or _CLOUD_MANAGER_KEY_PATHS.get(key, "")
+    url = _cloud_manager_url(out, fmt, key, path)
+    if url:
+        out["cloud_manager_url"] = url
+    return out
+
+
+def _cloud_manager_url(
+    obj: dict[str, Any], fmt: OutputFormat, key: str, path: str
+) -> str:
+    """Return the Cloud Manager page of an object sitting under key, or ""
+    when links are off or the object is not a resource Cloud Manager shows.
+    An event entity names its own type instead of taking path."""
+    resource_id = obj.get("id")
+    if (
+        not fmt.cloud_manager_links
+        or not isinstance(resource_id, int)
+        or isinstance(resource_id, bool)
+        or "cloud_manager_url" in obj
+    ):
+        return ""
+    if key in ("entity", "secondary_entity"):
+        entity_type = obj.get("type")
+        path = (
+            _CLOUD_MANAGER_ENTITY_PATHS.get(entity_type, "")
+            if isinstance(entity_type, str)
+            else ""
+        )
+    if path == _CLOUD_MANAGER_DATABASE_PATH:
+        engine = obj.get("engine")
+        if not isinstance(engine, str) or not engine:
+            return ""
+        path = f"{path}/{engine}"
+    if not path:
+        return ""
+    return f"{_CLOUD_MANAGER_BASE_URL}/{path}/{resource_id}"
+
+
+def _is_number(value: Any) -> bool:
+    return isinstance(value, int | float) and not isinstance(value, bool)
+
+
+def _size_display(key: str, value: Any, fmt: OutputFormat, size_bytes: float) -> str:
+    """Render a size field in the configured unit, or ""."""
+    if not fmt.size_unit or not _is_number(value):
+        return ""
+    unit_bytes = size_bytes if key == "size" else _SIZE_FIELD_BYTES.get(key, 0.0)
+    if not unit_bytes:
+        return ""
+    divisor = _BYTES_PER_GB if fmt.size_unit == "GB" else _BYTES_PER_GIB
+    amount = _trim_decimals(f"{value * unit_bytes / divisor:.2f}", 0)
+    return f"{amount} {fmt.size_unit}"
+
+
+def _price_display(hourly: Any, monthly: Any, fmt: OutputFormat) -> str:
+    """Render a price object for the configured period, or "" when the object
+    lacks the period's number."""
+    has_hourly = _is_number(hourly)
+    has_monthly = _is_number(monthly)
+
+    def money(amount: str) -> str:
+        return f"${amount}" if fmt.currency_symbol else f"{amount} USD"
+
+    def hourly_text() -> str:
+        return money(_trim_decimals(f"{hourly:.4f}", 2)) + "/hr"
+
+    def monthly_text() -> str:
+        return money(f"{monthly:.2f}") + "/mo"
+
+    if fmt.price_period == "hourly" and has_hourly:
+        return hourly_text()
+    if fmt.price_period == "monthly" and has_monthly:
+        return monthly_text()
+    if fmt.price_period == "both" and has_hourly and has_monthly:
+        return f"{monthly_text()} ({hourly_text()})"
+    return ""
+
+
+def _trim_decimals(number: str, keep: int) -> str:
+    """Drop trailing zeros from a fixed-point number, keeping at least
+    ``keep`` decimals (and the point only when decimals remain)."""
+    point = number.find(".")
+    if point < 0:
+        return number
+    while len(number) - point - 1 > keep and number.endswith("0"):
+        number = number[:-1]
+    return number.removesuffix(".")
@@ -0,0 +1,96 @@
+"""Linode list-price tables shared by the project rollup and write cost
+attribution.
+
+Mirrors Go's tools/pricing.go.
+"""
+
+from __future__ import annotations
+
+import math
+from dataclasses import dataclass, field
+from typing import TYPE_CHECKING, Any
+
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.tools.envelope import add_warning
+
+if TYPE_CHECKING:
+    from linodemcp.linode import Instance, InstanceType, RetryableClient
+
+
+def round_cents(amount: float) -> float:
+    """Round a dollar amount to whole cents, halves away from zero like Go."""
+    return math.copysign(math.floor(abs(amount) * 100 + 0.5) / 100, amount)
+
+
+def type_monthly(linode_type: dict[str, Any] | None, region: str) -> float:
+    """A type's monthly price in region, else its base price."""
+    if linode_type is None:
+        return 0.0
+    for price in linode_type.get("region_prices") or []:
+        if price.get("id") == region:
+            return float(price.get("monthly") or 0)
+    return float((linode_type.get("price") or {}).get("monthly") or 0)
+
+
+@dataclass
+class PriceTables:
+    """The monthly list prices Linode publishes for instance types, Block
+    Storage, and NodeBalancers (Go's priceTables). A table that could not be
+    fetched is empty and costs 0."""
+
+    instance_types: dict[str, InstanceType] = field(
+        default_factory=dict[str, "InstanceType"]
+    )
+    volume: dict[str, Any] | None = None
+    node_balancer: dict[str, Any] | None = None
+
+    def instance(self, instance: Instance) -> float:
+        """An instance's monthly list price, with backups when enabled."""
+        monthly = self.instance_type_monthly(instance.type, instance.backups.enabled)
+        return monthly if monthly is not None else 0.0
+
+    def instance_type_monthly(self, type_id: str, backups: bool) -> float | None:
+        """The monthly list price of an instance of type_id, with the backups
+        add-on when backups is set; None for a type the table does not list."""
+        instance_type = self.instance_types.get(type_id)
+        if instance_type is None:
+            return None
+        monthly = instance_type.price.monthly
+        if backups:
+            monthly += instance_type.addons.backups.price.monthly
+        return round_cents(monthly)
+
+    def volume_monthly(self, size_gb: int, region: str) -> float:
+        """The monthly list price of a size_gb volume in region."""
+        return round_cents(size_gb * type_monthly(self.volume, region))
+
+    def node_balancer_monthly(self, region: str) -> float:
+        """The monthly list price of a NodeBalancer in region."""
+        return round_cents(type_monthly(self.node_balancer, region))
+
+
+async def fetch_price_tables(client: RetryableClient) -> PriceTables:
+    """Read the instance, volume, and NodeBalancer price tables (Go's
+    fetchPriceTables); a table that cannot be read costs 0, with a
+    warning."""
+    prices = PriceTables()
+    try:
+        prices.instance_types = {t.id: t for t in await client.list_types()}
+    except (APIError, NetworkError) as exc:
+        add_warning(
+            "instance prices unavailable, instance costs are reported as 0: %s", exc
+        )
+    try:
+        prices.volume = next(iter(await client.list_volume_types()), None)
+    except (APIError, NetworkError) as exc:
+        add_warning(
+            "volume prices unavailable, volume costs are reported as 0: %s", exc
+        )
+    try:
+        prices.node_balancer = next(iter(await client.list_nodebalancer_types()), None)
+    except (APIError, NetworkError) as exc:
+        add_warning(
+            "NodeBalancer prices unavailable, NodeBalancer costs are reported as 0: %s",
+            exc,
+        )
+    return prices
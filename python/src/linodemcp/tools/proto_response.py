@@ -12,16 +12,24 @@ structural: protojson and MessageToJson differ only in whitespace.
 from __future__ import annotations
 
 import json
+import logging
 from typing import TYPE_CHECKING, Any, cast
 
 from google.protobuf import json_format, struct_pb2
 from google.protobuf.descriptor import FieldDescriptor
 
+from linodemcp.config import SCHEMA_DRIFT_STRICT, SCHEMA_DRIFT_WARN
+from linodemcp.linode import SchemaDriftError
+from linodemcp.linode.schema_drift import get_schema_drift
+from linodemcp.tools.envelope import set_envelope_count
+
 if TYPE_CHECKING:
     from collections.abc import Callable
 
     from google.protobuf.message import Message
 
+logger = logging.getLogger(__name__)
+
 # The five 64-bit integer field types the proto3 JSON mapping emits as quoted
 # strings; the widening pass below converts them back to JSON numbers.
 _INT64_FIELD_TYPES = frozenset(
@@ -165,9 +173,40 @@ def serialize_api_response(raw: dict[str, Any], message: Message) -> dict[str, A
     """Decode a raw API response into message and return its canonical output.
 
     Unknown fields (the API returns more than the proto models) are ignored, the
-    same way Go's protojson DiscardUnknown decode does.
+    same way Go's protojson DiscardUnknown decode does, unless the call's
+    schema_drift mode says otherwise: warn logs the first one, strict raises
+    SchemaDriftError (mirrors the Go client's drift check).
     """
     json_format.ParseDict(raw, message, ignore_unknown_fields=True)
+    _check_schema_drift(raw, message)
+    return proto_to_canonical_dict(message)
+
+
+def _check_schema_drift(raw: dict[str, Any], message: Message) -> None:
+    """Re-decode raw without ignore_unknown_fields into a fresh message when
+    the drift mode asks for it. The lenient decode of the same raw already
+    succeeded, so a strict failure can only be a field (or enum value) the
+    model does not declare."""
+    mode = get_schema_drift()
+    if mode not in (SCHEMA_DRIFT_WARN, SCHEMA_DRIFT_STRICT):
+        return
+    try:
+        json_format.ParseDict(raw, type(message)())
+    except json_format.ParseError as exc:
+        model = message.DESCRIPTOR.full_name
+        if mode == SCHEMA_DRIFT_STRICT:
+            raise SchemaDriftError(model, exc) from exc
+        logger.warning(
+            "linode response has fields the model does not capture: %s: %s",
+            model,
+            exc,
+        )
+
+
+def _decode_canonical(raw: dict[str, Any], message: Message) -> dict[str, Any]:
+    """Decode a server-built dict into message and return its canonical output,
+    ignoring unknown fields without a drift check."""
+    json_format.ParseDict(raw, message, ignore_unknown_fields=True)
     return proto_to_canonical_dict(message)
 
 
@@ -242,7 +281,7 @@ def serialize_preview_envelope(raw: dict[str, Any], message: Message) -> dict[st
     "11.0".
     """
     result = cast(
-        "dict[str, Any]", _collapse_integral_deep(serialize_api_response(raw, message))
+        "dict[str, Any]", _collapse_integral_deep(_decode_canonical(raw, message))
     )
     if "current_state" in result:
         result["current_state"] = _sorted_deep(result["current_state"])
@@ -271,7 +310,8 @@ def serialize_list_response(
     message so the output matches Go's MarshalProtoToolResponse element-for-element.
 
     message must be the proto list-response message whose repeated field is named
-    key (e.g. InstanceListResponse with key "instances").
+    key (e.g. InstanceListResponse with key "instances"). The count is also
+    stamped on the call's response envelope.
     """
     if not isinstance(raw, dict):
         msg = "list response must be an object"
@@ -300,4 +340,5 @@ def serialize_list_response(
     if filter_value:
         wrapper["filter"] = filter_value
 
+    set_envelope_count(len(items))
     return serialize_api_response(wrapper, message)
@@ -0,0 +1,209 @@
+"""Read-after-write check: re-read written resources until the change shows.
+
+Mirrors Go's tools/read_after_write.go: the same writes are probed the same
+way, so both servers hold a write tool's result back until a fresh GET
+reflects the change, or warn why it never did.
+"""
+
+from __future__ import annotations
+
+import asyncio
+from collections.abc import Callable
+from dataclasses import dataclass
+from typing import Any, cast
+
+from linodemcp.config import Config, ReadAfterWriteConfig
+from linodemcp.linode import APIError, Client, LinodeError, RetryableClient
+from linodemcp.linode.writelog import WriteRecord, writes_recorded
+from linodemcp.profiles.capability import Capability
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import is_dry_run, is_error_response, with_client
+
+# Per-call argument that turns the check on or off, overriding
+# read_after_write.enabled. The dispatch path reads it for every tool, so it
+# never appears in a tool's input schema.
+PARAM_READ_AFTER_WRITE = "read_after_write"
+
+_HTTP_NOT_FOUND = 404
+
+@dataclass(frozen=True)
+class ReadAfterWrite:
+    """The check resolved for one call. Zero attempts means it is off."""
+
+    attempts: int = 0
+    interval_ms: int = 0
+
+    @property
+    def enabled(self) -> bool:
+        """Whether the call re-reads what it wrote."""
+        return self.attempts > 0
+
+
+@dataclass(frozen=True)
+class _Probe:
+    """One resource to re-read: the endpoint to GET and the test that the
+    fetched state (or the APIError the GET raised) reflects the write."""
+
+    method: str
+    endpoint: str
+    visible: Callable[[Any, APIError | None], bool]
+
+
+def resolve_read_after_write(
+    settings: ReadAfterWriteConfig,
+    capability: Capability,
+    arguments: dict[str, Any],
+) -> ReadAfterWrite:
+    """Merge the call's read_after_write argument over the configured setting.
+    Read tools and dry runs write nothing, so the check is always off for
+    them. A non-boolean argument is ignored, keeping the configured setting."""
+    enabled = settings.enabled
+    override = arguments.get(PARAM_READ_AFTER_WRITE)
+    if isinstance(override, bool):
+        enabled = override
+    elif override is not None:
+        add_warning("%s must be a boolean and was ignored", PARAM_READ_AFTER_WRITE)
+    if (
+        not enabled
+        or capability in (Capability.Read, Capability.Meta)
+        or is_dry_run(arguments)
+    ):
+        return ReadAfterWrite()
+    return ReadAfterWrite(attempts=settings.attempts, interval_ms=settings.interval_ms)
+
+
+async def await_read_after_write(
+    cfg: Config,
+    arguments: dict[str, Any],
+    check: ReadAfterWrite,
+    result: list[Any],
+) -> None:
+    """Re-read every resource the call wrote until each write is visible or
+    check.attempts reads have been spent. The first read happens immediately
+    and later ones check.interval_ms apart. Writes that stay stale, or whose
+    re-read fails outright, are envelope warnings; the result is left unchanged because
+    the write already succeeded."""
+    if not check.enabled or is_error_response(result):
+        return
+    probes = _write_probes(writes_recorded())
+    if not probes:
+        return
+
+    async def _poll(client: RetryableClient) -> None:
+        await _poll_probes(client.client, probes, check)
+
+    try:
+        await with_client(cfg, arguments, _poll)
+    except (LinodeError, ValueError) as e:
+        add_warning("read_after_write: %s", e)
+
+
+async def _poll_probes(
+    client: Client, probes: list[_Probe], check: ReadAfterWrite
+) -> None:
+    pending = probes
+    for attempt in range(1, check.attempts + 1):
+        if not pending:
+            return
+        if attempt > 1:
+            await asyncio.sleep(check.interval_ms / 1000)
+        stale: list[_Probe] = []
+        for probe in pending:
+            try:
+                body: Any = await client.get_raw(probe.endpoint)
+                visible = probe.visible(body, None)
+            except APIError as e:
+                if e.status_code != _HTTP_NOT_FOUND:
+                    _warn_failed(probe, e)
+                    continue
+                visible = probe.visible(None, e)
+            except LinodeError as e:
+                _warn_failed(probe, e)
+                continue
+            if not visible:
+                stale.append(probe)
+        pending = stale
+    for probe in pending:
+        add_warning(
+            "read_after_write: %s %s still showed the old state after %d reads",
+            probe.method,
+            probe.endpoint,
+            check.attempts,
+        )
+
+
+def _warn_failed(probe: _Probe, err: Exception) -> None:
+    add_warning("read_after_write: re-reading %s failed: %s", probe.endpoint, err)
+
+
+def _write_probes(writes: list[WriteRecord]) -> list[_Probe]:
+    """Turn the recorded writes into re-read probes, one per endpoint with
+    the last write winning. Actions (POST to a resource's sub-path, such as
+    /linode/instances/{id}/boot) have no readable state of their own and are
+    skipped."""
+    probes: dict[str, _Probe] = {}
+    for write in writes:
+        probe = _probe_for(write, write.endpoint.split("?", 1)[0])
+        if probe is not None:
+            probes[probe.endpoint] = probe
+    return list(probes.values())
+
+
+def _probe_for(write: WriteRecord, endpoint: str) -> _Probe | None:
+    if write.method == "PUT":
+        if not write.body:
+            return None
+        want = write.body
+        return _Probe(
+            write.method,
+            endpoint,
+            lambda body, err: err is None and _reflects_write(want, body),
+        )
+    if write.method == "DELETE":
+        return _Probe(write.method, endpoint, lambda _body, err: err is not None)
+    if write.method == "POST":
+        if _has_id_segment(endpoint):
+            return None
+        response = write.response
+        if not isinstance(response, dict):
+            return None
+        created_id = cast("dict[str, Any]", response).get("id")
+        if not isinstance(created_id, int) or isinstance(created_id, bool):
+            return None
+        return _Probe(
+            write.method,
+            f"{endpoint.rstrip('/')}/{created_id}",
+            lambda _body, err: err is None,
+        )
+    return None
+
+
+def _reflects_write(want: Any, got: Any) -> bool:
+    """Whether got shows every field of want. Fields the API does not echo
+    back (passwords, write-only settings) are skipped; a list matches when it
+    has the same length and each wanted element matches some fetched
+    element."""
+    if isinstance(want, dict):
+        if not isinstance(got, dict):
+            return False
+        got_map = cast("dict[str, Any]", got)
+        return all(
+            key not in got_map or _reflects_write(value, got_map[key])
+            for key, value in cast("dict[str, Any]", want).items()
+        )
+    if isinstance(want, list):
+        want_list = cast("list[Any]", want)
+        if not isinstance(got, list):
+            return False
+        got_list = cast("list[Any]", got)
+        return len(got_list) == len(want_list) and all(
+            any(_reflects_write(element, candidate) for candidate in got_list)
+            for element in want_list
+        )
+    return bool(want == got)
+
+
+def _has_id_segment(endpoint: str) -> bool:
+    """Whether endpoint names an existing resource, which marks a POST to it
+    as an action rather than a create."""
+    return any(segment.isdigit() for segment in endpoint.strip("/").split("/"))
@@ -0,0 +1,129 @@
+"""Result summary: replace oversized results with a summary from the client.
+
+Mirrors Go's tools/result_summary.go: the same results are summarized with
+the same prompt, and both servers keep the full result in memory for
+``linode_result_get`` under the ID the closing note names.
+"""
+
+from __future__ import annotations
+
+from collections.abc import Awaitable, Callable
+from contextvars import ContextVar, Token
+from dataclasses import dataclass
+from typing import Any
+
+from mcp.types import TextContent
+
+from linodemcp.config import (
+    DEFAULT_RESULT_SUMMARY_MAX_TOKENS,
+    DEFAULT_RESULT_SUMMARY_THRESHOLD_BYTES,
+    ResultSummaryConfig,
+)
+from linodemcp.profiles.capability import Capability
+from linodemcp.resultstore import Store
+from linodemcp.tools.envelope import add_warning
+from linodemcp.tools.helpers import is_error_response, store_partition
+
+# Tells the client's model what a summary is for. The Go server sends the
+# same text.
+RESULT_SUMMARY_SYSTEM_PROMPT = (
+    "You summarize JSON results from Linode API tools for an agent that "
+    "manages a Linode account. Keep every resource ID, label, status, and "
+    "region, give counts, and call out anything failed, degraded, or unusual. "
+    "Reply with the summary only."
+)
+
+# Asks the client's model for a completion through an MCP sampling request:
+# (system_prompt, text, max_tokens) -> the text it wrote.
+Sampler = Callable[[str, str, int], Awaitable[str]]
+
+_RESULT_STORE: ContextVar[Store | None] = ContextVar(
+    "linodemcp_result_store", default=None
+)
+
+
+def set_result_store(store: Store | None) -> Token[Store | None]:
+    """Publish the server's result store, returning a token that resets it."""
+    return _RESULT_STORE.set(store)
+
+
+def reset_result_store(token: Token[Store | None]) -> None:
+    """Restore the result store the matching set_result_store call replaced."""
+    _RESULT_STORE.reset(token)
+
+
+def result_store_from_context() -> Store | None:
+    """Return the result store the server published, or None when unset."""
+    return _RESULT_STORE.get()
+
+
+@dataclass(frozen=True)
+class ResultSummary:
+    """The result summary resolved for one call. No sampler means the result
+    is returned in full."""
+
+    sampler: Sampler | None = None
+    threshold_bytes: int = 0
+    max_tokens: int = 0
+
+
+def resolve_result_summary(
+    settings: ResultSummaryConfig, capability: Capability, sampler: Sampler | None
+) -> ResultSummary:
+    """Return the result summary for a call. It is off when result_summary is
+    disabled, when the client cannot sample (no sampler), and for meta tools,
+    whose results are local state; linode_result_get is one of them, so a
+    full result is never summarized again."""
+    if not settings.enabled or sampler is None or capability == Capability.Meta:
+        return ResultSummary()
+    return ResultSummary(
+        sampler=sampler,
+        threshold_bytes=settings.threshold_bytes
+        or DEFAULT_RESULT_SUMMARY_THRESHOLD_BYTES,
+        max_tokens=settings.max_tokens or DEFAULT_RESULT_SUMMARY_MAX_TOKENS,
+    )
+
+
+async def apply_result_summary(
+    summary: ResultSummary, tool: str, result: list[Any]
+) -> list[Any]:
+    """Replace a successful result whose text is larger than the threshold
+    with a summary from the client's model, followed by a note naming the
+    result_id linode_result_get returns the full result under. If the sampling
+    request fails, the full result is returned and the failure is a warning."""
+    if summary.sampler is None or is_error_response(result):
+        return result
+
+    content = [item.text for item in result if isinstance(item, TextContent)]
+    if len(content) != len(result):
+        return result
+
+    size = sum(len(text.encode()) for text in content)
+    if size <= summary.threshold_bytes:
+        return result
+
+    store = result_store_from_context()
+    if store is None:
+        return result
+
+    try:
+        partition = store_partition()
+    except ValueError as exc:
+        add_warning("result was not summarized: %s", exc)
+        return result
+
+    result_id = store.put(partition, tool, content)
+    prompt = f"Summarize this {tool} result:\n\n" + "\n\n".join(content)
+    try:
+        text = await summary.sampler(
+            RESULT_SUMMARY_SYSTEM_PROMPT, prompt, summary.max_tokens
+        )
+    except Exception as exc:  # noqa: BLE001 - any failure keeps the full result
+        add_warning("result was not summarized: %s", exc)
+        return result
+
+    note = (
+        f"Note: this is a summary of a {size}-byte result. Call "
+        f'linode_result_get with result_id "{result_id}" for the full result.'
+    )
+    return [TextContent(type="text", text=text), TextContent(type="text", text=note)]
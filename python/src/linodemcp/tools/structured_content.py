@@ -0,0 +1,69 @@
+"""Structured content: machine-readable copies of a tool result.
+
+Mirrors Go's tools/structured_content.go: a successful result whose content is
+a single JSON object text also carries the object as structuredContent and,
+when configured, as an application/json embedded resource.
+"""
+
+from __future__ import annotations
+
+import json
+from typing import Any, cast
+
+from mcp.types import EmbeddedResource, TextContent, TextResourceContents
+from pydantic import AnyUrl
+
+from linodemcp.config import StructuredContentConfig
+from linodemcp.linode.correlation import get_correlation_id
+from linodemcp.tools.helpers import is_error_response
+
+# Start of a result's embedded resource URI, completed with the tool name and
+# the call's correlation ID. The server does not serve these URIs; they only
+# name the result.
+_RESULT_URI_PREFIX = "linodemcp://results/"
+
+
+def structured_content(
+    settings: StructuredContentConfig, result: list[Any]
+) -> dict[str, Any] | None:
+    """Return the JSON object a successful result's text holds, or None for
+    errors and for results whose text is not a JSON object (a summary, a plain
+    message). The text is the first content item; an embedded resource may
+    follow it."""
+    if not settings.enabled or not result or is_error_response(result):
+        return None
+    text = result[0]
+    if not isinstance(text, TextContent) or not text.text.lstrip().startswith("{"):
+        return None
+    try:
+        parsed = json.loads(text.text)
+    except ValueError:
+        return None
+    return cast("dict[str, Any]", parsed) if isinstance(parsed, dict) else None
+
+
+def apply_embedded_resource(
+    settings: StructuredContentConfig, name: str, result: list[Any]
+) -> list[Any]:
+    """Attach a result's JSON object text as an application/json embedded
+    resource after the text, which stays first and unchanged."""
+    if not settings.embedded_resource or len(result) != 1:
+        return result
+    if structured_content(settings, result) is None:
+        return result
+    uri = _RESULT_URI_PREFIX + name
+    correlation_id = get_correlation_id()
+    if correlation_id:
+        uri += "/" + correlation_id
+    text = cast("TextContent", result[0]).text
+    return [
+        *result,
+        EmbeddedResource(
+            type="resource",
+            resource=TextResourceContents(
+                uri=AnyUrl(uri),
+                mimeType="application/json",
+                text=text,
+            ),
+        ),
+    ]
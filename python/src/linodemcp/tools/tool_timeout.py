@@ -0,0 +1,106 @@
+"""Per-call tool timeouts: bound a call and each API request it makes.
+
+Mirrors Go's tools/tool_timeout.go: the same argument, precedence, clamp, and
+timeout message, so a call times out the same way on either server.
+"""
+
+from __future__ import annotations
+
+from typing import Any, cast
+
+from mcp.types import TextContent, Tool
+
+from linodemcp.config import DEFAULT_TOOL_TIMEOUT_MAX_SECONDS, ToolTimeoutConfig
+from linodemcp.tools.envelope import add_warning
+
+# Per-call argument that sets the call's timeout, overriding tool_timeouts and
+# clamped to tool_timeouts.max_seconds. The dispatch path reads it for every
+# tool, so add_tool_timeout_schema adds it to every tool's input schema rather
+# than each proto declaring it.
+PARAM_TIMEOUT_SECONDS = "timeout_seconds"
+
+# The schema entry add_tool_timeout_schema adds (Go's toolTimeoutProperties).
+_TOOL_TIMEOUT_PROPERTIES: dict[str, dict[str, Any]] = {
+    PARAM_TIMEOUT_SECONDS: {
+        "type": "integer",
+        "minimum": 1,
+        "description": (
+            "Seconds this call may run before it fails, overriding "
+            "tool_timeouts; clamped to tool_timeouts.max_seconds."
+        ),
+    },
+}
+
+
+def add_tool_timeout_schema(tool: Tool) -> Tool:
+    """Return tool with the timeout_seconds argument added to its input
+    schema (Go's AddToolTimeoutSchema)."""
+    schema = dict(tool.inputSchema)
+    properties = cast("dict[str, Any]", schema.get("properties") or {})
+    schema["properties"] = {
+        **properties,
+        **{name: dict(prop) for name, prop in _TOOL_TIMEOUT_PROPERTIES.items()},
+    }
+    return tool.model_copy(update={"inputSchema": schema})
+
+
+def resolve_tool_timeout(
+    settings: ToolTimeoutConfig, name: str, arguments: dict[str, Any]
+) -> int:
+    """Return the timeout in seconds for one call of ``name``: the call's
+    timeout_seconds argument, else the tool's tool_timeouts.tools entry, else
+    tool_timeouts.default_seconds. Zero means the call has no timeout of its
+    own. An argument above the configured maximum is clamped, and one that is
+    not a positive whole number is ignored; both are envelope warnings."""
+    seconds = settings.tools.get(name, settings.default_seconds)
+    if PARAM_TIMEOUT_SECONDS not in arguments:
+        return seconds
+    requested = arguments[PARAM_TIMEOUT_SECONDS]
+    whole = isinstance(requested, int) or (
+        isinstance(requested, float) and requested.is_integer()
+    )
+    if isinstance(requested, bool) or not whole or requested < 1:
+        add_warning(
+            "%s must be a positive whole number of seconds and was ignored",
+            PARAM_TIMEOUT_SECONDS,
+        )
+        return seconds
+    limit = settings.max_seconds or DEFAULT_TOOL_TIMEOUT_MAX_SECONDS
+    if requested > limit:
+        add_warning(
+            "%s %d is above the %d-second limit (tool_timeouts.max_seconds); "
+            "using %d",
+            PARAM_TIMEOUT_SECONDS,
+            requested,
+            limit,
+            limit,
+        )
+        return limit
+    return int(requested)
+
+
+def timeout_response(name: str, seconds: int) -> list[TextContent]:
+    """The error a call gets when its timeout passed before it finished,
+    naming the timeout and how to raise it."""
+    return [
+        TextContent(
+            type="text",
+            text=(
+                f"Error: {name} did not finish within its {_duration(seconds)} "
+                f"timeout; pass {PARAM_TIMEOUT_SECONDS} or set "
+                f"tool_timeouts.tools.{name} to allow longer"
+            ),
+        )
+    ]
+
+
+def _duration(seconds: int) -> str:
+    """Render whole seconds the way Go's time.Duration prints them (90 ->
+    "1m30s"), so both servers word the timeout the same."""
+    hours, rest = divmod(seconds, 3600)
+    minutes, secs = divmod(rest, 60)
+    if hours:
+        return f"{hours}h{minutes}m{secs}s"
+    if minutes:
+        return f"{minutes}m{secs}s"
+    return f"{secs}s"
@@ -42,6 +42,7 @@ _CONTROL_KEYS = frozenset(
         "dry_run",
         "confirmed_dry_run",
         "confirm_bypass_dry_run",
+        "confirm_label",
         "yolo",
     }
 )
@@ -150,6 +151,50 @@ def _refusal(
     return _text(messages.get(err_code, default))
 
 
+def _confirm_label_error(
+    arguments: dict[str, Any], tool_name: str, path: str, state: Any
+) -> str | None:
+    """The confirm_label refusal for a fetched state, or None when the call
+    echoed the resource's label. The label itself is never revealed, since
+    echoing it back would let the model copy it without noticing a mix-up."""
+    supplied = arguments.get("confirm_label")
+    if not isinstance(supplied, str) or not supplied:
+        return (
+            f"{tool_name} requires confirm_label: pass the exact label of the "
+            "resource you mean to delete"
+        )
+    if supplied != helpers.preview_state_str(state, "label"):
+        return (
+            f"confirm_label {json.dumps(supplied)} does not match the label of "
+            f"{path}; check that the ID is the resource you mean to delete"
+        )
+    return None
+
+
+async def require_confirm_label(
+    cfg: Config,
+    arguments: dict[str, Any],
+    *,
+    tool_name: str,
+    path: str,
+    fetch_state: _FetchState,
+) -> list[TextContent] | None:
+    """Enforce the confirm_label config before a confirmed delete.
+
+    Returns None when the check is off or passes, else the error to return.
+    Yolo does not skip it: the label guards against a wrong ID, not against an
+    unreviewed call. Mirrors the Go requireConfirmLabel.
+    """
+    if not helpers.resolve_config(cfg).confirm_label.enabled:
+        return None
+    try:
+        state = await helpers.with_client(cfg, arguments, fetch_state)
+    except _FETCH_ERRORS as exc:
+        return _text(f"Failed to fetch state for the label check: {exc}")
+    error = _confirm_label_error(arguments, tool_name, path, state)
+    return helpers.error_response(error) if error is not None else None
+
+
 async def run_two_stage_destroy(
     cfg: Config,
     arguments: dict[str, Any],
@@ -162,6 +207,7 @@ async def run_two_stage_destroy(
     hash_ignore: list[str] | None = None,
     dependency_walk: _DependencyWalk | None = None,
     capability: Capability = Capability.Destroy,
+    confirm_label: bool = False,
 ) -> list[TextContent] | None:
     """Handle plan/apply for a destroy tool, or None to fall through.
 
@@ -172,6 +218,9 @@ async def run_two_stage_destroy(
     capability is the tool's profile capability, consulted at the opt-in gate.
     It defaults to Destroy (every delete tool), so a CapWrite tool like
     instance_resize passes Capability.Write to stay opt-in by config only.
+
+    confirm_label puts the tool under the confirm_label config, which a plan
+    must then satisfy.
     """
     if arguments.get("yolo") is True:
         return None
@@ -202,6 +251,7 @@ async def run_two_stage_destroy(
             ignore,
             settings,
             dependency_walk,
+            confirm_label,
         )
 
     return await _run_apply(cfg, arguments, fetch_state, store, ignore, capability)
@@ -246,6 +296,7 @@ async def _run_plan(
     hash_ignore: list[str],
     settings: twostage.Settings,
     dependency_walk: _DependencyWalk | None,
+    confirm_label: bool,
 ) -> list[TextContent]:
     # Fetch the state and run the dependency walk under one client so the plan
     # body reads like a dry-run preview (dependencies / side_effects /
@@ -259,11 +310,21 @@ async def _run_plan(
             details = await dependency_walk(client, state)
         return state, details
 
+    try:
+        partition = helpers.store_partition()
+    except ValueError as exc:
+        return helpers.error_response(str(exc))
+
     try:
         state, details = await helpers.with_client(cfg, arguments, _fetch_and_walk)
     except _FETCH_ERRORS as exc:
         return _text(f"Failed to fetch state for plan: {exc}")
 
+    if confirm_label and helpers.resolve_config(cfg).confirm_label.enabled:
+        error = _confirm_label_error(arguments, tool_name, path, state)
+        if error is not None:
+            return helpers.error_response(error)
+
     state_hash, state_fields = _state_hash_and_fields(state, hash_ignore)
     plan_id = twostage.new_plan_id()
     now = datetime.now(UTC)
@@ -284,6 +345,7 @@ async def _run_plan(
             expires_at=expires,
             apply=_apply,
             state_fields=state_fields,
+            partition=partition,
         )
     )
 
@@ -362,7 +424,17 @@ async def _classify_plan(
     # only on a drift verdict so the refusal can name them. Mirrors the Go
     # classifyPlan, whose fourth return is the changed-field slice.
     try:
-        entry = await store.get(plan_id)
+        partition = helpers.store_partition()
+    except ValueError as exc:
+        return (
+            twostage.PlanLookup.NOT_APPLICABLE,
+            None,
+            [],
+            helpers.error_response(str(exc)),
+        )
+
+    try:
+        entry = await store.get(partition, plan_id)
     except PlanNotFoundError:
         return twostage.PlanLookup.UNKNOWN, None, [], None
     except PlanExpiredError:
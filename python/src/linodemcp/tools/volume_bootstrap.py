@@ -0,0 +1,145 @@
+"""bootstrap=true for linode_volume_create and linode_volume_attach.
+
+The call returns a bash script that partitions, formats, and mounts the
+volume from inside the Linode it is attached to. Linode runs StackScripts
+only when an instance is deployed or rebuilt, so the script is returned for
+the caller to run rather than deployed. It only writes to a volume with no
+filesystem and no partition table, so re-running it just mounts the volume.
+
+Mirrors ``go/internal/tools/linode_volume_bootstrap.go``.
+"""
+
+from __future__ import annotations
+
+import re
+from dataclasses import dataclass
+from typing import Any
+
+from linodemcp.tools.volume_wait import volume_device_path
+
+PARAM_VOLUME_BOOTSTRAP = "bootstrap"
+PARAM_VOLUME_FILESYSTEM = "filesystem"
+PARAM_VOLUME_MOUNT_POINT = "mount_point"
+
+_DEFAULT_FILESYSTEM = "ext4"
+_FILESYSTEMS = ("ext4", "xfs")
+
+# Keeps the mount point safe to paste into the script and /etc/fstab unquoted.
+_MOUNT_POINT_PATTERN = re.compile(r"^(/[A-Za-z0-9._-]+)+$")
+
+# Arguments: label, device, partition, mount point, filesystem.
+_SCRIPT = """#!/bin/bash
+# Partition, format, and mount Linode volume {label} at {mount_point}.
+# Safe to re-run: a volume that already holds a filesystem is mounted as it
+# is, never reformatted.
+set -euo pipefail
+
+DEVICE="{device}"
+PARTITION="{partition}"
+MOUNT_POINT="{mount_point}"
+FILESYSTEM="{filesystem}"
+
+# The device can take a few seconds to appear after the attach.
+for _ in $(seq 60); do [ -e "$DEVICE" ] && break; sleep 2; done
+if [ ! -e "$DEVICE" ]; then
+  echo "$DEVICE did not appear; is the volume attached?" >&2
+  exit 1
+fi
+
+if [ -n "$(blkid -o value -s TYPE "$DEVICE" || true)" ]; then
+  TARGET="$DEVICE"
+else
+  if [ ! -e "$PARTITION" ]; then
+    if [ -n "$(blkid -o value -s PTTYPE "$DEVICE" || true)" ]; then
+      echo "$DEVICE has a partition table but no $PARTITION; leaving it alone" >&2
+      exit 1
+    fi
+    parted -s "$DEVICE" mklabel gpt mkpart primary "$FILESYSTEM" 0% 100%
+    udevadm settle
+  fi
+  TARGET="$PARTITION"
+  if [ -z "$(blkid -o value -s TYPE "$TARGET" || true)" ]; then
+    "mkfs.$FILESYSTEM" "$TARGET"
+  fi
+fi
+
+mkdir -p "$MOUNT_POINT"
+if ! grep -qs " $MOUNT_POINT " /etc/fstab; then
+  TYPE="$(blkid -o value -s TYPE "$TARGET")"
+  echo "$TARGET $MOUNT_POINT $TYPE defaults,noatime,nofail 0 2" >> /etc/fstab
+fi
+mountpoint -q "$MOUNT_POINT" || mount "$MOUNT_POINT"
+"""
+
+
+@dataclass(frozen=True)
+class VolumeBootstrapOptions:
+    """What the caller asked the bootstrap script to do; an empty
+    mount_point means /mnt/<label>."""
+
+    enabled: bool = False
+    filesystem: str = ""
+    mount_point: str = ""
+
+
+def volume_bootstrap_options(
+    arguments: dict[str, Any], attach_to: int
+) -> tuple[VolumeBootstrapOptions, str]:
+    """Read and validate the bootstrap arguments, returning an error message
+    or "". attach_to is the Linode the volume ends up on; a volume created
+    without one has nothing to mount it."""
+    enabled = arguments.get(PARAM_VOLUME_BOOTSTRAP) is True
+    filesystem = str(arguments.get(PARAM_VOLUME_FILESYSTEM) or "")
+    mount_point = str(arguments.get(PARAM_VOLUME_MOUNT_POINT) or "")
+    opts = VolumeBootstrapOptions(enabled, filesystem, mount_point)
+
+    if not enabled:
+        if filesystem or mount_point:
+            return opts, "filesystem and mount_point require bootstrap=true"
+        return opts, ""
+    if not attach_to:
+        return opts, (
+            "bootstrap requires linode_id: the volume must be attached to an "
+            "instance to be mounted"
+        )
+    filesystem = filesystem or _DEFAULT_FILESYSTEM
+    opts = VolumeBootstrapOptions(enabled, filesystem, mount_point)
+    if opts.filesystem not in _FILESYSTEMS:
+        return opts, f"filesystem must be one of {', '.join(_FILESYSTEMS)}"
+    if mount_point and (
+        not _MOUNT_POINT_PATTERN.fullmatch(mount_point) or "/.." in mount_point
+    ):
+        return opts, "mount_point must be an absolute path such as /mnt/data"
+    return opts, ""
+
+
+def volume_bootstrap_payload(
+    payload: dict[str, Any], opts: VolumeBootstrapOptions, linode_id: int
+) -> None:
+    """Add the bootstrap script to a create or attach payload. It runs
+    whether or not the call waited: the script itself waits for the device
+    to appear."""
+    if not opts.enabled:
+        return
+    volume: dict[str, Any] = payload["volume"]
+    label = str(volume.get("label", ""))
+    device = volume_device_path(volume)
+    partition = f"{device}-part1"
+    mount_point = opts.mount_point or f"/mnt/{label}"
+    payload["bootstrap"] = {
+        "device_path": device,
+        "partition_path": partition,
+        "filesystem": opts.filesystem,
+        "mount_point": mount_point,
+        "script": _SCRIPT.format(
+            label=label,
+            device=device,
+            partition=partition,
+            mount_point=mount_point,
+            filesystem=opts.filesystem,
+        ),
+    }
+    payload["message"] += (
+        f"; run bootstrap.script as root on Linode {linode_id} to mount it at "
+        f"{mount_point}"
+    )
@@ -0,0 +1,122 @@
+"""wait=true for linode_volume_create and linode_volume_attach.
+
+The call re-reads the volume it just wrote until it is active (and, when
+attaching, shows the target Linode), then reports how to mount it. A wait
+that fails or times out leaves the write standing and is an envelope
+warning.
+
+Mirrors ``go/internal/tools/linode_volume_wait.go``.
+"""
+
+from __future__ import annotations
+
+import asyncio
+import time
+from typing import TYPE_CHECKING, Any
+
+from linodemcp.tools.envelope import add_warning
+
+if TYPE_CHECKING:
+    from linodemcp.linode import RetryableClient
+
+PARAM_VOLUME_WAIT = "wait"
+
+# Pacing for wait=true. Linode provisions and attaches a volume within seconds
+# to a couple of minutes; past the timeout the call returns what it last saw
+# with ready=false.
+_VOLUME_WAIT_INTERVAL_SECONDS = 3.0
+_VOLUME_WAIT_TIMEOUT_SECONDS = 300.0
+_VOLUME_STATUS_ACTIVE = "active"
+
+
+def _volume_ready(volume: dict[str, Any], linode_id: int) -> bool:
+    """Whether a volume is active and, when linode_id is set, attached to it."""
+    if volume.get("status") != _VOLUME_STATUS_ACTIVE:
+        return False
+    return not linode_id or volume.get("linode_id") == linode_id
+
+
+async def await_volume_ready(
+    client: RetryableClient, volume: dict[str, Any], linode_id: int
+) -> tuple[dict[str, Any], bool]:
+    """Re-read a volume until it is ready or the timeout passes. The first
+    re-read is immediate and later ones an interval apart. Returns the last
+    state read and whether the volume became ready; a failed re-read
+    propagates."""
+    if _volume_ready(volume, linode_id):
+        return volume, True
+    deadline = time.monotonic() + _VOLUME_WAIT_TIMEOUT_SECONDS
+    first = True
+    while True:
+        if not first:
+            if time.monotonic() + _VOLUME_WAIT_INTERVAL_SECONDS > deadline:
+                return volume, False
+            await asyncio.sleep(_VOLUME_WAIT_INTERVAL_SECONDS)
+        first = False
+        volume = await client.get_raw(f"/volumes/{int(volume.get('id', 0))}")
+        if _volume_ready(volume, linode_id):
+            return volume, True
+
+
+def volume_mount(volume: dict[str, Any]) -> dict[str, Any]:
+    """The commands that put an attached volume to use, following the layout
+    Cloud Manager suggests: the by-id device path, formatted as ext4 and
+    mounted under /mnt/<label>."""
+    label = str(volume.get("label", ""))
+    path = volume_device_path(volume)
+    mount_point = f"/mnt/{label}"
+    return {
+        "filesystem_path": path,
+        "mount_point": mount_point,
+        "format_command": f'mkfs.ext4 "{path}"',
+        "mount_commands": [
+            f'mkdir -p "{mount_point}"',
+            f'mount "{path}" "{mount_point}"',
+            f'echo "{path} {mount_point} ext4 defaults,noatime,nofail 0 2" '
+            ">> /etc/fstab",
+        ],
+    }
+
+
+def volume_device_path(volume: dict[str, Any]) -> str:
+    """The stable by-id device path of an attached volume, derived from its
+    label when the API response leaves filesystem_path out."""
+    path = str(volume.get("filesystem_path") or "")
+    if path:
+        return path
+    return f"/dev/disk/by-id/scsi-0Linode_Volume_{volume.get('label', '')}"
+
+
+async def volume_wait_payload(
+    client: RetryableClient,
+    arguments: dict[str, Any],
+    payload: dict[str, Any],
+    linode_id: int,
+) -> None:
+    """Finish a create or attach made with wait=true: wait for the volume in
+    ``payload``, then record readiness and, for an attached volume, how to
+    mount it."""
+    if arguments.get(PARAM_VOLUME_WAIT) is not True:
+        return
+    volume: dict[str, Any] = payload["volume"]
+    ready = False
+    try:
+        volume, ready = await await_volume_ready(client, volume, linode_id)
+    except Exception as exc:  # noqa: BLE001 - the volume itself was created
+        add_warning("wait: failed to re-read volume %s: %s", volume.get("id"), exc)
+    else:
+        if not ready:
+            add_warning(
+                "wait: volume %s was not ready after %ss",
+                volume.get("id"),
+                int(_VOLUME_WAIT_TIMEOUT_SECONDS),
+            )
+    payload["volume"] = volume
+    payload["ready"] = ready
+    if ready and volume.get("linode_id") is not None:
+        mount = volume_mount(volume)
+        payload["mount"] = mount
+        payload["message"] += (
+            f"; it is active on Linode {volume['linode_id']} at "
+            f"{mount['filesystem_path']}"
+        )
@@ -0,0 +1,205 @@
+"""Write cost attribution: the estimated monthly cost change of a write.
+
+Mirrors Go's tools/write_cost.go. The audit capture in the server dispatch
+prices a write before it runs, when audit.cost_attribution is on, and records
+the estimate on the audit event only if the write succeeds.
+"""
+
+from __future__ import annotations
+
+import logging
+from typing import TYPE_CHECKING, Any
+
+import httpx
+
+from linodemcp.config import ConfigError
+from linodemcp.linode import APIError, NetworkError
+from linodemcp.tools.helpers import with_client
+from linodemcp.tools.pricing import PriceTables, round_cents
+
+if TYPE_CHECKING:
+    from collections.abc import Awaitable, Callable
+
+    from linodemcp.config import Config
+    from linodemcp.linode import RetryableClient
+
+logger = logging.getLogger(__name__)
+
+# The size the API gives a volume created without one (Go's
+# volumeDefaultSizeGB).
+_VOLUME_DEFAULT_SIZE_GB = 20
+
+
+class _UnpricedError(ValueError):
+    """A write whose cost cannot be estimated from the price tables."""
+
+
+def _int_argument(arguments: dict[str, Any], name: str, default: int = 0) -> int:
+    """An integer argument, with JSON's whole-number floats accepted like
+    Go's GetInt; anything else is the default."""
+    value = arguments.get(name, default)
+    if isinstance(value, bool) or not isinstance(value, (int, float)):
+        return default
+    return int(value)
+
+
+async def _instance_prices(client: RetryableClient) -> PriceTables:
+    """Read the instance price table."""
+    return PriceTables(instance_types={t.id: t for t in await client.list_types()})
+
+
+def _instance_type_monthly(prices: PriceTables, type_id: str, backups: bool) -> float:
+    """Price one instance of type_id, or raise _UnpricedError for a type the
+    table does not list."""
+    monthly = prices.instance_type_monthly(type_id, backups)
+    if monthly is None:
+        msg = f"no list price for instance type {type_id!r}"
+        raise _UnpricedError(msg)
+    return monthly
+
+
+async def _volume_prices(client: RetryableClient) -> PriceTables:
+    """Read the Block Storage price table."""
+    volume = next(iter(await client.list_volume_types()), None)
+    if volume is None:
+        msg = "volume types: price table lists no types"
+        raise _UnpricedError(msg)
+    return PriceTables(volume=volume)
+
+
+async def _node_balancer_prices(client: RetryableClient) -> PriceTables:
+    """Read the NodeBalancer price table."""
+    node_balancer = next(iter(await client.list_nodebalancer_types()), None)
+    if node_balancer is None:
+        msg = "NodeBalancer types: price table lists no types"
+        raise _UnpricedError(msg)
+    return PriceTables(node_balancer=node_balancer)
+
+
+async def _instance_create_cost(
+    client: RetryableClient, arguments: dict[str, Any]
+) -> float:
+    """The new instance's type, with backups when backups_enabled is set."""
+    prices = await _instance_prices(client)
+    return _instance_type_monthly(
+        prices,
+        str(arguments.get("type", "")),
+        arguments.get("backups_enabled") is True,
+    )
+
+
+async def _instance_delete_cost(
+    client: RetryableClient, arguments: dict[str, Any]
+) -> float:
+    """Credit the instance's current price."""
+    instance = await client.get_instance(_int_argument(arguments, "instance_id"))
+    prices = await _instance_prices(client)
+    return -_instance_type_monthly(prices, instance.type, instance.backups.enabled)
+
+
+async def _instance_resize_cost(
+    client: RetryableClient, arguments: dict[str, Any]
+) -> float:
+    """The new type's price less the current one, both with the instance's
+    backups setting."""
+    instance = await client.get_instance(_int_argument(arguments, "instance_id"))
+    prices = await _instance_prices(client)
+    backups = instance.backups.enabled
+    current = _instance_type_monthly(prices, instance.type, backups)
+    target = _instance_type_monthly(prices, str(arguments.get("type", "")), backups)
+    return target - current
+
+
+async def _volume_create_cost(
+    client: RetryableClient, arguments: dict[str, Any]
+) -> float:
+    """The new volume in its region, or in the region of the instance it
+    attaches to when only linode_id is given."""
+    region = str(arguments.get("region") or "")
+    linode_id = _int_argument(arguments, "linode_id")
+    if not region and linode_id > 0:
+        region = (await client.get_instance(linode_id)).region
+    if not region:
+        msg = "region or linode_id is required to price a volume"
+        raise _UnpricedError(msg)
+    prices = await _volume_prices(client)
+    return prices.volume_monthly(
+        _int_argument(arguments, "size", _VOLUME_DEFAULT_SIZE_GB), region
+    )
+
+
+async def _volume_delete_cost(
+    client: RetryableClient, arguments: dict[str, Any]
+) -> float:
+    """Credit the volume's current price."""
+    volume = await client.get_volume(_int_argument(arguments, "volume_id"))
+    prices = await _volume_prices(client)
+    return -prices.volume_monthly(volume.size, volume.region)
+
+
+async def _volume_resize_cost(
+    client: RetryableClient, arguments: dict[str, Any]
+) -> float:
+    """The gigabytes the resize adds."""
+    volume = await client.get_volume(_int_argument(arguments, "volume_id"))
+    prices = await _volume_prices(client)
+    target = prices.volume_monthly(_int_argument(arguments, "size"), volume.region)
+    return target - prices.volume_monthly(volume.size, volume.region)
+
+
+async def _node_balancer_create_cost(
+    client: RetryableClient, arguments: dict[str, Any]
+) -> float:
+    """A NodeBalancer in the requested region."""
+    prices = await _node_balancer_prices(client)
+    return prices.node_balancer_monthly(str(arguments.get("region") or ""))
+
+
+async def _node_balancer_delete_cost(
+    client: RetryableClient, arguments: dict[str, Any]
+) -> float:
+    """Credit the NodeBalancer's price in its region."""
+    node_balancer = await client.get_nodebalancer(
+        _int_argument(arguments, "nodebalancer_id")
+    )
+    prices = await _node_balancer_prices(client)
+    return -prices.node_balancer_monthly(node_balancer.region)
+
+
+# The writes cost attribution prices, keyed by tool name (Go's writeCosts).
+# They add, remove, or resize a resource with a list price; any other write
+# leaves its audit event's cost delta None.
+_WRITE_COSTS: dict[
+    str, Callable[[RetryableClient, dict[str, Any]], Awaitable[float]]
+] = {
+    "linode_instance_create": _instance_create_cost,
+    "linode_instance_delete": _instance_delete_cost,
+    "linode_instance_resize": _instance_resize_cost,
+    "linode_volume_create": _volume_create_cost,
+    "linode_volume_delete": _volume_delete_cost,
+    "linode_volume_resize": _volume_resize_cost,
+    "linode_nodebalancer_create": _node_balancer_create_cost,
+    "linode_nodebalancer_delete": _node_balancer_delete_cost,
+}
+
+
+async def write_cost_delta(
+    cfg: Config, name: str, arguments: dict[str, Any]
+) -> float | None:
+    """Estimate the monthly cost change of one call of tool name from the
+    Linode price tables, reading the resource a delete or resize targets
+    before the call changes it (Go's WriteCostDelta). None for a tool
+    _WRITE_COSTS does not price and for a price that could not be read,
+    which is logged rather than surfaced: attribution never fails the call
+    it describes."""
+    coster = _WRITE_COSTS.get(name)
+    if coster is None:
+        return None
+    try:
+        delta = await with_client(
+            cfg, arguments, lambda client: coster(client, arguments)
+        )
+    except (APIError, NetworkError, httpx.HTTPError, ConfigError, ValueError) as exc:
+        logger.debug("write cost not attributed for %s: %s", name, exc)
+        return None
+    return round_cents(delta)
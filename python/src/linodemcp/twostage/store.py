@@ -51,6 +51,10 @@ class PlanEntry:
     # re-fetched state to name the changed fields. None when the state did not
     # serialize to a JSON object. Mirrors the Go PlanEntry.StateFields.
     state_fields: dict[str, Any] | None = None
+    # The tenant that made the plan: empty unless server.tokenPassthrough has
+    # tenants share one server, where only the same tenant can look the plan
+    # up or apply it.
+    partition: str = ""
 
 
 def _wall_clock() -> datetime:
@@ -76,26 +80,29 @@ class PlanStore:
                 self._evict_oldest_locked()
             self._plans[entry.id] = entry
 
-    async def get(self, plan_id: str) -> PlanEntry:
-        """Return a plan without consuming it.
+    async def get(self, partition: str, plan_id: str) -> PlanEntry:
+        """Return a partition's plan without consuming it.
 
-        Raises PlanNotFoundError for an unknown id and PlanExpiredError when
-        the TTL has elapsed.
+        Raises PlanNotFoundError for an unknown id or another partition's plan
+        and PlanExpiredError when the TTL has elapsed.
         """
         async with self._lock:
             entry = self._plans.get(plan_id)
-            if entry is None:
+            if entry is None or entry.partition != partition:
                 raise PlanNotFoundError(plan_id)
             if self.now() > entry.expires_at:
                 raise PlanExpiredError(plan_id)
             return entry
 
-    async def take(self, plan_id: str) -> PlanEntry:
-        """Return a plan and remove it atomically (single-use)."""
+    async def take(self, partition: str, plan_id: str) -> PlanEntry:
+        """Return a partition's plan and remove it atomically (single-use).
+        Another partition's plan is left in place and reported as not
+        found."""
         async with self._lock:
-            entry = self._plans.pop(plan_id, None)
-            if entry is None:
+            entry = self._plans.get(plan_id)
+            if entry is None or entry.partition != partition:
                 raise PlanNotFoundError(plan_id)
+            del self._plans[plan_id]
             if self.now() > entry.expires_at:
                 raise PlanExpiredError(plan_id)
             return entry
@@ -121,6 +128,14 @@ class PlanStore:
         async with self._lock:
             return len(self._plans)
 
+    def size(self) -> int:
+        """Return the outstanding plan count without taking the lock.
+
+        For synchronous readers (the health endpoint runs on its own thread);
+        a dict length read is atomic, so the value is a consistent snapshot.
+        """
+        return len(self._plans)
+
     def start_janitor(self, interval: timedelta) -> asyncio.Task[None]:
         """Launch a background task that sweeps expired plans on an interval."""
 
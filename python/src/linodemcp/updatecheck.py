@@ -0,0 +1,125 @@
+"""Ask GitHub whether a newer LinodeMCP release exists.
+
+The server runs one check at startup and the linode_version_check tool runs
+one on demand; ``update_check.enabled: false`` turns both off for air-gapped
+installs. Nothing here downloads or installs anything.
+
+Mirrors ``go/internal/updatecheck``.
+"""
+
+from __future__ import annotations
+
+import re
+from dataclasses import dataclass, field
+from typing import Any
+
+import httpx
+
+# How many changelog bullets a result carries.
+MAX_HIGHLIGHTS = 5
+
+# One releases lookup never holds up startup or a tool call for long.
+_REQUEST_TIMEOUT = 5.0
+
+_VERSION_RE = re.compile(r"^(\d+)\.(\d+)\.(\d+)(?:-([0-9A-Za-z.-]+))?(?:\+.*)?$")
+
+
+class UpdateCheckError(Exception):
+    """The releases endpoint could not be reached or answered badly."""
+
+
+@dataclass
+class CheckResult:
+    """Outcome of one check.
+
+    ``update_available`` is True only when both versions parse and the latest
+    release is strictly newer than ``current``.
+    """
+
+    current: str
+    latest: str
+    update_available: bool
+    release_url: str = ""
+    published_at: str = ""
+    highlights: list[str] = field(default_factory=list[str])
+
+
+async def check(url: str, current: str) -> CheckResult:
+    """Fetch the latest release from url and compare it with current."""
+    headers = {
+        "Accept": "application/vnd.github+json",
+        "User-Agent": f"LinodeMCP/{current}",
+    }
+    try:
+        async with httpx.AsyncClient(timeout=_REQUEST_TIMEOUT) as client:
+            response = await client.get(url, headers=headers)
+    except httpx.HTTPError as exc:
+        msg = f"fetch latest release: {exc}"
+        raise UpdateCheckError(msg) from exc
+
+    if response.status_code != httpx.codes.OK:
+        msg = f"unexpected status from releases endpoint: {response.status_code}"
+        raise UpdateCheckError(msg)
+
+    try:
+        release: dict[str, Any] = response.json()
+    except ValueError as exc:
+        msg = f"decode latest release: {exc}"
+        raise UpdateCheckError(msg) from exc
+
+    tag = str(release.get("tag_name", ""))
+    return CheckResult(
+        current=current,
+        latest=tag.removeprefix("v"),
+        update_available=newer(tag, current),
+        release_url=str(release.get("html_url", "")),
+        published_at=str(release.get("published_at", "")),
+        highlights=highlights(str(release.get("body") or ""), MAX_HIGHLIGHTS),
+    )
+
+
+def highlights(body: str, limit: int) -> list[str]:
+    """Up to ``limit`` bullet lines from a release body, in order.
+
+    The release workflow groups notes under Features, Bug Fixes, and so on,
+    so the first bullets are the most notable changes.
+    """
+    out: list[str] = []
+    for raw in body.splitlines():
+        if len(out) == limit:
+            break
+        line = raw.strip()
+        for marker in ("- ", "* "):
+            if line.startswith(marker):
+                item = line.removeprefix(marker).strip()
+                if item:
+                    out.append(item)
+                break
+    return out
+
+
+def newer(latest: str, current: str) -> bool:
+    """Whether version ``latest`` is strictly newer than ``current``.
+
+    Both take an optional "v" prefix. A release without a pre-release suffix
+    beats the same core version with one (0.2.0 > 0.2.0-rc1). Anything that
+    does not parse as MAJOR.MINOR.PATCH compares as not newer.
+    """
+    latest_parsed = _parse_version(latest)
+    current_parsed = _parse_version(current)
+    if latest_parsed is None or current_parsed is None:
+        return False
+    latest_core, latest_pre = latest_parsed
+    current_core, current_pre = current_parsed
+    if latest_core != current_core:
+        return latest_core > current_core
+    return not latest_pre and bool(current_pre)
+
+
+def _parse_version(version: str) -> tuple[tuple[int, int, int], str] | None:
+    """Split "v1.2.3-rc1" into its numeric core and pre-release tag."""
+    match = _VERSION_RE.match(version.strip().removeprefix("v"))
+    if match is None:
+        return None
+    major, minor, patch, pre = match.groups()
+    return (int(major), int(minor), int(patch)), pre or ""
@@ -2,12 +2,17 @@
 
 import platform
 from dataclasses import asdict, dataclass
+from datetime import UTC, datetime
 
 VERSION = "0.1.0"
 API_VERSION = "0.1.0"
 
 REMOVED_FEATURE_TOOLS_LIST = "linode_object_storage_cluster_get"
 
+# When the process imported this module, close enough to process start for
+# uptime reporting (Go's appinfo.StartTime).
+_START_TIME = datetime.now(UTC)
+
 
 @dataclass
 class VersionInfo:
@@ -124,3 +129,8 @@ def get_version_info(
             "protocol": "mcp",
         },
     )
+
+
+def process_start_time() -> datetime:
+    """When the process started, as an aware UTC datetime."""
+    return _START_TIME
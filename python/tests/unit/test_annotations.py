@@ -0,0 +1,127 @@
+"""Tests for the local annotations store.
+
+Mirrors ``go/internal/annotations/store_test.go``.
+"""
+
+from __future__ import annotations
+
+import json
+from datetime import UTC, datetime
+from pathlib import Path
+
+import pytest
+
+from linodemcp.annotations import (
+    MAX_PER_RESOURCE,
+    AnnotationsError,
+    CorruptStoreError,
+    InvalidEnvironmentError,
+    InvalidKeyError,
+    Ref,
+    Store,
+    TooManyAnnotationsError,
+    ValueTooLongError,
+    parse_ref,
+)
+
+_FIXED_NOW = datetime(2026, 10, 15, 12, 0, 0, tzinfo=UTC)
+
+
+def _store(tmp_path: Path) -> tuple[Store, Path]:
+    directory = tmp_path / "annotations"
+    return Store(directory, now=lambda: _FIXED_NOW), directory
+
+
+def test_set_and_get(tmp_path: Path) -> None:
+    """Notes survive into a fresh Store over the same dir, as a later session
+    would see them, in the file layout the Go server writes."""
+    store, directory = _store(tmp_path)
+    ref = Ref(type="instance", id=123)
+
+    store.set("default", ref, "resize-reason", "resized 2024-06-01 due to OOM")
+    entries = store.set("default", ref, "owner", "web team")
+    assert set(entries) == {"owner", "resize-reason"}
+    assert entries["owner"].updated_at == "2026-10-15T12:00:00Z"
+
+    resources = Store(directory).get("default")
+    note = resources["instance/123"]["resize-reason"]
+    assert note.value == "resized 2024-06-01 due to OOM"
+
+    path = directory / "default.json"
+    assert path.stat().st_mode & 0o777 == 0o600
+    data = json.loads(path.read_text(encoding="utf-8"))
+    assert data["version"] == 1
+    assert data["resources"]["instance/123"]["owner"] == {
+        "value": "web team",
+        "updated_at": "2026-10-15T12:00:00Z",
+    }
+
+
+def test_empty_value_removes_key(tmp_path: Path) -> None:
+    """An empty value deletes the key and drops the emptied resource."""
+    store, _ = _store(tmp_path)
+    ref = Ref(type="volume", id=9)
+
+    store.set("default", ref, "note", "keep")
+    store.set("default", ref, "note", "")
+
+    assert "volume/9" not in store.get("default")
+
+
+def test_environments_are_separate(tmp_path: Path) -> None:
+    """Each environment has its own file."""
+    store, _ = _store(tmp_path)
+    store.set("production", Ref(type="instance", id=1), "note", "prod")
+    assert store.get("staging") == {}
+
+
+@pytest.mark.parametrize(
+    ("environment", "key", "value", "error"),
+    [
+        ("default", "Note", "x", InvalidKeyError),
+        ("default", "k" * 65, "x", InvalidKeyError),
+        ("default", "note", "v" * 1025, ValueTooLongError),
+        ("../etc", "note", "x", InvalidEnvironmentError),
+    ],
+)
+def test_validation(
+    tmp_path: Path,
+    environment: str,
+    key: str,
+    value: str,
+    error: type[AnnotationsError],
+) -> None:
+    """Bad keys, long values, and escaping environment names are rejected."""
+    store, _ = _store(tmp_path)
+    with pytest.raises(error):
+        store.set(environment, Ref(type="instance", id=1), key, value)
+
+
+def test_caps_annotations_per_resource(tmp_path: Path) -> None:
+    """A new key past the cap is rejected; overwriting an existing one is not."""
+    store, _ = _store(tmp_path)
+    ref = Ref(type="instance", id=1)
+    for i in range(MAX_PER_RESOURCE):
+        store.set("default", ref, "k" + "x" * i, "v")
+
+    with pytest.raises(TooManyAnnotationsError):
+        store.set("default", ref, "one-too-many", "v")
+
+    store.set("default", ref, "k", "updated")
+
+
+def test_rejects_corrupt_file(tmp_path: Path) -> None:
+    """A file that is not valid JSON surfaces as CorruptStoreError."""
+    store, directory = _store(tmp_path)
+    directory.mkdir(parents=True)
+    (directory / "default.json").write_text("{not json", encoding="utf-8")
+
+    with pytest.raises(CorruptStoreError):
+        store.get("default")
+
+
+def test_parse_ref() -> None:
+    """parse_ref reverses str(Ref) and rejects malformed keys."""
+    assert parse_ref("volume/42") == Ref(type="volume", id=42)
+    for bad in ("volume", "/42", "volume/x"):
+        assert parse_ref(bad) is None
@@ -0,0 +1,76 @@
+"""Tests for linode_api_status incident and error correlation."""
+
+from __future__ import annotations
+
+import json
+from datetime import UTC, datetime, timedelta
+from typing import TYPE_CHECKING, Any
+from unittest.mock import AsyncMock, patch
+
+from linodemcp.linode.api_errors import (
+    APIErrorLog,
+    APIErrorRecord,
+    reset_api_error_log,
+    set_api_error_log,
+)
+from linodemcp.statuspage import Incident, StatusPageError, Summary
+from linodemcp.tools.linode_api_status import handle_linode_api_status
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+
+
+async def _status(
+    config: Config, fetch: AsyncMock, arguments: dict[str, Any] | None = None
+) -> dict[str, Any]:
+    with patch("linodemcp.tools.linode_api_status.fetch", fetch):
+        result = await handle_linode_api_status(arguments or {}, config)
+    return json.loads(result[0].text)
+
+
+async def test_matches_incident_to_errors(sample_config: Config) -> None:
+    """Only the Object Storage failure inside the window matches the
+    incident, matching Go's TestLinodeAPIStatusMatchesIncidentToErrors."""
+    now = datetime.now(UTC)
+    log = APIErrorLog()
+    log.add(APIErrorRecord(now, "GET", "/object-storage/buckets", 503))
+    log.add(APIErrorRecord(now, "GET", "/linode/instances", 502))
+    log.add(APIErrorRecord(now - timedelta(hours=1), "GET", "/volumes", 0))
+    summary = Summary(
+        indicator="minor",
+        description="Minor Service Outage",
+        incidents=[
+            Incident(
+                name="Connectivity Issue",
+                status="investigating",
+                impact="minor",
+                components=["Object Storage - US-East (Newark)"],
+                latest_update="We are investigating.",
+            )
+        ],
+    )
+
+    token = set_api_error_log(log)
+    try:
+        response = await _status(sample_config, AsyncMock(return_value=summary))
+    finally:
+        reset_api_error_log(token)
+
+    assert response["message"] == (
+        "1 of 2 failed API requests in the last 15 minutes match an open "
+        "Linode incident: Connectivity Issue (minor)"
+    )
+    incident = response["incidents"][0]
+    assert incident["products"] == ["Object Storage"]
+    assert incident["matched_errors"] == 1
+    assert response["recent_errors"] == 2
+    assert len(response["error_groups"]) == 2
+
+
+async def test_reports_unreachable_status_page(sample_config: Config) -> None:
+    fetch = AsyncMock(side_effect=StatusPageError("unexpected status: 502"))
+
+    response = await _status(sample_config, fetch, {"window_minutes": 30})
+
+    assert response["status_error"] == "unexpected status: 502"
+    assert response["window_minutes"] == 30
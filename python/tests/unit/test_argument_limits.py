@@ -0,0 +1,63 @@
+"""Tests for the dispatcher's argument limits."""
+
+from __future__ import annotations
+
+import pytest
+
+from linodemcp.tools.argument_limits import validate_argument_limits
+
+
+def test_accepts_ordinary_arguments() -> None:
+    """Labels, multi-line descriptions, and short lists pass."""
+    assert (
+        validate_argument_limits(
+            {
+                "label": "web-1",
+                "description": "line one\nline two\ttabbed",
+                "tags": ["prod", "web"],
+                "linode_id": 5,
+            }
+        )
+        is None
+    )
+
+
+@pytest.mark.parametrize(
+    ("arguments", "want"),
+    [
+        (
+            {"label": "web\nINFO forged"},
+            "argument contains a control character: label has U+000A at character 4",
+        ),
+        (
+            {"rules": {"tags": ["ok", "bad\x1b[31m"]}},
+            "argument contains a control character: rules.tags[1] has U+001B "
+            "at character 4",
+        ),
+        (
+            {"root_pass": "x" * 70000},
+            "argument is too long: root_pass is 70000 characters, at most 65536",
+        ),
+        (
+            {"entity_ids": [None] * 1001},
+            "argument has too many items: entity_ids has 1001 items, at most 1000",
+        ),
+    ],
+)
+def test_rejects_malformed_arguments(arguments: dict[str, object], want: str) -> None:
+    """Each violation is reported by argument path with Go's wording."""
+    assert validate_argument_limits(arguments) == want
+
+
+def test_content_arguments_get_the_larger_limit() -> None:
+    """A StackScript body may exceed the per-string limit."""
+    assert validate_argument_limits({"script": "x" * 70000}) is None
+
+
+def test_rejects_oversized_request() -> None:
+    """The encoded arguments are capped as a whole."""
+    got = validate_argument_limits(
+        {"script": "x" * (6 << 20), "zone_file": "x" * (6 << 20)}
+    )
+    assert got is not None
+    assert got.startswith("arguments exceed the request size limit")
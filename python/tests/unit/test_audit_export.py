@@ -135,6 +135,20 @@ def test_encode_csv_header_and_args_cell() -> None:
     assert "us-east" in records[1][-1], "args cell is JSON"
 
 
+def test_encode_csv_cost_delta_cell() -> None:
+    """The cost delta renders in cents; an unpriced event leaves it empty."""
+    priced = _event("linode_volume_create", 1)
+    priced.set_monthly_cost_delta(12.5)
+
+    records = list(
+        csv.reader(io.StringIO(encode_events([priced, _event("tool_b", 2)], "csv")))
+    )
+
+    column = records[0].index("monthly_cost_delta_usd")
+    assert records[1][column] == "12.50"
+    assert records[2][column] == ""
+
+
 def test_encode_unknown_format_raises() -> None:
     """An unsupported format raises UnknownExportFormatError."""
     with pytest.raises(UnknownExportFormatError):
@@ -9,6 +9,7 @@ from __future__ import annotations
 
 import asyncio
 import json
+import sqlite3
 from datetime import UTC, datetime, timedelta
 from typing import TYPE_CHECKING
 
@@ -133,6 +134,37 @@ def test_sqlite_sink_stores_nulls_for_absent_optionals(tmp_path: Path) -> None:
     assert error is None
 
 
+def test_sqlite_sink_adds_cost_column_to_older_database(tmp_path: Path) -> None:
+    """A database created before cost attribution gains the cost column."""
+    db_path = tmp_path / "audit.db"
+    older = sqlite3.connect(db_path)
+    older.execute(
+        "CREATE TABLE events (event_id TEXT PRIMARY KEY, ts_unix_ns INTEGER NOT NULL, "
+        "tool TEXT NOT NULL, tool_capability TEXT NOT NULL, "
+        "environment TEXT NOT NULL, profile TEXT NOT NULL, mode TEXT NOT NULL, "
+        "plan_id TEXT, status TEXT NOT NULL, latency_ms INTEGER NOT NULL, "
+        "result_summary TEXT, error TEXT, linodemcp_version TEXT NOT NULL, "
+        "session_id TEXT NOT NULL, credential_generation INTEGER NOT NULL, "
+        "args_json TEXT NOT NULL, args_redacted_json TEXT NOT NULL)"
+    )
+    older.close()
+
+    sink = SQLiteSink(str(db_path), 5000)
+    try:
+        evt = _event(event_id="evt_cost", tool="linode_volume_create")
+        evt.set_monthly_cost_delta(2)
+        sink.write(evt)
+
+        (cost_delta,) = sink.connection.execute(
+            "SELECT monthly_cost_delta_usd FROM events WHERE event_id = ?",
+            (evt.event_id,),
+        ).fetchone()
+    finally:
+        sink.close()
+
+    assert cost_delta == 2
+
+
 def _count_rows(sink: SQLiteSink) -> int:
     """Return the total number of audit rows."""
     return int(sink.connection.execute("SELECT COUNT(*) FROM events").fetchone()[0])
@@ -0,0 +1,56 @@
+"""Tests for linode_availability_watch across calls."""
+
+from __future__ import annotations
+
+import json
+from typing import TYPE_CHECKING, Any
+from unittest.mock import AsyncMock, patch
+
+from linodemcp.availwatch import Store
+from linodemcp.tools.linode_availability_watch import (
+    handle_linode_availability_watch,
+    reset_availability_watch,
+    set_availability_watch,
+)
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+
+_ARGS = {"region_id": "us-ord", "plans": ["g1-gpu-rtx6000-1", "g6-dedicated-2"]}
+
+
+async def _watch(config: Config, available: bool) -> dict[str, Any]:
+    with patch("linodemcp.tools.helpers.RetryableClient") as mock_client_class:
+        mock_client = AsyncMock()
+        mock_client.get_region_availability.return_value = [
+            {"region": "us-ord", "plan": "g1-gpu-rtx6000-1", "available": available}
+        ]
+        mock_client.__aenter__.return_value = mock_client
+        mock_client.__aexit__.return_value = None
+        mock_client_class.return_value = mock_client
+
+        result = await handle_linode_availability_watch(dict(_ARGS), config)
+
+    return json.loads(result[0].text)
+
+
+async def test_watch_reports_changes(sample_config: Config) -> None:
+    """The second call reports only the plan whose status moved, matching
+    Go's TestLinodeAvailabilityWatchReportsChanges."""
+    token = set_availability_watch(Store())
+    try:
+        first = await _watch(sample_config, available=False)
+        second = await _watch(sample_config, available=True)
+    finally:
+        reset_availability_watch(token)
+
+    assert first["changed"] == 0
+    assert "previous_status" not in first["plans"][0]
+    assert first["plans"][1]["status"] == "unlisted"
+
+    assert second["changed"] == 1
+    assert [p["changed"] for p in second["plans"]] == [True, False]
+    assert second["message"] == (
+        "1 of 2 plans in us-ord changed since the last check: "
+        "g1-gpu-rtx6000-1 sold_out -> available"
+    )
@@ -0,0 +1,37 @@
+"""Tests for the availability watch store.
+
+Mirrors the Go ``store_test.go``: since holds while the status is unchanged,
+moves when it changes, and forget starts the watch over.
+"""
+
+from __future__ import annotations
+
+from datetime import UTC, datetime, timedelta
+
+from linodemcp.availwatch import Key, Store
+
+_KEY = Key(environment="default", region="us-ord", plan="g1-gpu-rtx6000-1")
+
+
+def test_record_tracks_status_changes() -> None:
+    clock = [datetime(2026, 10, 1, 12, 0, tzinfo=UTC)]
+    store = Store(now=lambda: clock[0])
+    start = clock[0]
+
+    _, previous = store.record(_KEY, "sold_out")
+    assert previous is None
+
+    clock[0] += timedelta(hours=1)
+    current, previous = store.record(_KEY, "sold_out")
+    assert previous is not None
+    assert previous.status == "sold_out"
+    assert current.since == start
+    assert current.checked_at == clock[0]
+
+    clock[0] += timedelta(hours=1)
+    current, _ = store.record(_KEY, "available")
+    assert current.since == clock[0]
+
+    store.forget(_KEY)
+    _, previous = store.record(_KEY, "available")
+    assert previous is None
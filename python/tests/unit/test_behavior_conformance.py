@@ -23,9 +23,13 @@ A request with no matching key fails the case, but an unused key does not:
 implementations may fetch equivalent data from different endpoints, and the
 contract these fixtures pin is the OUTPUT, not the fetch pattern. Without
 ``api_responses`` the single ``api_response`` (or ``{}``) answers every
-request. A case whose args include ``dry_run: true`` additionally asserts
-every captured request is a GET: a dry run may read whatever it needs for
-its preview but must never mutate.
+request, with status ``api_status`` (200 when unset), so a case can pin how a
+tool reports an API error. A case whose args include ``dry_run: true``
+additionally asserts every captured request is a GET: a dry run may read
+whatever it needs for its preview but must never mutate. ``expect_envelope``,
+when set, additionally pins the response envelope
+(``_meta["linodemcp/envelope"]``) minus its per-call ``elapsed_ms`` and
+``correlation_id``; it is not an outcome.
 """
 
 from __future__ import annotations
@@ -41,6 +45,7 @@ import pytest
 
 from linodemcp.config import BuiltinOverride, Config, EnvironmentConfig, LinodeConfig
 from linodemcp.server import Server
+from linodemcp.tools.envelope import ENVELOPE_META_KEY
 
 _BEHAVIOR_DIR = Path(__file__).resolve().parents[3] / "testdata" / "behavior"
 _FAKE_API_URL = "http://linode.test/v4"
@@ -87,6 +92,7 @@ def _behavior_outcome_count(case: dict[str, Any]) -> int:
 def _resolve_response(
     api_responses: dict[str, Any] | None,
     api_response: Any,
+    api_status: int,
     method: str,
     url: str,
     unmatched: list[str],
@@ -98,7 +104,7 @@ def _resolve_response(
     ``unmatched`` so the test fails loudly, and served as a 404.
     """
     if api_responses is None:
-        return 200, api_response
+        return api_status, api_response
 
     path = url.removeprefix(_FAKE_API_URL).split("?", 1)[0]
     key = f"{method} {path}"
@@ -129,13 +135,14 @@ async def test_behavior_conformance(
     unmatched: list[str] = []
     api_response = case.get("api_response", {})
     api_responses: dict[str, Any] | None = case.get("api_responses")
+    api_status: int = case.get("api_status", 200)
 
     async def _fake_request(
         _self: httpx.AsyncClient, method: str, url: str, **kwargs: Any
     ) -> httpx.Response:
         captured.append((method, url, kwargs.get("json")))
         status, body = _resolve_response(
-            api_responses, api_response, method, url, unmatched
+            api_responses, api_response, api_status, method, url, unmatched
         )
         return httpx.Response(
             status,
@@ -150,10 +157,10 @@ async def test_behavior_conformance(
     # the client instance as its first argument.
     with patch.object(httpx.AsyncClient, "request", autospec=True) as mock_req:
         mock_req.side_effect = _fake_request
-        result = await srv.dispatch(tool, dict(case["args"]))
+        result = await srv.call_tool_result(tool, dict(case["args"]))
 
-    assert len(result) == 1, f"{tool}/{case_name}: expected one content item"
-    text: str = result[0].text
+    assert len(result.content) == 1, f"{tool}/{case_name}: expected one content item"
+    text: str = getattr(result.content[0], "text", "")
 
     assert not unmatched, (
         f"{tool}/{case_name}: requests with no api_responses entry: "
@@ -167,6 +174,15 @@ async def test_behavior_conformance(
             "only GET is allowed"
         )
 
+    if "expect_envelope" in case:
+        envelope = dict((result.meta or {})[ENVELOPE_META_KEY])
+        envelope.pop("elapsed_ms", None)
+        envelope.pop("correlation_id", None)
+        assert envelope == case["expect_envelope"], (
+            f"{tool}/{case_name}: envelope {envelope!r}, "
+            f"want {case['expect_envelope']!r}"
+        )
+
     expect_error = case.get("expect_error")
     if expect_error:
         assert text == f"Error: {expect_error}", (
@@ -0,0 +1,63 @@
+"""Unit tests for ``linodemcp install-service``.
+
+Only the ``--print`` path runs here: it renders the systemd unit without
+touching /etc or the user's systemd directory. Mirrors
+``go/internal/cli/service_cmd_test.go``.
+"""
+
+from __future__ import annotations
+
+import io
+import sys
+from typing import TYPE_CHECKING
+
+import pytest
+
+from linodemcp.cli.service import run_install_service_command, systemd_unit
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+linux_only = pytest.mark.skipif(
+    not sys.platform.startswith("linux"),
+    reason="systemd units are rendered on Linux only",
+)
+
+
+def test_systemd_unit_quotes_paths_with_spaces(tmp_path: Path) -> None:
+    config_path = tmp_path / "linode mcp" / "config.yml"
+    unit = systemd_unit(
+        name="linodemcp-test",
+        executable="/usr/local/bin/linodemcp",
+        config_path=config_path,
+        working_dir=config_path.parent,
+        user=False,
+    )
+
+    assert "ExecStart=/usr/local/bin/linodemcp serve\n" in unit
+    assert f'WorkingDirectory="{config_path.parent}"' in unit
+    assert f'Environment="LINODEMCP_CONFIG_PATH={config_path}"' in unit
+    assert "StandardError=journal" in unit
+    assert "SyslogIdentifier=linodemcp-test" in unit
+    assert "WantedBy=multi-user.target" in unit
+
+
+@linux_only
+def test_print_user_unit_warns_about_missing_config(tmp_path: Path) -> None:
+    stdout, stderr = io.StringIO(), io.StringIO()
+
+    code = run_install_service_command(
+        ["--print", "--user", "--config", str(tmp_path / "absent.yml")],
+        stdout,
+        stderr,
+    )
+
+    assert code == 0
+    assert "WantedBy=default.target" in stdout.getvalue()
+    assert "warning: could not load" in stderr.getvalue()
+
+
+def test_positional_args_are_a_usage_error() -> None:
+    stdout, stderr = io.StringIO(), io.StringIO()
+
+    assert run_install_service_command(["extra"], stdout, stderr) == 2
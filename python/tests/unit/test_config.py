@@ -298,14 +298,17 @@ def test_environment_overrides(
 
     os.environ["LINODEMCP_SERVER_NAME"] = "OverriddenName"
     os.environ["LINODEMCP_LOG_LEVEL"] = "error"
+    os.environ["LINODEMCP_READ_ONLY"] = "true"
 
     try:
         cfg = load_from_file(config_file)
         assert cfg.server.name == "OverriddenName"
         assert cfg.server.log_level == "error"
+        assert cfg.read_only is True
     finally:
         del os.environ["LINODEMCP_SERVER_NAME"]
         del os.environ["LINODEMCP_LOG_LEVEL"]
+        del os.environ["LINODEMCP_READ_ONLY"]
 
 
 def test_linode_token_override(
@@ -316,12 +319,12 @@ def test_linode_token_override(
     config_file.write_text(yaml.dump(sample_config_data))
 
     os.environ["LINODEMCP_LINODE_TOKEN"] = "env-token-456"
-    os.environ["LINODEMCP_LINODE_API_URL"] = "https://api.custom.com"
+    os.environ["LINODEMCP_LINODE_API_URL"] = "https://api.custom.com/v4"
 
     try:
         cfg = load_from_file(config_file)
         assert cfg.environments["default"].linode.token == "env-token-456"
-        assert cfg.environments["default"].linode.api_url == "https://api.custom.com"
+        assert cfg.environments["default"].linode.api_url == "https://api.custom.com/v4"
     finally:
         del os.environ["LINODEMCP_LINODE_TOKEN"]
         del os.environ["LINODEMCP_LINODE_API_URL"]
@@ -348,6 +351,43 @@ def test_select_environment_empty_name_raises(sample_config: Config) -> None:
         cfg.select_environment("")
 
 
+def test_resolve_environment_is_case_insensitive(sample_config: Config) -> None:
+    """An environment name matches its key regardless of case."""
+    assert sample_config.resolve_environment("DEFAULT").label == "Default"
+
+
+def test_resolve_environment_name_returns_configured_key(
+    sample_config: Config,
+) -> None:
+    """Any spelling of a name resolves to the key it is configured under."""
+    assert sample_config.resolve_environment_name(" DEFAULT ") == "default"
+
+
+@pytest.mark.parametrize(
+    ("name", "expected"),
+    [
+        (
+            "defualt",
+            'environment not found in configuration: "defualt"; '
+            'valid environments: default; did you mean "default"?',
+        ),
+        (
+            "qa",
+            'environment not found in configuration: "qa"; '
+            "valid environments: default",
+        ),
+    ],
+)
+def test_resolve_environment_unknown_lists_names(
+    sample_config: Config, name: str, expected: str
+) -> None:
+    """An unknown name never falls back; the error lists the configured names
+    and suggests the closest one when it is near."""
+    with pytest.raises(EnvironmentNotFoundError) as excinfo:
+        sample_config.resolve_environment(name)
+    assert str(excinfo.value) == expected
+
+
 def test_get_linode_environment(sample_config: Config) -> None:
     """Test getting Linode environment."""
     linode_cfg = sample_config.get_linode_environment("default")
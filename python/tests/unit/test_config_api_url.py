@@ -0,0 +1,68 @@
+"""Tests for API URL validation and API version pinning."""
+
+from __future__ import annotations
+
+import pytest
+
+from linodemcp.config import (
+    Config,
+    ConfigInvalidError,
+    EnvironmentConfig,
+    LinodeConfig,
+    validate_config,
+)
+
+
+@pytest.mark.parametrize(
+    ("api_url", "api_version", "match"),
+    [
+        ("ftp://api.linode.com/v4", "", "scheme must be http or https"),
+        ("https:///v4", "", "missing host"),
+        ("https://api.linode.com", "", "must end in an API version"),
+        ("https://api.linode.com/v4?x=1", "", "query or fragment"),
+        ("https://api.linode.com/v4", "latest", "API version must look like"),
+    ],
+)
+def test_validate_config_rejects_bad_api_url(
+    api_url: str, api_version: str, match: str
+) -> None:
+    """A malformed API URL or pinned version fails validation naming the
+    environment, as the Go loader does."""
+    cfg = Config(
+        environments={
+            "staging": EnvironmentConfig(
+                label="Staging",
+                linode=LinodeConfig(
+                    api_url=api_url, token="tok", api_version=api_version
+                ),
+            ),
+        },
+    )
+
+    with pytest.raises(ConfigInvalidError, match=match) as exc:
+        validate_config(cfg)
+    assert "environment 'staging'" in str(exc.value)
+
+
+@pytest.mark.parametrize(
+    ("linode", "want"),
+    [
+        (
+            LinodeConfig(api_url="https://api.linode.com/v4"),
+            "https://api.linode.com/v4",
+        ),
+        (
+            LinodeConfig(api_url="https://api.linode.com/v4/", api_version="v4beta"),
+            "https://api.linode.com/v4beta",
+        ),
+        (
+            LinodeConfig(
+                api_url="https://proxy.example.com/linode/v4beta", api_version="v4"
+            ),
+            "https://proxy.example.com/linode/v4",
+        ),
+    ],
+)
+def test_linode_config_base_url(linode: LinodeConfig, want: str) -> None:
+    """A pinned version replaces the URL's version segment."""
+    assert linode.base_url() == want
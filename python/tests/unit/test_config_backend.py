@@ -0,0 +1,145 @@
+"""Tests for per-environment backends and the fixtures backend.
+
+Mirrors ``go/internal/config/backend_test.go`` and
+``go/internal/linode/backend_test.go``.
+"""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING
+
+import pytest
+
+from linodemcp.config import (
+    Config,
+    ConfigInvalidError,
+    EnvironmentConfig,
+    LinodeConfig,
+    validate_config,
+)
+from linodemcp.linode import APIError, Client
+from linodemcp.linode.backend import environment_transport
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+
+def _config(backend: str, fixtures_dir: str) -> Config:
+    return Config(
+        environments={
+            "staging": EnvironmentConfig(
+                label="Staging",
+                linode=LinodeConfig(
+                    api_url="https://api.linode.com/v4",
+                    token="tok",
+                    backend=backend,
+                    fixtures_dir=fixtures_dir,
+                ),
+            ),
+        },
+    )
+
+
+def test_validate_config_accepts_backends() -> None:
+    validate_config(_config("", ""))
+    validate_config(_config("api", ""))
+    validate_config(_config("fixtures", "/srv/fixtures"))
+
+
+@pytest.mark.parametrize(
+    ("backend", "fixtures_dir", "match"),
+    [
+        ("simulator", "", "got 'simulator'"),
+        ("fixtures", "", "needs fixturesDir"),
+        ("", "/srv/fixtures", "only read by the 'fixtures' backend"),
+        ("api", "/srv/fixtures", "only read by the 'fixtures' backend"),
+    ],
+)
+def test_validate_config_rejects_bad_backend(
+    backend: str, fixtures_dir: str, match: str
+) -> None:
+    """An unknown backend, or a fixturesDir that does not match the backend,
+    fails validation naming the environment, as the Go loader does."""
+    with pytest.raises(ConfigInvalidError, match=match) as exc:
+        validate_config(_config(backend, fixtures_dir))
+    assert "environment 'staging'" in str(exc.value)
+
+
+def test_api_backend_has_no_transport() -> None:
+    assert environment_transport(LinodeConfig(api_url="https://a/v4")) is None
+
+
+def _fixtures_client(tmp_path: Path, api_url: str) -> Client:
+    linode = LinodeConfig(
+        api_url=api_url,
+        token="fixtures",
+        backend="fixtures",
+        fixtures_dir=str(tmp_path),
+    )
+    return Client(
+        linode.base_url(), linode.token, transport=environment_transport(linode)
+    )
+
+
+@pytest.mark.parametrize(
+    "api_url",
+    ["https://api.linode.com/v4", "https://gateway.internal/proxy/v4beta"],
+)
+async def test_fixtures_backend_serves_recorded_files(
+    tmp_path: Path, api_url: str
+) -> None:
+    """Files are read relative to the API version, whatever path the API URL
+    carries ahead of it."""
+    (tmp_path / "volumes").mkdir()
+    (tmp_path / "volumes" / "7.json").write_text('{"id": 7, "label": "recorded"}')
+    client = _fixtures_client(tmp_path, api_url)
+    try:
+        response = await client.make_request("GET", "/volumes/7")
+    finally:
+        await client.close()
+
+    assert response.json()["label"] == "recorded"
+
+
+async def test_fixtures_backend_write_reads_method_file(tmp_path: Path) -> None:
+    (tmp_path / "volumes").mkdir()
+    (tmp_path / "volumes" / "7.delete.json").write_text("{}")
+    client = _fixtures_client(tmp_path, "https://api.linode.com/v4")
+    try:
+        response = await client.make_request("DELETE", "/volumes/7")
+    finally:
+        await client.close()
+
+    assert response.status_code == 200
+
+
+async def test_fixtures_backend_missing_file(tmp_path: Path) -> None:
+    """A request with no recorded file fails with the API's not-found error
+    naming the file it looked for."""
+    client = _fixtures_client(tmp_path, "https://api.linode.com/v4")
+    try:
+        with pytest.raises(APIError, match=r"volumes/8\.json"):
+            await client.get_volume(8)
+    finally:
+        await client.close()
+
+
+async def test_fixtures_backend_reads_page_files(tmp_path: Path) -> None:
+    """A page walk reads each later page from its own file instead of
+    replaying the first."""
+    (tmp_path / "tags").mkdir()
+    (tmp_path / "tags" / "prod.json").write_text(
+        '{"data": [{"type": "linode", "data": {"id": 1}}],'
+        ' "page": 1, "pages": 2, "results": 2}'
+    )
+    (tmp_path / "tags" / "prod_page2.json").write_text(
+        '{"data": [{"type": "volume", "data": {"id": 2}}],'
+        ' "page": 2, "pages": 2, "results": 2}'
+    )
+    client = _fixtures_client(tmp_path, "https://api.linode.com/v4")
+    try:
+        objects = await client.list_tagged_objects("prod")
+    finally:
+        await client.close()
+
+    assert [item["type"] for item in objects["data"]] == ["linode", "volume"]
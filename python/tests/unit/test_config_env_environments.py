@@ -0,0 +1,77 @@
+"""LINODEMCP_ENV_<NAME>_* environments, with and without a config file.
+
+Mirrors ``go/internal/config/env_environments_test.go``.
+"""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING, Any
+
+import pytest
+import yaml
+
+from linodemcp.config import ConfigInvalidError, load_from_file
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+_API_URL = "https://api.linode.com/v4"
+
+
+def test_load_env_only_without_config_file(
+    tmp_path: Path, monkeypatch: pytest.MonkeyPatch
+) -> None:
+    secret = tmp_path / "token"
+    secret.write_text("staging-secret\n", encoding="utf-8")
+    monkeypatch.setenv("LINODEMCP_ENV_PROD_URL", _API_URL)
+    monkeypatch.setenv("LINODEMCP_ENV_PROD_TOKEN", "prod-token")
+    monkeypatch.setenv("LINODEMCP_ENV_PROD_LABEL", "Production")
+    monkeypatch.setenv("LINODEMCP_ENV_STAGING_URL", _API_URL)
+    monkeypatch.setenv("LINODEMCP_ENV_STAGING_TOKEN_FILE", str(secret))
+
+    cfg = load_from_file(tmp_path / "absent.yml")
+
+    prod = cfg.environments["prod"]
+    assert prod.label == "Production"
+    assert prod.linode.token == "prod-token"
+    assert prod.linode.api_url == _API_URL
+    staging = cfg.environments["staging"]
+    assert staging.label == "staging"
+    assert staging.linode.token == "staging-secret"
+
+
+def test_env_environment_overrides_file(
+    tmp_path: Path,
+    monkeypatch: pytest.MonkeyPatch,
+    sample_config_data: dict[str, Any],
+) -> None:
+    config_file = tmp_path / "config.yml"
+    config_file.write_text(yaml.dump(sample_config_data))
+    monkeypatch.setenv("LINODEMCP_ENV_DEFAULT_TOKEN", "rotated-token")
+
+    cfg = load_from_file(config_file)
+
+    default = cfg.environments["default"]
+    assert default.linode.token == "rotated-token"
+    assert default.label == sample_config_data["environments"]["default"]["label"]
+
+
+def test_env_token_and_token_file_conflict(
+    tmp_path: Path, monkeypatch: pytest.MonkeyPatch
+) -> None:
+    monkeypatch.setenv("LINODEMCP_ENV_PROD_URL", _API_URL)
+    monkeypatch.setenv("LINODEMCP_ENV_PROD_TOKEN", "inline")
+    monkeypatch.setenv("LINODEMCP_ENV_PROD_TOKEN_FILE", str(tmp_path / "token"))
+
+    with pytest.raises(ConfigInvalidError, match="not both"):
+        load_from_file(tmp_path / "absent.yml")
+
+
+def test_env_token_file_unreadable(
+    tmp_path: Path, monkeypatch: pytest.MonkeyPatch
+) -> None:
+    monkeypatch.setenv("LINODEMCP_ENV_PROD_URL", _API_URL)
+    monkeypatch.setenv("LINODEMCP_ENV_PROD_TOKEN_FILE", str(tmp_path / "missing"))
+
+    with pytest.raises(ConfigInvalidError, match="cannot read token file"):
+        load_from_file(tmp_path / "absent.yml")
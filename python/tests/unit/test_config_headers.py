@@ -0,0 +1,79 @@
+"""Tests for per-environment extra API headers."""
+
+from __future__ import annotations
+
+import pytest
+
+from linodemcp.config import (
+    Config,
+    ConfigInvalidError,
+    EnvironmentConfig,
+    LinodeConfig,
+    validate_config,
+)
+from linodemcp.linode import Client
+
+
+def _config(headers: dict[str, str]) -> Config:
+    return Config(
+        environments={
+            "staging": EnvironmentConfig(
+                label="Staging",
+                linode=LinodeConfig(
+                    api_url="https://api.linode.com/v4",
+                    token="tok",
+                    headers=headers,
+                ),
+            ),
+        },
+    )
+
+
+def test_validate_config_accepts_headers() -> None:
+    """Well-formed extra headers pass validation."""
+    validate_config(
+        _config({"X-Gateway-Key": "gw-secret", "traceparent": "00-abc-def-01"})
+    )
+
+
+@pytest.mark.parametrize(
+    ("headers", "match"),
+    [
+        ({"X Gateway": "v"}, "is not a valid header name"),
+        ({"X-Gateway:": "v"}, "is not a valid header name"),
+        ({"authorization": "Bearer other"}, "Authorization is set by the client"),
+        ({"Content-Type": "text/plain"}, "Content-Type is set by the client"),
+        ({"User-Agent": "curl"}, "User-Agent is set by the client"),
+        ({"X-Gateway-Key": "a\r\nX-Injected: 1"}, "must not contain CR, LF, or NUL"),
+    ],
+)
+def test_validate_config_rejects_bad_headers(
+    headers: dict[str, str], match: str
+) -> None:
+    """A malformed or reserved header fails validation naming the
+    environment, as the Go loader does."""
+    with pytest.raises(ConfigInvalidError, match=match) as exc:
+        validate_config(_config(headers))
+    assert "environment 'staging'" in str(exc.value)
+
+
+async def test_client_sends_configured_headers() -> None:
+    """The client attaches the extra headers alongside its own Authorization."""
+    client = Client(
+        "https://api.linode.com/v4", "my-token", headers={"X-Gateway-Key": "gw"}
+    )
+    captured: dict[str, str] = {}
+
+    async def _request(method: str, url: str, **kwargs: object) -> object:
+        captured.update(kwargs["headers"])  # type: ignore[arg-type]
+        raise RuntimeError("stop")
+
+    client.client.request = _request  # type: ignore[method-assign]
+    try:
+        with pytest.raises(RuntimeError, match="stop"):
+            await client.make_request("GET", "/profile")
+    finally:
+        await client.close()
+
+    assert captured["X-Gateway-Key"] == "gw"
+    assert captured["Authorization"] == "Bearer my-token"
@@ -0,0 +1,47 @@
+"""Tests for the instance_defaults config."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING
+
+import pytest
+
+from linodemcp.config import ConfigInvalidError, load_from_file
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+_ENVIRONMENTS = (
+    "environments:\n"
+    "  default:\n"
+    '    label: "Default"\n'
+    "    linode:\n"
+    '      apiUrl: "https://api.linode.com/v4"\n'
+    '      token: "tok"\n'
+)
+
+
+def test_authorized_key_labels_loaded(tmp_path: Path) -> None:
+    """Labels load in order, matching Go's
+    TestInstanceDefaultsAuthorizedKeyLabels."""
+    config_file = tmp_path / "config.yml"
+    config_file.write_text(
+        'instance_defaults:\n  authorized_key_labels: ["ops", "ci"]\n'
+        + _ENVIRONMENTS
+    )
+
+    cfg = load_from_file(config_file)
+
+    assert cfg.instance_defaults.authorized_key_labels == ["ops", "ci"]
+
+
+def test_blank_authorized_key_label_rejected(tmp_path: Path) -> None:
+    """A blank label fails the load."""
+    config_file = tmp_path / "config.yml"
+    config_file.write_text(
+        'instance_defaults:\n  authorized_key_labels: ["ops", " "]\n'
+        + _ENVIRONMENTS
+    )
+
+    with pytest.raises(ConfigInvalidError, match="entry 1"):
+        load_from_file(config_file)
@@ -0,0 +1,52 @@
+"""Tests for the nodebalancer_probe allowlist config."""
+
+from __future__ import annotations
+
+from ipaddress import ip_network
+from typing import TYPE_CHECKING
+
+import pytest
+
+from linodemcp.config import ConfigInvalidError, load_from_file
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+_ENVIRONMENTS = (
+    "environments:\n"
+    "  default:\n"
+    '    label: "Default"\n'
+    "    linode:\n"
+    '      apiUrl: "https://api.linode.com/v4"\n'
+    '      token: "tok"\n'
+)
+
+
+def test_probe_networks_masks_cidrs_and_widens_addresses(tmp_path: Path) -> None:
+    """CIDRs are masked and a bare address becomes a single-host network,
+    matching Go's TestNodeBalancerProbePrefixes."""
+    config_file = tmp_path / "config.yml"
+    config_file.write_text(
+        "nodebalancer_probe:\n"
+        '  allowed_cidrs: ["192.168.128.7/17", "203.0.113.4", "2600:3c00::/32"]\n'
+        + _ENVIRONMENTS
+    )
+
+    cfg = load_from_file(config_file)
+
+    assert cfg.nodebalancer_probe.probe_networks() == [
+        ip_network("192.168.128.0/17"),
+        ip_network("203.0.113.4/32"),
+        ip_network("2600:3c00::/32"),
+    ]
+
+
+def test_probe_networks_invalid_entry_rejected(tmp_path: Path) -> None:
+    """A malformed allowlist entry fails the load."""
+    config_file = tmp_path / "config.yml"
+    config_file.write_text(
+        'nodebalancer_probe:\n  allowed_cidrs: ["10.0.0.0/33"]\n' + _ENVIRONMENTS
+    )
+
+    with pytest.raises(ConfigInvalidError, match="allowed_cidrs entry"):
+        load_from_file(config_file)
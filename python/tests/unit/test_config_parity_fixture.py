@@ -80,4 +80,8 @@ def test_shared_config_parity_fixture(monkeypatch: pytest.MonkeyPatch) -> None:
     env = cfg.environments["default"]
     assert env.label == "Parity"
     assert env.linode.api_url == "https://api.linode.com/v4"
+    assert env.linode.api_version == "v4beta"
     assert env.linode.token == "parity-test-token"
+    assert env.linode.headers == {"X-Gateway-Key": "parity-gateway"}
+    assert env.linode.backend == "fixtures"
+    assert env.linode.fixtures_dir == "parity-fixtures"
@@ -0,0 +1,105 @@
+"""Tests for strict config parsing and the published config schema."""
+
+from __future__ import annotations
+
+from pathlib import Path
+
+import pytest
+
+from linodemcp.config import (
+    ConfigInvalidError,
+    ConfigMalformedError,
+    config_schema,
+    load_from_file,
+)
+
+REPO_ROOT = Path(__file__).resolve().parents[3]
+
+
+def test_load_rejects_unknown_keys(tmp_path: Path) -> None:
+    """A misspelled key fails the load naming every unknown key with its line,
+    including keys under a free-form map such as an environment name; the
+    message matches Go's TestLoadRejectsUnknownKeys."""
+    config_file = tmp_path / "config.yml"
+    config_file.write_text(
+        "server:\n"
+        "  logLevl: debug\n"
+        "environments:\n"
+        "  default:\n"
+        '    label: "Default"\n'
+        "    linode:\n"
+        '      apiUrl: "https://api.linode.com/v4"\n'
+        '      tokn: "tok"\n'
+    )
+
+    with pytest.raises(ConfigMalformedError) as exc:
+        load_from_file(config_file)
+    assert str(exc.value) == (
+        "unknown configuration key: server.logLevl (line 2), "
+        "environments.default.linode.tokn (line 8)"
+    )
+
+
+def _schema_drift_config(tmp_path: Path, mode: str) -> Path:
+    config_file = tmp_path / "config.yml"
+    config_file.write_text(
+        "schema_drift:\n"
+        f"  mode: {mode}\n"
+        "environments:\n"
+        "  default:\n"
+        '    label: "Default"\n'
+        "    linode:\n"
+        '      apiUrl: "https://api.linode.com/v4"\n'
+        '      token: "tok"\n'
+    )
+    return config_file
+
+
+def test_load_reads_schema_drift(tmp_path: Path) -> None:
+    """schema_drift.mode loads in both implementations from one config file."""
+    cfg = load_from_file(_schema_drift_config(tmp_path, "warn"))
+
+    assert cfg.schema_drift.mode == "warn"
+
+
+def test_load_rejects_unknown_schema_drift_mode(tmp_path: Path) -> None:
+    """An unknown mode fails the load, as Go's ErrInvalidSchemaDriftMode does."""
+    with pytest.raises(ConfigInvalidError) as exc:
+        load_from_file(_schema_drift_config(tmp_path, "loud"))
+    assert str(exc.value) == (
+        "schema_drift.mode must be 'lenient', 'warn', or 'strict': got 'loud'"
+    )
+
+
+def test_load_checks_merged_keys(tmp_path: Path) -> None:
+    """Keys merged in with "<<" are checked against the mapping they land in,
+    so shared anchors load and a typo next to the merge is still caught."""
+    config_file = tmp_path / "config.yml"
+    config_file.write_text(
+        "environments:\n"
+        "  default:\n"
+        '    label: "Default"\n'
+        "    linode: &linode\n"
+        '      apiUrl: "https://api.linode.com/v4"\n'
+        '      token: "tok"\n'
+        "  staging:\n"
+        '    label: "Staging"\n'
+        "    linode:\n"
+        "      <<: *linode\n"
+        '      apiVersoin: "v4beta"\n'
+    )
+
+    with pytest.raises(
+        ConfigMalformedError,
+        match=r"environments\.staging\.linode\.apiVersoin \(line 11\)",
+    ):
+        load_from_file(config_file)
+
+
+def test_bundled_schema_matches_published() -> None:
+    """The schema the loader checks against is the published contract that
+    `make config-schema` generates from the Go structs."""
+    published = REPO_ROOT / "docs" / "contracts" / "config.schema.json"
+    bundled = Path(__file__).resolve().parents[2] / "src" / "linodemcp" / "config"
+    assert (bundled / "config.schema.json").read_text() == published.read_text()
+    assert config_schema()["properties"]["environments"]["type"] == "object"
@@ -0,0 +1,85 @@
+"""Tests for the tools enabled/disabled config."""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING
+
+import pytest
+
+from linodemcp.config import ConfigInvalidError, ToolsConfig, load_from_file
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+_ENVIRONMENTS = (
+    "environments:\n"
+    "  default:\n"
+    '    label: "Default"\n'
+    "    linode:\n"
+    '      apiUrl: "https://api.linode.com/v4"\n'
+    '      token: "tok"\n'
+)
+
+
+@pytest.mark.parametrize(
+    ("tools", "name", "want"),
+    [
+        (ToolsConfig(), "linode_instance_delete", True),
+        (ToolsConfig(enabled=["linode_lke_*"]), "linode_lke_cluster_list", True),
+        (ToolsConfig(enabled=["linode_lke_*"]), "linode_instance_list", False),
+        (ToolsConfig(enabled=["!linode_*_delete"]), "linode_instance_list", True),
+        (ToolsConfig(enabled=["!linode_*_delete"]), "linode_instance_delete", False),
+        (
+            ToolsConfig(enabled=["linode_instance_*", "!linode_*_delete"]),
+            "linode_instance_delete",
+            False,
+        ),
+        (
+            ToolsConfig(enabled=["linode_instance_?ist"]),
+            "linode_instance_list",
+            True,
+        ),
+        (
+            ToolsConfig(disabled=["linode_instance_create"]),
+            "linode_instance_create",
+            False,
+        ),
+        (ToolsConfig(disabled=["linode_instance_create"]), "linode_lke_list", True),
+    ],
+)
+def test_tools_config_allows(tools: ToolsConfig, name: str, want: bool) -> None:
+    """Allows applies enabled, "!" exclusions, and disabled like Go's
+    TestToolsConfigAllows."""
+    assert tools.allows(name) is want
+
+
+def test_tools_config_loaded(tmp_path: Path) -> None:
+    """Both lists load in order."""
+    config_file = tmp_path / "config.yml"
+    config_file.write_text(
+        "tools:\n"
+        '  enabled: ["linode_lke_*", "!linode_*_delete"]\n'
+        '  disabled: ["linode_lke_cluster_recycle"]\n' + _ENVIRONMENTS
+    )
+
+    cfg = load_from_file(config_file)
+
+    assert cfg.tools.enabled == ["linode_lke_*", "!linode_*_delete"]
+    assert cfg.tools.disabled == ["linode_lke_cluster_recycle"]
+
+
+@pytest.mark.parametrize(
+    ("block", "match"),
+    [
+        ('  enabled: ["linode_[lke"]\n', r"tools\.enabled\[0\]"),
+        ('  disabled: ["!linode_*_delete"]\n', r"tools\.disabled\[0\]"),
+        ('  enabled: ["linode_lke_*", ""]\n', r"tools\.enabled\[1\]"),
+    ],
+)
+def test_invalid_tool_pattern_rejected(tmp_path: Path, block: str, match: str) -> None:
+    """A malformed entry fails the load, naming its position."""
+    config_file = tmp_path / "config.yml"
+    config_file.write_text("tools:\n" + block + _ENVIRONMENTS)
+
+    with pytest.raises(ConfigInvalidError, match=match):
+        load_from_file(config_file)
@@ -307,6 +307,25 @@ def test_validate_config_empty_log_level_raises() -> None:
         validate_config(cfg)
 
 
+def test_validate_config_unknown_transport_raises() -> None:
+    """A transport neither implementation serves is rejected."""
+    cfg = _valid_config()
+    cfg.server.transport = "websocket"
+
+    with pytest.raises(ConfigInvalidError, match="server.transport must be"):
+        validate_config(cfg)
+
+
+def test_validate_config_auth_token_with_oauth_raises() -> None:
+    """server.authToken and oauth both read Authorization, so not both."""
+    cfg = _valid_config()
+    cfg.server.auth_token = "s3cret"
+    cfg.oauth.enabled = True
+
+    with pytest.raises(ConfigInvalidError, match="cannot be combined with oauth"):
+        validate_config(cfg)
+
+
 def test_validate_config_empty_environment_name_raises() -> None:
     """An environment keyed by the empty string is rejected in the loop."""
     cfg = _valid_config()
@@ -0,0 +1,98 @@
+"""Tests for the confirm_label config on the highest-risk deletes.
+
+Mirrors the Go ``confirm_label_test.go``: with the config on, a delete runs
+only when confirm_label matches the resource's label, and a refusal never
+names the real label.
+"""
+
+from __future__ import annotations
+
+import json
+from typing import TYPE_CHECKING
+
+import pytest
+
+from linodemcp.linode import parse_instance
+from linodemcp.tools.linode_instance_write import handle_linode_instance_delete
+from linodemcp.twostage import reset_plan_store, set_plan_store
+from linodemcp.twostage.store import PlanStore
+
+if TYPE_CHECKING:
+    from unittest.mock import AsyncMock
+
+    from linodemcp.config import Config
+
+
+@pytest.fixture(autouse=True)
+def stub_instance(mock_linode_client: AsyncMock) -> None:
+    """The label check and the plan-time walk both read the instance."""
+    mock_linode_client.get_instance.return_value = parse_instance(
+        {"id": 123, "label": "web-prod-01", "status": "running"}
+    )
+    mock_linode_client.list_volumes.return_value = []
+    mock_linode_client.list_instance_ips.return_value = {"ipv4": {"public": []}}
+
+
+@pytest.mark.parametrize(
+    ("enabled", "label", "want_error", "want_delete"),
+    [
+        (False, None, "", True),
+        (True, None, "linode_instance_delete requires confirm_label", False),
+        (
+            True,
+            "web-staging-01",
+            'confirm_label "web-staging-01" does not match',
+            False,
+        ),
+        (True, "web-prod-01", "", True),
+    ],
+)
+async def test_instance_delete_confirm_label(
+    sample_config: Config,
+    mock_linode_client: AsyncMock,
+    enabled: bool,
+    label: str | None,
+    want_error: str,
+    want_delete: bool,
+) -> None:
+    sample_config.confirm_label.enabled = enabled
+    arguments: dict[str, object] = {"instance_id": 123, "confirm": True}
+    if label is not None:
+        arguments["confirm_label"] = label
+
+    result = await handle_linode_instance_delete(arguments, sample_config)
+
+    if want_error:
+        assert want_error in result[0].text
+        assert "web-prod-01" not in result[0].text
+    else:
+        assert "removed successfully" in result[0].text
+    assert mock_linode_client.delete_instance.await_count == int(want_delete)
+
+
+async def test_plan_requires_confirm_label(
+    sample_config: Config, mock_linode_client: AsyncMock
+) -> None:
+    sample_config.confirm_label.enabled = True
+    store = PlanStore()
+    token = set_plan_store(store)
+    try:
+        refused = await handle_linode_instance_delete(
+            {"instance_id": 123, "mode": "plan"}, sample_config
+        )
+        assert "requires confirm_label" in refused[0].text
+        assert await store.length() == 0
+
+        planned = await handle_linode_instance_delete(
+            {"instance_id": 123, "mode": "plan", "confirm_label": "web-prod-01"},
+            sample_config,
+        )
+        plan_id = json.loads(planned[0].text)["plan_id"]
+
+        applied = await handle_linode_instance_delete(
+            {"instance_id": 123, "mode": "apply", "plan_id": plan_id}, sample_config
+        )
+        assert "removed successfully" in applied[0].text
+        mock_linode_client.delete_instance.assert_awaited_once()
+    finally:
+        reset_plan_store(token)
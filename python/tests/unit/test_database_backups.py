@@ -0,0 +1,94 @@
+"""Tests for the Managed Database restore tools."""
+
+from __future__ import annotations
+
+import json
+from typing import TYPE_CHECKING
+from unittest.mock import AsyncMock, patch
+
+from linodemcp.tools.linode_database_backups import (
+    handle_linode_database_mysql_instance_restore,
+    handle_linode_database_postgresql_instance_restore,
+)
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+
+_SOURCE = {
+    "id": 123,
+    "label": "orders",
+    "status": "active",
+    "region": "us-east",
+    "type": "g6-dedicated-2",
+    "engine": "mysql",
+    "version": "8.0.35",
+    "cluster_size": 3,
+}
+
+
+def _restored(status: str) -> dict[str, object]:
+    return {
+        "id": 456,
+        "label": "orders-restored",
+        "status": status,
+        "engine": "mysql",
+        "version": "8.0.35",
+    }
+
+
+async def test_mysql_restore_forks_and_waits(sample_config: Config) -> None:
+    """The restore forks the source into a new cluster and waits until active."""
+    with patch("linodemcp.tools.helpers.RetryableClient") as mock_client_class:
+        mock_client = AsyncMock()
+        mock_client.get_raw.side_effect = [_SOURCE, _restored("active")]
+        mock_client.post_raw.return_value = _restored("provisioning")
+        mock_client.__aenter__.return_value = mock_client
+        mock_client.__aexit__.return_value = None
+        mock_client_class.return_value = mock_client
+
+        result = await handle_linode_database_mysql_instance_restore(
+            {
+                "source_instance_id": 123,
+                "label": "orders-restored",
+                "region": "us-west",
+                "restore_time": "2025-01-02T10:00:00+01:00",
+                "confirm": True,
+                "wait": True,
+            },
+            sample_config,
+        )
+
+    body = json.loads(result[0].text)
+    mock_client.post_raw.assert_awaited_once_with(
+        "/databases/mysql/instances",
+        {
+            "label": "orders-restored",
+            "type": "g6-dedicated-2",
+            "region": "us-west",
+            "cluster_size": 3,
+            "engine": "mysql/8",
+            "fork": {"source": 123, "restore_time": "2025-01-02T09:00:00"},
+        },
+    )
+    assert body["ready"] is True
+    assert body["database_instance"]["status"] == "active"
+    assert body["message"].endswith("restoring from instance 123; it is active")
+
+
+async def test_postgresql_restore_rejects_future_restore_time(
+    sample_config: Config,
+) -> None:
+    """A restore_time in the future is rejected before any API call."""
+    with patch("linodemcp.tools.helpers.RetryableClient") as mock_client_class:
+        result = await handle_linode_database_postgresql_instance_restore(
+            {
+                "source_instance_id": 123,
+                "label": "orders-restored",
+                "restore_time": "2999-01-01T00:00:00Z",
+                "confirm": True,
+            },
+            sample_config,
+        )
+
+    mock_client_class.assert_not_called()
+    assert result[0].text == "restore_time must not be in the future"
@@ -0,0 +1,77 @@
+"""Tests for linode_domain_delegation_check NS parsing and summarizing."""
+
+from __future__ import annotations
+
+import struct
+from typing import Any
+from unittest.mock import patch
+
+import pytest
+
+from linodemcp.tools import linode_domain_delegation_check as delegation
+from linodemcp.tools.linode_dns_cutover import build_query
+
+
+def _ns_response(query_id: int, domain: str, hosts: list[str]) -> bytes:
+    """Build an NS answer whose owner names point back at the question."""
+    packet = bytearray(build_query(query_id, domain, 2))
+    struct.pack_into("!HHHH", packet, 2, 0x8180, 1, len(hosts), 0)
+    for host in hosts:
+        rdata = b"".join(
+            bytes([len(label)]) + label.encode() for label in host.split(".")
+        )
+        rdata += b"\x00"
+        packet += b"\xc0\x0c" + struct.pack("!HHIH", 2, 1, 300, len(rdata)) + rdata
+    return bytes(packet)
+
+
+def test_parse_nameservers_decodes_ns_hosts() -> None:
+    """NS rdata is decoded to lowercased host names."""
+    packet = _ns_response(7, "example.com", ["NS1.Linode.com", "ns2.linode.com"])
+
+    hosts = delegation._parse_nameservers(packet, 7)
+
+    assert hosts == ["ns1.linode.com", "ns2.linode.com"]
+
+
+def test_parse_nameservers_rejects_a_foreign_id() -> None:
+    """A response to another query is refused."""
+    packet = _ns_response(7, "example.com", ["ns1.linode.com"])
+
+    with pytest.raises(ValueError, match="ID mismatch"):
+        delegation._parse_nameservers(packet, 8)
+
+
+@pytest.mark.parametrize(
+    ("answers", "status"),
+    [
+        (
+            {"a": ["ns1.linode.com", "ns2.linode.com"], "b": ["ns1.linode.com"]},
+            "delegated",
+        ),
+        ({"a": ["ns1.linode.com"], "b": ["ns1.example.net"]}, "partial"),
+        ({"a": ["ns1.example.net"], "b": []}, "not_delegated"),
+        ({"a": OSError("refused"), "b": TimeoutError()}, "unknown"),
+    ],
+)
+@pytest.mark.asyncio
+async def test_check_domain_delegation_statuses(
+    answers: dict[str, Any], status: str
+) -> None:
+    """Each resolver's answer feeds the overall delegation status."""
+
+    async def fake_lookup(resolver: str, domain: str) -> list[str]:
+        assert domain == "example.com"
+        answer = answers[resolver]
+        if isinstance(answer, Exception):
+            raise answer
+        return list(answer)
+
+    with patch.object(delegation, "_lookup_ns", fake_lookup):
+        report = await delegation.check_domain_delegation(
+            {"id": 5, "domain": "example.com"}, ["a", "b"]
+        )
+
+    assert report["status"] == status
+    assert report["domain_id"] == 5
+    assert report["expected_nameservers"] == delegation.LINODE_NAMESERVERS
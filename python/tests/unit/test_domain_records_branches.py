@@ -2,7 +2,8 @@
 
 The happy paths live in ``test_tools.py``; this file drives the error and
 preview branches the main suite skips: missing IDs, the confirm gate, DNS
-name/target rejection, and the dry-run side-effect walks for update/delete.
+name/target rejection, record conflicts, and the dry-run side-effect walks
+for update/delete.
 """
 
 from __future__ import annotations
@@ -11,6 +12,7 @@ import json
 from typing import TYPE_CHECKING
 from unittest.mock import AsyncMock, patch
 
+from linodemcp.linode import DomainRecord
 from linodemcp.tools.linode_domain_records import (
     handle_linode_domain_record_create,
     handle_linode_domain_record_delete,
@@ -81,6 +83,46 @@ async def test_create_rejects_invalid_a_target(sample_config: Config) -> None:
     assert "A record target must be a valid IPv4 address" in result[0].text
 
 
+async def test_create_reports_cname_conflict(sample_config: Config) -> None:
+    """A CNAME over an existing record names that record and skips the POST,
+    matching Go's TestLinodeDomainRecordCreateToolReportsConflicts."""
+    existing = DomainRecord(
+        id=7,
+        type="A",
+        name="www",
+        target="8.8.8.8",
+        priority=0,
+        weight=0,
+        port=0,
+        ttl_sec=300,
+        created="2024-01-15T10:00:00",
+        updated="2024-01-15T10:00:00",
+    )
+    with patch("linodemcp.tools.helpers.RetryableClient") as mock_cls:
+        mock_client = AsyncMock()
+        mock_client.list_domain_records.return_value = [existing]
+        mock_client.__aenter__.return_value = mock_client
+        mock_client.__aexit__.return_value = None
+        mock_cls.return_value = mock_client
+
+        result = await handle_linode_domain_record_create(
+            {
+                "domain_id": 111,
+                "type": "CNAME",
+                "name": "www",
+                "target": "web.example.net",
+                "confirm": True,
+            },
+            sample_config,
+        )
+
+    assert result[0].text == (
+        'Error: CNAME record "www" conflicts with existing A record (ID: 7): '
+        "a CNAME cannot share its name with any other record"
+    )
+    mock_client.post_raw.assert_not_called()
+
+
 async def test_update_dry_run_missing_domain_id(sample_config: Config) -> None:
     """A dry-run update validates domain_id before fetching state."""
     result = await handle_linode_domain_record_update(
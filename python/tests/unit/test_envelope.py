@@ -0,0 +1,64 @@
+"""Tests for the response envelope carried in a tool result's _meta.
+
+Mirrors ``go/internal/server/envelope_test.go``.
+"""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING
+
+from linodemcp.server import Server
+from linodemcp.tools.envelope import (
+    ENVELOPE_META_KEY,
+    add_warning,
+    finalize_envelope,
+    reset_envelope,
+    set_envelope,
+    set_envelope_count,
+    warnings,
+)
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+
+
+async def test_envelope_warns_on_ignored_argument(sample_config: Config) -> None:
+    """An undeclared argument is a warning while the call still succeeds."""
+    srv = Server(sample_config)
+
+    result = await srv.call_tool_result("hello", {"name": "Pat", "nmae": "typo"})
+
+    envelope = (result.meta or {})[ENVELOPE_META_KEY]
+    assert envelope["warnings"] == [
+        'argument "nmae" is not accepted by hello and was ignored'
+    ]
+    assert "count" not in envelope
+    assert envelope["correlation_id"]
+
+
+def test_add_warning_outside_dispatch_is_a_noop() -> None:
+    """A handler called directly, with no envelope bound, drops warnings."""
+    add_warning("dropped %s", "warning")
+
+    assert warnings() == []
+
+
+def test_finalize_envelope_carries_count_and_warnings() -> None:
+    """A list count and formatted warnings land in Go's field order."""
+    token = set_envelope()
+    try:
+        set_envelope_count(2)
+        add_warning("page %d was skipped", 3)
+        envelope = finalize_envelope("default", 12, "evt_1")
+    finally:
+        reset_envelope(token)
+
+    assert list(envelope) == [
+        "count",
+        "warnings",
+        "environment",
+        "elapsed_ms",
+        "correlation_id",
+    ]
+    assert envelope["count"] == 2
+    assert envelope["warnings"] == ["page 3 was skipped"]
@@ -0,0 +1,48 @@
+"""Tests for the Linode API error remediation hints."""
+
+from __future__ import annotations
+
+import pytest
+from mcp.types import TextContent
+
+from linodemcp.tools.error_hints import append_error_hint
+
+
+@pytest.mark.parametrize(
+    ("text", "want_hint"),
+    [
+        (
+            "Failed to create instance: Linode API error (status 400): "
+            "Label must be unique among your linodes (field: label)",
+            "Another resource already uses that label",
+        ),
+        (
+            "Failed to create instance: Linode API error (status 400): "
+            "This plan is Sold Out in us-east",
+            "linode_region_availability_list",
+        ),
+        (
+            "Error: Linode API error (status 400): Volume is attached to a Linode",
+            "linode_volume_detach",
+        ),
+    ],
+)
+def test_append_error_hint_adds_catalog_hint(text: str, want_hint: str) -> None:
+    """A cataloged API error gets a Hint line appended."""
+    result = append_error_hint([TextContent(type="text", text=text)])
+    assert result[0].text.startswith(f"{text}\nHint: ")
+    assert want_hint in result[0].text
+
+
+@pytest.mark.parametrize(
+    "text",
+    [
+        "Error: label must be unique within the request",
+        "Failed to get instance: Linode API error (status 404): Not found",
+        '{"error": "Linode API error (status 400): must be unique"}',
+    ],
+)
+def test_append_error_hint_leaves_other_results(text: str) -> None:
+    """Local validation, uncataloged errors, and success payloads are untouched."""
+    result = append_error_hint([TextContent(type="text", text=text)])
+    assert result[0].text == text
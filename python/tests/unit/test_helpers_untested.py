@@ -114,7 +114,13 @@ def _capture_retry_max(captured: dict[str, int]) -> Callable[..., AsyncMock]:
     """A RetryableClient stand-in that records the max_retries of the
     RetryConfig it was built with, then behaves as the async-cm client."""
 
-    def _factory(api_url: str, token: str, retry_config: Any) -> AsyncMock:
+    def _factory(
+        api_url: str,
+        token: str,
+        retry_config: Any,
+        headers: Any = None,
+        transport: Any = None,
+    ) -> AsyncMock:
         captured["max_retries"] = retry_config.max_retries
         return _cm_client()
 
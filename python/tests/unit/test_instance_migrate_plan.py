@@ -0,0 +1,45 @@
+"""Tests for linode_instance_migrate with plan=true."""
+
+from __future__ import annotations
+
+import json
+from typing import TYPE_CHECKING
+from unittest.mock import AsyncMock, patch
+
+from linodemcp.linode import NetworkError
+from linodemcp.tools.linode_instance_actions import handle_linode_instance_migrate
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+
+
+async def test_migrate_plan_offline_instance_without_notifications(
+    sample_config: Config,
+) -> None:
+    """An offline instance plans with no downtime even if notifications fail."""
+
+    async def _get_raw(endpoint: str) -> dict[str, object]:
+        if endpoint == "/linode/instances/5":
+            return {"id": 5, "label": "web", "status": "offline", "region": "us-east"}
+        if endpoint == "/linode/instances/5/disks":
+            return {"data": [{"id": 11, "label": "boot", "size": 25600}]}
+        raise NetworkError("ListAccountNotifications", Exception("forbidden"))
+
+    with patch("linodemcp.tools.helpers.RetryableClient") as mock_client_class:
+        mock_client = AsyncMock()
+        mock_client.get_raw.side_effect = _get_raw
+        mock_client.__aenter__.return_value = mock_client
+        mock_client.__aexit__.return_value = None
+        mock_client_class.return_value = mock_client
+
+        result = await handle_linode_instance_migrate(
+            {"linode_id": 5, "plan": True}, sample_config
+        )
+
+    body = json.loads(result[0].text)
+    mock_client.migrate_instance.assert_not_awaited()
+    assert body["migration_type"] == "cold"
+    assert body["downtime_type"] == "none"
+    assert body["disk_size_mb"] == 25600
+    assert "to_region" not in body
+    assert body["queued_migrations"] == []
@@ -0,0 +1,134 @@
+"""Unit tests for the Kubernetes API server probe."""
+
+import base64
+
+import httpx
+import pytest
+
+from linodemcp.kubeprobe import (
+    CHECK_NODES,
+    KubeconfigError,
+    Target,
+    parse_kubeconfig,
+    ping,
+)
+
+_KUBECONFIG = """apiVersion: v1
+kind: Config
+clusters:
+- name: lke123
+  cluster:
+    certificate-authority-data: %CA%
+    server: https://123.us-east.linodelke.net:443/
+users:
+- name: lke123-admin
+  user:
+    token: secret-token
+contexts:
+- name: lke123-ctx
+  context:
+    cluster: lke123
+    namespace: default
+    user: lke123-admin
+current-context: lke123-ctx
+"""
+
+_NODES = {
+    "items": [
+        {
+            "metadata": {"name": "lke123-1"},
+            "status": {
+                "conditions": [{"type": "Ready", "status": "True"}],
+                "nodeInfo": {"kubeletVersion": "v1.31.1"},
+            },
+        },
+        {
+            "metadata": {"name": "lke123-2"},
+            "status": {"conditions": [{"type": "Ready", "status": "Unknown"}]},
+        },
+    ]
+}
+
+
+def _transport(nodes_status: int) -> httpx.MockTransport:
+    """An API server that requires the secret-token bearer token."""
+
+    def handler(request: httpx.Request) -> httpx.Response:
+        if request.headers.get("Authorization") != "Bearer secret-token":
+            return httpx.Response(401)
+        if request.url.path == "/version":
+            return httpx.Response(200, json={"gitVersion": "v1.31.1"})
+        if nodes_status != 200:
+            return httpx.Response(
+                nodes_status, json={"kind": "Status", "message": "nodes is forbidden"}
+            )
+        return httpx.Response(200, json=_NODES)
+
+    return httpx.MockTransport(handler)
+
+
+def test_parse_kubeconfig() -> None:
+    """The current context's server, CA, and token come back."""
+    ca = b"-----BEGIN CERTIFICATE-----\n-----END CERTIFICATE-----\n"
+    raw = _KUBECONFIG.replace("%CA%", base64.b64encode(ca).decode())
+
+    target = parse_kubeconfig(raw)
+
+    assert target == Target(
+        server="https://123.us-east.linodelke.net:443",
+        token="secret-token",
+        ca_data=ca,
+    )
+
+
+@pytest.mark.parametrize(
+    ("old", "new", "want"),
+    [
+        ("current-context: lke123-ctx", "current-context: other", "current context"),
+        ("server: https://123.us-east.linodelke.net:443/", 'server: ""', "server"),
+        ("token: secret-token", 'token: ""', "bearer token"),
+        ("%CA%", "not*base64", "certificate-authority-data"),
+    ],
+)
+def test_parse_kubeconfig_errors(old: str, new: str, want: str) -> None:
+    """Each unusable kubeconfig names what is missing."""
+    raw = _KUBECONFIG.replace(old, new).replace("%CA%", "")
+
+    with pytest.raises(KubeconfigError, match=want):
+        parse_kubeconfig(raw)
+
+
+async def test_ping_serving() -> None:
+    """Both checks pass and report the version and node readiness."""
+    target = Target(server="https://kube.test", token="secret-token")
+
+    result = await ping(target, transport=_transport(200))
+
+    assert result.serving()
+    assert result.version == "v1.31.1"
+    assert [(n.name, n.ready) for n in result.nodes] == [
+        ("lke123-1", True),
+        ("lke123-2", False),
+    ]
+
+
+async def test_ping_records_failed_check() -> None:
+    """A failed node list is recorded with the Status message, not raised."""
+    target = Target(server="https://kube.test", token="secret-token")
+
+    result = await ping(target, transport=_transport(403))
+
+    failed = result.first_failure()
+    assert not result.serving()
+    assert failed is not None
+    assert failed.name == CHECK_NODES
+    assert failed.error == "HTTP 403: nodes is forbidden"
+    assert result.version == "v1.31.1"
+
+
+async def test_ping_invalid_ca() -> None:
+    """A CA bundle with no certificate cannot be used at all."""
+    target = Target(server="https://kube.test", token="t", ca_data=b"junk")
+
+    with pytest.raises(KubeconfigError, match="PEM"):
+        await ping(target)
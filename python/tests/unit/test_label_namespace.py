@@ -0,0 +1,127 @@
+"""Tests for per-environment label namespaces."""
+
+from __future__ import annotations
+
+import json
+
+import pytest
+from mcp.types import TextContent
+
+from linodemcp.config import (
+    Config,
+    ConfigInvalidError,
+    EnvironmentConfig,
+    LinodeConfig,
+    validate_config,
+)
+from linodemcp.tools.envelope import (
+    envelope_count,
+    reset_envelope,
+    set_envelope,
+    set_envelope_count,
+)
+from linodemcp.tools.label_namespace import (
+    PARAM_NAMESPACE_ONLY,
+    apply_label_namespace,
+    resolve_label_namespace,
+    validate_label_namespace,
+)
+
+
+def _config(namespace: str = "team-a") -> Config:
+    return Config(
+        environments={
+            "default": EnvironmentConfig(
+                label="Default",
+                label_namespace=namespace,
+                linode=LinodeConfig(api_url="https://api.linode.com/v4", token="t"),
+            ),
+        },
+    )
+
+
+@pytest.mark.parametrize(
+    ("tool", "label", "want"),
+    [
+        ("linode_volume_create", "data", "team-a-data"),
+        ("linode_volume_create", "team-a-data", "team-a-data"),
+        ("linode_instance_clone", "web", "team-a-web"),
+        ("linode_volume_clone", "data", "team-a-data"),
+        ("linode_volume_update", "data", "data"),
+        ("linode_instance_disk_create", "boot", "boot"),
+        ("linode_object_storage_bucket_create", "assets", "assets"),
+    ],
+)
+def test_resolve_prefixes_create_label(tool: str, label: str, want: str) -> None:
+    """Top-level creates get the prefix once; other tools keep the label."""
+    arguments = {"label": label}
+    resolve_label_namespace(_config(), tool, arguments)
+    assert arguments["label"] == want
+
+
+def test_resolve_prefixes_deploy_label_prefix() -> None:
+    """Multi-region deploy gets the prefix on label_prefix, the label its
+    instance labels are built from."""
+    arguments = {"label_prefix": "web", "label": "ignored"}
+    resolve_label_namespace(_config(), "linode_multi_region_deploy", arguments)
+    assert arguments == {"label_prefix": "team-a-web", "label": "ignored"}
+
+
+def test_validate_rejects_overlong_label() -> None:
+    """A label the prefix pushes past the API's limit is left as given and
+    rejected."""
+    arguments = {"label": "data-volume-for-the-nightly-jobs"}
+    resolve_label_namespace(_config(), "linode_volume_create", arguments)
+
+    assert arguments["label"] == "data-volume-for-the-nightly-jobs"
+    assert validate_label_namespace(
+        _config(), "linode_volume_create", arguments
+    ) == (
+        "label is too long with the label_namespace prefix: "
+        '"team-a-data-volume-for-the-nightly-jobs" is 39 characters, and '
+        "linode_volume_create accepts at most 32"
+    )
+
+
+def test_apply_filters_list_and_count() -> None:
+    """namespace_only keeps the prefixed resources and recounts them."""
+    arguments = {PARAM_NAMESPACE_ONLY: True}
+    namespace = resolve_label_namespace(_config(), "linode_volumes_list", arguments)
+    assert namespace.list_prefix() == "team-a-"
+
+    body = {
+        "count": 2,
+        "volumes": [{"id": 1, "label": "team-a-data"}, {"id": 2, "label": "other"}],
+    }
+    token = set_envelope()
+    try:
+        set_envelope_count(2)
+        result = apply_label_namespace(
+            [TextContent(type="text", text=json.dumps(body))], namespace
+        )
+        count = envelope_count()
+    finally:
+        reset_envelope(token)
+
+    assert count == 1
+
+    assert isinstance(result[0], TextContent)
+    assert json.loads(result[0].text) == {
+        "count": 1,
+        "volumes": [{"id": 1, "label": "team-a-data"}],
+    }
+
+
+def test_namespace_only_ignored_off_list() -> None:
+    """namespace_only on a create tool does not filter."""
+    arguments = {PARAM_NAMESPACE_ONLY: True, "label": "data"}
+    namespace = resolve_label_namespace(_config(), "linode_volume_create", arguments)
+    assert not namespace.filter
+
+
+@pytest.mark.parametrize("namespace", ["abcdefghijklmnopq", "team-", "team a"])
+def test_validate_config_rejects_bad_namespace(namespace: str) -> None:
+    """A namespace that cannot prefix a Linode label fails validation."""
+    with pytest.raises(ConfigInvalidError, match="label_namespace") as exc:
+        validate_config(_config(namespace))
+    assert "environment 'default'" in str(exc.value)
@@ -0,0 +1,59 @@
+"""The label prefix every list read keeps.
+
+Mirrors ``go/internal/linode/label_prefix_test.go``.
+"""
+
+from typing import Any
+from unittest.mock import AsyncMock, MagicMock, patch
+
+from linodemcp.linode import Client
+from linodemcp.linode.label_prefix import reset_label_prefix, set_label_prefix
+from linodemcp.linode.max_results import (
+    list_truncated,
+    reset_max_results,
+    set_max_results,
+)
+
+# Page 2 holds nothing in the prefix, so the walk must read past it.
+_LABELS = {
+    1: ["other-1", "team-a-2"],
+    2: ["other-3", "other-4"],
+    3: ["team-a-5", "team-a-6"],
+}
+
+
+def _page(page: int) -> MagicMock:
+    """One of three pages of labeled volumes."""
+    response = MagicMock()
+    response.status_code = 200
+    response.json.return_value = {
+        "data": [
+            {"id": int(label.rsplit("-", 1)[1]), "label": label}
+            for label in _LABELS[page]
+        ],
+        "page": page,
+        "pages": 3,
+        "results": 6,
+    }
+    return response
+
+
+async def test_get_raw_keeps_label_prefix_before_max_results() -> None:
+    client = Client("https://api.linode.com/v4", "test-token")
+    max_results_token = set_max_results(2)
+    label_prefix_token = set_label_prefix("team-a-")
+    try:
+        with patch.object(client, "make_request", new_callable=AsyncMock) as request:
+            request.side_effect = [_page(1), _page(2), _page(3)]
+            raw: dict[str, Any] = await client.get_raw("/volumes")
+        truncated = list_truncated()
+    finally:
+        reset_label_prefix(label_prefix_token)
+        reset_max_results(max_results_token)
+
+    assert [item["id"] for item in raw["data"]] == [2, 5]
+    assert request.await_count == 3
+    # The API's total counts the volumes outside the prefix, so none is
+    # reported.
+    assert truncated == (0, True)
+    await client.close()
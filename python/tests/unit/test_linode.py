@@ -5063,6 +5063,37 @@ async def test_list_tagged_objects_sends_get_to_tag_route() -> None:
     await client.close()
 
 
+async def test_list_tagged_objects_walks_every_page() -> None:
+    """Asked for no page, listing tagged objects follows the pages count and
+    returns every page's objects as one page (mirrors Go's
+    TestClientListTaggedObjectsWalksEveryPage)."""
+    client = Client("https://api.linode.com/v4", "test-token")
+
+    def _page(page: int) -> MagicMock:
+        response = MagicMock()
+        response.status_code = 200
+        response.json.return_value = {
+            "data": [{"type": "linode", "data": {"id": page}}],
+            "page": page,
+            "pages": 2,
+            "results": 2,
+        }
+        return response
+
+    with patch.object(client, "make_request", new_callable=AsyncMock) as mock_request:
+        mock_request.side_effect = [_page(1), _page(2)]
+
+        result = await client.list_tagged_objects("production")
+
+    assert [item["data"]["id"] for item in result["data"]] == [1, 2]
+    assert (result["pages"], result["results"]) == (1, 2)
+    assert mock_request.call_args_list[1].args == (
+        "GET",
+        "/tags/production?page=2",
+    )
+    await client.close()
+
+
 async def test_list_tagged_objects_wraps_http_errors() -> None:
     """Test listing tagged objects wraps HTTP errors with operation context."""
     client = Client("https://api.linode.com/v4", "test-token")
@@ -16637,6 +16668,86 @@ class TestRetryableClientRateLimiter:
         await client.close()
 
 
+class TestRetryBackoff:
+    """Retry waits: the server's Retry-After hint, exponential backoff, and
+    the max_elapsed budget. Mirrors the Go client_test retry cases."""
+
+    @staticmethod
+    def _client(**overrides: Any) -> RetryableClient:
+        settings: dict[str, Any] = {
+            "max_retries": 5,
+            "base_delay": 1.0,
+            "max_delay": 30.0,
+            "jitter_enabled": False,
+            "circuit_breaker_threshold": 0,
+            "rate_limit_per_minute": 0,
+        }
+        settings.update(overrides)
+        return RetryableClient(
+            "https://api.linode.com/v4", "test-token", RetryConfig(**settings)
+        )
+
+    @staticmethod
+    def _fake_clock(monkeypatch: pytest.MonkeyPatch) -> list[float]:
+        clock = [0.0]
+        sleeps: list[float] = []
+
+        async def fake_sleep(delay: float) -> None:
+            sleeps.append(delay)
+            clock[0] += delay
+
+        monkeypatch.setattr("linodemcp.linode.time.monotonic", lambda: clock[0])
+        monkeypatch.setattr("linodemcp.linode.asyncio.sleep", fake_sleep)
+        return sleeps
+
+    async def test_honors_retry_after(self, monkeypatch: pytest.MonkeyPatch) -> None:
+        """A 429 with a Retry-After hint waits exactly that long, clamped to
+        max_delay."""
+        sleeps = self._fake_clock(monkeypatch)
+        client = self._client(max_delay=10.0)
+        with patch.object(client.client, "get_raw", new_callable=AsyncMock) as get:
+            get.side_effect = [
+                APIError(429, "slow down", retry_after=7),
+                APIError(429, "slow down", retry_after=60),
+                {"id": 1},
+            ]
+            assert await client.get_raw("/volumes/1") == {"id": 1}
+
+        assert sleeps == [7, 10.0]
+        await client.close()
+
+    async def test_stops_at_max_elapsed(self, monkeypatch: pytest.MonkeyPatch) -> None:
+        """Retries stop once the next wait would carry the call past
+        max_elapsed, even with attempts left."""
+        sleeps = self._fake_clock(monkeypatch)
+        client = self._client(max_elapsed=2.5)
+        with patch.object(client.client, "get_raw", new_callable=AsyncMock) as get:
+            get.side_effect = APIError(500, "server error")
+            with pytest.raises(APIError):
+                await client.get_raw("/volumes/1")
+
+        # Waits of 1s then 2s: the second would end at 3s, past the budget.
+        assert sleeps == [1.0]
+        assert get.await_count == 2
+        await client.close()
+
+    async def test_rate_limit_response_carries_retry_after(self) -> None:
+        def answer(request: httpx.Request) -> httpx.Response:
+            return httpx.Response(429, headers={"Retry-After": "30"}, request=request)
+
+        client = Client(
+            "https://api.linode.com/v4",
+            "test-token",
+            transport=httpx.MockTransport(answer),
+        )
+        with pytest.raises(APIError) as exc:
+            await client.make_request("GET", "/volumes")
+        await client.close()
+
+        assert exc.value.retry_after == 30
+        assert "Retry after 30s" in str(exc.value)
+
+
 async def test_create_profile_tfa_secret_sends_post_to_enable_route() -> None:
     """Profile TFA secret creation sends POST with no body."""
     client = Client("https://api.linode.com/v4", "test-token")
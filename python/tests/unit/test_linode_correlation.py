@@ -0,0 +1,44 @@
+"""Tests for the per-call correlation ID sent with Linode API requests."""
+
+from __future__ import annotations
+
+import pytest
+
+from linodemcp.linode import Client
+from linodemcp.linode.correlation import (
+    CORRELATION_ID_HEADER,
+    correlation_headers,
+    reset_correlation_id,
+    set_correlation_id,
+)
+
+_EVENT_ID = "evt_01HQXY3ZKQ8M7VRBNP4W5T2J9F"
+
+
+def test_correlation_headers_without_id() -> None:
+    """With no ID bound, only the plain User-Agent is sent."""
+    assert correlation_headers() == {"User-Agent": "LinodeMCP/1.0"}
+
+
+async def test_client_sends_correlation_id() -> None:
+    """A bound ID goes out as its own header and as a User-Agent product
+    token, alongside the client's Authorization header."""
+    client = Client("https://api.linode.com/v4", "my-token")
+    captured: dict[str, str] = {}
+
+    async def _request(method: str, url: str, **kwargs: object) -> object:
+        captured.update(kwargs["headers"])  # type: ignore[arg-type]
+        raise RuntimeError("stop")
+
+    client.client.request = _request  # type: ignore[method-assign]
+    token = set_correlation_id(_EVENT_ID)
+    try:
+        with pytest.raises(RuntimeError, match="stop"):
+            await client.make_request("GET", "/profile")
+    finally:
+        reset_correlation_id(token)
+        await client.close()
+
+    assert captured[CORRELATION_ID_HEADER] == _EVENT_ID
+    assert captured["User-Agent"] == f"LinodeMCP/1.0 correlation/{_EVENT_ID}"
+    assert captured["Authorization"] == "Bearer my-token"
@@ -0,0 +1,165 @@
+"""Server-side filter and sort order sent as the X-Filter header.
+
+Mirrors ``go/internal/linode/list_options_test.go`` and
+``go/internal/tools/list_options_test.go``.
+"""
+
+from typing import Any
+
+import httpx
+import pytest
+
+from linodemcp.linode import Client
+from linodemcp.linode.list_options import (
+    X_FILTER_HEADER,
+    ListOptions,
+    reset_list_options,
+    set_list_options,
+)
+from linodemcp.tools import (
+    create_linode_volume_get_tool,
+    create_linode_volume_list_tool,
+)
+from linodemcp.tools.linode_timeline import _events
+from linodemcp.tools.list_options import (
+    ERR_LIST_FILTER_NOT_OBJECT,
+    ERR_LIST_ORDER_BY_NOT_TEXT,
+    ERR_LIST_ORDER_INVALID,
+    ERR_LIST_ORDER_NO_ORDER_BY,
+    ListOptionsError,
+    add_list_options_schema,
+    resolve_list_options,
+)
+
+
+@pytest.mark.parametrize(
+    ("opts", "want"),
+    [
+        (ListOptions(), ""),
+        (ListOptions(filter={"region": "us-east"}), '{"region":"us-east"}'),
+        (
+            ListOptions(order_by="label", order="desc"),
+            '{"+order":"desc","+order_by":"label"}',
+        ),
+        (
+            ListOptions(
+                filter={"+or": [{"label": "a"}, {"label": "b"}]},
+                order_by="created",
+            ),
+            '{"+or":[{"label":"a"},{"label":"b"}],"+order_by":"created"}',
+        ),
+    ],
+)
+def test_x_filter(opts: ListOptions, want: str) -> None:
+    assert opts.x_filter() == want
+
+
+async def test_list_options_sent_on_list_reads_only() -> None:
+    filters: dict[str, str | None] = {}
+
+    def answer(request: httpx.Request) -> httpx.Response:
+        filters[request.url.path] = request.headers.get(X_FILTER_HEADER)
+        body: dict[str, Any] = {"id": 7, "label": "vol"}
+        if request.url.path.endswith("/volumes"):
+            body = {"data": [body], "page": 1, "pages": 1, "results": 1}
+        return httpx.Response(200, json=body)
+
+    client = Client(
+        "https://api.linode.com/v4",
+        "test-token",
+        transport=httpx.MockTransport(answer),
+    )
+    token = set_list_options(
+        ListOptions(filter={"region": "us-east"}, order_by="label")
+    )
+    try:
+        await client.get_raw("/volumes")
+        await client.make_request("GET", "/volumes/7")
+    finally:
+        reset_list_options(token)
+        await client.close()
+
+    assert filters["/v4/volumes"] == '{"+order_by":"label","region":"us-east"}'
+    assert filters["/v4/volumes/7"] is None
+
+
+async def test_timeline_sends_its_window_as_x_filter() -> None:
+    """linode_timeline asks the API for the window alone, newest first
+    (mirrors Go's TestLinodeTimelineToolNarratesResources)."""
+    filters: list[str | None] = []
+
+    def answer(request: httpx.Request) -> httpx.Response:
+        filters.append(request.headers.get(X_FILTER_HEADER))
+        return httpx.Response(
+            200, json={"data": [], "page": 1, "pages": 1, "results": 0}
+        )
+
+    client = Client(
+        "https://api.linode.com/v4",
+        "test-token",
+        transport=httpx.MockTransport(answer),
+    )
+    since = "2026-01-02T03:04:05"
+    try:
+        events, complete = await _events(client, since)  # type: ignore[arg-type]
+    finally:
+        await client.close()
+
+    assert (events, complete) == ([], True)
+    assert filters == [
+        '{"+order":"desc","+order_by":"created",'
+        f'"created":{{"+gte":"{since}"}}}}'
+    ]
+
+
+@pytest.mark.parametrize(
+    ("tool", "args", "want"),
+    [
+        ("linode_volume_list", {}, ListOptions()),
+        (
+            "linode_volume_list",
+            {"filter": {"region": "us-east"}},
+            ListOptions(filter={"region": "us-east"}),
+        ),
+        (
+            "linode_volume_list",
+            {"filter": '{"region":"us-east"}'},
+            ListOptions(filter={"region": "us-east"}),
+        ),
+        (
+            "linode_volume_list",
+            {"order_by": "label", "order": "DESC"},
+            ListOptions(order_by="label", order="desc"),
+        ),
+        ("linode_volume_get", {"filter": "not json"}, ListOptions()),
+    ],
+)
+def test_resolve_list_options(
+    tool: str, args: dict[str, Any], want: ListOptions
+) -> None:
+    assert resolve_list_options(tool, args) == want
+
+
+@pytest.mark.parametrize(
+    ("args", "message"),
+    [
+        ({"filter": '["region"]'}, ERR_LIST_FILTER_NOT_OBJECT),
+        ({"order_by": 1}, ERR_LIST_ORDER_BY_NOT_TEXT),
+        ({"order_by": "label", "order": "up"}, ERR_LIST_ORDER_INVALID),
+        ({"order": "asc"}, ERR_LIST_ORDER_NO_ORDER_BY),
+    ],
+)
+def test_resolve_list_options_rejects(args: dict[str, Any], message: str) -> None:
+    with pytest.raises(ListOptionsError) as exc:
+        resolve_list_options("linode_volume_list", args)
+    assert str(exc.value) == message
+
+
+def test_add_list_options_schema() -> None:
+    list_tool, _ = create_linode_volume_list_tool()
+    properties = add_list_options_schema(list_tool).inputSchema["properties"]
+    for name in ("filter", "order_by", "order", "region"):
+        assert name in properties
+
+    get_tool, _ = create_linode_volume_get_tool()
+    assert add_list_options_schema(get_tool) is get_tool
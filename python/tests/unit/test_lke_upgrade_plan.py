@@ -0,0 +1,77 @@
+"""Tests for linode_lke_upgrade_plan with execute=true."""
+
+from __future__ import annotations
+
+import json
+from typing import TYPE_CHECKING, Any
+from unittest.mock import AsyncMock, patch
+
+from linodemcp.linode import NetworkError
+from linodemcp.tools.linode_lke_upgrade_plan import handle_linode_lke_upgrade_plan
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+
+_CLUSTERS = [
+    {"id": 1, "label": "prod", "k8s_version": "1.30", "status": "ready"},
+    {"id": 2, "label": "dev", "k8s_version": "1.31", "status": "ready"},
+    {"id": 3, "label": "legacy", "k8s_version": "1.29", "status": "ready"},
+]
+
+
+async def _run(config: Config, fail_cluster: int) -> tuple[dict[str, Any], list[int]]:
+    updated: list[int] = []
+
+    async def _update(cluster_id: int, **_kwargs: Any) -> dict[str, Any]:
+        updated.append(cluster_id)
+        if cluster_id == fail_cluster:
+            raise NetworkError("UpdateLKECluster", Exception("upgrade blocked"))
+        return {"id": cluster_id, "status": "ready"}
+
+    with patch("linodemcp.tools.helpers.RetryableClient") as mock_client_class:
+        mock_client = AsyncMock()
+        mock_client.list_lke_versions.return_value = [{"id": "1.31"}, {"id": "1.30"}]
+        mock_client.list_lke_clusters.return_value = _CLUSTERS
+        mock_client.list_lke_node_pools.return_value = [{"id": 10, "count": 2}]
+        mock_client.get_lke_cluster.return_value = {"id": 1, "status": "ready"}
+        mock_client.update_lke_cluster.side_effect = _update
+        mock_client.__aenter__.return_value = mock_client
+        mock_client.__aexit__.return_value = None
+        mock_client_class.return_value = mock_client
+
+        result = await handle_linode_lke_upgrade_plan(
+            {"execute": True, "confirm": True}, config
+        )
+
+    return json.loads(result[0].text), updated
+
+
+async def test_upgrade_plan_executes_in_order(sample_config: Config) -> None:
+    """Every cluster with a next version upgrades, oldest plan entry first,
+    matching Go's TestLinodeLKEUpgradePlanExecutesInOrderAndStopsAtFailure."""
+    body, updated = await _run(sample_config, fail_cluster=0)
+
+    assert updated == [1, 3]
+    assert [c.get("outcome") for c in body["clusters"]] == [
+        "upgraded",
+        None,
+        "upgraded",
+    ]
+    assert body["upgraded"] == 2
+    assert body["message"] == "Upgraded 2 of 2 LKE clusters with an upgrade available"
+
+
+async def test_upgrade_plan_failure_skips_later_clusters(sample_config: Config) -> None:
+    """A failed upgrade stops the run and skips the clusters after it."""
+    body, updated = await _run(sample_config, fail_cluster=1)
+
+    assert updated == [1]
+    assert [c.get("outcome") for c in body["clusters"]] == [
+        "failed",
+        None,
+        "skipped",
+    ]
+    assert body["message"] == (
+        "Upgraded 0 of 2 LKE clusters with an upgrade available; "
+        "stopped at cluster 1 (failed)"
+    )
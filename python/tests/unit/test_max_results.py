@@ -0,0 +1,86 @@
+"""List pagination and the max_results cap.
+
+Mirrors ``go/internal/linode/max_results_test.go``.
+"""
+
+from typing import Any
+from unittest.mock import AsyncMock, MagicMock, patch
+
+from linodemcp.linode import Client
+from linodemcp.linode.max_results import (
+    list_truncated,
+    reset_max_results,
+    set_max_results,
+)
+from linodemcp.tools.max_results import resolve_max_results
+
+
+def _page(page: int) -> MagicMock:
+    """One of three pages of two volumes each."""
+    response = MagicMock()
+    response.status_code = 200
+    response.json.return_value = {
+        "data": [{"id": page * 2 - 1}, {"id": page * 2}],
+        "page": page,
+        "pages": 3,
+        "results": 6,
+    }
+    return response
+
+
+async def test_get_raw_follows_every_page() -> None:
+    client = Client("https://api.linode.com/v4", "test-token")
+
+    with patch.object(client, "make_request", new_callable=AsyncMock) as request:
+        request.side_effect = [_page(1), _page(2), _page(3)]
+        raw: dict[str, Any] = await client.get_raw("/volumes")
+
+    assert [item["id"] for item in raw["data"]] == [1, 2, 3, 4, 5, 6]
+    assert raw["pages"] == 1
+    assert raw["results"] == 6
+    assert [call.args for call in request.await_args_list] == [
+        ("GET", "/volumes"),
+        ("GET", "/volumes?page=2"),
+        ("GET", "/volumes?page=3"),
+    ]
+    await client.close()
+
+
+async def test_get_raw_with_explicit_page_reads_only_that_page() -> None:
+    client = Client("https://api.linode.com/v4", "test-token")
+
+    with patch.object(client, "make_request", new_callable=AsyncMock) as request:
+        request.return_value = _page(2)
+        raw: dict[str, Any] = await client.get_raw("/volumes?page=2&page_size=25")
+
+    assert [item["id"] for item in raw["data"]] == [3, 4]
+    assert request.await_count == 1
+    await client.close()
+
+
+async def test_get_raw_stops_at_max_results() -> None:
+    client = Client("https://api.linode.com/v4", "test-token")
+    token = set_max_results(3)
+    try:
+        with patch.object(client, "make_request", new_callable=AsyncMock) as request:
+            request.side_effect = [_page(1), _page(2), _page(3)]
+            raw: dict[str, Any] = await client.get_raw("/volumes")
+        truncated = list_truncated()
+    finally:
+        reset_max_results(token)
+
+    assert [item["id"] for item in raw["data"]] == [1, 2, 3]
+    assert request.await_count == 2
+    assert truncated == (6, True)
+    await client.close()
+
+
+def test_resolve_max_results() -> None:
+    tool = "linode_volumes_list"
+    assert resolve_max_results(tool, {}) == 0
+    assert resolve_max_results(tool, {"max_results": 50}) == 50
+    assert resolve_max_results(tool, {"max_results": 50.0}) == 50
+    assert resolve_max_results(tool, {"max_results": 0}) == 0
+    assert resolve_max_results(tool, {"max_results": 2.5}) == 0
+    assert resolve_max_results(tool, {"max_results": True}) == 0
+    assert resolve_max_results("linode_tags_cleanup", {"max_results": 50}) == 0
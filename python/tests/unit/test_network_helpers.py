@@ -0,0 +1,163 @@
+"""The public_internet / vpc_subnet interface helpers and config interface
+layout validation.
+
+Mirrors ``go/internal/tools/network_helpers_test.go``.
+"""
+
+from __future__ import annotations
+
+import json
+from typing import Any
+from unittest.mock import AsyncMock
+
+import pytest
+
+from linodemcp.tools.linode_instance_disks import (
+    handle_linode_instance_config_create,
+)
+from linodemcp.tools.linode_instance_write import handle_linode_instance_create
+from linodemcp.tools.linode_instances import handle_linode_instance_config_update
+
+_VPCS = [
+    {
+        "id": 7,
+        "label": "prod",
+        "subnets": [{"id": 41, "label": "web"}, {"id": 42, "label": "app"}],
+    }
+]
+
+
+async def test_instance_create_resolves_vpc_subnet_label(
+    sample_config: Any, mock_linode_client: AsyncMock
+) -> None:
+    mock_linode_client.list_vpcs.return_value = _VPCS
+    mock_linode_client.create_instance_raw.return_value = {"id": 1, "label": "a"}
+
+    await handle_linode_instance_create(
+        {
+            "region": "us-east",
+            "type": "g6-nanode-1",
+            "firewall_id": 9,
+            "vpc_subnet": "Prod/App",
+            "confirm": True,
+        },
+        sample_config,
+    )
+
+    interfaces = mock_linode_client.create_instance_raw.await_args.kwargs["interfaces"]
+    assert interfaces == [
+        {
+            "vpc": {
+                "subnet_id": 42,
+                "ipv4": {
+                    "addresses": [
+                        {"address": "auto", "primary": True, "nat_1_1_address": "auto"}
+                    ]
+                },
+            },
+            "firewall_id": 9,
+            "default_route": {"ipv4": True},
+        }
+    ]
+
+
+async def test_instance_create_unknown_vpc(
+    sample_config: Any, mock_linode_client: AsyncMock
+) -> None:
+    mock_linode_client.list_vpcs.return_value = _VPCS
+
+    result = await handle_linode_instance_create(
+        {
+            "region": "us-east",
+            "type": "g6-nanode-1",
+            "firewall_id": 9,
+            "vpc_subnet": "staging/app",
+            "confirm": True,
+        },
+        sample_config,
+    )
+
+    assert "no VPC with that label: staging" in result[0].text
+    mock_linode_client.create_instance_raw.assert_not_awaited()
+
+
+async def test_config_update_generates_private_vpc_interface(
+    sample_config: Any, mock_linode_client: AsyncMock
+) -> None:
+    mock_linode_client.update_instance_config.return_value = {"id": 456}
+
+    result = await handle_linode_instance_config_update(
+        {
+            "linode_id": 123,
+            "config_id": 456,
+            "vpc_subnet": "42",
+            "public_internet": False,
+            "confirm": True,
+        },
+        sample_config,
+    )
+
+    assert json.loads(result[0].text)["config"]["id"] == 456
+    mock_linode_client.update_instance_config.assert_awaited_once_with(
+        123,
+        456,
+        {
+            "interfaces": [
+                {
+                    "purpose": "vpc",
+                    "primary": True,
+                    "subnet_id": 42,
+                    "ipv4": {"vpc": "auto"},
+                }
+            ]
+        },
+    )
+    mock_linode_client.list_vpcs.assert_not_awaited()
+
+
+@pytest.mark.parametrize(
+    ("extra", "want"),
+    [
+        (
+            {"interfaces": '[{"purpose":"vlan","label":"v"},{"purpose":"public"}]'},
+            "public interface must be first",
+        ),
+        (
+            {
+                "interfaces": (
+                    '[{"purpose":"public","primary":true},'
+                    '{"purpose":"vpc","subnet_id":1,"primary":true}]'
+                )
+            },
+            "at most one interface can set primary",
+        ),
+        (
+            {"interfaces": '[{"purpose":"public","ipv4":{"nat_1_1":"any"}}]'},
+            "nat_1_1 applies only to vpc",
+        ),
+        ({"public_internet": False}, "requires vpc_subnet"),
+        (
+            {"interfaces": '[{"purpose":"public"}]', "vpc_subnet": "prod/app"},
+            "not both",
+        ),
+        ({"vpc_subnet": "prod/"}, "vpc_subnet must be"),
+    ],
+)
+async def test_config_create_interface_layout_validation(
+    extra: dict[str, Any],
+    want: str,
+    sample_config: Any,
+    mock_linode_client: AsyncMock,
+) -> None:
+    arguments: dict[str, Any] = {
+        "linode_id": 123,
+        "label": "boot",
+        "devices": {"sda": {"disk_id": 1}},
+        "confirm": True,
+        **extra,
+    }
+
+    result = await handle_linode_instance_config_create(arguments, sample_config)
+
+    assert want in result[0].text
+    mock_linode_client.create_instance_config.assert_not_awaited()
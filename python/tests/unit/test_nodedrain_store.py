@@ -0,0 +1,103 @@
+"""Tests for the NodeBalancer node drain store.
+
+Mirrors the coverage of the Go ``store_test.go``: the first restore mode wins
+on a repeated drain, a timed restore forgets the drain, and a failed restore
+keeps it with the error.
+"""
+
+from __future__ import annotations
+
+import asyncio
+from datetime import timedelta
+
+from linodemcp.nodedrain import Drain, Key, Store
+
+_KEY = Key(nodebalancer_id=1, config_id=2, node_id=3)
+
+
+def test_put_keeps_first_restore_mode() -> None:
+    store = Store()
+    store.put(Drain(key=_KEY, mode="drain", restore_mode="backup"))
+    drain = store.put(Drain(key=_KEY, mode="reject", restore_mode="drain"))
+
+    assert drain.mode == "reject"
+    assert drain.restore_mode == "backup"
+    assert store.take(_KEY) is not None
+    assert store.get(_KEY) is None
+
+
+def test_partitions_are_kept_apart() -> None:
+    store = Store()
+    key = Key(nodebalancer_id=1, config_id=2, node_id=3, partition="0a1b2c3d4e5f6071")
+    store.put(Drain(key=key, mode="drain", restore_mode="accept"))
+    other = Key(nodebalancer_id=1, config_id=2, node_id=3, partition="8192a3b4c5d6e7f8")
+
+    assert store.get(other) is None
+    assert store.take(other) is None
+    assert store.get(key) is not None
+
+
+async def test_restores_after_duration() -> None:
+    store = Store()
+    restored: list[Drain] = []
+
+    async def _restore(drain: Drain) -> None:
+        restored.append(drain)
+
+    drain = store.put(
+        Drain(key=_KEY, mode="drain", restore_mode="accept"),
+        timedelta(milliseconds=1),
+        _restore,
+    )
+    assert drain.restore_at is not None
+
+    for _ in range(500):
+        if store.get(_KEY) is None:
+            break
+        await asyncio.sleep(0.01)
+
+    assert [d.restore_mode for d in restored] == ["accept"]
+    assert store.get(_KEY) is None
+
+
+async def test_failed_restore_keeps_drain() -> None:
+    store = Store()
+
+    async def _restore(_drain: Drain) -> None:
+        msg = "api unavailable"
+        raise RuntimeError(msg)
+
+    store.put(
+        Drain(key=_KEY, mode="drain", restore_mode="accept"),
+        timedelta(milliseconds=1),
+        _restore,
+    )
+
+    for _ in range(500):
+        drain = store.get(_KEY)
+        if drain is not None and drain.restore_error:
+            break
+        await asyncio.sleep(0.01)
+
+    drain = store.get(_KEY)
+    assert drain is not None
+    assert drain.restore_error == "api unavailable"
+    assert drain.restore_at is None
+
+
+async def test_take_cancels_pending_restore() -> None:
+    store = Store()
+    restored: list[Drain] = []
+
+    async def _restore(drain: Drain) -> None:
+        restored.append(drain)
+
+    store.put(
+        Drain(key=_KEY, mode="drain", restore_mode="accept"),
+        timedelta(milliseconds=20),
+        _restore,
+    )
+    assert store.take(_KEY) is not None
+    await asyncio.sleep(0.05)
+
+    assert restored == []
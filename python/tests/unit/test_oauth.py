@@ -0,0 +1,166 @@
+"""OAuth authorization for the HTTP transport.
+
+Mirrors ``go/internal/oauth/oauth_test.go`` and the Go server and config
+tests for the oauth gate.
+"""
+
+from __future__ import annotations
+
+import dataclasses
+import json
+from typing import TYPE_CHECKING, Any
+
+import pytest
+
+from linodemcp.audit import CapturingSink, Status
+from linodemcp.config import (
+    DEFAULT_OAUTH_READ_SCOPE,
+    BuiltinOverride,
+    ConfigInvalidError,
+    OAuthConfig,
+    load_from_file,
+)
+from linodemcp.oauth import METADATA_PATH, Authorizer, OAuthError
+from linodemcp.profiles import Capability
+from linodemcp.server import Server
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+    from linodemcp.config import Config
+
+_ISSUER = "https://auth.example.test"
+_RESOURCE = "https://mcp.example.test"
+
+
+def _authorizer(response: dict[str, Any]) -> tuple[Authorizer, list[str]]:
+    """An Authorizer whose introspection answers ``response``, recording
+    each endpoint it calls."""
+    authorizer = Authorizer(
+        OAuthConfig(
+            enabled=True,
+            issuer=_ISSUER,
+            resource=_RESOURCE,
+            introspection_url=f"{_ISSUER}/introspect",
+        )
+    )
+    calls: list[str] = []
+
+    async def fake_post(
+        endpoint: str, form: dict[str, str], action: str
+    ) -> dict[str, Any]:
+        del action
+        calls.append(endpoint)
+        if form.get("token") != "good-token":
+            return {"active": False}
+        return response
+
+    authorizer._post = fake_post  # type: ignore[method-assign]
+    return authorizer, calls
+
+
+async def test_scopes_map_to_toolsets() -> None:
+    authorizer, calls = _authorizer(
+        {"active": True, "sub": "alice", "aud": _RESOURCE, "scope": "linodemcp:read"}
+    )
+
+    grant = await authorizer.authorize("Bearer good-token", Capability.Read)
+    assert grant.subject == "alice"
+    await authorizer.authorize("bearer good-token", Capability.Meta)
+    for capability in (Capability.Write, Capability.Destroy, Capability.Admin):
+        with pytest.raises(OAuthError, match="required scope"):
+            await authorizer.authorize("Bearer good-token", capability)
+    assert len(calls) == 1
+
+
+async def test_write_scope_implies_read() -> None:
+    authorizer, _ = _authorizer(
+        {"active": True, "aud": ["other", _RESOURCE], "scope": "linodemcp:write"}
+    )
+    for capability in (Capability.Read, Capability.Write, Capability.Destroy):
+        await authorizer.authorize("Bearer good-token", capability)
+
+
+@pytest.mark.parametrize(
+    ("response", "authorization", "match"),
+    [
+        ({}, "", "Authorization: Bearer"),
+        ({}, "Basic abc", "Authorization: Bearer"),
+        ({}, "Bearer other-token", "not active"),
+        (
+            {"active": True, "aud": _RESOURCE, "exp": 1},
+            "Bearer good-token",
+            "not active",
+        ),
+        (
+            {"active": True, "iss": "https://evil.test", "aud": _RESOURCE},
+            "Bearer good-token",
+            "different authorization server",
+        ),
+        (
+            {"active": True, "aud": "https://other.test"},
+            "Bearer good-token",
+            "audience",
+        ),
+    ],
+)
+async def test_rejects_bad_tokens(
+    response: dict[str, Any], authorization: str, match: str
+) -> None:
+    authorizer, _ = _authorizer(response)
+    with pytest.raises(OAuthError, match=match):
+        await authorizer.authorize(authorization, Capability.Read)
+
+
+def test_metadata_advertises_resource() -> None:
+    authorizer, _ = _authorizer({})
+    doc = json.loads(authorizer.metadata())
+    assert doc["resource"] == _RESOURCE
+    assert doc["authorization_servers"] == [_ISSUER]
+    assert DEFAULT_OAUTH_READ_SCOPE in doc["scopes_supported"]
+    assert f"{_RESOURCE}{METADATA_PATH}" in authorizer.challenge()
+
+
+async def test_dispatch_refuses_call_without_bearer(sample_config: Config) -> None:
+    cfg = dataclasses.replace(
+        sample_config,
+        active_profile="full-access",
+        profiles_builtin_overrides={"full-access": BuiltinOverride(disabled=False)},
+        oauth=OAuthConfig(
+            enabled=True,
+            issuer=_ISSUER,
+            resource=_RESOURCE,
+            introspection_url=f"{_ISSUER}/introspect",
+        ),
+    )
+    srv = Server(cfg)
+    sink = CapturingSink()
+    srv.set_audit_sink(sink)
+
+    with pytest.raises(OAuthError):
+        await srv.dispatch("hello", {"name": "Auditor"})
+
+    assert sink.events()[0].status is Status.REFUSED
+
+
+def test_token_exchange_requires_passthrough(tmp_path: Path) -> None:
+    config_file = tmp_path / "config.yml"
+    config_file.write_text(
+        "environments:\n"
+        "  default:\n"
+        "    linode:\n"
+        '      apiUrl: "https://api.linode.com/v4"\n'
+        '      token: "shared-token"\n'
+        "oauth:\n"
+        "  enabled: true\n"
+        f'  issuer: "{_ISSUER}"\n'
+        f'  resource: "{_RESOURCE}"\n'
+        f'  introspection_url: "{_ISSUER}/introspect"\n'
+        "  token_exchange:\n"
+        "    enabled: true\n"
+        f'    token_url: "{_ISSUER}/token"\n',
+        encoding="utf-8",
+    )
+
+    with pytest.raises(ConfigInvalidError, match="requires server.tokenPassthrough"):
+        load_from_file(config_file)
@@ -55,6 +55,56 @@ async def test_bucket_create_accepts_valid_label(sample_config: Config) -> None:
     assert "region is required" in result[0].text
 
 
+async def test_bucket_create_rejects_region_without_endpoint(
+    sample_config: Config,
+) -> None:
+    """A region missing from the endpoint listing is rejected with the valid
+    regions, and no bucket is created."""
+    client = _cm_client()
+    client.list_object_storage_endpoints.return_value = [
+        {"region": "us-ord", "endpoint_type": "E1"},
+        {"region": "us-east", "endpoint_type": "E0"},
+        {"region": "us-ord", "endpoint_type": "E3"},
+    ]
+    with patch("linodemcp.tools.helpers.RetryableClient", return_value=client):
+        result = await handle_linode_object_storage_bucket_create(
+            {"label": "my-bucket", "region": "mars-1", "confirm": True},
+            sample_config,
+        )
+    assert (
+        'region "mars-1" has no Object Storage endpoint; valid regions: '
+        "us-east, us-ord"
+    ) in result[0].text
+    client.create_object_storage_bucket.assert_not_called()
+
+
+async def test_bucket_create_auto_region_uses_preferred_order(
+    sample_config: Config,
+) -> None:
+    """region=auto takes the first preferred region with an endpoint and
+    reports how it chose."""
+    sample_config.object_storage.preferred_regions = ["mars-1", "US-EAST"]
+    client = _cm_client()
+    client.list_object_storage_endpoints.return_value = [
+        {"region": "us-ord", "endpoint_type": "E1"},
+        {"region": "us-east", "endpoint_type": "E0"},
+    ]
+    client.create_object_storage_bucket.return_value = {
+        "label": "my-bucket",
+        "region": "us-east",
+    }
+    with patch("linodemcp.tools.helpers.RetryableClient", return_value=client):
+        result = await handle_linode_object_storage_bucket_create(
+            {"label": "my-bucket", "region": "auto", "confirm": True},
+            sample_config,
+        )
+    assert client.create_object_storage_bucket.call_args.kwargs["region"] == "us-east"
+    body = json.loads(result[0].text)
+    assert body["region_selection"] == (
+        "first object_storage.preferred_regions entry with an endpoint"
+    )
+
+
 async def test_bucket_access_update_requires_region(sample_config: Config) -> None:
     """A missing region is reported first."""
     result = await handle_linode_object_storage_bucket_access_update(
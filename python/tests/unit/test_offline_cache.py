@@ -0,0 +1,160 @@
+"""Tests for the offline cache that answers read tools when the API is down."""
+
+from __future__ import annotations
+
+import stat
+from datetime import UTC, datetime
+from typing import TYPE_CHECKING
+
+import httpx
+import pytest
+from mcp.types import TextContent
+
+from linodemcp.config import (
+    Config,
+    EnvironmentConfig,
+    OfflineCacheConfig,
+    ServerConfig,
+)
+from linodemcp.linode import Client
+from linodemcp.linode.reachability import (
+    api_unreachable,
+    record_reachability,
+    reset_reachability,
+    set_reachability,
+)
+from linodemcp.offlinecache import InvalidEnvironmentError, Store
+from linodemcp.profiles import Capability
+from linodemcp.tools.offline_cache import apply_offline_cache, resolve_offline_cache
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+_FIXED_NOW = datetime(2026, 10, 16, 12, 0, 0, tzinfo=UTC)
+
+
+def _store(tmp_path: Path) -> Store:
+    return Store(tmp_path / "offline-cache", now=lambda: _FIXED_NOW)
+
+
+def test_store_put_and_get(tmp_path: Path) -> None:
+    """The environment and output_format arguments are not part of the key,
+    and the file is private to the user."""
+    store = _store(tmp_path)
+    store.put(
+        "default",
+        "linode_instance_list",
+        {"page": 2, "environment": "default", "output_format": {"size_unit": "GB"}},
+        ['{"instances":[]}'],
+    )
+
+    entry = Store(tmp_path / "offline-cache").get(
+        "default", "linode_instance_list", {"page": 2}
+    )
+    assert entry is not None
+    assert entry.cached_at == "2026-10-16T12:00:00Z"
+    assert entry.content == ['{"instances":[]}']
+    assert "environment" not in entry.arguments
+
+    (path,) = (tmp_path / "offline-cache" / "default").glob("linode_instance_list.*")
+    assert stat.S_IMODE(path.stat().st_mode) == 0o600
+
+
+def test_store_get_misses(tmp_path: Path) -> None:
+    """Other arguments, another environment, or another tool find nothing."""
+    store = _store(tmp_path)
+    store.put("default", "linode_instance_get", {"instance_id": 1}, ["{}"])
+
+    assert store.get("default", "linode_instance_get", {"instance_id": 2}) is None
+    assert store.get("staging", "linode_instance_get", {"instance_id": 1}) is None
+    assert store.get("default", "linode_volume_get", {"instance_id": 1}) is None
+
+
+def test_store_file_name_is_shared(tmp_path: Path) -> None:
+    """The file name matches Go's TestStoreFileNameIsShared, so either server
+    finds the entries the other saved; a whole float keys like Go's."""
+    store = _store(tmp_path)
+    store.put("default", "linode_tag_list", {"page": 2.0, "label": "a<b&é"}, ["{}"])
+
+    digest = "744f13c0d5ac09e34f979850098acc723a1a07486583977cf6b7e23adc87b153"
+    path = tmp_path / "offline-cache" / "default" / f"linode_tag_list.{digest}.json"
+    assert path.exists()
+
+
+@pytest.mark.parametrize("environment", ["", "..", "a/b"])
+def test_store_rejects_escaping_environment(tmp_path: Path, environment: str) -> None:
+    with pytest.raises(InvalidEnvironmentError):
+        _store(tmp_path).put(environment, "linode_instance_list", {}, [])
+
+
+async def test_client_records_reachability() -> None:
+    """Only a request that got no HTTP response marks the API unreachable."""
+    client = Client("https://api.linode.com/v4", "my-token")
+
+    async def _refused(method: str, url: str, **kwargs: object) -> object:
+        raise httpx.ConnectError("connection refused")
+
+    async def _not_found(method: str, url: str, **kwargs: object) -> object:
+        return httpx.Response(404, json={"errors": [{"reason": "Not found"}]})
+
+    token = set_reachability()
+    try:
+        client.client.request = _refused  # type: ignore[method-assign]
+        with pytest.raises(httpx.ConnectError):
+            await client.make_request("GET", "/profile")
+        assert api_unreachable()
+
+        client.client.request = _not_found  # type: ignore[method-assign]
+        with pytest.raises(Exception, match="Not found"):
+            await client.make_request("GET", "/profile")
+        assert not api_unreachable()
+    finally:
+        reset_reachability(token)
+        await client.close()
+
+    assert not api_unreachable()
+
+
+def test_apply_offline_cache_answers_when_unreachable(tmp_path: Path) -> None:
+    """A saved result stands in for a failed call only when the API could not
+    be reached, with a trailing stale-as-of warning."""
+    cfg = Config(
+        environments={"default": EnvironmentConfig()},
+        offline_cache=OfflineCacheConfig(enabled=True, dir=str(tmp_path)),
+    )
+    cache = resolve_offline_cache(cfg, Capability.Read, {})
+    assert cache.enabled
+    assert not resolve_offline_cache(cfg, Capability.Write, {}).enabled
+
+    live = [TextContent(type="text", text='{"tags":[]}')]
+    assert apply_offline_cache(cache, "linode_tag_list", {}, live) is live
+
+    failed = [TextContent(type="text", text="Failed to list tags: boom")]
+    assert apply_offline_cache(cache, "linode_tag_list", {}, failed) is failed
+
+    token = set_reachability()
+    try:
+        record_reachability(reached=False)
+        cached = apply_offline_cache(cache, "linode_tag_list", {}, failed)
+    finally:
+        reset_reachability(token)
+
+    assert cached[0].text == '{"tags":[]}'
+    assert cached[1].text.startswith("Warning: the Linode API is unreachable")
+    assert "stale as of " in cached[1].text
+
+
+def test_offline_cache_off_under_token_passthrough(tmp_path: Path) -> None:
+    """With token passthrough no call saves or replays a result, so one
+    tenant never gets another's saved inventory."""
+    cfg = Config(
+        server=ServerConfig(token_passthrough=True),
+        environments={"default": EnvironmentConfig()},
+        offline_cache=OfflineCacheConfig(enabled=True, dir=str(tmp_path)),
+    )
+    cache = resolve_offline_cache(cfg, Capability.Read, {})
+    assert not cache.enabled
+
+    live = [TextContent(type="text", text='{"tags":["alice-prod"]}')]
+    assert apply_offline_cache(cache, "linode_tag_list", {}, live) is live
+    assert not list(tmp_path.iterdir())
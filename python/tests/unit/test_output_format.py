@@ -0,0 +1,154 @@
+"""Tests for the size and price display annotations."""
+
+from __future__ import annotations
+
+import json
+
+import pytest
+from mcp.types import TextContent
+
+from linodemcp.config import (
+    ConfigInvalidError,
+    OutputFormatConfig,
+    validate_output_format,
+)
+from linodemcp.tools.output_format import (
+    OutputFormat,
+    apply_output_format,
+    resolve_output_format,
+)
+
+_TYPE_JSON = (
+    '{"id": "g6-nanode-1", "memory": 1024, "disk": 25600, "transfer": 1000, '
+    '"price": {"hourly": 0.0075, "monthly": 5}}'
+)
+
+
+def _annotated(text: str, tool_name: str, fmt: OutputFormat) -> object:
+    result = apply_output_format([TextContent(type="text", text=text)], tool_name, fmt)
+    return json.loads(result[0].text)
+
+
+def test_apply_output_format_gib_monthly() -> None:
+    """GiB sizes and a monthly price get display strings next to the raw values."""
+    got = _annotated(
+        _TYPE_JSON,
+        "linode_type_get",
+        OutputFormat(size_unit="GiB", price_period="monthly"),
+    )
+    assert got == {
+        "id": "g6-nanode-1",
+        "memory": 1024,
+        "memory_display": "1 GiB",
+        "disk": 25600,
+        "disk_display": "25 GiB",
+        "transfer": 1000,
+        "transfer_display": "1000 GiB",
+        "price": {"hourly": 0.0075, "monthly": 5, "display": "$5.00/mo"},
+    }
+
+
+def test_apply_output_format_gb_both_without_symbol() -> None:
+    """Decimal units and both periods render like the Go formatter."""
+    got = _annotated(
+        _TYPE_JSON,
+        "linode_type_get",
+        OutputFormat(size_unit="GB", price_period="both", currency_symbol=False),
+    )
+    assert isinstance(got, dict)
+    assert got["memory_display"] == "1.07 GB"
+    assert got["disk_display"] == "26.84 GB"
+    assert got["price"]["display"] == "5.00 USD/mo (0.0075 USD/hr)"
+
+
+def test_apply_output_format_volume_size_hourly() -> None:
+    """A volume's size is GiB and an hourly price keeps significant decimals."""
+    got = _annotated(
+        '[{"id": 1, "size": 20, "price": {"hourly": 0.003, "monthly": 2}}]',
+        "linode_volume_list",
+        OutputFormat(size_unit="GiB", price_period="hourly"),
+    )
+    assert got == [
+        {
+            "id": 1,
+            "size": 20,
+            "size_display": "20 GiB",
+            "price": {"hourly": 0.003, "monthly": 2, "display": "$0.003/hr"},
+        }
+    ]
+
+
+def test_apply_output_format_cloud_manager_links() -> None:
+    """Resources from a get tool, a list key, or an event entity gain a link."""
+    fmt = OutputFormat(cloud_manager_links=True)
+    assert _annotated('{"id": 123, "label": "web"}', "linode_instance_get", fmt) == {
+        "id": 123,
+        "label": "web",
+        "cloud_manager_url": "https://cloud.linode.com/linodes/123",
+    }
+    got = _annotated(
+        '{"database_instances": [{"id": 9, "engine": "postgresql"}, {"id": 10}]}',
+        "linode_database_list",
+        fmt,
+    )
+    assert got == {
+        "database_instances": [
+            {
+                "id": 9,
+                "engine": "postgresql",
+                "cloud_manager_url": "https://cloud.linode.com/databases/postgresql/9",
+            },
+            {"id": 10},
+        ]
+    }
+    got = _annotated(
+        '{"events": [{"id": 1, "entity": {"id": 55, "type": "volume"}, '
+        '"secondary_entity": {"id": 2, "type": "ticket"}}]}',
+        "linode_account_event_list",
+        fmt,
+    )
+    assert isinstance(got, dict)
+    event = got["events"][0]
+    assert "cloud_manager_url" not in event
+    assert event["entity"]["cloud_manager_url"] == "https://cloud.linode.com/volumes/55"
+    assert "cloud_manager_url" not in event["secondary_entity"]
+    assert _annotated('{"id": 4}', "linode_instance_disk_get", fmt) == {"id": 4}
+
+
+@pytest.mark.parametrize(
+    ("text", "fmt"),
+    [
+        ('{"memory": 1024}', OutputFormat()),
+        ('Error: {"memory": 1024}', OutputFormat(size_unit="GiB")),
+        ("memory: 1024", OutputFormat(size_unit="GiB")),
+    ],
+)
+def test_apply_output_format_leaves_other_results(text: str, fmt: OutputFormat) -> None:
+    """Unformatted, error, and non-JSON results are untouched."""
+    result = apply_output_format(
+        [TextContent(type="text", text=text)], "linode_type_get", fmt
+    )
+    assert result[0].text == text
+
+
+def test_resolve_output_format() -> None:
+    """A valid per-call override wins; an invalid one keeps the config."""
+    defaults = OutputFormatConfig(size_unit="GiB", price_period="monthly")
+    assert resolve_output_format(defaults, {}) == OutputFormat("GiB", "monthly", True)
+    assert resolve_output_format(
+        defaults, {"output_format": {"size_unit": "GB", "currency_symbol": False}}
+    ) == OutputFormat("GB", "monthly", False)
+    assert resolve_output_format(
+        defaults, {"output_format": {"price_period": "weekly"}}
+    ) == OutputFormat("GiB", "monthly", True)
+    assert resolve_output_format(
+        defaults, {"output_format": {"cloud_manager_links": True}}
+    ) == OutputFormat("GiB", "monthly", True, True)
+
+
+def test_validate_output_format_rejects_unknown_values() -> None:
+    """Config validation rejects units and periods the formatter cannot render."""
+    with pytest.raises(ConfigInvalidError):
+        validate_output_format("TB", "")
+    with pytest.raises(ConfigInvalidError):
+        validate_output_format("", "weekly")
@@ -542,6 +542,7 @@ def test_ssh_and_monitor_scopes(
         "linode_region_list",
         "linode_region_availability_get",
         "linode_region_availability_list",
+        "linode_availability_watch",
         "linode_type_get",
         "linode_type_list",
         "linode_lke_type_list",
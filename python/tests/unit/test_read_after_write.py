@@ -0,0 +1,129 @@
+"""Tests for the read-after-write check."""
+
+from __future__ import annotations
+
+from collections.abc import Awaitable, Callable
+from types import SimpleNamespace
+from typing import Any
+
+import pytest
+from mcp.types import TextContent
+
+from linodemcp.config import Config, ReadAfterWriteConfig
+from linodemcp.linode import APIError
+from linodemcp.linode.writelog import record_write, reset_write_log, set_write_log
+from linodemcp.profiles.capability import Capability
+from linodemcp.tools import read_after_write
+from linodemcp.tools.envelope import reset_envelope, set_envelope, warnings
+from linodemcp.tools.read_after_write import (
+    PARAM_READ_AFTER_WRITE,
+    ReadAfterWrite,
+    await_read_after_write,
+    resolve_read_after_write,
+)
+
+_SETTINGS = ReadAfterWriteConfig(enabled=True, attempts=4, interval_ms=250)
+_OK = [TextContent(type="text", text="{}")]
+
+
+class _StaleVolume:
+    """Serves volume 9 with the old label for the first stale_reads GETs."""
+
+    def __init__(self, stale_reads: int, *, deleted: bool = False) -> None:
+        self.stale_reads = stale_reads
+        self.deleted = deleted
+        self.reads = 0
+
+    async def get_raw(self, endpoint: str) -> Any:
+        self.reads += 1
+        stale = self.reads <= self.stale_reads
+        if self.deleted and not stale:
+            raise APIError(404, "Not found")
+        return {"id": 9, "label": "data" if stale else "data-new"}
+
+
+def _use_client(monkeypatch: pytest.MonkeyPatch, client: _StaleVolume) -> None:
+    async def fake_with_client(
+        _cfg: Config,
+        _arguments: dict[str, Any],
+        callback: Callable[[Any], Awaitable[None]],
+    ) -> None:
+        await callback(SimpleNamespace(client=client))
+
+    monkeypatch.setattr(read_after_write, "with_client", fake_with_client)
+
+
+async def _run_check(
+    method: str, body: dict[str, Any] | None, check: ReadAfterWrite
+) -> None:
+    token = set_write_log()
+    try:
+        record_write(method, "/volumes/9", body, SimpleNamespace(json=dict))
+        await await_read_after_write(Config(), {}, check, _OK)
+    finally:
+        reset_write_log(token)
+
+
+@pytest.mark.parametrize(
+    ("capability", "arguments", "expected"),
+    [
+        (Capability.Write, {}, ReadAfterWrite(attempts=4, interval_ms=250)),
+        (Capability.Read, {}, ReadAfterWrite()),
+        (Capability.Write, {"dry_run": True}, ReadAfterWrite()),
+        (Capability.Write, {PARAM_READ_AFTER_WRITE: False}, ReadAfterWrite()),
+        (
+            Capability.Write,
+            {PARAM_READ_AFTER_WRITE: "yes"},
+            ReadAfterWrite(attempts=4, interval_ms=250),
+        ),
+    ],
+)
+def test_resolve_read_after_write(
+    capability: Capability, arguments: dict[str, Any], expected: ReadAfterWrite
+) -> None:
+    """The argument overrides the config; reads and dry runs never check."""
+    assert resolve_read_after_write(_SETTINGS, capability, arguments) == expected
+
+
+async def test_update_visible_after_stale_read(
+    monkeypatch: pytest.MonkeyPatch,
+) -> None:
+    """A PUT is re-read until the fetched volume shows the new label."""
+    client = _StaleVolume(stale_reads=1)
+    _use_client(monkeypatch, client)
+
+    token = set_envelope()
+    try:
+        await _run_check("PUT", {"label": "data-new"}, ReadAfterWrite(3, 0))
+        assert warnings() == []
+    finally:
+        reset_envelope(token)
+
+    assert client.reads == 2
+
+
+async def test_update_never_visible_warns(monkeypatch: pytest.MonkeyPatch) -> None:
+    """A write still stale after every read is a warning, not an error."""
+    client = _StaleVolume(stale_reads=10)
+    _use_client(monkeypatch, client)
+
+    token = set_envelope()
+    try:
+        await _run_check("PUT", {"label": "data-new"}, ReadAfterWrite(3, 0))
+        assert warnings() == [
+            "read_after_write: PUT /volumes/9 still showed the old state after 3 reads"
+        ]
+    finally:
+        reset_envelope(token)
+
+    assert client.reads == 3
+
+
+async def test_delete_visible_once_gone(monkeypatch: pytest.MonkeyPatch) -> None:
+    """A DELETE is visible once the re-read returns 404."""
+    client = _StaleVolume(stale_reads=2, deleted=True)
+    _use_client(monkeypatch, client)
+
+    await _run_check("DELETE", None, ReadAfterWrite(5, 0))
+
+    assert client.reads == 3
@@ -0,0 +1,123 @@
+"""Tests for summarizing oversized results through MCP sampling."""
+
+from __future__ import annotations
+
+import json
+import re
+from datetime import UTC, datetime
+
+import pytest
+from mcp.types import TextContent
+
+from linodemcp.config import DEFAULT_RESULT_SUMMARY_MAX_TOKENS, ResultSummaryConfig
+from linodemcp.profiles import Capability
+from linodemcp.resultstore import MAX_RESULTS, RESULT_ID_PREFIX, Store
+from linodemcp.tools.linode_result_get import handle_linode_result_get
+from linodemcp.tools.result_summary import (
+    apply_result_summary,
+    reset_result_store,
+    resolve_result_summary,
+    set_result_store,
+)
+
+_FIXED_NOW = datetime(2026, 10, 16, 12, 0, 0, tzinfo=UTC)
+_LARGE = "x" * 128
+_ON = ResultSummaryConfig(enabled=True, threshold_bytes=64)
+
+
+async def _refuse(_system_prompt: str, _text: str, _max_tokens: int) -> str:
+    msg = "user rejected sampling request"
+    raise RuntimeError(msg)
+
+
+def test_store_evicts_oldest() -> None:
+    store = Store(now=lambda: _FIXED_NOW)
+    first = store.put("", "linode_instance_list", ["{}"])
+    assert first.startswith(RESULT_ID_PREFIX)
+    entry = store.get("", first)
+    assert entry is not None
+    assert entry.stored_at == _FIXED_NOW
+
+    last = ""
+    for _ in range(MAX_RESULTS):
+        last = store.put("", "linode_volume_list", [])
+    assert store.get("", first) is None
+    assert store.get("", last) is not None
+
+
+def test_store_keeps_partitions_apart() -> None:
+    store = Store()
+    result_id = store.put("0a1b2c3d4e5f6071", "linode_instance_list", ["{}"])
+    assert store.get("8192a3b4c5d6e7f8", result_id) is None
+    assert store.get("0a1b2c3d4e5f6071", result_id) is not None
+
+
+async def test_large_result_is_summarized_and_retrievable() -> None:
+    instances = [{"id": i, "label": "web"} for i in range(20)]
+    full = json.dumps({"instances": instances})
+    seen: dict[str, object] = {}
+
+    async def sampler(_system_prompt: str, text: str, max_tokens: int) -> str:
+        seen["text"] = text
+        seen["max_tokens"] = max_tokens
+        return "20 instances, all running."
+
+    token = set_result_store(Store())
+    try:
+        summary = resolve_result_summary(
+            ResultSummaryConfig(enabled=True, threshold_bytes=64),
+            Capability.Read,
+            sampler,
+        )
+        result = await apply_result_summary(
+            summary,
+            "linode_instance_list",
+            [TextContent(type="text", text=full)],
+        )
+
+        assert full in str(seen["text"])
+        assert seen["max_tokens"] == DEFAULT_RESULT_SUMMARY_MAX_TOKENS
+        assert len(result) == 2
+        assert result[0].text == "20 instances, all running."
+        match = re.search(r'result_id "(result_[0-9a-f-]+)"', result[1].text)
+        assert match is not None
+
+        fetched = await handle_linode_result_get({"result_id": match.group(1)})
+        payload = json.loads(fetched[0].text)
+        assert payload["tool"] == "linode_instance_list"
+        assert payload["content"] == [json.loads(full)]
+    finally:
+        reset_result_store(token)
+
+
+@pytest.mark.parametrize(
+    ("settings", "capability", "text"),
+    [
+        (ResultSummaryConfig(threshold_bytes=64), Capability.Read, _LARGE),
+        (ResultSummaryConfig(enabled=True), Capability.Read, _LARGE),
+        (_ON, Capability.Meta, _LARGE),
+        (_ON, Capability.Read, "Error: " + _LARGE),
+        (_ON, Capability.Read, _LARGE),
+    ],
+    ids=["disabled", "under threshold", "meta tool", "error result", "sampling fails"],
+)
+async def test_result_is_kept(
+    settings: ResultSummaryConfig, capability: Capability, text: str
+) -> None:
+    token = set_result_store(Store())
+    try:
+        result = [TextContent(type="text", text=text)]
+        summary = resolve_result_summary(settings, capability, _refuse)
+        kept = await apply_result_summary(summary, "linode_instance_list", result)
+        assert kept == result
+    finally:
+        reset_result_store(token)
+
+
+async def test_result_get_unknown_id() -> None:
+    token = set_result_store(Store())
+    try:
+        result = await handle_linode_result_get({"result_id": "result_missing"})
+        assert result[0].text.startswith("Error: result result_missing was not found")
+    finally:
+        reset_result_store(token)
@@ -0,0 +1,61 @@
+"""Schema drift handling in the canonical proto serializer.
+
+Mirrors ``go/internal/linode/schema_drift_test.go``: lenient and warn keep
+decoding a response that carries a field the model does not declare, strict
+fails it.
+"""
+
+from __future__ import annotations
+
+import logging
+from typing import Any
+
+import pytest
+
+from linodemcp.genpb.linode.mcp.v1 import profile_pb2
+from linodemcp.linode import SchemaDriftError
+from linodemcp.linode.schema_drift import reset_schema_drift, set_schema_drift
+from linodemcp.tools.proto_response import serialize_api_response
+
+# A /profile body carrying a field no model declares, standing in for a field
+# Linode adds after the models were written.
+_PROFILE_WITH_DRIFT: dict[str, Any] = {
+    "username": "patuser",
+    "email": "pat@example.com",
+    "brand_new_field": True,
+}
+
+
+def _serialize(mode: str) -> dict[str, Any]:
+    token = set_schema_drift(mode)
+    try:
+        return serialize_api_response(_PROFILE_WITH_DRIFT, profile_pb2.Profile())
+    finally:
+        reset_schema_drift(token)
+
+
+@pytest.mark.parametrize("mode", ["lenient", "warn"])
+def test_schema_drift_lenient_ignores_unknown_fields(mode: str) -> None:
+    """Lenient and warn decode the declared fields and drop the new one."""
+    result = _serialize(mode)
+
+    assert result["username"] == "patuser"
+    assert "brand_new_field" not in result
+
+
+def test_schema_drift_warn_logs_unknown_field(
+    caplog: pytest.LogCaptureFixture,
+) -> None:
+    """Warn names the model and the undeclared field in the log."""
+    with caplog.at_level(logging.WARNING, logger="linodemcp.tools.proto_response"):
+        _serialize("warn")
+
+    assert "brand_new_field" in caplog.text
+
+
+def test_schema_drift_strict_rejects_unknown_fields() -> None:
+    """Strict fails the decode with SchemaDriftError."""
+    with pytest.raises(SchemaDriftError) as exc:
+        _serialize("strict")
+
+    assert str(exc.value).startswith("response schema drift: linode.mcp.v1.Profile: ")
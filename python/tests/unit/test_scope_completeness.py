@@ -25,6 +25,7 @@ _SCOPELESS_TOOLS = frozenset(
         "linode_region_list",
         "linode_region_availability_get",
         "linode_region_availability_list",
+        "linode_availability_watch",
         "linode_type_get",
         "linode_type_list",
         "linode_database_engine_get",
@@ -13,7 +13,7 @@ import pytest
 from mcp.types import ListToolsRequest, ListToolsResult
 
 from linodemcp.audit import CapturingSink, Mode
-from linodemcp.config import BuiltinOverride, UserProfileConfig
+from linodemcp.config import BuiltinOverride, ToolsConfig, UserProfileConfig
 from linodemcp.genpb.linode.mcp.v1 import (
     account_pb2,
     account_user_pb2,
@@ -14837,6 +14837,48 @@ async def test_instance_ip_update_tool_is_exported_and_registered(
 # Phase 5: reload_profile tests. Each one exercises a path the hot-reload
 # code is responsible for: success (state swaps), error (state preserved),
 # and convergence (repeated reloads don't accumulate leftover tools).
+async def test_read_only_overrides_full_access(sample_config: Config) -> None:
+    """read_only registers only Read and Meta tools, even under full-access,
+    and a reload to another write-capable profile keeps the clamp."""
+    cfg = dataclasses.replace(_full_access_config(sample_config), read_only=True)
+    srv = Server(cfg)
+
+    assert "linode_instance_list" in srv.registered_tool_names
+    assert "linode_instance_create" not in srv.registered_tool_names
+    assert srv.active_profile.elevated is False
+
+    await srv.reload_profile(dataclasses.replace(cfg, active_profile="compute-admin"))
+
+    capabilities = {entry.name: entry.capability for entry in get_tool_registry()}
+    assert {capabilities[name] for name in srv.registered_tool_names} <= {
+        Capability.Read,
+        Capability.Meta,
+    }
+
+
+async def test_tools_config_filters_surface(sample_config: Config) -> None:
+    """The tools config narrows what full-access registers, and the filter
+    holds across a profile reload."""
+    tools = ToolsConfig(
+        enabled=["linode_instance_*", "!linode_*_delete"],
+        disabled=["linode_instance_create"],
+    )
+    cfg = dataclasses.replace(_full_access_config(sample_config), tools=tools)
+    srv = Server(cfg)
+
+    assert "linode_instance_list" in srv.registered_tool_names
+    for filtered in (
+        "linode_instance_create",
+        "linode_instance_delete",
+        "linode_volume_list",
+    ):
+        assert filtered not in srv.registered_tool_names
+
+    await srv.reload_profile(dataclasses.replace(cfg, active_profile="compute-admin"))
+
+    assert "linode_instance_create" not in srv.active_profile.allowed_tools
+
+
 async def test_reload_profile_swaps_allowed_set(sample_config: Config) -> None:
     """Reloading from default to full-access adds the writes; back removes."""
     srv = Server(sample_config)
@@ -0,0 +1,74 @@
+"""Tests for structured content on tool results."""
+
+from __future__ import annotations
+
+from typing import Any
+
+import pytest
+from mcp.types import EmbeddedResource, TextContent, TextResourceContents
+
+from linodemcp.config import StructuredContentConfig
+from linodemcp.linode.correlation import reset_correlation_id, set_correlation_id
+from linodemcp.tools.structured_content import (
+    apply_embedded_resource,
+    structured_content,
+)
+
+_OBJECT = '{"count": 1, "instances": [{"id": 123456789012, "label": "web"}]}'
+
+
+def _text(text: str) -> list[Any]:
+    return [TextContent(type="text", text=text)]
+
+
+@pytest.mark.parametrize(
+    ("settings", "result", "expected"),
+    [
+        (
+            StructuredContentConfig(),
+            _text(_OBJECT),
+            {"count": 1, "instances": [{"id": 123456789012, "label": "web"}]},
+        ),
+        (StructuredContentConfig(enabled=False), _text(_OBJECT), None),
+        (StructuredContentConfig(), _text('Error: {"reason": "nope"}'), None),
+        (StructuredContentConfig(), _text("Instance 42 rebooted"), None),
+        (StructuredContentConfig(), _text("[1, 2]"), None),
+    ],
+)
+def test_structured_content(
+    settings: StructuredContentConfig,
+    result: list[Any],
+    expected: dict[str, Any] | None,
+) -> None:
+    """Only a successful JSON object text becomes structured content."""
+    assert structured_content(settings, result) == expected
+
+
+def test_apply_embedded_resource() -> None:
+    """The JSON text is attached after itself under the call's URI."""
+    token = set_correlation_id("evt_1")
+    try:
+        result = apply_embedded_resource(
+            StructuredContentConfig(embedded_resource=True),
+            "linode_instances_list",
+            _text(_OBJECT),
+        )
+    finally:
+        reset_correlation_id(token)
+
+    assert len(result) == 2
+    assert isinstance(result[0], TextContent)
+    embedded = result[1]
+    assert isinstance(embedded, EmbeddedResource)
+    assert isinstance(embedded.resource, TextResourceContents)
+    assert embedded.resource.mimeType == "application/json"
+    assert embedded.resource.text == _OBJECT
+    assert str(embedded.resource.uri) == (
+        "linodemcp://results/linode_instances_list/evt_1"
+    )
+
+
+def test_apply_embedded_resource_off_by_default() -> None:
+    """Without embedded_resource the result is returned unchanged."""
+    result = _text(_OBJECT)
+    assert apply_embedded_resource(StructuredContentConfig(), "x", result) is result
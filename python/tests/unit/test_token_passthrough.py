@@ -0,0 +1,147 @@
+"""server.tokenPassthrough: per-request Linode tokens for shared HTTP servers.
+
+Mirrors ``go/internal/tools/token_passthrough_test.go`` and the Go config
+test for ErrPassthroughToken.
+"""
+
+from __future__ import annotations
+
+from types import SimpleNamespace
+from typing import TYPE_CHECKING
+
+import pytest
+from mcp.server.lowlevel.server import request_ctx
+
+from linodemcp.config import (
+    Config,
+    ConfigInvalidError,
+    EnvironmentConfig,
+    LinodeConfig,
+    ServerConfig,
+    load_from_file,
+)
+from linodemcp.resultstore import Store
+from linodemcp.tools.helpers import (
+    TOKEN_HEADER,
+    _linode_credentials,
+    local_store_partition,
+    reset_token_partition,
+    set_token_partition,
+    token_partition,
+)
+from linodemcp.tools.linode_result_get import handle_linode_result_get
+from linodemcp.tools.result_summary import reset_result_store, set_result_store
+
+if TYPE_CHECKING:
+    from pathlib import Path
+
+_API_URL = "https://api.linode.com/v4"
+
+
+def _passthrough_config() -> tuple[Config, EnvironmentConfig]:
+    env = EnvironmentConfig(label="Default", linode=LinodeConfig(api_url=_API_URL))
+    cfg = Config(
+        server=ServerConfig(token_passthrough=True),
+        environments={"default": env},
+    )
+    return cfg, env
+
+
+def test_credentials_come_from_each_request() -> None:
+    cfg, env = _passthrough_config()
+    for token in ("alice-token", "bob-token"):
+        headers = {TOKEN_HEADER: token}
+        context = SimpleNamespace(request=SimpleNamespace(headers=headers))
+        reset = request_ctx.set(context)  # type: ignore[arg-type]
+        try:
+            assert _linode_credentials(cfg, env) == (_API_URL, token)
+        finally:
+            request_ctx.reset(reset)
+
+
+def test_local_stores_are_split_by_token() -> None:
+    """Annotations and the availability watch keep each token's data apart."""
+    cfg, _ = _passthrough_config()
+    partitions: list[str] = []
+    for token in ("alice-token", "bob-token", "alice-token"):
+        headers = {TOKEN_HEADER: token}
+        context = SimpleNamespace(request=SimpleNamespace(headers=headers))
+        reset = request_ctx.set(context)  # type: ignore[arg-type]
+        try:
+            partitions.append(local_store_partition(cfg, "default"))
+        finally:
+            request_ctx.reset(reset)
+
+    assert partitions[0] == partitions[2]
+    assert partitions[0] != partitions[1]
+    assert partitions[0].startswith("default@")
+    with pytest.raises(ValueError, match=TOKEN_HEADER):
+        local_store_partition(cfg, "default")
+    assert local_store_partition(Config(), "default") == "default"
+
+
+async def test_stored_results_are_split_by_token() -> None:
+    """A result one token's call stored is not found for another token."""
+    cfg, _ = _passthrough_config()
+    store = Store()
+    found: dict[str, bool] = {}
+    result_id = ""
+    for token in ("alice-token", "bob-token"):
+        headers = {TOKEN_HEADER: token}
+        context = SimpleNamespace(request=SimpleNamespace(headers=headers))
+        reset = request_ctx.set(context)  # type: ignore[arg-type]
+        partition_token = set_token_partition(cfg)
+        store_token = set_result_store(store)
+        try:
+            if not result_id:
+                partition = token_partition(cfg)
+                result_id = store.put(partition, "linode_instance_list", ["{}"])
+            result = await handle_linode_result_get({"result_id": result_id})
+            found[token] = not result[0].text.startswith("Error:")
+        finally:
+            reset_result_store(store_token)
+            reset_token_partition(partition_token)
+            request_ctx.reset(reset)
+
+    assert found == {"alice-token": True, "bob-token": False}
+
+
+def test_missing_header_never_falls_back() -> None:
+    cfg, env = _passthrough_config()
+    with pytest.raises(ValueError, match=TOKEN_HEADER):
+        _linode_credentials(cfg, env)
+
+
+def test_passthrough_rejects_configured_token(tmp_path: Path) -> None:
+    config_file = tmp_path / "config.yml"
+    config_file.write_text(
+        "server:\n"
+        "  tokenPassthrough: true\n"
+        "environments:\n"
+        "  default:\n"
+        "    linode:\n"
+        f'      apiUrl: "{_API_URL}"\n'
+        '      token: "shared-token"\n',
+        encoding="utf-8",
+    )
+
+    with pytest.raises(ConfigInvalidError, match="must not carry a token"):
+        load_from_file(config_file)
+
+
+def test_passthrough_rejects_offline_cache(tmp_path: Path) -> None:
+    config_file = tmp_path / "config.yml"
+    config_file.write_text(
+        "server:\n"
+        "  tokenPassthrough: true\n"
+        "offline_cache:\n"
+        "  enabled: true\n"
+        "environments:\n"
+        "  default:\n"
+        "    linode:\n"
+        f'      apiUrl: "{_API_URL}"\n',
+        encoding="utf-8",
+    )
+
+    with pytest.raises(ConfigInvalidError, match="offline_cache cannot be combined"):
+        load_from_file(config_file)
@@ -0,0 +1,93 @@
+"""Tests for per-call tool timeouts."""
+
+from __future__ import annotations
+
+import asyncio
+from typing import Any
+from unittest.mock import patch
+
+import pytest
+
+from linodemcp.config import (
+    DEFAULT_TOOL_TIMEOUT_MAX_SECONDS,
+    Config,
+    ToolTimeoutConfig,
+)
+from linodemcp.linode.request_timeout import get_request_timeout
+from linodemcp.server import Server
+from linodemcp.tools import create_linode_volume_get_tool
+from linodemcp.tools.tool_timeout import (
+    PARAM_TIMEOUT_SECONDS,
+    add_tool_timeout_schema,
+    resolve_tool_timeout,
+    timeout_response,
+)
+
+_SETTINGS = ToolTimeoutConfig(
+    default_seconds=20,
+    max_seconds=600,
+    tools={"linode_lke_cluster_create": 300},
+)
+
+
+@pytest.mark.parametrize(
+    ("settings", "name", "arguments", "expected"),
+    [
+        (ToolTimeoutConfig(), "linode_instances_list", {}, 0),
+        (_SETTINGS, "linode_instances_list", {}, 20),
+        (_SETTINGS, "linode_lke_cluster_create", {}, 300),
+        (_SETTINGS, "linode_lke_cluster_create", {PARAM_TIMEOUT_SECONDS: 5}, 5),
+        (_SETTINGS, "linode_instances_list", {PARAM_TIMEOUT_SECONDS: 3600}, 600),
+        (
+            ToolTimeoutConfig(),
+            "linode_instances_list",
+            {PARAM_TIMEOUT_SECONDS: 7200},
+            DEFAULT_TOOL_TIMEOUT_MAX_SECONDS,
+        ),
+        (_SETTINGS, "linode_instances_list", {PARAM_TIMEOUT_SECONDS: 1.5}, 20),
+        (_SETTINGS, "linode_instances_list", {PARAM_TIMEOUT_SECONDS: "60"}, 20),
+        (_SETTINGS, "linode_instances_list", {PARAM_TIMEOUT_SECONDS: True}, 20),
+    ],
+)
+def test_resolve_tool_timeout(
+    settings: ToolTimeoutConfig, name: str, arguments: dict[str, Any], expected: int
+) -> None:
+    """The argument beats the per-tool entry, which beats the default."""
+    assert resolve_tool_timeout(settings, name, arguments) == expected
+
+
+def test_add_tool_timeout_schema() -> None:
+    """The timeout argument joins the tool's own properties."""
+    tool, _ = create_linode_volume_get_tool()
+    properties = add_tool_timeout_schema(tool).inputSchema["properties"]
+    assert PARAM_TIMEOUT_SECONDS in properties
+    assert "volume_id" in properties
+
+
+def test_timeout_response_names_timeout() -> None:
+    """The error reads like Go's, with the duration in Go's format."""
+    text = timeout_response("linode_lke_cluster_create", 90)[0].text
+    assert text == (
+        "Error: linode_lke_cluster_create did not finish within its 1m30s "
+        "timeout; pass timeout_seconds or set "
+        "tool_timeouts.tools.linode_lke_cluster_create to allow longer"
+    )
+
+
+async def test_dispatch_stops_call_at_timeout(sample_config: Config) -> None:
+    """A call past its timeout is cut off with the timeout error, and its API
+    requests run under the call's timeout while it runs."""
+    srv = Server(sample_config)
+    seen: list[float | None] = []
+
+    async def stuck_hello(_arguments: dict[str, Any]) -> list[Any]:
+        seen.append(get_request_timeout())
+        await asyncio.Event().wait()
+        return []
+
+    with patch("linodemcp.server.handle_hello", side_effect=stuck_hello):
+        result = await srv.dispatch("hello", {PARAM_TIMEOUT_SECONDS: 1})
+
+    assert seen == [1.0]
+    assert "hello did not finish within its 1s timeout" in result[0].text
+    assert get_request_timeout() is None
@@ -5982,6 +5982,31 @@ async def test_handle_linode_types_list_filter_class(sample_config: Config) -> N
         assert "g6-nanode-1" not in result[0].text
 
 
+async def test_handle_linode_types_list_region_availability(
+    sample_config: Config,
+) -> None:
+    """A region drops the plans its availability list marks unavailable, and the
+    min filters are lower bounds."""
+    with patch("linodemcp.tools.helpers.RetryableClient") as mock_client_class:
+        mock_client = AsyncMock()
+        mock_client.get_raw.return_value = _type_list_page()
+        mock_client.get_region_availability.return_value = [
+            {"region": "us-east", "plan": "g6-nanode-1", "available": False},
+        ]
+        mock_client.__aenter__.return_value = mock_client
+        mock_client.__aexit__.return_value = None
+        mock_client_class.return_value = mock_client
+
+        result = await handle_linode_type_list(
+            {"min_memory": 1024, "region": "us-east"}, sample_config
+        )
+
+        body = json.loads(result[0].text)
+        assert body["filter"] == "min_memory=1024, region=us-east"
+        assert [t["id"] for t in body["types"]] == ["g6-standard-2"]
+        mock_client.get_region_availability.assert_awaited_once_with("us-east")
+
+
 async def test_handle_linode_type_get(sample_config: Config) -> None:
     """Type get emits the InstanceType proto-canonically (unknown fields drop)."""
     raw_type: dict[str, Any] = {
@@ -11452,6 +11477,7 @@ async def test_handle_linode_domain_record_create(sample_config: Config) -> None
 
     with patch("linodemcp.tools.helpers.RetryableClient") as mock_client_class:
         mock_client = AsyncMock()
+        mock_client.list_domain_records.return_value = []
         mock_client.post_raw.return_value = raw_record
         mock_client.__aenter__.return_value = mock_client
         mock_client.__aexit__.return_value = None
@@ -14809,6 +14835,9 @@ async def test_handle_object_storage_bucket_create_success(
     """Test bucket create success."""
     with patch("linodemcp.tools.helpers.RetryableClient") as mock_cls:
         mock_client = AsyncMock()
+        mock_client.list_object_storage_endpoints.return_value = [
+            {"region": "us-east-1", "endpoint_type": "E1"}
+        ]
         mock_client.create_object_storage_bucket.return_value = {
             "label": "my-bucket",
             "region": "us-east-1",
@@ -25459,6 +25488,37 @@ async def test_handle_linode_firewall_device_create(sample_config: Config) -> No
     )
 
 
+async def test_handle_linode_firewall_device_create_nodebalancer_already_protected(
+    sample_config: Config,
+) -> None:
+    """A NodeBalancer already behind a firewall is rejected before the POST."""
+    from linodemcp.tools.linode_firewalls_write import (
+        handle_linode_firewall_device_create,
+    )
+
+    with patch("linodemcp.tools.helpers.RetryableClient") as mock_client_class:
+        mock_client = AsyncMock()
+        mock_client.list_nodebalancer_firewalls.return_value = {
+            "data": [{"id": 9, "label": "edge"}]
+        }
+        mock_client.__aenter__.return_value = mock_client
+        mock_client.__aexit__.return_value = None
+        mock_client_class.return_value = mock_client
+
+        arguments = {
+            "firewall_id": 12345,
+            "id": 20,
+            "type": "nodebalancer",
+            "confirm": True,
+        }
+        result = await handle_linode_firewall_device_create(arguments, sample_config)
+
+    assert result[0].text.startswith("Error: NodeBalancer 20 is already assigned")
+    assert "firewall 9 (edge)" in result[0].text
+    mock_client.list_nodebalancer_firewalls.assert_awaited_once_with(20)
+    mock_client.create_firewall_device.assert_not_awaited()
+
+
 async def test_handle_linode_firewall_device_create_requires_confirm(
     sample_config: Config,
 ) -> None:
@@ -26447,9 +26507,9 @@ async def test_networking_ip_share_dry_run_returns_preview(
 
 
 async def test_networking_ip_share_happy_path_calls_share_ips(
-    sample_config: Config,
+    sample_config: Config, sample_instance_data: dict[str, Any]
 ) -> None:
-    """confirm=true calls RetryableClient.share_ips once."""
+    """confirm=true checks regions, then calls RetryableClient.share_ips once."""
     from linodemcp.tools.linode_networking import (
         handle_linode_networking_ip_share,
     )
@@ -26457,6 +26517,13 @@ async def test_networking_ip_share_happy_path_calls_share_ips(
     response_data = {"success": True, "shared": ["192.0.2.10"]}
     with patch("linodemcp.tools.helpers.RetryableClient") as mock_client_class:
         mock_client = AsyncMock()
+        mock_client.get_instance.return_value = _make_instance(
+            123, "web-2", "running", sample_instance_data
+        )
+        mock_client.get_networking_ip.return_value = {
+            "address": "192.0.2.10",
+            "region": "us-east",
+        }
         mock_client.share_ips.return_value = response_data
         mock_client.__aenter__.return_value = mock_client
         mock_client.__aexit__.return_value = None
@@ -26714,7 +26781,9 @@ def test_networking_ip_assign_tool_definition() -> None:
     assert "assignments" not in tool.inputSchema["required"]
 
 
-async def test_networking_ip_assign_success(sample_config: Config) -> None:
+async def test_networking_ip_assign_success(
+    sample_config: Config, sample_instance_data: dict[str, Any]
+) -> None:
     """Confirmed assign posts to the generic endpoint via assign_ips."""
     from linodemcp.tools.linode_networking import handle_linode_networking_ip_assign
 
@@ -26722,6 +26791,13 @@ async def test_networking_ip_assign_success(sample_config: Config) -> None:
 
     with patch("linodemcp.tools.helpers.RetryableClient") as mock_cls:
         mock_client = AsyncMock()
+        mock_client.get_instance.return_value = _make_instance(
+            123, "web-2", "running", sample_instance_data
+        )
+        mock_client.get_networking_ip.return_value = {
+            "address": "192.0.2.10",
+            "region": "us-east",
+        }
         mock_client.assign_ips.return_value = {}
         mock_client.__aenter__.return_value = mock_client
         mock_client.__aexit__.return_value = None
@@ -26745,6 +26821,41 @@ async def test_networking_ip_assign_success(sample_config: Config) -> None:
     mock_client.assign_ips.assert_awaited_once_with("us-east", assignments)
 
 
+async def test_networking_ip_assign_refuses_cross_region(
+    sample_config: Config, sample_instance_data: dict[str, Any]
+) -> None:
+    """An address outside the region is refused before assign_ips runs."""
+    from linodemcp.tools.linode_networking import handle_linode_networking_ip_assign
+
+    with patch("linodemcp.tools.helpers.RetryableClient") as mock_cls:
+        mock_client = AsyncMock()
+        mock_client.get_instance.return_value = _make_instance(
+            123, "web-2", "running", sample_instance_data
+        )
+        mock_client.get_networking_ip.return_value = {
+            "address": "192.0.2.10",
+            "region": "us-west",
+        }
+        mock_client.__aenter__.return_value = mock_client
+        mock_client.__aexit__.return_value = None
+        mock_cls.return_value = mock_client
+
+        result = await handle_linode_networking_ip_assign(
+            {
+                "confirm": True,
+                "region": "us-east",
+                "assignments": [{"address": "192.0.2.10", "linode_id": 123}],
+            },
+            sample_config,
+        )
+
+    assert result[0].text == (
+        "Error: address 192.0.2.10 is in us-west, not us-east; IP addresses can"
+        " only be assigned or shared between Linodes in the same region"
+    )
+    mock_client.assign_ips.assert_not_awaited()
+
+
 @pytest.mark.parametrize("confirm", [None, False, "true", 1])
 async def test_networking_ip_assign_requires_boolean_confirm(
     sample_config: Config, confirm: Any
@@ -50,14 +50,14 @@ async def test_put_and_get() -> None:
     entry = _entry("plan_a", _BASE)
     await store.put(entry)
 
-    assert await store.get("plan_a") is entry
+    assert await store.get("", "plan_a") is entry
     assert await store.length() == 1
 
 
 async def test_get_unknown_raises_not_found() -> None:
     store = PlanStore(now=lambda: _BASE)
     with pytest.raises(PlanNotFoundError):
-        await store.get("plan_missing")
+        await store.get("", "plan_missing")
 
 
 async def test_get_expired_raises_expired() -> None:
@@ -68,21 +68,34 @@ async def test_get_expired_raises_expired() -> None:
     clock["t"] = _BASE + timedelta(minutes=10)
 
     with pytest.raises(PlanExpiredError):
-        await store.get("plan_a")
+        await store.get("", "plan_a")
 
 
 async def test_take_is_single_use() -> None:
     store = PlanStore(now=lambda: _BASE)
     await store.put(_entry("plan_x", _BASE))
 
-    taken = await store.take("plan_x")
+    taken = await store.take("", "plan_x")
     assert taken.id == "plan_x"
 
     with pytest.raises(PlanNotFoundError):
-        await store.take("plan_x")
+        await store.take("", "plan_x")
     assert await store.length() == 0
 
 
+async def test_partitions_are_kept_apart() -> None:
+    store = PlanStore(now=lambda: _BASE)
+    entry = _entry("plan_x", _BASE)
+    entry.partition = "0a1b2c3d4e5f6071"
+    await store.put(entry)
+
+    with pytest.raises(PlanNotFoundError):
+        await store.get("8192a3b4c5d6e7f8", "plan_x")
+    with pytest.raises(PlanNotFoundError):
+        await store.take("8192a3b4c5d6e7f8", "plan_x")
+    assert await store.take("0a1b2c3d4e5f6071", "plan_x") is entry
+
+
 async def test_take_expired_still_removes() -> None:
     clock = {"t": _BASE}
     store = PlanStore(now=lambda: clock["t"])
@@ -91,7 +104,7 @@ async def test_take_expired_still_removes() -> None:
     clock["t"] = _BASE + timedelta(minutes=10)
 
     with pytest.raises(PlanExpiredError):
-        await store.take("plan_x")
+        await store.take("", "plan_x")
     assert await store.length() == 0
 
 
@@ -115,7 +128,7 @@ async def test_sweep_drops_only_expired() -> None:
 
     assert await store.sweep() == 1
     assert await store.length() == 1
-    assert (await store.get("plan_long")).id == "plan_long"
+    assert (await store.get("", "plan_long")).id == "plan_long"
 
 
 async def test_start_janitor_sweeps_expired() -> None:
@@ -155,5 +168,5 @@ async def test_put_evicts_oldest_at_ceiling() -> None:
 
     assert await store.length() == MAX_OUTSTANDING_PLANS
     with pytest.raises(PlanNotFoundError):
-        await store.get("plan_0000")
-    assert (await store.get("plan_newest")).id == "plan_newest"
+        await store.get("", "plan_0000")
+    assert (await store.get("", "plan_newest")).id == "plan_newest"
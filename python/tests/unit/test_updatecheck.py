@@ -0,0 +1,36 @@
+"""Unit tests for the release update check helpers."""
+
+import pytest
+
+from linodemcp.updatecheck import highlights, newer
+
+
+@pytest.mark.parametrize(
+    ("latest", "current", "want"),
+    [
+        ("v0.2.0", "0.1.0", True),
+        ("v0.1.10", "0.1.9", True),
+        ("v0.1.0", "0.1.0", False),
+        ("v0.1.0", "0.2.0", False),
+        ("v0.2.0", "0.2.0-rc1", True),
+        ("v0.2.0-rc2", "0.2.0", False),
+        ("v1.0.0", "dev", False),
+        ("latest", "0.1.0", False),
+    ],
+)
+def test_newer(latest: str, current: str, want: bool) -> None:
+    """Newer matches Go's updatecheck.Newer on the same cases."""
+    assert newer(latest, current) is want
+
+
+def test_highlights_takes_leading_bullets() -> None:
+    """Bullets come back in body order, empty ones skipped, capped at limit."""
+    body = (
+        "Summary paragraph.\n\n## Features\n- Add DNS cutover\n- Add TTL bulk set\n"
+        "\n## Bug Fixes\n* Fix retry jitter\n-\n- Fix pager\n"
+    )
+    assert highlights(body, 3) == [
+        "Add DNS cutover",
+        "Add TTL bulk set",
+        "Fix retry jitter",
+    ]
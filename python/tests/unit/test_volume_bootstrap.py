@@ -0,0 +1,85 @@
+"""Tests for bootstrap=true on linode_volume_create and linode_volume_attach."""
+
+from __future__ import annotations
+
+import pytest
+
+from linodemcp.tools.volume_bootstrap import (
+    volume_bootstrap_options,
+    volume_bootstrap_payload,
+)
+
+_DEVICE = "/dev/disk/by-id/scsi-0Linode_Volume_data"
+
+
+def test_payload_carries_script() -> None:
+    """Mirrors Go's TestLinodeVolumeAttachBootstrapReturnsScript."""
+    opts, error = volume_bootstrap_options(
+        {"bootstrap": True, "filesystem": "xfs", "mount_point": "/srv/data"}, 42
+    )
+    assert error == ""
+    payload = {
+        "message": "Volume 7 attached to Linode 42 successfully",
+        "volume": {"id": 7, "label": "data", "filesystem_path": _DEVICE},
+    }
+
+    volume_bootstrap_payload(payload, opts, 42)
+
+    bootstrap = payload["bootstrap"]
+    assert bootstrap["partition_path"] == f"{_DEVICE}-part1"
+    assert bootstrap["filesystem"] == "xfs"
+    assert bootstrap["mount_point"] == "/srv/data"
+    for want in (f'DEVICE="{_DEVICE}"', 'MOUNT_POINT="/srv/data"', "0% 100%"):
+        assert want in bootstrap["script"]
+    assert str(payload["message"]).endswith(
+        "; run bootstrap.script as root on Linode 42 to mount it at /srv/data"
+    )
+
+
+def test_default_mount_point_uses_label() -> None:
+    opts, _ = volume_bootstrap_options({"bootstrap": True}, 42)
+    payload = {"message": "ok", "volume": {"label": "data"}}
+
+    volume_bootstrap_payload(payload, opts, 42)
+
+    assert payload["bootstrap"]["device_path"] == _DEVICE
+    assert payload["bootstrap"]["filesystem"] == "ext4"
+    assert payload["bootstrap"]["mount_point"] == "/mnt/data"
+
+
+@pytest.mark.parametrize(
+    ("arguments", "attach_to", "want"),
+    [
+        (
+            {"bootstrap": True},
+            0,
+            "bootstrap requires linode_id: the volume must be attached to an "
+            "instance to be mounted",
+        ),
+        (
+            {"filesystem": "xfs"},
+            42,
+            "filesystem and mount_point require bootstrap=true",
+        ),
+        (
+            {"bootstrap": True, "filesystem": "btrfs"},
+            42,
+            "filesystem must be one of ext4, xfs",
+        ),
+        (
+            {"bootstrap": True, "mount_point": "mnt/data"},
+            42,
+            "mount_point must be an absolute path such as /mnt/data",
+        ),
+        (
+            {"bootstrap": True, "mount_point": "/mnt/../etc"},
+            42,
+            "mount_point must be an absolute path such as /mnt/data",
+        ),
+    ],
+)
+def test_options_rejected(
+    arguments: dict[str, object], attach_to: int, want: str
+) -> None:
+    _, error = volume_bootstrap_options(arguments, attach_to)
+    assert error == want
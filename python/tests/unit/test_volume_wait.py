@@ -0,0 +1,82 @@
+"""Tests for wait=true on volume create and attach."""
+
+from __future__ import annotations
+
+import json
+from typing import TYPE_CHECKING
+from unittest.mock import AsyncMock, patch
+
+from linodemcp.tools.linode_volumes_write import (
+    handle_linode_volume_attach,
+    handle_linode_volume_create,
+)
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+
+_PATH = "/dev/disk/by-id/scsi-0Linode_Volume_data"
+
+
+def _volume(status: str) -> dict[str, object]:
+    return {
+        "id": 7,
+        "label": "data",
+        "status": status,
+        "size": 20,
+        "region": "us-east",
+        "linode_id": 42,
+        "filesystem_path": _PATH,
+    }
+
+
+async def test_volume_create_waits_until_active(sample_config: Config) -> None:
+    """A creating volume is re-read until active, then gains mount commands."""
+    with patch("linodemcp.tools.helpers.RetryableClient") as mock_client_class:
+        mock_client = AsyncMock()
+        mock_client.post_raw.return_value = _volume("creating")
+        mock_client.get_raw.return_value = _volume("active")
+        mock_client.__aenter__.return_value = mock_client
+        mock_client.__aexit__.return_value = None
+        mock_client_class.return_value = mock_client
+
+        result = await handle_linode_volume_create(
+            {"label": "data", "linode_id": 42, "confirm": True, "wait": True},
+            sample_config,
+        )
+
+    body = json.loads(result[0].text)
+    mock_client.get_raw.assert_awaited_once_with("/volumes/7")
+    assert body["ready"] is True
+    assert body["volume"]["status"] == "active"
+    assert body["mount"] == {
+        "filesystem_path": _PATH,
+        "mount_point": "/mnt/data",
+        "format_command": f'mkfs.ext4 "{_PATH}"',
+        "mount_commands": [
+            'mkdir -p "/mnt/data"',
+            f'mount "{_PATH}" "/mnt/data"',
+            f'echo "{_PATH} /mnt/data ext4 defaults,noatime,nofail 0 2" >> /etc/fstab',
+        ],
+    }
+    assert body["message"].endswith(f"; it is active on Linode 42 at {_PATH}")
+
+
+async def test_volume_attach_without_wait_skips_readiness(
+    sample_config: Config,
+) -> None:
+    """Without wait the attach result carries neither ready nor mount."""
+    with patch("linodemcp.tools.helpers.RetryableClient") as mock_client_class:
+        mock_client = AsyncMock()
+        mock_client.post_raw.return_value = _volume("active")
+        mock_client.__aenter__.return_value = mock_client
+        mock_client.__aexit__.return_value = None
+        mock_client_class.return_value = mock_client
+
+        result = await handle_linode_volume_attach(
+            {"volume_id": 7, "linode_id": 42, "confirm": True}, sample_config
+        )
+
+    body = json.loads(result[0].text)
+    mock_client.get_raw.assert_not_awaited()
+    assert "ready" not in body
+    assert "mount" not in body
@@ -0,0 +1,81 @@
+"""Write cost attribution tests.
+
+Mirrors ``go/internal/tools/write_cost_test.go``: each priced write resolves
+to its monthly list-price change, and a write the tables cannot price (or one
+write cost attribution does not cover) resolves to None.
+"""
+
+from __future__ import annotations
+
+from typing import TYPE_CHECKING, Any
+from unittest.mock import AsyncMock, patch
+
+import pytest
+
+from linodemcp.linode import Addons, BackupsAddon, InstanceType, Price
+from linodemcp.tools.write_cost import write_cost_delta
+
+if TYPE_CHECKING:
+    from linodemcp.config import Config
+
+
+def _instance_type(type_id: str, monthly: float, backups: float) -> InstanceType:
+    return InstanceType(
+        id=type_id,
+        label=type_id,
+        class_="standard",
+        disk=51200,
+        memory=2048,
+        vcpus=1,
+        gpus=0,
+        network_out=2000,
+        transfer=2000,
+        price=Price(hourly=0.0, monthly=monthly),
+        addons=Addons(backups=BackupsAddon(price=Price(hourly=0.0, monthly=backups))),
+        successor=None,
+    )
+
+
+@pytest.mark.parametrize(
+    ("name", "arguments", "expected"),
+    [
+        (
+            "linode_instance_create",
+            {"type": "g6-standard-2", "region": "us-east", "backups_enabled": True},
+            29.0,
+        ),
+        ("linode_volume_create", {"label": "logs", "region": "br-gru"}, 2.8),
+        ("linode_nodebalancer_create", {"region": "us-east"}, 10.0),
+        ("linode_instance_create", {"type": "g6-unknown"}, None),
+        ("linode_instance_reboot", {"instance_id": 1}, None),
+    ],
+)
+async def test_write_cost_delta(
+    sample_config: Config,
+    name: str,
+    arguments: dict[str, Any],
+    expected: float | None,
+) -> None:
+    """A priced write returns its list-price change; anything else None."""
+    with patch("linodemcp.tools.helpers.RetryableClient") as mock_client_class:
+        mock_client = AsyncMock()
+        mock_client.list_types.return_value = [
+            _instance_type("g6-standard-2", 24.0, 5.0)
+        ]
+        mock_client.list_volume_types.return_value = [
+            {
+                "id": "volume",
+                "price": {"hourly": 0.00015, "monthly": 0.1},
+                "region_prices": [{"id": "br-gru", "hourly": 0.00021, "monthly": 0.14}],
+            }
+        ]
+        mock_client.list_nodebalancer_types.return_value = [
+            {"id": "nodebalancer", "price": {"hourly": 0.015, "monthly": 10.0}}
+        ]
+        mock_client.__aenter__.return_value = mock_client
+        mock_client.__aexit__.return_value = None
+        mock_client_class.return_value = mock_client
+
+        result = await write_cost_delta(sample_config, name, arguments)
+
+    assert result == expected
@@ -33,8 +33,10 @@ _PY_MAIN = _REPO_ROOT / "python" / "src" / "linodemcp" / "main.py"
 _PY_CALL = _REPO_ROOT / "python" / "src" / "linodemcp" / "cli" / "call.py"
 _PY_TOOLS = _REPO_ROOT / "python" / "src" / "linodemcp" / "cli" / "tools.py"
 
-_GO_CASE = re.compile(r'^\tcase "([a-z]+)":', re.MULTILINE)
-_PY_SUBCOMMANDS = re.compile(r"_CLI_SUBCOMMANDS = frozenset\(\{(.*?)\}\)", re.DOTALL)
+_GO_CASE = re.compile(r'^\tcase "([a-z][a-z0-9-]*)":', re.MULTILINE)
+_PY_SUBCOMMANDS = re.compile(
+    r"_CLI_SUBCOMMANDS = frozenset\(\s*\{(.*?)\}\s*\)", re.DOTALL
+)
 _QUOTED_NAME = re.compile(r'"([a-z][a-z0-9-]*)"')
 _GO_FLAG_REGISTRATIONS = (
     re.compile(r'\.StringVar\(&[^,]+,\s*"([a-z][a-z0-9-]*)"'),